@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: eodini/v1/location.proto
+
+// 📝 설명: 차량 위치 전송 API (REST POST /trips/{id}/locations와 같은 권한: 배정 승무원 또는 locations:write API 키)
+// 🎯 실무 포인트: 차량 단말 게이트웨이는 StreamLocations 하나의 스트림으로 여러 운행의 위치를 연속 전송
+
+package eodiniv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Speed         *float64               `protobuf:"fixed64,4,opt,name=speed,proto3,oneof" json:"speed,omitempty"`                     // km/h
+	Heading       *float64               `protobuf:"fixed64,5,opt,name=heading,proto3,oneof" json:"heading,omitempty"`                 // 0~360도
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"` // 단말 측정 시각 (생략 시 수신 시각)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLocationRequest) Reset() {
+	*x = ReportLocationRequest{}
+	mi := &file_eodini_v1_location_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLocationRequest) ProtoMessage() {}
+
+func (x *ReportLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_location_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLocationRequest.ProtoReflect.Descriptor instead.
+func (*ReportLocationRequest) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_location_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportLocationRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *ReportLocationRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ReportLocationRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ReportLocationRequest) GetSpeed() float64 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+func (x *ReportLocationRequest) GetHeading() float64 {
+	if x != nil && x.Heading != nil {
+		return *x.Heading
+	}
+	return 0
+}
+
+func (x *ReportLocationRequest) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+// VehiclePosition - 저장된 차량 위치
+type VehiclePosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	VehicleId     string                 `protobuf:"bytes,2,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Speed         *float64               `protobuf:"fixed64,5,opt,name=speed,proto3,oneof" json:"speed,omitempty"`
+	Heading       *float64               `protobuf:"fixed64,6,opt,name=heading,proto3,oneof" json:"heading,omitempty"`
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehiclePosition) Reset() {
+	*x = VehiclePosition{}
+	mi := &file_eodini_v1_location_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehiclePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehiclePosition) ProtoMessage() {}
+
+func (x *VehiclePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_location_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehiclePosition.ProtoReflect.Descriptor instead.
+func (*VehiclePosition) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_location_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VehiclePosition) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *VehiclePosition) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *VehiclePosition) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *VehiclePosition) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *VehiclePosition) GetSpeed() float64 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+func (x *VehiclePosition) GetHeading() float64 {
+	if x != nil && x.Heading != nil {
+		return *x.Heading
+	}
+	return 0
+}
+
+func (x *VehiclePosition) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+type StreamLocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"` // 저장한 위치 수
+	Rejected      int32                  `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"` // 건너뛴 위치 수
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLocationsResponse) Reset() {
+	*x = StreamLocationsResponse{}
+	mi := &file_eodini_v1_location_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLocationsResponse) ProtoMessage() {}
+
+func (x *StreamLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_location_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLocationsResponse.ProtoReflect.Descriptor instead.
+func (*StreamLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_location_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamLocationsResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *StreamLocationsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+var File_eodini_v1_location_proto protoreflect.FileDescriptor
+
+const file_eodini_v1_location_proto_rawDesc = "" +
+	"\n" +
+	"\x18eodini/v1/location.proto\x12\teodini.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x01\n" +
+	"\x15ReportLocationRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x19\n" +
+	"\x05speed\x18\x04 \x01(\x01H\x00R\x05speed\x88\x01\x01\x12\x1d\n" +
+	"\aheading\x18\x05 \x01(\x01H\x01R\aheading\x88\x01\x01\x12;\n" +
+	"\vrecorded_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAtB\b\n" +
+	"\x06_speedB\n" +
+	"\n" +
+	"\b_heading\"\x90\x02\n" +
+	"\x0fVehiclePosition\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\tR\x06tripId\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x02 \x01(\tR\tvehicleId\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x19\n" +
+	"\x05speed\x18\x05 \x01(\x01H\x00R\x05speed\x88\x01\x01\x12\x1d\n" +
+	"\aheading\x18\x06 \x01(\x01H\x01R\aheading\x88\x01\x01\x12;\n" +
+	"\vrecorded_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAtB\b\n" +
+	"\x06_speedB\n" +
+	"\n" +
+	"\b_heading\"Q\n" +
+	"\x17StreamLocationsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x05R\brejected2\xbc\x01\n" +
+	"\x0fLocationService\x12N\n" +
+	"\x0eReportLocation\x12 .eodini.v1.ReportLocationRequest\x1a\x1a.eodini.v1.VehiclePosition\x12Y\n" +
+	"\x0fStreamLocations\x12 .eodini.v1.ReportLocationRequest\x1a\".eodini.v1.StreamLocationsResponse(\x01B3Z1github.com/hyeokjun/eodini/api/eodini/v1;eodiniv1b\x06proto3"
+
+var (
+	file_eodini_v1_location_proto_rawDescOnce sync.Once
+	file_eodini_v1_location_proto_rawDescData []byte
+)
+
+func file_eodini_v1_location_proto_rawDescGZIP() []byte {
+	file_eodini_v1_location_proto_rawDescOnce.Do(func() {
+		file_eodini_v1_location_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_eodini_v1_location_proto_rawDesc), len(file_eodini_v1_location_proto_rawDesc)))
+	})
+	return file_eodini_v1_location_proto_rawDescData
+}
+
+var file_eodini_v1_location_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_eodini_v1_location_proto_goTypes = []any{
+	(*ReportLocationRequest)(nil),   // 0: eodini.v1.ReportLocationRequest
+	(*VehiclePosition)(nil),         // 1: eodini.v1.VehiclePosition
+	(*StreamLocationsResponse)(nil), // 2: eodini.v1.StreamLocationsResponse
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+}
+var file_eodini_v1_location_proto_depIdxs = []int32{
+	3, // 0: eodini.v1.ReportLocationRequest.recorded_at:type_name -> google.protobuf.Timestamp
+	3, // 1: eodini.v1.VehiclePosition.recorded_at:type_name -> google.protobuf.Timestamp
+	0, // 2: eodini.v1.LocationService.ReportLocation:input_type -> eodini.v1.ReportLocationRequest
+	0, // 3: eodini.v1.LocationService.StreamLocations:input_type -> eodini.v1.ReportLocationRequest
+	1, // 4: eodini.v1.LocationService.ReportLocation:output_type -> eodini.v1.VehiclePosition
+	2, // 5: eodini.v1.LocationService.StreamLocations:output_type -> eodini.v1.StreamLocationsResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_eodini_v1_location_proto_init() }
+func file_eodini_v1_location_proto_init() {
+	if File_eodini_v1_location_proto != nil {
+		return
+	}
+	file_eodini_v1_location_proto_msgTypes[0].OneofWrappers = []any{}
+	file_eodini_v1_location_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eodini_v1_location_proto_rawDesc), len(file_eodini_v1_location_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_eodini_v1_location_proto_goTypes,
+		DependencyIndexes: file_eodini_v1_location_proto_depIdxs,
+		MessageInfos:      file_eodini_v1_location_proto_msgTypes,
+	}.Build()
+	File_eodini_v1_location_proto = out.File
+	file_eodini_v1_location_proto_goTypes = nil
+	file_eodini_v1_location_proto_depIdxs = nil
+}
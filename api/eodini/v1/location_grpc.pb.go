@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: eodini/v1/location.proto
+
+// 📝 설명: 차량 위치 전송 API (REST POST /trips/{id}/locations와 같은 권한: 배정 승무원 또는 locations:write API 키)
+// 🎯 실무 포인트: 차량 단말 게이트웨이는 StreamLocations 하나의 스트림으로 여러 운행의 위치를 연속 전송
+
+package eodiniv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LocationService_ReportLocation_FullMethodName  = "/eodini.v1.LocationService/ReportLocation"
+	LocationService_StreamLocations_FullMethodName = "/eodini.v1.LocationService/StreamLocations"
+)
+
+// LocationServiceClient is the client API for LocationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LocationServiceClient interface {
+	// ReportLocation - 운행 중 차량 위치 1건 전송
+	ReportLocation(ctx context.Context, in *ReportLocationRequest, opts ...grpc.CallOption) (*VehiclePosition, error)
+	// StreamLocations - 위치 연속 전송 (운행 중이 아니거나 잘못된 위치는 건너뛰고 건수만 집계)
+	StreamLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ReportLocationRequest, StreamLocationsResponse], error)
+}
+
+type locationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLocationServiceClient(cc grpc.ClientConnInterface) LocationServiceClient {
+	return &locationServiceClient{cc}
+}
+
+func (c *locationServiceClient) ReportLocation(ctx context.Context, in *ReportLocationRequest, opts ...grpc.CallOption) (*VehiclePosition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VehiclePosition)
+	err := c.cc.Invoke(ctx, LocationService_ReportLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) StreamLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ReportLocationRequest, StreamLocationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LocationService_ServiceDesc.Streams[0], LocationService_StreamLocations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReportLocationRequest, StreamLocationsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LocationService_StreamLocationsClient = grpc.ClientStreamingClient[ReportLocationRequest, StreamLocationsResponse]
+
+// LocationServiceServer is the server API for LocationService service.
+// All implementations must embed UnimplementedLocationServiceServer
+// for forward compatibility.
+type LocationServiceServer interface {
+	// ReportLocation - 운행 중 차량 위치 1건 전송
+	ReportLocation(context.Context, *ReportLocationRequest) (*VehiclePosition, error)
+	// StreamLocations - 위치 연속 전송 (운행 중이 아니거나 잘못된 위치는 건너뛰고 건수만 집계)
+	StreamLocations(grpc.ClientStreamingServer[ReportLocationRequest, StreamLocationsResponse]) error
+	mustEmbedUnimplementedLocationServiceServer()
+}
+
+// UnimplementedLocationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLocationServiceServer struct{}
+
+func (UnimplementedLocationServiceServer) ReportLocation(context.Context, *ReportLocationRequest) (*VehiclePosition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportLocation not implemented")
+}
+func (UnimplementedLocationServiceServer) StreamLocations(grpc.ClientStreamingServer[ReportLocationRequest, StreamLocationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLocations not implemented")
+}
+func (UnimplementedLocationServiceServer) mustEmbedUnimplementedLocationServiceServer() {}
+func (UnimplementedLocationServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeLocationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LocationServiceServer will
+// result in compilation errors.
+type UnsafeLocationServiceServer interface {
+	mustEmbedUnimplementedLocationServiceServer()
+}
+
+func RegisterLocationServiceServer(s grpc.ServiceRegistrar, srv LocationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLocationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LocationService_ServiceDesc, srv)
+}
+
+func _LocationService_ReportLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).ReportLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_ReportLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).ReportLocation(ctx, req.(*ReportLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_StreamLocations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LocationServiceServer).StreamLocations(&grpc.GenericServerStream[ReportLocationRequest, StreamLocationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LocationService_StreamLocationsServer = grpc.ClientStreamingServer[ReportLocationRequest, StreamLocationsResponse]
+
+// LocationService_ServiceDesc is the grpc.ServiceDesc for LocationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LocationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eodini.v1.LocationService",
+	HandlerType: (*LocationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportLocation",
+			Handler:    _LocationService_ReportLocation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLocations",
+			Handler:       _LocationService_StreamLocations_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "eodini/v1/location.proto",
+}
@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: eodini/v1/schedule.proto
+
+// 📝 설명: 일정 조회 API (REST GET /schedules, /schedules/{id}와 같은 권한: 운영 인력 또는 schedules:read API 키)
+
+package eodiniv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Schedule - 운행 일정
+type Schedule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // active, inactive
+	StartTime          string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 출발 시각 (HH:MM)
+	TimeSlot           string                 `protobuf:"bytes,6,opt,name=time_slot,json=timeSlot,proto3" json:"time_slot,omitempty"`
+	DaysOfWeek         []int32                `protobuf:"varint,7,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"` // 1=월 ~ 7=일
+	SkipHolidays       bool                   `protobuf:"varint,8,opt,name=skip_holidays,json=skipHolidays,proto3" json:"skip_holidays,omitempty"`
+	RouteId            string                 `protobuf:"bytes,9,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	VehicleId          string                 `protobuf:"bytes,10,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	DefaultDriverId    string                 `protobuf:"bytes,11,opt,name=default_driver_id,json=defaultDriverId,proto3" json:"default_driver_id,omitempty"`
+	DefaultAttendantId string                 `protobuf:"bytes,12,opt,name=default_attendant_id,json=defaultAttendantId,proto3" json:"default_attendant_id,omitempty"` // 기본 동승자가 없으면 빈 값
+	ValidFrom          string                 `protobuf:"bytes,13,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                              // 유효 시작일 (YYYY-MM-DD, 없으면 빈 값)
+	ValidTo            string                 `protobuf:"bytes,14,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`                                    // 유효 종료일 (YYYY-MM-DD, 없으면 빈 값)
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_eodini_v1_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Schedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Schedule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Schedule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Schedule) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Schedule) GetTimeSlot() string {
+	if x != nil {
+		return x.TimeSlot
+	}
+	return ""
+}
+
+func (x *Schedule) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *Schedule) GetSkipHolidays() bool {
+	if x != nil {
+		return x.SkipHolidays
+	}
+	return false
+}
+
+func (x *Schedule) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *Schedule) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *Schedule) GetDefaultDriverId() string {
+	if x != nil {
+		return x.DefaultDriverId
+	}
+	return ""
+}
+
+func (x *Schedule) GetDefaultAttendantId() string {
+	if x != nil {
+		return x.DefaultAttendantId
+	}
+	return ""
+}
+
+func (x *Schedule) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *Schedule) GetValidTo() string {
+	if x != nil {
+		return x.ValidTo
+	}
+	return ""
+}
+
+func (x *Schedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Schedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_eodini_v1_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RouteId       string                 `protobuf:"bytes,2,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	VehicleId     string                 `protobuf:"bytes,3,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	DriverId      string                 `protobuf:"bytes,4,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,5,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_eodini_v1_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSchedulesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	Page          *PageInfo              `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_eodini_v1_schedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_schedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ListSchedulesResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_eodini_v1_schedule_proto protoreflect.FileDescriptor
+
+const file_eodini_v1_schedule_proto_rawDesc = "" +
+	"\n" +
+	"\x18eodini/v1/schedule.proto\x12\teodini.v1\x1a\x16eodini/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb3\x04\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12\x1b\n" +
+	"\ttime_slot\x18\x06 \x01(\tR\btimeSlot\x12 \n" +
+	"\fdays_of_week\x18\a \x03(\x05R\n" +
+	"daysOfWeek\x12#\n" +
+	"\rskip_holidays\x18\b \x01(\bR\fskipHolidays\x12\x19\n" +
+	"\broute_id\x18\t \x01(\tR\arouteId\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\n" +
+	" \x01(\tR\tvehicleId\x12*\n" +
+	"\x11default_driver_id\x18\v \x01(\tR\x0fdefaultDriverId\x120\n" +
+	"\x14default_attendant_id\x18\f \x01(\tR\x12defaultAttendantId\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\r \x01(\tR\tvalidFrom\x12\x19\n" +
+	"\bvalid_to\x18\x0e \x01(\tR\avalidTo\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"$\n" +
+	"\x12GetScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb1\x01\n" +
+	"\x14ListSchedulesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
+	"\broute_id\x18\x02 \x01(\tR\arouteId\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x03 \x01(\tR\tvehicleId\x12\x1b\n" +
+	"\tdriver_id\x18\x04 \x01(\tR\bdriverId\x12*\n" +
+	"\x04page\x18\x05 \x01(\v2\x16.eodini.v1.PageRequestR\x04page\"s\n" +
+	"\x15ListSchedulesResponse\x121\n" +
+	"\tschedules\x18\x01 \x03(\v2\x13.eodini.v1.ScheduleR\tschedules\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.eodini.v1.PageInfoR\x04page2\xa8\x01\n" +
+	"\x0fScheduleService\x12A\n" +
+	"\vGetSchedule\x12\x1d.eodini.v1.GetScheduleRequest\x1a\x13.eodini.v1.Schedule\x12R\n" +
+	"\rListSchedules\x12\x1f.eodini.v1.ListSchedulesRequest\x1a .eodini.v1.ListSchedulesResponseB3Z1github.com/hyeokjun/eodini/api/eodini/v1;eodiniv1b\x06proto3"
+
+var (
+	file_eodini_v1_schedule_proto_rawDescOnce sync.Once
+	file_eodini_v1_schedule_proto_rawDescData []byte
+)
+
+func file_eodini_v1_schedule_proto_rawDescGZIP() []byte {
+	file_eodini_v1_schedule_proto_rawDescOnce.Do(func() {
+		file_eodini_v1_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_eodini_v1_schedule_proto_rawDesc), len(file_eodini_v1_schedule_proto_rawDesc)))
+	})
+	return file_eodini_v1_schedule_proto_rawDescData
+}
+
+var file_eodini_v1_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_eodini_v1_schedule_proto_goTypes = []any{
+	(*Schedule)(nil),              // 0: eodini.v1.Schedule
+	(*GetScheduleRequest)(nil),    // 1: eodini.v1.GetScheduleRequest
+	(*ListSchedulesRequest)(nil),  // 2: eodini.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil), // 3: eodini.v1.ListSchedulesResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*PageRequest)(nil),           // 5: eodini.v1.PageRequest
+	(*PageInfo)(nil),              // 6: eodini.v1.PageInfo
+}
+var file_eodini_v1_schedule_proto_depIdxs = []int32{
+	4, // 0: eodini.v1.Schedule.created_at:type_name -> google.protobuf.Timestamp
+	4, // 1: eodini.v1.Schedule.updated_at:type_name -> google.protobuf.Timestamp
+	5, // 2: eodini.v1.ListSchedulesRequest.page:type_name -> eodini.v1.PageRequest
+	0, // 3: eodini.v1.ListSchedulesResponse.schedules:type_name -> eodini.v1.Schedule
+	6, // 4: eodini.v1.ListSchedulesResponse.page:type_name -> eodini.v1.PageInfo
+	1, // 5: eodini.v1.ScheduleService.GetSchedule:input_type -> eodini.v1.GetScheduleRequest
+	2, // 6: eodini.v1.ScheduleService.ListSchedules:input_type -> eodini.v1.ListSchedulesRequest
+	0, // 7: eodini.v1.ScheduleService.GetSchedule:output_type -> eodini.v1.Schedule
+	3, // 8: eodini.v1.ScheduleService.ListSchedules:output_type -> eodini.v1.ListSchedulesResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_eodini_v1_schedule_proto_init() }
+func file_eodini_v1_schedule_proto_init() {
+	if File_eodini_v1_schedule_proto != nil {
+		return
+	}
+	file_eodini_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eodini_v1_schedule_proto_rawDesc), len(file_eodini_v1_schedule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_eodini_v1_schedule_proto_goTypes,
+		DependencyIndexes: file_eodini_v1_schedule_proto_depIdxs,
+		MessageInfos:      file_eodini_v1_schedule_proto_msgTypes,
+	}.Build()
+	File_eodini_v1_schedule_proto = out.File
+	file_eodini_v1_schedule_proto_goTypes = nil
+	file_eodini_v1_schedule_proto_depIdxs = nil
+}
@@ -0,0 +1,613 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: eodini/v1/trip.proto
+
+// 📝 설명: 운행 조회 API (REST GET /trips, /trips/{id}와 같은 권한: 운영 인력 또는 trips:read API 키)
+
+package eodiniv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Trip - 운행
+type Trip struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId          string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Date                string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`     // 운행 날짜 (YYYY-MM-DD)
+	Status              string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending, in_progress, paused, completed, cancelled
+	VehicleId           string                 `protobuf:"bytes,5,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	AssignedDriverId    string                 `protobuf:"bytes,6,opt,name=assigned_driver_id,json=assignedDriverId,proto3" json:"assigned_driver_id,omitempty"`
+	AssignedAttendantId string                 `protobuf:"bytes,7,opt,name=assigned_attendant_id,json=assignedAttendantId,proto3" json:"assigned_attendant_id,omitempty"` // 동승자 미배정이면 빈 값
+	StartedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CancelledAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancellationReason  string                 `protobuf:"bytes,11,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	DelayedAt           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delayed_at,json=delayedAt,proto3" json:"delayed_at,omitempty"`
+	DelayReason         string                 `protobuf:"bytes,13,opt,name=delay_reason,json=delayReason,proto3" json:"delay_reason,omitempty"`
+	TotalDistance       int32                  `protobuf:"varint,14,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"` // 총 주행 거리 (미터)
+	Passengers          []*TripPassenger       `protobuf:"bytes,15,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Trip) Reset() {
+	*x = Trip{}
+	mi := &file_eodini_v1_trip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trip) ProtoMessage() {}
+
+func (x *Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_trip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trip.ProtoReflect.Descriptor instead.
+func (*Trip) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_trip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Trip) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Trip) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *Trip) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Trip) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Trip) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *Trip) GetAssignedDriverId() string {
+	if x != nil {
+		return x.AssignedDriverId
+	}
+	return ""
+}
+
+func (x *Trip) GetAssignedAttendantId() string {
+	if x != nil {
+		return x.AssignedAttendantId
+	}
+	return ""
+}
+
+func (x *Trip) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Trip) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+func (x *Trip) GetDelayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DelayedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetDelayReason() string {
+	if x != nil {
+		return x.DelayReason
+	}
+	return ""
+}
+
+func (x *Trip) GetTotalDistance() int32 {
+	if x != nil {
+		return x.TotalDistance
+	}
+	return 0
+}
+
+func (x *Trip) GetPassengers() []*TripPassenger {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *Trip) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// TripPassenger - 운행 탑승자 기록
+type TripPassenger struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PassengerId   string                 `protobuf:"bytes,2,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	StopId        string                 `protobuf:"bytes,3,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`
+	IsBoarded     bool                   `protobuf:"varint,4,opt,name=is_boarded,json=isBoarded,proto3" json:"is_boarded,omitempty"`
+	IsAlighted    bool                   `protobuf:"varint,5,opt,name=is_alighted,json=isAlighted,proto3" json:"is_alighted,omitempty"`
+	BoardedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=boarded_at,json=boardedAt,proto3" json:"boarded_at,omitempty"`
+	AlightedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=alighted_at,json=alightedAt,proto3" json:"alighted_at,omitempty"`
+	NoShowReason  string                 `protobuf:"bytes,8,opt,name=no_show_reason,json=noShowReason,proto3" json:"no_show_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripPassenger) Reset() {
+	*x = TripPassenger{}
+	mi := &file_eodini_v1_trip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripPassenger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripPassenger) ProtoMessage() {}
+
+func (x *TripPassenger) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_trip_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripPassenger.ProtoReflect.Descriptor instead.
+func (*TripPassenger) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_trip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TripPassenger) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripPassenger) GetPassengerId() string {
+	if x != nil {
+		return x.PassengerId
+	}
+	return ""
+}
+
+func (x *TripPassenger) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *TripPassenger) GetIsBoarded() bool {
+	if x != nil {
+		return x.IsBoarded
+	}
+	return false
+}
+
+func (x *TripPassenger) GetIsAlighted() bool {
+	if x != nil {
+		return x.IsAlighted
+	}
+	return false
+}
+
+func (x *TripPassenger) GetBoardedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BoardedAt
+	}
+	return nil
+}
+
+func (x *TripPassenger) GetAlightedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AlightedAt
+	}
+	return nil
+}
+
+func (x *TripPassenger) GetNoShowReason() string {
+	if x != nil {
+		return x.NoShowReason
+	}
+	return ""
+}
+
+type GetTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripRequest) Reset() {
+	*x = GetTripRequest{}
+	mi := &file_eodini_v1_trip_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripRequest) ProtoMessage() {}
+
+func (x *GetTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_trip_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripRequest.ProtoReflect.Descriptor instead.
+func (*GetTripRequest) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_trip_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTripRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTripsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 운행 날짜 (YYYY-MM-DD)
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	DriverId      string                 `protobuf:"bytes,4,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	AttendantId   string                 `protobuf:"bytes,5,opt,name=attendant_id,json=attendantId,proto3" json:"attendant_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,6,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripsRequest) Reset() {
+	*x = ListTripsRequest{}
+	mi := &file_eodini_v1_trip_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsRequest) ProtoMessage() {}
+
+func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_trip_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsRequest.ProtoReflect.Descriptor instead.
+func (*ListTripsRequest) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_trip_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTripsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ListTripsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTripsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListTripsRequest) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *ListTripsRequest) GetAttendantId() string {
+	if x != nil {
+		return x.AttendantId
+	}
+	return ""
+}
+
+func (x *ListTripsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListTripsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trips         []*Trip                `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	Page          *PageInfo              `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripsResponse) Reset() {
+	*x = ListTripsResponse{}
+	mi := &file_eodini_v1_trip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsResponse) ProtoMessage() {}
+
+func (x *ListTripsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eodini_v1_trip_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsResponse.ProtoReflect.Descriptor instead.
+func (*ListTripsResponse) Descriptor() ([]byte, []int) {
+	return file_eodini_v1_trip_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTripsResponse) GetTrips() []*Trip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+func (x *ListTripsResponse) GetPage() *PageInfo {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+var File_eodini_v1_trip_proto protoreflect.FileDescriptor
+
+const file_eodini_v1_trip_proto_rawDesc = "" +
+	"\n" +
+	"\x14eodini/v1/trip.proto\x12\teodini.v1\x1a\x16eodini/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x83\x06\n" +
+	"\x04Trip\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x05 \x01(\tR\tvehicleId\x12,\n" +
+	"\x12assigned_driver_id\x18\x06 \x01(\tR\x10assignedDriverId\x122\n" +
+	"\x15assigned_attendant_id\x18\a \x01(\tR\x13assignedAttendantId\x129\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12=\n" +
+	"\fcancelled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12/\n" +
+	"\x13cancellation_reason\x18\v \x01(\tR\x12cancellationReason\x129\n" +
+	"\n" +
+	"delayed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdelayedAt\x12!\n" +
+	"\fdelay_reason\x18\r \x01(\tR\vdelayReason\x12%\n" +
+	"\x0etotal_distance\x18\x0e \x01(\x05R\rtotalDistance\x128\n" +
+	"\n" +
+	"passengers\x18\x0f \x03(\v2\x18.eodini.v1.TripPassengerR\n" +
+	"passengers\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb9\x02\n" +
+	"\rTripPassenger\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fpassenger_id\x18\x02 \x01(\tR\vpassengerId\x12\x17\n" +
+	"\astop_id\x18\x03 \x01(\tR\x06stopId\x12\x1d\n" +
+	"\n" +
+	"is_boarded\x18\x04 \x01(\bR\tisBoarded\x12\x1f\n" +
+	"\vis_alighted\x18\x05 \x01(\bR\n" +
+	"isAlighted\x129\n" +
+	"\n" +
+	"boarded_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tboardedAt\x12;\n" +
+	"\valighted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"alightedAt\x12$\n" +
+	"\x0eno_show_reason\x18\b \x01(\tR\fnoShowReason\" \n" +
+	"\x0eGetTripRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xcb\x01\n" +
+	"\x10ListTripsRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\tR\n" +
+	"scheduleId\x12\x1b\n" +
+	"\tdriver_id\x18\x04 \x01(\tR\bdriverId\x12!\n" +
+	"\fattendant_id\x18\x05 \x01(\tR\vattendantId\x12*\n" +
+	"\x04page\x18\x06 \x01(\v2\x16.eodini.v1.PageRequestR\x04page\"c\n" +
+	"\x11ListTripsResponse\x12%\n" +
+	"\x05trips\x18\x01 \x03(\v2\x0f.eodini.v1.TripR\x05trips\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.eodini.v1.PageInfoR\x04page2\x8c\x01\n" +
+	"\vTripService\x125\n" +
+	"\aGetTrip\x12\x19.eodini.v1.GetTripRequest\x1a\x0f.eodini.v1.Trip\x12F\n" +
+	"\tListTrips\x12\x1b.eodini.v1.ListTripsRequest\x1a\x1c.eodini.v1.ListTripsResponseB3Z1github.com/hyeokjun/eodini/api/eodini/v1;eodiniv1b\x06proto3"
+
+var (
+	file_eodini_v1_trip_proto_rawDescOnce sync.Once
+	file_eodini_v1_trip_proto_rawDescData []byte
+)
+
+func file_eodini_v1_trip_proto_rawDescGZIP() []byte {
+	file_eodini_v1_trip_proto_rawDescOnce.Do(func() {
+		file_eodini_v1_trip_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_eodini_v1_trip_proto_rawDesc), len(file_eodini_v1_trip_proto_rawDesc)))
+	})
+	return file_eodini_v1_trip_proto_rawDescData
+}
+
+var file_eodini_v1_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_eodini_v1_trip_proto_goTypes = []any{
+	(*Trip)(nil),                  // 0: eodini.v1.Trip
+	(*TripPassenger)(nil),         // 1: eodini.v1.TripPassenger
+	(*GetTripRequest)(nil),        // 2: eodini.v1.GetTripRequest
+	(*ListTripsRequest)(nil),      // 3: eodini.v1.ListTripsRequest
+	(*ListTripsResponse)(nil),     // 4: eodini.v1.ListTripsResponse
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*PageRequest)(nil),           // 6: eodini.v1.PageRequest
+	(*PageInfo)(nil),              // 7: eodini.v1.PageInfo
+}
+var file_eodini_v1_trip_proto_depIdxs = []int32{
+	5,  // 0: eodini.v1.Trip.started_at:type_name -> google.protobuf.Timestamp
+	5,  // 1: eodini.v1.Trip.completed_at:type_name -> google.protobuf.Timestamp
+	5,  // 2: eodini.v1.Trip.cancelled_at:type_name -> google.protobuf.Timestamp
+	5,  // 3: eodini.v1.Trip.delayed_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: eodini.v1.Trip.passengers:type_name -> eodini.v1.TripPassenger
+	5,  // 5: eodini.v1.Trip.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: eodini.v1.Trip.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 7: eodini.v1.TripPassenger.boarded_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: eodini.v1.TripPassenger.alighted_at:type_name -> google.protobuf.Timestamp
+	6,  // 9: eodini.v1.ListTripsRequest.page:type_name -> eodini.v1.PageRequest
+	0,  // 10: eodini.v1.ListTripsResponse.trips:type_name -> eodini.v1.Trip
+	7,  // 11: eodini.v1.ListTripsResponse.page:type_name -> eodini.v1.PageInfo
+	2,  // 12: eodini.v1.TripService.GetTrip:input_type -> eodini.v1.GetTripRequest
+	3,  // 13: eodini.v1.TripService.ListTrips:input_type -> eodini.v1.ListTripsRequest
+	0,  // 14: eodini.v1.TripService.GetTrip:output_type -> eodini.v1.Trip
+	4,  // 15: eodini.v1.TripService.ListTrips:output_type -> eodini.v1.ListTripsResponse
+	14, // [14:16] is the sub-list for method output_type
+	12, // [12:14] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_eodini_v1_trip_proto_init() }
+func file_eodini_v1_trip_proto_init() {
+	if File_eodini_v1_trip_proto != nil {
+		return
+	}
+	file_eodini_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eodini_v1_trip_proto_rawDesc), len(file_eodini_v1_trip_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_eodini_v1_trip_proto_goTypes,
+		DependencyIndexes: file_eodini_v1_trip_proto_depIdxs,
+		MessageInfos:      file_eodini_v1_trip_proto_msgTypes,
+	}.Build()
+	File_eodini_v1_trip_proto = out.File
+	file_eodini_v1_trip_proto_goTypes = nil
+	file_eodini_v1_trip_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: eodini/v1/trip.proto
+
+// 📝 설명: 운행 조회 API (REST GET /trips, /trips/{id}와 같은 권한: 운영 인력 또는 trips:read API 키)
+
+package eodiniv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TripService_GetTrip_FullMethodName   = "/eodini.v1.TripService/GetTrip"
+	TripService_ListTrips_FullMethodName = "/eodini.v1.TripService/ListTrips"
+)
+
+// TripServiceClient is the client API for TripService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TripServiceClient interface {
+	// GetTrip - 운행 단건 조회 (탑승자 기록 포함)
+	GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*Trip, error)
+	// ListTrips - 운행 목록 조회
+	ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*ListTripsResponse, error)
+}
+
+type tripServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTripServiceClient(cc grpc.ClientConnInterface) TripServiceClient {
+	return &tripServiceClient{cc}
+}
+
+func (c *tripServiceClient) GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*Trip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, TripService_GetTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*ListTripsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTripsResponse)
+	err := c.cc.Invoke(ctx, TripService_ListTrips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TripServiceServer is the server API for TripService service.
+// All implementations must embed UnimplementedTripServiceServer
+// for forward compatibility.
+type TripServiceServer interface {
+	// GetTrip - 운행 단건 조회 (탑승자 기록 포함)
+	GetTrip(context.Context, *GetTripRequest) (*Trip, error)
+	// ListTrips - 운행 목록 조회
+	ListTrips(context.Context, *ListTripsRequest) (*ListTripsResponse, error)
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+// UnimplementedTripServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTripServiceServer struct{}
+
+func (UnimplementedTripServiceServer) GetTrip(context.Context, *GetTripRequest) (*Trip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrip not implemented")
+}
+func (UnimplementedTripServiceServer) ListTrips(context.Context, *ListTripsRequest) (*ListTripsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrips not implemented")
+}
+func (UnimplementedTripServiceServer) mustEmbedUnimplementedTripServiceServer() {}
+func (UnimplementedTripServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTripServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TripServiceServer will
+// result in compilation errors.
+type UnsafeTripServiceServer interface {
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+func RegisterTripServiceServer(s grpc.ServiceRegistrar, srv TripServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTripServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TripService_ServiceDesc, srv)
+}
+
+func _TripService_GetTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetTrip(ctx, req.(*GetTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ListTrips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListTrips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_ListTrips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListTrips(ctx, req.(*ListTripsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TripService_ServiceDesc is the grpc.ServiceDesc for TripService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TripService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eodini.v1.TripService",
+	HandlerType: (*TripServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTrip",
+			Handler:    _TripService_GetTrip_Handler,
+		},
+		{
+			MethodName: "ListTrips",
+			Handler:    _TripService_ListTrips_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eodini/v1/trip.proto",
+}
@@ -0,0 +1,722 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/hyeokjun/eodini/config"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/grpcserver"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/job"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/pkg/alimtalk"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/email"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/holiday"
+	"github.com/hyeokjun/eodini/pkg/idempotency"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/maps"
+	"github.com/hyeokjun/eodini/pkg/push"
+	"github.com/hyeokjun/eodini/pkg/ratelimit"
+	"github.com/hyeokjun/eodini/pkg/sms"
+	"github.com/hyeokjun/eodini/pkg/webhook"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 의존성 조립 (Repository → Service → Handler)
+// 🎯 실무 포인트: Spring의 DI 컨테이너 역할을 수동으로 구성
+// ⚠️ 주의사항: 새 핸들러 추가 시 여기서 생성 후 Handlers에 등록
+// gRPC 서버는 REST 핸들러와 같은 Service 인스턴스를 사용
+
+// buildHandlers - 핸들러와 gRPC 서비스 의존성 조립 (hub: WebSocket 구독자, broker: 위치/정류장 이벤트를 인스턴스 간 전달)
+func buildHandlers(cfg *config.Config, db *gorm.DB, rdb *redis.Client, hub *realtime.Hub, broker *realtime.RedisBroker) (*handler.Handlers, *grpcserver.Services) {
+	// Repository
+	vehicleRepo := repository.NewVehicleRepository(db)
+	driverRepo := repository.NewDriverRepository(db)
+	routeRepo := repository.NewRouteRepository(db)
+	scheduleRepo := repository.NewScheduleRepository(db)
+	passengerRepo := repository.NewPassengerRepository(db)
+	attendantRepo := repository.NewAttendantRepository(db)
+	guardianRepo := repository.NewGuardianRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	tripRepo := repository.NewTripRepository(db)
+	userRepo := repository.NewUserRepository(db)
+	resetRepo := repository.NewPasswordResetTokenRepository(db)
+	sessionRepo := repository.NewSessionRepository(rdb)
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	usageRepo := repository.NewUsageRepository(rdb)
+	tripLocationRepo := repository.NewTripLocationRepository(db)
+	tripETARepo := repository.NewTripETARepository(rdb)
+	tripAlertRepo := repository.NewTripAlertRepository(db)
+	tripStopEventRepo := repository.NewTripStopEventRepository(db)
+	tripLastSeenRepo := repository.NewTripLastSeenRepository(rdb)
+	notificationDedupRepo := repository.NewNotificationDedupRepository(rdb)
+	deviceTokenRepo := repository.NewDeviceTokenRepository(db)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	notificationLogRepo := repository.NewNotificationLogRepository(db)
+	holidayRepo := repository.NewHolidayRepository(db)
+	scheduleExceptionRepo := repository.NewScheduleExceptionRepository(db)
+	fuelLogRepo := repository.NewFuelLogRepository(db)
+	odometerReadingRepo := repository.NewOdometerReadingRepository(db)
+	vehicleDocumentRepo := repository.NewVehicleDocumentRepository(db)
+	fileRepo := repository.NewFileRepository(db)
+	driverAssignmentRepo := repository.NewDriverAssignmentRepository(db)
+	attendantAssignmentRepo := repository.NewAttendantAssignmentRepository(db)
+	passengerAbsenceRepo := repository.NewPassengerAbsenceRepository(db)
+	calendarTokenRepo := repository.NewCalendarTokenRepository(db)
+	webhookSubscriptionRepo := repository.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	organizationRepo := repository.NewOrganizationRepository(db)
+	invitationRepo := repository.NewInvitationRepository(db)
+
+	tokens := auth.NewTokenManager(cfg.Auth.JWTSecret, cfg.Auth.AccessTokenTTL)
+
+	// Service
+	vehicleService := service.NewVehicleService(vehicleRepo)
+	driverService := service.NewDriverService(driverRepo)
+	routeMaps, geocoder := mapsClients(cfg.Maps, rdb)
+	routeService := service.NewRouteService(routeRepo, database.NewTxManager(db), routeMaps, geocoder)
+	scheduleService := service.NewScheduleService(scheduleRepo, routeRepo, vehicleRepo, driverRepo, passengerRepo)
+	scheduleExceptionService := service.NewScheduleExceptionService(scheduleRepo, scheduleExceptionRepo)
+	driverAssignmentService := service.NewDriverAssignmentService(driverAssignmentRepo, scheduleRepo, driverRepo)
+	attendantAssignmentService := service.NewAttendantAssignmentService(attendantAssignmentRepo, scheduleRepo, attendantRepo)
+	passengerService := service.NewPassengerService(passengerRepo, routeRepo, scheduleRepo, vehicleRepo)
+	attendantService := service.NewAttendantService(attendantRepo)
+	// CSV/Excel 일괄 등록 (검증을 통과한 행만 한 트랜잭션으로 저장)
+	importService := service.NewImportService(passengerRepo, routeRepo, scheduleRepo, vehicleRepo, routeService, database.NewTxManager(db))
+	guardianService := service.NewGuardianService(guardianRepo, passengerRepo, routeRepo)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+	organizationService := service.NewOrganizationService(organizationRepo)
+	// 주행 거리: 위치 수신마다 누적, 운행 완료 시 전체 경로로 확정
+	distanceService := service.NewDistanceService(tripRepo, tripLocationRepo)
+	// 웹훅 구독 관리 (이벤트 전달은 buildJobs의 outbox-relay 작업, 재시도는 webhook-retry 작업)
+	webhookService := service.NewWebhookService(webhookSubscriptionRepo, webhookDeliveryRepo, webhook.NewClient(cfg.Webhook.Timeout), webhookRetryPolicy(cfg.Webhook))
+	// 도메인 이벤트: 운행/탑승 변경과 같은 트랜잭션으로 아웃박스에 기록만 (핸들러 전달은 buildJobs의 outbox-relay 작업)
+	events := service.NewOutboxService(outboxRepo, nil, service.NotificationRetryPolicy{})
+	// 주행거리계: 운행 완료 시 기사 확인 값 또는 확정된 주행 거리로 갱신 (distanceService 다음에 실행)
+	odometerService := service.NewOdometerService(vehicleRepo, odometerReadingRepo, database.NewTxManager(db), organizationService)
+	// 공휴일: 동기화한 공휴일 → 기본 공휴일 표 순으로 판단 (동기화는 buildJobs의 holiday-sync 작업)
+	holidayService := service.NewHolidayService(holidayRepo, nil, organizationService)
+	tripGenerationService := service.NewTripGenerationService(scheduleRepo, scheduleExceptionRepo, driverAssignmentRepo, attendantAssignmentRepo, vehicleRepo, driverRepo, tripRepo, passengerRepo, holidayService, organizationService)
+	// 운행 생성: 운행 생성 계획(tripGenerationService)과 같은 기준으로 그날 운행하는 일정인지와 대체 배정 인력을 판정
+	tripService := service.NewTripService(tripRepo, scheduleRepo, passengerRepo, passengerAbsenceRepo, attendantRepo, database.NewTxManager(db), events, tripGenerationService, distanceService, odometerService)
+	smsClient := smsSender(cfg.SMS)
+	emailService := service.NewEmailService(emailSender(cfg.Email), cfg.Email.AdminRecipients, cfg.Auth.PasswordResetTTL)
+	authService := service.NewAuthService(userRepo, resetRepo, sessionRepo, tokens, passwordResetNotifier(emailService, smsClient), cfg.Auth.PasswordResetTTL)
+	userService := service.NewUserService(userRepo, sessionRepo)
+	invitationService := service.NewInvitationService(invitationRepo, userRepo, organizationRepo, database.NewTxManager(db),
+		invitationChannels(emailService, smsClient), cfg.Auth.InvitationTTL)
+	auditLogService := service.NewAuditLogService(auditLogRepo)
+	deviceService := service.NewDeviceService(deviceTokenRepo, pushSender(cfg.Push))
+	// 보호자 알림: 푸시 → 알림톡 → 문자 순 대체 발송, 보호자별 수신 설정 반영, 발송 결과 기록 (재시도는 buildJobs의 notification-retry 작업)
+	alimtalkClient, alimtalkTemplates := alimtalkSender(cfg.Alimtalk)
+	notificationService := service.NewNotificationService(notificationPreferenceRepo, notificationLogRepo, guardianRepo, userRepo, deviceService,
+		alimtalkClient, alimtalkTemplates, smsClient, notificationRetryPolicy(cfg.Delivery))
+	// 불참/지연/위험 운전 알림 수신자: 운행 기관의 관리자 (기관 연락처가 없을 때만 SMS_ADMIN_NUMBERS)
+	operatorContacts := service.NewOperatorContacts(userRepo, organizationRepo, cfg.SMS.AdminNumbers)
+	// 정류장 일괄 승차/하차는 한 트랜잭션으로 저장, 승차/하차/불참 알림은 이벤트로 기록 (발송은 buildJobs의 outbox-relay 작업)
+	boardingService := service.NewBoardingService(tripService, tripRepo, scheduleRepo, routeRepo, passengerRepo, guardianRepo, attendantRepo,
+		database.NewTxManager(db), notificationService, events, operatorContacts, organizationService)
+	// 출결 기록 Excel 내보내기 (관할 기관 제출용)
+	reportService := service.NewReportService(tripRepo, scheduleRepo, routeRepo, vehicleRepo, driverRepo, passengerRepo, tripStopEventRepo, organizationService)
+	manifestService := service.NewManifestService(tripService, scheduleRepo, routeRepo, passengerRepo, guardianRepo)
+	passengerAbsenceService := service.NewPassengerAbsenceService(passengerAbsenceRepo, passengerRepo, guardianRepo, tripRepo, scheduleRepo, organizationService)
+	attendanceService := service.NewAttendanceService(passengerRepo, guardianRepo, tripRepo, scheduleRepo, organizationService)
+	// 기사/동승자 운행 일정 캘린더 구독 (생성된 운행 + 운행 생성 미리보기로 대체 배정까지 반영)
+	calendarService := service.NewCalendarService(calendarTokenRepo, userRepo, tripRepo, scheduleRepo, routeRepo, vehicleRepo, tripGenerationService, organizationService)
+	// 지연 보고는 이벤트로 기록, 경로 탑승자의 보호자와 운행 기관의 관리자 알림은 buildJobs의 outbox-relay 작업 (자동 감지는 delay-watch 작업)
+	delayService := service.NewDelayService(tripService, tripRepo, scheduleRepo, passengerRepo, guardianRepo, database.NewTxManager(db),
+		notificationService, events, operatorContacts, cfg.Delay.Threshold, organizationService)
+	// 정류장 접근 시 배정 탑승자의 보호자에게 "약 N분 후 도착" 알림 (운행 + 정류장 단위 한 번)
+	approachService := service.NewApproachService(tripRepo, scheduleRepo, passengerRepo, guardianRepo, notificationDedupRepo,
+		notificationService, float64(cfg.ETA.AverageSpeed))
+	// 운행 단위 배정 변경 (교체 대상 투입 가능 여부·이중 배정 확인, 경로 탑승자의 보호자 알림은 이벤트로 기록 → buildJobs의 outbox-relay 작업)
+	tripAssignmentService := service.NewTripAssignmentService(tripService, tripRepo, scheduleRepo, routeRepo, vehicleRepo, driverRepo,
+		attendantRepo, passengerRepo, guardianRepo, database.NewTxManager(db), notificationService, events)
+	// 정류장 도착/출발 기록 (지오펜스 판정 자동 기록 + 기사/동승자 수동 기록), 정시성 보고서
+	stopEventService := service.NewStopEventService(tripService, scheduleRepo, routeRepo, tripStopEventRepo, organizationService)
+	// 정류장 접근/도착/출발 판정 (GEOFENCE_ENABLED=false면 판정 안 함, 이벤트는 WebSocket 구독자, 접근 알림, 불참 판정, 도착/출발 기록에 전달)
+	var stopGeofence *geofence.Engine
+	if cfg.Geofence.Enabled {
+		stopGeofence = geofence.NewEngine(geofence.Config{
+			ApproachRadius:  float64(cfg.Geofence.ApproachRadius),
+			ArrivalRadius:   float64(cfg.Geofence.ArrivalRadius),
+			DepartureRadius: float64(cfg.Geofence.DepartureRadius),
+		}, geofence.NewRedisStore(rdb), broker, approachService, boardingService, stopEventService)
+	}
+	// 위험 운전 경보 (ALERT_ENABLED=false면 판정 안 함, 조회는 제공)
+	alertService := service.NewAlertService(tripAlertRepo, tripService, alertRules(cfg.Alert), alertNotifier(cfg.Alert, smsClient, operatorContacts, organizationService))
+	locationObservers := []service.LocationObserver{distanceService}
+	if cfg.Alert.Enabled {
+		locationObservers = append(locationObservers, alertService)
+	}
+	// 위치 수신 끊김 감지용 마지막 수신 시각 (감지는 buildJobs의 signal-watch 작업)
+	if cfg.Signal.Enabled {
+		locationObservers = append(locationObservers, service.NewSignalService(tripRepo, tripLastSeenRepo, nil, cfg.Signal.Timeout))
+	}
+	trackingService := service.NewTrackingService(tripService, scheduleRepo, routeRepo, guardianRepo, passengerRepo, tripLocationRepo, broker, stopGeofence, locationObservers...)
+	etaService := service.NewETAService(trackingService, tripETARepo, service.StraightLineEstimator{
+		Speed:     float64(cfg.ETA.AverageSpeed),
+		StopDwell: cfg.ETA.StopDwell,
+	}, cfg.ETA.CacheTTL)
+	quotaService := service.NewQuotaService(usageRepo, service.QuotaLimits{
+		User:   int64(cfg.Quota.UserDaily),
+		APIKey: int64(cfg.Quota.APIKeyDaily),
+	})
+
+	// 요청 로그 마스킹 (기본 필드 + LOG_REDACT_FIELDS)
+	redactFields := append(append([]string{}, logger.DefaultRedactFields...), cfg.Log.RedactFields...)
+
+	// 요청 빈도 제한(클라이언트 IP 기준), 인증 주체별 일일 한도, POST 재시도 중복 처리 방지
+	// 사용 여부와 한도는 runtimeSettings (SIGHUP 재로드로 재시작 없이 변경)
+	limiter := ratelimit.NewRedisLimiter(rdb)
+	idempotencyStore := idempotency.NewRedisStore(rdb)
+
+	// gRPC (운행/일정 조회, 차량 위치 전송)
+	grpcServices := &grpcserver.Services{
+		Tokens:     tokens,
+		APIKeyAuth: apiKeyService,
+		Sessions:   authService,
+		Trip:       tripService,
+		Schedule:   scheduleService,
+		Tracking:   trackingService,
+	}
+
+	// 파일 업로드/차량 서류/면허증 스캔: 파일 저장소가 설정된 경우에만 (STORAGE_PROVIDER가 비어 있으면 API 없음)
+	var fileHandler *handler.FileHandler
+	var vehicleDocumentHandler *handler.VehicleDocumentHandler
+	var driverLicenseHandler *handler.DriverLicenseHandler
+	var passengerPhotoHandler *handler.PassengerPhotoHandler
+	if store := fileStorage(cfg.Storage); store != nil {
+		fileService := service.NewFileService(fileRepo, store)
+		fileHandler = handler.NewFileHandler(fileService)
+		vehicleDocumentHandler = handler.NewVehicleDocumentHandler(service.NewVehicleDocumentService(vehicleRepo, vehicleDocumentRepo, fileService, organizationService))
+		driverLicenseHandler = handler.NewDriverLicenseHandler(service.NewDriverLicenseService(driverRepo, fileService))
+		passengerPhotoHandler = handler.NewPassengerPhotoHandler(service.NewPassengerPhotoService(passengerRepo, guardianRepo, fileService))
+	}
+
+	// Handler
+	return &handler.Handlers{
+		Tokens:              tokens,
+		LogRedact:           logger.NewRedactor(redactFields),
+		Limiter:             limiter,
+		Quotas:              quotaService,
+		Idempotency:         idempotencyStore,
+		IdempotencyTTL:      cfg.Idempotency.TTL,
+		ServiceName:         cfg.Tracing.ServiceName,
+		Settings:            handler.NewLiveSettings(runtimeSettings(cfg)),
+		APIKeyAuth:          apiKeyService,
+		Sessions:            authService,
+		Vehicle:             handler.NewVehicleHandler(vehicleService),
+		Driver:              handler.NewDriverHandler(driverService),
+		Route:               handler.NewRouteHandler(routeService),
+		Schedule:            handler.NewScheduleHandler(scheduleService),
+		Passenger:           handler.NewPassengerHandler(passengerService),
+		Attendant:           handler.NewAttendantHandler(attendantService),
+		Guardian:            handler.NewGuardianHandler(guardianService),
+		APIKey:              handler.NewAPIKeyHandler(apiKeyService),
+		Trip:                handler.NewTripHandler(tripService),
+		Auth:                handler.NewAuthHandler(authService),
+		User:                handler.NewUserHandler(userService),
+		AuditLog:            handler.NewAuditLogHandler(auditLogService),
+		Usage:               handler.NewUsageHandler(quotaService),
+		Tracking:            handler.NewTrackingHandler(trackingService, hub),
+		ETA:                 handler.NewETAHandler(etaService),
+		Alert:               handler.NewAlertHandler(alertService),
+		StopEvent:           handler.NewStopEventHandler(stopEventService),
+		Device:              handler.NewDeviceHandler(deviceService),
+		Notification:        handler.NewNotificationHandler(notificationService),
+		Boarding:            handler.NewBoardingHandler(boardingService),
+		Delay:               handler.NewDelayHandler(delayService),
+		Manifest:            handler.NewManifestHandler(manifestService),
+		TripGeneration:      handler.NewTripGenerationHandler(tripGenerationService),
+		Holiday:             handler.NewHolidayHandler(holidayService),
+		ScheduleException:   handler.NewScheduleExceptionHandler(scheduleExceptionService),
+		DriverAssignment:    handler.NewDriverAssignmentHandler(driverAssignmentService),
+		AttendantAssignment: handler.NewAttendantAssignmentHandler(attendantAssignmentService),
+		PassengerAbsence:    handler.NewPassengerAbsenceHandler(passengerAbsenceService),
+		TripAssignment:      handler.NewTripAssignmentHandler(tripAssignmentService),
+		Import:              handler.NewImportHandler(importService),
+		Report:              handler.NewReportHandler(reportService),
+		FuelLog:             handler.NewFuelLogHandler(service.NewFuelLogService(vehicleRepo, fuelLogRepo, organizationService)),
+		Odometer:            handler.NewOdometerHandler(odometerService),
+		VehicleDocument:     vehicleDocumentHandler,
+		File:                fileHandler,
+		DriverLicense:       driverLicenseHandler,
+		PassengerPhoto:      passengerPhotoHandler,
+		Attendance:          handler.NewAttendanceHandler(attendanceService),
+		Calendar:            handler.NewCalendarHandler(calendarService),
+		Webhook:             handler.NewWebhookHandler(webhookService),
+		Organization:        handler.NewOrganizationHandler(organizationService),
+		Invitation:          handler.NewInvitationHandler(invitationService),
+		Origins:             organizationService,
+	}, grpcServices
+}
+
+// notificationRetryPolicy - 알림 재시도 기준 (NOTIFICATION_RETRY_ENABLED=false면 첫 실패로 dead)
+func notificationRetryPolicy(cfg config.DeliveryConfig) service.NotificationRetryPolicy {
+	if !cfg.RetryEnabled {
+		return service.NotificationRetryPolicy{MaxAttempts: 1}
+	}
+	return service.NotificationRetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     cfg.RetryBackoff,
+		MaxBackoff:  cfg.MaxBackoff,
+	}
+}
+
+// webhookRetryPolicy - 설정의 웹훅 재시도 기준을 서비스 기준으로 변환
+func webhookRetryPolicy(cfg config.WebhookConfig) service.NotificationRetryPolicy {
+	return service.NotificationRetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     cfg.RetryBackoff,
+		MaxBackoff:  cfg.MaxBackoff,
+	}
+}
+
+// outboxRetryPolicy - 설정의 이벤트 릴레이 재시도 기준을 서비스 기준으로 변환
+func outboxRetryPolicy(cfg config.OutboxConfig) service.NotificationRetryPolicy {
+	return service.NotificationRetryPolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Backoff:     cfg.RetryBackoff,
+		MaxBackoff:  cfg.MaxBackoff,
+	}
+}
+
+// alertRules - 설정의 경보 기준을 서비스 기준으로 변환
+func alertRules(cfg config.AlertConfig) service.AlertRules {
+	zones := make([]service.SchoolZone, len(cfg.SchoolZones))
+	for i, zone := range cfg.SchoolZones {
+		zones[i] = service.SchoolZone{
+			Center: geo.Point{Latitude: zone.Latitude, Longitude: zone.Longitude},
+			Radius: zone.Radius,
+		}
+	}
+	return service.AlertRules{
+		SpeedLimit:           float64(cfg.SpeedLimit),
+		SchoolZoneSpeedLimit: float64(cfg.SchoolZoneSpeedLimit),
+		SchoolZones:          zones,
+		HarshAcceleration:    float64(cfg.HarshAcceleration),
+		HarshBraking:         float64(cfg.HarshBraking),
+		Cooldown:             cfg.Cooldown,
+	}
+}
+
+// alertNotifier - 경보 관리자 알림 (ALERT_NOTIFY_ADMIN=false면 기록만, 문자 설정이 있으면 운행 기관의 관리자에게 문자)
+func alertNotifier(cfg config.AlertConfig, smsClient sms.Sender, operators service.OperatorResolver, locations service.TimezoneResolver) service.AlertNotifier {
+	if !cfg.NotifyAdmin {
+		return nil
+	}
+	if smsClient != nil {
+		return service.SMSAlertNotifier{Sender: smsClient, Operators: operators, Locations: locations}
+	}
+	return service.LogAlertNotifier{}
+}
+
+// passwordResetNotifier - 비밀번호 재설정 토큰 전달 (이메일 → 문자 순, 둘 다 미설정이면 nil → 로그 출력)
+func passwordResetNotifier(emailService *service.EmailService, smsClient sms.Sender) service.PasswordResetNotifier {
+	if emailService.Enabled() {
+		return emailService
+	}
+	if smsClient == nil {
+		return nil
+	}
+	return service.SMSPasswordResetNotifier{Sender: smsClient}
+}
+
+// invitationChannels - 초대 발송 채널 (이메일 초대는 이메일, 연락처 초대는 문자, 미설정 채널은 로그 출력)
+func invitationChannels(emailService *service.EmailService, smsClient sms.Sender) service.InvitationChannels {
+	var channels service.InvitationChannels
+	if emailService.Enabled() {
+		channels.Email = emailService
+	}
+	if smsClient != nil {
+		channels.SMS = service.SMSInvitationNotifier{Sender: smsClient}
+	}
+	return channels
+}
+
+// emailSender - 설정된 이메일 발송 클라이언트 (EMAIL_PROVIDER가 비어 있으면 nil)
+func emailSender(cfg config.EmailConfig) email.Sender {
+	switch cfg.Provider {
+	case "smtp":
+		return email.NewSMTPClient(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From, cfg.Timeout)
+	case "ses":
+		return email.NewSESClient(cfg.SESRegion, cfg.SESAccessKeyID, cfg.SESSecretAccessKey, cfg.From, cfg.Timeout)
+	default:
+		return nil
+	}
+}
+
+// fileStorage - 설정된 파일 저장소 (STORAGE_PROVIDER가 비어 있으면 nil)
+func fileStorage(cfg config.StorageConfig) storage.Storage {
+	switch cfg.Provider {
+	case "local":
+		return storage.NewLocalStorage(cfg.LocalDir)
+	case "s3":
+		return storage.NewS3Storage(cfg.S3Region, cfg.S3Bucket, cfg.S3Endpoint, cfg.S3AccessKeyID, cfg.S3SecretAccessKey, cfg.Timeout)
+	default:
+		return nil
+	}
+}
+
+// runtimeSettings - 재시작 없이 바꿀 수 있는 요청 처리 설정 (시작 시, SIGHUP 재로드 시 사용)
+func runtimeSettings(cfg *config.Config) handler.Settings {
+	return handler.Settings{
+		CORSOrigins:      cfg.CORS.AllowedOrigins,
+		RateLimitEnabled: cfg.RateLimit.Enabled,
+		RateLimits: handler.RateLimits{
+			Auth: middleware.RateLimitRule{Name: "auth", Limit: cfg.RateLimit.AuthLimit, Window: cfg.RateLimit.Window},
+			API:  middleware.RateLimitRule{Name: "api", Limit: cfg.RateLimit.APILimit, Window: cfg.RateLimit.Window},
+		},
+		QuotaEnabled:       cfg.Quota.Enabled,
+		IdempotencyEnabled: cfg.Idempotency.Enabled,
+	}
+}
+
+// smsSender - 설정된 문자 발송 클라이언트 (SMS_PROVIDER가 비어 있으면 nil)
+func smsSender(cfg config.SMSConfig) sms.Sender {
+	switch cfg.Provider {
+	case "aligo":
+		return sms.NewAligoClient(cfg.APIKey, cfg.AccountID, cfg.SenderNumber, cfg.Timeout)
+	case "nhn":
+		return sms.NewNHNClient(cfg.AccountID, cfg.APIKey, cfg.SenderNumber, cfg.Timeout)
+	default:
+		return nil
+	}
+}
+
+// alimtalkSender - 설정된 알림톡 발송 클라이언트와 템플릿 (ALIMTALK_PROVIDER가 비어 있거나 템플릿 파일 오류면 nil)
+func alimtalkSender(cfg config.AlimtalkConfig) (alimtalk.Sender, map[string]alimtalk.Template) {
+	if cfg.Provider == "" {
+		return nil, nil
+	}
+	raw, err := os.ReadFile(cfg.TemplatesFile)
+	if err != nil {
+		logger.Warn("Alimtalk disabled: cannot read templates file", map[string]interface{}{"error": err.Error()})
+		return nil, nil
+	}
+	templates, err := alimtalk.ParseTemplates(raw)
+	if err != nil {
+		logger.Warn("Alimtalk disabled: invalid templates file", map[string]interface{}{"error": err.Error()})
+		return nil, nil
+	}
+
+	switch cfg.Provider {
+	case "aligo":
+		return alimtalk.NewAligoClient(cfg.APIKey, cfg.AccountID, cfg.SenderKey, cfg.SenderNumber, cfg.Timeout), templates
+	case "nhn":
+		return alimtalk.NewNHNClient(cfg.AccountID, cfg.APIKey, cfg.SenderKey, cfg.Timeout), templates
+	default:
+		return nil, nil
+	}
+}
+
+// mapsClients - 설정된 지도 API 길찾기/주소 검색 클라이언트 (Redis 캐시 포함, MAPS_PROVIDER가 비어 있으면 nil)
+func mapsClients(cfg config.MapsConfig, rdb *redis.Client) (maps.Client, maps.Geocoder) {
+	var provider interface {
+		maps.Client
+		maps.Geocoder
+	}
+	switch cfg.Provider {
+	case "kakao":
+		provider = maps.NewKakaoClient(cfg.APIKey, cfg.Timeout)
+	case "naver":
+		provider = maps.NewNaverClient(cfg.ClientID, cfg.APIKey, cfg.Timeout)
+	case "google":
+		provider = maps.NewGoogleClient(cfg.APIKey, cfg.Timeout)
+	default:
+		return nil, nil
+	}
+	cache := maps.NewRedisCache(rdb, "maps:"+cfg.Provider+":")
+	return maps.NewCachedClient(provider, cache, cfg.CacheTTL), maps.NewCachedGeocoder(provider, cache, cfg.CacheTTL)
+}
+
+// pushSender - FCM 발송 클라이언트 (FCM_CREDENTIALS_FILE이 비어 있거나 읽을 수 없으면 nil → 발송 안 함)
+func pushSender(cfg config.PushConfig) push.Sender {
+	if cfg.FCMCredentialsFile == "" {
+		return nil
+	}
+	credentials, err := os.ReadFile(cfg.FCMCredentialsFile)
+	if err != nil {
+		logger.Warn("Push disabled: cannot read FCM credentials", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+	client, err := push.NewFCMClient(credentials, cfg.Timeout)
+	if err != nil {
+		logger.Warn("Push disabled: invalid FCM credentials", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+	return client
+}
+
+// buildJobs - 백그라운드 작업 조립 (설정으로 꺼진 작업은 제외)
+func buildJobs(cfg *config.Config, db *gorm.DB, rdb *redis.Client) []job.Job {
+	var jobs []job.Job
+
+	if cfg.Retention.Enabled {
+		retentionService := service.NewRetentionService(
+			repository.NewPassengerRepository(db),
+			repository.NewDriverRepository(db),
+			repository.NewAuditLogRepository(db),
+			database.NewTxManager(db),
+			cfg.Retention.Period,
+		)
+		jobs = append(jobs, job.Job{
+			Name:     "retention",
+			Interval: cfg.Retention.Interval,
+			Run: func(ctx context.Context) error {
+				result, err := retentionService.Run(ctx, time.Now())
+				if err != nil {
+					return err
+				}
+				if result.Passengers > 0 || result.Drivers > 0 {
+					logger.Info("Personal data anonymized", map[string]interface{}{
+						"passengers": result.Passengers,
+						"drivers":    result.Drivers,
+					})
+				}
+				return nil
+			},
+		})
+	}
+
+	// 여러 인스턴스에서 실행해도 끊김 표시/알림은 한 번 (DB 조건부 갱신)
+	if cfg.Signal.Enabled {
+		signalService := service.NewSignalService(
+			repository.NewTripRepository(db),
+			repository.NewTripLastSeenRepository(rdb),
+			nil,
+			cfg.Signal.Timeout,
+		)
+		jobs = append(jobs, job.Job{
+			Name:     "signal-watch",
+			Interval: cfg.Signal.CheckInterval,
+			Run: func(ctx context.Context) error {
+				_, err := signalService.Detect(ctx, time.Now())
+				return err
+			},
+		})
+	}
+
+	// 여러 인스턴스에서 실행해도 지연 표시/알림은 한 번 (DB 조건부 갱신)
+	if cfg.Delay.Enabled {
+		delayService := jobDelayService(cfg, db)
+		jobs = append(jobs, job.Job{
+			Name:     "delay-watch",
+			Interval: cfg.Delay.CheckInterval,
+			Run: func(ctx context.Context) error {
+				_, err := delayService.Detect(ctx, time.Now())
+				return err
+			},
+		})
+	}
+
+	// 면허/보험/정기검사 만료 안내 (구간마다 발송 기록을 먼저 선점 → 여러 인스턴스에서 실행해도 한 번)
+	if cfg.Expiry.Enabled {
+		expiryService := service.NewExpiryReminderService(
+			repository.NewDriverRepository(db),
+			repository.NewVehicleRepository(db),
+			repository.NewScheduleRepository(db),
+			repository.NewUserRepository(db),
+			repository.NewExpiryReminderRepository(db),
+			service.NewEmailService(emailSender(cfg.Email), cfg.Email.AdminRecipients, cfg.Auth.PasswordResetTTL),
+			jobNotificationService(cfg, db),
+			jobOperatorContacts(cfg, db),
+			cfg.Expiry.Days,
+			service.NewOrganizationService(repository.NewOrganizationRepository(db)),
+		)
+		jobs = append(jobs, job.Job{
+			Name:     "expiry-reminder",
+			Interval: cfg.Expiry.Interval,
+			Run: func(ctx context.Context) error {
+				result, err := expiryService.Run(ctx, time.Now())
+				if err != nil {
+					return err
+				}
+				if result.Total() > 0 {
+					logger.Info("Expiry reminders sent", map[string]interface{}{
+						"licenses":    result.Licenses,
+						"insurances":  result.Insurances,
+						"inspections": result.Inspections,
+					})
+				}
+				return nil
+			},
+		})
+	}
+
+	// 올해와 내년 공휴일 동기화 (날짜 기준 upsert라 여러 인스턴스에서 실행해도 안전, 내년분은 발표 후 반영)
+	if cfg.Holiday.APIKey != "" {
+		holidayService := service.NewHolidayService(
+			repository.NewHolidayRepository(db),
+			holiday.NewDataGoKrClient(cfg.Holiday.APIKey, cfg.Holiday.Timeout),
+			nil,
+		)
+		jobs = append(jobs, job.Job{
+			Name:     "holiday-sync",
+			Interval: cfg.Holiday.SyncInterval,
+			Run: func(ctx context.Context) error {
+				year := time.Now().Year()
+				for _, y := range []int{year, year + 1} {
+					if _, err := holidayService.Sync(ctx, y); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		})
+	}
+
+	// 여러 인스턴스에서 실행해도 같은 알림은 한 인스턴스만 재발송 (행 잠금 후 lease)
+	if cfg.Delivery.RetryEnabled {
+		notificationService := jobNotificationService(cfg, db)
+		jobs = append(jobs, job.Job{
+			Name:     "notification-retry",
+			Interval: cfg.Delivery.RetryInterval,
+			Run: func(ctx context.Context) error {
+				retried, err := notificationService.RetryDue(ctx, time.Now())
+				if retried > 0 {
+					logger.Info("Notifications retried", map[string]interface{}{"count": retried})
+				}
+				return err
+			},
+		})
+	}
+
+	// 웹훅 재시도 (중단된 첫 전달 포함, 행 잠금 후 lease로 한 인스턴스만 전달)
+	webhookService := service.NewWebhookService(
+		repository.NewWebhookSubscriptionRepository(db),
+		repository.NewWebhookDeliveryRepository(db),
+		webhook.NewClient(cfg.Webhook.Timeout),
+		webhookRetryPolicy(cfg.Webhook),
+	)
+	jobs = append(jobs, job.Job{
+		Name:     "webhook-retry",
+		Interval: cfg.Webhook.RetryInterval,
+		Run: func(ctx context.Context) error {
+			retried, err := webhookService.RetryDue(ctx, time.Now())
+			if retried > 0 {
+				logger.Info("Webhook deliveries retried", map[string]interface{}{"count": retried})
+			}
+			return err
+		},
+	})
+
+	// 도메인 이벤트 릴레이 (커밋된 이벤트를 웹훅 전달, 승차/하차/불참·지연·배정 변경 알림 핸들러에 전달, 행 잠금 후 lease로 한 인스턴스만 처리)
+	// 핸들러 이름은 재시도 시 처리 완료 여부 기준이므로 바꾸지 않음
+	dispatcher := event.NewDispatcher()
+	dispatcher.Subscribe("webhook", webhookService.HandleEvent)
+	dispatcher.Subscribe("boarding-notification", jobBoardingService(cfg, db).HandleEvent,
+		string(domain.WebhookEventPassengerBoarded), string(domain.WebhookEventPassengerAlighted), string(domain.WebhookEventPassengerNoShow))
+	dispatcher.Subscribe("delay-notification", jobDelayService(cfg, db).HandleEvent, string(domain.WebhookEventTripDelayed))
+	dispatcher.Subscribe("assignment-notification", jobTripAssignmentService(cfg, db).HandleEvent, string(domain.WebhookEventTripReassigned))
+	outboxService := service.NewOutboxService(repository.NewOutboxRepository(db), dispatcher, outboxRetryPolicy(cfg.Outbox))
+	jobs = append(jobs, job.Job{
+		Name:     "outbox-relay",
+		Interval: cfg.Outbox.RelayInterval,
+		Run: func(ctx context.Context) error {
+			_, err := outboxService.Relay(ctx, time.Now())
+			return err
+		},
+	})
+
+	return jobs
+}
+
+// jobBoardingService - 이벤트 릴레이용 승차/하차 서비스 (알림 발송만 사용, API 서버와 같은 운영 알림 수신자)
+func jobBoardingService(cfg *config.Config, db *gorm.DB) *service.BoardingService {
+	tripRepo := repository.NewTripRepository(db)
+	scheduleRepo := repository.NewScheduleRepository(db)
+	passengerRepo := repository.NewPassengerRepository(db)
+	attendantRepo := repository.NewAttendantRepository(db)
+	return service.NewBoardingService(
+		service.NewTripService(tripRepo, scheduleRepo, passengerRepo, repository.NewPassengerAbsenceRepository(db), attendantRepo, nil, nil, nil),
+		tripRepo,
+		scheduleRepo,
+		repository.NewRouteRepository(db),
+		passengerRepo,
+		repository.NewGuardianRepository(db),
+		attendantRepo,
+		database.NewTxManager(db),
+		jobNotificationService(cfg, db),
+		nil,
+		jobOperatorContacts(cfg, db),
+		service.NewOrganizationService(repository.NewOrganizationRepository(db)),
+	)
+}
+
+// jobDelayService - 지연 감지 작업과 이벤트 릴레이용 지연 서비스 (감지한 지연은 이벤트로 기록, 알림 발송은 HandleEvent)
+func jobDelayService(cfg *config.Config, db *gorm.DB) *service.DelayService {
+	tripRepo := repository.NewTripRepository(db)
+	scheduleRepo := repository.NewScheduleRepository(db)
+	passengerRepo := repository.NewPassengerRepository(db)
+	return service.NewDelayService(
+		service.NewTripService(tripRepo, scheduleRepo, passengerRepo, repository.NewPassengerAbsenceRepository(db), repository.NewAttendantRepository(db), nil, nil, nil),
+		tripRepo,
+		scheduleRepo,
+		passengerRepo,
+		repository.NewGuardianRepository(db),
+		database.NewTxManager(db),
+		jobNotificationService(cfg, db),
+		service.NewOutboxService(repository.NewOutboxRepository(db), nil, service.NotificationRetryPolicy{}),
+		jobOperatorContacts(cfg, db),
+		cfg.Delay.Threshold,
+		service.NewOrganizationService(repository.NewOrganizationRepository(db)),
+	)
+}
+
+// jobTripAssignmentService - 이벤트 릴레이용 배정 변경 서비스 (알림 발송만 사용)
+func jobTripAssignmentService(cfg *config.Config, db *gorm.DB) *service.TripAssignmentService {
+	tripRepo := repository.NewTripRepository(db)
+	scheduleRepo := repository.NewScheduleRepository(db)
+	passengerRepo := repository.NewPassengerRepository(db)
+	attendantRepo := repository.NewAttendantRepository(db)
+	return service.NewTripAssignmentService(
+		service.NewTripService(tripRepo, scheduleRepo, passengerRepo, repository.NewPassengerAbsenceRepository(db), attendantRepo, nil, nil, nil),
+		tripRepo,
+		scheduleRepo,
+		repository.NewRouteRepository(db),
+		repository.NewVehicleRepository(db),
+		repository.NewDriverRepository(db),
+		attendantRepo,
+		passengerRepo,
+		repository.NewGuardianRepository(db),
+		database.NewTxManager(db),
+		jobNotificationService(cfg, db),
+		nil,
+	)
+}
+
+// jobOperatorContacts - 주기 작업용 운영 알림 수신자 조회 (API 서버와 같은 플랫폼 운영 연락처)
+func jobOperatorContacts(cfg *config.Config, db *gorm.DB) *service.OperatorContacts {
+	return service.NewOperatorContacts(repository.NewUserRepository(db), repository.NewOrganizationRepository(db), cfg.SMS.AdminNumbers)
+}
+
+// jobNotificationService - 주기 작업용 알림 서비스 (API 서버와 같은 채널/재시도 설정)
+func jobNotificationService(cfg *config.Config, db *gorm.DB) *service.NotificationService {
+	alimtalkClient, alimtalkTemplates := alimtalkSender(cfg.Alimtalk)
+	return service.NewNotificationService(
+		repository.NewNotificationPreferenceRepository(db),
+		repository.NewNotificationLogRepository(db),
+		repository.NewGuardianRepository(db),
+		repository.NewUserRepository(db),
+		service.NewDeviceService(repository.NewDeviceTokenRepository(db), pushSender(cfg.Push)),
+		alimtalkClient,
+		alimtalkTemplates,
+		smsSender(cfg.SMS),
+		notificationRetryPolicy(cfg.Delivery),
+	)
+}
@@ -2,23 +2,43 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // 기관 시간대 (zoneinfo가 없는 컨테이너 이미지 대비)
 
 	"github.com/gin-gonic/gin"
 	"github.com/hyeokjun/eodini/config"
+	"github.com/hyeokjun/eodini/internal/grpcserver"
 	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/job"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/migrations"
+	"github.com/hyeokjun/eodini/pkg/cache"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/encryption"
 	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/startup"
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
 )
 
 // 📝 설명: 애플리케이션 진입점
 // 🎯 실무 포인트: Graceful Shutdown, 설정 로드, 로거 초기화
 // ⚠️ 주의사항: 서버 시작 전 설정 검증 필수
 
+// version - 애플리케이션 버전 (시작 로그, 추적 service.version)
+const version = "0.1.0"
+
 // @title						Eodini API
 // @version					1.0
 // @description				통학/통원 차량 관리 시스템 API
@@ -33,8 +53,12 @@ import (
 // @securityDefinitions.apikey	BearerAuth
 // @in							header
 // @name						Authorization
-// @description				JWT Bearer token (추후 구현 예정)
+// @description				JWT Bearer token ("Bearer {token}" 형식)
 func main() {
+	migrateOnly := flag.Bool("migrate", false, "마이그레이션만 적용하고 종료")
+	encryptFields := flag.Bool("encrypt-fields", false, "기존 평문 민감 정보를 암호화하고 종료")
+	flag.Parse()
+
 	// 1. 설정 로드
 	cfg, err := config.Load()
 	if err != nil {
@@ -42,14 +66,21 @@ func main() {
 		os.Exit(1)
 	}
 
-	// 2. 로거 초기화
-	initLogger(cfg)
+	// 2. 로거 초기화 (파일 출력은 종료 시 닫기)
+	closeLogs := initLogger(cfg)
+	defer closeLogs()
 	logger.Info("Starting Eodini API Server", map[string]interface{}{
-		"version":     "0.1.0",
+		"version":     version,
 		"environment": cfg.Server.Environment,
 		"port":        cfg.Server.Port,
 	})
 
+	// 분산 추적 (TRACING_ENABLED=true일 때만, 종료 시 남은 span 전송)
+	if cfg.Tracing.Enabled {
+		shutdownTracing := initTracing(cfg)
+		defer shutdownTracing()
+	}
+
 	// 3. Gin 모드 설정
 	if cfg.IsProduction() {
 		gin.SetMode(gin.ReleaseMode)
@@ -57,10 +88,103 @@ func main() {
 		gin.SetMode(gin.DebugMode)
 	}
 
-	// 4. 라우터 설정
-	router := handler.SetupRouter()
+	// 시작 대기 (DB/Redis/마이그레이션이 준비될 때까지 재시도, 전체 STARTUP_TIMEOUT 안에 끝나야 HTTP 리스너를 엶)
+	startupCtx, cancelStartup := context.WithTimeout(context.Background(), cfg.Startup.Timeout)
+	defer cancelStartup()
+	startupOpts := startup.Options{Backoff: cfg.Startup.Backoff, MaxBackoff: cfg.Startup.MaxBackoff}
+
+	// 4. 데이터베이스 연결 (변경 작업은 감사 로그 자동 기록, 민감 컬럼은 암호화 저장)
+	var db *gorm.DB
+	waitFor(startupCtx, "database", startupOpts, func(context.Context) error {
+		var err error
+		db, err = database.New(cfg)
+		return err
+	})
+	defer func() {
+		if err := database.Close(db); err != nil {
+			logger.Errorf("Failed to close database: %v", err)
+		}
+	}()
+	// 기관 조건이 먼저 붙어야 감사 로그의 변경 전 스냅샷도 같은 기관으로 제한됨
+	if err := repository.RegisterTenantHooks(db); err != nil {
+		logger.Fatal("Failed to register tenant hooks", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	if err := repository.RegisterAuditHooks(db); err != nil {
+		logger.Fatal("Failed to register audit hooks", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	cipher, err := encryption.NewFieldCipherFromBase64(cfg.Security.FieldEncryptionKey)
+	if err != nil {
+		logger.Fatal("Failed to load field encryption key", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	repository.UseFieldCipher(cipher)
+	if cfg.Tracing.Enabled {
+		if err := database.RegisterTracing(db); err != nil {
+			logger.Fatal("Failed to register database tracing", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	// 5. 마이그레이션 (-migrate: 적용 후 종료, DB_AUTO_MIGRATE=true: 적용 후 서버 시작, -encrypt-fields: 기존 데이터 암호화 후 종료)
+	// 그 외에는 다른 인스턴스/Job이 마이그레이션을 모두 적용할 때까지 대기
+	if *migrateOnly || cfg.Database.AutoMigrate {
+		runMigrations(db)
+		if *migrateOnly {
+			return
+		}
+	} else {
+		waitFor(startupCtx, "migrations", startupOpts, func(ctx context.Context) error {
+			pending, err := database.MigrationsPending(ctx, db, migrations.FS)
+			if err != nil {
+				return err
+			}
+			if pending {
+				return errors.New("pending migrations (apply with -migrate or DB_AUTO_MIGRATE=true)")
+			}
+			return nil
+		})
+	}
+	if *encryptFields {
+		runFieldEncryption(db)
+		return
+	}
 
-	// 5. HTTP 서버 설정
+	// 6. Redis 연결 (세션/토큰 폐기 목록)
+	var rdb *redis.Client
+	waitFor(startupCtx, "redis", startupOpts, func(context.Context) error {
+		var err error
+		rdb, err = cache.New(cfg)
+		return err
+	})
+	cancelStartup()
+	defer func() {
+		if err := rdb.Close(); err != nil {
+			logger.Errorf("Failed to close redis: %v", err)
+		}
+	}()
+
+	// 실시간 위치 이벤트 (Redis pub/sub으로 모든 인스턴스의 WebSocket 구독자에게 전달)
+	hub := realtime.NewHub()
+	broker := realtime.NewRedisBroker(rdb, hub)
+	realtimeCtx, stopRealtime := context.WithCancel(context.Background())
+	defer stopRealtime()
+	go broker.Run(realtimeCtx)
+
+	// 7. 라우터 설정
+	handlers, grpcServices := buildHandlers(cfg, db, rdb, hub, broker)
+	router := handler.SetupRouter(handlers)
+
+	// 백그라운드 작업 (보존 기간 익명화, 위치 수신 끊김 감지 등, 종료 시 진행 중인 작업 취소)
+	jobs := job.Start(context.Background(), buildJobs(cfg, db, rdb)...)
+	defer jobs.Stop()
+
+	// 8. HTTP 서버 설정
 	srv := &http.Server{
 		Addr:         fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port),
 		Handler:      router,
@@ -69,7 +193,7 @@ func main() {
 		IdleTimeout:  cfg.Server.IdleTimeout,
 	}
 
-	// 6. 서버 시작 (고루틴)
+	// 9. 서버 시작 (고루틴)
 	go func() {
 		logger.Infof("Server listening on %s:%s", cfg.Server.Host, cfg.Server.Port)
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -78,17 +202,49 @@ func main() {
 		}
 	}()
 
-	// 7. Graceful Shutdown 대기
+	// gRPC 서버 (별도 포트, GRPC_ENABLED=false면 실행 안 함)
+	var grpcSrv *grpc.Server
+	if cfg.GRPC.Enabled {
+		listener, err := net.Listen("tcp", fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.GRPC.Port))
+		if err != nil {
+			logger.Fatal("Failed to listen gRPC port", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		grpcSrv = grpcserver.NewServer(grpcServices)
+		go func() {
+			logger.Infof("gRPC server listening on %s:%s", cfg.Server.Host, cfg.GRPC.Port)
+			if err := grpcSrv.Serve(listener); err != nil {
+				logger.Errorf("Failed to start gRPC server: %v", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	// 설정 재로드 (SIGHUP: 로그 레벨, CORS 허용 Origin, 요청 제한, 기능 플래그를 재시작 없이 적용)
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	defer signal.Stop(reload)
+	go func() {
+		for range reload {
+			reloadConfig(handlers.Settings)
+		}
+	}()
+
+	// 10. Graceful Shutdown 대기
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("Shutting down server...", nil)
 
-	// 8. Graceful Shutdown (최대 30초 대기)
+	// 11. Graceful Shutdown (최대 30초 대기)
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	if grpcSrv != nil {
+		stopGRPC(ctx, grpcSrv)
+	}
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Errorf("Server forced to shutdown: %v", err)
 		os.Exit(1)
@@ -97,22 +253,146 @@ func main() {
 	logger.Info("Server exited gracefully", nil)
 }
 
-// initLogger - 로거 초기화
-func initLogger(cfg *config.Config) {
+// reloadConfig - 설정(환경변수 + CONFIG_FILE)을 다시 읽어 실행 중 변경 가능한 값만 적용 (오류면 기존 설정 유지)
+func reloadConfig(settings *handler.LiveSettings) {
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Error("Config reload failed, keeping current settings", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	logger.SetLevel(logger.ParseLevel(cfg.Log.Level))
+	settings.Store(runtimeSettings(cfg))
+	logger.Info("Config reloaded", map[string]interface{}{
+		"log_level":    logger.Level().String(),
+		"cors_origins": cfg.CORS.AllowedOrigins,
+		"rate_limit":   cfg.RateLimit.Enabled,
+		"quota":        cfg.Quota.Enabled,
+		"idempotency":  cfg.Idempotency.Enabled,
+	})
+}
+
+// waitFor - 의존성이 준비될 때까지 재시도, 기한을 넘기면 종료
+func waitFor(ctx context.Context, name string, opts startup.Options, check func(context.Context) error) {
+	if err := startup.Wait(ctx, name, opts, check); err != nil {
+		logger.Fatal("Startup dependency not ready", map[string]interface{}{
+			"dependency": name,
+			"error":      err.Error(),
+		})
+	}
+}
+
+// initTracing - OTLP 내보내기 설정, 종료 함수 반환 (남은 span을 최대 5초 동안 전송)
+func initTracing(cfg *config.Config) func() {
+	shutdown, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName: cfg.Tracing.ServiceName,
+		Version:     version,
+		Environment: cfg.Server.Environment,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	logger.Infof("Tracing enabled (endpoint %s, sample ratio %.2f)", cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			logger.Errorf("Failed to flush traces: %v", err)
+		}
+	}
+}
+
+// stopGRPC - 진행 중인 RPC(위치 스트림 포함)를 기다렸다가 종료, ctx가 끝나면 강제 종료
+func stopGRPC(ctx context.Context, srv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logger.Errorf("gRPC server forced to stop: %v", ctx.Err())
+		srv.Stop()
+	}
+}
+
+// runMigrations - 임베드된 SQL 마이그레이션 적용
+func runMigrations(db *gorm.DB) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	results, err := database.Migrate(ctx, db, migrations.FS)
+	if err != nil {
+		logger.Fatal("Failed to run migrations", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	for _, result := range results {
+		logger.Info("Migration applied", map[string]interface{}{
+			"version": result.Version,
+			"source":  result.Source,
+		})
+	}
+	logger.Infof("Migrations up to date (%d applied)", len(results))
+}
+
+// runFieldEncryption - 암호화 도입 전 평문으로 저장된 민감 정보 암호화
+func runFieldEncryption(db *gorm.DB) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	count, err := repository.EncryptExistingFields(ctx, db)
+	if err != nil {
+		logger.Fatal("Failed to encrypt existing fields", map[string]interface{}{
+			"error":     err.Error(),
+			"encrypted": count,
+		})
+	}
+	logger.Infof("Field encryption completed (%d rows encrypted)", count)
+}
+
+// initLogger - 로거 초기화, 로그 파일을 닫는 함수 반환
+func initLogger(cfg *config.Config) func() {
 	// 로그 레벨 설정
-	switch cfg.Log.Level {
-	case "debug":
-		logger.SetLevel(logger.DebugLevel)
-	case "info":
-		logger.SetLevel(logger.InfoLevel)
-	case "warn":
-		logger.SetLevel(logger.WarnLevel)
-	case "error":
-		logger.SetLevel(logger.ErrorLevel)
-	default:
-		logger.SetLevel(logger.InfoLevel)
-	}
-
-	// TODO: 로그 포맷 설정 (json/text)
-	// 현재는 기본 text 포맷 사용
+	logger.SetLevel(logger.ParseLevel(cfg.Log.Level))
+
+	// 로그 포맷 설정 (json: Loki/ELK 수집용, 그 외: text)
+	logger.SetFormat(logger.Format(cfg.Log.Format))
+
+	// 출력 대상 (stdout/stderr/파일, 파일은 크기/주기 기준 교체)
+	output, err := logger.OpenOutputs(cfg.Log.Outputs, logger.RotateOptions{
+		MaxSize:    int64(cfg.Log.MaxSize) << 20,
+		Interval:   cfg.Log.RotateEvery,
+		MaxBackups: cfg.Log.MaxBackups,
+	})
+	if err != nil {
+		fmt.Printf("Failed to open log output: %v\n", err)
+		os.Exit(1)
+	}
+	logger.SetOutput(output)
+
+	// 출력 구현 선택 (standard, slog, zap)
+	backend, err := logger.NewBackend(cfg.Log.Backend, logger.Format(cfg.Log.Format), output)
+	if err != nil {
+		fmt.Printf("Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	logger.SetBackend(backend)
+
+	return func() {
+		if err := output.Close(); err != nil {
+			fmt.Printf("Failed to close log output: %v\n", err)
+		}
+	}
 }
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 샘플 데이터 정의 및 적재
+// 🎯 실무 포인트: Repository가 아닌 Service를 통해 적재 → API와 같은 검증 규칙 적용
+// ⚠️ 주의사항: 하나라도 실패하면 전체 롤백 (TxManager)
+
+// seeder - 샘플 데이터 적재기
+type seeder struct {
+	vehicles   *service.VehicleService
+	drivers    *service.DriverService
+	attendants *service.AttendantService
+	routes     *service.RouteService
+	schedules  *service.ScheduleService
+	passengers *service.PassengerService
+	guardians  *service.GuardianService
+}
+
+// newSeeder - 서비스 조립
+func newSeeder(db *gorm.DB) *seeder {
+	vehicleRepo := repository.NewVehicleRepository(db)
+	driverRepo := repository.NewDriverRepository(db)
+	routeRepo := repository.NewRouteRepository(db)
+	passengerRepo := repository.NewPassengerRepository(db)
+	scheduleRepo := repository.NewScheduleRepository(db)
+
+	return &seeder{
+		vehicles:   service.NewVehicleService(vehicleRepo),
+		drivers:    service.NewDriverService(driverRepo),
+		attendants: service.NewAttendantService(repository.NewAttendantRepository(db)),
+		routes:     service.NewRouteService(routeRepo, database.NewTxManager(db), nil, nil),
+		schedules:  service.NewScheduleService(scheduleRepo, routeRepo, vehicleRepo, driverRepo, passengerRepo),
+		passengers: service.NewPassengerService(passengerRepo, routeRepo, scheduleRepo, vehicleRepo),
+		guardians:  service.NewGuardianService(repository.NewGuardianRepository(db), passengerRepo, routeRepo),
+	}
+}
+
+// Run - 하나의 트랜잭션에서 전체 샘플 데이터 적재
+func (s *seeder) Run(ctx context.Context, txManager database.TxManager) (map[string]interface{}, error) {
+	summary := map[string]interface{}{}
+	err := txManager.WithTx(ctx, func(ctx context.Context) error {
+		vehicles, err := s.seedVehicles(ctx)
+		if err != nil {
+			return fmt.Errorf("vehicles: %w", err)
+		}
+		drivers, err := s.seedDrivers(ctx)
+		if err != nil {
+			return fmt.Errorf("drivers: %w", err)
+		}
+		attendant, err := s.seedAttendant(ctx)
+		if err != nil {
+			return fmt.Errorf("attendants: %w", err)
+		}
+		routes, err := s.seedRoutes(ctx)
+		if err != nil {
+			return fmt.Errorf("routes: %w", err)
+		}
+		schedules, err := s.seedSchedules(ctx, routes, vehicles, drivers, attendant)
+		if err != nil {
+			return fmt.Errorf("schedules: %w", err)
+		}
+		passengers, err := s.seedPassengers(ctx, routes)
+		if err != nil {
+			return fmt.Errorf("passengers: %w", err)
+		}
+		guardian, err := s.seedGuardian(ctx, passengers[:2])
+		if err != nil {
+			return fmt.Errorf("guardians: %w", err)
+		}
+
+		summary["vehicles"] = len(vehicles)
+		summary["drivers"] = len(drivers)
+		summary["attendants"] = 1
+		summary["routes"] = len(routes)
+		summary["schedules"] = schedules
+		summary["passengers"] = len(passengers)
+		summary["guardian_id"] = guardian.ID // make token ROLE=guardian PROFILE=<id>
+		return nil
+	})
+	return summary, err
+}
+
+// seedVehicles - 차량 3대
+func (s *seeder) seedVehicles(ctx context.Context) ([]*domain.Vehicle, error) {
+	requests := []dto.CreateVehicleRequest{
+		{PlateNumber: "12가3456", Model: "그랜드스타렉스", Manufacturer: "현대", VehicleType: "van", Capacity: 12, Year: 2022, Color: "노란색"},
+		{PlateNumber: "34나5678", Model: "쏠라티", Manufacturer: "현대", VehicleType: "mini_bus", Capacity: 15, Year: 2023, Color: "노란색"},
+		{PlateNumber: "56다7890", Model: "카운티", Manufacturer: "현대", VehicleType: "bus", Capacity: 25, Year: 2021, Color: "흰색"},
+	}
+
+	vehicles := make([]*domain.Vehicle, 0, len(requests))
+	for i := range requests {
+		vehicle, err := s.vehicles.Create(ctx, &requests[i])
+		if err != nil {
+			return nil, err
+		}
+		vehicles = append(vehicles, vehicle)
+	}
+	return vehicles, nil
+}
+
+// seedDrivers - 기사 3명
+func (s *seeder) seedDrivers(ctx context.Context) ([]*domain.Driver, error) {
+	expiry := time.Now().AddDate(2, 0, 0)
+	requests := []dto.CreateDriverRequest{
+		{Name: "김운전", Phone: "010-1111-1111", LicenseNumber: "11-20-123456-01", LicenseType: "type_1_large", LicenseExpiry: expiry},
+		{Name: "이운전", Phone: "010-2222-2222", LicenseNumber: "11-20-123456-02", LicenseType: "type_1_large", LicenseExpiry: expiry},
+		{Name: "박운전", Phone: "010-3333-3333", LicenseNumber: "11-20-123456-03", LicenseType: "type_1_regular", LicenseExpiry: time.Now().AddDate(0, 0, 20)}, // 면허 만료 임박
+	}
+
+	drivers := make([]*domain.Driver, 0, len(requests))
+	for i := range requests {
+		driver, err := s.drivers.Create(ctx, &requests[i])
+		if err != nil {
+			return nil, err
+		}
+		drivers = append(drivers, driver)
+	}
+	return drivers, nil
+}
+
+// seedAttendant - 운행 시작 권한이 있는 선생님 1명
+func (s *seeder) seedAttendant(ctx context.Context) (*domain.Attendant, error) {
+	attendant, err := s.attendants.Create(ctx, &dto.CreateAttendantRequest{
+		Name:         "최선생",
+		Phone:        "010-4444-4444",
+		Role:         "teacher",
+		Organization: "어디니 유치원",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.attendants.GrantStartTripPermission(ctx, attendant.ID)
+}
+
+// seedRoutes - 정류장을 포함한 경로 2개
+func (s *seeder) seedRoutes(ctx context.Context) ([]*domain.Route, error) {
+	requests := []dto.CreateRouteRequest{
+		{
+			Name: "A코스", Description: "강남역 방면", EstimatedTime: 40, TotalDistance: 12000,
+			Stops: []dto.CreateStopRequest{
+				{Name: "래미안아파트 정문", Address: "서울 강남구 역삼동 1", Latitude: coord(37.4979), Longitude: coord(127.0276), EstimatedArrivalTime: 0},
+				{Name: "역삼초등학교 앞", Address: "서울 강남구 역삼동 2", Latitude: coord(37.5008), Longitude: coord(127.0366), EstimatedArrivalTime: 10},
+				{Name: "선릉역 3번 출구", Address: "서울 강남구 대치동 3", Latitude: coord(37.5045), Longitude: coord(127.0490), EstimatedArrivalTime: 20},
+				{Name: "어디니 유치원", Address: "서울 강남구 삼성동 4", Latitude: coord(37.5088), Longitude: coord(127.0631), EstimatedArrivalTime: 35},
+			},
+		},
+		{
+			Name: "B코스", Description: "잠실 방면", EstimatedTime: 30, TotalDistance: 9000,
+			Stops: []dto.CreateStopRequest{
+				{Name: "잠실엘스 후문", Address: "서울 송파구 잠실동 5", Latitude: coord(37.5133), Longitude: coord(127.0823), EstimatedArrivalTime: 0},
+				{Name: "종합운동장역", Address: "서울 송파구 잠실동 6", Latitude: coord(37.5109), Longitude: coord(127.0736), EstimatedArrivalTime: 12},
+				{Name: "어디니 유치원", Address: "서울 강남구 삼성동 4", Latitude: coord(37.5088), Longitude: coord(127.0631), EstimatedArrivalTime: 25},
+			},
+		},
+	}
+
+	routes := make([]*domain.Route, 0, len(requests))
+	for i := range requests {
+		route, err := s.routes.Create(ctx, &requests[i])
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// seedSchedules - 경로별 평일 등원/하원 일정
+func (s *seeder) seedSchedules(ctx context.Context, routes []*domain.Route, vehicles []*domain.Vehicle, drivers []*domain.Driver, attendant *domain.Attendant) (int, error) {
+	weekdays := []int{1, 2, 3, 4, 5}
+	count := 0
+	for i, route := range routes {
+		for _, slot := range []struct {
+			label, startTime, timeSlot string
+		}{
+			{"등원", "08:00", "morning"},
+			{"하원", "15:30", "afternoon"},
+		} {
+			req := &dto.CreateScheduleRequest{
+				Name:            fmt.Sprintf("%s %s %s", route.Name, slot.label, slot.startTime),
+				StartTime:       slot.startTime,
+				TimeSlot:        slot.timeSlot,
+				DaysOfWeek:      weekdays,
+				RouteID:         route.ID,
+				VehicleID:       vehicles[i].ID,
+				DefaultDriverID: drivers[i].ID,
+			}
+			if i == 0 {
+				req.DefaultAttendantID = &attendant.ID
+			}
+			if _, err := s.schedules.Create(ctx, req); err != nil {
+				return 0, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// seedPassengers - 정류장마다 탑승자 2명씩 (마지막 정류장 제외)
+func (s *seeder) seedPassengers(ctx context.Context, routes []*domain.Route) ([]*domain.Passenger, error) {
+	names := []string{"김하늘", "이바다", "박구름", "최별님", "정햇살", "강나무", "윤시내", "임들꽃", "한바람", "오솔길"}
+	var passengers []*domain.Passenger
+	count := 0
+	for _, route := range routes {
+		for _, stop := range route.Stops[:len(route.Stops)-1] {
+			for j := 0; j < 2; j++ {
+				name := names[count%len(names)]
+				req := &dto.CreatePassengerRequest{
+					Name:             name,
+					Age:              5 + count%3,
+					GuardianName:     name + " 어머니",
+					GuardianPhone:    fmt.Sprintf("010-9000-%04d", count+1),
+					GuardianRelation: "모",
+					RouteID:          route.ID,
+					StopID:           stop.ID,
+				}
+				if count%4 == 0 {
+					req.MedicalNotes = "땅콩 알레르기"
+				}
+				passenger, err := s.passengers.Create(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				passengers = append(passengers, passenger)
+				count++
+			}
+		}
+	}
+	return passengers, nil
+}
+
+// seedGuardian - 형제 자녀를 둔 보호자 계정 1명
+func (s *seeder) seedGuardian(ctx context.Context, children []*domain.Passenger) (*domain.Guardian, error) {
+	guardian, err := s.guardians.Create(ctx, &dto.CreateGuardianRequest{
+		Name:  "김보호",
+		Phone: "010-5555-5555",
+		Email: "guardian@example.com",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		if _, err := s.guardians.LinkPassenger(ctx, guardian.ID, &dto.LinkPassengerRequest{
+			PassengerID: child.ID,
+			Relation:    "모",
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return guardian, nil
+}
+
+// coord - 정류장 좌표 (요청 DTO는 생략 가능한 포인터)
+func coord(v float64) *float64 {
+	return &v
+}
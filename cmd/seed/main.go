@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hyeokjun/eodini/config"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/migrations"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/encryption"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 로컬 개발용 샘플 데이터 적재 명령
+// 🎯 실무 포인트: 프론트엔드 개발자가 수동 INSERT 없이 바로 API를 호출할 수 있도록 지원
+// ⚠️ 주의사항: prod 환경에서는 실행 거부, 이미 데이터가 있으면 -reset 없이는 건너뜀
+
+func main() {
+	reset := flag.Bool("reset", false, "기존 데이터를 모두 삭제하고 다시 적재")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+	if cfg.IsProduction() {
+		logger.Fatal("Seed is not allowed in production", nil)
+	}
+
+	db, err := database.New(cfg)
+	if err != nil {
+		logger.Fatal("Failed to connect database", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	defer func() {
+		if err := database.Close(db); err != nil {
+			logger.Errorf("Failed to close database: %v", err)
+		}
+	}()
+
+	cipher, err := encryption.NewFieldCipherFromBase64(cfg.Security.FieldEncryptionKey)
+	if err != nil {
+		logger.Fatal("Failed to load field encryption key", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	repository.UseFieldCipher(cipher)
+	if err := repository.RegisterTenantHooks(db); err != nil {
+		logger.Fatal("Failed to register tenant hooks", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	// 샘플 데이터는 모두 기본 기관(마이그레이션이 생성)에 적재
+	ctx = repository.WithOrganization(ctx, domain.DefaultOrganizationID)
+
+	// 스키마가 최신인지 먼저 보장
+	if _, err := database.Migrate(ctx, db, migrations.FS); err != nil {
+		logger.Fatal("Failed to run migrations", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	if *reset {
+		if err := truncateAll(ctx, db); err != nil {
+			logger.Fatal("Failed to reset data", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		logger.Info("Existing data removed", nil)
+	} else if seeded, err := alreadySeeded(ctx, db); err != nil {
+		logger.Fatal("Failed to check existing data", map[string]interface{}{
+			"error": err.Error(),
+		})
+	} else if seeded {
+		logger.Info("Data already exists, skipping seed (use -reset to reload)", nil)
+		return
+	}
+
+	txManager := database.NewTxManager(db)
+	summary, err := newSeeder(db).Run(ctx, txManager)
+	if err != nil {
+		logger.Fatal("Failed to seed data", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	logger.Info("Seed completed", summary)
+}
+
+// alreadySeeded - 차량 데이터 존재 여부로 적재 여부 판단
+func alreadySeeded(ctx context.Context, db *gorm.DB) (bool, error) {
+	var count int64
+	if err := db.WithContext(ctx).Table("vehicles").Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// truncateAll - 모든 도메인 테이블 비우기 (FK 순서 무관하게 CASCADE)
+func truncateAll(ctx context.Context, db *gorm.DB) error {
+	return db.WithContext(ctx).Exec(`TRUNCATE TABLE
+		trip_passengers, trips, driver_assignments, schedules,
+		guardian_passengers, guardians, passengers, stops, routes, attendants, drivers, vehicles
+		CASCADE`).Error
+}
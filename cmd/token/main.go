@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/config"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: 로컬 개발용 액세스 토큰 발급 명령
+// 🎯 실무 포인트: 로그인 API 없이도 역할별로 API를 호출해 볼 수 있도록 지원
+// ⚠️ 주의사항: prod 환경에서는 실행 거부
+
+func main() {
+	role := flag.String("role", string(domain.RoleAdmin), "역할 (admin, driver, attendant, guardian, super_admin)")
+	userID := flag.String("user", "", "사용자 ID (생략 시 임의 UUID)")
+	profileID := flag.String("profile", "", "역할별 프로필 ID (기사/동승자/보호자 ID)")
+	orgID := flag.String("org", domain.DefaultOrganizationID, "소속 기관 ID")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+	if cfg.IsProduction() {
+		fmt.Fprintln(os.Stderr, "Token issuing is not allowed in production")
+		os.Exit(1)
+	}
+
+	if *userID == "" {
+		*userID = uuid.New().String()
+	}
+
+	tokens := auth.NewTokenManager(cfg.Auth.JWTSecret, cfg.Auth.AccessTokenTTL)
+	token, err := tokens.Issue(&auth.Principal{
+		UserID:         *userID,
+		OrganizationID: *orgID,
+		Role:           domain.Role(*role),
+		ProfileID:      *profileID,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to issue token: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(token)
+}
@@ -1,22 +1,58 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // 📝 설명: 애플리케이션 설정 중앙 관리
 // 🎯 실무 포인트: 환경변수로 설정을 주입받아 K8s ConfigMap/Secret과 연동
+// 긴 목록/묶음 설정은 CONFIG_FILE(YAML/TOML)로, 같은 키의 환경변수가 있으면 환경변수 우선
 // ⚠️ 주의사항: 민감한 정보(DB 비밀번호 등)는 반드시 환경변수로 주입
 
+// devJWTSecret - 개발 환경 기본 서명 키 (dev 외 환경에서는 사용 불가)
+const devJWTSecret = "eodini-dev-secret"
+
+// devFieldEncryptionKey - 개발 환경 기본 컬럼 암호화 키 (base64, 32바이트)
+const devFieldEncryptionKey = "ZW9kaW5pLWRldi1maWVsZC1lbmNyeXB0aW9uLWtleSE="
+
 // Config - 전체 애플리케이션 설정
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	Log      LogConfig
+	Server      ServerConfig
+	CORS        CORSConfig
+	GRPC        GRPCConfig
+	Database    DatabaseConfig
+	Redis       RedisConfig
+	Log         LogConfig
+	Auth        AuthConfig
+	Security    SecurityConfig
+	Retention   RetentionConfig
+	RateLimit   RateLimitConfig
+	Quota       QuotaConfig
+	Idempotency IdempotencyConfig
+	Geofence    GeofenceConfig
+	ETA         ETAConfig
+	Alert       AlertConfig
+	Signal      SignalConfig
+	Delay       DelayConfig
+	Expiry      ExpiryConfig
+	Holiday     HolidayConfig
+	Maps        MapsConfig
+	Push        PushConfig
+	SMS         SMSConfig
+	Alimtalk    AlimtalkConfig
+	Email       EmailConfig
+	Storage     StorageConfig
+	Delivery    DeliveryConfig
+	Webhook     WebhookConfig
+	Outbox      OutboxConfig
+	Tracing     TracingConfig
+	Startup     StartupConfig
+	Secrets     SecretsConfig
 }
 
 // ServerConfig - 서버 관련 설정
@@ -29,6 +65,33 @@ type ServerConfig struct {
 	IdleTimeout  time.Duration // 유휴 연결 타임아웃
 }
 
+// CORSConfig - 브라우저 요청 허용 Origin 설정
+type CORSConfig struct {
+	AllowedOrigins []string // 허용할 Origin (쉼표 구분, 비어 있으면 모든 Origin 허용)
+}
+
+// GRPCConfig - gRPC 서버 설정 (REST와 별도 포트, 차량 단말 게이트웨이/내부 서비스용)
+type GRPCConfig struct {
+	Enabled bool   // gRPC 서버 실행 여부
+	Port    string // gRPC 서버 포트 (예: "9090", SERVER_PORT와 달라야 함)
+}
+
+// TracingConfig - OpenTelemetry 분산 추적 설정 (OTLP/HTTP 수집기로 전송)
+type TracingConfig struct {
+	Enabled     bool    // 추적 사용 여부 (false면 span을 만들지 않음)
+	Endpoint    string  // OTLP/HTTP 수집기 주소 (host:port, 예: "tempo:4318")
+	Insecure    bool    // TLS 없이 전송 (클러스터 내부 수집기)
+	ServiceName string  // service.name
+	SampleRatio float64 // 새 트레이스 샘플링 비율 (0~1, 수신 traceparent의 결정은 그대로 따름)
+}
+
+// StartupConfig - 시작 시 DB/Redis/마이그레이션 준비 대기 설정 (준비가 끝나야 HTTP 리스너를 엶)
+type StartupConfig struct {
+	Timeout    time.Duration // 전체 대기 한도 (넘으면 종료 → Kubernetes가 재시작)
+	Backoff    time.Duration // 첫 재시도 간격 (실패할 때마다 두 배)
+	MaxBackoff time.Duration // 재시도 간격 상한
+}
+
 // DatabaseConfig - 데이터베이스 관련 설정
 type DatabaseConfig struct {
 	Host            string // DB 호스트 (예: "localhost")
@@ -41,24 +104,254 @@ type DatabaseConfig struct {
 	MaxIdleConns    int    // 최대 유휴 커넥션 수
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
+	AutoMigrate     bool // 서버 시작 시 마이그레이션 자동 적용 여부
 }
 
 // RedisConfig - Redis 관련 설정
 type RedisConfig struct {
 	Host     string // Redis 호스트
 	Port     string // Redis 포트
+	Username string // Redis ACL 사용자 (선택, 비어 있으면 default)
 	Password string // Redis 비밀번호 (선택)
 	DB       int    // Redis DB 번호 (0-15)
+	TLS      bool   // TLS 연결 (관리형 Redis, REDIS_URL이 rediss://면 자동)
 }
 
 // LogConfig - 로그 관련 설정
 type LogConfig struct {
-	Level  string // 로그 레벨 (debug, info, warn, error)
-	Format string // 로그 포맷 (json, text)
+	Level        string        // 로그 레벨 (debug, info, warn, error)
+	Format       string        // 로그 포맷 (json, text)
+	Backend      string        // 출력 구현 (standard, slog, zap)
+	Outputs      []string      // 출력 대상 (stdout, stderr, 파일 경로, 쉼표 구분으로 동시 출력)
+	MaxSize      int           // 로그 파일 최대 크기 (MB, 넘으면 교체, 0이면 크기 기준 없음)
+	RotateEvery  time.Duration // 로그 파일 교체 주기 (0이면 주기 기준 없음)
+	MaxBackups   int           // 보관할 교체 파일 수 (0이면 모두 보관)
+	RedactFields []string      // 기본 목록 외에 추가로 마스킹할 JSON 필드명 (쉼표 구분)
+}
+
+// AuthConfig - 인증 관련 설정
+type AuthConfig struct {
+	JWTSecret        string        // 액세스 토큰 서명 키 (prod 필수)
+	AccessTokenTTL   time.Duration // 액세스 토큰 유효 기간
+	PasswordResetTTL time.Duration // 비밀번호 재설정 토큰 유효 기간
+	InvitationTTL    time.Duration // 기관 초대 토큰 유효 기간
+}
+
+// SecurityConfig - 데이터 보호 관련 설정
+type SecurityConfig struct {
+	FieldEncryptionKey string // 민감 컬럼 암호화 키 (base64 32바이트, prod는 KMS로 관리되는 Secret에서 주입)
+}
+
+// RetentionConfig - 개인정보 보존 기간 관리 설정
+type RetentionConfig struct {
+	Enabled  bool          // 익명화 작업 실행 여부 (여러 인스턴스 중 한 곳에서만 켜는 것을 권장)
+	Period   time.Duration // 비활성/퇴사/삭제 후 개인정보 보관 기간 (기본 3년)
+	Interval time.Duration // 익명화 작업 실행 간격
+}
+
+// RateLimitConfig - 요청 빈도 제한 설정 (클라이언트 IP 기준, 윈도우당 요청 수)
+type RateLimitConfig struct {
+	Enabled   bool          // 요청 제한 적용 여부
+	AuthLimit int           // 로그인/비밀번호 재설정 경로 한도
+	APILimit  int           // 일반 API 한도
+	Window    time.Duration // 윈도우 길이
+}
+
+// QuotaConfig - 인증 주체별 일일 요청 한도 설정 (0이면 무제한)
+type QuotaConfig struct {
+	Enabled     bool // 일일 한도 적용 여부
+	UserDaily   int  // 로그인 사용자 일일 한도
+	APIKeyDaily int  // API 키 기본 일일 한도 (키별 daily_quota가 있으면 그 값)
+}
+
+// IdempotencyConfig - Idempotency-Key 응답 재사용 설정
+type IdempotencyConfig struct {
+	Enabled bool          // Idempotency-Key 처리 여부
+	TTL     time.Duration // 응답 보관 기간 (이 기간 안의 재시도만 중복 처리 방지)
+}
+
+// GeofenceConfig - 정류장 접근/도착/출발 판정 반경 (미터)
+type GeofenceConfig struct {
+	Enabled         bool // 정류장 판정 여부
+	ApproachRadius  int  // 접근 반경
+	ArrivalRadius   int  // 도착 반경
+	DepartureRadius int  // 출발 반경 (도착 반경보다 크게, GPS 튐으로 도착/출발 반복 방지)
+}
+
+// ETAConfig - 정류장 도착 예정 시간 계산 설정 (직선 거리 추정)
+type ETAConfig struct {
+	AverageSpeed int           // 평균 주행 속도 (km/h)
+	StopDwell    time.Duration // 정류장 정차 시간
+	CacheTTL     time.Duration // 계산 결과 캐시 기간
+}
+
+// AlertConfig - 위험 운전 경보 기준 (0이면 해당 판정 안 함)
+type AlertConfig struct {
+	Enabled              bool          // 경보 판정 여부
+	SpeedLimit           int           // 전체 제한 속도 (km/h)
+	SchoolZoneSpeedLimit int           // 어린이 보호구역 제한 속도 (km/h)
+	SchoolZones          []SchoolZone  // 어린이 보호구역 ("위도,경도,반경m" 세미콜론 구분)
+	HarshAcceleration    int           // 급가속 기준 (초당 속도 증가 km/h)
+	HarshBraking         int           // 급감속 기준 (초당 속도 감소 km/h)
+	Cooldown             time.Duration // 같은 종류 경보 최소 간격
+	NotifyAdmin          bool          // 경보 발생 시 관리자 알림 여부
+}
+
+// SignalConfig - 운행 중 위치 수신 끊김 감지 설정
+type SignalConfig struct {
+	Enabled       bool          // 끊김 감지 여부
+	Timeout       time.Duration // 이 시간 동안 위치가 없으면 끊김
+	CheckInterval time.Duration // 감지 작업 실행 간격
+}
+
+// DelayConfig - 출발 지연 감지 설정
+type DelayConfig struct {
+	Enabled       bool          // 지연 감지 여부
+	Threshold     time.Duration // 출발 예정 시각 후 이 시간이 지나도 대기 중이면 지연
+	CheckInterval time.Duration // 감지 작업 실행 간격
+}
+
+// ExpiryConfig - 면허/보험/정기검사 만료 안내 작업 설정
+type ExpiryConfig struct {
+	Enabled  bool          // 만료 안내 작업 실행 여부 (구간마다 한 번만 안내하므로 여러 인스턴스에서 켜도 안전)
+	Days     []int         // 만료 며칠 전에 안내할지 (쉼표 구분, 기본 30,14,3)
+	Interval time.Duration // 작업 실행 간격
+}
+
+// HolidayConfig - 공휴일 동기화 설정 (공공데이터포털 특일 정보 API)
+type HolidayConfig struct {
+	APIKey       string        // 서비스 키 (비어 있으면 기본 공휴일 표만 사용)
+	SyncInterval time.Duration // 동기화 작업 실행 간격 (올해와 내년)
+	Timeout      time.Duration // API 호출 제한 시간
+}
+
+// MapsConfig - 지도 API 설정 (경로 도로 거리/소요 시간)
+type MapsConfig struct {
+	Provider string        // kakao, naver, google (비어 있으면 사용 안 함)
+	APIKey   string        // 카카오 REST API 키 / 네이버 Client Secret / 구글 API 키
+	ClientID string        // 네이버 Client ID
+	Timeout  time.Duration // API 호출 제한 시간
+	CacheTTL time.Duration // 길찾기 결과 캐시 시간
+}
+
+// PushConfig - 앱 푸시 알림 설정 (Firebase Cloud Messaging)
+type PushConfig struct {
+	FCMCredentialsFile string        // 서비스 계정 키(JSON) 경로 (비어 있으면 기기 등록만 받고 발송 안 함)
+	Timeout            time.Duration // FCM 호출 제한 시간
+}
+
+// SMSConfig - 문자 발송 설정 (비밀번호 재설정, 관리자 경보, 푸시 불가 시 보호자 알림)
+type SMSConfig struct {
+	Provider     string        // aligo, nhn (비어 있으면 사용 안 함)
+	APIKey       string        // 알리고 API 키 / NHN Cloud Secret Key
+	AccountID    string        // 알리고 계정 ID / NHN Cloud 앱키
+	SenderNumber string        // 사전 등록된 발신번호
+	AdminNumbers []string      // 기관 연락처가 없을 때 운영 알림을 받을 플랫폼 운영 연락처 (쉼표 구분)
+	Timeout      time.Duration // API 호출 제한 시간
+}
+
+// AlimtalkConfig - 카카오 알림톡 발송 설정 (푸시 불가 시 보호자 알림, 실패하면 문자로 대체)
+type AlimtalkConfig struct {
+	Provider      string        // aligo, nhn (비어 있으면 사용 안 함)
+	APIKey        string        // 알리고 API 키 / NHN Cloud Secret Key
+	AccountID     string        // 알리고 계정 ID / NHN Cloud 앱키
+	SenderKey     string        // 카카오 발신 프로필 키
+	SenderNumber  string        // 사전 등록된 발신번호 (알리고만 사용)
+	TemplatesFile string        // 템플릿 파일(JSON, 이름 → {code, text}) 경로
+	Timeout       time.Duration // API 호출 제한 시간
+}
+
+// EmailConfig - 이메일 발송 설정 (비밀번호 재설정, 서류 만료 안내, 관리자 보고서)
+type EmailConfig struct {
+	Provider           string        // smtp, ses (비어 있으면 사용 안 함)
+	From               string        // 발신 주소 (예: "어디니 <no-reply@eodini.kr>")
+	SMTPHost           string        // SMTP 서버 주소
+	SMTPPort           string        // SMTP 포트 (STARTTLS, 기본 587)
+	SMTPUsername       string        // 비어 있으면 인증 생략
+	SMTPPassword       string        // SMTP 비밀번호 (Gmail 등은 앱 비밀번호)
+	SESRegion          string        // AWS 리전 (예: ap-northeast-2)
+	SESAccessKeyID     string        // IAM 액세스 키
+	SESSecretAccessKey string        // IAM 시크릿 키
+	AdminRecipients    []string      // 보고서를 받을 관리자 주소 (쉼표 구분, 서류 만료 안내는 기관 관리자 계정 메일)
+	Timeout            time.Duration // 발송 제한 시간
+}
+
+// StorageConfig - 업로드 파일 저장소 설정 (차량 서류 원본)
+type StorageConfig struct {
+	Provider          string        // local, s3 (비어 있으면 파일 업로드 API 사용 안 함)
+	LocalDir          string        // 로컬 저장 디렉터리 (local)
+	S3Bucket          string        // 버킷 이름 (비공개 버킷)
+	S3Region          string        // AWS 리전 (예: ap-northeast-2, NCP는 kr-standard)
+	S3Endpoint        string        // S3 호환 저장소 주소 (비어 있으면 AWS)
+	S3AccessKeyID     string        // IAM 액세스 키
+	S3SecretAccessKey string        // IAM 시크릿 키
+	Timeout           time.Duration // 저장소 요청 제한 시간
+}
+
+// DeliveryConfig - 알림 발송 기록 및 재시도 설정
+type DeliveryConfig struct {
+	RetryEnabled  bool          // 일시 장애로 실패한 알림 재시도 여부 (false면 첫 실패로 종료)
+	MaxAttempts   int           // 최초 발송 포함 최대 시도 횟수 (넘으면 dead letter)
+	RetryBackoff  time.Duration // 첫 재시도 대기 시간 (시도마다 2배)
+	MaxBackoff    time.Duration // 재시도 대기 시간 상한
+	RetryInterval time.Duration // 재시도 작업 실행 간격
+}
+
+// WebhookConfig - 웹훅 전달 및 재시도 설정
+type WebhookConfig struct {
+	Timeout       time.Duration // 전달 요청 제한 시간 (넘으면 일시 장애로 재시도)
+	MaxAttempts   int           // 최초 전달 포함 최대 시도 횟수 (넘으면 dead letter)
+	RetryBackoff  time.Duration // 첫 재시도 대기 시간 (시도마다 2배)
+	MaxBackoff    time.Duration // 재시도 대기 시간 상한
+	RetryInterval time.Duration // 재시도 작업 실행 간격
+}
+
+// OutboxConfig - 도메인 이벤트 릴레이 설정 (아웃박스 → 웹훅 전달, 보호자 알림 등 이벤트 핸들러)
+type OutboxConfig struct {
+	RelayInterval time.Duration // 릴레이 작업 실행 간격 (후속 처리 지연의 상한)
+	MaxAttempts   int           // 핸들러 실패 시 최초 포함 최대 시도 횟수 (넘으면 dead letter)
+	RetryBackoff  time.Duration // 첫 재시도 대기 시간 (시도마다 2배)
+	MaxBackoff    time.Duration // 재시도 대기 시간 상한
+}
+
+// SecretsConfig - 비밀 저장소 설정 (DB 비밀번호, 서명 키, API 키를 시작 시 조회)
+type SecretsConfig struct {
+	Provider           string        // vault, aws-sm (비어 있으면 환경변수/설정 파일만 사용)
+	Timeout            time.Duration // 조회 제한 시간
+	VaultAddr          string        // Vault 주소 (예: https://vault.internal:8200)
+	VaultToken         string        // Vault 토큰 (해당 경로 읽기 전용)
+	VaultNamespace     string        // Vault Enterprise 네임스페이스
+	VaultPath          string        // KV 경로 (v2는 "secret/data/eodini")
+	AWSRegion          string        // AWS 리전 (예: ap-northeast-2)
+	AWSAccessKeyID     string        // IAM 액세스 키
+	AWSSecretAccessKey string        // IAM 시크릿 키
+	AWSSecretID        string        // 비밀 이름 또는 ARN
 }
 
-// Load - 환경변수에서 설정 로드
+// SchoolZone - 어린이 보호구역 (중심 좌표 + 반경)
+type SchoolZone struct {
+	Latitude  float64
+	Longitude float64
+	Radius    float64 // 미터
+}
+
+// Load - 환경변수(+ CONFIG_FILE 설정 파일)에서 설정 로드
 func Load() (*Config, error) {
+	fileValues = nil
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		values, err := loadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		fileValues = values
+	}
+	secretValues = nil
+	values, err := loadSecrets()
+	if err != nil {
+		return nil, err
+	}
+	secretValues = values
+
 	config := &Config{
 		Server: ServerConfig{
 			Port:         getEnv("SERVER_PORT", "8080"),
@@ -68,6 +361,13 @@ func Load() (*Config, error) {
 			WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 10*time.Second),
 			IdleTimeout:  getDurationEnv("SERVER_IDLE_TIMEOUT", 60*time.Second),
 		},
+		CORS: CORSConfig{
+			AllowedOrigins: getListEnv("CORS_ALLOWED_ORIGINS"),
+		},
+		GRPC: GRPCConfig{
+			Enabled: getBoolEnv("GRPC_ENABLED", true),
+			Port:    getEnv("GRPC_PORT", "9090"),
+		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
 			Port:            getEnv("DB_PORT", "5432"),
@@ -79,17 +379,202 @@ func Load() (*Config, error) {
 			MaxIdleConns:    getIntEnv("DB_MAX_IDLE_CONNS", 5),
 			ConnMaxLifetime: getDurationEnv("DB_CONN_MAX_LIFETIME", 5*time.Minute),
 			ConnMaxIdleTime: getDurationEnv("DB_CONN_MAX_IDLE_TIME", 10*time.Minute),
+			AutoMigrate:     getBoolEnv("DB_AUTO_MIGRATE", false),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
 			Port:     getEnv("REDIS_PORT", "6379"),
+			Username: getEnv("REDIS_USERNAME", ""),
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       getIntEnv("REDIS_DB", 0),
+			TLS:      getBoolEnv("REDIS_TLS", false),
 		},
 		Log: LogConfig{
-			Level:  getEnv("LOG_LEVEL", "info"),
-			Format: getEnv("LOG_FORMAT", "text"),
+			Level:        getEnv("LOG_LEVEL", "info"),
+			Format:       getEnv("LOG_FORMAT", "text"),
+			Backend:      getEnv("LOG_BACKEND", "standard"),
+			Outputs:      getListEnv("LOG_OUTPUT"),
+			MaxSize:      getIntEnv("LOG_MAX_SIZE", 100),
+			RotateEvery:  getDurationEnv("LOG_ROTATE_INTERVAL", 24*time.Hour),
+			MaxBackups:   getIntEnv("LOG_MAX_BACKUPS", 7),
+			RedactFields: getListEnv("LOG_REDACT_FIELDS"),
+		},
+		Auth: AuthConfig{
+			JWTSecret:        getEnv("JWT_SECRET", ""),
+			AccessTokenTTL:   getDurationEnv("JWT_ACCESS_TOKEN_TTL", time.Hour),
+			PasswordResetTTL: getDurationEnv("AUTH_PASSWORD_RESET_TTL", 30*time.Minute),
+			InvitationTTL:    getDurationEnv("AUTH_INVITATION_TTL", 72*time.Hour),
+		},
+		Security: SecurityConfig{
+			FieldEncryptionKey: getEnv("FIELD_ENCRYPTION_KEY", ""),
+		},
+		Retention: RetentionConfig{
+			Enabled:  getBoolEnv("RETENTION_JOB_ENABLED", false),
+			Period:   getDurationEnv("RETENTION_PERIOD", 3*365*24*time.Hour),
+			Interval: getDurationEnv("RETENTION_JOB_INTERVAL", 24*time.Hour),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:   getBoolEnv("RATE_LIMIT_ENABLED", true),
+			AuthLimit: getIntEnv("RATE_LIMIT_AUTH", 10),
+			APILimit:  getIntEnv("RATE_LIMIT_API", 100),
+			Window:    getDurationEnv("RATE_LIMIT_WINDOW", time.Minute),
+		},
+		Quota: QuotaConfig{
+			Enabled:     getBoolEnv("QUOTA_ENABLED", true),
+			UserDaily:   getIntEnv("QUOTA_USER_DAILY", 20000),
+			APIKeyDaily: getIntEnv("QUOTA_API_KEY_DAILY", 10000),
+		},
+		Idempotency: IdempotencyConfig{
+			Enabled: getBoolEnv("IDEMPOTENCY_ENABLED", true),
+			TTL:     getDurationEnv("IDEMPOTENCY_TTL", 24*time.Hour),
+		},
+		Geofence: GeofenceConfig{
+			Enabled:         getBoolEnv("GEOFENCE_ENABLED", true),
+			ApproachRadius:  getIntEnv("GEOFENCE_APPROACH_RADIUS", 300),
+			ArrivalRadius:   getIntEnv("GEOFENCE_ARRIVAL_RADIUS", 50),
+			DepartureRadius: getIntEnv("GEOFENCE_DEPARTURE_RADIUS", 80),
+		},
+		ETA: ETAConfig{
+			AverageSpeed: getIntEnv("ETA_AVERAGE_SPEED", 20),
+			StopDwell:    getDurationEnv("ETA_STOP_DWELL", 30*time.Second),
+			CacheTTL:     getDurationEnv("ETA_CACHE_TTL", 30*time.Second),
+		},
+		Alert: AlertConfig{
+			Enabled:              getBoolEnv("ALERT_ENABLED", true),
+			SpeedLimit:           getIntEnv("ALERT_SPEED_LIMIT", 80),
+			SchoolZoneSpeedLimit: getIntEnv("ALERT_SCHOOL_ZONE_SPEED_LIMIT", 30),
+			HarshAcceleration:    getIntEnv("ALERT_HARSH_ACCELERATION", 11),
+			HarshBraking:         getIntEnv("ALERT_HARSH_BRAKING", 8),
+			Cooldown:             getDurationEnv("ALERT_COOLDOWN", time.Minute),
+			NotifyAdmin:          getBoolEnv("ALERT_NOTIFY_ADMIN", false),
 		},
+		Signal: SignalConfig{
+			Enabled:       getBoolEnv("SIGNAL_WATCH_ENABLED", true),
+			Timeout:       getDurationEnv("SIGNAL_LOST_TIMEOUT", 3*time.Minute),
+			CheckInterval: getDurationEnv("SIGNAL_CHECK_INTERVAL", 30*time.Second),
+		},
+		Delay: DelayConfig{
+			Enabled:       getBoolEnv("DELAY_WATCH_ENABLED", true),
+			Threshold:     getDurationEnv("DELAY_THRESHOLD", 10*time.Minute),
+			CheckInterval: getDurationEnv("DELAY_CHECK_INTERVAL", time.Minute),
+		},
+		Expiry: ExpiryConfig{
+			Enabled:  getBoolEnv("EXPIRY_REMINDER_ENABLED", true),
+			Interval: getDurationEnv("EXPIRY_REMINDER_INTERVAL", 24*time.Hour),
+		},
+		Holiday: HolidayConfig{
+			APIKey:       getEnv("HOLIDAY_API_KEY", ""),
+			SyncInterval: getDurationEnv("HOLIDAY_SYNC_INTERVAL", 24*time.Hour),
+			Timeout:      getDurationEnv("HOLIDAY_TIMEOUT", 10*time.Second),
+		},
+		Maps: MapsConfig{
+			Provider: getEnv("MAPS_PROVIDER", ""),
+			APIKey:   getEnv("MAPS_API_KEY", ""),
+			ClientID: getEnv("MAPS_CLIENT_ID", ""),
+			Timeout:  getDurationEnv("MAPS_TIMEOUT", 5*time.Second),
+			CacheTTL: getDurationEnv("MAPS_CACHE_TTL", 24*time.Hour),
+		},
+		Push: PushConfig{
+			FCMCredentialsFile: getEnv("FCM_CREDENTIALS_FILE", ""),
+			Timeout:            getDurationEnv("PUSH_TIMEOUT", 5*time.Second),
+		},
+		SMS: SMSConfig{
+			Provider:     getEnv("SMS_PROVIDER", ""),
+			APIKey:       getEnv("SMS_API_KEY", ""),
+			AccountID:    getEnv("SMS_ACCOUNT_ID", ""),
+			SenderNumber: getEnv("SMS_SENDER_NUMBER", ""),
+			AdminNumbers: getListEnv("SMS_ADMIN_NUMBERS"),
+			Timeout:      getDurationEnv("SMS_TIMEOUT", 5*time.Second),
+		},
+		Alimtalk: AlimtalkConfig{
+			Provider:      getEnv("ALIMTALK_PROVIDER", ""),
+			APIKey:        getEnv("ALIMTALK_API_KEY", ""),
+			AccountID:     getEnv("ALIMTALK_ACCOUNT_ID", ""),
+			SenderKey:     getEnv("ALIMTALK_SENDER_KEY", ""),
+			SenderNumber:  getEnv("ALIMTALK_SENDER_NUMBER", ""),
+			TemplatesFile: getEnv("ALIMTALK_TEMPLATES_FILE", ""),
+			Timeout:       getDurationEnv("ALIMTALK_TIMEOUT", 5*time.Second),
+		},
+		Email: EmailConfig{
+			Provider:           getEnv("EMAIL_PROVIDER", ""),
+			From:               getEnv("EMAIL_FROM", ""),
+			SMTPHost:           getEnv("SMTP_HOST", ""),
+			SMTPPort:           getEnv("SMTP_PORT", "587"),
+			SMTPUsername:       getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:       getEnv("SMTP_PASSWORD", ""),
+			SESRegion:          getEnv("SES_REGION", "ap-northeast-2"),
+			SESAccessKeyID:     getEnv("SES_ACCESS_KEY_ID", ""),
+			SESSecretAccessKey: getEnv("SES_SECRET_ACCESS_KEY", ""),
+			AdminRecipients:    getListEnv("EMAIL_ADMIN_RECIPIENTS"),
+			Timeout:            getDurationEnv("EMAIL_TIMEOUT", 10*time.Second),
+		},
+		Storage: StorageConfig{
+			Provider:          getEnv("STORAGE_PROVIDER", "local"),
+			LocalDir:          getEnv("STORAGE_LOCAL_DIR", "./data/uploads"),
+			S3Bucket:          getEnv("S3_BUCKET", ""),
+			S3Region:          getEnv("S3_REGION", "ap-northeast-2"),
+			S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+			S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+			S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+			Timeout:           getDurationEnv("STORAGE_TIMEOUT", 30*time.Second),
+		},
+		Delivery: DeliveryConfig{
+			RetryEnabled:  getBoolEnv("NOTIFICATION_RETRY_ENABLED", true),
+			MaxAttempts:   getIntEnv("NOTIFICATION_MAX_ATTEMPTS", 5),
+			RetryBackoff:  getDurationEnv("NOTIFICATION_RETRY_BACKOFF", time.Minute),
+			MaxBackoff:    getDurationEnv("NOTIFICATION_RETRY_MAX_BACKOFF", 30*time.Minute),
+			RetryInterval: getDurationEnv("NOTIFICATION_RETRY_INTERVAL", 30*time.Second),
+		},
+		Webhook: WebhookConfig{
+			Timeout:       getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:   getIntEnv("WEBHOOK_MAX_ATTEMPTS", 8),
+			RetryBackoff:  getDurationEnv("WEBHOOK_RETRY_BACKOFF", time.Minute),
+			MaxBackoff:    getDurationEnv("WEBHOOK_RETRY_MAX_BACKOFF", time.Hour),
+			RetryInterval: getDurationEnv("WEBHOOK_RETRY_INTERVAL", 30*time.Second),
+		},
+		Outbox: OutboxConfig{
+			RelayInterval: getDurationEnv("OUTBOX_RELAY_INTERVAL", time.Second),
+			MaxAttempts:   getIntEnv("OUTBOX_MAX_ATTEMPTS", 10),
+			RetryBackoff:  getDurationEnv("OUTBOX_RETRY_BACKOFF", 10*time.Second),
+			MaxBackoff:    getDurationEnv("OUTBOX_RETRY_MAX_BACKOFF", 10*time.Minute),
+		},
+		Startup: StartupConfig{
+			Timeout:    getDurationEnv("STARTUP_TIMEOUT", 2*time.Minute),
+			Backoff:    getDurationEnv("STARTUP_RETRY_BACKOFF", time.Second),
+			MaxBackoff: getDurationEnv("STARTUP_RETRY_MAX_BACKOFF", 15*time.Second),
+		},
+		Secrets: loadSecretsConfig(),
+		Tracing: TracingConfig{
+			Enabled:     getBoolEnv("TRACING_ENABLED", false),
+			Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+			Insecure:    getBoolEnv("OTEL_EXPORTER_OTLP_INSECURE", false),
+			ServiceName: getEnv("OTEL_SERVICE_NAME", "eodini-api"),
+			SampleRatio: getFloatEnv("TRACING_SAMPLE_RATIO", 1.0),
+		},
+	}
+
+	zones, err := parseSchoolZones(lookupEnv("ALERT_SCHOOL_ZONES"))
+	if err != nil {
+		return nil, err
+	}
+	config.Alert.SchoolZones = zones
+
+	days, err := parseReminderDays(lookupEnv("EXPIRY_REMINDER_DAYS"), []int{30, 14, 3})
+	if err != nil {
+		return nil, err
+	}
+	config.Expiry.Days = days
+
+	// 연결 문자열이 있으면 개별 변수보다 우선
+	if raw := lookupEnv("DATABASE_URL"); raw != "" {
+		if err := applyDatabaseURL(&config.Database, raw); err != nil {
+			return nil, err
+		}
+	}
+	if raw := lookupEnv("REDIS_URL"); raw != "" {
+		if err := applyRedisURL(&config.Redis, raw); err != nil {
+			return nil, err
+		}
 	}
 
 	// 설정 검증
@@ -100,12 +585,36 @@ func Load() (*Config, error) {
 	return config, nil
 }
 
+// loadSecretsConfig - 비밀 저장소 접속 정보 (환경변수/설정 파일)
+func loadSecretsConfig() SecretsConfig {
+	return SecretsConfig{
+		Provider:           getEnv("SECRETS_PROVIDER", ""),
+		Timeout:            getDurationEnv("SECRETS_TIMEOUT", 10*time.Second),
+		VaultAddr:          getEnv("VAULT_ADDR", ""),
+		VaultToken:         getEnv("VAULT_TOKEN", ""),
+		VaultNamespace:     getEnv("VAULT_NAMESPACE", ""),
+		VaultPath:          getEnv("VAULT_SECRET_PATH", ""),
+		AWSRegion:          getEnv("AWS_REGION", ""),
+		AWSAccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
+		AWSSecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
+		AWSSecretID:        getEnv("AWS_SM_SECRET_ID", ""),
+	}
+}
+
 // Validate - 설정 검증
 func (c *Config) Validate() error {
 	// 필수 값 검증
 	if c.Server.Port == "" {
 		return fmt.Errorf("SERVER_PORT is required")
 	}
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" && len(c.CORS.AllowedOrigins) > 1 {
+			return fmt.Errorf("CORS_ALLOWED_ORIGINS must not mix * with specific origins")
+		}
+	}
+	if c.GRPC.Enabled && (c.GRPC.Port == "" || c.GRPC.Port == c.Server.Port) {
+		return fmt.Errorf("GRPC_PORT is required and must differ from SERVER_PORT when GRPC_ENABLED=true")
+	}
 
 	if c.Database.User == "" {
 		return fmt.Errorf("DB_USER is required")
@@ -126,20 +635,247 @@ func (c *Config) Validate() error {
 	if !validLogLevels[c.Log.Level] {
 		return fmt.Errorf("invalid LOG_LEVEL: %s (must be debug, info, warn, or error)", c.Log.Level)
 	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		return fmt.Errorf("invalid LOG_FORMAT: %s (must be json or text)", c.Log.Format)
+	}
+	validLogBackends := map[string]bool{"standard": true, "slog": true, "zap": true}
+	if !validLogBackends[c.Log.Backend] {
+		return fmt.Errorf("invalid LOG_BACKEND: %s (must be standard, slog, or zap)", c.Log.Backend)
+	}
+	if c.Log.MaxSize < 0 || c.Log.RotateEvery < 0 || c.Log.MaxBackups < 0 {
+		return fmt.Errorf("LOG_MAX_SIZE, LOG_ROTATE_INTERVAL and LOG_MAX_BACKUPS must not be negative")
+	}
+
+	// 토큰 서명 키 검증 (개발 환경은 기본 키 허용)
+	if c.Auth.JWTSecret == "" {
+		if c.Server.Environment != "dev" {
+			return fmt.Errorf("JWT_SECRET is required in %s", c.Server.Environment)
+		}
+		c.Auth.JWTSecret = devJWTSecret
+	}
+
+	// 컬럼 암호화 키 검증 (개발 환경은 기본 키 허용)
+	if c.Security.FieldEncryptionKey == "" {
+		if c.Server.Environment != "dev" {
+			return fmt.Errorf("FIELD_ENCRYPTION_KEY is required in %s", c.Server.Environment)
+		}
+		c.Security.FieldEncryptionKey = devFieldEncryptionKey
+	}
+	if key, err := base64.StdEncoding.DecodeString(c.Security.FieldEncryptionKey); err != nil || len(key) != 32 {
+		return fmt.Errorf("FIELD_ENCRYPTION_KEY must be a base64-encoded 32-byte key")
+	}
+
+	// 보존 기간 검증
+	if c.Retention.Period <= 0 {
+		return fmt.Errorf("RETENTION_PERIOD must be positive")
+	}
+	if c.Retention.Interval <= 0 {
+		return fmt.Errorf("RETENTION_JOB_INTERVAL must be positive")
+	}
+
+	// 요청 제한 검증
+	if c.RateLimit.Enabled && c.RateLimit.Window <= 0 {
+		return fmt.Errorf("RATE_LIMIT_WINDOW must be positive")
+	}
+	if c.Quota.UserDaily < 0 || c.Quota.APIKeyDaily < 0 {
+		return fmt.Errorf("QUOTA_USER_DAILY and QUOTA_API_KEY_DAILY must not be negative")
+	}
+	if c.Idempotency.Enabled && c.Idempotency.TTL <= 0 {
+		return fmt.Errorf("IDEMPOTENCY_TTL must be positive")
+	}
+
+	// 지오펜스 반경 검증 (도착 < 출발 <= 접근)
+	if c.Geofence.Enabled {
+		g := c.Geofence
+		if g.ArrivalRadius <= 0 || g.DepartureRadius <= g.ArrivalRadius || g.ApproachRadius < g.DepartureRadius {
+			return fmt.Errorf("GEOFENCE radii must satisfy 0 < GEOFENCE_ARRIVAL_RADIUS < GEOFENCE_DEPARTURE_RADIUS <= GEOFENCE_APPROACH_RADIUS")
+		}
+	}
+
+	// 도착 예정 시간 검증
+	if c.ETA.AverageSpeed <= 0 {
+		return fmt.Errorf("ETA_AVERAGE_SPEED must be positive")
+	}
+	if c.ETA.StopDwell < 0 || c.ETA.CacheTTL <= 0 {
+		return fmt.Errorf("ETA_STOP_DWELL must not be negative and ETA_CACHE_TTL must be positive")
+	}
+
+	// 경보 기준 검증
+	a := c.Alert
+	if a.SpeedLimit < 0 || a.SchoolZoneSpeedLimit < 0 || a.HarshAcceleration < 0 || a.HarshBraking < 0 || a.Cooldown < 0 {
+		return fmt.Errorf("ALERT_* thresholds must not be negative")
+	}
+
+	// 위치 수신 끊김 감지 검증
+	if c.Signal.Enabled && (c.Signal.Timeout <= 0 || c.Signal.CheckInterval <= 0) {
+		return fmt.Errorf("SIGNAL_LOST_TIMEOUT and SIGNAL_CHECK_INTERVAL must be positive")
+	}
+
+	// 출발 지연 감지 검증
+	if c.Delay.Enabled && (c.Delay.Threshold <= 0 || c.Delay.CheckInterval <= 0) {
+		return fmt.Errorf("DELAY_THRESHOLD and DELAY_CHECK_INTERVAL must be positive")
+	}
+
+	// 만료 안내 검증
+	if c.Expiry.Enabled && (c.Expiry.Interval <= 0 || len(c.Expiry.Days) == 0) {
+		return fmt.Errorf("EXPIRY_REMINDER_INTERVAL must be positive and EXPIRY_REMINDER_DAYS must not be empty")
+	}
+
+	// 공휴일 동기화 검증
+	if c.Holiday.APIKey != "" && (c.Holiday.SyncInterval <= 0 || c.Holiday.Timeout <= 0) {
+		return fmt.Errorf("HOLIDAY_SYNC_INTERVAL and HOLIDAY_TIMEOUT must be positive")
+	}
+
+	// 지도 API 검증
+	switch c.Maps.Provider {
+	case "":
+	case "kakao", "google":
+		if c.Maps.APIKey == "" {
+			return fmt.Errorf("MAPS_API_KEY is required for MAPS_PROVIDER=%s", c.Maps.Provider)
+		}
+	case "naver":
+		if c.Maps.APIKey == "" || c.Maps.ClientID == "" {
+			return fmt.Errorf("MAPS_CLIENT_ID and MAPS_API_KEY are required for MAPS_PROVIDER=naver")
+		}
+	default:
+		return fmt.Errorf("MAPS_PROVIDER must be one of kakao, naver, google")
+	}
+	if c.Maps.Provider != "" && (c.Maps.Timeout <= 0 || c.Maps.CacheTTL <= 0) {
+		return fmt.Errorf("MAPS_TIMEOUT and MAPS_CACHE_TTL must be positive")
+	}
+
+	// 푸시 알림 검증
+	if c.Push.FCMCredentialsFile != "" && c.Push.Timeout <= 0 {
+		return fmt.Errorf("PUSH_TIMEOUT must be positive")
+	}
+
+	// 문자 발송 검증
+	switch c.SMS.Provider {
+	case "":
+	case "aligo", "nhn":
+		if c.SMS.APIKey == "" || c.SMS.AccountID == "" || c.SMS.SenderNumber == "" {
+			return fmt.Errorf("SMS_API_KEY, SMS_ACCOUNT_ID and SMS_SENDER_NUMBER are required for SMS_PROVIDER=%s", c.SMS.Provider)
+		}
+		if c.SMS.Timeout <= 0 {
+			return fmt.Errorf("SMS_TIMEOUT must be positive")
+		}
+	default:
+		return fmt.Errorf("SMS_PROVIDER must be one of aligo, nhn")
+	}
+
+	// 알림톡 발송 검증
+	switch c.Alimtalk.Provider {
+	case "":
+	case "aligo", "nhn":
+		if c.Alimtalk.APIKey == "" || c.Alimtalk.AccountID == "" || c.Alimtalk.SenderKey == "" || c.Alimtalk.TemplatesFile == "" {
+			return fmt.Errorf("ALIMTALK_API_KEY, ALIMTALK_ACCOUNT_ID, ALIMTALK_SENDER_KEY and ALIMTALK_TEMPLATES_FILE are required for ALIMTALK_PROVIDER=%s", c.Alimtalk.Provider)
+		}
+		if c.Alimtalk.Provider == "aligo" && c.Alimtalk.SenderNumber == "" {
+			return fmt.Errorf("ALIMTALK_SENDER_NUMBER is required for ALIMTALK_PROVIDER=aligo")
+		}
+		if c.Alimtalk.Timeout <= 0 {
+			return fmt.Errorf("ALIMTALK_TIMEOUT must be positive")
+		}
+	default:
+		return fmt.Errorf("ALIMTALK_PROVIDER must be one of aligo, nhn")
+	}
+
+	// 이메일 발송 검증
+	switch c.Email.Provider {
+	case "":
+	case "smtp":
+		if c.Email.SMTPHost == "" || c.Email.SMTPPort == "" {
+			return fmt.Errorf("SMTP_HOST and SMTP_PORT are required for EMAIL_PROVIDER=smtp")
+		}
+	case "ses":
+		if c.Email.SESRegion == "" || c.Email.SESAccessKeyID == "" || c.Email.SESSecretAccessKey == "" {
+			return fmt.Errorf("SES_REGION, SES_ACCESS_KEY_ID and SES_SECRET_ACCESS_KEY are required for EMAIL_PROVIDER=ses")
+		}
+	default:
+		return fmt.Errorf("EMAIL_PROVIDER must be one of smtp, ses")
+	}
+	if c.Email.Provider != "" {
+		if c.Email.From == "" {
+			return fmt.Errorf("EMAIL_FROM is required when EMAIL_PROVIDER is set")
+		}
+		if c.Email.Timeout <= 0 {
+			return fmt.Errorf("EMAIL_TIMEOUT must be positive")
+		}
+	}
+
+	// 파일 저장소 검증
+	switch c.Storage.Provider {
+	case "":
+	case "local":
+		if c.Storage.LocalDir == "" {
+			return fmt.Errorf("STORAGE_LOCAL_DIR is required for STORAGE_PROVIDER=local")
+		}
+	case "s3":
+		if c.Storage.S3Bucket == "" || c.Storage.S3Region == "" || c.Storage.S3AccessKeyID == "" || c.Storage.S3SecretAccessKey == "" {
+			return fmt.Errorf("S3_BUCKET, S3_REGION, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY are required for STORAGE_PROVIDER=s3")
+		}
+		if c.Storage.Timeout <= 0 {
+			return fmt.Errorf("STORAGE_TIMEOUT must be positive")
+		}
+	default:
+		return fmt.Errorf("STORAGE_PROVIDER must be one of local, s3")
+	}
+
+	// 알림 재시도 검증
+	if c.Delivery.RetryEnabled {
+		if c.Delivery.MaxAttempts < 1 {
+			return fmt.Errorf("NOTIFICATION_MAX_ATTEMPTS must be at least 1")
+		}
+		if c.Delivery.RetryBackoff <= 0 || c.Delivery.MaxBackoff < c.Delivery.RetryBackoff || c.Delivery.RetryInterval <= 0 {
+			return fmt.Errorf("NOTIFICATION_RETRY_BACKOFF and NOTIFICATION_RETRY_INTERVAL must be positive and NOTIFICATION_RETRY_MAX_BACKOFF must not be smaller than the backoff")
+		}
+	}
+
+	// 웹훅 전달 검증
+	if c.Webhook.Timeout <= 0 {
+		return fmt.Errorf("WEBHOOK_TIMEOUT must be positive")
+	}
+	if c.Webhook.MaxAttempts < 1 {
+		return fmt.Errorf("WEBHOOK_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.Webhook.RetryBackoff <= 0 || c.Webhook.MaxBackoff < c.Webhook.RetryBackoff || c.Webhook.RetryInterval <= 0 {
+		return fmt.Errorf("WEBHOOK_RETRY_BACKOFF and WEBHOOK_RETRY_INTERVAL must be positive and WEBHOOK_RETRY_MAX_BACKOFF must not be smaller than the backoff")
+	}
+
+	if c.Outbox.RelayInterval <= 0 {
+		return fmt.Errorf("OUTBOX_RELAY_INTERVAL must be positive")
+	}
+	if c.Outbox.MaxAttempts < 1 {
+		return fmt.Errorf("OUTBOX_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.Outbox.RetryBackoff <= 0 || c.Outbox.MaxBackoff < c.Outbox.RetryBackoff {
+		return fmt.Errorf("OUTBOX_RETRY_BACKOFF must be positive and OUTBOX_RETRY_MAX_BACKOFF must not be smaller than the backoff")
+	}
+
+	if c.Startup.Timeout <= 0 || c.Startup.Backoff <= 0 || c.Startup.MaxBackoff < c.Startup.Backoff {
+		return fmt.Errorf("STARTUP_TIMEOUT and STARTUP_RETRY_BACKOFF must be positive and STARTUP_RETRY_MAX_BACKOFF must not be smaller than the backoff")
+	}
+
+	if c.Tracing.Enabled && c.Tracing.Endpoint == "" {
+		return fmt.Errorf("OTEL_EXPORTER_OTLP_ENDPOINT is required when TRACING_ENABLED=true")
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1")
+	}
 
 	return nil
 }
 
-// GetDatabaseDSN - PostgreSQL DSN 생성
+// GetDatabaseDSN - PostgreSQL DSN 생성 (공백/따옴표가 있는 값은 작은따옴표로 감쌈)
 func (c *Config) GetDatabaseDSN() string {
 	return fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		c.Database.Host,
-		c.Database.Port,
-		c.Database.User,
-		c.Database.Password,
-		c.Database.DBName,
-		c.Database.SSLMode,
+		dsnValue(c.Database.Host),
+		dsnValue(c.Database.Port),
+		dsnValue(c.Database.User),
+		dsnValue(c.Database.Password),
+		dsnValue(c.Database.DBName),
+		dsnValue(c.Database.SSLMode),
 	)
 }
 
@@ -160,7 +896,7 @@ func (c *Config) IsProduction() bool {
 
 // getEnv - 환경변수 조회 (기본값 포함)
 func getEnv(key, defaultValue string) string {
-	value := os.Getenv(key)
+	value := lookupEnv(key)
 	if value == "" {
 		return defaultValue
 	}
@@ -169,7 +905,7 @@ func getEnv(key, defaultValue string) string {
 
 // getIntEnv - 정수형 환경변수 조회
 func getIntEnv(key string, defaultValue int) int {
-	valueStr := os.Getenv(key)
+	valueStr := lookupEnv(key)
 	if valueStr == "" {
 		return defaultValue
 	}
@@ -184,7 +920,7 @@ func getIntEnv(key string, defaultValue int) int {
 
 // getDurationEnv - Duration 환경변수 조회
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
-	valueStr := os.Getenv(key)
+	valueStr := lookupEnv(key)
 	if valueStr == "" {
 		return defaultValue
 	}
@@ -196,3 +932,90 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 
 	return value
 }
+
+// getBoolEnv - bool 환경변수 조회 (true/false, 1/0)
+func getBoolEnv(key string, defaultValue bool) bool {
+	valueStr := lookupEnv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// getFloatEnv - 실수형 환경변수 조회
+func getFloatEnv(key string, defaultValue float64) float64 {
+	valueStr := lookupEnv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// parseSchoolZones - "위도,경도,반경m" 항목을 세미콜론으로 구분한 목록 해석 (빈 값이면 없음)
+func parseSchoolZones(value string) ([]SchoolZone, error) {
+	var zones []SchoolZone
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		parts := strings.Split(item, ",")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("ALERT_SCHOOL_ZONES entry %q must be latitude,longitude,radius", item)
+		}
+		var numbers [3]float64
+		for i, part := range parts {
+			number, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+			if err != nil {
+				return nil, fmt.Errorf("ALERT_SCHOOL_ZONES entry %q must be latitude,longitude,radius", item)
+			}
+			numbers[i] = number
+		}
+		if numbers[2] <= 0 {
+			return nil, fmt.Errorf("ALERT_SCHOOL_ZONES radius must be positive: %q", item)
+		}
+		zones = append(zones, SchoolZone{Latitude: numbers[0], Longitude: numbers[1], Radius: numbers[2]})
+	}
+	return zones, nil
+}
+
+// parseReminderDays - 쉼표로 구분한 안내 일수 목록 해석 (빈 값이면 기본값, 각 값은 1 이상)
+func parseReminderDays(value string, defaultDays []int) ([]int, error) {
+	if strings.TrimSpace(value) == "" {
+		return defaultDays, nil
+	}
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		day, err := strconv.Atoi(item)
+		if err != nil || day <= 0 {
+			return nil, fmt.Errorf("EXPIRY_REMINDER_DAYS entry %q must be a positive number of days", item)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// getListEnv - 쉼표로 구분된 환경변수를 목록으로 (빈 항목 제외)
+func getListEnv(key string) []string {
+	var values []string
+	for _, value := range strings.Split(lookupEnv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
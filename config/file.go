@@ -0,0 +1,104 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// 📝 설명: 설정 파일(YAML/TOML) 지원 (CONFIG_FILE로 경로 지정, 환경변수가 항상 우선)
+// 🎯 실무 포인트: CORS 허용 Origin 목록, 지오펜스 반경처럼 길거나 묶음인 설정을 파일 하나로 관리
+// 파일의 중첩 키는 "_"로 이어 환경변수 이름으로 변환 → Load의 기본값/검증 로직을 그대로 사용
+//
+//	geofence:
+//	  approach_radius: 300      # GEOFENCE_APPROACH_RADIUS=300
+//	cors:
+//	  allowed_origins:          # CORS_ALLOWED_ORIGINS=https://admin.eodini.kr,https://app.eodini.kr
+//	    - https://admin.eodini.kr
+//	    - https://app.eodini.kr
+//
+// ⚠️ 주의사항: 비밀번호/키 등 민감한 값은 파일에 두지 말고 Secret(환경변수)으로 주입
+// 빈 환경변수는 설정되지 않은 것으로 보고 파일 값을 사용
+
+// fileValues - 설정 파일에서 읽은 값 (환경변수 이름 → 값, Load마다 다시 읽음)
+var fileValues map[string]string
+
+// loadFile - 설정 파일을 읽어 환경변수 이름 → 값으로 변환 (확장자로 형식 판단: .yaml/.yml/.toml)
+func loadFile(path string) (map[string]string, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("CONFIG_FILE: failed to read %s: %w", path, err)
+	}
+
+	var tree map[string]interface{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(raw, &tree)
+	case ".toml":
+		err = toml.Unmarshal(raw, &tree)
+	default:
+		return nil, fmt.Errorf("CONFIG_FILE must be a .yaml, .yml or .toml file: %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CONFIG_FILE: failed to parse %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	if err := flattenFile("", tree, values); err != nil {
+		return nil, fmt.Errorf("CONFIG_FILE: %w", err)
+	}
+	return values, nil
+}
+
+// flattenFile - 중첩 키를 "_"로 이어 대문자 환경변수 이름으로 (목록은 쉼표로 연결)
+func flattenFile(prefix string, tree map[string]interface{}, values map[string]string) error {
+	keys := make([]string, 0, len(tree))
+	for key := range tree {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name := strings.ToUpper(key)
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		switch value := tree[key].(type) {
+		case map[string]interface{}:
+			if err := flattenFile(name, value, values); err != nil {
+				return err
+			}
+		case []interface{}:
+			items := make([]string, 0, len(value))
+			for _, item := range value {
+				if _, ok := item.(map[string]interface{}); ok {
+					return fmt.Errorf("%s must be a list of plain values", name)
+				}
+				items = append(items, fmt.Sprint(item))
+			}
+			values[name] = strings.Join(items, ",")
+		case nil:
+			// 빈 값은 설정하지 않은 것으로 처리 (기본값 사용)
+		default:
+			values[name] = fmt.Sprint(value)
+		}
+	}
+	return nil
+}
+
+// lookupEnv - 환경변수 조회, 비어 있으면 비밀 저장소 → 설정 파일 값
+func lookupEnv(key string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	if value := secretValues[key]; value != "" {
+		return value
+	}
+	return fileValues[key]
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyeokjun/eodini/pkg/secrets"
+)
+
+// 📝 설명: 비밀 저장소(Vault/AWS Secrets Manager)에서 비밀 값 조회 (SECRETS_PROVIDER로 선택)
+// 🎯 실무 포인트: DB_PASSWORD, JWT_SECRET, 외부 API 키 등을 환경변수 이름 그대로 비밀 하나에 저장
+// 조회 순서: 환경변수 → 비밀 저장소 → 설정 파일 (로컬에서는 환경변수로 덮어쓰기 가능)
+// ⚠️ 주의사항: 저장소 접속 정보(VAULT_*, AWS_*)는 환경변수 또는 설정 파일로만 지정
+// 조회 실패 시 Load가 실패 → 비밀 없이 기본값으로 뜨는 일이 없도록
+
+// secretValues - 비밀 저장소에서 조회한 값 (환경변수 이름 → 값, Load마다 다시 조회)
+var secretValues map[string]string
+
+// loadSecrets - 비밀 저장소 설정을 읽어 값 조회 (SECRETS_PROVIDER가 비어 있으면 nil)
+func loadSecrets() (map[string]string, error) {
+	cfg := loadSecretsConfig()
+	if cfg.Provider == "" {
+		return nil, nil
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	provider, err := secrets.New(secrets.Config{
+		Provider:           cfg.Provider,
+		Timeout:            cfg.Timeout,
+		VaultAddr:          cfg.VaultAddr,
+		VaultToken:         cfg.VaultToken,
+		VaultNamespace:     cfg.VaultNamespace,
+		VaultPath:          cfg.VaultPath,
+		AWSRegion:          cfg.AWSRegion,
+		AWSAccessKeyID:     cfg.AWSAccessKeyID,
+		AWSSecretAccessKey: cfg.AWSSecretAccessKey,
+		AWSSecretID:        cfg.AWSSecretID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+	values, err := provider.Fetch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("SECRETS_PROVIDER=%s: failed to fetch secrets: %w", cfg.Provider, err)
+	}
+	return values, nil
+}
+
+// validate - 저장소별 필수 접속 정보 확인
+func (s SecretsConfig) validate() error {
+	if s.Timeout <= 0 {
+		return fmt.Errorf("SECRETS_TIMEOUT must be positive")
+	}
+	switch s.Provider {
+	case secrets.VaultProviderName:
+		if s.VaultAddr == "" || s.VaultToken == "" || s.VaultPath == "" {
+			return fmt.Errorf("VAULT_ADDR, VAULT_TOKEN and VAULT_SECRET_PATH are required for SECRETS_PROVIDER=vault")
+		}
+	case secrets.AWSProviderName:
+		if s.AWSRegion == "" || s.AWSAccessKeyID == "" || s.AWSSecretAccessKey == "" || s.AWSSecretID == "" {
+			return fmt.Errorf("AWS_REGION, AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SM_SECRET_ID are required for SECRETS_PROVIDER=aws-sm")
+		}
+	default:
+		return fmt.Errorf("SECRETS_PROVIDER must be one of: vault, aws-sm")
+	}
+	return nil
+}
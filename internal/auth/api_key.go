@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// 📝 설명: API 키 원문 생성과 해시
+// 🎯 실무 포인트: 256비트 난수 키라 bcrypt 없이 SHA-256 해시로 충분 (조회도 해시로 인덱스 검색)
+// ⚠️ 주의사항: 원문 키는 로그에 남기지 않음
+
+// apiKeyPrefix - 키 원문 접두어 (유출 스캐너가 식별하기 쉽도록)
+const apiKeyPrefix = "eod_"
+
+// apiKeyDisplayLength - 식별용으로 저장하는 앞자리 길이
+const apiKeyDisplayLength = 8
+
+// GenerateAPIKey - 새 API 키 원문, 식별용 앞자리, 해시 생성
+func GenerateAPIKey() (raw, prefix, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+
+	raw = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return raw, raw[:apiKeyDisplayLength], HashToken(raw), nil
+}
+
+// HashToken - 고엔트로피 토큰(API 키, 재설정 토큰, 캘린더 구독 토큰, 초대 토큰) 원문 해시 (SHA-256 hex)
+func HashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateCalendarToken - 캘린더 구독 주소용 토큰 원문과 해시 생성 (원문은 URL 경로에 그대로 사용)
+func GenerateCalendarToken() (raw, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	raw = base64.RawURLEncoding.EncodeToString(buf)
+	return raw, HashToken(raw), nil
+}
+
+// GenerateInvitationToken - 기관 초대 토큰 원문과 해시 생성 (원문은 초대 메일/문자로만 전달)
+func GenerateInvitationToken() (raw, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	raw = base64.RawURLEncoding.EncodeToString(buf)
+	return raw, HashToken(raw), nil
+}
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 📝 설명: 비밀번호 해시와 재설정 토큰 생성
+// 🎯 실무 포인트: bcrypt(cost 기본값)로 해시, 재설정 토큰은 API 키와 같이 SHA-256 해시로 저장
+// ⚠️ 주의사항: bcrypt는 72바이트까지만 사용하므로 DTO에서 최대 길이 제한
+
+// HashPassword - 비밀번호 해시 생성
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword - 비밀번호 일치 여부
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// GenerateResetToken - 비밀번호 재설정 토큰 원문과 해시 생성
+func GenerateResetToken() (raw, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	raw = base64.RawURLEncoding.EncodeToString(buf)
+	return raw, HashToken(raw), nil
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: 인증된 요청 주체 (Spring Security의 Authentication 역할)
+// 🎯 실무 포인트: 미들웨어가 context에 저장 → Service에서 auth.FromContext로 조회
+// ⚠️ 주의사항: ProfileID는 역할별 엔티티 ID (기사면 Driver.ID, 동승자면 Attendant.ID)
+// OrganizationID는 소속 기관 → Repository가 이 기관의 데이터만 조회/변경
+// API 키로 인증된 경우 Role은 RoleIntegration, UserID는 API 키 ID
+// 플랫폼 운영자(RoleSuperAdmin)는 관리자 권한을 포함, 요청에서 기관을 지정하지 않으면 OrganizationID가 빈 값(전체 기관)
+
+// Principal - 인증된 사용자 정보
+type Principal struct {
+	UserID         string               `json:"user_id"`
+	OrganizationID string               `json:"organization_id"` // 소속 기관
+	Role           domain.Role          `json:"role"`
+	ProfileID      string               `json:"profile_id,omitempty"`
+	Scopes         []domain.APIKeyScope `json:"scopes,omitempty"`     // API 키 권한 범위
+	SessionID      string               `json:"session_id,omitempty"` // 토큰 jti (세션 폐기 단위)
+	Locale         string               `json:"locale,omitempty"`     // 응답 언어 설정 (비어 있으면 Accept-Language)
+
+	DailyQuota int `json:"-"` // API 키별 일일 요청 한도 (0이면 기본 한도, 토큰에는 포함하지 않음)
+}
+
+// HasRole - 주어진 역할 중 하나라도 해당하는지 확인 (플랫폼 운영자는 관리자 역할로도 인정)
+func (p *Principal) HasRole(roles ...domain.Role) bool {
+	for _, role := range roles {
+		if p.Role == role || (role == domain.RoleAdmin && p.IsSuperAdmin()) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAPIKey - API 키로 인증된 외부 시스템 여부
+func (p *Principal) IsAPIKey() bool {
+	return p.Role == domain.RoleIntegration
+}
+
+// HasScope - API 키 권한 범위 보유 여부
+func (p *Principal) HasScope(scope domain.APIKeyScope) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAdmin - 관리자 여부 (플랫폼 운영자 포함)
+func (p *Principal) IsAdmin() bool {
+	return p.Role == domain.RoleAdmin || p.IsSuperAdmin()
+}
+
+// IsSuperAdmin - 플랫폼 운영자 여부
+func (p *Principal) IsSuperAdmin() bool {
+	return p.Role == domain.RoleSuperAdmin
+}
+
+type principalKey struct{}
+
+// WithPrincipal - context에 인증 주체 저장
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext - context에서 인증 주체 조회
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: JWT 액세스 토큰 발급/검증
+// 🎯 실무 포인트: HS256 서명, sub=사용자 ID, jti=세션 ID, role/profile_id/org_id는 커스텀 클레임
+// ⚠️ 주의사항: 서명 키는 JWT_SECRET 환경변수로 주입 (prod 필수)
+
+// ErrInvalidToken - 서명/만료/형식이 올바르지 않은 토큰
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims - 토큰 클레임
+type Claims struct {
+	Role           domain.Role `json:"role"`
+	ProfileID      string      `json:"profile_id,omitempty"`
+	OrganizationID string      `json:"org_id,omitempty"` // 소속 기관 (없으면 인증 미들웨어가 거부)
+	Locale         string      `json:"locale,omitempty"` // 사용자 언어 설정
+	jwt.RegisteredClaims
+}
+
+// TokenManager - 토큰 발급/검증기
+type TokenManager struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewTokenManager - 토큰 관리자 생성
+func NewTokenManager(secret string, ttl time.Duration) *TokenManager {
+	return &TokenManager{secret: []byte(secret), ttl: ttl}
+}
+
+// TTL - 액세스 토큰 유효 기간
+func (m *TokenManager) TTL() time.Duration {
+	return m.ttl
+}
+
+// Issue - 액세스 토큰 발급 (SessionID가 비어 있으면 새 jti 생성)
+func (m *TokenManager) Issue(p *Principal) (string, error) {
+	if !p.Role.IsValid() {
+		return "", fmt.Errorf("invalid role: %s", p.Role)
+	}
+
+	sessionID := p.SessionID
+	if sessionID == "" {
+		sessionID = uuid.New().String()
+	}
+
+	now := time.Now()
+	claims := Claims{
+		Role:           p.Role,
+		ProfileID:      p.ProfileID,
+		OrganizationID: p.OrganizationID,
+		Locale:         p.Locale,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
+			Subject:   p.UserID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttl)),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+}
+
+// Parse - 토큰 검증 후 인증 주체 반환
+func (m *TokenManager) Parse(tokenString string) (*Principal, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Subject == "" || !claims.Role.IsValid() {
+		return nil, ErrInvalidToken
+	}
+
+	return &Principal{
+		UserID:         claims.Subject,
+		OrganizationID: claims.OrganizationID,
+		Role:           claims.Role,
+		ProfileID:      claims.ProfileID,
+		SessionID:      claims.ID,
+		Locale:         claims.Locale,
+	}, nil
+}
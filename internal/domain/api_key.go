@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 서버 간 연동용 API 키 (텔레매틱스 게이트웨이 등)
+// 🎯 실무 포인트: 원문 키는 발급 시 한 번만 노출, DB에는 SHA-256 해시만 저장
+// ⚠️ 주의사항: 폐기(revoke)된 키는 삭제하지 않고 이력으로 보존
+
+// APIKeyScope - API 키 권한 범위
+type APIKeyScope string
+
+const (
+	ScopeVehiclesRead   APIKeyScope = "vehicles:read"   // 차량 조회
+	ScopeRoutesRead     APIKeyScope = "routes:read"     // 경로/정류장 조회
+	ScopeSchedulesRead  APIKeyScope = "schedules:read"  // 일정 조회
+	ScopeTripsRead      APIKeyScope = "trips:read"      // 운행 조회
+	ScopeLocationsWrite APIKeyScope = "locations:write" // 차량 위치 전송
+)
+
+// APIKey - API 키 엔티티
+type APIKey struct {
+	ID             string        `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string        `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Name           string        `json:"name" gorm:"not null"`                            // 용도 (예: "1호차 단말기 게이트웨이")
+	Prefix         string        `json:"prefix" gorm:"type:varchar(12);not null"`         // 키 식별용 앞자리 (예: "eod_3fA9")
+	KeyHash        string        `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	Scopes         []APIKeyScope `json:"scopes" gorm:"type:jsonb;serializer:json"`
+	CreatedBy      string        `json:"created_by"` // 발급한 관리자 ID
+
+	DailyQuota int `json:"daily_quota" gorm:"not null;default:0"` // 일일 요청 한도 (0이면 기본 한도 적용)
+
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"` // nil이면 만료 없음
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+
+	// 메타데이터
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewAPIKey - API 키 생성 팩토리 함수
+func NewAPIKey(name, prefix, keyHash string, scopes []APIKeyScope, createdBy string) *APIKey {
+	now := time.Now()
+	return &APIKey{
+		ID:        uuid.New().String(), // UUID 자동 생성
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = uuid.New().String()
+	}
+	now := time.Now()
+	k.CreatedAt = now
+	k.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (k *APIKey) BeforeUpdate(tx *gorm.DB) error {
+	k.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsUsable - 폐기/만료되지 않은 키인지 확인
+func (k *APIKey) IsUsable(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// IsRevoked - 폐기 여부
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Revoke - 키 폐기
+func (k *APIKey) Revoke() {
+	now := time.Now()
+	k.RevokedAt = &now
+	k.UpdatedAt = now
+}
+
+// HasScope - 권한 범위 보유 여부
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,9 @@ package domain
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // 📝 설명: 동승자(선생님/간호사) 도메인 모델
@@ -29,19 +32,20 @@ const (
 
 // Attendant - 동승자 엔티티
 type Attendant struct {
-	ID     string          `json:"id"`
-	Name   string          `json:"name"`
-	Phone  string          `json:"phone"`
-	Email  string          `json:"email,omitempty"`
-	Role   AttendantRole   `json:"role"`   // 역할 (선생님, 간호사 등)
-	Status AttendantStatus `json:"status"` // 상태
+	ID             string          `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string          `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Name           string          `json:"name" gorm:"not null"`
+	Phone          string          `json:"phone" gorm:"not null"`
+	Email          string          `json:"email,omitempty"`
+	Role           AttendantRole   `json:"role" gorm:"type:varchar(20);not null;index"`              // 역할 (선생님, 간호사 등)
+	Status         AttendantStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"` // 상태
 
 	// 권한
-	CanStartTrip bool `json:"can_start_trip"` // 운행 시작 권한
+	CanStartTrip bool `json:"can_start_trip" gorm:"not null;default:false"` // 운행 시작 권한
 
 	// 근무 정보
-	HireDate         time.Time  `json:"hire_date"`                   // 입사일
-	TerminationDate  *time.Time `json:"termination_date,omitempty"`  // 퇴사일
+	HireDate        time.Time  `json:"hire_date"`                  // 입사일
+	TerminationDate *time.Time `json:"termination_date,omitempty"` // 퇴사일
 
 	// 추가 정보
 	Organization     string `json:"organization,omitempty"` // 소속 기관 (유치원명 등)
@@ -52,13 +56,14 @@ type Attendant struct {
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // NewAttendant - 동승자 생성 팩토리 함수
 func NewAttendant(name, phone string, role AttendantRole) *Attendant {
 	now := time.Now()
 	return &Attendant{
+		ID:           uuid.New().String(), // UUID 자동 생성
 		Name:         name,
 		Phone:        phone,
 		Role:         role,
@@ -70,6 +75,23 @@ func NewAttendant(name, phone string, role AttendantRole) *Attendant {
 	}
 }
 
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (a *Attendant) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == "" {
+		a.ID = uuid.New().String()
+	}
+	now := time.Now()
+	a.CreatedAt = now
+	a.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (a *Attendant) BeforeUpdate(tx *gorm.DB) error {
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
 // IsActive - 활동 중인 동승자인지 확인
 func (a *Attendant) IsActive() bool {
 	return a.Status == AttendantStatusActive && a.DeletedAt == nil
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 동승자 대체 배정 (기본 동승자 → 대체 교사/간호사)
+// 🎯 실무 포인트: 휴가/병가 시 다른 동승자가 기간 동안 특정 일정을 대체, 운행 생성 시 기본 동승자 대신 배정
+// ⚠️ 주의사항: 같은 일정에 기간이 겹치는 대체 배정은 허용하지 않음 (Service에서 검증), 그래도 겹치면 가장 나중에 승인된 배정 우선
+// 기사 대체 배정(DriverAssignment)과 같은 구조
+
+// AttendantAssignment - 동승자 대체 배정 엔티티
+type AttendantAssignment struct {
+	ID          string `json:"id" gorm:"type:uuid;primaryKey"`
+	ScheduleID  string `json:"schedule_id" gorm:"type:uuid;not null;index"`  // 대체할 일정
+	AttendantID string `json:"attendant_id" gorm:"type:uuid;not null;index"` // 대체 동승자
+
+	// 대체 기간 (양 끝 포함)
+	StartDate time.Time `json:"start_date" gorm:"type:date;not null"`
+	EndDate   time.Time `json:"end_date" gorm:"type:date;not null"`
+
+	// 대체 사유
+	Reason string `json:"reason"` // "원 담당자 병가", "임시 배정" 등
+
+	// 승인 정보 (선택적)
+	ApprovedBy string     `json:"approved_by,omitempty"`
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+
+	// 메타데이터
+	CreatedBy string     `json:"created_by"` // 생성자 (관리자)
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
+}
+
+// NewAttendantAssignment - 동승자 대체 배정 생성 팩토리 함수 (기간은 날짜 단위)
+func NewAttendantAssignment(scheduleID, attendantID string, startDate, endDate time.Time, reason, createdBy string) *AttendantAssignment {
+	now := time.Now()
+	return &AttendantAssignment{
+		ID:          uuid.New().String(),
+		ScheduleID:  scheduleID,
+		AttendantID: attendantID,
+		StartDate:   dateOnly(startDate),
+		EndDate:     dateOnly(endDate),
+		Reason:      reason,
+		CreatedBy:   createdBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsActiveOnDate - 특정 날짜에 유효한 배정인지 확인
+func (a *AttendantAssignment) IsActiveOnDate(date time.Time) bool {
+	if a.DeletedAt != nil {
+		return false
+	}
+	day := dateOnly(date)
+	return !day.Before(dateOnly(a.StartDate)) && !day.After(dateOnly(a.EndDate))
+}
+
+// Overlaps - 다른 배정과 기간이 겹치는지 확인
+func (a *AttendantAssignment) Overlaps(from, to time.Time) bool {
+	return !dateOnly(a.EndDate).Before(dateOnly(from)) && !dateOnly(to).Before(dateOnly(a.StartDate))
+}
+
+// TakesPrecedenceOver - 같은 날짜에 겹칠 때 우선하는 배정인지 (나중에 승인된 배정 우선, 같으면 나중에 생성된 배정)
+func (a *AttendantAssignment) TakesPrecedenceOver(other *AttendantAssignment) bool {
+	return assignmentPrecedes(a.ApprovedAt, a.CreatedAt, a.ID, other.ApprovedAt, other.CreatedAt, other.ID)
+}
+
+// Approve - 배정 승인
+func (a *AttendantAssignment) Approve(approverID string) {
+	now := time.Now()
+	a.ApprovedBy = approverID
+	a.ApprovedAt = &now
+	a.UpdatedAt = now
+}
+
+// IsApproved - 승인 여부
+func (a *AttendantAssignment) IsApproved() bool {
+	return a.ApprovedAt != nil
+}
+
+// assignmentPrecedes - 대체 배정 우선순위 (승인 > 미승인, 승인 시각 → 생성 시각 → ID 순으로 나중 것 우선)
+func assignmentPrecedes(approvedAt *time.Time, createdAt time.Time, id string, otherApprovedAt *time.Time, otherCreatedAt time.Time, otherID string) bool {
+	if (approvedAt == nil) != (otherApprovedAt == nil) {
+		return approvedAt != nil
+	}
+	if approvedAt != nil && !approvedAt.Equal(*otherApprovedAt) {
+		return approvedAt.After(*otherApprovedAt)
+	}
+	if !createdAt.Equal(otherCreatedAt) {
+		return createdAt.After(otherCreatedAt)
+	}
+	return id > otherID
+}
+
+// dateOnly - 날짜만 남김 (UTC 자정, DATE 컬럼과 같은 기준)
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 감사 로그 (누가 언제 무엇을 어떻게 바꿨는지)
+// 🎯 실무 포인트: 어린이 통학 차량 규정상 변경 이력 추적 필수 → 모든 변경 작업을 자동 기록
+// ⚠️ 주의사항: 한 번 기록된 감사 로그는 수정/삭제하지 않음 (append-only)
+// 예외: 개인정보 익명화 시 과거 값만 가림 (RedactColumns)
+
+// AuditAction - 변경 종류
+type AuditAction string
+
+const (
+	AuditActionCreate  AuditAction = "create"  // 생성
+	AuditActionUpdate  AuditAction = "update"  // 수정
+	AuditActionDelete  AuditAction = "delete"  // 삭제 (Soft delete 포함)
+	AuditActionRestore AuditAction = "restore" // Soft delete 복구
+)
+
+// AuditRedacted - 민감 필드(비밀번호 해시 등)의 값 대신 기록하는 표시
+const AuditRedacted = "[REDACTED]"
+
+// AuditChange - 필드 단위 변경 전/후 값
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditLog - 감사 로그 엔티티
+type AuditLog struct {
+	ID             string                 `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string                 `json:"organization_id,omitempty" gorm:"type:uuid;index;default:null"` // 변경된 엔티티의 기관 (비어 있으면 플랫폼 작업, 운영자만 조회)
+	EntityType     string                 `json:"entity_type" gorm:"type:varchar(50);not null"`                  // 테이블명 (예: "vehicles")
+	EntityID       string                 `json:"entity_id" gorm:"type:varchar(80);not null"`                    // 복합키는 ":"로 연결
+	Action         AuditAction            `json:"action" gorm:"type:varchar(10);not null"`
+	Changes        map[string]AuditChange `json:"changes" gorm:"type:jsonb;serializer:json"` // 컬럼명 → 변경 전/후
+
+	// 행위자 (비어 있으면 시스템 작업: 시드, 배치 등)
+	ActorID   string `json:"actor_id,omitempty"`
+	ActorRole Role   `json:"actor_role,omitempty" gorm:"type:varchar(20)"`
+	RequestID string `json:"request_id,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewAuditLog - 감사 로그 생성 팩토리 함수
+func NewAuditLog(entityType, entityID string, action AuditAction, changes map[string]AuditChange) *AuditLog {
+	return &AuditLog{
+		ID:         uuid.New().String(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    changes,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// RedactColumns - 지정 컬럼의 변경 전/후 값을 AuditRedacted로 대체
+// 반환값: 실제로 가린 값이 있는지 (저장 필요 여부)
+func (l *AuditLog) RedactColumns(columns []string) bool {
+	redacted := false
+	for _, column := range columns {
+		change, ok := l.Changes[column]
+		if !ok {
+			continue
+		}
+		if change.Before != nil && change.Before != AuditRedacted {
+			change.Before = AuditRedacted
+			redacted = true
+		}
+		if change.After != nil && change.After != AuditRedacted {
+			change.After = AuditRedacted
+			redacted = true
+		}
+		l.Changes[column] = change
+	}
+	return redacted
+}
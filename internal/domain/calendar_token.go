@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 운행 일정 캘린더 구독 토큰 (기사/동승자가 휴대폰 캘린더에 등록하는 .ics 주소)
+// 🎯 실무 포인트: 캘린더 앱은 Authorization 헤더를 보낼 수 없으므로 주소 자체가 인증 수단
+// 원문 토큰은 발급 시 한 번만 노출, DB에는 API 키와 같이 SHA-256 해시만 저장
+// ⚠️ 주의사항: 계정당 하나 → 다시 발급하면 이전 주소는 바로 무효
+
+// CalendarToken - 캘린더 구독 토큰
+type CalendarToken struct {
+	ID         string     `json:"id" gorm:"type:uuid;primaryKey"`
+	UserID     string     `json:"user_id" gorm:"type:uuid;uniqueIndex;not null"`
+	TokenHash  string     `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"` // 캘린더 앱이 마지막으로 가져간 시각
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"` // 마지막 발급 시각
+}
+
+// NewCalendarToken - 캘린더 구독 토큰 생성 팩토리 함수
+func NewCalendarToken(userID, tokenHash string) *CalendarToken {
+	now := time.Now()
+	return &CalendarToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 푸시 알림 수신 기기 (앱이 로그인 후 등록하는 FCM 토큰)
+// 🎯 실무 포인트: 한 사용자가 여러 기기를 쓸 수 있으므로 사용자별 토큰 여러 개 보관
+// ⚠️ 주의사항: 토큰은 기기(앱 설치)마다 하나 → 다른 계정으로 다시 로그인하면 소유자를 새 사용자로 옮김
+// FCM이 무효라고 응답한 토큰은 발송 시 자동 삭제
+
+// DevicePlatform - 기기 종류
+type DevicePlatform string
+
+const (
+	PlatformAndroid DevicePlatform = "android"
+	PlatformIOS     DevicePlatform = "ios"
+	PlatformWeb     DevicePlatform = "web"
+)
+
+// DeviceToken - 푸시 수신 기기
+type DeviceToken struct {
+	ID        string         `json:"id" gorm:"type:uuid;primaryKey"`
+	UserID    string         `json:"user_id" gorm:"type:uuid;not null;index"`
+	Token     string         `json:"token" gorm:"type:varchar(512);uniqueIndex;not null"`
+	Platform  DevicePlatform `json:"platform" gorm:"type:varchar(10);not null"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"` // 마지막 등록 시각 (앱 실행 시마다 갱신)
+}
+
+// NewDeviceToken - 기기 토큰 생성 팩토리 함수
+func NewDeviceToken(userID, token string, platform DevicePlatform) *DeviceToken {
+	now := time.Now()
+	return &DeviceToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Token:     token,
+		Platform:  platform,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
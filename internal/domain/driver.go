@@ -2,11 +2,15 @@ package domain
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // 📝 설명: 기사 도메인 모델
-// 🎯 실무 포인트: 면허 만료일 체크, 휴가/퇴사 상태 관리
-// ⚠️ 주의사항: 면허 만료된 기사는 운행 불가
+// 🎯 실무 포인트: 면허 만료일 체크, 면허증 스캔 확인(관리자), 휴가/퇴사 상태 관리
+// ⚠️ 주의사항: 면허가 만료됐거나 관리자가 확인(verified)하지 않은 기사는 운행 배정 불가
+// 면허 정보가 바뀌거나 새 스캔을 올리면 다시 확인 대기(pending)
 
 // DriverStatus - 기사 상태
 type DriverStatus string
@@ -26,50 +30,89 @@ const (
 	LicenseType2Regular LicenseType = "type_2_regular" // 2종 보통
 )
 
+// LicenseStatus - 면허 확인 상태 (관리자가 면허증 스캔/실물을 보고 결정)
+type LicenseStatus string
+
+const (
+	LicenseStatusPending  LicenseStatus = "pending"  // 확인 대기 (신규 등록, 면허 정보 변경, 새 스캔 제출)
+	LicenseStatusVerified LicenseStatus = "verified" // 확인 완료
+	LicenseStatusRejected LicenseStatus = "rejected" // 반려 (사유는 LicenseRejectReason)
+)
+
 // Driver - 기사 엔티티
 type Driver struct {
-	ID     string `json:"id"`
-	Name   string `json:"name"`
-	Phone  string `json:"phone"`
-	Email  string `json:"email,omitempty"`
-	Status DriverStatus `json:"status"`
+	ID             string       `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string       `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Name           string       `json:"name" gorm:"not null"`
+	Phone          string       `json:"phone" gorm:"not null"`
+	Email          string       `json:"email,omitempty"`
+	Status         DriverStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"`
 
 	// 운전면허 정보
-	LicenseNumber string      `json:"license_number"` // 면허 번호
-	LicenseType   LicenseType `json:"license_type"`   // 면허 종류
-	LicenseExpiry time.Time   `json:"license_expiry"` // 면허 만료일
+	LicenseNumber string      `json:"license_number" gorm:"uniqueIndex;not null"`    // 면허 번호
+	LicenseType   LicenseType `json:"license_type" gorm:"type:varchar(20);not null"` // 면허 종류
+	LicenseExpiry time.Time   `json:"license_expiry" gorm:"not null;index"`          // 면허 만료일
+
+	// 면허 확인 (면허증 스캔 → 관리자 확인)
+	LicenseStatus       LicenseStatus `json:"license_status" gorm:"type:varchar(20);not null;default:'pending'"`
+	LicenseFileID       *string       `json:"license_file_id,omitempty" gorm:"type:uuid"`            // 면허증 스캔 (files)
+	LicenseReviewedBy   string        `json:"license_reviewed_by,omitempty" gorm:"type:varchar(36)"` // 확인/반려한 관리자 ID
+	LicenseReviewedAt   *time.Time    `json:"license_reviewed_at,omitempty"`
+	LicenseRejectReason string        `json:"license_reject_reason,omitempty"` // 반려 사유
 
 	// 근무 정보
-	HireDate       time.Time  `json:"hire_date"`                  // 입사일
+	HireDate        time.Time  `json:"hire_date"`                  // 입사일
 	TerminationDate *time.Time `json:"termination_date,omitempty"` // 퇴사일
 
 	// 추가 정보
-	Address      string `json:"address,omitempty"`
+	Address          string `json:"address,omitempty"`
 	EmergencyContact string `json:"emergency_contact,omitempty"` // 비상 연락처
-	Notes        string `json:"notes,omitempty"` // 메모
+	Notes            string `json:"notes,omitempty"`             // 메모
+
+	// 개인정보 익명화 시각 (퇴사 후 보존 기간 경과)
+	AnonymizedAt *time.Time `json:"anonymized_at,omitempty"`
 
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // NewDriver - 기사 생성 팩토리 함수
 func NewDriver(name, phone, licenseNumber string, licenseType LicenseType, licenseExpiry time.Time) *Driver {
 	now := time.Now()
 	return &Driver{
+		ID:            uuid.New().String(), // UUID 자동 생성
 		Name:          name,
 		Phone:         phone,
 		LicenseNumber: licenseNumber,
 		LicenseType:   licenseType,
 		LicenseExpiry: licenseExpiry,
-		Status:        DriverStatusActive, // 기본값: 활동 중
+		LicenseStatus: LicenseStatusPending, // 관리자 확인 전까지 운행 배정 불가
+		Status:        DriverStatusActive,   // 기본값: 활동 중
 		HireDate:      now,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
 }
 
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (d *Driver) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	now := time.Now()
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (d *Driver) BeforeUpdate(tx *gorm.DB) error {
+	d.UpdatedAt = time.Now()
+	return nil
+}
+
 // IsActive - 활동 중인 기사인지 확인
 func (d *Driver) IsActive() bool {
 	return d.Status == DriverStatusActive && d.DeletedAt == nil
@@ -82,14 +125,57 @@ func (d *Driver) IsAvailableForTrip() bool {
 		return false
 	}
 
-	// 면허 만료 확인
-	if d.IsLicenseExpired() {
+	// 면허 확인/만료 확인
+	if !d.HasValidLicense() {
 		return false
 	}
 
 	return true
 }
 
+// HasValidLicense - 관리자가 확인했고 만료되지 않은 면허인지
+func (d *Driver) HasValidLicense() bool {
+	return d.IsLicenseVerified() && !d.IsLicenseExpired()
+}
+
+// IsLicenseVerified - 면허 확인 완료 여부
+func (d *Driver) IsLicenseVerified() bool {
+	return d.LicenseStatus == LicenseStatusVerified
+}
+
+// SubmitLicenseScan - 면허증 스캔 제출 (이전 확인 결과는 지우고 다시 확인 대기)
+func (d *Driver) SubmitLicenseScan(fileID string) {
+	d.LicenseFileID = &fileID
+	d.resetLicenseReview()
+}
+
+// VerifyLicense - 면허 확인 완료
+func (d *Driver) VerifyLicense(reviewerID string, at time.Time) {
+	d.LicenseStatus = LicenseStatusVerified
+	d.LicenseReviewedBy = reviewerID
+	d.LicenseReviewedAt = &at
+	d.LicenseRejectReason = ""
+	d.UpdatedAt = at
+}
+
+// RejectLicense - 면허 반려 (스캔이 흐리거나 면허 정보와 다를 때)
+func (d *Driver) RejectLicense(reviewerID, reason string, at time.Time) {
+	d.LicenseStatus = LicenseStatusRejected
+	d.LicenseReviewedBy = reviewerID
+	d.LicenseReviewedAt = &at
+	d.LicenseRejectReason = reason
+	d.UpdatedAt = at
+}
+
+// resetLicenseReview - 확인 결과 초기화 (확인 대기)
+func (d *Driver) resetLicenseReview() {
+	d.LicenseStatus = LicenseStatusPending
+	d.LicenseReviewedBy = ""
+	d.LicenseReviewedAt = nil
+	d.LicenseRejectReason = ""
+	d.UpdatedAt = time.Now()
+}
+
 // IsLicenseExpired - 면허 만료 여부
 func (d *Driver) IsLicenseExpired() bool {
 	return d.LicenseExpiry.Before(time.Now())
@@ -97,8 +183,12 @@ func (d *Driver) IsLicenseExpired() bool {
 
 // NeedsLicenseRenewal - 면허 갱신 필요 여부 (30일 이내 만료)
 func (d *Driver) NeedsLicenseRenewal() bool {
-	thirtyDaysLater := time.Now().AddDate(0, 0, 30)
-	return d.LicenseExpiry.Before(thirtyDaysLater)
+	return d.LicenseExpiresWithin(time.Now(), 30)
+}
+
+// LicenseExpiresWithin - at 기준 days일 이내 면허 만료 여부 (이미 만료 포함)
+func (d *Driver) LicenseExpiresWithin(at time.Time, days int) bool {
+	return d.LicenseExpiry.Before(at.AddDate(0, 0, days))
 }
 
 // SetOnLeave - 휴가 상태로 변경
@@ -125,12 +215,43 @@ func (d *Driver) IsTerminated() bool {
 	return d.TerminationDate != nil
 }
 
+// Anonymize - 개인정보 익명화 (퇴사 후 보존 기간 경과)
+// 면허 번호는 유일 제약이 있어 ID 기반 값으로 대체, 운행 기록의 driver_id는 유지
+func (d *Driver) Anonymize(now time.Time) {
+	d.Name = AnonymizedName
+	d.Phone = ""
+	d.Email = ""
+	d.LicenseNumber = "ANON-" + d.ID
+	d.LicenseFileID = nil
+	d.Address = ""
+	d.EmergencyContact = ""
+	d.Notes = ""
+	d.AnonymizedAt = &now
+	d.UpdatedAt = now
+}
+
+// IsAnonymized - 익명화 여부
+func (d *Driver) IsAnonymized() bool {
+	return d.AnonymizedAt != nil
+}
+
 // UpdateLicenseExpiry - 면허 만료일 업데이트
 func (d *Driver) UpdateLicenseExpiry(expiry time.Time) {
 	d.LicenseExpiry = expiry
 	d.UpdatedAt = time.Now()
 }
 
+// UpdateLicenseInfo - 면허 번호/종류/만료일 변경 (바뀐 값이 있으면 다시 확인 대기)
+func (d *Driver) UpdateLicenseInfo(number string, licenseType LicenseType, expiry time.Time) {
+	if number == d.LicenseNumber && licenseType == d.LicenseType && expiry.Equal(d.LicenseExpiry) {
+		return
+	}
+	d.LicenseNumber = number
+	d.LicenseType = licenseType
+	d.UpdateLicenseExpiry(expiry)
+	d.resetLicenseReview()
+}
+
 // UpdateContactInfo - 연락처 정보 업데이트
 func (d *Driver) UpdateContactInfo(phone, email string) {
 	if phone != "" {
@@ -3,21 +3,24 @@ package domain
 import (
 	"fmt"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // 📝 설명: 기사 대체 배정 (A 기사 → G 기사)
 // 🎯 실무 포인트: 휴가/퇴사 시 다른 기사가 특정 일정을 대체
 // ⚠️ 주의사항: Trip 생성 시 이 정보를 확인하여 대체 기사 배정
+// 같은 일정에 기간이 겹치는 배정은 Service에서 거부, 그래도 겹치면 가장 나중에 승인된 배정 우선
 
 // DriverAssignment - 기사 대체 배정 엔티티
 type DriverAssignment struct {
-	ID         string `json:"id"`
-	ScheduleID string `json:"schedule_id"` // 대체할 일정
-	DriverID   string `json:"driver_id"`   // 대체 기사
+	ID         string `json:"id" gorm:"type:uuid;primaryKey"`
+	ScheduleID string `json:"schedule_id" gorm:"type:uuid;not null;index"` // 대체할 일정
+	DriverID   string `json:"driver_id" gorm:"type:uuid;not null;index"`   // 대체 기사
 
-	// 대체 기간
-	StartDate time.Time `json:"start_date"` // 시작일
-	EndDate   time.Time `json:"end_date"`   // 종료일
+	// 대체 기간 (양 끝 포함)
+	StartDate time.Time `json:"start_date" gorm:"not null"` // 시작일
+	EndDate   time.Time `json:"end_date" gorm:"not null"`   // 종료일
 
 	// 대체 사유
 	Reason string `json:"reason"` // "원 담당자 휴가", "임시 배정" 등
@@ -30,17 +33,18 @@ type DriverAssignment struct {
 	CreatedBy string     `json:"created_by"` // 생성자 (관리자)
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // NewDriverAssignment - 대체 배정 생성 팩토리 함수
 func NewDriverAssignment(scheduleID, driverID string, startDate, endDate time.Time, reason, createdBy string) *DriverAssignment {
 	now := time.Now()
 	return &DriverAssignment{
+		ID:         uuid.New().String(), // UUID 자동 생성
 		ScheduleID: scheduleID,
 		DriverID:   driverID,
-		StartDate:  startDate,
-		EndDate:    endDate,
+		StartDate:  dateOnly(startDate),
+		EndDate:    dateOnly(endDate),
 		Reason:     reason,
 		CreatedBy:  createdBy,
 		CreatedAt:  now,
@@ -63,6 +67,16 @@ func (da *DriverAssignment) IsActiveOnDate(date time.Time) bool {
 		(dateOnly.Equal(endDateOnly) || dateOnly.Before(endDateOnly))
 }
 
+// Overlaps - 다른 배정과 기간이 겹치는지 확인
+func (da *DriverAssignment) Overlaps(from, to time.Time) bool {
+	return !dateOnly(da.EndDate).Before(dateOnly(from)) && !dateOnly(to).Before(dateOnly(da.StartDate))
+}
+
+// TakesPrecedenceOver - 같은 날짜에 겹칠 때 우선하는 배정인지 (나중에 승인된 배정 우선, 같으면 나중에 생성된 배정)
+func (da *DriverAssignment) TakesPrecedenceOver(other *DriverAssignment) bool {
+	return assignmentPrecedes(da.ApprovedAt, da.CreatedAt, da.ID, other.ApprovedAt, other.CreatedAt, other.ID)
+}
+
 // Approve - 배정 승인
 func (da *DriverAssignment) Approve(approverID string) {
 	now := time.Now()
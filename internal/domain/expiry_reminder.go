@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 면허/보험/정기검사 만료 안내 발송 기록
+// 🎯 실무 포인트: 만료 30/14/3일 전(EXPIRY_REMINDER_DAYS) 구간마다 한 번씩 관리자와 해당 기사에게 안내
+// 대상·만료일·구간이 같은 기록은 하나만 저장(유니크) → 작업을 여러 번/여러 인스턴스에서 실행해도 중복 안내 없음
+// ⚠️ 주의사항: 갱신으로 만료일이 바뀌면 새 만료일 기준으로 다시 안내
+
+// ExpiryKind - 만료 안내 대상 종류
+type ExpiryKind string
+
+const (
+	ExpiryKindLicense    ExpiryKind = "license"    // 기사 운전면허
+	ExpiryKindInsurance  ExpiryKind = "insurance"  // 차량 보험
+	ExpiryKindInspection ExpiryKind = "inspection" // 차량 정기검사
+)
+
+// DisplayName - 안내 문구에 쓰는 서류 이름
+func (k ExpiryKind) DisplayName() string {
+	switch k {
+	case ExpiryKindLicense:
+		return "운전면허"
+	case ExpiryKindInsurance:
+		return "자동차 보험"
+	case ExpiryKindInspection:
+		return "정기검사"
+	}
+	return string(k)
+}
+
+// ExpiryReminder - 만료 안내 발송 기록 엔티티
+type ExpiryReminder struct {
+	ID             string     `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string     `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Kind           ExpiryKind `json:"kind" gorm:"type:varchar(20);not null"`
+	SubjectID      string     `json:"subject_id" gorm:"type:uuid;not null"` // 기사 ID(license) 또는 차량 ID(insurance, inspection)
+	ExpiresOn      time.Time  `json:"expires_on" gorm:"type:date;not null"` // 안내한 만료일
+	WindowDays     int        `json:"window_days" gorm:"not null"`          // 안내 구간 (만료 N일 전)
+	SentAt         time.Time  `json:"sent_at" gorm:"not null"`
+}
+
+// NewExpiryReminder - 만료 안내 발송 기록 생성 팩토리 함수
+func NewExpiryReminder(organizationID string, kind ExpiryKind, subjectID string, expiresOn time.Time, windowDays int, sentAt time.Time) *ExpiryReminder {
+	return &ExpiryReminder{
+		ID:             uuid.New().String(),
+		OrganizationID: organizationID,
+		Kind:           kind,
+		SubjectID:      subjectID,
+		ExpiresOn:      expiresOn,
+		WindowDays:     windowDays,
+		SentAt:         sentAt,
+	}
+}
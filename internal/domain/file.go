@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 업로드 파일 메타데이터 (원본은 internal/storage 저장소)
+// 🎯 실무 포인트: 차량 서류, 면허증 스캔, 사고 사진 등 모든 업로드를 한 테이블로 관리 → 용도(purpose)별로 허용 형식/크기/업로드 역할이 다름
+// 파일을 먼저 올리고(POST /files) 받은 ID를 각 리소스에 연결하거나, 리소스 API가 업로드와 연결을 한 번에 처리
+// ⚠️ 주의사항: 저장소 키는 응답에 노출하지 않음 (다운로드는 항상 API 서버를 거쳐 권한 확인)
+
+// FilePurpose - 업로드 용도
+type FilePurpose string
+
+const (
+	FilePurposeVehicleDocument FilePurpose = "vehicle_document" // 차량 서류 (보험 증권, 정기검사 증명서 등)
+	FilePurposeDriverLicense   FilePurpose = "driver_license"   // 기사 면허증 스캔
+	FilePurposeIncidentPhoto   FilePurpose = "incident_photo"   // 사고/파손 현장 사진
+	FilePurposePassengerPhoto  FilePurpose = "passenger_photo"  // 탑승자 사진 (보호자 동의 필수)
+)
+
+// File - 업로드 파일 엔티티
+type File struct {
+	ID             string      `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string      `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Purpose        FilePurpose `json:"purpose" gorm:"type:varchar(30);not null"`
+	FileName       string      `json:"file_name" gorm:"type:varchar(255);not null"` // 업로드한 파일 이름
+	ContentType    string      `json:"content_type" gorm:"type:varchar(100);not null"`
+	Size           int64       `json:"size" gorm:"not null"`                       // 파일 크기 (바이트)
+	StorageKey     string      `json:"-" gorm:"type:varchar(255);not null;unique"` // 저장소 키
+	UploadedBy     string      `json:"uploaded_by" gorm:"type:varchar(36)"`        // 업로드한 사용자 ID
+
+	// 메타데이터
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewFile - 업로드 파일 생성 팩토리 함수 (저장소 키는 {용도}/{파일 ID})
+func NewFile(purpose FilePurpose, fileName, contentType string, size int64, uploadedBy string) *File {
+	id := uuid.New().String()
+	return &File{
+		ID:          id,
+		Purpose:     purpose,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  string(purpose) + "/" + id,
+		UploadedBy:  uploadedBy,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (f *File) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == "" {
+		f.ID = uuid.New().String()
+	}
+	return nil
+}
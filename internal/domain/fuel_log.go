@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 차량 주유 기록 (주유량, 금액, 주유 시점 주행거리계)
+// 🎯 실무 포인트: 직전 주유 이후 주행 거리 ÷ 이번 주유량 = 연비(km/L) → 연비가 낮은 차량, 주행 없이 반복 주유(유류카드 부정 사용) 확인
+// ⚠️ 주의사항: 주행거리계는 주유 시각 순으로 줄어들 수 없음 (Service에서 앞뒤 기록과 비교), 수정 API 없음 → 삭제 후 다시 등록
+
+// FuelLog - 주유 기록 엔티티
+type FuelLog struct {
+	ID             string    `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string    `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	VehicleID      string    `json:"vehicle_id" gorm:"type:uuid;not null;index"`
+	FilledAt       time.Time `json:"filled_at" gorm:"not null"`                  // 주유 시각
+	Liters         float64   `json:"liters" gorm:"type:numeric(7,2);not null"`   // 주유량 (L)
+	Cost           int       `json:"cost" gorm:"not null"`                       // 결제 금액 (원)
+	OdometerKm     int       `json:"odometer_km" gorm:"not null"`                // 주유 시점 주행거리계 (km)
+	Station        string    `json:"station,omitempty" gorm:"type:varchar(100)"` // 주유소 (예: "SK 역삼점")
+	RecordedBy     string    `json:"recorded_by" gorm:"type:uuid;not null"`      // 기록한 사용자 ID (관리자 또는 기사)
+
+	// 메타데이터
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewFuelLog - 주유 기록 생성 팩토리 함수
+func NewFuelLog(vehicleID string, filledAt time.Time, liters float64, cost, odometerKm int, station, recordedBy string) *FuelLog {
+	now := time.Now()
+	return &FuelLog{
+		ID:         uuid.New().String(),
+		VehicleID:  vehicleID,
+		FilledAt:   filledAt,
+		Liters:     liters,
+		Cost:       cost,
+		OdometerKm: odometerKm,
+		Station:    station,
+		RecordedBy: recordedBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (f *FuelLog) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == "" {
+		f.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// DistanceSince - 직전 주유 이후 주행 거리 (km)
+func (f *FuelLog) DistanceSince(previous *FuelLog) int {
+	return f.OdometerKm - previous.OdometerKm
+}
+
+// KmPerLiter - 직전 주유 이후 연비 (km/L, 주유량이 없으면 0)
+func (f *FuelLog) KmPerLiter(previous *FuelLog) float64 {
+	if f.Liters <= 0 {
+		return 0
+	}
+	return float64(f.DistanceSince(previous)) / f.Liters
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 보호자 계정 도메인 모델
+// 🎯 실무 포인트: 한 보호자가 여러 자녀(탑승자)와 연결, 한 탑승자도 여러 보호자 가능 (N:M)
+// ⚠️ 주의사항: Passenger의 GuardianName/GuardianPhone은 연락용 스냅샷, 앱 계정은 Guardian으로 관리
+
+// GuardianStatus - 보호자 상태
+type GuardianStatus string
+
+const (
+	GuardianStatusActive   GuardianStatus = "active"   // 활성
+	GuardianStatusInactive GuardianStatus = "inactive" // 비활성 (자녀 졸업 등)
+)
+
+// Guardian - 보호자 엔티티
+type Guardian struct {
+	ID             string         `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string         `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Name           string         `json:"name" gorm:"not null"`
+	Phone          string         `json:"phone" gorm:"not null;serializer:encrypted"` // 연락처 (암호화 저장, 삭제되지 않은 보호자 간 유일)
+	Email          string         `json:"email,omitempty"`
+	Status         GuardianStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"`
+
+	// PhoneHash - 연락처 검색용 해시 (Repository가 저장 시 계산)
+	PhoneHash string `json:"-" gorm:"type:varchar(64)"`
+
+	// 메타데이터
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
+}
+
+// GuardianPassenger - 보호자-탑승자 연결
+type GuardianPassenger struct {
+	GuardianID  string    `json:"guardian_id" gorm:"type:uuid;primaryKey"`
+	PassengerID string    `json:"passenger_id" gorm:"type:uuid;primaryKey"`
+	Relation    string    `json:"relation,omitempty"` // 관계 (부, 모, 조부모 등)
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NewGuardian - 보호자 생성 팩토리 함수
+func NewGuardian(name, phone string) *Guardian {
+	now := time.Now()
+	return &Guardian{
+		ID:        uuid.New().String(), // UUID 자동 생성
+		Name:      name,
+		Phone:     phone,
+		Status:    GuardianStatusActive, // 기본값: 활성
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// NewGuardianPassenger - 보호자-탑승자 연결 생성
+func NewGuardianPassenger(guardianID, passengerID, relation string) *GuardianPassenger {
+	return &GuardianPassenger{
+		GuardianID:  guardianID,
+		PassengerID: passengerID,
+		Relation:    relation,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (g *Guardian) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == "" {
+		g.ID = uuid.New().String()
+	}
+	now := time.Now()
+	g.CreatedAt = now
+	g.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (g *Guardian) BeforeUpdate(tx *gorm.DB) error {
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsActive - 활성 보호자인지 확인
+func (g *Guardian) IsActive() bool {
+	return g.Status == GuardianStatusActive && g.DeletedAt == nil
+}
+
+// SetActive - 활성 상태로 변경
+func (g *Guardian) SetActive() {
+	g.Status = GuardianStatusActive
+	g.UpdatedAt = time.Now()
+}
+
+// SetInactive - 비활성 상태로 변경
+func (g *Guardian) SetInactive() {
+	g.Status = GuardianStatusInactive
+	g.UpdatedAt = time.Now()
+}
+
+// UpdateContactInfo - 연락처 정보 업데이트
+func (g *Guardian) UpdateContactInfo(phone, email string) {
+	if phone != "" {
+		g.Phone = phone
+	}
+	if email != "" {
+		g.Email = email
+	}
+	g.UpdatedAt = time.Now()
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// 📝 설명: 공휴일 (공공데이터포털 특일 정보 API에서 동기화한 날짜)
+// 🎯 실무 포인트: 운행 생성 시 "공휴일 제외" 일정은 이 날짜에 운행을 만들지 않음
+// 동기화하지 않은 연도는 기본 공휴일 표(pkg/holiday)로 판단
+// ⚠️ 주의사항: 날짜가 기본키 → 같은 날 공휴일이 겹치면(예: 추석 + 개천절) 나중에 받은 이름 하나만 보관
+
+// HolidaySource - 공휴일 출처
+type HolidaySource string
+
+const (
+	HolidaySourceStatic HolidaySource = "static" // 기본 공휴일 표
+	HolidaySourceAPI    HolidaySource = "api"    // 특일 정보 API 동기화
+)
+
+// Holiday - 공휴일
+type Holiday struct {
+	Date      time.Time     `json:"date" gorm:"type:date;primaryKey"`
+	Name      string        `json:"name" gorm:"type:varchar(50);not null"` // 예: "설날", "대체공휴일"
+	Source    HolidaySource `json:"source" gorm:"type:varchar(20);not null"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// NewHoliday - 공휴일 생성 팩토리 함수 (날짜는 자정으로 맞춤)
+func NewHoliday(date time.Time, name string, source HolidaySource) *Holiday {
+	now := time.Now()
+	return &Holiday{
+		Date:      time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		Name:      name,
+		Source:    source,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
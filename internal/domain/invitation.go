@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 기관 직원 초대 도메인 모델
+// 🎯 실무 포인트: 관리자가 이메일/연락처로 초대 → 받은 사람이 토큰으로 수락하면 같은 기관 계정이 생성됨
+// 원문 토큰은 발송 시 한 번만 사용하고 DB에는 SHA-256 해시만 저장
+// ⚠️ 주의사항: 수락/취소/만료된 초대는 다시 사용할 수 없음 (재발송은 새 초대로)
+
+// InvitationStatus - 초대 상태 (저장하지 않고 시각 필드로 판단)
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"  // 수락 대기
+	InvitationStatusAccepted InvitationStatus = "accepted" // 수락 완료 (계정 생성됨)
+	InvitationStatusRevoked  InvitationStatus = "revoked"  // 관리자가 취소
+	InvitationStatusExpired  InvitationStatus = "expired"  // 유효 기간 경과
+)
+
+// Invitation - 초대 엔티티
+type Invitation struct {
+	ID             string  `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string  `json:"organization_id" gorm:"type:uuid;not null;index"` // 초대한 기관 (수락 시 계정의 소속 기관)
+	Email          *string `json:"email,omitempty"`                                 // 이메일 초대 (수락 시 로그인 ID)
+	Phone          *string `json:"phone,omitempty"`                                 // 연락처 초대 (수락 시 이메일 입력 필요)
+	Role           Role    `json:"role" gorm:"type:varchar(20);not null"`
+	ProfileID      string  `json:"profile_id,omitempty" gorm:"type:varchar(36)"` // 기사/동승자 ID (관리자는 빈 값)
+	TokenHash      string  `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	InvitedBy      string  `json:"invited_by" gorm:"type:uuid;not null"` // 초대한 관리자 계정 ID
+
+	ExpiresAt      time.Time  `json:"expires_at"`
+	AcceptedAt     *time.Time `json:"accepted_at,omitempty"`
+	AcceptedUserID *string    `json:"accepted_user_id,omitempty" gorm:"type:uuid"` // 수락으로 생성된 계정
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+
+	// 메타데이터
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewInvitation - 초대 생성 팩토리 함수
+func NewInvitation(role Role, profileID, tokenHash, invitedBy string, ttl time.Duration) *Invitation {
+	now := time.Now()
+	return &Invitation{
+		ID:        uuid.New().String(),
+		Role:      role,
+		ProfileID: profileID,
+		TokenHash: tokenHash,
+		InvitedBy: invitedBy,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (i *Invitation) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == "" {
+		i.ID = uuid.New().String()
+	}
+	now := time.Now()
+	i.CreatedAt = now
+	i.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 수정 전 자동 처리
+func (i *Invitation) BeforeUpdate(tx *gorm.DB) error {
+	i.UpdatedAt = time.Now()
+	return nil
+}
+
+// Status - 현재 상태 (취소 → 수락 → 만료 순으로 판단)
+func (i *Invitation) Status(now time.Time) InvitationStatus {
+	switch {
+	case i.RevokedAt != nil:
+		return InvitationStatusRevoked
+	case i.AcceptedAt != nil:
+		return InvitationStatusAccepted
+	case !now.Before(i.ExpiresAt):
+		return InvitationStatusExpired
+	}
+	return InvitationStatusPending
+}
+
+// IsPending - 수락 가능한 초대인지 확인
+func (i *Invitation) IsPending(now time.Time) bool {
+	return i.Status(now) == InvitationStatusPending
+}
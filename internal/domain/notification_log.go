@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 알림 발송 기록 (보호자가 실제로 무엇을 언제 어떤 채널로 받았는지)
+// 🎯 실무 포인트: "알림을 못 받았다"는 민원 확인용 → 발송 시도마다 상태/중계사 응답/시도 횟수 기록
+// 일시 장애(중계사 5xx, 타임아웃 등)는 retrying으로 두고 재시도 작업이 지수 백오프로 다시 발송
+// ⚠️ 주의사항: 연락처는 암호화 저장, 수신 거부(설정에서 끈 알림)는 발송하지 않았으므로 기록하지 않음
+
+// NotificationStatus - 발송 상태
+type NotificationStatus string
+
+const (
+	NotificationStatusSent     NotificationStatus = "sent"     // 발송 완료
+	NotificationStatusRetrying NotificationStatus = "retrying" // 일시 장애, 재시도 대기
+	NotificationStatusFailed   NotificationStatus = "failed"   // 재시도해도 보낼 수 없음 (연락처 없음, 잘못된 번호 등)
+	NotificationStatusDead     NotificationStatus = "dead"     // 최대 시도 횟수 초과 (dead letter)
+)
+
+// NotificationLog - 알림 발송 기록
+type NotificationLog struct {
+	ID             string `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string `json:"organization_id,omitempty" gorm:"type:uuid;index;default:null"` // 발송한 기관 (비어 있으면 운영 연락처로 보낸 플랫폼 알림)
+
+	// 수신자 (재시도 시 같은 대상에게 다시 발송)
+	GuardianID string                `json:"guardian_id,omitempty" gorm:"type:varchar(36);index"`
+	UserID     string                `json:"user_id,omitempty" gorm:"type:varchar(36)"`
+	Phone      string                `json:"phone,omitempty" gorm:"serializer:encrypted"`
+	Channels   []NotificationChannel `json:"allowed_channels" gorm:"type:jsonb;serializer:json"` // 허용 채널 (null이면 모든 채널)
+
+	// 내용
+	Event     NotificationEvent `json:"event,omitempty" gorm:"type:varchar(20)"`
+	Title     string            `json:"title" gorm:"type:varchar(200);not null"`
+	Body      string            `json:"body" gorm:"type:text;not null"`
+	Data      map[string]string `json:"data,omitempty" gorm:"type:jsonb;serializer:json"`
+	Template  string            `json:"template,omitempty" gorm:"type:varchar(50)"`
+	Variables map[string]string `json:"variables,omitempty" gorm:"type:jsonb;serializer:json"`
+	Locale    string            `json:"locale,omitempty" gorm:"type:varchar(10);not null;default:''"` // 제목/본문 언어 (재시도 시 알림톡 템플릿 선택)
+
+	// 결과
+	Status        NotificationStatus  `json:"status" gorm:"type:varchar(10);not null;index"`
+	Channel       NotificationChannel `json:"channel,omitempty" gorm:"type:varchar(10)"` // 실제 발송한 채널
+	Attempts      int                 `json:"attempts" gorm:"not null;default:0"`
+	Response      string              `json:"response,omitempty" gorm:"type:text"` // 마지막 실패의 중계사 응답/오류
+	NextAttemptAt *time.Time          `json:"next_attempt_at,omitempty"`
+	SentAt        *time.Time          `json:"sent_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewNotificationLog - 발송 기록 생성 팩토리 함수 (시도 전 상태)
+func NewNotificationLog(userID, phone string, channels []NotificationChannel, title, body string) *NotificationLog {
+	now := time.Now()
+	return &NotificationLog{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Phone:     phone,
+		Channels:  channels,
+		Title:     title,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// MarkSent - 발송 성공
+func (l *NotificationLog) MarkSent(channel NotificationChannel, now time.Time) {
+	l.Attempts++
+	l.Status = NotificationStatusSent
+	l.Channel = channel
+	l.Response = ""
+	l.NextAttemptAt = nil
+	l.SentAt = &now
+	l.UpdatedAt = now
+}
+
+// MarkRetry - 일시 장애로 실패, next에 다시 시도
+func (l *NotificationLog) MarkRetry(response string, next, now time.Time) {
+	l.Attempts++
+	l.Status = NotificationStatusRetrying
+	l.Response = response
+	l.NextAttemptAt = &next
+	l.UpdatedAt = now
+}
+
+// MarkFailed - 재시도할 수 없는 실패 (dead: 최대 시도 횟수 초과 여부)
+func (l *NotificationLog) MarkFailed(response string, dead bool, now time.Time) {
+	l.Attempts++
+	l.Status = NotificationStatusFailed
+	if dead {
+		l.Status = NotificationStatusDead
+	}
+	l.Response = response
+	l.NextAttemptAt = nil
+	l.UpdatedAt = now
+}
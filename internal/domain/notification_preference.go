@@ -0,0 +1,91 @@
+package domain
+
+import "time"
+
+// 📝 설명: 보호자 알림 수신 설정 (받을 채널과 알림 종류)
+// 🎯 실무 포인트: 설정하지 않은 보호자는 모든 채널/모든 알림을 받음 (기본값은 저장하지 않고 계산)
+// ⚠️ 주의사항: 채널을 모두 끄면 알림 종류와 무관하게 아무것도 받지 않음
+
+// NotificationChannel - 알림 발송 채널
+type NotificationChannel string
+
+const (
+	NotificationChannelPush     NotificationChannel = "push"
+	NotificationChannelAlimtalk NotificationChannel = "alimtalk"
+	NotificationChannelSMS      NotificationChannel = "sms"
+)
+
+// NotificationEvent - 보호자 알림 종류
+type NotificationEvent string
+
+const (
+	NotificationEventBoarded     NotificationEvent = "boarded"     // 승차
+	NotificationEventAlighted    NotificationEvent = "alighted"    // 하차
+	NotificationEventApproaching NotificationEvent = "approaching" // 정류장 접근
+	NotificationEventDelay       NotificationEvent = "delay"       // 운행 지연
+	NotificationEventAssignment  NotificationEvent = "assignment"  // 운행 차량/기사/동승자 변경
+)
+
+// AllNotificationChannels - 기본 수신 채널 (발송 우선순위 순)
+var AllNotificationChannels = []NotificationChannel{
+	NotificationChannelPush,
+	NotificationChannelAlimtalk,
+	NotificationChannelSMS,
+}
+
+// AllNotificationEvents - 기본 수신 알림 종류
+var AllNotificationEvents = []NotificationEvent{
+	NotificationEventBoarded,
+	NotificationEventAlighted,
+	NotificationEventApproaching,
+	NotificationEventDelay,
+	NotificationEventAssignment,
+}
+
+// NotificationPreference - 보호자 알림 수신 설정
+type NotificationPreference struct {
+	GuardianID string                `json:"guardian_id" gorm:"type:uuid;primaryKey"`
+	Channels   []NotificationChannel `json:"channels" gorm:"type:jsonb;serializer:json;not null"` // 받을 채널 (비어 있으면 알림 끔)
+	Events     []NotificationEvent   `json:"events" gorm:"type:jsonb;serializer:json;not null"`   // 받을 알림 종류
+	CreatedAt  time.Time             `json:"created_at"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+}
+
+// NewNotificationPreference - 기본 설정 (모든 채널, 모든 알림)
+func NewNotificationPreference(guardianID string) *NotificationPreference {
+	now := time.Now()
+	return &NotificationPreference{
+		GuardianID: guardianID,
+		Channels:   append([]NotificationChannel(nil), AllNotificationChannels...),
+		Events:     append([]NotificationEvent(nil), AllNotificationEvents...),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Update - 채널/알림 종류 교체
+func (p *NotificationPreference) Update(channels []NotificationChannel, events []NotificationEvent) {
+	p.Channels = channels
+	p.Events = events
+	p.UpdatedAt = time.Now()
+}
+
+// WantsEvent - 해당 알림을 받는지
+func (p *NotificationPreference) WantsEvent(event NotificationEvent) bool {
+	for _, e := range p.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsChannel - 해당 채널로 받는지
+func (p *NotificationPreference) AllowsChannel(channel NotificationChannel) bool {
+	for _, c := range p.Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 차량 주행거리계 기록 (운행 완료 시 기사 확인 값, 주행 거리로 계산한 값, 관리자 입력 값)
+// 🎯 실무 포인트: 주행거리 기준 정비 주기(엔진오일 등)를 잡을 수 있도록 차량 주행거리계 변화를 이력으로 남김
+// ⚠️ 주의사항: 계산 값(trip_distance)은 GPS 주행 거리 기반 추정 → 실제 계기판과 어긋나면 관리자가 manual로 보정
+
+// OdometerSource - 주행거리계 값 출처
+type OdometerSource string
+
+const (
+	OdometerSourceTrip         OdometerSource = "trip"          // 운행 완료 시 기사가 확인한 값
+	OdometerSourceTripDistance OdometerSource = "trip_distance" // 운행 완료 시 주행 거리로 계산한 값 (기사 미입력)
+	OdometerSourceManual       OdometerSource = "manual"        // 관리자 입력 (기준값, 보정, 계기판 교체)
+)
+
+// OdometerReading - 주행거리계 기록 엔티티
+type OdometerReading struct {
+	ID             string         `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string         `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	VehicleID      string         `json:"vehicle_id" gorm:"type:uuid;not null;index"`
+	OdometerKm     int            `json:"odometer_km" gorm:"not null"`                   // 주행거리계 (km)
+	Source         OdometerSource `json:"source" gorm:"type:varchar(20);not null"`       // 값 출처
+	TripID         *string        `json:"trip_id,omitempty" gorm:"type:uuid"`            // 운행 완료로 기록한 경우
+	RecordedBy     string         `json:"recorded_by,omitempty" gorm:"type:varchar(36)"` // 기록한 사용자 ID
+	RecordedAt     time.Time      `json:"recorded_at" gorm:"not null"`
+
+	// 메타데이터
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewOdometerReading - 주행거리계 기록 생성 팩토리 함수
+func NewOdometerReading(vehicleID string, odometerKm int, source OdometerSource, tripID *string, recordedBy string, recordedAt time.Time) *OdometerReading {
+	return &OdometerReading{
+		ID:         uuid.New().String(),
+		VehicleID:  vehicleID,
+		OdometerKm: odometerKm,
+		Source:     source,
+		TripID:     tripID,
+		RecordedBy: recordedBy,
+		RecordedAt: recordedAt,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (r *OdometerReading) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
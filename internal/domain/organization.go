@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 기관(테넌트) 도메인 모델
+// 🎯 실무 포인트: 한 배포에서 여러 시설(유치원, 병원, 학원)을 운영 → 차량/기사/노선/운행 등은 모두 기관에 소속
+// ⚠️ 주의사항: 기관 간 데이터는 Repository의 테넌트 훅이 organization_id로 분리 (직접 조건을 빠뜨려도 누출되지 않음)
+// 일정 출발 시각(HH:MM)과 "오늘"은 기관 시간대(Timezone) 기준
+
+// DefaultOrganizationID - 마이그레이션 이전 데이터가 배정되는 기본 기관
+const DefaultOrganizationID = "00000000-0000-0000-0000-000000000001"
+
+// DefaultTimezone - 기관 시간대 기본값
+const DefaultTimezone = "Asia/Seoul"
+
+// OrganizationType - 기관 유형
+type OrganizationType string
+
+const (
+	OrganizationTypeKindergarten OrganizationType = "kindergarten" // 유치원/어린이집
+	OrganizationTypeClinic       OrganizationType = "clinic"       // 병원/요양시설
+	OrganizationTypeAcademy      OrganizationType = "academy"      // 학원
+)
+
+// IsValid - 허용된 기관 유형인지 확인
+func (t OrganizationType) IsValid() bool {
+	switch t {
+	case OrganizationTypeKindergarten, OrganizationTypeClinic, OrganizationTypeAcademy:
+		return true
+	}
+	return false
+}
+
+// Organization - 기관 엔티티
+type Organization struct {
+	ID      string           `json:"id" gorm:"type:uuid;primaryKey"`
+	Name    string           `json:"name" gorm:"type:varchar(100);not null"` // 기관명 (예: "해바라기 유치원")
+	Type    OrganizationType `json:"type" gorm:"type:varchar(20);not null"`  // 기관 유형
+	Phone   *string          `json:"phone,omitempty"`                        // 대표 연락처
+	Address *string          `json:"address,omitempty"`                      // 주소
+
+	// 화면 표시/웹 앱 설정
+	DisplayName    *string  `json:"display_name,omitempty"`                            // 앱에 표시할 이름 (없으면 기관명)
+	LogoURL        *string  `json:"logo_url,omitempty"`                                // 로고 이미지 주소
+	AllowedOrigins []string `json:"allowed_origins" gorm:"type:jsonb;serializer:json"` // 기관 웹 앱 Origin (CORS 허용)
+	Timezone       string   `json:"timezone" gorm:"type:varchar(64);not null"`         // 시간대 (IANA 이름, 예: "Asia/Seoul")
+
+	// 메타데이터
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
+}
+
+// NewOrganization - 기관 생성 팩토리 함수
+func NewOrganization(name string, orgType OrganizationType) *Organization {
+	now := time.Now()
+	return &Organization{
+		ID:             uuid.New().String(),
+		Name:           name,
+		Type:           orgType,
+		AllowedOrigins: []string{},
+		Timezone:       DefaultTimezone,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Branding - 앱에 표시할 이름 (표시 이름이 없으면 기관명)
+func (o *Organization) Branding() string {
+	if o.DisplayName != nil && *o.DisplayName != "" {
+		return *o.DisplayName
+	}
+	return o.Name
+}
+
+// Location - 기관 시간대 (비어 있거나 알 수 없는 이름이면 기본 시간대)
+func (o *Organization) Location() *time.Location {
+	if o.Timezone != "" {
+		if location, err := time.LoadLocation(o.Timezone); err == nil {
+			return location
+		}
+	}
+	location, err := time.LoadLocation(DefaultTimezone)
+	if err != nil {
+		return time.FixedZone("KST", 9*60*60)
+	}
+	return location
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (o *Organization) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == "" {
+		o.ID = uuid.New().String()
+	}
+	if o.Timezone == "" {
+		o.Timezone = DefaultTimezone
+	}
+	now := time.Now()
+	o.CreatedAt = now
+	o.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 수정 전 자동 처리
+func (o *Organization) BeforeUpdate(tx *gorm.DB) error {
+	o.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 아웃박스 이벤트 (엔티티 변경과 같은 트랜잭션으로 기록한 도메인 이벤트)
+// 🎯 실무 포인트: 변경이 커밋되면 이벤트도 반드시 남고, 롤백되면 이벤트도 남지 않음
+// 릴레이 작업이 pending 이벤트를 가져가 구독 핸들러(웹훅, 보호자 알림 등)에 전달
+// ⚠️ 주의사항: 성공한 핸들러는 handled에 기록 → 재시도 시 실패한 핸들러만 다시 실행 (알림 중복 방지)
+
+// OutboxStatus - 아웃박스 이벤트 상태
+type OutboxStatus string
+
+const (
+	OutboxPending   OutboxStatus = "pending"   // 전달 대기 (재시도 대기 포함)
+	OutboxPublished OutboxStatus = "published" // 모든 핸들러 처리 완료
+	OutboxDead      OutboxStatus = "dead"      // 최대 시도 횟수 초과 (dead letter)
+)
+
+// OutboxEvent - 아웃박스 이벤트
+type OutboxEvent struct {
+	ID             string       `json:"id" gorm:"type:uuid;primaryKey"`                  // 이벤트 ID (웹훅 본문 id로도 사용)
+	OrganizationID string       `json:"organization_id" gorm:"type:uuid;not null;index"` // 이벤트가 발생한 기관 (이 기관의 웹훅 구독에만 전달)
+	Type           string       `json:"type" gorm:"type:varchar(50);not null"`
+	AggregateID    string       `json:"aggregate_id" gorm:"type:uuid;not null"` // 이벤트가 발생한 엔티티 ID (운행 등)
+	Payload        string       `json:"payload" gorm:"type:jsonb;not null"`
+	Status         OutboxStatus `json:"status" gorm:"type:varchar(10);not null"`
+	Attempts       int          `json:"attempts" gorm:"not null;default:0"`
+	Handled        []string     `json:"handled" gorm:"type:jsonb;serializer:json"` // 처리를 마친 핸들러 이름
+	LastError      string       `json:"last_error,omitempty" gorm:"type:text"`
+	AvailableAt    time.Time    `json:"available_at" gorm:"not null"` // 이 시각 이후 전달 (재시도 예약, 전달 중 lease)
+	PublishedAt    *time.Time   `json:"published_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"` // 발생 시각
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewOutboxEvent - 아웃박스 이벤트 생성 팩토리 함수 (바로 전달 대상)
+func NewOutboxEvent(organizationID, eventType, aggregateID, payload string) *OutboxEvent {
+	now := time.Now()
+	return &OutboxEvent{
+		ID:             uuid.New().String(),
+		OrganizationID: organizationID,
+		Type:           eventType,
+		AggregateID:    aggregateID,
+		Payload:        payload,
+		Status:         OutboxPending,
+		AvailableAt:    now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// MarkPublished - 모든 핸들러 처리 완료
+func (e *OutboxEvent) MarkPublished(handled []string, now time.Time) {
+	e.Attempts++
+	e.Status = OutboxPublished
+	e.Handled = handled
+	e.LastError = ""
+	e.PublishedAt = &now
+	e.UpdatedAt = now
+}
+
+// MarkRetry - 일부 핸들러 실패, next에 실패한 핸들러만 다시 실행
+func (e *OutboxEvent) MarkRetry(handled []string, lastError string, next, now time.Time) {
+	e.Attempts++
+	e.Handled = handled
+	e.LastError = lastError
+	e.AvailableAt = next
+	e.UpdatedAt = now
+}
+
+// MarkDead - 최대 시도 횟수 초과
+func (e *OutboxEvent) MarkDead(handled []string, lastError string, now time.Time) {
+	e.Attempts++
+	e.Status = OutboxDead
+	e.Handled = handled
+	e.LastError = lastError
+	e.UpdatedAt = now
+}
@@ -2,11 +2,16 @@ package domain
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // 📝 설명: 탑승자 도메인 모델 (유치원생, 통원 환자 등)
 // 🎯 실무 포인트: 보호자 정보, 특이사항 관리
 // ⚠️ 주의사항: 알레르기, 투약 정보 등 민감 정보 포함 가능
+// MedicalNotes, GuardianPhone, EmergencyContact는 DB에 암호문으로 저장 (serializer:encrypted)
+// 사진은 보호자 동의(PhotoConsent)가 기록된 경우에만 등록/조회, 동의를 철회하면 사진도 삭제
 
 // PassengerStatus - 탑승자 상태
 type PassengerStatus string
@@ -18,42 +23,55 @@ const (
 
 // Passenger - 탑승자 엔티티
 type Passenger struct {
-	ID     string          `json:"id"`
-	Name   string          `json:"name"`
-	Age    int             `json:"age,omitempty"`
-	Gender string          `json:"gender,omitempty"` // "male", "female", "other"
-	Status PassengerStatus `json:"status"`
+	ID             string          `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string          `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Name           string          `json:"name" gorm:"not null"`
+	Age            int             `json:"age,omitempty"`
+	Gender         string          `json:"gender,omitempty"` // "male", "female", "other"
+	Status         PassengerStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"`
 
 	// 탑승 정보
-	AssignedRouteID string  `json:"assigned_route_id"`          // 배정된 경로
-	AssignedStopID  string  `json:"assigned_stop_id"`           // 배정된 정류장
-	StopOrder       int     `json:"stop_order"`                 // 정류장 순서 (캐싱용)
+	AssignedRouteID string `json:"assigned_route_id" gorm:"type:varchar(36);index"` // 배정된 경로 (미배정 시 빈 값)
+	AssignedStopID  string `json:"assigned_stop_id" gorm:"type:varchar(36);index"`  // 배정된 정류장
+	StopOrder       int    `json:"stop_order"`                                      // 정류장 순서 (캐싱용, 정류장 순서 변경 시 Repository가 함께 갱신)
 
 	// 보호자 정보
-	GuardianName    string `json:"guardian_name"`    // 보호자 이름
-	GuardianPhone   string `json:"guardian_phone"`   // 보호자 연락처
-	GuardianEmail   string `json:"guardian_email,omitempty"`
-	GuardianRelation string `json:"guardian_relation,omitempty"` // 관계 (부, 모, 조부모 등)
+	GuardianName      string `json:"guardian_name" gorm:"not null"`                       // 보호자 이름
+	GuardianPhone     string `json:"guardian_phone" gorm:"not null;serializer:encrypted"` // 보호자 연락처 (암호화 저장)
+	GuardianPhoneHash string `json:"-" gorm:"type:varchar(64)"`                           // 연락처 검색용 해시 (Repository가 저장 시 계산)
+	GuardianEmail     string `json:"guardian_email,omitempty"`
+	GuardianRelation  string `json:"guardian_relation,omitempty"` // 관계 (부, 모, 조부모 등)
 
 	// 비상 연락처 (보호자와 다른 경우)
-	EmergencyContact string `json:"emergency_contact,omitempty"`
+	EmergencyContact  string `json:"emergency_contact,omitempty" gorm:"serializer:encrypted"` // 암호화 저장
 	EmergencyRelation string `json:"emergency_relation,omitempty"`
 
 	// 추가 정보
 	Address      string `json:"address,omitempty"`
-	MedicalNotes string `json:"medical_notes,omitempty"` // 의료 특이사항 (알레르기 등)
-	Notes        string `json:"notes,omitempty"`         // 일반 메모
+	MedicalNotes string `json:"medical_notes,omitempty" gorm:"serializer:encrypted"` // 의료 특이사항 (알레르기 등, 암호화 저장)
+	Notes        string `json:"notes,omitempty"`                                     // 일반 메모
+
+	// 사진 (대체 기사/동승자의 아이 확인용, 보호자 동의 필수)
+	PhotoConsent   bool       `json:"photo_consent" gorm:"not null;default:false"`        // 보호자 사진 동의 여부
+	PhotoConsentAt *time.Time `json:"photo_consent_at,omitempty"`                         // 동의/철회 시각
+	PhotoConsentBy string     `json:"photo_consent_by,omitempty" gorm:"type:varchar(36)"` // 동의/철회를 기록한 사용자 ID (보호자 또는 서면 동의를 입력한 관리자)
+	PhotoFileID    *string    `json:"photo_file_id,omitempty" gorm:"type:uuid"`           // 사진 파일 (files)
+
+	// 보존 기간 관리
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"` // 비활성 전환 시각 (보존 기간 기산점)
+	AnonymizedAt  *time.Time `json:"anonymized_at,omitempty"`  // 개인정보 익명화 시각
 
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // NewPassenger - 탑승자 생성 팩토리 함수
 func NewPassenger(name, guardianName, guardianPhone string) *Passenger {
 	now := time.Now()
 	return &Passenger{
+		ID:            uuid.New().String(), // UUID 자동 생성
 		Name:          name,
 		GuardianName:  guardianName,
 		GuardianPhone: guardianPhone,
@@ -63,6 +81,23 @@ func NewPassenger(name, guardianName, guardianPhone string) *Passenger {
 	}
 }
 
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (p *Passenger) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+	now := time.Now()
+	p.CreatedAt = now
+	p.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (p *Passenger) BeforeUpdate(tx *gorm.DB) error {
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
 // IsActive - 활동 중인 탑승자인지 확인
 func (p *Passenger) IsActive() bool {
 	return p.Status == PassengerStatusActive && p.DeletedAt == nil
@@ -71,13 +106,72 @@ func (p *Passenger) IsActive() bool {
 // SetActive - 활동 중 상태로 변경
 func (p *Passenger) SetActive() {
 	p.Status = PassengerStatusActive
+	p.DeactivatedAt = nil
 	p.UpdatedAt = time.Now()
 }
 
 // SetInactive - 비활성 상태로 변경 (졸업, 전학 등)
 func (p *Passenger) SetInactive() {
+	now := time.Now()
 	p.Status = PassengerStatusInactive
+	p.DeactivatedAt = &now
+	p.UpdatedAt = now
+}
+
+// Anonymize - 개인정보 익명화 (보존 기간 경과 후)
+// ID/나이/성별/배정 이력은 남겨 운행 통계는 유지
+func (p *Passenger) Anonymize(now time.Time) {
+	p.Name = AnonymizedName
+	p.GuardianName = AnonymizedName
+	p.GuardianPhone = ""
+	p.GuardianPhoneHash = ""
+	p.GuardianEmail = ""
+	p.EmergencyContact = ""
+	p.EmergencyRelation = ""
+	p.Address = ""
+	p.MedicalNotes = ""
+	p.Notes = ""
+	p.PhotoFileID = nil
+	p.AnonymizedAt = &now
+	p.UpdatedAt = now
+}
+
+// IsAnonymized - 익명화 여부
+func (p *Passenger) IsAnonymized() bool {
+	return p.AnonymizedAt != nil
+}
+
+// RecordPhotoConsent - 사진 동의/철회 기록 (철회하면 사진 연결 해제 → 이전 사진 파일 ID 반환)
+func (p *Passenger) RecordPhotoConsent(consent bool, recordedBy string, at time.Time) *string {
+	p.PhotoConsent = consent
+	p.PhotoConsentAt = &at
+	p.PhotoConsentBy = recordedBy
+	p.UpdatedAt = at
+	if consent {
+		return nil
+	}
+	return p.RemovePhoto()
+}
+
+// HasPhotoConsent - 사진 동의가 기록되어 있는지
+func (p *Passenger) HasPhotoConsent() bool {
+	return p.PhotoConsent && p.PhotoConsentAt != nil
+}
+
+// SetPhoto - 사진 등록 (이전 사진 파일 ID 반환, 없으면 nil)
+func (p *Passenger) SetPhoto(fileID string) *string {
+	previous := p.PhotoFileID
+	p.PhotoFileID = &fileID
+	p.UpdatedAt = time.Now()
+	return previous
+}
+
+// RemovePhoto - 사진 연결 해제 (이전 사진 파일 ID 반환, 없으면 nil)
+func (p *Passenger) RemovePhoto() *string {
+	previous := p.PhotoFileID
+	p.PhotoFileID = nil
 	p.UpdatedAt = time.Now()
+	return previous
 }
 
 // AssignToStop - 정류장 배정
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 탑승자 결석 사전 신고 ("내일 아침 안 타요")
+// 🎯 실무 포인트: 보호자 앱/관리자가 미리 신고 → 운행 탑승 기록은 불참(no-show)이 아닌 결석 신고(excused)로 표시
+// ⚠️ 주의사항: TimeSlot이 빈 값이면 그날 모든 시간대, 같은 날짜에 시간대가 겹치는 신고는 Service에서 거부
+
+// PassengerAbsence - 결석 신고 엔티티
+type PassengerAbsence struct {
+	ID          string    `json:"id" gorm:"type:uuid;primaryKey"`
+	PassengerID string    `json:"passenger_id" gorm:"type:uuid;not null;index"`
+	Date        time.Time `json:"date" gorm:"type:date;not null"`                       // 결석 날짜
+	TimeSlot    TimeSlot  `json:"time_slot,omitempty" gorm:"type:varchar(20);not null"` // 결석 시간대 (빈 값이면 하루 종일)
+	Reason      string    `json:"reason,omitempty"`                                     // 사유 ("병원 진료", "가족 여행" 등)
+	ReportedBy  string    `json:"reported_by"`                                          // 신고한 사용자 ID (보호자 또는 관리자)
+
+	// 메타데이터
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete (신고 취소)
+}
+
+// NewPassengerAbsence - 결석 신고 생성 팩토리 함수 (날짜 단위)
+func NewPassengerAbsence(passengerID string, date time.Time, timeSlot TimeSlot, reason, reportedBy string) *PassengerAbsence {
+	now := time.Now()
+	return &PassengerAbsence{
+		ID:          uuid.New().String(),
+		PassengerID: passengerID,
+		Date:        dateOnly(date),
+		TimeSlot:    timeSlot,
+		Reason:      reason,
+		ReportedBy:  reportedBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsAllDay - 하루 종일 결석인지
+func (a *PassengerAbsence) IsAllDay() bool {
+	return a.TimeSlot == ""
+}
+
+// Covers - 날짜/시간대의 운행에 해당하는 신고인지
+func (a *PassengerAbsence) Covers(date time.Time, timeSlot TimeSlot) bool {
+	if a.DeletedAt != nil || !dateOnly(a.Date).Equal(dateOnly(date)) {
+		return false
+	}
+	return a.IsAllDay() || a.TimeSlot == timeSlot
+}
+
+// Overlaps - 같은 날짜에 시간대가 겹치는 신고인지 (하루 종일은 모든 시간대와 겹침)
+func (a *PassengerAbsence) Overlaps(date time.Time, timeSlot TimeSlot) bool {
+	if timeSlot == "" {
+		return a.DeletedAt == nil && dateOnly(a.Date).Equal(dateOnly(date))
+	}
+	return a.Covers(date, timeSlot)
+}
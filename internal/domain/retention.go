@@ -0,0 +1,36 @@
+package domain
+
+// 📝 설명: 개인정보 보존 기간 경과 후 익명화 공통 정의
+// 🎯 실무 포인트: 개인정보보호법상 보존 기간이 지난 정보는 파기 또는 익명화
+// 행 자체는 남겨 운행/탑승 통계(trip, trip_passengers의 ID 참조)는 유지
+// ⚠️ 주의사항: 익명화는 되돌릴 수 없음
+
+// AnonymizedName - 익명화된 이름 표시
+const AnonymizedName = "(익명)"
+
+// PassengerPersonalColumns - 익명화 대상 탑승자 컬럼 (Passenger.Anonymize와 동일하게 유지)
+var PassengerPersonalColumns = []string{
+	"name",
+	"guardian_name",
+	"guardian_phone",
+	"guardian_phone_hash",
+	"guardian_email",
+	"emergency_contact",
+	"emergency_relation",
+	"address",
+	"medical_notes",
+	"notes",
+	"photo_file_id",
+}
+
+// DriverPersonalColumns - 익명화 대상 기사 컬럼 (Driver.Anonymize와 동일하게 유지)
+var DriverPersonalColumns = []string{
+	"name",
+	"phone",
+	"email",
+	"license_number",
+	"license_file_id",
+	"address",
+	"emergency_contact",
+	"notes",
+}
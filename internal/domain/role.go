@@ -0,0 +1,52 @@
+package domain
+
+// 📝 설명: 사용자 역할 (RBAC)
+// 🎯 실무 포인트: 역할별로 접근 가능한 API를 라우터에서 제한 (RequireRole 미들웨어)
+// ⚠️ 주의사항: "배정된 기사만 운행 시작" 같은 리소스 단위 규칙은 Service에서 추가 검증
+
+// Role - 사용자 역할
+type Role string
+
+const (
+	RoleAdmin     Role = "admin"     // 관리자 (운영 담당자)
+	RoleDriver    Role = "driver"    // 기사
+	RoleAttendant Role = "attendant" // 동승자 (선생님/보조원)
+	RoleGuardian  Role = "guardian"  // 보호자
+
+	// RoleSuperAdmin - 플랫폼 운영자 (모든 기관 조회, 관리자 권한 포함, 기관 관리자가 부여할 수 없음)
+	RoleSuperAdmin Role = "super_admin"
+
+	// RoleIntegration - API 키로 인증된 외부 시스템 (토큰 발급 대상 아님)
+	RoleIntegration Role = "integration"
+)
+
+// IsValid - 사용자 역할인지 확인 (RoleIntegration 제외)
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleDriver, RoleAttendant, RoleGuardian, RoleSuperAdmin:
+		return true
+	}
+	return false
+}
+
+// IsStaff - 운영 인력(관리자/기사/동승자) 여부
+func (r Role) IsStaff() bool {
+	return r == RoleAdmin || r == RoleDriver || r == RoleAttendant || r == RoleSuperAdmin
+}
+
+// DisplayName - 화면/안내 문구용 역할 이름
+func (r Role) DisplayName() string {
+	switch r {
+	case RoleAdmin:
+		return "관리자"
+	case RoleDriver:
+		return "기사"
+	case RoleAttendant:
+		return "동승자"
+	case RoleGuardian:
+		return "보호자"
+	case RoleSuperAdmin:
+		return "플랫폼 운영자"
+	}
+	return string(r)
+}
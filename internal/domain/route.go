@@ -2,6 +2,9 @@ package domain
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // 📝 설명: 경로 및 정류장 도메인 모델
@@ -18,29 +21,30 @@ const (
 
 // Route - 경로 엔티티 (A코스, B코스 등)
 type Route struct {
-	ID          string      `json:"id"`
-	Name        string      `json:"name"`        // 경로명 (예: "A코스", "오전 노선 1")
-	Description string      `json:"description"` // 경로 설명
-	Status      RouteStatus `json:"status"`
+	ID             string      `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string      `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Name           string      `json:"name" gorm:"not null"`                            // 경로명 (예: "A코스", "오전 노선 1")
+	Description    string      `json:"description"`                                     // 경로 설명
+	Status         RouteStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"`
 
 	// 경로 정보
-	Stops            []Stop `json:"stops,omitempty"`           // 정류장 목록
-	EstimatedTime    int    `json:"estimated_time"`            // 예상 소요 시간 (분)
-	TotalDistance    int    `json:"total_distance,omitempty"`  // 총 거리 (미터)
+	Stops         []Stop `json:"stops,omitempty" gorm:"foreignKey:RouteID"` // 정류장 목록
+	EstimatedTime int    `json:"estimated_time"`                            // 예상 소요 시간 (분)
+	TotalDistance int    `json:"total_distance,omitempty"`                  // 총 거리 (미터)
 
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // Stop - 정류장 엔티티
 type Stop struct {
-	ID       string  `json:"id"`
-	RouteID  string  `json:"route_id"`  // 소속 경로
-	Name     string  `json:"name"`      // 정류장 이름 (예: "OO아파트 정문")
-	Address  string  `json:"address"`   // 주소
-	Order    int     `json:"order"`     // 순서 (1부터 시작)
+	ID       string  `json:"id" gorm:"type:uuid;primaryKey"`
+	RouteID  string  `json:"route_id" gorm:"type:uuid;not null;index"` // 소속 경로
+	Name     string  `json:"name" gorm:"not null"`                     // 정류장 이름 (예: "OO아파트 정문")
+	Address  string  `json:"address"`                                  // 주소
+	Order    int     `json:"order" gorm:"column:stop_order;not null"`  // 순서 (1부터 시작, ORDER는 예약어라 컬럼명 변경)
 	Latitude float64 `json:"latitude"`  // 위도
 	Longitude float64 `json:"longitude"` // 경도
 
@@ -51,13 +55,14 @@ type Stop struct {
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // NewRoute - 경로 생성 팩토리 함수
 func NewRoute(name, description string, estimatedTime int) *Route {
 	now := time.Now()
 	return &Route{
+		ID:            uuid.New().String(), // UUID 자동 생성
 		Name:          name,
 		Description:   description,
 		EstimatedTime: estimatedTime,
@@ -72,6 +77,7 @@ func NewRoute(name, description string, estimatedTime int) *Route {
 func NewStop(routeID, name, address string, order int, latitude, longitude float64, estimatedArrivalTime int) *Stop {
 	now := time.Now()
 	return &Stop{
+		ID:                   uuid.New().String(), // UUID 자동 생성
 		RouteID:              routeID,
 		Name:                 name,
 		Address:              address,
@@ -84,6 +90,40 @@ func NewStop(routeID, name, address string, order int, latitude, longitude float
 	}
 }
 
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (r *Route) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	now := time.Now()
+	r.CreatedAt = now
+	r.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (r *Route) BeforeUpdate(tx *gorm.DB) error {
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (s *Stop) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	now := time.Now()
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (s *Stop) BeforeUpdate(tx *gorm.DB) error {
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
 // IsActive - 활성 경로인지 확인
 func (r *Route) IsActive() bool {
 	return r.Status == RouteStatusActive && r.DeletedAt == nil
@@ -2,6 +2,9 @@ package domain
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // 📝 설명: 운행 일정 템플릿 (매일 반복되는 운행 계획)
@@ -40,25 +43,27 @@ const (
 
 // Schedule - 운행 일정 템플릿
 type Schedule struct {
-	ID          string         `json:"id"`
-	Name        string         `json:"name"`        // 일정명 (예: "오전 8시 A코스")
-	Description string         `json:"description"` // 설명
-	Status      ScheduleStatus `json:"status"`
+	ID             string         `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string         `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Name           string         `json:"name" gorm:"not null"`                            // 일정명 (예: "오전 8시 A코스")
+	Description    string         `json:"description"`                                     // 설명
+	Status         ScheduleStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"`
 
 	// 시간 설정
-	StartTime string   `json:"start_time"` // 출발 시각 (HH:MM 형식, 예: "08:00")
-	TimeSlot  TimeSlot `json:"time_slot"`  // 시간대 (오전/오후/저녁)
+	StartTime string   `json:"start_time" gorm:"type:varchar(5);not null"` // 출발 시각 (HH:MM 형식, 예: "08:00")
+	TimeSlot  TimeSlot `json:"time_slot" gorm:"type:varchar(20)"`          // 시간대 (오전/오후/저녁)
 
 	// 운행 요일 (1=월, 2=화, ..., 7=일)
-	DaysOfWeek []int `json:"days_of_week"` // 예: [1,2,3,4,5] (월~금)
+	DaysOfWeek   []int `json:"days_of_week" gorm:"type:jsonb;serializer:json"` // 예: [1,2,3,4,5] (월~금)
+	SkipHolidays bool  `json:"skip_holidays" gorm:"not null"`                  // 공휴일 운행 제외 (설날/추석 등)
 
 	// 배정 정보
-	RouteID  string  `json:"route_id"`   // 경로
-	VehicleID string `json:"vehicle_id"` // 차량
+	RouteID   string `json:"route_id" gorm:"type:uuid;not null;index"`   // 경로
+	VehicleID string `json:"vehicle_id" gorm:"type:uuid;not null;index"` // 차량
 
 	// 기본 담당자 (대체 가능)
-	DefaultDriverID    string  `json:"default_driver_id"`               // 기본 기사
-	DefaultAttendantID *string `json:"default_attendant_id,omitempty"`  // 기본 동승자 (선택적)
+	DefaultDriverID    string  `json:"default_driver_id" gorm:"type:uuid;not null;index"` // 기본 기사
+	DefaultAttendantID *string `json:"default_attendant_id,omitempty" gorm:"type:uuid"`   // 기본 동승자 (선택적)
 
 	// 유효 기간 (선택적)
 	ValidFrom *time.Time `json:"valid_from,omitempty"` // 유효 시작일
@@ -67,13 +72,14 @@ type Schedule struct {
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // NewSchedule - 일정 생성 팩토리 함수
 func NewSchedule(name, startTime string, timeSlot TimeSlot, daysOfWeek []int, routeID, vehicleID, driverID string) *Schedule {
 	now := time.Now()
 	return &Schedule{
+		ID:              uuid.New().String(), // UUID 자동 생성
 		Name:            name,
 		StartTime:       startTime,
 		TimeSlot:        timeSlot,
@@ -81,12 +87,30 @@ func NewSchedule(name, startTime string, timeSlot TimeSlot, daysOfWeek []int, ro
 		RouteID:         routeID,
 		VehicleID:       vehicleID,
 		DefaultDriverID: driverID,
+		SkipHolidays:    true,                 // 기본값: 공휴일 운행 안 함
 		Status:          ScheduleStatusActive, // 기본값: 사용 중
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
 }
 
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (s *Schedule) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	now := time.Now()
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (s *Schedule) BeforeUpdate(tx *gorm.DB) error {
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
 // IsActive - 활성 일정인지 확인
 func (s *Schedule) IsActive() bool {
 	return s.Status == ScheduleStatusActive && s.DeletedAt == nil
@@ -121,6 +145,31 @@ func (s *Schedule) IsActiveOnDate(date time.Time) bool {
 	return false
 }
 
+// SharesDayWith - 다른 일정과 운행 요일이 하나라도 겹치는지 확인
+func (s *Schedule) SharesDayWith(other *Schedule) bool {
+	days := make(map[int]bool, len(s.DaysOfWeek))
+	for _, day := range s.DaysOfWeek {
+		days[day] = true
+	}
+	for _, day := range other.DaysOfWeek {
+		if days[day] {
+			return true
+		}
+	}
+	return false
+}
+
+// PeriodOverlaps - 다른 일정과 유효 기간이 겹치는지 확인 (기간이 없으면 무기한)
+func (s *Schedule) PeriodOverlaps(other *Schedule) bool {
+	if s.ValidTo != nil && other.ValidFrom != nil && s.ValidTo.Before(*other.ValidFrom) {
+		return false
+	}
+	if other.ValidTo != nil && s.ValidFrom != nil && other.ValidTo.Before(*s.ValidFrom) {
+		return false
+	}
+	return true
+}
+
 // SetActive - 활성 상태로 변경
 func (s *Schedule) SetActive() {
 	s.Status = ScheduleStatusActive
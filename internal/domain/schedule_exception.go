@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 일정 예외 날짜 (하루만 운행 안 함 / 하루만 추가 운행)
+// 🎯 실무 포인트: "2025-05-05 운행 없음", "2025-07-20 오후 추가 운행" 같은 일회성 변경을 일정 수정 없이 처리
+// 운행 생성 시 요일/유효 기간/공휴일 판단보다 우선
+// ⚠️ 주의사항: 일정 + 날짜당 예외 하나, 비활성 일정은 추가 운행 예외가 있어도 운행하지 않음
+
+// ScheduleExceptionType - 예외 종류
+type ScheduleExceptionType string
+
+const (
+	ScheduleExceptionSkip  ScheduleExceptionType = "skip"  // 그날 운행 안 함
+	ScheduleExceptionExtra ScheduleExceptionType = "extra" // 그날 추가 운행 (운행 요일/유효 기간/공휴일과 무관)
+)
+
+// ScheduleException - 일정 예외 날짜
+type ScheduleException struct {
+	ID         string                `json:"id" gorm:"type:uuid;primaryKey"`
+	ScheduleID string                `json:"schedule_id" gorm:"type:uuid;not null;uniqueIndex:idx_schedule_exceptions_schedule_date"`
+	Date       time.Time             `json:"date" gorm:"type:date;not null;uniqueIndex:idx_schedule_exceptions_schedule_date"`
+	Type       ScheduleExceptionType `json:"type" gorm:"type:varchar(10);not null"`
+	Reason     string                `json:"reason" gorm:"type:varchar(200)"` // 예: "어린이날 휴원", "여름 캠프"
+	CreatedAt  time.Time             `json:"created_at"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+}
+
+// NewScheduleException - 일정 예외 생성 팩토리 함수 (날짜는 자정으로 맞춤)
+func NewScheduleException(scheduleID string, date time.Time, exceptionType ScheduleExceptionType, reason string) *ScheduleException {
+	now := time.Now()
+	return &ScheduleException{
+		ID:         uuid.New().String(),
+		ScheduleID: scheduleID,
+		Date:       time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		Type:       exceptionType,
+		Reason:     reason,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// IsSkip - 그날 운행하지 않는 예외인지 확인
+func (e *ScheduleException) IsSkip() bool {
+	return e.Type == ScheduleExceptionSkip
+}
+
+// IsExtra - 그날 추가 운행하는 예외인지 확인
+func (e *ScheduleException) IsExtra() bool {
+	return e.Type == ScheduleExceptionExtra
+}
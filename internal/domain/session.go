@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// 📝 설명: 로그인 세션 (발급된 액세스 토큰 단위)
+// 🎯 실무 포인트: 세션 ID는 JWT의 jti 클레임과 같음
+// ⚠️ 주의사항: DB가 아닌 Redis에 토큰 만료 시각까지만 보관
+
+// Session - 활성 로그인 세션
+type Session struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
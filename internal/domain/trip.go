@@ -3,6 +3,9 @@ package domain
 import (
 	"fmt"
 	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // 📝 설명: 실제 운행 기록 (날짜별 생성)
@@ -15,38 +18,48 @@ type TripStatus string
 const (
 	TripStatusPending    TripStatus = "pending"     // 대기 중
 	TripStatusInProgress TripStatus = "in_progress" // 운행 중
+	TripStatusPaused     TripStatus = "paused"      // 일시정지 (휴식, 병원 대기 등)
 	TripStatusCompleted  TripStatus = "completed"   // 완료
 	TripStatusCancelled  TripStatus = "cancelled"   // 취소
 )
 
 // Trip - 실제 운행 엔티티
 type Trip struct {
-	ID         string     `json:"id"`
-	ScheduleID string     `json:"schedule_id"` // 어떤 일정인지
-	Date       time.Time  `json:"date"`        // 운행 날짜
-	Status     TripStatus `json:"status"`
+	ID             string     `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string     `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	ScheduleID     string     `json:"schedule_id" gorm:"type:uuid;not null"`           // 어떤 일정인지
+	Date           time.Time  `json:"date" gorm:"type:date;not null"`                  // 운행 날짜
+	Status         TripStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending'"`
 
 	// 배정 정보 (Schedule의 기본값에서 변경 가능)
-	VehicleID           string  `json:"vehicle_id"`
-	AssignedDriverID    string  `json:"assigned_driver_id"`
-	AssignedAttendantID *string `json:"assigned_attendant_id,omitempty"`
+	VehicleID           string  `json:"vehicle_id" gorm:"type:uuid;not null"`
+	AssignedDriverID    string  `json:"assigned_driver_id" gorm:"type:uuid;not null;index"`
+	AssignedAttendantID *string `json:"assigned_attendant_id,omitempty" gorm:"type:uuid"`
 
 	// 운행 기록
-	StartedAt   *time.Time `json:"started_at,omitempty"`   // 실제 출발 시각
-	CompletedAt *time.Time `json:"completed_at,omitempty"` // 실제 완료 시각
-	StartedBy   string     `json:"started_by,omitempty"`   // 누가 시작했는지 (driver:{id} or attendant:{id})
+	StartedAt        *time.Time  `json:"started_at,omitempty"`                               // 실제 출발 시각
+	CompletedAt      *time.Time  `json:"completed_at,omitempty"`                             // 실제 완료 시각
+	StartedBy        string      `json:"started_by,omitempty"`                               // 누가 시작했는지 (driver:{id} or attendant:{id})
+	SignalLostAt     *time.Time  `json:"signal_lost_at,omitempty"`                           // 위치 수신 끊김 감지 시각 (운행 중 위치가 다시 오면 해제)
+	DelayedAt        *time.Time  `json:"delayed_at,omitempty"`                               // 지연 표시 시각 (출발 예정 시각 경과 자동 감지 또는 기사/관리자 보고)
+	DelayReason      string      `json:"delay_reason,omitempty"`                             // 지연 사유 (마지막 보고 기준)
+	PausedSeconds    int         `json:"paused_seconds,omitempty"`                           // 누적 일시정지 시간 (초, 재개/완료/취소 시 가산)
+	Pauses           []TripPause `json:"pauses,omitempty" gorm:"type:jsonb;serializer:json"` // 일시정지 이력 (마지막 항목이 진행 중일 수 있음)
+	VehicleCheckedAt *time.Time  `json:"vehicle_checked_at,omitempty"`                       // 차량 내부 잔류 인원 없음 확인 시각 (운행 완료 전 필수)
+	VehicleCheckedBy string      `json:"vehicle_checked_by,omitempty"`                       // 확인한 승무원 (driver:{id} or attendant:{id})
 
 	// 운행 정보
-	ActualStartLocation *Location `json:"actual_start_location,omitempty"` // 실제 출발 위치
-	ActualEndLocation   *Location `json:"actual_end_location,omitempty"`   // 실제 도착 위치
-	TotalDistance       int       `json:"total_distance,omitempty"`        // 총 주행 거리 (미터)
+	ActualStartLocation *Location `json:"actual_start_location,omitempty" gorm:"type:jsonb;serializer:json"` // 실제 출발 위치
+	ActualEndLocation   *Location `json:"actual_end_location,omitempty" gorm:"type:jsonb;serializer:json"`   // 실제 도착 위치
+	TotalDistance       int       `json:"total_distance,omitempty"`                                          // 총 주행 거리 (미터)
+	OdometerKm          *int      `json:"odometer_km,omitempty"`                                             // 완료 시 주행거리계 (km, 기사 확인 값 또는 주행 거리로 계산)
 
 	// 탑승 기록
-	TripPassengers []TripPassenger `json:"trip_passengers,omitempty"` // 탑승자별 기록
+	TripPassengers []TripPassenger `json:"trip_passengers,omitempty" gorm:"foreignKey:TripID"` // 탑승자별 기록
 
 	// 취소 정보
-	CancelledAt     *time.Time `json:"cancelled_at,omitempty"`
-	CancellationReason string  `json:"cancellation_reason,omitempty"`
+	CancelledAt        *time.Time `json:"cancelled_at,omitempty"`
+	CancellationReason string     `json:"cancellation_reason,omitempty"`
 
 	// 메모
 	Notes string `json:"notes,omitempty"`
@@ -54,7 +67,7 @@ type Trip struct {
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"` // Soft delete
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
 }
 
 // Location - 위치 정보
@@ -64,21 +77,49 @@ type Location struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// TripPause - 운행 일시정지 1회 기록
+type TripPause struct {
+	Reason    string     `json:"reason"`
+	PausedBy  string     `json:"paused_by"` // driver:{id} or attendant:{id}
+	PausedAt  time.Time  `json:"paused_at"`
+	ResumedBy string     `json:"resumed_by,omitempty"`
+	ResumedAt *time.Time `json:"resumed_at,omitempty"` // nil이면 일시정지 중
+}
+
+// Duration - 일시정지 시간 (재개 전이면 now까지)
+func (p *TripPause) Duration(now time.Time) time.Duration {
+	if p.ResumedAt != nil {
+		now = *p.ResumedAt
+	}
+	return now.Sub(p.PausedAt)
+}
+
 // TripPassenger - 탑승자별 운행 기록
 type TripPassenger struct {
-	ID          string     `json:"id"`
-	TripID      string     `json:"trip_id"`
-	PassengerID string     `json:"passenger_id"`
-	StopID      string     `json:"stop_id"` // 어느 정류장
+	ID          string     `json:"id" gorm:"type:uuid;primaryKey"`
+	TripID      string     `json:"trip_id" gorm:"type:uuid;not null"`
+	PassengerID string     `json:"passenger_id" gorm:"type:uuid;not null"`
+	StopID      string     `json:"stop_id" gorm:"type:uuid;not null"` // 어느 정류장
 
 	// 탑승 기록
 	BoardedAt   *time.Time `json:"boarded_at,omitempty"`   // 탑승 시각
 	AlightedAt  *time.Time `json:"alighted_at,omitempty"`  // 하차 시각
 	IsBoarded   bool       `json:"is_boarded"`             // 탑승 여부
 	IsAlighted  bool       `json:"is_alighted"`            // 하차 여부
+	BoardedBy        string    `json:"boarded_by,omitempty"`                                            // 승차 기록자 (driver:{id} or attendant:{id})
+	BoardedLocation  *Location `json:"boarded_location,omitempty" gorm:"type:jsonb;serializer:json"`  // 승차 기록 위치 (앱 GPS)
+	AlightedBy       string    `json:"alighted_by,omitempty"`                                           // 하차 기록자
+	AlightedLocation *Location `json:"alighted_location,omitempty" gorm:"type:jsonb;serializer:json"` // 하차 기록 위치 (앱 GPS)
 
 	// 추가 정보
 	NoShowReason string     `json:"no_show_reason,omitempty"` // 불참 사유
+	NoShowAt     *time.Time `json:"no_show_at,omitempty"`     // 불참 처리 시각 (일일 출결 집계 기준)
+	NoShowBy     string     `json:"no_show_by,omitempty"`     // 불참 처리자 (정류장 출발 시 자동 처리는 system)
+	ExcusedAt     *time.Time `json:"excused_at,omitempty"`     // 결석 신고 반영 시각 (불참이 아닌 사전 신고 결석)
+	ExcusedReason string     `json:"excused_reason,omitempty"` // 결석 신고 사유
+	ExceptionAt     *time.Time `json:"exception_at,omitempty"`     // 승차 후 예외 처리 시각 (하차 기록 없이 차량을 떠난 경우)
+	ExceptionReason string     `json:"exception_reason,omitempty"` // 예외 사유 (예: "중간에 보호자 인계", "병원 이송")
+	ExceptionBy     string     `json:"exception_by,omitempty"`     // 예외 처리자
 	Notes        string     `json:"notes,omitempty"`
 
 	// 메타데이터
@@ -90,6 +131,7 @@ type TripPassenger struct {
 func NewTrip(scheduleID string, date time.Time, vehicleID, driverID string, attendantID *string) *Trip {
 	now := time.Now()
 	return &Trip{
+		ID:                  uuid.New().String(), // UUID 자동 생성
 		ScheduleID:          scheduleID,
 		Date:                date,
 		VehicleID:           vehicleID,
@@ -106,6 +148,7 @@ func NewTrip(scheduleID string, date time.Time, vehicleID, driverID string, atte
 func NewTripPassenger(tripID, passengerID, stopID string) *TripPassenger {
 	now := time.Now()
 	return &TripPassenger{
+		ID:          uuid.New().String(),
 		TripID:      tripID,
 		PassengerID: passengerID,
 		StopID:      stopID,
@@ -116,6 +159,23 @@ func NewTripPassenger(tripID, passengerID, stopID string) *TripPassenger {
 	}
 }
 
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (t *Trip) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+	now := time.Now()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (t *Trip) BeforeUpdate(tx *gorm.DB) error {
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
 // IsPending - 대기 중인 운행인지
 func (t *Trip) IsPending() bool {
 	return t.Status == TripStatusPending
@@ -126,6 +186,16 @@ func (t *Trip) IsInProgress() bool {
 	return t.Status == TripStatusInProgress
 }
 
+// IsPaused - 일시정지 중인지
+func (t *Trip) IsPaused() bool {
+	return t.Status == TripStatusPaused
+}
+
+// IsActive - 출발 후 완료 전인지 (운행 중 또는 일시정지)
+func (t *Trip) IsActive() bool {
+	return t.IsInProgress() || t.IsPaused()
+}
+
 // IsCompleted - 완료된 운행인지
 func (t *Trip) IsCompleted() bool {
 	return t.Status == TripStatusCompleted
@@ -141,9 +211,9 @@ func (t *Trip) CanStart() bool {
 	return t.Status == TripStatusPending
 }
 
-// CanComplete - 운행 완료 가능한지
+// CanComplete - 운행 완료 가능한지 (일시정지 중이면 일시정지를 끝내고 완료)
 func (t *Trip) CanComplete() bool {
-	return t.Status == TripStatusInProgress
+	return t.Status == TripStatusInProgress || t.Status == TripStatusPaused
 }
 
 // Start - 운행 시작
@@ -162,16 +232,21 @@ func (t *Trip) Start(startedBy string, location *Location) error {
 	return nil
 }
 
-// Complete - 운행 완료
+// Complete - 운행 완료 (차내 잔류 탑승자가 없고 차량 내부 확인을 마친 경우만)
 func (t *Trip) Complete(location *Location) error {
 	if !t.CanComplete() {
 		return fmt.Errorf("cannot complete trip: current status is %s", t.Status)
 	}
+	if len(t.OnBoardPassengers()) > 0 || !t.IsVehicleChecked() {
+		return fmt.Errorf("cannot complete trip: vehicle not checked empty")
+	}
 
 	now := time.Now()
+	t.endPause("", now)
 	t.Status = TripStatusCompleted
 	t.CompletedAt = &now
 	t.ActualEndLocation = location
+	t.SignalLostAt = nil
 	t.UpdatedAt = now
 
 	return nil
@@ -184,14 +259,145 @@ func (t *Trip) Cancel(reason string) error {
 	}
 
 	now := time.Now()
+	t.endPause("", now)
 	t.Status = TripStatusCancelled
 	t.CancelledAt = &now
+	t.SignalLostAt = nil
 	t.CancellationReason = reason
 	t.UpdatedAt = now
 
 	return nil
 }
 
+// Pause - 운행 일시정지 (운행 중일 때만, 위치 수신 끊김 표시는 해제)
+func (t *Trip) Pause(pausedBy, reason string) error {
+	if !t.IsInProgress() {
+		return fmt.Errorf("cannot pause trip: current status is %s", t.Status)
+	}
+
+	now := time.Now()
+	t.Status = TripStatusPaused
+	t.Pauses = append(t.Pauses, TripPause{Reason: reason, PausedBy: pausedBy, PausedAt: now})
+	t.SignalLostAt = nil
+	t.UpdatedAt = now
+
+	return nil
+}
+
+// Resume - 일시정지 후 운행 재개 (일시정지 시간 누적)
+func (t *Trip) Resume(resumedBy string) error {
+	if !t.IsPaused() {
+		return fmt.Errorf("cannot resume trip: current status is %s", t.Status)
+	}
+
+	now := time.Now()
+	t.endPause(resumedBy, now)
+	t.Status = TripStatusInProgress
+	t.UpdatedAt = now
+
+	return nil
+}
+
+// CurrentPause - 진행 중인 일시정지 (없으면 nil)
+func (t *Trip) CurrentPause() *TripPause {
+	if len(t.Pauses) == 0 || t.Pauses[len(t.Pauses)-1].ResumedAt != nil {
+		return nil
+	}
+	return &t.Pauses[len(t.Pauses)-1]
+}
+
+// endPause - 진행 중인 일시정지를 끝내고 누적 시간에 가산
+func (t *Trip) endPause(resumedBy string, at time.Time) {
+	pause := t.CurrentPause()
+	if pause == nil {
+		return
+	}
+	pause.ResumedBy = resumedBy
+	pause.ResumedAt = &at
+	t.PausedSeconds += int(pause.Duration(at).Seconds())
+}
+
+// Reassign - 차량/기사/동승자 변경 (완료/취소된 운행 제외, attendantID가 nil이면 동승자 없음)
+// 차량이 바뀌면 이전 차량의 내부 확인 기록은 무효
+func (t *Trip) Reassign(vehicleID, driverID string, attendantID *string) error {
+	if t.IsCompleted() || t.IsCancelled() {
+		return fmt.Errorf("cannot reassign trip: current status is %s", t.Status)
+	}
+
+	if t.VehicleID != vehicleID {
+		t.VehicleCheckedAt = nil
+		t.VehicleCheckedBy = ""
+	}
+	t.VehicleID = vehicleID
+	t.AssignedDriverID = driverID
+	t.AssignedAttendantID = attendantID
+	t.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// OnBoardPassengers - 승차 후 하차/예외 처리되지 않은 탑승 기록 (차내 잔류 가능 인원)
+func (t *Trip) OnBoardPassengers() []TripPassenger {
+	var onBoard []TripPassenger
+	for _, record := range t.TripPassengers {
+		if record.IsOnBoard() {
+			onBoard = append(onBoard, record)
+		}
+	}
+	return onBoard
+}
+
+// ConfirmVehicleEmpty - 차량 내부 잔류 인원 없음 확인 (운행 중/일시정지 중, 차내 잔류 탑승자가 없을 때만)
+func (t *Trip) ConfirmVehicleEmpty(checkedBy string) error {
+	if !t.IsActive() {
+		return fmt.Errorf("cannot check vehicle: current status is %s", t.Status)
+	}
+	if len(t.OnBoardPassengers()) > 0 {
+		return fmt.Errorf("cannot check vehicle: passengers still on board")
+	}
+
+	now := time.Now()
+	t.VehicleCheckedAt = &now
+	t.VehicleCheckedBy = checkedBy
+	t.UpdatedAt = now
+
+	return nil
+}
+
+// IsVehicleChecked - 마지막 승차/하차/예외 기록 이후에 차량 내부를 확인했는지
+func (t *Trip) IsVehicleChecked() bool {
+	if t.VehicleCheckedAt == nil {
+		return false
+	}
+	for _, record := range t.TripPassengers {
+		for _, at := range []*time.Time{record.BoardedAt, record.AlightedAt, record.ExceptionAt} {
+			if at != nil && at.After(*t.VehicleCheckedAt) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MarkDelayed - 지연 표시 (처음 표시한 시각은 유지하고 사유만 갱신)
+func (t *Trip) MarkDelayed(reason string, at time.Time) {
+	if t.DelayedAt == nil {
+		t.DelayedAt = &at
+	}
+	t.DelayReason = reason
+	t.UpdatedAt = at
+}
+
+// IsDelayed - 지연 표시 여부
+func (t *Trip) IsDelayed() bool {
+	return t.DelayedAt != nil
+}
+
+// IsSignalLost - 운행 중 위치 수신이 끊겼는지
+func (t *Trip) IsSignalLost() bool {
+	return t.SignalLostAt != nil
+}
+
 // GetDuration - 운행 소요 시간 (분)
 func (t *Trip) GetDuration() int {
 	if t.StartedAt == nil || t.CompletedAt == nil {
@@ -200,29 +406,90 @@ func (t *Trip) GetDuration() int {
 	return int(t.CompletedAt.Sub(*t.StartedAt).Minutes())
 }
 
-// BoardPassenger - 탑승자 탑승 처리
-func (tp *TripPassenger) BoardPassenger() {
+// BoardPassenger - 탑승자 탑승 처리 (불참 처리 후 늦게 탄 경우 불참 기록 해제)
+// by: 기록자, location: 기록 위치 (없으면 nil)
+func (tp *TripPassenger) BoardPassenger(by string, location *Location) {
 	now := time.Now()
 	tp.IsBoarded = true
 	tp.BoardedAt = &now
+	tp.BoardedBy = by
+	tp.BoardedLocation = location
+	tp.NoShowReason = ""
+	tp.NoShowAt = nil
+	tp.NoShowBy = ""
 	tp.UpdatedAt = now
 }
 
 // AlightPassenger - 탑승자 하차 처리
-func (tp *TripPassenger) AlightPassenger() {
+func (tp *TripPassenger) AlightPassenger(by string, location *Location) {
 	now := time.Now()
 	tp.IsAlighted = true
 	tp.AlightedAt = &now
+	tp.AlightedBy = by
+	tp.AlightedLocation = location
 	tp.UpdatedAt = now
 }
 
 // MarkNoShow - 불참 처리
-func (tp *TripPassenger) MarkNoShow(reason string) {
+func (tp *TripPassenger) MarkNoShow(reason, by string) {
+	now := time.Now()
 	tp.IsBoarded = false
 	tp.NoShowReason = reason
+	tp.NoShowAt = &now
+	tp.NoShowBy = by
+	tp.UpdatedAt = now
+}
+
+// IsNoShow - 불참 처리 여부
+func (tp *TripPassenger) IsNoShow() bool {
+	return tp.NoShowAt != nil
+}
+
+// MarkExcused - 결석 신고 반영 (불참으로 처리하지 않음)
+func (tp *TripPassenger) MarkExcused(reason string) {
+	now := time.Now()
+	tp.ExcusedAt = &now
+	tp.ExcusedReason = reason
+	tp.UpdatedAt = now
+}
+
+// ClearExcused - 결석 신고 취소 반영
+func (tp *TripPassenger) ClearExcused() {
+	tp.ExcusedAt = nil
+	tp.ExcusedReason = ""
 	tp.UpdatedAt = time.Now()
 }
 
+// IsExcused - 결석 신고 여부
+func (tp *TripPassenger) IsExcused() bool {
+	return tp.ExcusedAt != nil
+}
+
+// FlagException - 승차 후 하차 기록 없이 차량을 떠난 탑승자 예외 처리 (승차 중일 때만)
+func (tp *TripPassenger) FlagException(reason, by string) error {
+	if !tp.IsOnBoard() {
+		return fmt.Errorf("cannot flag exception: passenger is not on board")
+	}
+
+	now := time.Now()
+	tp.ExceptionAt = &now
+	tp.ExceptionReason = reason
+	tp.ExceptionBy = by
+	tp.UpdatedAt = now
+
+	return nil
+}
+
+// HasException - 예외 처리 여부
+func (tp *TripPassenger) HasException() bool {
+	return tp.ExceptionAt != nil
+}
+
+// IsOnBoard - 승차 후 하차/예외 처리되지 않았는지 (운행 완료 전 차내 잔류 확인 대상)
+func (tp *TripPassenger) IsOnBoard() bool {
+	return tp.IsBoarded && !tp.IsAlighted && !tp.HasException()
+}
+
 // GetBoardingDuration - 탑승 시간 (분)
 func (tp *TripPassenger) GetBoardingDuration() int {
 	if tp.BoardedAt == nil || tp.AlightedAt == nil {
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 운행 중 위험 운전 경보 (과속, 어린이 보호구역 과속, 급가속/급감속)
+// 🎯 실무 포인트: 수신한 GPS 속도로 자동 판정해 운행별로 기록 → 관리자 사후 점검/기사 교육 근거
+// ⚠️ 주의사항: 시스템이 생성하는 append-only 기록 (수정/삭제 없음)
+
+// AlertType - 경보 종류
+type AlertType string
+
+const (
+	AlertSpeeding           AlertType = "speeding"             // 전체 제한 속도 초과
+	AlertSchoolZoneSpeeding AlertType = "school_zone_speeding" // 어린이 보호구역 제한 속도 초과
+	AlertHarshAcceleration  AlertType = "harsh_acceleration"   // 급가속
+	AlertHarshBraking       AlertType = "harsh_braking"        // 급감속
+)
+
+// TripAlert - 운행 경보
+type TripAlert struct {
+	ID         string    `json:"id" gorm:"type:uuid;primaryKey"`
+	TripID     string    `json:"trip_id" gorm:"type:uuid;not null"`
+	VehicleID  string    `json:"vehicle_id" gorm:"type:uuid;not null"`
+	Type       AlertType `json:"type" gorm:"type:varchar(30);not null"`
+	Value      float64   `json:"value"`     // 측정값 (속도 km/h, 급가속/급감속은 초당 속도 변화 km/h)
+	Threshold  float64   `json:"threshold"` // 판정 기준값 (같은 단위)
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	OccurredAt time.Time `json:"occurred_at"` // 위치 측정 시각
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// NewTripAlert - 위치 기록으로 경보 생성
+func NewTripAlert(position *VehiclePosition, alertType AlertType, value, threshold float64) *TripAlert {
+	return &TripAlert{
+		ID:         uuid.New().String(),
+		TripID:     position.TripID,
+		VehicleID:  position.VehicleID,
+		Type:       alertType,
+		Value:      value,
+		Threshold:  threshold,
+		Latitude:   position.Latitude,
+		Longitude:  position.Longitude,
+		OccurredAt: position.RecordedAt,
+		CreatedAt:  time.Now(),
+	}
+}
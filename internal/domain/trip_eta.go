@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// 📝 설명: 운행 정류장별 도착 예정 시간
+// 🎯 실무 포인트: 마지막 수신 위치에서 남은 정류장 순서대로 계산해 보호자 앱에 "7분 후 도착" 표시
+// ⚠️ 주의사항: 도착 예정 시각(ArrivalAt)으로 저장 (남은 분은 조회 시점 기준으로 다시 계산)
+
+// TripETA - 운행의 남은 정류장 도착 예정 시간
+type TripETA struct {
+	TripID       string    `json:"trip_id"`
+	Latitude     float64   `json:"latitude"`    // 계산 기준 위치
+	Longitude    float64   `json:"longitude"`   // 계산 기준 위치
+	PositionAt   time.Time `json:"position_at"` // 계산 기준 위치 측정 시각
+	CalculatedAt time.Time `json:"calculated_at"`
+	Stops        []StopETA `json:"stops"` // 남은 정류장 (순서대로)
+}
+
+// StopETA - 정류장 도착 예정
+type StopETA struct {
+	StopID    string    `json:"stop_id"`
+	StopName  string    `json:"stop_name"`
+	StopOrder int       `json:"stop_order"`
+	Distance  float64   `json:"distance"`   // 경로를 따라 남은 거리 (미터)
+	ArrivalAt time.Time `json:"arrival_at"` // 도착 예정 시각
+	Arrived   bool      `json:"arrived"`    // 현재 정류장에 도착해 있음
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 운행 중 정류장 도착/출발 기록 (지오펜스 자동 판정 또는 기사/동승자 수동 기록)
+// 🎯 실무 포인트: 정류장별 실제 도착 시각과 예상 도착 시각을 비교하는 정시성 보고서의 근거
+// ⚠️ 주의사항: 운행 + 정류장 + 종류당 한 건만 기록 (먼저 들어온 기록 유지, 수정/삭제 없음)
+
+// StopEventType - 정류장 기록 종류
+type StopEventType string
+
+const (
+	StopEventArrived  StopEventType = "arrived"  // 정류장 도착
+	StopEventDeparted StopEventType = "departed" // 정류장 출발
+)
+
+// StopEventSource - 기록 출처
+type StopEventSource string
+
+const (
+	StopEventSourceGeofence StopEventSource = "geofence" // 지오펜스 자동 판정
+	StopEventSourceManual   StopEventSource = "manual"   // 기사/동승자 앱에서 수동 기록
+)
+
+// TripStopEvent - 운행 정류장 도착/출발 기록
+type TripStopEvent struct {
+	ID         string          `json:"id" gorm:"type:uuid;primaryKey"`
+	TripID     string          `json:"trip_id" gorm:"type:uuid;not null"`
+	StopID     string          `json:"stop_id" gorm:"type:uuid;not null"`
+	Type       StopEventType   `json:"type" gorm:"type:varchar(20);not null"`
+	Source     StopEventSource `json:"source" gorm:"type:varchar(20);not null"`
+	RecordedBy string          `json:"recorded_by"`                                          // driver:{id} / attendant:{id} / system
+	Location   *Location       `json:"location,omitempty" gorm:"type:jsonb;serializer:json"` // 기록 위치 (앱 GPS, 지오펜스는 없음)
+	OccurredAt time.Time       `json:"occurred_at"`                                          // 도착/출발 시각
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// NewTripStopEvent - 정류장 도착/출발 기록 생성
+func NewTripStopEvent(tripID, stopID string, eventType StopEventType, source StopEventSource, recordedBy string, location *Location, occurredAt time.Time) *TripStopEvent {
+	return &TripStopEvent{
+		ID:         uuid.New().String(),
+		TripID:     tripID,
+		StopID:     stopID,
+		Type:       eventType,
+		Source:     source,
+		RecordedBy: recordedBy,
+		Location:   location,
+		OccurredAt: occurredAt,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// 📝 설명: 인증 주체(사용자/API 키)별 일일 요청 사용량
+// 🎯 실무 포인트: 외부 연동사가 GET /me/usage로 남은 한도를 확인하고 호출량을 조절
+// ⚠️ 주의사항: 하루 기준은 한국 시간(KST) 자정 → 연동사 문서에도 명시
+
+// UsageIdentityType - 사용량 집계 단위
+type UsageIdentityType string
+
+const (
+	UsageIdentityUser   UsageIdentityType = "user"    // 로그인 사용자
+	UsageIdentityAPIKey UsageIdentityType = "api_key" // 서버 간 연동 API 키
+)
+
+// quotaLocation - 일일 한도 기준 시간대 (KST, 서머타임 없음)
+var quotaLocation = time.FixedZone("KST", 9*60*60)
+
+// Usage - 일일 요청 사용량
+type Usage struct {
+	IdentityType UsageIdentityType `json:"identity_type"`
+	IdentityID   string            `json:"identity_id"`
+	Date         string            `json:"date"`      // 집계일 (YYYY-MM-DD, KST)
+	Used         int64             `json:"used"`      // 오늘 요청 수 (한도 초과로 거부된 요청 포함)
+	Limit        int64             `json:"limit"`     // 일일 한도 (0이면 무제한)
+	Remaining    int64             `json:"remaining"` // 남은 요청 수 (무제한이면 -1)
+	ResetsAt     time.Time         `json:"resets_at"` // 다음 집계 시작 시각
+}
+
+// NewUsage - now 기준 집계일의 사용량
+func NewUsage(identityType UsageIdentityType, identityID string, used, limit int64, now time.Time) *Usage {
+	day := QuotaDay(now)
+	usage := &Usage{
+		IdentityType: identityType,
+		IdentityID:   identityID,
+		Date:         day.Format("2006-01-02"),
+		Used:         used,
+		Limit:        limit,
+		Remaining:    -1,
+		ResetsAt:     day.AddDate(0, 0, 1),
+	}
+	if limit > 0 {
+		usage.Remaining = max(limit-used, 0)
+	}
+	return usage
+}
+
+// Exceeded - 일일 한도 초과 여부
+func (u *Usage) Exceeded() bool {
+	return u.Limit > 0 && u.Used > u.Limit
+}
+
+// QuotaDay - 일일 한도 집계일 시작 시각 (KST 자정)
+func QuotaDay(t time.Time) time.Time {
+	local := t.In(quotaLocation)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, quotaLocation)
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 로그인 계정 도메인 모델
+// 🎯 실무 포인트: 계정(User)과 업무 프로필(Driver/Attendant/Guardian)을 분리, ProfileID로 연결
+// ⚠️ 주의사항: 비밀번호는 해시만 저장하며 JSON 응답에 절대 포함하지 않음
+
+// UserStatus - 계정 상태
+type UserStatus string
+
+const (
+	UserStatusActive   UserStatus = "active"   // 사용 가능
+	UserStatusDisabled UserStatus = "disabled" // 비활성 (로그인 불가)
+)
+
+// User - 계정 엔티티
+type User struct {
+	ID             string     `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string     `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	Email          string     `json:"email" gorm:"not null"`                           // 로그인 ID (삭제되지 않은 계정 간 유일)
+	Phone          string     `json:"phone,omitempty"`                                 // 비밀번호 재설정 SMS 수신용
+	PasswordHash   string     `json:"-" gorm:"not null"`
+	Role           Role       `json:"role" gorm:"type:varchar(20);not null"`
+	ProfileID      string     `json:"profile_id,omitempty" gorm:"type:varchar(36)"` // 기사/동승자/보호자 ID (관리자는 빈 값)
+	Status         UserStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"`
+	Locale         string     `json:"locale,omitempty" gorm:"type:varchar(10);not null;default:''"` // 응답/이메일 언어 (빈 값이면 Accept-Language)
+
+	LastLoginAt       *time.Time `json:"last_login_at,omitempty"`
+	PasswordChangedAt time.Time  `json:"password_changed_at"`
+	DisabledAt        *time.Time `json:"disabled_at,omitempty"`
+
+	// 메타데이터
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"` // Soft delete
+}
+
+// PasswordResetToken - 비밀번호 재설정 토큰 (1회용)
+type PasswordResetToken struct {
+	ID        string     `json:"id" gorm:"type:uuid;primaryKey"`
+	UserID    string     `json:"user_id" gorm:"type:uuid;not null;index"`
+	TokenHash string     `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NewUser - 계정 생성 팩토리 함수
+func NewUser(email, passwordHash string, role Role, profileID string) *User {
+	now := time.Now()
+	return &User{
+		ID:                uuid.New().String(), // UUID 자동 생성
+		Email:             email,
+		PasswordHash:      passwordHash,
+		Role:              role,
+		ProfileID:         profileID,
+		Status:            UserStatusActive, // 기본값: 사용 가능
+		PasswordChangedAt: now,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
+
+// NewPasswordResetToken - 재설정 토큰 생성
+func NewPasswordResetToken(userID, tokenHash string, ttl time.Duration) *PasswordResetToken {
+	now := time.Now()
+	return &PasswordResetToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (u *User) BeforeCreate(tx *gorm.DB) error {
+	if u.ID == "" {
+		u.ID = uuid.New().String()
+	}
+	now := time.Now()
+	u.CreatedAt = now
+	u.UpdatedAt = now
+	return nil
+}
+
+// BeforeUpdate - GORM Hook: 업데이트 전 자동 처리
+func (u *User) BeforeUpdate(tx *gorm.DB) error {
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsActive - 로그인 가능한 계정인지 확인
+func (u *User) IsActive() bool {
+	return u.Status == UserStatusActive && u.DeletedAt == nil
+}
+
+// Disable - 계정 비활성화
+func (u *User) Disable() {
+	now := time.Now()
+	u.Status = UserStatusDisabled
+	u.DisabledAt = &now
+	u.UpdatedAt = now
+}
+
+// Enable - 계정 재활성화
+func (u *User) Enable() {
+	u.Status = UserStatusActive
+	u.DisabledAt = nil
+	u.UpdatedAt = time.Now()
+}
+
+// ChangeRole - 기관 내 역할 변경 (프로필 연결도 함께 교체)
+func (u *User) ChangeRole(role Role, profileID string) {
+	u.Role = role
+	u.ProfileID = profileID
+	u.UpdatedAt = time.Now()
+}
+
+// ChangePassword - 비밀번호 해시 교체
+func (u *User) ChangePassword(passwordHash string) {
+	now := time.Now()
+	u.PasswordHash = passwordHash
+	u.PasswordChangedAt = now
+	u.UpdatedAt = now
+}
+
+// RecordLogin - 로그인 시각 기록
+func (u *User) RecordLogin() {
+	now := time.Now()
+	u.LastLoginAt = &now
+}
+
+// IsUsable - 사용되지 않았고 만료되지 않은 토큰인지 확인
+func (t *PasswordResetToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -32,20 +32,23 @@ const (
 
 // Vehicle - 차량 엔티티
 type Vehicle struct {
-	ID           string        `json:"id" gorm:"type:uuid;primaryKey"`
-	PlateNumber  string        `json:"plate_number" gorm:"uniqueIndex;not null"` // 차량 번호 (예: "12가3456")
-	Model        string        `json:"model" gorm:"not null"`                    // 차량 모델 (예: "그랜드스타렉스")
-	Manufacturer string        `json:"manufacturer"`                             // 제조사 (예: "현대")
-	VehicleType  VehicleType   `json:"vehicle_type" gorm:"type:varchar(20);not null"` // 차량 유형
-	Capacity     int           `json:"capacity" gorm:"not null"`                 // 정원 (운전자 포함)
-	Year         int           `json:"year"`                                     // 연식
-	Color        string        `json:"color"`                                    // 색상
-	Status       VehicleStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"` // 차량 상태
+	ID             string        `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string        `json:"organization_id" gorm:"type:uuid;not null;index"`          // 소속 기관
+	PlateNumber    string        `json:"plate_number" gorm:"uniqueIndex;not null"`                 // 차량 번호 (예: "12가3456")
+	Model          string        `json:"model" gorm:"not null"`                                    // 차량 모델 (예: "그랜드스타렉스")
+	Manufacturer   string        `json:"manufacturer"`                                             // 제조사 (예: "현대")
+	VehicleType    VehicleType   `json:"vehicle_type" gorm:"type:varchar(20);not null"`            // 차량 유형
+	Capacity       int           `json:"capacity" gorm:"not null"`                                 // 정원 (운전자 포함)
+	Year           int           `json:"year"`                                                     // 연식
+	Color          string        `json:"color"`                                                    // 색상
+	Status         VehicleStatus `json:"status" gorm:"type:varchar(20);not null;default:'active'"` // 차량 상태
 
 	// 차량 관리 정보
 	InsuranceExpiry   *time.Time `json:"insurance_expiry,omitempty"`    // 보험 만료일
 	InspectionExpiry  *time.Time `json:"inspection_expiry,omitempty"`   // 정기검사 만료일
 	LastMaintenanceAt *time.Time `json:"last_maintenance_at,omitempty"` // 마지막 정비 날짜
+	OdometerKm        int        `json:"odometer_km" gorm:"not null;default:0"` // 현재 주행거리계 (km, 운행 완료/관리자 입력으로 갱신)
+	OdometerUpdatedAt *time.Time `json:"odometer_updated_at,omitempty"`         // 주행거리계 마지막 갱신 시각 (없으면 기준값 미입력)
 
 	// 메타데이터
 	CreatedAt time.Time  `json:"created_at"`
@@ -96,19 +99,22 @@ func (v *Vehicle) IsActive() bool {
 // IsAvailableForTrip - 운행에 사용 가능한지 확인
 // 정비 중이거나 보험/검사가 만료된 차량은 불가
 func (v *Vehicle) IsAvailableForTrip() bool {
+	return v.IsAvailableOn(time.Now())
+}
+
+// IsAvailableOn - 특정 시점에 운행에 사용 가능한지 확인 (운행 생성 계획 등 미래 날짜 판단)
+func (v *Vehicle) IsAvailableOn(at time.Time) bool {
 	if !v.IsActive() {
 		return false
 	}
 
-	now := time.Now()
-
 	// 보험 만료 확인
-	if v.InsuranceExpiry != nil && v.InsuranceExpiry.Before(now) {
+	if v.InsuranceExpiry != nil && v.InsuranceExpiry.Before(at) {
 		return false
 	}
 
 	// 정기검사 만료 확인
-	if v.InspectionExpiry != nil && v.InspectionExpiry.Before(now) {
+	if v.InspectionExpiry != nil && v.InspectionExpiry.Before(at) {
 		return false
 	}
 
@@ -129,6 +135,18 @@ func (v *Vehicle) SetActive() {
 	v.UpdatedAt = time.Now()
 }
 
+// HasOdometer - 주행거리계 기준값이 입력되었는지 (없으면 주행 거리로 계산할 수 없음)
+func (v *Vehicle) HasOdometer() bool {
+	return v.OdometerUpdatedAt != nil
+}
+
+// UpdateOdometer - 주행거리계 갱신
+func (v *Vehicle) UpdateOdometer(km int, at time.Time) {
+	v.OdometerKm = km
+	v.OdometerUpdatedAt = &at
+	v.UpdatedAt = time.Now()
+}
+
 // SetInactive - 비활성 상태로 변경 (폐차 등)
 func (v *Vehicle) SetInactive() {
 	v.Status = VehicleStatusInactive
@@ -149,22 +167,22 @@ func (v *Vehicle) UpdateInspectionExpiry(expiry time.Time) {
 
 // NeedsInsuranceRenewal - 보험 갱신 필요 여부 (30일 이내 만료)
 func (v *Vehicle) NeedsInsuranceRenewal() bool {
-	if v.InsuranceExpiry == nil {
-		return false
-	}
-
-	thirtyDaysLater := time.Now().AddDate(0, 0, 30)
-	return v.InsuranceExpiry.Before(thirtyDaysLater)
+	return v.InsuranceExpiresWithin(time.Now(), 30)
 }
 
 // NeedsInspection - 정기검사 필요 여부 (30일 이내 만료)
 func (v *Vehicle) NeedsInspection() bool {
-	if v.InspectionExpiry == nil {
-		return false
-	}
+	return v.InspectionExpiresWithin(time.Now(), 30)
+}
+
+// InsuranceExpiresWithin - at 기준 days일 이내 보험 만료 여부 (이미 만료 포함, 만료일이 없으면 false)
+func (v *Vehicle) InsuranceExpiresWithin(at time.Time, days int) bool {
+	return v.InsuranceExpiry != nil && v.InsuranceExpiry.Before(at.AddDate(0, 0, days))
+}
 
-	thirtyDaysLater := time.Now().AddDate(0, 0, 30)
-	return v.InspectionExpiry.Before(thirtyDaysLater)
+// InspectionExpiresWithin - at 기준 days일 이내 정기검사 만료 여부 (이미 만료 포함, 만료일이 없으면 false)
+func (v *Vehicle) InspectionExpiresWithin(at time.Time, days int) bool {
+	return v.InspectionExpiry != nil && v.InspectionExpiry.Before(at.AddDate(0, 0, days))
 }
 
 // GetPassengerCapacity - 승객 정원 (운전자 제외)
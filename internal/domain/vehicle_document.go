@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 차량 서류 (보험 증권, 정기검사 증명서, 자동차 등록증 등 원본 파일)
+// 🎯 실무 포인트: 감사/점검 때 날짜만이 아니라 실제 서류를 제출해야 함 → 파일은 files(원본은 internal/storage), 서류 정보는 vehicle_documents
+// 보험/정기검사 서류의 만료일은 차량 InsuranceExpiry/InspectionExpiry에 반영 (만료 안내 작업이 그대로 사용)
+// ⚠️ 주의사항: 만료일이 차량의 현재 만료일보다 늦을 때만 반영 → 지난 서류를 보관용으로 올려도 만료일이 되돌아가지 않음
+
+// VehicleDocumentType - 차량 서류 종류
+type VehicleDocumentType string
+
+const (
+	VehicleDocumentInsurance    VehicleDocumentType = "insurance"    // 보험 증권
+	VehicleDocumentInspection   VehicleDocumentType = "inspection"   // 정기검사 증명서
+	VehicleDocumentRegistration VehicleDocumentType = "registration" // 자동차 등록증
+	VehicleDocumentOther        VehicleDocumentType = "other"        // 기타 (어린이 통학버스 신고증명서 등)
+)
+
+// VehicleDocument - 차량 서류 엔티티 (파일 원본/형식/크기는 File)
+type VehicleDocument struct {
+	ID             string              `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string              `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관
+	VehicleID      string              `json:"vehicle_id" gorm:"type:uuid;not null;index"`
+	Type           VehicleDocumentType `json:"type" gorm:"type:varchar(20);not null"`
+	Title          string              `json:"title,omitempty" gorm:"type:varchar(100)"` // 예: "2025 DB손해보험 증권"
+	FileID         string              `json:"file_id" gorm:"type:uuid;not null"`
+	File           *File               `json:"file,omitempty" gorm:"foreignKey:FileID"`
+	IssuedOn       *time.Time          `json:"issued_on,omitempty" gorm:"type:date"`  // 발급일
+	ExpiresOn      *time.Time          `json:"expires_on,omitempty" gorm:"type:date"` // 만료일 (보험/정기검사는 차량 만료일에 반영)
+	UploadedBy     string              `json:"uploaded_by" gorm:"type:varchar(36)"`   // 업로드한 사용자 ID
+
+	// 메타데이터
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewVehicleDocument - 차량 서류 생성 팩토리 함수 (업로드한 파일과 연결)
+func NewVehicleDocument(vehicleID string, docType VehicleDocumentType, title string, file *File) *VehicleDocument {
+	return &VehicleDocument{
+		ID:         uuid.New().String(),
+		VehicleID:  vehicleID,
+		Type:       docType,
+		Title:      title,
+		FileID:     file.ID,
+		File:       file,
+		UploadedBy: file.UploadedBy,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// BeforeCreate - GORM Hook: 생성 전 자동 처리
+func (d *VehicleDocument) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// ApplyExpiry - 보험/정기검사 서류의 만료일을 차량 만료일에 반영 (현재 만료일보다 늦을 때만, 반영했으면 true)
+func (d *VehicleDocument) ApplyExpiry(v *Vehicle) bool {
+	if d.ExpiresOn == nil {
+		return false
+	}
+	switch d.Type {
+	case VehicleDocumentInsurance:
+		if v.InsuranceExpiry == nil || d.ExpiresOn.After(*v.InsuranceExpiry) {
+			v.UpdateInsuranceExpiry(*d.ExpiresOn)
+			return true
+		}
+	case VehicleDocumentInspection:
+		if v.InspectionExpiry == nil || d.ExpiresOn.After(*v.InspectionExpiry) {
+			v.UpdateInspectionExpiry(*d.ExpiresOn)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// 📝 설명: 운행 중 차량 실시간 위치
+// 🎯 실무 포인트: 기사 앱/차량 단말이 주기적으로 전송, 관리자 대시보드·보호자 앱에 실시간 전달
+// ⚠️ 주의사항: RecordedAt은 단말 측정 시각 (전송 지연이 있어도 측정 순서 유지)
+// 운행 경로 기록(trip_locations)으로 저장, 건수가 많아 감사 로그 대상에서 제외
+
+// VehiclePosition - 운행 중 차량 위치
+type VehiclePosition struct {
+	ID         int64     `json:"-" gorm:"primaryKey"`
+	TripID     string    `json:"trip_id" gorm:"type:uuid;not null"`
+	VehicleID  string    `json:"vehicle_id" gorm:"type:uuid;not null"`
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	Speed      *float64  `json:"speed,omitempty"`   // 속도 (km/h, 단말이 제공할 때만)
+	Heading    *float64  `json:"heading,omitempty"` // 진행 방향 (0~360도, 북쪽 0)
+	RecordedAt time.Time `json:"recorded_at"`
+	CreatedAt  time.Time `json:"-"`
+}
+
+// TableName - 운행 경로 기록 테이블
+func (VehiclePosition) TableName() string {
+	return "trip_locations"
+}
+
+// NewVehiclePosition - 운행 차량 위치 생성 (측정 시각이 없으면 현재 시각)
+func NewVehiclePosition(trip *Trip, latitude, longitude float64, recordedAt time.Time) *VehiclePosition {
+	if recordedAt.IsZero() {
+		recordedAt = time.Now()
+	}
+	return &VehiclePosition{
+		TripID:     trip.ID,
+		VehicleID:  trip.VehicleID,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		RecordedAt: recordedAt,
+	}
+}
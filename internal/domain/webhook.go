@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 📝 설명: 웹훅 구독과 전달 기록 (시설이 자체 시스템에서 운행/탑승 이벤트를 받는 연동)
+// 🎯 실무 포인트: 구독마다 받을 이벤트 종류와 서명 비밀키를 두고, 이벤트 발생 시 구독별로 전달 기록을 남김
+// 수신 서버 장애(5xx, 타임아웃)는 retrying으로 두고 재시도 작업이 지수 백오프로 다시 전달
+// ⚠️ 주의사항: 서명 비밀키는 암호화 저장하고 생성 시 한 번만 응답에 노출
+// 전달 기록은 전달 전에 pending으로 먼저 저장 → 전달 도중 서버가 종료되어도 재시도 작업이 이어서 전달
+
+// WebhookEvent - 웹훅 이벤트 종류
+type WebhookEvent string
+
+const (
+	WebhookEventTripStarted        WebhookEvent = "trip.started"        // 운행 시작
+	WebhookEventTripCompleted      WebhookEvent = "trip.completed"      // 운행 완료
+	WebhookEventTripCancelled      WebhookEvent = "trip.cancelled"      // 운행 취소
+	WebhookEventTripDelayed        WebhookEvent = "trip.delayed"        // 운행 지연 (자동 감지 또는 직접 보고)
+	WebhookEventTripReassigned     WebhookEvent = "trip.reassigned"     // 운행 배정 변경 (차량/기사/동승자 교체)
+	WebhookEventPassengerBoarded   WebhookEvent = "passenger.boarded"   // 승차
+	WebhookEventPassengerAlighted  WebhookEvent = "passenger.alighted"  // 하차
+	WebhookEventPassengerNoShow    WebhookEvent = "passenger.no_show"   // 미탑승
+	WebhookEventPassengerException WebhookEvent = "passenger.exception" // 승차 후 예외 처리 (하차 기록 없이 차량을 떠남)
+)
+
+// WebhookEvents - 구독할 수 있는 이벤트 전체
+var WebhookEvents = []WebhookEvent{
+	WebhookEventTripStarted,
+	WebhookEventTripCompleted,
+	WebhookEventTripCancelled,
+	WebhookEventTripDelayed,
+	WebhookEventTripReassigned,
+	WebhookEventPassengerBoarded,
+	WebhookEventPassengerAlighted,
+	WebhookEventPassengerNoShow,
+	WebhookEventPassengerException,
+}
+
+// WebhookSubscription - 웹훅 구독
+type WebhookSubscription struct {
+	ID             string         `json:"id" gorm:"type:uuid;primaryKey"`
+	OrganizationID string         `json:"organization_id" gorm:"type:uuid;not null;index"` // 소속 기관 (이 기관의 이벤트만 전달)
+	Name           string         `json:"name" gorm:"type:varchar(100);not null"`          // 용도 (예: "출결 관리 시스템")
+	URL            string         `json:"url" gorm:"type:varchar(500);not null"`
+	Secret         string         `json:"-" gorm:"not null;serializer:encrypted"` // 서명 비밀키
+	Events         []WebhookEvent `json:"events" gorm:"type:jsonb;serializer:json;not null"`
+	Active         bool           `json:"active" gorm:"not null;default:true"` // false면 전달하지 않음 (재시도 대기 중인 전달 포함)
+	CreatedBy      string         `json:"created_by"`                          // 등록한 관리자 ID
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewWebhookSubscription - 웹훅 구독 생성 팩토리 함수
+func NewWebhookSubscription(name, url, secret string, events []WebhookEvent, createdBy string) *WebhookSubscription {
+	now := time.Now()
+	return &WebhookSubscription{
+		ID:        uuid.New().String(),
+		Name:      name,
+		URL:       url,
+		Secret:    secret,
+		Events:    events,
+		Active:    true,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Subscribes - 이벤트를 받는 활성 구독인지
+func (s *WebhookSubscription) Subscribes(event WebhookEvent) bool {
+	return s.Active && slices.Contains(s.Events, event)
+}
+
+// WebhookDeliveryStatus - 전달 상태
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"   // 전달 전 (중단되면 재시도 작업이 전달)
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered" // 2xx 응답
+	WebhookDeliveryRetrying  WebhookDeliveryStatus = "retrying"  // 일시 장애, 재시도 대기
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"    // 재시도해도 같은 결과 (4xx, 구독 삭제/비활성)
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead"      // 최대 시도 횟수 초과 (dead letter)
+)
+
+// WebhookDelivery - 웹훅 전달 기록 (구독 × 이벤트 하나)
+type WebhookDelivery struct {
+	ID             string                `json:"id" gorm:"type:uuid;primaryKey"`                  // X-Eodini-Delivery 헤더 값
+	OrganizationID string                `json:"organization_id" gorm:"type:uuid;not null;index"` // 구독의 기관
+	SubscriptionID string                `json:"subscription_id" gorm:"type:uuid;not null;index"`
+	EventID        string                `json:"event_id" gorm:"type:uuid;not null"` // 같은 이벤트의 구독별 전달은 같은 값
+	Event          WebhookEvent          `json:"event" gorm:"type:varchar(30);not null"`
+	Payload        string                `json:"payload" gorm:"type:text;not null"` // 전달 본문 (JSON, 재시도 시 그대로 다시 전달)
+	Status         WebhookDeliveryStatus `json:"status" gorm:"type:varchar(10);not null;index"`
+	Attempts       int                   `json:"attempts" gorm:"not null;default:0"`
+	ResponseStatus int                   `json:"response_status,omitempty"`           // 마지막 응답 HTTP 상태 (네트워크 오류면 0)
+	Response       string                `json:"response,omitempty" gorm:"type:text"` // 마지막 실패의 응답 본문/오류
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewWebhookDelivery - 전달 기록 생성 팩토리 함수 (구독의 기관 소속, next까지 전달 결과가 반영되지 않으면 재시도 대상)
+func NewWebhookDelivery(subscription *WebhookSubscription, eventID string, event WebhookEvent, payload string, next time.Time) *WebhookDelivery {
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:             uuid.New().String(),
+		OrganizationID: subscription.OrganizationID,
+		SubscriptionID: subscription.ID,
+		EventID:        eventID,
+		Event:          event,
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		NextAttemptAt:  &next,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// MarkDelivered - 전달 성공
+func (d *WebhookDelivery) MarkDelivered(status int, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.ResponseStatus = status
+	d.Response = ""
+	d.NextAttemptAt = nil
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkRetry - 일시 장애로 실패, next에 다시 시도
+func (d *WebhookDelivery) MarkRetry(status int, response string, next, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryRetrying
+	d.ResponseStatus = status
+	d.Response = response
+	d.NextAttemptAt = &next
+	d.UpdatedAt = now
+}
+
+// MarkFailed - 재시도할 수 없는 실패 (dead: 최대 시도 횟수 초과 여부)
+func (d *WebhookDelivery) MarkFailed(status int, response string, dead bool, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryFailed
+	if dead {
+		d.Status = WebhookDeliveryDead
+	}
+	d.ResponseStatus = status
+	d.Response = response
+	d.NextAttemptAt = nil
+	d.UpdatedAt = now
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: API 키 관리 요청/응답 DTO
+// 🎯 실무 포인트: 발급 응답에만 원문 키 포함 (이후 조회 불가)
+// ⚠️ 주의사항: scope는 domain.APIKeyScope에 정의된 값만 허용
+
+// IssueAPIKeyRequest - API 키 발급 요청
+type IssueAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,oneof=vehicles:read routes:read schedules:read trips:read locations:write"`
+	ExpiresAt *time.Time `json:"expires_at"` // 생략 시 만료 없음
+
+	DailyQuota int `json:"daily_quota" binding:"omitempty,min=0"` // 일일 요청 한도 (생략/0이면 기본 한도)
+}
+
+// ListAPIKeyQuery - API 키 목록 조회 쿼리
+type ListAPIKeyQuery struct {
+	IncludeRevoked bool `form:"include_revoked"`
+}
+
+// IssuedAPIKeyResponse - API 키 발급 결과
+type IssuedAPIKeyResponse struct {
+	APIKey *domain.APIKey `json:"api_key"`
+	Key    string         `json:"key"` // 원문 키 (이 응답에서만 제공)
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: 탑승자 월별 출결 API DTO
+// 🎯 실무 포인트: 기관 청구(이용 일수)와 보호자 안내용 → 운행이 있었던 날마다 운행별 탑승 상태 + 월 합계
+// ⚠️ 주의사항: 월은 YYYY-MM (생략 시 한국 시간 기준 이번 달), 취소된 운행은 제외
+
+// PassengerAttendanceQuery - 월별 출결 조회 조건
+type PassengerAttendanceQuery struct {
+	Month string `form:"month" binding:"omitempty,datetime=2006-01"` // 예: "2025-03"
+}
+
+// PassengerAttendanceResponse - 탑승자 월별 출결
+type PassengerAttendanceResponse struct {
+	PassengerID   string                    `json:"passenger_id"`
+	PassengerName string                    `json:"passenger_name"`
+	Month         string                    `json:"month"`
+	Summary       AttendanceSummaryResponse `json:"summary"`
+	Days          []AttendanceDayResponse   `json:"days"` // 운행이 있었던 날만, 날짜 순
+}
+
+// AttendanceSummaryResponse - 월 합계
+type AttendanceSummaryResponse struct {
+	Trips    int `json:"trips"`     // 탑승 대상 운행 수
+	Rides    int `json:"rides"`     // 승차한 운행 수
+	Absences int `json:"absences"`  // 결석 신고 운행 수
+	NoShows  int `json:"no_shows"`  // 불참 운행 수
+	RideDays int `json:"ride_days"` // 한 번이라도 승차한 날 수
+}
+
+// AttendanceDayResponse - 하루의 운행별 탑승 상태
+type AttendanceDayResponse struct {
+	Date  string                   `json:"date"`
+	Trips []AttendanceTripResponse `json:"trips"` // 출발 시각 순
+}
+
+// AttendanceTripResponse - 운행 한 건의 탑승 상태
+type AttendanceTripResponse struct {
+	TripID       string          `json:"trip_id"`
+	ScheduleName string          `json:"schedule_name"`
+	TimeSlot     domain.TimeSlot `json:"time_slot"`
+	StartTime    string          `json:"start_time"`
+	Status       string          `json:"status"`           // expected | boarded | alighted | no_show | excused
+	Reason       string          `json:"reason,omitempty"` // 불참/결석 사유
+	BoardedAt    *time.Time      `json:"boarded_at,omitempty"`
+	AlightedAt   *time.Time      `json:"alighted_at,omitempty"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+)
+
+// 📝 설명: 동승자 API 요청 DTO
+// 🎯 실무 포인트: 운행 시작 권한은 별도 엔드포인트로만 변경 (수정 요청에 포함하지 않음)
+// ⚠️ 주의사항: 역할은 teacher, nurse, carer, assistant 중 하나
+
+// CreateAttendantRequest - 동승자 등록 요청
+type CreateAttendantRequest struct {
+	Name             string     `json:"name" binding:"required"`
+	Phone            string     `json:"phone" binding:"required,phone"`
+	Email            string     `json:"email" binding:"omitempty,email"`
+	Role             string     `json:"role" binding:"required,oneof=teacher nurse carer assistant"`
+	HireDate         *time.Time `json:"hire_date"`
+	Organization     string     `json:"organization"`
+	Address          string     `json:"address"`
+	EmergencyContact string     `json:"emergency_contact" binding:"omitempty,phone"`
+	Notes            string     `json:"notes"`
+}
+
+// UpdateAttendantRequest - 동승자 정보 수정 요청 (전달된 필드만 수정)
+type UpdateAttendantRequest struct {
+	Name             *string `json:"name" binding:"omitempty,min=1"`
+	Phone            *string `json:"phone" binding:"omitempty,phone"`
+	Email            *string `json:"email" binding:"omitempty,email"`
+	Role             *string `json:"role" binding:"omitempty,oneof=teacher nurse carer assistant"`
+	Status           *string `json:"status" binding:"omitempty,oneof=active on_leave"`
+	Organization     *string `json:"organization"`
+	Address          *string `json:"address"`
+	EmergencyContact *string `json:"emergency_contact" binding:"omitempty,phone"`
+	Notes            *string `json:"notes"`
+}
+
+// ListAttendantQuery - 동승자 목록 조회 쿼리
+type ListAttendantQuery struct {
+	Status       string `form:"status" binding:"omitempty,oneof=active on_leave inactive"`
+	Role         string `form:"role" binding:"omitempty,oneof=teacher nurse carer assistant"`
+	CanStartTrip *bool  `form:"can_start_trip"`
+}
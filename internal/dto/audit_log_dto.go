@@ -0,0 +1,12 @@
+package dto
+
+// 📝 설명: 감사 로그 조회 DTO
+// 🎯 실무 포인트: 특정 엔티티의 변경 이력 / 특정 사용자의 작업 이력 조회
+// ⚠️ 주의사항: entity_type은 테이블명 (예: vehicles, passengers)
+
+// ListAuditLogQuery - 감사 로그 목록 조회 쿼리
+type ListAuditLogQuery struct {
+	EntityType string `form:"entity_type" binding:"omitempty,max=50"`
+	EntityID   string `form:"entity_id" binding:"omitempty,max=80"`
+	ActorID    string `form:"actor_id" binding:"omitempty,max=36"`
+}
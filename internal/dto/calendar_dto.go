@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+// 📝 설명: 운행 일정 캘린더 구독 DTO
+// 🎯 실무 포인트: 앱은 path 앞에 서버 주소를 붙여(https:// 또는 webcal://) 휴대폰 캘린더 구독 화면을 연결
+// ⚠️ 주의사항: 토큰 원문은 발급 응답에서만 확인 가능 (잃어버리면 다시 발급)
+
+// CalendarTokenResponse - 캘린더 구독 주소 발급 결과
+type CalendarTokenResponse struct {
+	Token    string    `json:"token"`     // 구독 토큰 원문
+	Path     string    `json:"path"`      // 구독 경로 (예: "/api/v1/calendar/{token}.ics")
+	IssuedAt time.Time `json:"issued_at"` // 발급 시각 (이전 주소는 이 시각부터 무효)
+}
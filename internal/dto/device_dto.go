@@ -0,0 +1,16 @@
+package dto
+
+// 📝 설명: 푸시 수신 기기 등록/해제 요청 DTO
+// 🎯 실무 포인트: 같은 토큰을 여러 번 등록해도 한 건으로 유지 (앱 실행 시마다 호출해도 됨)
+// ⚠️ 주의사항: FCM 토큰은 길이가 고정되지 않아 넉넉하게 512자까지 허용
+
+// RegisterDeviceRequest - 푸시 수신 기기 등록 요청 (앱 실행/토큰 갱신 시마다 호출)
+type RegisterDeviceRequest struct {
+	Token    string `json:"token" binding:"required,max=512"`                  // FCM 등록 토큰
+	Platform string `json:"platform" binding:"required,oneof=android ios web"` // 기기 종류
+}
+
+// UnregisterDeviceRequest - 푸시 수신 기기 해제 요청 (로그아웃 시)
+type UnregisterDeviceRequest struct {
+	Token string `json:"token" binding:"required,max=512"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+// 📝 설명: 기사 대체 배정 API 요청 DTO
+// 🎯 실무 포인트: 기간은 날짜 단위(YYYY-MM-DD, 양 끝 포함)
+// ⚠️ 주의사항: 종료일이 시작일보다 빠른지, 기간 겹침은 Service에서 검증
+
+// CreateDriverAssignmentRequest - 기사 대체 배정 생성 요청
+type CreateDriverAssignmentRequest struct {
+	DriverID  string `json:"driver_id" binding:"required"`
+	StartDate string `json:"start_date" binding:"required,datetime=2006-01-02"` // 예: "2025-03-10"
+	EndDate   string `json:"end_date" binding:"required,datetime=2006-01-02"`   // 예: "2025-03-14"
+	Reason    string `json:"reason" binding:"max=200"`
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+)
+
+// 📝 설명: 기사 API 요청 DTO
+// 🎯 실무 포인트: 면허 정보/상태 변경은 별도 요청으로 분리
+// ⚠️ 주의사항: 날짜는 RFC3339 형식 (예: "2026-12-31T00:00:00Z")
+
+// CreateDriverRequest - 기사 등록 요청
+type CreateDriverRequest struct {
+	Name             string     `json:"name" binding:"required"`
+	Phone            string     `json:"phone" binding:"required,phone"`
+	Email            string     `json:"email" binding:"omitempty,email"`
+	LicenseNumber    string     `json:"license_number" binding:"required"`
+	LicenseType      string     `json:"license_type" binding:"required,oneof=type_1_regular type_1_large type_2_regular"`
+	LicenseExpiry    time.Time  `json:"license_expiry" binding:"required"`
+	HireDate         *time.Time `json:"hire_date"`
+	Address          string     `json:"address"`
+	EmergencyContact string     `json:"emergency_contact" binding:"omitempty,phone"`
+	Notes            string     `json:"notes"`
+}
+
+// UpdateDriverRequest - 기사 기본 정보 수정 요청 (전달된 필드만 수정)
+type UpdateDriverRequest struct {
+	Name             *string `json:"name" binding:"omitempty,min=1"`
+	Phone            *string `json:"phone" binding:"omitempty,phone"`
+	Email            *string `json:"email" binding:"omitempty,email"`
+	Address          *string `json:"address"`
+	EmergencyContact *string `json:"emergency_contact" binding:"omitempty,phone"`
+	Notes            *string `json:"notes"`
+}
+
+// UpdateLicenseRequest - 면허 정보 수정 요청
+type UpdateLicenseRequest struct {
+	LicenseNumber *string    `json:"license_number" binding:"omitempty,min=1"`
+	LicenseType   *string    `json:"license_type" binding:"omitempty,oneof=type_1_regular type_1_large type_2_regular"`
+	LicenseExpiry *time.Time `json:"license_expiry"`
+}
+
+// ReviewLicenseRequest - 면허 확인/반려 요청 (관리자)
+type ReviewLicenseRequest struct {
+	Status string `json:"status" binding:"required,oneof=verified rejected"`
+	Reason string `json:"reason" binding:"max=200"` // 반려 사유 (rejected일 때 필수)
+}
+
+// UpdateDriverStatusRequest - 기사 상태 변경 요청 (휴가/복귀)
+type UpdateDriverStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=active on_leave"`
+}
+
+// TerminateDriverRequest - 퇴사 처리 요청
+type TerminateDriverRequest struct {
+	TerminationDate *time.Time `json:"termination_date"` // 생략 시 오늘
+}
+
+// ListDriverQuery - 기사 목록 조회 쿼리
+type ListDriverQuery struct {
+	Status                string `form:"status" binding:"omitempty,oneof=active on_leave inactive"`
+	LicenseStatus         string `form:"license_status" binding:"omitempty,oneof=pending verified rejected"`
+	LicenseExpiringWithin *int   `form:"license_expiring_within" binding:"omitempty,min=0,max=3650"` // N일 이내 만료
+	IncludeDeleted        bool   `form:"include_deleted"`                                            // 삭제된 항목 포함 (관리자만)
+}
@@ -0,0 +1,10 @@
+package dto
+
+// 📝 설명: 업로드 파일 DTO (multipart/form-data)
+// 🎯 실무 포인트: 파일은 file 필드, 용도는 같은 폼의 purpose 필드 → 용도별 허용 형식/크기/역할은 서비스가 확인
+// ⚠️ 주의사항: 차량 서류는 /vehicles/:id/documents로 올려야 차량에 연결됨 (여기서 올리면 파일만 생성)
+
+// UploadFileRequest - 파일 업로드 요청 (파일 제외 폼 필드)
+type UploadFileRequest struct {
+	Purpose string `form:"purpose" binding:"required,oneof=vehicle_document driver_license incident_photo"`
+}
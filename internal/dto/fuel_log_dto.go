@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// 📝 설명: 주유 기록 DTO (차량 하위 리소스)
+// 🎯 실무 포인트: 주유 시각을 생략하면 지금 → 기사가 주유 직후 앱에서 바로 기록
+// ⚠️ 주의사항: 주유 시각은 RFC3339 형식 (예: "2025-03-10T08:30:00+09:00"), 조회 기간은 YYYY-MM-DD (기관 시간대, 종료일 포함)
+
+// CreateFuelLogRequest - 주유 기록 등록 요청
+type CreateFuelLogRequest struct {
+	FilledAt   *time.Time `json:"filled_at"`                                  // 생략 시 지금
+	Liters     float64    `json:"liters" binding:"required,gt=0,lte=1000"`    // 주유량 (L)
+	Cost       int        `json:"cost" binding:"gte=0"`                       // 결제 금액 (원)
+	OdometerKm int        `json:"odometer_km" binding:"required,gt=0"`        // 주유 시점 주행거리계 (km)
+	Station    string     `json:"station" binding:"max=100" example:"SK 역삼점"` // 주유소
+}
+
+// FuelLogQuery - 주유 기록 목록 조건 (생략하면 전체 기간)
+type FuelLogQuery struct {
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: 보호자 API 요청/응답 DTO
+// 🎯 실무 포인트: "내 자녀" 응답은 탑승자 + 배정 경로/정류장을 한 번에 제공 (앱 첫 화면용)
+// ⚠️ 주의사항: 연락처는 보호자 간 중복 불가
+
+// CreateGuardianRequest - 보호자 등록 요청
+type CreateGuardianRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Phone string `json:"phone" binding:"required,phone"`
+	Email string `json:"email" binding:"omitempty,email"`
+}
+
+// UpdateGuardianAccountRequest - 보호자 정보 수정 요청 (전달된 필드만 수정)
+type UpdateGuardianAccountRequest struct {
+	Name   *string `json:"name" binding:"omitempty,min=1"`
+	Phone  *string `json:"phone" binding:"omitempty,phone"`
+	Email  *string `json:"email" binding:"omitempty,email"`
+	Status *string `json:"status" binding:"omitempty,oneof=active inactive"`
+}
+
+// ListGuardianQuery - 보호자 목록 조회 쿼리
+type ListGuardianQuery struct {
+	Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+	Name   string `form:"name"`
+	Phone  string `form:"phone"`
+}
+
+// LinkPassengerRequest - 보호자-탑승자 연결 요청
+type LinkPassengerRequest struct {
+	PassengerID string `json:"passenger_id" binding:"required,uuid"`
+	Relation    string `json:"relation"` // 부, 모, 조부모 등
+}
+
+// ChildResponse - 보호자의 자녀 정보 (배정 경로/정류장 포함)
+type ChildResponse struct {
+	Passenger *domain.Passenger `json:"passenger"`
+	Relation  string            `json:"relation,omitempty"`
+	Route     *ChildRoute       `json:"route,omitempty"` // 미배정이면 생략
+	Stop      *domain.Stop      `json:"stop,omitempty"`
+}
+
+// ChildRoute - 자녀 배정 경로 요약
+type ChildRoute struct {
+	ID     string             `json:"id"`
+	Name   string             `json:"name"`
+	Status domain.RouteStatus `json:"status"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+// 📝 설명: 공휴일 API 요청 DTO
+// 🎯 실무 포인트: 연도를 생략하면 올해(한국 시간) 공휴일
+// ⚠️ 주의사항: 기본 공휴일 표에 없고 동기화하지 않은 연도는 빈 목록
+
+// ListHolidayQuery - 공휴일 목록 조회 쿼리
+type ListHolidayQuery struct {
+	Year int `form:"year" binding:"omitempty,min=2000,max=2100"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+// 📝 설명: 파일 가져오기(탑승자/정류장 일괄 등록) DTO
+// 🎯 실무 포인트: 행마다 등록 여부를 판정하고 실패한 행은 파일 행 번호와 열별 사유를 함께 반환
+// ⚠️ 주의사항: 행 번호는 파일 기준 (머리글 = 1행), 빈 행은 건너뛰어 번호가 띄엄띄엄일 수 있음
+
+// ImportQuery - 가져오기 옵션
+type ImportQuery struct {
+	DryRun bool `form:"dry_run"` // true면 검증만 하고 저장하지 않음
+}
+
+// ImportResponse - 가져오기 결과
+type ImportResponse struct {
+	Total    int              `json:"total"`    // 데이터 행 수 (머리글, 빈 행 제외)
+	Imported int              `json:"imported"` // 등록한 행 수 (dry_run이면 등록 가능한 행 수)
+	Failed   int              `json:"failed"`   // 검증에 실패해 건너뛴 행 수
+	DryRun   bool             `json:"dry_run"`
+	Errors   []ImportRowError `json:"errors"` // 실패한 행 (행 번호 순)
+}
+
+// ImportRowError - 실패한 행과 열별 사유
+type ImportRowError struct {
+	Row    int                    `json:"row"`    // 파일 기준 행 번호
+	Errors map[string]interface{} `json:"errors"` // 필드 → 사유 (예: {"guardian_phone": "전화번호 형식이 아닙니다"})
+}
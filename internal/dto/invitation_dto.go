@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/hyeokjun/eodini/internal/domain"
+
+// 📝 설명: 기관 직원 초대 요청·응답 DTO
+// 🎯 실무 포인트: 초대는 이메일/연락처 중 하나 이상으로, 수락은 토큰과 비밀번호로 (인증 불필요)
+// ⚠️ 주의사항: 초대 토큰 원문은 응답에 포함하지 않음 (메일/문자로만 전달)
+
+// InviteMemberRequest - 직원 초대 요청 (관리자)
+type InviteMemberRequest struct {
+	Email     string `json:"email" binding:"omitempty,email"`
+	Phone     string `json:"phone" binding:"omitempty,phone"`
+	Role      string `json:"role" binding:"required,oneof=admin driver attendant"`
+	ProfileID string `json:"profile_id" binding:"omitempty,uuid"` // 기사/동승자 ID
+}
+
+// InvitationResponse - 초대 정보 (상태 포함)
+type InvitationResponse struct {
+	*domain.Invitation
+	Status    domain.InvitationStatus `json:"status"`
+	Delivered *bool                   `json:"delivered,omitempty"` // 초대 발송 성공 여부 (초대 생성 응답에만 포함)
+}
+
+// AcceptInvitationRequest - 초대 수락 요청 (계정 생성)
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Email    string `json:"email" binding:"omitempty,email"` // 로그인 ID (연락처로 초대받은 경우 필수, 이메일 초대는 무시)
+	Password string `json:"password" binding:"required,min=8,max=72"`
+}
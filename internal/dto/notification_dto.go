@@ -0,0 +1,19 @@
+package dto
+
+// 📝 설명: 보호자 알림 수신 설정 요청 / 발송 기록 조회 DTO
+// 🎯 실무 포인트: 채널/알림 종류 목록을 통째로 교체 (부분 수정 없음)
+// ⚠️ 주의사항: 빈 배열은 허용 (channels가 비면 모든 알림 끔), 필드 자체를 빼면 400
+
+// UpdateNotificationPreferenceRequest - 알림 수신 설정 변경 요청
+type UpdateNotificationPreferenceRequest struct {
+	Channels []string `json:"channels" binding:"required,max=3,dive,oneof=push alimtalk sms"`                           // 받을 채널
+	Events   []string `json:"events" binding:"required,max=5,dive,oneof=boarded alighted approaching delay assignment"` // 받을 알림 종류
+}
+
+// ListNotificationLogQuery - 알림 발송 기록 목록 조회 쿼리
+type ListNotificationLogQuery struct {
+	GuardianID string `form:"guardian_id" binding:"omitempty,max=36"`
+	Status     string `form:"status" binding:"omitempty,oneof=sent retrying failed dead"`
+	Channel    string `form:"channel" binding:"omitempty,oneof=push alimtalk sms"`
+	Event      string `form:"event" binding:"omitempty,oneof=boarded alighted approaching delay assignment"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+// 📝 설명: 차량 주행거리계 DTO (관리자 입력, 변경 이력 조회)
+// 🎯 실무 포인트: 운행 완료 시 기사 확인 값은 CompleteTripRequest.OdometerKm으로 받음
+// ⚠️ 주의사항: 조회 기간은 YYYY-MM-DD (기관 시간대, 종료일 포함)
+
+// UpdateOdometerRequest - 주행거리계 입력 요청 (기준값 입력, 보정, 계기판 교체)
+type UpdateOdometerRequest struct {
+	OdometerKm *int `json:"odometer_km" binding:"required,gte=0,lte=10000000"` // 주행거리계 (km, 현재 값보다 작아도 됨)
+}
+
+// OdometerReadingQuery - 주행거리계 기록 조건 (생략하면 전체 기간)
+type OdometerReadingQuery struct {
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+// 📝 설명: 기관 API 요청·응답 DTO
+// 🎯 실무 포인트: 관리자는 자기 기관 정보(이름, 유형, 연락처, 주소)와 화면 표시/웹 앱 설정만 수정
+// ⚠️ 주의사항: 수정 요청은 포인터 필드로 "값 없음"과 "빈 값"을 구분 (빈 연락처/주소는 삭제)
+
+// UpdateOrganizationRequest - 기관 정보 수정 요청 (전달된 필드만 수정)
+type UpdateOrganizationRequest struct {
+	Name    *string `json:"name" binding:"omitempty,min=1,max=100"`
+	Type    *string `json:"type" binding:"omitempty,oneof=kindergarten clinic academy"`
+	Phone   *string `json:"phone" binding:"omitempty,phone"`
+	Address *string `json:"address" binding:"omitempty,max=255"`
+}
+
+// OrganizationSettingsResponse - 기관 화면 표시/웹 앱 설정
+type OrganizationSettingsResponse struct {
+	OrganizationID string   `json:"organization_id"`
+	Name           string   `json:"name"`               // 기관명
+	DisplayName    string   `json:"display_name"`       // 앱에 표시할 이름 (설정하지 않으면 기관명)
+	LogoURL        *string  `json:"logo_url,omitempty"` // 로고 이미지 주소
+	AllowedOrigins []string `json:"allowed_origins"`    // 기관 웹 앱 Origin (CORS 허용)
+	Timezone       string   `json:"timezone"`           // 일정 시각/날짜 기준 시간대 (IANA 이름)
+}
+
+// UpdateOrganizationSettingsRequest - 기관 설정 수정 요청 (전달된 필드만 수정, 허용 Origin은 목록 전체 교체)
+type UpdateOrganizationSettingsRequest struct {
+	DisplayName    *string   `json:"display_name" binding:"omitempty,max=100"`
+	LogoURL        *string   `json:"logo_url" binding:"omitempty,max=500"`
+	AllowedOrigins *[]string `json:"allowed_origins"`                     // 예: ["https://sunflower.eodini.kr"]
+	Timezone       *string   `json:"timezone" binding:"omitempty,max=64"` // 예: "Asia/Seoul"
+}
@@ -0,0 +1,12 @@
+package dto
+
+// 📝 설명: 탑승자 결석 신고 API 요청 DTO
+// 🎯 실무 포인트: 시간대를 생략하면 그날 모든 시간대 결석
+// ⚠️ 주의사항: 지난 날짜 신고, 같은 날짜 시간대 겹침은 Service에서 검증
+
+// CreatePassengerAbsenceRequest - 결석 신고 요청
+type CreatePassengerAbsenceRequest struct {
+	Date     string `json:"date" binding:"required,datetime=2006-01-02"`                   // 예: "2025-03-11"
+	TimeSlot string `json:"time_slot" binding:"omitempty,oneof=morning afternoon evening"` // 생략 시 하루 종일
+	Reason   string `json:"reason" binding:"max=200"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+// 📝 설명: 탑승자 API 요청 DTO
+// 🎯 실무 포인트: 정류장 배정/보호자 정보는 별도 요청으로 분리
+// ⚠️ 주의사항: 정류장은 반드시 지정한 경로에 속해야 함 (Service에서 검증)
+
+// CreatePassengerRequest - 탑승자 등록 요청
+type CreatePassengerRequest struct {
+	Name              string `json:"name" binding:"required"`
+	Age               int    `json:"age" binding:"omitempty,min=0,max=150"`
+	Gender            string `json:"gender" binding:"omitempty,oneof=male female other"`
+	GuardianName      string `json:"guardian_name" binding:"required"`
+	GuardianPhone     string `json:"guardian_phone" binding:"required,phone"`
+	GuardianEmail     string `json:"guardian_email" binding:"omitempty,email"`
+	GuardianRelation  string `json:"guardian_relation"`
+	EmergencyContact  string `json:"emergency_contact" binding:"omitempty,phone"`
+	EmergencyRelation string `json:"emergency_relation"`
+	Address           string `json:"address"`
+	MedicalNotes      string `json:"medical_notes"`
+	Notes             string `json:"notes"`
+
+	// 등록과 동시에 정류장 배정 (선택, 둘 다 전달해야 함)
+	RouteID string `json:"route_id" binding:"required_with=StopID"`
+	StopID  string `json:"stop_id" binding:"required_with=RouteID"`
+}
+
+// UpdatePassengerRequest - 탑승자 기본 정보 수정 요청 (전달된 필드만 수정)
+type UpdatePassengerRequest struct {
+	Name         *string `json:"name" binding:"omitempty,min=1"`
+	Age          *int    `json:"age" binding:"omitempty,min=0,max=150"`
+	Gender       *string `json:"gender" binding:"omitempty,oneof=male female other"`
+	Status       *string `json:"status" binding:"omitempty,oneof=active inactive"`
+	Address      *string `json:"address"`
+	MedicalNotes *string `json:"medical_notes"`
+	Notes        *string `json:"notes"`
+}
+
+// AssignStopRequest - 정류장 배정 요청
+type AssignStopRequest struct {
+	RouteID string `json:"route_id" binding:"required"`
+	StopID  string `json:"stop_id" binding:"required"`
+}
+
+// UpdateGuardianRequest - 보호자/비상 연락처 수정 요청 (전달된 필드만 수정)
+type UpdateGuardianRequest struct {
+	GuardianName      *string `json:"guardian_name" binding:"omitempty,min=1"`
+	GuardianPhone     *string `json:"guardian_phone" binding:"omitempty,phone"`
+	GuardianEmail     *string `json:"guardian_email" binding:"omitempty,email"`
+	GuardianRelation  *string `json:"guardian_relation"`
+	EmergencyContact  *string `json:"emergency_contact" binding:"omitempty,phone"`
+	EmergencyRelation *string `json:"emergency_relation"`
+}
+
+// ListPassengerQuery - 탑승자 목록 조회 쿼리
+type ListPassengerQuery struct {
+	Status         string `form:"status" binding:"omitempty,oneof=active inactive"`
+	RouteID        string `form:"route_id"`
+	StopID         string `form:"stop_id"`
+	Name           string `form:"name"`
+	IncludeDeleted bool   `form:"include_deleted"` // 삭제된 항목 포함 (관리자만)
+}
+
+// SearchPassengerQuery - 탑승자 검색 쿼리 (아이 이름, 보호자 이름, 보호자 연락처)
+type SearchPassengerQuery struct {
+	Q     string `form:"q" binding:"required,min=2,max=50"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"` // 기본 20
+}
+
+// UpdatePhotoConsentRequest - 탑승자 사진 동의/철회 요청
+type UpdatePhotoConsentRequest struct {
+	Consent *bool `json:"consent" binding:"required"` // false면 철회 (등록된 사진 삭제)
+}
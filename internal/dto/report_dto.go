@@ -0,0 +1,174 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: 보고서 DTO (내보내기 조건, 기사/차량별 합계)
+// 🎯 실무 포인트: 기간과 경로(또는 차량)로 대상을 좁혀 파일로 받거나 기간 합계를 JSON으로 조회
+// ⚠️ 주의사항: 날짜는 YYYY-MM-DD (시작/종료일 포함)
+
+// ExportAttendanceQuery - 출결 기록 내보내기 조건
+type ExportAttendanceQuery struct {
+	From    string `form:"from" binding:"required,datetime=2006-01-02"`
+	To      string `form:"to" binding:"required,datetime=2006-01-02"`
+	RouteID string `form:"route_id" binding:"omitempty,uuid"` // 비우면 전체 경로
+}
+
+// ExportDrivingLogQuery - 차량 운행일지 내보내기 조건
+type ExportDrivingLogQuery struct {
+	VehicleID string `form:"vehicle_id" binding:"required,uuid"`
+	From      string `form:"from" binding:"required,datetime=2006-01-02"`
+	To        string `form:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// DriverReportQuery - 기사별 근무 보고서 조건
+type DriverReportQuery struct {
+	From string `form:"from" binding:"required,datetime=2006-01-02"`
+	To   string `form:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// DriverReportResponse - 기간의 기사별 근무 보고서 (급여 정산, 근로 시간 관리)
+type DriverReportResponse struct {
+	From    string              `json:"from"`
+	To      string              `json:"to"`
+	Drivers []DriverReportEntry `json:"drivers"` // 이름 순
+}
+
+// DriverReportEntry - 기사 한 명의 기간 합계 (취소된 운행 제외)
+type DriverReportEntry struct {
+	DriverID         string `json:"driver_id"`
+	DriverName       string `json:"driver_name"`
+	Trips            int    `json:"trips"`             // 배정된 운행 수
+	CompletedTrips   int    `json:"completed_trips"`   // 완료한 운행 수
+	DrivingMinutes   int    `json:"driving_minutes"`   // 완료한 운행의 출발~완료 시간 합계 (분)
+	TotalDistance    int    `json:"total_distance"`    // 주행 거리 합계 (미터)
+	WorkDays         int    `json:"work_days"`         // 운행이 있었던 날 수
+	SubstitutionDays int    `json:"substitution_days"` // 일정의 기본 기사 대신 운행한 날 수
+}
+
+// VehicleReportQuery - 차량 가동률 보고서 조건
+type VehicleReportQuery struct {
+	From string `form:"from" binding:"required,datetime=2006-01-02"`
+	To   string `form:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// VehicleReportResponse - 기간의 차량별 가동률 (차량 증감 판단)
+type VehicleReportResponse struct {
+	From        string               `json:"from"`
+	To          string               `json:"to"`
+	ServiceDays int                  `json:"service_days"` // 어느 차량이든 운행이 있었던 날 수 (가동률 기준)
+	Vehicles    []VehicleReportEntry `json:"vehicles"`     // 차량 번호 순
+}
+
+// VehicleReportEntry - 차량 한 대의 기간 합계 (취소된 운행 제외)
+type VehicleReportEntry struct {
+	VehicleID   string               `json:"vehicle_id"`
+	PlateNumber string               `json:"plate_number"`
+	Status      domain.VehicleStatus `json:"status"`
+	Capacity    int                  `json:"capacity"`
+	Trips       int                  `json:"trips"`       // 배정된 운행 수
+	DistanceKm  float64              `json:"distance_km"` // 주행 거리 합계 (km, 소수 첫째 자리)
+	ActiveDays  int                  `json:"active_days"` // 운행한 날 수
+	IdleDays    int                  `json:"idle_days"`   // 운행일 중 이 차량이 운행하지 않은 날 수
+	Utilization float64              `json:"utilization"` // 가동률 (%, active_days / service_days, 소수 첫째 자리)
+}
+
+// FuelEconomyReportQuery - 연비 보고서 조건
+type FuelEconomyReportQuery struct {
+	From string `form:"from" binding:"required,datetime=2006-01-02"`
+	To   string `form:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// FuelEconomyReportResponse - 기간의 차량별 연비와 월별 추이, 확인이 필요한 주유 기록
+type FuelEconomyReportResponse struct {
+	From     string                    `json:"from"`
+	To       string                    `json:"to"`
+	Fleet    FuelEconomySummary        `json:"fleet"`    // 전체 차량 합계
+	Vehicles []FuelEconomyVehicleEntry `json:"vehicles"` // 연비 낮은 순 (연비를 계산할 수 없는 차량은 마지막)
+}
+
+// FuelEconomySummary - 주유 합계와 연비 (연비/거리는 직전 주유 기록이 있는 주유만)
+type FuelEconomySummary struct {
+	FillUps    int     `json:"fill_ups"`     // 주유 횟수
+	Liters     float64 `json:"liters"`       // 주유량 합계 (L, 소수 둘째 자리)
+	Cost       int     `json:"cost"`         // 결제 금액 합계 (원)
+	DistanceKm int     `json:"distance_km"`  // 주행거리계 기준 주행 거리 (km)
+	KmPerLiter float64 `json:"km_per_liter"` // 연비 (km/L, 소수 첫째 자리, 계산할 수 없으면 0)
+	CostPerKm  float64 `json:"cost_per_km"`  // km당 연료비 (원, 소수 첫째 자리)
+}
+
+// FuelEconomyVehicleEntry - 차량 한 대의 기간 연비
+type FuelEconomyVehicleEntry struct {
+	VehicleID   string `json:"vehicle_id"`
+	PlateNumber string `json:"plate_number"`
+	FuelEconomySummary
+	FleetRatio float64            `json:"fleet_ratio"` // 전체 평균 연비 대비 (%, 100 미만이면 평균보다 낮음)
+	Monthly    []FuelEconomyMonth `json:"monthly"`     // 월별 추이 (월 순)
+	Flags      []FuelLogFlag      `json:"flags"`       // 확인이 필요한 주유 기록
+}
+
+// FuelEconomyMonth - 한 달의 주유 합계와 연비
+type FuelEconomyMonth struct {
+	Month string `json:"month"` // YYYY-MM (기관 시간대)
+	FuelEconomySummary
+}
+
+// FuelLogFlag - 확인이 필요한 주유 기록 (유류카드 부정 사용, 입력 오류 의심)
+type FuelLogFlag struct {
+	FuelLogID  string    `json:"fuel_log_id"`
+	FilledAt   time.Time `json:"filled_at"`
+	Reason     string    `json:"reason"`       // no_distance: 직전 주유 이후 주행 없음, low_economy: 차량 평균 연비보다 크게 낮음
+	KmPerLiter float64   `json:"km_per_liter"` // 이 주유의 연비
+}
+
+// PunctualityReportQuery - 정시성 통계 조건
+type PunctualityReportQuery struct {
+	From string `form:"from" binding:"required,datetime=2006-01-02"`
+	To   string `form:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// PunctualityReportResponse - 기간의 정시성 통계 (전체, 경로별, 기사별)
+type PunctualityReportResponse struct {
+	From    string                   `json:"from"`
+	To      string                   `json:"to"`
+	Overall PunctualityStats         `json:"overall"`
+	Routes  []RoutePunctualityEntry  `json:"routes"`  // 경로 이름 순
+	Drivers []DriverPunctualityEntry `json:"drivers"` // 기사 이름 순
+}
+
+// RoutePunctualityEntry - 경로별 정시성
+type RoutePunctualityEntry struct {
+	RouteID   string `json:"route_id"`
+	RouteName string `json:"route_name"`
+	PunctualityStats
+}
+
+// DriverPunctualityEntry - 기사별 정시성
+type DriverPunctualityEntry struct {
+	DriverID   string `json:"driver_id"`
+	DriverName string `json:"driver_name"`
+	PunctualityStats
+}
+
+// PunctualityStats - 출발/정류장 도착 정시율과 지연 분포 (지연은 분, 일찍은 음수)
+type PunctualityStats struct {
+	Trips          int             `json:"trips"`             // 출발 기록이 있는 운행 수
+	OnTimeStarts   int             `json:"on_time_starts"`    // 일정 출발 시각 + 허용 오차 안에 출발
+	OnTimeStartPct float64         `json:"on_time_start_pct"` // 출발 정시율 (%)
+	StartDelay     DelayPercentile `json:"start_delay"`
+	RecordedStops  int             `json:"recorded_stops"`   // 도착 기록이 있는 정류장 수
+	OnTimeStops    int             `json:"on_time_stops"`    // 예상 도착 시각 + 허용 오차 안에 도착
+	OnTimeStopPct  float64         `json:"on_time_stop_pct"` // 도착 정시율 (%)
+	ArrivalDelay   DelayPercentile `json:"arrival_delay"`
+}
+
+// DelayPercentile - 지연 분포 (분, 표본이 없으면 0)
+type DelayPercentile struct {
+	P50 int `json:"p50"`
+	P90 int `json:"p90"`
+	P95 int `json:"p95"`
+	Max int `json:"max"`
+}
@@ -0,0 +1,96 @@
+package dto
+
+// 📝 설명: 경로/정류장 API 요청/응답 DTO
+// 🎯 실무 포인트: 경로 생성 시 정류장을 함께 등록 가능 (배열 순서 = 정류장 순서)
+// ⚠️ 주의사항: 정류장 순서는 서버에서 1..N 연속으로 관리
+// 지도 API가 설정되어 있으면 estimated_time/total_distance는 정류장 기준 도로 경로로 자동 계산
+
+// CreateRouteRequest - 경로 생성 요청
+type CreateRouteRequest struct {
+	Name          string              `json:"name" binding:"required"`
+	Description   string              `json:"description"`
+	EstimatedTime int                 `json:"estimated_time" binding:"omitempty,min=0"` // 분
+	TotalDistance int                 `json:"total_distance" binding:"omitempty,min=0"` // 미터
+	Stops         []CreateStopRequest `json:"stops" binding:"omitempty,dive"`
+}
+
+// UpdateRouteRequest - 경로 수정 요청 (전달된 필드만 수정)
+type UpdateRouteRequest struct {
+	Name          *string `json:"name" binding:"omitempty,min=1"`
+	Description   *string `json:"description"`
+	Status        *string `json:"status" binding:"omitempty,oneof=active inactive"`
+	EstimatedTime *int    `json:"estimated_time" binding:"omitempty,min=0"`
+	TotalDistance *int    `json:"total_distance" binding:"omitempty,min=0"`
+}
+
+// ListRouteQuery - 경로 목록 조회 쿼리
+type ListRouteQuery struct {
+	Status         string `form:"status" binding:"omitempty,oneof=active inactive"`
+	IncludeDeleted bool   `form:"include_deleted"` // 삭제된 항목 포함 (관리자만)
+}
+
+// CreateStopRequest - 정류장 추가 요청
+// 위도/경도를 생략하면 주소로 좌표를 찾고(지오코딩), 입력하면 입력값을 그대로 사용
+type CreateStopRequest struct {
+	Name                 string   `json:"name" binding:"required"`
+	Address              string   `json:"address" binding:"required"`
+	Order                int      `json:"order" binding:"omitempty,min=1"` // 생략 시 마지막에 추가
+	Latitude             *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,latitude"`
+	Longitude            *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,longitude"`
+	EstimatedArrivalTime int      `json:"estimated_arrival_time" binding:"omitempty,min=0"` // 출발 후 몇 분
+	Notes                string   `json:"notes"`
+}
+
+// UpdateStopRequest - 정류장 수정 요청 (전달된 필드만 수정)
+type UpdateStopRequest struct {
+	Name                 *string  `json:"name" binding:"omitempty,min=1"`
+	Address              *string  `json:"address" binding:"omitempty,min=1"`
+	Order                *int     `json:"order" binding:"omitempty,min=1"` // 순서 이동
+	Latitude             *float64 `json:"latitude" binding:"omitempty,latitude"`
+	Longitude            *float64 `json:"longitude" binding:"omitempty,longitude"`
+	EstimatedArrivalTime *int     `json:"estimated_arrival_time" binding:"omitempty,min=0"`
+	Notes                *string  `json:"notes"`
+}
+
+// ReorderStopsRequest - 정류장 전체 순서 재배치 요청
+type ReorderStopsRequest struct {
+	StopIDs []string `json:"stop_ids" binding:"required,min=1"` // 새 순서대로 나열한 정류장 ID (전체)
+}
+
+// RouteGeometryResponse - 정류장 순서대로 지나는 도로 경로 (지도 API)
+type RouteGeometryResponse struct {
+	RouteID  string             `json:"route_id"`
+	Polyline string             `json:"polyline"` // Google Encoded Polyline
+	Distance int                `json:"distance"` // 미터
+	Duration int                `json:"duration"` // 초
+	Legs     []RouteLegResponse `json:"legs"`     // 정류장 사이 구간
+}
+
+// RouteLegResponse - 정류장 사이 한 구간
+type RouteLegResponse struct {
+	FromStopID string `json:"from_stop_id"`
+	ToStopID   string `json:"to_stop_id"`
+	Distance   int    `json:"distance"` // 미터
+	Duration   int    `json:"duration"` // 초
+}
+
+// RouteOptimizeResponse - 정류장 순서 최적화 제안 (첫/마지막 정류장 고정, 반영은 관리자가 순서 재배치 API로)
+type RouteOptimizeResponse struct {
+	RouteID           string                      `json:"route_id"`
+	StopIDs           []string                    `json:"stop_ids"` // 제안 순서 (PUT /routes/{id}/stops/reorder 요청에 그대로 사용)
+	Stops             []RouteOptimizeStopResponse `json:"stops"`
+	Changed           bool                        `json:"changed"`            // 현재 순서보다 빠른 순서를 찾았는지
+	CurrentDuration   int                         `json:"current_duration"`   // 현재 순서 예상 소요 시간 (초)
+	OptimizedDuration int                         `json:"optimized_duration"` // 제안 순서 예상 소요 시간 (초)
+	SavedDuration     int                         `json:"saved_duration"`     // 절약 시간 (초)
+	CurrentDistance   int                         `json:"current_distance"`   // 미터
+	OptimizedDistance int                         `json:"optimized_distance"` // 미터
+}
+
+// RouteOptimizeStopResponse - 제안 순서의 정류장
+type RouteOptimizeStopResponse struct {
+	StopID        string `json:"stop_id"`
+	Name          string `json:"name"`
+	CurrentOrder  int    `json:"current_order"`
+	ProposedOrder int    `json:"proposed_order"`
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"time"
+)
+
+// 📝 설명: 운행 일정 API 요청 DTO
+// 🎯 실무 포인트: 출발 시각은 HH:MM(24시간), 요일은 1(월)~7(일)
+// ⚠️ 주의사항: len=5 + datetime 조합으로 "8:00" 같은 한 자리 시각도 거부
+
+// CreateScheduleRequest - 일정 생성 요청
+type CreateScheduleRequest struct {
+	Name               string     `json:"name" binding:"required"`
+	Description        string     `json:"description"`
+	StartTime          string     `json:"start_time" binding:"required,hhmm"` // 예: "08:00"
+	TimeSlot           string     `json:"time_slot" binding:"required,oneof=morning afternoon evening"`
+	DaysOfWeek         []int      `json:"days_of_week" binding:"required,min=1,max=7,dive,weekday"` // 예: [1,2,3,4,5]
+	SkipHolidays       *bool      `json:"skip_holidays"`                                            // 공휴일 운행 제외 (기본 true)
+	RouteID            string     `json:"route_id" binding:"required"`
+	VehicleID          string     `json:"vehicle_id" binding:"required"`
+	DefaultDriverID    string     `json:"default_driver_id" binding:"required"`
+	DefaultAttendantID *string    `json:"default_attendant_id"`
+	ValidFrom          *time.Time `json:"valid_from"`
+	ValidTo            *time.Time `json:"valid_to"`
+}
+
+// UpdateScheduleRequest - 일정 수정 요청 (전달된 필드만 수정)
+type UpdateScheduleRequest struct {
+	Name               *string    `json:"name" binding:"omitempty,min=1"`
+	Description        *string    `json:"description"`
+	StartTime          *string    `json:"start_time" binding:"omitempty,hhmm"`
+	TimeSlot           *string    `json:"time_slot" binding:"omitempty,oneof=morning afternoon evening"`
+	DaysOfWeek         []int      `json:"days_of_week" binding:"omitempty,min=1,max=7,dive,weekday"`
+	SkipHolidays       *bool      `json:"skip_holidays"`
+	RouteID            *string    `json:"route_id" binding:"omitempty,min=1"`
+	VehicleID          *string    `json:"vehicle_id" binding:"omitempty,min=1"`
+	DefaultDriverID    *string    `json:"default_driver_id" binding:"omitempty,min=1"`
+	DefaultAttendantID *string    `json:"default_attendant_id"` // 빈 문자열이면 배정 해제
+	ValidFrom          *time.Time `json:"valid_from"`
+	ValidTo            *time.Time `json:"valid_to"`
+}
+
+// ListScheduleQuery - 일정 목록 조회 쿼리
+type ListScheduleQuery struct {
+	Status         string `form:"status" binding:"omitempty,oneof=active inactive"`
+	RouteID        string `form:"route_id"`
+	VehicleID      string `form:"vehicle_id"`
+	DriverID       string `form:"driver_id"`
+	IncludeDeleted bool   `form:"include_deleted"` // 삭제된 항목 포함 (관리자만)
+}
+
+// CreateScheduleExceptionRequest - 일정 예외 날짜 추가 요청
+type CreateScheduleExceptionRequest struct {
+	Date   string `json:"date" binding:"required,datetime=2006-01-02"` // 예: "2025-05-05"
+	Type   string `json:"type" binding:"required,oneof=skip extra"`    // skip: 운행 안 함, extra: 추가 운행
+	Reason string `json:"reason" binding:"max=200"`
+}
+
+// ScheduleConflictResponse - 같은 차량/기사가 이미 배정된 겹치는 일정 (CONFLICT 응답 details.conflicts)
+type ScheduleConflictResponse struct {
+	Resource     string `json:"resource"` // vehicle, driver
+	ScheduleID   string `json:"schedule_id"`
+	ScheduleName string `json:"schedule_name"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"` // 출발 시각 + 경로 예상 소요 시간
+	DaysOfWeek   []int  `json:"days_of_week"`
+}
@@ -0,0 +1,244 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: 운행(Trip) API 요청 DTO
+// 🎯 실무 포인트: 날짜는 YYYY-MM-DD, 배정 정보는 일정 기본값에서 복사
+// ⚠️ 주의사항: 위치는 위도/경도를 함께 보내야 기록됨
+
+// CreateTripRequest - 운행 생성 요청 (일정 + 날짜)
+type CreateTripRequest struct {
+	ScheduleID string `json:"schedule_id" binding:"required"`
+	Date       string `json:"date" binding:"required,datetime=2006-01-02"` // 예: "2025-03-02"
+}
+
+// ListTripQuery - 운행 목록 조회 쿼리
+type ListTripQuery struct {
+	Date        string `form:"date" binding:"omitempty,datetime=2006-01-02"`
+	Status      string `form:"status" binding:"omitempty,oneof=pending in_progress paused completed cancelled"`
+	ScheduleID  string `form:"schedule_id"`
+	DriverID    string `form:"driver_id"`
+	AttendantID string `form:"attendant_id"`
+}
+
+// TripLocationRequest - 운행 시작/완료 시 현재 위치 (선택)
+type TripLocationRequest struct {
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,longitude"`
+}
+
+// CompleteTripRequest - 운행 완료 요청 (현재 위치, 기사가 확인한 주행거리계 선택)
+type CompleteTripRequest struct {
+	TripLocationRequest
+	OdometerKm *int `json:"odometer_km" binding:"omitempty,gt=0"` // 생략하면 차량 주행거리계 + 주행 거리로 계산
+}
+
+// CancelTripRequest - 운행 취소 요청
+type CancelTripRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// PauseTripRequest - 운행 일시정지 요청
+type PauseTripRequest struct {
+	Reason string `json:"reason" binding:"required,max=200"` // 예: "병원 진료 대기"
+}
+
+// ReportDelayRequest - 운행 지연 보고 요청
+type ReportDelayRequest struct {
+	Reason string `json:"reason" binding:"required,max=200"`
+}
+
+// MarkNoShowRequest - 탑승자 불참 처리 요청
+type MarkNoShowRequest struct {
+	Reason string `json:"reason" binding:"required,max=200"`
+}
+
+// FlagPassengerExceptionRequest - 승차 탑승자 예외 처리 요청 (하차 기록 없이 차량을 떠난 경우)
+type FlagPassengerExceptionRequest struct {
+	Reason string `json:"reason" binding:"required,max=200"` // 예: "중간에 보호자 인계"
+}
+
+// BulkBoardingRequest - 정류장 일괄 승차/하차 요청 (passenger_ids 또는 all_expected 중 하나 + 현재 위치 선택)
+type BulkBoardingRequest struct {
+	PassengerIDs []string `json:"passenger_ids" binding:"required_without=AllExpected,excluded_with=AllExpected,max=100,dive,required"`
+	AllExpected  bool     `json:"all_expected"` // 그 정류장의 탑승 예정자 전원 (승차: 미승차·불참·결석 신고 제외 / 하차: 승차 후 미하차)
+	TripLocationRequest
+}
+
+// RecordStopEventRequest - 정류장 도착/출발 수동 기록 요청 (현재 위치 선택)
+type RecordStopEventRequest struct {
+	Type string `json:"type" binding:"required,oneof=arrived departed"`
+	TripLocationRequest
+}
+
+// UpdateTripAssignmentRequest - 운행 배정 변경 요청 (전달된 항목만 교체, 차량 고장·기사 병가 등)
+type UpdateTripAssignmentRequest struct {
+	VehicleID   *string `json:"vehicle_id" binding:"omitempty,min=1"`
+	DriverID    *string `json:"driver_id" binding:"omitempty,min=1"`
+	AttendantID *string `json:"attendant_id"`                       // 빈 문자열이면 동승자 해제
+	Reason      string  `json:"reason" binding:"omitempty,max=200"` // 보호자 알림에 포함 (예: "차량 고장")
+}
+
+// TripConflictResponse - 교체 대상이 이미 배정된 겹치는 운행 (resource: vehicle / driver / attendant)
+type TripConflictResponse struct {
+	Resource     string            `json:"resource"`
+	TripID       string            `json:"trip_id"`
+	ScheduleID   string            `json:"schedule_id"`
+	ScheduleName string            `json:"schedule_name"`
+	Status       domain.TripStatus `json:"status"`
+	StartTime    string            `json:"start_time"` // HH:MM
+	EndTime      string            `json:"end_time"`   // HH:MM (출발 + 경로 예상 소요 시간)
+}
+
+// ReportLocationRequest - 운행 중 차량 위치 전송 요청
+type ReportLocationRequest struct {
+	Latitude   *float64   `json:"latitude" binding:"required,latitude"`
+	Longitude  *float64   `json:"longitude" binding:"required,longitude"`
+	Speed      *float64   `json:"speed" binding:"omitempty,min=0"`           // km/h
+	Heading    *float64   `json:"heading" binding:"omitempty,min=0,max=360"` // 도
+	RecordedAt *time.Time `json:"recorded_at"`                               // 단말 측정 시각 (RFC3339, 생략 시 수신 시각)
+}
+
+// TrackQuery - 운행 경로 조회 쿼리
+type TrackQuery struct {
+	Tolerance *float64 `form:"tolerance" binding:"omitempty,min=0,max=500"` // 단순화 허용 오차 (미터, 기본 10, 0이면 원본)
+}
+
+// TrackPoint - 경로 좌표
+type TrackPoint struct {
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// TrackResponse - 실제 주행 경로 (계획 경로와 비교용)
+type TrackResponse struct {
+	TripID       string        `json:"trip_id"`
+	TotalPoints  int           `json:"total_points"`  // 저장된 GPS 기록 수 (단순화 전)
+	Points       []TrackPoint  `json:"points"`        // 단순화된 좌표 (측정 시각 순)
+	Polyline     string        `json:"polyline"`      // Points의 Google Encoded Polyline
+	PlannedStops []domain.Stop `json:"planned_stops"` // 일정 경로의 정류장 (순서대로)
+}
+
+// ReplayQuery - 운행 재생 쿼리 (구간 생략 시 기록 전체)
+type ReplayQuery struct {
+	From     *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"` // 시작 시각 (RFC3339)
+	To       *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`   // 끝 시각 (RFC3339)
+	Interval int        `form:"interval" binding:"omitempty,min=1,max=300"`   // 프레임 간격 (초, 기본 5)
+}
+
+// ReplayFrame - 재생 프레임 (기록 사이 시각은 보간 위치)
+type ReplayFrame struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	Speed        *float64  `json:"speed,omitempty"`
+	Heading      *float64  `json:"heading,omitempty"`
+	Interpolated bool      `json:"interpolated"` // true면 기록 사이 보간 위치
+}
+
+// ReplayResponse - 일정 간격 위치 프레임 (관리자 화면 애니메이션용)
+type ReplayResponse struct {
+	TripID   string        `json:"trip_id"`
+	From     *time.Time    `json:"from,omitempty"` // 실제 재생 구간 (기록이 없으면 생략)
+	To       *time.Time    `json:"to,omitempty"`
+	Interval int           `json:"interval"` // 초
+	Frames   []ReplayFrame `json:"frames"`
+}
+
+// TripETAResponse - 남은 정류장 도착 예정 시간 (아직 위치가 없으면 position 생략, stops 빈 목록)
+type TripETAResponse struct {
+	TripID       string            `json:"trip_id"`
+	Position     *TrackPoint       `json:"position,omitempty"` // 계산 기준 위치
+	CalculatedAt *time.Time        `json:"calculated_at,omitempty"`
+	Stops        []StopETAResponse `json:"stops"`
+}
+
+// StopETAResponse - 정류장 도착 예정
+type StopETAResponse struct {
+	StopID    string    `json:"stop_id"`
+	StopName  string    `json:"stop_name"`
+	StopOrder int       `json:"stop_order"`
+	Distance  float64   `json:"distance"` // 남은 거리 (미터)
+	ArrivalAt time.Time `json:"arrival_at"`
+	Minutes   int       `json:"minutes"` // 조회 시점 기준 남은 분 (올림, 도착했으면 0)
+	Arrived   bool      `json:"arrived"`
+}
+
+// TripManifestResponse - 운행 탑승 명단 (정류장 순서대로, 기사/동승자 앱 기본 화면)
+type TripManifestResponse struct {
+	TripID          string                 `json:"trip_id"`
+	ScheduleID      string                 `json:"schedule_id"`
+	ScheduleName    string                 `json:"schedule_name"`
+	Date            string                 `json:"date"`
+	Status          domain.TripStatus      `json:"status"`
+	TimeSlot        domain.TimeSlot        `json:"time_slot"`
+	RouteID         string                 `json:"route_id"`
+	TotalPassengers int                    `json:"total_passengers"`
+	MedicalAlerts   int                    `json:"medical_alerts"` // 의료 특이사항이 있는 탑승자 수
+	Stops           []ManifestStopResponse `json:"stops"`
+}
+
+// ManifestStopResponse - 정류장별 승차/하차 예정 탑승자
+type ManifestStopResponse struct {
+	StopID    string                      `json:"stop_id"`
+	StopName  string                      `json:"stop_name"`
+	StopOrder int                         `json:"stop_order"`
+	Address   string                      `json:"address,omitempty"`
+	Latitude  float64                     `json:"latitude"`
+	Longitude float64                     `json:"longitude"`
+	Boarding  []ManifestPassengerResponse `json:"boarding"`  // 이 정류장에서 승차
+	Alighting []ManifestPassengerResponse `json:"alighting"` // 이 정류장에서 하차
+}
+
+// ManifestPassengerResponse - 명단의 탑승자 (보호자 연락처, 의료 특이사항 포함)
+type ManifestPassengerResponse struct {
+	PassengerID       string                    `json:"passenger_id"`
+	Name              string                    `json:"name"`
+	Age               int                       `json:"age,omitempty"`
+	Status            string                    `json:"status"` // expected | boarded | alighted | no_show | excused
+	AbsenceReason     string                    `json:"absence_reason,omitempty"`
+	MedicalAlert      bool                      `json:"medical_alert"`
+	MedicalNotes      string                    `json:"medical_notes,omitempty"`
+	Notes             string                    `json:"notes,omitempty"`
+	Guardians         []ManifestGuardianContact `json:"guardians"`
+	EmergencyContact  string                    `json:"emergency_contact,omitempty"`
+	EmergencyRelation string                    `json:"emergency_relation,omitempty"`
+}
+
+// ManifestGuardianContact - 보호자 연락처
+type ManifestGuardianContact struct {
+	Name     string `json:"name"`
+	Phone    string `json:"phone"`
+	Relation string `json:"relation,omitempty"`
+}
+
+// TripPunctualityResponse - 정류장별 예상 도착 시각 대비 실제 도착 (정시성 보고서)
+type TripPunctualityResponse struct {
+	TripID             string                    `json:"trip_id"`
+	Date               string                    `json:"date"`
+	ScheduledDeparture time.Time                 `json:"scheduled_departure"` // 일정 출발 시각
+	StartedAt          *time.Time                `json:"started_at,omitempty"`
+	RecordedStops      int                       `json:"recorded_stops"` // 도착 기록이 있는 정류장 수
+	OnTimeStops        int                       `json:"on_time_stops"`  // 허용 오차 안에 도착한 정류장 수
+	LateStops          int                       `json:"late_stops"`
+	AverageDelay       float64                   `json:"average_delay"` // 도착 기록 정류장의 평균 지연 (분, 일찍 도착은 음수)
+	Stops              []StopPunctualityResponse `json:"stops"`
+}
+
+// StopPunctualityResponse - 정류장 예상/실제 도착 (도착 기록이 없으면 actual_arrival, delay 생략)
+type StopPunctualityResponse struct {
+	StopID           string                 `json:"stop_id"`
+	StopName         string                 `json:"stop_name"`
+	StopOrder        int                    `json:"stop_order"`
+	EstimatedArrival time.Time              `json:"estimated_arrival"` // 일정 출발 시각 + 정류장 예상 도착 시간
+	ActualArrival    *time.Time             `json:"actual_arrival,omitempty"`
+	ActualDeparture  *time.Time             `json:"actual_departure,omitempty"`
+	ArrivalSource    domain.StopEventSource `json:"arrival_source,omitempty"` // geofence | manual
+	Delay            *int                   `json:"delay,omitempty"`          // 지연 (분, 일찍 도착은 음수)
+	OnTime           bool                   `json:"on_time"`
+}
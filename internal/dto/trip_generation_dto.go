@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+// 📝 설명: 운행 생성 미리보기 DTO
+// 🎯 실무 포인트: 일정마다 생성될 운행 또는 생성하지 않는 이유(코드 + 설명)를 함께 반환
+// ⚠️ 주의사항: 미리보기는 아무것도 저장하지 않음
+
+// PreviewTripGenerationQuery - 운행 생성 미리보기 조건
+type PreviewTripGenerationQuery struct {
+	Date string `form:"date" binding:"omitempty,datetime=2006-01-02"` // 비우면 기관 시간대의 오늘
+}
+
+// TripGenerationPreviewResponse - 운행 생성 미리보기 (저장하지 않음)
+type TripGenerationPreviewResponse struct {
+	Date     string                    `json:"date"`
+	Timezone string                    `json:"timezone"` // 출발 시각 기준 시간대 (기관 설정)
+	Planned  []PlannedTripResponse     `json:"planned"`  // 생성될 운행
+	Skipped  []SkippedScheduleResponse `json:"skipped"`  // 생성하지 않는 일정과 이유
+}
+
+// PlannedTripResponse - 생성될 운행 (일정 기본 배정 기준, 기사/동승자는 대체 배정 우선)
+type PlannedTripResponse struct {
+	ScheduleID          string    `json:"schedule_id"`
+	ScheduleName        string    `json:"schedule_name"`
+	StartTime           string    `json:"start_time"`
+	DepartureAt         time.Time `json:"departure_at"` // 기관 시간대로 해석한 출발 예정 시각
+	RouteID             string    `json:"route_id"`
+	VehicleID           string    `json:"vehicle_id"`
+	DriverID            string    `json:"driver_id"`
+	DriverSubstitute    bool      `json:"driver_substitute"` // 기사 대체 배정 적용
+	AttendantID         *string   `json:"attendant_id,omitempty"`
+	AttendantSubstitute bool      `json:"attendant_substitute"` // 동승자 대체 배정 적용
+	Extra               bool      `json:"extra"`                // 일정 예외 날짜의 추가 운행
+}
+
+// SkippedScheduleResponse - 생성하지 않는 일정
+type SkippedScheduleResponse struct {
+	ScheduleID   string `json:"schedule_id"`
+	ScheduleName string `json:"schedule_name"`
+	Reason       string `json:"reason"`             // inactive, out_of_period, not_service_day, holiday, exception, already_exists, vehicle_unavailable, over_capacity
+	Detail       string `json:"detail"`             // 사람이 읽을 설명
+	Overflow     int    `json:"overflow,omitempty"` // over_capacity일 때 정원 초과 인원 (경로 분할 판단용)
+}
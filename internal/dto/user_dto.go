@@ -0,0 +1,77 @@
+package dto
+
+import "github.com/hyeokjun/eodini/internal/domain"
+
+// 📝 설명: 로그인/비밀번호/계정 관리 요청·응답 DTO
+// 🎯 실무 포인트: 비밀번호는 bcrypt 한계(72바이트)에 맞춰 최대 길이 제한
+// ⚠️ 주의사항: 재설정 요청은 계정 존재 여부와 관계없이 같은 응답
+
+// LoginRequest - 로그인 요청
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+// LoginResponse - 로그인 결과 (액세스 토큰)
+type LoginResponse struct {
+	AccessToken string       `json:"access_token"`
+	TokenType   string       `json:"token_type"` // 항상 "Bearer"
+	ExpiresIn   int          `json:"expires_in"` // 초 단위
+	SessionID   string       `json:"session_id"` // 세션 종료(DELETE /auth/sessions/:id)에 사용
+	User        *domain.User `json:"user"`
+}
+
+// SessionResponse - 로그인 세션 정보
+type SessionResponse struct {
+	*domain.Session
+	Current bool `json:"current"` // 요청에 사용한 토큰의 세션 여부
+}
+
+// RevokeSessionsResponse - 세션 일괄 종료 결과
+type RevokeSessionsResponse struct {
+	Revoked int `json:"revoked"` // 종료한 세션 수
+}
+
+// ChangePasswordRequest - 비밀번호 변경 요청 (로그인 사용자 본인)
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=8,max=72"`
+}
+
+// UpdateLocaleRequest - 언어 설정 변경 요청 (로그인 사용자 본인, 빈 값이면 설정 해제)
+type UpdateLocaleRequest struct {
+	Locale string `json:"locale" binding:"omitempty,oneof=ko en" example:"en"`
+}
+
+// ForgotPasswordRequest - 비밀번호 재설정 안내 요청
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetPasswordRequest - 재설정 토큰으로 비밀번호 변경
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8,max=72"`
+}
+
+// CreateUserRequest - 계정 생성 요청 (관리자)
+type CreateUserRequest struct {
+	Email     string `json:"email" binding:"required,email"`
+	Phone     string `json:"phone"`
+	Password  string `json:"password" binding:"required,min=8,max=72"`
+	Role      string `json:"role" binding:"required,oneof=admin driver attendant guardian"`
+	ProfileID string `json:"profile_id" binding:"omitempty,uuid"` // 기사/동승자/보호자 ID
+}
+
+// ChangeUserRoleRequest - 기관 내 역할 변경 요청 (관리자)
+type ChangeUserRoleRequest struct {
+	Role      string `json:"role" binding:"required,oneof=admin driver attendant guardian"`
+	ProfileID string `json:"profile_id" binding:"omitempty,uuid"` // 기사/동승자/보호자 ID
+}
+
+// ListUserQuery - 계정 목록 조회 쿼리
+type ListUserQuery struct {
+	Role   string `form:"role" binding:"omitempty,oneof=admin driver attendant guardian"`
+	Status string `form:"status" binding:"omitempty,oneof=active disabled"`
+	Email  string `form:"email"`
+}
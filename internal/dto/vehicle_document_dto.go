@@ -0,0 +1,18 @@
+package dto
+
+// 📝 설명: 차량 서류 DTO (multipart/form-data 업로드, 목록 조건)
+// 🎯 실무 포인트: 파일은 file 필드, 나머지 항목은 같은 폼의 필드로 받음
+// ⚠️ 주의사항: 발급일/만료일은 YYYY-MM-DD (기관 시간대)
+
+// UploadVehicleDocumentRequest - 차량 서류 업로드 요청 (파일 제외 폼 필드)
+type UploadVehicleDocumentRequest struct {
+	Type      string `form:"type" binding:"required,oneof=insurance inspection registration other"`
+	Title     string `form:"title" binding:"max=100"`
+	IssuedOn  string `form:"issued_on" binding:"omitempty,datetime=2006-01-02"`
+	ExpiresOn string `form:"expires_on" binding:"omitempty,datetime=2006-01-02"` // 보험/정기검사는 차량 만료일에 반영
+}
+
+// VehicleDocumentQuery - 차량 서류 목록 조건
+type VehicleDocumentQuery struct {
+	Type string `form:"type" binding:"omitempty,oneof=insurance inspection registration other"`
+}
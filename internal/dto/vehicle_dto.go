@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+)
+
+// 📝 설명: 차량 API 요청 DTO
+// 🎯 실무 포인트: Spring의 @RequestBody + @Valid처럼 gin binding 태그로 검증
+// ⚠️ 주의사항: 수정 요청은 포인터 필드로 "값 없음"과 "빈 값"을 구분
+
+// CreateVehicleRequest - 차량 등록 요청
+type CreateVehicleRequest struct {
+	PlateNumber      string     `json:"plate_number" binding:"required,kr_plate"`
+	Model            string     `json:"model" binding:"required"`
+	Manufacturer     string     `json:"manufacturer"`
+	VehicleType      string     `json:"vehicle_type" binding:"required,oneof=van bus mini_bus sedan"`
+	Capacity         int        `json:"capacity" binding:"required,min=1,max=100"`
+	Year             int        `json:"year" binding:"omitempty,min=1990,max=2100"`
+	Color            string     `json:"color"`
+	InsuranceExpiry  *time.Time `json:"insurance_expiry"`
+	InspectionExpiry *time.Time `json:"inspection_expiry"`
+}
+
+// UpdateVehicleRequest - 차량 수정 요청 (전달된 필드만 수정)
+type UpdateVehicleRequest struct {
+	PlateNumber      *string    `json:"plate_number" binding:"omitempty,kr_plate"`
+	Model            *string    `json:"model" binding:"omitempty,min=1"`
+	Manufacturer     *string    `json:"manufacturer"`
+	VehicleType      *string    `json:"vehicle_type" binding:"omitempty,oneof=van bus mini_bus sedan"`
+	Capacity         *int       `json:"capacity" binding:"omitempty,min=1,max=100"`
+	Year             *int       `json:"year" binding:"omitempty,min=1990,max=2100"`
+	Color            *string    `json:"color"`
+	Status           *string    `json:"status" binding:"omitempty,oneof=active maintenance inactive"`
+	InsuranceExpiry  *time.Time `json:"insurance_expiry"`
+	InspectionExpiry *time.Time `json:"inspection_expiry"`
+}
+
+// ListVehicleQuery - 차량 목록 조회 쿼리
+type ListVehicleQuery struct {
+	Status      string `form:"status" binding:"omitempty,oneof=active maintenance inactive"`
+	VehicleType string `form:"vehicle_type" binding:"omitempty,oneof=van bus mini_bus sedan"`
+	MinCapacity *int   `form:"min_capacity" binding:"omitempty,min=1,max=100"` // 정원 하한 (운전석 포함)
+	MaxCapacity *int   `form:"max_capacity" binding:"omitempty,min=1,max=100"` // 정원 상한
+
+	// 보험/정기검사 만료 (만료 임박은 이미 만료 포함, 예: insurance_expiring_within=30d)
+	InsuranceExpiringWithin  string `form:"insurance_expiring_within" binding:"omitempty,days"`
+	InsuranceExpired         *bool  `form:"insurance_expired"`
+	InspectionExpiringWithin string `form:"inspection_expiring_within" binding:"omitempty,days"`
+	InspectionExpired        *bool  `form:"inspection_expired"`
+
+	IncludeDeleted bool `form:"include_deleted"` // 삭제된 항목 포함 (관리자만)
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// 📝 설명: 웹훅 구독 관리 요청/응답 DTO와 전달 본문(이벤트 데이터)
+// 🎯 실무 포인트: 등록 응답에만 서명 비밀키 포함 (이후 조회 불가, 잊어버리면 구독을 다시 등록)
+// ⚠️ 주의사항: 전달 본문은 시설 외부 시스템으로 나가므로 ID와 시각만 담고 이름/연락처 등 개인정보는 넣지 않음
+
+// CreateWebhookRequest - 웹훅 구독 등록 요청
+type CreateWebhookRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	URL    string   `json:"url" binding:"required,url,max=500"`
+	Secret string   `json:"secret" binding:"omitempty,min=16,max=200"` // 생략 시 서버가 생성
+	Events []string `json:"events" binding:"required,min=1,dive,oneof=trip.started trip.completed trip.cancelled trip.delayed trip.reassigned passenger.boarded passenger.alighted passenger.no_show passenger.exception"`
+}
+
+// UpdateWebhookRequest - 웹훅 구독 수정 요청 (보낸 필드만 변경)
+type UpdateWebhookRequest struct {
+	Name   *string  `json:"name" binding:"omitempty,min=1,max=100"`
+	URL    *string  `json:"url" binding:"omitempty,url,max=500"`
+	Events []string `json:"events" binding:"omitempty,min=1,dive,oneof=trip.started trip.completed trip.cancelled trip.delayed trip.reassigned passenger.boarded passenger.alighted passenger.no_show passenger.exception"`
+	Active *bool    `json:"active"`
+}
+
+// ListWebhookDeliveryQuery - 웹훅 전달 기록 목록 조회 쿼리
+type ListWebhookDeliveryQuery struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending delivered retrying failed dead"`
+	Event  string `form:"event" binding:"omitempty,oneof=trip.started trip.completed trip.cancelled trip.delayed trip.reassigned passenger.boarded passenger.alighted passenger.no_show passenger.exception"`
+}
+
+// IssuedWebhookResponse - 웹훅 구독 등록 결과
+type IssuedWebhookResponse struct {
+	Webhook *domain.WebhookSubscription `json:"webhook"`
+	Secret  string                      `json:"secret"` // 서명 비밀키 (이 응답에서만 제공)
+}
+
+// WebhookEnvelope - 웹훅 전달 본문
+type WebhookEnvelope struct {
+	ID        string              `json:"id"` // 이벤트 ID (구독별 전달이 같은 값 → 수신 측 중복 처리 방지)
+	Type      domain.WebhookEvent `json:"type"`
+	CreatedAt time.Time           `json:"created_at"`
+	Data      interface{}         `json:"data"`
+}
+
+// TripWebhookData - 운행 이벤트 데이터 (trip.started, trip.completed, trip.cancelled, trip.delayed)
+type TripWebhookData struct {
+	TripID             string     `json:"trip_id"`
+	ScheduleID         string     `json:"schedule_id"`
+	Date               string     `json:"date"` // YYYY-MM-DD
+	VehicleID          string     `json:"vehicle_id"`
+	DriverID           string     `json:"driver_id"`
+	AttendantID        *string    `json:"attendant_id,omitempty"`
+	Status             string     `json:"status"`
+	StartedAt          *time.Time `json:"started_at,omitempty"`
+	CompletedAt        *time.Time `json:"completed_at,omitempty"`
+	CancellationReason string     `json:"cancellation_reason,omitempty"`
+	DelayedAt          *time.Time `json:"delayed_at,omitempty"`
+	DelayReason        string     `json:"delay_reason,omitempty"`
+}
+
+// TripReassignmentWebhookData - 운행 배정 변경 이벤트 데이터 (trip.reassigned, 변경 전 배정과 사유 포함)
+type TripReassignmentWebhookData struct {
+	TripWebhookData
+	PreviousVehicleID   string  `json:"previous_vehicle_id"`
+	PreviousDriverID    string  `json:"previous_driver_id"`
+	PreviousAttendantID *string `json:"previous_attendant_id,omitempty"`
+	Reason              string  `json:"reason,omitempty"`
+}
+
+// PassengerWebhookData - 탑승 이벤트 데이터 (passenger.boarded, passenger.alighted, passenger.no_show, passenger.exception)
+type PassengerWebhookData struct {
+	TripID          string     `json:"trip_id"`
+	ScheduleID      string     `json:"schedule_id"`
+	Date            string     `json:"date"` // YYYY-MM-DD
+	PassengerID     string     `json:"passenger_id"`
+	StopID          string     `json:"stop_id"`
+	BoardedAt       *time.Time `json:"boarded_at,omitempty"`
+	AlightedAt      *time.Time `json:"alighted_at,omitempty"`
+	NoShowAt        *time.Time `json:"no_show_at,omitempty"`
+	NoShowReason    string     `json:"no_show_reason,omitempty"`
+	ExceptionAt     *time.Time `json:"exception_at,omitempty"`
+	ExceptionReason string     `json:"exception_reason,omitempty"`
+}
@@ -0,0 +1,83 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// 📝 설명: 도메인 이벤트 디스패처 (운행 시작, 승차 등 상태 변화를 후속 모듈에 전달)
+// 🎯 실무 포인트: 서비스는 이벤트를 아웃박스에 기록만 하고, 릴레이가 커밋된 이벤트를 Dispatch로 구독 핸들러에 전달
+// 웹훅 전달/보호자 알림/통계 집계 같은 후속 처리는 요청 처리와 분리되고 서버가 중간에 내려가도 유실되지 않음
+// ⚠️ 주의사항: 같은 이벤트를 여러 번 받을 수 있음 (at-least-once) → 핸들러는 Event.ID로 중복을 견디게 작성
+// 핸들러 이름은 재시도 시 이미 처리한 핸들러를 건너뛰는 기준이므로 배포 간에 바꾸지 않음
+
+// Event - 도메인 이벤트
+type Event struct {
+	ID             string          `json:"id"`              // 이벤트 ID (재전달해도 같은 값)
+	Type           string          `json:"type"`            // 이벤트 종류 (예: trip.started)
+	OrganizationID string          `json:"organization_id"` // 이벤트가 발생한 기관
+	OccurredAt     time.Time       `json:"occurred_at"`     // 발생 시각
+	Payload        json.RawMessage `json:"payload"`         // 이벤트 데이터 (JSON)
+}
+
+// Decode - Payload를 v로 해석
+func (e Event) Decode(v interface{}) error {
+	return json.Unmarshal(e.Payload, v)
+}
+
+// HandlerFunc - 이벤트 처리 함수 (에러를 반환하면 릴레이가 나중에 다시 전달)
+type HandlerFunc func(ctx context.Context, e Event) error
+
+// subscription - 핸들러 등록 정보
+type subscription struct {
+	name    string
+	types   []string // 비어 있으면 모든 이벤트
+	handler HandlerFunc
+}
+
+// Dispatcher - 이벤트 종류별 핸들러 목록
+type Dispatcher struct {
+	subscriptions []subscription
+}
+
+// NewDispatcher - 디스패처 생성
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{}
+}
+
+// Subscribe - 핸들러 등록 (types를 생략하면 모든 이벤트, 서버 시작 시에만 호출)
+// 사용 예: dispatcher.Subscribe("webhook", webhookService.HandleEvent)
+func (d *Dispatcher) Subscribe(name string, handler HandlerFunc, types ...string) {
+	d.subscriptions = append(d.subscriptions, subscription{name: name, types: types, handler: handler})
+}
+
+// Dispatch - 이벤트를 받는 핸들러를 등록 순서대로 실행 (done에 있는 핸들러는 건너뜀)
+// 한 핸들러가 실패해도 나머지는 실행, 성공한 핸들러 이름을 done에 더해 반환
+func (d *Dispatcher) Dispatch(ctx context.Context, e Event, done []string) ([]string, error) {
+	var errs []error
+	for _, sub := range d.subscriptions {
+		if slices.Contains(done, sub.name) || (len(sub.types) > 0 && !slices.Contains(sub.types, e.Type)) {
+			continue
+		}
+		if err := sub.handle(ctx, e); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+			continue
+		}
+		done = append(done, sub.name)
+	}
+	return done, errors.Join(errs...)
+}
+
+// handle - 핸들러 실행 (패닉은 에러로 바꿔 다른 핸들러와 릴레이를 보호)
+func (s subscription) handle(ctx context.Context, e Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.handler(ctx, e)
+}
@@ -0,0 +1,190 @@
+package geofence
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 정류장 지오펜스 (수신한 GPS 좌표로 정류장 접근/도착/출발 판정)
+// 🎯 실무 포인트: 정류장별 단계(접근 → 도착 → 출발)를 운행 단위로 저장하고, 단계가 바뀔 때만 이벤트 발생
+// 알림/도착 예정 시간 등 후속 모듈은 Listener로 이벤트를 받음
+// ⚠️ 주의사항: 출발 반경을 도착 반경보다 크게 두어 정류장 근처 GPS 튐으로 도착/출발이 반복되지 않게 함
+// 한 운행에서 정류장은 한 번만 출발 처리 (출발 후 다시 들어와도 이벤트 없음)
+
+// EventType - 지오펜스 이벤트 종류
+type EventType string
+
+const (
+	EventApproaching EventType = "stop_approaching" // 접근 반경 진입
+	EventArrived     EventType = "stop_arrived"     // 도착 반경 진입
+	EventDeparted    EventType = "stop_departed"    // 도착 후 출발 반경 이탈
+)
+
+// Phase - 운행 중 정류장별 진행 단계
+type Phase string
+
+const (
+	PhaseNone        Phase = ""            // 아직 접근 전
+	PhaseApproaching Phase = "approaching" // 접근 중
+	PhaseArrived     Phase = "arrived"     // 정류장 도착
+	PhaseDeparted    Phase = "departed"    // 정류장 출발
+)
+
+// Event - 정류장 지오펜스 이벤트
+type Event struct {
+	Type       EventType `json:"type"`
+	TripID     string    `json:"trip_id"`
+	VehicleID  string    `json:"vehicle_id"`
+	StopID     string    `json:"stop_id"`
+	StopName   string    `json:"stop_name"`
+	StopOrder  int       `json:"stop_order"`
+	Distance   float64   `json:"distance"` // 판정 시점 정류장까지 거리 (미터)
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Config - 판정 반경 (미터)
+type Config struct {
+	ApproachRadius  float64 // 이 안에 들어오면 접근
+	ArrivalRadius   float64 // 이 안에 들어오면 도착
+	DepartureRadius float64 // 도착 후 이 밖으로 나가면 출발 (ArrivalRadius보다 크게)
+}
+
+// DefaultConfig - 기본 반경 (접근 300m, 도착 50m, 출발 80m)
+func DefaultConfig() Config {
+	return Config{ApproachRadius: 300, ArrivalRadius: 50, DepartureRadius: 80}
+}
+
+// StateStore - 운행별 정류장 단계 저장소 (RedisStore, MemoryStore가 구현)
+type StateStore interface {
+	Load(ctx context.Context, tripID string) (map[string]Phase, error)       // 정류장 ID → 단계
+	Save(ctx context.Context, tripID string, changes map[string]Phase) error // 바뀐 정류장만 저장
+	Clear(ctx context.Context, tripID string) error
+}
+
+// Listener - 지오펜스 이벤트 수신 (알림, 도착 예정 시간 등)
+type Listener interface {
+	HandleStopEvent(ctx context.Context, event Event)
+}
+
+// ListenerFunc - 함수를 Listener로 사용
+type ListenerFunc func(ctx context.Context, event Event)
+
+// HandleStopEvent - Listener 구현
+func (f ListenerFunc) HandleStopEvent(ctx context.Context, event Event) {
+	f(ctx, event)
+}
+
+// Engine - 정류장 지오펜스 판정기
+type Engine struct {
+	config    Config
+	store     StateStore
+	listeners []Listener
+}
+
+// NewEngine - 판정기 생성
+// 사용 예: engine := geofence.NewEngine(geofence.DefaultConfig(), geofence.NewRedisStore(rdb), broker)
+func NewEngine(config Config, store StateStore, listeners ...Listener) *Engine {
+	return &Engine{config: config, store: store, listeners: listeners}
+}
+
+// Evaluate - 위치를 정류장들과 비교해 단계가 바뀐 정류장의 이벤트를 Listener에 전달하고 반환
+// 저장소 오류 시 판정을 건너뜀 (위치 수신은 계속되어야 하므로 에러를 돌려주지 않음)
+func (e *Engine) Evaluate(ctx context.Context, position *domain.VehiclePosition, stops []domain.Stop) []Event {
+	if len(stops) == 0 {
+		return nil
+	}
+
+	phases, err := e.store.Load(ctx, position.TripID)
+	if err != nil {
+		logger.Warn("Geofence state unavailable", map[string]interface{}{
+			"trip_id": position.TripID,
+			"error":   err.Error(),
+		})
+		return nil
+	}
+
+	current := geo.Point{Latitude: position.Latitude, Longitude: position.Longitude}
+	changes := map[string]Phase{}
+	var events []Event
+	for _, stop := range stops {
+		distance := geo.Distance(current, geo.Point{Latitude: stop.Latitude, Longitude: stop.Longitude})
+		phase, eventType := e.transition(phases[stop.ID], distance)
+		if phase == phases[stop.ID] {
+			continue
+		}
+		changes[stop.ID] = phase
+		if eventType == "" {
+			continue
+		}
+		events = append(events, Event{
+			Type:       eventType,
+			TripID:     position.TripID,
+			VehicleID:  position.VehicleID,
+			StopID:     stop.ID,
+			StopName:   stop.Name,
+			StopOrder:  stop.Order,
+			Distance:   distance,
+			OccurredAt: position.RecordedAt,
+		})
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err := e.store.Save(ctx, position.TripID, changes); err != nil {
+		// 저장하지 못한 단계 변화는 다음 위치에서 다시 판정 (이벤트 중복 방지를 위해 전달하지 않음)
+		logger.Warn("Failed to save geofence state", map[string]interface{}{
+			"trip_id": position.TripID,
+			"error":   err.Error(),
+		})
+		return nil
+	}
+
+	for _, event := range events {
+		for _, listener := range e.listeners {
+			listener.HandleStopEvent(ctx, event)
+		}
+	}
+	return events
+}
+
+// Phases - 운행의 정류장별 현재 단계 (정류장 ID → 단계, 도착 예정 시간 계산 등에서 지난 정류장 판별)
+func (e *Engine) Phases(ctx context.Context, tripID string) (map[string]Phase, error) {
+	return e.store.Load(ctx, tripID)
+}
+
+// Reset - 운행의 정류장 단계 초기화 (운행 종료 시)
+func (e *Engine) Reset(ctx context.Context, tripID string) error {
+	return e.store.Clear(ctx, tripID)
+}
+
+// transition - 현재 단계와 거리로 다음 단계와 발생할 이벤트 결정
+func (e *Engine) transition(phase Phase, distance float64) (Phase, EventType) {
+	switch phase {
+	case PhaseDeparted:
+		return phase, ""
+	case PhaseArrived:
+		if distance > e.config.DepartureRadius {
+			return PhaseDeparted, EventDeparted
+		}
+		return phase, ""
+	}
+
+	switch {
+	case distance <= e.config.ArrivalRadius:
+		return PhaseArrived, EventArrived
+	case distance <= e.config.ApproachRadius:
+		if phase == PhaseNone {
+			return PhaseApproaching, EventApproaching
+		}
+		return phase, ""
+	case phase == PhaseApproaching:
+		// 도착 없이 접근 반경을 벗어남 (지나쳐 감) → 다시 접근할 수 있도록 초기화
+		return PhaseNone, ""
+	}
+	return phase, ""
+}
@@ -0,0 +1,105 @@
+package geofence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: 정류장 단계 저장소 (Redis: 여러 인스턴스 공유, 메모리: 단일 인스턴스/테스트)
+// 🎯 실무 포인트: 위치 요청이 어느 인스턴스로 들어와도 같은 단계를 보도록 Redis 해시(정류장 ID → 단계)에 저장
+// ⚠️ 주의사항: 운행 종료 후 Clear하지 못해도 stateTTL 뒤 자동 삭제
+
+// keyPrefix - 저장 키 접두사
+const keyPrefix = "geofence:"
+
+// stateTTL - 마지막 저장 후 단계 보관 시간 (하루 운행보다 길게)
+const stateTTL = 24 * time.Hour
+
+// RedisStore - Redis 기반 저장소
+type RedisStore struct {
+	rdb *redis.Client
+}
+
+// NewRedisStore - 저장소 생성
+// 사용 예: store := geofence.NewRedisStore(rdb)
+func NewRedisStore(rdb *redis.Client) *RedisStore {
+	return &RedisStore{rdb: rdb}
+}
+
+// Load - 운행의 정류장별 단계
+func (s *RedisStore) Load(ctx context.Context, tripID string) (map[string]Phase, error) {
+	values, err := s.rdb.HGetAll(ctx, keyPrefix+tripID).Result()
+	if err != nil {
+		return nil, err
+	}
+	phases := make(map[string]Phase, len(values))
+	for stopID, phase := range values {
+		phases[stopID] = Phase(phase)
+	}
+	return phases, nil
+}
+
+// Save - 바뀐 정류장 단계 저장 후 보관 시간 연장
+func (s *RedisStore) Save(ctx context.Context, tripID string, changes map[string]Phase) error {
+	values := make(map[string]interface{}, len(changes))
+	for stopID, phase := range changes {
+		values[stopID] = string(phase)
+	}
+
+	pipe := s.rdb.TxPipeline()
+	pipe.HSet(ctx, keyPrefix+tripID, values)
+	pipe.Expire(ctx, keyPrefix+tripID, stateTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Clear - 운행 단계 삭제
+func (s *RedisStore) Clear(ctx context.Context, tripID string) error {
+	return s.rdb.Del(ctx, keyPrefix+tripID).Err()
+}
+
+// MemoryStore - 프로세스 메모리 저장소 (단일 인스턴스/테스트용)
+type MemoryStore struct {
+	mu     sync.Mutex
+	phases map[string]map[string]Phase
+}
+
+// NewMemoryStore - 메모리 저장소 생성
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{phases: map[string]map[string]Phase{}}
+}
+
+// Load - 운행의 정류장별 단계 (복사본)
+func (s *MemoryStore) Load(ctx context.Context, tripID string) (map[string]Phase, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	phases := make(map[string]Phase, len(s.phases[tripID]))
+	for stopID, phase := range s.phases[tripID] {
+		phases[stopID] = phase
+	}
+	return phases, nil
+}
+
+// Save - 바뀐 정류장 단계 저장
+func (s *MemoryStore) Save(ctx context.Context, tripID string, changes map[string]Phase) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.phases[tripID] == nil {
+		s.phases[tripID] = map[string]Phase{}
+	}
+	for stopID, phase := range changes {
+		s.phases[tripID][stopID] = phase
+	}
+	return nil
+}
+
+// Clear - 운행 단계 삭제
+func (s *MemoryStore) Clear(ctx context.Context, tripID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.phases, tripID)
+	return nil
+}
@@ -0,0 +1,160 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+
+	eodiniv1 "github.com/hyeokjun/eodini/api/eodini/v1"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/util"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// 📝 설명: gRPC 인증/인가 인터셉터 (REST의 Authenticate + RequireRoleOrScope 역할)
+// 🎯 실무 포인트: 메서드별로 REST 라우트와 같은 scope 요구 → 같은 API 키를 REST/gRPC 어느 쪽에도 사용
+// ⚠️ 주의사항: 새 RPC를 추가하면 methodScopes에도 등록 (등록되지 않은 메서드는 거부)
+
+// 메타데이터 키 (gRPC 메타데이터 키는 소문자)
+const (
+	apiKeyMetadata        = "x-api-key"
+	authorizationMetadata = "authorization"
+	organizationMetadata  = "x-organization-id" // 플랫폼 운영자의 대상 기관 (REST의 X-Organization-ID)
+	languageMetadata      = "accept-language"   // 응답 언어 (REST의 Accept-Language)
+)
+
+// methodScopes - RPC별 API 키 권한 범위 (사용자는 운영 인력 역할이면 허용, 운행별 권한은 Service에서 검증)
+var methodScopes = map[string]domain.APIKeyScope{
+	eodiniv1.TripService_GetTrip_FullMethodName:             domain.ScopeTripsRead,
+	eodiniv1.TripService_ListTrips_FullMethodName:           domain.ScopeTripsRead,
+	eodiniv1.ScheduleService_GetSchedule_FullMethodName:     domain.ScopeSchedulesRead,
+	eodiniv1.ScheduleService_ListSchedules_FullMethodName:   domain.ScopeSchedulesRead,
+	eodiniv1.LocationService_ReportLocation_FullMethodName:  domain.ScopeLocationsWrite,
+	eodiniv1.LocationService_StreamLocations_FullMethodName: domain.ScopeLocationsWrite,
+	healthpb.Health_Check_FullMethodName:                    "",
+	healthpb.Health_Watch_FullMethodName:                    "",
+}
+
+// authenticator - 메타데이터의 토큰/API 키 검증 후 인증 주체를 context에 저장
+type authenticator struct {
+	tokens   *auth.TokenManager
+	apiKeys  middleware.APIKeyAuthenticator
+	sessions middleware.SessionChecker
+}
+
+// unary - 단건 RPC 인증/인가
+func (a *authenticator) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx = withRequestLocale(ctx)
+	authorized, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return handler(authorized, req)
+}
+
+// stream - 스트리밍 RPC 인증/인가 (이후 스트림의 context에 인증 주체 포함)
+func (a *authenticator) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := withRequestLocale(ss.Context())
+	authorized, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return toStatus(ctx, err)
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: authorized})
+}
+
+// authorize - 메서드 허용 여부 확인 (헬스 체크는 인증 없이 허용)
+func (a *authenticator) authorize(ctx context.Context, method string) (context.Context, error) {
+	scope, ok := methodScopes[method]
+	if !ok {
+		return nil, util.NewForbiddenError()
+	}
+	if scope == "" {
+		return ctx, nil
+	}
+
+	principal, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if principal.OrganizationID == "" {
+		return nil, util.NewUnauthorizedError() // 소속 기관이 없으면 조회 범위를 정할 수 없음
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	principal, appErr := middleware.ResolveOrganization(principal, firstValue(md, organizationMetadata))
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	allowed := principal.Role.IsStaff()
+	if principal.IsAPIKey() {
+		allowed = principal.HasScope(scope)
+	}
+	if !allowed {
+		return nil, util.NewForbiddenError()
+	}
+	if locale, ok := util.ParseLocale(principal.Locale); ok {
+		ctx = util.WithLocale(ctx, locale) // 사용자 언어 설정이 accept-language보다 우선
+	}
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// withRequestLocale - accept-language 메타데이터로 응답 언어 선택 (지원하지 않는 언어면 기본 언어)
+func withRequestLocale(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return util.WithLocale(ctx, util.NegotiateLocale(firstValue(md, languageMetadata)))
+}
+
+// authenticate - x-api-key 우선, 없으면 Bearer 토큰 검증 (폐기된 세션 거부)
+func (a *authenticator) authenticate(ctx context.Context) (*auth.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if raw := firstValue(md, apiKeyMetadata); raw != "" {
+		if a.apiKeys == nil {
+			return nil, util.NewUnauthorizedError()
+		}
+		return a.apiKeys.AuthenticateAPIKey(ctx, raw)
+	}
+
+	token, found := strings.CutPrefix(firstValue(md, authorizationMetadata), "Bearer ")
+	if !found || token == "" || a.tokens == nil {
+		return nil, util.NewUnauthorizedError()
+	}
+	principal, err := a.tokens.Parse(token)
+	if err != nil {
+		return nil, util.NewUnauthorizedError()
+	}
+
+	if a.sessions != nil && principal.SessionID != "" {
+		revoked, err := a.sessions.IsSessionRevoked(ctx, principal.SessionID)
+		if err != nil {
+			return nil, util.NewInternalError(err) // 폐기 여부를 모르면 통과시키지 않음
+		}
+		if revoked {
+			return nil, util.NewUnauthorizedError()
+		}
+	}
+	return principal, nil
+}
+
+// firstValue - 메타데이터 키의 첫 값 (없으면 빈 값)
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// authenticatedStream - 인증 주체가 담긴 context를 돌려주는 스트림
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context - 인증 주체가 담긴 context
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,83 @@
+package grpcserver
+
+import (
+	"time"
+
+	eodiniv1 "github.com/hyeokjun/eodini/api/eodini/v1"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/validation"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 📝 설명: 도메인/protobuf 메시지 공통 변환 (시각, 날짜, 페이지)와 요청 검증
+// ⚠️ 주의사항: 페이지 기본값/상한은 REST 목록 조회(page, page_size)와 같게 유지
+
+// 목록 조회 페이지 기본값 (REST와 같음)
+const (
+	defaultPage     = 1
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// validate - 요청 검증기 (dto의 binding 태그 규칙을 REST와 같게 적용)
+var validate = validation.New()
+
+// page - 요청 페이지 정규화 (page 1 미만/누락은 1, page_size는 기본 20, 최대 100)
+type page struct {
+	number int
+	size   int
+}
+
+// newPage - 요청 페이지를 조회 옵션으로 사용할 수 있게 정규화
+func newPage(req *eodiniv1.PageRequest) page {
+	p := page{number: int(req.GetPage()), size: int(req.GetPageSize())}
+	if p.number < 1 {
+		p.number = defaultPage
+	}
+	if p.size < 1 {
+		p.size = defaultPageSize
+	}
+	if p.size > maxPageSize {
+		p.size = maxPageSize
+	}
+	return p
+}
+
+// options - Repository 조회 옵션 (정렬은 리소스 기본 정렬)
+func (p page) options() repository.ListOptions {
+	return repository.ListOptions{Offset: (p.number - 1) * p.size, Limit: p.size}
+}
+
+// info - 응답 페이지 정보
+func (p page) info(total int64) *eodiniv1.PageInfo {
+	return &eodiniv1.PageInfo{
+		Page:       int32(p.number),
+		PageSize:   int32(p.size),
+		TotalItems: total,
+		TotalPages: int32((total + int64(p.size) - 1) / int64(p.size)),
+	}
+}
+
+// timestamp - 선택 시각 변환 (nil이면 nil)
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// optionalDate - 선택 날짜를 YYYY-MM-DD로 변환 (nil이면 빈 값)
+func optionalDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+// optionalString - 선택 문자열 변환 (nil이면 빈 값)
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
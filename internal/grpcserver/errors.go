@@ -0,0 +1,96 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/internal/validation"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// 📝 설명: Service 에러(AppError)를 gRPC 상태로 변환 (REST의 ErrorHandler 역할)
+// 🎯 실무 포인트: HTTP 상태 코드 → gRPC 코드, 에러 코드(NOT_FOUND 등)는 ErrorInfo.reason으로 전달
+// 검증 실패의 필드별 사유는 BadRequest.field_violations로 전달
+// ⚠️ 주의사항: 내부 오류의 원인(details.error)은 로그에만 남기고 응답에 포함하지 않음
+
+// errorDomain - ErrorInfo.domain (에러 코드의 출처)
+const errorDomain = "eodini"
+
+// grpcCodes - HTTP 상태 코드별 gRPC 코드
+var grpcCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.FailedPrecondition,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusBadGateway:          codes.Unavailable,
+	http.StatusInternalServerError: codes.Internal,
+}
+
+// toStatus - 에러를 gRPC 상태로 변환 (AppError가 아니면 Internal, 요청 ID는 ErrorInfo.metadata.request_id로 반환)
+func toStatus(ctx context.Context, err error) error {
+	var appErr *util.AppError
+	if !errors.As(err, &appErr) {
+		appErr = util.NewInternalError(err)
+	}
+	appErr = appErr.Localize(util.LocaleFromContext(ctx))
+
+	code, ok := grpcCodes[appErr.StatusCode]
+	if !ok {
+		code = codes.Unknown
+	}
+	if appErr.Code == util.ErrCodeDuplicate {
+		code = codes.AlreadyExists
+	}
+	if code == codes.Internal {
+		logger.FromContext(ctx).Error("gRPC request failed", map[string]interface{}{
+			"error": appErr.Details["error"],
+		})
+	}
+
+	st := status.New(code, appErr.Message)
+	info := &errdetails.ErrorInfo{Reason: appErr.Code, Domain: errorDomain}
+	if requestID := logger.RequestIDFromContext(ctx); requestID != "" {
+		info.Metadata = map[string]string{"request_id": requestID}
+	}
+	details := []protoadapt.MessageV1{info}
+	if appErr.Code == util.ErrCodeValidation && len(appErr.Details) > 0 {
+		details = append(details, fieldViolations(appErr.Details))
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// fieldViolations - 검증 실패 상세({"필드": "사유"})를 BadRequest로 변환 (필드명 순)
+func fieldViolations(details map[string]interface{}) *errdetails.BadRequest {
+	fields := make([]string, 0, len(details))
+	for field := range details {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	badRequest := &errdetails.BadRequest{}
+	for _, field := range fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: fmt.Sprint(details[field]),
+		})
+	}
+	return badRequest
+}
+
+// newValidationError - 요청 검증 실패 (REST의 바인딩 에러와 같은 형식)
+func newValidationError(err error) *util.AppError {
+	return util.NewValidationError(util.MsgValidationFailed, validation.FieldErrors(err))
+}
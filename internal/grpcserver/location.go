@@ -0,0 +1,97 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	eodiniv1 "github.com/hyeokjun/eodini/api/eodini/v1"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 📝 설명: 차량 위치 전송 gRPC 서비스 (REST TrackingHandler.ReportLocation과 같은 처리)
+// 🎯 실무 포인트: 저장 후 실시간 전달, 정류장 판정, 위치 수신 끊김 해제 등 후속 처리는 TrackingService가 동일하게 수행
+// ⚠️ 주의사항: StreamLocations는 건별 거부(운행 중 아님, 권한 없음, 잘못된 좌표)로 스트림을 끊지 않고 건수만 집계
+// 내부 오류(DB 장애 등)만 스트림을 종료 → 게이트웨이가 다시 연결해서 재전송
+
+// locationServer - eodini.v1.LocationService 구현
+type locationServer struct {
+	eodiniv1.UnimplementedLocationServiceServer
+	trackingService *service.TrackingService
+}
+
+// ReportLocation - 운행 중 차량 위치 1건 전송
+func (s *locationServer) ReportLocation(ctx context.Context, req *eodiniv1.ReportLocationRequest) (*eodiniv1.VehiclePosition, error) {
+	position, err := s.report(ctx, req)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return positionMessage(position), nil
+}
+
+// StreamLocations - 위치 연속 전송 (클라이언트가 스트림을 닫으면 처리 건수 응답)
+func (s *locationServer) StreamLocations(stream eodiniv1.LocationService_StreamLocationsServer) error {
+	ctx := stream.Context()
+	resp := &eodiniv1.StreamLocationsResponse{}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.report(ctx, req); err != nil {
+			if isInternal(err) {
+				return toStatus(ctx, err)
+			}
+			resp.Rejected++
+			continue
+		}
+		resp.Accepted++
+	}
+}
+
+// report - 요청 검증 후 위치 기록 (검증 규칙은 REST 요청과 같음)
+func (s *locationServer) report(ctx context.Context, req *eodiniv1.ReportLocationRequest) (*domain.VehiclePosition, error) {
+	latitude, longitude := req.GetLatitude(), req.GetLongitude()
+	body := dto.ReportLocationRequest{
+		Latitude:  &latitude,
+		Longitude: &longitude,
+		Speed:     req.Speed,
+		Heading:   req.Heading,
+	}
+	if req.RecordedAt != nil {
+		recordedAt := req.RecordedAt.AsTime()
+		body.RecordedAt = &recordedAt
+	}
+	if err := validate.Struct(&body); err != nil {
+		return nil, newValidationError(err)
+	}
+
+	return s.trackingService.ReportLocation(ctx, req.GetTripId(), &body)
+}
+
+// isInternal - 요청과 무관한 서버 오류 여부 (AppError가 아니거나 내부 오류 코드)
+func isInternal(err error) bool {
+	var appErr *util.AppError
+	return !errors.As(err, &appErr) || appErr.Code == util.ErrCodeInternal
+}
+
+// positionMessage - 차량 위치 도메인 → protobuf 메시지
+func positionMessage(position *domain.VehiclePosition) *eodiniv1.VehiclePosition {
+	return &eodiniv1.VehiclePosition{
+		TripId:     position.TripID,
+		VehicleId:  position.VehicleID,
+		Latitude:   position.Latitude,
+		Longitude:  position.Longitude,
+		Speed:      position.Speed,
+		Heading:    position.Heading,
+		RecordedAt: timestamppb.New(position.RecordedAt),
+	}
+}
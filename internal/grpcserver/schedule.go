@@ -0,0 +1,87 @@
+package grpcserver
+
+import (
+	"context"
+
+	eodiniv1 "github.com/hyeokjun/eodini/api/eodini/v1"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 📝 설명: 일정 조회 gRPC 서비스 (REST ScheduleHandler.List/Get과 같은 조회)
+// ⚠️ 주의사항: 삭제된 일정 포함 조회(include_deleted)는 관리자 REST API에서만 제공
+
+// scheduleServer - eodini.v1.ScheduleService 구현
+type scheduleServer struct {
+	eodiniv1.UnimplementedScheduleServiceServer
+	scheduleService *service.ScheduleService
+}
+
+// GetSchedule - 일정 단건 조회
+func (s *scheduleServer) GetSchedule(ctx context.Context, req *eodiniv1.GetScheduleRequest) (*eodiniv1.Schedule, error) {
+	schedule, err := s.scheduleService.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return scheduleMessage(schedule), nil
+}
+
+// ListSchedules - 일정 목록 조회
+func (s *scheduleServer) ListSchedules(ctx context.Context, req *eodiniv1.ListSchedulesRequest) (*eodiniv1.ListSchedulesResponse, error) {
+	query := dto.ListScheduleQuery{
+		Status:    req.GetStatus(),
+		RouteID:   req.GetRouteId(),
+		VehicleID: req.GetVehicleId(),
+		DriverID:  req.GetDriverId(),
+	}
+	if err := validate.Struct(&query); err != nil {
+		return nil, toStatus(ctx, newValidationError(err))
+	}
+
+	p := newPage(req.GetPage())
+	schedules, total, err := s.scheduleService.List(ctx, repository.ScheduleFilter{
+		Status:      domain.ScheduleStatus(query.Status),
+		RouteID:     query.RouteID,
+		VehicleID:   query.VehicleID,
+		DriverID:    query.DriverID,
+		ListOptions: p.options(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	resp := &eodiniv1.ListSchedulesResponse{Page: p.info(total)}
+	for _, schedule := range schedules {
+		resp.Schedules = append(resp.Schedules, scheduleMessage(schedule))
+	}
+	return resp, nil
+}
+
+// scheduleMessage - 일정 도메인 → protobuf 메시지
+func scheduleMessage(schedule *domain.Schedule) *eodiniv1.Schedule {
+	days := make([]int32, len(schedule.DaysOfWeek))
+	for i, day := range schedule.DaysOfWeek {
+		days[i] = int32(day)
+	}
+	return &eodiniv1.Schedule{
+		Id:                 schedule.ID,
+		Name:               schedule.Name,
+		Description:        schedule.Description,
+		Status:             string(schedule.Status),
+		StartTime:          schedule.StartTime,
+		TimeSlot:           string(schedule.TimeSlot),
+		DaysOfWeek:         days,
+		SkipHolidays:       schedule.SkipHolidays,
+		RouteId:            schedule.RouteID,
+		VehicleId:          schedule.VehicleID,
+		DefaultDriverId:    schedule.DefaultDriverID,
+		DefaultAttendantId: optionalString(schedule.DefaultAttendantID),
+		ValidFrom:          optionalDate(schedule.ValidFrom),
+		ValidTo:            optionalDate(schedule.ValidTo),
+		CreatedAt:          timestamppb.New(schedule.CreatedAt),
+		UpdatedAt:          timestamppb.New(schedule.UpdatedAt),
+	}
+}
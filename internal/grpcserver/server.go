@@ -0,0 +1,101 @@
+package grpcserver
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+
+	eodiniv1 "github.com/hyeokjun/eodini/api/eodini/v1"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+// 📝 설명: gRPC API 서버 (운행/일정 조회, 차량 위치 전송)
+// 🎯 실무 포인트: REST 핸들러와 같은 Service 인스턴스를 사용 → 권한/검증/후속 처리(지오펜스, 이벤트 등)가 동일
+// 차량 단말 게이트웨이와 내부 서비스가 JSON 대신 protobuf 타입으로 호출 (api/eodini/v1)
+// ⚠️ 주의사항: 인증은 REST와 같은 방식 (메타데이터 x-api-key 또는 authorization: Bearer)
+// 헬스 체크(grpc.health.v1)만 인증 없이 허용
+// 요청마다 서버 span 생성 (메타데이터의 traceparent/x-request-id 이어받기, 헬스 체크 제외)
+
+// Services - gRPC 서버가 사용하는 인증 구성과 Service (REST 라우터와 같은 인스턴스)
+type Services struct {
+	Tokens     *auth.TokenManager             // 토큰 검증기 (nil이면 Bearer 인증 거부)
+	APIKeyAuth middleware.APIKeyAuthenticator // API 키 검증기 (nil이면 API 키 인증 거부)
+	Sessions   middleware.SessionChecker      // 세션 폐기 확인 (nil이면 확인 생략)
+
+	Trip     *service.TripService
+	Schedule *service.ScheduleService
+	Tracking *service.TrackingService
+}
+
+// NewServer - 서비스 등록과 인증/복구 인터셉터를 적용한 gRPC 서버 생성
+//
+// 사용 예:
+//
+//	srv := grpcserver.NewServer(services)
+//	go srv.Serve(listener)
+func NewServer(s *Services, opts ...grpc.ServerOption) *grpc.Server {
+	authenticator := &authenticator{tokens: s.Tokens, apiKeys: s.APIKeyAuth, sessions: s.Sessions}
+
+	opts = append(opts,
+		grpc.StatsHandler(otelgrpc.NewServerHandler(
+			otelgrpc.WithPropagators(tracing.Propagator()),
+			otelgrpc.WithFilter(traceable),
+		)),
+		grpc.ChainUnaryInterceptor(recoverUnary, authenticator.unary),
+		grpc.ChainStreamInterceptor(recoverStream, authenticator.stream),
+	)
+	srv := grpc.NewServer(opts...)
+
+	eodiniv1.RegisterTripServiceServer(srv, &tripServer{tripService: s.Trip})
+	eodiniv1.RegisterScheduleServiceServer(srv, &scheduleServer{scheduleService: s.Schedule})
+	eodiniv1.RegisterLocationServiceServer(srv, &locationServer{trackingService: s.Tracking})
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+
+	return srv
+}
+
+// recoverUnary - 처리 중 panic을 Internal 응답으로 변환 (서버 프로세스 유지)
+func recoverUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoverStream - 스트림 처리 중 panic을 Internal 응답으로 변환
+func recoverStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// recovered - panic 로그 후 Internal 상태 반환
+func recovered(ctx context.Context, method string, r interface{}) error {
+	logger.FromContext(ctx).Error("gRPC handler panicked", map[string]interface{}{
+		"method": method,
+		"panic":  r,
+		"stack":  string(debug.Stack()),
+	})
+	return status.Error(codes.Internal, "internal error")
+}
+
+// traceable - span을 만들 RPC인지 (헬스 체크 제외)
+func traceable(info *stats.RPCTagInfo) bool {
+	return !strings.HasPrefix(info.FullMethodName, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
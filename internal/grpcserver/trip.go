@@ -0,0 +1,103 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	eodiniv1 "github.com/hyeokjun/eodini/api/eodini/v1"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 📝 설명: 운행 조회 gRPC 서비스 (REST TripHandler.List/Get과 같은 조회)
+
+// tripServer - eodini.v1.TripService 구현
+type tripServer struct {
+	eodiniv1.UnimplementedTripServiceServer
+	tripService *service.TripService
+}
+
+// GetTrip - 운행 단건 조회 (탑승자 기록 포함)
+func (s *tripServer) GetTrip(ctx context.Context, req *eodiniv1.GetTripRequest) (*eodiniv1.Trip, error) {
+	trip, err := s.tripService.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return tripMessage(trip), nil
+}
+
+// ListTrips - 운행 목록 조회 (필터 검증은 REST 쿼리와 같은 규칙)
+func (s *tripServer) ListTrips(ctx context.Context, req *eodiniv1.ListTripsRequest) (*eodiniv1.ListTripsResponse, error) {
+	query := dto.ListTripQuery{
+		Date:        req.GetDate(),
+		Status:      req.GetStatus(),
+		ScheduleID:  req.GetScheduleId(),
+		DriverID:    req.GetDriverId(),
+		AttendantID: req.GetAttendantId(),
+	}
+	if err := validate.Struct(&query); err != nil {
+		return nil, toStatus(ctx, newValidationError(err))
+	}
+
+	p := newPage(req.GetPage())
+	filter := repository.TripFilter{
+		Status:      domain.TripStatus(query.Status),
+		ScheduleID:  query.ScheduleID,
+		DriverID:    query.DriverID,
+		AttendantID: query.AttendantID,
+		ListOptions: p.options(),
+	}
+	if query.Date != "" {
+		date, _ := time.Parse(time.DateOnly, query.Date) // 검증에서 형식 확인 완료
+		filter.Date = &date
+	}
+
+	trips, total, err := s.tripService.List(ctx, filter)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	resp := &eodiniv1.ListTripsResponse{Page: p.info(total)}
+	for _, trip := range trips {
+		resp.Trips = append(resp.Trips, tripMessage(trip))
+	}
+	return resp, nil
+}
+
+// tripMessage - 운행 도메인 → protobuf 메시지
+func tripMessage(trip *domain.Trip) *eodiniv1.Trip {
+	msg := &eodiniv1.Trip{
+		Id:                  trip.ID,
+		ScheduleId:          trip.ScheduleID,
+		Date:                trip.Date.Format(time.DateOnly),
+		Status:              string(trip.Status),
+		VehicleId:           trip.VehicleID,
+		AssignedDriverId:    trip.AssignedDriverID,
+		AssignedAttendantId: optionalString(trip.AssignedAttendantID),
+		StartedAt:           timestamp(trip.StartedAt),
+		CompletedAt:         timestamp(trip.CompletedAt),
+		CancelledAt:         timestamp(trip.CancelledAt),
+		CancellationReason:  trip.CancellationReason,
+		DelayedAt:           timestamp(trip.DelayedAt),
+		DelayReason:         trip.DelayReason,
+		TotalDistance:       int32(trip.TotalDistance),
+		CreatedAt:           timestamppb.New(trip.CreatedAt),
+		UpdatedAt:           timestamppb.New(trip.UpdatedAt),
+	}
+	for _, record := range trip.TripPassengers {
+		msg.Passengers = append(msg.Passengers, &eodiniv1.TripPassenger{
+			Id:           record.ID,
+			PassengerId:  record.PassengerID,
+			StopId:       record.StopID,
+			IsBoarded:    record.IsBoarded,
+			IsAlighted:   record.IsAlighted,
+			BoardedAt:    timestamp(record.BoardedAt),
+			AlightedAt:   timestamp(record.AlightedAt),
+			NoShowReason: record.NoShowReason,
+		})
+	}
+	return msg
+}
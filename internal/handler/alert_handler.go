@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 경보 핸들러 (과속/급가속/급감속 기록 조회)
+// 🎯 실무 포인트: 관리자가 운행 종료 후 위험 운전 여부를 점검
+// ⚠️ 주의사항: 경보는 위치 수신 시 자동 생성되므로 조회만 제공
+
+// AlertHandler - 운행 경보 핸들러
+type AlertHandler struct {
+	alertService *service.AlertService
+}
+
+// NewAlertHandler - 운행 경보 핸들러 생성
+func NewAlertHandler(alertService *service.AlertService) *AlertHandler {
+	return &AlertHandler{alertService: alertService}
+}
+
+// List - 운행 경보 목록
+// @Summary		운행 경보 조회
+// @Description	운행 중 기록된 과속, 어린이 보호구역 과속, 급가속/급감속 경보를 발생 순으로 반환합니다
+// @Tags		Tracking
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse{data=[]domain.TripAlert}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/trips/{id}/alerts [get]
+func (h *AlertHandler) List(c *gin.Context) {
+	alerts, err := h.alertService.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), alerts)
+}
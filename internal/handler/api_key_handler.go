@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: API 키 관리 핸들러 (관리자 전용)
+// 🎯 실무 포인트: 발급 응답의 key 값은 다시 조회할 수 없으므로 연동 측에 바로 전달
+// ⚠️ 주의사항: DELETE는 삭제가 아닌 폐기 (이력 보존)
+
+// APIKeyHandler - API 키 핸들러
+type APIKeyHandler struct {
+	apiKeyService *service.APIKeyService
+}
+
+// NewAPIKeyHandler - API 키 핸들러 생성
+func NewAPIKeyHandler(apiKeyService *service.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{apiKeyService: apiKeyService}
+}
+
+// List - API 키 목록 조회
+// @Summary		API 키 목록 조회
+// @Tags		APIKey
+// @Produce		json
+// @Param		include_revoked	query	bool	false	"폐기된 키 포함 여부"
+// @Param		page			query	int		false	"페이지 (기본 1)"
+// @Param		page_size		query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/api-keys [get]
+func (h *APIKeyHandler) List(c *gin.Context) {
+	var query dto.ListAPIKeyQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.APIKeyListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.APIKeyFilter{
+		IncludeRevoked: query.IncludeRevoked,
+		ListOptions:    params.options,
+	}
+
+	keys, total, err := h.apiKeyService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), keys, params.meta(total))
+}
+
+// Get - API 키 단건 조회
+// @Summary		API 키 조회
+// @Tags		APIKey
+// @Produce		json
+// @Param		id	path	string	true	"API 키 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/api-keys/{id} [get]
+func (h *APIKeyHandler) Get(c *gin.Context) {
+	key, err := h.apiKeyService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), key)
+}
+
+// Issue - API 키 발급
+// @Summary		API 키 발급
+// @Description	응답의 key 값은 이 응답에서만 확인할 수 있습니다
+// @Tags		APIKey
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.IssueAPIKeyRequest	true	"이름, 권한 범위, 만료 시각"
+// @Success		201	{object}	util.APIResponse{data=dto.IssuedAPIKeyResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Router		/api-keys [post]
+func (h *APIKeyHandler) Issue(c *gin.Context) {
+	var req dto.IssueAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	var createdBy string
+	if principal, ok := auth.FromContext(c.Request.Context()); ok {
+		createdBy = principal.UserID
+	}
+
+	issued, err := h.apiKeyService.Issue(c.Request.Context(), createdBy, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "API 키"), issued)
+}
+
+// Revoke - API 키 폐기
+// @Summary		API 키 폐기
+// @Tags		APIKey
+// @Produce		json
+// @Param		id	path	string	true	"API 키 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"이미 폐기됨"
+// @Router		/api-keys/{id} [delete]
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	key, err := h.apiKeyService.Revoke(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "API 키"), key)
+}
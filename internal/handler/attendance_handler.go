@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 탑승자 월별 출결 핸들러
+// 🎯 실무 포인트: 탑승자 하위 리소스 (/passengers/:id/attendance), 기관 청구와 보호자 안내에 사용
+// ⚠️ 주의사항: 관리자 또는 연결된 보호자만 (Service에서 탑승자별 검증)
+
+// AttendanceHandler - 월별 출결 핸들러
+type AttendanceHandler struct {
+	attendanceService *service.AttendanceService
+}
+
+// NewAttendanceHandler - 월별 출결 핸들러 생성
+func NewAttendanceHandler(attendanceService *service.AttendanceService) *AttendanceHandler {
+	return &AttendanceHandler{attendanceService: attendanceService}
+}
+
+// Monthly - 탑승자 월별 출결
+// @Summary		탑승자 월별 출결
+// @Description	한 달 동안 운행이 있었던 날마다 운행별 탑승 상태(expected, boarded, alighted, no_show, excused)와 불참/결석 사유, 승차·결석·불참 횟수와 승차한 날 수 합계를 조회합니다. 월을 생략하면 이번 달(한국 시간)이며 취소된 운행은 제외합니다
+// @Tags		Passenger
+// @Produce		json
+// @Param		id		path	string	true	"탑승자 ID"
+// @Param		month	query	string	false	"조회 월 (YYYY-MM)"
+// @Success		200	{object}	util.APIResponse{data=dto.PassengerAttendanceResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/attendance [get]
+func (h *AttendanceHandler) Monthly(c *gin.Context) {
+	var query dto.PassengerAttendanceQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	attendance, err := h.attendanceService.MonthlyAttendance(c.Request.Context(), c.Param("id"), query.Month)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), attendance)
+}
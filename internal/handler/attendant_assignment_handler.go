@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 동승자 대체 배정 핸들러 (대체 교사/간호사가 기간 동안 일정 담당)
+// 🎯 실무 포인트: 일정 하위 리소스 (/schedules/:id/attendant-assignments), 승인된 배정만 운행 생성에 반영
+// ⚠️ 주의사항: 수정 API 없음 → 기간을 바꾸려면 삭제 후 다시 생성
+
+// AttendantAssignmentHandler - 동승자 대체 배정 핸들러
+type AttendantAssignmentHandler struct {
+	assignmentService *service.AttendantAssignmentService
+}
+
+// NewAttendantAssignmentHandler - 동승자 대체 배정 핸들러 생성
+func NewAttendantAssignmentHandler(assignmentService *service.AttendantAssignmentService) *AttendantAssignmentHandler {
+	return &AttendantAssignmentHandler{assignmentService: assignmentService}
+}
+
+// List - 동승자 대체 배정 목록
+// @Summary		동승자 대체 배정 목록
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse{data=[]domain.AttendantAssignment}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/attendant-assignments [get]
+func (h *AttendantAssignmentHandler) List(c *gin.Context) {
+	assignments, err := h.assignmentService.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), assignments)
+}
+
+// Create - 동승자 대체 배정 생성
+// @Summary		동승자 대체 배정 생성
+// @Description	기간 동안 기본 동승자 대신 대체 동승자가 일정을 담당합니다. 승인 후 운행 생성에 반영되며, 같은 일정에 기간이 겹치는 배정이 있으면 409를 반환합니다
+// @Tags		Schedule
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string									true	"일정 ID"
+// @Param		request	body	dto.CreateAttendantAssignmentRequest	true	"대체 배정"
+// @Success		201	{object}	util.APIResponse{data=domain.AttendantAssignment}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/schedules/{id}/attendant-assignments [post]
+func (h *AttendantAssignmentHandler) Create(c *gin.Context) {
+	var req dto.CreateAttendantAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	assignment, err := h.assignmentService.Create(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "동승자 대체 배정"), assignment)
+}
+
+// Approve - 동승자 대체 배정 승인
+// @Summary		동승자 대체 배정 승인
+// @Tags		Schedule
+// @Produce		json
+// @Param		id				path	string	true	"일정 ID"
+// @Param		assignmentId	path	string	true	"대체 배정 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.AttendantAssignment}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/attendant-assignments/{assignmentId}/approve [post]
+func (h *AttendantAssignmentHandler) Approve(c *gin.Context) {
+	assignment, err := h.assignmentService.Approve(c.Request.Context(), c.Param("id"), c.Param("assignmentId"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), assignment)
+}
+
+// Delete - 동승자 대체 배정 삭제
+// @Summary		동승자 대체 배정 삭제
+// @Tags		Schedule
+// @Produce		json
+// @Param		id				path	string	true	"일정 ID"
+// @Param		assignmentId	path	string	true	"대체 배정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/attendant-assignments/{assignmentId} [delete]
+func (h *AttendantAssignmentHandler) Delete(c *gin.Context) {
+	if err := h.assignmentService.Delete(c.Request.Context(), c.Param("id"), c.Param("assignmentId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "동승자 대체 배정"))
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 동승자 관리 핸들러
+// 🎯 실무 포인트: 운행 시작 권한은 permissions/start-trip 리소스로 부여(POST)/회수(DELETE)
+// ⚠️ 주의사항: 에러는 c.Error()로 넘기고 응답은 ErrorHandler에서 통일
+
+// AttendantHandler - 동승자 핸들러
+type AttendantHandler struct {
+	attendantService *service.AttendantService
+}
+
+// NewAttendantHandler - 동승자 핸들러 생성
+func NewAttendantHandler(attendantService *service.AttendantService) *AttendantHandler {
+	return &AttendantHandler{attendantService: attendantService}
+}
+
+// List - 동승자 목록 조회
+// @Summary		동승자 목록 조회
+// @Description	상태, 역할, 운행 시작 권한 보유 여부로 동승자 목록을 조회합니다
+// @Tags		Attendant
+// @Produce		json
+// @Param		status			query	string	false	"상태 (active, on_leave, inactive)"
+// @Param		role			query	string	false	"역할 (teacher, nurse, carer, assistant)"
+// @Param		can_start_trip	query	bool	false	"운행 시작 권한 보유 여부"
+// @Param		page			query	int		false	"페이지 (기본 1)"
+// @Param		page_size		query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/attendants [get]
+func (h *AttendantHandler) List(c *gin.Context) {
+	var query dto.ListAttendantQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.AttendantListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.AttendantFilter{
+		Status:       domain.AttendantStatus(query.Status),
+		Role:         domain.AttendantRole(query.Role),
+		CanStartTrip: query.CanStartTrip,
+		ListOptions:  params.options,
+	}
+
+	attendants, total, err := h.attendantService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), attendants, params.meta(total))
+}
+
+// Get - 동승자 단건 조회
+// @Summary		동승자 조회
+// @Tags		Attendant
+// @Produce		json
+// @Param		id	path	string	true	"동승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/attendants/{id} [get]
+func (h *AttendantHandler) Get(c *gin.Context) {
+	attendant, err := h.attendantService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), attendant)
+}
+
+// Create - 동승자 등록
+// @Summary		동승자 등록
+// @Tags		Attendant
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateAttendantRequest	true	"동승자 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Router		/attendants [post]
+func (h *AttendantHandler) Create(c *gin.Context) {
+	var req dto.CreateAttendantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	attendant, err := h.attendantService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "동승자"), attendant)
+}
+
+// Update - 동승자 정보 수정
+// @Summary		동승자 정보 수정
+// @Tags		Attendant
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"동승자 ID"
+// @Param		request	body	dto.UpdateAttendantRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/attendants/{id} [put]
+func (h *AttendantHandler) Update(c *gin.Context) {
+	var req dto.UpdateAttendantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	attendant, err := h.attendantService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "동승자"), attendant)
+}
+
+// GrantStartTripPermission - 운행 시작 권한 부여
+// @Summary		운행 시작 권한 부여
+// @Tags		Attendant
+// @Produce		json
+// @Param		id	path	string	true	"동승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"활동 중이 아닌 동승자"
+// @Router		/attendants/{id}/permissions/start-trip [post]
+func (h *AttendantHandler) GrantStartTripPermission(c *gin.Context) {
+	attendant, err := h.attendantService.GrantStartTripPermission(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행 시작 권한"), attendant)
+}
+
+// RevokeStartTripPermission - 운행 시작 권한 회수
+// @Summary		운행 시작 권한 회수
+// @Tags		Attendant
+// @Produce		json
+// @Param		id	path	string	true	"동승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/attendants/{id}/permissions/start-trip [delete]
+func (h *AttendantHandler) RevokeStartTripPermission(c *gin.Context) {
+	attendant, err := h.attendantService.RevokeStartTripPermission(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행 시작 권한"), attendant)
+}
+
+// Delete - 동승자 삭제
+// @Summary		동승자 삭제
+// @Tags		Attendant
+// @Produce		json
+// @Param		id	path	string	true	"동승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/attendants/{id} [delete]
+func (h *AttendantHandler) Delete(c *gin.Context) {
+	if err := h.attendantService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "동승자"))
+}
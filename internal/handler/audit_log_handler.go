@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 감사 로그 조회 핸들러 (관리자 전용)
+// 🎯 실무 포인트: 민원/사고 발생 시 "누가 언제 무엇을 바꿨는지" 추적
+// ⚠️ 주의사항: 조회 전용 (감사 로그는 API로 수정/삭제 불가)
+
+// AuditLogHandler - 감사 로그 핸들러
+type AuditLogHandler struct {
+	auditLogService *service.AuditLogService
+}
+
+// NewAuditLogHandler - 감사 로그 핸들러 생성
+func NewAuditLogHandler(auditLogService *service.AuditLogService) *AuditLogHandler {
+	return &AuditLogHandler{auditLogService: auditLogService}
+}
+
+// List - 감사 로그 목록 조회
+// @Summary		감사 로그 목록 조회
+// @Description	최신순 정렬, 엔티티(테이블명/ID)와 행위자로 필터링
+// @Tags		AuditLog
+// @Produce		json
+// @Param		entity_type	query	string	false	"엔티티 종류 (예: vehicles)"
+// @Param		entity_id	query	string	false	"엔티티 ID"
+// @Param		actor_id	query	string	false	"행위자 ID (사용자 또는 API 키)"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/audit-logs [get]
+func (h *AuditLogHandler) List(c *gin.Context) {
+	var query dto.ListAuditLogQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.AuditLogListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.AuditLogFilter{
+		EntityType:  query.EntityType,
+		EntityID:    query.EntityID,
+		ActorID:     query.ActorID,
+		ListOptions: params.options,
+	}
+
+	logs, total, err := h.auditLogService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), logs, params.meta(total))
+}
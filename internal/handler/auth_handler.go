@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 로그인/비밀번호 핸들러
+// 🎯 실무 포인트: 로그인·재설정은 공개 엔드포인트, 내 정보·비밀번호 변경은 인증 필요
+// ⚠️ 주의사항: API 키 인증 주체는 계정이 아니므로 /auth/me 사용 불가
+
+// AuthHandler - 인증 핸들러
+type AuthHandler struct {
+	authService *service.AuthService
+}
+
+// NewAuthHandler - 인증 핸들러 생성
+func NewAuthHandler(authService *service.AuthService) *AuthHandler {
+	return &AuthHandler{authService: authService}
+}
+
+// Login - 로그인
+// @Summary		로그인
+// @Description	이메일/비밀번호로 액세스 토큰을 발급합니다
+// @Tags		Auth
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.LoginRequest	true	"이메일, 비밀번호"
+// @Success		200	{object}	util.APIResponse{data=dto.LoginResponse}
+// @Failure		401	{object}	util.APIResponse
+// @Router		/auth/login [post]
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	result, err := h.authService.Login(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), result)
+}
+
+// Me - 내 계정 조회
+// @Summary		내 계정 조회
+// @Tags		Auth
+// @Produce		json
+// @Success		200	{object}	util.APIResponse
+// @Failure		401	{object}	util.APIResponse
+// @Router		/auth/me [get]
+func (h *AuthHandler) Me(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.authService.Me(c.Request.Context(), principal.UserID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), user)
+}
+
+// ChangePassword - 비밀번호 변경
+// @Summary		비밀번호 변경
+// @Tags		Auth
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.ChangePasswordRequest	true	"현재 비밀번호, 새 비밀번호"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Router		/auth/password [put]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.authService.ChangePassword(c.Request.Context(), principal.UserID, &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "비밀번호"))
+}
+
+// UpdateLocale - 응답/이메일 언어 설정 변경
+// @Summary		언어 설정 변경
+// @Description	빈 값이면 설정을 해제하고 Accept-Language 헤더를 따릅니다 (다음 로그인부터 적용)
+// @Tags		Auth
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.UpdateLocaleRequest	true	"언어 (ko, en)"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Router		/auth/locale [put]
+func (h *AuthHandler) UpdateLocale(c *gin.Context) {
+	var req dto.UpdateLocaleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.authService.UpdateLocale(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "언어 설정"), user)
+}
+
+// ForgotPassword - 비밀번호 재설정 안내 요청
+// @Summary		비밀번호 재설정 요청
+// @Description	가입된 계정이면 재설정 토큰을 발송합니다 (계정 존재 여부와 관계없이 같은 응답)
+// @Tags		Auth
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.ForgotPasswordRequest	true	"이메일"
+// @Success		202	{object}	util.APIResponse
+// @Router		/auth/password/forgot [post]
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if err := h.authService.ForgotPassword(c.Request.Context(), &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusAccepted, util.GetRequestMessage(c.Request.Context(), util.MsgPasswordResetSent))
+}
+
+// ResetPassword - 재설정 토큰으로 비밀번호 변경
+// @Summary		비밀번호 재설정
+// @Tags		Auth
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.ResetPasswordRequest	true	"재설정 토큰, 새 비밀번호"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse	"유효하지 않거나 만료된 토큰"
+// @Router		/auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if err := h.authService.ResetPassword(c.Request.Context(), &req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "비밀번호"))
+}
+
+// ListSessions - 내 로그인 세션 목록
+// @Summary		내 세션 목록
+// @Tags		Auth
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=[]dto.SessionResponse}
+// @Router		/auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	sessions, err := h.authService.ListSessions(c.Request.Context(), principal)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), sessions)
+}
+
+// RevokeAllSessions - 내 모든 세션 종료 (모든 기기에서 로그아웃)
+// @Summary		모든 기기에서 로그아웃
+// @Description	현재 요청에 사용한 토큰을 포함해 발급된 모든 토큰을 즉시 무효화합니다
+// @Tags		Auth
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=dto.RevokeSessionsResponse}
+// @Router		/auth/sessions [delete]
+func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	count, err := h.authService.RevokeAllSessions(c.Request.Context(), principal.UserID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "세션"), &dto.RevokeSessionsResponse{Revoked: count})
+}
+
+// RevokeSession - 내 세션 하나 종료
+// @Summary		세션 종료
+// @Tags		Auth
+// @Produce		json
+// @Param		id	path	string	true	"세션 ID (로그인 응답의 session_id)"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.authService.RevokeSession(c.Request.Context(), principal.UserID, c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "세션"))
+}
+
+// currentUser - 계정 기반 인증 주체 조회 (API 키 주체는 403)
+func currentUser(c *gin.Context) (*auth.Principal, bool) {
+	principal, ok := auth.FromContext(c.Request.Context())
+	if !ok {
+		_ = c.Error(util.NewUnauthorizedError())
+		return nil, false
+	}
+	if principal.IsAPIKey() {
+		_ = c.Error(util.NewForbiddenError())
+		return nil, false
+	}
+	return principal, true
+}
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 중 탑승자 승차/하차/불참 기록 핸들러
+// 🎯 실무 포인트: 동승자 앱의 승차/하차 버튼 → 기록 후 보호자에게 정류장/시각 알림, 불참은 관리자/동승자에게 알림
+// 승차/하차는 앱의 현재 위치(선택)와 기록자를 함께 남김 (분쟁 시 확인용)
+// ⚠️ 주의사항: 배정 기사/동승자만 가능 (Service에서 운행별 검증), 운행 중에만 기록
+
+// BoardingHandler - 승차/하차 핸들러
+type BoardingHandler struct {
+	boardingService *service.BoardingService
+}
+
+// NewBoardingHandler - 승차/하차 핸들러 생성
+func NewBoardingHandler(boardingService *service.BoardingService) *BoardingHandler {
+	return &BoardingHandler{boardingService: boardingService}
+}
+
+// Board - 승차 기록
+// @Summary		탑승자 승차 기록
+// @Description	운행 중 탑승자의 승차를 기록하고 연결된 보호자에게 정류장/시각 알림을 보냅니다. 탑승 기록이 없으면 운행 경로에 배정된 정류장으로 새로 기록합니다. 기록자(boarded_by)와 전달한 현재 위치(boarded_location)를 함께 남깁니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id			path	string					true	"운행 ID"
+// @Param		passengerId	path	string					true	"탑승자 ID"
+// @Param		request		body	dto.TripLocationRequest	false	"현재 위치"
+// @Success		200	{object}	util.APIResponse{data=domain.TripPassenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/passengers/{passengerId}/board [post]
+func (h *BoardingHandler) Board(c *gin.Context) {
+	req, ok := bindTripLocation(c)
+	if !ok {
+		return
+	}
+
+	record, err := h.boardingService.Board(c.Request.Context(), c.Param("id"), c.Param("passengerId"), req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승 기록"), record)
+}
+
+// Alight - 하차 기록
+// @Summary		탑승자 하차 기록
+// @Description	승차한 탑승자의 하차를 기록하고 연결된 보호자에게 정류장/시각 알림을 보냅니다. 승차 기록이 없으면 409를 반환하며, 기록자(alighted_by)와 전달한 현재 위치(alighted_location)를 함께 남깁니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id			path	string					true	"운행 ID"
+// @Param		passengerId	path	string					true	"탑승자 ID"
+// @Param		request		body	dto.TripLocationRequest	false	"현재 위치"
+// @Success		200	{object}	util.APIResponse{data=domain.TripPassenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/passengers/{passengerId}/alight [post]
+func (h *BoardingHandler) Alight(c *gin.Context) {
+	req, ok := bindTripLocation(c)
+	if !ok {
+		return
+	}
+
+	record, err := h.boardingService.Alight(c.Request.Context(), c.Param("id"), c.Param("passengerId"), req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승 기록"), record)
+}
+
+// MarkNoShow - 불참 처리
+// @Summary		탑승자 불참 처리
+// @Description	운행 중 탑승하지 않은 탑승자를 불참 처리하고 관리자 연락처와 배정 동승자에게 알립니다. 불참 시각은 일일 출결 집계에 사용됩니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id			path	string					true	"운행 ID"
+// @Param		passengerId	path	string					true	"탑승자 ID"
+// @Param		request		body	dto.MarkNoShowRequest	true	"불참 사유"
+// @Success		200	{object}	util.APIResponse{data=domain.TripPassenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/passengers/{passengerId}/no-show [post]
+func (h *BoardingHandler) MarkNoShow(c *gin.Context) {
+	var req dto.MarkNoShowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	record, err := h.boardingService.MarkNoShow(c.Request.Context(), c.Param("id"), c.Param("passengerId"), req.Reason)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승 기록"), record)
+}
+
+// FlagException - 승차 탑승자 예외 처리
+// @Summary		승차 탑승자 예외 처리
+// @Description	승차 후 하차 기록 없이 차량을 떠난 탑승자(중간 보호자 인계, 병원 이송 등)를 사유와 함께 예외 처리합니다. 예외 처리된 탑승자는 운행 완료 전 차내 잔류 확인에서 제외되며, 승차 중이 아니면 409를 반환합니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id			path	string								true	"운행 ID"
+// @Param		passengerId	path	string								true	"탑승자 ID"
+// @Param		request		body	dto.FlagPassengerExceptionRequest	true	"예외 사유"
+// @Success		200	{object}	util.APIResponse{data=domain.TripPassenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/passengers/{passengerId}/exception [post]
+func (h *BoardingHandler) FlagException(c *gin.Context) {
+	var req dto.FlagPassengerExceptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	record, err := h.boardingService.FlagException(c.Request.Context(), c.Param("id"), c.Param("passengerId"), req.Reason)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승 기록"), record)
+}
+
+// BoardAll - 정류장 일괄 승차
+// @Summary		정류장 일괄 승차
+// @Description	전달한 탑승자(passenger_ids) 또는 그 정류장의 탑승 예정자 전원(all_expected)을 한 번에 승차 처리합니다. 한 트랜잭션으로 저장하며, 다른 정류장 탑승자가 있으면 400, 이미 승차한 탑승자가 있으면 409를 반환하고 아무것도 기록하지 않습니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"운행 ID"
+// @Param		stopId	path	string					true	"정류장 ID"
+// @Param		request	body	dto.BulkBoardingRequest	true	"대상 탑승자"
+// @Success		200	{object}	util.APIResponse{data=[]domain.TripPassenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/stops/{stopId}/board-all [post]
+func (h *BoardingHandler) BoardAll(c *gin.Context) {
+	var req dto.BulkBoardingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	records, err := h.boardingService.BoardAll(c.Request.Context(), c.Param("id"), c.Param("stopId"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승 기록"), records)
+}
+
+// AlightAll - 정류장 일괄 하차
+// @Summary		정류장 일괄 하차
+// @Description	전달한 탑승자(passenger_ids) 또는 그 정류장에서 내릴 승차자 전원(all_expected, 오전 일정은 승차자 전원)을 한 번에 하차 처리합니다. 승차 기록이 없거나 이미 하차한 탑승자가 있으면 409를 반환하고 아무것도 기록하지 않습니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"운행 ID"
+// @Param		stopId	path	string					true	"정류장 ID"
+// @Param		request	body	dto.BulkBoardingRequest	true	"대상 탑승자"
+// @Success		200	{object}	util.APIResponse{data=[]domain.TripPassenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/stops/{stopId}/alight-all [post]
+func (h *BoardingHandler) AlightAll(c *gin.Context) {
+	var req dto.BulkBoardingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	records, err := h.boardingService.AlightAll(c.Request.Context(), c.Param("id"), c.Param("stopId"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승 기록"), records)
+}
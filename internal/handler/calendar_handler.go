@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 일정 캘린더 구독 핸들러 (구독 주소 발급/해제, .ics 피드)
+// 🎯 실무 포인트: 피드는 캘린더 앱이 직접 가져가므로 Bearer 인증 없이 주소의 토큰으로 인증
+// ⚠️ 주의사항: 주소가 곧 비밀번호 → 요청 로그/응답 에러에 토큰을 남기지 않음, 유출되면 다시 발급해 교체
+
+// calendarContentType - iCalendar MIME 타입
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// CalendarHandler - 캘린더 핸들러
+type CalendarHandler struct {
+	calendarService *service.CalendarService
+}
+
+// NewCalendarHandler - 캘린더 핸들러 생성
+func NewCalendarHandler(calendarService *service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{calendarService: calendarService}
+}
+
+// IssueToken - 내 캘린더 구독 주소 발급
+// @Summary		캘린더 구독 주소 발급
+// @Description	기사/동승자 본인의 운행 일정(지난 1주 ~ 앞으로 2주, 대체 배정 포함)을 휴대폰 캘린더에서 구독할 .ics 주소를 발급합니다. 이미 발급한 주소가 있으면 새 주소로 교체되어 이전 주소는 더 이상 동작하지 않습니다
+// @Tags		Calendar
+// @Produce		json
+// @Success		201	{object}	util.APIResponse{data=dto.CalendarTokenResponse}
+// @Failure		403	{object}	util.APIResponse
+// @Router		/me/calendar-token [post]
+func (h *CalendarHandler) IssueToken(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	token, err := h.calendarService.IssueToken(c.Request.Context(), principal.UserID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "캘린더 구독"), token)
+}
+
+// RevokeToken - 내 캘린더 구독 해제
+// @Summary		캘린더 구독 해제
+// @Description	발급한 캘린더 구독 주소를 폐기합니다. 구독 중인 캘린더에는 더 이상 일정이 갱신되지 않습니다
+// @Tags		Calendar
+// @Produce		json
+// @Success		200	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/me/calendar-token [delete]
+func (h *CalendarHandler) RevokeToken(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.calendarService.RevokeToken(c.Request.Context(), principal.UserID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "캘린더 구독"))
+}
+
+// Feed - 운행 일정 캘린더 피드
+// @Summary		운행 일정 캘린더 피드 (.ics)
+// @Description	구독 주소의 토큰으로 인증해 기사/동승자의 운행 일정을 iCalendar 형식으로 내려줍니다. 생성된 운행과 아직 생성되지 않은 날의 운행 계획(공휴일/예외 날짜/대체 배정 반영)을 포함하고, 취소된 운행은 취소 상태로 표시합니다
+// @Tags		Calendar
+// @Produce		text/calendar
+// @Param		token	path	string	true	"구독 토큰 (.ics 포함)"
+// @Success		200	{file}	file
+// @Failure		404	{object}	util.APIResponse
+// @Router		/calendar/{token}.ics [get]
+func (h *CalendarHandler) Feed(c *gin.Context) {
+	raw, ok := strings.CutSuffix(c.Param("token"), ".ics")
+	if !ok || raw == "" {
+		_ = c.Error(util.NewNotFoundError("캘린더"))
+		return
+	}
+
+	data, err := h.calendarService.Feed(c.Request.Context(), raw)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="eodini.ics"`)
+	c.Data(http.StatusOK, calendarContentType, data)
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 지연 보고 핸들러
+// 🎯 실무 포인트: 기사 앱/관리자 화면에서 사유와 함께 지연을 알리면 경로 탑승자의 보호자와 관리자에게 알림
+// ⚠️ 주의사항: 관리자 또는 배정 기사/동승자만 가능 (Service에서 운행별 검증), 대기 중이거나 운행 중일 때만
+
+// DelayHandler - 운행 지연 핸들러
+type DelayHandler struct {
+	delayService *service.DelayService
+}
+
+// NewDelayHandler - 운행 지연 핸들러 생성
+func NewDelayHandler(delayService *service.DelayService) *DelayHandler {
+	return &DelayHandler{delayService: delayService}
+}
+
+// Report - 지연 보고
+// @Summary		운행 지연 보고
+// @Description	운행을 지연으로 표시하고 경로에 배정된 탑승자의 보호자와 관리자 연락처에 사유를 알립니다. 사유를 바꿔 다시 보고하면 다시 알립니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"운행 ID"
+// @Param		request	body	dto.ReportDelayRequest	true	"지연 사유"
+// @Success		200	{object}	util.APIResponse{data=domain.Trip}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/delay [post]
+func (h *DelayHandler) Report(c *gin.Context) {
+	var req dto.ReportDelayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	trip, err := h.delayService.Report(c.Request.Context(), c.Param("id"), req.Reason)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행"), trip)
+}
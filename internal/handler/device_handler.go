@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 내 푸시 수신 기기 등록/해제 핸들러 (로그인 사용자 본인)
+// 🎯 실무 포인트: 앱은 로그인 직후와 FCM 토큰 갱신 시 등록, 로그아웃 시 해제
+// ⚠️ 주의사항: API 키 주체는 기기를 가질 수 없으므로 403
+
+// DeviceHandler - 기기 핸들러
+type DeviceHandler struct {
+	deviceService *service.DeviceService
+}
+
+// NewDeviceHandler - 기기 핸들러 생성
+func NewDeviceHandler(deviceService *service.DeviceService) *DeviceHandler {
+	return &DeviceHandler{deviceService: deviceService}
+}
+
+// Register - 내 기기 등록
+// @Summary		푸시 수신 기기 등록
+// @Description	FCM 등록 토큰을 로그인한 사용자에게 연결합니다. 이미 등록된 토큰이면 현재 사용자로 옮기고 등록 시각을 갱신합니다
+// @Tags		Device
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.RegisterDeviceRequest	true	"기기 정보"
+// @Success		201	{object}	util.APIResponse{data=domain.DeviceToken}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/me/devices [post]
+func (h *DeviceHandler) Register(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RegisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	device, err := h.deviceService.Register(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "기기"), device)
+}
+
+// Unregister - 내 기기 해제
+// @Summary		푸시 수신 기기 해제
+// @Description	로그아웃 시 호출하면 이 기기로 더 이상 알림을 보내지 않습니다
+// @Tags		Device
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.UnregisterDeviceRequest	true	"해제할 토큰"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/me/devices [delete]
+func (h *DeviceHandler) Unregister(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UnregisterDeviceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if err := h.deviceService.Unregister(c.Request.Context(), principal.UserID, req.Token); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "기기"))
+}
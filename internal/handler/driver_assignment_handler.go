@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 기사 대체 배정 핸들러 (휴가/퇴사 시 다른 기사가 기간 동안 일정 담당)
+// 🎯 실무 포인트: 일정 하위 리소스 (/schedules/:id/driver-assignments), 승인된 배정만 운행 생성에 반영
+// ⚠️ 주의사항: 수정 API 없음 → 기간을 바꾸려면 삭제 후 다시 생성
+
+// DriverAssignmentHandler - 기사 대체 배정 핸들러
+type DriverAssignmentHandler struct {
+	assignmentService *service.DriverAssignmentService
+}
+
+// NewDriverAssignmentHandler - 기사 대체 배정 핸들러 생성
+func NewDriverAssignmentHandler(assignmentService *service.DriverAssignmentService) *DriverAssignmentHandler {
+	return &DriverAssignmentHandler{assignmentService: assignmentService}
+}
+
+// List - 기사 대체 배정 목록
+// @Summary		기사 대체 배정 목록
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse{data=[]domain.DriverAssignment}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/driver-assignments [get]
+func (h *DriverAssignmentHandler) List(c *gin.Context) {
+	assignments, err := h.assignmentService.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), assignments)
+}
+
+// Create - 기사 대체 배정 생성
+// @Summary		기사 대체 배정 생성
+// @Description	기간 동안 기본 기사 대신 대체 기사가 일정을 담당합니다. 승인 후 운행 생성에 반영되며, 같은 일정에 기간이 겹치는 배정이 있으면 409를 반환합니다
+// @Tags		Schedule
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string									true	"일정 ID"
+// @Param		request	body	dto.CreateDriverAssignmentRequest	true	"대체 배정"
+// @Success		201	{object}	util.APIResponse{data=domain.DriverAssignment}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/schedules/{id}/driver-assignments [post]
+func (h *DriverAssignmentHandler) Create(c *gin.Context) {
+	var req dto.CreateDriverAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	assignment, err := h.assignmentService.Create(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "기사 대체 배정"), assignment)
+}
+
+// Approve - 기사 대체 배정 승인
+// @Summary		기사 대체 배정 승인
+// @Tags		Schedule
+// @Produce		json
+// @Param		id				path	string	true	"일정 ID"
+// @Param		assignmentId	path	string	true	"대체 배정 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.DriverAssignment}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/driver-assignments/{assignmentId}/approve [post]
+func (h *DriverAssignmentHandler) Approve(c *gin.Context) {
+	assignment, err := h.assignmentService.Approve(c.Request.Context(), c.Param("id"), c.Param("assignmentId"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), assignment)
+}
+
+// Delete - 기사 대체 배정 삭제
+// @Summary		기사 대체 배정 삭제
+// @Tags		Schedule
+// @Produce		json
+// @Param		id				path	string	true	"일정 ID"
+// @Param		assignmentId	path	string	true	"대체 배정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/driver-assignments/{assignmentId} [delete]
+func (h *DriverAssignmentHandler) Delete(c *gin.Context) {
+	if err := h.assignmentService.Delete(c.Request.Context(), c.Param("id"), c.Param("assignmentId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "기사 대체 배정"))
+}
@@ -0,0 +1,289 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 기사 관리 핸들러
+// 🎯 실무 포인트: 등록/수정/면허 갱신/휴가/퇴사를 각각의 엔드포인트로 분리
+// ⚠️ 주의사항: 에러는 c.Error()로 넘기고 응답은 ErrorHandler에서 통일
+
+// DriverHandler - 기사 핸들러
+type DriverHandler struct {
+	driverService *service.DriverService
+}
+
+// NewDriverHandler - 기사 핸들러 생성
+func NewDriverHandler(driverService *service.DriverService) *DriverHandler {
+	return &DriverHandler{driverService: driverService}
+}
+
+// List - 기사 목록 조회
+// @Summary		기사 목록 조회
+// @Description	상태, 면허 확인 상태, 면허 만료 임박(N일 이내) 조건으로 기사 목록을 조회합니다
+// @Tags		Driver
+// @Produce		json
+// @Param		status					query	string	false	"상태 (active, on_leave, inactive)"
+// @Param		license_status			query	string	false	"면허 확인 상태 (pending, verified, rejected)"
+// @Param		license_expiring_within	query	int		false	"N일 이내 면허 만료"
+// @Param		page					query	int		false	"페이지 (기본 1)"
+// @Param		page_size				query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Param		include_deleted	query	bool	false	"삭제된 기사 포함 (관리자만)"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/drivers [get]
+func (h *DriverHandler) List(c *gin.Context) {
+	var query dto.ListDriverQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if !allowIncludeDeleted(c, query.IncludeDeleted) {
+		return
+	}
+
+	params, err := parseListParams(c, repository.DriverListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.DriverFilter{
+		Status:                domain.DriverStatus(query.Status),
+		LicenseStatus:         domain.LicenseStatus(query.LicenseStatus),
+		LicenseExpiringWithin: query.LicenseExpiringWithin,
+		IncludeDeleted:        query.IncludeDeleted,
+		ListOptions:           params.options,
+	}
+
+	drivers, total, err := h.driverService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), drivers, params.meta(total))
+}
+
+// Get - 기사 단건 조회
+// @Summary		기사 조회
+// @Tags		Driver
+// @Produce		json
+// @Param		id	path	string	true	"기사 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/drivers/{id} [get]
+func (h *DriverHandler) Get(c *gin.Context) {
+	driver, err := h.driverService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), driver)
+}
+
+// Create - 기사 등록
+// @Summary		기사 등록
+// @Tags		Driver
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateDriverRequest	true	"기사 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"면허 번호 중복"
+// @Router		/drivers [post]
+func (h *DriverHandler) Create(c *gin.Context) {
+	var req dto.CreateDriverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	driver, err := h.driverService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "기사"), driver)
+}
+
+// Update - 기사 기본 정보 수정
+// @Summary		기사 정보 수정
+// @Tags		Driver
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"기사 ID"
+// @Param		request	body	dto.UpdateDriverRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/drivers/{id} [put]
+func (h *DriverHandler) Update(c *gin.Context) {
+	var req dto.UpdateDriverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	driver, err := h.driverService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "기사"), driver)
+}
+
+// UpdateLicense - 면허 정보 수정
+// @Summary		면허 정보 수정
+// @Tags		Driver
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"기사 ID"
+// @Param		request	body	dto.UpdateLicenseRequest	true	"면허 정보"
+// @Success		200	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"면허 번호 중복"
+// @Router		/drivers/{id}/license [put]
+func (h *DriverHandler) UpdateLicense(c *gin.Context) {
+	var req dto.UpdateLicenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	driver, err := h.driverService.UpdateLicense(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "면허 정보"), driver)
+}
+
+// ReviewLicense - 면허 확인/반려
+// @Summary		면허 확인/반려
+// @Description	면허증 스캔(또는 실물)을 확인해 verified 또는 rejected로 정합니다. 확인(verified)된 면허만 운행에 배정할 수 있으며, 면허 정보를 바꾸거나 새 스캔을 올리면 다시 pending이 됩니다
+// @Tags		Driver
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"기사 ID"
+// @Param		request	body	dto.ReviewLicenseRequest	true	"확인 결과 (rejected는 reason 필수)"
+// @Success		200	{object}	util.APIResponse{data=domain.Driver}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"만료된 면허"
+// @Router		/drivers/{id}/license/review [put]
+func (h *DriverHandler) ReviewLicense(c *gin.Context) {
+	var req dto.ReviewLicenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	driver, err := h.driverService.ReviewLicense(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "면허 확인"), driver)
+}
+
+// ChangeStatus - 휴가/복귀 상태 변경
+// @Summary		기사 상태 변경
+// @Description	기사를 휴가(on_leave) 또는 활동(active) 상태로 변경합니다
+// @Tags		Driver
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string							true	"기사 ID"
+// @Param		request	body	dto.UpdateDriverStatusRequest	true	"변경할 상태"
+// @Success		200	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"퇴사한 기사"
+// @Router		/drivers/{id}/status [patch]
+func (h *DriverHandler) ChangeStatus(c *gin.Context) {
+	var req dto.UpdateDriverStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	driver, err := h.driverService.ChangeStatus(c.Request.Context(), c.Param("id"), domain.DriverStatus(req.Status))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "기사 상태"), driver)
+}
+
+// Terminate - 퇴사 처리
+// @Summary		기사 퇴사 처리
+// @Tags		Driver
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"기사 ID"
+// @Param		request	body	dto.TerminateDriverRequest	false	"퇴사일 (생략 시 오늘)"
+// @Success		200	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"이미 퇴사 처리됨"
+// @Router		/drivers/{id}/terminate [post]
+func (h *DriverHandler) Terminate(c *gin.Context) {
+	var req dto.TerminateDriverRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(newBindingError(err))
+			return
+		}
+	}
+
+	driver, err := h.driverService.Terminate(c.Request.Context(), c.Param("id"), req.TerminationDate)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "기사 상태"), driver)
+}
+
+// Delete - 기사 삭제
+// @Summary		기사 삭제
+// @Tags		Driver
+// @Produce		json
+// @Param		id	path	string	true	"기사 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/drivers/{id} [delete]
+func (h *DriverHandler) Delete(c *gin.Context) {
+	if err := h.driverService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "기사"))
+}
+
+// Restore - 삭제된 기사 복구
+// @Summary		기사 복구
+// @Tags		Driver
+// @Produce		json
+// @Param		id	path	string	true	"기사 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.Driver}
+// @Failure		404	{object}	util.APIResponse	"삭제된 기사 없음"
+// @Failure		409	{object}	util.APIResponse
+// @Router		/drivers/{id}/restore [post]
+func (h *DriverHandler) Restore(c *gin.Context) {
+	restored, err := h.driverService.Restore(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgRestored, "기사"), restored)
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 면허증 스캔 핸들러 (기사 하위 리소스 /drivers/:id/license/scan)
+// 🎯 실무 포인트: 기사 앱에서 면허증을 촬영해 올리면 관리자가 PUT /drivers/:id/license/review로 확인/반려
+// ⚠️ 주의사항: 관리자와 기사 본인만 (다른 기사의 스캔은 403), 파일 저장소가 설정된 경우에만 등록
+
+// DriverLicenseHandler - 면허증 스캔 핸들러
+type DriverLicenseHandler struct {
+	licenseService *service.DriverLicenseService
+}
+
+// NewDriverLicenseHandler - 면허증 스캔 핸들러 생성
+func NewDriverLicenseHandler(licenseService *service.DriverLicenseService) *DriverLicenseHandler {
+	return &DriverLicenseHandler{licenseService: licenseService}
+}
+
+// UploadScan - 면허증 스캔 업로드
+// @Summary		면허증 스캔 업로드
+// @Description	면허증 사진/스캔(PDF, JPEG, PNG, 최대 5MB)을 올립니다. 올리면 면허 확인 상태가 pending으로 바뀌고 관리자가 확인할 때까지 운행에 배정할 수 없습니다. 이전 스캔은 삭제됩니다
+// @Tags		Driver
+// @Accept		multipart/form-data
+// @Produce		json
+// @Param		id		path		string	true	"기사 ID"
+// @Param		file	formData	file	true	"면허증 스캔 (PDF, JPEG, PNG)"
+// @Success		200	{object}	util.APIResponse{data=domain.Driver}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		502	{object}	util.APIResponse	"파일 저장소 오류"
+// @Router		/drivers/{id}/license/scan [put]
+func (h *DriverLicenseHandler) UploadScan(c *gin.Context) {
+	fileName, data, err := readUploadFile(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	driver, err := h.licenseService.UploadScan(c.Request.Context(), c.Param("id"), fileName, data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "면허증 스캔"), driver)
+}
+
+// DownloadScan - 면허증 스캔 다운로드
+// @Summary		면허증 스캔 다운로드
+// @Tags		Driver
+// @Produce		application/pdf,image/jpeg,image/png
+// @Param		id	path	string	true	"기사 ID"
+// @Success		200	{file}		binary
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/drivers/{id}/license/scan [get]
+func (h *DriverLicenseHandler) DownloadScan(c *gin.Context) {
+	file, data, err := h.licenseService.DownloadScan(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	sendFile(c, file, data)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 도착 예정 시간 핸들러
+// 🎯 실무 포인트: 보호자 앱이 주기적으로 조회 (계산 결과는 서비스에서 캐시)
+// ⚠️ 주의사항: 권한은 실시간 위치 구독과 동일 (서비스에서 검증)
+
+// ETAHandler - 도착 예정 시간 핸들러
+type ETAHandler struct {
+	etaService *service.ETAService
+}
+
+// NewETAHandler - 도착 예정 시간 핸들러 생성
+func NewETAHandler(etaService *service.ETAService) *ETAHandler {
+	return &ETAHandler{etaService: etaService}
+}
+
+// Get - 남은 정류장 도착 예정 시간 조회
+// @Summary		정류장 도착 예정 시간
+// @Description	마지막 수신 위치에서 남은 정류장까지 도착 예정 시각과 남은 분을 반환합니다. 관리자, trips:read API 키, 탑승 자녀가 있는 보호자만 조회할 수 있습니다
+// @Tags		Tracking
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse{data=dto.TripETAResponse}
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"운행 중이 아님"
+// @Router		/trips/{id}/eta [get]
+func (h *ETAHandler) Get(c *gin.Context) {
+	eta, err := h.etaService.GetETA(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), eta)
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 업로드 파일 핸들러 (/files)
+// 🎯 실무 포인트: 앱이 먼저 파일을 올려 ID를 받고, 그 ID로 면허증/사고 기록 등 리소스에 연결
+// multipart 파일 읽기(readUploadFile)와 파일 응답(sendFile)은 차량 서류 등 다른 업로드 핸들러도 함께 사용
+// ⚠️ 주의사항: 요청 본문은 service.MaxUploadSize까지만 읽음 (용도별 최대 크기는 서비스가 확인), 한글 파일 이름은 filename*(RFC 5987)로 내려줌
+
+// FileHandler - 업로드 파일 핸들러
+type FileHandler struct {
+	fileService *service.FileService
+}
+
+// NewFileHandler - 업로드 파일 핸들러 생성
+func NewFileHandler(fileService *service.FileService) *FileHandler {
+	return &FileHandler{fileService: fileService}
+}
+
+// Upload - 파일 업로드
+// @Summary		파일 업로드
+// @Description	용도(purpose)별로 허용 형식과 크기가 다릅니다. vehicle_document: PDF/JPEG/PNG 10MB (관리자), driver_license: PDF/JPEG/PNG 5MB (관리자, 기사), incident_photo: JPEG/PNG 10MB (관리자, 기사, 동승자). 파일 형식은 내용으로 판별합니다
+// @Tags		File
+// @Accept		multipart/form-data
+// @Produce		json
+// @Param		file	formData	file	true	"파일"
+// @Param		purpose	formData	string	true	"용도 (vehicle_document, driver_license, incident_photo)"
+// @Success		201	{object}	util.APIResponse{data=domain.File}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		502	{object}	util.APIResponse	"파일 저장소 오류"
+// @Router		/files [post]
+func (h *FileHandler) Upload(c *gin.Context) {
+	var req dto.UploadFileRequest
+	if err := c.ShouldBind(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	fileName, data, err := readUploadFile(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	file, err := h.fileService.Upload(c.Request.Context(), domain.FilePurpose(req.Purpose), fileName, data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "파일"), file)
+}
+
+// Get - 파일 메타데이터
+// @Summary		파일 정보
+// @Description	업로드한 사용자와 관리자만 조회할 수 있습니다
+// @Tags		File
+// @Produce		json
+// @Param		id	path	string	true	"파일 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.File}
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/files/{id} [get]
+func (h *FileHandler) Get(c *gin.Context) {
+	file, err := h.fileService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), file)
+}
+
+// Download - 파일 다운로드
+// @Summary		파일 다운로드
+// @Description	업로드한 원본 파일을 내려받습니다. 업로드한 사용자와 관리자만 받을 수 있습니다
+// @Tags		File
+// @Produce		application/pdf,image/jpeg,image/png
+// @Param		id	path	string	true	"파일 ID"
+// @Success		200	{file}		binary
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/files/{id}/content [get]
+func (h *FileHandler) Download(c *gin.Context) {
+	file, data, err := h.fileService.Download(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	sendFile(c, file, data)
+}
+
+// readUploadFile - multipart/form-data의 file 필드 (파일 이름, 내용)
+func readUploadFile(c *gin.Context) (string, []byte, error) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return "", nil, uploadFileError("파일을 첨부해 주세요 (multipart/form-data의 file 필드)")
+	}
+	if header.Size > service.MaxUploadSize {
+		return "", nil, uploadFileError("파일은 최대 %dMB까지 올릴 수 있습니다", service.MaxUploadSize>>20)
+	}
+	file, err := header.Open()
+	if err != nil {
+		return "", nil, util.NewInternalError(err)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, service.MaxUploadSize))
+	if err != nil {
+		return "", nil, util.NewInternalError(err)
+	}
+	return header.Filename, data, nil
+}
+
+// sendFile - 파일 원본 응답 (Content-Disposition: attachment)
+func sendFile(c *gin.Context, file *domain.File, data []byte) {
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+	c.Data(http.StatusOK, file.ContentType, data)
+}
+
+// uploadFileError - file 필드 Validation 에러
+func uploadFileError(message string, args ...interface{}) *util.AppError {
+	return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{"file": util.Text(message, args...)})
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 주유 기록 핸들러 (차량 하위 리소스 /vehicles/:id/fuel-logs) + 연비 보고서
+// 🎯 실무 포인트: 기사가 주유 직후 앱에서 기록, 관리자는 연비 보고서로 연비가 낮은 차량/의심 주유 확인
+// ⚠️ 주의사항: 수정 API 없음 → 잘못 입력한 기록은 관리자가 삭제 후 다시 등록
+
+// FuelLogHandler - 주유 기록 핸들러
+type FuelLogHandler struct {
+	fuelService *service.FuelLogService
+}
+
+// NewFuelLogHandler - 주유 기록 핸들러 생성
+func NewFuelLogHandler(fuelService *service.FuelLogService) *FuelLogHandler {
+	return &FuelLogHandler{fuelService: fuelService}
+}
+
+// List - 차량의 주유 기록 목록
+// @Summary		주유 기록 목록
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id		path	string	true	"차량 ID"
+// @Param		from	query	string	false	"시작일 (YYYY-MM-DD, 기관 시간대)"
+// @Param		to		query	string	false	"종료일 (YYYY-MM-DD, 포함)"
+// @Success		200	{object}	util.APIResponse{data=[]domain.FuelLog}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/fuel-logs [get]
+func (h *FuelLogHandler) List(c *gin.Context) {
+	var query dto.FuelLogQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	logs, err := h.fuelService.List(c.Request.Context(), c.Param("id"), &query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), logs)
+}
+
+// Add - 주유 기록 등록
+// @Summary		주유 기록 등록
+// @Description	주유량(L), 결제 금액(원), 주유 시점 주행거리계(km)를 기록합니다. 주유 시각을 생략하면 지금이며, 주행거리계는 앞뒤 주유 기록 사이여야 합니다
+// @Tags		Vehicle
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"차량 ID"
+// @Param		request	body	dto.CreateFuelLogRequest	true	"주유 기록"
+// @Success		201	{object}	util.APIResponse{data=domain.FuelLog}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/fuel-logs [post]
+func (h *FuelLogHandler) Add(c *gin.Context) {
+	var req dto.CreateFuelLogRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	log, err := h.fuelService.Add(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "주유 기록"), log)
+}
+
+// Delete - 주유 기록 삭제
+// @Summary		주유 기록 삭제
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id		path	string	true	"차량 ID"
+// @Param		logId	path	string	true	"주유 기록 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/fuel-logs/{logId} [delete]
+func (h *FuelLogHandler) Delete(c *gin.Context) {
+	if err := h.fuelService.Delete(c.Request.Context(), c.Param("id"), c.Param("logId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "주유 기록"))
+}
+
+// Report - 연비 보고서
+// @Summary		연비 보고서
+// @Description	기간에 주유한 차량별 주유량, 금액, 주행 거리, 연비(km/L), km당 연료비와 월별 추이를 조회합니다. 연비는 직전 주유 이후 주행거리계 차이 ÷ 주유량이며, 직전 주유 이후 주행이 없거나(no_distance) 차량 평균 연비의 70% 미만인(low_economy) 주유는 flags로 표시합니다. 기간은 최대 366일입니다
+// @Tags		Report
+// @Produce		json
+// @Param		from	query	string	true	"시작일 (YYYY-MM-DD)"
+// @Param		to		query	string	true	"종료일 (YYYY-MM-DD, 포함)"
+// @Success		200	{object}	util.APIResponse{data=dto.FuelEconomyReportResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/reports/fuel-economy [get]
+func (h *FuelLogHandler) Report(c *gin.Context) {
+	var query dto.FuelEconomyReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	from, _ := time.Parse(time.DateOnly, query.From) // 바인딩에서 형식 검증 완료
+	to, _ := time.Parse(time.DateOnly, query.To)
+
+	report, err := h.fuelService.Report(c.Request.Context(), from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), report)
+}
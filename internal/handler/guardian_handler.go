@@ -0,0 +1,242 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 보호자 관리 핸들러
+// 🎯 실무 포인트: 관리자는 /guardians로 계정/자녀 연결 관리, 보호자는 /me/children으로 본인 자녀만 조회
+// ⚠️ 주의사항: /me/children은 토큰의 profile_id(보호자 ID) 기준으로만 조회
+
+// GuardianHandler - 보호자 핸들러
+type GuardianHandler struct {
+	guardianService *service.GuardianService
+}
+
+// NewGuardianHandler - 보호자 핸들러 생성
+func NewGuardianHandler(guardianService *service.GuardianService) *GuardianHandler {
+	return &GuardianHandler{guardianService: guardianService}
+}
+
+// List - 보호자 목록 조회
+// @Summary		보호자 목록 조회
+// @Tags		Guardian
+// @Produce		json
+// @Param		status		query	string	false	"상태 (active, inactive)"
+// @Param		name		query	string	false	"이름 부분 검색"
+// @Param		phone		query	string	false	"연락처"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/guardians [get]
+func (h *GuardianHandler) List(c *gin.Context) {
+	var query dto.ListGuardianQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.GuardianListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.GuardianFilter{
+		Status:      domain.GuardianStatus(query.Status),
+		Name:        query.Name,
+		Phone:       query.Phone,
+		ListOptions: params.options,
+	}
+
+	guardians, total, err := h.guardianService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), guardians, params.meta(total))
+}
+
+// Get - 보호자 단건 조회
+// @Summary		보호자 조회
+// @Tags		Guardian
+// @Produce		json
+// @Param		id	path	string	true	"보호자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/guardians/{id} [get]
+func (h *GuardianHandler) Get(c *gin.Context) {
+	guardian, err := h.guardianService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), guardian)
+}
+
+// Create - 보호자 등록
+// @Summary		보호자 등록
+// @Tags		Guardian
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateGuardianRequest	true	"보호자 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"연락처 중복"
+// @Router		/guardians [post]
+func (h *GuardianHandler) Create(c *gin.Context) {
+	var req dto.CreateGuardianRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	guardian, err := h.guardianService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "보호자"), guardian)
+}
+
+// Update - 보호자 정보 수정
+// @Summary		보호자 정보 수정
+// @Tags		Guardian
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string								true	"보호자 ID"
+// @Param		request	body	dto.UpdateGuardianAccountRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/guardians/{id} [put]
+func (h *GuardianHandler) Update(c *gin.Context) {
+	var req dto.UpdateGuardianAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	guardian, err := h.guardianService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "보호자"), guardian)
+}
+
+// Delete - 보호자 삭제
+// @Summary		보호자 삭제
+// @Tags		Guardian
+// @Produce		json
+// @Param		id	path	string	true	"보호자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/guardians/{id} [delete]
+func (h *GuardianHandler) Delete(c *gin.Context) {
+	if err := h.guardianService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "보호자"))
+}
+
+// LinkPassenger - 자녀(탑승자) 연결
+// @Summary		자녀 연결
+// @Tags		Guardian
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"보호자 ID"
+// @Param		request	body	dto.LinkPassengerRequest	true	"연결할 탑승자"
+// @Success		201	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"이미 연결됨"
+// @Router		/guardians/{id}/passengers [post]
+func (h *GuardianHandler) LinkPassenger(c *gin.Context) {
+	var req dto.LinkPassengerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	link, err := h.guardianService.LinkPassenger(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "자녀 연결"), link)
+}
+
+// UnlinkPassenger - 자녀(탑승자) 연결 해제
+// @Summary		자녀 연결 해제
+// @Tags		Guardian
+// @Produce		json
+// @Param		id			path	string	true	"보호자 ID"
+// @Param		passengerId	path	string	true	"탑승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/guardians/{id}/passengers/{passengerId} [delete]
+func (h *GuardianHandler) UnlinkPassenger(c *gin.Context) {
+	if err := h.guardianService.UnlinkPassenger(c.Request.Context(), c.Param("id"), c.Param("passengerId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "자녀 연결"))
+}
+
+// ListChildren - 보호자의 자녀 목록 (관리자용)
+// @Summary		보호자 자녀 목록
+// @Tags		Guardian
+// @Produce		json
+// @Param		id	path	string	true	"보호자 ID"
+// @Success		200	{object}	util.APIResponse{data=[]dto.ChildResponse}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/guardians/{id}/children [get]
+func (h *GuardianHandler) ListChildren(c *gin.Context) {
+	children, err := h.guardianService.ListChildren(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), children)
+}
+
+// MyChildren - 내 자녀 목록 (보호자 본인)
+// @Summary		내 자녀 목록
+// @Description	로그인한 보호자의 자녀와 배정 경로/정류장을 조회합니다
+// @Tags		Guardian
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=[]dto.ChildResponse}
+// @Failure		403	{object}	util.APIResponse
+// @Router		/me/children [get]
+func (h *GuardianHandler) MyChildren(c *gin.Context) {
+	principal, ok := auth.FromContext(c.Request.Context())
+	if !ok || principal.ProfileID == "" {
+		_ = c.Error(util.NewForbiddenError())
+		return
+	}
+
+	children, err := h.guardianService.ListChildren(c.Request.Context(), principal.ProfileID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), children)
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/internal/validation"
+)
+
+// 📝 설명: 핸들러 공용 헬퍼 (바인딩 에러 변환, 목록 페이지/정렬/필터 파싱, 삭제 포함 조회 권한)
+// 🎯 실무 포인트: 핸들러마다 반복되는 코드를 한 곳에 모음
+// ⚠️ 주의사항: page_size는 최대값으로 제한 (과도한 조회 방지), 정렬/필터 필드는 Repository의 ListSpec에 선언된 것만 허용
+
+const (
+	defaultPage     = 1
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func init() {
+	// 검증 에러 필드명(json/form 태그)과 도메인 검증 규칙(차량 번호, 연락처 등) 등록
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		if err := validation.Register(v); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// newBindingError - 요청 바인딩 실패를 Validation 에러로 변환
+// 검증 실패와 JSON 타입 오류는 필드별 메시지로, 본문 파싱 실패는 "body" 키로 반환
+func newBindingError(err error) *util.AppError {
+	return util.NewValidationError(util.MsgValidationFailed, validation.FieldErrors(err))
+}
+
+// listParams - 목록 조회 공통 파라미터 (페이지 + Repository 조회 옵션)
+type listParams struct {
+	page     int
+	pageSize int
+	options  repository.ListOptions
+}
+
+// parseListParams - page, page_size, sort, filter[필드] 쿼리 파라미터를 spec 기준으로 변환
+// sort는 쉼표 구분, "-" 접두사는 내림차순 (예: sort=-created_at,name), filter[필드]=값은 일치 조건
+// 잘못된 page/page_size는 기본값으로 대체, 허용되지 않은 정렬/필터 필드는 Validation 에러
+func parseListParams(c *gin.Context, spec repository.ListSpec) (listParams, error) {
+	page, pageSize := parsePagination(c)
+	params := listParams{
+		page:     page,
+		pageSize: pageSize,
+		options: repository.ListOptions{
+			Offset: (page - 1) * pageSize,
+			Limit:  pageSize,
+		},
+	}
+	details := make(map[string]interface{})
+
+	if sort := c.Query("sort"); sort != "" {
+		for _, name := range strings.Split(sort, ",") {
+			name = strings.TrimSpace(name)
+			desc := strings.HasPrefix(name, "-")
+			column, ok := spec.Sortable[strings.TrimPrefix(name, "-")]
+			if !ok {
+				details["sort"] = util.Text("정렬할 수 없는 필드입니다: %s (가능: %s)", name, allowedFields(spec.Sortable))
+				break
+			}
+			params.options.Sort = append(params.options.Sort, repository.SortField{Column: column, Desc: desc})
+		}
+	}
+
+	for key, values := range c.Request.URL.Query() {
+		if !strings.HasPrefix(key, "filter[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		name := key[len("filter[") : len(key)-1]
+		column, ok := spec.Filterable[name]
+		if !ok {
+			details[key] = util.Text("필터할 수 없는 필드입니다 (가능: %s)", allowedFields(spec.Filterable))
+			continue
+		}
+		if values[0] == "" {
+			continue
+		}
+		if params.options.Filters == nil {
+			params.options.Filters = make(map[string]string)
+		}
+		params.options.Filters[column] = values[0]
+	}
+
+	if len(details) > 0 {
+		return listParams{}, util.NewValidationError(util.MsgValidationFailed, details)
+	}
+	return params, nil
+}
+
+// meta - 페이지네이션 메타데이터 생성
+func (p listParams) meta(total int64) util.PaginationMeta {
+	totalPages := int((total + int64(p.pageSize) - 1) / int64(p.pageSize))
+	return util.PaginationMeta{
+		Page:       p.page,
+		PageSize:   p.pageSize,
+		TotalItems: total,
+		TotalPages: totalPages,
+	}
+}
+
+// allowedFields - 에러 메시지용 허용 필드 목록 (정렬된 쉼표 구분)
+func allowedFields(fields map[string]string) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// parsePagination - 쿼리 파라미터에서 page, page_size 추출
+// 잘못된 값은 기본값으로 대체
+func parsePagination(c *gin.Context) (page, pageSize int) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", strconv.Itoa(defaultPage)))
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+
+	pageSize, err = strconv.Atoi(c.DefaultQuery("page_size", strconv.Itoa(defaultPageSize)))
+	if err != nil || pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	return page, pageSize
+}
+
+// optionalDays - days 규칙으로 검증된 일수 쿼리 값 변환 (빈 값이면 nil)
+func optionalDays(value string) *int {
+	days, ok := validation.ParseDays(value)
+	if !ok {
+		return nil
+	}
+	return &days
+}
+
+// allowIncludeDeleted - include_deleted=true는 관리자만 허용 (그 외는 FORBIDDEN을 기록하고 false)
+func allowIncludeDeleted(c *gin.Context, includeDeleted bool) bool {
+	if !includeDeleted {
+		return true
+	}
+	if principal, ok := auth.FromContext(c.Request.Context()); ok && principal.IsAdmin() {
+		return true
+	}
+	_ = c.Error(util.NewForbiddenError())
+	return false
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 공휴일 조회 핸들러
+// 🎯 실무 포인트: 운행 생성에서 빠지는 공휴일(기본 표 + 동기화분)을 운영 인력이 미리 확인
+// ⚠️ 주의사항: 동기화는 주기 작업(holiday-sync)이 담당, 여기서는 조회만
+
+// HolidayHandler - 공휴일 핸들러
+type HolidayHandler struct {
+	holidayService *service.HolidayService
+}
+
+// NewHolidayHandler - 공휴일 핸들러 생성
+func NewHolidayHandler(holidayService *service.HolidayService) *HolidayHandler {
+	return &HolidayHandler{holidayService: holidayService}
+}
+
+// List - 연도별 공휴일 목록
+// @Summary		공휴일 목록
+// @Description	연도의 공휴일을 날짜 순으로 반환합니다 (source: static=기본 표, api=특일 정보 API 동기화). 공휴일 제외 일정은 이 날짜에 운행을 만들지 않습니다
+// @Tags		Holiday
+// @Produce		json
+// @Param		year	query	int	false	"연도 (기본: 올해)"
+// @Success		200	{object}	util.APIResponse{data=[]domain.Holiday}
+// @Failure		400	{object}	util.APIResponse
+// @Router		/holidays [get]
+func (h *HolidayHandler) List(c *gin.Context) {
+	var query dto.ListHolidayQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	holidays, err := h.holidayService.List(c.Request.Context(), query.Year)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), holidays)
+}
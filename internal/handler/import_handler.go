@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 파일 가져오기 핸들러 (탑승자/정류장 일괄 등록)
+// 🎯 실무 포인트: multipart/form-data의 file 필드로 CSV 또는 Excel(.xlsx) 업로드, dry_run=true면 검증 결과만 확인
+// ⚠️ 주의사항: 파일 크기는 maxImportFileSize로 제한, 행 검증 실패는 200 응답의 errors에 담김 (파일 자체 문제만 400)
+
+// maxImportFileSize - 업로드 파일 최대 크기 (5MB)
+const maxImportFileSize = 5 << 20
+
+// ImportHandler - 파일 가져오기 핸들러
+type ImportHandler struct {
+	importService *service.ImportService
+}
+
+// NewImportHandler - 파일 가져오기 핸들러 생성
+func NewImportHandler(importService *service.ImportService) *ImportHandler {
+	return &ImportHandler{importService: importService}
+}
+
+// ImportPassengers - 탑승자 일괄 등록
+// @Summary		탑승자 일괄 등록
+// @Description	CSV/Excel 파일의 행마다 탑승자를 검증하고, 통과한 행만 한 트랜잭션으로 등록합니다. 머리글: 이름, 나이, 성별, 보호자 이름, 보호자 연락처, 보호자 이메일, 보호자 관계, 비상 연락처, 비상 연락처 관계, 주소, 의료 메모, 메모, 경로, 정류장 (API 필드명도 가능). 경로/정류장은 이름 또는 ID로 지정합니다
+// @Tags		Import
+// @Accept		multipart/form-data
+// @Produce		json
+// @Param		file	formData	file	true	"CSV(UTF-8) 또는 Excel(.xlsx) 파일 (최대 5MB, 1000행)"
+// @Param		dry_run	query	bool	false	"true면 검증만 하고 저장하지 않음"
+// @Success		200	{object}	util.APIResponse{data=dto.ImportResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/imports/passengers [post]
+func (h *ImportHandler) ImportPassengers(c *gin.Context) {
+	h.handle(c, h.importService.ImportPassengers)
+}
+
+// ImportStops - 정류장 일괄 등록
+// @Summary		정류장 일괄 등록
+// @Description	CSV/Excel 파일의 행마다 정류장을 검증하고, 통과한 행만 한 트랜잭션으로 파일 순서대로 추가합니다. 머리글: 경로, 정류장 이름, 주소, 순서, 위도, 경도, 도착 예정(분), 메모 (API 필드명도 가능). 순서를 비우면 경로 마지막에, 좌표를 비우면 주소로 검색합니다
+// @Tags		Import
+// @Accept		multipart/form-data
+// @Produce		json
+// @Param		file	formData	file	true	"CSV(UTF-8) 또는 Excel(.xlsx) 파일 (최대 5MB, 1000행)"
+// @Param		dry_run	query	bool	false	"true면 검증만 하고 저장하지 않음"
+// @Success		200	{object}	util.APIResponse{data=dto.ImportResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/imports/stops [post]
+func (h *ImportHandler) ImportStops(c *gin.Context) {
+	h.handle(c, h.importService.ImportStops)
+}
+
+// handle - 업로드 파일을 읽어 가져오기 실행
+func (h *ImportHandler) handle(c *gin.Context, run func(ctx context.Context, filename string, data []byte, dryRun bool) (*dto.ImportResponse, error)) {
+	var query dto.ImportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		_ = c.Error(importFileError("파일을 첨부해 주세요 (multipart/form-data의 file 필드)"))
+		return
+	}
+	if header.Size > maxImportFileSize {
+		_ = c.Error(importFileError("파일은 최대 %dMB까지 올릴 수 있습니다", maxImportFileSize>>20))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		_ = c.Error(util.NewInternalError(err))
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize))
+	if err != nil {
+		_ = c.Error(util.NewInternalError(err))
+		return
+	}
+
+	result, err := run(c.Request.Context(), header.Filename, data, query.DryRun)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), result)
+}
+
+// importFileError - file 필드 Validation 에러
+func importFileError(message string, args ...interface{}) *util.AppError {
+	return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{"file": util.Text(message, args...)})
+}
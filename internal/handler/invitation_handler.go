@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 기관 직원 초대 핸들러
+// 🎯 실무 포인트: 초대/목록/취소는 관리자가 자기 기관에 대해서만, 수락은 인증 없이 초대 토큰으로
+// ⚠️ 주의사항: 수락 API는 무차별 대입 방지를 위해 로그인과 같은 요청 제한 적용 (라우터에서 authLimit)
+
+// InvitationHandler - 초대 핸들러
+type InvitationHandler struct {
+	invitationService *service.InvitationService
+}
+
+// NewInvitationHandler - 초대 핸들러 생성
+func NewInvitationHandler(invitationService *service.InvitationService) *InvitationHandler {
+	return &InvitationHandler{invitationService: invitationService}
+}
+
+// Invite - 직원 초대
+// @Summary		직원 초대
+// @Description	이메일 또는 연락처로 초대 코드를 발송합니다. 발송에 실패하면 delivered가 false입니다
+// @Tags		Organization
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.InviteMemberRequest	true	"초대 정보"
+// @Success		201	{object}	util.APIResponse{data=dto.InvitationResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"이미 기관에 등록된 이메일"
+// @Router		/organization/invitations [post]
+func (h *InvitationHandler) Invite(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.InviteMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	invitation, err := h.invitationService.Invite(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "초대"), invitation)
+}
+
+// List - 수락 대기 중인 초대 목록
+// @Summary		대기 중인 초대 목록
+// @Tags		Organization
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=[]dto.InvitationResponse}
+// @Router		/organization/invitations [get]
+func (h *InvitationHandler) List(c *gin.Context) {
+	invitations, err := h.invitationService.ListPending(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), invitations)
+}
+
+// Revoke - 초대 취소
+// @Summary		초대 취소
+// @Tags		Organization
+// @Produce		json
+// @Param		id	path	string	true	"초대 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse	"없거나 이미 수락/취소/만료된 초대"
+// @Router		/organization/invitations/{id} [delete]
+func (h *InvitationHandler) Revoke(c *gin.Context) {
+	if err := h.invitationService.Revoke(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "초대"), nil)
+}
+
+// Accept - 초대 수락 (계정 생성)
+// @Summary		초대 수락
+// @Description	초대 코드와 비밀번호로 초대한 기관 소속 계정을 만듭니다. 연락처로 받은 초대는 email이 필요합니다
+// @Tags		Organization
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.AcceptInvitationRequest	true	"초대 코드와 계정 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse	"유효하지 않거나 만료된 초대"
+// @Failure		409	{object}	util.APIResponse	"이메일 중복"
+// @Router		/invitations/accept [post]
+func (h *InvitationHandler) Accept(c *gin.Context) {
+	var req dto.AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	user, err := h.invitationService.Accept(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "계정"), user)
+}
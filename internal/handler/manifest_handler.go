@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 탑승 명단 핸들러 (정류장별 승차/하차 예정 탑승자)
+// 🎯 실무 포인트: 기사/동승자 앱의 기본 화면, 보호자 연락처와 의료 특이사항을 정류장 단위로 확인
+// ⚠️ 주의사항: 관리자와 배정 기사/동승자만 조회 (Service에서 운행별 검증)
+
+// ManifestHandler - 운행 탑승 명단 핸들러
+type ManifestHandler struct {
+	manifestService *service.ManifestService
+}
+
+// NewManifestHandler - 운행 탑승 명단 핸들러 생성
+func NewManifestHandler(manifestService *service.ManifestService) *ManifestHandler {
+	return &ManifestHandler{manifestService: manifestService}
+}
+
+// Get - 운행 탑승 명단 조회
+// @Summary		운행 탑승 명단
+// @Description	정류장 순서대로 승차/하차 예정 탑승자, 보호자 연락처, 의료 특이사항 여부를 반환합니다. 오전 일정은 배정 정류장에서 승차해 마지막 정류장에서 하차하고, 오후/저녁 일정은 첫 정류장에서 승차해 배정 정류장에서 하차합니다
+// @Tags		Trip
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse{data=dto.TripManifestResponse}
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/trips/{id}/manifest [get]
+func (h *ManifestHandler) Get(c *gin.Context) {
+	manifest, err := h.manifestService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), manifest)
+}
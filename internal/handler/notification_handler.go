@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 보호자 알림 수신 설정 핸들러 (보호자 본인) + 발송 기록 조회 (관리자)
+// 🎯 실무 포인트: 앱 설정 화면에서 받을 채널(푸시/알림톡/문자)과 알림 종류(승차/하차/접근/지연)를 선택
+// 관리자는 "알림을 못 받았다"는 민원에 보호자별 실제 발송 채널/상태/중계사 응답을 확인
+// ⚠️ 주의사항: 수신 설정은 토큰의 profile_id(보호자 ID) 기준으로만 조회/변경
+
+// NotificationHandler - 알림 핸들러
+type NotificationHandler struct {
+	notificationService *service.NotificationService
+}
+
+// NewNotificationHandler - 알림 핸들러 생성
+func NewNotificationHandler(notificationService *service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notificationService: notificationService}
+}
+
+// MyPreference - 내 알림 수신 설정 조회
+// @Summary		내 알림 수신 설정 조회
+// @Description	설정한 적이 없으면 모든 채널/모든 알림을 받는 기본값을 반환합니다
+// @Tags		Notification
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=domain.NotificationPreference}
+// @Failure		403	{object}	util.APIResponse
+// @Router		/me/notification-preferences [get]
+func (h *NotificationHandler) MyPreference(c *gin.Context) {
+	principal, ok := auth.FromContext(c.Request.Context())
+	if !ok || principal.ProfileID == "" {
+		_ = c.Error(util.NewForbiddenError())
+		return
+	}
+
+	preference, err := h.notificationService.GetPreference(c.Request.Context(), principal.ProfileID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), preference)
+}
+
+// UpdateMyPreference - 내 알림 수신 설정 변경
+// @Summary		내 알림 수신 설정 변경
+// @Description	받을 채널과 알림 종류 목록을 통째로 교체합니다. channels를 빈 배열로 보내면 모든 알림을 끕니다
+// @Tags		Notification
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.UpdateNotificationPreferenceRequest	true	"수신 설정"
+// @Success		200	{object}	util.APIResponse{data=domain.NotificationPreference}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/me/notification-preferences [put]
+func (h *NotificationHandler) UpdateMyPreference(c *gin.Context) {
+	principal, ok := auth.FromContext(c.Request.Context())
+	if !ok || principal.ProfileID == "" {
+		_ = c.Error(util.NewForbiddenError())
+		return
+	}
+
+	var req dto.UpdateNotificationPreferenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	preference, err := h.notificationService.UpdatePreference(c.Request.Context(), principal.ProfileID, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "알림 설정"), preference)
+}
+
+// List - 알림 발송 기록 조회
+// @Summary		알림 발송 기록 조회
+// @Description	최신순 정렬, 보호자/상태/실제 발송 채널/알림 종류로 필터링. retrying은 재시도 대기, dead는 최대 시도 횟수 초과
+// @Tags		Notification
+// @Produce		json
+// @Param		guardian_id	query	string	false	"보호자 ID"
+// @Param		status		query	string	false	"상태 (sent, retrying, failed, dead)"
+// @Param		channel		query	string	false	"발송 채널 (push, alimtalk, sms)"
+// @Param		event		query	string	false	"알림 종류 (boarded, alighted, approaching, delay, assignment)"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/notifications [get]
+func (h *NotificationHandler) List(c *gin.Context) {
+	var query dto.ListNotificationLogQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.NotificationLogListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.NotificationLogFilter{
+		GuardianID:  query.GuardianID,
+		Status:      domain.NotificationStatus(query.Status),
+		Channel:     domain.NotificationChannel(query.Channel),
+		Event:       domain.NotificationEvent(query.Event),
+		ListOptions: params.options,
+	}
+
+	logs, total, err := h.notificationService.ListLogs(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), logs, params.meta(total))
+}
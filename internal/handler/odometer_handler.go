@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 차량 주행거리계 핸들러 (관리자 입력 /vehicles/:id/odometer, 변경 이력 /vehicles/:id/odometer-readings)
+// 🎯 실무 포인트: 운행 완료 시 갱신은 POST /trips/:id/complete의 odometer_km → 여기서는 기준값 입력/보정과 이력 조회
+// ⚠️ 주의사항: 관리자 입력은 현재 값보다 작아도 허용 (계기판 교체 등)
+
+// OdometerHandler - 주행거리계 핸들러
+type OdometerHandler struct {
+	odometerService *service.OdometerService
+}
+
+// NewOdometerHandler - 주행거리계 핸들러 생성
+func NewOdometerHandler(odometerService *service.OdometerService) *OdometerHandler {
+	return &OdometerHandler{odometerService: odometerService}
+}
+
+// Update - 주행거리계 입력
+// @Summary		주행거리계 입력
+// @Description	차량 주행거리계 기준값을 입력하거나 보정합니다 (계기판 교체 시 현재 값보다 작아도 됨). 입력 이후 운행 완료 시 기사 확인 값 또는 주행 거리로 갱신됩니다
+// @Tags		Vehicle
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"차량 ID"
+// @Param		request	body	dto.UpdateOdometerRequest	true	"주행거리계"
+// @Success		200	{object}	util.APIResponse{data=domain.Vehicle}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/odometer [put]
+func (h *OdometerHandler) Update(c *gin.Context) {
+	var req dto.UpdateOdometerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	vehicle, err := h.odometerService.SetOdometer(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "주행거리계"), vehicle)
+}
+
+// History - 주행거리계 변경 이력
+// @Summary		주행거리계 변경 이력
+// @Description	운행 완료(trip: 기사 확인, trip_distance: 주행 거리로 계산)와 관리자 입력(manual)으로 바뀐 주행거리계 기록을 최신 순으로 조회합니다
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id		path	string	true	"차량 ID"
+// @Param		from	query	string	false	"시작일 (YYYY-MM-DD, 기관 시간대)"
+// @Param		to		query	string	false	"종료일 (YYYY-MM-DD, 포함)"
+// @Success		200	{object}	util.APIResponse{data=[]domain.OdometerReading}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/odometer-readings [get]
+func (h *OdometerHandler) History(c *gin.Context) {
+	var query dto.OdometerReadingQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	readings, err := h.odometerService.History(c.Request.Context(), c.Param("id"), &query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), readings)
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 기관 핸들러 (로그인 사용자의 소속 기관)
+// 🎯 실무 포인트: 경로에 기관 ID를 받지 않음 → 토큰의 소속 기관만 조회/수정
+// 화면 표시/웹 앱 설정(/org/settings)은 보호자 앱도 읽음 → 인증된 사용자 모두 조회 가능
+// ⚠️ 주의사항: 수정은 관리자만 허용 (라우터에서 RequireRole)
+
+// OrganizationHandler - 기관 핸들러
+type OrganizationHandler struct {
+	organizationService *service.OrganizationService
+}
+
+// NewOrganizationHandler - 기관 핸들러 생성
+func NewOrganizationHandler(organizationService *service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{organizationService: organizationService}
+}
+
+// Get - 소속 기관 조회
+// @Summary		소속 기관 조회
+// @Tags		Organization
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=domain.Organization}
+// @Failure		401	{object}	util.APIResponse
+// @Router		/organization [get]
+func (h *OrganizationHandler) Get(c *gin.Context) {
+	organization, err := h.organizationService.Current(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), organization)
+}
+
+// Update - 소속 기관 정보 수정
+// @Summary		소속 기관 정보 수정
+// @Description	보낸 필드만 변경합니다. 빈 phone/address는 값을 삭제합니다
+// @Tags		Organization
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.UpdateOrganizationRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse{data=domain.Organization}
+// @Failure		400	{object}	util.APIResponse
+// @Router		/organization [put]
+func (h *OrganizationHandler) Update(c *gin.Context) {
+	var req dto.UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	organization, err := h.organizationService.Update(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "기관"), organization)
+}
+
+// Settings - 소속 기관의 화면 표시/웹 앱 설정 조회
+// @Summary		기관 설정 조회
+// @Description	앱에 표시할 기관 이름/로고와 웹 앱 허용 Origin을 조회합니다
+// @Tags		Organization
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=dto.OrganizationSettingsResponse}
+// @Failure		401	{object}	util.APIResponse
+// @Router		/org/settings [get]
+func (h *OrganizationHandler) Settings(c *gin.Context) {
+	settings, err := h.organizationService.Settings(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), settings)
+}
+
+// UpdateSettings - 소속 기관의 화면 표시/웹 앱 설정 수정
+// @Summary		기관 설정 수정
+// @Description	보낸 필드만 변경합니다. 빈 display_name/logo_url은 값을 삭제하고, allowed_origins는 목록 전체를 교체합니다
+// @Tags		Organization
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.UpdateOrganizationSettingsRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse{data=dto.OrganizationSettingsResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Router		/org/settings [put]
+func (h *OrganizationHandler) UpdateSettings(c *gin.Context) {
+	var req dto.UpdateOrganizationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	settings, err := h.organizationService.UpdateSettings(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "기관 설정"), settings)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 탑승자 결석 사전 신고 핸들러 (보호자 앱 "내일 아침 안 타요")
+// 🎯 실무 포인트: 탑승자 하위 리소스 (/passengers/:id/absences), 신고된 탑승자는 운행에서 불참 대신 결석 신고로 표시
+// ⚠️ 주의사항: 관리자 또는 연결된 보호자만 (Service에서 탑승자별 검증)
+
+// PassengerAbsenceHandler - 결석 신고 핸들러
+type PassengerAbsenceHandler struct {
+	absenceService *service.PassengerAbsenceService
+}
+
+// NewPassengerAbsenceHandler - 결석 신고 핸들러 생성
+func NewPassengerAbsenceHandler(absenceService *service.PassengerAbsenceService) *PassengerAbsenceHandler {
+	return &PassengerAbsenceHandler{absenceService: absenceService}
+}
+
+// List - 오늘 이후 결석 신고 목록
+// @Summary		결석 신고 목록
+// @Tags		Passenger
+// @Produce		json
+// @Param		id	path	string	true	"탑승자 ID"
+// @Success		200	{object}	util.APIResponse{data=[]domain.PassengerAbsence}
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/absences [get]
+func (h *PassengerAbsenceHandler) List(c *gin.Context) {
+	absences, err := h.absenceService.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), absences)
+}
+
+// Create - 결석 신고
+// @Summary		결석 신고
+// @Description	날짜(와 시간대)에 탑승하지 않음을 미리 알립니다. 시간대를 생략하면 하루 종일 결석이며, 해당 운행의 탑승 기록은 불참 대신 결석 신고로 표시됩니다. 지난 날짜는 400, 같은 날짜에 시간대가 겹치는 신고가 있으면 409를 반환합니다
+// @Tags		Passenger
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string								true	"탑승자 ID"
+// @Param		request	body	dto.CreatePassengerAbsenceRequest	true	"결석 신고"
+// @Success		201	{object}	util.APIResponse{data=domain.PassengerAbsence}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/passengers/{id}/absences [post]
+func (h *PassengerAbsenceHandler) Create(c *gin.Context) {
+	var req dto.CreatePassengerAbsenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	absence, err := h.absenceService.Create(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "결석 신고"), absence)
+}
+
+// Delete - 결석 신고 취소
+// @Summary		결석 신고 취소
+// @Tags		Passenger
+// @Produce		json
+// @Param		id			path	string	true	"탑승자 ID"
+// @Param		absenceId	path	string	true	"결석 신고 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/passengers/{id}/absences/{absenceId} [delete]
+func (h *PassengerAbsenceHandler) Delete(c *gin.Context) {
+	if err := h.absenceService.Delete(c.Request.Context(), c.Param("id"), c.Param("absenceId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "결석 신고"))
+}
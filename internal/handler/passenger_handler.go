@@ -0,0 +1,279 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 탑승자 관리 핸들러
+// 🎯 실무 포인트: 정류장 배정과 보호자 정보는 하위 리소스로 분리
+// ⚠️ 주의사항: 응답에 의료/연락처 정보 포함 → 권한 처리 필요 (추후 인증 미들웨어)
+
+// PassengerHandler - 탑승자 핸들러
+type PassengerHandler struct {
+	passengerService *service.PassengerService
+}
+
+// NewPassengerHandler - 탑승자 핸들러 생성
+func NewPassengerHandler(passengerService *service.PassengerService) *PassengerHandler {
+	return &PassengerHandler{passengerService: passengerService}
+}
+
+// List - 탑승자 목록 조회
+// @Summary		탑승자 목록 조회
+// @Tags		Passenger
+// @Produce		json
+// @Param		status		query	string	false	"상태 (active, inactive)"
+// @Param		route_id	query	string	false	"배정된 경로 ID"
+// @Param		stop_id		query	string	false	"배정된 정류장 ID"
+// @Param		name		query	string	false	"이름 검색"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Param		include_deleted	query	bool	false	"삭제된 탑승자 포함 (관리자만)"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/passengers [get]
+func (h *PassengerHandler) List(c *gin.Context) {
+	var query dto.ListPassengerQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if !allowIncludeDeleted(c, query.IncludeDeleted) {
+		return
+	}
+
+	params, err := parseListParams(c, repository.PassengerListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.PassengerFilter{
+		Status:         domain.PassengerStatus(query.Status),
+		RouteID:        query.RouteID,
+		StopID:         query.StopID,
+		Name:           query.Name,
+		IncludeDeleted: query.IncludeDeleted,
+		ListOptions:    params.options,
+	}
+
+	passengers, total, err := h.passengerService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), passengers, params.meta(total))
+}
+
+// Search - 탑승자 검색 (아이 이름, 보호자 이름, 보호자 연락처)
+// @Summary		탑승자 검색
+// @Description	전화 문의 응대용으로 이름은 부분 일치, 보호자 연락처는 전체 번호로 찾습니다 (연결된 보호자 계정 포함)
+// @Tags		Passenger
+// @Produce		json
+// @Param		q		query	string	true	"검색어 (2자 이상)"
+// @Param		limit	query	int		false	"최대 결과 수 (기본 20, 최대 50)"
+// @Success		200	{object}	util.APIResponse{data=[]domain.Passenger}
+// @Failure		400	{object}	util.APIResponse
+// @Router		/passengers/search [get]
+func (h *PassengerHandler) Search(c *gin.Context) {
+	var query dto.SearchPassengerQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	passengers, err := h.passengerService.Search(c.Request.Context(), query.Q, query.Limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), passengers)
+}
+
+// Get - 탑승자 단건 조회
+// @Summary		탑승자 조회
+// @Tags		Passenger
+// @Produce		json
+// @Param		id	path	string	true	"탑승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id} [get]
+func (h *PassengerHandler) Get(c *gin.Context) {
+	passenger, err := h.passengerService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), passenger)
+}
+
+// Create - 탑승자 등록
+// @Summary		탑승자 등록
+// @Description	route_id와 stop_id를 함께 전달하면 등록과 동시에 정류장에 배정합니다
+// @Tags		Passenger
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreatePassengerRequest	true	"탑승자 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Router		/passengers [post]
+func (h *PassengerHandler) Create(c *gin.Context) {
+	var req dto.CreatePassengerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	passenger, err := h.passengerService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "탑승자"), passenger)
+}
+
+// Update - 탑승자 기본 정보 수정
+// @Summary		탑승자 정보 수정
+// @Tags		Passenger
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"탑승자 ID"
+// @Param		request	body	dto.UpdatePassengerRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id} [put]
+func (h *PassengerHandler) Update(c *gin.Context) {
+	var req dto.UpdatePassengerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	passenger, err := h.passengerService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승자"), passenger)
+}
+
+// AssignToStop - 정류장 배정
+// @Summary		정류장 배정
+// @Description	정류장이 지정한 경로에 속하지 않으면 400(stop_id)을 반환합니다
+// @Tags		Passenger
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"탑승자 ID"
+// @Param		request	body	dto.AssignStopRequest	true	"경로/정류장 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/stop [put]
+func (h *PassengerHandler) AssignToStop(c *gin.Context) {
+	var req dto.AssignStopRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	passenger, err := h.passengerService.AssignToStop(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "정류장 배정"), passenger)
+}
+
+// UnassignFromStop - 정류장 배정 해제
+// @Summary		정류장 배정 해제
+// @Tags		Passenger
+// @Produce		json
+// @Param		id	path	string	true	"탑승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/stop [delete]
+func (h *PassengerHandler) UnassignFromStop(c *gin.Context) {
+	passenger, err := h.passengerService.UnassignFromStop(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "정류장 배정"), passenger)
+}
+
+// UpdateGuardian - 보호자 정보 수정
+// @Summary		보호자 정보 수정
+// @Tags		Passenger
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"탑승자 ID"
+// @Param		request	body	dto.UpdateGuardianRequest	true	"보호자/비상 연락처"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/guardian [put]
+func (h *PassengerHandler) UpdateGuardian(c *gin.Context) {
+	var req dto.UpdateGuardianRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	passenger, err := h.passengerService.UpdateGuardian(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "보호자 정보"), passenger)
+}
+
+// Delete - 탑승자 삭제
+// @Summary		탑승자 삭제
+// @Tags		Passenger
+// @Produce		json
+// @Param		id	path	string	true	"탑승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id} [delete]
+func (h *PassengerHandler) Delete(c *gin.Context) {
+	if err := h.passengerService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "탑승자"))
+}
+
+// Restore - 삭제된 탑승자 복구
+// @Summary		탑승자 복구
+// @Tags		Passenger
+// @Produce		json
+// @Param		id	path	string	true	"탑승자 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.Passenger}
+// @Failure		404	{object}	util.APIResponse	"삭제된 탑승자 없음"
+// @Failure		409	{object}	util.APIResponse
+// @Router		/passengers/{id}/restore [post]
+func (h *PassengerHandler) Restore(c *gin.Context) {
+	restored, err := h.passengerService.Restore(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgRestored, "탑승자"), restored)
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 탑승자 사진/사진 동의 핸들러 (탑승자 하위 리소스 /passengers/:id/photo, /passengers/:id/photo-consent)
+// 🎯 실무 포인트: 보호자가 앱에서 동의 후 사진을 올리면 대체 기사/동승자가 탑승 확인 때 아이를 알아볼 수 있음
+// ⚠️ 주의사항: 동의가 없으면 사진 등록/조회 모두 403, 보호자는 연결된 자녀만 (서비스가 확인)
+// 파일 저장소가 설정된 경우에만 등록
+
+// PassengerPhotoHandler - 탑승자 사진 핸들러
+type PassengerPhotoHandler struct {
+	photoService *service.PassengerPhotoService
+}
+
+// NewPassengerPhotoHandler - 탑승자 사진 핸들러 생성
+func NewPassengerPhotoHandler(photoService *service.PassengerPhotoService) *PassengerPhotoHandler {
+	return &PassengerPhotoHandler{photoService: photoService}
+}
+
+// UpdateConsent - 사진 동의/철회
+// @Summary		탑승자 사진 동의/철회
+// @Description	보호자의 사진 동의를 기록합니다 (동의 시각과 기록한 사용자 저장). 관리자는 서면 동의를 받은 경우 대신 기록할 수 있습니다. 철회하면 등록된 사진이 삭제됩니다
+// @Tags		Passenger
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string							true	"탑승자 ID"
+// @Param		request	body	dto.UpdatePhotoConsentRequest	true	"동의 여부"
+// @Success		200	{object}	util.APIResponse{data=domain.Passenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/photo-consent [put]
+func (h *PassengerPhotoHandler) UpdateConsent(c *gin.Context) {
+	var req dto.UpdatePhotoConsentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	passenger, err := h.photoService.SetConsent(c.Request.Context(), c.Param("id"), *req.Consent)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "사진 동의"), passenger)
+}
+
+// UploadPhoto - 사진 등록
+// @Summary		탑승자 사진 등록
+// @Description	탑승자 사진(JPEG, PNG, 최대 5MB)을 올립니다. 보호자의 사진 동의가 없으면 403이며, 이전 사진은 삭제됩니다
+// @Tags		Passenger
+// @Accept		multipart/form-data
+// @Produce		json
+// @Param		id		path		string	true	"탑승자 ID"
+// @Param		file	formData	file	true	"사진 (JPEG, PNG)"
+// @Success		200	{object}	util.APIResponse{data=domain.Passenger}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse	"권한 없음 또는 사진 동의 없음"
+// @Failure		404	{object}	util.APIResponse
+// @Failure		502	{object}	util.APIResponse	"파일 저장소 오류"
+// @Router		/passengers/{id}/photo [put]
+func (h *PassengerPhotoHandler) UploadPhoto(c *gin.Context) {
+	fileName, data, err := readUploadFile(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	passenger, err := h.photoService.UploadPhoto(c.Request.Context(), c.Param("id"), fileName, data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "탑승자 사진"), passenger)
+}
+
+// GetPhoto - 사진 조회
+// @Summary		탑승자 사진 조회
+// @Description	관리자, 기사, 동승자와 연결된 보호자가 조회할 수 있습니다. 사진 동의가 없으면 403입니다
+// @Tags		Passenger
+// @Produce		image/jpeg,image/png
+// @Param		id	path	string	true	"탑승자 ID"
+// @Success		200	{file}		binary
+// @Failure		403	{object}	util.APIResponse	"권한 없음 또는 사진 동의 없음"
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/photo [get]
+func (h *PassengerPhotoHandler) GetPhoto(c *gin.Context) {
+	file, data, err := h.photoService.Photo(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	sendFile(c, file, data)
+}
+
+// DeletePhoto - 사진 삭제
+// @Summary		탑승자 사진 삭제
+// @Description	사진만 삭제하고 사진 동의는 그대로 둡니다
+// @Tags		Passenger
+// @Produce		json
+// @Param		id	path	string	true	"탑승자 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/passengers/{id}/photo [delete]
+func (h *PassengerPhotoHandler) DeletePhoto(c *gin.Context) {
+	if err := h.photoService.DeletePhoto(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "탑승자 사진"))
+}
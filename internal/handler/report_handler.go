@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 보고서 핸들러 (출결 기록 Excel, 차량 운행일지 PDF 다운로드, 기사별 근무 합계, 차량 가동률, 정시성 통계)
+// 🎯 실무 포인트: 내보내기는 응답 본문이 파일 (Content-Disposition: attachment), 에러는 다른 API와 같은 JSON
+// ⚠️ 주의사항: 탑승자/기사 이름이 포함되므로 관리자 전용
+
+// 내보내기 파일 MIME 타입
+const (
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	pdfContentType  = "application/pdf"
+)
+
+// ReportHandler - 보고서 핸들러
+type ReportHandler struct {
+	reportService *service.ReportService
+}
+
+// NewReportHandler - 보고서 핸들러 생성
+func NewReportHandler(reportService *service.ReportService) *ReportHandler {
+	return &ReportHandler{reportService: reportService}
+}
+
+// ExportAttendance - 출결 기록 Excel 내보내기
+// @Summary		출결 기록 Excel 내보내기
+// @Description	기간의 운행마다 탑승자 한 명당 한 행(운행 날짜, 일정, 경로, 차량, 기사, 탑승자, 정류장, 출결, 승차/하차 시각, 불참·결석 사유)을 .xlsx 파일로 내려받습니다. 취소된 운행은 제외하고, 시각은 KST이며 기간은 최대 366일입니다
+// @Tags		Report
+// @Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param		from		query	string	true	"시작일 (YYYY-MM-DD)"
+// @Param		to			query	string	true	"종료일 (YYYY-MM-DD, 포함)"
+// @Param		route_id	query	string	false	"경로 ID (비우면 전체)"
+// @Success		200	{file}	file
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/reports/attendance/export [get]
+func (h *ReportHandler) ExportAttendance(c *gin.Context) {
+	var query dto.ExportAttendanceQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	from, _ := time.Parse(time.DateOnly, query.From) // 바인딩에서 형식 검증 완료
+	to, _ := time.Parse(time.DateOnly, query.To)
+
+	data, err := h.reportService.ExportAttendance(c.Request.Context(), from, to, query.RouteID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="attendance_%s_%s.xlsx"`, query.From, query.To))
+	c.Data(http.StatusOK, xlsxContentType, data)
+}
+
+// ExportDrivingLog - 차량 운행일지 PDF 내보내기
+// @Summary		차량 운행일지 PDF 내보내기
+// @Description	차량 한 대의 기간 운행마다 한 행(운행 날짜, 일정, 기사, 상태, 출발/도착 시각, 운행 시간, 주행 거리, 배정/승차/불참/결석 인원)을 .pdf 파일로 내려받습니다. 취소된 운행은 제외하고, 시각은 KST이며 기간은 최대 366일입니다. 삭제된 차량도 출력할 수 있습니다
+// @Tags		Report
+// @Produce		application/pdf
+// @Param		vehicle_id	query	string	true	"차량 ID"
+// @Param		from		query	string	true	"시작일 (YYYY-MM-DD)"
+// @Param		to			query	string	true	"종료일 (YYYY-MM-DD, 포함)"
+// @Success		200	{file}	file
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/reports/driving-log/export [get]
+func (h *ReportHandler) ExportDrivingLog(c *gin.Context) {
+	var query dto.ExportDrivingLogQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	from, _ := time.Parse(time.DateOnly, query.From) // 바인딩에서 형식 검증 완료
+	to, _ := time.Parse(time.DateOnly, query.To)
+
+	data, err := h.reportService.ExportDrivingLog(c.Request.Context(), query.VehicleID, from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="driving_log_%s_%s.pdf"`, query.From, query.To))
+	c.Data(http.StatusOK, pdfContentType, data)
+}
+
+// DriverSummary - 기사별 근무 보고서
+// @Summary		기사별 근무 보고서
+// @Description	기간의 기사별 배정 운행 수, 완료 운행 수, 운행 시간(완료한 운행의 출발~완료, 분), 주행 거리(미터), 운행한 날 수, 일정의 기본 기사 대신 운행한 날 수를 조회합니다. 취소된 운행은 제외하고, 운행이 없는 기사도 0으로 포함하며 기간은 최대 366일입니다
+// @Tags		Report
+// @Produce		json
+// @Param		from	query	string	true	"시작일 (YYYY-MM-DD)"
+// @Param		to		query	string	true	"종료일 (YYYY-MM-DD, 포함)"
+// @Success		200	{object}	util.APIResponse{data=dto.DriverReportResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/reports/drivers [get]
+func (h *ReportHandler) DriverSummary(c *gin.Context) {
+	var query dto.DriverReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	from, _ := time.Parse(time.DateOnly, query.From) // 바인딩에서 형식 검증 완료
+	to, _ := time.Parse(time.DateOnly, query.To)
+
+	report, err := h.reportService.DriverSummary(c.Request.Context(), from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), report)
+}
+
+// VehicleSummary - 차량 가동률 보고서
+// @Summary		차량 가동률 보고서
+// @Description	기간의 차량별 배정 운행 수, 주행 거리(km), 운행한 날 수, 미운행 일수, 가동률(%)을 조회합니다. 가동률은 어느 차량이든 운행이 있었던 날(service_days) 대비 그 차량이 운행한 날의 비율입니다. 취소된 운행은 제외하고, 운행이 없는 차량도 포함하며 기간은 최대 366일입니다
+// @Tags		Report
+// @Produce		json
+// @Param		from	query	string	true	"시작일 (YYYY-MM-DD)"
+// @Param		to		query	string	true	"종료일 (YYYY-MM-DD, 포함)"
+// @Success		200	{object}	util.APIResponse{data=dto.VehicleReportResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/reports/vehicles [get]
+func (h *ReportHandler) VehicleSummary(c *gin.Context) {
+	var query dto.VehicleReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	from, _ := time.Parse(time.DateOnly, query.From) // 바인딩에서 형식 검증 완료
+	to, _ := time.Parse(time.DateOnly, query.To)
+
+	report, err := h.reportService.VehicleSummary(c.Request.Context(), from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), report)
+}
+
+// Punctuality - 정시성 통계 보고서
+// @Summary		정시성 통계 보고서
+// @Description	기간에 출발한 운행의 출발 지연(실제 출발 - 일정 출발 시각, 분)과 정류장 도착 지연(도착 기록 - 예상 도착 시각, 분)을 전체, 경로별, 기사별로 집계해 정시율(%)과 백분위(p50, p90, p95, 최대)를 조회합니다. 5분 이내 지연은 정시로 보고, 취소되었거나 출발하지 않은 운행은 제외하며 기간은 최대 366일입니다
+// @Tags		Report
+// @Produce		json
+// @Param		from	query	string	true	"시작일 (YYYY-MM-DD)"
+// @Param		to		query	string	true	"종료일 (YYYY-MM-DD, 포함)"
+// @Success		200	{object}	util.APIResponse{data=dto.PunctualityReportResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/reports/punctuality [get]
+func (h *ReportHandler) Punctuality(c *gin.Context) {
+	var query dto.PunctualityReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	from, _ := time.Parse(time.DateOnly, query.From) // 바인딩에서 형식 검증 완료
+	to, _ := time.Parse(time.DateOnly, query.To)
+
+	report, err := h.reportService.Punctuality(c.Request.Context(), from, to)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), report)
+}
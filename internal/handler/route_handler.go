@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 경로/정류장 관리 핸들러
+// 🎯 실무 포인트: 정류장은 /routes/:id/stops 하위 리소스로 관리
+// ⚠️ 주의사항: 정류장 순서 변경은 단건 이동(PUT stops/:stopId)과 전체 재배치(PUT stops/reorder) 두 가지 제공
+
+// RouteHandler - 경로 핸들러
+type RouteHandler struct {
+	routeService *service.RouteService
+}
+
+// NewRouteHandler - 경로 핸들러 생성
+func NewRouteHandler(routeService *service.RouteService) *RouteHandler {
+	return &RouteHandler{routeService: routeService}
+}
+
+// List - 경로 목록 조회
+// @Summary		경로 목록 조회
+// @Tags		Route
+// @Produce		json
+// @Param		status		query	string	false	"상태 (active, inactive)"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Param		include_deleted	query	bool	false	"삭제된 경로 포함 (관리자만)"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/routes [get]
+func (h *RouteHandler) List(c *gin.Context) {
+	var query dto.ListRouteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if !allowIncludeDeleted(c, query.IncludeDeleted) {
+		return
+	}
+
+	params, err := parseListParams(c, repository.RouteListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.RouteFilter{
+		Status:         domain.RouteStatus(query.Status),
+		IncludeDeleted: query.IncludeDeleted,
+		ListOptions:    params.options,
+	}
+
+	routes, total, err := h.routeService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), routes, params.meta(total))
+}
+
+// Get - 경로 단건 조회 (정류장 포함)
+// @Summary		경로 조회
+// @Tags		Route
+// @Produce		json
+// @Param		id	path	string	true	"경로 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/routes/{id} [get]
+func (h *RouteHandler) Get(c *gin.Context) {
+	route, err := h.routeService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), route)
+}
+
+// Create - 경로 생성
+// @Summary		경로 생성
+// @Description	정류장 배열을 함께 전달하면 배열 순서대로 정류장이 등록됩니다
+// @Tags		Route
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateRouteRequest	true	"경로 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Router		/routes [post]
+func (h *RouteHandler) Create(c *gin.Context) {
+	var req dto.CreateRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	route, err := h.routeService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "경로"), route)
+}
+
+// Update - 경로 정보 수정
+// @Summary		경로 수정
+// @Tags		Route
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"경로 ID"
+// @Param		request	body	dto.UpdateRouteRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/routes/{id} [put]
+func (h *RouteHandler) Update(c *gin.Context) {
+	var req dto.UpdateRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	route, err := h.routeService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "경로"), route)
+}
+
+// Delete - 경로 삭제
+// @Summary		경로 삭제
+// @Tags		Route
+// @Produce		json
+// @Param		id	path	string	true	"경로 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/routes/{id} [delete]
+func (h *RouteHandler) Delete(c *gin.Context) {
+	if err := h.routeService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "경로"))
+}
+
+// Restore - 삭제된 경로 복구
+// @Summary		경로 복구
+// @Tags		Route
+// @Produce		json
+// @Param		id	path	string	true	"경로 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.Route}
+// @Failure		404	{object}	util.APIResponse	"삭제된 경로 없음"
+// @Failure		409	{object}	util.APIResponse
+// @Router		/routes/{id}/restore [post]
+func (h *RouteHandler) Restore(c *gin.Context) {
+	restored, err := h.routeService.Restore(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgRestored, "경로"), restored)
+}
+
+// ListStops - 정류장 목록 조회
+// @Summary		정류장 목록 조회
+// @Tags		Route
+// @Produce		json
+// @Param		id	path	string	true	"경로 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/routes/{id}/stops [get]
+func (h *RouteHandler) ListStops(c *gin.Context) {
+	stops, err := h.routeService.ListStops(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), stops)
+}
+
+// Geometry - 정류장 순서대로 지나는 도로 경로 (지도 API)
+// @Summary		경로 도로 기하 정보 조회
+// @Description	정류장 순서대로 지나는 도로 경로의 폴리라인과 정류장 사이 구간별 거리(미터)/소요 시간(초)을 반환합니다
+// @Tags		Route
+// @Produce		json
+// @Param		id	path	string	true	"경로 ID"
+// @Success		200	{object}	util.APIResponse{data=dto.RouteGeometryResponse}
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Failure		502	{object}	util.APIResponse
+// @Router		/routes/{id}/geometry [get]
+func (h *RouteHandler) Geometry(c *gin.Context) {
+	geometry, err := h.routeService.Geometry(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), geometry)
+}
+
+// Optimize - 정류장 순서 최적화 제안
+// @Summary		정류장 순서 최적화 제안
+// @Description	첫/마지막 정류장을 고정하고 지도 API 거리 행렬로 소요 시간이 가장 짧은 순서를 계산합니다. 저장하지 않으며, 관리자가 확인 후 stop_ids를 순서 재배치 API로 보내 반영합니다
+// @Tags		Route
+// @Produce		json
+// @Param		id	path	string	true	"경로 ID"
+// @Success		200	{object}	util.APIResponse{data=dto.RouteOptimizeResponse}
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Failure		502	{object}	util.APIResponse
+// @Router		/routes/{id}/optimize [post]
+func (h *RouteHandler) Optimize(c *gin.Context) {
+	proposal, err := h.routeService.Optimize(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), proposal)
+}
+
+// AddStop - 정류장 추가
+// @Summary		정류장 추가
+// @Description	order 생략 시 마지막에 추가되고, 지정 시 해당 위치에 삽입됩니다
+// @Tags		Route
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"경로 ID"
+// @Param		request	body	dto.CreateStopRequest	true	"정류장 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/routes/{id}/stops [post]
+func (h *RouteHandler) AddStop(c *gin.Context) {
+	var req dto.CreateStopRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	stop, err := h.routeService.AddStop(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "정류장"), stop)
+}
+
+// UpdateStop - 정류장 수정
+// @Summary		정류장 수정
+// @Description	order 전달 시 해당 위치로 이동하며 사이 정류장 순서가 조정됩니다
+// @Tags		Route
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"경로 ID"
+// @Param		stopId	path	string					true	"정류장 ID"
+// @Param		request	body	dto.UpdateStopRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/routes/{id}/stops/{stopId} [put]
+func (h *RouteHandler) UpdateStop(c *gin.Context) {
+	var req dto.UpdateStopRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	stop, err := h.routeService.UpdateStop(c.Request.Context(), c.Param("id"), c.Param("stopId"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "정류장"), stop)
+}
+
+// RemoveStop - 정류장 삭제
+// @Summary		정류장 삭제
+// @Tags		Route
+// @Produce		json
+// @Param		id		path	string	true	"경로 ID"
+// @Param		stopId	path	string	true	"정류장 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/routes/{id}/stops/{stopId} [delete]
+func (h *RouteHandler) RemoveStop(c *gin.Context) {
+	if err := h.routeService.RemoveStop(c.Request.Context(), c.Param("id"), c.Param("stopId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "정류장"))
+}
+
+// ReorderStops - 정류장 전체 순서 재배치
+// @Summary		정류장 순서 재배치
+// @Tags		Route
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"경로 ID"
+// @Param		request	body	dto.ReorderStopsRequest	true	"새 순서의 정류장 ID 목록"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Router		/routes/{id}/stops/reorder [put]
+func (h *RouteHandler) ReorderStops(c *gin.Context) {
+	var req dto.ReorderStopsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	route, err := h.routeService.ReorderStops(c.Request.Context(), c.Param("id"), req.StopIDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "정류장 순서"), route)
+}
@@ -1,28 +1,120 @@
 package handler
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
 	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/pkg/logger"
 
+	_ "github.com/hyeokjun/eodini/docs" // Swagger 문서 임포트
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
-	_ "github.com/hyeokjun/eodini/docs" // Swagger 문서 임포트
 )
 
 // 📝 설명: API 라우터 설정
 // 🎯 실무 포인트: 버전별 라우팅, 미들웨어 적용
 // ⚠️ 주의사항: 미들웨어 순서 중요 (Recovery -> Logger -> CORS -> ErrorHandler)
+// 리소스 API는 인증 필수, 조회는 운영 인력(관리자/기사/동승자), 변경은 관리자만 허용
+
+// Handlers - 라우터에 등록할 핸들러 모음
+// nil인 핸들러는 라우트를 등록하지 않음 (테스트에서 필요한 것만 주입)
+type Handlers struct {
+	Tokens              *auth.TokenManager             // 토큰 검증기 (nil이면 Bearer 인증 거부)
+	APIKeyAuth          middleware.APIKeyAuthenticator // X-API-Key 검증기 (nil이면 API 키 인증 거부)
+	Sessions            middleware.SessionChecker      // 세션 폐기 확인 (nil이면 확인 생략)
+	LogRedact           *logger.Redactor               // 요청 로그 개인정보 마스킹 규칙 (nil이면 기본 규칙)
+	Limiter             middleware.RateLimiter         // 요청 빈도 제한기 (nil이면 제한 없음)
+	Quotas              middleware.QuotaEnforcer       // 인증 주체별 일일 한도 (nil이면 제한 없음)
+	Idempotency         middleware.IdempotencyStore    // Idempotency-Key 응답 저장소 (nil이면 헤더 무시)
+	IdempotencyTTL      time.Duration                  // 저장한 응답 재사용 기간
+	ServiceName         string                         // 추적 span의 서버 이름 (빈 값이면 defaultServiceName)
+	Settings            *LiveSettings                  // 재시작 없이 바꿀 수 있는 설정 (nil이면 모든 Origin 허용, 제한 규칙 없음)
+	Origins             middleware.OriginChecker       // 기관별 허용 Origin (nil이면 설정의 Origin만 허용)
+	Vehicle             *VehicleHandler
+	Driver              *DriverHandler
+	Route               *RouteHandler
+	Schedule            *ScheduleHandler
+	Passenger           *PassengerHandler
+	Attendant           *AttendantHandler
+	Guardian            *GuardianHandler
+	APIKey              *APIKeyHandler
+	Auth                *AuthHandler
+	User                *UserHandler
+	Trip                *TripHandler
+	AuditLog            *AuditLogHandler
+	Usage               *UsageHandler
+	Tracking            *TrackingHandler
+	ETA                 *ETAHandler
+	Alert               *AlertHandler
+	StopEvent           *StopEventHandler
+	Device              *DeviceHandler
+	Notification        *NotificationHandler
+	Boarding            *BoardingHandler
+	Delay               *DelayHandler
+	Manifest            *ManifestHandler
+	TripGeneration      *TripGenerationHandler
+	Holiday             *HolidayHandler
+	ScheduleException   *ScheduleExceptionHandler
+	DriverAssignment    *DriverAssignmentHandler
+	AttendantAssignment *AttendantAssignmentHandler
+	PassengerAbsence    *PassengerAbsenceHandler
+	TripAssignment      *TripAssignmentHandler
+	Import              *ImportHandler
+	Report              *ReportHandler
+	FuelLog             *FuelLogHandler
+	Odometer            *OdometerHandler
+	VehicleDocument     *VehicleDocumentHandler
+	File                *FileHandler
+	DriverLicense       *DriverLicenseHandler
+	PassengerPhoto      *PassengerPhotoHandler
+	Attendance          *AttendanceHandler
+	Calendar            *CalendarHandler
+	Webhook             *WebhookHandler
+	Organization        *OrganizationHandler
+	Invitation          *InvitationHandler
+}
+
+// RateLimits - 라우트 그룹별 요청 제한 규칙 (Limit 0이면 해당 그룹 제한 없음)
+type RateLimits struct {
+	Auth middleware.RateLimitRule // 로그인/비밀번호 재설정 (무차별 대입 방지)
+	API  middleware.RateLimitRule // 인증이 필요한 리소스 API
+}
+
+// defaultServiceName - 추적 서버 이름 기본값
+const defaultServiceName = "eodini-api"
 
 // SetupRouter - 라우터 설정
-func SetupRouter() *gin.Engine {
+func SetupRouter(h *Handlers) *gin.Engine {
+	if h == nil {
+		h = &Handlers{}
+	}
+	serviceName := h.ServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+
+	settings := h.Settings
+	if settings == nil {
+		settings = NewLiveSettings(Settings{RateLimitEnabled: true, QuotaEnabled: true, IdempotencyEnabled: true})
+	}
+	authLimit := middleware.DynamicRateLimit(h.Limiter, settings.rateLimit(func(r RateLimits) middleware.RateLimitRule { return r.Auth }))
+	apiLimit := middleware.DynamicRateLimit(h.Limiter, settings.rateLimit(func(r RateLimits) middleware.RateLimitRule { return r.API }))
+
 	// Gin 모드 설정은 main에서 환경변수로 처리
 	router := gin.New()
 
 	// 글로벌 미들웨어 적용
-	router.Use(middleware.RecoveryHandler())      // Panic 복구 (최우선)
-	router.Use(middleware.RequestLogger())        // 요청 로깅
-	router.Use(middleware.CORS(middleware.DefaultCORSConfig())) // CORS
-	router.Use(middleware.ErrorHandler())         // 에러 처리 (마지막)
+	logConfig := middleware.RequestLoggerConfig{Redactor: h.LogRedact}
+	router.Use(middleware.RecoveryHandler())                            // Panic 복구 (최우선)
+	router.Use(middleware.Tracing(serviceName))                         // 요청 span (traceparent 이어받기)
+	router.Use(middleware.RequestIDMiddleware())                        // 요청 ID 부여 (감사 로그 추적)
+	router.Use(middleware.RequestLoggerWithConfig(logConfig))           // 요청 로깅 (개인정보 마스킹)
+	router.Use(middleware.DynamicCORS(corsConfig(settings, h.Origins))) // CORS (허용 Origin은 설정 + 기관별 설정)
+	router.Use(middleware.Locale())                                     // 응답 언어 (Accept-Language, 로그인 후 사용자 설정)
+	router.Use(middleware.ErrorHandler())                               // 에러 처리 (마지막)
 
 	// Health Check (미들웨어 제외, 가볍게)
 	healthHandler := NewHealthHandler()
@@ -33,22 +125,437 @@ func SetupRouter() *gin.Engine {
 	// Swagger UI
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// 실시간 위치 구독 (WebSocket, 브라우저는 access_token 쿼리로 인증)
+	if h.Tracking != nil {
+		router.GET("/ws",
+			apiLimit,
+			middleware.TokenFromQuery("access_token"),
+			middleware.Authenticate(h.Tokens, h.APIKeyAuth, h.Sessions),
+			h.Tracking.Watch,
+		)
+	}
+
 	// API v1 그룹
 	v1 := router.Group("/api/v1")
 	{
-		// TODO: Vehicle API
-		// vehicles := v1.Group("/vehicles")
-		// {
-		//     vehicles.GET("", vehicleHandler.List)
-		//     vehicles.GET("/:id", vehicleHandler.Get)
-		//     vehicles.POST("", vehicleHandler.Create)
-		//     vehicles.PUT("/:id", vehicleHandler.Update)
-		//     vehicles.DELETE("/:id", vehicleHandler.Delete)
-		// }
-
-		// TODO: Driver API
-		// TODO: Route API
-		// TODO: Trip API
+		// 로그인/비밀번호 재설정 (인증 불필요)
+		if h.Auth != nil {
+			public := v1.Group("/auth", authLimit)
+			public.POST("/login", h.Auth.Login)
+			public.POST("/password/forgot", h.Auth.ForgotPassword)
+			public.POST("/password/reset", h.Auth.ResetPassword)
+		}
+
+		// 기관 초대 수락 (초대 토큰으로 계정 생성, 인증 불필요)
+		if h.Invitation != nil {
+			v1.POST("/invitations/accept", authLimit, h.Invitation.Accept)
+		}
+
+		// 운행 일정 캘린더 피드 (캘린더 앱은 헤더를 보낼 수 없으므로 주소의 구독 토큰으로 인증)
+		if h.Calendar != nil {
+			v1.GET("/calendar/:token", apiLimit, h.Calendar.Feed)
+		}
+
+		// 인증이 필요한 리소스 API
+		authenticated := v1.Group("",
+			apiLimit,
+			middleware.Authenticate(h.Tokens, h.APIKeyAuth, h.Sessions),
+		)
+
+		// 내 사용량 (일일 한도에 포함하지 않음 → 한도 초과 후에도 조회 가능)
+		if h.Usage != nil {
+			authenticated.GET("/me/usage", h.Usage.Me)
+		}
+
+		// 그 외 리소스 API는 일일 한도 적용, POST 재시도는 Idempotency-Key로 중복 처리 방지
+		api := authenticated.Group("",
+			settings.when(func(s Settings) bool { return s.QuotaEnabled }, middleware.Quota(h.Quotas)),
+			settings.when(func(s Settings) bool { return s.IdempotencyEnabled }, middleware.Idempotency(h.Idempotency, h.IdempotencyTTL)),
+		)
+		staffRoles := []domain.Role{domain.RoleAdmin, domain.RoleDriver, domain.RoleAttendant}
+		staff := middleware.RequireRole(staffRoles...)
+		adminOnly := middleware.RequireRole(domain.RoleAdmin)
+		staffOr := func(scope domain.APIKeyScope) gin.HandlerFunc { // 운영 인력 또는 해당 scope의 API 키
+			return middleware.RequireRoleOrScope(scope, staffRoles...)
+		}
+
+		// Vehicle API
+		if h.Vehicle != nil {
+			vehicles := api.Group("/vehicles")
+			{
+				vehicles.GET("", staffOr(domain.ScopeVehiclesRead), h.Vehicle.List)
+				vehicles.GET("/:id", staffOr(domain.ScopeVehiclesRead), h.Vehicle.Get)
+				vehicles.POST("", adminOnly, h.Vehicle.Create)
+				vehicles.PUT("/:id", adminOnly, h.Vehicle.Update)
+				vehicles.DELETE("/:id", adminOnly, h.Vehicle.Delete)
+				vehicles.POST("/:id/restore", adminOnly, h.Vehicle.Restore)
+			}
+		}
+
+		// 주유 기록 (기사/관리자 등록, 삭제는 관리자) + 연비 보고서
+		if h.FuelLog != nil {
+			api.GET("/vehicles/:id/fuel-logs", staff, h.FuelLog.List)
+			api.POST("/vehicles/:id/fuel-logs", middleware.RequireRole(domain.RoleAdmin, domain.RoleDriver), h.FuelLog.Add)
+			api.DELETE("/vehicles/:id/fuel-logs/:logId", adminOnly, h.FuelLog.Delete)
+			api.GET("/reports/fuel-economy", adminOnly, h.FuelLog.Report)
+		}
+
+		// 주행거리계 (관리자 입력, 변경 이력 → 주행거리 기준 정비 주기)
+		if h.Odometer != nil {
+			api.PUT("/vehicles/:id/odometer", adminOnly, h.Odometer.Update)
+			api.GET("/vehicles/:id/odometer-readings", staff, h.Odometer.History)
+		}
+
+		// 차량 서류 (보험 증권, 정기검사 증명서 → 업로드/삭제는 관리자)
+		if h.VehicleDocument != nil {
+			api.GET("/vehicles/:id/documents", staff, h.VehicleDocument.List)
+			api.POST("/vehicles/:id/documents", adminOnly, h.VehicleDocument.Upload)
+			api.GET("/vehicles/:id/documents/:documentId/file", staff, h.VehicleDocument.Download)
+			api.DELETE("/vehicles/:id/documents/:documentId", adminOnly, h.VehicleDocument.Delete)
+		}
+
+		// 업로드 파일 (용도별 업로드 역할은 서비스가 확인, 조회는 업로드한 사용자와 관리자)
+		if h.File != nil {
+			api.POST("/files", staff, h.File.Upload)
+			api.GET("/files/:id", staff, h.File.Get)
+			api.GET("/files/:id/content", staff, h.File.Download)
+		}
+
+		// 면허증 스캔 (관리자 또는 기사 본인 → 본인 여부는 서비스가 확인)
+		if h.DriverLicense != nil {
+			driverOrAdmin := middleware.RequireRole(domain.RoleAdmin, domain.RoleDriver)
+			api.PUT("/drivers/:id/license/scan", driverOrAdmin, h.DriverLicense.UploadScan)
+			api.GET("/drivers/:id/license/scan", driverOrAdmin, h.DriverLicense.DownloadScan)
+		}
+
+		// Driver API
+		if h.Driver != nil {
+			drivers := api.Group("/drivers")
+			{
+				drivers.GET("", staff, h.Driver.List)
+				drivers.GET("/:id", staff, h.Driver.Get)
+				drivers.POST("", adminOnly, h.Driver.Create)
+				drivers.PUT("/:id", adminOnly, h.Driver.Update)
+				drivers.PUT("/:id/license", adminOnly, h.Driver.UpdateLicense)
+				drivers.PUT("/:id/license/review", adminOnly, h.Driver.ReviewLicense)
+				drivers.PATCH("/:id/status", adminOnly, h.Driver.ChangeStatus)
+				drivers.POST("/:id/terminate", adminOnly, h.Driver.Terminate)
+				drivers.DELETE("/:id", adminOnly, h.Driver.Delete)
+				drivers.POST("/:id/restore", adminOnly, h.Driver.Restore)
+			}
+		}
+
+		// Route API (정류장은 하위 리소스)
+		if h.Route != nil {
+			routes := api.Group("/routes")
+			{
+				routes.GET("", staffOr(domain.ScopeRoutesRead), h.Route.List)
+				routes.GET("/:id", staffOr(domain.ScopeRoutesRead), h.Route.Get)
+				routes.POST("", adminOnly, h.Route.Create)
+				routes.PUT("/:id", adminOnly, h.Route.Update)
+				routes.DELETE("/:id", adminOnly, h.Route.Delete)
+				routes.POST("/:id/restore", adminOnly, h.Route.Restore)
+
+				routes.GET("/:id/geometry", staffOr(domain.ScopeRoutesRead), h.Route.Geometry)
+				routes.POST("/:id/optimize", adminOnly, h.Route.Optimize)
+				routes.GET("/:id/stops", staffOr(domain.ScopeRoutesRead), h.Route.ListStops)
+				routes.POST("/:id/stops", adminOnly, h.Route.AddStop)
+				routes.PUT("/:id/stops/reorder", adminOnly, h.Route.ReorderStops)
+				routes.PUT("/:id/stops/:stopId", adminOnly, h.Route.UpdateStop)
+				routes.DELETE("/:id/stops/:stopId", adminOnly, h.Route.RemoveStop)
+			}
+		}
+
+		// Schedule API
+		if h.Schedule != nil {
+			schedules := api.Group("/schedules")
+			{
+				schedules.GET("", staffOr(domain.ScopeSchedulesRead), h.Schedule.List)
+				schedules.GET("/:id", staffOr(domain.ScopeSchedulesRead), h.Schedule.Get)
+				schedules.POST("", adminOnly, h.Schedule.Create)
+				schedules.PUT("/:id", adminOnly, h.Schedule.Update)
+				schedules.POST("/:id/activate", adminOnly, h.Schedule.Activate)
+				schedules.POST("/:id/deactivate", adminOnly, h.Schedule.Deactivate)
+				schedules.DELETE("/:id", adminOnly, h.Schedule.Delete)
+				schedules.POST("/:id/restore", adminOnly, h.Schedule.Restore)
+			}
+		}
+
+		// 일정 예외 날짜 (하루만 운행 안 함 / 추가 운행, 운행 생성에 반영)
+		if h.ScheduleException != nil {
+			api.GET("/schedules/:id/exceptions", staffOr(domain.ScopeSchedulesRead), h.ScheduleException.List)
+			api.POST("/schedules/:id/exceptions", adminOnly, h.ScheduleException.Add)
+			api.DELETE("/schedules/:id/exceptions/:exceptionId", adminOnly, h.ScheduleException.Delete)
+		}
+
+		if h.DriverAssignment != nil {
+			api.GET("/schedules/:id/driver-assignments", staffOr(domain.ScopeSchedulesRead), h.DriverAssignment.List)
+			api.POST("/schedules/:id/driver-assignments", adminOnly, h.DriverAssignment.Create)
+			api.POST("/schedules/:id/driver-assignments/:assignmentId/approve", adminOnly, h.DriverAssignment.Approve)
+			api.DELETE("/schedules/:id/driver-assignments/:assignmentId", adminOnly, h.DriverAssignment.Delete)
+		}
+
+		if h.AttendantAssignment != nil {
+			api.GET("/schedules/:id/attendant-assignments", staffOr(domain.ScopeSchedulesRead), h.AttendantAssignment.List)
+			api.POST("/schedules/:id/attendant-assignments", adminOnly, h.AttendantAssignment.Create)
+			api.POST("/schedules/:id/attendant-assignments/:assignmentId/approve", adminOnly, h.AttendantAssignment.Approve)
+			api.DELETE("/schedules/:id/attendant-assignments/:assignmentId", adminOnly, h.AttendantAssignment.Delete)
+		}
+
+		// Passenger API
+		if h.Passenger != nil {
+			passengers := api.Group("/passengers")
+			{
+				passengers.GET("", staff, h.Passenger.List)
+				passengers.GET("/search", staff, h.Passenger.Search)
+				passengers.GET("/:id", staff, h.Passenger.Get)
+				passengers.POST("", adminOnly, h.Passenger.Create)
+				passengers.PUT("/:id", adminOnly, h.Passenger.Update)
+				passengers.PUT("/:id/stop", adminOnly, h.Passenger.AssignToStop)
+				passengers.DELETE("/:id/stop", adminOnly, h.Passenger.UnassignFromStop)
+				passengers.PUT("/:id/guardian", adminOnly, h.Passenger.UpdateGuardian)
+				passengers.DELETE("/:id", adminOnly, h.Passenger.Delete)
+				passengers.POST("/:id/restore", adminOnly, h.Passenger.Restore)
+			}
+		}
+
+		// 파일 가져오기 (관리자, CSV/Excel로 탑승자·정류장 일괄 등록)
+		if h.Import != nil {
+			api.POST("/imports/passengers", adminOnly, h.Import.ImportPassengers)
+			api.POST("/imports/stops", adminOnly, h.Import.ImportStops)
+		}
+
+		// 결석 사전 신고 (관리자 또는 연결된 보호자, Service에서 탑승자별 검증)
+		if h.PassengerAbsence != nil {
+			adminOrGuardian := middleware.RequireRole(domain.RoleAdmin, domain.RoleGuardian)
+			api.GET("/passengers/:id/absences", adminOrGuardian, h.PassengerAbsence.List)
+			api.POST("/passengers/:id/absences", adminOrGuardian, h.PassengerAbsence.Create)
+			api.DELETE("/passengers/:id/absences/:absenceId", adminOrGuardian, h.PassengerAbsence.Delete)
+		}
+
+		// 탑승자 사진 (등록/동의는 관리자 또는 연결된 보호자, 조회는 기사/동승자도 → 보호자 연결/동의 여부는 서비스가 확인)
+		if h.PassengerPhoto != nil {
+			photoEditors := middleware.RequireRole(domain.RoleAdmin, domain.RoleGuardian)
+			photoViewers := middleware.RequireRole(domain.RoleAdmin, domain.RoleDriver, domain.RoleAttendant, domain.RoleGuardian)
+			api.PUT("/passengers/:id/photo-consent", photoEditors, h.PassengerPhoto.UpdateConsent)
+			api.PUT("/passengers/:id/photo", photoEditors, h.PassengerPhoto.UploadPhoto)
+			api.GET("/passengers/:id/photo", photoViewers, h.PassengerPhoto.GetPhoto)
+			api.DELETE("/passengers/:id/photo", photoEditors, h.PassengerPhoto.DeletePhoto)
+		}
+
+		// 탑승자 월별 출결 (관리자 또는 연결된 보호자, Service에서 탑승자별 검증)
+		if h.Attendance != nil {
+			api.GET("/passengers/:id/attendance", middleware.RequireRole(domain.RoleAdmin, domain.RoleGuardian), h.Attendance.Monthly)
+		}
+
+		// Attendant API
+		if h.Attendant != nil {
+			attendants := api.Group("/attendants")
+			{
+				attendants.GET("", staff, h.Attendant.List)
+				attendants.GET("/:id", staff, h.Attendant.Get)
+				attendants.POST("", adminOnly, h.Attendant.Create)
+				attendants.PUT("/:id", adminOnly, h.Attendant.Update)
+				attendants.POST("/:id/permissions/start-trip", adminOnly, h.Attendant.GrantStartTripPermission)
+				attendants.DELETE("/:id/permissions/start-trip", adminOnly, h.Attendant.RevokeStartTripPermission)
+				attendants.DELETE("/:id", adminOnly, h.Attendant.Delete)
+			}
+		}
+
+		// Guardian API (자녀 연결은 하위 리소스, 보호자 본인은 /me/children)
+		if h.Guardian != nil {
+			guardians := api.Group("/guardians")
+			{
+				guardians.GET("", adminOnly, h.Guardian.List)
+				guardians.GET("/:id", adminOnly, h.Guardian.Get)
+				guardians.POST("", adminOnly, h.Guardian.Create)
+				guardians.PUT("/:id", adminOnly, h.Guardian.Update)
+				guardians.DELETE("/:id", adminOnly, h.Guardian.Delete)
+
+				guardians.GET("/:id/children", adminOnly, h.Guardian.ListChildren)
+				guardians.POST("/:id/passengers", adminOnly, h.Guardian.LinkPassenger)
+				guardians.DELETE("/:id/passengers/:passengerId", adminOnly, h.Guardian.UnlinkPassenger)
+			}
+
+			api.GET("/me/children", middleware.RequireRole(domain.RoleGuardian), h.Guardian.MyChildren)
+		}
+
+		// API Key API (서버 간 연동 키 발급/폐기)
+		if h.APIKey != nil {
+			apiKeys := api.Group("/api-keys", adminOnly)
+			{
+				apiKeys.GET("", h.APIKey.List)
+				apiKeys.GET("/:id", h.APIKey.Get)
+				apiKeys.POST("", h.APIKey.Issue)
+				apiKeys.DELETE("/:id", h.APIKey.Revoke)
+			}
+		}
+
+		// Webhook API (시설 외부 시스템으로 운행/탑승 이벤트 전달)
+		if h.Webhook != nil {
+			webhooks := api.Group("/webhooks", adminOnly)
+			{
+				webhooks.GET("", h.Webhook.List)
+				webhooks.GET("/:id", h.Webhook.Get)
+				webhooks.POST("", h.Webhook.Create)
+				webhooks.PATCH("/:id", h.Webhook.Update)
+				webhooks.DELETE("/:id", h.Webhook.Delete)
+				webhooks.GET("/:id/deliveries", h.Webhook.ListDeliveries)
+			}
+		}
+
+		// Organization API (로그인 사용자의 소속 기관)
+		if h.Organization != nil {
+			api.GET("/organization", staff, h.Organization.Get)
+			api.PUT("/organization", adminOnly, h.Organization.Update)
+			api.GET("/org/settings", h.Organization.Settings) // 앱 화면 표시용 (보호자 포함)
+			api.PUT("/org/settings", adminOnly, h.Organization.UpdateSettings)
+		}
+
+		// Invitation API (관리자가 자기 기관에 직원 초대)
+		if h.Invitation != nil {
+			invitations := api.Group("/organization/invitations", adminOnly)
+			{
+				invitations.GET("", h.Invitation.List)
+				invitations.POST("", h.Invitation.Invite)
+				invitations.DELETE("/:id", h.Invitation.Revoke)
+			}
+		}
+
+		// Auth API (로그인 사용자 본인)
+		if h.Auth != nil {
+			api.GET("/auth/me", h.Auth.Me)
+			api.PUT("/auth/password", h.Auth.ChangePassword)
+			api.PUT("/auth/locale", h.Auth.UpdateLocale)
+			api.GET("/auth/sessions", h.Auth.ListSessions)
+			api.DELETE("/auth/sessions", h.Auth.RevokeAllSessions)
+			api.DELETE("/auth/sessions/:id", h.Auth.RevokeSession)
+		}
+
+		// 내 캘린더 구독 주소 발급/해제 (기사/동승자 본인)
+		if h.Calendar != nil {
+			crew := middleware.RequireRole(domain.RoleDriver, domain.RoleAttendant)
+			api.POST("/me/calendar-token", crew, h.Calendar.IssueToken)
+			api.DELETE("/me/calendar-token", crew, h.Calendar.RevokeToken)
+		}
+
+		// Device API (로그인 사용자 본인의 푸시 수신 기기)
+		if h.Device != nil {
+			api.POST("/me/devices", h.Device.Register)
+			api.DELETE("/me/devices", h.Device.Unregister)
+		}
+
+		// Notification API (보호자 본인의 알림 수신 설정, 관리자 발송 기록 조회)
+		if h.Notification != nil {
+			guardianOnly := middleware.RequireRole(domain.RoleGuardian)
+			api.GET("/me/notification-preferences", guardianOnly, h.Notification.MyPreference)
+			api.PUT("/me/notification-preferences", guardianOnly, h.Notification.UpdateMyPreference)
+			api.GET("/notifications", adminOnly, h.Notification.List)
+		}
+
+		// User API (계정 관리)
+		if h.User != nil {
+			users := api.Group("/users", adminOnly)
+			{
+				users.GET("", h.User.List)
+				users.GET("/:id", h.User.Get)
+				users.POST("", h.User.Create)
+				users.POST("/:id/disable", h.User.Disable)
+				users.POST("/:id/enable", h.User.Enable)
+				users.PUT("/:id/role", h.User.ChangeRole)
+				users.DELETE("/:id", h.User.Remove)
+				users.DELETE("/:id/sessions", h.User.RevokeSessions)
+			}
+		}
+
+		// Trip API (시작/완료는 배정 기사 또는 운행 시작 권한이 있는 동승자만 - Service에서 검증)
+		if h.Trip != nil {
+			trips := api.Group("/trips")
+			{
+				trips.GET("", staffOr(domain.ScopeTripsRead), h.Trip.List)
+				trips.GET("/:id", staffOr(domain.ScopeTripsRead), h.Trip.Get)
+				trips.POST("", adminOnly, h.Trip.Create)
+				trips.POST("/:id/start", staff, h.Trip.Start)
+				trips.POST("/:id/vehicle-check", staff, h.Trip.ConfirmVehicleEmpty)
+				trips.POST("/:id/complete", staff, h.Trip.Complete)
+				trips.POST("/:id/pause", staff, h.Trip.Pause)
+				trips.POST("/:id/resume", staff, h.Trip.Resume)
+				trips.POST("/:id/cancel", adminOnly, h.Trip.Cancel)
+			}
+		}
+
+		// 운행 생성 미리보기 (관리자, 저장하지 않음)
+		if h.TripGeneration != nil {
+			api.GET("/trip-generation/preview", adminOnly, h.TripGeneration.Preview)
+		}
+
+		// 보고서 (관리자, 탑승자/기사 이름 포함)
+		if h.Report != nil {
+			api.GET("/reports/attendance/export", adminOnly, h.Report.ExportAttendance)
+			api.GET("/reports/driving-log/export", adminOnly, h.Report.ExportDrivingLog)
+			api.GET("/reports/drivers", adminOnly, h.Report.DriverSummary)
+			api.GET("/reports/vehicles", adminOnly, h.Report.VehicleSummary)
+			api.GET("/reports/punctuality", adminOnly, h.Report.Punctuality)
+		}
+
+		// 공휴일 목록 (운영 인력, 공휴일 제외 일정은 이 날짜에 운행 생성 안 함)
+		if h.Holiday != nil {
+			api.GET("/holidays", staff, h.Holiday.List)
+		}
+
+		// 승차/하차 기록 (배정 기사/동승자만 - Service에서 검증, 보호자 알림 발송)
+		if h.Boarding != nil {
+			api.POST("/trips/:id/passengers/:passengerId/board", staff, h.Boarding.Board)
+			api.POST("/trips/:id/passengers/:passengerId/alight", staff, h.Boarding.Alight)
+			api.POST("/trips/:id/passengers/:passengerId/no-show", staff, h.Boarding.MarkNoShow)
+			api.POST("/trips/:id/passengers/:passengerId/exception", staff, h.Boarding.FlagException)
+			api.POST("/trips/:id/stops/:stopId/board-all", staff, h.Boarding.BoardAll)
+			api.POST("/trips/:id/stops/:stopId/alight-all", staff, h.Boarding.AlightAll)
+		}
+
+		// 운행 지연 보고 (관리자 또는 배정 기사/동승자 - Service에서 검증, 보호자/관리자 알림 발송)
+		if h.Delay != nil {
+			api.POST("/trips/:id/delay", staff, h.Delay.Report)
+		}
+
+		// 운행 탑승 명단 (관리자 또는 배정 기사/동승자 - Service에서 검증, 보호자 연락처/의료 정보 포함)
+		if h.Manifest != nil {
+			api.GET("/trips/:id/manifest", staff, h.Manifest.Get)
+		}
+
+		// 운행 중 차량 위치 전송 (배정 승무원 또는 locations:write API 키), 주행 경로 조회
+		if h.Tracking != nil {
+			api.POST("/trips/:id/locations", staffOr(domain.ScopeLocationsWrite), h.Tracking.ReportLocation)
+			api.GET("/trips/:id/track", staffOr(domain.ScopeTripsRead), h.Tracking.Track)
+			api.GET("/trips/:id/replay", staffOr(domain.ScopeTripsRead), h.Tracking.Replay)
+		}
+
+		// 운행 경보 (과속, 급가속/급감속)
+		if h.Alert != nil {
+			api.GET("/trips/:id/alerts", staffOr(domain.ScopeTripsRead), h.Alert.List)
+		}
+
+		// 정류장 도착/출발 기록 (수동 기록은 배정 기사/동승자 - Service에서 검증, 지오펜스 판정은 자동 기록), 정시성 보고서
+		if h.StopEvent != nil {
+			api.POST("/trips/:id/stops/:stopId/events", staff, h.StopEvent.Record)
+			api.GET("/trips/:id/stop-events", staffOr(domain.ScopeTripsRead), h.StopEvent.List)
+			api.GET("/trips/:id/punctuality", staffOr(domain.ScopeTripsRead), h.StopEvent.Punctuality)
+		}
+
+		// 운행 배정 변경 (관리자 - 차량 고장·기사 병가 등 그 운행만 교체, 보호자 알림)
+		if h.TripAssignment != nil {
+			api.PATCH("/trips/:id/assignment", adminOnly, h.TripAssignment.Update)
+		}
+
+		// 정류장 도착 예정 시간 (관리자, trips:read API 키, 탑승 자녀 보호자 - Service에서 검증)
+		if h.ETA != nil {
+			api.GET("/trips/:id/eta", h.ETA.Get)
+		}
+
+		// Audit Log API (변경 이력 조회)
+		if h.AuditLog != nil {
+			api.GET("/audit-logs", adminOnly, h.AuditLog.List)
+		}
 
 		// 임시 테스트 엔드포인트
 		v1.GET("/ping", func(c *gin.Context) {
@@ -60,3 +567,12 @@ func SetupRouter() *gin.Engine {
 
 	return router
 }
+
+// corsConfig - 현재 CORS 설정에 기관별 허용 Origin 확인 추가
+func corsConfig(settings *LiveSettings, origins middleware.OriginChecker) func() middleware.CORSConfig {
+	return func() middleware.CORSConfig {
+		config := settings.cors()
+		config.Origins = origins
+		return config
+	}
+}
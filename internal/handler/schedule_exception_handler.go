@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 일정 예외 날짜 핸들러 (하루만 운행 안 함 / 추가 운행)
+// 🎯 실무 포인트: 일정 하위 리소스 (/schedules/:id/exceptions), 운행 생성 미리보기에 바로 반영
+// ⚠️ 주의사항: 수정 API 없음 → 바꾸려면 삭제 후 다시 추가
+
+// ScheduleExceptionHandler - 일정 예외 핸들러
+type ScheduleExceptionHandler struct {
+	exceptionService *service.ScheduleExceptionService
+}
+
+// NewScheduleExceptionHandler - 일정 예외 핸들러 생성
+func NewScheduleExceptionHandler(exceptionService *service.ScheduleExceptionService) *ScheduleExceptionHandler {
+	return &ScheduleExceptionHandler{exceptionService: exceptionService}
+}
+
+// List - 일정 예외 날짜 목록
+// @Summary		일정 예외 날짜 목록
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse{data=[]domain.ScheduleException}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/exceptions [get]
+func (h *ScheduleExceptionHandler) List(c *gin.Context) {
+	exceptions, err := h.exceptionService.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), exceptions)
+}
+
+// Add - 일정 예외 날짜 추가
+// @Summary		일정 예외 날짜 추가
+// @Description	type=skip이면 그날 운행하지 않고, type=extra면 운행 요일/유효 기간/공휴일과 무관하게 그날 운행합니다. 일정 + 날짜당 하나만 등록할 수 있습니다
+// @Tags		Schedule
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string								true	"일정 ID"
+// @Param		request	body	dto.CreateScheduleExceptionRequest	true	"예외 날짜"
+// @Success		201	{object}	util.APIResponse{data=domain.ScheduleException}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/schedules/{id}/exceptions [post]
+func (h *ScheduleExceptionHandler) Add(c *gin.Context) {
+	var req dto.CreateScheduleExceptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	exception, err := h.exceptionService.Add(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "일정 예외 날짜"), exception)
+}
+
+// Delete - 일정 예외 날짜 삭제
+// @Summary		일정 예외 날짜 삭제
+// @Tags		Schedule
+// @Produce		json
+// @Param		id			path	string	true	"일정 ID"
+// @Param		exceptionId	path	string	true	"예외 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/exceptions/{exceptionId} [delete]
+func (h *ScheduleExceptionHandler) Delete(c *gin.Context) {
+	if err := h.exceptionService.Delete(c.Request.Context(), c.Param("id"), c.Param("exceptionId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "일정 예외 날짜"))
+}
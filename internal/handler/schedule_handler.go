@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 일정 관리 핸들러
+// 🎯 실무 포인트: 활성화/비활성화는 별도 엔드포인트로 분리
+// ⚠️ 주의사항: 형식 검증(HH:MM, 요일 1~7)은 바인딩에서, 참조 검증은 Service에서 처리
+
+// ScheduleHandler - 일정 핸들러
+type ScheduleHandler struct {
+	scheduleService *service.ScheduleService
+}
+
+// NewScheduleHandler - 일정 핸들러 생성
+func NewScheduleHandler(scheduleService *service.ScheduleService) *ScheduleHandler {
+	return &ScheduleHandler{scheduleService: scheduleService}
+}
+
+// List - 일정 목록 조회
+// @Summary		일정 목록 조회
+// @Tags		Schedule
+// @Produce		json
+// @Param		status		query	string	false	"상태 (active, inactive)"
+// @Param		route_id	query	string	false	"경로 ID"
+// @Param		vehicle_id	query	string	false	"차량 ID"
+// @Param		driver_id	query	string	false	"기본 기사 ID"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Param		include_deleted	query	bool	false	"삭제된 일정 포함 (관리자만)"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/schedules [get]
+func (h *ScheduleHandler) List(c *gin.Context) {
+	var query dto.ListScheduleQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if !allowIncludeDeleted(c, query.IncludeDeleted) {
+		return
+	}
+
+	params, err := parseListParams(c, repository.ScheduleListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.ScheduleFilter{
+		Status:         domain.ScheduleStatus(query.Status),
+		RouteID:        query.RouteID,
+		VehicleID:      query.VehicleID,
+		DriverID:       query.DriverID,
+		IncludeDeleted: query.IncludeDeleted,
+		ListOptions:    params.options,
+	}
+
+	schedules, total, err := h.scheduleService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), schedules, params.meta(total))
+}
+
+// Get - 일정 단건 조회
+// @Summary		일정 조회
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id} [get]
+func (h *ScheduleHandler) Get(c *gin.Context) {
+	schedule, err := h.scheduleService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), schedule)
+}
+
+// Create - 일정 생성
+// @Summary		일정 생성
+// @Description	출발 시각(HH:MM)과 운행 요일(1=월 ~ 7=일)을 검증하고, 경로/차량/기사가 활성 상태인지 확인합니다
+// @Tags		Schedule
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateScheduleRequest	true	"일정 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Router		/schedules [post]
+func (h *ScheduleHandler) Create(c *gin.Context) {
+	var req dto.CreateScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	schedule, err := h.scheduleService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "일정"), schedule)
+}
+
+// Update - 일정 수정
+// @Summary		일정 수정
+// @Tags		Schedule
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"일정 ID"
+// @Param		request	body	dto.UpdateScheduleRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id} [put]
+func (h *ScheduleHandler) Update(c *gin.Context) {
+	var req dto.UpdateScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	schedule, err := h.scheduleService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "일정"), schedule)
+}
+
+// Activate - 일정 활성화
+// @Summary		일정 활성화
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse	"비활성 경로/차량/기사 참조"
+// @Router		/schedules/{id}/activate [post]
+func (h *ScheduleHandler) Activate(c *gin.Context) {
+	schedule, err := h.scheduleService.Activate(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "일정 상태"), schedule)
+}
+
+// Deactivate - 일정 비활성화
+// @Summary		일정 비활성화
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id}/deactivate [post]
+func (h *ScheduleHandler) Deactivate(c *gin.Context) {
+	schedule, err := h.scheduleService.Deactivate(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "일정 상태"), schedule)
+}
+
+// Delete - 일정 삭제
+// @Summary		일정 삭제
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/schedules/{id} [delete]
+func (h *ScheduleHandler) Delete(c *gin.Context) {
+	if err := h.scheduleService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "일정"))
+}
+
+// Restore - 삭제된 일정 복구
+// @Summary		일정 복구
+// @Tags		Schedule
+// @Produce		json
+// @Param		id	path	string	true	"일정 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.Schedule}
+// @Failure		404	{object}	util.APIResponse	"삭제된 일정 없음"
+// @Failure		409	{object}	util.APIResponse
+// @Router		/schedules/{id}/restore [post]
+func (h *ScheduleHandler) Restore(c *gin.Context) {
+	restored, err := h.scheduleService.Restore(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgRestored, "일정"), restored)
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/middleware"
+)
+
+// 📝 설명: 재시작 없이 바꿀 수 있는 요청 처리 설정 (CORS 허용 Origin, 요청 제한, 기능 플래그)
+// 🎯 실무 포인트: SIGHUP으로 설정(CONFIG_FILE)을 다시 읽어 Store → 다음 요청부터 새 값 적용
+// ⚠️ 주의사항: 진행 중인 요청은 시작할 때 읽은 값을 그대로 사용
+// DB/Redis 연결, 포트, 백그라운드 작업 설정은 재시작해야 반영
+
+// Settings - 실행 중 교체 가능한 설정
+type Settings struct {
+	CORSOrigins        []string   // 허용할 Origin (비어 있으면 모든 Origin 허용)
+	RateLimitEnabled   bool       // 요청 빈도 제한 사용 여부
+	RateLimits         RateLimits // 라우트 그룹별 제한 규칙
+	QuotaEnabled       bool       // 인증 주체별 일일 한도 사용 여부
+	IdempotencyEnabled bool       // Idempotency-Key 중복 처리 방지 사용 여부
+}
+
+// liveState - 교체 단위 (CORS 설정은 Store할 때 미리 계산)
+type liveState struct {
+	settings Settings
+	cors     middleware.CORSConfig
+}
+
+// LiveSettings - 요청마다 최신 Settings를 읽는 보관소 (요청 처리와 동시에 교체해도 안전)
+type LiveSettings struct {
+	current atomic.Pointer[liveState]
+}
+
+// NewLiveSettings - 초기 설정으로 보관소 생성
+func NewLiveSettings(settings Settings) *LiveSettings {
+	l := &LiveSettings{}
+	l.Store(settings)
+	return l
+}
+
+// Load - 현재 설정
+func (l *LiveSettings) Load() Settings {
+	return l.current.Load().settings
+}
+
+// Store - 설정 교체 (다음 요청부터 적용)
+func (l *LiveSettings) Store(settings Settings) {
+	cors := middleware.DefaultCORSConfig()
+	if len(settings.CORSOrigins) > 0 {
+		cors = middleware.ProductionCORSConfig(settings.CORSOrigins)
+	}
+	l.current.Store(&liveState{settings: settings, cors: cors})
+}
+
+// cors - 현재 CORS 설정
+func (l *LiveSettings) cors() middleware.CORSConfig {
+	return l.current.Load().cors
+}
+
+// rateLimit - 현재 그룹 규칙 (제한을 끄면 Limit 0 → 제한 없음)
+func (l *LiveSettings) rateLimit(group func(RateLimits) middleware.RateLimitRule) func() middleware.RateLimitRule {
+	return func() middleware.RateLimitRule {
+		settings := l.Load()
+		if !settings.RateLimitEnabled {
+			return middleware.RateLimitRule{}
+		}
+		return group(settings.RateLimits)
+	}
+}
+
+// when - 플래그가 켜져 있을 때만 미들웨어 실행 (꺼져 있으면 통과)
+func (l *LiveSettings) when(enabled func(Settings) bool, next gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !enabled(l.Load()) {
+			c.Next()
+			return
+		}
+		next(c)
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 정류장 도착/출발 기록 핸들러 (기사/동승자 수동 기록, 기록 조회, 정시성 보고서)
+// 🎯 실무 포인트: 지오펜스가 켜져 있으면 자동 기록되므로 수동 기록은 GPS가 부정확한 정류장 보완용
+// ⚠️ 주의사항: 수동 기록은 배정 기사/동승자만 (Service에서 검증)
+
+// StopEventHandler - 정류장 도착/출발 기록 핸들러
+type StopEventHandler struct {
+	stopEventService *service.StopEventService
+}
+
+// NewStopEventHandler - 정류장 도착/출발 기록 핸들러 생성
+func NewStopEventHandler(stopEventService *service.StopEventService) *StopEventHandler {
+	return &StopEventHandler{stopEventService: stopEventService}
+}
+
+// Record - 정류장 도착/출발 수동 기록
+// @Summary		정류장 도착/출발 기록
+// @Description	기사/동승자가 정류장 도착(arrived) 또는 출발(departed)을 직접 기록합니다. 운행 중(일시정지 포함)에만 가능하며, 같은 정류장에 같은 종류 기록(지오펜스 자동 기록 포함)이 이미 있으면 409를 반환합니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"운행 ID"
+// @Param		stopId	path	string						true	"정류장 ID"
+// @Param		request	body	dto.RecordStopEventRequest	true	"기록 종류와 현재 위치"
+// @Success		201	{object}	util.APIResponse{data=domain.TripStopEvent}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/stops/{stopId}/events [post]
+func (h *StopEventHandler) Record(c *gin.Context) {
+	var req dto.RecordStopEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	event, err := h.stopEventService.Record(c.Request.Context(), c.Param("id"), c.Param("stopId"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "정류장 기록"), event)
+}
+
+// List - 운행의 정류장 도착/출발 기록
+// @Summary		정류장 도착/출발 기록 조회
+// @Description	지오펜스 자동 기록과 수동 기록을 발생 순으로 반환합니다
+// @Tags		Trip
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse{data=[]domain.TripStopEvent}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/trips/{id}/stop-events [get]
+func (h *StopEventHandler) List(c *gin.Context) {
+	events, err := h.stopEventService.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), events)
+}
+
+// Punctuality - 정시성 보고서
+// @Summary		정시성 보고서
+// @Description	정류장별 예상 도착 시각(일정 출발 시각 + 정류장 예상 도착 시간)과 실제 도착 시각을 비교합니다. 예상보다 5분 이내 도착이면 정시입니다
+// @Tags		Trip
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse{data=dto.TripPunctualityResponse}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/trips/{id}/punctuality [get]
+func (h *StopEventHandler) Punctuality(c *gin.Context) {
+	report, err := h.stopEventService.Punctuality(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), report)
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 차량 위치 핸들러 (위치 전송/주행 경로 REST + 실시간 구독 WebSocket)
+// 🎯 실무 포인트: 연결 전에 인증/권한을 확인하고, 연결이 끊기면 구독을 바로 해제
+// ⚠️ 주의사항: WebSocket은 쿠키가 아닌 토큰으로 인증하므로 Origin 검사를 하지 않음
+
+const (
+	wsWriteWait  = 10 * time.Second    // 메시지 쓰기 제한 시간
+	wsPongWait   = 60 * time.Second    // 이 시간 안에 pong이 없으면 연결 종료
+	wsPingPeriod = wsPongWait * 9 / 10 // ping 주기 (pong 대기보다 짧게)
+	wsReadLimit  = 512                 // 클라이언트 메시지 최대 크기 (구독 전용이라 작게)
+)
+
+// TrackingHandler - 실시간 위치 핸들러
+type TrackingHandler struct {
+	trackingService *service.TrackingService
+	hub             *realtime.Hub
+	upgrader        websocket.Upgrader
+}
+
+// NewTrackingHandler - 실시간 위치 핸들러 생성
+func NewTrackingHandler(trackingService *service.TrackingService, hub *realtime.Hub) *TrackingHandler {
+	return &TrackingHandler{
+		trackingService: trackingService,
+		hub:             hub,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+// ReportLocation - 운행 중 차량 위치 전송
+// @Summary		차량 위치 전송
+// @Description	배정 기사/운행 시작 권한 동승자 또는 locations:write API 키가 운행 중 위치를 전송합니다
+// @Tags		Tracking
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"운행 ID"
+// @Param		request	body	dto.ReportLocationRequest	true	"현재 위치"
+// @Success		200	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"운행 중이 아님"
+// @Router		/trips/{id}/locations [post]
+func (h *TrackingHandler) ReportLocation(c *gin.Context) {
+	var req dto.ReportLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	position, err := h.trackingService.ReportLocation(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), position)
+}
+
+// Track - 실제 주행 경로 조회 (단순화된 폴리라인 + 계획 경로 정류장)
+// @Summary		주행 경로 조회
+// @Description	운행 중 기록된 GPS 좌표를 tolerance(미터) 기준으로 단순화해 반환합니다
+// @Tags		Tracking
+// @Produce		json
+// @Param		id			path	string	true	"운행 ID"
+// @Param		tolerance	query	number	false	"단순화 허용 오차 (미터, 기본 10, 0이면 원본)"
+// @Success		200	{object}	util.APIResponse{data=dto.TrackResponse}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/trips/{id}/track [get]
+func (h *TrackingHandler) Track(c *gin.Context) {
+	var query dto.TrackQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	tolerance := service.DefaultTrackTolerance
+	if query.Tolerance != nil {
+		tolerance = *query.Tolerance
+	}
+
+	track, err := h.trackingService.GetTrack(c.Request.Context(), c.Param("id"), tolerance)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), track)
+}
+
+// Replay - 운행 재생 (일정 간격 보간 위치)
+// @Summary		운행 재생
+// @Description	기록된 GPS 좌표를 interval(초) 간격으로 보간해 반환합니다. 관리자 화면에서 완료된 운행을 재생할 때 사용합니다
+// @Tags		Tracking
+// @Produce		json
+// @Param		id			path	string	true	"운행 ID"
+// @Param		from		query	string	false	"시작 시각 (RFC3339, 기본 첫 기록)"
+// @Param		to			query	string	false	"끝 시각 (RFC3339, 기본 마지막 기록)"
+// @Param		interval	query	int		false	"프레임 간격 (초, 1~300, 기본 5)"
+// @Success		200	{object}	util.APIResponse{data=dto.ReplayResponse}
+// @Failure		400	{object}	util.APIResponse	"구간 오류 또는 프레임 수 초과"
+// @Failure		404	{object}	util.APIResponse
+// @Router		/trips/{id}/replay [get]
+func (h *TrackingHandler) Replay(c *gin.Context) {
+	var query dto.ReplayQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	interval := service.DefaultReplayInterval
+	if query.Interval > 0 {
+		interval = time.Duration(query.Interval) * time.Second
+	}
+
+	replay, err := h.trackingService.GetReplay(c.Request.Context(), c.Param("id"), query.From, query.To, interval)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), replay)
+}
+
+// Watch - 운행 실시간 위치 구독 (WebSocket)
+// @Summary		실시간 위치 구독
+// @Description	WebSocket으로 연결하면 운행의 위치 이벤트({"type":"location","trip_id":...,"data":{...}})를 받습니다. 브라우저는 access_token 쿼리로 토큰을 전달합니다
+// @Tags		Tracking
+// @Param		trip_id			query	string	true	"운행 ID"
+// @Param		access_token	query	string	false	"Bearer 토큰 (Authorization 헤더 대신)"
+// @Success		101
+// @Failure		403	{object}	util.APIResponse
+// @Router		/ws [get]
+func (h *TrackingHandler) Watch(c *gin.Context) {
+	tripID := c.Query("trip_id")
+	if tripID == "" {
+		_ = c.Error(util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"trip_id": "필수 항목입니다",
+		}))
+		return
+	}
+
+	if _, err := h.trackingService.AuthorizeWatch(c.Request.Context(), tripID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return // Upgrader가 에러 응답 작성
+	}
+
+	sub := h.hub.Subscribe(tripID)
+	defer func() {
+		h.hub.Unsubscribe(sub)
+		_ = conn.Close()
+	}()
+
+	done := make(chan struct{})
+	go readUntilClosed(conn, done)
+
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case message, ok := <-sub.Messages():
+			if !ok {
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// readUntilClosed - 클라이언트 메시지는 버리고 연결 종료(close/pong 시간 초과)를 감지하면 done을 닫음
+func readUntilClosed(conn *websocket.Conn, done chan<- struct{}) {
+	defer close(done)
+
+	conn.SetReadLimit(wsReadLimit)
+	_ = conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.Debug("WebSocket closed unexpectedly", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+			return
+		}
+	}
+}
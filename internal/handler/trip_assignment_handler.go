@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 배정 변경 핸들러 (차량 고장, 기사 병가 등 그 운행만 차량/기사/동승자 교체)
+// 🎯 실무 포인트: 일정 기본 배정과 기간 대체 배정은 그대로, 경로 탑승자의 보호자에게 변경 알림
+// ⚠️ 주의사항: 관리자 전용 (라우터에서 검증)
+
+// TripAssignmentHandler - 운행 배정 변경 핸들러
+type TripAssignmentHandler struct {
+	tripAssignmentService *service.TripAssignmentService
+}
+
+// NewTripAssignmentHandler - 운행 배정 변경 핸들러 생성
+func NewTripAssignmentHandler(tripAssignmentService *service.TripAssignmentService) *TripAssignmentHandler {
+	return &TripAssignmentHandler{tripAssignmentService: tripAssignmentService}
+}
+
+// Update - 운행 배정 변경
+// @Summary		운행 배정 변경
+// @Description	운행 하나의 차량/기사/동승자를 교체합니다 (전달된 항목만, attendant_id가 빈 문자열이면 동승자 해제). 교체 대상이 운행 날짜에 투입할 수 없으면 400, 같은 날 시간대가 겹치는 다른 운행에 이미 배정되어 있으면 409(details.conflicts)를 반환합니다. 변경 후 경로 탑승자의 보호자에게 알림을 보냅니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string							true	"운행 ID"
+// @Param		request	body	dto.UpdateTripAssignmentRequest	true	"교체할 배정과 사유"
+// @Success		200	{object}	util.APIResponse{data=domain.Trip}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/assignment [patch]
+func (h *TripAssignmentHandler) Update(c *gin.Context) {
+	var req dto.UpdateTripAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	trip, err := h.tripAssignmentService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행 배정"), trip)
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 생성 미리보기 핸들러
+// 🎯 실무 포인트: 관리자가 날짜를 골라 어떤 운행이 생성되고 어떤 일정이 왜 빠지는지 확인
+// ⚠️ 주의사항: 조회만 하고 운행은 만들지 않음
+
+// TripGenerationHandler - 운행 생성 계획 핸들러
+type TripGenerationHandler struct {
+	tripGenerationService *service.TripGenerationService
+}
+
+// NewTripGenerationHandler - 운행 생성 계획 핸들러 생성
+func NewTripGenerationHandler(tripGenerationService *service.TripGenerationService) *TripGenerationHandler {
+	return &TripGenerationHandler{tripGenerationService: tripGenerationService}
+}
+
+// Preview - 운행 생성 미리보기
+// @Summary		운행 생성 미리보기
+// @Description	날짜에 생성될 운행과 생성하지 않는 일정 및 이유(inactive, out_of_period, not_service_day, holiday, exception, already_exists, vehicle_unavailable)를 반환합니다. 아무것도 저장하지 않습니다
+// @Tags		Trip
+// @Produce		json
+// @Param		date	query	string	false	"운행 날짜 (YYYY-MM-DD, 비우면 기관 시간대의 오늘)"
+// @Success		200	{object}	util.APIResponse{data=dto.TripGenerationPreviewResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Router		/trip-generation/preview [get]
+func (h *TripGenerationHandler) Preview(c *gin.Context) {
+	var query dto.PreviewTripGenerationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+	date := h.tripGenerationService.Today(c.Request.Context())
+	if query.Date != "" {
+		date, _ = time.Parse(time.DateOnly, query.Date) // 바인딩에서 형식 검증 완료
+	}
+
+	preview, err := h.tripGenerationService.Preview(c.Request.Context(), date)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), preview)
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행(Trip) 핸들러
+// 🎯 실무 포인트: 시작/완료는 운영 인력 라우트, 운행별 권한은 TripService에서 검증
+// ⚠️ 주의사항: 위치 본문은 선택 (본문 없이 호출 가능)
+
+// TripHandler - 운행 핸들러
+type TripHandler struct {
+	tripService *service.TripService
+}
+
+// NewTripHandler - 운행 핸들러 생성
+func NewTripHandler(tripService *service.TripService) *TripHandler {
+	return &TripHandler{tripService: tripService}
+}
+
+// List - 운행 목록 조회
+// @Summary		운행 목록 조회
+// @Tags		Trip
+// @Produce		json
+// @Param		date			query	string	false	"운행 날짜 (YYYY-MM-DD)"
+// @Param		status			query	string	false	"상태 (pending, in_progress, paused, completed, cancelled)"
+// @Param		schedule_id		query	string	false	"일정 ID"
+// @Param		driver_id		query	string	false	"배정 기사 ID"
+// @Param		attendant_id	query	string	false	"배정 동승자 ID"
+// @Param		page			query	int		false	"페이지 (기본 1)"
+// @Param		page_size		query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/trips [get]
+func (h *TripHandler) List(c *gin.Context) {
+	var query dto.ListTripQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.TripListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.TripFilter{
+		Status:      domain.TripStatus(query.Status),
+		ScheduleID:  query.ScheduleID,
+		DriverID:    query.DriverID,
+		AttendantID: query.AttendantID,
+		ListOptions: params.options,
+	}
+	if query.Date != "" {
+		date, _ := time.Parse(time.DateOnly, query.Date) // 바인딩에서 형식 검증 완료
+		filter.Date = &date
+	}
+
+	trips, total, err := h.tripService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), trips, params.meta(total))
+}
+
+// Get - 운행 단건 조회 (탑승자 기록 포함)
+// @Summary		운행 조회
+// @Tags		Trip
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/trips/{id} [get]
+func (h *TripHandler) Get(c *gin.Context) {
+	trip, err := h.tripService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), trip)
+}
+
+// Create - 운행 생성
+// @Summary		운행 생성
+// @Description	일정의 기본 차량/기사/동승자로 해당 날짜의 운행을 생성합니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateTripRequest	true	"일정 ID, 날짜"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"이미 생성된 운행"
+// @Router		/trips [post]
+func (h *TripHandler) Create(c *gin.Context) {
+	var req dto.CreateTripRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	trip, err := h.tripService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "운행"), trip)
+}
+
+// Start - 운행 시작
+// @Summary		운행 시작
+// @Description	배정 기사 또는 운행 시작 권한이 있는 배정 동승자만 시작할 수 있습니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"운행 ID"
+// @Param		request	body	dto.TripLocationRequest	false	"현재 위치"
+// @Success		200	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"시작할 수 없는 상태"
+// @Router		/trips/{id}/start [post]
+func (h *TripHandler) Start(c *gin.Context) {
+	req, ok := bindTripLocation(c)
+	if !ok {
+		return
+	}
+
+	trip, err := h.tripService.Start(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행"), trip)
+}
+
+// ConfirmVehicleEmpty - 차량 내부 잔류 인원 없음 확인
+// @Summary		차량 내부 확인
+// @Description	배정 기사 또는 동승자가 차량 내부에 남은 탑승자가 없음을 확인합니다 (잠든 아이 방지). 확인 시각과 확인자가 기록되며, 승차 후 하차/예외 처리되지 않은 탑승자가 있으면 409(details.passenger_ids)를 반환합니다
+// @Tags		Trip
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.Trip}
+// @Failure		403	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/vehicle-check [post]
+func (h *TripHandler) ConfirmVehicleEmpty(c *gin.Context) {
+	trip, err := h.tripService.ConfirmVehicleEmpty(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행"), trip)
+}
+
+// Complete - 운행 완료
+// @Summary		운행 완료
+// @Description	승차한 탑승자가 모두 하차 또는 예외 처리되고, 그 이후 차량 내부 확인(POST /trips/{id}/vehicle-check)을 마쳐야 완료할 수 있습니다 (아니면 409)
+// @Description	기사가 확인한 주행거리계(odometer_km)로 차량 주행거리계를 갱신하며, 현재 차량 주행거리계보다 작으면 400입니다. 생략하면 차량 주행거리계에 주행 거리를 더해 계산합니다 (기준값이 없으면 갱신 안 함)
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"운행 ID"
+// @Param		request	body	dto.CompleteTripRequest	false	"현재 위치, 주행거리계"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"완료할 수 없는 상태, 차내 잔류 탑승자 또는 차량 내부 미확인"
+// @Router		/trips/{id}/complete [post]
+func (h *TripHandler) Complete(c *gin.Context) {
+	var req dto.CompleteTripRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(newBindingError(err))
+			return
+		}
+	}
+
+	trip, err := h.tripService.Complete(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행"), trip)
+}
+
+// Pause - 운행 일시정지
+// @Summary		운행 일시정지
+// @Description	운행 중인 운행을 일시정지합니다 (휴식, 병원 대기 등). 배정 기사 또는 배정 동승자만 가능하며 사유와 시각이 일시정지 이력(pauses)에 남습니다
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"운행 ID"
+// @Param		request	body	dto.PauseTripRequest	true	"일시정지 사유"
+// @Success		200	{object}	util.APIResponse{data=domain.Trip}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"운행 중이 아님"
+// @Router		/trips/{id}/pause [post]
+func (h *TripHandler) Pause(c *gin.Context) {
+	var req dto.PauseTripRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	trip, err := h.tripService.Pause(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행"), trip)
+}
+
+// Resume - 운행 재개
+// @Summary		운행 재개
+// @Description	일시정지한 운행을 재개하고 일시정지 시간을 누적(paused_seconds)합니다
+// @Tags		Trip
+// @Produce		json
+// @Param		id	path	string	true	"운행 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.Trip}
+// @Failure		403	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"일시정지 중이 아님"
+// @Router		/trips/{id}/resume [post]
+func (h *TripHandler) Resume(c *gin.Context) {
+	trip, err := h.tripService.Resume(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행"), trip)
+}
+
+// Cancel - 운행 취소
+// @Summary		운행 취소
+// @Tags		Trip
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"운행 ID"
+// @Param		request	body	dto.CancelTripRequest	true	"취소 사유"
+// @Success		200	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse
+// @Router		/trips/{id}/cancel [post]
+func (h *TripHandler) Cancel(c *gin.Context) {
+	var req dto.CancelTripRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	trip, err := h.tripService.Cancel(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "운행"), trip)
+}
+
+// bindTripLocation - 선택적 위치 본문 바인딩 (본문이 없으면 빈 요청)
+func bindTripLocation(c *gin.Context) (*dto.TripLocationRequest, bool) {
+	var req dto.TripLocationRequest
+	if c.Request.ContentLength == 0 {
+		return &req, true
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return nil, false
+	}
+	return &req, true
+}
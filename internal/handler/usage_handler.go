@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 내 요청 사용량 조회 핸들러
+// 🎯 실무 포인트: 사용자와 API 키 모두 자신의 일일 사용량/남은 한도 확인 가능
+// ⚠️ 주의사항: 이 조회 자체는 사용량에 포함하지 않음 (한도 초과 후에도 확인 가능)
+
+// UsageHandler - 사용량 핸들러
+type UsageHandler struct {
+	quotaService *service.QuotaService
+}
+
+// NewUsageHandler - 사용량 핸들러 생성
+func NewUsageHandler(quotaService *service.QuotaService) *UsageHandler {
+	return &UsageHandler{quotaService: quotaService}
+}
+
+// Me - 내 일일 사용량 조회
+// @Summary		내 요청 사용량 조회
+// @Description	오늘(KST) 요청 수와 일일 한도, 남은 요청 수, 초기화 시각을 반환합니다 (limit 0은 무제한)
+// @Tags		Usage
+// @Produce		json
+// @Success		200	{object}	util.APIResponse{data=domain.Usage}
+// @Failure		401	{object}	util.APIResponse
+// @Router		/me/usage [get]
+func (h *UsageHandler) Me(c *gin.Context) {
+	principal, ok := auth.FromContext(c.Request.Context())
+	if !ok {
+		_ = c.Error(util.NewUnauthorizedError())
+		return
+	}
+
+	usage, err := h.quotaService.GetUsage(c.Request.Context(), principal)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), usage)
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 계정 관리 핸들러 (관리자 전용)
+// 🎯 실무 포인트: 계정 삭제 대신 비활성화로 로그인 차단 (이력 보존)
+// ⚠️ 주의사항: 비밀번호 해시는 응답에 포함되지 않음
+
+// UserHandler - 계정 핸들러
+type UserHandler struct {
+	userService *service.UserService
+}
+
+// NewUserHandler - 계정 핸들러 생성
+func NewUserHandler(userService *service.UserService) *UserHandler {
+	return &UserHandler{userService: userService}
+}
+
+// List - 계정 목록 조회
+// @Summary		계정 목록 조회
+// @Tags		User
+// @Produce		json
+// @Param		role		query	string	false	"역할 (admin, driver, attendant, guardian)"
+// @Param		status		query	string	false	"상태 (active, disabled)"
+// @Param		email		query	string	false	"이메일 부분 검색"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/users [get]
+func (h *UserHandler) List(c *gin.Context) {
+	var query dto.ListUserQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.UserListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.UserFilter{
+		Role:        domain.Role(query.Role),
+		Status:      domain.UserStatus(query.Status),
+		Email:       query.Email,
+		ListOptions: params.options,
+	}
+
+	users, total, err := h.userService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), users, params.meta(total))
+}
+
+// Get - 계정 단건 조회
+// @Summary		계정 조회
+// @Tags		User
+// @Produce		json
+// @Param		id	path	string	true	"계정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/users/{id} [get]
+func (h *UserHandler) Get(c *gin.Context) {
+	user, err := h.userService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), user)
+}
+
+// Create - 계정 생성
+// @Summary		계정 생성
+// @Tags		User
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateUserRequest	true	"계정 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"이메일 중복"
+// @Router		/users [post]
+func (h *UserHandler) Create(c *gin.Context) {
+	var req dto.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	user, err := h.userService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "계정"), user)
+}
+
+// Disable - 계정 비활성화
+// @Summary		계정 비활성화
+// @Tags		User
+// @Produce		json
+// @Param		id	path	string	true	"계정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse	"플랫폼 운영자 계정"
+// @Failure		409	{object}	util.APIResponse	"이미 비활성화됨"
+// @Router		/users/{id}/disable [post]
+func (h *UserHandler) Disable(c *gin.Context) {
+	user, err := h.userService.Disable(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "계정"), user)
+}
+
+// Enable - 계정 재활성화
+// @Summary		계정 재활성화
+// @Tags		User
+// @Produce		json
+// @Param		id	path	string	true	"계정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse	"플랫폼 운영자 계정"
+// @Failure		409	{object}	util.APIResponse	"이미 활성화됨"
+// @Router		/users/{id}/enable [post]
+func (h *UserHandler) Enable(c *gin.Context) {
+	user, err := h.userService.Enable(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "계정"), user)
+}
+
+// ChangeRole - 기관 내 역할 변경
+// @Summary		계정 역할 변경
+// @Description	변경 후 기존 세션은 모두 종료됩니다. 본인 계정은 변경할 수 없습니다
+// @Tags		User
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"계정 ID"
+// @Param		request	body	dto.ChangeUserRoleRequest	true	"역할과 프로필"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse	"플랫폼 운영자 계정"
+// @Failure		404	{object}	util.APIResponse
+// @Router		/users/{id}/role [put]
+func (h *UserHandler) ChangeRole(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangeUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	user, err := h.userService.ChangeRole(c.Request.Context(), principal.UserID, c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "계정"), user)
+}
+
+// Remove - 기관에서 구성원 제외
+// @Summary		구성원 제외
+// @Description	계정을 삭제하고 기존 세션을 모두 종료합니다. 본인 계정은 제외할 수 없습니다
+// @Tags		User
+// @Produce		json
+// @Param		id	path	string	true	"계정 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		403	{object}	util.APIResponse	"플랫폼 운영자 계정"
+// @Failure		404	{object}	util.APIResponse
+// @Router		/users/{id} [delete]
+func (h *UserHandler) Remove(c *gin.Context) {
+	principal, ok := currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.Remove(c.Request.Context(), principal.UserID, c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "계정"), nil)
+}
+
+// RevokeSessions - 계정의 모든 세션 강제 종료
+// @Summary		계정 세션 강제 종료
+// @Description	분실/도난 단말의 토큰을 만료 전에 즉시 무효화합니다
+// @Tags		User
+// @Produce		json
+// @Param		id	path	string	true	"계정 ID"
+// @Success		200	{object}	util.APIResponse{data=dto.RevokeSessionsResponse}
+// @Failure		403	{object}	util.APIResponse	"플랫폼 운영자 계정"
+// @Failure		404	{object}	util.APIResponse
+// @Router		/users/{id}/sessions [delete]
+func (h *UserHandler) RevokeSessions(c *gin.Context) {
+	count, err := h.userService.RevokeSessions(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "세션"), &dto.RevokeSessionsResponse{Revoked: count})
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 차량 서류 핸들러 (차량 하위 리소스 /vehicles/:id/documents)
+// 🎯 실무 포인트: multipart/form-data의 file 필드로 업로드, 다운로드는 응답 본문이 파일 (Content-Disposition: attachment)
+// ⚠️ 주의사항: 파일 형식/크기 검증은 FileService의 vehicle_document 정책 (PDF, JPEG, PNG, 최대 10MB)
+
+// VehicleDocumentHandler - 차량 서류 핸들러
+type VehicleDocumentHandler struct {
+	documentService *service.VehicleDocumentService
+}
+
+// NewVehicleDocumentHandler - 차량 서류 핸들러 생성
+func NewVehicleDocumentHandler(documentService *service.VehicleDocumentService) *VehicleDocumentHandler {
+	return &VehicleDocumentHandler{documentService: documentService}
+}
+
+// List - 차량 서류 목록
+// @Summary		차량 서류 목록
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id		path	string	true	"차량 ID"
+// @Param		type	query	string	false	"서류 종류 (insurance, inspection, registration, other)"
+// @Success		200	{object}	util.APIResponse{data=[]domain.VehicleDocument}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/documents [get]
+func (h *VehicleDocumentHandler) List(c *gin.Context) {
+	var query dto.VehicleDocumentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	documents, err := h.documentService.List(c.Request.Context(), c.Param("id"), &query)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), documents)
+}
+
+// Upload - 차량 서류 업로드
+// @Summary		차량 서류 업로드
+// @Description	보험 증권, 정기검사 증명서 등 서류 파일(PDF, JPEG, PNG, 최대 10MB)을 올립니다. 보험(insurance)/정기검사(inspection) 서류의 만료일이 차량의 현재 만료일보다 늦으면 차량 insurance_expiry/inspection_expiry에 반영됩니다
+// @Tags		Vehicle
+// @Accept		multipart/form-data
+// @Produce		json
+// @Param		id			path		string	true	"차량 ID"
+// @Param		file		formData	file	true	"서류 파일 (PDF, JPEG, PNG)"
+// @Param		type		formData	string	true	"서류 종류 (insurance, inspection, registration, other)"
+// @Param		title		formData	string	false	"제목"
+// @Param		issued_on	formData	string	false	"발급일 (YYYY-MM-DD)"
+// @Param		expires_on	formData	string	false	"만료일 (YYYY-MM-DD)"
+// @Success		201	{object}	util.APIResponse{data=domain.VehicleDocument}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		502	{object}	util.APIResponse	"파일 저장소 오류"
+// @Router		/vehicles/{id}/documents [post]
+func (h *VehicleDocumentHandler) Upload(c *gin.Context) {
+	var req dto.UploadVehicleDocumentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	fileName, data, err := readUploadFile(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	document, err := h.documentService.Upload(c.Request.Context(), c.Param("id"), &req, fileName, data)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "차량 서류"), document)
+}
+
+// Download - 차량 서류 파일 다운로드
+// @Summary		차량 서류 다운로드
+// @Description	업로드한 원본 파일을 내려받습니다
+// @Tags		Vehicle
+// @Produce		application/pdf,image/jpeg,image/png
+// @Param		id			path	string	true	"차량 ID"
+// @Param		documentId	path	string	true	"서류 ID"
+// @Success		200	{file}		binary
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/documents/{documentId}/file [get]
+func (h *VehicleDocumentHandler) Download(c *gin.Context) {
+	file, data, err := h.documentService.Download(c.Request.Context(), c.Param("id"), c.Param("documentId"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	sendFile(c, file, data)
+}
+
+// Delete - 차량 서류 삭제
+// @Summary		차량 서류 삭제
+// @Description	서류 파일과 기록을 삭제합니다. 차량 만료일은 바뀌지 않습니다
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id			path	string	true	"차량 ID"
+// @Param		documentId	path	string	true	"서류 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id}/documents/{documentId} [delete]
+func (h *VehicleDocumentHandler) Delete(c *gin.Context) {
+	if err := h.documentService.Delete(c.Request.Context(), c.Param("id"), c.Param("documentId")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "차량 서류"))
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 차량 CRUD 핸들러
+// 🎯 실무 포인트: 핸들러는 요청 검증 + Service 호출 + 응답만 담당
+// ⚠️ 주의사항: 에러는 c.Error()로 넘기고 응답은 ErrorHandler에서 통일
+
+// VehicleHandler - 차량 핸들러
+type VehicleHandler struct {
+	vehicleService *service.VehicleService
+}
+
+// NewVehicleHandler - 차량 핸들러 생성
+func NewVehicleHandler(vehicleService *service.VehicleService) *VehicleHandler {
+	return &VehicleHandler{vehicleService: vehicleService}
+}
+
+// List - 차량 목록 조회
+// @Summary		차량 목록 조회
+// @Description	차량 목록을 페이지네이션하여 조회합니다
+// @Tags		Vehicle
+// @Produce		json
+// @Param		status			query	string	false	"상태 (active, maintenance, inactive)"
+// @Param		vehicle_type	query	string	false	"유형 (van, bus, mini_bus, sedan)"
+// @Param		min_capacity				query	int		false	"정원 하한"
+// @Param		max_capacity				query	int		false	"정원 상한"
+// @Param		insurance_expiring_within	query	string	false	"N일 이내 보험 만료, 이미 만료 포함 (예: 30d)"
+// @Param		insurance_expired			query	bool	false	"보험 만료 여부"
+// @Param		inspection_expiring_within	query	string	false	"N일 이내 정기검사 만료, 이미 만료 포함 (예: 30d)"
+// @Param		inspection_expired			query	bool	false	"정기검사 만료 여부"
+// @Param		page			query	int		false	"페이지 (기본 1)"
+// @Param		page_size		query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort			query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Param		include_deleted	query	bool	false	"삭제된 차량 포함 (관리자만)"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/vehicles [get]
+func (h *VehicleHandler) List(c *gin.Context) {
+	var query dto.ListVehicleQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	if !allowIncludeDeleted(c, query.IncludeDeleted) {
+		return
+	}
+
+	params, err := parseListParams(c, repository.VehicleListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.VehicleFilter{
+		Status:                   domain.VehicleStatus(query.Status),
+		VehicleType:              domain.VehicleType(query.VehicleType),
+		MinCapacity:              query.MinCapacity,
+		MaxCapacity:              query.MaxCapacity,
+		InsuranceExpiringWithin:  optionalDays(query.InsuranceExpiringWithin),
+		InsuranceExpired:         query.InsuranceExpired,
+		InspectionExpiringWithin: optionalDays(query.InspectionExpiringWithin),
+		InspectionExpired:        query.InspectionExpired,
+		IncludeDeleted:           query.IncludeDeleted,
+		ListOptions:              params.options,
+	}
+
+	vehicles, total, err := h.vehicleService.List(c.Request.Context(), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), vehicles, params.meta(total))
+}
+
+// Get - 차량 단건 조회
+// @Summary		차량 조회
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id	path	string	true	"차량 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id} [get]
+func (h *VehicleHandler) Get(c *gin.Context) {
+	vehicle, err := h.vehicleService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), vehicle)
+}
+
+// Create - 차량 등록
+// @Summary		차량 등록
+// @Tags		Vehicle
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateVehicleRequest	true	"차량 정보"
+// @Success		201	{object}	util.APIResponse
+// @Failure		400	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"차량 번호 중복"
+// @Router		/vehicles [post]
+func (h *VehicleHandler) Create(c *gin.Context) {
+	var req dto.CreateVehicleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	vehicle, err := h.vehicleService.Create(c.Request.Context(), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "차량"), vehicle)
+}
+
+// Update - 차량 수정
+// @Summary		차량 수정
+// @Tags		Vehicle
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string						true	"차량 ID"
+// @Param		request	body	dto.UpdateVehicleRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Failure		409	{object}	util.APIResponse	"차량 번호 중복"
+// @Router		/vehicles/{id} [put]
+func (h *VehicleHandler) Update(c *gin.Context) {
+	var req dto.UpdateVehicleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	vehicle, err := h.vehicleService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "차량"), vehicle)
+}
+
+// Delete - 차량 삭제
+// @Summary		차량 삭제
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id	path	string	true	"차량 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/vehicles/{id} [delete]
+func (h *VehicleHandler) Delete(c *gin.Context) {
+	if err := h.vehicleService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "차량"))
+}
+
+// Restore - 삭제된 차량 복구
+// @Summary		차량 복구
+// @Tags		Vehicle
+// @Produce		json
+// @Param		id	path	string	true	"차량 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.Vehicle}
+// @Failure		404	{object}	util.APIResponse	"삭제된 차량 없음"
+// @Failure		409	{object}	util.APIResponse
+// @Router		/vehicles/{id}/restore [post]
+func (h *VehicleHandler) Restore(c *gin.Context) {
+	restored, err := h.vehicleService.Restore(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgRestored, "차량"), restored)
+}
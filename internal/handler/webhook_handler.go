@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 웹훅 구독 관리 핸들러 (관리자 전용)
+// 🎯 실무 포인트: 등록 응답의 secret 값은 다시 조회할 수 없으므로 연동 측에 바로 전달 (수신 측 서명 검증용)
+// 전달 기록으로 연동 측 장애(응답 상태, 재시도 예정 시각)를 확인
+// ⚠️ 주의사항: 구독을 삭제하면 전달 기록도 함께 삭제 → 잠시 멈추려면 active=false로 수정
+
+// WebhookHandler - 웹훅 핸들러
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+// NewWebhookHandler - 웹훅 핸들러 생성
+func NewWebhookHandler(webhookService *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService}
+}
+
+// List - 웹훅 구독 목록 조회
+// @Summary		웹훅 구독 목록 조회
+// @Tags		Webhook
+// @Produce		json
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort		query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at,name). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Router		/webhooks [get]
+func (h *WebhookHandler) List(c *gin.Context) {
+	params, err := parseListParams(c, repository.WebhookSubscriptionListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	subscriptions, total, err := h.webhookService.List(c.Request.Context(), repository.WebhookSubscriptionFilter{ListOptions: params.options})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), subscriptions, params.meta(total))
+}
+
+// Get - 웹훅 구독 단건 조회
+// @Summary		웹훅 구독 조회
+// @Tags		Webhook
+// @Produce		json
+// @Param		id	path	string	true	"웹훅 ID"
+// @Success		200	{object}	util.APIResponse{data=domain.WebhookSubscription}
+// @Failure		404	{object}	util.APIResponse
+// @Router		/webhooks/{id} [get]
+func (h *WebhookHandler) Get(c *gin.Context) {
+	subscription, err := h.webhookService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), subscription)
+}
+
+// Create - 웹훅 구독 등록
+// @Summary		웹훅 구독 등록
+// @Description	secret을 생략하면 서버가 생성합니다. 응답의 secret 값은 이 응답에서만 확인할 수 있습니다
+// @Description	전달 요청의 X-Eodini-Signature 헤더(t=<유닉스 초>,v1=<HMAC-SHA256("<t>.<본문>") hex>)로 위변조를 확인합니다
+// @Tags		Webhook
+// @Accept		json
+// @Produce		json
+// @Param		request	body	dto.CreateWebhookRequest	true	"이름, URL, 받을 이벤트, 서명 비밀키"
+// @Success		201	{object}	util.APIResponse{data=dto.IssuedWebhookResponse}
+// @Failure		400	{object}	util.APIResponse
+// @Router		/webhooks [post]
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	var createdBy string
+	if principal, ok := auth.FromContext(c.Request.Context()); ok {
+		createdBy = principal.UserID
+	}
+
+	issued, err := h.webhookService.Create(c.Request.Context(), createdBy, &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusCreated, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "웹훅"), issued)
+}
+
+// Update - 웹훅 구독 수정
+// @Summary		웹훅 구독 수정
+// @Description	보낸 필드만 변경합니다. active=false면 새 이벤트와 재시도 대기 중인 전달을 모두 보내지 않습니다
+// @Tags		Webhook
+// @Accept		json
+// @Produce		json
+// @Param		id		path	string					true	"웹훅 ID"
+// @Param		request	body	dto.UpdateWebhookRequest	true	"수정할 필드"
+// @Success		200	{object}	util.APIResponse{data=domain.WebhookSubscription}
+// @Failure		400	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/webhooks/{id} [patch]
+func (h *WebhookHandler) Update(c *gin.Context) {
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	subscription, err := h.webhookService.Update(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessResponse(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgUpdated, "웹훅"), subscription)
+}
+
+// Delete - 웹훅 구독 삭제
+// @Summary		웹훅 구독 삭제
+// @Tags		Webhook
+// @Produce		json
+// @Param		id	path	string	true	"웹훅 ID"
+// @Success		200	{object}	util.APIResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	if err := h.webhookService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgDeleted, "웹훅"))
+}
+
+// ListDeliveries - 웹훅 전달 기록 조회
+// @Summary		웹훅 전달 기록 조회
+// @Description	구독의 전달 기록을 최신순으로 조회합니다 (응답 상태, 시도 횟수, 다음 재시도 시각)
+// @Tags		Webhook
+// @Produce		json
+// @Param		id			path	string	true	"웹훅 ID"
+// @Param		status		query	string	false	"전달 상태 (pending, delivered, retrying, failed, dead)"
+// @Param		event		query	string	false	"이벤트 종류"
+// @Param		page		query	int		false	"페이지 (기본 1)"
+// @Param		page_size	query	int		false	"페이지 크기 (기본 20, 최대 100)"
+// @Param		sort		query	string	false	"정렬 (쉼표 구분, -는 내림차순. 예: -created_at). 일치 필터는 filter[필드]=값"
+// @Success		200	{object}	util.PaginatedResponse
+// @Failure		404	{object}	util.APIResponse
+// @Router		/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	var query dto.ListWebhookDeliveryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(newBindingError(err))
+		return
+	}
+
+	params, err := parseListParams(c, repository.WebhookDeliveryListSpec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	filter := repository.WebhookDeliveryFilter{
+		Status:      domain.WebhookDeliveryStatus(query.Status),
+		Event:       domain.WebhookEvent(query.Event),
+		ListOptions: params.options,
+	}
+
+	deliveries, total, err := h.webhookService.ListDeliveries(c.Request.Context(), c.Param("id"), filter)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	util.SuccessWithPagination(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess), deliveries, params.meta(total))
+}
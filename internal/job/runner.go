@@ -0,0 +1,97 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// 📝 설명: 주기 실행 백그라운드 작업
+// 🎯 실무 포인트: API 서버 프로세스 안에서 간단한 배치(보존 기간 익명화 등)를 주기적으로 실행
+// ⚠️ 주의사항: 서버를 여러 대 띄우면 각 인스턴스에서 실행됨 → 작업은 재실행해도 안전하게(멱등) 작성
+
+// Job - 주기 실행 작업
+type Job struct {
+	Name     string                          // 로그용 이름
+	Interval time.Duration                   // 실행 간격 (시작 직후 1회 실행 후 반복)
+	Run      func(ctx context.Context) error // 실행 함수 (ctx 취소 시 중단)
+}
+
+// Runner - 작업 실행기
+type Runner struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Start - 작업들을 각자의 고루틴에서 주기 실행
+// 사용 예: runner := job.Start(ctx, jobs...); defer runner.Stop()
+func Start(ctx context.Context, jobs ...Job) *Runner {
+	ctx, cancel := context.WithCancel(ctx)
+	r := &Runner{cancel: cancel}
+
+	for _, j := range jobs {
+		r.wg.Add(1)
+		go func(j Job) {
+			defer r.wg.Done()
+			loop(ctx, j)
+		}(j)
+	}
+	return r
+}
+
+// Stop - 실행 중인 작업 취소 후 종료 대기
+func (r *Runner) Stop() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// loop - ctx가 취소될 때까지 Interval마다 실행
+func loop(ctx context.Context, j Job) {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	for {
+		runOnce(ctx, j)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce - 1회 실행 (실패/패닉은 로그만 남기고 다음 주기에 재시도, 실행마다 새 트레이스)
+func runOnce(ctx context.Context, j Job) {
+	ctx, span := tracing.Tracer().Start(ctx, "job "+j.Name)
+	defer span.End()
+
+	defer func() {
+		if r := recover(); r != nil {
+			span.SetStatus(codes.Error, "panic")
+			logger.Error("Job panicked", map[string]interface{}{
+				"job":   j.Name,
+				"panic": r,
+			})
+		}
+	}()
+
+	started := time.Now()
+	if err := j.Run(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.Error("Job failed", map[string]interface{}{
+			"job":   j.Name,
+			"error": err.Error(),
+		})
+		return
+	}
+	logger.Debug("Job completed", map[string]interface{}{
+		"job":      j.Name,
+		"duration": time.Since(started).String(),
+	})
+}
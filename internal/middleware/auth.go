@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 인증(Authenticate)과 역할 기반 인가(RequireRole) 미들웨어
+// 🎯 실무 포인트: Spring Security의 필터 체인 + @PreAuthorize("hasRole(...)") 역할
+// 인증 시 요청 대상 기관도 결정 → Repository 테넌트 훅이 인증 주체의 기관으로 조회 범위 제한
+// ⚠️ 주의사항: RequireRole은 반드시 Authenticate 이후에 등록
+
+// APIKeyHeader - 서버 간 연동용 API 키 헤더
+const APIKeyHeader = "X-API-Key"
+
+// OrganizationHeader - 요청 대상 기관 헤더 (플랫폼 운영자가 특정 기관을 다룰 때)
+const OrganizationHeader = "X-Organization-ID"
+
+// APIKeyAuthenticator - X-API-Key 검증기 (APIKeyService가 구현)
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, raw string) (*auth.Principal, error)
+}
+
+// SessionChecker - 폐기된 세션(jti) 확인 (AuthService가 구현)
+type SessionChecker interface {
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+// Authenticate - Bearer 토큰 또는 X-API-Key 검증 후 인증 주체를 요청 context에 저장
+// X-API-Key 헤더가 있으면 API 키 인증을 우선 적용 (apiKeys가 nil이면 거부)
+// sessions가 있으면 로그아웃/강제 종료된 토큰을 거부
+// 소속 기관이 없는 인증 주체(기관 도입 이전에 발급된 토큰 등)는 거부 → 다시 로그인
+// 대상 기관은 ResolveOrganization 규칙으로 결정, 플랫폼 운영자의 변경 요청(POST/PUT/PATCH/DELETE)은 X-Organization-ID 필수
+//
+// 사용 예:
+//   api := router.Group("/api/v1", middleware.Authenticate(tokens, apiKeyService, authService))
+func Authenticate(tokens *auth.TokenManager, apiKeys APIKeyAuthenticator, sessions SessionChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if raw := c.GetHeader(APIKeyHeader); raw != "" {
+			authenticateAPIKey(c, apiKeys, raw)
+			return
+		}
+
+		header := c.GetHeader("Authorization")
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" || tokens == nil {
+			abortWith(c, util.NewUnauthorizedError())
+			return
+		}
+
+		principal, err := tokens.Parse(token)
+		if err != nil || principal.OrganizationID == "" {
+			abortWith(c, util.NewUnauthorizedError())
+			return
+		}
+
+		if sessions != nil && principal.SessionID != "" {
+			revoked, err := sessions.IsSessionRevoked(c.Request.Context(), principal.SessionID)
+			if err != nil {
+				abortWith(c, util.NewInternalError(err)) // 폐기 여부를 모르면 통과시키지 않음
+				return
+			}
+			if revoked {
+				abortWith(c, util.NewUnauthorizedError())
+				return
+			}
+		}
+
+		setPrincipal(c, principal)
+	}
+}
+
+// authenticateAPIKey - API 키 인증 처리
+func authenticateAPIKey(c *gin.Context, apiKeys APIKeyAuthenticator, raw string) {
+	if apiKeys == nil {
+		abortWith(c, util.NewUnauthorizedError())
+		return
+	}
+
+	principal, err := apiKeys.AuthenticateAPIKey(c.Request.Context(), raw)
+	if err != nil {
+		appErr, ok := err.(*util.AppError)
+		if !ok {
+			appErr = util.NewInternalError(err)
+		}
+		abortWith(c, appErr)
+		return
+	}
+	if principal.OrganizationID == "" {
+		abortWith(c, util.NewUnauthorizedError())
+		return
+	}
+
+	setPrincipal(c, principal)
+}
+
+// setPrincipal - 요청 대상 기관을 결정한 인증 주체를 context에 저장 후 다음 핸들러 실행
+func setPrincipal(c *gin.Context, principal *auth.Principal) {
+	resolved, appErr := ResolveOrganization(principal, c.GetHeader(OrganizationHeader))
+	if appErr == nil && resolved.OrganizationID == "" && !isReadOnly(c.Request.Method) {
+		// 전체 기관 대상 변경은 허용하지 않음 (다른 기관 데이터를 실수로 바꾸거나 기관 없는 행을 만들지 않도록)
+		appErr = util.NewBadRequestError("플랫폼 운영자의 변경 요청은 %s 헤더로 대상 기관을 지정해야 합니다", OrganizationHeader)
+	}
+	if appErr != nil {
+		abortWith(c, appErr)
+		return
+	}
+
+	c.Request = c.Request.WithContext(auth.WithPrincipal(c.Request.Context(), resolved))
+	applyPrincipalLocale(c, resolved)
+	c.Next()
+}
+
+// ResolveOrganization - 요청 대상 기관 결정 (gRPC 인증도 같은 규칙 사용)
+// 일반 사용자/API 키는 소속 기관으로 고정 (다른 기관을 지정하면 403)
+// 플랫폼 운영자는 지정한 기관, 지정하지 않으면 OrganizationID를 비움 → 전체 기관 대상 (Repository 기관 조건 생략)
+func ResolveOrganization(principal *auth.Principal, requested string) (*auth.Principal, *util.AppError) {
+	if !principal.IsSuperAdmin() {
+		if requested != "" && requested != principal.OrganizationID {
+			return nil, util.NewForbiddenError()
+		}
+		return principal, nil
+	}
+
+	if requested != "" {
+		if _, err := uuid.Parse(requested); err != nil {
+			return nil, util.NewBadRequestError("%s 값이 올바른 기관 ID가 아닙니다", OrganizationHeader)
+		}
+	}
+	resolved := *principal
+	resolved.OrganizationID = requested
+	return &resolved, nil
+}
+
+// isReadOnly - 데이터를 변경하지 않는 HTTP 메서드인지 확인
+func isReadOnly(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// RequireRole - 지정한 역할 중 하나를 가진 사용자만 허용 (관리자 허용 라우트는 플랫폼 운영자도 허용)
+//
+// 사용 예:
+//   schedules.POST("", middleware.RequireRole(domain.RoleAdmin), h.Create)
+func RequireRole(roles ...domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.FromContext(c.Request.Context())
+		if !ok {
+			abortWith(c, util.NewUnauthorizedError())
+			return
+		}
+		if !principal.HasRole(roles...) {
+			abortWith(c, util.NewForbiddenError())
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireRoleOrScope - 사용자는 역할로, API 키는 권한 범위(scope)로 허용 여부 판단
+//
+// 사용 예:
+//   vehicles.GET("", middleware.RequireRoleOrScope(domain.ScopeVehiclesRead, domain.RoleAdmin), h.List)
+func RequireRoleOrScope(scope domain.APIKeyScope, roles ...domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.FromContext(c.Request.Context())
+		if !ok {
+			abortWith(c, util.NewUnauthorizedError())
+			return
+		}
+
+		allowed := principal.HasRole(roles...)
+		if principal.IsAPIKey() {
+			allowed = principal.HasScope(scope)
+		}
+		if !allowed {
+			abortWith(c, util.NewForbiddenError())
+			return
+		}
+		c.Next()
+	}
+}
+
+// TokenFromQuery - 쿼리 파라미터의 토큰을 Authorization 헤더로 옮김 (Authenticate 이전에 등록)
+// 브라우저 WebSocket API는 헤더를 붙일 수 없어 연결 URL에 토큰을 담아 보냄
+// 요청 로그에는 경로만 남으므로 토큰이 기록되지 않음
+//
+// 사용 예:
+//   router.GET("/ws", middleware.TokenFromQuery("access_token"), middleware.Authenticate(tokens, nil, nil), h.Watch)
+func TokenFromQuery(param string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token := c.Query(param); token != "" && c.GetHeader("Authorization") == "" {
+			c.Request.Header.Set("Authorization", "Bearer "+token)
+		}
+		c.Next()
+	}
+}
+
+// abortWith - 에러 등록 후 이후 핸들러 중단 (응답은 ErrorHandler가 작성)
+func abortWith(c *gin.Context, err *util.AppError) {
+	_ = c.Error(err)
+	c.Abort()
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -9,6 +10,7 @@ import (
 // 📝 설명: Cross-Origin Resource Sharing (CORS) 설정
 // 🎯 실무 포인트: 프론트엔드와 백엔드가 다른 도메인일 때 필수
 // ⚠️ 주의사항: 프로덕션에서는 AllowOrigins를 특정 도메인으로 제한
+// 기관별 웹 앱 Origin은 Origins(OriginChecker)로 추가 허용 → 전역 목록에는 플랫폼 공용 주소만 등록
 
 // CORSConfig - CORS 설정 구조체
 type CORSConfig struct {
@@ -18,6 +20,13 @@ type CORSConfig struct {
 	ExposeHeaders    []string // 노출할 헤더
 	AllowCredentials bool     // 쿠키 포함 여부
 	MaxAge           int      // Preflight 요청 캐시 시간 (초)
+
+	Origins OriginChecker // AllowOrigins에 없는 Origin의 추가 허용 여부 (nil이면 AllowOrigins만)
+}
+
+// OriginChecker - 설정 목록 외에 허용할 Origin 확인 (기관별 허용 Origin, OrganizationService가 구현)
+type OriginChecker interface {
+	IsAllowedOrigin(ctx context.Context, origin string) bool
 }
 
 // DefaultCORSConfig - 기본 CORS 설정 (개발 환경용)
@@ -38,6 +47,7 @@ func DefaultCORSConfig() CORSConfig {
 			"Accept",
 			"Authorization",
 			"X-Request-ID",
+			"X-Organization-ID",
 		},
 		ExposeHeaders: []string{
 			"Content-Length",
@@ -67,6 +77,7 @@ func ProductionCORSConfig(allowedOrigins []string) CORSConfig {
 			"Accept",
 			"Authorization",
 			"X-Request-ID",
+			"X-Organization-ID",
 		},
 		ExposeHeaders: []string{
 			"Content-Length",
@@ -87,7 +98,14 @@ func ProductionCORSConfig(allowedOrigins []string) CORSConfig {
 //   config := middleware.ProductionCORSConfig([]string{"https://example.com"})
 //   router.Use(middleware.CORS(config))
 func CORS(config CORSConfig) gin.HandlerFunc {
+	return DynamicCORS(func() CORSConfig { return config })
+}
+
+// DynamicCORS - 요청마다 현재 설정을 읽는 CORS 미들웨어 (SIGHUP 재로드로 허용 Origin 변경)
+// 사용 예: router.Use(middleware.DynamicCORS(func() middleware.CORSConfig { return settings.Load().CORS }))
+func DynamicCORS(current func() CORSConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		config := current()
 		origin := c.Request.Header.Get("Origin")
 
 		// Origin 체크
@@ -103,10 +121,17 @@ func CORS(config CORSConfig) gin.HandlerFunc {
 				for _, allowedOrigin := range config.AllowOrigins {
 					if origin == allowedOrigin {
 						allowed = true
-						c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
 						break
 					}
 				}
+				// 기관별 허용 Origin
+				if !allowed && origin != "" && config.Origins != nil {
+					allowed = config.Origins.IsAllowedOrigin(c.Request.Context(), origin)
+				}
+				if allowed {
+					c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+					c.Writer.Header().Add("Vary", "Origin")
+				}
 			}
 
 			if !allowed && origin != "" {
@@ -56,12 +56,7 @@ func RecoveryHandler() gin.HandlerFunc {
 					appErr = util.NewInternalError(e)
 				} else {
 					// 그 외의 경우 (string 등)
-					appErr = &util.AppError{
-						Code:       util.ErrCodeInternal,
-						Message:    util.GetMessage(util.MsgInternalError),
-						StatusCode: 500,
-						Details:    map[string]interface{}{"panic": err},
-					}
+					appErr = util.NewInternalError(nil).WithDetail("panic", err)
 				}
 
 				util.ErrorResponse(c, appErr)
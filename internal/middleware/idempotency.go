@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/idempotency"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: Idempotency-Key 미들웨어 (POST 재시도 중복 처리 방지)
+// 🎯 실무 포인트: 기사 앱이 네트워크 불안정으로 "운행 시작" 등을 재전송해도 첫 응답을 그대로 돌려줌
+// ⚠️ 주의사항: 반드시 Authenticate 이후에 등록 (키는 인증 주체별로 구분)
+// 성공(2xx) 응답만 저장, 실패한 요청은 키를 해제해 같은 키로 다시 시도 가능
+// 요청 해시를 위해 본문을 메모리에 읽으므로 본문 크기를 제한 (넘으면 413), 파일 업로드(multipart)는 멱등 처리 안 함
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"     // 클라이언트가 요청마다 생성하는 고유 키 (UUID 권장)
+	IdempotentReplayedHeader  = "Idempotent-Replayed" // 저장된 응답을 재사용했으면 "true"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentBodySize     = 1 << 20 // 요청 해시를 위해 읽는 본문 최대 크기 (1MB)
+	idempotencyReleaseTimeout = 5 * time.Second
+)
+
+// IdempotencyStore - 키 선점 및 응답 저장 (idempotency.RedisStore가 구현)
+type IdempotencyStore interface {
+	Reserve(ctx context.Context, key, fingerprint string) (*idempotency.Record, error)
+	Complete(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotency - Idempotency-Key 헤더가 있는 POST 요청의 응답을 ttl 동안 저장해 재시도에 재사용
+// 같은 키로 다른 요청을 보내면 422, 첫 요청이 처리 중이면 409로 거부
+// 저장소 오류 시에는 RateLimit과 같이 통과시킴
+// 사용 예: api.Use(middleware.Idempotency(store, 24*time.Hour))
+func Idempotency(store IdempotencyStore, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if store == nil || key == "" || c.Request.Method != http.MethodPost || strings.HasPrefix(c.ContentType(), "multipart/") {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			abortWith(c, util.NewBadRequestError("Idempotency-Key는 255자 이하여야 합니다"))
+			return
+		}
+
+		principal, ok := auth.FromContext(c.Request.Context())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxIdempotentBodySize))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			abortWith(c, &util.AppError{
+				Code:       util.ErrCodeBadRequest,
+				Message:    "요청 본문이 너무 큽니다 (Idempotency-Key 요청은 1MB 이하)",
+				StatusCode: http.StatusRequestEntityTooLarge,
+			})
+			return
+		}
+		if err != nil {
+			abortWith(c, util.NewBadRequestError("요청 본문을 읽을 수 없습니다"))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		storeKey := principal.UserID + ":" + key
+		fingerprint := requestFingerprint(c.Request.Method, c.Request.URL.Path, body)
+
+		record, err := store.Reserve(c.Request.Context(), storeKey, fingerprint)
+		if err != nil {
+			logger.FromContext(c.Request.Context()).Warn("Idempotency store unavailable", map[string]interface{}{
+				"user_id": principal.UserID,
+				"error":   err.Error(),
+			})
+			c.Next()
+			return
+		}
+
+		if record != nil {
+			switch {
+			case record.Fingerprint != fingerprint:
+				abortWith(c, &util.AppError{
+					Code:       util.ErrCodeConflict,
+					Message:    "같은 Idempotency-Key가 다른 요청에 사용되었습니다",
+					StatusCode: http.StatusUnprocessableEntity,
+				})
+			case !record.Completed:
+				abortWith(c, util.NewConflictError("같은 Idempotency-Key의 요청을 처리 중입니다"))
+			default:
+				replay(c, record)
+			}
+			return
+		}
+
+		writer := &capturingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		status := c.Writer.Status()
+		if len(c.Errors) > 0 || status < 200 || status >= 300 {
+			// 실패는 저장하지 않음 (핸들러 에러는 ErrorHandler가 나중에 응답하므로 본문도 없음)
+			releaseCtx, cancel := context.WithTimeout(context.Background(), idempotencyReleaseTimeout)
+			defer cancel()
+			if err := store.Release(releaseCtx, storeKey); err != nil {
+				logger.FromContext(c.Request.Context()).Warn("Failed to release idempotency key", map[string]interface{}{
+					"user_id": principal.UserID,
+					"error":   err.Error(),
+				})
+			}
+			return
+		}
+
+		completed := &idempotency.Record{
+			Fingerprint: fingerprint,
+			Status:      status,
+			Header:      map[string][]string{"Content-Type": c.Writer.Header().Values("Content-Type")},
+			Body:        writer.body.Bytes(),
+			CreatedAt:   time.Now(),
+		}
+		if err := store.Complete(c.Request.Context(), storeKey, completed, ttl); err != nil {
+			logger.FromContext(c.Request.Context()).Warn("Failed to save idempotent response", map[string]interface{}{
+				"user_id": principal.UserID,
+				"error":   err.Error(),
+			})
+		}
+	}
+}
+
+// replay - 저장된 응답을 그대로 반환
+func replay(c *gin.Context, record *idempotency.Record) {
+	for name, values := range record.Header {
+		for _, value := range values {
+			c.Writer.Header().Add(name, value)
+		}
+	}
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Status(record.Status)
+	_, _ = c.Writer.Write(record.Body)
+	c.Abort()
+}
+
+// requestFingerprint - 같은 키로 다른 요청을 보냈는지 판별하기 위한 요청 해시
+func requestFingerprint(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// capturingWriter - 응답 본문을 복사해 두는 ResponseWriter
+type capturingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *capturingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *capturingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
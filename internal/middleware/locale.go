@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 응답 언어 선택 (Accept-Language → 로그인 사용자의 언어 설정 순으로 덮어씀)
+// 🎯 실무 포인트: 선택한 언어는 요청 context에 저장 → util 응답 헬퍼가 메시지를 그 언어로 변환
+// ⚠️ 주의사항: 사용자 언어 설정은 토큰에 포함되므로 설정을 바꾸면 다음 로그인부터 적용
+// 응답에는 Content-Language 헤더로 실제 사용한 언어를 알림
+
+// Locale - Accept-Language 헤더로 응답 언어 선택 (지원하지 않는 언어면 기본 언어)
+// 사용 예: router.Use(middleware.Locale())
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		setLocale(c, util.NegotiateLocale(c.GetHeader("Accept-Language")))
+		c.Next()
+	}
+}
+
+// applyPrincipalLocale - 인증 주체에 언어 설정이 있으면 응답 언어로 사용 (Accept-Language보다 우선)
+func applyPrincipalLocale(c *gin.Context, principal *auth.Principal) {
+	if locale, ok := util.ParseLocale(principal.Locale); ok {
+		setLocale(c, locale)
+	}
+}
+
+// setLocale - 요청 context와 Content-Language 헤더에 응답 언어 설정
+func setLocale(c *gin.Context, locale util.Locale) {
+	c.Request = c.Request.WithContext(util.WithLocale(c.Request.Context(), locale))
+	c.Writer.Header().Set("Content-Language", string(locale))
+}
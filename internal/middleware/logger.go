@@ -1,29 +1,57 @@
 package middleware
 
 import (
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // 📝 설명: 모든 HTTP 요청/응답을 로깅하는 미들웨어
 // 🎯 실무 포인트: 요청 시간, 응답 시간, 상태 코드, 에러 등 기록
-// ⚠️ 주의사항: 민감한 정보(비밀번호 등)는 로그에서 제외
+// ⚠️ 주의사항: 민감한 정보(비밀번호, 연락처, 의료 정보 등)는 logger.Redactor로 마스킹 후 기록
 
-// RequestLogger - HTTP 요청/응답 로깅 미들웨어
+// RequestLoggerConfig - 요청 로깅 설정
+type RequestLoggerConfig struct {
+	Redactor *logger.Redactor // 에러 메시지/본문 마스킹 규칙 (nil이면 기본 규칙)
+}
+
+// DefaultRequestLoggerConfig - 기본 요청 로깅 설정 (logger.DefaultRedactFields 마스킹)
+func DefaultRequestLoggerConfig() RequestLoggerConfig {
+	return RequestLoggerConfig{Redactor: logger.DefaultRedactor()}
+}
+
+// RequestLogger - HTTP 요청/응답 로깅 미들웨어 (기본 설정)
 //
 // 로그 내용:
 // - 메소드, 경로, 상태 코드
 // - 처리 시간 (latency)
 // - 클라이언트 IP
 // - User-Agent
-// - 에러 메시지 (있을 경우)
+// - 에러 메시지 (있을 경우, 개인정보 마스킹)
 //
 // 사용 예:
 //   router := gin.Default()
 //   router.Use(middleware.RequestLogger())
 func RequestLogger() gin.HandlerFunc {
+	return RequestLoggerWithConfig(DefaultRequestLoggerConfig())
+}
+
+// RequestLoggerWithConfig - 마스킹 규칙을 지정한 요청 로깅 미들웨어
+// 사용 예:
+//   redactor := logger.NewRedactor(append(logger.DefaultRedactFields, "email"))
+//   router.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{Redactor: redactor}))
+func RequestLoggerWithConfig(config RequestLoggerConfig) gin.HandlerFunc {
+	redactor := config.Redactor
+	if redactor == nil {
+		redactor = logger.DefaultRedactor()
+	}
+
 	return func(c *gin.Context) {
 		// 시작 시간 기록
 		startTime := time.Now()
@@ -44,13 +72,19 @@ func RequestLogger() gin.HandlerFunc {
 		// 응답 정보
 		statusCode := c.Writer.Status()
 
-		// 로그 레벨 결정
+		// 주소에 담긴 인증 토큰 (캘린더 구독 주소 등) 마스킹
+		if token := c.Param("token"); token != "" {
+			path = strings.Replace(path, token, logger.RedactedValue, 1)
+		}
+
+		// 로그 레벨 결정 (요청 ID는 request_id 필드로 자동 추가)
 		// 4xx: Warning, 5xx: Error, 나머지: Info
-		logFunc := logger.Info
+		entry := logger.FromContext(c.Request.Context())
+		logFunc := entry.Info
 		if statusCode >= 400 && statusCode < 500 {
-			logFunc = logger.Warn
+			logFunc = entry.Warn
 		} else if statusCode >= 500 {
-			logFunc = logger.Error
+			logFunc = entry.Error
 		}
 
 		// 기본 로그 필드
@@ -63,9 +97,14 @@ func RequestLogger() gin.HandlerFunc {
 			"user_agent": userAgent,
 		}
 
-		// 에러가 있으면 추가
+		// 트레이스 ID (추적이 켜져 있으면 Tempo에서 바로 조회)
+		if traceID := tracing.TraceID(c.Request.Context()); traceID != "" {
+			fields["trace_id"] = traceID
+		}
+
+		// 에러가 있으면 추가 (연락처/의료 정보 등 마스킹)
 		if len(c.Errors) > 0 {
-			fields["error"] = c.Errors.String()
+			fields["error"] = redactor.RedactString(c.Errors.String())
 		}
 
 		// 로그 출력
@@ -74,21 +113,31 @@ func RequestLogger() gin.HandlerFunc {
 }
 
 // RequestIDMiddleware - 요청마다 고유 ID 부여
-// 분산 추적(Distributed Tracing)에 유용
+// 분산 추적(Distributed Tracing)에 유용 (Tracing 다음에 등록하면 헤더가 없을 때 트레이스 ID 사용)
 //
 // 사용 예:
 //   router := gin.Default()
 //   router.Use(middleware.RequestIDMiddleware())
 func RequestIDMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// X-Request-ID 헤더에서 가져오거나 새로 생성
+		// X-Request-ID 헤더에서 가져오거나 새로 생성 (형식이 맞지 않는 값은 버림 → 로그 주입 방지)
 		requestID := c.GetHeader("X-Request-ID")
+		if !validRequestID(requestID) {
+			requestID = ""
+		}
+		if requestID == "" {
+			requestID = tracing.TraceID(c.Request.Context())
+		}
 		if requestID == "" {
 			requestID = generateRequestID()
 		}
 
-		// Context에 저장
+		// 현재 span에 요청 ID 기록 (트레이스 → 로그 검색)
+		trace.SpanFromContext(c.Request.Context()).SetAttributes(attribute.String("http.request_id", requestID))
+
+		// Context에 저장 (하위 계층은 logger.RequestIDFromContext로 조회)
 		c.Set("request_id", requestID)
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), requestID))
 
 		// 응답 헤더에도 추가
 		c.Writer.Header().Set("X-Request-ID", requestID)
@@ -97,18 +146,23 @@ func RequestIDMiddleware() gin.HandlerFunc {
 	}
 }
 
-// generateRequestID - 간단한 요청 ID 생성
-// 실무에서는 UUID 사용 권장
-func generateRequestID() string {
-	return time.Now().Format("20060102150405") + "-" + randomString(8)
-}
+// maxRequestIDLength - 클라이언트가 보낸 요청 ID 최대 길이
+const maxRequestIDLength = 128
 
-// randomString - 랜덤 문자열 생성 (간단한 버전)
-func randomString(length int) string {
-	const charset = "abcdefghijklmnopqrstuvwxyz0123456789"
-	b := make([]byte, length)
-	for i := range b {
-		b[i] = charset[time.Now().UnixNano()%int64(len(charset))]
+// validRequestID - 영문/숫자/-/_/./: 로만 이루어진 최대 128자 값인지
+func validRequestID(requestID string) bool {
+	if len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_.:", r)) {
+			return false
+		}
 	}
-	return string(b)
+	return true
+}
+
+// generateRequestID - 요청 ID 생성 (UUIDv4, 추측할 수 없는 값)
+func generateRequestID() string {
+	return uuid.NewString()
 }
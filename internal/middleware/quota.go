@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 인증 주체별 일일 요청 한도 미들웨어
+// 🎯 실무 포인트: 응답 헤더(X-Quota-*)로 남은 한도를 알려 연동사가 호출량을 조절
+// ⚠️ 주의사항: 반드시 Authenticate 이후에 등록 (인증 주체 기준으로 집계)
+
+// QuotaEnforcer - 요청 기록 및 한도 확인 (QuotaService가 구현)
+type QuotaEnforcer interface {
+	ConsumeQuota(ctx context.Context, principal *auth.Principal) (*domain.Usage, error)
+}
+
+// Quota - 일일 한도를 넘으면 429와 Retry-After(다음 집계 시작까지)로 거부
+// 사용량 저장소 오류 시에는 RateLimit과 같이 통과시킴
+// 사용 예: api := v1.Group("", middleware.Authenticate(...), middleware.Quota(quotaService))
+func Quota(quotas QuotaEnforcer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.FromContext(c.Request.Context())
+		if quotas == nil || !ok {
+			c.Next()
+			return
+		}
+
+		usage, err := quotas.ConsumeQuota(c.Request.Context(), principal)
+		if usage != nil && usage.Limit > 0 {
+			c.Header("X-Quota-Limit", strconv.FormatInt(usage.Limit, 10))
+			c.Header("X-Quota-Remaining", strconv.FormatInt(usage.Remaining, 10))
+			c.Header("X-Quota-Reset", strconv.FormatInt(usage.ResetsAt.Unix(), 10))
+		}
+		if err != nil {
+			if appErr, ok := err.(*util.AppError); ok && appErr.Code == util.ErrCodeTooMany {
+				c.Header("Retry-After", strconv.Itoa(int(math.Ceil(time.Until(usage.ResetsAt).Seconds()))))
+				abortWith(c, appErr)
+				return
+			}
+			logger.FromContext(c.Request.Context()).Warn("Quota check unavailable", map[string]interface{}{
+				"user_id": principal.UserID,
+				"error":   err.Error(),
+			})
+		}
+		c.Next()
+	}
+}
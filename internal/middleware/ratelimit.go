@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/ratelimit"
+)
+
+// 📝 설명: 요청 빈도 제한 미들웨어 (클라이언트 IP 기준)
+// 🎯 실무 포인트: 로그인/비밀번호 재설정처럼 무차별 대입 대상인 경로는 엄격하게, 일반 API는 넉넉하게 라우트 그룹별로 설정
+// ⚠️ 주의사항: 프록시/로드밸런서 뒤에서는 gin의 TrustedProxies를 설정해야 ClientIP가 실제 클라이언트 IP
+
+// RateLimiter - 요청 허용 여부 판단 (ratelimit.RedisLimiter가 구현)
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (ratelimit.Result, error)
+}
+
+// RateLimitRule - 라우트 그룹별 제한 규칙
+type RateLimitRule struct {
+	Name   string        // 규칙 이름 (같은 이름끼리 한도 공유, 예: "auth", "api")
+	Limit  int           // 윈도우당 최대 요청 수 (0 이하면 제한 없음)
+	Window time.Duration // 윈도우 길이
+}
+
+// RateLimit - 규칙 한도를 넘으면 429와 Retry-After 헤더로 거부
+// 제한기 오류(Redis 장애 등) 시에는 서비스 가용성을 위해 통과시킴
+// 사용 예: v1.Group("/auth", middleware.RateLimit(limiter, middleware.RateLimitRule{Name: "auth", Limit: 10, Window: time.Minute}))
+func RateLimit(limiter RateLimiter, rule RateLimitRule) gin.HandlerFunc {
+	return DynamicRateLimit(limiter, func() RateLimitRule { return rule })
+}
+
+// DynamicRateLimit - 요청마다 현재 규칙을 읽는 RateLimit (SIGHUP 재로드로 한도 변경, Limit 0이면 제한 없음)
+func DynamicRateLimit(limiter RateLimiter, current func() RateLimitRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule := current()
+		if limiter == nil || rule.Limit <= 0 {
+			c.Next()
+			return
+		}
+
+		result, err := limiter.Allow(c.Request.Context(), rule.Name+":"+c.ClientIP(), rule.Limit, rule.Window)
+		if err != nil {
+			logger.FromContext(c.Request.Context()).Warn("Rate limiter unavailable", map[string]interface{}{
+				"rule":  rule.Name,
+				"error": err.Error(),
+			})
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(rule.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+			abortWith(c, util.NewTooManyRequestsError(result.RetryAfter))
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// 📝 설명: 요청마다 서버 span 생성 (수신 traceparent가 있으면 같은 트레이스로 이어감)
+// 🎯 실무 포인트: RequestIDMiddleware보다 먼저 등록 → 요청 ID가 없으면 트레이스 ID를 요청 ID로 사용
+// ⚠️ 주의사항: tracing.Init을 호출하지 않았으면 no-op span이라 비용이 거의 없음
+// 헬스 체크/Swagger는 span을 만들지 않음 (프로브 요청이 트레이스를 채우지 않도록)
+
+// Tracing - OpenTelemetry 요청 추적 미들웨어
+// 사용 예:
+//
+//	router.Use(middleware.Tracing("eodini-api"))
+func Tracing(serviceName string) gin.HandlerFunc {
+	return otelgin.Middleware(serviceName,
+		otelgin.WithPropagators(tracing.Propagator()),
+		otelgin.WithFilter(traceable),
+	)
+}
+
+// traceable - span을 만들 요청인지 (헬스 체크/Swagger 제외)
+func traceable(r *http.Request) bool {
+	path := r.URL.Path
+	return !strings.HasPrefix(path, "/health") && !strings.HasPrefix(path, "/swagger")
+}
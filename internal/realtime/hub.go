@@ -0,0 +1,119 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/geofence"
+)
+
+// 📝 설명: 운행별 실시간 이벤트 구독 허브 (WebSocket 연결이 구독, 위치 전송이 발행)
+// 🎯 실무 포인트: 운행 ID 단위로 구독자를 묶어 해당 운행을 보는 연결에만 전달
+// ⚠️ 주의사항: 느린 구독자는 버퍼가 차면 메시지를 건너뜀 (위치는 다음 값이 이전 값을 대체하므로 허용)
+// 이 허브는 인스턴스 내부 구독자에게만 전달 (인스턴스 간 전달은 RedisBroker)
+
+// EventLocation - 차량 위치 이벤트 타입 (정류장 이벤트는 geofence.EventType 값 사용)
+const EventLocation = "location"
+
+// subscriptionBuffer - 구독자별 대기 메시지 수
+const subscriptionBuffer = 16
+
+// Event - 구독자에게 전달하는 메시지
+type Event struct {
+	Type   string      `json:"type"`
+	TripID string      `json:"trip_id"`
+	Data   interface{} `json:"data"`
+}
+
+// Subscription - 운행 하나에 대한 구독
+type Subscription struct {
+	TripID   string
+	messages chan []byte
+}
+
+// Messages - 수신 채널 (Unsubscribe 시 닫힘)
+func (s *Subscription) Messages() <-chan []byte {
+	return s.messages
+}
+
+// Hub - 운행별 구독자 관리 및 메시지 전달
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*Subscription]struct{}
+}
+
+// NewHub - 허브 생성
+func NewHub() *Hub {
+	return &Hub{subscribers: map[string]map[*Subscription]struct{}{}}
+}
+
+// Subscribe - 운행 구독 등록
+func (h *Hub) Subscribe(tripID string) *Subscription {
+	sub := &Subscription{TripID: tripID, messages: make(chan []byte, subscriptionBuffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[tripID] == nil {
+		h.subscribers[tripID] = map[*Subscription]struct{}{}
+	}
+	h.subscribers[tripID][sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe - 구독 해제 (연결 종료 시 호출, 여러 번 호출해도 안전)
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	subs, ok := h.subscribers[sub.TripID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	close(sub.messages)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.TripID)
+	}
+}
+
+// Broadcast - 운행 구독자에게 메시지 전달 (버퍼가 찬 구독자는 건너뜀)
+func (h *Hub) Broadcast(tripID string, message []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subscribers[tripID] {
+		select {
+		case sub.messages <- message:
+		default:
+		}
+	}
+}
+
+// SubscriberCount - 운행 구독자 수
+func (h *Hub) SubscriberCount(tripID string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscribers[tripID])
+}
+
+// PublishLocation - 차량 위치를 이 인스턴스의 운행 구독자에게 전달 (단일 인스턴스/테스트용 service.LocationPublisher)
+func (h *Hub) PublishLocation(ctx context.Context, position *domain.VehiclePosition) error {
+	message, err := json.Marshal(&Event{Type: EventLocation, TripID: position.TripID, Data: position})
+	if err != nil {
+		return err
+	}
+	h.Broadcast(position.TripID, message)
+	return nil
+}
+
+// HandleStopEvent - 정류장 이벤트를 이 인스턴스의 운행 구독자에게 전달 (단일 인스턴스/테스트용 geofence.Listener)
+func (h *Hub) HandleStopEvent(ctx context.Context, event geofence.Event) {
+	message, err := json.Marshal(&Event{Type: string(event.Type), TripID: event.TripID, Data: event})
+	if err != nil {
+		return
+	}
+	h.Broadcast(event.TripID, message)
+}
@@ -0,0 +1,101 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: Redis pub/sub으로 인스턴스 간 실시간 이벤트 전달
+// 🎯 실무 포인트: 인스턴스 A에 들어온 위치를 인스턴스 B에 연결된 구독자도 받도록 모든 이벤트를 Redis 채널로 발행
+// 각 인스턴스는 채널을 구독해 자기 Hub의 구독자에게만 전달 (발행한 인스턴스도 채널을 통해 받음)
+// ⚠️ 주의사항: pub/sub은 전달 보장이 없음 (구독 재연결 중 이벤트는 유실, 위치는 다음 값이 대체하므로 허용)
+
+// eventsChannel - 실시간 이벤트 채널
+const eventsChannel = "realtime:events"
+
+// RedisBroker - Redis pub/sub 기반 이벤트 발행/수신 (service.LocationPublisher, geofence.Listener 구현)
+type RedisBroker struct {
+	rdb *redis.Client
+	hub *Hub
+}
+
+// NewRedisBroker - 브로커 생성 (수신은 Run 호출 후 시작)
+// 사용 예: broker := realtime.NewRedisBroker(rdb, hub); go broker.Run(ctx)
+func NewRedisBroker(rdb *redis.Client, hub *Hub) *RedisBroker {
+	return &RedisBroker{rdb: rdb, hub: hub}
+}
+
+// PublishLocation - 차량 위치를 모든 인스턴스에 발행
+func (b *RedisBroker) PublishLocation(ctx context.Context, position *domain.VehiclePosition) error {
+	return b.publish(ctx, &Event{Type: EventLocation, TripID: position.TripID, Data: position})
+}
+
+// HandleStopEvent - 정류장 접근/도착/출발을 모든 인스턴스에 발행
+func (b *RedisBroker) HandleStopEvent(ctx context.Context, event geofence.Event) {
+	if err := b.publish(ctx, &Event{Type: string(event.Type), TripID: event.TripID, Data: event}); err != nil {
+		logger.Warn("Failed to publish stop event", map[string]interface{}{
+			"trip_id": event.TripID,
+			"error":   err.Error(),
+		})
+	}
+}
+
+// publish - 이벤트 발행 (Redis 발행 실패 시 이 인스턴스 구독자에게라도 직접 전달)
+func (b *RedisBroker) publish(ctx context.Context, event *Event) error {
+	message, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if err := b.rdb.Publish(ctx, eventsChannel, message).Err(); err != nil {
+		logger.Warn("Realtime broker unavailable, delivering locally", map[string]interface{}{
+			"trip_id": event.TripID,
+			"error":   err.Error(),
+		})
+		b.hub.Broadcast(event.TripID, message)
+	}
+	return nil
+}
+
+// Run - 채널을 구독해 수신한 이벤트를 Hub로 전달 (ctx 취소 시 종료)
+// 연결이 끊기면 go-redis가 자동으로 재구독
+func (b *RedisBroker) Run(ctx context.Context) {
+	pubsub := b.rdb.Subscribe(ctx, eventsChannel)
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			logger.Warn("Failed to close realtime subscription", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			b.deliver(msg.Payload)
+		}
+	}
+}
+
+// deliver - 이벤트의 운행 ID로 Hub 구독자에게 전달
+func (b *RedisBroker) deliver(payload string) {
+	var event struct {
+		TripID string `json:"trip_id"`
+	}
+	if err := json.Unmarshal([]byte(payload), &event); err != nil || event.TripID == "" {
+		logger.Warn("Dropped malformed realtime event", nil)
+		return
+	}
+	b.hub.Broadcast(event.TripID, []byte(payload))
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: API 키 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 인증 시 키 해시로 단건 조회 (unique index)
+// ⚠️ 주의사항: 폐기된 키도 조회 대상 (Service에서 사용 가능 여부 판단)
+
+// APIKeyFilter - API 키 목록 조회 조건
+type APIKeyFilter struct {
+	IncludeRevoked bool // 폐기된 키 포함 여부
+	ListOptions         // 페이지 범위, 정렬, 필드 필터
+}
+
+// APIKeyListSpec - API 키 목록 정렬/필터 허용 필드
+var APIKeyListSpec = ListSpec{
+	Sortable:    columns("created_at", "name", "last_used_at", "expires_at"),
+	Filterable:  columns("created_by"),
+	DefaultSort: []SortField{{Column: "created_at", Desc: true}},
+}
+
+// APIKeyRepository - API 키 저장소 인터페이스
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) error
+	GetByID(ctx context.Context, id string) (*domain.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	List(ctx context.Context, filter APIKeyFilter) ([]*domain.APIKey, int64, error)
+	Update(ctx context.Context, key *domain.APIKey) error
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
+
+// apiKeyRepository - GORM 기반 구현체
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepository - API 키 Repository 생성
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+// Create - API 키 저장
+func (r *apiKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	return database.Conn(ctx, r.db).Create(key).Error
+}
+
+// GetByID - ID로 API 키 조회
+func (r *apiKeyRepository) GetByID(ctx context.Context, id string) (*domain.APIKey, error) {
+	var key domain.APIKey
+	if err := database.Conn(ctx, r.db).Where("id = ?", id).First(&key).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &key, nil
+}
+
+// GetByHash - 키 해시로 조회 (인증용)
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	var key domain.APIKey
+	if err := database.Conn(ctx, r.db).Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &key, nil
+}
+
+// List - 조건에 맞는 API 키 목록과 전체 개수 조회
+func (r *apiKeyRepository) List(ctx context.Context, filter APIKeyFilter) ([]*domain.APIKey, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.APIKey{})
+
+	if !filter.IncludeRevoked {
+		query = query.Where("revoked_at IS NULL")
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, APIKeyListSpec.DefaultSort...))
+
+	var keys []*domain.APIKey
+	if err := query.Find(&keys).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return keys, total, nil
+}
+
+// Update - API 키 수정 (전체 필드 저장)
+func (r *apiKeyRepository) Update(ctx context.Context, key *domain.APIKey) error {
+	result := database.Conn(ctx, r.db).Model(key).Select("*").Updates(key)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// TouchLastUsed - 마지막 사용 시각 갱신 (updated_at은 변경하지 않음)
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&domain.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", usedAt).Error
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 동승자 대체 배정 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 운행 생성은 날짜로 한 번에 조회 (일정마다 조회하지 않음)
+// ⚠️ 주의사항: Soft Delete → 모든 조회에 deleted_at IS NULL 조건
+
+// AttendantAssignmentRepository - 동승자 대체 배정 저장소 인터페이스
+type AttendantAssignmentRepository interface {
+	Create(ctx context.Context, assignment *domain.AttendantAssignment) error
+	GetByID(ctx context.Context, id string) (*domain.AttendantAssignment, error)
+	ListBySchedule(ctx context.Context, scheduleID string) ([]*domain.AttendantAssignment, error) // 시작일 순
+	ListActiveOn(ctx context.Context, date time.Time) ([]*domain.AttendantAssignment, error)      // 날짜가 기간에 포함된 배정
+	Update(ctx context.Context, assignment *domain.AttendantAssignment) error
+	SoftDelete(ctx context.Context, id string) error
+}
+
+// attendantAssignmentRepository - GORM 기반 구현체
+type attendantAssignmentRepository struct {
+	db *gorm.DB
+}
+
+// NewAttendantAssignmentRepository - 동승자 대체 배정 Repository 생성
+func NewAttendantAssignmentRepository(db *gorm.DB) AttendantAssignmentRepository {
+	return &attendantAssignmentRepository{db: db}
+}
+
+// Create - 대체 배정 생성
+func (r *attendantAssignmentRepository) Create(ctx context.Context, assignment *domain.AttendantAssignment) error {
+	return database.Conn(ctx, r.db).Create(assignment).Error
+}
+
+// GetByID - 대체 배정 단건 조회
+func (r *attendantAssignmentRepository) GetByID(ctx context.Context, id string) (*domain.AttendantAssignment, error) {
+	var assignment domain.AttendantAssignment
+	err := database.Conn(ctx, r.db).Where("id = ? AND deleted_at IS NULL", id).First(&assignment).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &assignment, nil
+}
+
+// ListBySchedule - 일정의 대체 배정 목록 (시작일 순)
+func (r *attendantAssignmentRepository) ListBySchedule(ctx context.Context, scheduleID string) ([]*domain.AttendantAssignment, error) {
+	var assignments []*domain.AttendantAssignment
+	err := database.Conn(ctx, r.db).
+		Where("schedule_id = ? AND deleted_at IS NULL", scheduleID).
+		Order("start_date").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+// ListActiveOn - 날짜가 기간에 포함된 대체 배정 목록
+func (r *attendantAssignmentRepository) ListActiveOn(ctx context.Context, date time.Time) ([]*domain.AttendantAssignment, error) {
+	day := date.Format(time.DateOnly)
+	var assignments []*domain.AttendantAssignment
+	err := database.Conn(ctx, r.db).
+		Where("start_date <= ? AND end_date >= ? AND deleted_at IS NULL", day, day).
+		Find(&assignments).Error
+	return assignments, err
+}
+
+// Update - 대체 배정 수정 (전체 필드 저장)
+func (r *attendantAssignmentRepository) Update(ctx context.Context, assignment *domain.AttendantAssignment) error {
+	result := database.Conn(ctx, r.db).
+		Model(assignment).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(assignment)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 대체 배정 삭제 (deleted_at 설정)
+func (r *attendantAssignmentRepository) SoftDelete(ctx context.Context, id string) error {
+	now := time.Now()
+	result := database.Conn(ctx, r.db).
+		Model(&domain.AttendantAssignment{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
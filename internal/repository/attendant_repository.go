@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 동승자 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 역할(선생님/간호사 등)과 운행 시작 권한으로 필터링
+// ⚠️ 주의사항: 운행 시작 권한은 Service에서 도메인 메소드로만 변경
+
+// AttendantFilter - 동승자 목록 조회 조건
+type AttendantFilter struct {
+	Status       domain.AttendantStatus // 상태 필터 (빈 값이면 전체)
+	Role         domain.AttendantRole   // 역할 필터 (빈 값이면 전체)
+	CanStartTrip *bool                  // 운행 시작 권한 보유 여부
+	ListOptions                         // 페이지 범위, 정렬, 필드 필터
+}
+
+// AttendantListSpec - 동승자 목록 정렬/필터 허용 필드
+var AttendantListSpec = ListSpec{
+	Sortable:    columns("name", "role", "status", "hire_date", "created_at"),
+	Filterable:  columns("status", "role", "organization"),
+	DefaultSort: []SortField{{Column: "name"}},
+}
+
+// AttendantRepository - 동승자 저장소 인터페이스
+type AttendantRepository interface {
+	Create(ctx context.Context, attendant *domain.Attendant) error
+	GetByID(ctx context.Context, id string) (*domain.Attendant, error)
+	List(ctx context.Context, filter AttendantFilter) ([]*domain.Attendant, int64, error)
+	Update(ctx context.Context, attendant *domain.Attendant) error
+	SoftDelete(ctx context.Context, id string) error
+}
+
+// attendantRepository - GORM 기반 구현체
+type attendantRepository struct {
+	db *gorm.DB
+}
+
+// NewAttendantRepository - 동승자 Repository 생성
+func NewAttendantRepository(db *gorm.DB) AttendantRepository {
+	return &attendantRepository{db: db}
+}
+
+// Create - 동승자 저장
+func (r *attendantRepository) Create(ctx context.Context, attendant *domain.Attendant) error {
+	return database.Conn(ctx, r.db).Create(attendant).Error
+}
+
+// GetByID - ID로 동승자 조회
+func (r *attendantRepository) GetByID(ctx context.Context, id string) (*domain.Attendant, error) {
+	var attendant domain.Attendant
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&attendant).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &attendant, nil
+}
+
+// List - 조건에 맞는 동승자 목록과 전체 개수 조회
+func (r *attendantRepository) List(ctx context.Context, filter AttendantFilter) ([]*domain.Attendant, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Attendant{}).Where("deleted_at IS NULL")
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.CanStartTrip != nil {
+		query = query.Where("can_start_trip = ?", *filter.CanStartTrip)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, AttendantListSpec.DefaultSort...))
+
+	var attendants []*domain.Attendant
+	if err := query.Find(&attendants).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return attendants, total, nil
+}
+
+// Update - 동승자 정보 수정 (전체 필드 저장)
+func (r *attendantRepository) Update(ctx context.Context, attendant *domain.Attendant) error {
+	result := database.Conn(ctx, r.db).
+		Model(attendant).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(attendant)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 동승자 삭제 (deleted_at 설정)
+func (r *attendantRepository) SoftDelete(ctx context.Context, id string) error {
+	now := time.Now()
+	result := database.Conn(ctx, r.db).
+		Model(&domain.Attendant{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
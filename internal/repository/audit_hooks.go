@@ -0,0 +1,398 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/encryption"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// 📝 설명: 감사 로그 자동 기록 (GORM 생성/수정/삭제 콜백)
+// 🎯 실무 포인트: Repository마다 기록 코드를 넣지 않고 콜백에서 일괄 처리 → 새 엔티티도 자동으로 감사 대상
+// 행위자는 context의 인증 주체(auth.FromContext), 요청 ID는 logger.RequestIDFromContext에서 조회
+// 기관은 변경된 행의 organization_id (없으면 현재 기관) → 기관 관리자는 자기 기관의 기록만 조회
+// ⚠️ 주의사항: 같은 트랜잭션에 기록하므로 감사 로그 저장 실패 시 원래 변경도 롤백됨
+
+// auditSnapshotKey - 변경 전 행을 Statement에 보관하는 키
+const auditSnapshotKey = "audit:before"
+
+// auditSkipTables - 감사 대상에서 제외하는 테이블
+var auditSkipTables = map[string]bool{
+	"audit_logs":            true, // 자기 자신
+	"password_reset_tokens": true, // 일회용 토큰 (비밀번호 변경 자체는 users에 기록)
+	"trip_locations":        true, // 운행 중 수 초마다 쌓이는 GPS 기록
+	"trip_alerts":           true, // 위치 수신 시 시스템이 생성하는 경보
+	"device_tokens":         true, // 앱 실행마다 갱신되는 푸시 토큰
+	"notification_logs":     true, // 발송/재시도마다 시스템이 갱신하는 발송 기록
+	"holidays":              true, // 주기 작업이 외부 API에서 동기화하는 공휴일
+}
+
+// auditIgnoredColumns - 비교에서 제외하는 컬럼 (이 컬럼만 바뀐 변경은 기록하지 않음)
+var auditIgnoredColumns = map[string]bool{
+	"created_at":   true,
+	"updated_at":   true,
+	"last_used_at": true, // API 키 사용 시각 (인증 시마다 갱신)
+}
+
+// auditRedactionKey - 변경 전 값을 모두 가리도록 표시하는 context 키
+type auditRedactionKey struct{}
+
+// WithAuditRedaction - 이 context로 실행한 변경은 변경 전 값을 기록하지 않음
+// 사용 예: 개인정보 익명화 시 원래 값이 감사 로그에 복사되지 않도록
+func WithAuditRedaction(ctx context.Context) context.Context {
+	return context.WithValue(ctx, auditRedactionKey{}, true)
+}
+
+// auditRedactionFromContext - WithAuditRedaction 적용 여부
+func auditRedactionFromContext(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	redact, _ := ctx.Value(auditRedactionKey{}).(bool)
+	return redact
+}
+
+// RegisterAuditHooks - GORM 콜백에 감사 로그 기록 등록
+// 사용 예: DB 연결 직후 repository.RegisterAuditHooks(db) 한 번 호출
+func RegisterAuditHooks(db *gorm.DB) error {
+	callback := db.Callback()
+
+	if err := callback.Create().After("gorm:after_create").Before("gorm:commit_or_rollback_transaction").
+		Register("audit:after_create", auditAfterCreate); err != nil {
+		return err
+	}
+
+	if err := callback.Update().After("gorm:setup_reflect_value").Before("gorm:update").
+		Register("audit:before_update", auditSnapshot); err != nil {
+		return err
+	}
+	if err := callback.Update().After("gorm:after_update").Before("gorm:commit_or_rollback_transaction").
+		Register("audit:after_update", auditAfterUpdate); err != nil {
+		return err
+	}
+
+	if err := callback.Delete().After("gorm:begin_transaction").Before("gorm:delete").
+		Register("audit:before_delete", auditSnapshot); err != nil {
+		return err
+	}
+	return callback.Delete().After("gorm:after_delete").Before("gorm:commit_or_rollback_transaction").
+		Register("audit:after_delete", auditAfterDelete)
+}
+
+// auditable - 감사 대상 Statement인지 확인
+func auditable(db *gorm.DB) bool {
+	stmt := db.Statement
+	return db.Error == nil && stmt.Schema != nil && len(stmt.Schema.PrimaryFields) > 0 && !auditSkipTables[stmt.Table]
+}
+
+// auditSnapshot - 수정/삭제 대상 행을 변경 전에 조회해서 보관
+func auditSnapshot(db *gorm.DB) {
+	if !auditable(db) {
+		return
+	}
+
+	stmt := db.Statement
+	query := db.Session(&gorm.Session{NewDB: true}).Table(stmt.Table)
+	conditions := 0
+
+	if where, ok := stmt.Clauses["WHERE"]; ok && where.Expression != nil {
+		query = query.Clauses(where.Expression)
+		conditions++
+	}
+	// Model(x).Updates(x) 형태는 기본키 조건이 gorm:update 단계에서 추가되므로 직접 붙임
+	if stmt.ReflectValue.Kind() == reflect.Struct {
+		for _, field := range stmt.Schema.PrimaryFields {
+			if value, zero := field.ValueOf(stmt.Context, stmt.ReflectValue); !zero {
+				query = query.Where(clause.Eq{Column: clause.Column{Name: field.DBName}, Value: value})
+				conditions++
+			}
+		}
+	}
+	if conditions == 0 {
+		return
+	}
+
+	var rows []map[string]interface{}
+	if err := query.Find(&rows).Error; err != nil {
+		db.AddError(fmt.Errorf("audit: failed to load rows before change: %w", err))
+		return
+	}
+	db.InstanceSet(auditSnapshotKey, rows)
+}
+
+// auditAfterCreate - 생성된 행 기록
+func auditAfterCreate(db *gorm.DB) {
+	if !auditable(db) || db.Statement.RowsAffected == 0 {
+		return
+	}
+
+	stmt := db.Statement
+	var keys []map[string]interface{}
+	collect := func(value reflect.Value) {
+		key := map[string]interface{}{}
+		for _, field := range stmt.Schema.PrimaryFields {
+			v, zero := field.ValueOf(stmt.Context, value)
+			if zero {
+				return
+			}
+			key[field.DBName] = v
+		}
+		keys = append(keys, key)
+	}
+
+	switch stmt.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < stmt.ReflectValue.Len(); i++ {
+			collect(reflect.Indirect(stmt.ReflectValue.Index(i)))
+		}
+	case reflect.Struct:
+		collect(stmt.ReflectValue)
+	}
+
+	rows, err := auditLoadRows(db, keys)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+
+	logs := make([]*domain.AuditLog, 0, len(rows))
+	for _, row := range rows {
+		if changes := auditDiff(db, nil, row); len(changes) > 0 {
+			logs = append(logs, newAuditLog(db, row, domain.AuditActionCreate, changes))
+		}
+	}
+	auditSave(db, logs)
+}
+
+// auditAfterUpdate - 변경 전/후 비교 기록 (deleted_at이 채워지면 삭제, 비워지면 복구로 기록)
+func auditAfterUpdate(db *gorm.DB) {
+	before := auditSnapshotRows(db)
+	if len(before) == 0 || db.Error != nil || db.Statement.RowsAffected == 0 {
+		return
+	}
+
+	after, err := auditLoadRows(db, before)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+	afterByKey := make(map[string]map[string]interface{}, len(after))
+	for _, row := range after {
+		afterByKey[auditEntityID(db, row)] = row
+	}
+
+	logs := make([]*domain.AuditLog, 0, len(before))
+	for _, row := range before {
+		current, ok := afterByKey[auditEntityID(db, row)]
+		if !ok {
+			continue
+		}
+		changes := auditDiff(db, row, current)
+		if len(changes) == 0 {
+			continue
+		}
+
+		action := domain.AuditActionUpdate
+		switch {
+		case row["deleted_at"] == nil && current["deleted_at"] != nil:
+			action = domain.AuditActionDelete
+		case row["deleted_at"] != nil && current["deleted_at"] == nil:
+			action = domain.AuditActionRestore
+		}
+		logs = append(logs, newAuditLog(db, row, action, changes))
+	}
+	auditSave(db, logs)
+}
+
+// auditAfterDelete - 삭제된 행 기록 (변경 전 값만 남김)
+func auditAfterDelete(db *gorm.DB) {
+	before := auditSnapshotRows(db)
+	if len(before) == 0 || db.Error != nil || db.Statement.RowsAffected == 0 {
+		return
+	}
+
+	logs := make([]*domain.AuditLog, 0, len(before))
+	for _, row := range before {
+		logs = append(logs, newAuditLog(db, row, domain.AuditActionDelete, auditDiff(db, row, nil)))
+	}
+	auditSave(db, logs)
+}
+
+// auditSnapshotRows - auditSnapshot이 보관한 변경 전 행
+func auditSnapshotRows(db *gorm.DB) []map[string]interface{} {
+	value, ok := db.InstanceGet(auditSnapshotKey)
+	if !ok {
+		return nil
+	}
+	rows, _ := value.([]map[string]interface{})
+	return rows
+}
+
+// auditLoadRows - 기본키 목록으로 현재 행 조회
+func auditLoadRows(db *gorm.DB, keys []map[string]interface{}) ([]map[string]interface{}, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	conditions := make([]clause.Expression, 0, len(keys))
+	for _, key := range keys {
+		eqs := make([]clause.Expression, 0, len(db.Statement.Schema.PrimaryFields))
+		for _, field := range db.Statement.Schema.PrimaryFields {
+			eqs = append(eqs, clause.Eq{Column: clause.Column{Name: field.DBName}, Value: key[field.DBName]})
+		}
+		conditions = append(conditions, clause.And(eqs...))
+	}
+
+	var rows []map[string]interface{}
+	err := db.Session(&gorm.Session{NewDB: true}).
+		Table(db.Statement.Table).
+		Where(clause.Or(conditions...)).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("audit: failed to load rows after change: %w", err)
+	}
+	return rows, nil
+}
+
+// auditDiff - 컬럼별 변경 전/후 값 (before 또는 after가 nil이면 생성/삭제)
+func auditDiff(db *gorm.DB, before, after map[string]interface{}) map[string]domain.AuditChange {
+	columns := map[string]bool{}
+	for column := range before {
+		columns[column] = true
+	}
+	for column := range after {
+		columns[column] = true
+	}
+
+	redactBefore := auditRedactionFromContext(db.Statement.Context)
+	changes := map[string]domain.AuditChange{}
+	for column := range columns {
+		if auditIgnoredColumns[column] {
+			continue
+		}
+
+		field := db.Statement.Schema.LookUpField(column)
+		oldValue, newValue := auditValue(before[column]), auditValue(after[column])
+		if isEncryptedField(field) {
+			// 암호문은 저장할 때마다 달라지므로 복호화한 값으로 비교
+			oldValue, newValue = auditDecrypt(oldValue), auditDecrypt(newValue)
+		}
+		if auditEqual(oldValue, newValue) {
+			continue
+		}
+
+		if auditRedacted(field) {
+			if oldValue != nil {
+				oldValue = domain.AuditRedacted
+			}
+			if newValue != nil {
+				newValue = domain.AuditRedacted
+			}
+		}
+		if redactBefore && oldValue != nil {
+			oldValue = domain.AuditRedacted
+		}
+		changes[column] = domain.AuditChange{Before: oldValue, After: newValue}
+	}
+	return changes
+}
+
+// auditRedacted - 값 대신 표시만 기록하는 필드 (json:"-" 필드, 암호화 컬럼)
+func auditRedacted(field *schema.Field) bool {
+	return field != nil && (field.Tag.Get("json") == "-" || isEncryptedField(field))
+}
+
+// auditDecrypt - 암호화 컬럼 값 복호화 (비교용, 실패하면 원래 값)
+func auditDecrypt(value interface{}) interface{} {
+	stored, ok := value.(string)
+	if !ok || !encryption.IsEncrypted(stored) {
+		return value
+	}
+	cipher, err := currentFieldCipher()
+	if err != nil {
+		return value
+	}
+	plaintext, err := cipher.Decrypt(stored)
+	if err != nil {
+		return value
+	}
+	return plaintext
+}
+
+// auditValue - 드라이버 값을 JSON으로 기록 가능한 형태로 변환
+func auditValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		if json.Valid(v) {
+			return json.RawMessage(v)
+		}
+		return string(v)
+	case [16]byte:
+		return uuid.UUID(v).String()
+	case time.Time:
+		return v.UTC()
+	}
+	return value
+}
+
+// auditEqual - 변경 여부 비교
+func auditEqual(a, b interface{}) bool {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		return ok && at.Equal(bt)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// auditEntityID - 기본키 값 (복합키는 ":"로 연결)
+func auditEntityID(db *gorm.DB, row map[string]interface{}) string {
+	parts := make([]string, 0, len(db.Statement.Schema.PrimaryFields))
+	for _, field := range db.Statement.Schema.PrimaryFields {
+		parts = append(parts, fmt.Sprint(auditValue(row[field.DBName])))
+	}
+	return strings.Join(parts, ":")
+}
+
+// newAuditLog - 기관/행위자/요청 ID를 채운 감사 로그 생성
+func newAuditLog(db *gorm.DB, row map[string]interface{}, action domain.AuditAction, changes map[string]domain.AuditChange) *domain.AuditLog {
+	ctx := db.Statement.Context
+	log := domain.NewAuditLog(db.Statement.Table, auditEntityID(db, row), action, changes)
+	log.OrganizationID = auditOrganization(ctx, row)
+	if principal, ok := auth.FromContext(ctx); ok {
+		log.ActorID = principal.UserID
+		log.ActorRole = principal.Role
+	}
+	log.RequestID = logger.RequestIDFromContext(ctx)
+	return log
+}
+
+// auditOrganization - 감사 로그의 기관 (변경된 행의 기관 → 현재 기관 순, 둘 다 없으면 플랫폼 작업으로 빈 값)
+func auditOrganization(ctx context.Context, row map[string]interface{}) string {
+	if value := row[tenantColumn]; value != nil {
+		if organizationID := fmt.Sprint(auditValue(value)); organizationID != "" {
+			return organizationID
+		}
+	}
+	return OrganizationFromContext(ctx)
+}
+
+// auditSave - 같은 트랜잭션으로 감사 로그 저장 (실패 시 원래 변경도 롤백)
+func auditSave(db *gorm.DB, logs []*domain.AuditLog) {
+	if len(logs) == 0 {
+		return
+	}
+	if err := db.Session(&gorm.Session{NewDB: true}).Create(&logs).Error; err != nil {
+		db.AddError(fmt.Errorf("audit: failed to save audit logs: %w", err))
+	}
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 감사 로그 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 변경 기록은 GORM 콜백(audit_hooks.go)이 자동 저장, 여기서는 조회 위주
+// ⚠️ 주의사항: 수정/삭제 메서드는 제공하지 않음 (append-only)
+// 예외: 보존 기간 경과로 익명화된 엔티티의 과거 값 가리기(RedactEntity)
+
+// AuditLogFilter - 감사 로그 목록 조회 조건
+type AuditLogFilter struct {
+	EntityType  string
+	EntityID    string
+	ActorID     string
+	ListOptions // 페이지 범위, 정렬, 필드 필터
+}
+
+// AuditLogListSpec - 감사 로그 목록 정렬/필터 허용 필드
+var AuditLogListSpec = ListSpec{
+	Sortable:    columns("created_at"),
+	Filterable:  columns("entity_type", "entity_id", "action", "actor_id", "actor_role"),
+	DefaultSort: []SortField{{Column: "created_at", Desc: true}},
+}
+
+// AuditLogRepository - 감사 로그 저장소 인터페이스
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *domain.AuditLog) error
+	List(ctx context.Context, filter AuditLogFilter) ([]*domain.AuditLog, int64, error)
+	RedactEntity(ctx context.Context, entityType, entityID string, columns []string) error
+}
+
+// auditLogRepository - GORM 기반 구현체
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository - 감사 로그 Repository 생성
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+// Create - 감사 로그 저장 (DB 변경이 아닌 이벤트를 직접 기록할 때 사용)
+func (r *auditLogRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	return database.Conn(ctx, r.db).Create(log).Error
+}
+
+// List - 조건에 맞는 감사 로그 목록과 전체 개수 조회 (최신순)
+func (r *auditLogRepository) List(ctx context.Context, filter AuditLogFilter) ([]*domain.AuditLog, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.AuditLog{})
+
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, AuditLogListSpec.DefaultSort...))
+
+	var logs []*domain.AuditLog
+	if err := query.Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
+
+// RedactEntity - 엔티티의 과거 감사 로그에서 지정 컬럼 값을 가림 (개인정보 익명화 시)
+// 변경 이력(언제, 누가, 어떤 컬럼)은 남기고 값만 domain.AuditRedacted로 대체
+func (r *auditLogRepository) RedactEntity(ctx context.Context, entityType, entityID string, columns []string) error {
+	var logs []*domain.AuditLog
+	err := database.Conn(ctx, r.db).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Find(&logs).Error
+	if err != nil {
+		return err
+	}
+
+	for _, log := range logs {
+		if !log.RedactColumns(columns) {
+			continue
+		}
+		if err := database.Conn(ctx, r.db).Model(log).Select("changes").Updates(log).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 캘린더 구독 토큰 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 계정당 한 행, 재발급은 사용자 ID 기준 upsert로 해시 교체 → 이전 주소 즉시 무효
+// ⚠️ 주의사항: 조회는 해시로만 (원문 토큰은 저장하지 않음)
+
+// CalendarTokenRepository - 캘린더 구독 토큰 저장소 인터페이스
+type CalendarTokenRepository interface {
+	Upsert(ctx context.Context, token *domain.CalendarToken) error // 같은 사용자의 토큰이 있으면 해시/발급 시각 교체
+	GetByHash(ctx context.Context, tokenHash string) (*domain.CalendarToken, error)
+	DeleteByUser(ctx context.Context, userID string) error // 토큰이 없으면 ErrNotFound
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
+
+// calendarTokenRepository - GORM 기반 구현체
+type calendarTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewCalendarTokenRepository - 캘린더 구독 토큰 Repository 생성
+func NewCalendarTokenRepository(db *gorm.DB) CalendarTokenRepository {
+	return &calendarTokenRepository{db: db}
+}
+
+// Upsert - 캘린더 구독 토큰 발급 (이미 있으면 새 해시로 교체하고 사용 기록 초기화)
+func (r *calendarTokenRepository) Upsert(ctx context.Context, token *domain.CalendarToken) error {
+	token.UpdatedAt = time.Now()
+	token.LastUsedAt = nil
+	return database.Conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"token_hash", "last_used_at", "updated_at"}),
+		}).
+		Create(token).Error
+}
+
+// GetByHash - 토큰 해시로 조회
+func (r *calendarTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.CalendarToken, error) {
+	var token domain.CalendarToken
+	if err := database.Conn(ctx, r.db).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &token, nil
+}
+
+// DeleteByUser - 사용자의 캘린더 구독 토큰 삭제 (구독 해제)
+func (r *calendarTokenRepository) DeleteByUser(ctx context.Context, userID string) error {
+	result := database.Conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Delete(&domain.CalendarToken{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// TouchLastUsed - 마지막 사용 시각 기록
+func (r *calendarTokenRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&domain.CalendarToken{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", usedAt).Error
+}
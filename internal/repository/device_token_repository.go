@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 푸시 수신 기기 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 토큰 기준 upsert → 같은 기기의 재등록/계정 전환을 한 번에 처리
+// ⚠️ 주의사항: 무효 토큰 정리는 사용자와 무관하게 토큰 값으로 삭제
+
+// DeviceTokenRepository - 기기 토큰 저장소 인터페이스
+type DeviceTokenRepository interface {
+	Upsert(ctx context.Context, device *domain.DeviceToken) error // 같은 토큰이 있으면 사용자/기기 종류/등록 시각 교체
+	ListByUser(ctx context.Context, userID string) ([]*domain.DeviceToken, error)
+	Delete(ctx context.Context, userID, token string) error           // 사용자의 토큰이 아니면 ErrNotFound
+	DeleteTokens(ctx context.Context, tokens []string) (int64, error) // 무효 토큰 정리
+}
+
+// deviceTokenRepository - GORM 기반 구현체
+type deviceTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewDeviceTokenRepository - 기기 토큰 Repository 생성
+func NewDeviceTokenRepository(db *gorm.DB) DeviceTokenRepository {
+	return &deviceTokenRepository{db: db}
+}
+
+// Upsert - 기기 토큰 등록 (이미 있으면 소유자 이전/갱신 시각 반영)
+func (r *deviceTokenRepository) Upsert(ctx context.Context, device *domain.DeviceToken) error {
+	device.UpdatedAt = time.Now()
+	return database.Conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "token"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "updated_at"}),
+		}).
+		Create(device).Error
+}
+
+// ListByUser - 사용자의 기기 토큰 (최근 등록 순)
+func (r *deviceTokenRepository) ListByUser(ctx context.Context, userID string) ([]*domain.DeviceToken, error) {
+	var devices []*domain.DeviceToken
+	err := database.Conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("updated_at DESC").
+		Find(&devices).Error
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+// Delete - 사용자의 기기 토큰 삭제 (로그아웃, 알림 끄기)
+func (r *deviceTokenRepository) Delete(ctx context.Context, userID, token string) error {
+	result := database.Conn(ctx, r.db).
+		Where("user_id = ? AND token = ?", userID, token).
+		Delete(&domain.DeviceToken{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeleteTokens - 토큰 값으로 일괄 삭제 (삭제한 수 반환)
+func (r *deviceTokenRepository) DeleteTokens(ctx context.Context, tokens []string) (int64, error) {
+	if len(tokens) == 0 {
+		return 0, nil
+	}
+	result := database.Conn(ctx, r.db).
+		Where("token IN ?", tokens).
+		Delete(&domain.DeviceToken{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 기사 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 면허 만료 임박 조건을 SQL로 처리 (메모리 필터링 X)
+// ⚠️ 주의사항: 면허 만료 기준은 Driver.NeedsLicenseRenewal과 동일하게 유지
+
+// DriverFilter - 기사 목록 조회 조건
+type DriverFilter struct {
+	Status                domain.DriverStatus  // 상태 필터 (빈 값이면 전체)
+	LicenseStatus         domain.LicenseStatus // 면허 확인 상태 (빈 값이면 전체)
+	LicenseExpiringWithin *int                 // N일 이내 면허 만료 (이미 만료 포함)
+	IncludeDeleted        bool                 // 삭제된 기사 포함 (관리자 조회)
+	ListOptions                                // 페이지 범위, 정렬, 필드 필터
+}
+
+// DriverListSpec - 기사 목록 정렬/필터 허용 필드
+var DriverListSpec = ListSpec{
+	Sortable:    columns("name", "status", "license_expiry", "hire_date", "created_at"),
+	Filterable:  columns("status", "license_type"),
+	DefaultSort: []SortField{{Column: "name"}},
+}
+
+// DriverRepository - 기사 저장소 인터페이스
+type DriverRepository interface {
+	Create(ctx context.Context, driver *domain.Driver) error
+	GetByID(ctx context.Context, id string) (*domain.Driver, error)
+	GetByLicenseNumber(ctx context.Context, licenseNumber string) (*domain.Driver, error)
+	List(ctx context.Context, filter DriverFilter) ([]*domain.Driver, int64, error)
+	Update(ctx context.Context, driver *domain.Driver) error
+	SoftDelete(ctx context.Context, id string) error
+	GetDeleted(ctx context.Context, id string) (*domain.Driver, error) // 삭제된 기사 조회 (복구 전 확인용)
+	Restore(ctx context.Context, id string) error
+	ListForAnonymization(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Driver, error)
+	Anonymize(ctx context.Context, driver *domain.Driver) error
+}
+
+// driverRepository - GORM 기반 구현체
+type driverRepository struct {
+	db *gorm.DB
+}
+
+// NewDriverRepository - 기사 Repository 생성
+func NewDriverRepository(db *gorm.DB) DriverRepository {
+	return &driverRepository{db: db}
+}
+
+// Create - 기사 저장
+func (r *driverRepository) Create(ctx context.Context, driver *domain.Driver) error {
+	return database.Conn(ctx, r.db).Create(driver).Error
+}
+
+// GetByID - ID로 기사 조회
+func (r *driverRepository) GetByID(ctx context.Context, id string) (*domain.Driver, error) {
+	var driver domain.Driver
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&driver).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &driver, nil
+}
+
+// GetByLicenseNumber - 면허 번호로 조회 (중복 체크용)
+func (r *driverRepository) GetByLicenseNumber(ctx context.Context, licenseNumber string) (*domain.Driver, error) {
+	var driver domain.Driver
+	err := database.Conn(ctx, r.db).
+		Where("license_number = ? AND deleted_at IS NULL", licenseNumber).
+		First(&driver).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &driver, nil
+}
+
+// List - 조건에 맞는 기사 목록과 전체 개수 조회
+func (r *driverRepository) List(ctx context.Context, filter DriverFilter) ([]*domain.Driver, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Driver{}).Scopes(withDeleted(filter.IncludeDeleted))
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.LicenseStatus != "" {
+		query = query.Where("license_status = ?", filter.LicenseStatus)
+	}
+	if filter.LicenseExpiringWithin != nil {
+		deadline := time.Now().AddDate(0, 0, *filter.LicenseExpiringWithin)
+		query = query.Where("license_expiry < ?", deadline)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, DriverListSpec.DefaultSort...))
+
+	var drivers []*domain.Driver
+	if err := query.Find(&drivers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return drivers, total, nil
+}
+
+// Update - 기사 정보 수정 (전체 필드 저장)
+func (r *driverRepository) Update(ctx context.Context, driver *domain.Driver) error {
+	result := database.Conn(ctx, r.db).
+		Model(driver).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(driver)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 기사 삭제 (deleted_at 설정)
+func (r *driverRepository) SoftDelete(ctx context.Context, id string) error {
+	return softDelete(ctx, r.db, &domain.Driver{}, id)
+}
+
+// GetDeleted - 삭제된 기사 조회 (없거나 삭제되지 않았으면 ErrNotFound)
+func (r *driverRepository) GetDeleted(ctx context.Context, id string) (*domain.Driver, error) {
+	var driver domain.Driver
+	if err := getDeleted(ctx, r.db, &driver, id); err != nil {
+		return nil, err
+	}
+	return &driver, nil
+}
+
+// Restore - 삭제된 기사 복구 (deleted_at 해제)
+func (r *driverRepository) Restore(ctx context.Context, id string) error {
+	return restore(ctx, r.db, &domain.Driver{}, id)
+}
+
+// ListForAnonymization - 보존 기간이 지난 기사 조회 (cutoff 이전 퇴사 또는 삭제)
+// 삭제된 기사도 포함 (soft delete는 개인정보가 남아 있음)
+func (r *driverRepository) ListForAnonymization(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Driver, error) {
+	query := database.Conn(ctx, r.db).
+		Where("anonymized_at IS NULL").
+		Where("termination_date < ? OR deleted_at < ?", cutoff, cutoff).
+		Order("id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var drivers []*domain.Driver
+	if err := query.Find(&drivers).Error; err != nil {
+		return nil, err
+	}
+	return drivers, nil
+}
+
+// Anonymize - 개인정보 컬럼과 익명화 시각만 저장 (삭제된 행 포함)
+func (r *driverRepository) Anonymize(ctx context.Context, driver *domain.Driver) error {
+	columns := append([]string{"anonymized_at", "updated_at"}, domain.DriverPersonalColumns...)
+	result := database.Conn(ctx, r.db).
+		Model(driver).
+		Where("anonymized_at IS NULL").
+		Select(columns).
+		Updates(driver)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/encryption"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// 📝 설명: 민감 컬럼 투명 암호화 (GORM serializer:encrypted)
+// 🎯 실무 포인트: 도메인 필드에 `gorm:"serializer:encrypted"`만 붙이면 저장 시 암호화, 조회 시 복호화
+// Service/Handler는 평문만 다루므로 암호화 여부를 신경 쓰지 않음
+// ⚠️ 주의사항: 암호문 컬럼은 WHERE 검색 불가 → 일치 검색은 BlindIndex 컬럼(예: guardians.phone_hash)으로
+
+// encryptedSerializerName - 도메인 태그에서 사용하는 serializer 이름
+const encryptedSerializerName = "encrypted"
+
+// errFieldCipherMissing - UseFieldCipher 호출 전 암호화 컬럼 접근
+var errFieldCipherMissing = errors.New("field cipher is not configured")
+
+// fieldCipher - 애플리케이션 전역 컬럼 암호화기 (serializer는 전역 등록이라 함께 전역으로 보관)
+var fieldCipher atomic.Pointer[encryption.FieldCipher]
+
+func init() {
+	schema.RegisterSerializer(encryptedSerializerName, encryptedSerializer{})
+}
+
+// UseFieldCipher - 컬럼 암호화기 설정 (DB 연결 직후 한 번)
+// 사용 예: repository.UseFieldCipher(cipher)
+func UseFieldCipher(cipher *encryption.FieldCipher) {
+	fieldCipher.Store(cipher)
+}
+
+// currentFieldCipher - 설정된 암호화기 조회
+func currentFieldCipher() (*encryption.FieldCipher, error) {
+	cipher := fieldCipher.Load()
+	if cipher == nil {
+		return nil, errFieldCipherMissing
+	}
+	return cipher, nil
+}
+
+// blindIndex - 일치 검색용 해시 (빈 값은 빈 문자열)
+func blindIndex(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	cipher, err := currentFieldCipher()
+	if err != nil {
+		return "", err
+	}
+	return cipher.BlindIndex(value), nil
+}
+
+// encryptedSerializer - 문자열 필드를 AES-GCM 암호문으로 저장
+type encryptedSerializer struct{}
+
+// Scan - DB 값 복호화 (접두사 없는 기존 평문은 그대로)
+func (encryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var stored string
+	switch v := dbValue.(type) {
+	case nil:
+	case string:
+		stored = v
+	case []byte:
+		stored = string(v)
+	default:
+		return fmt.Errorf("encrypted field %s: unsupported db value %T", field.Name, dbValue)
+	}
+
+	plaintext := stored
+	if encryption.IsEncrypted(stored) {
+		cipher, err := currentFieldCipher()
+		if err != nil {
+			return err
+		}
+		if plaintext, err = cipher.Decrypt(stored); err != nil {
+			return fmt.Errorf("encrypted field %s: %w", field.Name, err)
+		}
+	}
+	return field.Set(ctx, dst, plaintext)
+}
+
+// Value - 저장 전 암호화
+func (encryptedSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	plaintext, ok := fieldValue.(string)
+	if !ok {
+		return nil, fmt.Errorf("encrypted field %s: must be string, got %T", field.Name, fieldValue)
+	}
+	if plaintext == "" {
+		return "", nil
+	}
+
+	cipher, err := currentFieldCipher()
+	if err != nil {
+		return nil, err
+	}
+	return cipher.Encrypt(plaintext)
+}
+
+// isEncryptedField - serializer:encrypted 컬럼 여부
+func isEncryptedField(field *schema.Field) bool {
+	return field != nil && field.TagSettings["SERIALIZER"] == encryptedSerializerName
+}
+
+// EncryptExistingFields - 암호화 도입 전 저장된 평문 데이터를 암호화 (재실행해도 안전)
+// 조회 시 평문은 그대로 읽히므로 다시 저장하면 serializer가 암호화하고 검색용 해시도 채움
+func EncryptExistingFields(ctx context.Context, db *gorm.DB) (int, error) {
+	count := 0
+
+	var passengers []*domain.Passenger
+	err := database.Conn(ctx, db).
+		Where(plaintextCondition("guardian_phone") + " OR " + plaintextCondition("medical_notes") + " OR " + plaintextCondition("emergency_contact") + " OR guardian_phone_hash IS NULL").
+		Find(&passengers).Error
+	if err != nil {
+		return count, err
+	}
+	for _, passenger := range passengers {
+		if err := setPassengerPhoneHash(passenger); err != nil {
+			return count, err
+		}
+		if err := database.Conn(ctx, db).Model(passenger).Select("guardian_phone", "guardian_phone_hash", "medical_notes", "emergency_contact").Updates(passenger).Error; err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	var guardians []*domain.Guardian
+	if err := database.Conn(ctx, db).Where(plaintextCondition("phone") + " OR phone_hash IS NULL").Find(&guardians).Error; err != nil {
+		return count, err
+	}
+	for _, guardian := range guardians {
+		if err := setGuardianPhoneHash(guardian); err != nil {
+			return count, err
+		}
+		if err := database.Conn(ctx, db).Model(guardian).Select("phone", "phone_hash").Updates(guardian).Error; err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// plaintextCondition - 아직 암호화되지 않은 값이 있는 컬럼 조건
+func plaintextCondition(column string) string {
+	return fmt.Sprintf("(%s <> '' AND %s NOT LIKE 'enc:%%')", column, column)
+}
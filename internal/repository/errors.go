@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// 📝 설명: Repository 계층 공용 에러
+// 🎯 실무 포인트: GORM 에러를 그대로 노출하지 않고 Repository 에러로 변환
+// ⚠️ 주의사항: Service 계층에서 ErrNotFound를 NewNotFoundError로 변환해서 사용
+
+// ErrNotFound - 조회 결과 없음
+var ErrNotFound = errors.New("record not found")
+
+// translateError - GORM 에러를 Repository 에러로 변환
+func translateError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 만료 안내 발송 기록 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 발송 전에 기록부터 선점(Claim) → 같은 안내를 다른 인스턴스가 이미 기록했으면 보내지 않음
+// ⚠️ 주의사항: 선점 후 발송이 실패해도 다시 보내지 않음 (중복 안내보다 누락 1회가 낫다고 판단, 다음 구간에 다시 안내)
+
+// ExpiryReminderRepository - 만료 안내 발송 기록 저장소 인터페이스
+type ExpiryReminderRepository interface {
+	Claim(ctx context.Context, reminder *domain.ExpiryReminder) (bool, error) // 같은 대상·만료일·구간 기록이 이미 있으면 false
+}
+
+// expiryReminderRepository - GORM 기반 구현체
+type expiryReminderRepository struct {
+	db *gorm.DB
+}
+
+// NewExpiryReminderRepository - 만료 안내 발송 기록 Repository 생성
+func NewExpiryReminderRepository(db *gorm.DB) ExpiryReminderRepository {
+	return &expiryReminderRepository{db: db}
+}
+
+// Claim - 발송 기록 저장 (유니크 충돌이면 이미 안내한 것으로 보고 false)
+func (r *expiryReminderRepository) Claim(ctx context.Context, reminder *domain.ExpiryReminder) (bool, error) {
+	result := database.Conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "kind"}, {Name: "subject_id"}, {Name: "expires_on"}, {Name: "window_days"}},
+			DoNothing: true,
+		}).
+		Create(reminder)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 업로드 파일 메타데이터 Repository (PostgreSQL + GORM, 원본은 internal/storage)
+// 🎯 실무 포인트: 파일을 참조하는 리소스(차량 서류 등)는 file_id로 연결 → 조회 시 Preload("File")
+// ⚠️ 주의사항: 참조 중인 파일은 외래 키로 삭제가 막힘 → 리소스 삭제 후 파일 삭제
+
+// FileRepository - 업로드 파일 저장소 인터페이스
+type FileRepository interface {
+	Create(ctx context.Context, file *domain.File) error
+	GetByID(ctx context.Context, id string) (*domain.File, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// fileRepository - GORM 기반 구현체
+type fileRepository struct {
+	db *gorm.DB
+}
+
+// NewFileRepository - 업로드 파일 Repository 생성
+func NewFileRepository(db *gorm.DB) FileRepository {
+	return &fileRepository{db: db}
+}
+
+// Create - 업로드 파일 생성
+func (r *fileRepository) Create(ctx context.Context, file *domain.File) error {
+	return database.Conn(ctx, r.db).Create(file).Error
+}
+
+// GetByID - ID로 업로드 파일 조회
+func (r *fileRepository) GetByID(ctx context.Context, id string) (*domain.File, error) {
+	var file domain.File
+	if err := database.Conn(ctx, r.db).Where("id = ?", id).First(&file).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &file, nil
+}
+
+// Delete - 업로드 파일 삭제
+func (r *fileRepository) Delete(ctx context.Context, id string) error {
+	result := database.Conn(ctx, r.db).Where("id = ?", id).Delete(&domain.File{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
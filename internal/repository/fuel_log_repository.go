@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 차량 주유 기록 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 연비는 직전 주유와 비교하므로 주유 시각 순 조회 + 기간 직전 기록(LatestBefore)을 함께 사용
+// ⚠️ 주의사항: 기간(From/To)은 주유 시각 기준 [From, To) → 날짜 경계는 Service에서 기관 시간대로 계산
+
+// FuelLogFilter - 주유 기록 조회 조건
+type FuelLogFilter struct {
+	VehicleID string     // 빈 값이면 전체 차량
+	From      *time.Time // 주유 시각 시작 (포함)
+	To        *time.Time // 주유 시각 끝 (제외)
+}
+
+// FuelLogRepository - 주유 기록 저장소 인터페이스
+type FuelLogRepository interface {
+	Create(ctx context.Context, log *domain.FuelLog) error
+	List(ctx context.Context, filter FuelLogFilter) ([]*domain.FuelLog, error)                  // 주유 시각 순
+	LatestBefore(ctx context.Context, vehicleID string, at time.Time) (*domain.FuelLog, error)  // at 이전 마지막 기록 (없으면 ErrNotFound)
+	EarliestAfter(ctx context.Context, vehicleID string, at time.Time) (*domain.FuelLog, error) // at 이후 첫 기록 (없으면 ErrNotFound)
+	Delete(ctx context.Context, vehicleID, id string) error                                     // 차량의 기록이 아니면 ErrNotFound
+}
+
+// fuelLogRepository - GORM 기반 구현체
+type fuelLogRepository struct {
+	db *gorm.DB
+}
+
+// NewFuelLogRepository - 주유 기록 Repository 생성
+func NewFuelLogRepository(db *gorm.DB) FuelLogRepository {
+	return &fuelLogRepository{db: db}
+}
+
+// Create - 주유 기록 생성
+func (r *fuelLogRepository) Create(ctx context.Context, log *domain.FuelLog) error {
+	return database.Conn(ctx, r.db).Create(log).Error
+}
+
+// List - 조건에 맞는 주유 기록 (주유 시각, 주행거리계 순)
+func (r *fuelLogRepository) List(ctx context.Context, filter FuelLogFilter) ([]*domain.FuelLog, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.FuelLog{})
+	if filter.VehicleID != "" {
+		query = query.Where("vehicle_id = ?", filter.VehicleID)
+	}
+	if filter.From != nil {
+		query = query.Where("filled_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("filled_at < ?", *filter.To)
+	}
+
+	var logs []*domain.FuelLog
+	err := query.Order("filled_at, odometer_km").Find(&logs).Error
+	return logs, err
+}
+
+// LatestBefore - 차량의 at 이전 마지막 주유 기록
+func (r *fuelLogRepository) LatestBefore(ctx context.Context, vehicleID string, at time.Time) (*domain.FuelLog, error) {
+	var log domain.FuelLog
+	err := database.Conn(ctx, r.db).
+		Where("vehicle_id = ? AND filled_at < ?", vehicleID, at).
+		Order("filled_at DESC, odometer_km DESC").
+		First(&log).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &log, nil
+}
+
+// EarliestAfter - 차량의 at 이후 첫 주유 기록
+func (r *fuelLogRepository) EarliestAfter(ctx context.Context, vehicleID string, at time.Time) (*domain.FuelLog, error) {
+	var log domain.FuelLog
+	err := database.Conn(ctx, r.db).
+		Where("vehicle_id = ? AND filled_at > ?", vehicleID, at).
+		Order("filled_at, odometer_km").
+		First(&log).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &log, nil
+}
+
+// Delete - 주유 기록 삭제
+func (r *fuelLogRepository) Delete(ctx context.Context, vehicleID, id string) error {
+	result := database.Conn(ctx, r.db).
+		Where("id = ? AND vehicle_id = ?", id, vehicleID).
+		Delete(&domain.FuelLog{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
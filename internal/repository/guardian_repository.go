@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 보호자 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 보호자-탑승자 연결은 guardian_passengers 조인 테이블로 관리
+// ⚠️ 주의사항: 연결 해제는 hard delete (이력은 보존하지 않음)
+
+// GuardianFilter - 보호자 목록 조회 조건
+type GuardianFilter struct {
+	Status      domain.GuardianStatus // 상태 필터 (빈 값이면 전체)
+	Name        string                // 이름 부분 검색
+	Phone       string                // 연락처 일치
+	ListOptions                       // 페이지 범위, 정렬, 필드 필터
+}
+
+// GuardianListSpec - 보호자 목록 정렬/필터 허용 필드
+var GuardianListSpec = ListSpec{
+	Sortable:    columns("name", "status", "created_at"),
+	Filterable:  columns("status"),
+	DefaultSort: []SortField{{Column: "name"}},
+}
+
+// GuardianRepository - 보호자 저장소 인터페이스
+type GuardianRepository interface {
+	Create(ctx context.Context, guardian *domain.Guardian) error
+	GetByID(ctx context.Context, id string) (*domain.Guardian, error)
+	GetByPhone(ctx context.Context, phone string) (*domain.Guardian, error)
+	List(ctx context.Context, filter GuardianFilter) ([]*domain.Guardian, int64, error)
+	Update(ctx context.Context, guardian *domain.Guardian) error
+	SoftDelete(ctx context.Context, id string) error
+
+	// 탑승자 연결
+	LinkPassenger(ctx context.Context, link *domain.GuardianPassenger) error
+	UnlinkPassenger(ctx context.Context, guardianID, passengerID string) error
+	GetLink(ctx context.Context, guardianID, passengerID string) (*domain.GuardianPassenger, error)
+	ListLinks(ctx context.Context, guardianID string) ([]domain.GuardianPassenger, error)
+	ListPassengerLinks(ctx context.Context, passengerID string) ([]domain.GuardianPassenger, error)
+}
+
+// guardianRepository - GORM 기반 구현체
+type guardianRepository struct {
+	db *gorm.DB
+}
+
+// NewGuardianRepository - 보호자 Repository 생성
+func NewGuardianRepository(db *gorm.DB) GuardianRepository {
+	return &guardianRepository{db: db}
+}
+
+// Create - 보호자 저장
+func (r *guardianRepository) Create(ctx context.Context, guardian *domain.Guardian) error {
+	if err := setGuardianPhoneHash(guardian); err != nil {
+		return err
+	}
+	return database.Conn(ctx, r.db).Create(guardian).Error
+}
+
+// GetByID - ID로 보호자 조회
+func (r *guardianRepository) GetByID(ctx context.Context, id string) (*domain.Guardian, error) {
+	var guardian domain.Guardian
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&guardian).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &guardian, nil
+}
+
+// GetByPhone - 연락처로 조회 (중복 체크용, 암호문 대신 검색용 해시로 비교)
+func (r *guardianRepository) GetByPhone(ctx context.Context, phone string) (*domain.Guardian, error) {
+	phoneHash, err := blindIndex(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	var guardian domain.Guardian
+	err = database.Conn(ctx, r.db).
+		Where("phone_hash = ? AND deleted_at IS NULL", phoneHash).
+		First(&guardian).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &guardian, nil
+}
+
+// List - 조건에 맞는 보호자 목록과 전체 개수 조회
+func (r *guardianRepository) List(ctx context.Context, filter GuardianFilter) ([]*domain.Guardian, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Guardian{}).Where("deleted_at IS NULL")
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Name != "" {
+		query = query.Where("name ILIKE ?", "%"+filter.Name+"%")
+	}
+	if filter.Phone != "" {
+		phoneHash, err := blindIndex(filter.Phone)
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where("phone_hash = ?", phoneHash)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, GuardianListSpec.DefaultSort...))
+
+	var guardians []*domain.Guardian
+	if err := query.Find(&guardians).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return guardians, total, nil
+}
+
+// Update - 보호자 정보 수정 (전체 필드 저장)
+func (r *guardianRepository) Update(ctx context.Context, guardian *domain.Guardian) error {
+	if err := setGuardianPhoneHash(guardian); err != nil {
+		return err
+	}
+
+	result := database.Conn(ctx, r.db).
+		Model(guardian).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(guardian)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 보호자 삭제 (deleted_at 설정 + 자녀 연결 해제)
+func (r *guardianRepository) SoftDelete(ctx context.Context, id string) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&domain.Guardian{}).
+			Where("id = ? AND deleted_at IS NULL", id).
+			Updates(map[string]interface{}{
+				"deleted_at": now,
+				"updated_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return tx.Where("guardian_id = ?", id).Delete(&domain.GuardianPassenger{}).Error
+	})
+}
+
+// LinkPassenger - 보호자-탑승자 연결 저장
+func (r *guardianRepository) LinkPassenger(ctx context.Context, link *domain.GuardianPassenger) error {
+	return database.Conn(ctx, r.db).Create(link).Error
+}
+
+// UnlinkPassenger - 보호자-탑승자 연결 삭제
+func (r *guardianRepository) UnlinkPassenger(ctx context.Context, guardianID, passengerID string) error {
+	result := database.Conn(ctx, r.db).
+		Where("guardian_id = ? AND passenger_id = ?", guardianID, passengerID).
+		Delete(&domain.GuardianPassenger{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetLink - 보호자-탑승자 연결 조회
+func (r *guardianRepository) GetLink(ctx context.Context, guardianID, passengerID string) (*domain.GuardianPassenger, error) {
+	var link domain.GuardianPassenger
+	err := database.Conn(ctx, r.db).
+		Where("guardian_id = ? AND passenger_id = ?", guardianID, passengerID).
+		First(&link).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &link, nil
+}
+
+// ListLinks - 보호자에 연결된 탑승자 목록 (연결 순)
+func (r *guardianRepository) ListLinks(ctx context.Context, guardianID string) ([]domain.GuardianPassenger, error) {
+	var links []domain.GuardianPassenger
+	err := database.Conn(ctx, r.db).
+		Where("guardian_id = ?", guardianID).
+		Order("created_at ASC").
+		Find(&links).Error
+	return links, err
+}
+
+// ListPassengerLinks - 탑승자에 연결된 보호자 목록 (연결 순)
+func (r *guardianRepository) ListPassengerLinks(ctx context.Context, passengerID string) ([]domain.GuardianPassenger, error) {
+	var links []domain.GuardianPassenger
+	err := database.Conn(ctx, r.db).
+		Where("passenger_id = ?", passengerID).
+		Order("created_at ASC").
+		Find(&links).Error
+	return links, err
+}
+
+// setGuardianPhoneHash - 연락처 검색용 해시 계산
+func setGuardianPhoneHash(guardian *domain.Guardian) error {
+	phoneHash, err := blindIndex(guardian.Phone)
+	if err != nil {
+		return err
+	}
+	guardian.PhoneHash = phoneHash
+	return nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 공휴일 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 동기화는 날짜 기준 upsert → 같은 연도를 여러 번 받아도 행이 늘지 않음
+// ⚠️ 주의사항: 저장된 공휴일이 없는 날짜는 ErrNotFound → 서비스에서 기본 공휴일 표 확인
+
+// HolidayRepository - 공휴일 저장소 인터페이스
+type HolidayRepository interface {
+	Get(ctx context.Context, date time.Time) (*domain.Holiday, error)
+	ListRange(ctx context.Context, from, to time.Time) ([]*domain.Holiday, error)
+	Upsert(ctx context.Context, holidays []*domain.Holiday) error
+}
+
+// holidayRepository - GORM 기반 구현체
+type holidayRepository struct {
+	db *gorm.DB
+}
+
+// NewHolidayRepository - 공휴일 Repository 생성
+func NewHolidayRepository(db *gorm.DB) HolidayRepository {
+	return &holidayRepository{db: db}
+}
+
+// Get - 날짜의 공휴일 조회
+func (r *holidayRepository) Get(ctx context.Context, date time.Time) (*domain.Holiday, error) {
+	var holiday domain.Holiday
+	err := database.Conn(ctx, r.db).Where("date = ?", date.Format(time.DateOnly)).First(&holiday).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &holiday, nil
+}
+
+// ListRange - 기간(from~to, 양 끝 포함)의 공휴일 목록 (날짜 순)
+func (r *holidayRepository) ListRange(ctx context.Context, from, to time.Time) ([]*domain.Holiday, error) {
+	var holidays []*domain.Holiday
+	err := database.Conn(ctx, r.db).
+		Where("date BETWEEN ? AND ?", from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Order("date").
+		Find(&holidays).Error
+	return holidays, err
+}
+
+// Upsert - 공휴일 저장 (이미 있는 날짜면 이름/출처 교체)
+func (r *holidayRepository) Upsert(ctx context.Context, holidays []*domain.Holiday) error {
+	if len(holidays) == 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, holiday := range holidays {
+		holiday.UpdatedAt = now
+	}
+	return database.Conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "source", "updated_at"}),
+		}).
+		Create(&holidays).Error
+}
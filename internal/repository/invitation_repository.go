@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 기관 직원 초대 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 목록/취소는 테넌트 훅으로 관리자 소속 기관 초대만 대상, 수락(토큰 조회)은 인증 주체가 없어 전체 기관 대상
+// ⚠️ 주의사항: 수락/취소는 대기 중인 초대에만 조건부 UPDATE → 동시에 처리해도 한 번만 성공
+
+// InvitationRepository - 초대 저장소 인터페이스
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *domain.Invitation) error
+	GetByHash(ctx context.Context, tokenHash string) (*domain.Invitation, error)
+	ListPending(ctx context.Context, now time.Time) ([]*domain.Invitation, error)
+	MarkAccepted(ctx context.Context, id, userID string, at time.Time) error // 대기 중이 아니면 ErrNotFound
+	Revoke(ctx context.Context, id string, at time.Time) error               // 대기 중이 아니면 ErrNotFound
+}
+
+// invitationRepository - GORM 기반 구현체
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+// NewInvitationRepository - 초대 Repository 생성
+func NewInvitationRepository(db *gorm.DB) InvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+// Create - 초대 저장
+func (r *invitationRepository) Create(ctx context.Context, invitation *domain.Invitation) error {
+	return database.Conn(ctx, r.db).Create(invitation).Error
+}
+
+// GetByHash - 토큰 해시로 조회
+func (r *invitationRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.Invitation, error) {
+	var invitation domain.Invitation
+	if err := database.Conn(ctx, r.db).Where("token_hash = ?", tokenHash).First(&invitation).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &invitation, nil
+}
+
+// ListPending - 수락 대기 중인 초대 목록 (최근 초대 순)
+func (r *invitationRepository) ListPending(ctx context.Context, now time.Time) ([]*domain.Invitation, error) {
+	var invitations []*domain.Invitation
+	err := database.Conn(ctx, r.db).
+		Scopes(pendingInvitation(now)).
+		Order("created_at DESC").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// MarkAccepted - 수락 처리 (생성된 계정 연결)
+func (r *invitationRepository) MarkAccepted(ctx context.Context, id, userID string, at time.Time) error {
+	return r.finish(ctx, id, at, map[string]interface{}{
+		"accepted_at":      at,
+		"accepted_user_id": userID,
+		"updated_at":       at,
+	})
+}
+
+// Revoke - 초대 취소
+func (r *invitationRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	return r.finish(ctx, id, at, map[string]interface{}{
+		"revoked_at": at,
+		"updated_at": at,
+	})
+}
+
+// finish - 대기 중인 초대에만 변경 적용 (없거나 이미 처리된 초대면 ErrNotFound)
+func (r *invitationRepository) finish(ctx context.Context, id string, at time.Time, updates map[string]interface{}) error {
+	result := database.Conn(ctx, r.db).
+		Model(&domain.Invitation{}).
+		Scopes(pendingInvitation(at)).
+		Where("id = ?", id).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// pendingInvitation - 수락/취소되지 않았고 만료되지 않은 초대만
+func pendingInvitation(now time.Time) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?", now)
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"slices"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 목록 조회 공통 옵션 (페이지 범위, 정렬, 필드 일치 필터)
+// 🎯 실무 포인트: 리소스별 ListSpec에 선언된 API 필드만 DB 컬럼으로 바꿔 쿼리에 반영
+// 컬럼명은 코드에 선언된 값만 식별자로 쓰고 필터 값은 바인딩 파라미터로 전달 (사용자 입력이 SQL에 섞이지 않음)
+// ⚠️ 주의사항: 필터는 일치(=) 비교만 지원, 범위/부분 검색/암호화 컬럼은 리소스별 Filter 필드로 처리
+
+// SortField - 정렬 기준 컬럼
+type SortField struct {
+	Column string // DB 컬럼 (ListSpec.Sortable의 값)
+	Desc   bool   // 내림차순 여부
+}
+
+// ListOptions - 목록 공통 조회 옵션 (리소스별 Filter에 임베드)
+type ListOptions struct {
+	Offset  int               // 조회 시작 위치
+	Limit   int               // 조회 개수 (0이면 제한 없음)
+	Sort    []SortField       // 정렬 (비어 있으면 리소스 기본 정렬)
+	Filters map[string]string // DB 컬럼 → 일치 값
+}
+
+// ListSpec - 리소스별 정렬/필터 허용 필드 (API 필드명 → DB 컬럼)
+type ListSpec struct {
+	Sortable    map[string]string
+	Filterable  map[string]string
+	DefaultSort []SortField // 정렬 미지정 시 기본 정렬 (지정 시에도 동순위 정렬 기준으로 뒤에 붙음)
+}
+
+// columns - API 필드명과 DB 컬럼이 같은 필드 목록을 ListSpec 매핑으로 변환
+func columns(names ...string) map[string]string {
+	mapping := make(map[string]string, len(names))
+	for _, name := range names {
+		mapping[name] = name
+	}
+	return mapping
+}
+
+// filterScope - 필드 일치 필터 (전체 개수 조회 전에 적용)
+func filterScope(opts ListOptions) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		keys := make([]string, 0, len(opts.Filters))
+		for column := range opts.Filters {
+			keys = append(keys, column)
+		}
+		slices.Sort(keys) // 쿼리 문자열을 일정하게 유지
+
+		for _, column := range keys {
+			db = db.Where(clause.Eq{Column: clause.Column{Name: column}, Value: opts.Filters[column]})
+		}
+		return db
+	}
+}
+
+// pageScope - 정렬과 페이지 범위 (요청한 정렬 뒤에 기본 정렬을 붙여 페이지 간 순서 고정)
+func pageScope(opts ListOptions, defaultSort ...SortField) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		sorted := make(map[string]bool, len(opts.Sort))
+		for _, field := range append(slices.Clone(opts.Sort), defaultSort...) {
+			if sorted[field.Column] {
+				continue
+			}
+			sorted[field.Column] = true
+			db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: field.Column}, Desc: field.Desc})
+		}
+
+		if opts.Limit > 0 {
+			db = db.Offset(opts.Offset).Limit(opts.Limit)
+		}
+		return db
+	}
+}
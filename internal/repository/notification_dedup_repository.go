@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: 알림 중복 발송 방지 (Redis)
+// 🎯 실무 포인트: "운행 + 정류장"처럼 한 번만 보낼 알림 키를 SETNX로 선점, 먼저 선점한 요청만 발송
+// 여러 서버 인스턴스가 같은 이벤트를 받아도 한 번만 발송
+// ⚠️ 주의사항: TTL이 지나면 같은 키로 다시 발송될 수 있으므로 운행 하루보다 길게 설정
+
+const notificationDedupKeyPrefix = "notification:dedup:"
+
+// NotificationDedupRepository - 알림 중복 방지 저장소 인터페이스
+type NotificationDedupRepository interface {
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) // 처음 선점하면 true
+}
+
+// notificationDedupRepository - Redis 기반 구현체
+type notificationDedupRepository struct {
+	rdb *redis.Client
+}
+
+// NewNotificationDedupRepository - 알림 중복 방지 Repository 생성
+func NewNotificationDedupRepository(rdb *redis.Client) NotificationDedupRepository {
+	return &notificationDedupRepository{rdb: rdb}
+}
+
+// Claim - 키 선점 (이미 선점된 키면 false)
+func (r *notificationDedupRepository) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return r.rdb.SetNX(ctx, notificationDedupKeyPrefix+key, 1, ttl).Result()
+}
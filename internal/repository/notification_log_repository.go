@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 알림 발송 기록 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 발송 직후 기록, 재시도 작업은 ClaimDue로 때가 된 기록을 가져가 결과를 Update
+// ⚠️ 주의사항: 여러 인스턴스가 동시에 재시도해도 같은 알림을 두 번 보내지 않도록
+// ClaimDue는 행 잠금(SKIP LOCKED) 후 next_attempt_at을 lease만큼 미뤄서 가져감
+
+// NotificationLogFilter - 발송 기록 목록 조회 조건
+type NotificationLogFilter struct {
+	GuardianID  string
+	Status      domain.NotificationStatus
+	Channel     domain.NotificationChannel
+	Event       domain.NotificationEvent
+	ListOptions // 페이지 범위, 정렬, 필드 필터
+}
+
+// NotificationLogListSpec - 알림 발송 기록 목록 정렬/필터 허용 필드
+var NotificationLogListSpec = ListSpec{
+	Sortable:    columns("created_at", "sent_at", "attempts"),
+	Filterable:  columns("guardian_id", "status", "channel", "event"),
+	DefaultSort: []SortField{{Column: "created_at", Desc: true}},
+}
+
+// NotificationLogRepository - 알림 발송 기록 저장소 인터페이스
+type NotificationLogRepository interface {
+	Create(ctx context.Context, log *domain.NotificationLog) error
+	Update(ctx context.Context, log *domain.NotificationLog) error
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.NotificationLog, error)
+	List(ctx context.Context, filter NotificationLogFilter) ([]*domain.NotificationLog, int64, error)
+}
+
+// notificationLogRepository - GORM 기반 구현체
+type notificationLogRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationLogRepository - 알림 발송 기록 Repository 생성
+func NewNotificationLogRepository(db *gorm.DB) NotificationLogRepository {
+	return &notificationLogRepository{db: db}
+}
+
+// Create - 발송 기록 저장
+func (r *notificationLogRepository) Create(ctx context.Context, log *domain.NotificationLog) error {
+	return database.Conn(ctx, r.db).Create(log).Error
+}
+
+// Update - 재시도 결과 반영
+func (r *notificationLogRepository) Update(ctx context.Context, log *domain.NotificationLog) error {
+	return database.Conn(ctx, r.db).
+		Model(log).
+		Select("status", "channel", "attempts", "response", "next_attempt_at", "sent_at", "updated_at").
+		Updates(log).Error
+}
+
+// ClaimDue - 재시도할 때가 된 기록을 가져가고 lease 동안 다른 인스턴스가 가져가지 못하게 함 (오래 기다린 순)
+func (r *notificationLogRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.NotificationLog, error) {
+	var logs []*domain.NotificationLog
+	err := database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", domain.NotificationStatusRetrying, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&logs).Error
+		if err != nil || len(logs) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(logs))
+		for i, log := range logs {
+			ids[i] = log.ID
+		}
+		return tx.Model(&domain.NotificationLog{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// List - 조건에 맞는 발송 기록 목록과 전체 개수 조회 (최신순)
+func (r *notificationLogRepository) List(ctx context.Context, filter NotificationLogFilter) ([]*domain.NotificationLog, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.NotificationLog{})
+
+	if filter.GuardianID != "" {
+		query = query.Where("guardian_id = ?", filter.GuardianID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Channel != "" {
+		query = query.Where("channel = ?", filter.Channel)
+	}
+	if filter.Event != "" {
+		query = query.Where("event = ?", filter.Event)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, NotificationLogListSpec.DefaultSort...))
+
+	var logs []*domain.NotificationLog
+	if err := query.Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 보호자 알림 수신 설정 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 보호자당 한 행, 저장은 보호자 ID 기준 upsert
+// ⚠️ 주의사항: 설정하지 않은 보호자는 ErrNotFound → 서비스에서 기본값 사용
+
+// NotificationPreferenceRepository - 알림 수신 설정 저장소 인터페이스
+type NotificationPreferenceRepository interface {
+	Get(ctx context.Context, guardianID string) (*domain.NotificationPreference, error)
+	Upsert(ctx context.Context, preference *domain.NotificationPreference) error
+}
+
+// notificationPreferenceRepository - GORM 기반 구현체
+type notificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationPreferenceRepository - 알림 수신 설정 Repository 생성
+func NewNotificationPreferenceRepository(db *gorm.DB) NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+// Get - 보호자의 알림 수신 설정 조회
+func (r *notificationPreferenceRepository) Get(ctx context.Context, guardianID string) (*domain.NotificationPreference, error) {
+	var preference domain.NotificationPreference
+	err := database.Conn(ctx, r.db).Where("guardian_id = ?", guardianID).First(&preference).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &preference, nil
+}
+
+// Upsert - 알림 수신 설정 저장 (이미 있으면 채널/알림 종류 교체)
+func (r *notificationPreferenceRepository) Upsert(ctx context.Context, preference *domain.NotificationPreference) error {
+	preference.UpdatedAt = time.Now()
+	return database.Conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "guardian_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"channels", "events", "updated_at"}),
+		}).
+		Create(preference).Error
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 차량 주행거리계 기록 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 기록은 추가만 (수정/삭제 없음) → 잘못된 값은 관리자 입력(manual) 기록으로 보정
+// ⚠️ 주의사항: 기간(From/To)은 기록 시각 기준 [From, To) → 날짜 경계는 Service에서 기관 시간대로 계산
+
+// OdometerReadingFilter - 주행거리계 기록 조회 조건
+type OdometerReadingFilter struct {
+	VehicleID string
+	From      *time.Time // 기록 시각 시작 (포함)
+	To        *time.Time // 기록 시각 끝 (제외)
+}
+
+// OdometerReadingRepository - 주행거리계 기록 저장소 인터페이스
+type OdometerReadingRepository interface {
+	Create(ctx context.Context, reading *domain.OdometerReading) error
+	List(ctx context.Context, filter OdometerReadingFilter) ([]*domain.OdometerReading, error) // 최신 기록 먼저
+}
+
+// odometerReadingRepository - GORM 기반 구현체
+type odometerReadingRepository struct {
+	db *gorm.DB
+}
+
+// NewOdometerReadingRepository - 주행거리계 기록 Repository 생성
+func NewOdometerReadingRepository(db *gorm.DB) OdometerReadingRepository {
+	return &odometerReadingRepository{db: db}
+}
+
+// Create - 주행거리계 기록 생성
+func (r *odometerReadingRepository) Create(ctx context.Context, reading *domain.OdometerReading) error {
+	return database.Conn(ctx, r.db).Create(reading).Error
+}
+
+// List - 조건에 맞는 주행거리계 기록 (기록 시각 역순)
+func (r *odometerReadingRepository) List(ctx context.Context, filter OdometerReadingFilter) ([]*domain.OdometerReading, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.OdometerReading{}).
+		Where("vehicle_id = ?", filter.VehicleID)
+	if filter.From != nil {
+		query = query.Where("recorded_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("recorded_at < ?", *filter.To)
+	}
+
+	var readings []*domain.OdometerReading
+	err := query.Order("recorded_at DESC, created_at DESC").Find(&readings).Error
+	return readings, err
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 기관 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 기관 테이블 자체에는 organization_id가 없어 테넌트 훅 대상이 아님 → 조회 범위는 Service가 제한
+// ⚠️ 주의사항: DeletedAt이 *time.Time이므로 soft delete 필터를 직접 적용
+
+// OrganizationRepository - 기관 저장소 인터페이스
+type OrganizationRepository interface {
+	Create(ctx context.Context, organization *domain.Organization) error
+	GetByID(ctx context.Context, id string) (*domain.Organization, error)
+	Update(ctx context.Context, organization *domain.Organization) error
+	ListAllowedOrigins(ctx context.Context) ([]string, error) // 전체 기관의 웹 앱 허용 Origin (CORS)
+}
+
+// organizationRepository - GORM 기반 구현체
+type organizationRepository struct {
+	db *gorm.DB
+}
+
+// NewOrganizationRepository - 기관 Repository 생성
+func NewOrganizationRepository(db *gorm.DB) OrganizationRepository {
+	return &organizationRepository{db: db}
+}
+
+// Create - 기관 저장
+func (r *organizationRepository) Create(ctx context.Context, organization *domain.Organization) error {
+	return database.Conn(ctx, r.db).Create(organization).Error
+}
+
+// GetByID - ID로 기관 조회
+func (r *organizationRepository) GetByID(ctx context.Context, id string) (*domain.Organization, error) {
+	var organization domain.Organization
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&organization).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &organization, nil
+}
+
+// Update - 기관 정보 수정
+// Save는 대상이 없으면 INSERT로 동작하므로 Select("*").Updates 사용
+func (r *organizationRepository) Update(ctx context.Context, organization *domain.Organization) error {
+	result := database.Conn(ctx, r.db).
+		Model(organization).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(organization)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListAllowedOrigins - 삭제되지 않은 기관의 허용 Origin 전체 (중복 포함)
+func (r *organizationRepository) ListAllowedOrigins(ctx context.Context) ([]string, error) {
+	var rows []*domain.Organization
+	err := database.Conn(ctx, r.db).
+		Select("allowed_origins").
+		Where("deleted_at IS NULL AND allowed_origins <> '[]'::jsonb").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var origins []string
+	for _, row := range rows {
+		origins = append(origins, row.AllowedOrigins...)
+	}
+	return origins, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 아웃박스 이벤트 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: Create는 Conn(ctx)로 서비스의 트랜잭션에 참여 → 엔티티 변경과 이벤트 기록이 함께 커밋/롤백
+// ⚠️ 주의사항: ClaimDue는 행 잠금(SKIP LOCKED) 후 available_at을 lease만큼 미뤄서 가져감
+// → 여러 인스턴스가 동시에 릴레이해도 같은 이벤트를 동시에 처리하지 않음
+
+// OutboxRepository - 아웃박스 이벤트 저장소 인터페이스
+type OutboxRepository interface {
+	Create(ctx context.Context, event *domain.OutboxEvent) error
+	Update(ctx context.Context, event *domain.OutboxEvent) error
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.OutboxEvent, error)
+}
+
+// outboxRepository - GORM 기반 구현체
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository - 아웃박스 Repository 생성
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// Create - 이벤트 기록 (context에 트랜잭션이 있으면 참여)
+func (r *outboxRepository) Create(ctx context.Context, event *domain.OutboxEvent) error {
+	return database.Conn(ctx, r.db).Create(event).Error
+}
+
+// Update - 전달 결과 반영
+func (r *outboxRepository) Update(ctx context.Context, event *domain.OutboxEvent) error {
+	return database.Conn(ctx, r.db).
+		Model(event).
+		Select("status", "attempts", "handled", "last_error", "available_at", "published_at", "updated_at").
+		Updates(event).Error
+}
+
+// ClaimDue - 전달할 때가 된 이벤트를 가져가고 lease 동안 다른 인스턴스가 가져가지 못하게 함 (발생 순)
+func (r *outboxRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.OutboxEvent, error) {
+	var events []*domain.OutboxEvent
+	err := database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND available_at <= ?", domain.OutboxPending, now).
+			Order("created_at").
+			Limit(limit).
+			Find(&events).Error
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(events))
+		for i, event := range events {
+			ids[i] = event.ID
+		}
+		return tx.Model(&domain.OutboxEvent{}).
+			Where("id IN ?", ids).
+			Update("available_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
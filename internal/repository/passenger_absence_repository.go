@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 탑승자 결석 신고 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 운행 생성은 날짜로 한 번에 조회 (탑승자마다 조회하지 않음)
+// ⚠️ 주의사항: Soft Delete(신고 취소) → 모든 조회에 deleted_at IS NULL 조건
+
+// PassengerAbsenceRepository - 결석 신고 저장소 인터페이스
+type PassengerAbsenceRepository interface {
+	Create(ctx context.Context, absence *domain.PassengerAbsence) error
+	GetByID(ctx context.Context, id string) (*domain.PassengerAbsence, error)
+	ListByPassenger(ctx context.Context, passengerID string, from time.Time) ([]*domain.PassengerAbsence, error) // from 이후 날짜 순
+	ListByDate(ctx context.Context, date time.Time) ([]*domain.PassengerAbsence, error)
+	SoftDelete(ctx context.Context, id string) error
+}
+
+// passengerAbsenceRepository - GORM 기반 구현체
+type passengerAbsenceRepository struct {
+	db *gorm.DB
+}
+
+// NewPassengerAbsenceRepository - 결석 신고 Repository 생성
+func NewPassengerAbsenceRepository(db *gorm.DB) PassengerAbsenceRepository {
+	return &passengerAbsenceRepository{db: db}
+}
+
+// Create - 결석 신고 생성
+func (r *passengerAbsenceRepository) Create(ctx context.Context, absence *domain.PassengerAbsence) error {
+	return database.Conn(ctx, r.db).Create(absence).Error
+}
+
+// GetByID - 결석 신고 단건 조회
+func (r *passengerAbsenceRepository) GetByID(ctx context.Context, id string) (*domain.PassengerAbsence, error) {
+	var absence domain.PassengerAbsence
+	err := database.Conn(ctx, r.db).Where("id = ? AND deleted_at IS NULL", id).First(&absence).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &absence, nil
+}
+
+// ListByPassenger - 탑승자의 결석 신고 목록 (from 날짜 이후, 날짜 순)
+func (r *passengerAbsenceRepository) ListByPassenger(ctx context.Context, passengerID string, from time.Time) ([]*domain.PassengerAbsence, error) {
+	var absences []*domain.PassengerAbsence
+	err := database.Conn(ctx, r.db).
+		Where("passenger_id = ? AND date >= ? AND deleted_at IS NULL", passengerID, from.Format(time.DateOnly)).
+		Order("date, time_slot").
+		Find(&absences).Error
+	return absences, err
+}
+
+// ListByDate - 날짜의 결석 신고 목록
+func (r *passengerAbsenceRepository) ListByDate(ctx context.Context, date time.Time) ([]*domain.PassengerAbsence, error) {
+	var absences []*domain.PassengerAbsence
+	err := database.Conn(ctx, r.db).
+		Where("date = ? AND deleted_at IS NULL", date.Format(time.DateOnly)).
+		Find(&absences).Error
+	return absences, err
+}
+
+// SoftDelete - 결석 신고 취소 (deleted_at 설정)
+func (r *passengerAbsenceRepository) SoftDelete(ctx context.Context, id string) error {
+	now := time.Now()
+	result := database.Conn(ctx, r.db).
+		Model(&domain.PassengerAbsence{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 탑승자 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 경로/정류장별 탑승자 조회 지원 (운행 시 탑승 명단 구성), 전화 문의용 이름/연락처 검색
+// ⚠️ 주의사항: 보호자 연락처, 의료 정보 등 민감 정보 포함 → 로그 출력 금지
+
+// PassengerFilter - 탑승자 목록 조회 조건
+type PassengerFilter struct {
+	Status         domain.PassengerStatus // 상태 필터 (빈 값이면 전체)
+	RouteID        string                 // 배정된 경로
+	StopID         string                 // 배정된 정류장
+	Name           string                 // 이름 부분 검색
+	IncludeDeleted bool                   // 삭제된 탑승자 포함 (관리자 조회)
+	ListOptions                           // 페이지 범위, 정렬, 필드 필터
+}
+
+// PassengerListSpec - 탑승자 목록 정렬/필터 허용 필드
+var PassengerListSpec = ListSpec{
+	Sortable:    columns("name", "age", "status", "stop_order", "created_at"),
+	Filterable:  columns("status", "gender", "assigned_route_id", "assigned_stop_id"),
+	DefaultSort: []SortField{{Column: "name"}},
+}
+
+// PassengerRepository - 탑승자 저장소 인터페이스
+type PassengerRepository interface {
+	Create(ctx context.Context, passenger *domain.Passenger) error
+	GetByID(ctx context.Context, id string) (*domain.Passenger, error)
+	List(ctx context.Context, filter PassengerFilter) ([]*domain.Passenger, int64, error)
+	Update(ctx context.Context, passenger *domain.Passenger) error
+	SoftDelete(ctx context.Context, id string) error
+	GetDeleted(ctx context.Context, id string) (*domain.Passenger, error) // 삭제된 탑승자 조회 (복구 전 확인용)
+	Restore(ctx context.Context, id string) error
+	ListForAnonymization(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Passenger, error)
+	Anonymize(ctx context.Context, passenger *domain.Passenger) error
+	Search(ctx context.Context, query string, limit int) ([]*domain.Passenger, error)
+}
+
+// passengerRepository - GORM 기반 구현체
+type passengerRepository struct {
+	db *gorm.DB
+}
+
+// NewPassengerRepository - 탑승자 Repository 생성
+func NewPassengerRepository(db *gorm.DB) PassengerRepository {
+	return &passengerRepository{db: db}
+}
+
+// Create - 탑승자 저장
+func (r *passengerRepository) Create(ctx context.Context, passenger *domain.Passenger) error {
+	if err := setPassengerPhoneHash(passenger); err != nil {
+		return err
+	}
+	return database.Conn(ctx, r.db).Create(passenger).Error
+}
+
+// GetByID - ID로 탑승자 조회
+func (r *passengerRepository) GetByID(ctx context.Context, id string) (*domain.Passenger, error) {
+	var passenger domain.Passenger
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&passenger).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &passenger, nil
+}
+
+// List - 조건에 맞는 탑승자 목록과 전체 개수 조회
+// 경로 필터 시 정류장 순서대로, 그 외에는 이름순 정렬
+func (r *passengerRepository) List(ctx context.Context, filter PassengerFilter) ([]*domain.Passenger, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Passenger{}).Scopes(withDeleted(filter.IncludeDeleted))
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.RouteID != "" {
+		query = query.Where("assigned_route_id = ?", filter.RouteID)
+	}
+	if filter.StopID != "" {
+		query = query.Where("assigned_stop_id = ?", filter.StopID)
+	}
+	if filter.Name != "" {
+		query = query.Where("name ILIKE ?", "%"+filter.Name+"%")
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	defaultSort := PassengerListSpec.DefaultSort
+	if filter.RouteID != "" {
+		defaultSort = []SortField{{Column: "stop_order"}, {Column: "name"}} // 경로 탑승자는 정류장 순서대로
+	}
+	query = query.Scopes(pageScope(filter.ListOptions, defaultSort...))
+
+	var passengers []*domain.Passenger
+	if err := query.Find(&passengers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return passengers, total, nil
+}
+
+// Update - 탑승자 정보 수정 (전체 필드 저장)
+func (r *passengerRepository) Update(ctx context.Context, passenger *domain.Passenger) error {
+	if err := setPassengerPhoneHash(passenger); err != nil {
+		return err
+	}
+
+	result := database.Conn(ctx, r.db).
+		Model(passenger).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(passenger)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 탑승자 삭제 (deleted_at 설정)
+func (r *passengerRepository) SoftDelete(ctx context.Context, id string) error {
+	return softDelete(ctx, r.db, &domain.Passenger{}, id)
+}
+
+// GetDeleted - 삭제된 탑승자 조회 (없거나 삭제되지 않았으면 ErrNotFound)
+func (r *passengerRepository) GetDeleted(ctx context.Context, id string) (*domain.Passenger, error) {
+	var passenger domain.Passenger
+	if err := getDeleted(ctx, r.db, &passenger, id); err != nil {
+		return nil, err
+	}
+	return &passenger, nil
+}
+
+// Restore - 삭제된 탑승자 복구 (deleted_at 해제)
+func (r *passengerRepository) Restore(ctx context.Context, id string) error {
+	return restore(ctx, r.db, &domain.Passenger{}, id)
+}
+
+// ListForAnonymization - 보존 기간이 지난 탑승자 조회 (cutoff 이전 비활성 전환 또는 삭제)
+// 삭제된 탑승자도 포함 (soft delete는 개인정보가 남아 있음)
+func (r *passengerRepository) ListForAnonymization(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Passenger, error) {
+	query := database.Conn(ctx, r.db).
+		Where("anonymized_at IS NULL").
+		Where("(status = ? AND COALESCE(deactivated_at, updated_at) < ?) OR deleted_at < ?",
+			domain.PassengerStatusInactive, cutoff, cutoff).
+		Order("id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var passengers []*domain.Passenger
+	if err := query.Find(&passengers).Error; err != nil {
+		return nil, err
+	}
+	return passengers, nil
+}
+
+// Anonymize - 개인정보 컬럼과 익명화 시각만 저장 (삭제된 행 포함)
+func (r *passengerRepository) Anonymize(ctx context.Context, passenger *domain.Passenger) error {
+	columns := append([]string{"anonymized_at", "updated_at"}, domain.PassengerPersonalColumns...)
+	result := database.Conn(ctx, r.db).
+		Model(passenger).
+		Where("anonymized_at IS NULL").
+		Select(columns).
+		Updates(passenger)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Search - 탑승자 이름, 보호자 이름, 보호자 연락처로 검색 (연결된 보호자 계정의 이름/연락처 포함)
+// 이름은 부분 일치, 연락처는 전체 번호 일치, 이름 유사도가 높은 순
+func (r *passengerRepository) Search(ctx context.Context, query string, limit int) ([]*domain.Passenger, error) {
+	phoneHashes, err := phoneSearchHashes(query)
+	if err != nil {
+		return nil, err
+	}
+
+	passengerMatch := "passengers.name ILIKE @pattern OR passengers.guardian_name ILIKE @pattern"
+	guardianMatch := "g.name ILIKE @pattern"
+	if len(phoneHashes) > 0 {
+		passengerMatch += " OR passengers.guardian_phone_hash IN @phones"
+		guardianMatch += " OR g.phone_hash IN @phones"
+	}
+	args := map[string]interface{}{"pattern": containsPattern(query), "phones": phoneHashes}
+
+	var passengers []*domain.Passenger
+	err = database.Conn(ctx, r.db).
+		Where("passengers.deleted_at IS NULL").
+		Where("("+passengerMatch+` OR EXISTS (
+			SELECT 1 FROM guardian_passengers gp
+			JOIN guardians g ON g.id = gp.guardian_id AND g.deleted_at IS NULL
+			WHERE gp.passenger_id = passengers.id AND (`+guardianMatch+`)))`, args).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "GREATEST(similarity(passengers.name, ?), similarity(passengers.guardian_name, ?)) DESC, passengers.name ASC",
+			Vars:               []interface{}{query, query},
+			WithoutParentheses: true,
+		}}).
+		Limit(limit).
+		Find(&passengers).Error
+	if err != nil {
+		return nil, err
+	}
+	return passengers, nil
+}
+
+// setPassengerPhoneHash - 보호자 연락처 검색용 해시 계산
+func setPassengerPhoneHash(passenger *domain.Passenger) error {
+	phoneHash, err := blindIndex(passenger.GuardianPhone)
+	if err != nil {
+		return err
+	}
+	passenger.GuardianPhoneHash = phoneHash
+	return nil
+}
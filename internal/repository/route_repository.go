@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 경로/정류장 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: Route를 Aggregate Root로 보고 정류장 변경은 Route 단위로 처리
+// ⚠️ 주의사항: 정류장 순서(1..N 연속)가 깨지지 않도록 순서 이동은 트랜잭션으로 수행
+// 탑승자에 캐싱된 정류장 순서(passengers.stop_order)도 같은 트랜잭션에서 함께 갱신
+
+// RouteFilter - 경로 목록 조회 조건
+type RouteFilter struct {
+	Status         domain.RouteStatus // 상태 필터 (빈 값이면 전체)
+	IncludeDeleted bool               // 삭제된 경로 포함 (관리자 조회)
+	ListOptions                       // 페이지 범위, 정렬, 필드 필터
+}
+
+// RouteListSpec - 경로 목록 정렬/필터 허용 필드
+var RouteListSpec = ListSpec{
+	Sortable:    columns("name", "status", "estimated_time", "total_distance", "created_at"),
+	Filterable:  columns("status"),
+	DefaultSort: []SortField{{Column: "name"}},
+}
+
+// RouteRepository - 경로 저장소 인터페이스
+type RouteRepository interface {
+	Create(ctx context.Context, route *domain.Route) error
+	GetByID(ctx context.Context, id string) (*domain.Route, error) // 정류장 포함 (순서대로)
+	List(ctx context.Context, filter RouteFilter) ([]*domain.Route, int64, error)
+	Update(ctx context.Context, route *domain.Route) error
+	SoftDelete(ctx context.Context, id string) error
+	GetDeleted(ctx context.Context, id string) (*domain.Route, error) // 삭제된 경로 조회 (복구 전 확인용, 정류장 미포함)
+	Restore(ctx context.Context, id string) error                     // 경로와 함께 삭제된 정류장도 복구
+
+	// 정류장
+	ListStops(ctx context.Context, routeID string) ([]domain.Stop, error)
+	GetStop(ctx context.Context, routeID, stopID string) (*domain.Stop, error)
+	InsertStop(ctx context.Context, stop *domain.Stop) error                  // stop.Order 위치에 삽입 (이후 정류장은 한 칸씩 뒤로)
+	UpdateStop(ctx context.Context, stop *domain.Stop) error                  // 순서 외 정보 수정
+	MoveStop(ctx context.Context, routeID, stopID string, newOrder int) error // 순서 이동
+	RemoveStop(ctx context.Context, routeID, stopID string) error             // 삭제 후 이후 정류장 한 칸씩 앞으로
+	ReorderStops(ctx context.Context, routeID string, stopIDs []string) error // 전달된 순서대로 1..N 재배치
+}
+
+// routeRepository - GORM 기반 구현체
+type routeRepository struct {
+	db *gorm.DB
+}
+
+// NewRouteRepository - 경로 Repository 생성
+func NewRouteRepository(db *gorm.DB) RouteRepository {
+	return &routeRepository{db: db}
+}
+
+// Create - 경로 저장 (정류장 포함)
+func (r *routeRepository) Create(ctx context.Context, route *domain.Route) error {
+	return database.Conn(ctx, r.db).Create(route).Error
+}
+
+// GetByID - ID로 경로 조회 (삭제되지 않은 정류장을 순서대로 포함)
+func (r *routeRepository) GetByID(ctx context.Context, id string) (*domain.Route, error) {
+	var route domain.Route
+	err := database.Conn(ctx, r.db).
+		Preload("Stops", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("stop_order ASC")
+		}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&route).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &route, nil
+}
+
+// List - 조건에 맞는 경로 목록 조회 (정류장 미포함)
+func (r *routeRepository) List(ctx context.Context, filter RouteFilter) ([]*domain.Route, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Route{}).Scopes(withDeleted(filter.IncludeDeleted))
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, RouteListSpec.DefaultSort...))
+
+	var routes []*domain.Route
+	if err := query.Find(&routes).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return routes, total, nil
+}
+
+// Update - 경로 정보 수정 (정류장은 별도 메소드로 관리)
+func (r *routeRepository) Update(ctx context.Context, route *domain.Route) error {
+	result := database.Conn(ctx, r.db).
+		Model(route).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Omit("Stops").
+		Updates(route)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 경로 및 소속 정류장 삭제
+func (r *routeRepository) SoftDelete(ctx context.Context, id string) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&domain.Route{}).
+			Where("id = ? AND deleted_at IS NULL", id).
+			Updates(map[string]interface{}{"deleted_at": now, "updated_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return tx.Model(&domain.Stop{}).
+			Where("route_id = ? AND deleted_at IS NULL", id).
+			Updates(map[string]interface{}{"deleted_at": now, "updated_at": now}).Error
+	})
+}
+
+// GetDeleted - 삭제된 경로 조회 (없거나 삭제되지 않았으면 ErrNotFound)
+func (r *routeRepository) GetDeleted(ctx context.Context, id string) (*domain.Route, error) {
+	var route domain.Route
+	if err := getDeleted(ctx, r.db, &route, id); err != nil {
+		return nil, err
+	}
+	return &route, nil
+}
+
+// Restore - 삭제된 경로 복구 (경로 삭제 시 함께 삭제된 정류장만 복구, 그 전에 따로 삭제된 정류장은 그대로)
+func (r *routeRepository) Restore(ctx context.Context, id string) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var route domain.Route
+		if err := tx.Where("id = ? AND deleted_at IS NOT NULL", id).First(&route).Error; err != nil {
+			return translateError(err)
+		}
+
+		now := time.Now()
+		if err := tx.Model(&domain.Route{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{"deleted_at": nil, "updated_at": now}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.Stop{}).
+			Where("route_id = ? AND deleted_at = ?", id, route.DeletedAt).
+			Updates(map[string]interface{}{"deleted_at": nil, "updated_at": now}).Error
+	})
+}
+
+// ListStops - 경로의 정류장 목록 (순서대로)
+func (r *routeRepository) ListStops(ctx context.Context, routeID string) ([]domain.Stop, error) {
+	var stops []domain.Stop
+	err := database.Conn(ctx, r.db).
+		Where("route_id = ? AND deleted_at IS NULL", routeID).
+		Order("stop_order ASC").
+		Find(&stops).Error
+	return stops, err
+}
+
+// GetStop - 경로 내 정류장 조회
+func (r *routeRepository) GetStop(ctx context.Context, routeID, stopID string) (*domain.Stop, error) {
+	var stop domain.Stop
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND route_id = ? AND deleted_at IS NULL", stopID, routeID).
+		First(&stop).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &stop, nil
+}
+
+// InsertStop - stop.Order 위치에 정류장 삽입
+func (r *routeRepository) InsertStop(ctx context.Context, stop *domain.Stop) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := shiftStops(tx, stop.RouteID, stop.Order, 0, 1); err != nil {
+			return err
+		}
+		if err := tx.Create(stop).Error; err != nil {
+			return err
+		}
+		return syncPassengerStopOrders(tx, stop.RouteID)
+	})
+}
+
+// UpdateStop - 정류장 정보 수정 (순서 제외)
+func (r *routeRepository) UpdateStop(ctx context.Context, stop *domain.Stop) error {
+	result := database.Conn(ctx, r.db).
+		Model(stop).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Omit("stop_order", "route_id", "created_at").
+		Updates(stop)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// MoveStop - 정류장 순서 이동 (사이 정류장은 한 칸씩 밀거나 당김)
+func (r *routeRepository) MoveStop(ctx context.Context, routeID, stopID string, newOrder int) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var stop domain.Stop
+		if err := tx.Where("id = ? AND route_id = ? AND deleted_at IS NULL", stopID, routeID).First(&stop).Error; err != nil {
+			return translateError(err)
+		}
+
+		oldOrder := stop.Order
+		switch {
+		case newOrder < oldOrder:
+			// 앞으로 이동: [newOrder, oldOrder-1] 구간을 뒤로 한 칸
+			if err := shiftStops(tx, routeID, newOrder, oldOrder-1, 1); err != nil {
+				return err
+			}
+		case newOrder > oldOrder:
+			// 뒤로 이동: [oldOrder+1, newOrder] 구간을 앞으로 한 칸
+			if err := shiftStops(tx, routeID, oldOrder+1, newOrder, -1); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+
+		if err := tx.Model(&domain.Stop{}).
+			Where("id = ?", stopID).
+			Updates(map[string]interface{}{"stop_order": newOrder, "updated_at": time.Now()}).Error; err != nil {
+			return err
+		}
+		return syncPassengerStopOrders(tx, routeID)
+	})
+}
+
+// RemoveStop - 정류장 삭제 후 이후 정류장 순서 당김
+func (r *routeRepository) RemoveStop(ctx context.Context, routeID, stopID string) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var stop domain.Stop
+		if err := tx.Where("id = ? AND route_id = ? AND deleted_at IS NULL", stopID, routeID).First(&stop).Error; err != nil {
+			return translateError(err)
+		}
+
+		now := time.Now()
+		if err := tx.Model(&domain.Stop{}).
+			Where("id = ?", stopID).
+			Updates(map[string]interface{}{"deleted_at": now, "updated_at": now}).Error; err != nil {
+			return err
+		}
+
+		if err := shiftStops(tx, routeID, stop.Order+1, 0, -1); err != nil {
+			return err
+		}
+		return syncPassengerStopOrders(tx, routeID)
+	})
+}
+
+// ReorderStops - 전달된 ID 순서대로 1..N 재배치
+func (r *routeRepository) ReorderStops(ctx context.Context, routeID string, stopIDs []string) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for i, stopID := range stopIDs {
+			result := tx.Model(&domain.Stop{}).
+				Where("id = ? AND route_id = ? AND deleted_at IS NULL", stopID, routeID).
+				Updates(map[string]interface{}{"stop_order": i + 1, "updated_at": now})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrNotFound
+			}
+		}
+		return syncPassengerStopOrders(tx, routeID)
+	})
+}
+
+// shiftStops - [from, to] 구간 정류장 순서를 delta만큼 이동 (to가 0이면 끝까지)
+func shiftStops(tx *gorm.DB, routeID string, from, to, delta int) error {
+	query := tx.Model(&domain.Stop{}).
+		Where("route_id = ? AND deleted_at IS NULL AND stop_order >= ?", routeID, from)
+	if to > 0 {
+		query = query.Where("stop_order <= ?", to)
+	}
+	return query.Updates(map[string]interface{}{
+		"stop_order": gorm.Expr("stop_order + ?", delta),
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// syncPassengerStopOrders - 경로 탑승자의 캐싱된 정류장 순서를 현재 정류장 순서로 갱신
+// 삭제된 정류장에 남아 있는 탑승자는 기존 값 유지
+func syncPassengerStopOrders(tx *gorm.DB, routeID string) error {
+	return tx.Model(&domain.Passenger{}).
+		Where("assigned_route_id = ? AND deleted_at IS NULL", routeID).
+		Update("stop_order", gorm.Expr(
+			"COALESCE((SELECT stops.stop_order FROM stops WHERE stops.id = passengers.assigned_stop_id AND stops.deleted_at IS NULL), stop_order)",
+		)).Error
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 일정 예외 날짜 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 운행 생성은 날짜로 한 번에 조회 (일정마다 조회하지 않음)
+// ⚠️ 주의사항: 일정 + 날짜 유니크 → 중복 확인은 Service에서 GetBySchedule로 먼저
+
+// ScheduleExceptionRepository - 일정 예외 저장소 인터페이스
+type ScheduleExceptionRepository interface {
+	Create(ctx context.Context, exception *domain.ScheduleException) error
+	GetBySchedule(ctx context.Context, scheduleID string, date time.Time) (*domain.ScheduleException, error)
+	ListBySchedule(ctx context.Context, scheduleID string) ([]*domain.ScheduleException, error) // 날짜 순
+	ListByDate(ctx context.Context, date time.Time) ([]*domain.ScheduleException, error)
+	Delete(ctx context.Context, scheduleID, id string) error // 일정의 예외가 아니면 ErrNotFound
+}
+
+// scheduleExceptionRepository - GORM 기반 구현체
+type scheduleExceptionRepository struct {
+	db *gorm.DB
+}
+
+// NewScheduleExceptionRepository - 일정 예외 Repository 생성
+func NewScheduleExceptionRepository(db *gorm.DB) ScheduleExceptionRepository {
+	return &scheduleExceptionRepository{db: db}
+}
+
+// Create - 일정 예외 생성
+func (r *scheduleExceptionRepository) Create(ctx context.Context, exception *domain.ScheduleException) error {
+	return database.Conn(ctx, r.db).Create(exception).Error
+}
+
+// GetBySchedule - 일정의 날짜 예외 조회
+func (r *scheduleExceptionRepository) GetBySchedule(ctx context.Context, scheduleID string, date time.Time) (*domain.ScheduleException, error) {
+	var exception domain.ScheduleException
+	err := database.Conn(ctx, r.db).
+		Where("schedule_id = ? AND date = ?", scheduleID, date.Format(time.DateOnly)).
+		First(&exception).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &exception, nil
+}
+
+// ListBySchedule - 일정의 예외 목록 (날짜 순)
+func (r *scheduleExceptionRepository) ListBySchedule(ctx context.Context, scheduleID string) ([]*domain.ScheduleException, error) {
+	var exceptions []*domain.ScheduleException
+	err := database.Conn(ctx, r.db).
+		Where("schedule_id = ?", scheduleID).
+		Order("date").
+		Find(&exceptions).Error
+	return exceptions, err
+}
+
+// ListByDate - 날짜의 모든 일정 예외
+func (r *scheduleExceptionRepository) ListByDate(ctx context.Context, date time.Time) ([]*domain.ScheduleException, error) {
+	var exceptions []*domain.ScheduleException
+	err := database.Conn(ctx, r.db).
+		Where("date = ?", date.Format(time.DateOnly)).
+		Find(&exceptions).Error
+	return exceptions, err
+}
+
+// Delete - 일정 예외 삭제
+func (r *scheduleExceptionRepository) Delete(ctx context.Context, scheduleID, id string) error {
+	result := database.Conn(ctx, r.db).
+		Where("id = ? AND schedule_id = ?", id, scheduleID).
+		Delete(&domain.ScheduleException{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
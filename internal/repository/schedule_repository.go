@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 운행 일정 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 운행 요일은 JSONB 컬럼에 직렬화해서 저장
+// ⚠️ 주의사항: 경로/차량/기사 유효성 검증은 Service에서 처리
+
+// ScheduleFilter - 일정 목록 조회 조건
+type ScheduleFilter struct {
+	Status         domain.ScheduleStatus // 상태 필터 (빈 값이면 전체)
+	RouteID        string                // 경로 필터
+	VehicleID      string                // 차량 필터
+	DriverID       string                // 기본 기사 필터
+	IncludeDeleted bool                  // 삭제된 일정 포함 (관리자 조회)
+	ListOptions                          // 페이지 범위, 정렬, 필드 필터
+}
+
+// ScheduleListSpec - 일정 목록 정렬/필터 허용 필드
+var ScheduleListSpec = ListSpec{
+	Sortable:    columns("start_time", "name", "status", "time_slot", "created_at"),
+	Filterable:  columns("status", "time_slot", "route_id", "vehicle_id", "default_driver_id"),
+	DefaultSort: []SortField{{Column: "start_time"}, {Column: "name"}},
+}
+
+// ScheduleRepository - 일정 저장소 인터페이스
+type ScheduleRepository interface {
+	Create(ctx context.Context, schedule *domain.Schedule) error
+	GetByID(ctx context.Context, id string) (*domain.Schedule, error)
+	List(ctx context.Context, filter ScheduleFilter) ([]*domain.Schedule, int64, error)
+	Update(ctx context.Context, schedule *domain.Schedule) error
+	SoftDelete(ctx context.Context, id string) error
+	GetDeleted(ctx context.Context, id string) (*domain.Schedule, error) // 삭제된 일정 조회 (복구 전 확인용)
+	Restore(ctx context.Context, id string) error
+}
+
+// scheduleRepository - GORM 기반 구현체
+type scheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewScheduleRepository - 일정 Repository 생성
+func NewScheduleRepository(db *gorm.DB) ScheduleRepository {
+	return &scheduleRepository{db: db}
+}
+
+// Create - 일정 저장
+func (r *scheduleRepository) Create(ctx context.Context, schedule *domain.Schedule) error {
+	return database.Conn(ctx, r.db).Create(schedule).Error
+}
+
+// GetByID - ID로 일정 조회
+func (r *scheduleRepository) GetByID(ctx context.Context, id string) (*domain.Schedule, error) {
+	var schedule domain.Schedule
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&schedule).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &schedule, nil
+}
+
+// List - 조건에 맞는 일정 목록과 전체 개수 조회 (출발 시각 순)
+func (r *scheduleRepository) List(ctx context.Context, filter ScheduleFilter) ([]*domain.Schedule, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Schedule{}).Scopes(withDeleted(filter.IncludeDeleted))
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.RouteID != "" {
+		query = query.Where("route_id = ?", filter.RouteID)
+	}
+	if filter.VehicleID != "" {
+		query = query.Where("vehicle_id = ?", filter.VehicleID)
+	}
+	if filter.DriverID != "" {
+		query = query.Where("default_driver_id = ?", filter.DriverID)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, ScheduleListSpec.DefaultSort...))
+
+	var schedules []*domain.Schedule
+	if err := query.Find(&schedules).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return schedules, total, nil
+}
+
+// Update - 일정 수정 (전체 필드 저장)
+func (r *scheduleRepository) Update(ctx context.Context, schedule *domain.Schedule) error {
+	result := database.Conn(ctx, r.db).
+		Model(schedule).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(schedule)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 일정 삭제 (deleted_at 설정)
+func (r *scheduleRepository) SoftDelete(ctx context.Context, id string) error {
+	return softDelete(ctx, r.db, &domain.Schedule{}, id)
+}
+
+// GetDeleted - 삭제된 일정 조회 (없거나 삭제되지 않았으면 ErrNotFound)
+func (r *scheduleRepository) GetDeleted(ctx context.Context, id string) (*domain.Schedule, error) {
+	var schedule domain.Schedule
+	if err := getDeleted(ctx, r.db, &schedule, id); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Restore - 삭제된 일정 복구 (deleted_at 해제)
+func (r *scheduleRepository) Restore(ctx context.Context, id string) error {
+	return restore(ctx, r.db, &domain.Schedule{}, id)
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"strings"
+)
+
+// 📝 설명: 검색어 변환 공통 처리 (부분 일치 패턴, 연락처 검색용 해시)
+// 🎯 실무 포인트: 이름은 ILIKE 부분 일치 (pg_trgm GIN 인덱스 사용), 암호화된 연락처는 검색용 해시 일치
+// 연락처는 입력 형식이 제각각이라 저장될 수 있는 형식(그대로, 숫자만, 하이픈, +82) 후보의 해시를 모두 비교
+// ⚠️ 주의사항: 해시 비교라 연락처 일부(뒷자리 등)로는 찾을 수 없음
+
+// containsPattern - ILIKE 부분 일치 패턴 (검색어의 %, _, \는 문자 그대로)
+func containsPattern(query string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(query)
+	return "%" + escaped + "%"
+}
+
+// phoneSearchHashes - 연락처로 보이는 검색어의 저장 형식 후보별 검색용 해시 (연락처가 아니면 nil)
+func phoneSearchHashes(query string) ([]string, error) {
+	digits := strings.NewReplacer("-", "", " ", "").Replace(query)
+	if strings.HasPrefix(digits, "+82") {
+		digits = "0" + digits[len("+82"):]
+	}
+	if len(digits) < 9 || len(digits) > 11 || digits[0] != '0' || strings.Trim(digits, "0123456789") != "" {
+		return nil, nil
+	}
+
+	candidates := []string{query, digits, hyphenatePhone(digits), "+82" + digits[1:]}
+	hashes := make([]string, 0, len(candidates))
+	seen := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		if seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+		hash, err := blindIndex(candidate)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// hyphenatePhone - 국내 번호를 하이픈 형식으로 (예: "01012345678" → "010-1234-5678", "0212345678" → "02-1234-5678")
+func hyphenatePhone(digits string) string {
+	area := 3
+	if strings.HasPrefix(digits, "02") {
+		area = 2
+	}
+	last := len(digits) - 4
+	return digits[:area] + "-" + digits[area:last] + "-" + digits[last:]
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: 로그인 세션 추적 및 토큰 폐기 목록 (Redis)
+// 🎯 실무 포인트: 사용자별 세션은 ZSET(score=만료 시각), 폐기된 jti는 만료 시각까지만 보관
+// ⚠️ 주의사항: 폐기 목록 키는 토큰 만료와 함께 자동 삭제되므로 별도 정리 작업 불필요
+
+const (
+	sessionsKeyPrefix = "auth:sessions:" // + userID → ZSET(jti, exp)
+	denylistKeyPrefix = "auth:denylist:" // + jti → "1" (TTL = 남은 토큰 수명)
+)
+
+// SessionRepository - 세션/토큰 폐기 저장소 인터페이스
+type SessionRepository interface {
+	Track(ctx context.Context, session *domain.Session) error
+	List(ctx context.Context, userID string) ([]*domain.Session, error)
+	Revoke(ctx context.Context, userID, sessionID string) error
+	RevokeAll(ctx context.Context, userID string) (int, error)
+	IsRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+// sessionRepository - Redis 기반 구현체
+type sessionRepository struct {
+	rdb *redis.Client
+}
+
+// NewSessionRepository - 세션 Repository 생성
+func NewSessionRepository(rdb *redis.Client) SessionRepository {
+	return &sessionRepository{rdb: rdb}
+}
+
+// Track - 새 세션 등록 (만료된 세션은 함께 정리)
+func (r *sessionRepository) Track(ctx context.Context, session *domain.Session) error {
+	key := sessionsKeyPrefix + session.UserID
+	now := time.Now()
+
+	pipe := r.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(session.ExpiresAt.Unix()), Member: session.ID})
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Unix(), 10))
+	pipe.ExpireAt(ctx, key, session.ExpiresAt) // 가장 최근 세션이 가장 늦게 만료
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// List - 만료되지 않은 세션 목록 (만료 임박 순)
+func (r *sessionRepository) List(ctx context.Context, userID string) ([]*domain.Session, error) {
+	entries, err := r.activeEntries(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*domain.Session, 0, len(entries))
+	for _, entry := range entries {
+		sessions = append(sessions, &domain.Session{
+			ID:        entry.Member.(string),
+			UserID:    userID,
+			ExpiresAt: time.Unix(int64(entry.Score), 0),
+		})
+	}
+	return sessions, nil
+}
+
+// Revoke - 세션 하나 폐기 (사용자의 세션이 아니면 ErrNotFound)
+func (r *sessionRepository) Revoke(ctx context.Context, userID, sessionID string) error {
+	key := sessionsKeyPrefix + userID
+	score, err := r.rdb.ZScore(ctx, key, sessionID).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	expiresAt := time.Unix(int64(score), 0)
+	if !expiresAt.After(time.Now()) {
+		return ErrNotFound
+	}
+
+	pipe := r.rdb.TxPipeline()
+	pipe.Set(ctx, denylistKeyPrefix+sessionID, "1", time.Until(expiresAt))
+	pipe.ZRem(ctx, key, sessionID)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// RevokeAll - 사용자의 모든 세션 폐기, 폐기한 세션 수 반환
+func (r *sessionRepository) RevokeAll(ctx context.Context, userID string) (int, error) {
+	entries, err := r.activeEntries(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	pipe := r.rdb.TxPipeline()
+	for _, entry := range entries {
+		expiresAt := time.Unix(int64(entry.Score), 0)
+		pipe.Set(ctx, denylistKeyPrefix+entry.Member.(string), "1", time.Until(expiresAt))
+	}
+	pipe.Del(ctx, sessionsKeyPrefix+userID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(entries), nil
+}
+
+// IsRevoked - 폐기된 세션(jti)인지 확인
+func (r *sessionRepository) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	n, err := r.rdb.Exists(ctx, denylistKeyPrefix+sessionID).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// activeEntries - 만료되지 않은 세션 ZSET 항목 조회
+func (r *sessionRepository) activeEntries(ctx context.Context, userID string) ([]redis.Z, error) {
+	return r.rdb.ZRangeByScoreWithScores(ctx, sessionsKeyPrefix+userID, &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(time.Now().Unix(), 10),
+		Max: "+inf",
+	}).Result()
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: Soft Delete 공통 처리 (삭제 필터, 삭제 포함 조회, 삭제/복구)
+// 🎯 실무 포인트: DeletedAt이 *time.Time이라 GORM 기본 soft delete가 동작하지 않으므로 Scope와 헬퍼로 통일
+// 복구는 삭제된 행의 deleted_at만 해제 (나머지 필드는 삭제 당시 그대로)
+// ⚠️ 주의사항: 삭제 포함 조회(include_deleted)는 관리자 목록 조회 전용, 단건 조회/수정은 항상 삭제 제외
+
+// notDeleted - 삭제되지 않은 행만 (deleted_at IS NULL)
+func notDeleted(db *gorm.DB) *gorm.DB {
+	return db.Where("deleted_at IS NULL")
+}
+
+// withDeleted - includeDeleted면 삭제된 행 포함, 아니면 notDeleted
+func withDeleted(includeDeleted bool) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if includeDeleted {
+			return db
+		}
+		return notDeleted(db)
+	}
+}
+
+// softDelete - 삭제 (deleted_at 설정, 없거나 이미 삭제되었으면 ErrNotFound)
+func softDelete(ctx context.Context, db *gorm.DB, model interface{}, id string) error {
+	now := time.Now()
+	result := database.Conn(ctx, db).
+		Model(model).
+		Scopes(notDeleted).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// getDeleted - 삭제된 행 조회 (없거나 삭제되지 않았으면 ErrNotFound)
+func getDeleted(ctx context.Context, db *gorm.DB, dest interface{}, id string) error {
+	err := database.Conn(ctx, db).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(dest).Error
+	return translateError(err)
+}
+
+// restore - 삭제된 행 복구 (deleted_at 해제, 없거나 삭제되지 않았으면 ErrNotFound)
+func restore(ctx context.Context, db *gorm.DB, model interface{}, id string) error {
+	result := database.Conn(ctx, db).
+		Model(model).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 기관(테넌트)별 데이터 분리 (GORM 조회/수정/삭제/생성 콜백)
+// 🎯 실무 포인트: organization_id 컬럼이 있는 모델에 현재 기관 조건을 일괄 추가 → Repository에서 조건을 빠뜨려도 다른 기관 데이터가 노출되지 않음
+// 현재 기관은 WithOrganization으로 지정한 값, 없으면 context의 인증 주체(auth.FromContext)
+// 플랫폼 운영자가 기관을 지정하지 않은 요청은 인증 주체의 기관이 빈 값 → 조건 없이 전체 기관 대상 (middleware.ResolveOrganization)
+// ⚠️ 주의사항: 기관이 없는 context(백그라운드 작업, 로그인, 캘린더 구독)는 전체 기관이 대상 → 생성 시 OrganizationID를 직접 지정
+// 다른 기관의 행은 "없는 것"으로 보이므로 조회/수정/삭제 모두 ErrNotFound
+
+// tenantColumn - 기관 컬럼 이름
+const tenantColumn = "organization_id"
+
+// tenantScopedKey - 같은 Statement에 조건을 중복으로 붙이지 않도록 표시하는 키 (Count 후 Find 등)
+const tenantScopedKey = "tenant:scoped"
+
+// ErrOrganizationMismatch - 다른 기관 소속으로 생성하려는 경우
+var ErrOrganizationMismatch = errors.New("organization mismatch")
+
+// organizationKey - WithOrganization으로 지정한 기관을 담는 context 키
+type organizationKey struct{}
+
+// WithOrganization - 이 context로 실행한 쿼리는 지정한 기관 데이터만 대상
+// 사용 예: 인증 주체가 없는 시드/백그라운드 작업에서 특정 기관 데이터를 다룰 때
+func WithOrganization(ctx context.Context, organizationID string) context.Context {
+	return context.WithValue(ctx, organizationKey{}, organizationID)
+}
+
+// OrganizationFromContext - 현재 기관 ID (WithOrganization → 인증 주체 순, 없으면 빈 값)
+func OrganizationFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if organizationID, ok := ctx.Value(organizationKey{}).(string); ok && organizationID != "" {
+		return organizationID
+	}
+	if principal, ok := auth.FromContext(ctx); ok {
+		return principal.OrganizationID
+	}
+	return ""
+}
+
+// RegisterTenantHooks - GORM 콜백에 기관 조건 등록
+// 사용 예: DB 연결 직후 repository.RegisterTenantHooks(db) 호출 (감사 로그 훅보다 먼저 → 변경 전 스냅샷도 같은 기관으로 제한)
+func RegisterTenantHooks(db *gorm.DB) error {
+	callback := db.Callback()
+
+	// 조건은 가장 먼저 추가 (트랜잭션 콜백 유무와 관계없이 감사 로그 스냅샷보다 앞서도록)
+	if err := callback.Query().Before("*").Register("tenant:query", tenantScope); err != nil {
+		return err
+	}
+	if err := callback.Row().Before("*").Register("tenant:row", tenantScope); err != nil {
+		return err
+	}
+	if err := callback.Update().Before("*").Register("tenant:update", tenantScope); err != nil {
+		return err
+	}
+	if err := callback.Delete().Before("*").Register("tenant:delete", tenantScope); err != nil {
+		return err
+	}
+	return callback.Create().Before("gorm:create").Register("tenant:create", tenantAssign)
+}
+
+// tenantScoped - 기관 컬럼이 있는 모델의 Statement인지 확인
+func tenantScoped(db *gorm.DB) bool {
+	stmt := db.Statement
+	return db.Error == nil && stmt.Schema != nil && stmt.Schema.LookUpField(tenantColumn) != nil
+}
+
+// tenantScope - 조회/수정/삭제에 현재 기관 조건 추가
+func tenantScope(db *gorm.DB) {
+	if !tenantScoped(db) {
+		return
+	}
+	organizationID := OrganizationFromContext(db.Statement.Context)
+	if organizationID == "" {
+		return
+	}
+	if _, done := db.InstanceGet(tenantScopedKey); done {
+		return
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: tenantColumn}, Value: organizationID},
+	}})
+	db.InstanceSet(tenantScopedKey, true)
+}
+
+// tenantAssign - 생성할 행에 현재 기관 지정 (이미 다른 기관이 지정되어 있으면 거부)
+func tenantAssign(db *gorm.DB) {
+	if !tenantScoped(db) {
+		return
+	}
+	stmt := db.Statement
+	organizationID := OrganizationFromContext(stmt.Context)
+	if organizationID == "" {
+		return
+	}
+
+	field := stmt.Schema.LookUpField(tenantColumn)
+	assign := func(value reflect.Value) {
+		current, zero := field.ValueOf(stmt.Context, value)
+		if zero {
+			if err := field.Set(stmt.Context, value, organizationID); err != nil {
+				db.AddError(err)
+			}
+			return
+		}
+		if current != organizationID {
+			db.AddError(ErrOrganizationMismatch)
+		}
+	}
+
+	switch stmt.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < stmt.ReflectValue.Len(); i++ {
+			assign(reflect.Indirect(stmt.ReflectValue.Index(i)))
+		}
+	case reflect.Struct:
+		assign(stmt.ReflectValue)
+	}
+}
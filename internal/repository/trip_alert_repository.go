@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 운행 경보 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 운행별 발생 순 조회, 같은 종류의 마지막 경보로 연속 경보 억제
+// ⚠️ 주의사항: append-only (수정/삭제 없음)
+
+// TripAlertRepository - 운행 경보 저장소 인터페이스
+type TripAlertRepository interface {
+	Create(ctx context.Context, alert *domain.TripAlert) error
+	ListByTrip(ctx context.Context, tripID string) ([]*domain.TripAlert, error)                             // 발생 순
+	LatestByType(ctx context.Context, tripID string, alertType domain.AlertType) (*domain.TripAlert, error) // 없으면 ErrNotFound
+}
+
+// tripAlertRepository - GORM 기반 구현체
+type tripAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewTripAlertRepository - 운행 경보 Repository 생성
+func NewTripAlertRepository(db *gorm.DB) TripAlertRepository {
+	return &tripAlertRepository{db: db}
+}
+
+// Create - 경보 저장
+func (r *tripAlertRepository) Create(ctx context.Context, alert *domain.TripAlert) error {
+	return database.Conn(ctx, r.db).Create(alert).Error
+}
+
+// ListByTrip - 운행의 경보 전체 (발생 순)
+func (r *tripAlertRepository) ListByTrip(ctx context.Context, tripID string) ([]*domain.TripAlert, error) {
+	var alerts []*domain.TripAlert
+	err := database.Conn(ctx, r.db).
+		Where("trip_id = ?", tripID).
+		Order("occurred_at ASC, created_at ASC").
+		Find(&alerts).Error
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// LatestByType - 운행의 해당 종류 마지막 경보
+func (r *tripAlertRepository) LatestByType(ctx context.Context, tripID string, alertType domain.AlertType) (*domain.TripAlert, error) {
+	var alert domain.TripAlert
+	err := database.Conn(ctx, r.db).
+		Where("trip_id = ? AND type = ?", tripID, alertType).
+		Order("occurred_at DESC").
+		First(&alert).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &alert, nil
+}
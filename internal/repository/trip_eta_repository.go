@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: 운행 도착 예정 시간 캐시 (Redis)
+// 🎯 실무 포인트: 보호자 여러 명이 같은 운행을 조회해도 계산(지도 API 호출 등)은 TTL마다 한 번
+// ⚠️ 주의사항: 캐시이므로 유실되어도 다시 계산하면 됨
+
+// tripETAKeyPrefix - 캐시 키 접두사 (+ 운행 ID)
+const tripETAKeyPrefix = "eta:trip:"
+
+// TripETARepository - 도착 예정 시간 캐시 인터페이스
+type TripETARepository interface {
+	Get(ctx context.Context, tripID string) (*domain.TripETA, error) // 없으면 ErrNotFound
+	Save(ctx context.Context, eta *domain.TripETA, ttl time.Duration) error
+}
+
+// tripETARepository - Redis 기반 구현체
+type tripETARepository struct {
+	rdb *redis.Client
+}
+
+// NewTripETARepository - 도착 예정 시간 캐시 생성
+func NewTripETARepository(rdb *redis.Client) TripETARepository {
+	return &tripETARepository{rdb: rdb}
+}
+
+// Get - 캐시된 도착 예정 시간
+func (r *tripETARepository) Get(ctx context.Context, tripID string) (*domain.TripETA, error) {
+	raw, err := r.rdb.Get(ctx, tripETAKeyPrefix+tripID).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	var eta domain.TripETA
+	if err := json.Unmarshal(raw, &eta); err != nil {
+		return nil, err
+	}
+	return &eta, nil
+}
+
+// Save - 도착 예정 시간 캐시 (ttl 후 만료)
+func (r *tripETARepository) Save(ctx context.Context, eta *domain.TripETA, ttl time.Duration) error {
+	raw, err := json.Marshal(eta)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, tripETAKeyPrefix+eta.TripID, raw, ttl).Err()
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: 운행별 마지막 위치 수신 시각 (Redis)
+// 🎯 실무 포인트: 위치마다 DB를 갱신하지 않고 Redis 키 하나만 덮어써서 끊김 감시 작업이 한 번에 조회(MGET)
+// ⚠️ 주의사항: 서버가 받은 시각 기준 (단말 측정 시각은 단말 시계가 틀릴 수 있어 사용하지 않음)
+
+const (
+	tripLastSeenKeyPrefix = "tracking:last_seen:" // + 운행 ID → Unix 밀리초
+	tripLastSeenTTL       = 24 * time.Hour        // 하루 운행보다 길게
+)
+
+// TripLastSeenRepository - 마지막 위치 수신 시각 저장소 인터페이스
+type TripLastSeenRepository interface {
+	Touch(ctx context.Context, tripID string, at time.Time) error
+	GetMany(ctx context.Context, tripIDs []string) (map[string]time.Time, error) // 기록이 없는 운행은 제외
+}
+
+// tripLastSeenRepository - Redis 기반 구현체
+type tripLastSeenRepository struct {
+	rdb *redis.Client
+}
+
+// NewTripLastSeenRepository - 마지막 위치 수신 시각 Repository 생성
+func NewTripLastSeenRepository(rdb *redis.Client) TripLastSeenRepository {
+	return &tripLastSeenRepository{rdb: rdb}
+}
+
+// Touch - 마지막 수신 시각 갱신
+func (r *tripLastSeenRepository) Touch(ctx context.Context, tripID string, at time.Time) error {
+	return r.rdb.Set(ctx, tripLastSeenKeyPrefix+tripID, at.UnixMilli(), tripLastSeenTTL).Err()
+}
+
+// GetMany - 여러 운행의 마지막 수신 시각
+func (r *tripLastSeenRepository) GetMany(ctx context.Context, tripIDs []string) (map[string]time.Time, error) {
+	seen := make(map[string]time.Time, len(tripIDs))
+	if len(tripIDs) == 0 {
+		return seen, nil
+	}
+
+	keys := make([]string, len(tripIDs))
+	for i, id := range tripIDs {
+		keys[i] = tripLastSeenKeyPrefix + id
+	}
+	values, err := r.rdb.MGet(ctx, keys...).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+		millis, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			continue
+		}
+		seen[tripIDs[i]] = time.UnixMilli(millis)
+	}
+	return seen, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 운행 GPS 기록 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 운행별 측정 시각 순 조회 (trip_id, recorded_at 인덱스)
+// ⚠️ 주의사항: append-only (수정/삭제 없음)
+
+// TripLocationRepository - 운행 GPS 기록 저장소 인터페이스
+type TripLocationRepository interface {
+	Create(ctx context.Context, position *domain.VehiclePosition) error
+	ListByTrip(ctx context.Context, tripID string) ([]*domain.VehiclePosition, error) // 측정 시각 순
+	Latest(ctx context.Context, tripID string) (*domain.VehiclePosition, error)       // 없으면 ErrNotFound
+}
+
+// tripLocationRepository - GORM 기반 구현체
+type tripLocationRepository struct {
+	db *gorm.DB
+}
+
+// NewTripLocationRepository - 운행 GPS 기록 Repository 생성
+func NewTripLocationRepository(db *gorm.DB) TripLocationRepository {
+	return &tripLocationRepository{db: db}
+}
+
+// Create - GPS 기록 저장
+func (r *tripLocationRepository) Create(ctx context.Context, position *domain.VehiclePosition) error {
+	return database.Conn(ctx, r.db).Create(position).Error
+}
+
+// ListByTrip - 운행의 GPS 기록 전체 (측정 시각 순)
+func (r *tripLocationRepository) ListByTrip(ctx context.Context, tripID string) ([]*domain.VehiclePosition, error) {
+	var positions []*domain.VehiclePosition
+	err := database.Conn(ctx, r.db).
+		Where("trip_id = ?", tripID).
+		Order("recorded_at ASC, id ASC").
+		Find(&positions).Error
+	if err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+// Latest - 운행의 마지막 GPS 기록
+func (r *tripLocationRepository) Latest(ctx context.Context, tripID string) (*domain.VehiclePosition, error) {
+	var position domain.VehiclePosition
+	err := database.Conn(ctx, r.db).
+		Where("trip_id = ?", tripID).
+		Order("recorded_at DESC, id DESC").
+		First(&position).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &position, nil
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 운행(Trip) Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 일정당 하루 1회 운행은 DB 부분 유니크 인덱스로도 보장
+// ⚠️ 주의사항: Update는 운행 자체 필드만 저장 (탑승 기록은 별도 처리)
+
+// TripFilter - 운행 목록 조회 조건
+type TripFilter struct {
+	Date           *time.Time        // 운행 날짜 (nil이면 전체)
+	From           *time.Time        // 운행 날짜 시작 (포함)
+	To             *time.Time        // 운행 날짜 끝 (포함)
+	Status         domain.TripStatus // 상태 필터 (빈 값이면 전체)
+	ScheduleID     string            // 일정 필터
+	ScheduleIDs    []string          // 일정 중 하나 (nil이면 전체, 빈 슬라이스면 결과 없음)
+	VehicleID      string            // 배정 차량 필터
+	DriverID       string            // 배정 기사 필터
+	AttendantID    string            // 배정 동승자 필터
+	PassengerID    string            // 탑승자 기록이 있는 운행만
+	WithPassengers bool              // 탑승자 기록 포함 (보고서용)
+	ListOptions                      // 페이지 범위, 정렬, 필드 필터
+}
+
+// TripListSpec - 운행 목록 정렬/필터 허용 필드
+var TripListSpec = ListSpec{
+	Sortable:    columns("date", "status", "started_at", "completed_at", "created_at"),
+	Filterable:  columns("status", "schedule_id", "vehicle_id", "assigned_driver_id", "assigned_attendant_id"),
+	DefaultSort: []SortField{{Column: "date", Desc: true}, {Column: "created_at"}},
+}
+
+// TripRepository - 운행 저장소 인터페이스
+type TripRepository interface {
+	Create(ctx context.Context, trip *domain.Trip) error
+	GetByID(ctx context.Context, id string) (*domain.Trip, error)
+	GetByScheduleAndDate(ctx context.Context, scheduleID string, date time.Time) (*domain.Trip, error)
+	List(ctx context.Context, filter TripFilter) ([]*domain.Trip, int64, error)
+	Update(ctx context.Context, trip *domain.Trip) error
+	MarkSignalLost(ctx context.Context, id string, at time.Time) (bool, error)             // 운행 중이고 아직 표시 전일 때만 (표시했으면 true)
+	ClearSignalLost(ctx context.Context, id string) (bool, error)                          // 표시되어 있을 때만 해제 (해제했으면 true)
+	MarkDelayed(ctx context.Context, id string, at time.Time, reason string) (bool, error) // 대기 중이고 아직 지연 표시 전일 때만 (표시했으면 true)
+	AddDistance(ctx context.Context, id string, meters int) error                          // 운행 중일 때만 주행 거리 누적
+	SavePassenger(ctx context.Context, passenger *domain.TripPassenger) error              // 탑승자 기록 저장 (없으면 생성)
+}
+
+// tripRepository - GORM 기반 구현체
+type tripRepository struct {
+	db *gorm.DB
+}
+
+// NewTripRepository - 운행 Repository 생성
+func NewTripRepository(db *gorm.DB) TripRepository {
+	return &tripRepository{db: db}
+}
+
+// Create - 운행 저장 (탑승자 기록 포함)
+func (r *tripRepository) Create(ctx context.Context, trip *domain.Trip) error {
+	return database.Conn(ctx, r.db).Create(trip).Error
+}
+
+// GetByID - ID로 운행 조회 (탑승자 기록 포함)
+func (r *tripRepository) GetByID(ctx context.Context, id string) (*domain.Trip, error) {
+	var trip domain.Trip
+	err := database.Conn(ctx, r.db).
+		Preload("TripPassengers").
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&trip).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &trip, nil
+}
+
+// GetByScheduleAndDate - 일정/날짜로 운행 조회 (중복 생성 체크용)
+func (r *tripRepository) GetByScheduleAndDate(ctx context.Context, scheduleID string, date time.Time) (*domain.Trip, error) {
+	var trip domain.Trip
+	err := database.Conn(ctx, r.db).
+		Where("schedule_id = ? AND date = ? AND deleted_at IS NULL", scheduleID, date.Format(time.DateOnly)).
+		First(&trip).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &trip, nil
+}
+
+// List - 조건에 맞는 운행 목록과 전체 개수 조회 (날짜 역순)
+func (r *tripRepository) List(ctx context.Context, filter TripFilter) ([]*domain.Trip, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Trip{}).Where("deleted_at IS NULL")
+
+	if filter.Date != nil {
+		query = query.Where("date = ?", filter.Date.Format(time.DateOnly))
+	}
+	if filter.From != nil {
+		query = query.Where("date >= ?", filter.From.Format(time.DateOnly))
+	}
+	if filter.To != nil {
+		query = query.Where("date <= ?", filter.To.Format(time.DateOnly))
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ScheduleID != "" {
+		query = query.Where("schedule_id = ?", filter.ScheduleID)
+	}
+	if filter.ScheduleIDs != nil {
+		query = query.Where("schedule_id IN ?", filter.ScheduleIDs)
+	}
+	if filter.VehicleID != "" {
+		query = query.Where("vehicle_id = ?", filter.VehicleID)
+	}
+	if filter.DriverID != "" {
+		query = query.Where("assigned_driver_id = ?", filter.DriverID)
+	}
+	if filter.AttendantID != "" {
+		query = query.Where("assigned_attendant_id = ?", filter.AttendantID)
+	}
+	if filter.PassengerID != "" {
+		query = query.Where("id IN (SELECT trip_id FROM trip_passengers WHERE passenger_id = ?)", filter.PassengerID)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, TripListSpec.DefaultSort...))
+	if filter.WithPassengers {
+		query = query.Preload("TripPassengers")
+	}
+
+	var trips []*domain.Trip
+	if err := query.Find(&trips).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return trips, total, nil
+}
+
+// Update - 운행 수정 (탑승자 기록 제외)
+func (r *tripRepository) Update(ctx context.Context, trip *domain.Trip) error {
+	result := database.Conn(ctx, r.db).
+		Model(trip).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Omit(clause.Associations).
+		Updates(trip)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// MarkSignalLost - 위치 수신 끊김 표시 (여러 인스턴스가 동시에 감지해도 한 번만 표시)
+func (r *tripRepository) MarkSignalLost(ctx context.Context, id string, at time.Time) (bool, error) {
+	result := database.Conn(ctx, r.db).
+		Model(&domain.Trip{}).
+		Where("id = ? AND deleted_at IS NULL AND status = ? AND signal_lost_at IS NULL", id, domain.TripStatusInProgress).
+		Update("signal_lost_at", at)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ClearSignalLost - 위치 수신 끊김 해제
+func (r *tripRepository) ClearSignalLost(ctx context.Context, id string) (bool, error) {
+	result := database.Conn(ctx, r.db).
+		Model(&domain.Trip{}).
+		Where("id = ? AND deleted_at IS NULL AND signal_lost_at IS NOT NULL", id).
+		Update("signal_lost_at", nil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// MarkDelayed - 출발 지연 표시 (여러 인스턴스가 동시에 감지해도 한 번만 표시)
+func (r *tripRepository) MarkDelayed(ctx context.Context, id string, at time.Time, reason string) (bool, error) {
+	result := database.Conn(ctx, r.db).
+		Model(&domain.Trip{}).
+		Where("id = ? AND deleted_at IS NULL AND status = ? AND delayed_at IS NULL", id, domain.TripStatusPending).
+		Updates(map[string]interface{}{"delayed_at": at, "delay_reason": reason})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// AddDistance - 주행 거리 누적 (운행 전체를 다시 저장하지 않고 컬럼만 증가시켜 동시 갱신에도 값이 유실되지 않음)
+func (r *tripRepository) AddDistance(ctx context.Context, id string, meters int) error {
+	return database.Conn(ctx, r.db).
+		Model(&domain.Trip{}).
+		Where("id = ? AND deleted_at IS NULL AND status = ?", id, domain.TripStatusInProgress).
+		Update("total_distance", gorm.Expr("total_distance + ?", meters)).Error
+}
+
+// SavePassenger - 탑승자 기록 저장 (없으면 생성, 있으면 탑승/하차/불참 정보 갱신)
+func (r *tripRepository) SavePassenger(ctx context.Context, passenger *domain.TripPassenger) error {
+	return database.Conn(ctx, r.db).Save(passenger).Error
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 운행 정류장 도착/출발 기록 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 운행별 발생 순 조회 (정시성 보고서), 운행 + 정류장 + 종류로 중복 확인
+// ⚠️ 주의사항: append-only (수정/삭제 없음), (trip_id, stop_id, type) 유니크 인덱스
+
+// TripStopEventRepository - 정류장 도착/출발 기록 저장소 인터페이스
+type TripStopEventRepository interface {
+	Create(ctx context.Context, event *domain.TripStopEvent) error
+	ListByTrip(ctx context.Context, tripID string) ([]*domain.TripStopEvent, error)                                // 발생 순
+	ListByTrips(ctx context.Context, tripIDs []string) ([]*domain.TripStopEvent, error)                            // 여러 운행의 기록 (보고서용, 운행별 발생 순)
+	Get(ctx context.Context, tripID, stopID string, eventType domain.StopEventType) (*domain.TripStopEvent, error) // 없으면 ErrNotFound
+}
+
+// stopEventBatchSize - ListByTrips 한 번에 조회할 운행 수
+const stopEventBatchSize = 1000
+
+// tripStopEventRepository - GORM 기반 구현체
+type tripStopEventRepository struct {
+	db *gorm.DB
+}
+
+// NewTripStopEventRepository - 정류장 도착/출발 기록 Repository 생성
+func NewTripStopEventRepository(db *gorm.DB) TripStopEventRepository {
+	return &tripStopEventRepository{db: db}
+}
+
+// Create - 기록 저장
+func (r *tripStopEventRepository) Create(ctx context.Context, event *domain.TripStopEvent) error {
+	return database.Conn(ctx, r.db).Create(event).Error
+}
+
+// ListByTrip - 운행의 도착/출발 기록 전체 (발생 순)
+func (r *tripStopEventRepository) ListByTrip(ctx context.Context, tripID string) ([]*domain.TripStopEvent, error) {
+	var events []*domain.TripStopEvent
+	err := database.Conn(ctx, r.db).
+		Where("trip_id = ?", tripID).
+		Order("occurred_at ASC, created_at ASC").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ListByTrips - 여러 운행의 도착/출발 기록 (IN 목록이 길어지지 않게 나눠 조회)
+func (r *tripStopEventRepository) ListByTrips(ctx context.Context, tripIDs []string) ([]*domain.TripStopEvent, error) {
+	var events []*domain.TripStopEvent
+	for start := 0; start < len(tripIDs); start += stopEventBatchSize {
+		end := min(start+stopEventBatchSize, len(tripIDs))
+		var batch []*domain.TripStopEvent
+		err := database.Conn(ctx, r.db).
+			Where("trip_id IN ?", tripIDs[start:end]).
+			Order("trip_id ASC, occurred_at ASC, created_at ASC").
+			Find(&batch).Error
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, batch...)
+	}
+	return events, nil
+}
+
+// Get - 운행 + 정류장 + 종류의 기록
+func (r *tripStopEventRepository) Get(ctx context.Context, tripID, stopID string, eventType domain.StopEventType) (*domain.TripStopEvent, error) {
+	var event domain.TripStopEvent
+	err := database.Conn(ctx, r.db).
+		Where("trip_id = ? AND stop_id = ? AND type = ?", tripID, stopID, eventType).
+		First(&event).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &event, nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: 인증 주체별 일일 요청 수 카운터 (Redis)
+// 🎯 실무 포인트: 요청마다 INCR 한 번 → DB 부하 없이 집계, 키는 집계일이 지나면 자동 만료
+// ⚠️ 주의사항: 카운터는 Redis에만 있으므로 장기 통계가 필요하면 별도로 적재
+
+const (
+	usageKeyPrefix = "quota:usage:" // + identity + ":" + YYYYMMDD → 요청 수
+	usageKeyTTL    = 48 * time.Hour // 집계일 이후 하루 더 보관 (조회 여유)
+)
+
+// UsageRepository - 일일 사용량 저장소 인터페이스
+// identity는 "user:{id}" 또는 "api_key:{id}", day는 집계일 시작 시각
+type UsageRepository interface {
+	Increment(ctx context.Context, identity string, day time.Time) (int64, error)
+	Get(ctx context.Context, identity string, day time.Time) (int64, error)
+}
+
+// usageRepository - Redis 기반 구현체
+type usageRepository struct {
+	rdb *redis.Client
+}
+
+// NewUsageRepository - 사용량 Repository 생성
+func NewUsageRepository(rdb *redis.Client) UsageRepository {
+	return &usageRepository{rdb: rdb}
+}
+
+// Increment - 요청 수 1 증가 후 현재 값 반환
+func (r *usageRepository) Increment(ctx context.Context, identity string, day time.Time) (int64, error) {
+	key := usageKey(identity, day)
+
+	pipe := r.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireAt(ctx, key, day.Add(usageKeyTTL))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// Get - 현재 요청 수 (기록이 없으면 0)
+func (r *usageRepository) Get(ctx context.Context, identity string, day time.Time) (int64, error) {
+	count, err := r.rdb.Get(ctx, usageKey(identity, day)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+// usageKey - 집계 키 생성
+func usageKey(identity string, day time.Time) string {
+	return usageKeyPrefix + identity + ":" + day.Format("20060102")
+}
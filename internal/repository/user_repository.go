@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 계정/비밀번호 재설정 토큰 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 이메일은 소문자로 정규화해 저장/조회
+// ⚠️ 주의사항: 재설정 토큰은 해시로만 조회
+
+// UserFilter - 계정 목록 조회 조건
+type UserFilter struct {
+	Role        domain.Role       // 역할 필터 (빈 값이면 전체)
+	Status      domain.UserStatus // 상태 필터 (빈 값이면 전체)
+	Email       string            // 이메일 부분 검색
+	ProfileID   string            // 연결된 프로필 ID (보호자 ID 등)
+	ListOptions                   // 페이지 범위, 정렬, 필드 필터
+}
+
+// UserListSpec - 사용자 목록 정렬/필터 허용 필드
+var UserListSpec = ListSpec{
+	Sortable:    columns("email", "role", "status", "last_login_at", "created_at"),
+	Filterable:  columns("role", "status", "profile_id"),
+	DefaultSort: []SortField{{Column: "email"}},
+}
+
+// UserRepository - 계정 저장소 인터페이스
+type UserRepository interface {
+	Create(ctx context.Context, user *domain.User) error
+	GetByID(ctx context.Context, id string) (*domain.User, error)
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	List(ctx context.Context, filter UserFilter) ([]*domain.User, int64, error)
+	Update(ctx context.Context, user *domain.User) error
+	Delete(ctx context.Context, id string) error // 기관에서 제외 (soft delete)
+}
+
+// PasswordResetTokenRepository - 비밀번호 재설정 토큰 저장소 인터페이스
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *domain.PasswordResetToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error)
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	InvalidateAll(ctx context.Context, userID string, at time.Time) error
+}
+
+// userRepository - GORM 기반 구현체
+type userRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository - 계정 Repository 생성
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+// Create - 계정 저장
+func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
+	user.Email = strings.ToLower(user.Email)
+	return database.Conn(ctx, r.db).Create(user).Error
+}
+
+// GetByID - ID로 계정 조회
+func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	var user domain.User
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&user).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &user, nil
+}
+
+// GetByEmail - 이메일로 계정 조회 (로그인, 중복 체크용)
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	var user domain.User
+	err := database.Conn(ctx, r.db).
+		Where("email = ? AND deleted_at IS NULL", strings.ToLower(email)).
+		First(&user).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &user, nil
+}
+
+// List - 조건에 맞는 계정 목록과 전체 개수 조회
+func (r *userRepository) List(ctx context.Context, filter UserFilter) ([]*domain.User, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.User{}).Where("deleted_at IS NULL")
+
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Email != "" {
+		query = query.Where("email ILIKE ?", "%"+filter.Email+"%")
+	}
+	if filter.ProfileID != "" {
+		query = query.Where("profile_id = ?", filter.ProfileID)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, UserListSpec.DefaultSort...))
+
+	var users []*domain.User
+	if err := query.Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// Update - 계정 수정 (전체 필드 저장)
+func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
+	user.Email = strings.ToLower(user.Email)
+	result := database.Conn(ctx, r.db).
+		Model(user).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(user)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete - 계정 삭제 (soft delete, 삭제된 계정의 이메일은 다시 사용 가능)
+func (r *userRepository) Delete(ctx context.Context, id string) error {
+	return softDelete(ctx, r.db, &domain.User{}, id)
+}
+
+// passwordResetTokenRepository - GORM 기반 구현체
+type passwordResetTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetTokenRepository - 재설정 토큰 Repository 생성
+func NewPasswordResetTokenRepository(db *gorm.DB) PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{db: db}
+}
+
+// Create - 재설정 토큰 저장
+func (r *passwordResetTokenRepository) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	return database.Conn(ctx, r.db).Create(token).Error
+}
+
+// GetByHash - 토큰 해시로 조회
+func (r *passwordResetTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	var token domain.PasswordResetToken
+	if err := database.Conn(ctx, r.db).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &token, nil
+}
+
+// MarkUsed - 토큰 사용 처리 (이미 사용된 토큰이면 ErrNotFound)
+func (r *passwordResetTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	result := database.Conn(ctx, r.db).
+		Model(&domain.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// InvalidateAll - 사용자의 미사용 토큰 일괄 무효화
+func (r *passwordResetTokenRepository) InvalidateAll(ctx context.Context, userID string, at time.Time) error {
+	return database.Conn(ctx, r.db).
+		Model(&domain.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", at).Error
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 차량 서류 Repository (PostgreSQL + GORM, 파일 정보는 files → Preload("File"))
+// 🎯 실무 포인트: 서류는 차량 하위 리소스 → 조회/삭제는 항상 차량 ID와 함께 (다른 차량의 서류 ID로 접근 불가)
+// ⚠️ 주의사항: 서류 삭제와 파일 삭제는 Service에서 순서대로 처리 (서류 먼저, files 외래 키)
+
+// VehicleDocumentRepository - 차량 서류 저장소 인터페이스
+type VehicleDocumentRepository interface {
+	Create(ctx context.Context, document *domain.VehicleDocument) error
+	List(ctx context.Context, vehicleID string, docType domain.VehicleDocumentType) ([]*domain.VehicleDocument, error) // 종류가 비면 전체, 최신 업로드 먼저
+	GetByID(ctx context.Context, vehicleID, id string) (*domain.VehicleDocument, error)                                // 차량의 서류가 아니면 ErrNotFound
+	Delete(ctx context.Context, vehicleID, id string) error
+}
+
+// vehicleDocumentRepository - GORM 기반 구현체
+type vehicleDocumentRepository struct {
+	db *gorm.DB
+}
+
+// NewVehicleDocumentRepository - 차량 서류 Repository 생성
+func NewVehicleDocumentRepository(db *gorm.DB) VehicleDocumentRepository {
+	return &vehicleDocumentRepository{db: db}
+}
+
+// Create - 차량 서류 생성 (파일은 FileRepository로 먼저 생성)
+func (r *vehicleDocumentRepository) Create(ctx context.Context, document *domain.VehicleDocument) error {
+	return database.Conn(ctx, r.db).Omit("File").Create(document).Error
+}
+
+// List - 차량의 서류 목록 (업로드 시각 역순)
+func (r *vehicleDocumentRepository) List(ctx context.Context, vehicleID string, docType domain.VehicleDocumentType) ([]*domain.VehicleDocument, error) {
+	query := database.Conn(ctx, r.db).Preload("File").Where("vehicle_id = ?", vehicleID)
+	if docType != "" {
+		query = query.Where("type = ?", docType)
+	}
+
+	var documents []*domain.VehicleDocument
+	err := query.Order("created_at DESC").Find(&documents).Error
+	return documents, err
+}
+
+// GetByID - 차량의 서류 조회
+func (r *vehicleDocumentRepository) GetByID(ctx context.Context, vehicleID, id string) (*domain.VehicleDocument, error) {
+	var document domain.VehicleDocument
+	err := database.Conn(ctx, r.db).Preload("File").Where("id = ? AND vehicle_id = ?", id, vehicleID).First(&document).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &document, nil
+}
+
+// Delete - 차량 서류 삭제
+func (r *vehicleDocumentRepository) Delete(ctx context.Context, vehicleID, id string) error {
+	result := database.Conn(ctx, r.db).
+		Where("id = ? AND vehicle_id = ?", id, vehicleID).
+		Delete(&domain.VehicleDocument{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 차량 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: Spring Data JPA의 Repository처럼 인터페이스로 추상화
+// ⚠️ 주의사항: DeletedAt이 *time.Time이므로 soft delete 필터를 직접 적용
+
+// VehicleFilter - 차량 목록 조회 조건
+type VehicleFilter struct {
+	Status      domain.VehicleStatus // 상태 필터 (빈 값이면 전체)
+	VehicleType domain.VehicleType   // 유형 필터 (빈 값이면 전체)
+	MinCapacity *int                 // 정원 하한 (운전석 포함)
+	MaxCapacity *int                 // 정원 상한
+
+	InsuranceExpiringWithin  *int  // N일 이내 보험 만료 (이미 만료 포함)
+	InsuranceExpired         *bool // 보험 만료 여부 (false면 만료일 없음 또는 미만료)
+	InspectionExpiringWithin *int  // N일 이내 정기검사 만료 (이미 만료 포함)
+	InspectionExpired        *bool // 정기검사 만료 여부
+
+	IncludeDeleted bool // 삭제된 차량 포함 (관리자 조회)
+	ListOptions         // 페이지 범위, 정렬, 필드 필터
+}
+
+// VehicleListSpec - 차량 목록 정렬/필터 허용 필드
+var VehicleListSpec = ListSpec{
+	Sortable:    columns("created_at", "plate_number", "model", "manufacturer", "capacity", "year", "status", "insurance_expiry", "inspection_expiry", "odometer_km"),
+	Filterable:  columns("status", "vehicle_type", "manufacturer", "model", "color"),
+	DefaultSort: []SortField{{Column: "created_at", Desc: true}},
+}
+
+// VehicleRepository - 차량 저장소 인터페이스
+type VehicleRepository interface {
+	Create(ctx context.Context, vehicle *domain.Vehicle) error
+	GetByID(ctx context.Context, id string) (*domain.Vehicle, error)
+	GetByPlateNumber(ctx context.Context, plateNumber string) (*domain.Vehicle, error)
+	List(ctx context.Context, filter VehicleFilter) ([]*domain.Vehicle, int64, error)
+	Update(ctx context.Context, vehicle *domain.Vehicle) error
+	SoftDelete(ctx context.Context, id string) error
+	GetDeleted(ctx context.Context, id string) (*domain.Vehicle, error) // 삭제된 차량 조회 (복구 전 확인용)
+	Restore(ctx context.Context, id string) error
+}
+
+// vehicleRepository - GORM 기반 구현체
+type vehicleRepository struct {
+	db *gorm.DB
+}
+
+// NewVehicleRepository - 차량 Repository 생성
+func NewVehicleRepository(db *gorm.DB) VehicleRepository {
+	return &vehicleRepository{db: db}
+}
+
+// Create - 차량 저장
+func (r *vehicleRepository) Create(ctx context.Context, vehicle *domain.Vehicle) error {
+	return database.Conn(ctx, r.db).Create(vehicle).Error
+}
+
+// GetByID - ID로 차량 조회
+func (r *vehicleRepository) GetByID(ctx context.Context, id string) (*domain.Vehicle, error) {
+	var vehicle domain.Vehicle
+	err := database.Conn(ctx, r.db).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&vehicle).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &vehicle, nil
+}
+
+// GetByPlateNumber - 차량 번호로 조회 (중복 체크용)
+func (r *vehicleRepository) GetByPlateNumber(ctx context.Context, plateNumber string) (*domain.Vehicle, error) {
+	var vehicle domain.Vehicle
+	err := database.Conn(ctx, r.db).
+		Where("plate_number = ? AND deleted_at IS NULL", plateNumber).
+		First(&vehicle).Error
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &vehicle, nil
+}
+
+// List - 조건에 맞는 차량 목록과 전체 개수 조회
+func (r *vehicleRepository) List(ctx context.Context, filter VehicleFilter) ([]*domain.Vehicle, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.Vehicle{}).Scopes(withDeleted(filter.IncludeDeleted))
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.VehicleType != "" {
+		query = query.Where("vehicle_type = ?", filter.VehicleType)
+	}
+	if filter.MinCapacity != nil {
+		query = query.Where("capacity >= ?", *filter.MinCapacity)
+	}
+	if filter.MaxCapacity != nil {
+		query = query.Where("capacity <= ?", *filter.MaxCapacity)
+	}
+	now := time.Now()
+	query = query.Scopes(
+		expiryScope("insurance_expiry", filter.InsuranceExpiringWithin, filter.InsuranceExpired, now),
+		expiryScope("inspection_expiry", filter.InspectionExpiringWithin, filter.InspectionExpired, now),
+	)
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, VehicleListSpec.DefaultSort...))
+
+	var vehicles []*domain.Vehicle
+	if err := query.Find(&vehicles).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return vehicles, total, nil
+}
+
+// Update - 차량 정보 수정 (전체 필드 저장)
+// Save는 대상이 없으면 INSERT로 동작하므로 Select("*").Updates 사용
+func (r *vehicleRepository) Update(ctx context.Context, vehicle *domain.Vehicle) error {
+	result := database.Conn(ctx, r.db).
+		Model(vehicle).
+		Where("deleted_at IS NULL").
+		Select("*").
+		Updates(vehicle)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SoftDelete - 차량 삭제 (deleted_at 설정)
+func (r *vehicleRepository) SoftDelete(ctx context.Context, id string) error {
+	return softDelete(ctx, r.db, &domain.Vehicle{}, id)
+}
+
+// GetDeleted - 삭제된 차량 조회 (없거나 삭제되지 않았으면 ErrNotFound)
+func (r *vehicleRepository) GetDeleted(ctx context.Context, id string) (*domain.Vehicle, error) {
+	var vehicle domain.Vehicle
+	if err := getDeleted(ctx, r.db, &vehicle, id); err != nil {
+		return nil, err
+	}
+	return &vehicle, nil
+}
+
+// Restore - 삭제된 차량 복구 (deleted_at 해제)
+func (r *vehicleRepository) Restore(ctx context.Context, id string) error {
+	return restore(ctx, r.db, &domain.Vehicle{}, id)
+}
+
+// expiryScope - 만료일 컬럼 조건 (N일 이내 만료는 이미 만료 포함, 만료 여부 false는 만료일 없음 포함)
+func expiryScope(column string, within *int, expired *bool, now time.Time) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if within != nil {
+			db = db.Where(column+" < ?", now.AddDate(0, 0, *within))
+		}
+		if expired != nil {
+			if *expired {
+				db = db.Where(column+" < ?", now)
+			} else {
+				db = db.Where("("+column+" IS NULL OR "+column+" >= ?)", now)
+			}
+		}
+		return db
+	}
+}
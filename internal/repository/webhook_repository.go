@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 📝 설명: 웹훅 구독/전달 기록 Repository (PostgreSQL + GORM)
+// 🎯 실무 포인트: 이벤트 발생 시 이벤트가 발생한 기관의 구독 중 events 배열 포함 조건(@>, GIN 인덱스)으로 받을 구독만 조회
+// 전달 기록은 알림 발송 기록과 같이 ClaimDue로 때가 된 기록을 가져가 결과를 Update
+// ⚠️ 주의사항: ClaimDue는 행 잠금(SKIP LOCKED) 후 next_attempt_at을 lease만큼 미뤄서 가져감
+// → 여러 인스턴스가 동시에 재시도해도 같은 전달을 두 번 보내지 않음
+
+// WebhookSubscriptionFilter - 웹훅 구독 목록 조회 조건
+type WebhookSubscriptionFilter struct {
+	ListOptions // 페이지 범위, 정렬, 필드 필터
+}
+
+// WebhookSubscriptionListSpec - 웹훅 구독 목록 정렬/필터 허용 필드
+var WebhookSubscriptionListSpec = ListSpec{
+	Sortable:    columns("created_at", "name"),
+	Filterable:  columns("active", "created_by"),
+	DefaultSort: []SortField{{Column: "created_at", Desc: true}},
+}
+
+// WebhookDeliveryFilter - 웹훅 전달 기록 목록 조회 조건
+type WebhookDeliveryFilter struct {
+	SubscriptionID string
+	Status         domain.WebhookDeliveryStatus
+	Event          domain.WebhookEvent
+	ListOptions    // 페이지 범위, 정렬, 필드 필터
+}
+
+// WebhookDeliveryListSpec - 웹훅 전달 기록 목록 정렬/필터 허용 필드
+var WebhookDeliveryListSpec = ListSpec{
+	Sortable:    columns("created_at", "delivered_at", "attempts"),
+	Filterable:  columns("status", "event", "event_id", "response_status"),
+	DefaultSort: []SortField{{Column: "created_at", Desc: true}},
+}
+
+// WebhookSubscriptionRepository - 웹훅 구독 저장소 인터페이스
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *domain.WebhookSubscription) error
+	GetByID(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	List(ctx context.Context, filter WebhookSubscriptionFilter) ([]*domain.WebhookSubscription, int64, error)
+	ListActiveByEvent(ctx context.Context, organizationID string, event domain.WebhookEvent) ([]*domain.WebhookSubscription, error)
+	Update(ctx context.Context, subscription *domain.WebhookSubscription) error
+	Delete(ctx context.Context, id string) error // 전달 기록도 함께 삭제 (ON DELETE CASCADE)
+}
+
+// WebhookDeliveryRepository - 웹훅 전달 기록 저장소 인터페이스
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *domain.WebhookDelivery) error
+	Update(ctx context.Context, delivery *domain.WebhookDelivery) error
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.WebhookDelivery, error)
+	List(ctx context.Context, filter WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error)
+}
+
+// webhookSubscriptionRepository - GORM 기반 구현체
+type webhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookSubscriptionRepository - 웹훅 구독 Repository 생성
+func NewWebhookSubscriptionRepository(db *gorm.DB) WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{db: db}
+}
+
+// Create - 웹훅 구독 저장
+func (r *webhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	return database.Conn(ctx, r.db).Create(subscription).Error
+}
+
+// GetByID - ID로 웹훅 구독 조회
+func (r *webhookSubscriptionRepository) GetByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	var subscription domain.WebhookSubscription
+	if err := database.Conn(ctx, r.db).Where("id = ?", id).First(&subscription).Error; err != nil {
+		return nil, translateError(err)
+	}
+	return &subscription, nil
+}
+
+// List - 조건에 맞는 웹훅 구독 목록과 전체 개수 조회
+func (r *webhookSubscriptionRepository) List(ctx context.Context, filter WebhookSubscriptionFilter) ([]*domain.WebhookSubscription, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.WebhookSubscription{}).
+		Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, WebhookSubscriptionListSpec.DefaultSort...))
+
+	var subscriptions []*domain.WebhookSubscription
+	if err := query.Find(&subscriptions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return subscriptions, total, nil
+}
+
+// ListActiveByEvent - 기관에서 이벤트를 받는 활성 구독 목록
+// 릴레이 작업은 인증 주체가 없어 테넌트 조건이 붙지 않으므로 기관을 직접 지정
+func (r *webhookSubscriptionRepository) ListActiveByEvent(ctx context.Context, organizationID string, event domain.WebhookEvent) ([]*domain.WebhookSubscription, error) {
+	contains, err := json.Marshal([]domain.WebhookEvent{event})
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []*domain.WebhookSubscription
+	err = database.Conn(ctx, r.db).
+		Where("organization_id = ? AND active AND events @> ?", organizationID, string(contains)).
+		Order("created_at").
+		Find(&subscriptions).Error
+	return subscriptions, err
+}
+
+// Update - 웹훅 구독 수정 (전체 필드 저장)
+func (r *webhookSubscriptionRepository) Update(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	subscription.UpdatedAt = time.Now()
+	result := database.Conn(ctx, r.db).Model(subscription).Select("*").Updates(subscription)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete - 웹훅 구독 삭제
+func (r *webhookSubscriptionRepository) Delete(ctx context.Context, id string) error {
+	result := database.Conn(ctx, r.db).Where("id = ?", id).Delete(&domain.WebhookSubscription{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// webhookDeliveryRepository - GORM 기반 구현체
+type webhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookDeliveryRepository - 웹훅 전달 기록 Repository 생성
+func NewWebhookDeliveryRepository(db *gorm.DB) WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+// Create - 전달 기록 저장
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	return database.Conn(ctx, r.db).Create(delivery).Error
+}
+
+// Update - 전달 결과 반영
+func (r *webhookDeliveryRepository) Update(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	return database.Conn(ctx, r.db).
+		Model(delivery).
+		Select("status", "attempts", "response_status", "response", "next_attempt_at", "delivered_at", "updated_at").
+		Updates(delivery).Error
+}
+
+// ClaimDue - 전달할 때가 된 기록(pending/retrying)을 가져가고 lease 동안 다른 인스턴스가 가져가지 못하게 함 (오래 기다린 순)
+func (r *webhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.WebhookDelivery, error) {
+	var deliveries []*domain.WebhookDelivery
+	err := database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ? AND next_attempt_at <= ?", []domain.WebhookDeliveryStatus{domain.WebhookDeliveryPending, domain.WebhookDeliveryRetrying}, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(deliveries))
+		for i, delivery := range deliveries {
+			ids[i] = delivery.ID
+		}
+		return tx.Model(&domain.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// List - 조건에 맞는 전달 기록 목록과 전체 개수 조회 (최신순)
+func (r *webhookDeliveryRepository) List(ctx context.Context, filter WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	query := database.Conn(ctx, r.db).Model(&domain.WebhookDelivery{})
+
+	if filter.SubscriptionID != "" {
+		query = query.Where("subscription_id = ?", filter.SubscriptionID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Event != "" {
+		query = query.Where("event = ?", filter.Event)
+	}
+
+	query = query.Scopes(filterScope(filter.ListOptions))
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Scopes(pageScope(filter.ListOptions, WebhookDeliveryListSpec.DefaultSort...))
+
+	var deliveries []*domain.WebhookDelivery
+	if err := query.Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/sms"
+)
+
+// 📝 설명: 위험 운전 경보 (수신 위치의 속도로 과속/보호구역 과속/급가속/급감속 판정 후 운행별 기록)
+// 🎯 실무 포인트: TrackingService의 LocationObserver로 등록해 위치가 들어올 때마다 판정
+// ⚠️ 주의사항: 같은 종류 경보는 Cooldown 안에 다시 기록하지 않음 (과속이 이어지는 동안 위치마다 쌓이지 않게)
+// 급가속/급감속은 직전 위치와 간격이 짧을 때만 판정 (간격이 길면 평균 변화율이라 의미 없음)
+
+// maxHarshInterval - 급가속/급감속을 판정하는 직전 위치와의 최대 간격
+const maxHarshInterval = 10 * time.Second
+
+// SchoolZone - 어린이 보호구역 (중심 좌표 + 반경 미터)
+type SchoolZone struct {
+	Center geo.Point
+	Radius float64
+}
+
+// AlertRules - 경보 판정 기준 (0이면 해당 판정 안 함)
+type AlertRules struct {
+	SpeedLimit           float64       // 전체 제한 속도 (km/h)
+	SchoolZoneSpeedLimit float64       // 어린이 보호구역 제한 속도 (km/h)
+	SchoolZones          []SchoolZone  // 어린이 보호구역 목록
+	HarshAcceleration    float64       // 급가속 기준 (초당 속도 증가 km/h)
+	HarshBraking         float64       // 급감속 기준 (초당 속도 감소 km/h)
+	Cooldown             time.Duration // 같은 종류 경보 최소 간격
+}
+
+// AlertNotifier - 경보 발생 시 관리자 알림 (메신저/SMS 등)
+type AlertNotifier interface {
+	NotifyAlert(ctx context.Context, alert *domain.TripAlert) error
+}
+
+// LogAlertNotifier - 로그로만 남기는 기본 Notifier
+type LogAlertNotifier struct{}
+
+// NotifyAlert - 경보를 경고 로그로 기록
+func (LogAlertNotifier) NotifyAlert(ctx context.Context, alert *domain.TripAlert) error {
+	logger.FromContext(ctx).Warn("Trip alert raised", map[string]interface{}{
+		"trip_id":    alert.TripID,
+		"vehicle_id": alert.VehicleID,
+		"type":       string(alert.Type),
+		"value":      alert.Value,
+		"threshold":  alert.Threshold,
+	})
+	return nil
+}
+
+// alertLabels - 경보 종류별 문자 표기
+var alertLabels = map[domain.AlertType]string{
+	domain.AlertSpeeding:           "과속",
+	domain.AlertSchoolZoneSpeeding: "어린이 보호구역 과속",
+	domain.AlertHarshAcceleration:  "급가속",
+	domain.AlertHarshBraking:       "급감속",
+}
+
+// SMSAlertNotifier - 요청 기관의 관리자 연락처로 경보 문자 발송 (한 명에게 실패해도 나머지에게 계속 발송)
+type SMSAlertNotifier struct {
+	Sender    sms.Sender
+	Operators OperatorResolver // 경보를 받을 기관 관리자 (연락처가 있는 수신자만)
+	Locations TimezoneResolver // 발생 시각을 표기할 요청 기관 시간대 (nil이면 한국 시간)
+}
+
+// NotifyAlert - 경보 문자 발송 (실패한 수신자가 있으면 첫 번째 에러 반환)
+func (n SMSAlertNotifier) NotifyAlert(ctx context.Context, alert *domain.TripAlert) error {
+	organizationID := repository.OrganizationFromContext(ctx)
+	text := fmt.Sprintf("[어디니] %s 경보\n측정 %.0fkm/h (기준 %.0fkm/h)\n%s\n운행 %s",
+		alertLabels[alert.Type], alert.Value, alert.Threshold,
+		alert.OccurredAt.In(organizationLocation(ctx, n.Locations, organizationID)).Format("01/02 15:04:05"), alert.TripID)
+	var firstErr error
+	for _, operator := range organizationOperators(ctx, n.Operators, organizationID) {
+		if operator.Phone == "" {
+			continue
+		}
+		if err := n.Sender.Send(ctx, &sms.Message{To: operator.Phone, Title: "위험 운전 경보", Text: text}); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// AlertService - 위험 운전 경보 서비스
+type AlertService struct {
+	alertRepo   repository.TripAlertRepository
+	tripService *TripService
+	rules       AlertRules
+	notifier    AlertNotifier
+}
+
+// NewAlertService - 경보 서비스 생성 (notifier가 nil이면 기록만 하고 알리지 않음)
+func NewAlertService(
+	alertRepo repository.TripAlertRepository,
+	tripService *TripService,
+	rules AlertRules,
+	notifier AlertNotifier,
+) *AlertService {
+	return &AlertService{
+		alertRepo:   alertRepo,
+		tripService: tripService,
+		rules:       rules,
+		notifier:    notifier,
+	}
+}
+
+// ObserveLocation - 위치 속도로 경보 판정 후 기록 (LocationObserver 구현)
+func (s *AlertService) ObserveLocation(ctx context.Context, trip *domain.Trip, previous, current *domain.VehiclePosition) {
+	for _, alert := range s.detect(previous, current) {
+		if s.coolingDown(ctx, alert) {
+			continue
+		}
+		if err := s.alertRepo.Create(ctx, alert); err != nil {
+			logger.FromContext(ctx).Warn("Failed to save trip alert", map[string]interface{}{
+				"trip_id": trip.ID,
+				"type":    string(alert.Type),
+				"error":   err.Error(),
+			})
+			continue
+		}
+		if s.notifier != nil {
+			if err := s.notifier.NotifyAlert(ctx, alert); err != nil {
+				logger.FromContext(ctx).Warn("Failed to notify trip alert", map[string]interface{}{
+					"trip_id": trip.ID,
+					"type":    string(alert.Type),
+					"error":   err.Error(),
+				})
+			}
+		}
+	}
+}
+
+// List - 운행 경보 목록 (발생 순)
+func (s *AlertService) List(ctx context.Context, tripID string) ([]*domain.TripAlert, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := s.alertRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	if alerts == nil {
+		alerts = []*domain.TripAlert{}
+	}
+	return alerts, nil
+}
+
+// detect - 판정 기준을 넘은 경보 (속도가 없는 위치는 판정하지 않음)
+func (s *AlertService) detect(previous, current *domain.VehiclePosition) []*domain.TripAlert {
+	if current.Speed == nil {
+		return nil
+	}
+	speed := *current.Speed
+
+	var alerts []*domain.TripAlert
+	switch {
+	case s.rules.SchoolZoneSpeedLimit > 0 && speed > s.rules.SchoolZoneSpeedLimit && s.inSchoolZone(current):
+		alerts = append(alerts, domain.NewTripAlert(current, domain.AlertSchoolZoneSpeeding, speed, s.rules.SchoolZoneSpeedLimit))
+	case s.rules.SpeedLimit > 0 && speed > s.rules.SpeedLimit:
+		alerts = append(alerts, domain.NewTripAlert(current, domain.AlertSpeeding, speed, s.rules.SpeedLimit))
+	}
+
+	if previous == nil || previous.Speed == nil {
+		return alerts
+	}
+	interval := current.RecordedAt.Sub(previous.RecordedAt)
+	if interval <= 0 || interval > maxHarshInterval {
+		return alerts
+	}
+	change := (speed - *previous.Speed) / interval.Seconds()
+	switch {
+	case s.rules.HarshAcceleration > 0 && change >= s.rules.HarshAcceleration:
+		alerts = append(alerts, domain.NewTripAlert(current, domain.AlertHarshAcceleration, change, s.rules.HarshAcceleration))
+	case s.rules.HarshBraking > 0 && -change >= s.rules.HarshBraking:
+		alerts = append(alerts, domain.NewTripAlert(current, domain.AlertHarshBraking, -change, s.rules.HarshBraking))
+	}
+	return alerts
+}
+
+// inSchoolZone - 위치가 어린이 보호구역 안인지
+func (s *AlertService) inSchoolZone(position *domain.VehiclePosition) bool {
+	point := geo.Point{Latitude: position.Latitude, Longitude: position.Longitude}
+	for _, zone := range s.rules.SchoolZones {
+		if geo.Distance(point, zone.Center) <= zone.Radius {
+			return true
+		}
+	}
+	return false
+}
+
+// coolingDown - 같은 종류 경보가 Cooldown 안에 이미 기록되었는지
+func (s *AlertService) coolingDown(ctx context.Context, alert *domain.TripAlert) bool {
+	if s.rules.Cooldown <= 0 {
+		return false
+	}
+	last, err := s.alertRepo.LatestByType(ctx, alert.TripID, alert.Type)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			logger.FromContext(ctx).Warn("Failed to load latest trip alert", map[string]interface{}{
+				"trip_id": alert.TripID,
+				"error":   err.Error(),
+			})
+		}
+		return false
+	}
+	return alert.OccurredAt.Sub(last.OccurredAt) < s.rules.Cooldown
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: API 키 발급/폐기 및 X-API-Key 인증
+// 🎯 실무 포인트: 인증 시 마지막 사용 시각을 기록해 미사용 키 정리에 활용
+// ⚠️ 주의사항: 사용 시각 갱신은 분 단위로만 (요청마다 UPDATE 방지)
+
+// lastUsedResolution - 마지막 사용 시각 갱신 간격
+const lastUsedResolution = time.Minute
+
+// APIKeyService - API 키 서비스
+type APIKeyService struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+// NewAPIKeyService - API 키 서비스 생성
+func NewAPIKeyService(apiKeyRepo repository.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{apiKeyRepo: apiKeyRepo}
+}
+
+// Issue - API 키 발급 (원문 키는 반환값으로 한 번만 제공)
+func (s *APIKeyService) Issue(ctx context.Context, createdBy string, req *dto.IssueAPIKeyRequest) (*dto.IssuedAPIKeyResponse, error) {
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"expires_at": "만료 시각은 현재 이후여야 합니다",
+		})
+	}
+
+	raw, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	scopes := make([]domain.APIKeyScope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, domain.APIKeyScope(scope))
+	}
+
+	key := domain.NewAPIKey(req.Name, prefix, hash, scopes, createdBy)
+	key.ExpiresAt = req.ExpiresAt
+	key.DailyQuota = req.DailyQuota
+
+	if err := s.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return &dto.IssuedAPIKeyResponse{APIKey: key, Key: raw}, nil
+}
+
+// Get - API 키 단건 조회
+func (s *APIKeyService) Get(ctx context.Context, id string) (*domain.APIKey, error) {
+	key, err := s.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "API 키")
+	}
+	return key, nil
+}
+
+// List - API 키 목록 조회
+func (s *APIKeyService) List(ctx context.Context, filter repository.APIKeyFilter) ([]*domain.APIKey, int64, error) {
+	keys, total, err := s.apiKeyRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return keys, total, nil
+}
+
+// Revoke - API 키 폐기
+func (s *APIKeyService) Revoke(ctx context.Context, id string) (*domain.APIKey, error) {
+	key, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.IsRevoked() {
+		return nil, util.NewConflictError("이미 폐기된 API 키입니다")
+	}
+
+	key.Revoke()
+	if err := s.apiKeyRepo.Update(ctx, key); err != nil {
+		return nil, toAppError(err, "API 키")
+	}
+
+	return key, nil
+}
+
+// AuthenticateAPIKey - X-API-Key 원문으로 인증 주체 조회
+// 없거나 폐기/만료된 키는 UNAUTHORIZED
+func (s *APIKeyService) AuthenticateAPIKey(ctx context.Context, raw string) (*auth.Principal, error) {
+	key, err := s.apiKeyRepo.GetByHash(ctx, auth.HashToken(raw))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, util.NewUnauthorizedError()
+		}
+		return nil, util.NewInternalError(err)
+	}
+
+	now := time.Now()
+	if !key.IsUsable(now) {
+		return nil, util.NewUnauthorizedError()
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		if err := s.apiKeyRepo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			// 사용 시각 기록 실패로 인증을 막지는 않음
+			logger.FromContext(ctx).Warn("Failed to record API key usage", map[string]interface{}{
+				"api_key_id": key.ID,
+				"error":      err.Error(),
+			})
+		}
+	}
+
+	return &auth.Principal{
+		UserID:         key.ID,
+		OrganizationID: key.OrganizationID,
+		Role:           domain.RoleIntegration,
+		Scopes:         key.Scopes,
+		DailyQuota:     key.DailyQuota,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: "차량이 곧 도착합니다" 선제 알림 (지오펜스 접근 이벤트 → 정류장 배정 탑승자의 보호자)
+// 🎯 실무 포인트: 차량이 다음 정류장 접근 반경에 들어오면 그 정류장에 배정된 탑승자의 보호자에게 "약 N분 후 도착" 알림
+// 남은 시간은 판정 시점 거리와 평균 속도로 직선 추정 (도착 예정 시간 계산과 같은 보정 비율)
+// ⚠️ 주의사항: 접근 반경을 지나쳤다 다시 들어오면 지오펜스가 접근 이벤트를 다시 내므로 운행 + 정류장 단위로 한 번만 발송
+// 위치 수신 요청을 막지 않도록 조회/발송은 백그라운드
+
+// approachDedupTTL - 운행 + 정류장 접근 알림 중복 방지 기간 (하루 운행보다 길게)
+const approachDedupTTL = 24 * time.Hour
+
+// ApproachService - 정류장 접근 알림 서비스
+type ApproachService struct {
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+	dedupRepo     repository.NotificationDedupRepository
+	notifier      GuardianNotifier
+	speed         float64 // 평균 주행 속도 (km/h)
+}
+
+// NewApproachService - 정류장 접근 알림 서비스 생성
+func NewApproachService(
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	dedupRepo repository.NotificationDedupRepository,
+	notifier GuardianNotifier,
+	speed float64,
+) *ApproachService {
+	return &ApproachService{
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+		dedupRepo:     dedupRepo,
+		notifier:      notifier,
+		speed:         speed,
+	}
+}
+
+// HandleStopEvent - 정류장 접근 시 배정 탑승자의 보호자에게 알림 (geofence.Listener 구현)
+func (s *ApproachService) HandleStopEvent(ctx context.Context, event geofence.Event) {
+	if event.Type != geofence.EventApproaching {
+		return
+	}
+
+	claimed, err := s.dedupRepo.Claim(ctx, "approaching:"+event.TripID+":"+event.StopID, approachDedupTTL)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to claim approach notification", map[string]interface{}{
+			"trip_id": event.TripID,
+			"stop_id": event.StopID,
+			"error":   err.Error(),
+		})
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	// 요청이 끝나도 발송은 계속 (인증 주체 등 context 값은 유지)
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.notifyStop(ctx, event); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify stop approach", map[string]interface{}{
+				"trip_id": event.TripID,
+				"stop_id": event.StopID,
+				"error":   err.Error(),
+			})
+		}
+	}()
+}
+
+// notifyStop - 정류장에 배정된 활동 중 탑승자의 보호자에게 접근 알림 (불참 처리·결석 신고된 탑승자 제외)
+func (s *ApproachService) notifyStop(ctx context.Context, event geofence.Event) error {
+	trip, err := s.tripRepo.GetByID(ctx, event.TripID)
+	if err != nil {
+		return err
+	}
+	if !trip.IsInProgress() {
+		return nil
+	}
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return err
+	}
+	passengers, _, err := s.passengerRepo.List(ctx, repository.PassengerFilter{
+		Status:  domain.PassengerStatusActive,
+		RouteID: schedule.RouteID,
+		StopID:  event.StopID,
+	})
+	if err != nil {
+		return err
+	}
+
+	minutes := s.estimateMinutes(event.Distance)
+	for _, passenger := range passengers {
+		if record := findTripPassenger(trip, passenger.ID); record != nil && (record.IsNoShow() || record.IsExcused()) {
+			continue
+		}
+		notifyPassengerGuardians(ctx, s.notifier, s.guardianRepo, passenger, domain.NotificationEventApproaching,
+			approachNotification(passenger, event, minutes))
+	}
+	return nil
+}
+
+// estimateMinutes - 남은 거리 × 보정 비율 ÷ 평균 속도 (분 단위 올림, 최소 1분)
+func (s *ApproachService) estimateMinutes(distance float64) int {
+	if s.speed <= 0 {
+		return 1
+	}
+	metersPerMinute := s.speed * 1000 / 60
+	return max(1, int(math.Ceil(distance*straightLineDetour/metersPerMinute)))
+}
+
+// approachNotification - 정류장 접근 알림 내용
+func approachNotification(passenger *domain.Passenger, event geofence.Event, minutes int) *Notification {
+	notification := newNotification(util.Text("차량 도착 예정"),
+		util.Text("%s 님의 차량이 %s에 약 %d분 후 도착합니다", passenger.Name, event.StopName, minutes))
+	notification.Data = map[string]string{
+		"type":         string(domain.NotificationEventApproaching),
+		"trip_id":      event.TripID,
+		"passenger_id": passenger.ID,
+		"stop_id":      event.StopID,
+		"eta_minutes":  strconv.Itoa(minutes),
+	}
+	notification.Template = string(domain.NotificationEventApproaching)
+	notification.Variables = map[string]string{
+		"name":    passenger.Name,
+		"stop":    event.StopName,
+		"minutes": strconv.Itoa(minutes),
+	}
+	return notification
+}
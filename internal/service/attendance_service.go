@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 탑승자 월별 출결 (기관 청구, 보호자 안내)
+// 🎯 실무 포인트: 탑승 기록이 있는 운행을 날짜별로 묶어 상태(명단과 같은 값)와 사유를 보여주고 승차/결석/불참 횟수를 합계
+// ⚠️ 주의사항: 관리자 또는 연결된 보호자만 조회, 취소된 운행은 제외, 월 경계는 운행 날짜 기준
+
+// AttendanceService - 월별 출결 서비스
+type AttendanceService struct {
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	locations     TimezoneResolver
+}
+
+// NewAttendanceService - 월별 출결 서비스 생성 (locations가 nil이면 한국 시간 기준으로 이번 달 판단)
+func NewAttendanceService(
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	locations TimezoneResolver,
+) *AttendanceService {
+	return &AttendanceService{
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		locations:     locations,
+	}
+}
+
+// MonthlyAttendance - 탑승자의 한 달 출결 (month: YYYY-MM, 빈 값이면 기관 시간대 기준 이번 달)
+func (s *AttendanceService) MonthlyAttendance(ctx context.Context, passengerID, month string) (*dto.PassengerAttendanceResponse, error) {
+	_, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	if err != nil {
+		return nil, err
+	}
+	if month == "" {
+		month = organizationToday(ctx, s.locations, passenger.OrganizationID).Format("2006-01")
+	}
+	from, err := time.Parse("2006-01", month)
+	if err != nil {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"month": "월은 YYYY-MM 형식이어야 합니다",
+		})
+	}
+	to := from.AddDate(0, 1, -1)
+
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{From: &from, To: &to, PassengerID: passengerID, WithPassengers: true})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	schedules, err := listByID(ctx, s.scheduleRepo.List, repository.ScheduleFilter{IncludeDeleted: true}, func(sc *domain.Schedule) string { return sc.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	var ordered []reportTrip
+	for _, trip := range trips {
+		schedule, ok := schedules[trip.ScheduleID]
+		if !ok || trip.IsCancelled() {
+			continue
+		}
+		ordered = append(ordered, reportTrip{trip: trip, schedule: schedule})
+	}
+	sortReportTrips(ordered)
+
+	response := &dto.PassengerAttendanceResponse{
+		PassengerID:   passenger.ID,
+		PassengerName: passenger.Name,
+		Month:         month,
+		Days:          []dto.AttendanceDayResponse{},
+	}
+	rode := map[string]bool{}
+	for _, item := range ordered {
+		record := findTripPassenger(item.trip, passengerID)
+		if record == nil {
+			continue
+		}
+		date := item.trip.Date.Format(time.DateOnly)
+		if n := len(response.Days); n == 0 || response.Days[n-1].Date != date {
+			response.Days = append(response.Days, dto.AttendanceDayResponse{Date: date, Trips: []dto.AttendanceTripResponse{}})
+		}
+		day := &response.Days[len(response.Days)-1]
+
+		status := manifestStatus(record)
+		entry := dto.AttendanceTripResponse{
+			TripID:       item.trip.ID,
+			ScheduleName: item.schedule.Name,
+			TimeSlot:     item.schedule.TimeSlot,
+			StartTime:    item.schedule.StartTime,
+			Status:       string(status),
+			BoardedAt:    record.BoardedAt,
+			AlightedAt:   record.AlightedAt,
+		}
+		response.Summary.Trips++
+		switch status {
+		case ManifestStatusBoarded, ManifestStatusAlighted:
+			response.Summary.Rides++
+			rode[date] = true
+		case ManifestStatusExcused:
+			response.Summary.Absences++
+			entry.Reason = record.ExcusedReason
+		case ManifestStatusNoShow:
+			response.Summary.NoShows++
+			entry.Reason = record.NoShowReason
+		}
+		day.Trips = append(day.Trips, entry)
+	}
+	response.Summary.RideDays = len(rode)
+	return response, nil
+}
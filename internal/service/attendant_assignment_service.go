@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 동승자 대체 배정 비즈니스 로직 (대체 교사/간호사가 기간 동안 일정 담당)
+// 🎯 실무 포인트: 운행 생성 시 그날 유효한 승인된 대체 배정이 있으면 기본 동승자 대신 대체 동승자 배정
+// ⚠️ 주의사항: 같은 일정에 기간이 겹치는 대체 배정은 CONFLICT → 날짜마다 대체 동승자는 최대 한 명
+// 이미 만들어진 운행의 동승자는 바꾸지 않음
+
+// AttendantAssignmentService - 동승자 대체 배정 서비스
+type AttendantAssignmentService struct {
+	assignmentRepo repository.AttendantAssignmentRepository
+	scheduleRepo   repository.ScheduleRepository
+	attendantRepo  repository.AttendantRepository
+}
+
+// NewAttendantAssignmentService - 동승자 대체 배정 서비스 생성
+func NewAttendantAssignmentService(
+	assignmentRepo repository.AttendantAssignmentRepository,
+	scheduleRepo repository.ScheduleRepository,
+	attendantRepo repository.AttendantRepository,
+) *AttendantAssignmentService {
+	return &AttendantAssignmentService{
+		assignmentRepo: assignmentRepo,
+		scheduleRepo:   scheduleRepo,
+		attendantRepo:  attendantRepo,
+	}
+}
+
+// Create - 대체 배정 생성 (대체 동승자는 활동 중이어야 하고, 같은 일정의 다른 배정과 기간이 겹치면 CONFLICT)
+func (s *AttendantAssignmentService) Create(ctx context.Context, scheduleID string, req *dto.CreateAttendantAssignmentRequest) (*domain.AttendantAssignment, error) {
+	if _, err := s.scheduleRepo.GetByID(ctx, scheduleID); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+	// 바인딩에서 형식 검증 완료
+	startDate, _ := time.Parse(time.DateOnly, req.StartDate)
+	endDate, _ := time.Parse(time.DateOnly, req.EndDate)
+
+	details := map[string]interface{}{}
+	if endDate.Before(startDate) {
+		details["end_date"] = "종료일은 시작일 이후여야 합니다"
+	}
+	if attendant, err := s.attendantRepo.GetByID(ctx, req.AttendantID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			return nil, util.NewInternalError(err)
+		}
+		details["attendant_id"] = "존재하지 않는 동승자입니다"
+	} else if !attendant.IsActive() {
+		details["attendant_id"] = "활동 중인 동승자가 아닙니다"
+	}
+	if len(details) > 0 {
+		return nil, util.NewValidationError(util.MsgValidationFailed, details)
+	}
+
+	existing, err := s.assignmentRepo.ListBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	var overlapping []*domain.AttendantAssignment
+	for _, assignment := range existing {
+		if assignment.Overlaps(startDate, endDate) {
+			overlapping = append(overlapping, assignment)
+		}
+	}
+	if len(overlapping) > 0 {
+		return nil, util.NewConflictErrorWithDetails("같은 일정에 기간이 겹치는 동승자 대체 배정이 있습니다",
+			map[string]interface{}{"conflicts": overlapping})
+	}
+
+	var createdBy string
+	if principal, ok := auth.FromContext(ctx); ok {
+		createdBy = principal.UserID
+	}
+	assignment := domain.NewAttendantAssignment(scheduleID, req.AttendantID, startDate, endDate, req.Reason, createdBy)
+	if err := s.assignmentRepo.Create(ctx, assignment); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return assignment, nil
+}
+
+// List - 일정의 대체 배정 목록 (시작일 순)
+func (s *AttendantAssignmentService) List(ctx context.Context, scheduleID string) ([]*domain.AttendantAssignment, error) {
+	if _, err := s.scheduleRepo.GetByID(ctx, scheduleID); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+	assignments, err := s.assignmentRepo.ListBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return assignments, nil
+}
+
+// Approve - 대체 배정 승인 (이미 승인된 배정은 그대로 반환)
+func (s *AttendantAssignmentService) Approve(ctx context.Context, scheduleID, id string) (*domain.AttendantAssignment, error) {
+	assignment, err := s.get(ctx, scheduleID, id)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.IsApproved() {
+		return assignment, nil
+	}
+
+	var approvedBy string
+	if principal, ok := auth.FromContext(ctx); ok {
+		approvedBy = principal.UserID
+	}
+	assignment.Approve(approvedBy)
+	if err := s.assignmentRepo.Update(ctx, assignment); err != nil {
+		return nil, toAppError(err, "동승자 대체 배정")
+	}
+	return assignment, nil
+}
+
+// Delete - 대체 배정 삭제
+func (s *AttendantAssignmentService) Delete(ctx context.Context, scheduleID, id string) error {
+	if _, err := s.get(ctx, scheduleID, id); err != nil {
+		return err
+	}
+	if err := s.assignmentRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "동승자 대체 배정")
+	}
+	return nil
+}
+
+// get - 일정의 대체 배정 조회 (다른 일정의 배정이면 NOT_FOUND)
+func (s *AttendantAssignmentService) get(ctx context.Context, scheduleID, id string) (*domain.AttendantAssignment, error) {
+	assignment, err := s.assignmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "동승자 대체 배정")
+	}
+	if assignment.ScheduleID != scheduleID {
+		return nil, util.NewNotFoundError("동승자 대체 배정")
+	}
+	return assignment, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 동승자 비즈니스 로직
+// 🎯 실무 포인트: 운행 시작 권한 부여/회수는 도메인 메소드를 통해서만 수행
+// ⚠️ 주의사항: 활동 중인 동승자에게만 운행 시작 권한 부여 가능
+
+// AttendantService - 동승자 서비스
+type AttendantService struct {
+	attendantRepo repository.AttendantRepository
+}
+
+// NewAttendantService - 동승자 서비스 생성
+func NewAttendantService(attendantRepo repository.AttendantRepository) *AttendantService {
+	return &AttendantService{attendantRepo: attendantRepo}
+}
+
+// Create - 동승자 등록 (운행 시작 권한 없음으로 시작)
+func (s *AttendantService) Create(ctx context.Context, req *dto.CreateAttendantRequest) (*domain.Attendant, error) {
+	attendant := domain.NewAttendant(req.Name, req.Phone, domain.AttendantRole(req.Role))
+	attendant.Email = req.Email
+	attendant.Organization = req.Organization
+	attendant.Address = req.Address
+	attendant.EmergencyContact = req.EmergencyContact
+	attendant.Notes = req.Notes
+	if req.HireDate != nil {
+		attendant.HireDate = *req.HireDate
+	}
+
+	if err := s.attendantRepo.Create(ctx, attendant); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return attendant, nil
+}
+
+// Get - 동승자 단건 조회
+func (s *AttendantService) Get(ctx context.Context, id string) (*domain.Attendant, error) {
+	attendant, err := s.attendantRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "동승자")
+	}
+	return attendant, nil
+}
+
+// List - 동승자 목록 조회
+func (s *AttendantService) List(ctx context.Context, filter repository.AttendantFilter) ([]*domain.Attendant, int64, error) {
+	attendants, total, err := s.attendantRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return attendants, total, nil
+}
+
+// Update - 동승자 정보 수정
+func (s *AttendantService) Update(ctx context.Context, id string, req *dto.UpdateAttendantRequest) (*domain.Attendant, error) {
+	attendant, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		attendant.Name = *req.Name
+	}
+	if req.Phone != nil || req.Email != nil {
+		attendant.UpdateContactInfo(deref(req.Phone), deref(req.Email))
+	}
+	if req.Role != nil {
+		attendant.Role = domain.AttendantRole(*req.Role)
+	}
+	if req.Organization != nil {
+		attendant.Organization = *req.Organization
+	}
+	if req.Address != nil {
+		attendant.Address = *req.Address
+	}
+	if req.EmergencyContact != nil {
+		attendant.EmergencyContact = *req.EmergencyContact
+	}
+	if req.Notes != nil {
+		attendant.Notes = *req.Notes
+	}
+	if req.Status != nil {
+		if attendant.IsTerminated() {
+			return nil, util.NewConflictError("퇴사한 동승자의 상태는 변경할 수 없습니다")
+		}
+		if domain.AttendantStatus(*req.Status) == domain.AttendantStatusOnLeave {
+			attendant.SetOnLeave()
+		} else {
+			attendant.SetActive()
+		}
+	}
+
+	return s.save(ctx, attendant)
+}
+
+// GrantStartTripPermission - 운행 시작 권한 부여
+func (s *AttendantService) GrantStartTripPermission(ctx context.Context, id string) (*domain.Attendant, error) {
+	attendant, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !attendant.IsActive() {
+		return nil, util.NewConflictError("활동 중인 동승자에게만 운행 시작 권한을 부여할 수 있습니다")
+	}
+
+	attendant.GrantStartTripPermission()
+	return s.save(ctx, attendant)
+}
+
+// RevokeStartTripPermission - 운행 시작 권한 회수
+func (s *AttendantService) RevokeStartTripPermission(ctx context.Context, id string) (*domain.Attendant, error) {
+	attendant, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	attendant.RevokeStartTripPermission()
+	return s.save(ctx, attendant)
+}
+
+// Delete - 동승자 삭제
+func (s *AttendantService) Delete(ctx context.Context, id string) error {
+	if err := s.attendantRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "동승자")
+	}
+	return nil
+}
+
+// save - 변경된 동승자 저장
+func (s *AttendantService) save(ctx context.Context, attendant *domain.Attendant) (*domain.Attendant, error) {
+	if err := s.attendantRepo.Update(ctx, attendant); err != nil {
+		return nil, toAppError(err, "동승자")
+	}
+	return attendant, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 감사 로그 조회 서비스
+// 🎯 실무 포인트: 기록은 Repository 계층의 GORM 콜백이 담당, Service는 조회만 제공
+// ⚠️ 주의사항: 변경 전/후 값에 개인정보가 포함되므로 관리자에게만 노출
+
+// AuditLogService - 감사 로그 서비스
+type AuditLogService struct {
+	auditLogRepo repository.AuditLogRepository
+}
+
+// NewAuditLogService - 감사 로그 서비스 생성
+func NewAuditLogService(auditLogRepo repository.AuditLogRepository) *AuditLogService {
+	return &AuditLogService{auditLogRepo: auditLogRepo}
+}
+
+// List - 감사 로그 목록 조회 (엔티티/행위자 필터)
+func (s *AuditLogService) List(ctx context.Context, filter repository.AuditLogFilter) ([]*domain.AuditLog, int64, error) {
+	logs, total, err := s.auditLogRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return logs, total, nil
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/sms"
+)
+
+// 📝 설명: 로그인, 비밀번호 변경, 비밀번호 재설정
+// 🎯 실무 포인트: 재설정 토큰은 해시만 저장하고 1회 사용 후 같은 사용자의 다른 토큰도 무효화
+// 로그인마다 세션(jti)을 등록해 분실 단말의 토큰을 만료 전에 폐기 가능
+// ⚠️ 주의사항: 로그인 실패/재설정 요청 응답으로 계정 존재 여부가 드러나지 않도록 처리
+
+// PasswordResetNotifier - 재설정 토큰 전달 채널 (이메일/SMS)
+type PasswordResetNotifier interface {
+	SendPasswordReset(ctx context.Context, user *domain.User, token string) error
+}
+
+// LogPasswordResetNotifier - 로그로만 남기는 기본 Notifier (개발용)
+type LogPasswordResetNotifier struct{}
+
+// SendPasswordReset - 재설정 토큰을 로그로 출력
+func (LogPasswordResetNotifier) SendPasswordReset(ctx context.Context, user *domain.User, token string) error {
+	logger.FromContext(ctx).Info("Password reset requested", map[string]interface{}{
+		"user_id": user.ID,
+		"token":   token,
+	})
+	return nil
+}
+
+// SMSPasswordResetNotifier - 계정 연락처로 재설정 토큰을 문자 발송
+type SMSPasswordResetNotifier struct {
+	Sender sms.Sender
+}
+
+// SendPasswordReset - 재설정 토큰 문자 발송 (연락처가 없는 계정은 에러, 언어는 재설정 이메일과 같은 규칙)
+func (n SMSPasswordResetNotifier) SendPasswordReset(ctx context.Context, user *domain.User, token string) error {
+	if user.Phone == "" {
+		return errors.New("user has no phone number")
+	}
+	locale := userLocale(ctx, user)
+	return n.Sender.Send(ctx, &sms.Message{
+		To:    user.Phone,
+		Title: util.GetLocalizedMessage(locale, "비밀번호 재설정"),
+		Text:  util.GetLocalizedMessage(locale, "[어디니] 비밀번호 재설정 코드입니다. 본인이 요청하지 않았다면 무시해 주세요.") + "\n" + token,
+	})
+}
+
+// AuthService - 인증 서비스
+type AuthService struct {
+	userRepo  repository.UserRepository
+	resetRepo repository.PasswordResetTokenRepository
+	sessions  repository.SessionRepository
+	tokens    *auth.TokenManager
+	notifier  PasswordResetNotifier
+	resetTTL  time.Duration
+}
+
+// NewAuthService - 인증 서비스 생성 (notifier가 nil이면 로그 출력)
+func NewAuthService(
+	userRepo repository.UserRepository,
+	resetRepo repository.PasswordResetTokenRepository,
+	sessions repository.SessionRepository,
+	tokens *auth.TokenManager,
+	notifier PasswordResetNotifier,
+	resetTTL time.Duration,
+) *AuthService {
+	if notifier == nil {
+		notifier = LogPasswordResetNotifier{}
+	}
+	return &AuthService{
+		userRepo:  userRepo,
+		resetRepo: resetRepo,
+		sessions:  sessions,
+		tokens:    tokens,
+		notifier:  notifier,
+		resetTTL:  resetTTL,
+	}
+}
+
+// Login - 이메일/비밀번호 확인 후 액세스 토큰 발급
+func (s *AuthService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error) {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, util.NewUnauthorizedErrorWithMessage(util.MsgInvalidCredentials)
+		}
+		return nil, util.NewInternalError(err)
+	}
+
+	if !auth.CheckPassword(user.PasswordHash, req.Password) {
+		return nil, util.NewUnauthorizedErrorWithMessage(util.MsgInvalidCredentials)
+	}
+	if !user.IsActive() {
+		return nil, util.NewUnauthorizedErrorWithMessage(util.MsgAccountDisabled)
+	}
+
+	session := &domain.Session{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(s.tokens.TTL()),
+	}
+	token, err := s.tokens.Issue(&auth.Principal{
+		UserID:         user.ID,
+		OrganizationID: user.OrganizationID,
+		Role:           user.Role,
+		ProfileID:      user.ProfileID,
+		SessionID:      session.ID,
+		Locale:         user.Locale,
+	})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	// 추적되지 않는 토큰은 폐기할 수 없으므로 등록 실패 시 로그인 실패 처리
+	if err := s.sessions.Track(ctx, session); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	user.RecordLogin()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, toAppError(err, "계정")
+	}
+
+	return &dto.LoginResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		SessionID:   session.ID,
+		ExpiresIn:   int(s.tokens.TTL().Seconds()),
+		User:        user,
+	}, nil
+}
+
+// Me - 로그인 사용자 계정 조회
+func (s *AuthService) Me(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, toAppError(err, "계정")
+	}
+	return user, nil
+}
+
+// ChangePassword - 현재 비밀번호 확인 후 변경
+func (s *AuthService) ChangePassword(ctx context.Context, userID string, req *dto.ChangePasswordRequest) error {
+	user, err := s.Me(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !auth.CheckPassword(user.PasswordHash, req.CurrentPassword) {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"current_password": "현재 비밀번호가 올바르지 않습니다",
+		})
+	}
+
+	return s.setPassword(ctx, user, req.NewPassword)
+}
+
+// UpdateLocale - 응답/이메일 언어 설정 변경 (새 설정은 다음 로그인에서 발급한 토큰부터 적용)
+func (s *AuthService) UpdateLocale(ctx context.Context, userID string, req *dto.UpdateLocaleRequest) (*domain.User, error) {
+	user, err := s.Me(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Locale = req.Locale
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, toAppError(err, "언어 설정")
+	}
+	return user, nil
+}
+
+// ForgotPassword - 재설정 토큰 발급 후 Notifier로 전달
+// 없는 이메일/비활성 계정이어도 성공으로 응답 (계정 존재 여부 노출 방지)
+func (s *AuthService) ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return util.NewInternalError(err)
+	}
+	if !user.IsActive() {
+		return nil
+	}
+
+	raw, hash, err := auth.GenerateResetToken()
+	if err != nil {
+		return util.NewInternalError(err)
+	}
+
+	token := domain.NewPasswordResetToken(user.ID, hash, s.resetTTL)
+	if err := s.resetRepo.Create(ctx, token); err != nil {
+		return util.NewInternalError(err)
+	}
+
+	if err := s.notifier.SendPasswordReset(ctx, user, raw); err != nil {
+		// 발송 실패도 응답으로 구분하지 않음
+		logger.FromContext(ctx).Warn("Failed to send password reset", map[string]interface{}{
+			"user_id": user.ID,
+			"error":   err.Error(),
+		})
+	}
+
+	return nil
+}
+
+// ResetPassword - 재설정 토큰 검증 후 비밀번호 변경 (토큰 1회용)
+func (s *AuthService) ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error {
+	invalid := util.NewBadRequestError(util.MsgInvalidResetToken)
+
+	token, err := s.resetRepo.GetByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return invalid
+		}
+		return util.NewInternalError(err)
+	}
+
+	now := time.Now()
+	if !token.IsUsable(now) {
+		return invalid
+	}
+
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return invalid
+		}
+		return util.NewInternalError(err)
+	}
+	if !user.IsActive() {
+		return invalid
+	}
+
+	// 동시 요청 시 한 번만 성공하도록 사용 처리를 먼저 수행
+	if err := s.resetRepo.MarkUsed(ctx, token.ID, now); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return invalid
+		}
+		return util.NewInternalError(err)
+	}
+
+	if err := s.setPassword(ctx, user, req.NewPassword); err != nil {
+		return err
+	}
+
+	if err := s.resetRepo.InvalidateAll(ctx, user.ID, now); err != nil {
+		return util.NewInternalError(err)
+	}
+
+	// 비밀번호가 유출됐을 수 있으므로 기존 로그인 세션 모두 종료
+	if _, err := s.sessions.RevokeAll(ctx, user.ID); err != nil {
+		return util.NewInternalError(err)
+	}
+	return nil
+}
+
+// ListSessions - 로그인 사용자의 활성 세션 목록 (현재 세션 표시)
+func (s *AuthService) ListSessions(ctx context.Context, principal *auth.Principal) ([]*dto.SessionResponse, error) {
+	sessions, err := s.sessions.List(ctx, principal.UserID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	result := make([]*dto.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &dto.SessionResponse{
+			Session: session,
+			Current: session.ID == principal.SessionID,
+		})
+	}
+	return result, nil
+}
+
+// RevokeSession - 본인 세션 하나 종료
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if err := s.sessions.Revoke(ctx, userID, sessionID); err != nil {
+		return toAppError(err, "세션")
+	}
+	return nil
+}
+
+// RevokeAllSessions - 본인 세션 모두 종료 (현재 세션 포함), 종료한 세션 수 반환
+func (s *AuthService) RevokeAllSessions(ctx context.Context, userID string) (int, error) {
+	count, err := s.sessions.RevokeAll(ctx, userID)
+	if err != nil {
+		return 0, util.NewInternalError(err)
+	}
+	return count, nil
+}
+
+// IsSessionRevoked - 폐기된 세션인지 확인 (middleware.SessionChecker 구현)
+func (s *AuthService) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	return s.sessions.IsRevoked(ctx, sessionID)
+}
+
+// setPassword - 새 비밀번호 해시 저장
+func (s *AuthService) setPassword(ctx context.Context, user *domain.User, password string) error {
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return util.NewInternalError(err)
+	}
+
+	user.ChangePassword(hash)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return toAppError(err, "계정")
+	}
+	return nil
+}
@@ -0,0 +1,665 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 운행 중 탑승자 승차/하차 기록 + 보호자 알림
+// 🎯 실무 포인트: 동승자(또는 기사)가 앱에서 승차/하차를 누르면 연결된 보호자 전원에게 정류장/시각 알림
+// 연결된 보호자가 없으면 탑승자 정보의 보호자 연락처로 발송
+// 불참(수동 처리 또는 차량이 정류장을 출발할 때까지 미탑승)은 운행 기관의 관리자와 배정 동승자에게 알리고
+// 불참 시각을 탑승 기록에 남겨 일일 출결 집계에 사용
+// 정류장 일괄 승차/하차는 한 트랜잭션으로 저장 (하나라도 기록할 수 없으면 전체 거부)
+// 승차/하차/불참은 탑승 기록과 같은 트랜잭션으로 이벤트 기록 → 보호자/불참 알림과 웹훅은 이벤트 핸들러가 처리
+// ⚠️ 주의사항: 알림은 아웃박스 릴레이가 HandleEvent로 발송 (문자 중계사 지연이 승차 처리를 막지 않게)
+// HandleEvent를 이벤트 디스패처에 등록하지 않으면 알림이 발송되지 않음
+// 탑승 기록이 아직 없으면 운행 경로에 배정된 탑승자만 배정 정류장으로 새로 기록
+
+// GuardianNotifier - 보호자 수신 설정에 따른 알림 발송 (NotificationService가 구현)
+type GuardianNotifier interface {
+	NotifyGuardian(ctx context.Context, guardianID string, event domain.NotificationEvent, notification *Notification) (domain.NotificationChannel, error)
+	Notify(ctx context.Context, recipient Recipient, notification *Notification) (domain.NotificationChannel, error)
+}
+
+// BoardingService - 승차/하차 서비스
+type BoardingService struct {
+	tripService   *TripService
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	routeRepo     repository.RouteRepository
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+	attendantRepo repository.AttendantRepository
+	txManager     database.TxManager
+	notifier      GuardianNotifier
+	events        EventPublisher
+	operators     OperatorResolver // 불참 알림을 받을 기관 관리자
+	locations     TimezoneResolver
+}
+
+// NewBoardingService - 승차/하차 서비스 생성 (notifier가 nil이면 알림 없음, events가 nil이면 이벤트 발행 안 함)
+// 알림은 발행된 이벤트를 HandleEvent로 받아 발송하므로 events가 nil이면 알림도 없음
+// operators: 불참 알림을 받을 운행 기관의 관리자 (nil이면 배정 동승자에게만)
+// locations: 알림 본문 시각을 표기할 기관 시간대 (nil이면 한국 시간)
+func NewBoardingService(
+	tripService *TripService,
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	attendantRepo repository.AttendantRepository,
+	txManager database.TxManager,
+	notifier GuardianNotifier,
+	events EventPublisher,
+	operators OperatorResolver,
+	locations TimezoneResolver,
+) *BoardingService {
+	return &BoardingService{
+		tripService:   tripService,
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		routeRepo:     routeRepo,
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+		attendantRepo: attendantRepo,
+		txManager:     txManager,
+		notifier:      notifier,
+		events:        events,
+		operators:     operators,
+		locations:     locations,
+	}
+}
+
+const (
+	departedNoShowReason = "정류장 출발 시까지 미탑승" // 정류장 출발 시 자동 불참 처리 사유
+	departedNoShowActor  = "system"         // 자동 불참 처리 기록자
+)
+
+// Board - 승차 기록 (배정 기사/동승자, 운행 중에만 / 기록자와 앱 위치 함께 기록)
+func (s *BoardingService) Board(ctx context.Context, tripID, passengerID string, req *dto.TripLocationRequest) (*domain.TripPassenger, error) {
+	trip, passenger, err := s.load(ctx, tripID, passengerID)
+	if err != nil {
+		return nil, err
+	}
+
+	record := findTripPassenger(trip, passengerID)
+	if record == nil {
+		if record, err = s.newTripPassenger(ctx, trip, passenger); err != nil {
+			return nil, err
+		}
+	}
+	if record.IsBoarded {
+		return nil, util.NewConflictError("이미 승차한 탑승자입니다")
+	}
+
+	record.BoardPassenger(crewActor(ctx), newTripLocation(req))
+	if err := s.save(ctx, trip, record, domain.WebhookEventPassengerBoarded); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return record, nil
+}
+
+// Alight - 하차 기록 (승차한 탑승자만 / 기록자와 앱 위치 함께 기록)
+func (s *BoardingService) Alight(ctx context.Context, tripID, passengerID string, req *dto.TripLocationRequest) (*domain.TripPassenger, error) {
+	trip, _, err := s.load(ctx, tripID, passengerID)
+	if err != nil {
+		return nil, err
+	}
+
+	record := findTripPassenger(trip, passengerID)
+	if record == nil || !record.IsBoarded {
+		return nil, util.NewConflictError("승차 기록이 없는 탑승자입니다")
+	}
+	if record.IsAlighted {
+		return nil, util.NewConflictError("이미 하차한 탑승자입니다")
+	}
+
+	record.AlightPassenger(crewActor(ctx), newTripLocation(req))
+	if err := s.save(ctx, trip, record, domain.WebhookEventPassengerAlighted); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return record, nil
+}
+
+// MarkNoShow - 불참 처리 (배정 기사/동승자, 운행 중에만 / 승차한 탑승자는 불가)
+func (s *BoardingService) MarkNoShow(ctx context.Context, tripID, passengerID, reason string) (*domain.TripPassenger, error) {
+	trip, passenger, err := s.load(ctx, tripID, passengerID)
+	if err != nil {
+		return nil, err
+	}
+
+	record := findTripPassenger(trip, passengerID)
+	if record == nil {
+		if record, err = s.newTripPassenger(ctx, trip, passenger); err != nil {
+			return nil, err
+		}
+	}
+	if record.IsBoarded {
+		return nil, util.NewConflictError("이미 승차한 탑승자는 불참 처리할 수 없습니다")
+	}
+	if record.IsNoShow() {
+		return nil, util.NewConflictError("이미 불참 처리된 탑승자입니다")
+	}
+	if record.IsExcused() {
+		return nil, util.NewConflictError("결석 신고된 탑승자입니다")
+	}
+
+	record.MarkNoShow(reason, crewActor(ctx))
+	if err := s.save(ctx, trip, record, domain.WebhookEventPassengerNoShow); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	logNoShow(ctx, trip, record)
+	return record, nil
+}
+
+// FlagException - 승차 후 하차 기록 없이 차량을 떠난 탑승자 예외 처리 (중간 인계, 병원 이송 등)
+// 예외 처리된 탑승자는 운행 완료 전 차내 잔류 확인에서 제외, 기록과 같은 트랜잭션으로 passenger.exception 이벤트 기록
+func (s *BoardingService) FlagException(ctx context.Context, tripID, passengerID, reason string) (*domain.TripPassenger, error) {
+	trip, _, err := s.load(ctx, tripID, passengerID)
+	if err != nil {
+		return nil, err
+	}
+
+	record := findTripPassenger(trip, passengerID)
+	if record == nil || !record.IsBoarded {
+		return nil, util.NewConflictError("승차 기록이 없는 탑승자입니다")
+	}
+	if record.IsAlighted {
+		return nil, util.NewConflictError("이미 하차한 탑승자입니다")
+	}
+	if record.HasException() {
+		return nil, util.NewConflictError("이미 예외 처리된 탑승자입니다")
+	}
+
+	if err := record.FlagException(reason, crewActor(ctx)); err != nil {
+		return nil, util.NewConflictError("승차 중인 탑승자만 예외 처리할 수 있습니다")
+	}
+	if err := s.save(ctx, trip, record, domain.WebhookEventPassengerException); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return record, nil
+}
+
+// bulkTarget - 일괄 승차/하차 대상 탑승자와 탑승 기록
+type bulkTarget struct {
+	passenger *domain.Passenger
+	record    *domain.TripPassenger
+}
+
+// BoardAll - 정류장 일괄 승차 (전달한 탑승자 또는 그 정류장의 탑승 예정자 전원)
+// 전달한 탑승자는 그 정류장에 배정/기록되어 있고 아직 승차하지 않았어야 하며, 하나라도 어긋나면 아무것도 기록하지 않음
+func (s *BoardingService) BoardAll(ctx context.Context, tripID, stopID string, req *dto.BulkBoardingRequest) ([]*domain.TripPassenger, error) {
+	trip, schedule, err := s.loadStop(ctx, tripID, stopID)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []bulkTarget
+	if req.AllExpected {
+		targets, err = s.expectedAt(ctx, trip, schedule, stopID)
+	} else {
+		targets, err = s.bulkTargets(ctx, trip, req.PassengerIDs, func(passenger *domain.Passenger, record *domain.TripPassenger) (*domain.TripPassenger, error) {
+			if record == nil {
+				if passenger.AssignedRouteID != schedule.RouteID || passenger.AssignedStopID != stopID {
+					return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+						"passenger_id": util.Text("이 정류장에 배정되지 않은 탑승자입니다: %s", passenger.ID),
+					})
+				}
+				record = domain.NewTripPassenger(trip.ID, passenger.ID, stopID)
+			}
+			if record.StopID != stopID {
+				return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+					"passenger_id": util.Text("다른 정류장에 기록된 탑승자입니다: %s", passenger.ID),
+				})
+			}
+			if record.IsBoarded {
+				return nil, util.NewConflictErrorWithDetails("이미 승차한 탑승자가 포함되어 있습니다", map[string]interface{}{"passenger_id": passenger.ID})
+			}
+			return record, nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	actor, location := crewActor(ctx), newTripLocation(&req.TripLocationRequest)
+	return s.saveAll(ctx, trip, targets, domain.WebhookEventPassengerBoarded, func(record *domain.TripPassenger) {
+		record.BoardPassenger(actor, location)
+	})
+}
+
+// AlightAll - 정류장 일괄 하차 (전달한 탑승자 또는 그 정류장에서 내릴 승차자 전원)
+// 오전 일정은 모든 승차자가 기관(마지막 정류장)에서 내리므로 정류장과 무관하게 승차 후 미하차자 전원
+func (s *BoardingService) AlightAll(ctx context.Context, tripID, stopID string, req *dto.BulkBoardingRequest) ([]*domain.TripPassenger, error) {
+	trip, schedule, err := s.loadStop(ctx, tripID, stopID)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []bulkTarget
+	if req.AllExpected {
+		for i := range trip.TripPassengers {
+			record := &trip.TripPassengers[i]
+			if !record.IsBoarded || record.IsAlighted {
+				continue
+			}
+			if schedule.TimeSlot != domain.TimeSlotMorning && record.StopID != stopID {
+				continue
+			}
+			passenger, err := s.passengerRepo.GetByID(ctx, record.PassengerID)
+			if err != nil {
+				return nil, toAppError(err, "탑승자")
+			}
+			targets = append(targets, bulkTarget{passenger: passenger, record: record})
+		}
+	} else {
+		targets, err = s.bulkTargets(ctx, trip, req.PassengerIDs, func(passenger *domain.Passenger, record *domain.TripPassenger) (*domain.TripPassenger, error) {
+			if record == nil || !record.IsBoarded {
+				return nil, util.NewConflictErrorWithDetails("승차 기록이 없는 탑승자가 포함되어 있습니다", map[string]interface{}{"passenger_id": passenger.ID})
+			}
+			if record.IsAlighted {
+				return nil, util.NewConflictErrorWithDetails("이미 하차한 탑승자가 포함되어 있습니다", map[string]interface{}{"passenger_id": passenger.ID})
+			}
+			return record, nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	actor, location := crewActor(ctx), newTripLocation(&req.TripLocationRequest)
+	return s.saveAll(ctx, trip, targets, domain.WebhookEventPassengerAlighted, func(record *domain.TripPassenger) {
+		record.AlightPassenger(actor, location)
+	})
+}
+
+// loadStop - 일괄 처리 공통 검증 (운행 + 운행 경로의 정류장)
+func (s *BoardingService) loadStop(ctx context.Context, tripID, stopID string) (*domain.Trip, *domain.Schedule, error) {
+	trip, err := s.loadTrip(ctx, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return nil, nil, toAppError(err, "운행 일정")
+	}
+	if _, err := s.routeRepo.GetStop(ctx, schedule.RouteID, stopID); err != nil {
+		return nil, nil, toAppError(err, "정류장")
+	}
+	return trip, schedule, nil
+}
+
+// bulkTargets - 전달한 탑승자별 기록 검증 (중복 ID는 한 번만, check가 기록할 탑승 기록 반환)
+func (s *BoardingService) bulkTargets(
+	ctx context.Context,
+	trip *domain.Trip,
+	passengerIDs []string,
+	check func(passenger *domain.Passenger, record *domain.TripPassenger) (*domain.TripPassenger, error),
+) ([]bulkTarget, error) {
+	targets := make([]bulkTarget, 0, len(passengerIDs))
+	seen := make(map[string]bool, len(passengerIDs))
+	for _, passengerID := range passengerIDs {
+		if seen[passengerID] {
+			continue
+		}
+		seen[passengerID] = true
+
+		passenger, err := s.passengerRepo.GetByID(ctx, passengerID)
+		if err != nil {
+			return nil, toAppError(err, "탑승자")
+		}
+		record, err := check(passenger, findTripPassenger(trip, passengerID))
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, bulkTarget{passenger: passenger, record: record})
+	}
+	return targets, nil
+}
+
+// expectedAt - 정류장의 승차 예정자 (기록이 그 정류장이거나 기록 없이 배정된 활동 중 탑승자, 승차·불참·결석 신고 제외)
+func (s *BoardingService) expectedAt(ctx context.Context, trip *domain.Trip, schedule *domain.Schedule, stopID string) ([]bulkTarget, error) {
+	var targets []bulkTarget
+	for i := range trip.TripPassengers {
+		record := &trip.TripPassengers[i]
+		if record.StopID != stopID || record.IsBoarded || record.IsNoShow() || record.IsExcused() {
+			continue
+		}
+		passenger, err := s.passengerRepo.GetByID(ctx, record.PassengerID)
+		if err != nil {
+			return nil, toAppError(err, "탑승자")
+		}
+		targets = append(targets, bulkTarget{passenger: passenger, record: record})
+	}
+
+	assigned, _, err := s.passengerRepo.List(ctx, repository.PassengerFilter{
+		Status:  domain.PassengerStatusActive,
+		RouteID: schedule.RouteID,
+		StopID:  stopID,
+	})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	for _, passenger := range assigned {
+		if findTripPassenger(trip, passenger.ID) == nil {
+			targets = append(targets, bulkTarget{passenger: passenger, record: domain.NewTripPassenger(trip.ID, passenger.ID, stopID)})
+		}
+	}
+	return targets, nil
+}
+
+// saveAll - 대상 기록과 탑승자별 이벤트를 한 트랜잭션으로 저장
+func (s *BoardingService) saveAll(ctx context.Context, trip *domain.Trip, targets []bulkTarget, event domain.WebhookEvent, apply func(record *domain.TripPassenger)) ([]*domain.TripPassenger, error) {
+	records := make([]*domain.TripPassenger, 0, len(targets))
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		for _, target := range targets {
+			apply(target.record)
+			if err := s.tripRepo.SavePassenger(ctx, target.record); err != nil {
+				return err
+			}
+			if err := publishEvent(ctx, s.events, event, trip.OrganizationID, trip.ID, passengerWebhookData(trip, target.record)); err != nil {
+				return err
+			}
+			records = append(records, target.record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return records, nil
+}
+
+// save - 탑승 기록 저장과 이벤트 기록 (한 트랜잭션)
+func (s *BoardingService) save(ctx context.Context, trip *domain.Trip, record *domain.TripPassenger, event domain.WebhookEvent) error {
+	return saveAndPublish(ctx, s.txManager, s.events, func(ctx context.Context) error {
+		return s.tripRepo.SavePassenger(ctx, record)
+	}, event, trip.OrganizationID, trip.ID, passengerWebhookData(trip, record))
+}
+
+// HandleStopEvent - 차량이 정류장을 출발하면 그 정류장의 미탑승자를 불참 처리 (geofence.Listener 구현)
+func (s *BoardingService) HandleStopEvent(ctx context.Context, event geofence.Event) {
+	if event.Type != geofence.EventDeparted {
+		return
+	}
+	if err := s.markMissed(ctx, event); err != nil {
+		logger.FromContext(ctx).Warn("Failed to mark missed passengers", map[string]interface{}{
+			"trip_id": event.TripID,
+			"stop_id": event.StopID,
+			"error":   err.Error(),
+		})
+	}
+}
+
+// markMissed - 출발한 정류장에 배정된 활동 중 탑승자 중 승차/불참/결석 신고 기록이 없는 탑승자를 불참 처리
+func (s *BoardingService) markMissed(ctx context.Context, event geofence.Event) error {
+	trip, err := s.tripRepo.GetByID(ctx, event.TripID)
+	if err != nil {
+		return err
+	}
+	if !trip.IsInProgress() {
+		return nil
+	}
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return err
+	}
+	passengers, _, err := s.passengerRepo.List(ctx, repository.PassengerFilter{
+		Status:  domain.PassengerStatusActive,
+		RouteID: schedule.RouteID,
+		StopID:  event.StopID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, passenger := range passengers {
+		record := findTripPassenger(trip, passenger.ID)
+		if record == nil {
+			record = domain.NewTripPassenger(trip.ID, passenger.ID, event.StopID)
+		}
+		if record.IsBoarded || record.IsNoShow() || record.IsExcused() {
+			continue
+		}
+
+		record.MarkNoShow(departedNoShowReason, departedNoShowActor)
+		if err := s.save(ctx, trip, record, domain.WebhookEventPassengerNoShow); err != nil {
+			return err
+		}
+		logNoShow(ctx, trip, record)
+	}
+	return nil
+}
+
+// load - 운행/탑승자 조회와 공통 검증 (배정 승무원, 운행 중)
+func (s *BoardingService) load(ctx context.Context, tripID, passengerID string) (*domain.Trip, *domain.Passenger, error) {
+	trip, err := s.loadTrip(ctx, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	passenger, err := s.passengerRepo.GetByID(ctx, passengerID)
+	if err != nil {
+		return nil, nil, toAppError(err, "탑승자")
+	}
+	return trip, passenger, nil
+}
+
+// loadTrip - 운행 조회와 공통 검증 (배정 승무원, 운행 중 또는 일시정지 - 병원 대기 중 하차 등)
+func (s *BoardingService) loadTrip(ctx context.Context, tripID string) (*domain.Trip, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tripService.authorizeAssigned(ctx, trip); err != nil {
+		return nil, err
+	}
+	if !trip.IsActive() {
+		return nil, util.NewConflictError("운행 중에만 승차/하차를 기록할 수 있습니다: %s", trip.Status)
+	}
+	return trip, nil
+}
+
+// newTripPassenger - 운행 경로에 배정된 탑승자의 탑승 기록 생성 (배정 정류장 기준)
+func (s *BoardingService) newTripPassenger(ctx context.Context, trip *domain.Trip, passenger *domain.Passenger) (*domain.TripPassenger, error) {
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return nil, toAppError(err, "운행 일정")
+	}
+	if passenger.AssignedRouteID != schedule.RouteID || passenger.AssignedStopID == "" {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"passenger_id": "이 운행 경로의 정류장에 배정되지 않은 탑승자입니다",
+		})
+	}
+	return domain.NewTripPassenger(trip.ID, passenger.ID, passenger.AssignedStopID), nil
+}
+
+// findTripPassenger - 운행의 탑승자 기록 (없으면 nil)
+func findTripPassenger(trip *domain.Trip, passengerID string) *domain.TripPassenger {
+	for i := range trip.TripPassengers {
+		if trip.TripPassengers[i].PassengerID == passengerID {
+			return &trip.TripPassengers[i]
+		}
+	}
+	return nil
+}
+
+// HandleEvent - 승차/하차는 보호자, 불참은 관리자 연락처/배정 동승자에게 알림 (아웃박스 릴레이의 핸들러)
+// 알림 발송 실패는 알림 발송 기록의 재시도에 맡기고, 조회 실패만 에러로 반환해 릴레이가 다시 전달
+func (s *BoardingService) HandleEvent(ctx context.Context, e event.Event) error {
+	if s.notifier == nil {
+		return nil
+	}
+
+	var data dto.PassengerWebhookData
+	if err := e.Decode(&data); err != nil {
+		return err
+	}
+	passenger, err := s.passengerRepo.GetByID(ctx, data.PassengerID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	record := &domain.TripPassenger{
+		TripID:       data.TripID,
+		PassengerID:  data.PassengerID,
+		StopID:       data.StopID,
+		BoardedAt:    data.BoardedAt,
+		AlightedAt:   data.AlightedAt,
+		NoShowAt:     data.NoShowAt,
+		NoShowReason: data.NoShowReason,
+	}
+
+	switch domain.WebhookEvent(e.Type) {
+	case domain.WebhookEventPassengerBoarded:
+		s.notifyGuardians(ctx, passenger, record, domain.NotificationEventBoarded)
+	case domain.WebhookEventPassengerAlighted:
+		s.notifyGuardians(ctx, passenger, record, domain.NotificationEventAlighted)
+	case domain.WebhookEventPassengerNoShow:
+		return s.notifyOperators(ctx, passenger, record)
+	}
+	return nil
+}
+
+// notifyGuardians - 승차/하차 알림을 탑승자의 보호자에게 발송
+func (s *BoardingService) notifyGuardians(ctx context.Context, passenger *domain.Passenger, record *domain.TripPassenger, event domain.NotificationEvent) {
+	notification := s.boardingNotification(ctx, passenger, record, event)
+	notifyPassengerGuardians(ctx, s.notifier, s.guardianRepo, passenger, event, notification)
+}
+
+// notifyPassengerGuardians - 탑승자에 연결된 보호자 전원에게 수신 설정대로 알림 (연결이 없으면 탑승자 정보의 보호자 연락처)
+func notifyPassengerGuardians(
+	ctx context.Context,
+	notifier GuardianNotifier,
+	guardianRepo repository.GuardianRepository,
+	passenger *domain.Passenger,
+	event domain.NotificationEvent,
+	notification *Notification,
+) {
+	links, err := guardianRepo.ListPassengerLinks(ctx, passenger.ID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to list passenger guardians", map[string]interface{}{"passenger_id": passenger.ID, "error": err.Error()})
+		return
+	}
+
+	if len(links) == 0 {
+		if passenger.GuardianPhone == "" {
+			return
+		}
+		if _, err := notifier.Notify(ctx, Recipient{Phone: passenger.GuardianPhone}, notification); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify passenger guardian", map[string]interface{}{"passenger_id": passenger.ID, "event": event, "error": err.Error()})
+		}
+		return
+	}
+
+	for _, link := range links {
+		_, err := notifier.NotifyGuardian(ctx, link.GuardianID, event, notification)
+		if err != nil && !errors.Is(err, ErrNotificationOptedOut) {
+			logger.FromContext(ctx).Warn("Failed to notify guardian", map[string]interface{}{"guardian_id": link.GuardianID, "event": event, "error": err.Error()})
+		}
+	}
+}
+
+// boardingNotification - 승차/하차 알림 내용 (정류장 이름을 찾지 못하면 이름 없이)
+func (s *BoardingService) boardingNotification(ctx context.Context, passenger *domain.Passenger, record *domain.TripPassenger, event domain.NotificationEvent) *Notification {
+	stopName := "정류장"
+	if passenger.AssignedRouteID != "" {
+		if stop, err := s.routeRepo.GetStop(ctx, passenger.AssignedRouteID, record.StopID); err == nil {
+			stopName = stop.Name
+		}
+	}
+
+	title, body, at := "승차 알림", "%s 님이 %s에서 %s에 승차했습니다", record.BoardedAt
+	if event == domain.NotificationEventAlighted {
+		title, body, at = "하차 알림", "%s 님이 %s에서 %s에 하차했습니다", record.AlightedAt
+	}
+	clock := at.In(organizationLocation(ctx, s.locations, passenger.OrganizationID)).Format("15:04")
+
+	notification := newNotification(util.Text(title), util.Text(body, passenger.Name, stopName, clock))
+	notification.Data = map[string]string{
+		"type":         string(event),
+		"trip_id":      record.TripID,
+		"passenger_id": passenger.ID,
+		"stop_id":      record.StopID,
+	}
+	notification.Template = string(event)
+	notification.Variables = map[string]string{
+		"name": passenger.Name,
+		"stop": stopName,
+		"time": clock,
+	}
+	return notification
+}
+
+// logNoShow - 불참 기록 로그
+func logNoShow(ctx context.Context, trip *domain.Trip, record *domain.TripPassenger) {
+	logger.FromContext(ctx).Info("Passenger marked no-show", map[string]interface{}{
+		"trip_id":      trip.ID,
+		"passenger_id": record.PassengerID,
+		"stop_id":      record.StopID,
+		"reason":       record.NoShowReason,
+	})
+}
+
+// notifyOperators - 운행 기관의 관리자/배정 동승자에게 불참 알림
+func (s *BoardingService) notifyOperators(ctx context.Context, passenger *domain.Passenger, record *domain.TripPassenger) error {
+	trip, err := s.tripRepo.GetByID(ctx, record.TripID)
+	if err != nil {
+		return err
+	}
+	notification := s.noShowNotification(ctx, passenger, record)
+
+	var recipients []Recipient
+	for _, operator := range organizationOperators(ctx, s.operators, trip.OrganizationID) {
+		recipients = append(recipients, operator.Recipient())
+	}
+	if trip.AssignedAttendantID != nil {
+		attendant, err := s.attendantRepo.GetByID(ctx, *trip.AssignedAttendantID)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to get trip attendant", map[string]interface{}{"trip_id": trip.ID, "error": err.Error()})
+		} else if attendant.Phone != "" {
+			recipients = append(recipients, Recipient{Phone: attendant.Phone})
+		}
+	}
+
+	for _, recipient := range recipients {
+		if _, err := s.notifier.Notify(ctx, recipient, notification); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify no-show", map[string]interface{}{"trip_id": trip.ID, "passenger_id": passenger.ID, "error": err.Error()})
+		}
+	}
+	return nil
+}
+
+// noShowNotification - 불참 알림 내용 (관리자/동승자용, 알림톡 템플릿 없이 문자로 발송)
+func (s *BoardingService) noShowNotification(ctx context.Context, passenger *domain.Passenger, record *domain.TripPassenger) *Notification {
+	stopName := "정류장"
+	if passenger.AssignedRouteID != "" {
+		if stop, err := s.routeRepo.GetStop(ctx, passenger.AssignedRouteID, record.StopID); err == nil {
+			stopName = stop.Name
+		}
+	}
+	clock := record.NoShowAt.In(organizationLocation(ctx, s.locations, passenger.OrganizationID)).Format("15:04")
+
+	notification := newNotification(util.Text("불참 알림"),
+		util.Text("%s 님이 %s에서 탑승하지 않았습니다 (%s, %s)", passenger.Name, stopName, record.NoShowReason, clock))
+	notification.Data = map[string]string{
+		"type":         "no_show",
+		"trip_id":      record.TripID,
+		"passenger_id": passenger.ID,
+		"stop_id":      record.StopID,
+	}
+	return notification
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/ical"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 기사/동승자 운행 일정 캘린더 구독 (.ics)
+// 🎯 실무 포인트: 이미 생성된 운행(관리자의 운행별 배정 변경 반영)과 아직 생성되지 않은 날의 운행 계획
+// (운행 생성 미리보기 → 공휴일/예외 날짜/대체 배정 반영)을 합쳐 앞으로 2주 일정을 제공
+// 같은 일정·날짜는 운행 생성 전후 모두 같은 UID → 운행이 생성되어도 캘린더에 중복으로 생기지 않음
+// 출발 시각과 "오늘"은 구독자 소속 기관의 시간대 기준
+// ⚠️ 주의사항: 캘린더 앱이 주기적으로 가져가므로 지난 운행은 1주까지만 유지
+// 차량 사용 불가/정원 초과로 미리보기에서 제외된 일정은 관리자가 조치해 운행이 생성되기 전까지 보이지 않음
+
+// 캘린더 피드 범위와 새로 고침 간격
+const (
+	calendarFeedDays      = 14
+	calendarHistoryDays   = 7
+	calendarRefresh       = time.Hour
+	calendarDefaultLength = time.Hour // 경로 예상 소요 시간이 없을 때 일정 길이
+)
+
+// CalendarService - 운행 일정 캘린더 서비스
+type CalendarService struct {
+	tokenRepo    repository.CalendarTokenRepository
+	userRepo     repository.UserRepository
+	tripRepo     repository.TripRepository
+	scheduleRepo repository.ScheduleRepository
+	routeRepo    repository.RouteRepository
+	vehicleRepo  repository.VehicleRepository
+	generation   *TripGenerationService
+	locations    TimezoneResolver
+}
+
+// NewCalendarService - 운행 일정 캘린더 서비스 생성 (locations가 nil이면 한국 시간)
+func NewCalendarService(
+	tokenRepo repository.CalendarTokenRepository,
+	userRepo repository.UserRepository,
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	vehicleRepo repository.VehicleRepository,
+	generation *TripGenerationService,
+	locations TimezoneResolver,
+) *CalendarService {
+	return &CalendarService{
+		tokenRepo:    tokenRepo,
+		userRepo:     userRepo,
+		tripRepo:     tripRepo,
+		scheduleRepo: scheduleRepo,
+		routeRepo:    routeRepo,
+		vehicleRepo:  vehicleRepo,
+		generation:   generation,
+		locations:    locations,
+	}
+}
+
+// IssueToken - 캘린더 구독 토큰 발급 (이미 있으면 교체 → 이전 주소 무효)
+func (s *CalendarService) IssueToken(ctx context.Context, userID string) (*dto.CalendarTokenResponse, error) {
+	raw, hash, err := auth.GenerateCalendarToken()
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	token := domain.NewCalendarToken(userID, hash)
+	if err := s.tokenRepo.Upsert(ctx, token); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return &dto.CalendarTokenResponse{
+		Token:    raw,
+		Path:     "/api/v1/calendar/" + raw + ".ics",
+		IssuedAt: token.UpdatedAt,
+	}, nil
+}
+
+// RevokeToken - 캘린더 구독 해제 (발급한 토큰이 없으면 NOT_FOUND)
+func (s *CalendarService) RevokeToken(ctx context.Context, userID string) error {
+	if err := s.tokenRepo.DeleteByUser(ctx, userID); err != nil {
+		return toAppError(err, "캘린더 구독")
+	}
+	return nil
+}
+
+// Feed - 구독 토큰 원문으로 운행 일정 .ics 생성
+// 없는 토큰, 비활성 계정, 기사/동승자가 아닌 계정은 모두 NOT_FOUND (토큰 존재 여부를 드러내지 않음)
+func (s *CalendarService) Feed(ctx context.Context, raw string) ([]byte, error) {
+	token, err := s.tokenRepo.GetByHash(ctx, auth.HashToken(raw))
+	if err != nil {
+		return nil, toAppError(err, "캘린더")
+	}
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, util.NewNotFoundError("캘린더")
+		}
+		return nil, util.NewInternalError(err)
+	}
+	if !user.IsActive() || user.ProfileID == "" || (user.Role != domain.RoleDriver && user.Role != domain.RoleAttendant) {
+		return nil, util.NewNotFoundError("캘린더")
+	}
+
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedResolution {
+		if err := s.tokenRepo.TouchLastUsed(ctx, token.ID, now); err != nil {
+			// 사용 시각 기록 실패로 구독을 막지는 않음
+			logger.FromContext(ctx).Warn("Failed to record calendar token usage", map[string]interface{}{
+				"user_id": user.ID,
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	events, err := s.events(ctx, user, now)
+	if err != nil {
+		return nil, err
+	}
+	return ical.Write(ical.Calendar{Name: "어디니 운행 일정", Refresh: calendarRefresh, Events: events}, now), nil
+}
+
+// calendarRun - 캘린더에 표시할 운행 (생성된 운행 또는 운행 계획)
+type calendarRun struct {
+	scheduleID string
+	date       time.Time
+	vehicleID  string
+	substitute bool
+	trip       *domain.Trip // 아직 생성되지 않았으면 nil
+}
+
+// events - 지난 1주 ~ 앞으로 2주의 배정 운행 (출발 시각 순)
+func (s *CalendarService) events(ctx context.Context, user *domain.User, now time.Time) ([]ical.Event, error) {
+	location := organizationLocation(ctx, s.locations, user.OrganizationID)
+	local := now.In(location)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -calendarHistoryDays)
+	to := today.AddDate(0, 0, calendarFeedDays-1)
+
+	schedules, err := listByID(ctx, s.scheduleRepo.List, repository.ScheduleFilter{IncludeDeleted: true}, func(sc *domain.Schedule) string { return sc.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.TripFilter{From: &from, To: &to}
+	if user.Role == domain.RoleDriver {
+		filter.DriverID = user.ProfileID
+	} else {
+		filter.AttendantID = user.ProfileID
+	}
+	trips, _, err := s.tripRepo.List(ctx, filter)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	runs := make([]calendarRun, 0, len(trips))
+	for _, trip := range trips {
+		schedule, ok := schedules[trip.ScheduleID]
+		if !ok {
+			continue
+		}
+		substitute := trip.AssignedDriverID != schedule.DefaultDriverID
+		if user.Role == domain.RoleAttendant {
+			substitute = schedule.DefaultAttendantID == nil || *schedule.DefaultAttendantID != user.ProfileID
+		}
+		runs = append(runs, calendarRun{scheduleID: trip.ScheduleID, date: trip.Date, vehicleID: trip.VehicleID, substitute: substitute, trip: trip})
+	}
+
+	// 아직 생성되지 않은 날 (이미 생성된 일정은 미리보기에서 제외되므로 중복 없음)
+	for date := today; !date.After(to); date = date.AddDate(0, 0, 1) {
+		preview, err := s.generation.Preview(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+		for _, planned := range preview.Planned {
+			mine, substitute := planned.DriverID == user.ProfileID, planned.DriverSubstitute
+			if user.Role == domain.RoleAttendant {
+				mine, substitute = planned.AttendantID != nil && *planned.AttendantID == user.ProfileID, planned.AttendantSubstitute
+			}
+			if mine {
+				runs = append(runs, calendarRun{scheduleID: planned.ScheduleID, date: date, vehicleID: planned.VehicleID, substitute: substitute})
+			}
+		}
+	}
+
+	routes, err := listByID(ctx, s.routeRepo.List, repository.RouteFilter{IncludeDeleted: true}, func(r *domain.Route) string { return r.ID })
+	if err != nil {
+		return nil, err
+	}
+	vehicles, err := listByID(ctx, s.vehicleRepo.List, repository.VehicleFilter{IncludeDeleted: true}, func(v *domain.Vehicle) string { return v.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ical.Event, 0, len(runs))
+	for _, run := range runs {
+		schedule := schedules[run.scheduleID]
+		start, err := departureOn(run.date, schedule.StartTime, location)
+		if err != nil {
+			continue // 출발 시각 형식이 잘못된 일정은 표시하지 않음
+		}
+		length := calendarDefaultLength
+		route, ok := routes[schedule.RouteID]
+		if ok && route.EstimatedTime > 0 {
+			length = time.Duration(route.EstimatedTime) * time.Minute
+		}
+
+		summary := schedule.Name
+		if run.substitute {
+			summary += " (대체)"
+		}
+		var description []string
+		if ok {
+			description = append(description, "경로: "+route.Name)
+		}
+		if vehicle, ok := vehicles[run.vehicleID]; ok {
+			description = append(description, fmt.Sprintf("차량: %s (%s)", vehicle.PlateNumber, vehicle.Model))
+		}
+		if run.substitute {
+			description = append(description, "기본 담당자 대신 배정된 운행입니다")
+		}
+
+		event := ical.Event{
+			UID:     fmt.Sprintf("%s-%s@eodini", run.scheduleID, run.date.Format("20060102")),
+			Start:   start,
+			End:     start.Add(length),
+			Summary: summary,
+		}
+		if run.trip != nil {
+			event.Updated = run.trip.UpdatedAt
+			if run.trip.IsCancelled() {
+				event.Status = ical.StatusCancelled
+				event.Summary = "[취소] " + summary
+				if run.trip.CancellationReason != "" {
+					description = append(description, "취소 사유: "+run.trip.CancellationReason)
+				}
+			}
+		}
+		event.Description = strings.Join(description, "\n")
+		events = append(events, event)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return events, nil
+}
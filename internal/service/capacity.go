@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 차량 승객 정원 확인 (경로 정류장에 배정된 탑승자 수 vs 일정 차량의 승객 정원)
+// 🎯 실무 포인트: 탑승자 정류장 배정, 일정 생성/수정/활성화, 운행 생성 계획에서 같은 기준으로 판정
+// 넘으면 초과 인원을 알려줘서 관리자가 경로를 나누거나 큰 차량으로 바꿀 수 있게 함
+// ⚠️ 주의사항: 탑승 예정 인원은 경로에 배정된 활동 중 탑승자 전체 (날짜별 결석 신고는 빼지 않음)
+
+// routeRiders - 경로 정류장에 배정된 활동 중 탑승자 수
+func routeRiders(ctx context.Context, passengerRepo repository.PassengerRepository, routeID string) (int, error) {
+	passengers, _, err := passengerRepo.List(ctx, repository.PassengerFilter{
+		Status:  domain.PassengerStatusActive,
+		RouteID: routeID,
+	})
+	if err != nil {
+		return 0, util.NewInternalError(err)
+	}
+	riders := 0
+	for _, passenger := range passengers {
+		if passenger.IsAssigned() {
+			riders++
+		}
+	}
+	return riders, nil
+}
+
+// capacityOverflow - 탑승 예정 인원이 차량 승객 정원을 넘는 인원 (넘지 않으면 0)
+func capacityOverflow(vehicle *domain.Vehicle, riders int) int {
+	return max(riders-vehicle.GetPassengerCapacity(), 0)
+}
+
+// checkRouteCapacity - 경로에 adding명을 더 배정해도 그 경로를 쓰는 활성 일정마다 차량 승객 정원 안인지 확인
+func checkRouteCapacity(ctx context.Context, passengerRepo repository.PassengerRepository, scheduleRepo repository.ScheduleRepository,
+	vehicleRepo repository.VehicleRepository, routeID string, adding int) error {
+	riders, err := routeRiders(ctx, passengerRepo, routeID)
+	if err != nil {
+		return err
+	}
+	riders += adding
+
+	schedules, _, err := scheduleRepo.List(ctx, repository.ScheduleFilter{Status: domain.ScheduleStatusActive, RouteID: routeID})
+	if err != nil {
+		return util.NewInternalError(err)
+	}
+	for _, schedule := range schedules {
+		vehicle, err := vehicleRepo.GetByID(ctx, schedule.VehicleID)
+		if errors.Is(err, repository.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return util.NewInternalError(err)
+		}
+		if capacityOverflow(vehicle, riders) > 0 {
+			return capacityConflict(schedule, vehicle, riders)
+		}
+	}
+	return nil
+}
+
+// capacityConflict - 일정 차량의 승객 정원 초과 CONFLICT (details에 정원, 탑승 예정 인원, 초과 인원)
+func capacityConflict(schedule *domain.Schedule, vehicle *domain.Vehicle, riders int) error {
+	capacity := vehicle.GetPassengerCapacity()
+	err := util.NewConflictError("탑승 예정 인원(%d명)이 차량 %s 승객 정원(%d명)을 %d명 초과합니다", riders, vehicle.PlateNumber, capacity, riders-capacity)
+	err.Details = map[string]interface{}{
+		"schedule_id":   schedule.ID,
+		"schedule_name": schedule.Name,
+		"route_id":      schedule.RouteID,
+		"vehicle_id":    vehicle.ID,
+		"plate_number":  vehicle.PlateNumber,
+		"capacity":      capacity,
+		"riders":        riders,
+		"overflow":      riders - capacity,
+	}
+	return err
+}
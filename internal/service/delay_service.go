@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 운행 지연 감지/보고 + 보호자/운영자 알림
+// 🎯 실무 포인트: 주기 작업이 출발 예정 시각(일정 StartTime) 후 threshold가 지나도 대기 중인 오늘 운행을 지연으로 표시하고,
+// (출발 시각과 "오늘"은 운행 기관의 시간대 기준)
+// 기사/관리자는 POST /trips/:id/delay로 사유와 함께 직접 보고 → 경로에 배정된 탑승자의 보호자와 운행 기관의 관리자에게 알림
+// 지연 표시와 trip.delayed 이벤트는 한 트랜잭션으로 기록 → 알림은 아웃박스 릴레이가 HandleEvent로 발송
+// ⚠️ 주의사항: 자동 감지는 DB 조건부 갱신으로 운행당 한 번만 (여러 인스턴스가 동시에 감지해도 알림 한 번)
+// HandleEvent를 이벤트 디스패처에 등록하지 않으면 알림이 발송되지 않음
+// 직접 보고는 사유가 바뀔 때마다 다시 알림, 처음 지연 표시 시각은 유지 (정시성 집계 기준)
+
+// DelayService - 운행 지연 서비스
+type DelayService struct {
+	tripService   *TripService
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+	txManager     database.TxManager
+	notifier      GuardianNotifier
+	events        EventPublisher
+	operators     OperatorResolver // 지연 알림을 받을 기관 관리자
+	threshold     time.Duration    // 출발 예정 시각 후 지연으로 볼 시간
+	locations     TimezoneResolver
+}
+
+// NewDelayService - 운행 지연 서비스 생성 (notifier가 nil이면 알림 없음, events가 nil이면 이벤트 발행 안 함, locations가 nil이면 한국 시간)
+// 알림은 발행된 이벤트를 HandleEvent로 받아 발송하므로 events가 nil이면 알림도 없음
+func NewDelayService(
+	tripService *TripService,
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	txManager database.TxManager,
+	notifier GuardianNotifier,
+	events EventPublisher,
+	operators OperatorResolver,
+	threshold time.Duration,
+	locations TimezoneResolver,
+) *DelayService {
+	return &DelayService{
+		tripService:   tripService,
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+		txManager:     txManager,
+		notifier:      notifier,
+		events:        events,
+		operators:     operators,
+		threshold:     threshold,
+		locations:     locations,
+	}
+}
+
+// Detect - 출발 예정 시각 후 threshold가 지나도 대기 중인 오늘 운행을 지연으로 표시 (새로 표시한 운행 수 반환)
+// 기관마다 "오늘"이 다르므로 UTC 기준 전날~다음 날 운행을 읽고 기관 시간대의 오늘 운행만 판정
+func (s *DelayService) Detect(ctx context.Context, now time.Time) (int, error) {
+	from, to := now.UTC().AddDate(0, 0, -1), now.UTC().AddDate(0, 0, 1)
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{From: &from, To: &to, Status: domain.TripStatusPending})
+	if err != nil {
+		return 0, err
+	}
+
+	marked := 0
+	for _, trip := range trips {
+		location := organizationLocation(ctx, s.locations, trip.OrganizationID)
+		if trip.IsDelayed() || trip.Date.Format(time.DateOnly) != now.In(location).Format(time.DateOnly) {
+			continue
+		}
+		schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to get trip schedule", map[string]interface{}{"trip_id": trip.ID, "error": err.Error()})
+			continue
+		}
+		departure, err := scheduledDeparture(trip, schedule, location)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Invalid schedule start time", map[string]interface{}{"schedule_id": schedule.ID, "start_time": schedule.StartTime})
+			continue
+		}
+		if now.Sub(departure) < s.threshold {
+			continue
+		}
+
+		reason := fmt.Sprintf("출발 예정 시각(%s) 경과", schedule.StartTime)
+		updated := false
+		err = withTx(ctx, s.txManager, func(ctx context.Context) error {
+			ok, err := s.tripRepo.MarkDelayed(ctx, trip.ID, now, reason)
+			if err != nil || !ok {
+				return err // 다른 인스턴스가 먼저 표시했거나 운행이 시작됨
+			}
+			updated = true
+			trip.MarkDelayed(reason, now)
+			return publishEvent(ctx, s.events, domain.WebhookEventTripDelayed, trip.OrganizationID, trip.ID, tripWebhookData(trip))
+		})
+		if err != nil {
+			return marked, err
+		}
+		if updated {
+			marked++
+		}
+	}
+	return marked, nil
+}
+
+// Report - 지연 직접 보고 (관리자 또는 배정 기사/동승자, 대기 중이거나 운행 중일 때만)
+func (s *DelayService) Report(ctx context.Context, tripID, reason string) (*domain.Trip, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if principal, ok := auth.FromContext(ctx); !ok || !principal.IsAdmin() {
+		if err := s.tripService.authorizeAssigned(ctx, trip); err != nil {
+			return nil, err
+		}
+	}
+	if !trip.IsPending() && !trip.IsInProgress() {
+		return nil, util.NewConflictError("대기 중이거나 운행 중인 운행만 지연을 보고할 수 있습니다: %s", trip.Status)
+	}
+
+	trip.MarkDelayed(reason, time.Now())
+	err = saveAndPublish(ctx, s.txManager, s.events, func(ctx context.Context) error {
+		return s.tripRepo.Update(ctx, trip)
+	}, domain.WebhookEventTripDelayed, trip.OrganizationID, trip.ID, tripWebhookData(trip))
+	if err != nil {
+		return nil, toAppError(err, "운행")
+	}
+	return trip, nil
+}
+
+// HandleEvent - 지연 이벤트를 받아 보호자와 운행 기관의 관리자에게 알림 (아웃박스 릴레이의 핸들러)
+// 알림 발송 실패는 알림 발송 기록의 재시도에 맡기고, 조회 실패만 에러로 반환해 릴레이가 다시 전달
+func (s *DelayService) HandleEvent(ctx context.Context, e event.Event) error {
+	if s.notifier == nil {
+		return nil
+	}
+
+	var data dto.TripWebhookData
+	if err := e.Decode(&data); err != nil {
+		return err
+	}
+	trip, err := s.tripRepo.GetByID(ctx, data.TripID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	trip.DelayReason = data.DelayReason // 이벤트를 기록한 보고의 사유 (이후 다시 보고했으면 그 이벤트가 따로 알림)
+	s.notifyDelay(ctx, trip, schedule)
+	return nil
+}
+
+// scheduledDeparture - 운행 날짜의 출발 예정 시각 (일정 StartTime, 기관 시간대 기준)
+func scheduledDeparture(trip *domain.Trip, schedule *domain.Schedule, location *time.Location) (time.Time, error) {
+	return departureOn(trip.Date, schedule.StartTime, location)
+}
+
+// departureOn - 날짜의 출발 시각 (HH:MM을 location의 벽시계 시각으로 해석, 아직 생성되지 않은 운행 포함)
+func departureOn(date time.Time, startTime string, location *time.Location) (time.Time, error) {
+	clock, err := time.Parse("15:04", startTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), 0, 0, location), nil
+}
+
+// notifyDelay - 경로에 배정된 탑승자의 보호자(보호자당 한 번)와 운행 기관의 관리자에게 지연 알림
+func (s *DelayService) notifyDelay(ctx context.Context, trip *domain.Trip, schedule *domain.Schedule) {
+	logger.FromContext(ctx).Warn("Trip delayed", map[string]interface{}{
+		"trip_id":     trip.ID,
+		"schedule_id": schedule.ID,
+		"reason":      trip.DelayReason,
+	})
+	if s.notifier == nil {
+		return
+	}
+	// 감지 작업은 인증 주체가 없으므로 운행의 기관으로 조회/발송 기록 범위 지정
+	ctx = repository.WithOrganization(ctx, trip.OrganizationID)
+	notification := delayNotification(trip, schedule)
+
+	guardianIDs, phones, err := tripGuardians(ctx, s.passengerRepo, s.guardianRepo, trip, schedule)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to list trip guardians", map[string]interface{}{"trip_id": trip.ID, "error": err.Error()})
+	}
+	for _, guardianID := range guardianIDs {
+		_, err := s.notifier.NotifyGuardian(ctx, guardianID, domain.NotificationEventDelay, notification)
+		if err != nil && !errors.Is(err, ErrNotificationOptedOut) {
+			logger.FromContext(ctx).Warn("Failed to notify guardian", map[string]interface{}{"guardian_id": guardianID, "event": domain.NotificationEventDelay, "error": err.Error()})
+		}
+	}
+	recipients := make([]Recipient, 0, len(phones))
+	for _, phone := range phones {
+		recipients = append(recipients, Recipient{Phone: phone})
+	}
+	for _, operator := range organizationOperators(ctx, s.operators, trip.OrganizationID) {
+		recipients = append(recipients, operator.Recipient())
+	}
+	for _, recipient := range recipients {
+		if _, err := s.notifier.Notify(ctx, recipient, notification); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify trip delay", map[string]interface{}{"trip_id": trip.ID, "error": err.Error()})
+		}
+	}
+}
+
+// tripGuardians - 경로에 배정된 활동 중 탑승자(불참·결석 신고 제외)의 보호자 ID와 연결 없는 탑승자의 보호자 연락처 (중복 제거)
+func tripGuardians(
+	ctx context.Context,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	trip *domain.Trip,
+	schedule *domain.Schedule,
+) ([]string, []string, error) {
+	passengers, _, err := passengerRepo.List(ctx, repository.PassengerFilter{
+		Status:  domain.PassengerStatusActive,
+		RouteID: schedule.RouteID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var guardianIDs, phones []string
+	seen := map[string]bool{}
+	for _, passenger := range passengers {
+		if record := findTripPassenger(trip, passenger.ID); record != nil && (record.IsNoShow() || record.IsExcused()) {
+			continue
+		}
+		links, err := guardianRepo.ListPassengerLinks(ctx, passenger.ID)
+		if err != nil {
+			return guardianIDs, phones, err
+		}
+		if len(links) == 0 {
+			if passenger.GuardianPhone != "" && !seen[passenger.GuardianPhone] {
+				seen[passenger.GuardianPhone] = true
+				phones = append(phones, passenger.GuardianPhone)
+			}
+			continue
+		}
+		for _, link := range links {
+			if !seen[link.GuardianID] {
+				seen[link.GuardianID] = true
+				guardianIDs = append(guardianIDs, link.GuardianID)
+			}
+		}
+	}
+	return guardianIDs, phones, nil
+}
+
+// delayNotification - 지연 알림 내용
+func delayNotification(trip *domain.Trip, schedule *domain.Schedule) *Notification {
+	notification := newNotification(util.Text("운행 지연 알림"),
+		util.Text("%s 운행이 지연되고 있습니다 (%s)", schedule.Name, trip.DelayReason))
+	notification.Data = map[string]string{
+		"type":    string(domain.NotificationEventDelay),
+		"trip_id": trip.ID,
+	}
+	notification.Template = string(domain.NotificationEventDelay)
+	notification.Variables = map[string]string{
+		"schedule": schedule.Name,
+		"reason":   trip.DelayReason,
+	}
+	return notification
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/push"
+)
+
+// 📝 설명: 푸시 수신 기기 등록/해제와 사용자 단위 푸시 발송
+// 🎯 실무 포인트: 발송 중 FCM이 무효라고 응답한 토큰은 바로 삭제 → 다음 발송부터 제외
+// ⚠️ 주의사항: 기기 하나의 발송 실패는 경고 로그만 남기고 나머지 기기에 계속 발송
+
+// DeviceService - 기기 서비스
+type DeviceService struct {
+	deviceRepo repository.DeviceTokenRepository
+	sender     push.Sender
+}
+
+// NewDeviceService - 기기 서비스 생성 (sender가 nil이면 등록만 받고 발송하지 않음)
+func NewDeviceService(deviceRepo repository.DeviceTokenRepository, sender push.Sender) *DeviceService {
+	return &DeviceService{deviceRepo: deviceRepo, sender: sender}
+}
+
+// Register - 기기 토큰 등록 (이미 등록된 토큰이면 현재 사용자로 갱신)
+func (s *DeviceService) Register(ctx context.Context, userID string, req *dto.RegisterDeviceRequest) (*domain.DeviceToken, error) {
+	device := domain.NewDeviceToken(userID, req.Token, domain.DevicePlatform(req.Platform))
+	if err := s.deviceRepo.Upsert(ctx, device); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return device, nil
+}
+
+// Unregister - 내 기기 토큰 해제
+func (s *DeviceService) Unregister(ctx context.Context, userID, token string) error {
+	if err := s.deviceRepo.Delete(ctx, userID, token); err != nil {
+		return toAppError(err, "기기")
+	}
+	return nil
+}
+
+// Push - 사용자의 모든 기기에 발송하고 발송 성공 수 반환 (무효 토큰은 삭제, 모두 일시 오류로 실패하면 마지막 에러)
+func (s *DeviceService) Push(ctx context.Context, userID string, msg *push.Message) (int, error) {
+	if s.sender == nil {
+		return 0, nil
+	}
+	devices, err := s.deviceRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return 0, util.NewInternalError(err)
+	}
+
+	sent := 0
+	var invalid []string
+	var sendErr error
+	for _, device := range devices {
+		if err := s.sender.Send(ctx, device.Token, msg); err != nil {
+			if errors.Is(err, push.ErrInvalidToken) {
+				invalid = append(invalid, device.Token)
+				continue
+			}
+			sendErr = err
+			logger.FromContext(ctx).Warn("Failed to send push", map[string]interface{}{
+				"user_id":   userID,
+				"device_id": device.ID,
+				"error":     err.Error(),
+			})
+			continue
+		}
+		sent++
+	}
+
+	if len(invalid) > 0 {
+		pruned, err := s.deviceRepo.DeleteTokens(ctx, invalid)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to prune invalid device tokens", map[string]interface{}{"user_id": userID, "error": err.Error()})
+		} else {
+			logger.FromContext(ctx).Info("Pruned invalid device tokens", map[string]interface{}{"user_id": userID, "count": pruned})
+		}
+	}
+	// 한 기기도 받지 못했는데 일시 오류가 있었으면 재시도할 수 있게 에러 반환
+	if sent == 0 && sendErr != nil {
+		return 0, sendErr
+	}
+	return sent, nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 운행 주행 거리 자동 계산 (수신 위치 사이 하버사인 거리 누적)
+// 🎯 실무 포인트: 위치가 들어올 때마다 직전 위치와의 거리를 trips.total_distance에 더해 운행 중에도 거리 확인
+// 운행 완료 시 기록된 전체 경로로 다시 계산해 확정 (누락/순서가 뒤바뀐 위치로 생긴 오차 보정)
+// ⚠️ 주의사항: GPS 튐(비현실적인 속도의 구간)과 정차 중 좌표 흔들림(앞뒤 속도 0)은 거리에서 제외
+
+// maxSegmentSpeed - 이 속도(km/h)를 넘는 구간은 GPS 튐으로 보고 거리에서 제외
+const maxSegmentSpeed = 200
+
+// DistanceService - 운행 주행 거리 계산 서비스
+type DistanceService struct {
+	tripRepo     repository.TripRepository
+	locationRepo repository.TripLocationRepository
+}
+
+// NewDistanceService - 주행 거리 계산 서비스 생성
+func NewDistanceService(tripRepo repository.TripRepository, locationRepo repository.TripLocationRepository) *DistanceService {
+	return &DistanceService{tripRepo: tripRepo, locationRepo: locationRepo}
+}
+
+// ObserveLocation - 직전 위치와의 거리를 누적 (LocationObserver 구현)
+func (s *DistanceService) ObserveLocation(ctx context.Context, trip *domain.Trip, previous, current *domain.VehiclePosition) {
+	meters := int(math.Round(segmentLength(previous, current)))
+	if meters == 0 {
+		return
+	}
+	if err := s.tripRepo.AddDistance(ctx, trip.ID, meters); err != nil {
+		logger.FromContext(ctx).Warn("Failed to accumulate trip distance", map[string]interface{}{
+			"trip_id": trip.ID,
+			"error":   err.Error(),
+		})
+		return
+	}
+	trip.TotalDistance += meters
+}
+
+// FinalizeTrip - 기록된 전체 경로로 주행 거리 확정 (TripFinalizer 구현, 조회 실패 시 누적값 유지 → 완료를 막지 않음)
+func (s *DistanceService) FinalizeTrip(ctx context.Context, trip *domain.Trip) error {
+	positions, err := s.locationRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load trip track for distance", map[string]interface{}{
+			"trip_id": trip.ID,
+			"error":   err.Error(),
+		})
+		return nil
+	}
+	if len(positions) == 0 {
+		return nil
+	}
+
+	// 튄 위치는 건너뛰고 마지막으로 인정한 위치에서 다음 위치까지 계산
+	var total float64
+	anchor := positions[0]
+	for _, position := range positions[1:] {
+		if isJump(anchor, position) {
+			continue
+		}
+		total += segmentLength(anchor, position)
+		anchor = position
+	}
+	trip.TotalDistance = int(math.Round(total))
+	return nil
+}
+
+// segmentLength - 두 위치 사이 주행 거리 (미터, 제외 대상 구간은 0)
+func segmentLength(previous, current *domain.VehiclePosition) float64 {
+	if previous == nil || current == nil {
+		return 0
+	}
+	if isStopped(previous) && isStopped(current) {
+		return 0
+	}
+
+	if !current.RecordedAt.After(previous.RecordedAt) {
+		return 0 // 순서가 뒤바뀐 위치는 완료 시 전체 경로로 보정
+	}
+	if isJump(previous, current) {
+		return 0
+	}
+	return distanceBetween(previous, current)
+}
+
+// isJump - 두 위치 사이 이동 속도가 maxSegmentSpeed를 넘는지 (GPS 튐)
+func isJump(previous, current *domain.VehiclePosition) bool {
+	interval := current.RecordedAt.Sub(previous.RecordedAt)
+	if interval <= 0 {
+		return false
+	}
+	return distanceBetween(previous, current)/interval.Seconds()*3.6 > maxSegmentSpeed
+}
+
+// distanceBetween - 두 위치 사이 직선 거리 (미터)
+func distanceBetween(a, b *domain.VehiclePosition) float64 {
+	return geo.Distance(
+		geo.Point{Latitude: a.Latitude, Longitude: a.Longitude},
+		geo.Point{Latitude: b.Latitude, Longitude: b.Longitude},
+	)
+}
+
+// isStopped - 단말이 속도 0을 보고한 위치인지
+func isStopped(position *domain.VehiclePosition) bool {
+	return position.Speed != nil && *position.Speed == 0
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 기사 대체 배정 비즈니스 로직 (휴가/퇴사 시 다른 기사가 기간 동안 일정 담당)
+// 🎯 실무 포인트: 운행 생성 시 그날 유효한 승인된 대체 배정이 있으면 기본 기사 대신 대체 기사 배정
+// ⚠️ 주의사항: 같은 일정에 기간이 겹치는 대체 배정은 CONFLICT → 날짜마다 대체 기사는 최대 한 명
+// 검증 이전 데이터나 동시 생성으로 겹치면 가장 나중에 승인된 배정 우선 (DriverAssignment.TakesPrecedenceOver)
+// 이미 만들어진 운행의 기사는 바꾸지 않음
+
+// DriverAssignmentService - 기사 대체 배정 서비스
+type DriverAssignmentService struct {
+	assignmentRepo repository.DriverAssignmentRepository
+	scheduleRepo   repository.ScheduleRepository
+	driverRepo     repository.DriverRepository
+}
+
+// NewDriverAssignmentService - 기사 대체 배정 서비스 생성
+func NewDriverAssignmentService(
+	assignmentRepo repository.DriverAssignmentRepository,
+	scheduleRepo repository.ScheduleRepository,
+	driverRepo repository.DriverRepository,
+) *DriverAssignmentService {
+	return &DriverAssignmentService{
+		assignmentRepo: assignmentRepo,
+		scheduleRepo:   scheduleRepo,
+		driverRepo:     driverRepo,
+	}
+}
+
+// Create - 대체 배정 생성 (대체 기사는 활동 중이고 면허가 확인·유효해야 하고, 같은 일정의 다른 배정과 기간이 겹치면 CONFLICT)
+func (s *DriverAssignmentService) Create(ctx context.Context, scheduleID string, req *dto.CreateDriverAssignmentRequest) (*domain.DriverAssignment, error) {
+	if _, err := s.scheduleRepo.GetByID(ctx, scheduleID); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+	// 바인딩에서 형식 검증 완료
+	startDate, _ := time.Parse(time.DateOnly, req.StartDate)
+	endDate, _ := time.Parse(time.DateOnly, req.EndDate)
+
+	details := map[string]interface{}{}
+	if endDate.Before(startDate) {
+		details["end_date"] = "종료일은 시작일 이후여야 합니다"
+	}
+	if driver, err := s.driverRepo.GetByID(ctx, req.DriverID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			return nil, util.NewInternalError(err)
+		}
+		details["driver_id"] = "존재하지 않는 기사입니다"
+	} else if !driver.IsActive() {
+		details["driver_id"] = "활동 중인 기사가 아닙니다"
+	} else if !driver.HasValidLicense() {
+		details["driver_id"] = "면허가 확인되지 않았거나 만료된 기사입니다"
+	}
+	if len(details) > 0 {
+		return nil, util.NewValidationError(util.MsgValidationFailed, details)
+	}
+
+	existing, err := s.assignmentRepo.ListBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	var overlapping []*domain.DriverAssignment
+	for _, assignment := range existing {
+		if assignment.Overlaps(startDate, endDate) {
+			overlapping = append(overlapping, assignment)
+		}
+	}
+	if len(overlapping) > 0 {
+		return nil, util.NewConflictErrorWithDetails("같은 일정에 기간이 겹치는 기사 대체 배정이 있습니다",
+			map[string]interface{}{"conflicts": overlapping})
+	}
+
+	var createdBy string
+	if principal, ok := auth.FromContext(ctx); ok {
+		createdBy = principal.UserID
+	}
+	assignment := domain.NewDriverAssignment(scheduleID, req.DriverID, startDate, endDate, req.Reason, createdBy)
+	if err := s.assignmentRepo.Create(ctx, assignment); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return assignment, nil
+}
+
+// List - 일정의 대체 배정 목록 (시작일 순)
+func (s *DriverAssignmentService) List(ctx context.Context, scheduleID string) ([]*domain.DriverAssignment, error) {
+	if _, err := s.scheduleRepo.GetByID(ctx, scheduleID); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+	assignments, err := s.assignmentRepo.ListBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return assignments, nil
+}
+
+// Approve - 대체 배정 승인 (이미 승인된 배정은 그대로 반환)
+func (s *DriverAssignmentService) Approve(ctx context.Context, scheduleID, id string) (*domain.DriverAssignment, error) {
+	assignment, err := s.get(ctx, scheduleID, id)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.IsApproved() {
+		return assignment, nil
+	}
+
+	var approvedBy string
+	if principal, ok := auth.FromContext(ctx); ok {
+		approvedBy = principal.UserID
+	}
+	assignment.Approve(approvedBy)
+	if err := s.assignmentRepo.Update(ctx, assignment); err != nil {
+		return nil, toAppError(err, "기사 대체 배정")
+	}
+	return assignment, nil
+}
+
+// Delete - 대체 배정 삭제
+func (s *DriverAssignmentService) Delete(ctx context.Context, scheduleID, id string) error {
+	if _, err := s.get(ctx, scheduleID, id); err != nil {
+		return err
+	}
+	if err := s.assignmentRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "기사 대체 배정")
+	}
+	return nil
+}
+
+// get - 일정의 대체 배정 조회 (다른 일정의 배정이면 NOT_FOUND)
+func (s *DriverAssignmentService) get(ctx context.Context, scheduleID, id string) (*domain.DriverAssignment, error) {
+	assignment, err := s.assignmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "기사 대체 배정")
+	}
+	if assignment.ScheduleID != scheduleID {
+		return nil, util.NewNotFoundError("기사 대체 배정")
+	}
+	return assignment, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 기사 면허증 스캔 업로드/다운로드
+// 🎯 실무 포인트: 기사가 앱에서 직접 올리거나 관리자가 대신 올림 → 확인 대기(pending)로 바뀌고 관리자가 DriverService.ReviewLicense로 확인/반려
+// 파일은 FileService(용도 driver_license, PDF/JPEG/PNG 최대 5MB)가 검증/저장
+// ⚠️ 주의사항: 스캔은 관리자와 기사 본인만 올리고 받을 수 있음, 새 스캔을 올리면 이전 스캔 파일은 삭제
+
+// DriverLicenseService - 면허증 스캔 서비스
+type DriverLicenseService struct {
+	driverRepo  repository.DriverRepository
+	fileService *FileService
+}
+
+// NewDriverLicenseService - 면허증 스캔 서비스 생성
+func NewDriverLicenseService(driverRepo repository.DriverRepository, fileService *FileService) *DriverLicenseService {
+	return &DriverLicenseService{driverRepo: driverRepo, fileService: fileService}
+}
+
+// UploadScan - 면허증 스캔 제출 (면허 확인 상태는 pending으로 초기화)
+func (s *DriverLicenseService) UploadScan(ctx context.Context, driverID, fileName string, data []byte) (*domain.Driver, error) {
+	driver, err := s.authorizedDriver(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := s.fileService.Upload(ctx, domain.FilePurposeDriverLicense, fileName, data)
+	if err != nil {
+		return nil, err
+	}
+	previous := driver.LicenseFileID
+	driver.SubmitLicenseScan(file.ID)
+	if err := s.driverRepo.Update(ctx, driver); err != nil {
+		s.removeFile(ctx, file.ID)
+		return nil, toAppError(err, "기사")
+	}
+	if previous != nil {
+		s.removeFile(ctx, *previous)
+	}
+	return driver, nil
+}
+
+// DownloadScan - 면허증 스캔 파일 메타데이터와 내용
+func (s *DriverLicenseService) DownloadScan(ctx context.Context, driverID string) (*domain.File, []byte, error) {
+	driver, err := s.authorizedDriver(ctx, driverID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if driver.LicenseFileID == nil {
+		return nil, nil, util.NewNotFoundError("면허증 스캔")
+	}
+	return s.fileService.Read(ctx, *driver.LicenseFileID)
+}
+
+// authorizedDriver - 관리자 또는 기사 본인만 (다른 기사의 스캔은 FORBIDDEN)
+func (s *DriverLicenseService) authorizedDriver(ctx context.Context, driverID string) (*domain.Driver, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, util.NewUnauthorizedError()
+	}
+	if !principal.IsAdmin() && (principal.Role != domain.RoleDriver || principal.ProfileID != driverID) {
+		return nil, util.NewForbiddenError()
+	}
+	driver, err := s.driverRepo.GetByID(ctx, driverID)
+	if err != nil {
+		return nil, toAppError(err, "기사")
+	}
+	return driver, nil
+}
+
+// removeFile - 면허증 스캔 파일 삭제 (실패는 로그만)
+func (s *DriverLicenseService) removeFile(ctx context.Context, fileID string) {
+	if err := s.fileService.Delete(ctx, fileID); err != nil {
+		logger.FromContext(ctx).Warn("Failed to delete driver license scan", map[string]interface{}{
+			"file_id": fileID,
+			"error":   err.Error(),
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 기사 비즈니스 로직
+// 🎯 실무 포인트: 상태 전환(휴가/복귀/퇴사), 면허 확인/반려는 도메인 메소드를 통해서만 수행
+// ⚠️ 주의사항: 퇴사한 기사는 상태 변경 불가, 면허 정보를 바꾸면 다시 확인 대기(운행 배정 불가)
+
+// DriverService - 기사 서비스
+type DriverService struct {
+	driverRepo repository.DriverRepository
+}
+
+// NewDriverService - 기사 서비스 생성
+func NewDriverService(driverRepo repository.DriverRepository) *DriverService {
+	return &DriverService{driverRepo: driverRepo}
+}
+
+// Create - 기사 등록
+// 면허 번호가 이미 존재하면 DUPLICATE_ERROR 반환
+func (s *DriverService) Create(ctx context.Context, req *dto.CreateDriverRequest) (*domain.Driver, error) {
+	if err := s.ensureLicenseNumberAvailable(ctx, req.LicenseNumber, ""); err != nil {
+		return nil, err
+	}
+
+	driver := domain.NewDriver(req.Name, req.Phone, req.LicenseNumber, domain.LicenseType(req.LicenseType), req.LicenseExpiry)
+	driver.Email = req.Email
+	driver.Address = req.Address
+	driver.EmergencyContact = req.EmergencyContact
+	driver.Notes = req.Notes
+	if req.HireDate != nil {
+		driver.HireDate = *req.HireDate
+	}
+
+	if err := s.driverRepo.Create(ctx, driver); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return driver, nil
+}
+
+// Get - 기사 단건 조회
+func (s *DriverService) Get(ctx context.Context, id string) (*domain.Driver, error) {
+	driver, err := s.driverRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "기사")
+	}
+	return driver, nil
+}
+
+// List - 기사 목록 조회
+func (s *DriverService) List(ctx context.Context, filter repository.DriverFilter) ([]*domain.Driver, int64, error) {
+	drivers, total, err := s.driverRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return drivers, total, nil
+}
+
+// Update - 기사 기본 정보 수정
+func (s *DriverService) Update(ctx context.Context, id string, req *dto.UpdateDriverRequest) (*domain.Driver, error) {
+	driver, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		driver.Name = *req.Name
+	}
+	if req.Phone != nil || req.Email != nil {
+		driver.UpdateContactInfo(deref(req.Phone), deref(req.Email))
+	}
+	if req.Address != nil {
+		driver.Address = *req.Address
+	}
+	if req.EmergencyContact != nil {
+		driver.EmergencyContact = *req.EmergencyContact
+	}
+	if req.Notes != nil {
+		driver.Notes = *req.Notes
+	}
+
+	return s.save(ctx, driver)
+}
+
+// UpdateLicense - 면허 정보 수정
+func (s *DriverService) UpdateLicense(ctx context.Context, id string, req *dto.UpdateLicenseRequest) (*domain.Driver, error) {
+	driver, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	number, licenseType, expiry := driver.LicenseNumber, driver.LicenseType, driver.LicenseExpiry
+	if req.LicenseNumber != nil && *req.LicenseNumber != driver.LicenseNumber {
+		if err := s.ensureLicenseNumberAvailable(ctx, *req.LicenseNumber, driver.ID); err != nil {
+			return nil, err
+		}
+		number = *req.LicenseNumber
+	}
+	if req.LicenseType != nil {
+		licenseType = domain.LicenseType(*req.LicenseType)
+	}
+	if req.LicenseExpiry != nil {
+		expiry = *req.LicenseExpiry
+	}
+	driver.UpdateLicenseInfo(number, licenseType, expiry)
+
+	return s.save(ctx, driver)
+}
+
+// ReviewLicense - 면허 확인/반려 (관리자, 반려는 사유 필수)
+func (s *DriverService) ReviewLicense(ctx context.Context, id string, req *dto.ReviewLicenseRequest) (*domain.Driver, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, util.NewUnauthorizedError()
+	}
+	driver, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch domain.LicenseStatus(req.Status) {
+	case domain.LicenseStatusVerified:
+		if driver.IsLicenseExpired() {
+			return nil, util.NewConflictError("만료된 면허는 확인할 수 없습니다 (면허 정보를 먼저 갱신해 주세요)")
+		}
+		driver.VerifyLicense(principal.UserID, now)
+	case domain.LicenseStatusRejected:
+		if strings.TrimSpace(req.Reason) == "" {
+			return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"reason": "반려 사유를 입력해 주세요",
+			})
+		}
+		driver.RejectLicense(principal.UserID, strings.TrimSpace(req.Reason), now)
+	}
+
+	return s.save(ctx, driver)
+}
+
+// ChangeStatus - 휴가/복귀 상태 변경
+func (s *DriverService) ChangeStatus(ctx context.Context, id string, status domain.DriverStatus) (*domain.Driver, error) {
+	driver, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if driver.IsTerminated() {
+		return nil, util.NewConflictError("퇴사한 기사의 상태는 변경할 수 없습니다")
+	}
+
+	switch status {
+	case domain.DriverStatusActive:
+		driver.SetActive()
+	case domain.DriverStatusOnLeave:
+		driver.SetOnLeave()
+	default:
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"status": "active 또는 on_leave만 가능합니다",
+		})
+	}
+
+	return s.save(ctx, driver)
+}
+
+// Terminate - 퇴사 처리
+func (s *DriverService) Terminate(ctx context.Context, id string, terminationDate *time.Time) (*domain.Driver, error) {
+	driver, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if driver.IsTerminated() {
+		return nil, util.NewConflictError("이미 퇴사 처리된 기사입니다")
+	}
+
+	date := time.Now()
+	if terminationDate != nil {
+		date = *terminationDate
+	}
+	driver.Terminate(date)
+
+	return s.save(ctx, driver)
+}
+
+// Delete - 기사 삭제 (soft delete)
+func (s *DriverService) Delete(ctx context.Context, id string) error {
+	if err := s.driverRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "기사")
+	}
+	return nil
+}
+
+// Restore - 삭제된 기사 복구 (익명화된 기사는 CONFLICT, 같은 면허 번호로 다시 등록된 기사가 있으면 DUPLICATE)
+func (s *DriverService) Restore(ctx context.Context, id string) (*domain.Driver, error) {
+	driver, err := s.driverRepo.GetDeleted(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "삭제된 기사")
+	}
+	if driver.IsAnonymized() {
+		return nil, util.NewConflictError("개인정보가 익명화된 기사는 복구할 수 없습니다")
+	}
+	if err := s.ensureLicenseNumberAvailable(ctx, driver.LicenseNumber, driver.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.driverRepo.Restore(ctx, id); err != nil {
+		return nil, toAppError(err, "삭제된 기사")
+	}
+	return s.Get(ctx, id)
+}
+
+// save - 변경 사항 저장
+func (s *DriverService) save(ctx context.Context, driver *domain.Driver) (*domain.Driver, error) {
+	if err := s.driverRepo.Update(ctx, driver); err != nil {
+		return nil, toAppError(err, "기사")
+	}
+	return driver, nil
+}
+
+// ensureLicenseNumberAvailable - 면허 번호 중복 확인
+func (s *DriverService) ensureLicenseNumberAvailable(ctx context.Context, licenseNumber, excludeID string) error {
+	existing, err := s.driverRepo.GetByLicenseNumber(ctx, licenseNumber)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return util.NewInternalError(err)
+	}
+
+	if existing.ID != excludeID {
+		return util.NewDuplicateError("면허 번호")
+	}
+	return nil
+}
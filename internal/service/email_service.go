@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/email"
+)
+
+// 📝 설명: 이메일 알림 (비밀번호 재설정, 기관 초대, 서류 만료 안내, 관리자 보고서)
+// 🎯 실무 포인트: 메일 본문은 templates/email의 HTML 템플릿으로 관리하고 바이너리에 포함(embed)
+// 언어별 템플릿은 templates/email/<언어> 하위에 두고, 없는 메일은 기본 언어(한국어) 템플릿으로 발송
+// ⚠️ 주의사항: 발송 클라이언트가 없으면 ErrEmailDisabled (호출 측에서 다른 채널로 대체)
+
+//go:embed templates/email/*.html templates/email/en/*.html
+var emailTemplateFiles embed.FS
+
+// emailTemplates - 언어별 내장 메일 템플릿 (해석 실패는 빌드 결함이므로 시작 시 panic)
+var emailTemplates = map[util.Locale]*email.Templates{
+	util.DefaultLocale: mustEmailTemplates("templates/email"),
+	util.LocaleEnglish: mustEmailTemplates("templates/email/en"),
+}
+
+// mustEmailTemplates - dir의 메일 템플릿 해석
+func mustEmailTemplates(dir string) *email.Templates {
+	files, err := fs.Sub(emailTemplateFiles, dir)
+	if err != nil {
+		panic(err)
+	}
+	return email.MustParseTemplates(files)
+}
+
+// ErrEmailDisabled - 이메일 발송 미설정
+var ErrEmailDisabled = errors.New("email: sender not configured")
+
+// DocumentExpiry - 만료 예정 서류 한 건
+type DocumentExpiry struct {
+	Document  string // 서류 종류 (예: "운전면허", "자동차 보험", "정기검사")
+	Holder    string // 대상 (기사 이름, 차량 번호)
+	ExpiresAt time.Time
+	Location  *time.Location // 만료일/남은 일수를 셀 기관 시간대 (nil이면 한국 시간)
+}
+
+// AdminReport - 관리자 보고서 (항목별 요약 수치)
+type AdminReport struct {
+	Title  string // 예: "일일 운행 보고서"
+	Period string // 예: "2025-03-03"
+	Rows   []AdminReportRow
+	Notes  []string // 표 아래 참고 사항
+}
+
+// AdminReportRow - 보고서 항목
+type AdminReportRow struct {
+	Label string
+	Value string
+}
+
+// EmailService - 이메일 알림 서비스
+type EmailService struct {
+	sender          email.Sender
+	adminRecipients []string
+	resetTTL        time.Duration
+}
+
+// NewEmailService - 이메일 서비스 생성 (sender가 nil이면 발송 안 함)
+func NewEmailService(sender email.Sender, adminRecipients []string, resetTTL time.Duration) *EmailService {
+	return &EmailService{sender: sender, adminRecipients: adminRecipients, resetTTL: resetTTL}
+}
+
+// Enabled - 발송 가능 여부
+func (s *EmailService) Enabled() bool {
+	return s.sender != nil
+}
+
+// SendPasswordReset - 계정 이메일로 재설정 토큰 발송 (PasswordResetNotifier 구현)
+// 계정 언어 설정이 있으면 그 언어, 없으면 요청 언어로 발송
+func (s *EmailService) SendPasswordReset(ctx context.Context, user *domain.User, token string) error {
+	locale := userLocale(ctx, user)
+	return s.send(ctx, locale, []string{user.Email}, "password_reset", map[string]interface{}{
+		"Email":    user.Email,
+		"Token":    token,
+		"ValidFor": formatValidFor(locale, s.resetTTL),
+	})
+}
+
+// userLocale - 계정 언어 설정 (설정이 없으면 요청 언어)
+func userLocale(ctx context.Context, user *domain.User) util.Locale {
+	if locale, ok := util.ParseLocale(user.Locale); ok {
+		return locale
+	}
+	return util.LocaleFromContext(ctx)
+}
+
+// SendInvitation - 초대 이메일 주소로 초대 토큰 발송 (InvitationNotifier 구현, 초대한 관리자의 요청 언어)
+func (s *EmailService) SendInvitation(ctx context.Context, invitation *domain.Invitation, organization *domain.Organization, token string) error {
+	if invitation.Email == nil {
+		return email.ErrNoRecipients
+	}
+	locale := util.LocaleFromContext(ctx)
+	return s.send(ctx, locale, []string{*invitation.Email}, "invitation", map[string]interface{}{
+		"Organization": organization.Name,
+		"Role":         util.LocalizeName(locale, invitation.Role.DisplayName()),
+		"Token":        token,
+		"ValidFor":     formatValidFor(locale, invitation.ExpiresAt.Sub(invitation.CreatedAt)),
+	})
+}
+
+// SendDocumentExpiry - 만료 예정 서류 목록 발송 (to가 비어 있으면 관리자에게)
+func (s *EmailService) SendDocumentExpiry(ctx context.Context, to []string, items []DocumentExpiry) error {
+	if len(to) == 0 {
+		to = s.adminRecipients
+	}
+	now := time.Now()
+	rows := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		location := item.Location
+		if location == nil {
+			location = organizationLocation(ctx, nil, "")
+		}
+		today := truncateToDay(now.In(location))
+		expiresOn := truncateToDay(item.ExpiresAt.In(location))
+		rows[i] = map[string]interface{}{
+			"Document":  item.Document,
+			"Holder":    item.Holder,
+			"ExpiresOn": expiresOn.Format("2006-01-02"),
+			"DaysLeft":  int(expiresOn.Sub(today).Hours() / 24),
+		}
+	}
+	return s.send(ctx, util.DefaultLocale, to, "document_expiry", map[string]interface{}{"Items": rows})
+}
+
+// SendAdminReport - 관리자 전원에게 보고서 발송
+func (s *EmailService) SendAdminReport(ctx context.Context, report *AdminReport) error {
+	return s.send(ctx, util.DefaultLocale, s.adminRecipients, "admin_report", report)
+}
+
+// send - 템플릿 렌더링 후 발송
+func (s *EmailService) send(ctx context.Context, locale util.Locale, to []string, template string, data interface{}) error {
+	if s.sender == nil {
+		return ErrEmailDisabled
+	}
+	if len(to) == 0 {
+		return email.ErrNoRecipients
+	}
+	subject, body, err := renderEmail(locale, template, data)
+	if err != nil {
+		return err
+	}
+	return s.sender.Send(ctx, &email.Message{To: to, Subject: subject, HTML: body})
+}
+
+// truncateToDay - 같은 시간대의 자정으로 내림
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// renderEmail - 언어별 템플릿 렌더링 (그 언어에 없는 메일은 기본 언어 템플릿)
+func renderEmail(locale util.Locale, template string, data interface{}) (string, string, error) {
+	if templates, ok := emailTemplates[locale]; ok {
+		subject, body, err := templates.Render(template, data)
+		if !errors.Is(err, email.ErrTemplateNotFound) {
+			return subject, body, err
+		}
+	}
+	return emailTemplates[util.DefaultLocale].Render(template, data)
+}
+
+// formatValidFor - 유효 기간 표시 (예: 30분, 2시간 / 30 minutes, 2 hours)
+func formatValidFor(locale util.Locale, ttl time.Duration) string {
+	hours, minutes := int(ttl.Hours()), int(ttl.Minutes())
+	if locale == util.LocaleEnglish {
+		if ttl >= time.Hour && ttl%time.Hour == 0 {
+			return pluralize(hours, "hour")
+		}
+		return pluralize(minutes, "minute")
+	}
+	if ttl >= time.Hour && ttl%time.Hour == 0 {
+		return fmt.Sprintf("%d시간", hours)
+	}
+	return fmt.Sprintf("%d분", minutes)
+}
+
+// pluralize - 영어 수량 표기 (1 hour, 2 hours)
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
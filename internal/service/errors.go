@@ -0,0 +1,25 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: Service 계층 에러 변환
+// 🎯 실무 포인트: Repository 에러 → AppError 변환을 한 곳에서 처리
+// ⚠️ 주의사항: 이미 AppError인 경우 그대로 반환
+
+// toAppError - Repository 에러를 AppError로 변환
+// 사용 예: return nil, toAppError(err, "차량")
+func toAppError(err error, resource string) error {
+	var appErr *util.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return util.NewNotFoundError(resource)
+	}
+	return util.NewInternalError(err)
+}
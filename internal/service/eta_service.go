@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 운행 정류장별 도착 예정 시간 (마지막 수신 위치 → 남은 정류장 순서대로)
+// 🎯 실무 포인트: 보호자 앱 "7분 후 도착" 표시, 계산 결과는 캐시해 조회가 몰려도 TTL마다 한 번만 계산
+// ⚠️ 주의사항: 지난 정류장은 지오펜스 출발 기록으로 판별 (지오펜스가 꺼져 있으면 모든 정류장이 남은 것으로 봄)
+// 기본 추정은 직선 거리 기반이므로 지도 API를 쓰려면 TravelTimeEstimator 구현을 교체
+
+// TravelTimeEstimator - 출발 위치에서 정류장을 순서대로 거칠 때 정류장별 누적 소요 시간
+type TravelTimeEstimator interface {
+	TravelTimes(ctx context.Context, origin geo.Point, stops []geo.Point) ([]time.Duration, error)
+}
+
+// straightLineDetour - 직선 거리 대비 실제 도로 거리 보정 비율
+const straightLineDetour = 1.3
+
+// StraightLineEstimator - 직선 거리와 평균 속도로 추정 (지도 API 없이 동작하는 기본 Estimator)
+type StraightLineEstimator struct {
+	Speed     float64       // 평균 속도 (km/h)
+	StopDwell time.Duration // 앞 정류장마다 더하는 정차 시간
+}
+
+// TravelTimes - 구간 직선 거리 × 보정 비율 ÷ 평균 속도 + 앞 정류장 정차 시간
+func (e StraightLineEstimator) TravelTimes(ctx context.Context, origin geo.Point, stops []geo.Point) ([]time.Duration, error) {
+	metersPerSecond := e.Speed * 1000 / 3600
+	times := make([]time.Duration, len(stops))
+	var elapsed time.Duration
+	previous := origin
+	for i, stop := range stops {
+		if i > 0 {
+			elapsed += e.StopDwell
+		}
+		seconds := geo.Distance(previous, stop) * straightLineDetour / metersPerSecond
+		elapsed += time.Duration(seconds * float64(time.Second))
+		times[i] = elapsed
+		previous = stop
+	}
+	return times, nil
+}
+
+// ETAService - 도착 예정 시간 서비스
+type ETAService struct {
+	trackingService *TrackingService
+	etaRepo         repository.TripETARepository
+	estimator       TravelTimeEstimator
+	cacheTTL        time.Duration
+}
+
+// NewETAService - 도착 예정 시간 서비스 생성 (estimator가 nil이면 평균 20km/h 직선 거리 추정)
+func NewETAService(
+	trackingService *TrackingService,
+	etaRepo repository.TripETARepository,
+	estimator TravelTimeEstimator,
+	cacheTTL time.Duration,
+) *ETAService {
+	if estimator == nil {
+		estimator = StraightLineEstimator{Speed: 20, StopDwell: 30 * time.Second}
+	}
+	return &ETAService{
+		trackingService: trackingService,
+		etaRepo:         etaRepo,
+		estimator:       estimator,
+		cacheTTL:        cacheTTL,
+	}
+}
+
+// GetETA - 운행의 남은 정류장 도착 예정 시간 (실시간 위치 조회 권한과 동일)
+func (s *ETAService) GetETA(ctx context.Context, tripID string) (*dto.TripETAResponse, error) {
+	trip, err := s.trackingService.AuthorizeWatch(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if !trip.IsInProgress() {
+		return nil, util.NewConflictError("운행 중인 운행만 도착 예정 시간을 제공합니다")
+	}
+
+	eta, err := s.etaRepo.Get(ctx, trip.ID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			logger.FromContext(ctx).Warn("ETA cache unavailable", map[string]interface{}{
+				"trip_id": trip.ID,
+				"error":   err.Error(),
+			})
+		}
+		if eta, err = s.calculate(ctx, trip); err != nil {
+			return nil, err
+		}
+		if eta != nil {
+			if err := s.etaRepo.Save(ctx, eta, s.cacheTTL); err != nil {
+				logger.FromContext(ctx).Warn("Failed to cache ETA", map[string]interface{}{
+					"trip_id": trip.ID,
+					"error":   err.Error(),
+				})
+			}
+		}
+	}
+
+	return newTripETAResponse(trip.ID, eta, time.Now()), nil
+}
+
+// calculate - 마지막 수신 위치 기준 계산 (아직 위치가 없으면 nil)
+func (s *ETAService) calculate(ctx context.Context, trip *domain.Trip) (*domain.TripETA, error) {
+	position, err := s.trackingService.locationRepo.Latest(ctx, trip.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, util.NewInternalError(err)
+	}
+
+	stops, err := s.trackingService.plannedStops(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+	remaining := s.remainingStops(ctx, trip.ID, stops)
+
+	origin := geo.Point{Latitude: position.Latitude, Longitude: position.Longitude}
+	points := make([]geo.Point, len(remaining))
+	for i, stop := range remaining {
+		points[i] = geo.Point{Latitude: stop.Latitude, Longitude: stop.Longitude}
+	}
+	times, err := s.estimator.TravelTimes(ctx, origin, points)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	eta := &domain.TripETA{
+		TripID:       trip.ID,
+		Latitude:     position.Latitude,
+		Longitude:    position.Longitude,
+		PositionAt:   position.RecordedAt,
+		CalculatedAt: time.Now(),
+		Stops:        make([]domain.StopETA, len(remaining)),
+	}
+	var distance float64
+	previous := origin
+	for i, stop := range remaining {
+		distance += geo.Distance(previous, points[i])
+		previous = points[i]
+		eta.Stops[i] = domain.StopETA{
+			StopID:    stop.ID,
+			StopName:  stop.Name,
+			StopOrder: stop.Order,
+			Distance:  math.Round(distance),
+			ArrivalAt: position.RecordedAt.Add(times[i]),
+			Arrived:   stop.arrived,
+		}
+		if stop.arrived {
+			eta.Stops[i].ArrivalAt = position.RecordedAt
+		}
+	}
+	return eta, nil
+}
+
+// remainingStop - 남은 정류장 (현재 도착해 있는지 포함)
+type remainingStop struct {
+	domain.Stop
+	arrived bool
+}
+
+// remainingStops - 마지막으로 출발한 정류장 다음부터 순서대로
+func (s *ETAService) remainingStops(ctx context.Context, tripID string, stops []domain.Stop) []remainingStop {
+	ordered := append([]domain.Stop(nil), stops...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Order < ordered[j].Order })
+
+	var phases map[string]geofence.Phase
+	if s.trackingService.geofence != nil {
+		var err error
+		if phases, err = s.trackingService.geofence.Phases(ctx, tripID); err != nil {
+			logger.FromContext(ctx).Warn("Geofence state unavailable for ETA", map[string]interface{}{
+				"trip_id": tripID,
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	next := 0
+	for i, stop := range ordered {
+		if phases[stop.ID] == geofence.PhaseDeparted {
+			next = i + 1
+		}
+	}
+
+	remaining := make([]remainingStop, 0, len(ordered)-next)
+	for _, stop := range ordered[next:] {
+		remaining = append(remaining, remainingStop{Stop: stop, arrived: phases[stop.ID] == geofence.PhaseArrived})
+	}
+	return remaining
+}
+
+// newTripETAResponse - 조회 시점 기준 남은 분 계산 (캐시된 결과도 시간이 지난 만큼 줄어듦)
+func newTripETAResponse(tripID string, eta *domain.TripETA, now time.Time) *dto.TripETAResponse {
+	response := &dto.TripETAResponse{TripID: tripID, Stops: []dto.StopETAResponse{}}
+	if eta == nil {
+		return response
+	}
+
+	response.Position = &dto.TrackPoint{Latitude: eta.Latitude, Longitude: eta.Longitude, RecordedAt: eta.PositionAt}
+	response.CalculatedAt = &eta.CalculatedAt
+	for _, stop := range eta.Stops {
+		minutes := 0
+		if remaining := stop.ArrivalAt.Sub(now); remaining > 0 && !stop.Arrived {
+			minutes = int(math.Ceil(remaining.Minutes()))
+		}
+		response.Stops = append(response.Stops, dto.StopETAResponse{
+			StopID:    stop.StopID,
+			StopName:  stop.StopName,
+			StopOrder: stop.StopOrder,
+			Distance:  stop.Distance,
+			ArrivalAt: stop.ArrivalAt,
+			Minutes:   minutes,
+			Arrived:   stop.Arrived,
+		})
+	}
+	return response
+}
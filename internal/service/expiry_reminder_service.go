@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/email"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 면허/보험/정기검사 만료 안내 (매일 실행하는 주기 작업)
+// 🎯 실무 포인트: 만료 30/14/3일 전 구간에 들어오면 기관마다 그 기관 관리자에게 메일 한 통으로 모아 안내하고, 해당 기사에게도 알림
+// 면허는 기사 본인, 보험/정기검사는 그 차량을 기본 차량으로 쓰는 일정의 기사가 대상
+// 만료된 면허/보험/검사로는 운행에 배정할 수 없으므로 미리 갱신하도록 (Driver.HasValidLicense, Vehicle.IsAvailableOn)
+// ⚠️ 주의사항: 구간마다 한 번만 안내 (발송 기록을 먼저 선점), 작업이 며칠 멈췄다가 다시 돌면 지금 속한 가장 짧은 구간으로 한 번만 안내
+// 관리자 메일 주소가 없거나 메일이 설정되지 않았으면 운영 알림 수신자(관리자 계정 → 기관 대표 연락처 → SMS_ADMIN_NUMBERS)에게 요약 알림
+// 다른 기관의 만료 항목은 요약에 섞지 않음
+
+// DocumentExpiryNotifier - 관리자 서류 만료 안내 (EmailService 구현, to가 비어 있으면 EMAIL_ADMIN_RECIPIENTS)
+type DocumentExpiryNotifier interface {
+	SendDocumentExpiry(ctx context.Context, to []string, items []DocumentExpiry) error
+}
+
+// ExpiryReminderResult - 만료 안내 실행 결과
+type ExpiryReminderResult struct {
+	Licenses    int `json:"licenses"`    // 안내한 면허 수
+	Insurances  int `json:"insurances"`  // 안내한 차량 보험 수
+	Inspections int `json:"inspections"` // 안내한 차량 정기검사 수
+}
+
+// Total - 이번 실행에서 안내한 전체 건수
+func (r *ExpiryReminderResult) Total() int {
+	return r.Licenses + r.Insurances + r.Inspections
+}
+
+// ExpiryReminderService - 만료 안내 서비스
+type ExpiryReminderService struct {
+	driverRepo   repository.DriverRepository
+	vehicleRepo  repository.VehicleRepository
+	scheduleRepo repository.ScheduleRepository
+	userRepo     repository.UserRepository
+	reminderRepo repository.ExpiryReminderRepository
+	emails       DocumentExpiryNotifier
+	notifier     GuardianNotifier
+	operators    OperatorResolver // 기관별 요약을 받을 관리자
+	days         []int            // 안내 구간 (만료 N일 전, 오름차순)
+	locations    TimezoneResolver
+}
+
+// NewExpiryReminderService - 만료 안내 서비스 생성
+// days: 만료 며칠 전에 안내할지 (예: 30, 14, 3), emails/notifier가 nil이면 해당 안내 생략
+// operators: 기관별 요약을 받을 관리자 (nil이면 관리자 안내 생략)
+// locations: 남은 일수를 셀 기관 시간대 (nil이면 한국 시간)
+func NewExpiryReminderService(
+	driverRepo repository.DriverRepository,
+	vehicleRepo repository.VehicleRepository,
+	scheduleRepo repository.ScheduleRepository,
+	userRepo repository.UserRepository,
+	reminderRepo repository.ExpiryReminderRepository,
+	emails DocumentExpiryNotifier,
+	notifier GuardianNotifier,
+	operators OperatorResolver,
+	days []int,
+	locations TimezoneResolver,
+) *ExpiryReminderService {
+	sorted := slices.Clone(days)
+	slices.Sort(sorted)
+	return &ExpiryReminderService{
+		driverRepo:   driverRepo,
+		vehicleRepo:  vehicleRepo,
+		scheduleRepo: scheduleRepo,
+		userRepo:     userRepo,
+		reminderRepo: reminderRepo,
+		emails:       emails,
+		notifier:     notifier,
+		operators:    operators,
+		days:         slices.Compact(sorted),
+		locations:    locations,
+	}
+}
+
+// expiryItem - 안내할 만료 한 건
+type expiryItem struct {
+	kind           domain.ExpiryKind
+	subjectID      string
+	organizationID string
+	holder         string    // 기사 이름 또는 차량 번호
+	expiresAt      time.Time // 만료일
+	window         int       // 속한 안내 구간 (만료 N일 전)
+}
+
+// Run - now 기준 안내 구간에 들어온 면허/보험/정기검사 안내 (이미 안내한 구간은 건너뜀)
+func (s *ExpiryReminderService) Run(ctx context.Context, now time.Time) (*ExpiryReminderResult, error) {
+	result := &ExpiryReminderResult{}
+	if len(s.days) == 0 {
+		return result, nil
+	}
+
+	items, err := s.dueItems(ctx, now)
+	if err != nil {
+		return result, err
+	}
+
+	sent := map[string][]DocumentExpiry{} // 기관 ID → 안내한 항목
+	var organizations []string
+	for _, item := range items {
+		reminder := domain.NewExpiryReminder(item.organizationID, item.kind, item.subjectID, item.expiresAt, item.window, now)
+		claimed, err := s.reminderRepo.Claim(repository.WithOrganization(ctx, item.organizationID), reminder)
+		if err != nil {
+			return result, util.NewInternalError(err)
+		}
+		if !claimed {
+			continue
+		}
+
+		switch item.kind {
+		case domain.ExpiryKindLicense:
+			result.Licenses++
+		case domain.ExpiryKindInsurance:
+			result.Insurances++
+		case domain.ExpiryKindInspection:
+			result.Inspections++
+		}
+		location := organizationLocation(ctx, s.locations, item.organizationID)
+		if _, ok := sent[item.organizationID]; !ok {
+			organizations = append(organizations, item.organizationID)
+		}
+		sent[item.organizationID] = append(sent[item.organizationID], DocumentExpiry{Document: item.kind.DisplayName(), Holder: item.holder, ExpiresAt: item.expiresAt, Location: location})
+		s.notifyDrivers(ctx, item, now, location)
+	}
+
+	for _, organizationID := range organizations {
+		s.notifyAdmins(ctx, organizationID, sent[organizationID], now)
+	}
+	return result, nil
+}
+
+// dueItems - 가장 긴 구간 안에 만료되는 면허(휴직 포함 재직 기사)와 보험/정기검사(비활성 차량 제외)
+func (s *ExpiryReminderService) dueItems(ctx context.Context, now time.Time) ([]expiryItem, error) {
+	longest := s.days[len(s.days)-1]
+	var items []expiryItem
+
+	drivers, _, err := s.driverRepo.List(ctx, repository.DriverFilter{LicenseExpiringWithin: &longest})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	for _, driver := range drivers {
+		if driver.Status == domain.DriverStatusInactive {
+			continue
+		}
+		if window, ok := s.window(func(days int) bool { return driver.LicenseExpiresWithin(now, days) }); ok {
+			items = append(items, expiryItem{
+				kind:           domain.ExpiryKindLicense,
+				subjectID:      driver.ID,
+				organizationID: driver.OrganizationID,
+				holder:         driver.Name,
+				expiresAt:      driver.LicenseExpiry,
+				window:         window,
+			})
+		}
+	}
+
+	for _, filter := range []repository.VehicleFilter{{InsuranceExpiringWithin: &longest}, {InspectionExpiringWithin: &longest}} {
+		vehicles, _, err := s.vehicleRepo.List(ctx, filter)
+		if err != nil {
+			return nil, util.NewInternalError(err)
+		}
+		for _, vehicle := range vehicles {
+			if vehicle.Status == domain.VehicleStatusInactive {
+				continue
+			}
+			item := expiryItem{subjectID: vehicle.ID, organizationID: vehicle.OrganizationID, holder: vehicle.PlateNumber}
+			var ok bool
+			if filter.InsuranceExpiringWithin != nil {
+				item.kind, item.expiresAt = domain.ExpiryKindInsurance, *vehicle.InsuranceExpiry
+				item.window, ok = s.window(func(days int) bool { return vehicle.InsuranceExpiresWithin(now, days) })
+			} else {
+				item.kind, item.expiresAt = domain.ExpiryKindInspection, *vehicle.InspectionExpiry
+				item.window, ok = s.window(func(days int) bool { return vehicle.InspectionExpiresWithin(now, days) })
+			}
+			if ok {
+				items = append(items, item)
+			}
+		}
+	}
+	return items, nil
+}
+
+// window - 만료가 들어오는 가장 짧은 안내 구간 (어느 구간에도 들지 않으면 false)
+func (s *ExpiryReminderService) window(expiresWithin func(days int) bool) (int, bool) {
+	for _, days := range s.days {
+		if expiresWithin(days) {
+			return days, true
+		}
+	}
+	return 0, false
+}
+
+// notifyDrivers - 해당 기사에게 만료 안내 (면허는 본인, 보험/정기검사는 그 차량을 쓰는 활성 일정의 기본 기사, 실패는 로그만)
+func (s *ExpiryReminderService) notifyDrivers(ctx context.Context, item expiryItem, now time.Time, location *time.Location) {
+	if s.notifier == nil {
+		return
+	}
+	ctx = repository.WithOrganization(ctx, item.organizationID)
+
+	driverIDs := []string{item.subjectID}
+	if item.kind != domain.ExpiryKindLicense {
+		schedules, _, err := s.scheduleRepo.List(ctx, repository.ScheduleFilter{Status: domain.ScheduleStatusActive, VehicleID: item.subjectID})
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to list vehicle schedules", map[string]interface{}{"vehicle_id": item.subjectID, "error": err.Error()})
+			return
+		}
+		driverIDs = driverIDs[:0]
+		for _, schedule := range schedules {
+			if schedule.DefaultDriverID != "" && !slices.Contains(driverIDs, schedule.DefaultDriverID) {
+				driverIDs = append(driverIDs, schedule.DefaultDriverID)
+			}
+		}
+	}
+
+	notification := expiryNotification(item, now, location)
+	for _, driverID := range driverIDs {
+		driver, err := s.driverRepo.GetByID(ctx, driverID)
+		if err != nil {
+			if !errors.Is(err, repository.ErrNotFound) {
+				logger.FromContext(ctx).Warn("Failed to load driver for expiry reminder", map[string]interface{}{"driver_id": driverID, "error": err.Error()})
+			}
+			continue
+		}
+		recipient := Recipient{UserID: s.driverAccount(ctx, driverID), Phone: driver.Phone}
+		if _, err := s.notifier.Notify(ctx, recipient, notification); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify driver of expiry", map[string]interface{}{
+				"driver_id": driverID,
+				"kind":      item.kind,
+				"error":     err.Error(),
+			})
+		}
+	}
+}
+
+// driverAccount - 기사 앱 계정 ID (계정이 없거나 조회에 실패하면 빈 값 → 푸시 생략)
+func (s *ExpiryReminderService) driverAccount(ctx context.Context, driverID string) string {
+	users, _, err := s.userRepo.List(ctx, repository.UserFilter{
+		Role:        domain.RoleDriver,
+		Status:      domain.UserStatusActive,
+		ProfileID:   driverID,
+		ListOptions: repository.ListOptions{Limit: 1},
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to look up driver account", map[string]interface{}{"driver_id": driverID, "error": err.Error()})
+		return ""
+	}
+	if len(users) == 0 {
+		return ""
+	}
+	return users[0].ID
+}
+
+// notifyAdmins - 이번 실행에서 안내한 기관의 항목을 그 기관 관리자에게 메일 한 통으로
+// 메일 주소가 있는 관리자가 없거나 메일이 설정되지 않았으면 운영 알림 수신자에게 요약 (실패는 로그만)
+func (s *ExpiryReminderService) notifyAdmins(ctx context.Context, organizationID string, items []DocumentExpiry, now time.Time) {
+	ctx = repository.WithOrganization(ctx, organizationID)
+	operators := organizationOperators(ctx, s.operators, organizationID)
+
+	var addresses []string
+	for _, operator := range operators {
+		if operator.Email != "" {
+			addresses = append(addresses, operator.Email)
+		}
+	}
+	if s.emails != nil && len(addresses) > 0 {
+		err := s.emails.SendDocumentExpiry(ctx, addresses, items)
+		if err == nil {
+			return
+		}
+		if !errors.Is(err, ErrEmailDisabled) && !errors.Is(err, email.ErrNoRecipients) {
+			logger.FromContext(ctx).Warn("Failed to email document expiry", map[string]interface{}{"organization_id": organizationID, "count": len(items), "error": err.Error()})
+			return
+		}
+	}
+	if s.notifier == nil {
+		return
+	}
+
+	lines := make([]util.LocalizedText, len(items))
+	for i, item := range items {
+		lines[i] = util.Text("%s %s %s (%s)", item.Document, item.Holder, item.ExpiresAt.Format("2006-01-02"), util.Text(formatDaysLeft(item.ExpiresAt, now, item.Location)))
+	}
+	notification := newNotification(util.Text("서류 만료 예정 %d건", len(items)), lines...)
+	for _, operator := range operators {
+		if _, err := s.notifier.Notify(ctx, operator.Recipient(), notification); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify document expiry", map[string]interface{}{"organization_id": organizationID, "error": err.Error()})
+		}
+	}
+}
+
+// expiryNotification - 기사에게 보내는 만료 안내 (남은 일수는 기관 시간대 기준)
+func expiryNotification(item expiryItem, now time.Time, location *time.Location) *Notification {
+	document := util.Text("%s", item.kind.DisplayName())
+	body := "%s이(가) %s에 만료됩니다 (%s). 갱신한 면허증을 앱에서 다시 올려 주세요."
+	if item.kind != domain.ExpiryKindLicense {
+		document = util.Text("%s %s", item.holder, item.kind.DisplayName())
+		body = "%s이(가) %s에 만료됩니다 (%s). 만료되면 운행에 배정할 수 없으니 관리자에게 갱신을 요청해 주세요."
+	}
+	daysLeft := util.Text(formatDaysLeft(item.expiresAt, now, location))
+
+	notification := newNotification(util.Text("%s 만료 예정", document),
+		util.Text(body, document, item.expiresAt.Format("2006-01-02"), daysLeft))
+	notification.Data = map[string]string{
+		"type":       "document_expiry",
+		"kind":       string(item.kind),
+		"subject_id": item.subjectID,
+	}
+	return notification
+}
+
+// formatDaysLeft - 남은 기간 표기 (기관 시간대 날짜 기준, 예: "D-14", 당일 "D-day", 지났으면 "만료됨")
+func formatDaysLeft(expiresAt, now time.Time, location *time.Location) string {
+	days := int(truncateToDay(expiresAt.In(location)).Sub(truncateToDay(now.In(location))).Hours() / 24)
+	switch {
+	case days < 0:
+		return "만료됨"
+	case days == 0:
+		return "D-day"
+	}
+	return fmt.Sprintf("D-%d", days)
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 업로드 파일 공통 서비스 (형식/크기 검증, 저장소 저장, 메타데이터, 다운로드)
+// 🎯 실무 포인트: 용도(purpose)별 정책(허용 형식, 최대 크기, 업로드 역할)을 한 곳에서 관리 → 차량 서류, 면허증 스캔, 사고 사진이 같은 규칙으로 저장
+// 파일 형식은 내용으로 판별 (확장자/요청 Content-Type은 믿지 않음)
+// ⚠️ 주의사항: 업로드는 저장소 저장 → 레코드 생성 순서 (레코드 생성 실패 시 원본 삭제), 삭제는 레코드 먼저 (원본 삭제 실패는 로그만)
+// 리소스에 연결하지 않은 파일은 업로드한 사용자와 관리자만 조회 가능, 연결된 파일은 리소스 API가 권한 확인 후 Read로 읽음
+
+// FilePolicy - 용도별 업로드 정책
+type FilePolicy struct {
+	ContentTypes []string      // 허용 형식 (http.DetectContentType 결과)
+	MaxSize      int64         // 최대 크기 (바이트)
+	Roles        []domain.Role // 업로드할 수 있는 역할
+}
+
+// filePolicies - 용도별 업로드 정책
+var filePolicies = map[domain.FilePurpose]FilePolicy{
+	domain.FilePurposeVehicleDocument: {
+		ContentTypes: []string{"application/pdf", "image/jpeg", "image/png"},
+		MaxSize:      10 << 20,
+		Roles:        []domain.Role{domain.RoleAdmin},
+	},
+	domain.FilePurposeDriverLicense: {
+		ContentTypes: []string{"application/pdf", "image/jpeg", "image/png"},
+		MaxSize:      5 << 20,
+		Roles:        []domain.Role{domain.RoleAdmin, domain.RoleDriver},
+	},
+	domain.FilePurposeIncidentPhoto: {
+		ContentTypes: []string{"image/jpeg", "image/png"},
+		MaxSize:      10 << 20,
+		Roles:        []domain.Role{domain.RoleAdmin, domain.RoleDriver, domain.RoleAttendant},
+	},
+	domain.FilePurposePassengerPhoto: {
+		ContentTypes: []string{"image/jpeg", "image/png"},
+		MaxSize:      5 << 20,
+		Roles:        []domain.Role{domain.RoleAdmin, domain.RoleGuardian},
+	},
+}
+
+// MaxUploadSize - 모든 용도 중 가장 큰 최대 크기 (핸들러가 요청 본문을 읽기 전에 확인)
+const MaxUploadSize = 10 << 20
+
+// FileService - 업로드 파일 서비스
+type FileService struct {
+	fileRepo repository.FileRepository
+	storage  storage.Storage
+}
+
+// NewFileService - 업로드 파일 서비스 생성
+func NewFileService(fileRepo repository.FileRepository, storage storage.Storage) *FileService {
+	return &FileService{fileRepo: fileRepo, storage: storage}
+}
+
+// Upload - 용도 정책 확인 후 원본 저장 + 메타데이터 생성 (업로드한 사용자는 인증 주체)
+func (s *FileService) Upload(ctx context.Context, purpose domain.FilePurpose, fileName string, data []byte) (*domain.File, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, util.NewUnauthorizedError()
+	}
+	policy, ok := filePolicies[purpose]
+	if !ok {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"purpose": util.Text("지원하지 않는 용도입니다: %s", purpose),
+		})
+	}
+	if !principal.HasRole(policy.Roles...) {
+		return nil, util.NewForbiddenError()
+	}
+
+	contentType := http.DetectContentType(data)
+	switch {
+	case len(data) == 0:
+		return nil, fileError("빈 파일은 올릴 수 없습니다")
+	case int64(len(data)) > policy.MaxSize:
+		return nil, fileError("파일은 최대 %dMB까지 올릴 수 있습니다", policy.MaxSize>>20)
+	case !slices.Contains(policy.ContentTypes, contentType):
+		return nil, fileError("올릴 수 있는 파일 형식: %s", fileTypeNames(policy.ContentTypes))
+	}
+
+	file := domain.NewFile(purpose, fileName, contentType, int64(len(data)), principal.UserID)
+	if err := s.storage.Put(ctx, file.StorageKey, data, contentType); err != nil {
+		return nil, util.NewExternalServiceError("파일 저장소", err)
+	}
+	if err := s.fileRepo.Create(ctx, file); err != nil {
+		s.removeObject(ctx, file)
+		return nil, util.NewInternalError(err)
+	}
+	return file, nil
+}
+
+// Get - 파일 메타데이터 (업로드한 사용자 또는 관리자)
+func (s *FileService) Get(ctx context.Context, id string) (*domain.File, error) {
+	file, err := s.fileRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "파일")
+	}
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, util.NewUnauthorizedError()
+	}
+	if !principal.IsAdmin() && principal.UserID != file.UploadedBy {
+		return nil, util.NewForbiddenError()
+	}
+	return file, nil
+}
+
+// Download - 파일 메타데이터와 원본 (업로드한 사용자 또는 관리자)
+func (s *FileService) Download(ctx context.Context, id string) (*domain.File, []byte, error) {
+	file, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := s.content(ctx, file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, data, nil
+}
+
+// Read - 리소스에 연결된 파일 원본 (권한은 호출하는 리소스 서비스가 확인)
+func (s *FileService) Read(ctx context.Context, id string) (*domain.File, []byte, error) {
+	file, err := s.fileRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, toAppError(err, "파일")
+	}
+	data, err := s.content(ctx, file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, data, nil
+}
+
+// Delete - 파일 삭제 (레코드 먼저, 원본 삭제 실패는 로그만 → 레코드가 없어 노출되지 않음)
+func (s *FileService) Delete(ctx context.Context, id string) error {
+	file, err := s.fileRepo.GetByID(ctx, id)
+	if err != nil {
+		return toAppError(err, "파일")
+	}
+	if err := s.fileRepo.Delete(ctx, id); err != nil {
+		return toAppError(err, "파일")
+	}
+	s.removeObject(ctx, file)
+	return nil
+}
+
+// content - 저장소에서 원본 읽기
+func (s *FileService) content(ctx context.Context, file *domain.File) ([]byte, error) {
+	data, err := s.storage.Get(ctx, file.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, util.NewNotFoundError("파일")
+	}
+	if err != nil {
+		return nil, util.NewExternalServiceError("파일 저장소", err)
+	}
+	return data, nil
+}
+
+// removeObject - 저장소 원본 삭제 (실패는 로그만)
+func (s *FileService) removeObject(ctx context.Context, file *domain.File) {
+	if err := s.storage.Delete(ctx, file.StorageKey); err != nil {
+		logger.FromContext(ctx).Warn("Failed to delete stored file", map[string]interface{}{
+			"file_id":     file.ID,
+			"storage_key": file.StorageKey,
+			"error":       err.Error(),
+		})
+	}
+}
+
+// fileTypeNames - 안내 문구용 형식 이름 (예: "PDF, JPEG, PNG")
+func fileTypeNames(contentTypes []string) string {
+	names := map[string]string{"application/pdf": "PDF", "image/jpeg": "JPEG", "image/png": "PNG"}
+	result := ""
+	for i, contentType := range contentTypes {
+		if i > 0 {
+			result += ", "
+		}
+		result += names[contentType]
+	}
+	return result
+}
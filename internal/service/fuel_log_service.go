@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 차량 주유 기록과 연비 보고서
+// 🎯 실무 포인트: 연비 = 직전 주유 이후 주행 거리(주행거리계 차이) ÷ 이번 주유량 → 기간 첫 주유도 기간 이전 마지막 기록과 비교
+// 연비가 차량 평균보다 크게 낮은 주유, 주행 없이 다시 주유한 기록은 flags로 표시 (유류카드 부정 사용, 입력 오류 확인용)
+// ⚠️ 주의사항: 주행거리계는 주유 시각 순으로 줄어들 수 없음 (앞뒤 기록과 비교해 거부), 기간/월 경계는 기관 시간대
+
+// lowEconomyRatio - 차량 평균 연비 대비 이 비율 미만인 주유는 확인 필요 (low_economy)
+const lowEconomyRatio = 0.7
+
+// FuelLogService - 주유 기록 서비스
+type FuelLogService struct {
+	vehicleRepo repository.VehicleRepository
+	fuelRepo    repository.FuelLogRepository
+	locations   TimezoneResolver
+}
+
+// NewFuelLogService - 주유 기록 서비스 생성 (locations가 nil이면 한국 시간)
+func NewFuelLogService(
+	vehicleRepo repository.VehicleRepository,
+	fuelRepo repository.FuelLogRepository,
+	locations TimezoneResolver,
+) *FuelLogService {
+	return &FuelLogService{
+		vehicleRepo: vehicleRepo,
+		fuelRepo:    fuelRepo,
+		locations:   locations,
+	}
+}
+
+// Add - 주유 기록 등록 (주유 시각 생략 시 지금, 주행거리계는 앞뒤 기록 사이여야 함)
+func (s *FuelLogService) Add(ctx context.Context, vehicleID string, req *dto.CreateFuelLogRequest) (*domain.FuelLog, error) {
+	if _, err := s.vehicleRepo.GetByID(ctx, vehicleID); err != nil {
+		return nil, toAppError(err, "차량")
+	}
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, util.NewUnauthorizedError()
+	}
+
+	filledAt := time.Now()
+	if req.FilledAt != nil {
+		if req.FilledAt.After(filledAt) {
+			return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"filled_at": "주유 시각은 현재 이후일 수 없습니다",
+			})
+		}
+		filledAt = *req.FilledAt
+	}
+	if err := s.checkOdometer(ctx, vehicleID, filledAt, req.OdometerKm); err != nil {
+		return nil, err
+	}
+
+	log := domain.NewFuelLog(vehicleID, filledAt, req.Liters, req.Cost, req.OdometerKm, req.Station, principal.UserID)
+	if err := s.fuelRepo.Create(ctx, log); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return log, nil
+}
+
+// List - 차량의 주유 기록 (주유 시각 순, 기간을 생략하면 전체)
+func (s *FuelLogService) List(ctx context.Context, vehicleID string, query *dto.FuelLogQuery) ([]*domain.FuelLog, error) {
+	if _, err := s.vehicleRepo.GetByID(ctx, vehicleID); err != nil {
+		return nil, toAppError(err, "차량")
+	}
+
+	location := s.location(ctx)
+	filter := repository.FuelLogFilter{VehicleID: vehicleID}
+	if query.From != "" {
+		from, _ := time.ParseInLocation(time.DateOnly, query.From, location) // 바인딩에서 형식 검증 완료
+		filter.From = &from
+	}
+	if query.To != "" {
+		to, _ := time.ParseInLocation(time.DateOnly, query.To, location)
+		end := to.AddDate(0, 0, 1)
+		filter.To = &end
+	}
+
+	logs, err := s.fuelRepo.List(ctx, filter)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return logs, nil
+}
+
+// Delete - 주유 기록 삭제
+func (s *FuelLogService) Delete(ctx context.Context, vehicleID, id string) error {
+	if err := s.fuelRepo.Delete(ctx, vehicleID, id); err != nil {
+		return toAppError(err, "주유 기록")
+	}
+	return nil
+}
+
+// Report - 기간(from~to, 포함) 차량별 연비, 월별 추이, 확인이 필요한 주유 기록
+// 기간에 주유 기록이 있는 차량만 (삭제된 차량 포함)
+func (s *FuelLogService) Report(ctx context.Context, from, to time.Time) (*dto.FuelEconomyReportResponse, error) {
+	if err := validateReportPeriod(from, to); err != nil {
+		return nil, err
+	}
+	vehicles, err := listByID(ctx, s.vehicleRepo.List, repository.VehicleFilter{IncludeDeleted: true}, func(v *domain.Vehicle) string { return v.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	location := s.location(ctx)
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, location).AddDate(0, 0, 1)
+	logs, err := s.fuelRepo.List(ctx, repository.FuelLogFilter{From: &start, To: &end})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	byVehicle := make(map[string][]*domain.FuelLog)
+	for _, log := range logs {
+		byVehicle[log.VehicleID] = append(byVehicle[log.VehicleID], log)
+	}
+
+	var fleet fuelTotals
+	response := &dto.FuelEconomyReportResponse{
+		From:     from.Format(time.DateOnly),
+		To:       to.Format(time.DateOnly),
+		Vehicles: make([]dto.FuelEconomyVehicleEntry, 0, len(byVehicle)),
+	}
+	for vehicleID, vehicleLogs := range byVehicle {
+		previous, err := s.fuelRepo.LatestBefore(ctx, vehicleID, start)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return nil, util.NewInternalError(err)
+		}
+
+		entry := fuelEconomyEntry(vehicleLogs, previous, location)
+		entry.VehicleID = vehicleID
+		entry.PlateNumber = lookupName(vehicles, vehicleID, func(v *domain.Vehicle) string { return v.PlateNumber })
+		for _, log := range vehicleLogs {
+			fleet.add(log, previous)
+			previous = log
+		}
+		response.Vehicles = append(response.Vehicles, entry)
+	}
+
+	response.Fleet = fleet.summary()
+	for i := range response.Vehicles {
+		if response.Fleet.KmPerLiter > 0 {
+			response.Vehicles[i].FleetRatio = math.Round(response.Vehicles[i].KmPerLiter/response.Fleet.KmPerLiter*1000) / 10
+		}
+	}
+	sort.Slice(response.Vehicles, func(i, j int) bool {
+		a, b := response.Vehicles[i], response.Vehicles[j]
+		if (a.KmPerLiter > 0) != (b.KmPerLiter > 0) {
+			return a.KmPerLiter > 0
+		}
+		if a.KmPerLiter != b.KmPerLiter {
+			return a.KmPerLiter < b.KmPerLiter
+		}
+		return a.PlateNumber < b.PlateNumber
+	})
+	return response, nil
+}
+
+// checkOdometer - 주행거리계가 직전 기록 이상, 다음 기록 이하인지 확인
+func (s *FuelLogService) checkOdometer(ctx context.Context, vehicleID string, filledAt time.Time, odometerKm int) error {
+	previous, err := s.fuelRepo.LatestBefore(ctx, vehicleID, filledAt)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return util.NewInternalError(err)
+	}
+	if previous != nil && odometerKm < previous.OdometerKm {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"odometer_km": util.Text("주행거리계는 직전 주유 기록(%d km)보다 작을 수 없습니다", previous.OdometerKm),
+		})
+	}
+
+	next, err := s.fuelRepo.EarliestAfter(ctx, vehicleID, filledAt)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return util.NewInternalError(err)
+	}
+	if next != nil && odometerKm > next.OdometerKm {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"odometer_km": util.Text("주행거리계는 다음 주유 기록(%d km)보다 클 수 없습니다", next.OdometerKm),
+		})
+	}
+	return nil
+}
+
+// location - 요청 기관의 시간대
+func (s *FuelLogService) location(ctx context.Context) *time.Location {
+	return organizationLocation(ctx, s.locations, repository.OrganizationFromContext(ctx))
+}
+
+// fuelEconomyEntry - 차량 한 대의 기간 합계, 월별 추이, 확인이 필요한 주유 (logs는 주유 시각 순, previous는 기간 직전 기록)
+func fuelEconomyEntry(logs []*domain.FuelLog, previous *domain.FuelLog, location *time.Location) dto.FuelEconomyVehicleEntry {
+	var total fuelTotals
+	months := make(map[string]*fuelTotals)
+	var order []string
+	last := previous
+	for _, log := range logs {
+		total.add(log, last)
+		month := log.FilledAt.In(location).Format("2006-01")
+		if months[month] == nil {
+			months[month] = &fuelTotals{}
+			order = append(order, month)
+		}
+		months[month].add(log, last)
+		last = log
+	}
+
+	entry := dto.FuelEconomyVehicleEntry{
+		FuelEconomySummary: total.summary(),
+		Monthly:            make([]dto.FuelEconomyMonth, 0, len(order)),
+		Flags:              []dto.FuelLogFlag{},
+	}
+	for _, month := range order {
+		entry.Monthly = append(entry.Monthly, dto.FuelEconomyMonth{Month: month, FuelEconomySummary: months[month].summary()})
+	}
+
+	average := total.kmPerLiter()
+	last = previous
+	for _, log := range logs {
+		if last != nil {
+			kmPerLiter := log.KmPerLiter(last)
+			reason := ""
+			switch {
+			case log.DistanceSince(last) == 0:
+				reason = "no_distance"
+			case average > 0 && kmPerLiter < average*lowEconomyRatio:
+				reason = "low_economy"
+			}
+			if reason != "" {
+				entry.Flags = append(entry.Flags, dto.FuelLogFlag{
+					FuelLogID:  log.ID,
+					FilledAt:   log.FilledAt,
+					Reason:     reason,
+					KmPerLiter: math.Round(kmPerLiter*10) / 10,
+				})
+			}
+		}
+		last = log
+	}
+	return entry
+}
+
+// fuelTotals - 주유 합계 (연비는 직전 기록이 있는 주유만)
+type fuelTotals struct {
+	fillUps       int
+	liters        float64
+	cost          int
+	distance      int
+	economyLiters float64 // 연비 계산에 쓰는 주유량 (직전 기록이 있는 주유)
+	economyCost   int
+}
+
+// add - 주유 한 건 추가 (previous가 nil이면 주행 거리/연비에서 제외)
+func (t *fuelTotals) add(log, previous *domain.FuelLog) {
+	t.fillUps++
+	t.liters += log.Liters
+	t.cost += log.Cost
+	if previous != nil {
+		t.distance += log.DistanceSince(previous)
+		t.economyLiters += log.Liters
+		t.economyCost += log.Cost
+	}
+}
+
+// kmPerLiter - 연비 (계산할 수 없으면 0)
+func (t *fuelTotals) kmPerLiter() float64 {
+	if t.economyLiters <= 0 {
+		return 0
+	}
+	return float64(t.distance) / t.economyLiters
+}
+
+// summary - 응답용 합계 (연비/km당 연료비는 소수 첫째 자리)
+func (t *fuelTotals) summary() dto.FuelEconomySummary {
+	summary := dto.FuelEconomySummary{
+		FillUps:    t.fillUps,
+		Liters:     math.Round(t.liters*100) / 100,
+		Cost:       t.cost,
+		DistanceKm: t.distance,
+		KmPerLiter: math.Round(t.kmPerLiter()*10) / 10,
+	}
+	if t.distance > 0 {
+		summary.CostPerKm = math.Round(float64(t.economyCost)/float64(t.distance)*10) / 10
+	}
+	return summary
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 보호자 비즈니스 로직
+// 🎯 실무 포인트: 자녀 연결/해제와 "내 자녀" 조회 (배정 경로/정류장 포함)
+// ⚠️ 주의사항: 삭제된 탑승자와의 연결은 자녀 목록에서 제외
+
+// GuardianService - 보호자 서비스
+type GuardianService struct {
+	guardianRepo  repository.GuardianRepository
+	passengerRepo repository.PassengerRepository
+	routeRepo     repository.RouteRepository
+}
+
+// NewGuardianService - 보호자 서비스 생성
+func NewGuardianService(
+	guardianRepo repository.GuardianRepository,
+	passengerRepo repository.PassengerRepository,
+	routeRepo repository.RouteRepository,
+) *GuardianService {
+	return &GuardianService{
+		guardianRepo:  guardianRepo,
+		passengerRepo: passengerRepo,
+		routeRepo:     routeRepo,
+	}
+}
+
+// Create - 보호자 등록
+func (s *GuardianService) Create(ctx context.Context, req *dto.CreateGuardianRequest) (*domain.Guardian, error) {
+	if err := s.ensurePhoneAvailable(ctx, req.Phone, ""); err != nil {
+		return nil, err
+	}
+
+	guardian := domain.NewGuardian(req.Name, req.Phone)
+	guardian.Email = req.Email
+
+	if err := s.guardianRepo.Create(ctx, guardian); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return guardian, nil
+}
+
+// Get - 보호자 단건 조회
+func (s *GuardianService) Get(ctx context.Context, id string) (*domain.Guardian, error) {
+	guardian, err := s.guardianRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "보호자")
+	}
+	return guardian, nil
+}
+
+// List - 보호자 목록 조회
+func (s *GuardianService) List(ctx context.Context, filter repository.GuardianFilter) ([]*domain.Guardian, int64, error) {
+	guardians, total, err := s.guardianRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return guardians, total, nil
+}
+
+// Update - 보호자 정보 수정
+func (s *GuardianService) Update(ctx context.Context, id string, req *dto.UpdateGuardianAccountRequest) (*domain.Guardian, error) {
+	guardian, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Phone != nil && *req.Phone != guardian.Phone {
+		if err := s.ensurePhoneAvailable(ctx, *req.Phone, guardian.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Name != nil {
+		guardian.Name = *req.Name
+	}
+	if req.Phone != nil || req.Email != nil {
+		guardian.UpdateContactInfo(deref(req.Phone), deref(req.Email))
+	}
+	if req.Status != nil {
+		if domain.GuardianStatus(*req.Status) == domain.GuardianStatusActive {
+			guardian.SetActive()
+		} else {
+			guardian.SetInactive()
+		}
+	}
+
+	if err := s.guardianRepo.Update(ctx, guardian); err != nil {
+		return nil, toAppError(err, "보호자")
+	}
+
+	return guardian, nil
+}
+
+// Delete - 보호자 삭제 (자녀 연결도 해제)
+func (s *GuardianService) Delete(ctx context.Context, id string) error {
+	if err := s.guardianRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "보호자")
+	}
+	return nil
+}
+
+// LinkPassenger - 자녀(탑승자) 연결
+func (s *GuardianService) LinkPassenger(ctx context.Context, guardianID string, req *dto.LinkPassengerRequest) (*domain.GuardianPassenger, error) {
+	if _, err := s.Get(ctx, guardianID); err != nil {
+		return nil, err
+	}
+	if _, err := s.passengerRepo.GetByID(ctx, req.PassengerID); err != nil {
+		return nil, toAppError(err, "탑승자")
+	}
+
+	_, err := s.guardianRepo.GetLink(ctx, guardianID, req.PassengerID)
+	if err == nil {
+		return nil, util.NewConflictError("이미 연결된 탑승자입니다")
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return nil, util.NewInternalError(err)
+	}
+
+	link := domain.NewGuardianPassenger(guardianID, req.PassengerID, req.Relation)
+	if err := s.guardianRepo.LinkPassenger(ctx, link); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return link, nil
+}
+
+// UnlinkPassenger - 자녀(탑승자) 연결 해제
+func (s *GuardianService) UnlinkPassenger(ctx context.Context, guardianID, passengerID string) error {
+	if _, err := s.Get(ctx, guardianID); err != nil {
+		return err
+	}
+	if err := s.guardianRepo.UnlinkPassenger(ctx, guardianID, passengerID); err != nil {
+		return toAppError(err, "연결된 탑승자")
+	}
+	return nil
+}
+
+// ListChildren - 보호자의 자녀 목록 (배정 경로/정류장 포함)
+func (s *GuardianService) ListChildren(ctx context.Context, guardianID string) ([]*dto.ChildResponse, error) {
+	if _, err := s.Get(ctx, guardianID); err != nil {
+		return nil, err
+	}
+
+	links, err := s.guardianRepo.ListLinks(ctx, guardianID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	children := make([]*dto.ChildResponse, 0, len(links))
+	routes := map[string]*domain.Route{} // 형제가 같은 경로인 경우 재조회 방지
+	for _, link := range links {
+		passenger, err := s.passengerRepo.GetByID(ctx, link.PassengerID)
+		if errors.Is(err, repository.ErrNotFound) {
+			continue // 삭제된 탑승자
+		}
+		if err != nil {
+			return nil, util.NewInternalError(err)
+		}
+
+		child := &dto.ChildResponse{Passenger: passenger, Relation: link.Relation}
+		if passenger.IsAssigned() {
+			route, err := s.cachedRoute(ctx, routes, passenger.AssignedRouteID)
+			if err != nil {
+				return nil, err
+			}
+			if route != nil {
+				child.Route = &dto.ChildRoute{ID: route.ID, Name: route.Name, Status: route.Status}
+				child.Stop = findStop(route, passenger.AssignedStopID)
+			}
+		}
+		children = append(children, child)
+	}
+
+	return children, nil
+}
+
+// cachedRoute - 경로 조회 (삭제된 경로는 nil)
+func (s *GuardianService) cachedRoute(ctx context.Context, cache map[string]*domain.Route, routeID string) (*domain.Route, error) {
+	if route, ok := cache[routeID]; ok {
+		return route, nil
+	}
+
+	route, err := s.routeRepo.GetByID(ctx, routeID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			return nil, util.NewInternalError(err)
+		}
+		route = nil
+	}
+
+	cache[routeID] = route
+	return route, nil
+}
+
+// ensurePhoneAvailable - 연락처 중복 확인
+// excludeID: 수정 시 자기 자신은 제외
+func (s *GuardianService) ensurePhoneAvailable(ctx context.Context, phone, excludeID string) error {
+	existing, err := s.guardianRepo.GetByPhone(ctx, phone)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return util.NewInternalError(err)
+	}
+
+	if existing.ID != excludeID {
+		return util.NewDuplicateError("보호자 연락처")
+	}
+	return nil
+}
+
+// findStop - 경로에서 정류장 찾기 (없으면 nil)
+func findStop(route *domain.Route, stopID string) *domain.Stop {
+	for i := range route.Stops {
+		if route.Stops[i].ID == stopID {
+			return &route.Stops[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package service
+
+// deref - 포인터 문자열 값 추출 (nil이면 빈 문자열)
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// emptyToNil - 빈 문자열은 nil (수정 요청에서 값 삭제)
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
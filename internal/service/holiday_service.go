@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/holiday"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 공휴일 달력 (운행 생성 시 공휴일 판단 + 특일 정보 API 동기화)
+// 🎯 실무 포인트: 동기화한 공휴일(DB)을 먼저 보고, 없으면 기본 공휴일 표(pkg/holiday)로 판단
+// → API 키가 없어도 설날/추석 등은 제외되고, 키가 있으면 임시공휴일과 표에 없는 연도까지 반영
+// ⚠️ 주의사항: 동기화는 추가/갱신만 (API 응답에서 빠진 날짜를 지우지 않음)
+
+// ErrHolidaySyncDisabled - 특일 정보 API 키가 설정되지 않음
+var ErrHolidaySyncDisabled = errors.New("holiday: fetcher not configured")
+
+// HolidayService - 공휴일 서비스
+type HolidayService struct {
+	holidayRepo repository.HolidayRepository
+	fetcher     holiday.Fetcher // nil이면 동기화하지 않음
+	locations   TimezoneResolver
+}
+
+// NewHolidayService - 공휴일 서비스 생성 (fetcher가 nil이면 기본 공휴일 표와 저장된 공휴일만 사용, locations가 nil이면 한국 시간 기준 올해)
+func NewHolidayService(holidayRepo repository.HolidayRepository, fetcher holiday.Fetcher, locations TimezoneResolver) *HolidayService {
+	return &HolidayService{holidayRepo: holidayRepo, fetcher: fetcher, locations: locations}
+}
+
+// IsHoliday - 날짜가 공휴일인지 확인 (공휴일이면 이름 반환)
+func (s *HolidayService) IsHoliday(ctx context.Context, date time.Time) (string, bool, error) {
+	stored, err := s.holidayRepo.Get(ctx, date)
+	if err == nil {
+		return stored.Name, true, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return "", false, util.NewInternalError(err)
+	}
+	name, ok := holiday.Lookup(date)
+	return name, ok, nil
+}
+
+// List - 연도의 공휴일 목록 (기본 공휴일 표 + 동기화한 공휴일, 같은 날짜면 동기화한 쪽 우선, 날짜 순, 0이면 요청 기관 시간대 기준 올해)
+func (s *HolidayService) List(ctx context.Context, year int) ([]*domain.Holiday, error) {
+	if year == 0 {
+		year = organizationToday(ctx, s.locations, repository.OrganizationFromContext(ctx)).Year()
+	}
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	stored, err := s.holidayRepo.ListRange(ctx, from, from.AddDate(1, 0, -1))
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	byDate := make(map[string]*domain.Holiday, len(stored))
+	for _, h := range holiday.Static(year) {
+		byDate[h.Date.Format(time.DateOnly)] = domain.NewHoliday(h.Date, h.Name, domain.HolidaySourceStatic)
+	}
+	for _, h := range stored {
+		byDate[h.Date.Format(time.DateOnly)] = h
+	}
+
+	holidays := make([]*domain.Holiday, 0, len(byDate))
+	for _, h := range byDate {
+		holidays = append(holidays, h)
+	}
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays, nil
+}
+
+// Sync - 특일 정보 API에서 연도의 공휴일을 받아 저장 (저장한 공휴일 수 반환)
+func (s *HolidayService) Sync(ctx context.Context, year int) (int, error) {
+	if s.fetcher == nil {
+		return 0, ErrHolidaySyncDisabled
+	}
+	fetched, err := s.fetcher.Fetch(ctx, year)
+	if err != nil {
+		return 0, err
+	}
+
+	holidays := make([]*domain.Holiday, 0, len(fetched))
+	for _, h := range fetched {
+		holidays = append(holidays, domain.NewHoliday(h.Date, h.Name, domain.HolidaySourceAPI))
+	}
+	if err := s.holidayRepo.Upsert(ctx, holidays); err != nil {
+		return 0, err
+	}
+	logger.FromContext(ctx).Info("Holidays synced", map[string]interface{}{"year": year, "count": len(holidays)})
+	return len(holidays), nil
+}
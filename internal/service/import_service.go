@@ -0,0 +1,614 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/internal/validation"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/tabular"
+)
+
+// 📝 설명: 파일 가져오기 (CSV/Excel로 탑승자, 정류장 일괄 등록)
+// 🎯 실무 포인트: 학기 초 수십~수백 명을 한 번에 등록 → 행마다 검증하고 실패한 행은 행 번호와 사유를 보고
+// 검증을 통과한 행만 한 트랜잭션으로 저장 (저장 중 오류면 전부 롤백), dry_run이면 검증 결과만 반환
+// 머리글(1행)의 열 이름으로 항목을 찾음 (한글 이름/API 필드명 모두 인정, 공백/밑줄/대소문자 무시, 모르는 열은 무시)
+// 경로/정류장은 이름 또는 ID로 지정, 탑승자 배정은 차량 승객 정원을 파일 안에서 누적해 확인
+// ⚠️ 주의사항: 같은 파일 안의 중복(탑승자: 이름+보호자 연락처, 정류장: 경로+이름)과 이미 등록된 항목은 실패 처리
+// 정류장 좌표가 없으면 주소 검색 (행마다 외부 API 호출)
+
+// maxImportRows - 한 파일에서 가져올 수 있는 최대 데이터 행 수
+const maxImportRows = 1000
+
+// fileField - 파일 전체 오류의 details 키
+const fileField = "file"
+
+// importColumn - 가져오기 파일의 열 정의
+type importColumn struct {
+	field    string   // 행 오류 키 (API 필드명, 머리글로도 인정)
+	labels   []string // 머리글로 인정하는 이름 (첫 번째가 안내용 대표 이름)
+	required bool     // 머리글에 반드시 있어야 하는 열
+}
+
+// passengerColumns - 탑승자 가져오기 열
+var passengerColumns = []importColumn{
+	{field: "name", labels: []string{"이름", "아이 이름", "원아 이름", "탑승자 이름"}, required: true},
+	{field: "age", labels: []string{"나이"}},
+	{field: "gender", labels: []string{"성별"}},
+	{field: "guardian_name", labels: []string{"보호자 이름", "보호자명", "보호자"}, required: true},
+	{field: "guardian_phone", labels: []string{"보호자 연락처", "보호자 전화번호", "보호자 휴대폰"}, required: true},
+	{field: "guardian_email", labels: []string{"보호자 이메일"}},
+	{field: "guardian_relation", labels: []string{"보호자 관계", "관계"}},
+	{field: "emergency_contact", labels: []string{"비상 연락처"}},
+	{field: "emergency_relation", labels: []string{"비상 연락처 관계"}},
+	{field: "address", labels: []string{"주소"}},
+	{field: "medical_notes", labels: []string{"의료 메모", "특이사항"}},
+	{field: "notes", labels: []string{"메모", "비고"}},
+	{field: "route", labels: []string{"경로", "노선", "route_id"}},
+	{field: "stop", labels: []string{"정류장", "stop_id"}},
+}
+
+// stopColumns - 정류장 가져오기 열
+var stopColumns = []importColumn{
+	{field: "route", labels: []string{"경로", "노선", "route_id"}, required: true},
+	{field: "name", labels: []string{"정류장 이름", "정류장명", "정류장", "이름"}, required: true},
+	{field: "address", labels: []string{"주소"}, required: true},
+	{field: "order", labels: []string{"순서"}},
+	{field: "latitude", labels: []string{"위도"}},
+	{field: "longitude", labels: []string{"경도"}},
+	{field: "estimated_arrival_time", labels: []string{"도착 예정(분)", "도착 예정", "소요 시간(분)"}},
+	{field: "notes", labels: []string{"메모", "비고"}},
+}
+
+// genderAliases - 한글 성별 표기 → API 값
+var genderAliases = map[string]string{
+	"남": "male", "남자": "male", "남아": "male",
+	"여": "female", "여자": "female", "여아": "female",
+	"기타": "other",
+}
+
+// ImportService - 파일 가져오기 서비스
+type ImportService struct {
+	passengerRepo repository.PassengerRepository
+	routeRepo     repository.RouteRepository
+	scheduleRepo  repository.ScheduleRepository
+	vehicleRepo   repository.VehicleRepository
+	routeService  *RouteService
+	txManager     database.TxManager
+	validate      *validator.Validate
+}
+
+// NewImportService - 파일 가져오기 서비스 생성
+func NewImportService(
+	passengerRepo repository.PassengerRepository,
+	routeRepo repository.RouteRepository,
+	scheduleRepo repository.ScheduleRepository,
+	vehicleRepo repository.VehicleRepository,
+	routeService *RouteService,
+	txManager database.TxManager,
+) *ImportService {
+	return &ImportService{
+		passengerRepo: passengerRepo,
+		routeRepo:     routeRepo,
+		scheduleRepo:  scheduleRepo,
+		vehicleRepo:   vehicleRepo,
+		routeService:  routeService,
+		txManager:     txManager,
+		validate:      validation.New(),
+	}
+}
+
+// ImportPassengers - 탑승자 일괄 등록 (경로/정류장 열이 있으면 배정까지)
+func (s *ImportService) ImportPassengers(ctx context.Context, filename string, data []byte, dryRun bool) (*dto.ImportResponse, error) {
+	rows, header, err := readImportFile(filename, data, passengerColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.ImportResponse{Total: len(rows), DryRun: dryRun, Errors: []dto.ImportRowError{}}
+	routes := newRouteLookup(s.routeRepo)
+	assigned := make(map[string]int) // 경로 ID → 이 파일에서 배정할 인원
+	seen := make(map[string]int)     // 이름+보호자 연락처 → 처음 나온 행 번호
+	var passengers []*domain.Passenger
+
+	for _, row := range rows {
+		passenger, details, err := s.passengerFromRow(ctx, row, header, routes, assigned, seen)
+		if err != nil {
+			return nil, err
+		}
+		if len(details) > 0 {
+			result.Errors = append(result.Errors, dto.ImportRowError{Row: row.Number, Errors: util.LocalizeDetails(util.LocaleFromContext(ctx), details)})
+			continue
+		}
+		passengers = append(passengers, passenger)
+	}
+
+	if !dryRun && len(passengers) > 0 {
+		err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			for _, passenger := range passengers {
+				if err := s.passengerRepo.Create(ctx, passenger); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, util.NewInternalError(err)
+		}
+	}
+
+	result.Imported = len(passengers)
+	result.Failed = len(result.Errors)
+	return result, nil
+}
+
+// ImportStops - 정류장 일괄 등록 (순서 생략 시 경로 마지막에 추가, 파일 순서대로 삽입)
+func (s *ImportService) ImportStops(ctx context.Context, filename string, data []byte, dryRun bool) (*dto.ImportResponse, error) {
+	rows, header, err := readImportFile(filename, data, stopColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.ImportResponse{Total: len(rows), DryRun: dryRun, Errors: []dto.ImportRowError{}}
+	routes := newRouteLookup(s.routeRepo)
+	added := make(map[string]int) // 경로 ID → 이 파일에서 추가할 정류장 수
+	seen := make(map[string]int)  // 경로 ID+정류장 이름 → 처음 나온 행 번호
+	var stops []*domain.Stop
+
+	for _, row := range rows {
+		stop, details, err := s.stopFromRow(ctx, row, header, routes, added, seen)
+		if err != nil {
+			return nil, err
+		}
+		if len(details) > 0 {
+			result.Errors = append(result.Errors, dto.ImportRowError{Row: row.Number, Errors: util.LocalizeDetails(util.LocaleFromContext(ctx), details)})
+			continue
+		}
+		stops = append(stops, stop)
+	}
+
+	if !dryRun && len(stops) > 0 {
+		err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			for _, stop := range stops {
+				if err := s.routeRepo.InsertStop(ctx, stop); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, util.NewInternalError(err)
+		}
+		for routeID := range added {
+			s.routeService.refreshDirections(ctx, routeID)
+		}
+	}
+
+	result.Imported = len(stops)
+	result.Failed = len(result.Errors)
+	return result, nil
+}
+
+// passengerFromRow - 행을 검증해 탑승자 생성 (검증 실패는 details, 조회 실패 등은 error)
+func (s *ImportService) passengerFromRow(ctx context.Context, row tabular.Row, header map[string]int,
+	routes *routeLookup, assigned, seen map[string]int) (*domain.Passenger, map[string]interface{}, error) {
+	cell := func(field string) string { return cellOf(row, header, field) }
+	details := make(map[string]interface{})
+
+	req := dto.CreatePassengerRequest{
+		Name:              cell("name"),
+		Gender:            importGender(cell("gender")),
+		GuardianName:      cell("guardian_name"),
+		GuardianPhone:     importPhone(cell("guardian_phone")),
+		GuardianEmail:     cell("guardian_email"),
+		GuardianRelation:  cell("guardian_relation"),
+		EmergencyContact:  importPhone(cell("emergency_contact")),
+		EmergencyRelation: cell("emergency_relation"),
+		Address:           cell("address"),
+		MedicalNotes:      cell("medical_notes"),
+		Notes:             cell("notes"),
+	}
+	parseInt(cell("age"), &req.Age, "age", details)
+	s.validateRow(&req, details)
+	if len(details) > 0 {
+		return nil, details, nil
+	}
+
+	key := strings.ToLower(req.Name) + "|" + phoneDigits(req.GuardianPhone)
+	if first, ok := seen[key]; ok {
+		details["name"] = util.Text("파일 %d행과 이름, 보호자 연락처가 같습니다", first)
+		return nil, details, nil
+	}
+	seen[key] = row.Number
+
+	exists, err := s.passengerExists(ctx, req.Name, req.GuardianPhone)
+	if err != nil {
+		return nil, nil, err
+	}
+	if exists {
+		details["name"] = "이름과 보호자 연락처가 같은 탑승자가 이미 등록되어 있습니다"
+		return nil, details, nil
+	}
+
+	passenger := newPassengerFromRequest(&req)
+
+	routeValue, stopValue := cell("route"), cell("stop")
+	if routeValue == "" && stopValue == "" {
+		return passenger, nil, nil
+	}
+	if routeValue == "" || stopValue == "" {
+		details["route"] = "경로와 정류장은 함께 입력해야 합니다"
+		return nil, details, nil
+	}
+
+	route, reason, err := routes.find(ctx, routeValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	if reason == "" && !route.IsActive() {
+		reason = "비활성 상태의 경로입니다"
+	}
+	if reason != "" {
+		details["route"] = reason
+		return nil, details, nil
+	}
+	stop, reason := matchStop(route, stopValue)
+	if reason != "" {
+		details["stop"] = reason
+		return nil, details, nil
+	}
+
+	err = checkRouteCapacity(ctx, s.passengerRepo, s.scheduleRepo, s.vehicleRepo, route.ID, assigned[route.ID]+1)
+	var appErr *util.AppError
+	if errors.As(err, &appErr) && appErr.Code == util.ErrCodeConflict {
+		details["route"] = appErr.Message
+		return nil, details, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	assigned[route.ID]++
+
+	passenger.AssignToStop(route.ID, stop.ID, stop.Order)
+	return passenger, nil, nil
+}
+
+// stopFromRow - 행을 검증해 정류장 생성 (좌표가 없으면 주소 검색)
+func (s *ImportService) stopFromRow(ctx context.Context, row tabular.Row, header map[string]int,
+	routes *routeLookup, added, seen map[string]int) (*domain.Stop, map[string]interface{}, error) {
+	cell := func(field string) string { return cellOf(row, header, field) }
+	details := make(map[string]interface{})
+
+	req := dto.CreateStopRequest{
+		Name:    cell("name"),
+		Address: cell("address"),
+		Notes:   cell("notes"),
+	}
+	parseInt(cell("order"), &req.Order, "order", details)
+	parseInt(cell("estimated_arrival_time"), &req.EstimatedArrivalTime, "estimated_arrival_time", details)
+	req.Latitude = parseFloat(cell("latitude"), "latitude", details)
+	req.Longitude = parseFloat(cell("longitude"), "longitude", details)
+	s.validateRow(&req, details)
+
+	route, reason, err := routes.find(ctx, cell("route"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if reason != "" {
+		details["route"] = reason
+	}
+	if len(details) > 0 {
+		return nil, details, nil
+	}
+
+	key := route.ID + "|" + strings.ToLower(req.Name)
+	if first, ok := seen[key]; ok {
+		details["name"] = util.Text("파일 %d행과 경로, 정류장 이름이 같습니다", first)
+		return nil, details, nil
+	}
+	seen[key] = row.Number
+	for _, existing := range route.Stops {
+		if strings.EqualFold(existing.Name, req.Name) {
+			details["name"] = "경로에 같은 이름의 정류장이 이미 있습니다"
+			return nil, details, nil
+		}
+	}
+
+	count := len(route.Stops) + added[route.ID]
+	order := req.Order
+	if order == 0 {
+		order = count + 1
+	}
+	if err := validateStopOrder(order, count+1); err != nil {
+		return nil, rowDetails(err), nil
+	}
+
+	stop, err := s.routeService.newStopFromRequest(ctx, route.ID, &req, "")
+	if err != nil {
+		if details := rowDetails(err); details != nil {
+			return nil, details, nil
+		}
+		return nil, nil, err
+	}
+	stop.Order = order
+	added[route.ID]++
+
+	return stop, nil, nil
+}
+
+// validateRow - binding 태그로 행 검증 (실패한 필드는 details에 추가, 이미 오류가 있는 필드는 유지)
+func (s *ImportService) validateRow(req interface{}, details map[string]interface{}) {
+	if err := s.validate.Struct(req); err != nil {
+		for field, message := range validation.FieldErrors(err) {
+			if _, ok := details[field]; !ok {
+				details[field] = message
+			}
+		}
+	}
+}
+
+// passengerExists - 이름과 보호자 연락처가 같은 탑승자가 이미 있는지
+func (s *ImportService) passengerExists(ctx context.Context, name, guardianPhone string) (bool, error) {
+	candidates, err := s.passengerRepo.Search(ctx, guardianPhone, defaultSearchLimit)
+	if err != nil {
+		return false, util.NewInternalError(err)
+	}
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate.Name, name) && phoneDigits(candidate.GuardianPhone) == phoneDigits(guardianPhone) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// readImportFile - 파일을 읽어 머리글(열 위치)과 데이터 행으로 나눔 (파일 자체 문제는 file 필드 VALIDATION_ERROR)
+func readImportFile(filename string, data []byte, columns []importColumn) ([]tabular.Row, map[string]int, error) {
+	rows, err := tabular.Read(filename, data)
+	if err != nil {
+		return nil, nil, fileError(importFileMessage(err))
+	}
+	if len(rows) < 2 {
+		return nil, nil, fileError("머리글과 데이터 행이 필요합니다")
+	}
+	if len(rows)-1 > maxImportRows {
+		return nil, nil, fileError("한 번에 최대 %d행까지 가져올 수 있습니다", maxImportRows)
+	}
+
+	header, err := mapHeader(rows[0], columns)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows[1:], header, nil
+}
+
+// importFileMessage - 파일 읽기 실패 사유
+func importFileMessage(err error) string {
+	switch {
+	case errors.Is(err, tabular.ErrUnsupportedFormat):
+		return "CSV(.csv) 또는 Excel(.xlsx) 파일만 가져올 수 있습니다"
+	case errors.Is(err, tabular.ErrNotUTF8):
+		return "CSV 파일이 UTF-8 인코딩이 아닙니다. 엑셀에서 'CSV UTF-8(쉼표로 분리)' 형식으로 저장해 주세요"
+	default:
+		return "파일을 읽을 수 없습니다. 형식이 올바른지 확인해 주세요"
+	}
+}
+
+// mapHeader - 머리글의 열 이름을 필드 → 열 위치로 변환 (필수 열 누락, 같은 항목 중복은 VALIDATION_ERROR)
+func mapHeader(header tabular.Row, columns []importColumn) (map[string]int, error) {
+	names := make(map[string]string) // 정규화한 머리글 이름 → 필드
+	for _, column := range columns {
+		names[headerKey(column.field)] = column.field
+		for _, label := range column.labels {
+			names[headerKey(label)] = column.field
+		}
+	}
+
+	positions := make(map[string]int)
+	for i, name := range header.Cells {
+		field, ok := names[headerKey(name)]
+		if !ok {
+			continue
+		}
+		if _, dup := positions[field]; dup {
+			return nil, fileError("같은 항목의 열이 여러 개입니다: %s", name)
+		}
+		positions[field] = i
+	}
+
+	var missing []string
+	for _, column := range columns {
+		if _, ok := positions[column.field]; column.required && !ok {
+			missing = append(missing, column.labels[0])
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fileError("필수 열이 없습니다: %s", strings.Join(missing, ", "))
+	}
+	return positions, nil
+}
+
+// headerKey - 머리글 비교용 이름 (공백/밑줄 제거, 소문자)
+func headerKey(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "_", "").Replace(name))
+}
+
+// fileError - 파일 전체 문제 VALIDATION_ERROR
+func fileError(message string, args ...interface{}) error {
+	return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{fileField: util.Text(message, args...)})
+}
+
+// cellOf - 필드 열의 값 (열이 없으면 빈 문자열)
+func cellOf(row tabular.Row, header map[string]int, field string) string {
+	i, ok := header[field]
+	if !ok {
+		return ""
+	}
+	return row.Cell(i)
+}
+
+// parseInt - 정수 칸 변환 (빈 칸은 0, 숫자가 아니면 details에 추가)
+func parseInt(value string, dest *int, field string, details map[string]interface{}) {
+	if value == "" {
+		return
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		details[field] = "정수여야 합니다"
+		return
+	}
+	*dest = n
+}
+
+// parseFloat - 실수 칸 변환 (빈 칸은 nil, 숫자가 아니면 details에 추가)
+func parseFloat(value, field string, details map[string]interface{}) *float64 {
+	if value == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		details[field] = "숫자여야 합니다"
+		return nil
+	}
+	return &f
+}
+
+// importGender - 한글 성별 표기를 API 값으로 (모르는 값은 그대로 두어 검증에서 실패)
+func importGender(value string) string {
+	if gender, ok := genderAliases[value]; ok {
+		return gender
+	}
+	return strings.ToLower(value)
+}
+
+// importPhone - 엑셀이 숫자로 저장하며 빠뜨린 휴대폰 번호 앞자리 0 복구 (예: "1012345678" → "01012345678")
+func importPhone(value string) string {
+	if len(value) == 10 && strings.HasPrefix(value, "1") && strings.Trim(value, "0123456789") == "" {
+		return "0" + value
+	}
+	return value
+}
+
+// phoneDigits - 연락처 비교용 숫자만 (+82는 0으로)
+func phoneDigits(phone string) string {
+	if strings.HasPrefix(phone, "+82") {
+		phone = "0" + phone[len("+82"):]
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+// rowDetails - 검증 실패 에러의 필드별 사유 (검증 실패가 아니면 nil)
+func rowDetails(err error) map[string]interface{} {
+	var appErr *util.AppError
+	if errors.As(err, &appErr) && appErr.Code == util.ErrCodeValidation {
+		return appErr.Details
+	}
+	return nil
+}
+
+// matchStop - 경로의 정류장을 ID 또는 이름으로 찾음 (못 찾으면 사유)
+func matchStop(route *domain.Route, value string) (*domain.Stop, string) {
+	var matched []*domain.Stop
+	for i := range route.Stops {
+		stop := &route.Stops[i]
+		if stop.ID == value {
+			return stop, ""
+		}
+		if strings.EqualFold(stop.Name, value) {
+			matched = append(matched, stop)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return nil, "해당 경로에 속한 정류장이 아닙니다"
+	case 1:
+		return matched[0], ""
+	default:
+		return nil, "같은 이름의 정류장이 여러 개입니다. 정류장 ID를 입력해 주세요"
+	}
+}
+
+// routeLookup - 가져오기 중 경로를 ID 또는 이름으로 찾음 (한 번 읽은 경로는 재사용)
+type routeLookup struct {
+	routeRepo repository.RouteRepository
+	byID      map[string]*domain.Route
+	byName    map[string][]string // 소문자 이름 → 경로 ID (처음 이름으로 찾을 때 한 번 조회)
+}
+
+func newRouteLookup(routeRepo repository.RouteRepository) *routeLookup {
+	return &routeLookup{routeRepo: routeRepo, byID: make(map[string]*domain.Route)}
+}
+
+// find - 경로 조회 (정류장 포함, 못 찾으면 사유)
+func (l *routeLookup) find(ctx context.Context, value string) (*domain.Route, string, error) {
+	if value == "" {
+		return nil, "필수 입력 항목입니다", nil
+	}
+	if route, ok := l.byID[value]; ok {
+		return route, "", nil
+	}
+
+	if uuid.Validate(value) == nil {
+		route, err := l.load(ctx, value)
+		if err != nil || route != nil {
+			return route, "", err
+		}
+	}
+
+	if l.byName == nil {
+		routes, _, err := l.routeRepo.List(ctx, repository.RouteFilter{})
+		if err != nil {
+			return nil, "", util.NewInternalError(err)
+		}
+		l.byName = make(map[string][]string, len(routes))
+		for _, route := range routes {
+			name := strings.ToLower(route.Name)
+			l.byName[name] = append(l.byName[name], route.ID)
+		}
+	}
+
+	ids := l.byName[strings.ToLower(value)]
+	switch len(ids) {
+	case 0:
+		return nil, "존재하지 않는 경로입니다", nil
+	case 1:
+		if route, ok := l.byID[ids[0]]; ok {
+			return route, "", nil
+		}
+		route, err := l.load(ctx, ids[0])
+		if err != nil {
+			return nil, "", err
+		}
+		if route == nil {
+			return nil, "존재하지 않는 경로입니다", nil
+		}
+		return route, "", nil
+	default:
+		return nil, "같은 이름의 경로가 여러 개입니다. 경로 ID를 입력해 주세요", nil
+	}
+}
+
+// load - ID로 경로 조회 (없으면 nil)
+func (l *routeLookup) load(ctx context.Context, id string) (*domain.Route, error) {
+	route, err := l.routeRepo.GetByID(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	l.byID[id] = route
+	return route, nil
+}
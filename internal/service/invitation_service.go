@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/sms"
+)
+
+// 📝 설명: 기관 직원 초대 (초대 발송, 대기 목록, 취소, 수락)
+// 🎯 실무 포인트: 관리자가 이메일/연락처로 초대 → 받은 사람이 토큰과 비밀번호로 수락하면 초대한 기관 소속 계정 생성
+// 초대 토큰은 재설정 토큰과 같이 해시만 저장, 원문은 메일/문자로만 전달
+// ⚠️ 주의사항: 수락 요청에는 인증 주체가 없으므로 계정 생성은 초대의 기관으로 지정(WithOrganization)
+// 이메일은 전체 기관에서 유일한 로그인 ID → 초대 시에는 같은 기관만, 수락 시 전체 기관 기준으로 중복 확인
+
+// InvitationNotifier - 초대 토큰 전달 채널 (이메일/SMS)
+type InvitationNotifier interface {
+	SendInvitation(ctx context.Context, invitation *domain.Invitation, organization *domain.Organization, token string) error
+}
+
+// LogInvitationNotifier - 로그로만 남기는 기본 Notifier (개발용)
+type LogInvitationNotifier struct{}
+
+// SendInvitation - 초대 토큰을 로그로 출력
+func (LogInvitationNotifier) SendInvitation(ctx context.Context, invitation *domain.Invitation, organization *domain.Organization, token string) error {
+	logger.FromContext(ctx).Info("Invitation created", map[string]interface{}{
+		"invitation_id":   invitation.ID,
+		"organization_id": organization.ID,
+		"token":           token,
+	})
+	return nil
+}
+
+// SMSInvitationNotifier - 초대 연락처로 초대 토큰을 문자 발송
+type SMSInvitationNotifier struct {
+	Sender sms.Sender
+}
+
+// SendInvitation - 초대 토큰 문자 발송 (연락처가 없는 초대는 에러)
+func (n SMSInvitationNotifier) SendInvitation(ctx context.Context, invitation *domain.Invitation, organization *domain.Organization, token string) error {
+	if invitation.Phone == nil {
+		return errors.New("invitation has no phone number")
+	}
+	locale := util.LocaleFromContext(ctx) // 초대한 관리자의 요청 언어 (초대 이메일과 같은 규칙)
+	return n.Sender.Send(ctx, &sms.Message{
+		To:    *invitation.Phone,
+		Title: util.GetLocalizedMessage(locale, "기관 초대"),
+		Text: util.GetLocalizedMessage(locale, "[어디니] %s에서 %s 계정으로 초대했습니다. 앱에서 초대 코드를 입력해 주세요.",
+			organization.Name, invitation.Role.DisplayName()) + "\n" + token,
+	})
+}
+
+// InvitationChannels - 초대 연락 수단별 발송 채널 (이메일 초대는 Email, 연락처 초대는 SMS, 없으면 로그 출력)
+type InvitationChannels struct {
+	Email InvitationNotifier
+	SMS   InvitationNotifier
+}
+
+// notifier - 초대에 맞는 발송 채널 선택 (이메일 우선)
+func (c InvitationChannels) notifier(invitation *domain.Invitation) InvitationNotifier {
+	if invitation.Email != nil && c.Email != nil {
+		return c.Email
+	}
+	if invitation.Phone != nil && c.SMS != nil {
+		return c.SMS
+	}
+	return LogInvitationNotifier{}
+}
+
+// InvitationService - 초대 서비스
+type InvitationService struct {
+	invitationRepo   repository.InvitationRepository
+	userRepo         repository.UserRepository
+	organizationRepo repository.OrganizationRepository
+	txManager        database.TxManager
+	channels         InvitationChannels
+	ttl              time.Duration
+}
+
+// NewInvitationService - 초대 서비스 생성 (ttl: 초대 유효 기간)
+func NewInvitationService(
+	invitationRepo repository.InvitationRepository,
+	userRepo repository.UserRepository,
+	organizationRepo repository.OrganizationRepository,
+	txManager database.TxManager,
+	channels InvitationChannels,
+	ttl time.Duration,
+) *InvitationService {
+	return &InvitationService{
+		invitationRepo:   invitationRepo,
+		userRepo:         userRepo,
+		organizationRepo: organizationRepo,
+		txManager:        txManager,
+		channels:         channels,
+		ttl:              ttl,
+	}
+}
+
+// Invite - 초대 생성 후 발송 (발송 실패는 응답의 delivered로 알림 → 취소 후 다시 초대)
+func (s *InvitationService) Invite(ctx context.Context, inviterID string, req *dto.InviteMemberRequest) (*dto.InvitationResponse, error) {
+	if req.Email == "" && req.Phone == "" {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"email": "이메일 또는 연락처가 필요합니다",
+		})
+	}
+
+	role := domain.Role(req.Role)
+	if err := requireProfile(role, req.ProfileID); err != nil {
+		return nil, err
+	}
+
+	if req.Email != "" {
+		if err := s.ensureNotMember(ctx, req.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	organizationID, err := currentOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := s.organizationRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, toAppError(err, "기관")
+	}
+
+	raw, hash, err := auth.GenerateInvitationToken()
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	invitation := domain.NewInvitation(role, req.ProfileID, hash, inviterID, s.ttl)
+	invitation.Email = emptyToNil(strings.ToLower(req.Email))
+	invitation.Phone = emptyToNil(req.Phone)
+
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	delivered := true
+	if err := s.channels.notifier(invitation).SendInvitation(ctx, invitation, organization, raw); err != nil {
+		delivered = false
+		logger.FromContext(ctx).Warn("Failed to send invitation", map[string]interface{}{
+			"invitation_id": invitation.ID,
+			"error":         err.Error(),
+		})
+	}
+
+	return &dto.InvitationResponse{
+		Invitation: invitation,
+		Status:     invitation.Status(time.Now()),
+		Delivered:  &delivered,
+	}, nil
+}
+
+// ListPending - 수락 대기 중인 초대 목록
+func (s *InvitationService) ListPending(ctx context.Context) ([]*dto.InvitationResponse, error) {
+	now := time.Now()
+	invitations, err := s.invitationRepo.ListPending(ctx, now)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	result := make([]*dto.InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, &dto.InvitationResponse{Invitation: invitation, Status: invitation.Status(now)})
+	}
+	return result, nil
+}
+
+// Revoke - 대기 중인 초대 취소 (이미 수락/취소/만료된 초대는 404)
+func (s *InvitationService) Revoke(ctx context.Context, id string) error {
+	if err := s.invitationRepo.Revoke(ctx, id, time.Now()); err != nil {
+		return toAppError(err, "초대")
+	}
+	return nil
+}
+
+// Accept - 초대 토큰 검증 후 초대한 기관 소속 계정 생성 (토큰 1회용)
+func (s *InvitationService) Accept(ctx context.Context, req *dto.AcceptInvitationRequest) (*domain.User, error) {
+	invalid := util.NewBadRequestError(util.MsgInvalidInvitation)
+
+	invitation, err := s.invitationRepo.GetByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, util.NewInternalError(err)
+	}
+
+	now := time.Now()
+	if !invitation.IsPending(now) {
+		return nil, invalid
+	}
+
+	email := req.Email
+	if invitation.Email != nil {
+		email = *invitation.Email
+	}
+	if email == "" {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"email": "연락처로 받은 초대는 로그인에 사용할 이메일이 필요합니다",
+		})
+	}
+
+	// 인증 주체가 없는 요청 → 전체 기관 기준 중복 확인
+	if err := s.ensureNotMember(ctx, email); err != nil {
+		return nil, err
+	}
+
+	hash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	user := domain.NewUser(email, hash, invitation.Role, invitation.ProfileID)
+	user.OrganizationID = invitation.OrganizationID
+	if invitation.Phone != nil {
+		user.Phone = *invitation.Phone
+	}
+
+	// 계정 생성과 수락 처리를 함께 → 동시 수락 시 한 번만 성공
+	scoped := repository.WithOrganization(ctx, invitation.OrganizationID)
+	err = s.txManager.WithTx(scoped, func(ctx context.Context) error {
+		if err := s.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+		return s.invitationRepo.MarkAccepted(ctx, invitation.ID, user.ID, now)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, util.NewInternalError(err)
+	}
+
+	return user, nil
+}
+
+// ensureNotMember - 같은 이메일의 계정이 없는지 확인 (조회 범위는 context의 기관)
+func (s *InvitationService) ensureNotMember(ctx context.Context, email string) error {
+	_, err := s.userRepo.GetByEmail(ctx, email)
+	if err == nil {
+		return util.NewDuplicateError("이메일")
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return util.NewInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 탑승 명단 (정류장 순서대로 승차/하차 예정 탑승자 + 보호자 연락처 + 의료 특이사항)
+// 🎯 실무 포인트: 기사/동승자 앱의 기본 화면, 탑승 기록이 있으면 기록된 정류장과 상태(승차/하차/불참) 반영
+// 오전 일정은 배정 정류장에서 승차 → 마지막 정류장(기관)에서 하차,
+// 오후/저녁 일정은 첫 정류장(기관)에서 승차 → 배정 정류장에서 하차
+// ⚠️ 주의사항: 보호자 연락처/의료 정보가 포함되므로 관리자와 배정 기사/동승자만 조회 (API 키 불가)
+
+// ManifestPassengerStatus - 명단의 탑승자 상태
+type ManifestPassengerStatus string
+
+const (
+	ManifestStatusExpected ManifestPassengerStatus = "expected" // 탑승 예정 (기록 없음)
+	ManifestStatusBoarded  ManifestPassengerStatus = "boarded"  // 승차
+	ManifestStatusAlighted ManifestPassengerStatus = "alighted" // 하차
+	ManifestStatusNoShow   ManifestPassengerStatus = "no_show"  // 불참
+	ManifestStatusExcused  ManifestPassengerStatus = "excused"  // 결석 신고 (보호자 사전 신고)
+)
+
+// ManifestService - 운행 탑승 명단 서비스
+type ManifestService struct {
+	tripService   *TripService
+	scheduleRepo  repository.ScheduleRepository
+	routeRepo     repository.RouteRepository
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+}
+
+// NewManifestService - 운행 탑승 명단 서비스 생성
+func NewManifestService(
+	tripService *TripService,
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+) *ManifestService {
+	return &ManifestService{
+		tripService:   tripService,
+		scheduleRepo:  scheduleRepo,
+		routeRepo:     routeRepo,
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+	}
+}
+
+// Get - 운행 탑승 명단 (관리자 또는 배정 기사/동승자)
+// 탑승 기록이 있는 탑승자는 기록된 정류장으로, 없으면 경로에 배정된 활동 중 탑승자를 배정 정류장으로 포함
+func (s *ManifestService) Get(ctx context.Context, tripID string) (*dto.TripManifestResponse, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if principal, ok := auth.FromContext(ctx); !ok || !principal.IsAdmin() {
+		if err := s.tripService.authorizeAssigned(ctx, trip); err != nil {
+			return nil, err
+		}
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return nil, toAppError(err, "운행 일정")
+	}
+	stops, err := s.routeRepo.ListStops(ctx, schedule.RouteID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	assigned, _, err := s.passengerRepo.List(ctx, repository.PassengerFilter{
+		Status:  domain.PassengerStatusActive,
+		RouteID: schedule.RouteID,
+	})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	manifest := &dto.TripManifestResponse{
+		TripID:       trip.ID,
+		ScheduleID:   schedule.ID,
+		ScheduleName: schedule.Name,
+		Date:         trip.Date.Format("2006-01-02"),
+		Status:       trip.Status,
+		TimeSlot:     schedule.TimeSlot,
+		RouteID:      schedule.RouteID,
+		Stops:        make([]dto.ManifestStopResponse, len(stops)),
+	}
+	stopIndex := make(map[string]int, len(stops))
+	for i, stop := range stops {
+		stopIndex[stop.ID] = i
+		manifest.Stops[i] = dto.ManifestStopResponse{
+			StopID:    stop.ID,
+			StopName:  stop.Name,
+			StopOrder: stop.Order,
+			Address:   stop.Address,
+			Latitude:  stop.Latitude,
+			Longitude: stop.Longitude,
+			Boarding:  []dto.ManifestPassengerResponse{},
+			Alighting: []dto.ManifestPassengerResponse{},
+		}
+	}
+	if len(stops) == 0 {
+		return manifest, nil
+	}
+
+	// 탑승 기록이 있는 탑승자 먼저 (배정이 바뀌었거나 비활성이 되어도 그날 기록은 유지)
+	entries := make([]manifestEntry, 0, len(trip.TripPassengers)+len(assigned))
+	recorded := make(map[string]bool, len(trip.TripPassengers))
+	for i := range trip.TripPassengers {
+		record := &trip.TripPassengers[i]
+		passenger, err := s.passengerRepo.GetByID(ctx, record.PassengerID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return nil, util.NewInternalError(err)
+		}
+		recorded[record.PassengerID] = true
+		entries = append(entries, manifestEntry{passenger: passenger, stopID: record.StopID, record: record})
+	}
+	for _, passenger := range assigned {
+		if !recorded[passenger.ID] && passenger.AssignedStopID != "" {
+			entries = append(entries, manifestEntry{passenger: passenger, stopID: passenger.AssignedStopID})
+		}
+	}
+
+	first, last := 0, len(stops)-1
+	for _, entry := range entries {
+		index, ok := stopIndex[entry.stopID]
+		if !ok {
+			continue // 경로에서 삭제된 정류장
+		}
+		item, err := s.manifestPassenger(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		boardAt, alightAt := index, last
+		if schedule.TimeSlot != domain.TimeSlotMorning {
+			boardAt, alightAt = first, index
+		}
+		manifest.Stops[boardAt].Boarding = append(manifest.Stops[boardAt].Boarding, item)
+		manifest.Stops[alightAt].Alighting = append(manifest.Stops[alightAt].Alighting, item)
+
+		manifest.TotalPassengers++
+		if item.MedicalAlert {
+			manifest.MedicalAlerts++
+		}
+	}
+	for i := range manifest.Stops {
+		sortManifestPassengers(manifest.Stops[i].Boarding)
+		sortManifestPassengers(manifest.Stops[i].Alighting)
+	}
+	return manifest, nil
+}
+
+// manifestEntry - 명단에 넣을 탑승자와 정류장 (탑승 기록이 없으면 record nil)
+type manifestEntry struct {
+	passenger *domain.Passenger
+	stopID    string
+	record    *domain.TripPassenger
+}
+
+// manifestPassenger - 명단의 탑승자 항목 (연결된 보호자가 없으면 탑승자 정보의 보호자 연락처)
+func (s *ManifestService) manifestPassenger(ctx context.Context, entry manifestEntry) (dto.ManifestPassengerResponse, error) {
+	passenger := entry.passenger
+	item := dto.ManifestPassengerResponse{
+		PassengerID:       passenger.ID,
+		Name:              passenger.Name,
+		Age:               passenger.Age,
+		Status:            string(manifestStatus(entry.record)),
+		MedicalAlert:      passenger.MedicalNotes != "",
+		MedicalNotes:      passenger.MedicalNotes,
+		Notes:             passenger.Notes,
+		Guardians:         []dto.ManifestGuardianContact{},
+		EmergencyContact:  passenger.EmergencyContact,
+		EmergencyRelation: passenger.EmergencyRelation,
+	}
+	if entry.record != nil && entry.record.IsExcused() {
+		item.AbsenceReason = entry.record.ExcusedReason
+	}
+
+	links, err := s.guardianRepo.ListPassengerLinks(ctx, passenger.ID)
+	if err != nil {
+		return item, util.NewInternalError(err)
+	}
+	for _, link := range links {
+		guardian, err := s.guardianRepo.GetByID(ctx, link.GuardianID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return item, util.NewInternalError(err)
+		}
+		item.Guardians = append(item.Guardians, dto.ManifestGuardianContact{
+			Name:     guardian.Name,
+			Phone:    guardian.Phone,
+			Relation: link.Relation,
+		})
+	}
+	if len(item.Guardians) == 0 && passenger.GuardianPhone != "" {
+		item.Guardians = append(item.Guardians, dto.ManifestGuardianContact{
+			Name:     passenger.GuardianName,
+			Phone:    passenger.GuardianPhone,
+			Relation: passenger.GuardianRelation,
+		})
+	}
+	return item, nil
+}
+
+// sortManifestPassengers - 정류장 안에서는 이름순
+func sortManifestPassengers(passengers []dto.ManifestPassengerResponse) {
+	sort.SliceStable(passengers, func(i, j int) bool { return passengers[i].Name < passengers[j].Name })
+}
+
+// manifestStatus - 탑승 기록으로 명단 상태 판정
+func manifestStatus(record *domain.TripPassenger) ManifestPassengerStatus {
+	switch {
+	case record == nil:
+		return ManifestStatusExpected
+	case record.IsAlighted:
+		return ManifestStatusAlighted
+	case record.IsBoarded:
+		return ManifestStatusBoarded
+	case record.IsExcused():
+		return ManifestStatusExcused
+	case record.IsNoShow():
+		return ManifestStatusNoShow
+	default:
+		return ManifestStatusExpected
+	}
+}
@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/alimtalk"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/push"
+	"github.com/hyeokjun/eodini/pkg/sms"
+)
+
+// 📝 설명: 보호자 등 사용자 알림 발송 (앱 푸시 → 카카오 알림톡 → 문자 순으로 대체)
+// 🎯 실무 포인트: 앱을 설치하지 않았거나 알림 토큰이 모두 무효가 된 보호자도 알림톡/문자로 받을 수 있게
+// 보호자 알림은 수신 설정(받을 채널/알림 종류)을 먼저 확인
+// 발송마다 결과를 기록하고, 일시 장애로 실패한 알림은 재시도 작업(RetryDue)이 지수 백오프로 다시 발송
+// ⚠️ 주의사항: 알림톡/문자는 건당 비용이 들므로 앞 채널이 성공하면 보내지 않음
+// 알림톡은 템플릿이 등록된 알림만 발송하고, 실패하면 같은 내용을 문자로 재발송
+// 발송 기록은 context의 기관 소속 → 인증 주체가 없는 작업은 repository.WithOrganization으로 기관을 지정해서 호출 (지정하지 않으면 플랫폼 알림)
+// 제목/본문은 수신자 계정의 언어로 만듦 (계정이 없는 연락처는 기본 언어), 알림톡은 "이름.언어" 템플릿이 있으면 그 템플릿
+
+var (
+	// ErrNotificationUndelivered - 허용된 채널 중 푸시를 받을 기기도 알림톡/문자 연락처도 없음
+	ErrNotificationUndelivered = errors.New("notification: no channel available for recipient")
+	// ErrNotificationOptedOut - 보호자가 받지 않기로 설정한 알림 종류 (비활성 보호자 포함)
+	ErrNotificationOptedOut = errors.New("notification: recipient opted out of event")
+)
+
+// Recipient - 알림 수신자
+type Recipient struct {
+	UserID   string                       // 앱 계정 ID (비어 있으면 푸시 생략)
+	Phone    string                       // 알림톡/문자 대체 발송 연락처 (비어 있으면 생략)
+	Channels []domain.NotificationChannel // 허용 채널 (nil이면 모든 채널)
+	Locale   util.Locale                  // 알림 언어 (비어 있으면 UserID 계정의 언어 설정, 계정도 없으면 기본 언어)
+}
+
+// allows - 해당 채널로 보내도 되는지
+func (r Recipient) allows(channel domain.NotificationChannel) bool {
+	if r.Channels == nil {
+		return true
+	}
+	for _, c := range r.Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationRetryPolicy - 일시 장애로 실패한 알림의 재시도 기준
+type NotificationRetryPolicy struct {
+	MaxAttempts int           // 최초 발송 포함 최대 시도 횟수 (1 이하면 재시도 안 함)
+	Backoff     time.Duration // 첫 재시도 대기 시간 (시도마다 2배)
+	MaxBackoff  time.Duration // 대기 시간 상한
+}
+
+// delay - attempts번 실패한 뒤 다음 시도까지 대기 시간
+func (p NotificationRetryPolicy) delay(attempts int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// notificationRetryBatch - 재시도 작업 1회에 가져가는 최대 건수
+const notificationRetryBatch = 100
+
+// notificationRetryLease - 가져간 기록을 다른 인스턴스가 다시 가져가지 못하는 시간 (발송 중 종료 대비)
+const notificationRetryLease = 5 * time.Minute
+
+// Notification - 알림 내용
+type Notification struct {
+	Title string
+	Body  string
+	Data  map[string]string // 앱 화면 이동용 부가 정보 (푸시에만 포함)
+
+	Template  string            // 알림톡 템플릿 이름 (비어 있거나 등록되지 않았으면 알림톡 생략)
+	Variables map[string]string // 알림톡 템플릿 변수
+
+	title  util.LocalizedText            // 수신자 언어로 만들 제목 (비어 있으면 Title 그대로)
+	body   []util.LocalizedText          // 수신자 언어로 만들 본문 (줄 단위, 비어 있으면 Body 그대로)
+	texts  map[string]util.LocalizedText // 수신자 언어로 만들 알림톡 변수 (Variables보다 우선)
+	locale util.Locale                   // 발송 언어 (localize에서 결정)
+}
+
+// newNotification - 수신자 언어로 만드는 알림 (Title/Body는 기본 언어로 채워 둠)
+// 사용 예: newNotification(util.Text("차량 도착 예정"), util.Text("%s 님의 차량이 %s에 약 %d분 후 도착합니다", name, stop, minutes))
+func newNotification(title util.LocalizedText, body ...util.LocalizedText) *Notification {
+	n := &Notification{title: title, body: body}
+	return n.localize(util.DefaultLocale)
+}
+
+// localize - 지정한 언어로 제목/본문/알림톡 변수를 만든 복사본
+func (n *Notification) localize(locale util.Locale) *Notification {
+	copied := *n
+	copied.locale = locale
+	if n.title.Key != "" {
+		copied.Title = n.title.Localize(locale)
+	}
+	if len(n.body) > 0 {
+		lines := make([]string, len(n.body))
+		for i, line := range n.body {
+			lines[i] = line.Localize(locale)
+		}
+		copied.Body = strings.Join(lines, "\n")
+	}
+	if len(n.texts) > 0 {
+		variables := make(map[string]string, len(n.Variables)+len(n.texts))
+		for key, value := range n.Variables {
+			variables[key] = value
+		}
+		for key, text := range n.texts {
+			variables[key] = text.Localize(locale)
+		}
+		copied.Variables = variables
+	}
+	return &copied
+}
+
+// NotificationService - 알림 서비스
+type NotificationService struct {
+	preferenceRepo repository.NotificationPreferenceRepository
+	logRepo        repository.NotificationLogRepository
+	guardianRepo   repository.GuardianRepository
+	userRepo       repository.UserRepository
+	devices        *DeviceService
+	alimtalkSender alimtalk.Sender
+	templates      map[string]alimtalk.Template
+	smsSender      sms.Sender
+	retry          NotificationRetryPolicy
+}
+
+// NewNotificationService - 알림 서비스 생성 (alimtalkSender/smsSender가 nil이면 해당 채널 대체 발송 안 함, logRepo가 nil이면 기록/재시도 안 함)
+func NewNotificationService(
+	preferenceRepo repository.NotificationPreferenceRepository,
+	logRepo repository.NotificationLogRepository,
+	guardianRepo repository.GuardianRepository,
+	userRepo repository.UserRepository,
+	devices *DeviceService,
+	alimtalkSender alimtalk.Sender,
+	templates map[string]alimtalk.Template,
+	smsSender sms.Sender,
+	retry NotificationRetryPolicy,
+) *NotificationService {
+	return &NotificationService{
+		preferenceRepo: preferenceRepo,
+		logRepo:        logRepo,
+		guardianRepo:   guardianRepo,
+		userRepo:       userRepo,
+		devices:        devices,
+		alimtalkSender: alimtalkSender,
+		templates:      templates,
+		smsSender:      smsSender,
+		retry:          retry,
+	}
+}
+
+// GetPreference - 보호자 알림 수신 설정 (설정한 적 없으면 기본값)
+func (s *NotificationService) GetPreference(ctx context.Context, guardianID string) (*domain.NotificationPreference, error) {
+	preference, err := s.preferenceRepo.Get(ctx, guardianID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return domain.NewNotificationPreference(guardianID), nil
+	}
+	if err != nil {
+		return nil, toAppError(err, "알림 설정")
+	}
+	return preference, nil
+}
+
+// UpdatePreference - 보호자 알림 수신 설정 교체
+func (s *NotificationService) UpdatePreference(ctx context.Context, guardianID string, req *dto.UpdateNotificationPreferenceRequest) (*domain.NotificationPreference, error) {
+	preference, err := s.GetPreference(ctx, guardianID)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]domain.NotificationChannel, len(req.Channels))
+	for i, channel := range req.Channels {
+		channels[i] = domain.NotificationChannel(channel)
+	}
+	events := make([]domain.NotificationEvent, len(req.Events))
+	for i, event := range req.Events {
+		events[i] = domain.NotificationEvent(event)
+	}
+	preference.Update(channels, events)
+
+	if err := s.preferenceRepo.Upsert(ctx, preference); err != nil {
+		return nil, toAppError(err, "알림 설정")
+	}
+	return preference, nil
+}
+
+// NotifyGuardian - 보호자 수신 설정에 따라 알림 발송 (받지 않는 알림 종류거나 비활성 보호자면 ErrNotificationOptedOut)
+func (s *NotificationService) NotifyGuardian(ctx context.Context, guardianID string, event domain.NotificationEvent, notification *Notification) (domain.NotificationChannel, error) {
+	preference, err := s.GetPreference(ctx, guardianID)
+	if err != nil {
+		return "", err
+	}
+	if !preference.WantsEvent(event) {
+		return "", ErrNotificationOptedOut
+	}
+
+	guardian, err := s.guardianRepo.GetByID(ctx, guardianID)
+	if err != nil {
+		return "", toAppError(err, "보호자")
+	}
+	// 비활성 보호자(자녀 졸업 등)는 받지 않음
+	if guardian.Status != domain.GuardianStatusActive {
+		return "", ErrNotificationOptedOut
+	}
+
+	// 앱 계정이 없는 보호자도 있으므로 계정 조회 실패는 푸시만 생략 (언어는 계정 설정, 없으면 기본 언어)
+	var userID string
+	locale := util.DefaultLocale
+	users, _, err := s.userRepo.List(ctx, repository.UserFilter{
+		Role:        domain.RoleGuardian,
+		Status:      domain.UserStatusActive,
+		ProfileID:   guardianID,
+		ListOptions: repository.ListOptions{Limit: 1},
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to look up guardian account", map[string]interface{}{"guardian_id": guardianID, "error": err.Error()})
+	} else if len(users) > 0 {
+		userID = users[0].ID
+		if parsed, ok := util.ParseLocale(users[0].Locale); ok {
+			locale = parsed
+		}
+	}
+
+	return s.dispatch(ctx, guardianID, event, Recipient{UserID: userID, Phone: guardian.Phone, Channels: preference.Channels, Locale: locale}, notification)
+}
+
+// Notify - 푸시 발송, 성공한 기기가 없으면 알림톡, 알림톡도 보내지 못하면 문자 발송 (실제 발송한 채널 반환)
+// 일시 장애로 실패하면 에러를 반환하고 재시도 예약
+func (s *NotificationService) Notify(ctx context.Context, recipient Recipient, notification *Notification) (domain.NotificationChannel, error) {
+	return s.dispatch(ctx, "", "", recipient, notification)
+}
+
+// ListLogs - 알림 발송 기록 조회 (관리자)
+func (s *NotificationService) ListLogs(ctx context.Context, filter repository.NotificationLogFilter) ([]*domain.NotificationLog, int64, error) {
+	if s.logRepo == nil {
+		return []*domain.NotificationLog{}, 0, nil
+	}
+	logs, total, err := s.logRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return logs, total, nil
+}
+
+// RetryDue - 재시도할 때가 된 알림 다시 발송 (재시도 작업에서 주기 실행, 처리 건수 반환)
+func (s *NotificationService) RetryDue(ctx context.Context, now time.Time) (int, error) {
+	if s.logRepo == nil {
+		return 0, nil
+	}
+	logs, err := s.logRepo.ClaimDue(ctx, now, notificationRetryLease, notificationRetryBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, entry := range logs {
+		locale, _ := util.ParseLocale(entry.Locale)
+		channel, err := s.deliver(ctx, Recipient{UserID: entry.UserID, Phone: entry.Phone, Channels: entry.Channels}, &Notification{
+			Title:     entry.Title,
+			Body:      entry.Body,
+			Data:      entry.Data,
+			Template:  entry.Template,
+			Variables: entry.Variables,
+			locale:    locale,
+		})
+		s.record(entry, channel, err, time.Now())
+		if err := s.logRepo.Update(ctx, entry); err != nil {
+			return i, err
+		}
+		if entry.Status == domain.NotificationStatusDead {
+			logger.FromContext(ctx).Warn("Notification dead-lettered", map[string]interface{}{
+				"notification_id": entry.ID,
+				"attempts":        entry.Attempts,
+				"response":        entry.Response,
+			})
+		}
+	}
+	return len(logs), nil
+}
+
+// dispatch - 수신자 언어로 만든 뒤 발송하고 결과 기록 (재시도는 기록된 내용 그대로)
+func (s *NotificationService) dispatch(ctx context.Context, guardianID string, event domain.NotificationEvent, recipient Recipient, notification *Notification) (domain.NotificationChannel, error) {
+	notification = notification.localize(s.recipientLocale(ctx, recipient))
+	channel, err := s.deliver(ctx, recipient, notification)
+	if s.logRepo == nil {
+		return channel, err
+	}
+
+	entry := domain.NewNotificationLog(recipient.UserID, recipient.Phone, recipient.Channels, notification.Title, notification.Body)
+	entry.OrganizationID = repository.OrganizationFromContext(ctx)
+	entry.GuardianID = guardianID
+	entry.Event = event
+	entry.Data = notification.Data
+	entry.Template = notification.Template
+	entry.Variables = notification.Variables
+	entry.Locale = string(notification.locale)
+	s.record(entry, channel, err, time.Now())
+	// 기록 실패로 발송 결과가 바뀌지는 않음
+	if createErr := s.logRepo.Create(ctx, entry); createErr != nil {
+		logger.FromContext(ctx).Warn("Failed to record notification", map[string]interface{}{"guardian_id": guardianID, "error": createErr.Error()})
+	}
+	return channel, err
+}
+
+// recipientLocale - 수신자 알림 언어 (지정한 언어 > 앱 계정의 언어 설정 > 기본 언어)
+func (s *NotificationService) recipientLocale(ctx context.Context, recipient Recipient) util.Locale {
+	if recipient.Locale != "" {
+		return recipient.Locale
+	}
+	if recipient.UserID != "" && s.userRepo != nil {
+		if user, err := s.userRepo.GetByID(ctx, recipient.UserID); err == nil {
+			if locale, ok := util.ParseLocale(user.Locale); ok {
+				return locale
+			}
+		}
+	}
+	return util.DefaultLocale
+}
+
+// record - 발송 결과를 기록에 반영 (일시 장애는 시도 횟수가 남아 있으면 재시도 예약, 아니면 dead)
+func (s *NotificationService) record(entry *domain.NotificationLog, channel domain.NotificationChannel, err error, now time.Time) {
+	switch {
+	case err == nil:
+		entry.MarkSent(channel, now)
+	case !retryableNotificationError(err):
+		entry.MarkFailed(err.Error(), false, now)
+	case entry.Attempts+1 >= s.retry.MaxAttempts:
+		entry.MarkFailed(err.Error(), true, now)
+	default:
+		entry.MarkRetry(err.Error(), now.Add(s.retry.delay(entry.Attempts+1)), now)
+	}
+}
+
+// retryableNotificationError - 다시 보내면 성공할 수 있는 실패인지 (연락처/내용 문제는 재시도해도 같은 결과)
+func retryableNotificationError(err error) bool {
+	return !errors.Is(err, ErrNotificationUndelivered) &&
+		!errors.Is(err, sms.ErrInvalidNumber) &&
+		!errors.Is(err, sms.ErrTooLong) &&
+		!errors.Is(err, alimtalk.ErrMissingVariable)
+}
+
+// deliver - 채널 순서대로 발송 시도 (기록 없음)
+func (s *NotificationService) deliver(ctx context.Context, recipient Recipient, notification *Notification) (domain.NotificationChannel, error) {
+	var pushErr error
+	if recipient.UserID != "" && s.devices != nil && recipient.allows(domain.NotificationChannelPush) {
+		sent, err := s.devices.Push(ctx, recipient.UserID, &push.Message{
+			Title: notification.Title,
+			Body:  notification.Body,
+			Data:  notification.Data,
+		})
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to push notification", map[string]interface{}{"user_id": recipient.UserID, "error": err.Error()})
+			pushErr = err
+		}
+		if sent > 0 {
+			return domain.NotificationChannelPush, nil
+		}
+	}
+
+	// 대체 채널이 없으면 푸시 일시 오류를 그대로 반환 (재시도 대상)
+	if recipient.Phone == "" {
+		if pushErr != nil {
+			return "", pushErr
+		}
+		return "", ErrNotificationUndelivered
+	}
+	alimtalkErr := errAlimtalkSkipped
+	if recipient.allows(domain.NotificationChannelAlimtalk) {
+		alimtalkErr = s.sendAlimtalk(ctx, recipient.Phone, notification)
+	}
+	if alimtalkErr == nil {
+		return domain.NotificationChannelAlimtalk, nil
+	}
+	if alimtalkErr != errAlimtalkSkipped {
+		logger.FromContext(ctx).Warn("Failed to send alimtalk, falling back to SMS", map[string]interface{}{
+			"template": notification.Template,
+			"error":    alimtalkErr.Error(),
+		})
+	}
+
+	if s.smsSender == nil || !recipient.allows(domain.NotificationChannelSMS) {
+		if alimtalkErr != errAlimtalkSkipped {
+			return "", alimtalkErr
+		}
+		if pushErr != nil {
+			return "", pushErr
+		}
+		return "", ErrNotificationUndelivered
+	}
+	err := s.smsSender.Send(ctx, &sms.Message{
+		To:    recipient.Phone,
+		Title: notification.Title,
+		Text:  util.GetLocalizedMessage(notification.locale, "[어디니]") + " " + notification.Title + "\n" + notification.Body,
+	})
+	if err != nil {
+		return "", err
+	}
+	return domain.NotificationChannelSMS, nil
+}
+
+// errAlimtalkSkipped - 알림톡 미설정/미허용 또는 템플릿 미등록 (실패가 아니므로 경고 없이 문자로 넘어감)
+var errAlimtalkSkipped = errors.New("alimtalk skipped")
+
+// sendAlimtalk - 등록된 템플릿으로 알림톡 발송 (발송 언어의 "이름.언어" 템플릿이 있으면 우선, 예: boarded.en)
+func (s *NotificationService) sendAlimtalk(ctx context.Context, phone string, notification *Notification) error {
+	if s.alimtalkSender == nil || notification.Template == "" {
+		return errAlimtalkSkipped
+	}
+	template, ok := s.templates[notification.Template+"."+string(notification.locale)]
+	if !ok {
+		template, ok = s.templates[notification.Template]
+	}
+	if !ok {
+		return errAlimtalkSkipped
+	}
+	msg, err := template.Message(phone, notification.Variables)
+	if err != nil {
+		return err
+	}
+	return s.alimtalkSender.Send(ctx, msg)
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 차량 주행거리계 갱신 (운행 완료, 관리자 입력)과 변경 이력
+// 🎯 실무 포인트: 운행 완료 시 기사가 확인한 값으로 갱신, 입력이 없으면 차량 주행거리계 + 운행 주행 거리(km 반올림)로 계산
+// 변경마다 기록(odometer_readings)을 남겨 주행거리 기준 정비 주기(엔진오일 교환 등)를 잡을 수 있게 함
+// ⚠️ 주의사항: TripFinalizer로 운행 완료 저장과 같은 트랜잭션에서 실행 → 운행 저장이 실패하면 차량 주행거리계 갱신과 기록도 롤백
+// 기준값(관리자 입력 또는 첫 기사 확인)이 없는 차량은 주행 거리만으로 갱신하지 않음 (0 km부터 누적하면 실제 값과 무관)
+
+// OdometerService - 주행거리계 서비스
+type OdometerService struct {
+	vehicleRepo repository.VehicleRepository
+	readingRepo repository.OdometerReadingRepository
+	txManager   database.TxManager
+	locations   TimezoneResolver
+}
+
+// NewOdometerService - 주행거리계 서비스 생성 (locations가 nil이면 한국 시간)
+// txManager는 차량 주행거리계 갱신과 기록 추가를 한 트랜잭션으로 묶음 (운행 완료 중이면 그 트랜잭션에 합류)
+func NewOdometerService(
+	vehicleRepo repository.VehicleRepository,
+	readingRepo repository.OdometerReadingRepository,
+	txManager database.TxManager,
+	locations TimezoneResolver,
+) *OdometerService {
+	return &OdometerService{
+		vehicleRepo: vehicleRepo,
+		readingRepo: readingRepo,
+		txManager:   txManager,
+		locations:   locations,
+	}
+}
+
+// FinalizeTrip - 운행 완료 시 차량 주행거리계 갱신 (TripFinalizer 구현)
+// 기사 확인 값이 차량 주행거리계보다 작으면 검증 에러, 차량 저장/기록 실패는 에러 → 운행 완료도 롤백
+// 차량이 삭제된 운행은 로그만 남기고 완료 진행
+func (s *OdometerService) FinalizeTrip(ctx context.Context, trip *domain.Trip) error {
+	vehicle, err := s.vehicleRepo.GetByID(ctx, trip.VehicleID)
+	if errors.Is(err, repository.ErrNotFound) {
+		logger.FromContext(ctx).Warn("Vehicle not found for odometer", map[string]interface{}{
+			"trip_id":    trip.ID,
+			"vehicle_id": trip.VehicleID,
+		})
+		return nil
+	}
+	if err != nil {
+		return util.NewInternalError(err)
+	}
+
+	source := domain.OdometerSourceTrip
+	if trip.OdometerKm != nil {
+		if *trip.OdometerKm < vehicle.OdometerKm {
+			return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"odometer_km": util.Text("주행거리계는 현재 차량 주행거리계(%d km)보다 작을 수 없습니다", vehicle.OdometerKm),
+			})
+		}
+	} else {
+		if !vehicle.HasOdometer() || trip.TotalDistance <= 0 {
+			return nil
+		}
+		computed := vehicle.OdometerKm + int(math.Round(float64(trip.TotalDistance)/1000))
+		trip.OdometerKm = &computed
+		source = domain.OdometerSourceTripDistance
+	}
+
+	tripID := trip.ID
+	if err := s.record(ctx, vehicle, *trip.OdometerKm, source, &tripID); err != nil {
+		return util.NewInternalError(err)
+	}
+	return nil
+}
+
+// SetOdometer - 관리자 주행거리계 입력 (기준값 입력, 보정, 계기판 교체 → 현재 값보다 작아도 허용)
+func (s *OdometerService) SetOdometer(ctx context.Context, vehicleID string, req *dto.UpdateOdometerRequest) (*domain.Vehicle, error) {
+	vehicle, err := s.vehicleRepo.GetByID(ctx, vehicleID)
+	if err != nil {
+		return nil, toAppError(err, "차량")
+	}
+	if err := s.record(ctx, vehicle, *req.OdometerKm, domain.OdometerSourceManual, nil); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return vehicle, nil
+}
+
+// History - 차량의 주행거리계 기록 (최신 기록 먼저, 기간을 생략하면 전체)
+func (s *OdometerService) History(ctx context.Context, vehicleID string, query *dto.OdometerReadingQuery) ([]*domain.OdometerReading, error) {
+	if _, err := s.vehicleRepo.GetByID(ctx, vehicleID); err != nil {
+		return nil, toAppError(err, "차량")
+	}
+
+	location := organizationLocation(ctx, s.locations, repository.OrganizationFromContext(ctx))
+	filter := repository.OdometerReadingFilter{VehicleID: vehicleID}
+	if query.From != "" {
+		from, _ := time.ParseInLocation(time.DateOnly, query.From, location) // 바인딩에서 형식 검증 완료
+		filter.From = &from
+	}
+	if query.To != "" {
+		to, _ := time.ParseInLocation(time.DateOnly, query.To, location)
+		end := to.AddDate(0, 0, 1)
+		filter.To = &end
+	}
+
+	readings, err := s.readingRepo.List(ctx, filter)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return readings, nil
+}
+
+// record - 차량 주행거리계 갱신 + 기록 추가를 한 트랜잭션으로 (기록자는 요청 사용자)
+func (s *OdometerService) record(ctx context.Context, vehicle *domain.Vehicle, odometerKm int, source domain.OdometerSource, tripID *string) error {
+	now := time.Now()
+	recordedBy := ""
+	if principal, ok := auth.FromContext(ctx); ok {
+		recordedBy = principal.UserID
+	}
+
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		vehicle.UpdateOdometer(odometerKm, now)
+		if err := s.vehicleRepo.Update(ctx, vehicle); err != nil {
+			return err
+		}
+		reading := domain.NewOdometerReading(vehicle.ID, odometerKm, source, tripID, recordedBy, now)
+		reading.OrganizationID = vehicle.OrganizationID
+		return s.readingRepo.Create(ctx, reading)
+	})
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 기관 운영 알림(불참, 지연, 위험 운전 경보, 서류 만료) 수신자 조회
+// 🎯 실무 포인트: 기관의 활성 관리자 계정 → 연락처가 있는 관리자가 없으면 기관 대표 연락처 → 둘 다 없으면 플랫폼 운영 연락처(SMS_ADMIN_NUMBERS)
+// ⚠️ 주의사항: 항상 운행/대상의 기관으로 조회 → 다른 기관의 운영 알림이 섞이지 않음
+// 기관을 모르는 알림(organizationID가 빈 값)만 플랫폼 운영 연락처로 바로 발송
+
+// Operator - 운영 알림 수신자 한 명 (플랫폼 운영 연락처는 Phone만)
+type Operator struct {
+	UserID string // 관리자 계정 ID (비어 있으면 푸시 생략)
+	Phone  string // 알림톡/문자 연락처
+	Email  string // 관리자 메일 (요약 메일 수신용)
+}
+
+// Recipient - 알림 발송 수신자
+func (o Operator) Recipient() Recipient {
+	return Recipient{UserID: o.UserID, Phone: o.Phone}
+}
+
+// OperatorResolver - 기관 운영 알림 수신자 조회 (OperatorContacts가 구현)
+type OperatorResolver interface {
+	Operators(ctx context.Context, organizationID string) []Operator
+}
+
+// organizationOperators - 기관 운영 알림 수신자 (resolver가 없으면 없음)
+func organizationOperators(ctx context.Context, resolver OperatorResolver, organizationID string) []Operator {
+	if resolver == nil {
+		return nil
+	}
+	return resolver.Operators(ctx, organizationID)
+}
+
+// OperatorContacts - 기관 관리자 연락처 조회
+type OperatorContacts struct {
+	userRepo         repository.UserRepository
+	organizationRepo repository.OrganizationRepository
+	platformNumbers  []string // 기관 연락처가 없을 때 받을 플랫폼 운영 연락처
+}
+
+// NewOperatorContacts - 운영 알림 수신자 조회 생성 (platformNumbers는 SMS_ADMIN_NUMBERS)
+func NewOperatorContacts(
+	userRepo repository.UserRepository,
+	organizationRepo repository.OrganizationRepository,
+	platformNumbers []string,
+) *OperatorContacts {
+	return &OperatorContacts{
+		userRepo:         userRepo,
+		organizationRepo: organizationRepo,
+		platformNumbers:  platformNumbers,
+	}
+}
+
+// Operators - 기관의 활성 관리자 (연락처가 있는 관리자가 없으면 기관 대표 연락처 추가, 아무도 없으면 플랫폼 운영 연락처)
+// 조회 실패는 로그만 남기고 찾은 수신자까지만 반환
+func (c *OperatorContacts) Operators(ctx context.Context, organizationID string) []Operator {
+	if organizationID == "" {
+		return c.platform()
+	}
+	// 감지 작업/이벤트 핸들러는 인증 주체가 없으므로 대상 기관으로 조회 범위 지정
+	ctx = repository.WithOrganization(ctx, organizationID)
+
+	var operators []Operator
+	reachable := false
+	users, _, err := c.userRepo.List(ctx, repository.UserFilter{Role: domain.RoleAdmin, Status: domain.UserStatusActive})
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to list organization admins", map[string]interface{}{"organization_id": organizationID, "error": err.Error()})
+	}
+	for _, user := range users {
+		if user.OrganizationID != organizationID {
+			continue
+		}
+		operators = append(operators, Operator{UserID: user.ID, Phone: user.Phone, Email: user.Email})
+		reachable = reachable || user.Phone != ""
+	}
+
+	if !reachable {
+		organization, err := c.organizationRepo.GetByID(ctx, organizationID)
+		switch {
+		case err == nil && organization.Phone != nil && *organization.Phone != "":
+			operators = append(operators, Operator{Phone: *organization.Phone})
+		case err != nil && !errors.Is(err, repository.ErrNotFound):
+			logger.FromContext(ctx).Warn("Failed to load organization contact", map[string]interface{}{"organization_id": organizationID, "error": err.Error()})
+		}
+	}
+
+	if len(operators) == 0 {
+		return c.platform()
+	}
+	return operators
+}
+
+// platform - 플랫폼 운영 연락처
+func (c *OperatorContacts) platform() []Operator {
+	operators := make([]Operator, 0, len(c.platformNumbers))
+	for _, phone := range c.platformNumbers {
+		operators = append(operators, Operator{Phone: phone})
+	}
+	return operators
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 기관 비즈니스 로직 (현재 기관 조회/수정, 화면 표시/웹 앱 설정)
+// 🎯 실무 포인트: 대상 기관은 요청 경로가 아닌 인증 주체의 소속 기관 → 다른 기관 ID를 넣어 조회할 방법이 없음
+// 기관별 허용 Origin(CORS)과 시간대(운행 생성/지연 감지/보고서)는 요청·작업마다 확인하므로 메모리에 두고 주기적으로 갱신
+// ⚠️ 주의사항: 기관 생성은 운영자가 직접 처리 (기관 간 이동/병합은 지원하지 않음)
+// 다른 인스턴스에서 바꾼 허용 Origin/시간대는 settingsCacheTTL 이후 반영
+
+// settingsCacheTTL - 허용 Origin 목록/기관 시간대 갱신 주기
+const settingsCacheTTL = time.Minute
+
+// maxAllowedOrigins - 기관당 허용 Origin 최대 개수
+const maxAllowedOrigins = 20
+
+// OrganizationService - 기관 서비스
+type OrganizationService struct {
+	organizationRepo repository.OrganizationRepository
+
+	originsMu       sync.RWMutex
+	origins         map[string]struct{} // 전체 기관의 허용 Origin
+	originsLoadedAt time.Time           // 마지막 갱신 시각 (zero면 다음 확인 때 다시 읽음)
+
+	locationsMu sync.Mutex
+	locations   map[string]cachedLocation // 기관 ID → 시간대
+}
+
+// cachedLocation - 캐시한 기관 시간대
+type cachedLocation struct {
+	location *time.Location
+	loadedAt time.Time
+}
+
+// messageLocation - 기관 시간대를 모를 때 쓰는 기본 시간대 (KST)
+var messageLocation = time.FixedZone("KST", 9*60*60)
+
+// TimezoneResolver - 기관 시간대 조회 (OrganizationService가 구현)
+type TimezoneResolver interface {
+	Location(ctx context.Context, organizationID string) *time.Location
+}
+
+// organizationLocation - 기관 시간대 (resolver가 없거나 기관을 모르면 한국 시간)
+func organizationLocation(ctx context.Context, resolver TimezoneResolver, organizationID string) *time.Location {
+	if resolver == nil || organizationID == "" {
+		return messageLocation
+	}
+	return resolver.Location(ctx, organizationID)
+}
+
+// organizationToday - 기관 시간대 기준 오늘 날짜 (UTC 자정, 날짜 컬럼과 비교용)
+func organizationToday(ctx context.Context, resolver TimezoneResolver, organizationID string) time.Time {
+	local := time.Now().In(organizationLocation(ctx, resolver, organizationID))
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// NewOrganizationService - 기관 서비스 생성
+func NewOrganizationService(organizationRepo repository.OrganizationRepository) *OrganizationService {
+	return &OrganizationService{organizationRepo: organizationRepo, locations: map[string]cachedLocation{}}
+}
+
+// Current - 요청한 사용자의 소속 기관 조회
+func (s *OrganizationService) Current(ctx context.Context) (*domain.Organization, error) {
+	organizationID, err := currentOrganizationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	organization, err := s.organizationRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return nil, toAppError(err, "기관")
+	}
+	return organization, nil
+}
+
+// Update - 소속 기관 정보 수정 (빈 연락처/주소는 삭제)
+func (s *OrganizationService) Update(ctx context.Context, req *dto.UpdateOrganizationRequest) (*domain.Organization, error) {
+	organization, err := s.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		organization.Name = *req.Name
+	}
+	if req.Type != nil {
+		organization.Type = domain.OrganizationType(*req.Type)
+	}
+	if req.Phone != nil {
+		organization.Phone = emptyToNil(*req.Phone)
+	}
+	if req.Address != nil {
+		organization.Address = emptyToNil(*req.Address)
+	}
+
+	if err := s.organizationRepo.Update(ctx, organization); err != nil {
+		return nil, toAppError(err, "기관")
+	}
+	return organization, nil
+}
+
+// Settings - 소속 기관의 화면 표시/웹 앱 설정
+func (s *OrganizationService) Settings(ctx context.Context) (*dto.OrganizationSettingsResponse, error) {
+	organization, err := s.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toSettingsResponse(organization), nil
+}
+
+// UpdateSettings - 화면 표시/웹 앱 설정 수정 (빈 표시 이름/로고는 삭제, 허용 Origin은 목록 전체 교체)
+func (s *OrganizationService) UpdateSettings(ctx context.Context, req *dto.UpdateOrganizationSettingsRequest) (*dto.OrganizationSettingsResponse, error) {
+	organization, err := s.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DisplayName != nil {
+		organization.DisplayName = emptyToNil(*req.DisplayName)
+	}
+	if req.LogoURL != nil {
+		if *req.LogoURL != "" && !isWebURL(*req.LogoURL) {
+			return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"logo_url": "로고 주소는 http(s) URL이어야 합니다",
+			})
+		}
+		organization.LogoURL = emptyToNil(*req.LogoURL)
+	}
+	if req.AllowedOrigins != nil {
+		origins, err := normalizeOrigins(*req.AllowedOrigins)
+		if err != nil {
+			return nil, err
+		}
+		organization.AllowedOrigins = origins
+	}
+	if req.Timezone != nil {
+		if !isTimezone(*req.Timezone) {
+			return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"timezone": "시간대는 Asia/Seoul 같은 IANA 이름이어야 합니다",
+			})
+		}
+		organization.Timezone = *req.Timezone
+	}
+
+	if err := s.organizationRepo.Update(ctx, organization); err != nil {
+		return nil, toAppError(err, "기관")
+	}
+	if req.AllowedOrigins != nil {
+		s.invalidateOrigins()
+	}
+	if req.Timezone != nil {
+		s.locationsMu.Lock()
+		delete(s.locations, organization.ID)
+		s.locationsMu.Unlock()
+	}
+	return toSettingsResponse(organization), nil
+}
+
+// Location - 기관 시간대 (TimezoneResolver 구현, 기관을 읽지 못하면 직전 값 또는 한국 시간)
+func (s *OrganizationService) Location(ctx context.Context, organizationID string) *time.Location {
+	s.locationsMu.Lock()
+	cached, ok := s.locations[organizationID]
+	s.locationsMu.Unlock()
+	if ok && time.Since(cached.loadedAt) < settingsCacheTTL {
+		return cached.location
+	}
+
+	organization, err := s.organizationRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load organization timezone", map[string]interface{}{
+			"organization_id": organizationID,
+			"error":           err.Error(),
+		})
+		if ok {
+			return cached.location
+		}
+		return messageLocation
+	}
+
+	location := organization.Location()
+	s.locationsMu.Lock()
+	s.locations[organizationID] = cachedLocation{location: location, loadedAt: time.Now()}
+	s.locationsMu.Unlock()
+	return location
+}
+
+// IsAllowedOrigin - 어느 기관이든 허용한 Origin인지 확인 (middleware.OriginChecker 구현)
+// 목록을 읽지 못하면 직전 목록으로 판단 (처음부터 실패하면 거부)
+func (s *OrganizationService) IsAllowedOrigin(ctx context.Context, origin string) bool {
+	s.originsMu.RLock()
+	origins, fresh := s.origins, time.Since(s.originsLoadedAt) < settingsCacheTTL
+	s.originsMu.RUnlock()
+
+	if !fresh {
+		origins = s.reloadOrigins(ctx)
+	}
+	_, ok := origins[origin]
+	return ok
+}
+
+// reloadOrigins - 허용 Origin 목록 다시 읽기
+func (s *OrganizationService) reloadOrigins(ctx context.Context) map[string]struct{} {
+	list, err := s.organizationRepo.ListAllowedOrigins(ctx)
+
+	s.originsMu.Lock()
+	defer s.originsMu.Unlock()
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load organization origins", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return s.origins
+	}
+
+	origins := make(map[string]struct{}, len(list))
+	for _, origin := range list {
+		origins[origin] = struct{}{}
+	}
+	s.origins = origins
+	s.originsLoadedAt = time.Now()
+	return origins
+}
+
+// invalidateOrigins - 다음 확인 때 허용 Origin 목록을 다시 읽도록 표시
+func (s *OrganizationService) invalidateOrigins() {
+	s.originsMu.Lock()
+	s.originsLoadedAt = time.Time{}
+	s.originsMu.Unlock()
+}
+
+// toSettingsResponse - 기관 설정 응답 변환
+func toSettingsResponse(organization *domain.Organization) *dto.OrganizationSettingsResponse {
+	origins := organization.AllowedOrigins
+	if origins == nil {
+		origins = []string{}
+	}
+	return &dto.OrganizationSettingsResponse{
+		OrganizationID: organization.ID,
+		Name:           organization.Name,
+		DisplayName:    organization.Branding(),
+		LogoURL:        organization.LogoURL,
+		AllowedOrigins: origins,
+		Timezone:       organization.Timezone,
+	}
+}
+
+// normalizeOrigins - Origin 형식 검증 후 정규화 (scheme://host[:port], 소문자, 중복 제거)
+func normalizeOrigins(raw []string) ([]string, error) {
+	if len(raw) > maxAllowedOrigins {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"allowed_origins": "허용 Origin은 최대 20개까지 등록할 수 있습니다",
+		})
+	}
+
+	origins := make([]string, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for _, value := range raw {
+		parsed, err := url.Parse(strings.TrimSuffix(strings.TrimSpace(value), "/"))
+		if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" ||
+			parsed.Path != "" || parsed.RawQuery != "" || parsed.Fragment != "" || parsed.User != nil {
+			return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"allowed_origins": util.Text("Origin은 https://app.example.com 형식이어야 합니다: %s", value),
+			})
+		}
+		origin := parsed.Scheme + "://" + strings.ToLower(parsed.Host)
+		if !seen[origin] {
+			seen[origin] = true
+			origins = append(origins, origin)
+		}
+	}
+	return origins, nil
+}
+
+// isWebURL - http(s) 절대 주소인지 확인
+func isWebURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "https" || parsed.Scheme == "http") && parsed.Host != ""
+}
+
+// isTimezone - 불러올 수 있는 IANA 시간대 이름인지 확인 (빈 값/Local 제외)
+func isTimezone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// currentOrganizationID - 요청 대상 기관 ID (플랫폼 운영자가 기관을 지정하지 않았으면 400, 인증 주체가 없으면 401)
+func currentOrganizationID(ctx context.Context) (string, error) {
+	if organizationID := repository.OrganizationFromContext(ctx); organizationID != "" {
+		return organizationID, nil
+	}
+	if principal, ok := auth.FromContext(ctx); ok && principal.IsSuperAdmin() {
+		return "", util.NewBadRequestError("대상 기관을 X-Organization-ID 헤더로 지정해야 합니다")
+	}
+	return "", util.NewUnauthorizedError()
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 트랜잭셔널 아웃박스 (도메인 이벤트 기록 + 릴레이)
+// 🎯 실무 포인트: 운행/탑승 서비스는 엔티티 저장과 같은 트랜잭션에서 Publish로 이벤트를 기록만 함
+// 릴레이 작업(Relay)이 커밋된 이벤트를 디스패처의 구독 핸들러(웹훅 전달, 보호자 알림 등)에 전달
+// 이벤트마다 발생한 기관을 기록 → 릴레이는 그 기관 범위에서만 핸들러를 실행
+// → 요청 처리는 후속 작업을 기다리지 않고, 서버가 중간에 내려가도 이벤트가 유실되지 않음
+// ⚠️ 주의사항: 실패한 핸들러만 재시도(지수 백오프), OUTBOX_MAX_ATTEMPTS를 넘으면 dead로 남기고 로그
+// 릴레이 간격(OUTBOX_RELAY_INTERVAL)만큼 후속 처리가 늦어질 수 있음
+
+// outbox 릴레이 기준
+const (
+	outboxRelayBatch = 100             // 릴레이 1회에 가져가는 건수 (남아 있으면 이어서 가져감)
+	outboxLease      = 5 * time.Minute // 처리 중인 이벤트를 다른 인스턴스가 가져가지 않는 시간
+)
+
+// EventPublisher - 도메인 이벤트 발행 (OutboxService가 구현, ctx의 트랜잭션에 함께 기록)
+// organizationID는 이벤트가 발생한 기관 (이 기관의 웹훅 구독과 알림 대상에만 전달)
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.WebhookEvent, organizationID, aggregateID string, data interface{}) error
+}
+
+// publishEvent - 발행자가 설정된 경우에만 이벤트 발행
+func publishEvent(ctx context.Context, events EventPublisher, event domain.WebhookEvent, organizationID, aggregateID string, data interface{}) error {
+	if events == nil {
+		return nil
+	}
+	return events.Publish(ctx, event, organizationID, aggregateID, data)
+}
+
+// saveAndPublish - 저장과 이벤트 기록을 한 트랜잭션으로 실행 (events가 nil이면 저장만, txManager가 nil이면 트랜잭션 없이)
+func saveAndPublish(
+	ctx context.Context,
+	txManager database.TxManager,
+	events EventPublisher,
+	save func(ctx context.Context) error,
+	event domain.WebhookEvent,
+	organizationID, aggregateID string,
+	data interface{},
+) error {
+	return withTx(ctx, txManager, func(ctx context.Context) error {
+		if err := save(ctx); err != nil {
+			return err
+		}
+		return publishEvent(ctx, events, event, organizationID, aggregateID, data)
+	})
+}
+
+// withTx - fn을 트랜잭션으로 실행 (txManager가 nil이면 트랜잭션 없이)
+func withTx(ctx context.Context, txManager database.TxManager, fn func(ctx context.Context) error) error {
+	if txManager == nil {
+		return fn(ctx)
+	}
+	return txManager.WithTx(ctx, fn)
+}
+
+// OutboxService - 아웃박스 서비스
+type OutboxService struct {
+	outboxRepo repository.OutboxRepository
+	dispatcher *event.Dispatcher
+	retry      NotificationRetryPolicy
+}
+
+// NewOutboxService - 아웃박스 서비스 생성 (retry.MaxAttempts가 1 이하면 재시도 안 함)
+// dispatcher가 nil이면 기록(Publish)만 사용 (Relay는 릴레이 작업용 인스턴스에서 호출)
+func NewOutboxService(outboxRepo repository.OutboxRepository, dispatcher *event.Dispatcher, retry NotificationRetryPolicy) *OutboxService {
+	return &OutboxService{
+		outboxRepo: outboxRepo,
+		dispatcher: dispatcher,
+		retry:      retry,
+	}
+}
+
+// Publish - 이벤트를 아웃박스에 기록 (EventPublisher 구현, ctx에 트랜잭션이 있으면 참여)
+func (s *OutboxService) Publish(ctx context.Context, event domain.WebhookEvent, organizationID, aggregateID string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return s.outboxRepo.Create(ctx, domain.NewOutboxEvent(organizationID, string(event), aggregateID, string(payload)))
+}
+
+// Relay - 전달할 때가 된 이벤트를 구독 핸들러에 전달 (릴레이 작업에서 주기 실행, 처리 건수 반환)
+// 처리 중 중단된 이벤트도 lease가 지나면 다시 가져감
+func (s *OutboxService) Relay(ctx context.Context, now time.Time) (int, error) {
+	relayed := 0
+	for {
+		events, err := s.outboxRepo.ClaimDue(ctx, now, outboxLease, outboxRelayBatch)
+		if err != nil {
+			return relayed, err
+		}
+
+		for _, outboxEvent := range events {
+			s.dispatch(ctx, outboxEvent)
+			if err := s.outboxRepo.Update(ctx, outboxEvent); err != nil {
+				return relayed, err
+			}
+			relayed++
+		}
+		if len(events) < outboxRelayBatch {
+			return relayed, nil
+		}
+	}
+}
+
+// dispatch - 아직 처리하지 않은 핸들러에 전달하고 결과를 이벤트에 반영
+// 핸들러는 이벤트가 발생한 기관으로 제한한 context로 실행 (조회 범위, 알림/전달 기록의 기관)
+func (s *OutboxService) dispatch(ctx context.Context, outboxEvent *domain.OutboxEvent) {
+	handled, err := s.dispatcher.Dispatch(repository.WithOrganization(ctx, outboxEvent.OrganizationID), event.Event{
+		ID:             outboxEvent.ID,
+		Type:           outboxEvent.Type,
+		OrganizationID: outboxEvent.OrganizationID,
+		OccurredAt:     outboxEvent.CreatedAt,
+		Payload:        json.RawMessage(outboxEvent.Payload),
+	}, outboxEvent.Handled)
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		outboxEvent.MarkPublished(handled, now)
+	case outboxEvent.Attempts+1 >= s.retry.MaxAttempts:
+		outboxEvent.MarkDead(handled, err.Error(), now)
+		logger.FromContext(ctx).Warn("Outbox event dead-lettered", map[string]interface{}{
+			"event_id": outboxEvent.ID,
+			"type":     outboxEvent.Type,
+			"attempts": outboxEvent.Attempts,
+			"error":    err.Error(),
+		})
+	default:
+		outboxEvent.MarkRetry(handled, err.Error(), now.Add(s.retry.delay(outboxEvent.Attempts+1)), now)
+	}
+}
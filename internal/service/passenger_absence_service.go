@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 탑승자 결석 사전 신고 비즈니스 로직 (보호자 앱 "내일 아침 안 타요")
+// 🎯 실무 포인트: 운행 생성 시 신고된 탑승자의 탑승 기록은 결석 신고(excused)로 생성
+// 이미 만들어진 그날 운행이 있으면 신고/취소 즉시 탑승 기록에 반영 (정류장 출발 시 불참 처리 대상에서 제외)
+// ⚠️ 주의사항: 관리자 또는 연결된 보호자만 신고/조회/취소, 오늘(기관 시간대) 이전 날짜는 신고/취소 불가
+
+// PassengerAbsenceService - 결석 신고 서비스
+type PassengerAbsenceService struct {
+	absenceRepo   repository.PassengerAbsenceRepository
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	locations     TimezoneResolver
+}
+
+// NewPassengerAbsenceService - 결석 신고 서비스 생성 (locations가 nil이면 한국 시간 기준)
+func NewPassengerAbsenceService(
+	absenceRepo repository.PassengerAbsenceRepository,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	locations TimezoneResolver,
+) *PassengerAbsenceService {
+	return &PassengerAbsenceService{
+		absenceRepo:   absenceRepo,
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		locations:     locations,
+	}
+}
+
+// Create - 결석 신고 (같은 날짜에 시간대가 겹치는 신고가 있으면 DUPLICATE)
+func (s *PassengerAbsenceService) Create(ctx context.Context, passengerID string, req *dto.CreatePassengerAbsenceRequest) (*domain.PassengerAbsence, error) {
+	principal, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	if err != nil {
+		return nil, err
+	}
+	// 바인딩에서 형식 검증 완료
+	date, _ := time.Parse(time.DateOnly, req.Date)
+	if date.Before(s.today(ctx, passenger)) {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"date": "지난 날짜는 결석 신고할 수 없습니다",
+		})
+	}
+
+	timeSlot := domain.TimeSlot(req.TimeSlot)
+	existing, err := s.absenceRepo.ListByPassenger(ctx, passengerID, date)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	for _, absence := range existing {
+		if absence.Overlaps(date, timeSlot) {
+			return nil, util.NewDuplicateError("결석 신고")
+		}
+	}
+
+	absence := domain.NewPassengerAbsence(passengerID, date, timeSlot, req.Reason, principal.UserID)
+	if err := s.absenceRepo.Create(ctx, absence); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	s.applyToTrips(ctx, absence, true)
+	return absence, nil
+}
+
+// List - 오늘 이후 결석 신고 목록 (날짜 순)
+func (s *PassengerAbsenceService) List(ctx context.Context, passengerID string) ([]*domain.PassengerAbsence, error) {
+	_, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	if err != nil {
+		return nil, err
+	}
+	absences, err := s.absenceRepo.ListByPassenger(ctx, passengerID, s.today(ctx, passenger))
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return absences, nil
+}
+
+// Delete - 결석 신고 취소 (그날 운행 탑승 기록의 결석 표시도 해제)
+func (s *PassengerAbsenceService) Delete(ctx context.Context, passengerID, id string) error {
+	_, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	if err != nil {
+		return err
+	}
+	absence, err := s.absenceRepo.GetByID(ctx, id)
+	if err != nil {
+		return toAppError(err, "결석 신고")
+	}
+	if absence.PassengerID != passengerID {
+		return util.NewNotFoundError("결석 신고")
+	}
+	if absence.Date.Format(time.DateOnly) < s.today(ctx, passenger).Format(time.DateOnly) {
+		return util.NewConflictError("지난 결석 신고는 취소할 수 없습니다")
+	}
+
+	if err := s.absenceRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "결석 신고")
+	}
+	s.applyToTrips(ctx, absence, false)
+	return nil
+}
+
+// today - 탑승자 소속 기관 시간대 기준 오늘 날짜
+func (s *PassengerAbsenceService) today(ctx context.Context, passenger *domain.Passenger) time.Time {
+	return organizationToday(ctx, s.locations, passenger.OrganizationID)
+}
+
+// authorizePassengerAccess - 관리자 또는 탑승자에 연결된 보호자인지 확인하고 탑승자 반환 (결석 신고, 월별 출결 공용)
+func authorizePassengerAccess(
+	ctx context.Context,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	passengerID string,
+) (*auth.Principal, *domain.Passenger, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, nil, util.NewUnauthorizedError()
+	}
+	passenger, err := passengerRepo.GetByID(ctx, passengerID)
+	if err != nil {
+		return nil, nil, toAppError(err, "탑승자")
+	}
+
+	switch {
+	case principal.IsAdmin():
+		return principal, passenger, nil
+	case principal.Role == domain.RoleGuardian && principal.ProfileID != "":
+		_, err := guardianRepo.GetLink(ctx, principal.ProfileID, passengerID)
+		if err == nil {
+			return principal, passenger, nil
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, util.NewInternalError(err)
+		}
+	}
+	return nil, nil, util.NewForbiddenError()
+}
+
+// applyToTrips - 이미 만들어진 그날 운행의 탑승 기록에 결석 신고 반영/해제
+// 승차/불참 기록이 있으면 그대로 두고, 실패해도 신고 자체는 유지 (운행 생성 시에는 다시 반영됨)
+func (s *PassengerAbsenceService) applyToTrips(ctx context.Context, absence *domain.PassengerAbsence, excuse bool) {
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{Date: &absence.Date})
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to list trips for absence", map[string]interface{}{"absence_id": absence.ID, "error": err.Error()})
+		return
+	}
+
+	for _, trip := range trips {
+		if trip.IsCompleted() || trip.IsCancelled() {
+			continue
+		}
+		record := findTripPassenger(trip, absence.PassengerID)
+		if record == nil || record.IsBoarded || record.IsNoShow() || record.IsExcused() == excuse {
+			continue
+		}
+		schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to get trip schedule for absence", map[string]interface{}{"trip_id": trip.ID, "error": err.Error()})
+			continue
+		}
+		if !absence.Covers(trip.Date, schedule.TimeSlot) {
+			continue
+		}
+
+		if excuse {
+			record.MarkExcused(absence.Reason)
+		} else {
+			record.ClearExcused()
+		}
+		if err := s.tripRepo.SavePassenger(ctx, record); err != nil {
+			logger.FromContext(ctx).Warn("Failed to save trip passenger absence", map[string]interface{}{"trip_id": trip.ID, "passenger_id": absence.PassengerID, "error": err.Error()})
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 탑승자 사진과 보호자 사진 동의
+// 🎯 실무 포인트: 대체 기사/동승자가 처음 보는 아이를 확인할 수 있도록 사진을 등록 (선택)
+// 보호자가 앱에서 동의하거나, 서면 동의를 받은 관리자가 대신 기록 → 동의 시각/기록자를 함께 저장
+// ⚠️ 주의사항: 동의가 없으면 사진 등록/조회 모두 403, 동의를 철회하면 등록된 사진 파일도 삭제
+// 파일은 FileService(용도 passenger_photo, JPEG/PNG 최대 5MB)가 검증/저장 → /files 업로드로는 올릴 수 없음
+
+// PassengerPhotoService - 탑승자 사진 서비스
+type PassengerPhotoService struct {
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+	fileService   *FileService
+}
+
+// NewPassengerPhotoService - 탑승자 사진 서비스 생성
+func NewPassengerPhotoService(
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	fileService *FileService,
+) *PassengerPhotoService {
+	return &PassengerPhotoService{
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+		fileService:   fileService,
+	}
+}
+
+// SetConsent - 사진 동의/철회 기록 (관리자 또는 연결된 보호자, 철회하면 사진 삭제)
+func (s *PassengerPhotoService) SetConsent(ctx context.Context, passengerID string, consent bool) (*domain.Passenger, error) {
+	principal, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := passenger.RecordPhotoConsent(consent, principal.UserID, time.Now())
+	if err := s.passengerRepo.Update(ctx, passenger); err != nil {
+		return nil, toAppError(err, "탑승자")
+	}
+	if previous != nil {
+		s.removeFile(ctx, *previous)
+	}
+	return passenger, nil
+}
+
+// UploadPhoto - 사진 등록 (관리자 또는 연결된 보호자, 동의가 없으면 FORBIDDEN, 이전 사진은 삭제)
+func (s *PassengerPhotoService) UploadPhoto(ctx context.Context, passengerID, fileName string, data []byte) (*domain.Passenger, error) {
+	_, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	if err != nil {
+		return nil, err
+	}
+	if !passenger.HasPhotoConsent() {
+		return nil, photoConsentRequired()
+	}
+
+	file, err := s.fileService.Upload(ctx, domain.FilePurposePassengerPhoto, fileName, data)
+	if err != nil {
+		return nil, err
+	}
+	previous := passenger.SetPhoto(file.ID)
+	if err := s.passengerRepo.Update(ctx, passenger); err != nil {
+		s.removeFile(ctx, file.ID)
+		return nil, toAppError(err, "탑승자")
+	}
+	if previous != nil {
+		s.removeFile(ctx, *previous)
+	}
+	return passenger, nil
+}
+
+// Photo - 사진 파일 메타데이터와 내용 (관리자/기사/동승자 또는 연결된 보호자, 동의가 없으면 FORBIDDEN)
+func (s *PassengerPhotoService) Photo(ctx context.Context, passengerID string) (*domain.File, []byte, error) {
+	passenger, err := s.viewablePassenger(ctx, passengerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !passenger.HasPhotoConsent() {
+		return nil, nil, photoConsentRequired()
+	}
+	if passenger.PhotoFileID == nil {
+		return nil, nil, util.NewNotFoundError("탑승자 사진")
+	}
+	return s.fileService.Read(ctx, *passenger.PhotoFileID)
+}
+
+// DeletePhoto - 사진 삭제 (관리자 또는 연결된 보호자, 동의는 그대로)
+func (s *PassengerPhotoService) DeletePhoto(ctx context.Context, passengerID string) error {
+	_, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	if err != nil {
+		return err
+	}
+	previous := passenger.RemovePhoto()
+	if previous == nil {
+		return util.NewNotFoundError("탑승자 사진")
+	}
+	if err := s.passengerRepo.Update(ctx, passenger); err != nil {
+		return toAppError(err, "탑승자")
+	}
+	s.removeFile(ctx, *previous)
+	return nil
+}
+
+// viewablePassenger - 사진을 볼 수 있는 사용자인지 확인 (기사/동승자는 모든 탑승자, 보호자는 연결된 자녀만)
+func (s *PassengerPhotoService) viewablePassenger(ctx context.Context, passengerID string) (*domain.Passenger, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, util.NewUnauthorizedError()
+	}
+	if principal.HasRole(domain.RoleDriver, domain.RoleAttendant) {
+		passenger, err := s.passengerRepo.GetByID(ctx, passengerID)
+		if err != nil {
+			return nil, toAppError(err, "탑승자")
+		}
+		return passenger, nil
+	}
+	_, passenger, err := authorizePassengerAccess(ctx, s.passengerRepo, s.guardianRepo, passengerID)
+	return passenger, err
+}
+
+// removeFile - 사진 파일 삭제 (실패는 로그만)
+func (s *PassengerPhotoService) removeFile(ctx context.Context, fileID string) {
+	if err := s.fileService.Delete(ctx, fileID); err != nil {
+		logger.FromContext(ctx).Warn("Failed to delete passenger photo", map[string]interface{}{
+			"file_id": fileID,
+			"error":   err.Error(),
+		})
+	}
+}
+
+// photoConsentRequired - 보호자 사진 동의가 없을 때의 FORBIDDEN
+func photoConsentRequired() error {
+	return util.NewForbiddenError().WithDetail("photo_consent", "보호자의 사진 동의가 기록되지 않았습니다")
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 탑승자 비즈니스 로직
+// 🎯 실무 포인트: 정류장 배정 시 정류장이 해당 경로 소속인지 확인하고 순서를 캐싱
+// 다른 경로로 배정하면 그 경로를 쓰는 활성 일정의 차량 승객 정원을 넘는지 확인 (넘으면 CONFLICT + 초과 인원)
+// 검색은 아이 이름/보호자 이름 부분 일치 + 보호자 연락처 전체 일치 (연락처는 암호화 저장이라 검색용 해시로 비교)
+// ⚠️ 주의사항: 배정 검증 실패는 route_id/stop_id 필드별 VALIDATION_ERROR로 반환
+
+// defaultSearchLimit - 탑승자 검색 기본 결과 수
+const defaultSearchLimit = 20
+
+// PassengerService - 탑승자 서비스
+type PassengerService struct {
+	passengerRepo repository.PassengerRepository
+	routeRepo     repository.RouteRepository
+	scheduleRepo  repository.ScheduleRepository
+	vehicleRepo   repository.VehicleRepository
+}
+
+// NewPassengerService - 탑승자 서비스 생성
+func NewPassengerService(
+	passengerRepo repository.PassengerRepository,
+	routeRepo repository.RouteRepository,
+	scheduleRepo repository.ScheduleRepository,
+	vehicleRepo repository.VehicleRepository,
+) *PassengerService {
+	return &PassengerService{
+		passengerRepo: passengerRepo,
+		routeRepo:     routeRepo,
+		scheduleRepo:  scheduleRepo,
+		vehicleRepo:   vehicleRepo,
+	}
+}
+
+// Create - 탑승자 등록 (route_id/stop_id 전달 시 정류장 배정까지 처리)
+func (s *PassengerService) Create(ctx context.Context, req *dto.CreatePassengerRequest) (*domain.Passenger, error) {
+	passenger := newPassengerFromRequest(req)
+
+	if req.RouteID != "" {
+		if err := s.assign(ctx, passenger, req.RouteID, req.StopID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.passengerRepo.Create(ctx, passenger); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return passenger, nil
+}
+
+// Get - 탑승자 단건 조회
+func (s *PassengerService) Get(ctx context.Context, id string) (*domain.Passenger, error) {
+	passenger, err := s.passengerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "탑승자")
+	}
+	return passenger, nil
+}
+
+// List - 탑승자 목록 조회
+func (s *PassengerService) List(ctx context.Context, filter repository.PassengerFilter) ([]*domain.Passenger, int64, error) {
+	passengers, total, err := s.passengerRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return passengers, total, nil
+}
+
+// Search - 아이 이름, 보호자 이름, 보호자 연락처로 탑승자 검색 (전화 문의 응대용)
+// 이름은 부분 일치, 연락처는 전체 번호 일치 (하이픈/+82 형식 무관)
+func (s *PassengerService) Search(ctx context.Context, query string, limit int) ([]*domain.Passenger, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < 2 {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"q": "검색어는 2자 이상이어야 합니다",
+		})
+	}
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	passengers, err := s.passengerRepo.Search(ctx, query, limit)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return passengers, nil
+}
+
+// Update - 탑승자 기본 정보 수정
+func (s *PassengerService) Update(ctx context.Context, id string, req *dto.UpdatePassengerRequest) (*domain.Passenger, error) {
+	passenger, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		passenger.Name = *req.Name
+	}
+	if req.Age != nil {
+		passenger.Age = *req.Age
+	}
+	if req.Gender != nil {
+		passenger.Gender = *req.Gender
+	}
+	if req.Address != nil {
+		passenger.Address = *req.Address
+	}
+	if req.MedicalNotes != nil {
+		passenger.UpdateMedicalNotes(*req.MedicalNotes)
+	}
+	if req.Notes != nil {
+		passenger.Notes = *req.Notes
+	}
+	if req.Status != nil {
+		if domain.PassengerStatus(*req.Status) == domain.PassengerStatusActive {
+			passenger.SetActive()
+		} else {
+			passenger.SetInactive()
+		}
+	}
+
+	return s.save(ctx, passenger)
+}
+
+// AssignToStop - 정류장 배정
+func (s *PassengerService) AssignToStop(ctx context.Context, id string, req *dto.AssignStopRequest) (*domain.Passenger, error) {
+	passenger, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.assign(ctx, passenger, req.RouteID, req.StopID); err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, passenger)
+}
+
+// UnassignFromStop - 정류장 배정 해제
+func (s *PassengerService) UnassignFromStop(ctx context.Context, id string) (*domain.Passenger, error) {
+	passenger, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	passenger.UnassignFromStop()
+	return s.save(ctx, passenger)
+}
+
+// UpdateGuardian - 보호자/비상 연락처 수정
+func (s *PassengerService) UpdateGuardian(ctx context.Context, id string, req *dto.UpdateGuardianRequest) (*domain.Passenger, error) {
+	passenger, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	passenger.UpdateGuardianInfo(deref(req.GuardianName), deref(req.GuardianPhone), deref(req.GuardianEmail), deref(req.GuardianRelation))
+	if req.EmergencyContact != nil || req.EmergencyRelation != nil {
+		contact, relation := passenger.EmergencyContact, passenger.EmergencyRelation
+		if req.EmergencyContact != nil {
+			contact = *req.EmergencyContact
+		}
+		if req.EmergencyRelation != nil {
+			relation = *req.EmergencyRelation
+		}
+		passenger.UpdateEmergencyContact(contact, relation)
+	}
+
+	return s.save(ctx, passenger)
+}
+
+// Delete - 탑승자 삭제
+func (s *PassengerService) Delete(ctx context.Context, id string) error {
+	if err := s.passengerRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "탑승자")
+	}
+	return nil
+}
+
+// Restore - 삭제된 탑승자 복구 (익명화된 탑승자는 CONFLICT, 배정 경로가 있으면 정원 확인)
+func (s *PassengerService) Restore(ctx context.Context, id string) (*domain.Passenger, error) {
+	passenger, err := s.passengerRepo.GetDeleted(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "삭제된 탑승자")
+	}
+	if passenger.IsAnonymized() {
+		return nil, util.NewConflictError("개인정보가 익명화된 탑승자는 복구할 수 없습니다")
+	}
+	if passenger.Status == domain.PassengerStatusActive && passenger.IsAssigned() {
+		if err := s.checkCapacity(ctx, passenger.AssignedRouteID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.passengerRepo.Restore(ctx, id); err != nil {
+		return nil, toAppError(err, "삭제된 탑승자")
+	}
+	return s.Get(ctx, id)
+}
+
+// assign - 경로/정류장 검증 후 배정 (정류장 순서 캐싱, 다른 경로로 옮기면 정원 확인)
+func (s *PassengerService) assign(ctx context.Context, passenger *domain.Passenger, routeID, stopID string) error {
+	route, err := s.routeRepo.GetByID(ctx, routeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"route_id": "존재하지 않는 경로입니다",
+			})
+		}
+		return util.NewInternalError(err)
+	}
+	if !route.IsActive() {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"route_id": "비활성 상태의 경로입니다",
+		})
+	}
+
+	for _, stop := range route.Stops {
+		if stop.ID == stopID {
+			if passenger.IsActive() && passenger.AssignedRouteID != route.ID {
+				if err := s.checkCapacity(ctx, route.ID); err != nil {
+					return err
+				}
+			}
+			passenger.AssignToStop(route.ID, stop.ID, stop.Order)
+			return nil
+		}
+	}
+
+	return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+		"stop_id": "해당 경로에 속한 정류장이 아닙니다",
+	})
+}
+
+// checkCapacity - 경로에 한 명을 더 배정해도 그 경로를 쓰는 활성 일정마다 차량 승객 정원 안인지 확인
+func (s *PassengerService) checkCapacity(ctx context.Context, routeID string) error {
+	return checkRouteCapacity(ctx, s.passengerRepo, s.scheduleRepo, s.vehicleRepo, routeID, 1)
+}
+
+// newPassengerFromRequest - 요청으로 탑승자 생성 (정류장 배정 제외)
+func newPassengerFromRequest(req *dto.CreatePassengerRequest) *domain.Passenger {
+	passenger := domain.NewPassenger(req.Name, req.GuardianName, req.GuardianPhone)
+	passenger.Age = req.Age
+	passenger.Gender = req.Gender
+	passenger.GuardianEmail = req.GuardianEmail
+	passenger.GuardianRelation = req.GuardianRelation
+	passenger.EmergencyContact = req.EmergencyContact
+	passenger.EmergencyRelation = req.EmergencyRelation
+	passenger.Address = req.Address
+	passenger.MedicalNotes = req.MedicalNotes
+	passenger.Notes = req.Notes
+	return passenger
+}
+
+// save - 변경된 탑승자 저장
+func (s *PassengerService) save(ctx context.Context, passenger *domain.Passenger) (*domain.Passenger, error) {
+	if err := s.passengerRepo.Update(ctx, passenger); err != nil {
+		return nil, toAppError(err, "탑승자")
+	}
+	return passenger, nil
+}
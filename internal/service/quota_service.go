@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 인증 주체(사용자/API 키)별 일일 요청 한도
+// 🎯 실무 포인트: IP 기준 빈도 제한과 별개로 연동사별 하루 호출량을 제한 (API 키마다 한도 지정 가능)
+// ⚠️ 주의사항: 한도 초과 후의 요청도 사용량에 포함 → 연동사는 remaining을 보고 호출을 멈춰야 함
+
+// QuotaLimits - 일일 기본 한도 (0이면 무제한)
+type QuotaLimits struct {
+	User   int64 // 로그인 사용자
+	APIKey int64 // API 키 (키에 DailyQuota가 있으면 그 값)
+}
+
+// QuotaService - 일일 요청 한도 서비스
+type QuotaService struct {
+	usageRepo repository.UsageRepository
+	limits    QuotaLimits
+}
+
+// NewQuotaService - 일일 요청 한도 서비스 생성
+func NewQuotaService(usageRepo repository.UsageRepository, limits QuotaLimits) *QuotaService {
+	return &QuotaService{usageRepo: usageRepo, limits: limits}
+}
+
+// ConsumeQuota - 요청 1회 기록 후 사용량 반환 (한도 초과 시 TOO_MANY_REQUESTS)
+func (s *QuotaService) ConsumeQuota(ctx context.Context, principal *auth.Principal) (*domain.Usage, error) {
+	identityType, limit := s.identity(principal)
+	now := time.Now()
+
+	used, err := s.usageRepo.Increment(ctx, usageIdentity(identityType, principal.UserID), domain.QuotaDay(now))
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	usage := domain.NewUsage(identityType, principal.UserID, used, limit, now)
+	if usage.Exceeded() {
+		return usage, util.NewTooManyRequestsError(usage.ResetsAt.Sub(now))
+	}
+	return usage, nil
+}
+
+// GetUsage - 오늘 사용량 조회 (기록하지 않음)
+func (s *QuotaService) GetUsage(ctx context.Context, principal *auth.Principal) (*domain.Usage, error) {
+	identityType, limit := s.identity(principal)
+	now := time.Now()
+
+	used, err := s.usageRepo.Get(ctx, usageIdentity(identityType, principal.UserID), domain.QuotaDay(now))
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return domain.NewUsage(identityType, principal.UserID, used, limit, now), nil
+}
+
+// identity - 집계 단위와 적용 한도
+func (s *QuotaService) identity(principal *auth.Principal) (domain.UsageIdentityType, int64) {
+	if principal.IsAPIKey() {
+		if principal.DailyQuota > 0 {
+			return domain.UsageIdentityAPIKey, int64(principal.DailyQuota)
+		}
+		return domain.UsageIdentityAPIKey, s.limits.APIKey
+	}
+	return domain.UsageIdentityUser, s.limits.User
+}
+
+// usageIdentity - 사용량 저장소 키 (예: "api_key:{id}")
+func usageIdentity(identityType domain.UsageIdentityType, id string) string {
+	return string(identityType) + ":" + id
+}
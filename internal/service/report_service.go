@@ -0,0 +1,707 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/pdf"
+	"github.com/hyeokjun/eodini/pkg/tabular"
+)
+
+// 📝 설명: 보고서 내보내기 (출결 기록 Excel, 차량별 운행일지 PDF)
+// 🎯 실무 포인트: 시설 관리자가 관할 기관에 제출하는 출결 기록 → 운행마다 탑승자 한 명당 한 행 (승차/하차 시각, 불참 사유)
+// 운행일지는 차량 한 대의 운행마다 기사, 출발/도착 시각, 주행 거리, 인원을 한 행으로 (기록 보관용)
+// 기사별 근무 보고서는 운행 횟수, 운행 시간, 주행 거리, 대체 운행 일수 합계 (급여 정산, 근로 시간 관리)
+// 차량 가동률은 운행일(어느 차량이든 운행한 날) 대비 그 차량이 운행한 날 비율 (주말/방학/공휴일은 자연히 제외)
+// 정시성 통계는 실제 출발(일정 출발 시각 대비)과 정류장 도착(예상 도착 시각 대비) 지연을 경로별/기사별 백분위로
+// 삭제된 일정/경로/차량/기사/탑승자도 이름을 채움 (지난 기록은 삭제와 무관하게 제출 대상)
+// ⚠️ 주의사항: 시각은 운행 기관의 시간대, 취소된 운행은 제외, 기간은 최대 maxReportDays일 (행 전체를 메모리에서 만듦)
+
+// maxReportDays - 보고서 한 번에 조회할 수 있는 최대 일수 (1년)
+const maxReportDays = 366
+
+// attendanceHeader - 출결 기록 열
+var attendanceHeader = []string{"운행 날짜", "일정", "출발 시각", "경로", "차량 번호", "기사", "탑승자", "정류장", "출결", "승차 시각", "하차 시각", "사유"}
+
+// drivingLogColumns - 운행일지 열
+var drivingLogColumns = []pdf.Column{
+	{Header: "운행 날짜", Width: 1.1, Align: pdf.AlignCenter},
+	{Header: "일정", Width: 1.8},
+	{Header: "기사", Width: 1},
+	{Header: "상태", Width: 0.8, Align: pdf.AlignCenter},
+	{Header: "출발", Width: 0.7, Align: pdf.AlignCenter},
+	{Header: "도착", Width: 0.7, Align: pdf.AlignCenter},
+	{Header: "운행(분)", Width: 0.8, Align: pdf.AlignRight},
+	{Header: "주행 거리(km)", Width: 1.1, Align: pdf.AlignRight},
+	{Header: "배정", Width: 0.6, Align: pdf.AlignRight},
+	{Header: "승차", Width: 0.6, Align: pdf.AlignRight},
+	{Header: "불참", Width: 0.6, Align: pdf.AlignRight},
+	{Header: "결석", Width: 0.6, Align: pdf.AlignRight},
+}
+
+// tripStatusLabels - 운행일지 상태 표기 (취소된 운행은 일지에서 제외)
+var tripStatusLabels = map[domain.TripStatus]string{
+	domain.TripStatusPending:    "대기",
+	domain.TripStatusInProgress: "운행 중",
+	domain.TripStatusPaused:     "일시정지",
+	domain.TripStatusCompleted:  "완료",
+}
+
+// ReportService - 보고서 서비스
+type ReportService struct {
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	routeRepo     repository.RouteRepository
+	vehicleRepo   repository.VehicleRepository
+	driverRepo    repository.DriverRepository
+	passengerRepo repository.PassengerRepository
+	eventRepo     repository.TripStopEventRepository
+	locations     TimezoneResolver
+}
+
+// NewReportService - 보고서 서비스 생성 (locations가 nil이면 한국 시간)
+func NewReportService(
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	vehicleRepo repository.VehicleRepository,
+	driverRepo repository.DriverRepository,
+	passengerRepo repository.PassengerRepository,
+	eventRepo repository.TripStopEventRepository,
+	locations TimezoneResolver,
+) *ReportService {
+	return &ReportService{
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		routeRepo:     routeRepo,
+		vehicleRepo:   vehicleRepo,
+		driverRepo:    driverRepo,
+		passengerRepo: passengerRepo,
+		eventRepo:     eventRepo,
+		locations:     locations,
+	}
+}
+
+// reportTrip - 보고서 행을 만들 운행과 일정
+type reportTrip struct {
+	trip     *domain.Trip
+	schedule *domain.Schedule
+}
+
+// ExportAttendance - 기간(from~to, 포함)의 출결 기록 .xlsx (routeID가 있으면 그 경로 일정의 운행만)
+func (s *ReportService) ExportAttendance(ctx context.Context, from, to time.Time, routeID string) ([]byte, error) {
+	if err := validateReportPeriod(from, to); err != nil {
+		return nil, err
+	}
+
+	routes, err := listByID(ctx, s.routeRepo.List, repository.RouteFilter{IncludeDeleted: true}, func(r *domain.Route) string { return r.ID })
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := routes[routeID]; routeID != "" && !ok {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"route_id": "존재하지 않는 경로입니다",
+		})
+	}
+	schedules, err := listByID(ctx, s.scheduleRepo.List, repository.ScheduleFilter{IncludeDeleted: true}, func(sc *domain.Schedule) string { return sc.ID })
+	if err != nil {
+		return nil, err
+	}
+	vehicles, err := listByID(ctx, s.vehicleRepo.List, repository.VehicleFilter{IncludeDeleted: true}, func(v *domain.Vehicle) string { return v.ID })
+	if err != nil {
+		return nil, err
+	}
+	drivers, err := listByID(ctx, s.driverRepo.List, repository.DriverFilter{IncludeDeleted: true}, func(d *domain.Driver) string { return d.ID })
+	if err != nil {
+		return nil, err
+	}
+	passengers, err := listByID(ctx, s.passengerRepo.List, repository.PassengerFilter{IncludeDeleted: true}, func(p *domain.Passenger) string { return p.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.TripFilter{From: &from, To: &to, WithPassengers: true}
+	if routeID != "" {
+		filter.ScheduleIDs = []string{}
+		for _, schedule := range schedules {
+			if schedule.RouteID == routeID {
+				filter.ScheduleIDs = append(filter.ScheduleIDs, schedule.ID)
+			}
+		}
+	}
+	trips, _, err := s.tripRepo.List(ctx, filter)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	var ordered []reportTrip
+	for _, trip := range trips {
+		schedule, ok := schedules[trip.ScheduleID]
+		if !ok || trip.IsCancelled() {
+			continue
+		}
+		ordered = append(ordered, reportTrip{trip: trip, schedule: schedule})
+	}
+	sortReportTrips(ordered)
+
+	passengerName := func(id string) string {
+		return lookupName(passengers, id, func(p *domain.Passenger) string { return p.Name })
+	}
+	stops := make(map[string]map[string]domain.Stop) // 경로 ID → 정류장 ID → 정류장
+	var rows [][]string
+	for _, item := range ordered {
+		trip, schedule := item.trip, item.schedule
+		routeStops, ok := stops[schedule.RouteID]
+		if !ok {
+			list, err := s.routeRepo.ListStops(ctx, schedule.RouteID)
+			if err != nil {
+				return nil, util.NewInternalError(err)
+			}
+			routeStops = make(map[string]domain.Stop, len(list))
+			for _, stop := range list {
+				routeStops[stop.ID] = stop
+			}
+			stops[schedule.RouteID] = routeStops
+		}
+
+		records := append([]domain.TripPassenger(nil), trip.TripPassengers...)
+		sort.SliceStable(records, func(i, j int) bool {
+			a, b := routeStops[records[i].StopID], routeStops[records[j].StopID]
+			if a.Order != b.Order {
+				return a.Order < b.Order
+			}
+			return passengerName(records[i].PassengerID) < passengerName(records[j].PassengerID)
+		})
+
+		location := organizationLocation(ctx, s.locations, trip.OrganizationID)
+		for _, record := range records {
+			status, reason := attendanceStatus(&record)
+			rows = append(rows, []string{
+				trip.Date.Format(time.DateOnly),
+				schedule.Name,
+				schedule.StartTime,
+				lookupName(routes, schedule.RouteID, func(r *domain.Route) string { return r.Name }),
+				lookupName(vehicles, trip.VehicleID, func(v *domain.Vehicle) string { return v.PlateNumber }),
+				lookupName(drivers, trip.AssignedDriverID, func(d *domain.Driver) string { return d.Name }),
+				passengerName(record.PassengerID),
+				routeStops[record.StopID].Name,
+				status,
+				reportTime(record.BoardedAt, location),
+				reportTime(record.AlightedAt, location),
+				reason,
+			})
+		}
+	}
+
+	data, err := tabular.WriteXLSX(tabular.Sheet{Name: "출결 기록", Header: attendanceHeader, Rows: rows})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return data, nil
+}
+
+// ExportDrivingLog - 차량 한 대의 기간(from~to, 포함) 운행일지 .pdf
+func (s *ReportService) ExportDrivingLog(ctx context.Context, vehicleID string, from, to time.Time) ([]byte, error) {
+	if err := validateReportPeriod(from, to); err != nil {
+		return nil, err
+	}
+	vehicle, err := s.vehicleRepo.GetByID(ctx, vehicleID)
+	if errors.Is(err, repository.ErrNotFound) {
+		vehicle, err = s.vehicleRepo.GetDeleted(ctx, vehicleID) // 폐차 후에도 지난 일지는 출력
+	}
+	if err != nil {
+		return nil, toAppError(err, "차량")
+	}
+
+	schedules, err := listByID(ctx, s.scheduleRepo.List, repository.ScheduleFilter{IncludeDeleted: true}, func(sc *domain.Schedule) string { return sc.ID })
+	if err != nil {
+		return nil, err
+	}
+	drivers, err := listByID(ctx, s.driverRepo.List, repository.DriverFilter{IncludeDeleted: true}, func(d *domain.Driver) string { return d.ID })
+	if err != nil {
+		return nil, err
+	}
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{From: &from, To: &to, VehicleID: vehicle.ID, WithPassengers: true})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	var ordered []reportTrip
+	for _, trip := range trips {
+		schedule, ok := schedules[trip.ScheduleID]
+		if !ok || trip.IsCancelled() {
+			continue
+		}
+		ordered = append(ordered, reportTrip{trip: trip, schedule: schedule})
+	}
+	sortReportTrips(ordered)
+
+	var rows [][]string
+	var totalMinutes, totalMeters, totalBoarded int
+	for _, item := range ordered {
+		trip := item.trip
+		boarded, noShow, excused := 0, 0, 0
+		for _, record := range trip.TripPassengers {
+			switch {
+			case record.IsExcused():
+				excused++
+			case record.IsNoShow():
+				noShow++
+			case record.IsBoarded:
+				boarded++
+			}
+		}
+		minutes, distance := "", ""
+		if trip.StartedAt != nil {
+			distance = fmt.Sprintf("%.1f", float64(trip.TotalDistance)/1000)
+		}
+		if trip.CompletedAt != nil {
+			minutes = strconv.Itoa(trip.GetDuration())
+		}
+		totalMinutes += trip.GetDuration()
+		totalMeters += trip.TotalDistance
+		totalBoarded += boarded
+		location := organizationLocation(ctx, s.locations, trip.OrganizationID)
+
+		rows = append(rows, []string{
+			trip.Date.Format(time.DateOnly),
+			item.schedule.Name,
+			lookupName(drivers, trip.AssignedDriverID, func(d *domain.Driver) string { return d.Name }),
+			tripStatusLabels[trip.Status],
+			reportTime(trip.StartedAt, location),
+			reportTime(trip.CompletedAt, location),
+			minutes,
+			distance,
+			strconv.Itoa(len(trip.TripPassengers)),
+			strconv.Itoa(boarded),
+			strconv.Itoa(noShow),
+			strconv.Itoa(excused),
+		})
+	}
+
+	data, err := pdf.Write(pdf.Document{
+		Title: "차량 운행일지",
+		Info: []string{
+			fmt.Sprintf("차량: %s (%s %s, 정원 %d명)", vehicle.PlateNumber, vehicle.Manufacturer, vehicle.Model, vehicle.Capacity),
+			fmt.Sprintf("기간: %s ~ %s", from.Format(time.DateOnly), to.Format(time.DateOnly)),
+		},
+		Columns: drivingLogColumns,
+		Rows:    rows,
+		Summary: []string{
+			fmt.Sprintf("운행 %d회, 운행 시간 %d분, 주행 거리 %.1fkm, 승차 인원 연 %d명", len(rows), totalMinutes, float64(totalMeters)/1000, totalBoarded),
+		},
+	})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return data, nil
+}
+
+// DriverSummary - 기간(from~to, 포함) 기사별 근무 합계 (삭제되지 않은 기사 전원 + 기간에 운행한 삭제된 기사)
+func (s *ReportService) DriverSummary(ctx context.Context, from, to time.Time) (*dto.DriverReportResponse, error) {
+	if err := validateReportPeriod(from, to); err != nil {
+		return nil, err
+	}
+	drivers, err := listByID(ctx, s.driverRepo.List, repository.DriverFilter{IncludeDeleted: true}, func(d *domain.Driver) string { return d.ID })
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := listByID(ctx, s.scheduleRepo.List, repository.ScheduleFilter{IncludeDeleted: true}, func(sc *domain.Schedule) string { return sc.ID })
+	if err != nil {
+		return nil, err
+	}
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{From: &from, To: &to})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	entries := make(map[string]*dto.DriverReportEntry)
+	workDays := make(map[string]map[string]bool) // 기사 ID → 운행 날짜
+	substitutionDays := make(map[string]map[string]bool)
+	entry := func(driverID string) *dto.DriverReportEntry {
+		if e, ok := entries[driverID]; ok {
+			return e
+		}
+		e := &dto.DriverReportEntry{
+			DriverID:   driverID,
+			DriverName: lookupName(drivers, driverID, func(d *domain.Driver) string { return d.Name }),
+		}
+		entries[driverID] = e
+		workDays[driverID] = map[string]bool{}
+		substitutionDays[driverID] = map[string]bool{}
+		return e
+	}
+	for _, driver := range drivers {
+		if driver.DeletedAt == nil {
+			entry(driver.ID)
+		}
+	}
+
+	for _, trip := range trips {
+		if trip.IsCancelled() || trip.AssignedDriverID == "" {
+			continue
+		}
+		e := entry(trip.AssignedDriverID)
+		date := trip.Date.Format(time.DateOnly)
+		e.Trips++
+		if trip.IsCompleted() {
+			e.CompletedTrips++
+		}
+		e.DrivingMinutes += trip.GetDuration()
+		e.TotalDistance += trip.TotalDistance
+		workDays[trip.AssignedDriverID][date] = true
+		if schedule, ok := schedules[trip.ScheduleID]; ok && schedule.DefaultDriverID != trip.AssignedDriverID {
+			substitutionDays[trip.AssignedDriverID][date] = true
+		}
+	}
+
+	response := &dto.DriverReportResponse{
+		From:    from.Format(time.DateOnly),
+		To:      to.Format(time.DateOnly),
+		Drivers: make([]dto.DriverReportEntry, 0, len(entries)),
+	}
+	for driverID, e := range entries {
+		e.WorkDays = len(workDays[driverID])
+		e.SubstitutionDays = len(substitutionDays[driverID])
+		response.Drivers = append(response.Drivers, *e)
+	}
+	sort.Slice(response.Drivers, func(i, j int) bool {
+		a, b := response.Drivers[i], response.Drivers[j]
+		if a.DriverName != b.DriverName {
+			return a.DriverName < b.DriverName
+		}
+		return a.DriverID < b.DriverID
+	})
+	return response, nil
+}
+
+// VehicleSummary - 기간(from~to, 포함) 차량별 운행 수, 주행 거리, 미운행 일수, 가동률
+// 삭제되지 않은 차량 전원 + 기간에 운행한 삭제된 차량
+func (s *ReportService) VehicleSummary(ctx context.Context, from, to time.Time) (*dto.VehicleReportResponse, error) {
+	if err := validateReportPeriod(from, to); err != nil {
+		return nil, err
+	}
+	vehicles, err := listByID(ctx, s.vehicleRepo.List, repository.VehicleFilter{IncludeDeleted: true}, func(v *domain.Vehicle) string { return v.ID })
+	if err != nil {
+		return nil, err
+	}
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{From: &from, To: &to})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	serviceDays := make(map[string]bool)
+	activeDays := make(map[string]map[string]bool) // 차량 ID → 운행 날짜
+	distances := make(map[string]int)
+	tripCounts := make(map[string]int)
+	for _, vehicle := range vehicles {
+		if vehicle.DeletedAt == nil {
+			activeDays[vehicle.ID] = map[string]bool{}
+		}
+	}
+	for _, trip := range trips {
+		if trip.IsCancelled() {
+			continue
+		}
+		date := trip.Date.Format(time.DateOnly)
+		serviceDays[date] = true
+		if activeDays[trip.VehicleID] == nil {
+			activeDays[trip.VehicleID] = map[string]bool{}
+		}
+		activeDays[trip.VehicleID][date] = true
+		distances[trip.VehicleID] += trip.TotalDistance
+		tripCounts[trip.VehicleID]++
+	}
+
+	response := &dto.VehicleReportResponse{
+		From:        from.Format(time.DateOnly),
+		To:          to.Format(time.DateOnly),
+		ServiceDays: len(serviceDays),
+		Vehicles:    make([]dto.VehicleReportEntry, 0, len(activeDays)),
+	}
+	for vehicleID, days := range activeDays {
+		entry := dto.VehicleReportEntry{
+			VehicleID:  vehicleID,
+			Trips:      tripCounts[vehicleID],
+			DistanceKm: math.Round(float64(distances[vehicleID])/100) / 10,
+			ActiveDays: len(days),
+			IdleDays:   len(serviceDays) - len(days),
+		}
+		if vehicle, ok := vehicles[vehicleID]; ok {
+			entry.PlateNumber = vehicle.PlateNumber
+			entry.Status = vehicle.Status
+			entry.Capacity = vehicle.Capacity
+		}
+		entry.Utilization = percent(len(days), len(serviceDays))
+		response.Vehicles = append(response.Vehicles, entry)
+	}
+	sort.Slice(response.Vehicles, func(i, j int) bool {
+		a, b := response.Vehicles[i], response.Vehicles[j]
+		if a.PlateNumber != b.PlateNumber {
+			return a.PlateNumber < b.PlateNumber
+		}
+		return a.VehicleID < b.VehicleID
+	})
+	return response, nil
+}
+
+// Punctuality - 기간(from~to, 포함) 출발/정류장 도착 정시성 (전체, 경로별, 기사별)
+// 출발 기록이 있는 운행만 (취소 제외), 정류장은 도착 기록이 있는 것만, 허용 오차는 운행별 정시성 보고서와 같음
+func (s *ReportService) Punctuality(ctx context.Context, from, to time.Time) (*dto.PunctualityReportResponse, error) {
+	if err := validateReportPeriod(from, to); err != nil {
+		return nil, err
+	}
+	routes, err := listByID(ctx, s.routeRepo.List, repository.RouteFilter{IncludeDeleted: true}, func(r *domain.Route) string { return r.ID })
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := listByID(ctx, s.scheduleRepo.List, repository.ScheduleFilter{IncludeDeleted: true}, func(sc *domain.Schedule) string { return sc.ID })
+	if err != nil {
+		return nil, err
+	}
+	drivers, err := listByID(ctx, s.driverRepo.List, repository.DriverFilter{IncludeDeleted: true}, func(d *domain.Driver) string { return d.ID })
+	if err != nil {
+		return nil, err
+	}
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{From: &from, To: &to})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	var started []reportTrip
+	tripIDs := make([]string, 0, len(trips))
+	for _, trip := range trips {
+		schedule, ok := schedules[trip.ScheduleID]
+		if !ok || trip.IsCancelled() || trip.StartedAt == nil {
+			continue
+		}
+		started = append(started, reportTrip{trip: trip, schedule: schedule})
+		tripIDs = append(tripIDs, trip.ID)
+	}
+	events, err := s.eventRepo.ListByTrips(ctx, tripIDs)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	arrivals := make(map[string]map[string]time.Time) // 운행 ID → 정류장 ID → 도착 시각
+	for _, event := range events {
+		if event.Type != domain.StopEventArrived {
+			continue
+		}
+		if arrivals[event.TripID] == nil {
+			arrivals[event.TripID] = map[string]time.Time{}
+		}
+		arrivals[event.TripID][event.StopID] = event.OccurredAt
+	}
+
+	overall := &punctualitySamples{}
+	byRoute := make(map[string]*punctualitySamples)
+	byDriver := make(map[string]*punctualitySamples)
+	stops := make(map[string][]domain.Stop) // 경로 ID → 정류장
+	for _, item := range started {
+		trip, schedule := item.trip, item.schedule
+		departure, err := scheduledDeparture(trip, schedule, organizationLocation(ctx, s.locations, trip.OrganizationID))
+		if err != nil {
+			continue // 일정 출발 시각 형식 오류 (일정 검증을 거치므로 정상 데이터에는 없음)
+		}
+		if _, ok := stops[schedule.RouteID]; !ok {
+			if stops[schedule.RouteID], err = s.routeRepo.ListStops(ctx, schedule.RouteID); err != nil {
+				return nil, util.NewInternalError(err)
+			}
+		}
+		if byRoute[schedule.RouteID] == nil {
+			byRoute[schedule.RouteID] = &punctualitySamples{}
+		}
+		if byDriver[trip.AssignedDriverID] == nil {
+			byDriver[trip.AssignedDriverID] = &punctualitySamples{}
+		}
+		targets := []*punctualitySamples{overall, byRoute[schedule.RouteID], byDriver[trip.AssignedDriverID]}
+
+		startDelay := trip.StartedAt.Sub(departure)
+		for _, target := range targets {
+			target.starts = append(target.starts, startDelay)
+		}
+		for _, stop := range stops[schedule.RouteID] {
+			arrived, ok := arrivals[trip.ID][stop.ID]
+			if !ok {
+				continue
+			}
+			late := arrived.Sub(departure.Add(time.Duration(stop.EstimatedArrivalTime) * time.Minute))
+			for _, target := range targets {
+				target.arrivals = append(target.arrivals, late)
+			}
+		}
+	}
+
+	response := &dto.PunctualityReportResponse{
+		From:    from.Format(time.DateOnly),
+		To:      to.Format(time.DateOnly),
+		Overall: overall.stats(),
+		Routes:  make([]dto.RoutePunctualityEntry, 0, len(byRoute)),
+		Drivers: make([]dto.DriverPunctualityEntry, 0, len(byDriver)),
+	}
+	for routeID, samples := range byRoute {
+		response.Routes = append(response.Routes, dto.RoutePunctualityEntry{
+			RouteID:          routeID,
+			RouteName:        lookupName(routes, routeID, func(r *domain.Route) string { return r.Name }),
+			PunctualityStats: samples.stats(),
+		})
+	}
+	for driverID, samples := range byDriver {
+		response.Drivers = append(response.Drivers, dto.DriverPunctualityEntry{
+			DriverID:         driverID,
+			DriverName:       lookupName(drivers, driverID, func(d *domain.Driver) string { return d.Name }),
+			PunctualityStats: samples.stats(),
+		})
+	}
+	sort.Slice(response.Routes, func(i, j int) bool {
+		a, b := response.Routes[i], response.Routes[j]
+		if a.RouteName != b.RouteName {
+			return a.RouteName < b.RouteName
+		}
+		return a.RouteID < b.RouteID
+	})
+	sort.Slice(response.Drivers, func(i, j int) bool {
+		a, b := response.Drivers[i], response.Drivers[j]
+		if a.DriverName != b.DriverName {
+			return a.DriverName < b.DriverName
+		}
+		return a.DriverID < b.DriverID
+	})
+	return response, nil
+}
+
+// punctualitySamples - 정시성 집계 대상 지연 표본
+type punctualitySamples struct {
+	starts   []time.Duration // 운행별 출발 지연
+	arrivals []time.Duration // 정류장별 도착 지연
+}
+
+// stats - 정시 건수/비율과 지연 백분위
+func (p *punctualitySamples) stats() dto.PunctualityStats {
+	stats := dto.PunctualityStats{
+		Trips:         len(p.starts),
+		RecordedStops: len(p.arrivals),
+		StartDelay:    delayPercentiles(p.starts),
+		ArrivalDelay:  delayPercentiles(p.arrivals),
+	}
+	for _, late := range p.starts {
+		if late <= punctualityTolerance {
+			stats.OnTimeStarts++
+		}
+	}
+	for _, late := range p.arrivals {
+		if late <= punctualityTolerance {
+			stats.OnTimeStops++
+		}
+	}
+	stats.OnTimeStartPct = percent(stats.OnTimeStarts, stats.Trips)
+	stats.OnTimeStopPct = percent(stats.OnTimeStops, stats.RecordedStops)
+	return stats
+}
+
+// delayPercentiles - 지연 분포 (최근접 순위 백분위, 분 단위 반올림)
+func delayPercentiles(delays []time.Duration) dto.DelayPercentile {
+	if len(delays) == 0 {
+		return dto.DelayPercentile{}
+	}
+	sorted := append([]time.Duration(nil), delays...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := func(p float64) int {
+		index := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		return int(math.Round(sorted[max(index, 0)].Minutes()))
+	}
+	return dto.DelayPercentile{P50: rank(50), P90: rank(90), P95: rank(95), Max: rank(100)}
+}
+
+// percent - 비율 (%, 소수 첫째 자리, 분모가 0이면 0)
+func percent(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*1000) / 10
+}
+
+// validateReportPeriod - 보고서 기간 검증 (종료일 ≥ 시작일, 최대 maxReportDays일)
+func validateReportPeriod(from, to time.Time) error {
+	if to.Before(from) {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"to": "종료일은 시작일 이후여야 합니다",
+		})
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > maxReportDays {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"to": util.Text("기간은 최대 %d일까지 조회할 수 있습니다", maxReportDays),
+		})
+	}
+	return nil
+}
+
+// sortReportTrips - 날짜, 출발 시각, 일정 이름 순
+func sortReportTrips(trips []reportTrip) {
+	sort.SliceStable(trips, func(i, j int) bool {
+		a, b := trips[i], trips[j]
+		if !a.trip.Date.Equal(b.trip.Date) {
+			return a.trip.Date.Before(b.trip.Date)
+		}
+		if a.schedule.StartTime != b.schedule.StartTime {
+			return a.schedule.StartTime < b.schedule.StartTime
+		}
+		return a.schedule.Name < b.schedule.Name
+	})
+}
+
+// attendanceStatus - 탑승 기록의 출결 표기와 사유 (불참/결석 사유, 하차 기록 없이 떠난 예외 사유)
+func attendanceStatus(record *domain.TripPassenger) (string, string) {
+	switch {
+	case record.IsExcused():
+		return "결석(사전 신고)", record.ExcusedReason
+	case record.IsNoShow():
+		return "불참", record.NoShowReason
+	case record.HasException():
+		return "탑승(예외 처리)", record.ExceptionReason
+	case record.IsAlighted:
+		return "하차", ""
+	case record.IsBoarded:
+		return "승차", ""
+	default:
+		return "기록 없음", ""
+	}
+}
+
+// reportTime - 보고서 시각 표기 (기관 시간대, 기록 없으면 빈 칸)
+func reportTime(t *time.Time, location *time.Location) string {
+	if t == nil {
+		return ""
+	}
+	return t.In(location).Format("15:04")
+}
+
+// listByID - 목록 전체를 ID별로 (보고서의 이름 표기용)
+func listByID[T any, F any](ctx context.Context, list func(context.Context, F) ([]*T, int64, error), filter F, id func(*T) string) (map[string]*T, error) {
+	items, _, err := list(ctx, filter)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	byID := make(map[string]*T, len(items))
+	for _, item := range items {
+		byID[id(item)] = item
+	}
+	return byID, nil
+}
+
+// lookupName - ID로 찾은 항목의 표시 이름 (없으면 빈 칸)
+func lookupName[T any](items map[string]*T, id string, name func(*T) string) string {
+	if item, ok := items[id]; ok {
+		return name(item)
+	}
+	return ""
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+)
+
+// 📝 설명: 개인정보 보존 기간 관리 (비활성 탑승자/퇴사 기사 익명화)
+// 🎯 실무 포인트: 개인정보보호법상 보존 기간이 지난 개인정보는 지체 없이 파기
+// 행은 남기고 개인정보 컬럼만 익명화 → 운행/탑승 통계(trip, trip_passengers)는 그대로 집계 가능
+// ⚠️ 주의사항: 익명화는 되돌릴 수 없음, 과거 감사 로그의 값도 함께 가림
+
+// retentionBatchSize - 한 트랜잭션에서 익명화하는 최대 행 수
+const retentionBatchSize = 100
+
+// RetentionResult - 익명화 실행 결과
+type RetentionResult struct {
+	Passengers int `json:"passengers"` // 익명화된 탑승자 수
+	Drivers    int `json:"drivers"`    // 익명화된 기사 수
+}
+
+// RetentionService - 보존 기간 관리 서비스
+type RetentionService struct {
+	passengerRepo repository.PassengerRepository
+	driverRepo    repository.DriverRepository
+	auditLogRepo  repository.AuditLogRepository
+	txManager     database.TxManager
+	period        time.Duration
+}
+
+// NewRetentionService - 보존 기간 관리 서비스 생성
+// period: 비활성 전환/퇴사/삭제 후 개인정보를 보관하는 기간
+func NewRetentionService(
+	passengerRepo repository.PassengerRepository,
+	driverRepo repository.DriverRepository,
+	auditLogRepo repository.AuditLogRepository,
+	txManager database.TxManager,
+	period time.Duration,
+) *RetentionService {
+	return &RetentionService{
+		passengerRepo: passengerRepo,
+		driverRepo:    driverRepo,
+		auditLogRepo:  auditLogRepo,
+		txManager:     txManager,
+		period:        period,
+	}
+}
+
+// Run - now 기준 보존 기간이 지난 탑승자/기사 익명화 (배치 단위 트랜잭션)
+func (s *RetentionService) Run(ctx context.Context, now time.Time) (*RetentionResult, error) {
+	cutoff := now.Add(-s.period)
+	result := &RetentionResult{}
+
+	for {
+		passengers, err := s.passengerRepo.ListForAnonymization(ctx, cutoff, retentionBatchSize)
+		if err != nil {
+			return result, util.NewInternalError(err)
+		}
+		if len(passengers) == 0 {
+			break
+		}
+		err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			for _, passenger := range passengers {
+				if err := s.anonymizePassenger(ctx, passenger, now); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return result, util.NewInternalError(err)
+		}
+		result.Passengers += len(passengers)
+	}
+
+	for {
+		drivers, err := s.driverRepo.ListForAnonymization(ctx, cutoff, retentionBatchSize)
+		if err != nil {
+			return result, util.NewInternalError(err)
+		}
+		if len(drivers) == 0 {
+			break
+		}
+		err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			for _, driver := range drivers {
+				if err := s.anonymizeDriver(ctx, driver, now); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return result, util.NewInternalError(err)
+		}
+		result.Drivers += len(drivers)
+	}
+
+	return result, nil
+}
+
+// anonymizePassenger - 과거 감사 로그를 먼저 가린 뒤 익명화 (익명화 자체의 감사 로그는 변경 전 값 없이 기록)
+func (s *RetentionService) anonymizePassenger(ctx context.Context, passenger *domain.Passenger, now time.Time) error {
+	if err := s.auditLogRepo.RedactEntity(ctx, "passengers", passenger.ID, domain.PassengerPersonalColumns); err != nil {
+		return err
+	}
+	passenger.Anonymize(now)
+	return s.passengerRepo.Anonymize(repository.WithAuditRedaction(ctx), passenger)
+}
+
+// anonymizeDriver - 과거 감사 로그를 먼저 가린 뒤 익명화
+func (s *RetentionService) anonymizeDriver(ctx context.Context, driver *domain.Driver, now time.Time) error {
+	if err := s.auditLogRepo.RedactEntity(ctx, "drivers", driver.ID, domain.DriverPersonalColumns); err != nil {
+		return err
+	}
+	driver.Anonymize(now)
+	return s.driverRepo.Anonymize(repository.WithAuditRedaction(ctx), driver)
+}
@@ -0,0 +1,553 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/maps"
+)
+
+// 📝 설명: 경로/정류장 비즈니스 로직
+// 🎯 실무 포인트: 정류장 순서 1..N 연속성을 서버에서 보장
+// 지도 API가 있으면 정류장이 바뀔 때마다 도로 경로로 예상 소요 시간/총 거리를 다시 계산
+// ⚠️ 주의사항: 순서 범위 검증은 Service, 실제 순서 이동은 Repository 트랜잭션에서 처리
+// 정류장 수정은 순서를 먼저 검증한 뒤 필드 저장과 순서 이동을 하나의 트랜잭션으로 반영
+// 지도 API 실패는 경고 로그만 남기고 정류장 변경은 그대로 반영 (기존 예상 시간/거리 유지)
+// 정류장 좌표를 생략하면 주소로 좌표를 검색 (좌표를 직접 입력하면 검색하지 않음)
+
+// maxOptimizeStops - 순서 최적화 가능한 최대 정류장 수 (거리 행렬 크기 = 정류장 수²)
+const maxOptimizeStops = 15
+
+// RouteService - 경로 서비스
+type RouteService struct {
+	routeRepo  repository.RouteRepository
+	txManager  database.TxManager
+	mapsClient maps.Client
+	geocoder   maps.Geocoder
+}
+
+// NewRouteService - 경로 서비스 생성
+// mapsClient가 nil이면 예상 시간/거리는 직접 입력값 사용, geocoder가 nil이면 정류장 좌표 필수
+func NewRouteService(routeRepo repository.RouteRepository, txManager database.TxManager, mapsClient maps.Client, geocoder maps.Geocoder) *RouteService {
+	return &RouteService{routeRepo: routeRepo, txManager: txManager, mapsClient: mapsClient, geocoder: geocoder}
+}
+
+// Create - 경로 생성 (정류장 배열 순서대로 1..N 부여)
+func (s *RouteService) Create(ctx context.Context, req *dto.CreateRouteRequest) (*domain.Route, error) {
+	route := domain.NewRoute(req.Name, req.Description, req.EstimatedTime)
+	route.TotalDistance = req.TotalDistance
+
+	for i, stopReq := range req.Stops {
+		stop, err := s.newStopFromRequest(ctx, route.ID, &stopReq, fmt.Sprintf("stops[%d].", i))
+		if err != nil {
+			return nil, err
+		}
+		stop.Order = i + 1 // 요청의 order는 무시하고 배열 순서 사용
+		route.AddStop(*stop)
+	}
+	s.applyDirections(ctx, route)
+
+	if err := s.routeRepo.Create(ctx, route); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return route, nil
+}
+
+// Get - 경로 조회 (정류장 순서대로 포함)
+func (s *RouteService) Get(ctx context.Context, id string) (*domain.Route, error) {
+	route, err := s.routeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "경로")
+	}
+	return route, nil
+}
+
+// List - 경로 목록 조회
+func (s *RouteService) List(ctx context.Context, filter repository.RouteFilter) ([]*domain.Route, int64, error) {
+	routes, total, err := s.routeRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return routes, total, nil
+}
+
+// Update - 경로 정보 수정
+func (s *RouteService) Update(ctx context.Context, id string, req *dto.UpdateRouteRequest) (*domain.Route, error) {
+	route, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		route.Name = *req.Name
+	}
+	if req.Description != nil {
+		route.Description = *req.Description
+	}
+	if req.EstimatedTime != nil {
+		route.UpdateEstimatedTime(*req.EstimatedTime)
+	}
+	if req.TotalDistance != nil {
+		route.UpdateTotalDistance(*req.TotalDistance)
+	}
+	if req.Status != nil {
+		if domain.RouteStatus(*req.Status) == domain.RouteStatusActive {
+			route.SetActive()
+		} else {
+			route.SetInactive()
+		}
+	}
+
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		return nil, toAppError(err, "경로")
+	}
+
+	return route, nil
+}
+
+// Delete - 경로 삭제 (소속 정류장 포함)
+func (s *RouteService) Delete(ctx context.Context, id string) error {
+	if err := s.routeRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "경로")
+	}
+	return nil
+}
+
+// Restore - 삭제된 경로 복구 (경로와 함께 삭제된 정류장 포함)
+func (s *RouteService) Restore(ctx context.Context, id string) (*domain.Route, error) {
+	if err := s.routeRepo.Restore(ctx, id); err != nil {
+		return nil, toAppError(err, "삭제된 경로")
+	}
+	return s.Get(ctx, id)
+}
+
+// ListStops - 경로의 정류장 목록
+func (s *RouteService) ListStops(ctx context.Context, routeID string) ([]domain.Stop, error) {
+	route, err := s.Get(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	return route.Stops, nil
+}
+
+// AddStop - 정류장 추가
+// order 생략 시 마지막(N+1), 지정 시 1..N+1 범위에서 삽입
+func (s *RouteService) AddStop(ctx context.Context, routeID string, req *dto.CreateStopRequest) (*domain.Stop, error) {
+	route, err := s.Get(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+
+	count := route.GetStopCount()
+	order := req.Order
+	if order == 0 {
+		order = count + 1
+	}
+	if err := validateStopOrder(order, count+1); err != nil {
+		return nil, err
+	}
+
+	stop, err := s.newStopFromRequest(ctx, routeID, req, "")
+	if err != nil {
+		return nil, err
+	}
+	stop.Order = order
+
+	if err := s.routeRepo.InsertStop(ctx, stop); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	s.refreshDirections(ctx, routeID)
+
+	return stop, nil
+}
+
+// UpdateStop - 정류장 수정 (order 전달 시 순서 이동)
+func (s *RouteService) UpdateStop(ctx context.Context, routeID, stopID string, req *dto.UpdateStopRequest) (*domain.Stop, error) {
+	route, err := s.Get(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+
+	stop, err := s.routeRepo.GetStop(ctx, routeID, stopID)
+	if err != nil {
+		return nil, toAppError(err, "정류장")
+	}
+
+	// 순서가 잘못되면 아무것도 저장하지 않도록 필드 변경 전에 검증
+	reorder := req.Order != nil && *req.Order != stop.Order
+	if reorder {
+		if err := validateStopOrder(*req.Order, route.GetStopCount()); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Name != nil {
+		stop.Name = *req.Name
+	}
+	if req.Address != nil {
+		stop.Address = *req.Address
+	}
+	moved := req.Latitude != nil || req.Longitude != nil
+	if moved {
+		latitude, longitude := stop.Latitude, stop.Longitude
+		if req.Latitude != nil {
+			latitude = *req.Latitude
+		}
+		if req.Longitude != nil {
+			longitude = *req.Longitude
+		}
+		stop.UpdateLocation(latitude, longitude)
+	}
+	if req.EstimatedArrivalTime != nil {
+		stop.UpdateEstimatedArrivalTime(*req.EstimatedArrivalTime)
+	}
+	if req.Notes != nil {
+		stop.Notes = *req.Notes
+	}
+
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.routeRepo.UpdateStop(ctx, stop); err != nil {
+			return err
+		}
+		if reorder {
+			return s.routeRepo.MoveStop(ctx, routeID, stopID, *req.Order)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, toAppError(err, "정류장")
+	}
+
+	if reorder {
+		stop.Order = *req.Order
+		moved = true
+	}
+	if moved {
+		s.refreshDirections(ctx, routeID)
+	}
+
+	return stop, nil
+}
+
+// RemoveStop - 정류장 삭제 (이후 정류장 순서 자동 당김)
+func (s *RouteService) RemoveStop(ctx context.Context, routeID, stopID string) error {
+	if err := s.routeRepo.RemoveStop(ctx, routeID, stopID); err != nil {
+		return toAppError(err, "정류장")
+	}
+	s.refreshDirections(ctx, routeID)
+	return nil
+}
+
+// ReorderStops - 정류장 전체 순서 재배치
+// 경로의 모든 정류장 ID를 중복 없이 한 번씩 포함해야 함
+func (s *RouteService) ReorderStops(ctx context.Context, routeID string, stopIDs []string) (*domain.Route, error) {
+	route, err := s.Get(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stopIDs) != route.GetStopCount() {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"stop_ids": util.Text("경로의 정류장 %d개를 모두 포함해야 합니다", route.GetStopCount()),
+		})
+	}
+
+	existing := make(map[string]bool, len(route.Stops))
+	for _, stop := range route.Stops {
+		existing[stop.ID] = true
+	}
+	seen := make(map[string]bool, len(stopIDs))
+	for _, id := range stopIDs {
+		if !existing[id] || seen[id] {
+			return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				"stop_ids": util.Text("잘못되었거나 중복된 정류장 ID입니다: %s", id),
+			})
+		}
+		seen[id] = true
+	}
+
+	if err := s.routeRepo.ReorderStops(ctx, routeID, stopIDs); err != nil {
+		return nil, toAppError(err, "정류장")
+	}
+	s.refreshDirections(ctx, routeID)
+
+	return s.Get(ctx, routeID)
+}
+
+// Geometry - 정류장 순서대로 지나는 도로 경로 (폴리라인, 구간별 거리/소요 시간)
+func (s *RouteService) Geometry(ctx context.Context, routeID string) (*dto.RouteGeometryResponse, error) {
+	if s.mapsClient == nil {
+		return nil, util.NewConflictError("지도 API가 설정되지 않았습니다")
+	}
+	route, err := s.Get(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if route.GetStopCount() < 2 {
+		return nil, util.NewConflictError("정류장이 2개 이상이어야 경로를 계산할 수 있습니다")
+	}
+
+	stops := orderedStops(route.Stops)
+	directions, err := s.mapsClient.Directions(ctx, stopPoints(stops))
+	if err != nil {
+		if errors.Is(err, maps.ErrNoRoute) {
+			return nil, util.NewConflictError("정류장 사이 도로 경로를 찾을 수 없습니다")
+		}
+		return nil, util.NewExternalServiceError("지도 API", err)
+	}
+
+	response := &dto.RouteGeometryResponse{
+		RouteID:  route.ID,
+		Polyline: directions.Polyline,
+		Distance: directions.Distance,
+		Duration: int(directions.Duration.Seconds()),
+		Legs:     make([]dto.RouteLegResponse, len(directions.Legs)),
+	}
+	for i, leg := range directions.Legs {
+		response.Legs[i] = dto.RouteLegResponse{
+			FromStopID: stops[i].ID,
+			ToStopID:   stops[i+1].ID,
+			Distance:   leg.Distance,
+			Duration:   int(leg.Duration.Seconds()),
+		}
+	}
+	return response, nil
+}
+
+// Optimize - 첫/마지막 정류장을 고정하고 소요 시간이 가장 짧은 정류장 순서 제안 (저장하지 않음)
+func (s *RouteService) Optimize(ctx context.Context, routeID string) (*dto.RouteOptimizeResponse, error) {
+	if s.mapsClient == nil {
+		return nil, util.NewConflictError("지도 API가 설정되지 않았습니다")
+	}
+	route, err := s.Get(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	count := route.GetStopCount()
+	if count < 3 {
+		return nil, util.NewConflictError("정류장이 3개 이상이어야 순서를 최적화할 수 있습니다")
+	}
+	if count > maxOptimizeStops {
+		return nil, util.NewConflictError("정류장이 %d개 이하인 경로만 순서를 최적화할 수 있습니다", maxOptimizeStops)
+	}
+
+	stops := orderedStops(route.Stops)
+	matrix, err := s.mapsClient.Matrix(ctx, stopPoints(stops))
+	if err != nil {
+		if errors.Is(err, maps.ErrNoRoute) {
+			return nil, util.NewConflictError("정류장 사이 도로 경로를 찾을 수 없습니다")
+		}
+		return nil, util.NewExternalServiceError("지도 API", err)
+	}
+
+	current := make([]int, count)
+	for i := range current {
+		current[i] = i
+	}
+	proposed := optimizeOrder(matrix, current)
+	currentDuration, currentDistance := pathCost(matrix, current)
+	proposedDuration, proposedDistance := pathCost(matrix, proposed)
+
+	response := &dto.RouteOptimizeResponse{
+		RouteID:           route.ID,
+		StopIDs:           make([]string, count),
+		Stops:             make([]dto.RouteOptimizeStopResponse, count),
+		Changed:           proposedDuration < currentDuration,
+		CurrentDuration:   int(currentDuration.Seconds()),
+		OptimizedDuration: int(proposedDuration.Seconds()),
+		SavedDuration:     int((currentDuration - proposedDuration).Seconds()),
+		CurrentDistance:   currentDistance,
+		OptimizedDistance: proposedDistance,
+	}
+	for i, index := range proposed {
+		stop := stops[index]
+		response.StopIDs[i] = stop.ID
+		response.Stops[i] = dto.RouteOptimizeStopResponse{
+			StopID:        stop.ID,
+			Name:          stop.Name,
+			CurrentOrder:  stop.Order,
+			ProposedOrder: i + 1,
+		}
+	}
+	return response, nil
+}
+
+// refreshDirections - 정류장 변경 후 예상 소요 시간/총 거리 다시 계산해 저장
+func (s *RouteService) refreshDirections(ctx context.Context, routeID string) {
+	if s.mapsClient == nil {
+		return
+	}
+	route, err := s.routeRepo.GetByID(ctx, routeID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load route for directions", map[string]interface{}{
+			"route_id": routeID,
+			"error":    err.Error(),
+		})
+		return
+	}
+	if !s.applyDirections(ctx, route) {
+		return
+	}
+	if err := s.routeRepo.Update(ctx, route); err != nil {
+		logger.FromContext(ctx).Warn("Failed to save route directions", map[string]interface{}{
+			"route_id": routeID,
+			"error":    err.Error(),
+		})
+	}
+}
+
+// applyDirections - 도로 경로로 예상 소요 시간(분, 올림)/총 거리 채움 (채웠으면 true)
+func (s *RouteService) applyDirections(ctx context.Context, route *domain.Route) bool {
+	if s.mapsClient == nil || len(route.Stops) < 2 {
+		return false
+	}
+	directions, err := s.mapsClient.Directions(ctx, stopPoints(orderedStops(route.Stops)))
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to calculate route directions", map[string]interface{}{
+			"route_id": route.ID,
+			"error":    err.Error(),
+		})
+		return false
+	}
+	route.UpdateEstimatedTime(int(math.Ceil(directions.Duration.Minutes())))
+	route.UpdateTotalDistance(directions.Distance)
+	return true
+}
+
+// orderedStops - 순서대로 정렬한 정류장 복사본
+func orderedStops(stops []domain.Stop) []domain.Stop {
+	ordered := append([]domain.Stop(nil), stops...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Order < ordered[j].Order })
+	return ordered
+}
+
+// stopPoints - 정류장 좌표 목록
+func stopPoints(stops []domain.Stop) []geo.Point {
+	points := make([]geo.Point, len(stops))
+	for i, stop := range stops {
+		points[i] = geo.Point{Latitude: stop.Latitude, Longitude: stop.Longitude}
+	}
+	return points
+}
+
+// newStopFromRequest - 요청으로 정류장 생성 (좌표가 없으면 주소로 검색, field는 에러 상세 키 접두사)
+func (s *RouteService) newStopFromRequest(ctx context.Context, routeID string, req *dto.CreateStopRequest, field string) (*domain.Stop, error) {
+	point, err := s.resolveLocation(ctx, req, field)
+	if err != nil {
+		return nil, err
+	}
+	stop := domain.NewStop(routeID, req.Name, req.Address, req.Order, point.Latitude, point.Longitude, req.EstimatedArrivalTime)
+	stop.Notes = req.Notes
+	return stop, nil
+}
+
+// resolveLocation - 직접 입력한 좌표 우선, 없으면 주소 검색
+func (s *RouteService) resolveLocation(ctx context.Context, req *dto.CreateStopRequest, field string) (geo.Point, error) {
+	if req.Latitude != nil && req.Longitude != nil {
+		return geo.Point{Latitude: *req.Latitude, Longitude: *req.Longitude}, nil
+	}
+	if s.geocoder == nil {
+		return geo.Point{}, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			field + "latitude":  "주소 검색을 사용할 수 없어 위도/경도를 직접 입력해야 합니다",
+			field + "longitude": "주소 검색을 사용할 수 없어 위도/경도를 직접 입력해야 합니다",
+		})
+	}
+
+	point, err := s.geocoder.Geocode(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, maps.ErrAddressNotFound) {
+			return geo.Point{}, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+				field + "address": "주소로 좌표를 찾을 수 없습니다. 주소를 확인하거나 위도/경도를 직접 입력해 주세요",
+			})
+		}
+		logger.FromContext(ctx).Warn("Failed to geocode stop address", map[string]interface{}{"address": req.Address, "error": err.Error()})
+		return geo.Point{}, util.NewExternalServiceError("주소 검색", err)
+	}
+	return point, nil
+}
+
+// optimizeOrder - 첫/마지막 지점을 고정한 최단 소요 시간 순서 (최근접 이웃으로 시작해 2-opt로 개선)
+// 최적해를 보장하지 않으며, 현재 순서보다 나빠지지 않도록 둘 중 짧은 순서에서 개선을 시작
+func optimizeOrder(matrix *maps.Matrix, current []int) []int {
+	n := len(current)
+	best := append([]int(nil), current...)
+	if candidate := nearestNeighborOrder(matrix, current); pathDuration(matrix, candidate) < pathDuration(matrix, best) {
+		best = candidate
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < n-2; i++ {
+			for k := i + 1; k < n-1; k++ {
+				candidate := append([]int(nil), best...)
+				for l, r := i, k; l < r; l, r = l+1, r-1 {
+					candidate[l], candidate[r] = candidate[r], candidate[l]
+				}
+				if pathDuration(matrix, candidate) < pathDuration(matrix, best) {
+					best = candidate
+					improved = true
+				}
+			}
+		}
+	}
+	return best
+}
+
+// nearestNeighborOrder - 첫 지점에서 출발해 가장 가까운(소요 시간) 지점을 차례로 방문, 마지막 지점은 고정
+func nearestNeighborOrder(matrix *maps.Matrix, current []int) []int {
+	n := len(current)
+	order := []int{current[0]}
+	remaining := append([]int(nil), current[1:n-1]...)
+	for len(remaining) > 0 {
+		from := order[len(order)-1]
+		next := 0
+		for i, candidate := range remaining {
+			if matrix.Legs[from][candidate].Duration < matrix.Legs[from][remaining[next]].Duration {
+				next = i
+			}
+		}
+		order = append(order, remaining[next])
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+	return append(order, current[n-1])
+}
+
+// pathDuration - 순서대로 지날 때 총 소요 시간
+func pathDuration(matrix *maps.Matrix, order []int) time.Duration {
+	duration, _ := pathCost(matrix, order)
+	return duration
+}
+
+// pathCost - 순서대로 지날 때 총 소요 시간/거리(미터)
+func pathCost(matrix *maps.Matrix, order []int) (time.Duration, int) {
+	var duration time.Duration
+	var distance int
+	for i := 1; i < len(order); i++ {
+		leg := matrix.Legs[order[i-1]][order[i]]
+		duration += leg.Duration
+		distance += leg.Distance
+	}
+	return duration, distance
+}
+
+// validateStopOrder - 정류장 순서 범위 검증 (1..max)
+func validateStopOrder(order, max int) error {
+	if order < 1 || order > max {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"order": util.Text("정류장 순서는 1부터 %d 사이여야 합니다", max),
+		})
+	}
+	return nil
+}
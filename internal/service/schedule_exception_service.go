@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 일정 예외 날짜 비즈니스 로직 (하루만 운행 안 함 / 추가 운행)
+// 🎯 실무 포인트: 일정을 고치지 않고 특정 날짜만 바꿈 → 운행 생성이 요일/유효 기간/공휴일보다 먼저 확인
+// ⚠️ 주의사항: 일정 + 날짜당 하나 (바꾸려면 삭제 후 다시 추가), 이미 만들어진 운행은 바꾸지 않음
+
+// ScheduleExceptionService - 일정 예외 서비스
+type ScheduleExceptionService struct {
+	scheduleRepo  repository.ScheduleRepository
+	exceptionRepo repository.ScheduleExceptionRepository
+}
+
+// NewScheduleExceptionService - 일정 예외 서비스 생성
+func NewScheduleExceptionService(
+	scheduleRepo repository.ScheduleRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+) *ScheduleExceptionService {
+	return &ScheduleExceptionService{
+		scheduleRepo:  scheduleRepo,
+		exceptionRepo: exceptionRepo,
+	}
+}
+
+// Add - 일정 예외 날짜 추가 (같은 날짜에 이미 있으면 중복 에러)
+func (s *ScheduleExceptionService) Add(ctx context.Context, scheduleID string, req *dto.CreateScheduleExceptionRequest) (*domain.ScheduleException, error) {
+	if _, err := s.scheduleRepo.GetByID(ctx, scheduleID); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+	date, _ := time.Parse(time.DateOnly, req.Date) // 바인딩에서 형식 검증 완료
+
+	if _, err := s.exceptionRepo.GetBySchedule(ctx, scheduleID, date); err == nil {
+		return nil, util.NewDuplicateError("일정 예외 날짜")
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, util.NewInternalError(err)
+	}
+
+	exception := domain.NewScheduleException(scheduleID, date, domain.ScheduleExceptionType(req.Type), req.Reason)
+	if err := s.exceptionRepo.Create(ctx, exception); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return exception, nil
+}
+
+// List - 일정의 예외 날짜 목록 (날짜 순)
+func (s *ScheduleExceptionService) List(ctx context.Context, scheduleID string) ([]*domain.ScheduleException, error) {
+	if _, err := s.scheduleRepo.GetByID(ctx, scheduleID); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+	exceptions, err := s.exceptionRepo.ListBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return exceptions, nil
+}
+
+// Delete - 일정 예외 날짜 삭제
+func (s *ScheduleExceptionService) Delete(ctx context.Context, scheduleID, id string) error {
+	if err := s.exceptionRepo.Delete(ctx, scheduleID, id); err != nil {
+		return toAppError(err, "일정 예외 날짜")
+	}
+	return nil
+}
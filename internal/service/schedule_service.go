@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 운행 일정 비즈니스 로직
+// 🎯 실무 포인트: 저장 전에 경로/차량/기사가 존재하고 활성 상태인지 확인
+// 같은 차량/기본 기사가 겹치는 요일·시간대의 다른 활성 일정에 배정되어 있으면 CONFLICT (이중 배정 방지)
+// 경로에 배정된 탑승자가 차량 승객 정원을 넘어도 CONFLICT (details.overflow에 초과 인원)
+// ⚠️ 주의사항: 참조 검증 실패는 필드별 VALIDATION_ERROR로 반환
+// 일정 시간대는 출발 시각 ~ 출발 시각 + 경로 예상 소요 시간 (소요 시간이 없으면 출발 시각만 비교)
+
+// ScheduleService - 일정 서비스
+type ScheduleService struct {
+	scheduleRepo  repository.ScheduleRepository
+	routeRepo     repository.RouteRepository
+	vehicleRepo   repository.VehicleRepository
+	driverRepo    repository.DriverRepository
+	passengerRepo repository.PassengerRepository
+}
+
+// NewScheduleService - 일정 서비스 생성
+func NewScheduleService(
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	vehicleRepo repository.VehicleRepository,
+	driverRepo repository.DriverRepository,
+	passengerRepo repository.PassengerRepository,
+) *ScheduleService {
+	return &ScheduleService{
+		scheduleRepo:  scheduleRepo,
+		routeRepo:     routeRepo,
+		vehicleRepo:   vehicleRepo,
+		driverRepo:    driverRepo,
+		passengerRepo: passengerRepo,
+	}
+}
+
+// Create - 일정 생성
+func (s *ScheduleService) Create(ctx context.Context, req *dto.CreateScheduleRequest) (*domain.Schedule, error) {
+	schedule := domain.NewSchedule(req.Name, req.StartTime, domain.TimeSlot(req.TimeSlot), req.DaysOfWeek, req.RouteID, req.VehicleID, req.DefaultDriverID)
+	schedule.Description = req.Description
+	if req.DefaultAttendantID != nil && *req.DefaultAttendantID != "" {
+		schedule.AssignAttendant(*req.DefaultAttendantID)
+	}
+	if req.SkipHolidays != nil {
+		schedule.SkipHolidays = *req.SkipHolidays
+	}
+	schedule.ValidFrom = req.ValidFrom
+	schedule.ValidTo = req.ValidTo
+
+	if err := s.validate(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if err := s.checkConflicts(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if err := s.checkCapacity(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepo.Create(ctx, schedule); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return schedule, nil
+}
+
+// Get - 일정 단건 조회
+func (s *ScheduleService) Get(ctx context.Context, id string) (*domain.Schedule, error) {
+	schedule, err := s.scheduleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "일정")
+	}
+	return schedule, nil
+}
+
+// List - 일정 목록 조회
+func (s *ScheduleService) List(ctx context.Context, filter repository.ScheduleFilter) ([]*domain.Schedule, int64, error) {
+	schedules, total, err := s.scheduleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return schedules, total, nil
+}
+
+// Update - 일정 수정
+func (s *ScheduleService) Update(ctx context.Context, id string, req *dto.UpdateScheduleRequest) (*domain.Schedule, error) {
+	schedule, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		schedule.Name = *req.Name
+	}
+	if req.Description != nil {
+		schedule.Description = *req.Description
+	}
+	if req.StartTime != nil {
+		schedule.UpdateStartTime(*req.StartTime)
+	}
+	if req.TimeSlot != nil {
+		schedule.TimeSlot = domain.TimeSlot(*req.TimeSlot)
+	}
+	if req.DaysOfWeek != nil {
+		schedule.UpdateDaysOfWeek(req.DaysOfWeek)
+	}
+	if req.RouteID != nil {
+		schedule.RouteID = *req.RouteID
+	}
+	if req.VehicleID != nil {
+		schedule.AssignVehicle(*req.VehicleID)
+	}
+	if req.DefaultDriverID != nil {
+		schedule.AssignDriver(*req.DefaultDriverID)
+	}
+	if req.DefaultAttendantID != nil {
+		if *req.DefaultAttendantID == "" {
+			schedule.UnassignAttendant()
+		} else {
+			schedule.AssignAttendant(*req.DefaultAttendantID)
+		}
+	}
+	if req.SkipHolidays != nil {
+		schedule.SkipHolidays = *req.SkipHolidays
+	}
+	if req.ValidFrom != nil {
+		schedule.ValidFrom = req.ValidFrom
+	}
+	if req.ValidTo != nil {
+		schedule.ValidTo = req.ValidTo
+	}
+
+	if err := s.validate(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if err := s.checkConflicts(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if err := s.checkCapacity(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+
+	return schedule, nil
+}
+
+// Activate - 일정 활성화 (참조 대상이 모두 활성 상태여야 함)
+func (s *ScheduleService) Activate(ctx context.Context, id string) (*domain.Schedule, error) {
+	schedule, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validate(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	schedule.SetActive()
+	if err := s.checkConflicts(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if err := s.checkCapacity(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+
+	return schedule, nil
+}
+
+// Deactivate - 일정 비활성화
+func (s *ScheduleService) Deactivate(ctx context.Context, id string) (*domain.Schedule, error) {
+	schedule, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.SetInactive()
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, toAppError(err, "일정")
+	}
+
+	return schedule, nil
+}
+
+// Delete - 일정 삭제
+func (s *ScheduleService) Delete(ctx context.Context, id string) error {
+	if err := s.scheduleRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "일정")
+	}
+	return nil
+}
+
+// Restore - 삭제된 일정 복구 (활성 일정이면 생성과 같이 참조·이중 배정·정원 확인)
+func (s *ScheduleService) Restore(ctx context.Context, id string) (*domain.Schedule, error) {
+	schedule, err := s.scheduleRepo.GetDeleted(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "삭제된 일정")
+	}
+	if schedule.Status == domain.ScheduleStatusActive {
+		schedule.DeletedAt = nil
+		if err := s.validate(ctx, schedule); err != nil {
+			return nil, err
+		}
+		if err := s.checkConflicts(ctx, schedule); err != nil {
+			return nil, err
+		}
+		if err := s.checkCapacity(ctx, schedule); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.scheduleRepo.Restore(ctx, id); err != nil {
+		return nil, toAppError(err, "삭제된 일정")
+	}
+	return s.Get(ctx, id)
+}
+
+// validate - 요일/유효기간 및 경로·차량·기사 참조 검증 (기본 기사는 활동 중이고 면허가 확인·유효해야 함)
+// 실패한 필드를 모아서 한 번에 반환
+func (s *ScheduleService) validate(ctx context.Context, schedule *domain.Schedule) error {
+	details := map[string]interface{}{}
+
+	seen := make(map[int]bool, len(schedule.DaysOfWeek))
+	for _, day := range schedule.DaysOfWeek {
+		if seen[day] {
+			details["days_of_week"] = "운행 요일이 중복되었습니다"
+			break
+		}
+		seen[day] = true
+	}
+
+	if schedule.ValidFrom != nil && schedule.ValidTo != nil && schedule.ValidTo.Before(*schedule.ValidFrom) {
+		details["valid_to"] = "유효 종료일은 시작일 이후여야 합니다"
+	}
+
+	if route, err := s.routeRepo.GetByID(ctx, schedule.RouteID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			return util.NewInternalError(err)
+		}
+		details["route_id"] = "존재하지 않는 경로입니다"
+	} else if !route.IsActive() {
+		details["route_id"] = "비활성 상태의 경로입니다"
+	}
+
+	if vehicle, err := s.vehicleRepo.GetByID(ctx, schedule.VehicleID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			return util.NewInternalError(err)
+		}
+		details["vehicle_id"] = "존재하지 않는 차량입니다"
+	} else if !vehicle.IsActive() {
+		details["vehicle_id"] = "운행 가능한 상태의 차량이 아닙니다"
+	}
+
+	if driver, err := s.driverRepo.GetByID(ctx, schedule.DefaultDriverID); err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			return util.NewInternalError(err)
+		}
+		details["default_driver_id"] = "존재하지 않는 기사입니다"
+	} else if !driver.IsActive() {
+		details["default_driver_id"] = "활동 중인 기사가 아닙니다"
+	} else if !driver.HasValidLicense() {
+		details["default_driver_id"] = "면허가 확인되지 않았거나 만료된 기사입니다"
+	}
+
+	if len(details) > 0 {
+		return util.NewValidationError(util.MsgValidationFailed, details)
+	}
+	return nil
+}
+
+// checkConflicts - 같은 차량/기본 기사가 겹치는 요일·유효 기간·시간대의 다른 활성 일정에 배정되어 있으면 CONFLICT
+// 겹치는 일정은 details.conflicts로 반환 (비활성 일정은 확인하지 않음)
+func (s *ScheduleService) checkConflicts(ctx context.Context, schedule *domain.Schedule) error {
+	if !schedule.IsActive() {
+		return nil
+	}
+	durations := map[string]int{}
+	start, end, err := scheduleWindow(ctx, s.routeRepo, durations, schedule)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []dto.ScheduleConflictResponse
+	for _, check := range []struct {
+		resource string
+		filter   repository.ScheduleFilter
+	}{
+		{"vehicle", repository.ScheduleFilter{Status: domain.ScheduleStatusActive, VehicleID: schedule.VehicleID}},
+		{"driver", repository.ScheduleFilter{Status: domain.ScheduleStatusActive, DriverID: schedule.DefaultDriverID}},
+	} {
+		others, _, err := s.scheduleRepo.List(ctx, check.filter)
+		if err != nil {
+			return util.NewInternalError(err)
+		}
+		for _, other := range others {
+			if other.ID == schedule.ID || !schedule.SharesDayWith(other) || !schedule.PeriodOverlaps(other) {
+				continue
+			}
+			otherStart, otherEnd, err := scheduleWindow(ctx, s.routeRepo, durations, other)
+			if err != nil {
+				return err
+			}
+			if start >= otherEnd || otherStart >= end {
+				continue
+			}
+			conflicts = append(conflicts, dto.ScheduleConflictResponse{
+				Resource:     check.resource,
+				ScheduleID:   other.ID,
+				ScheduleName: other.Name,
+				StartTime:    other.StartTime,
+				EndTime:      formatClock(otherEnd),
+				DaysOfWeek:   other.DaysOfWeek,
+			})
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return util.NewConflictErrorWithDetails("차량 또는 기사가 겹치는 시간대의 다른 일정에 이미 배정되어 있습니다",
+			map[string]interface{}{"conflicts": conflicts})
+	}
+	return nil
+}
+
+// checkCapacity - 경로에 배정된 탑승자가 일정 차량의 승객 정원을 넘으면 CONFLICT (비활성 일정은 확인하지 않음)
+func (s *ScheduleService) checkCapacity(ctx context.Context, schedule *domain.Schedule) error {
+	if !schedule.IsActive() {
+		return nil
+	}
+	vehicle, err := s.vehicleRepo.GetByID(ctx, schedule.VehicleID)
+	if err != nil {
+		return toAppError(err, "차량")
+	}
+	riders, err := routeRiders(ctx, s.passengerRepo, schedule.RouteID)
+	if err != nil {
+		return err
+	}
+	if capacityOverflow(vehicle, riders) > 0 {
+		return capacityConflict(schedule, vehicle, riders)
+	}
+	return nil
+}
+
+// scheduleWindow - 일정 시간대 (자정 기준 분, 끝은 출발 시각 + 경로 예상 소요 시간, 최소 1분)
+// 같은 경로는 한 번만 조회 (durations에 보관), 운행 재배정의 이중 배정 확인도 같은 기준
+func scheduleWindow(ctx context.Context, routeRepo repository.RouteRepository, durations map[string]int, schedule *domain.Schedule) (int, int, error) {
+	clock, err := time.Parse("15:04", schedule.StartTime)
+	if err != nil {
+		return 0, 0, util.NewInternalError(err)
+	}
+	duration, ok := durations[schedule.RouteID]
+	if !ok {
+		route, err := routeRepo.GetByID(ctx, schedule.RouteID)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return 0, 0, util.NewInternalError(err)
+		}
+		if route != nil {
+			duration = route.EstimatedTime
+		}
+		durations[schedule.RouteID] = duration
+	}
+	start := clock.Hour()*60 + clock.Minute()
+	return start, start + max(duration, 1), nil
+}
+
+// formatClock - 자정 기준 분을 HH:MM으로 (24시 이후는 다음 날 시각)
+func formatClock(minutes int) string {
+	minutes %= 24 * 60
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 운행 중 위치 수신 끊김 감지 (단말 고장, 통신 음영, 앱 종료 등)
+// 🎯 실무 포인트: 위치 수신 시각을 Redis에 남기고, 주기 작업이 운행 중인 운행의 마지막 수신 시각을 확인해
+// timeout 이상 끊기면 운행에 signal_lost_at을 표시하고 운영자에게 알림 → 위치가 다시 오면 해제
+// ⚠️ 주의사항: 위치를 한 번도 보내지 않은 운행은 운행 시작 시각을 기준으로 판단
+// 여러 인스턴스가 동시에 감지해도 표시는 DB 조건부 갱신으로 한 번만 (알림도 한 번)
+
+// SignalNotifier - 위치 수신 끊김/복구 운영자 알림
+type SignalNotifier interface {
+	NotifySignalLost(ctx context.Context, trip *domain.Trip, lastSeen time.Time) error
+	NotifySignalRestored(ctx context.Context, trip *domain.Trip) error
+}
+
+// LogSignalNotifier - 로그로만 남기는 기본 Notifier
+type LogSignalNotifier struct{}
+
+// NotifySignalLost - 끊김을 경고 로그로 기록
+func (LogSignalNotifier) NotifySignalLost(ctx context.Context, trip *domain.Trip, lastSeen time.Time) error {
+	logger.FromContext(ctx).Warn("Trip location signal lost", map[string]interface{}{
+		"trip_id":    trip.ID,
+		"vehicle_id": trip.VehicleID,
+		"driver_id":  trip.AssignedDriverID,
+		"last_seen":  lastSeen.Format(time.RFC3339),
+	})
+	return nil
+}
+
+// NotifySignalRestored - 복구를 로그로 기록
+func (LogSignalNotifier) NotifySignalRestored(ctx context.Context, trip *domain.Trip) error {
+	logger.FromContext(ctx).Info("Trip location signal restored", map[string]interface{}{
+		"trip_id":    trip.ID,
+		"vehicle_id": trip.VehicleID,
+	})
+	return nil
+}
+
+// SignalService - 위치 수신 끊김 감지 서비스
+type SignalService struct {
+	tripRepo     repository.TripRepository
+	lastSeenRepo repository.TripLastSeenRepository
+	notifier     SignalNotifier
+	timeout      time.Duration
+}
+
+// NewSignalService - 위치 수신 끊김 감지 서비스 생성 (notifier가 nil이면 로그 출력)
+func NewSignalService(
+	tripRepo repository.TripRepository,
+	lastSeenRepo repository.TripLastSeenRepository,
+	notifier SignalNotifier,
+	timeout time.Duration,
+) *SignalService {
+	if notifier == nil {
+		notifier = LogSignalNotifier{}
+	}
+	return &SignalService{
+		tripRepo:     tripRepo,
+		lastSeenRepo: lastSeenRepo,
+		notifier:     notifier,
+		timeout:      timeout,
+	}
+}
+
+// ObserveLocation - 마지막 수신 시각 갱신, 끊김 표시된 운행이면 해제 (LocationObserver 구현)
+func (s *SignalService) ObserveLocation(ctx context.Context, trip *domain.Trip, previous, current *domain.VehiclePosition) {
+	if err := s.lastSeenRepo.Touch(ctx, trip.ID, time.Now()); err != nil {
+		logger.FromContext(ctx).Warn("Failed to record last location time", map[string]interface{}{
+			"trip_id": trip.ID,
+			"error":   err.Error(),
+		})
+	}
+	if !trip.IsSignalLost() {
+		return
+	}
+
+	cleared, err := s.tripRepo.ClearSignalLost(ctx, trip.ID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to clear signal lost", map[string]interface{}{
+			"trip_id": trip.ID,
+			"error":   err.Error(),
+		})
+		return
+	}
+	if cleared {
+		trip.SignalLostAt = nil
+		if err := s.notifier.NotifySignalRestored(ctx, trip); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify signal restored", map[string]interface{}{
+				"trip_id": trip.ID,
+				"error":   err.Error(),
+			})
+		}
+	}
+}
+
+// Detect - 운행 중 timeout 이상 위치가 없는 운행을 끊김으로 표시 (새로 표시한 운행 수 반환)
+func (s *SignalService) Detect(ctx context.Context, now time.Time) (int, error) {
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{Status: domain.TripStatusInProgress})
+	if err != nil {
+		return 0, err
+	}
+	if len(trips) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, len(trips))
+	for i, trip := range trips {
+		ids[i] = trip.ID
+	}
+	seen, err := s.lastSeenRepo.GetMany(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	marked := 0
+	for _, trip := range trips {
+		if trip.IsSignalLost() {
+			continue
+		}
+		lastSeen, ok := seen[trip.ID]
+		if !ok {
+			if trip.StartedAt == nil {
+				continue
+			}
+			lastSeen = *trip.StartedAt
+		}
+		if now.Sub(lastSeen) < s.timeout {
+			continue
+		}
+
+		updated, err := s.tripRepo.MarkSignalLost(ctx, trip.ID, now)
+		if err != nil {
+			return marked, err
+		}
+		if !updated {
+			continue // 다른 인스턴스가 먼저 표시했거나 운행이 끝남
+		}
+		marked++
+		trip.SignalLostAt = &now
+		if err := s.notifier.NotifySignalLost(ctx, trip, lastSeen); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify signal lost", map[string]interface{}{
+				"trip_id": trip.ID,
+				"error":   err.Error(),
+			})
+		}
+	}
+	return marked, nil
+}
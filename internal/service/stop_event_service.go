@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 정류장 도착/출발 기록과 정시성 보고서 (실제 도착 vs 예상 도착)
+// 🎯 실무 포인트: 지오펜스 도착/출발 이벤트를 자동 기록하고, GPS가 부정확한 정류장은 기사/동승자가 앱에서 직접 기록
+// 예상 도착 시각 = 일정 출발 시각 + 정류장 예상 도착 시간(출발 후 분)
+// ⚠️ 주의사항: 운행 + 정류장 + 종류당 먼저 들어온 기록만 유지 (수동 기록 후 지오펜스 판정이 와도 덮어쓰지 않음)
+
+// punctualityTolerance - 예상 도착 시각보다 이 시간 안에 도착하면 정시
+const punctualityTolerance = 5 * time.Minute
+
+// stopEventSystemActor - 지오펜스 자동 기록의 기록자
+const stopEventSystemActor = "system"
+
+// stopEventTypes - 지오펜스 이벤트 → 정류장 기록 종류 (접근은 기록하지 않음)
+var stopEventTypes = map[geofence.EventType]domain.StopEventType{
+	geofence.EventArrived:  domain.StopEventArrived,
+	geofence.EventDeparted: domain.StopEventDeparted,
+}
+
+// stopEventLabels - 기록 종류별 표기
+var stopEventLabels = map[domain.StopEventType]string{
+	domain.StopEventArrived:  "도착",
+	domain.StopEventDeparted: "출발",
+}
+
+// StopEventService - 정류장 도착/출발 기록 서비스
+type StopEventService struct {
+	tripService  *TripService
+	scheduleRepo repository.ScheduleRepository
+	routeRepo    repository.RouteRepository
+	eventRepo    repository.TripStopEventRepository
+	locations    TimezoneResolver
+}
+
+// NewStopEventService - 정류장 도착/출발 기록 서비스 생성 (locations가 nil이면 한국 시간)
+func NewStopEventService(
+	tripService *TripService,
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	eventRepo repository.TripStopEventRepository,
+	locations TimezoneResolver,
+) *StopEventService {
+	return &StopEventService{
+		tripService:  tripService,
+		scheduleRepo: scheduleRepo,
+		routeRepo:    routeRepo,
+		eventRepo:    eventRepo,
+		locations:    locations,
+	}
+}
+
+// HandleStopEvent - 지오펜스 도착/출발 판정을 정류장 기록으로 저장 (geofence.Listener 구현)
+func (s *StopEventService) HandleStopEvent(ctx context.Context, event geofence.Event) {
+	eventType, ok := stopEventTypes[event.Type]
+	if !ok {
+		return
+	}
+
+	recorded, err := s.recorded(ctx, event.TripID, event.StopID, eventType)
+	if err == nil && !recorded {
+		err = s.eventRepo.Create(ctx, domain.NewTripStopEvent(event.TripID, event.StopID, eventType,
+			domain.StopEventSourceGeofence, stopEventSystemActor, nil, event.OccurredAt))
+	}
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to record stop event", map[string]interface{}{
+			"trip_id": event.TripID,
+			"stop_id": event.StopID,
+			"type":    string(eventType),
+			"error":   err.Error(),
+		})
+	}
+}
+
+// Record - 정류장 도착/출발 수동 기록 (배정 기사/동승자, 운행 중 또는 일시정지 중)
+// 이미 같은 종류의 기록이 있으면 DUPLICATE
+func (s *StopEventService) Record(ctx context.Context, tripID, stopID string, req *dto.RecordStopEventRequest) (*domain.TripStopEvent, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tripService.authorizeAssigned(ctx, trip); err != nil {
+		return nil, err
+	}
+	if !trip.IsActive() {
+		return nil, util.NewConflictError("운행 중에만 정류장 도착/출발을 기록할 수 있습니다: %s", trip.Status)
+	}
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return nil, toAppError(err, "운행 일정")
+	}
+	if _, err := s.routeRepo.GetStop(ctx, schedule.RouteID, stopID); err != nil {
+		return nil, toAppError(err, "정류장")
+	}
+
+	eventType := domain.StopEventType(req.Type)
+	recorded, err := s.recorded(ctx, trip.ID, stopID, eventType)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	if recorded {
+		return nil, util.NewDuplicateError("정류장 " + stopEventLabels[eventType] + " 기록")
+	}
+
+	event := domain.NewTripStopEvent(trip.ID, stopID, eventType, domain.StopEventSourceManual,
+		crewActor(ctx), newTripLocation(&req.TripLocationRequest), time.Now())
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return event, nil
+}
+
+// List - 운행의 정류장 도착/출발 기록 (발생 순)
+func (s *StopEventService) List(ctx context.Context, tripID string) ([]*domain.TripStopEvent, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.eventRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	if events == nil {
+		events = []*domain.TripStopEvent{}
+	}
+	return events, nil
+}
+
+// Punctuality - 정류장별 예상 도착 시각 대비 실제 도착 (경로 정류장 순서대로)
+func (s *StopEventService) Punctuality(ctx context.Context, tripID string) (*dto.TripPunctualityResponse, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return nil, toAppError(err, "운행 일정")
+	}
+	departure, err := scheduledDeparture(trip, schedule, organizationLocation(ctx, s.locations, trip.OrganizationID))
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	stops, err := s.routeRepo.ListStops(ctx, schedule.RouteID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	events, err := s.eventRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	arrivals := make(map[string]*domain.TripStopEvent, len(events))
+	departures := make(map[string]*domain.TripStopEvent, len(events))
+	for _, event := range events {
+		switch event.Type {
+		case domain.StopEventArrived:
+			arrivals[event.StopID] = event
+		case domain.StopEventDeparted:
+			departures[event.StopID] = event
+		}
+	}
+
+	report := &dto.TripPunctualityResponse{
+		TripID:             trip.ID,
+		Date:               trip.Date.Format("2006-01-02"),
+		ScheduledDeparture: departure,
+		StartedAt:          trip.StartedAt,
+		Stops:              make([]dto.StopPunctualityResponse, 0, len(stops)),
+	}
+	totalDelay := 0
+	for _, stop := range stops {
+		item := dto.StopPunctualityResponse{
+			StopID:           stop.ID,
+			StopName:         stop.Name,
+			StopOrder:        stop.Order,
+			EstimatedArrival: departure.Add(time.Duration(stop.EstimatedArrivalTime) * time.Minute),
+		}
+		if departed, ok := departures[stop.ID]; ok {
+			item.ActualDeparture = &departed.OccurredAt
+		}
+		if arrived, ok := arrivals[stop.ID]; ok {
+			late := arrived.OccurredAt.Sub(item.EstimatedArrival)
+			delay := int(math.Round(late.Minutes()))
+			item.ActualArrival = &arrived.OccurredAt
+			item.ArrivalSource = arrived.Source
+			item.Delay = &delay
+			item.OnTime = late <= punctualityTolerance
+
+			report.RecordedStops++
+			totalDelay += delay
+			if item.OnTime {
+				report.OnTimeStops++
+			} else {
+				report.LateStops++
+			}
+		}
+		report.Stops = append(report.Stops, item)
+	}
+	if report.RecordedStops > 0 {
+		report.AverageDelay = math.Round(float64(totalDelay)/float64(report.RecordedStops)*10) / 10
+	}
+	return report, nil
+}
+
+// recorded - 운행 + 정류장 + 종류의 기록이 이미 있는지
+func (s *StopEventService) recorded(ctx context.Context, tripID, stopID string, eventType domain.StopEventType) (bool, error) {
+	_, err := s.eventRepo.Get(ctx, tripID, stopID, eventType)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 운행 중 차량 위치 수신/기록, 정류장 지오펜스 판정, 실시간 조회 권한 확인, 주행 경로 조회
+// 🎯 실무 포인트: 위치 전송은 배정 승무원(또는 locations:write API 키), 실시간 조회는 관리자와 탑승자 보호자
+// ⚠️ 주의사항: 운행 중(in_progress)인 운행만 위치를 받음
+
+// LocationPublisher - 차량 위치 실시간 전달 (realtime.Hub가 구현)
+type LocationPublisher interface {
+	PublishLocation(ctx context.Context, position *domain.VehiclePosition) error
+}
+
+// LocationObserver - 위치 기록 후 후속 처리 (과속 감지 등)
+// previous는 이 위치 직전에 기록된 위치 (첫 위치면 nil), 실패는 각자 로그로 처리 (위치 수신은 성공)
+type LocationObserver interface {
+	ObserveLocation(ctx context.Context, trip *domain.Trip, previous, current *domain.VehiclePosition)
+}
+
+const (
+	DefaultTrackTolerance = 10.0            // 경로 단순화 기본 허용 오차 (미터)
+	DefaultReplayInterval = 5 * time.Second // 운행 재생 기본 프레임 간격
+	maxReplayFrames       = 3600            // 한 번에 반환하는 최대 프레임 수
+)
+
+// TrackingService - 실시간 위치 서비스
+type TrackingService struct {
+	tripService   *TripService
+	scheduleRepo  repository.ScheduleRepository
+	routeRepo     repository.RouteRepository
+	guardianRepo  repository.GuardianRepository
+	passengerRepo repository.PassengerRepository
+	locationRepo  repository.TripLocationRepository
+	publisher     LocationPublisher
+	geofence      *geofence.Engine // nil이면 정류장 판정 안 함
+	observers     []LocationObserver
+}
+
+// NewTrackingService - 실시간 위치 서비스 생성
+func NewTrackingService(
+	tripService *TripService,
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	guardianRepo repository.GuardianRepository,
+	passengerRepo repository.PassengerRepository,
+	locationRepo repository.TripLocationRepository,
+	publisher LocationPublisher,
+	stopGeofence *geofence.Engine,
+	observers ...LocationObserver,
+) *TrackingService {
+	return &TrackingService{
+		tripService:   tripService,
+		scheduleRepo:  scheduleRepo,
+		routeRepo:     routeRepo,
+		guardianRepo:  guardianRepo,
+		passengerRepo: passengerRepo,
+		locationRepo:  locationRepo,
+		publisher:     publisher,
+		geofence:      stopGeofence,
+		observers:     observers,
+	}
+}
+
+// ReportLocation - 운행 중 차량 위치 기록 후 구독자에게 전달
+func (s *TrackingService) ReportLocation(ctx context.Context, tripID string, req *dto.ReportLocationRequest) (*domain.VehiclePosition, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isLocationDevice(ctx) {
+		if _, err := s.tripService.authorizeCrew(ctx, trip); err != nil {
+			return nil, err
+		}
+	}
+
+	// 일시정지 중에도 위치는 계속 기록 (보호자 실시간 확인)
+	if !trip.IsActive() {
+		return nil, util.NewConflictError("운행 중인 운행에만 위치를 전송할 수 있습니다")
+	}
+
+	var recordedAt time.Time
+	if req.RecordedAt != nil {
+		recordedAt = *req.RecordedAt
+	}
+	position := domain.NewVehiclePosition(trip, *req.Latitude, *req.Longitude, recordedAt)
+	position.Speed = req.Speed
+	position.Heading = req.Heading
+
+	previous := s.previousPosition(ctx, trip.ID)
+	if err := s.locationRepo.Create(ctx, position); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	if err := s.publisher.PublishLocation(ctx, position); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	s.evaluateStops(ctx, trip, position)
+	for _, observer := range s.observers {
+		observer.ObserveLocation(ctx, trip, previous, position)
+	}
+
+	return position, nil
+}
+
+// previousPosition - 직전 위치 기록 (후속 처리가 없거나 조회 실패 시 nil)
+func (s *TrackingService) previousPosition(ctx context.Context, tripID string) *domain.VehiclePosition {
+	if len(s.observers) == 0 {
+		return nil
+	}
+	previous, err := s.locationRepo.Latest(ctx, tripID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			logger.FromContext(ctx).Warn("Failed to load previous location", map[string]interface{}{
+				"trip_id": tripID,
+				"error":   err.Error(),
+			})
+		}
+		return nil
+	}
+	return previous
+}
+
+// evaluateStops - 일정 경로 정류장 지오펜스 판정 (실패해도 위치 수신은 성공 처리)
+func (s *TrackingService) evaluateStops(ctx context.Context, trip *domain.Trip, position *domain.VehiclePosition) {
+	if s.geofence == nil {
+		return
+	}
+	stops, err := s.plannedStops(ctx, trip)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load stops for geofence", map[string]interface{}{
+			"trip_id": trip.ID,
+			"error":   err.Error(),
+		})
+		return
+	}
+	s.geofence.Evaluate(ctx, position, stops)
+}
+
+// GetTrack - 실제 주행 경로 (tolerance 미터 이내 중간 좌표를 제거해 단순화, 0이면 원본)
+// 계획 경로와 비교할 수 있도록 일정 경로의 정류장을 함께 반환
+func (s *TrackingService) GetTrack(ctx context.Context, tripID string, tolerance float64) (*dto.TrackResponse, error) {
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := s.locationRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	points := make([]geo.Point, len(positions))
+	for i, p := range positions {
+		points[i] = geo.Point{Latitude: p.Latitude, Longitude: p.Longitude}
+	}
+
+	kept := geo.Simplify(points, tolerance)
+	track := &dto.TrackResponse{
+		TripID:       trip.ID,
+		TotalPoints:  len(positions),
+		Points:       make([]dto.TrackPoint, len(kept)),
+		PlannedStops: []domain.Stop{},
+	}
+	simplified := make([]geo.Point, len(kept))
+	for i, idx := range kept {
+		p := positions[idx]
+		track.Points[i] = dto.TrackPoint{Latitude: p.Latitude, Longitude: p.Longitude, RecordedAt: p.RecordedAt}
+		simplified[i] = points[idx]
+	}
+	track.Polyline = geo.EncodePolyline(simplified)
+
+	stops, err := s.plannedStops(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+	track.PlannedStops = stops
+
+	return track, nil
+}
+
+// GetReplay - from~to 구간을 interval 간격 프레임으로 재생 (기록 사이 시각은 선형 보간)
+// 구간을 생략하면 첫 기록~마지막 기록, 기록 범위를 벗어난 구간은 잘라냄
+func (s *TrackingService) GetReplay(ctx context.Context, tripID string, from, to *time.Time, interval time.Duration) (*dto.ReplayResponse, error) {
+	if from != nil && to != nil && !to.After(*from) {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"to": "from 이후 시각이어야 합니다",
+		})
+	}
+
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := s.locationRepo.ListByTrip(ctx, trip.ID)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	replay := &dto.ReplayResponse{
+		TripID:   trip.ID,
+		Interval: int(interval / time.Second),
+		Frames:   []dto.ReplayFrame{},
+	}
+	if len(positions) == 0 {
+		return replay, nil
+	}
+
+	start, end := positions[0].RecordedAt, positions[len(positions)-1].RecordedAt
+	if from != nil && from.After(start) {
+		start = *from
+	}
+	if to != nil && to.Before(end) {
+		end = *to
+	}
+	if end.Before(start) {
+		return replay, nil
+	}
+	if frames := int(end.Sub(start)/interval) + 1; frames > maxReplayFrames {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"interval": "프레임이 너무 많습니다. interval을 늘리거나 구간을 줄여주세요",
+		})
+	}
+
+	replay.From, replay.To = &start, &end
+	i := 0
+	for at := start; !at.After(end); at = at.Add(interval) {
+		for i+1 < len(positions) && !positions[i+1].RecordedAt.After(at) {
+			i++
+		}
+		replay.Frames = append(replay.Frames, replayFrame(positions, i, at))
+	}
+
+	return replay, nil
+}
+
+// replayFrame - at 시각 위치 (positions[i]가 at 이전 마지막 기록)
+func replayFrame(positions []*domain.VehiclePosition, i int, at time.Time) dto.ReplayFrame {
+	prev := positions[i]
+	frame := dto.ReplayFrame{
+		Timestamp: at,
+		Latitude:  prev.Latitude,
+		Longitude: prev.Longitude,
+		Speed:     prev.Speed,
+		Heading:   prev.Heading,
+	}
+	if i+1 == len(positions) || prev.RecordedAt.Equal(at) {
+		return frame
+	}
+
+	next := positions[i+1]
+	fraction := float64(at.Sub(prev.RecordedAt)) / float64(next.RecordedAt.Sub(prev.RecordedAt))
+	point := geo.Interpolate(
+		geo.Point{Latitude: prev.Latitude, Longitude: prev.Longitude},
+		geo.Point{Latitude: next.Latitude, Longitude: next.Longitude},
+		fraction,
+	)
+	frame.Latitude, frame.Longitude = point.Latitude, point.Longitude
+	if prev.Speed != nil && next.Speed != nil {
+		speed := *prev.Speed + (*next.Speed-*prev.Speed)*fraction
+		frame.Speed = &speed
+	}
+	frame.Interpolated = true
+	return frame
+}
+
+// plannedStops - 운행 일정 경로의 정류장 (일정/경로가 삭제되었으면 빈 목록)
+func (s *TrackingService) plannedStops(ctx context.Context, trip *domain.Trip) ([]domain.Stop, error) {
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return []domain.Stop{}, nil
+		}
+		return nil, util.NewInternalError(err)
+	}
+	route, err := s.routeRepo.GetByID(ctx, schedule.RouteID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return []domain.Stop{}, nil
+		}
+		return nil, util.NewInternalError(err)
+	}
+	if route.Stops == nil {
+		return []domain.Stop{}, nil
+	}
+	return route.Stops, nil
+}
+
+// AuthorizeWatch - 운행 실시간 위치 조회 권한 확인
+// 관리자, trips:read API 키, 탑승 자녀가 있는 보호자만 허용
+func (s *TrackingService) AuthorizeWatch(ctx context.Context, tripID string) (*domain.Trip, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, util.NewUnauthorizedError()
+	}
+
+	trip, err := s.tripService.Get(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case principal.IsAPIKey():
+		if principal.HasScope(domain.ScopeTripsRead) {
+			return trip, nil
+		}
+	case principal.IsAdmin():
+		return trip, nil
+	case principal.Role == domain.RoleGuardian && principal.ProfileID != "":
+		riding, err := s.hasChildOnTrip(ctx, principal.ProfileID, trip)
+		if err != nil {
+			return nil, err
+		}
+		if riding {
+			return trip, nil
+		}
+	}
+
+	return nil, util.NewForbiddenError()
+}
+
+// hasChildOnTrip - 보호자의 자녀가 운행 탑승 기록에 있거나 운행 경로에 배정되어 있는지 확인
+func (s *TrackingService) hasChildOnTrip(ctx context.Context, guardianID string, trip *domain.Trip) (bool, error) {
+	links, err := s.guardianRepo.ListLinks(ctx, guardianID)
+	if err != nil {
+		return false, util.NewInternalError(err)
+	}
+	if len(links) == 0 {
+		return false, nil
+	}
+
+	children := make(map[string]bool, len(links))
+	for _, link := range links {
+		children[link.PassengerID] = true
+	}
+	for _, tp := range trip.TripPassengers {
+		if children[tp.PassengerID] {
+			return true, nil
+		}
+	}
+
+	// 탑승 기록이 아직 없으면 일정 경로 배정 기준
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return false, nil
+		}
+		return false, util.NewInternalError(err)
+	}
+	for _, link := range links {
+		passenger, err := s.passengerRepo.GetByID(ctx, link.PassengerID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return false, util.NewInternalError(err)
+		}
+		if passenger.AssignedRouteID == schedule.RouteID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isLocationDevice - 위치 전송 권한(locations:write)이 있는 API 키(차량 단말)인지 확인
+func isLocationDevice(ctx context.Context) bool {
+	principal, ok := auth.FromContext(ctx)
+	return ok && principal.IsAPIKey() && principal.HasScope(domain.ScopeLocationsWrite)
+}
@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 운행 단위 배정 변경 (차량 고장, 기사 병가 등 당일 차량/기사/동승자 교체)
+// 🎯 실무 포인트: 일정/대체 배정은 그대로 두고 그 운행만 교체, 경로에 배정된 탑승자의 보호자에게 변경 알림
+// 교체 대상은 운행 날짜에 투입 가능해야 하고(상태, 면허/보험/정기검사), 같은 날 시간대가 겹치는 다른 운행에 배정되어 있으면 CONFLICT
+// 배정 변경과 trip.reassigned 이벤트(변경 전 배정, 사유 포함)는 한 트랜잭션으로 기록 → 보호자 알림은 아웃박스 릴레이가 HandleEvent로 발송
+// ⚠️ 주의사항: 완료/취소된 운행은 변경 불가, 운행 중 차량을 바꾸면 차량 내부 확인을 다시 해야 완료 가능
+// 이중 배정 시간대는 일정 이중 배정 확인과 같은 기준 (출발 시각 ~ + 경로 예상 소요 시간)
+// HandleEvent를 이벤트 디스패처에 등록하지 않으면 알림이 발송되지 않음
+
+// TripAssignmentService - 운행 배정 변경 서비스
+type TripAssignmentService struct {
+	tripService   *TripService
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	routeRepo     repository.RouteRepository
+	vehicleRepo   repository.VehicleRepository
+	driverRepo    repository.DriverRepository
+	attendantRepo repository.AttendantRepository
+	passengerRepo repository.PassengerRepository
+	guardianRepo  repository.GuardianRepository
+	txManager     database.TxManager
+	notifier      GuardianNotifier
+	events        EventPublisher
+}
+
+// NewTripAssignmentService - 운행 배정 변경 서비스 생성 (notifier가 nil이면 알림 없음, events가 nil이면 이벤트 발행 안 함)
+// 알림은 발행된 이벤트를 HandleEvent로 받아 발송하므로 events가 nil이면 알림도 없음
+func NewTripAssignmentService(
+	tripService *TripService,
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	routeRepo repository.RouteRepository,
+	vehicleRepo repository.VehicleRepository,
+	driverRepo repository.DriverRepository,
+	attendantRepo repository.AttendantRepository,
+	passengerRepo repository.PassengerRepository,
+	guardianRepo repository.GuardianRepository,
+	txManager database.TxManager,
+	notifier GuardianNotifier,
+	events EventPublisher,
+) *TripAssignmentService {
+	return &TripAssignmentService{
+		tripService:   tripService,
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		routeRepo:     routeRepo,
+		vehicleRepo:   vehicleRepo,
+		driverRepo:    driverRepo,
+		attendantRepo: attendantRepo,
+		passengerRepo: passengerRepo,
+		guardianRepo:  guardianRepo,
+		txManager:     txManager,
+		notifier:      notifier,
+		events:        events,
+	}
+}
+
+// tripAssignment - 변경할 배정 (바뀐 항목만 changed*)
+type tripAssignment struct {
+	vehicleID        string
+	driverID         string
+	attendantID      *string
+	changedVehicle   bool
+	changedDriver    bool
+	changedAttendant bool
+}
+
+// Update - 운행 배정 변경 (전달된 항목만, attendant_id가 빈 문자열이면 동승자 해제)
+func (s *TripAssignmentService) Update(ctx context.Context, id string, req *dto.UpdateTripAssignmentRequest) (*domain.Trip, error) {
+	trip, err := s.tripService.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if trip.IsCompleted() || trip.IsCancelled() {
+		return nil, util.NewConflictError("배정을 변경할 수 없는 상태입니다: %s", trip.Status)
+	}
+
+	assignment := newTripAssignment(trip, req)
+	if !assignment.changedVehicle && !assignment.changedDriver && !assignment.changedAttendant {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"assignment": "변경할 차량, 기사 또는 동승자가 없습니다",
+		})
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if err != nil {
+		return nil, toAppError(err, "운행 일정")
+	}
+	if err := s.validate(ctx, trip, assignment); err != nil {
+		return nil, err
+	}
+	if err := s.checkConflicts(ctx, trip, schedule, assignment); err != nil {
+		return nil, err
+	}
+
+	previousVehicleID, previousDriverID, previousAttendantID := trip.VehicleID, trip.AssignedDriverID, trip.AssignedAttendantID
+	if err := trip.Reassign(assignment.vehicleID, assignment.driverID, assignment.attendantID); err != nil {
+		return nil, util.NewConflictError("배정을 변경할 수 없는 상태입니다: %s", trip.Status)
+	}
+	data := &dto.TripReassignmentWebhookData{
+		TripWebhookData:     *tripWebhookData(trip),
+		PreviousVehicleID:   previousVehicleID,
+		PreviousDriverID:    previousDriverID,
+		PreviousAttendantID: previousAttendantID,
+		Reason:              req.Reason,
+	}
+	err = saveAndPublish(ctx, s.txManager, s.events, func(ctx context.Context) error {
+		return s.tripRepo.Update(ctx, trip)
+	}, domain.WebhookEventTripReassigned, trip.OrganizationID, trip.ID, data)
+	if err != nil {
+		return nil, toAppError(err, "운행")
+	}
+	return trip, nil
+}
+
+// newTripAssignment - 현재 배정에 요청을 반영 (현재와 같은 값은 변경 아님)
+func newTripAssignment(trip *domain.Trip, req *dto.UpdateTripAssignmentRequest) tripAssignment {
+	assignment := tripAssignment{
+		vehicleID:   trip.VehicleID,
+		driverID:    trip.AssignedDriverID,
+		attendantID: trip.AssignedAttendantID,
+	}
+	if req.VehicleID != nil && *req.VehicleID != trip.VehicleID {
+		assignment.vehicleID = *req.VehicleID
+		assignment.changedVehicle = true
+	}
+	if req.DriverID != nil && *req.DriverID != trip.AssignedDriverID {
+		assignment.driverID = *req.DriverID
+		assignment.changedDriver = true
+	}
+	if req.AttendantID != nil {
+		current := ""
+		if trip.AssignedAttendantID != nil {
+			current = *trip.AssignedAttendantID
+		}
+		if *req.AttendantID != current {
+			assignment.attendantID = nil
+			if *req.AttendantID != "" {
+				attendantID := *req.AttendantID
+				assignment.attendantID = &attendantID
+			}
+			assignment.changedAttendant = true
+		}
+	}
+	return assignment
+}
+
+// validate - 교체 대상이 운행 날짜에 투입 가능한지 (실패한 항목을 모아서 한 번에 반환)
+func (s *TripAssignmentService) validate(ctx context.Context, trip *domain.Trip, assignment tripAssignment) error {
+	details := map[string]interface{}{}
+
+	if assignment.changedVehicle {
+		vehicle, err := s.vehicleRepo.GetByID(ctx, assignment.vehicleID)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			details["vehicle_id"] = "존재하지 않는 차량입니다"
+		case err != nil:
+			return util.NewInternalError(err)
+		case !vehicle.IsAvailableOn(trip.Date):
+			details["vehicle_id"] = "운행에 투입할 수 없는 차량입니다 (정비 중·비활성 또는 보험/정기검사 만료)"
+		case vehicle.GetPassengerCapacity() < expectedRiders(trip):
+			details["vehicle_id"] = util.Text("차량 정원이 탑승 예정 인원(%d명)보다 적습니다", expectedRiders(trip))
+		}
+	}
+
+	if assignment.changedDriver {
+		driver, err := s.driverRepo.GetByID(ctx, assignment.driverID)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			details["driver_id"] = "존재하지 않는 기사입니다"
+		case err != nil:
+			return util.NewInternalError(err)
+		case !driver.IsAvailableForTrip():
+			details["driver_id"] = "운행에 투입할 수 없는 기사입니다 (휴직·퇴사, 면허 미확인 또는 만료)"
+		}
+	}
+
+	if assignment.changedAttendant && assignment.attendantID != nil {
+		attendant, err := s.attendantRepo.GetByID(ctx, *assignment.attendantID)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			details["attendant_id"] = "존재하지 않는 동승자입니다"
+		case err != nil:
+			return util.NewInternalError(err)
+		case !attendant.IsAvailableForTrip():
+			details["attendant_id"] = "운행에 투입할 수 없는 동승자입니다"
+		}
+	}
+
+	if len(details) > 0 {
+		return util.NewValidationError(util.MsgValidationFailed, details)
+	}
+	return nil
+}
+
+// expectedRiders - 탑승 예정 인원 (불참·결석 신고 제외)
+func expectedRiders(trip *domain.Trip) int {
+	count := 0
+	for i := range trip.TripPassengers {
+		record := &trip.TripPassengers[i]
+		if !record.IsNoShow() && !record.IsExcused() {
+			count++
+		}
+	}
+	return count
+}
+
+// checkConflicts - 교체 차량/기사/동승자가 같은 날 시간대가 겹치는 다른 운행(완료/취소 제외)에 배정되어 있으면 CONFLICT
+// 겹치는 운행은 details.conflicts로 반환
+func (s *TripAssignmentService) checkConflicts(ctx context.Context, trip *domain.Trip, schedule *domain.Schedule, assignment tripAssignment) error {
+	others, _, err := s.tripRepo.List(ctx, repository.TripFilter{Date: &trip.Date})
+	if err != nil {
+		return util.NewInternalError(err)
+	}
+	durations := map[string]int{}
+	start, end, err := scheduleWindow(ctx, s.routeRepo, durations, schedule)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []dto.TripConflictResponse
+	schedules := map[string]*domain.Schedule{schedule.ID: schedule}
+	for _, other := range others {
+		if other.ID == trip.ID || other.IsCompleted() || other.IsCancelled() {
+			continue
+		}
+		var resources []string
+		if assignment.changedVehicle && other.VehicleID == assignment.vehicleID {
+			resources = append(resources, "vehicle")
+		}
+		if assignment.changedDriver && other.AssignedDriverID == assignment.driverID {
+			resources = append(resources, "driver")
+		}
+		if assignment.changedAttendant && assignment.attendantID != nil &&
+			other.AssignedAttendantID != nil && *other.AssignedAttendantID == *assignment.attendantID {
+			resources = append(resources, "attendant")
+		}
+		if len(resources) == 0 {
+			continue
+		}
+
+		otherSchedule, ok := schedules[other.ScheduleID]
+		if !ok {
+			otherSchedule, err = s.scheduleRepo.GetByID(ctx, other.ScheduleID)
+			if err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					continue
+				}
+				return util.NewInternalError(err)
+			}
+			schedules[other.ScheduleID] = otherSchedule
+		}
+		otherStart, otherEnd, err := scheduleWindow(ctx, s.routeRepo, durations, otherSchedule)
+		if err != nil {
+			return err
+		}
+		if start >= otherEnd || otherStart >= end {
+			continue
+		}
+		for _, resource := range resources {
+			conflicts = append(conflicts, dto.TripConflictResponse{
+				Resource:     resource,
+				TripID:       other.ID,
+				ScheduleID:   otherSchedule.ID,
+				ScheduleName: otherSchedule.Name,
+				Status:       other.Status,
+				StartTime:    otherSchedule.StartTime,
+				EndTime:      formatClock(otherEnd),
+			})
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return util.NewConflictErrorWithDetails("교체 차량 또는 인력이 겹치는 시간대의 다른 운행에 이미 배정되어 있습니다",
+			map[string]interface{}{"conflicts": conflicts})
+	}
+	return nil
+}
+
+// HandleEvent - 배정 변경 이벤트를 받아 경로 탑승자의 보호자에게 알림 (아웃박스 릴레이의 핸들러)
+// 알림 발송 실패는 알림 발송 기록의 재시도에 맡기고, 조회 실패만 에러로 반환해 릴레이가 다시 전달
+func (s *TripAssignmentService) HandleEvent(ctx context.Context, e event.Event) error {
+	if s.notifier == nil {
+		return nil
+	}
+
+	var data dto.TripReassignmentWebhookData
+	if err := e.Decode(&data); err != nil {
+		return err
+	}
+	trip, err := s.tripRepo.GetByID(ctx, data.TripID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	schedule, err := s.scheduleRepo.GetByID(ctx, trip.ScheduleID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	changes, err := s.changes(ctx, &data)
+	if err != nil {
+		return err
+	}
+
+	s.notifyAssignment(ctx, trip, schedule, changes, data.Reason)
+	return nil
+}
+
+// changes - 알림에 쓸 변경 내용 ("차량 12가3456" 등, 이벤트 이후 삭제된 대상은 생략)
+func (s *TripAssignmentService) changes(ctx context.Context, data *dto.TripReassignmentWebhookData) ([]util.LocalizedText, error) {
+	var changes []util.LocalizedText
+	if data.VehicleID != data.PreviousVehicleID {
+		vehicle, err := s.vehicleRepo.GetByID(ctx, data.VehicleID)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			changes = append(changes, util.Text("차량 %s", vehicle.PlateNumber))
+		}
+	}
+	if data.DriverID != data.PreviousDriverID {
+		driver, err := s.driverRepo.GetByID(ctx, data.DriverID)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			changes = append(changes, util.Text("기사 %s", driver.Name))
+		}
+	}
+	if !sameID(data.AttendantID, data.PreviousAttendantID) {
+		if data.AttendantID == nil {
+			changes = append(changes, util.Text("동승자 없음"))
+		} else {
+			attendant, err := s.attendantRepo.GetByID(ctx, *data.AttendantID)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return nil, err
+			}
+			if err == nil {
+				changes = append(changes, util.Text("동승자 %s", attendant.Name))
+			}
+		}
+	}
+	return changes, nil
+}
+
+// sameID - 선택 ID 두 개가 같은지 (둘 다 nil이면 같음)
+func sameID(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// notifyAssignment - 경로에 배정된 탑승자의 보호자(보호자당 한 번)에게 배정 변경 알림
+func (s *TripAssignmentService) notifyAssignment(ctx context.Context, trip *domain.Trip, schedule *domain.Schedule, changes []util.LocalizedText, reason string) {
+	logger.FromContext(ctx).Info("Trip reassigned", map[string]interface{}{
+		"trip_id": trip.ID,
+		"changes": util.Text("%s", changes).String(),
+		"reason":  reason,
+	})
+	if s.notifier == nil {
+		return
+	}
+	notification := assignmentNotification(trip, schedule, changes, reason)
+
+	guardianIDs, phones, err := tripGuardians(ctx, s.passengerRepo, s.guardianRepo, trip, schedule)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to list trip guardians", map[string]interface{}{"trip_id": trip.ID, "error": err.Error()})
+	}
+	for _, guardianID := range guardianIDs {
+		_, err := s.notifier.NotifyGuardian(ctx, guardianID, domain.NotificationEventAssignment, notification)
+		if err != nil && !errors.Is(err, ErrNotificationOptedOut) {
+			logger.FromContext(ctx).Warn("Failed to notify guardian", map[string]interface{}{"guardian_id": guardianID, "event": domain.NotificationEventAssignment, "error": err.Error()})
+		}
+	}
+	for _, phone := range phones {
+		if _, err := s.notifier.Notify(ctx, Recipient{Phone: phone}, notification); err != nil {
+			logger.FromContext(ctx).Warn("Failed to notify trip reassignment", map[string]interface{}{"trip_id": trip.ID, "error": err.Error()})
+		}
+	}
+}
+
+// assignmentNotification - 배정 변경 알림 내용 (변경 내용은 수신자 언어로 ", "로 연결)
+func assignmentNotification(trip *domain.Trip, schedule *domain.Schedule, changes []util.LocalizedText, reason string) *Notification {
+	body := util.Text("%s %s 운행이 변경되었습니다: %s", trip.Date.Format("01/02"), schedule.Name, changes)
+	if reason != "" {
+		body = util.Text("%s %s 운행이 변경되었습니다: %s (%s)", trip.Date.Format("01/02"), schedule.Name, changes, reason)
+	}
+	notification := newNotification(util.Text("운행 배정 변경 알림"), body)
+	notification.Data = map[string]string{
+		"type":    string(domain.NotificationEventAssignment),
+		"trip_id": trip.ID,
+	}
+	notification.Template = string(domain.NotificationEventAssignment)
+	notification.Variables = map[string]string{
+		"schedule": schedule.Name,
+		"reason":   reason,
+	}
+	notification.texts = map[string]util.LocalizedText{"changes": util.Text("%s", changes)}
+	return notification.localize(util.DefaultLocale)
+}
@@ -0,0 +1,417 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 날짜별 운행 생성 계획 (일정 → 운행)
+// 🎯 실무 포인트: 일정마다 그 날짜에 운행을 만들지, 만들지 않으면 이유(비활성, 유효 기간 밖, 운행 요일 아님,
+// 공휴일, 예외 날짜, 이미 생성됨, 차량 사용 불가, 차량 정원 초과)를 판정 → 관리자가 생성 전에 미리보기로 확인
+// 일정 예외 날짜가 있으면 우선 (skip: 운행 안 함, extra: 요일/유효 기간/공휴일과 무관하게 운행)
+// 그날 유효한 승인된 기사/동승자 대체 배정이 있으면 기본 배정 대신 대체 인력 배정 (겹치면 가장 나중에 승인된 배정)
+// 일정 출발 시각(HH:MM)과 "오늘"은 기관 시간대 기준 (출발 예정 시각 departure_at은 시간대를 반영한 절대 시각)
+// ⚠️ 주의사항: 미리보기는 아무것도 저장하지 않음, 차량 보험/검사 만료는 조회 시점이 아닌 운행 날짜 기준
+
+// TripSkipReason - 운행을 생성하지 않는 이유
+type TripSkipReason string
+
+const (
+	TripSkipInactive           TripSkipReason = "inactive"            // 비활성 일정
+	TripSkipOutOfPeriod        TripSkipReason = "out_of_period"       // 유효 기간 밖
+	TripSkipNotServiceDay      TripSkipReason = "not_service_day"     // 운행 요일 아님
+	TripSkipHoliday            TripSkipReason = "holiday"             // 공휴일 (공휴일 제외 일정)
+	TripSkipException          TripSkipReason = "exception"           // 일정 예외 날짜 (그날 운행 안 함)
+	TripSkipAlreadyExists      TripSkipReason = "already_exists"      // 이미 생성된 운행
+	TripSkipVehicleUnavailable TripSkipReason = "vehicle_unavailable" // 차량 정비/비활성/보험·검사 만료
+	TripSkipOverCapacity       TripSkipReason = "over_capacity"       // 경로 배정 탑승자가 차량 승객 정원 초과
+)
+
+// TripGenerationService - 운행 생성 계획 서비스
+type TripGenerationService struct {
+	scheduleRepo            repository.ScheduleRepository
+	exceptionRepo           repository.ScheduleExceptionRepository
+	driverAssignmentRepo    repository.DriverAssignmentRepository
+	attendantAssignmentRepo repository.AttendantAssignmentRepository
+	vehicleRepo             repository.VehicleRepository
+	driverRepo              repository.DriverRepository
+	tripRepo                repository.TripRepository
+	passengerRepo           repository.PassengerRepository
+	holidays                *HolidayService
+	locations               TimezoneResolver
+}
+
+// NewTripGenerationService - 운행 생성 계획 서비스 생성 (locations가 nil이면 한국 시간)
+func NewTripGenerationService(
+	scheduleRepo repository.ScheduleRepository,
+	exceptionRepo repository.ScheduleExceptionRepository,
+	driverAssignmentRepo repository.DriverAssignmentRepository,
+	attendantAssignmentRepo repository.AttendantAssignmentRepository,
+	vehicleRepo repository.VehicleRepository,
+	driverRepo repository.DriverRepository,
+	tripRepo repository.TripRepository,
+	passengerRepo repository.PassengerRepository,
+	holidays *HolidayService,
+	locations TimezoneResolver,
+) *TripGenerationService {
+	return &TripGenerationService{
+		scheduleRepo:            scheduleRepo,
+		exceptionRepo:           exceptionRepo,
+		driverAssignmentRepo:    driverAssignmentRepo,
+		attendantAssignmentRepo: attendantAssignmentRepo,
+		vehicleRepo:             vehicleRepo,
+		driverRepo:              driverRepo,
+		tripRepo:                tripRepo,
+		passengerRepo:           passengerRepo,
+		holidays:                holidays,
+		locations:               locations,
+	}
+}
+
+// Today - 요청 기관 시간대의 오늘 날짜 (운행 날짜와 같은 UTC 자정)
+func (s *TripGenerationService) Today(ctx context.Context) time.Time {
+	return organizationToday(ctx, s.locations, repository.OrganizationFromContext(ctx))
+}
+
+// location - 요청 기관의 시간대
+func (s *TripGenerationService) location(ctx context.Context) *time.Location {
+	return organizationLocation(ctx, s.locations, repository.OrganizationFromContext(ctx))
+}
+
+// Preview - 날짜의 운행 생성 계획 (생성될 운행과 생성하지 않는 일정, 출발 시각 순)
+func (s *TripGenerationService) Preview(ctx context.Context, date time.Time) (*dto.TripGenerationPreviewResponse, error) {
+	schedules, _, err := s.scheduleRepo.List(ctx, repository.ScheduleFilter{})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	sort.SliceStable(schedules, func(i, j int) bool { return schedules[i].StartTime < schedules[j].StartTime })
+
+	trips, _, err := s.tripRepo.List(ctx, repository.TripFilter{Date: &date})
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	location := s.location(ctx)
+	preview := &dto.TripGenerationPreviewResponse{
+		Date:     date.Format(time.DateOnly),
+		Timezone: location.String(),
+		Planned:  []dto.PlannedTripResponse{},
+		Skipped:  []dto.SkippedScheduleResponse{},
+	}
+	day, err := s.loadTripDay(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	for _, trip := range trips {
+		day.existing[trip.ScheduleID] = true
+	}
+
+	for _, schedule := range schedules {
+		plan, err := s.plan(ctx, day, schedule)
+		if err != nil {
+			return nil, err
+		}
+		if plan.reason != "" {
+			preview.Skipped = append(preview.Skipped, dto.SkippedScheduleResponse{
+				ScheduleID:   schedule.ID,
+				ScheduleName: schedule.Name,
+				Reason:       string(plan.reason),
+				Detail:       plan.detail.Localize(util.LocaleFromContext(ctx)),
+				Overflow:     plan.overflow,
+			})
+			continue
+		}
+		planned := dto.PlannedTripResponse{
+			ScheduleID:          schedule.ID,
+			ScheduleName:        schedule.Name,
+			StartTime:           schedule.StartTime,
+			RouteID:             schedule.RouteID,
+			VehicleID:           schedule.VehicleID,
+			DriverID:            plan.crew.DriverID,
+			AttendantID:         plan.crew.AttendantID,
+			DriverSubstitute:    plan.crew.DriverSubstitute,
+			AttendantSubstitute: plan.crew.AttendantSubstitute,
+			Extra:               plan.extra,
+		}
+		if departure, err := departureOn(date, schedule.StartTime, location); err == nil {
+			planned.DepartureAt = departure
+		}
+		preview.Planned = append(preview.Planned, planned)
+	}
+	return preview, nil
+}
+
+// TripCrew - 운행에 배정할 기사/동승자 (그날 승인된 대체 배정이 있으면 대체 인력)
+type TripCrew struct {
+	DriverID            string
+	AttendantID         *string
+	DriverSubstitute    bool
+	AttendantSubstitute bool
+}
+
+// PlanTrip - 운행 생성 API용 일정 하나의 판정 (미리보기와 같은 기준, 이미 생성된 운행 여부는 호출하는 쪽에서 확인)
+// 예외 날짜로 운행하지 않는 날은 거부하고, 추가 운행 날짜는 운행 요일이 아니어도 허용
+// 차량을 쓸 수 없으면 VALIDATION_ERROR, 경로 배정 탑승자가 차량 승객 정원을 넘으면 CONFLICT (details에 초과 인원)
+// 운행하면 미리보기와 같은 기준으로 대체 배정을 반영한 기사/동승자 반환
+// 그 기사가 활동 중이 아니거나 면허가 확인되지 않았거나 만료되었으면 VALIDATION_ERROR (배정 후 면허가 만료된 경우)
+func (s *TripGenerationService) PlanTrip(ctx context.Context, schedule *domain.Schedule, date time.Time) (*TripCrew, error) {
+	day, err := s.loadTripDay(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.plan(ctx, day, schedule)
+	if err != nil {
+		return nil, err
+	}
+	switch plan.reason {
+	case "":
+		if err := s.checkDriver(ctx, plan.crew.DriverID); err != nil {
+			return nil, err
+		}
+		return &plan.crew, nil
+	case TripSkipVehicleUnavailable:
+		return nil, tripSkipError("vehicle_id", plan.detail)
+	case TripSkipOverCapacity:
+		return nil, capacityConflict(schedule, day.vehicles[schedule.VehicleID], day.riders[schedule.RouteID])
+	default:
+		return nil, tripSkipError("date", plan.detail)
+	}
+}
+
+// checkDriver - 운행 기사가 활동 중이고 면허가 확인·유효한지 확인 (아니면 VALIDATION_ERROR)
+func (s *TripGenerationService) checkDriver(ctx context.Context, driverID string) error {
+	driver, err := s.driverRepo.GetByID(ctx, driverID)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return tripSkipError("driver_id", util.Text("존재하지 않는 기사입니다"))
+	case err != nil:
+		return util.NewInternalError(err)
+	case !driver.IsActive():
+		return tripSkipError("driver_id", util.Text("활동 중인 기사가 아닙니다"))
+	case !driver.HasValidLicense():
+		return tripSkipError("driver_id", util.Text("면허가 확인되지 않았거나 만료된 기사입니다"))
+	}
+	return nil
+}
+
+// tripSkipError - 운행을 생성하지 않는 이유를 운행 생성 API 에러로 (details[field]에 이유)
+func tripSkipError(field string, detail util.LocalizedText) error {
+	return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+		field: detail,
+	})
+}
+
+// tripPlan - 일정 하나의 그날 운행 판정 (reason이 비어 있으면 운행)
+type tripPlan struct {
+	reason   TripSkipReason
+	detail   util.LocalizedText
+	overflow int  // 차량 승객 정원 초과 인원 (over_capacity)
+	extra    bool // 예외 날짜의 추가 운행
+	crew     TripCrew
+}
+
+// plan - 일정이 그날 운행하는지 판정 (일정/공휴일/예외 날짜 → 이미 생성됨 → 차량 → 차량 정원 순)
+func (s *TripGenerationService) plan(ctx context.Context, day *tripDay, schedule *domain.Schedule) (*tripPlan, error) {
+	plan := &tripPlan{crew: day.crew(schedule)}
+	plan.reason, plan.detail, plan.extra = day.skipReason(schedule)
+	if plan.reason == "" && day.existing[schedule.ID] {
+		plan.reason, plan.detail = TripSkipAlreadyExists, util.Text("이 날짜의 운행이 이미 있습니다")
+	}
+	if plan.reason != "" {
+		return plan, nil
+	}
+
+	reason, detail, err := s.vehicleSkipReason(ctx, day.vehicles, schedule.VehicleID, day.date)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		plan.reason, plan.detail = reason, detail
+		return plan, nil
+	}
+	vehicle := day.vehicles[schedule.VehicleID]
+	if plan.overflow, err = s.capacityOverflow(ctx, day.riders, vehicle, schedule.RouteID); err != nil {
+		return nil, err
+	}
+	if plan.overflow > 0 {
+		plan.reason, plan.detail = TripSkipOverCapacity, util.Text("경로 배정 탑승자가 차량 %s 승객 정원을 %d명 초과합니다", vehicle.PlateNumber, plan.overflow)
+	}
+	return plan, nil
+}
+
+// tripDay - 날짜 하나의 운행 생성 판정 자료 (일정마다 다시 조회하지 않게 한 번만 조회)
+type tripDay struct {
+	date        time.Time
+	holidayName string
+	isHoliday   bool
+	exceptions  map[string]*domain.ScheduleException   // 일정 ID → 그날 예외
+	drivers     map[string]*domain.DriverAssignment    // 일정 ID → 그날 우선하는 승인된 기사 대체 배정
+	attendants  map[string]*domain.AttendantAssignment // 일정 ID → 그날 우선하는 승인된 동승자 대체 배정
+	existing    map[string]bool                        // 일정 ID → 이미 생성된 운행 (미리보기만 채움)
+	vehicles    map[string]*domain.Vehicle             // 차량 ID → 조회한 차량
+	riders      map[string]int                         // 경로 ID → 배정 탑승자 수
+}
+
+// loadTripDay - 날짜의 운행 생성 판정 자료 조회
+func (s *TripGenerationService) loadTripDay(ctx context.Context, date time.Time) (*tripDay, error) {
+	holidayName, isHoliday, err := s.holidays.IsHoliday(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	exceptions, err := s.exceptionRepo.ListByDate(ctx, date)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	day := &tripDay{
+		date:        date,
+		holidayName: holidayName,
+		isHoliday:   isHoliday,
+		exceptions:  make(map[string]*domain.ScheduleException, len(exceptions)),
+		existing:    map[string]bool{},
+		vehicles:    map[string]*domain.Vehicle{},
+		riders:      map[string]int{},
+	}
+	for _, exception := range exceptions {
+		day.exceptions[exception.ScheduleID] = exception
+	}
+	if day.drivers, day.attendants, err = s.approvedSubstitutes(ctx, date); err != nil {
+		return nil, err
+	}
+	return day, nil
+}
+
+// approvedSubstitutes - 날짜에 유효한 승인된 기사/동승자 대체 배정 (일정마다 하나, 겹치면 가장 나중에 승인된 배정)
+func (s *TripGenerationService) approvedSubstitutes(ctx context.Context, date time.Time) (map[string]*domain.DriverAssignment, map[string]*domain.AttendantAssignment, error) {
+	driverAssignments, err := s.driverAssignmentRepo.ListActiveOn(ctx, date)
+	if err != nil {
+		return nil, nil, util.NewInternalError(err)
+	}
+	drivers := make(map[string]*domain.DriverAssignment, len(driverAssignments))
+	for _, assignment := range driverAssignments {
+		current := drivers[assignment.ScheduleID]
+		if assignment.IsApproved() && (current == nil || assignment.TakesPrecedenceOver(current)) {
+			drivers[assignment.ScheduleID] = assignment
+		}
+	}
+	attendantAssignments, err := s.attendantAssignmentRepo.ListActiveOn(ctx, date)
+	if err != nil {
+		return nil, nil, util.NewInternalError(err)
+	}
+	attendants := make(map[string]*domain.AttendantAssignment, len(attendantAssignments))
+	for _, assignment := range attendantAssignments {
+		current := attendants[assignment.ScheduleID]
+		if assignment.IsApproved() && (current == nil || assignment.TakesPrecedenceOver(current)) {
+			attendants[assignment.ScheduleID] = assignment
+		}
+	}
+	return drivers, attendants, nil
+}
+
+// crew - 일정의 그날 기사/동승자 (승인된 대체 배정이 있으면 일정 기본 배정 대신 대체 인력)
+func (day *tripDay) crew(schedule *domain.Schedule) TripCrew {
+	crew := TripCrew{DriverID: schedule.DefaultDriverID, AttendantID: schedule.DefaultAttendantID}
+	if assignment, ok := day.drivers[schedule.ID]; ok {
+		crew.DriverID = assignment.DriverID
+		crew.DriverSubstitute = true
+	}
+	if assignment, ok := day.attendants[schedule.ID]; ok {
+		attendantID := assignment.AttendantID
+		crew.AttendantID = &attendantID
+		crew.AttendantSubstitute = true
+	}
+	return crew
+}
+
+// skipReason - 일정이 그날 운행하지 않는 이유 (일정 자체 조건, 공휴일 제외 일정의 공휴일, 예외 날짜)
+// extra는 예외 날짜의 추가 운행으로 운행하는지 여부
+func (day *tripDay) skipReason(schedule *domain.Schedule) (reason TripSkipReason, detail util.LocalizedText, extra bool) {
+	reason, detail = scheduleSkipReason(schedule, day.date)
+	if reason == "" && day.isHoliday && schedule.SkipHolidays {
+		reason, detail = TripSkipHoliday, util.Text("공휴일(%s)입니다", day.holidayName)
+	}
+	// 예외 날짜: 추가 운행은 비활성 일정만 아니면 운행, 운행 안 함은 원래 운행하는 날에만 의미 있음
+	exception := day.exceptions[schedule.ID]
+	extra = exception != nil && exception.IsExtra() && reason != TripSkipInactive
+	if extra {
+		reason, detail = "", util.LocalizedText{}
+	}
+	if reason == "" && exception != nil && exception.IsSkip() {
+		reason, detail = TripSkipException, exceptionDetail(exception.Reason)
+	}
+	return reason, detail, extra
+}
+
+// scheduleSkipReason - 일정 자체 조건으로 운행하지 않는 이유 (운행하면 빈 값, Schedule.IsActiveOnDate와 같은 순서)
+func scheduleSkipReason(schedule *domain.Schedule, date time.Time) (TripSkipReason, util.LocalizedText) {
+	if !schedule.IsActive() {
+		return TripSkipInactive, util.Text("비활성 일정입니다")
+	}
+	if (schedule.ValidFrom != nil && date.Before(*schedule.ValidFrom)) || (schedule.ValidTo != nil && date.After(*schedule.ValidTo)) {
+		return TripSkipOutOfPeriod, util.Text("유효 기간(%s ~ %s) 밖입니다", formatOptionalDate(schedule.ValidFrom), formatOptionalDate(schedule.ValidTo))
+	}
+	if !schedule.IsActiveOnDate(date) {
+		return TripSkipNotServiceDay, util.Text("운행 요일이 아닙니다")
+	}
+	return "", util.LocalizedText{}
+}
+
+// exceptionDetail - 예외 날짜 설명 (사유가 있으면 덧붙임)
+func exceptionDetail(reason string) util.LocalizedText {
+	if reason == "" {
+		return util.Text("예외 날짜로 운행하지 않습니다")
+	}
+	return util.Text("예외 날짜로 운행하지 않습니다 (%s)", reason)
+}
+
+// vehicleSkipReason - 일정 차량을 운행 날짜에 쓸 수 없는 이유 (같은 차량은 한 번만 조회)
+func (s *TripGenerationService) vehicleSkipReason(ctx context.Context, cache map[string]*domain.Vehicle, vehicleID string, date time.Time) (TripSkipReason, util.LocalizedText, error) {
+	vehicle, ok := cache[vehicleID]
+	if !ok {
+		var err error
+		vehicle, err = s.vehicleRepo.GetByID(ctx, vehicleID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return TripSkipVehicleUnavailable, util.Text("배정된 차량이 없습니다"), nil
+		}
+		if err != nil {
+			return "", util.LocalizedText{}, util.NewInternalError(err)
+		}
+		cache[vehicleID] = vehicle
+	}
+
+	switch {
+	case vehicle.IsAvailableOn(date):
+		return "", util.LocalizedText{}, nil
+	case !vehicle.IsActive():
+		return TripSkipVehicleUnavailable, util.Text("차량 %s 상태가 %s입니다", vehicle.PlateNumber, string(vehicle.Status)), nil
+	default:
+		return TripSkipVehicleUnavailable, util.Text("차량 %s 보험 또는 정기검사가 만료됩니다", vehicle.PlateNumber), nil
+	}
+}
+
+// capacityOverflow - 경로 배정 탑승자가 차량 승객 정원을 넘는 인원 (같은 경로는 한 번만 조회)
+func (s *TripGenerationService) capacityOverflow(ctx context.Context, cache map[string]int, vehicle *domain.Vehicle, routeID string) (int, error) {
+	riders, ok := cache[routeID]
+	if !ok {
+		var err error
+		if riders, err = routeRiders(ctx, s.passengerRepo, routeID); err != nil {
+			return 0, err
+		}
+		cache[routeID] = riders
+	}
+	return capacityOverflow(vehicle, riders), nil
+}
+
+// formatOptionalDate - 날짜 (없으면 "-")
+func formatOptionalDate(date *time.Time) string {
+	if date == nil {
+		return "-"
+	}
+	return date.Format(time.DateOnly)
+}
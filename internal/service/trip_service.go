@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/database"
+)
+
+// 📝 설명: 운행(Trip) 생성/시작/완료/취소 비즈니스 로직
+// 🎯 실무 포인트: 운행 시작·완료 권한은 라우터가 아닌 Service에서 운행별로 검증
+// 배정 기사 또는 운행 시작 권한(CanStartTrip)이 있는 배정 동승자만 허용
+// 운행 생성 시 경로 정류장에 배정된 활동 중 탑승자의 탑승 기록을 함께 생성 (승차/하차 기록 바로 가능)
+// 보호자가 결석 신고한 탑승자는 결석 신고(excused) 기록으로 생성되어 불참 처리 대상에서 제외
+// 운행 생성은 운행 생성 계획(TripGenerationService)과 같은 기준으로 그날 운행하는 일정인지와 배정 인력을 판정 (공휴일 제외 일정의 공휴일, 일정 예외 날짜, 승인된 대체 배정, 차량 승객 정원 등)
+// 운행 시작/완료/취소는 운행 저장과 같은 트랜잭션으로 이벤트 기록 (events가 nil이면 발행 안 함)
+// ⚠️ 주의사항: 인증 주체는 요청 context(auth.FromContext)에서 조회
+
+// TripFinalizer - 운행 완료 저장 직전 운행 값 확정 (주행 거리, 주행거리계 등)
+// 운행 저장과 같은 트랜잭션(ctx)에서 실행, 에러를 반환하면 운행 완료를 롤백하고 그 에러로 응답 (기사 입력 값 검증 실패 등)
+type TripFinalizer interface {
+	FinalizeTrip(ctx context.Context, trip *domain.Trip) error
+}
+
+// TripService - 운행 서비스
+type TripService struct {
+	tripRepo      repository.TripRepository
+	scheduleRepo  repository.ScheduleRepository
+	passengerRepo repository.PassengerRepository
+	absenceRepo   repository.PassengerAbsenceRepository
+	attendantRepo repository.AttendantRepository
+	txManager     database.TxManager
+	events        EventPublisher
+	planner       *TripGenerationService // nil이면 일정 운행 요일만 확인
+	finalizers    []TripFinalizer
+}
+
+// NewTripService - 운행 서비스 생성 (events가 nil이면 이벤트 발행 안 함, finalizers는 운행 완료 시 등록 순서대로 호출)
+// txManager는 운행 저장과 이벤트 기록, 완료 finalizer를 한 트랜잭션으로 묶음 (nil이면 트랜잭션 없이 실행)
+// planner는 운행 생성 시 운행 생성 계획과 같은 기준으로 그날 운행하는 일정인지와 기사/동승자를 판정 (운행 생성 API가 아니면 nil)
+func NewTripService(
+	tripRepo repository.TripRepository,
+	scheduleRepo repository.ScheduleRepository,
+	passengerRepo repository.PassengerRepository,
+	absenceRepo repository.PassengerAbsenceRepository,
+	attendantRepo repository.AttendantRepository,
+	txManager database.TxManager,
+	events EventPublisher,
+	planner *TripGenerationService,
+	finalizers ...TripFinalizer,
+) *TripService {
+	return &TripService{
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		passengerRepo: passengerRepo,
+		absenceRepo:   absenceRepo,
+		attendantRepo: attendantRepo,
+		txManager:     txManager,
+		events:        events,
+		planner:       planner,
+		finalizers:    finalizers,
+	}
+}
+
+// Create - 일정과 날짜로 운행 생성 (차량은 일정 기본값, 기사/동승자는 그날 승인된 대체 배정 또는 일정 기본값, 배정 탑승자 기록 포함)
+// 공휴일 제외 일정은 공휴일에, 예외 날짜로 운행하지 않는 날에 생성하지 않음 (추가 운행 날짜는 운행 요일이 아니어도 생성)
+// 차량을 쓸 수 없거나 경로 배정 탑승자가 차량 승객 정원을 넘으면 생성하지 않음 (정원 초과는 CONFLICT, details에 초과 인원)
+func (s *TripService) Create(ctx context.Context, req *dto.CreateTripRequest) (*domain.Trip, error) {
+	date, err := time.Parse(time.DateOnly, req.Date)
+	if err != nil {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"date": "날짜는 YYYY-MM-DD 형식이어야 합니다",
+		})
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, req.ScheduleID)
+	if err != nil {
+		return nil, toAppError(err, "운행 일정")
+	}
+	crew, err := s.planCrew(ctx, schedule, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.tripRepo.GetByScheduleAndDate(ctx, schedule.ID, date); err == nil {
+		return nil, util.NewDuplicateError("운행")
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, util.NewInternalError(err)
+	}
+
+	trip := domain.NewTrip(schedule.ID, date, schedule.VehicleID, crew.DriverID, crew.AttendantID)
+	trip.OrganizationID = schedule.OrganizationID // 자동 생성 작업은 인증 주체가 없으므로 일정의 기관을 따름
+	if err := s.addAssignedPassengers(ctx, trip, schedule); err != nil {
+		return nil, err
+	}
+	if err := s.tripRepo.Create(ctx, trip); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return trip, nil
+}
+
+// planCrew - 일정이 그날 운행하는지 확인하고 운행 기사/동승자 결정
+// planner가 있으면 공휴일, 예외 날짜, 승인된 대체 배정, 차량 상태/승객 정원 등 운행 생성 계획과 같은 기준, 없으면 운행 요일과 일정 기본 배정만
+func (s *TripService) planCrew(ctx context.Context, schedule *domain.Schedule, date time.Time) (*TripCrew, error) {
+	if s.planner != nil {
+		return s.planner.PlanTrip(ctx, schedule, date)
+	}
+	if !schedule.IsActiveOnDate(date) {
+		return nil, tripSkipError("date", util.Text("해당 날짜에 운행하지 않는 일정입니다"))
+	}
+	return &TripCrew{DriverID: schedule.DefaultDriverID, AttendantID: schedule.DefaultAttendantID}, nil
+}
+
+// addAssignedPassengers - 일정 경로의 정류장에 배정된 활동 중 탑승자마다 탑승 기록 추가 (운행과 함께 저장)
+// 그날 일정 시간대에 결석 신고가 있으면 결석 신고 기록으로 추가
+func (s *TripService) addAssignedPassengers(ctx context.Context, trip *domain.Trip, schedule *domain.Schedule) error {
+	passengers, _, err := s.passengerRepo.List(ctx, repository.PassengerFilter{
+		Status:  domain.PassengerStatusActive,
+		RouteID: schedule.RouteID,
+	})
+	if err != nil {
+		return util.NewInternalError(err)
+	}
+	absences, err := s.absenceRepo.ListByDate(ctx, trip.Date)
+	if err != nil {
+		return util.NewInternalError(err)
+	}
+	excused := make(map[string]*domain.PassengerAbsence, len(absences))
+	for _, absence := range absences {
+		if absence.Covers(trip.Date, schedule.TimeSlot) {
+			excused[absence.PassengerID] = absence
+		}
+	}
+
+	for _, passenger := range passengers {
+		if passenger.AssignedStopID == "" {
+			continue
+		}
+		record := domain.NewTripPassenger(trip.ID, passenger.ID, passenger.AssignedStopID)
+		if absence, ok := excused[passenger.ID]; ok {
+			record.MarkExcused(absence.Reason)
+		}
+		trip.TripPassengers = append(trip.TripPassengers, *record)
+	}
+	return nil
+}
+
+// Get - 운행 조회
+func (s *TripService) Get(ctx context.Context, id string) (*domain.Trip, error) {
+	trip, err := s.tripRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "운행")
+	}
+	return trip, nil
+}
+
+// List - 운행 목록 조회
+func (s *TripService) List(ctx context.Context, filter repository.TripFilter) ([]*domain.Trip, int64, error) {
+	trips, total, err := s.tripRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return trips, total, nil
+}
+
+// Start - 운행 시작 (배정 기사 또는 운행 시작 권한이 있는 배정 동승자만)
+func (s *TripService) Start(ctx context.Context, id string, req *dto.TripLocationRequest) (*domain.Trip, error) {
+	trip, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	startedBy, err := s.authorizeCrew(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := trip.Start(startedBy, newTripLocation(req)); err != nil {
+		return nil, util.NewConflictError("운행을 시작할 수 없는 상태입니다: %s", trip.Status)
+	}
+
+	if err := s.save(ctx, trip, domain.WebhookEventTripStarted); err != nil {
+		return nil, toAppError(err, "운행")
+	}
+	return trip, nil
+}
+
+// Complete - 운행 완료 (시작과 같은 권한 규칙, 일시정지 중이면 일시정지를 끝내고 완료)
+// 기사가 확인한 주행거리계(odometer_km)는 운행에 기록 → 차량 주행거리계 갱신은 finalizer(OdometerService)가 처리
+func (s *TripService) Complete(ctx context.Context, id string, req *dto.CompleteTripRequest) (*domain.Trip, error) {
+	trip, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizeCrew(ctx, trip); err != nil {
+		return nil, err
+	}
+
+	if !trip.CanComplete() {
+		return nil, util.NewConflictError("운행을 완료할 수 없는 상태입니다: %s", trip.Status)
+	}
+	if err := onBoardConflict(trip); err != nil {
+		return nil, err
+	}
+	if !trip.IsVehicleChecked() {
+		return nil, util.NewConflictError("운행을 완료하기 전에 차량 내부 잔류 인원 확인이 필요합니다")
+	}
+	var location *dto.TripLocationRequest
+	if req != nil {
+		location = &req.TripLocationRequest
+		trip.OdometerKm = req.OdometerKm
+	}
+	if err := trip.Complete(newTripLocation(location)); err != nil {
+		return nil, util.NewConflictError("운행을 완료할 수 없는 상태입니다: %s", trip.Status)
+	}
+
+	// finalizer(주행 거리, 차량 주행거리계)도 운행 저장과 같은 트랜잭션 → 저장이 실패하면 함께 롤백되어 재시도해도 중복 반영되지 않음
+	err = saveAndPublish(ctx, s.txManager, s.events, func(ctx context.Context) error {
+		for _, finalizer := range s.finalizers {
+			if err := finalizer.FinalizeTrip(ctx, trip); err != nil {
+				return err
+			}
+		}
+		return s.tripRepo.Update(ctx, trip)
+	}, domain.WebhookEventTripCompleted, trip.OrganizationID, trip.ID, tripWebhookData(trip))
+	if err != nil {
+		return nil, toAppError(err, "운행")
+	}
+	return trip, nil
+}
+
+// ConfirmVehicleEmpty - 차량 내부 잔류 인원 없음 확인 (배정 기사/동승자, 운행 완료 전 필수)
+// 승차 후 하차/예외 처리되지 않은 탑승자가 있으면 CONFLICT (details.passenger_ids)
+func (s *TripService) ConfirmVehicleEmpty(ctx context.Context, id string) (*domain.Trip, error) {
+	trip, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeAssigned(ctx, trip); err != nil {
+		return nil, err
+	}
+
+	if !trip.IsActive() {
+		return nil, util.NewConflictError("운행 중에만 차량 내부를 확인할 수 있습니다: %s", trip.Status)
+	}
+	if err := onBoardConflict(trip); err != nil {
+		return nil, err
+	}
+	if err := trip.ConfirmVehicleEmpty(crewActor(ctx)); err != nil {
+		return nil, util.NewConflictError("운행 중에만 차량 내부를 확인할 수 있습니다: %s", trip.Status)
+	}
+
+	if err := s.tripRepo.Update(ctx, trip); err != nil {
+		return nil, toAppError(err, "운행")
+	}
+
+	return trip, nil
+}
+
+// Pause - 운행 일시정지 (배정 기사/동승자, 운행 중에만 / 휴식, 병원 대기 등)
+func (s *TripService) Pause(ctx context.Context, id string, req *dto.PauseTripRequest) (*domain.Trip, error) {
+	trip, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeAssigned(ctx, trip); err != nil {
+		return nil, err
+	}
+
+	if err := trip.Pause(crewActor(ctx), req.Reason); err != nil {
+		return nil, util.NewConflictError("운행을 일시정지할 수 없는 상태입니다: %s", trip.Status)
+	}
+
+	if err := s.tripRepo.Update(ctx, trip); err != nil {
+		return nil, toAppError(err, "운행")
+	}
+
+	return trip, nil
+}
+
+// Resume - 일시정지한 운행 재개 (일시정지와 같은 권한 규칙)
+func (s *TripService) Resume(ctx context.Context, id string) (*domain.Trip, error) {
+	trip, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeAssigned(ctx, trip); err != nil {
+		return nil, err
+	}
+
+	if err := trip.Resume(crewActor(ctx)); err != nil {
+		return nil, util.NewConflictError("일시정지 중인 운행만 재개할 수 있습니다: %s", trip.Status)
+	}
+
+	if err := s.tripRepo.Update(ctx, trip); err != nil {
+		return nil, toAppError(err, "운행")
+	}
+
+	return trip, nil
+}
+
+// Cancel - 운행 취소 (관리자, 라우터에서 제한)
+func (s *TripService) Cancel(ctx context.Context, id string, req *dto.CancelTripRequest) (*domain.Trip, error) {
+	trip, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if trip.IsCancelled() {
+		return nil, util.NewConflictError("이미 취소된 운행입니다")
+	}
+	if err := trip.Cancel(req.Reason); err != nil {
+		return nil, util.NewConflictError("완료된 운행은 취소할 수 없습니다")
+	}
+
+	if err := s.save(ctx, trip, domain.WebhookEventTripCancelled); err != nil {
+		return nil, toAppError(err, "운행")
+	}
+	return trip, nil
+}
+
+// save - 운행 저장과 이벤트 기록 (한 트랜잭션)
+func (s *TripService) save(ctx context.Context, trip *domain.Trip, event domain.WebhookEvent) error {
+	return saveAndPublish(ctx, s.txManager, s.events, func(ctx context.Context) error {
+		return s.tripRepo.Update(ctx, trip)
+	}, event, trip.OrganizationID, trip.ID, tripWebhookData(trip))
+}
+
+// authorizeCrew - 운행 조작 권한 확인 후 StartedBy 값(driver:{id} / attendant:{id}) 반환
+// 관리자를 포함해 배정되지 않은 사용자는 FORBIDDEN
+func (s *TripService) authorizeCrew(ctx context.Context, trip *domain.Trip) (string, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return "", util.NewUnauthorizedError()
+	}
+	if principal.ProfileID == "" {
+		return "", util.NewForbiddenError()
+	}
+
+	switch principal.Role {
+	case domain.RoleDriver:
+		if trip.AssignedDriverID == principal.ProfileID {
+			return fmt.Sprintf("driver:%s", principal.ProfileID), nil
+		}
+	case domain.RoleAttendant:
+		if trip.AssignedAttendantID == nil || *trip.AssignedAttendantID != principal.ProfileID {
+			break
+		}
+		attendant, err := s.attendantRepo.GetByID(ctx, principal.ProfileID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return "", util.NewForbiddenError()
+			}
+			return "", util.NewInternalError(err)
+		}
+		if attendant.CanStartTrip && attendant.IsAvailableForTrip() {
+			return fmt.Sprintf("attendant:%s", principal.ProfileID), nil
+		}
+	}
+
+	return "", util.NewForbiddenError()
+}
+
+// authorizeAssigned - 배정 기사 또는 배정 동승자인지 확인 (운행 시작 권한과 무관, 승차/하차 기록 등)
+func (s *TripService) authorizeAssigned(ctx context.Context, trip *domain.Trip) error {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return util.NewUnauthorizedError()
+	}
+	if principal.ProfileID == "" {
+		return util.NewForbiddenError()
+	}
+
+	switch principal.Role {
+	case domain.RoleDriver:
+		if trip.AssignedDriverID == principal.ProfileID {
+			return nil
+		}
+	case domain.RoleAttendant:
+		if trip.AssignedAttendantID != nil && *trip.AssignedAttendantID == principal.ProfileID {
+			return nil
+		}
+	}
+	return util.NewForbiddenError()
+}
+
+// onBoardConflict - 승차 후 하차/예외 처리되지 않은 탑승자가 있으면 CONFLICT (없으면 nil)
+func onBoardConflict(trip *domain.Trip) error {
+	onBoard := trip.OnBoardPassengers()
+	if len(onBoard) == 0 {
+		return nil
+	}
+	passengerIDs := make([]string, len(onBoard))
+	for i, record := range onBoard {
+		passengerIDs[i] = record.PassengerID
+	}
+	return util.NewConflictErrorWithDetails("하차 또는 예외 처리되지 않은 탑승자가 있습니다", map[string]interface{}{
+		"passenger_ids": passengerIDs,
+	})
+}
+
+// crewActor - 기록자 값 (driver:{id} / attendant:{id}, authorizeAssigned 통과 후 사용)
+func crewActor(ctx context.Context) string {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", principal.Role, principal.ProfileID)
+}
+
+// newTripLocation - 요청 위치를 도메인 위치로 변환 (미전달 시 nil)
+func newTripLocation(req *dto.TripLocationRequest) *domain.Location {
+	if req == nil || req.Latitude == nil || req.Longitude == nil {
+		return nil
+	}
+	return &domain.Location{
+		Latitude:  *req.Latitude,
+		Longitude: *req.Longitude,
+		Timestamp: time.Now(),
+	}
+}
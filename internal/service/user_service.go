@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 계정 관리 비즈니스 로직 (관리자 전용)
+// 🎯 실무 포인트: 이메일 중복은 DB 제약 이전에 Service에서 먼저 검증
+// ⚠️ 주의사항: 관리자 외 역할은 업무 프로필(ProfileID) 연결 필수
+// 플랫폼 운영자(super_admin) 계정은 플랫폼 운영자만 비활성화/역할 변경/제외/세션 종료 가능 (기관 관리자는 FORBIDDEN)
+
+// UserService - 계정 서비스
+type UserService struct {
+	userRepo repository.UserRepository
+	sessions repository.SessionRepository
+}
+
+// NewUserService - 계정 서비스 생성
+func NewUserService(userRepo repository.UserRepository, sessions repository.SessionRepository) *UserService {
+	return &UserService{userRepo: userRepo, sessions: sessions}
+}
+
+// Create - 계정 생성
+func (s *UserService) Create(ctx context.Context, req *dto.CreateUserRequest) (*domain.User, error) {
+	role := domain.Role(req.Role)
+	if err := requireProfile(role, req.ProfileID); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureEmailAvailable(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
+	hash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	user := domain.NewUser(req.Email, hash, role, req.ProfileID)
+	user.Phone = req.Phone
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return user, nil
+}
+
+// Get - 계정 조회
+func (s *UserService) Get(ctx context.Context, id string) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "계정")
+	}
+	return user, nil
+}
+
+// List - 계정 목록 조회
+func (s *UserService) List(ctx context.Context, filter repository.UserFilter) ([]*domain.User, int64, error) {
+	users, total, err := s.userRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return users, total, nil
+}
+
+// Disable - 계정 비활성화 (로그인 차단 + 기존 세션 종료)
+func (s *UserService) Disable(ctx context.Context, id string) (*domain.User, error) {
+	user, err := s.getManageable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive() {
+		return nil, util.NewConflictError("이미 비활성화된 계정입니다")
+	}
+
+	user.Disable()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, toAppError(err, "계정")
+	}
+
+	if _, err := s.sessions.RevokeAll(ctx, user.ID); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return user, nil
+}
+
+// Enable - 계정 재활성화
+func (s *UserService) Enable(ctx context.Context, id string) (*domain.User, error) {
+	user, err := s.getManageable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsActive() {
+		return nil, util.NewConflictError("이미 활성화된 계정입니다")
+	}
+
+	user.Enable()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, toAppError(err, "계정")
+	}
+
+	return user, nil
+}
+
+// ChangeRole - 기관 내 역할 변경 (본인 역할은 변경 불가 → 마지막 관리자가 권한을 잃는 것 방지)
+// 기존 토큰에는 이전 역할이 담겨 있으므로 세션을 모두 종료해 다시 로그인하게 함
+func (s *UserService) ChangeRole(ctx context.Context, actorID, id string, req *dto.ChangeUserRoleRequest) (*domain.User, error) {
+	if actorID == id {
+		return nil, util.NewBadRequestError("본인 계정의 역할은 변경할 수 없습니다")
+	}
+
+	role := domain.Role(req.Role)
+	if err := requireProfile(role, req.ProfileID); err != nil {
+		return nil, err
+	}
+
+	user, err := s.getManageable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	user.ChangeRole(role, req.ProfileID)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, toAppError(err, "계정")
+	}
+
+	if _, err := s.sessions.RevokeAll(ctx, user.ID); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return user, nil
+}
+
+// Remove - 기관에서 구성원 제외 (계정 삭제 + 기존 세션 종료, 본인은 제외 불가)
+func (s *UserService) Remove(ctx context.Context, actorID, id string) error {
+	if actorID == id {
+		return util.NewBadRequestError("본인 계정은 기관에서 제외할 수 없습니다")
+	}
+	if _, err := s.getManageable(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		return toAppError(err, "계정")
+	}
+
+	if _, err := s.sessions.RevokeAll(ctx, id); err != nil {
+		return util.NewInternalError(err)
+	}
+	return nil
+}
+
+// RevokeSessions - 계정의 모든 세션 강제 종료 (분실/도난 단말 차단), 종료한 세션 수 반환
+func (s *UserService) RevokeSessions(ctx context.Context, id string) (int, error) {
+	if _, err := s.getManageable(ctx, id); err != nil {
+		return 0, err
+	}
+
+	count, err := s.sessions.RevokeAll(ctx, id)
+	if err != nil {
+		return 0, util.NewInternalError(err)
+	}
+	return count, nil
+}
+
+// getManageable - 인증 주체가 관리할 수 있는 계정 조회 (플랫폼 운영자 계정은 플랫폼 운영자만, 아니면 FORBIDDEN)
+func (s *UserService) getManageable(ctx context.Context, id string) (*domain.User, error) {
+	user, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == domain.RoleSuperAdmin {
+		if principal, ok := auth.FromContext(ctx); !ok || !principal.IsSuperAdmin() {
+			return nil, util.NewForbiddenError()
+		}
+	}
+	return user, nil
+}
+
+// ensureEmailAvailable - 이메일 중복 검증
+func (s *UserService) ensureEmailAvailable(ctx context.Context, email string) error {
+	_, err := s.userRepo.GetByEmail(ctx, email)
+	if err == nil {
+		return util.NewDuplicateError("이메일")
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return util.NewInternalError(err)
+	}
+	return nil
+}
+
+// requireProfile - 관리자 외 역할은 업무 프로필 연결 필수
+func requireProfile(role domain.Role, profileID string) error {
+	if role != domain.RoleAdmin && profileID == "" {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"profile_id": "관리자 외 계정은 프로필 ID가 필요합니다",
+		})
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 차량 서류 업로드/목록/다운로드/삭제 (보험 증권, 정기검사 증명서 등)
+// 🎯 실무 포인트: 파일은 FileService(용도 vehicle_document)가 검증/저장, 서류 레코드는 파일을 참조 → 보험/정기검사 서류의 만료일은 차량 만료일에 자동 반영
+// ⚠️ 주의사항: 업로드는 파일 저장 → 서류 생성 순서 (서류 생성 실패 시 파일 삭제), 삭제는 서류 먼저 (파일 삭제 실패는 로그만)
+
+// VehicleDocumentService - 차량 서류 서비스
+type VehicleDocumentService struct {
+	vehicleRepo  repository.VehicleRepository
+	documentRepo repository.VehicleDocumentRepository
+	fileService  *FileService
+	locations    TimezoneResolver
+}
+
+// NewVehicleDocumentService - 차량 서류 서비스 생성 (locations가 nil이면 한국 시간)
+func NewVehicleDocumentService(
+	vehicleRepo repository.VehicleRepository,
+	documentRepo repository.VehicleDocumentRepository,
+	fileService *FileService,
+	locations TimezoneResolver,
+) *VehicleDocumentService {
+	return &VehicleDocumentService{
+		vehicleRepo:  vehicleRepo,
+		documentRepo: documentRepo,
+		fileService:  fileService,
+		locations:    locations,
+	}
+}
+
+// Upload - 서류 파일 저장 + 메타데이터 생성, 보험/정기검사 만료일이 차량 만료일보다 늦으면 차량에 반영
+func (s *VehicleDocumentService) Upload(ctx context.Context, vehicleID string, req *dto.UploadVehicleDocumentRequest, fileName string, data []byte) (*domain.VehicleDocument, error) {
+	vehicle, err := s.vehicleRepo.GetByID(ctx, vehicleID)
+	if err != nil {
+		return nil, toAppError(err, "차량")
+	}
+
+	location := organizationLocation(ctx, s.locations, vehicle.OrganizationID)
+	issuedOn := parseDocumentDate(req.IssuedOn, location)
+	expiresOn := parseDocumentDate(req.ExpiresOn, location)
+	if issuedOn != nil && expiresOn != nil && expiresOn.Before(*issuedOn) {
+		return nil, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"expires_on": "만료일은 발급일 이후여야 합니다",
+		})
+	}
+
+	file, err := s.fileService.Upload(ctx, domain.FilePurposeVehicleDocument, fileName, data)
+	if err != nil {
+		return nil, err
+	}
+	document := domain.NewVehicleDocument(vehicleID, domain.VehicleDocumentType(req.Type), req.Title, file)
+	document.OrganizationID = vehicle.OrganizationID
+	document.IssuedOn = issuedOn
+	document.ExpiresOn = expiresOn
+	if err := s.documentRepo.Create(ctx, document); err != nil {
+		s.removeFile(ctx, file.ID)
+		return nil, util.NewInternalError(err)
+	}
+
+	if document.ApplyExpiry(vehicle) {
+		if err := s.vehicleRepo.Update(ctx, vehicle); err != nil {
+			return nil, util.NewInternalError(err)
+		}
+	}
+	return document, nil
+}
+
+// List - 차량의 서류 목록 (최신 업로드 먼저, 종류를 생략하면 전체)
+func (s *VehicleDocumentService) List(ctx context.Context, vehicleID string, query *dto.VehicleDocumentQuery) ([]*domain.VehicleDocument, error) {
+	if _, err := s.vehicleRepo.GetByID(ctx, vehicleID); err != nil {
+		return nil, toAppError(err, "차량")
+	}
+
+	documents, err := s.documentRepo.List(ctx, vehicleID, domain.VehicleDocumentType(query.Type))
+	if err != nil {
+		return nil, util.NewInternalError(err)
+	}
+	return documents, nil
+}
+
+// Download - 서류 파일 메타데이터와 내용
+func (s *VehicleDocumentService) Download(ctx context.Context, vehicleID, id string) (*domain.File, []byte, error) {
+	document, err := s.documentRepo.GetByID(ctx, vehicleID, id)
+	if err != nil {
+		return nil, nil, toAppError(err, "차량 서류")
+	}
+	return s.fileService.Read(ctx, document.FileID)
+}
+
+// Delete - 서류 삭제 (차량 만료일은 그대로 유지)
+func (s *VehicleDocumentService) Delete(ctx context.Context, vehicleID, id string) error {
+	document, err := s.documentRepo.GetByID(ctx, vehicleID, id)
+	if err != nil {
+		return toAppError(err, "차량 서류")
+	}
+	if err := s.documentRepo.Delete(ctx, vehicleID, id); err != nil {
+		return toAppError(err, "차량 서류")
+	}
+	s.removeFile(ctx, document.FileID)
+	return nil
+}
+
+// removeFile - 서류 파일 삭제 (실패는 로그만, 남은 파일은 업로드한 관리자만 조회 가능)
+func (s *VehicleDocumentService) removeFile(ctx context.Context, fileID string) {
+	if err := s.fileService.Delete(ctx, fileID); err != nil {
+		logger.FromContext(ctx).Warn("Failed to delete vehicle document file", map[string]interface{}{
+			"file_id": fileID,
+			"error":   err.Error(),
+		})
+	}
+}
+
+// parseDocumentDate - 기관 시간대 날짜 (빈 값이면 nil, 형식은 바인딩에서 검증 완료)
+func parseDocumentDate(value string, location *time.Location) *time.Time {
+	if value == "" {
+		return nil
+	}
+	date, _ := time.ParseInLocation(time.DateOnly, value, location)
+	return &date
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 차량 비즈니스 로직
+// 🎯 실무 포인트: 차량 번호 중복 체크 등 규칙은 Service에서 처리
+// ⚠️ 주의사항: Repository 에러는 AppError로 변환해서 반환
+
+// VehicleService - 차량 서비스
+type VehicleService struct {
+	vehicleRepo repository.VehicleRepository
+}
+
+// NewVehicleService - 차량 서비스 생성
+func NewVehicleService(vehicleRepo repository.VehicleRepository) *VehicleService {
+	return &VehicleService{vehicleRepo: vehicleRepo}
+}
+
+// Create - 차량 등록
+// 차량 번호가 이미 존재하면 DUPLICATE_ERROR 반환
+func (s *VehicleService) Create(ctx context.Context, req *dto.CreateVehicleRequest) (*domain.Vehicle, error) {
+	if err := s.ensurePlateNumberAvailable(ctx, req.PlateNumber, ""); err != nil {
+		return nil, err
+	}
+
+	vehicle := domain.NewVehicle(
+		req.PlateNumber,
+		req.Model,
+		req.Manufacturer,
+		domain.VehicleType(req.VehicleType),
+		req.Capacity,
+		req.Year,
+		req.Color,
+	)
+	vehicle.InsuranceExpiry = req.InsuranceExpiry
+	vehicle.InspectionExpiry = req.InspectionExpiry
+
+	if err := s.vehicleRepo.Create(ctx, vehicle); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return vehicle, nil
+}
+
+// Get - 차량 단건 조회
+func (s *VehicleService) Get(ctx context.Context, id string) (*domain.Vehicle, error) {
+	vehicle, err := s.vehicleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "차량")
+	}
+	return vehicle, nil
+}
+
+// List - 차량 목록 조회 (전체 개수 포함, 정원 하한이 상한보다 크면 VALIDATION)
+func (s *VehicleService) List(ctx context.Context, filter repository.VehicleFilter) ([]*domain.Vehicle, int64, error) {
+	if filter.MinCapacity != nil && filter.MaxCapacity != nil && *filter.MinCapacity > *filter.MaxCapacity {
+		return nil, 0, util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"max_capacity": "정원 상한은 하한 이상이어야 합니다",
+		})
+	}
+	vehicles, total, err := s.vehicleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return vehicles, total, nil
+}
+
+// Update - 차량 정보 수정 (전달된 필드만 반영)
+func (s *VehicleService) Update(ctx context.Context, id string, req *dto.UpdateVehicleRequest) (*domain.Vehicle, error) {
+	vehicle, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.PlateNumber != nil && *req.PlateNumber != vehicle.PlateNumber {
+		if err := s.ensurePlateNumberAvailable(ctx, *req.PlateNumber, vehicle.ID); err != nil {
+			return nil, err
+		}
+		vehicle.PlateNumber = *req.PlateNumber
+	}
+	if req.Model != nil {
+		vehicle.Model = *req.Model
+	}
+	if req.Manufacturer != nil {
+		vehicle.Manufacturer = *req.Manufacturer
+	}
+	if req.VehicleType != nil {
+		vehicle.VehicleType = domain.VehicleType(*req.VehicleType)
+	}
+	if req.Capacity != nil {
+		vehicle.Capacity = *req.Capacity
+	}
+	if req.Year != nil {
+		vehicle.Year = *req.Year
+	}
+	if req.Color != nil {
+		vehicle.Color = *req.Color
+	}
+	if req.InsuranceExpiry != nil {
+		vehicle.UpdateInsuranceExpiry(*req.InsuranceExpiry)
+	}
+	if req.InspectionExpiry != nil {
+		vehicle.UpdateInspectionExpiry(*req.InspectionExpiry)
+	}
+	if req.Status != nil {
+		switch domain.VehicleStatus(*req.Status) {
+		case domain.VehicleStatusActive:
+			vehicle.SetActive()
+		case domain.VehicleStatusMaintenance:
+			vehicle.SetMaintenance()
+		case domain.VehicleStatusInactive:
+			vehicle.SetInactive()
+		}
+	}
+
+	if err := s.vehicleRepo.Update(ctx, vehicle); err != nil {
+		return nil, toAppError(err, "차량")
+	}
+
+	return vehicle, nil
+}
+
+// Delete - 차량 삭제 (soft delete)
+func (s *VehicleService) Delete(ctx context.Context, id string) error {
+	if err := s.vehicleRepo.SoftDelete(ctx, id); err != nil {
+		return toAppError(err, "차량")
+	}
+	return nil
+}
+
+// Restore - 삭제된 차량 복구 (같은 차량 번호로 다시 등록된 차량이 있으면 DUPLICATE)
+func (s *VehicleService) Restore(ctx context.Context, id string) (*domain.Vehicle, error) {
+	vehicle, err := s.vehicleRepo.GetDeleted(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "삭제된 차량")
+	}
+	if err := s.ensurePlateNumberAvailable(ctx, vehicle.PlateNumber, vehicle.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.vehicleRepo.Restore(ctx, id); err != nil {
+		return nil, toAppError(err, "삭제된 차량")
+	}
+	return s.Get(ctx, id)
+}
+
+// ensurePlateNumberAvailable - 차량 번호 중복 확인
+// excludeID: 수정 시 자기 자신은 제외
+func (s *VehicleService) ensurePlateNumberAvailable(ctx context.Context, plateNumber, excludeID string) error {
+	existing, err := s.vehicleRepo.GetByPlateNumber(ctx, plateNumber)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return util.NewInternalError(err)
+	}
+
+	if existing.ID != excludeID {
+		return util.NewDuplicateError("차량 번호")
+	}
+	return nil
+}
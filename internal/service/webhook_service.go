@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/webhook"
+)
+
+// 📝 설명: 웹훅 구독 관리와 이벤트 전달 (운행 시작/완료/취소, 승차/하차/불참)
+// 🎯 실무 포인트: 운행/탑승 이벤트는 아웃박스 릴레이가 HandleEvent로 전달 → 수신 서버가 느려도 앱 응답은 지연되지 않음
+// 구독은 기관 소속 → 이벤트가 발생한 기관의 구독에만 전달
+// 구독마다 전달 기록을 먼저 저장(pending)한 뒤 전달, 일시 장애는 재시도 작업(RetryDue)이 지수 백오프로 다시 전달
+// ⚠️ 주의사항: 같은 이벤트를 여러 번 받을 수 있음 (at-least-once) → 수신 측은 본문 id로 중복 처리
+// 전달 순서는 보장하지 않으므로 수신 측은 본문의 시각으로 판단
+
+// webhook 전달 기준
+const (
+	webhookRetryBatch = 100             // 재시도 작업 1회에 가져가는 최대 건수
+	webhookLease      = 5 * time.Minute // 전달 중인 기록을 다른 인스턴스가 가져가지 않는 시간 (첫 전달 포함)
+)
+
+// tripWebhookData - 운행 이벤트 데이터
+func tripWebhookData(trip *domain.Trip) *dto.TripWebhookData {
+	return &dto.TripWebhookData{
+		TripID:             trip.ID,
+		ScheduleID:         trip.ScheduleID,
+		Date:               trip.Date.Format(time.DateOnly),
+		VehicleID:          trip.VehicleID,
+		DriverID:           trip.AssignedDriverID,
+		AttendantID:        trip.AssignedAttendantID,
+		Status:             string(trip.Status),
+		StartedAt:          trip.StartedAt,
+		CompletedAt:        trip.CompletedAt,
+		CancellationReason: trip.CancellationReason,
+		DelayedAt:          trip.DelayedAt,
+		DelayReason:        trip.DelayReason,
+	}
+}
+
+// passengerWebhookData - 탑승 이벤트 데이터
+func passengerWebhookData(trip *domain.Trip, record *domain.TripPassenger) *dto.PassengerWebhookData {
+	return &dto.PassengerWebhookData{
+		TripID:          trip.ID,
+		ScheduleID:      trip.ScheduleID,
+		Date:            trip.Date.Format(time.DateOnly),
+		PassengerID:     record.PassengerID,
+		StopID:          record.StopID,
+		BoardedAt:       record.BoardedAt,
+		AlightedAt:      record.AlightedAt,
+		NoShowAt:        record.NoShowAt,
+		NoShowReason:    record.NoShowReason,
+		ExceptionAt:     record.ExceptionAt,
+		ExceptionReason: record.ExceptionReason,
+	}
+}
+
+// WebhookService - 웹훅 서비스
+type WebhookService struct {
+	subscriptionRepo repository.WebhookSubscriptionRepository
+	deliveryRepo     repository.WebhookDeliveryRepository
+	sender           webhook.Sender
+	retry            NotificationRetryPolicy
+}
+
+// NewWebhookService - 웹훅 서비스 생성 (retry.MaxAttempts가 1 이하면 재시도 안 함)
+func NewWebhookService(
+	subscriptionRepo repository.WebhookSubscriptionRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	sender webhook.Sender,
+	retry NotificationRetryPolicy,
+) *WebhookService {
+	return &WebhookService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		sender:           sender,
+		retry:            retry,
+	}
+}
+
+// Create - 웹훅 구독 등록 (비밀키를 생략하면 생성, 비밀키는 반환값으로 한 번만 제공)
+func (s *WebhookService) Create(ctx context.Context, createdBy string, req *dto.CreateWebhookRequest) (*dto.IssuedWebhookResponse, error) {
+	if err := validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := webhook.GenerateSecret()
+		if err != nil {
+			return nil, util.NewInternalError(err)
+		}
+		secret = generated
+	}
+
+	subscription := domain.NewWebhookSubscription(req.Name, req.URL, secret, webhookEvents(req.Events), createdBy)
+	if err := s.subscriptionRepo.Create(ctx, subscription); err != nil {
+		return nil, util.NewInternalError(err)
+	}
+
+	return &dto.IssuedWebhookResponse{Webhook: subscription, Secret: secret}, nil
+}
+
+// Get - 웹훅 구독 단건 조회
+func (s *WebhookService) Get(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	subscription, err := s.subscriptionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, toAppError(err, "웹훅")
+	}
+	return subscription, nil
+}
+
+// List - 웹훅 구독 목록 조회
+func (s *WebhookService) List(ctx context.Context, filter repository.WebhookSubscriptionFilter) ([]*domain.WebhookSubscription, int64, error) {
+	subscriptions, total, err := s.subscriptionRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return subscriptions, total, nil
+}
+
+// Update - 웹훅 구독 수정 (이름, URL, 이벤트, 활성 여부)
+func (s *WebhookService) Update(ctx context.Context, id string, req *dto.UpdateWebhookRequest) (*domain.WebhookSubscription, error) {
+	subscription, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		subscription.Name = *req.Name
+	}
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+		subscription.URL = *req.URL
+	}
+	if req.Events != nil {
+		subscription.Events = webhookEvents(req.Events)
+	}
+	if req.Active != nil {
+		subscription.Active = *req.Active
+	}
+
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return nil, toAppError(err, "웹훅")
+	}
+	return subscription, nil
+}
+
+// Delete - 웹훅 구독 삭제 (전달 기록 포함)
+func (s *WebhookService) Delete(ctx context.Context, id string) error {
+	if err := s.subscriptionRepo.Delete(ctx, id); err != nil {
+		return toAppError(err, "웹훅")
+	}
+	return nil
+}
+
+// ListDeliveries - 구독의 전달 기록 목록 조회 (최신순)
+func (s *WebhookService) ListDeliveries(ctx context.Context, id string, filter repository.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, 0, err
+	}
+
+	filter.SubscriptionID = id
+	deliveries, total, err := s.deliveryRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, util.NewInternalError(err)
+	}
+	return deliveries, total, nil
+}
+
+// HandleEvent - 도메인 이벤트를 받는 구독에 전달 (아웃박스 릴레이의 핸들러)
+func (s *WebhookService) HandleEvent(ctx context.Context, e event.Event) error {
+	_, err := s.Dispatch(ctx, e)
+	return err
+}
+
+// Dispatch - 이벤트가 발생한 기관에서 이벤트를 받는 구독마다 전달 기록을 저장하고 전달 (전달 기록 수 반환)
+// 전달 실패는 기록에 남기고 에러로 반환하지 않음 (구독 조회/기록 저장 실패만 에러)
+// 기관이 없는 이벤트는 어느 구독에도 전달하지 않음
+func (s *WebhookService) Dispatch(ctx context.Context, e event.Event) (int, error) {
+	if e.OrganizationID == "" {
+		logger.FromContext(ctx).Warn("Webhook event without organization skipped", map[string]interface{}{"event_id": e.ID, "type": e.Type})
+		return 0, nil
+	}
+	webhookEvent := domain.WebhookEvent(e.Type)
+	subscriptions, err := s.subscriptionRepo.ListActiveByEvent(ctx, e.OrganizationID, webhookEvent)
+	if err != nil || len(subscriptions) == 0 {
+		return 0, err
+	}
+
+	envelope := dto.WebhookEnvelope{ID: e.ID, Type: webhookEvent, CreatedAt: e.OccurredAt, Data: e.Payload}
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, subscription := range subscriptions {
+		delivery := domain.NewWebhookDelivery(subscription, envelope.ID, webhookEvent, string(body), time.Now().Add(webhookLease))
+		if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+			return i, err
+		}
+		s.deliver(ctx, subscription, delivery)
+		if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+			return i, err
+		}
+	}
+	return len(subscriptions), nil
+}
+
+// RetryDue - 전달할 때가 된 기록 다시 전달 (재시도 작업에서 주기 실행, 처리 건수 반환)
+// 중단된 첫 전달(pending)도 lease가 지나면 함께 처리
+func (s *WebhookService) RetryDue(ctx context.Context, now time.Time) (int, error) {
+	deliveries, err := s.deliveryRepo.ClaimDue(ctx, now, webhookLease, webhookRetryBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, delivery := range deliveries {
+		subscription, err := s.subscriptionRepo.GetByID(ctx, delivery.SubscriptionID)
+		switch {
+		case errors.Is(err, repository.ErrNotFound) || (err == nil && !subscription.Active):
+			delivery.MarkFailed(0, "구독이 삭제되었거나 비활성화되었습니다", false, time.Now())
+		case err != nil:
+			return i, err
+		default:
+			s.deliver(ctx, subscription, delivery)
+		}
+		if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+			return i, err
+		}
+		if delivery.Status == domain.WebhookDeliveryDead {
+			logger.FromContext(ctx).Warn("Webhook delivery dead-lettered", map[string]interface{}{
+				"delivery_id":     delivery.ID,
+				"subscription_id": delivery.SubscriptionID,
+				"attempts":        delivery.Attempts,
+				"response":        delivery.Response,
+			})
+		}
+	}
+	return len(deliveries), nil
+}
+
+// deliver - 서명해서 전달하고 결과를 기록에 반영 (일시 장애는 시도 횟수가 남아 있으면 재시도 예약, 아니면 dead)
+func (s *WebhookService) deliver(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) {
+	status, err := s.sender.Send(ctx, &webhook.Message{
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		Event:      string(delivery.Event),
+		DeliveryID: delivery.ID,
+		Body:       []byte(delivery.Payload),
+	})
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		delivery.MarkDelivered(status, now)
+	case webhook.IsPermanent(err):
+		delivery.MarkFailed(status, err.Error(), false, now)
+	case delivery.Attempts+1 >= s.retry.MaxAttempts:
+		delivery.MarkFailed(status, err.Error(), true, now)
+	default:
+		delivery.MarkRetry(status, err.Error(), now.Add(s.retry.delay(delivery.Attempts+1)), now)
+	}
+}
+
+// validateWebhookURL - http/https 주소만 허용
+func validateWebhookURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"url": "http 또는 https 주소여야 합니다",
+		})
+	}
+	return nil
+}
+
+// webhookEvents - 요청 이벤트 목록 변환 (중복 제거)
+func webhookEvents(values []string) []domain.WebhookEvent {
+	events := make([]domain.WebhookEvent, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		events = append(events, domain.WebhookEvent(value))
+	}
+	return events
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// 📝 설명: 로컬 디스크 저장소 (Dir 아래에 키 경로 그대로 저장)
+// 🎯 실무 포인트: 개발 환경이나 단일 서버 배포에서 외부 저장소 없이 사용
+// ⚠️ 주의사항: 서버가 여러 대면 인스턴스마다 디스크가 달라 다른 서버가 올린 파일을 못 읽음 → 운영은 S3 사용
+
+// LocalStorage - 로컬 디스크 저장소
+type LocalStorage struct {
+	Dir string
+}
+
+// NewLocalStorage - 로컬 디스크 저장소 생성
+// 사용 예: store := storage.NewLocalStorage(cfg.Storage.LocalDir)
+func NewLocalStorage(dir string) *LocalStorage {
+	return &LocalStorage{Dir: dir}
+}
+
+// Put - 파일 저장 (같은 키는 덮어씀, 임시 파일에 쓴 뒤 이름 변경)
+func (s *LocalStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get - 파일 조회
+func (s *LocalStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// Delete - 파일 삭제
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path - 키의 디스크 경로
+func (s *LocalStorage) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.Dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// 📝 설명: 업로드 파일 원본 저장소 (차량 서류, 면허증 스캔, 사고 사진 등 → 메타데이터는 files 테이블)
+// 🎯 실무 포인트: 로컬 디스크(개발/단일 서버)와 S3 호환 저장소(운영)를 Storage 인터페이스로 감싸 STORAGE_PROVIDER로 교체
+// ⚠️ 주의사항: 키는 슬래시로 구분한 상대 경로 (예: "vehicle_document/{파일 ID}"), ".."과 절대 경로는 거부
+
+// Storage - 파일 저장/조회/삭제 (LocalStorage, S3Storage가 구현)
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error) // 없으면 ErrNotFound
+	Delete(ctx context.Context, key string) error        // 없어도 에러 아님
+}
+
+var (
+	// ErrNotFound - 키에 해당하는 파일 없음
+	ErrNotFound = errors.New("storage: object not found")
+	// ErrInvalidKey - 빈 키, 절대 경로, 상위 디렉터리(..)를 포함한 키
+	ErrInvalidKey = errors.New("storage: invalid key")
+)
+
+// validateKey - 저장소 밖을 가리킬 수 없는 상대 경로인지 확인
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package util
 
-import "net/http"
+import (
+	"math"
+	"net/http"
+	"time"
+)
 
 // 📝 설명: Spring의 @ControllerAdvice처럼 중앙 집중식 에러 관리
 // 🎯 실무 포인트: 에러 코드를 상수로 관리하여 일관성 유지
@@ -16,6 +20,8 @@ const (
 	ErrCodeDuplicate    = "DUPLICATE_ERROR"
 	ErrCodeBadRequest   = "BAD_REQUEST"
 	ErrCodeConflict     = "CONFLICT"
+	ErrCodeTooMany      = "TOO_MANY_REQUESTS"
+	ErrCodeExternal     = "EXTERNAL_SERVICE_ERROR"
 )
 
 // AppError - 애플리케이션 에러 구조체
@@ -25,6 +31,8 @@ type AppError struct {
 	Message    string                 `json:"message"`           // 사용자에게 보여줄 메시지
 	StatusCode int                    `json:"-"`                 // HTTP 상태 코드 (JSON 응답에 미포함)
 	Details    map[string]interface{} `json:"details,omitempty"` // 추가 상세 정보 (선택적)
+
+	text LocalizedText // 언어별로 메시지를 다시 만들 키와 인자 (비어 있으면 Message를 키로)
 }
 
 // Error - error 인터페이스 구현
@@ -32,43 +40,96 @@ func (e *AppError) Error() string {
 	return e.Message
 }
 
-// NewValidationError - 입력값 검증 실패 에러
-// 사용 예: NewValidationError("입력값이 올바르지 않습니다", map[string]interface{}{"field": "email"})
-func NewValidationError(message string, details map[string]interface{}) *AppError {
+// WithDetail - 상세 정보를 추가한 복사본 (원본은 그대로, 공유 에러 값도 안전하게 사용)
+// 사용 예: appErr.WithDetail("request_id", requestID)
+func (e *AppError) WithDetail(key string, value interface{}) *AppError {
+	details := make(map[string]interface{}, len(e.Details)+1)
+	for k, v := range e.Details {
+		details[k] = v
+	}
+	details[key] = value
+
+	copied := *e
+	copied.Details = details
+	return &copied
+}
+
+// Localize - 메시지와 상세 사유를 지정한 언어로 다시 만든 복사본 (코드는 그대로, 번역이 없는 문구는 원문)
+// 사용 예: appErr.Localize(util.LocaleFromContext(ctx))
+func (e *AppError) Localize(locale Locale) *AppError {
+	if locale == DefaultLocale {
+		return e
+	}
+	text := e.text
+	if text.Key == "" {
+		text = Text(e.Message)
+	}
+	copied := *e
+	copied.Message = text.Localize(locale)
+	copied.Details = LocalizeDetails(locale, e.Details)
+	return &copied
+}
+
+// LocalizeDetails - 상세 정보의 사유 문구 번역 (문자열은 원문을 키로 조회, 중첩 맵도 같은 방식)
+// 사용 예: LocalizeDetails(LocaleFromContext(ctx), rowErrors) (에러가 아닌 응답에 사유를 담을 때)
+func LocalizeDetails(locale Locale, details map[string]interface{}) map[string]interface{} {
+	if details == nil {
+		return nil
+	}
+	localized := make(map[string]interface{}, len(details))
+	for key, value := range details {
+		switch v := value.(type) {
+		case string:
+			localized[key] = GetLocalizedMessage(locale, v)
+		case LocalizedText:
+			localized[key] = v.Localize(locale)
+		case map[string]interface{}:
+			localized[key] = LocalizeDetails(locale, v)
+		default:
+			localized[key] = value
+		}
+	}
+	return localized
+}
+
+// newAppError - 메시지 키(또는 한국어 원문)와 인자로 에러 생성 (Message는 기본 언어)
+func newAppError(code string, statusCode int, key string, args ...interface{}) *AppError {
 	return &AppError{
-		Code:       ErrCodeValidation,
-		Message:    message,
-		StatusCode: http.StatusBadRequest,
-		Details:    details,
+		Code:       code,
+		Message:    GetMessage(key, args...),
+		StatusCode: statusCode,
+		text:       Text(key, args...),
 	}
 }
 
+// NewValidationError - 입력값 검증 실패 에러 (message: 메시지 키 또는 문구, details: 필드별 사유)
+// 사용 예: NewValidationError(MsgValidationFailed, map[string]interface{}{"email": "올바른 이메일 형식이 아닙니다"})
+func NewValidationError(message string, details map[string]interface{}) *AppError {
+	err := newAppError(ErrCodeValidation, http.StatusBadRequest, message)
+	err.Details = details
+	return err
+}
+
 // NewNotFoundError - 리소스를 찾을 수 없는 경우
 // 사용 예: NewNotFoundError("차량")
 func NewNotFoundError(resource string) *AppError {
-	return &AppError{
-		Code:       ErrCodeNotFound,
-		Message:    GetMessage(MsgResourceNotFound, resource),
-		StatusCode: http.StatusNotFound,
-	}
+	return newAppError(ErrCodeNotFound, http.StatusNotFound, MsgResourceNotFound, resource)
 }
 
 // NewUnauthorizedError - 인증 실패
 func NewUnauthorizedError() *AppError {
-	return &AppError{
-		Code:       ErrCodeUnauthorized,
-		Message:    GetMessage(MsgUnauthorized),
-		StatusCode: http.StatusUnauthorized,
-	}
+	return newAppError(ErrCodeUnauthorized, http.StatusUnauthorized, MsgUnauthorized)
+}
+
+// NewUnauthorizedErrorWithMessage - 사유를 명시한 인증 실패 (message: 메시지 키 또는 문구)
+// 사용 예: NewUnauthorizedErrorWithMessage(MsgInvalidCredentials)
+func NewUnauthorizedErrorWithMessage(message string) *AppError {
+	return newAppError(ErrCodeUnauthorized, http.StatusUnauthorized, message)
 }
 
 // NewForbiddenError - 권한 없음
 func NewForbiddenError() *AppError {
-	return &AppError{
-		Code:       ErrCodeForbidden,
-		Message:    GetMessage(MsgForbidden),
-		StatusCode: http.StatusForbidden,
-	}
+	return newAppError(ErrCodeForbidden, http.StatusForbidden, MsgForbidden)
 }
 
 // NewInternalError - 서버 내부 오류
@@ -79,38 +140,54 @@ func NewInternalError(err error) *AppError {
 		details["error"] = err.Error()
 	}
 
-	return &AppError{
-		Code:       ErrCodeInternal,
-		Message:    GetMessage(MsgInternalError),
-		StatusCode: http.StatusInternalServerError,
-		Details:    details,
-	}
+	appErr := newAppError(ErrCodeInternal, http.StatusInternalServerError, MsgInternalError)
+	appErr.Details = details
+	return appErr
 }
 
 // NewDuplicateError - 중복된 리소스
 // 사용 예: NewDuplicateError("차량 번호")
 func NewDuplicateError(resource string) *AppError {
-	return &AppError{
-		Code:       ErrCodeDuplicate,
-		Message:    GetMessage(MsgDuplicate, resource),
-		StatusCode: http.StatusConflict,
-	}
+	return newAppError(ErrCodeDuplicate, http.StatusConflict, MsgDuplicate, resource)
 }
 
-// NewBadRequestError - 잘못된 요청
-func NewBadRequestError(message string) *AppError {
-	return &AppError{
-		Code:       ErrCodeBadRequest,
-		Message:    message,
-		StatusCode: http.StatusBadRequest,
-	}
+// NewBadRequestError - 잘못된 요청 (message: 메시지 키 또는 문구, args: 포맷 인자)
+// 사용 예: NewBadRequestError(MsgInvalidResetToken)
+func NewBadRequestError(message string, args ...interface{}) *AppError {
+	return newAppError(ErrCodeBadRequest, http.StatusBadRequest, message, args...)
 }
 
-// NewConflictError - 비즈니스 로직 충돌
-func NewConflictError(message string) *AppError {
-	return &AppError{
-		Code:       ErrCodeConflict,
-		Message:    message,
-		StatusCode: http.StatusConflict,
+// NewConflictError - 비즈니스 로직 충돌 (message: 메시지 키 또는 문구, args: 포맷 인자)
+// 사용 예: NewConflictError("운행을 시작할 수 없는 상태입니다: %s", trip.Status)
+func NewConflictError(message string, args ...interface{}) *AppError {
+	return newAppError(ErrCodeConflict, http.StatusConflict, message, args...)
+}
+
+// NewConflictErrorWithDetails - 충돌 대상 정보를 함께 반환하는 비즈니스 로직 충돌
+// 사용 예: NewConflictErrorWithDetails("이미 배정된 일정이 있습니다", map[string]interface{}{"conflicts": conflicts})
+func NewConflictErrorWithDetails(message string, details map[string]interface{}) *AppError {
+	err := newAppError(ErrCodeConflict, http.StatusConflict, message)
+	err.Details = details
+	return err
+}
+
+// NewTooManyRequestsError - 요청 한도 초과 (retryAfter 후 재시도 가능)
+// 사용 예: NewTooManyRequestsError(30 * time.Second)
+func NewTooManyRequestsError(retryAfter time.Duration) *AppError {
+	err := newAppError(ErrCodeTooMany, http.StatusTooManyRequests, MsgTooManyRequests)
+	err.Details = map[string]interface{}{"retry_after_seconds": int(math.Ceil(retryAfter.Seconds()))}
+	return err
+}
+
+// NewExternalServiceError - 외부 API(지도 등) 호출 실패 (원인은 details.error)
+// 사용 예: NewExternalServiceError("지도 API", err)
+func NewExternalServiceError(service string, err error) *AppError {
+	details := map[string]interface{}{}
+	if err != nil {
+		details["error"] = err.Error()
 	}
+
+	appErr := newAppError(ErrCodeExternal, http.StatusBadGateway, MsgExternalService, service)
+	appErr.Details = details
+	return appErr
 }
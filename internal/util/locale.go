@@ -0,0 +1,86 @@
+package util
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 📝 설명: 응답 언어(로케일) 선택 (Accept-Language 또는 사용자 설정)
+// 🎯 실무 포인트: 미들웨어가 요청 context에 로케일을 저장 → 핸들러/에러 응답이 그 언어로 메시지를 만듦
+// ⚠️ 주의사항: 지원하지 않는 언어는 기본 언어(한국어)로 응답
+
+// Locale - 응답 언어 (ISO 639-1 코드)
+type Locale string
+
+const (
+	LocaleKorean  Locale = "ko"
+	LocaleEnglish Locale = "en"
+)
+
+// DefaultLocale - 요청에 언어 정보가 없거나 지원하지 않는 언어일 때
+const DefaultLocale = LocaleKorean
+
+// ParseLocale - 지원하는 언어 코드인지 확인 (en-US → en, 대소문자 무시)
+func ParseLocale(value string) (Locale, bool) {
+	tag := strings.ToLower(strings.TrimSpace(value))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	locale := Locale(tag)
+	if !supportsLocale(locale) {
+		return "", false
+	}
+	return locale, true
+}
+
+// NegotiateLocale - Accept-Language 헤더에서 지원하는 언어 중 우선순위가 가장 높은 언어 (없으면 기본 언어)
+// 사용 예: NegotiateLocale("en-US,en;q=0.9,ko;q=0.8") -> "en"
+func NegotiateLocale(acceptLanguage string) Locale {
+	type candidate struct {
+		locale Locale
+		q      float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		locale, ok := ParseLocale(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil || parsed <= 0 {
+				continue
+			}
+			q = parsed
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q})
+	}
+	if len(candidates) == 0 {
+		return DefaultLocale
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].locale
+}
+
+// localeKey - 요청 로케일을 담는 context 키
+type localeKey struct{}
+
+// WithLocale - context에 응답 언어 저장
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext - context의 응답 언어 (없으면 기본 언어)
+func LocaleFromContext(ctx context.Context) Locale {
+	if ctx == nil {
+		return DefaultLocale
+	}
+	if locale, ok := ctx.Value(localeKey{}).(Locale); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
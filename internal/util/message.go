@@ -1,18 +1,29 @@
 package util
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
 
 // 📝 설명: Spring의 messages.properties처럼 메시지 중앙 관리
-// 🎯 실무 포인트: 다국어 지원 확장 가능, 메시지 일관성 유지
+// 🎯 실무 포인트: 언어별 메시지 카탈로그(messages_ko.go, messages_en.go)로 메시지 일관성 유지
+// 메시지는 키로 조회 → 에러는 키와 인자를 함께 들고 있다가 응답 언어가 정해지면 그 언어로 다시 만듦 (AppError.Localize)
+// 자유 형식 문구(서비스의 상세 안내, 검증 사유, 알림 본문)는 한국어 원문이 키 (phrases_en.go에 번역)
 // ⚠️ 주의사항: fmt.Sprintf 포맷 순서 주의 (%s 순서와 args 순서 일치)
+// 카탈로그는 catalogMu로 보호 (런타임 추가와 요청 처리 중 조회가 동시에 일어날 수 있음)
+// 번역이 없는 문구는 한국어 그대로 응답
 
 // 메시지 키 상수
 const (
 	// 성공 메시지
-	MsgSuccess = "SUCCESS"
-	MsgCreated = "CREATED"
-	MsgUpdated = "UPDATED"
-	MsgDeleted = "DELETED"
+	MsgSuccess  = "SUCCESS"
+	MsgCreated  = "CREATED"
+	MsgUpdated  = "UPDATED"
+	MsgDeleted  = "DELETED"
+	MsgRestored = "RESTORED"
 
 	// 에러 메시지
 	MsgResourceNotFound = "RESOURCE_NOT_FOUND"
@@ -23,6 +34,15 @@ const (
 	MsgValidationFailed = "VALIDATION_FAILED"
 	MsgBadRequest       = "BAD_REQUEST"
 	MsgConflict         = "CONFLICT"
+	MsgTooManyRequests  = "TOO_MANY_REQUESTS"
+	MsgExternalService  = "EXTERNAL_SERVICE_ERROR"
+
+	// 인증 메시지
+	MsgInvalidCredentials = "INVALID_CREDENTIALS"
+	MsgAccountDisabled    = "ACCOUNT_DISABLED"
+	MsgInvalidResetToken  = "INVALID_RESET_TOKEN"
+	MsgPasswordResetSent  = "PASSWORD_RESET_SENT"
+	MsgInvalidInvitation  = "INVALID_INVITATION"
 
 	// 특정 리소스 메시지
 	MsgVehicleNotFound  = "VEHICLE_NOT_FOUND"
@@ -31,65 +51,160 @@ const (
 	MsgScheduleNotFound = "SCHEDULE_NOT_FOUND"
 )
 
-// 메시지 맵 (한국어)
-// 추후 다국어 지원 시 messages_en.go, messages_ko.go로 분리 가능
-var messages = map[string]string{
-	// 성공 메시지
-	MsgSuccess: "요청이 성공적으로 처리되었습니다",
-	MsgCreated: "%s이(가) 생성되었습니다",
-	MsgUpdated: "%s이(가) 수정되었습니다",
-	MsgDeleted: "%s이(가) 삭제되었습니다",
+// catalogs - 언어별 메시지 카탈로그 (catalogMu로 보호)
+var catalogs = map[Locale]map[string]string{
+	LocaleKorean:  messagesKo,
+	LocaleEnglish: mergeMessages(messagesEn, phrasesEn),
+}
 
-	// 에러 메시지
-	MsgResourceNotFound: "%s을(를) 찾을 수 없습니다",
-	MsgUnauthorized:     "인증이 필요합니다",
-	MsgForbidden:        "접근 권한이 없습니다",
-	MsgInternalError:    "서버 내부 오류가 발생했습니다",
-	MsgDuplicate:        "이미 존재하는 %s입니다",
-	MsgValidationFailed: "입력값 검증에 실패했습니다",
-	MsgBadRequest:       "잘못된 요청입니다",
-	MsgConflict:         "요청이 현재 상태와 충돌합니다",
+// resourceNames - 언어별 리소스 이름 표기 (메시지 인자 번역, 한국어는 원문)
+var resourceNames = map[Locale]map[string]string{
+	LocaleEnglish: resourceNamesEn,
+}
 
-	// 특정 리소스 메시지
-	MsgVehicleNotFound:  "차량을 찾을 수 없습니다",
-	MsgDriverNotFound:   "운전자를 찾을 수 없습니다",
-	MsgRouteNotFound:    "경로를 찾을 수 없습니다",
-	MsgScheduleNotFound: "운행 일정을 찾을 수 없습니다",
+// catalogMu - catalogs 동시 접근 보호 (AddLocalizedMessage만 쓰기)
+var catalogMu sync.RWMutex
+
+// mergeMessages - 카탈로그 합치기 (뒤쪽이 우선)
+func mergeMessages(sources ...map[string]string) map[string]string {
+	merged := make(map[string]string)
+	for _, source := range sources {
+		for key, message := range source {
+			merged[key] = message
+		}
+	}
+	return merged
+}
+
+// supportsLocale - 카탈로그가 있는 언어인지
+func supportsLocale(locale Locale) bool {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	_, ok := catalogs[locale]
+	return ok
 }
 
 // getMessage - 메시지 조회 (내부 사용)
 // 포맷팅 지원: getMessage(MsgCreated, "차량") -> "차량이(가) 생성되었습니다"
 func getMessage(key string, args ...interface{}) string {
-	msg, exists := messages[key]
+	return GetLocalizedMessage(DefaultLocale, key, args...)
+}
+
+// GetMessage - 외부에서 사용할 수 있는 메시지 조회 함수 (기본 언어)
+// 사용 예:
+//   GetMessage(MsgSuccess) -> "요청이 성공적으로 처리되었습니다"
+//   GetMessage(MsgCreated, "차량") -> "차량이(가) 생성되었습니다"
+func GetMessage(key string, args ...interface{}) string {
+	return getMessage(key, args...)
+}
+
+// GetLocalizedMessage - 지정한 언어의 메시지 (그 언어에 없는 키는 기본 언어, 기본 언어에도 없으면 키를 원문으로)
+// 인자: 문자열은 리소스 이름 번역, LocalizedText는 같은 언어로, []LocalizedText는 각각 만든 뒤 ", "로 연결
+// 사용 예: GetLocalizedMessage(LocaleEnglish, MsgCreated, "차량") -> "Vehicle has been created"
+func GetLocalizedMessage(locale Locale, key string, args ...interface{}) string {
+	catalogMu.RLock()
+	msg, exists := catalogs[locale][key]
 	if !exists {
-		return key // 메시지가 없으면 키를 그대로 반환
+		locale = DefaultLocale
+		if msg, exists = catalogs[DefaultLocale][key]; !exists {
+			msg = key // 카탈로그에 없으면 키(자유 형식 문구)를 그대로 사용
+		}
 	}
+	catalogMu.RUnlock()
 
 	if len(args) > 0 {
-		return fmt.Sprintf(msg, args...)
+		translated := make([]interface{}, len(args))
+		for i, arg := range args {
+			translated[i] = localizeArg(locale, arg)
+		}
+		return fmt.Sprintf(msg, translated...)
 	}
 	return msg
 }
 
-// GetMessage - 외부에서 사용할 수 있는 메시지 조회 함수
-// 사용 예:
-//   GetMessage(MsgSuccess) -> "요청이 성공적으로 처리되었습니다"
-//   GetMessage(MsgCreated, "차량") -> "차량이(가) 생성되었습니다"
-func GetMessage(key string, args ...interface{}) string {
-	return getMessage(key, args...)
+// GetRequestMessage - 요청 언어(LocaleFromContext)의 메시지 (핸들러의 응답 메시지용)
+// 사용 예: GetRequestMessage(ctx, MsgCreated, "차량")
+func GetRequestMessage(ctx context.Context, key string, args ...interface{}) string {
+	return GetLocalizedMessage(LocaleFromContext(ctx), key, args...)
+}
+
+// localizeArg - 메시지 인자의 언어별 표기
+func localizeArg(locale Locale, arg interface{}) interface{} {
+	switch value := arg.(type) {
+	case string:
+		return LocalizeName(locale, value)
+	case LocalizedText:
+		return value.Localize(locale)
+	case []LocalizedText:
+		parts := make([]string, len(value))
+		for i, text := range value {
+			parts[i] = text.Localize(locale)
+		}
+		return strings.Join(parts, ", ")
+	}
+	return arg
+}
+
+// LocalizeName - 리소스/역할 이름의 언어별 표기 (표기가 없으면 그대로)
+// 사용 예: LocalizeName(LocaleEnglish, "차량") -> "Vehicle"
+func LocalizeName(locale Locale, name string) string {
+	if translated := resourceNames[locale][name]; translated != "" {
+		return translated
+	}
+	return name
+}
+
+// LocalizedText - 언어가 정해질 때 만드는 문구 (에러 상세 사유, 알림 본문 등)
+// Key는 메시지 키 또는 한국어 원문, Args는 포맷 인자 (GetLocalizedMessage와 같은 규칙)
+type LocalizedText struct {
+	Key  string
+	Args []interface{}
 }
 
-// AddMessage - 런타임에 메시지 추가 (필요 시 사용)
+// Text - 나중에 번역할 문구
+// 사용 예: Text("최소 %s개(자) 이상이어야 합니다", "8")
+func Text(key string, args ...interface{}) LocalizedText {
+	return LocalizedText{Key: key, Args: args}
+}
+
+// Localize - 지정한 언어의 문구
+func (t LocalizedText) Localize(locale Locale) string {
+	return GetLocalizedMessage(locale, t.Key, t.Args...)
+}
+
+// String - 기본 언어 문구
+func (t LocalizedText) String() string {
+	return t.Localize(DefaultLocale)
+}
+
+// MarshalJSON - 기본 언어 문자열로 직렬화 (언어를 정하지 않고 응답/기록하는 경우)
+func (t LocalizedText) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// AddMessage - 런타임에 메시지 추가 (필요 시 사용, 기본 언어)
 // 사용 예: AddMessage("CUSTOM_MSG", "커스텀 메시지입니다")
 func AddMessage(key, message string) {
-	messages[key] = message
+	AddLocalizedMessage(DefaultLocale, key, message)
+}
+
+// AddLocalizedMessage - 런타임에 언어별 메시지 추가 (지원하지 않는 언어면 무시)
+// 사용 예: AddLocalizedMessage(LocaleEnglish, "CUSTOM_MSG", "Custom message")
+func AddLocalizedMessage(locale Locale, key, message string) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	if catalog, ok := catalogs[locale]; ok {
+		catalog[key] = message
+	}
 }
 
-// GetAllMessages - 모든 메시지 조회 (디버깅/문서화 용도)
+// GetAllMessages - 모든 메시지 조회 (디버깅/문서화 용도, 기본 언어)
 func GetAllMessages() map[string]string {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
 	// 원본 맵을 복사하여 반환 (외부에서 수정 방지)
-	result := make(map[string]string, len(messages))
-	for k, v := range messages {
+	result := make(map[string]string, len(messagesKo))
+	for k, v := range messagesKo {
 		result[k] = v
 	}
 	return result
@@ -0,0 +1,113 @@
+package util
+
+// messagesEn - 영어 메시지
+var messagesEn = map[string]string{
+	// 성공 메시지
+	MsgSuccess:  "Request processed successfully",
+	MsgCreated:  "%s has been created",
+	MsgUpdated:  "%s has been updated",
+	MsgDeleted:  "%s has been deleted",
+	MsgRestored: "%s has been restored",
+
+	// 에러 메시지
+	MsgResourceNotFound: "%s not found",
+	MsgUnauthorized:     "Authentication required",
+	MsgForbidden:        "Access denied",
+	MsgInternalError:    "An internal server error occurred",
+	MsgDuplicate:        "%s already exists",
+	MsgValidationFailed: "Validation failed",
+	MsgBadRequest:       "Bad request",
+	MsgConflict:         "The request conflicts with the current state",
+	MsgTooManyRequests:  "Too many requests. Please try again later",
+	MsgExternalService:  "An error occurred while calling %s. Please try again later",
+
+	// 인증 메시지
+	MsgInvalidCredentials: "Invalid email or password",
+	MsgAccountDisabled:    "This account is disabled",
+	MsgInvalidResetToken:  "The password reset token is invalid or has expired",
+	MsgPasswordResetSent:  "If an account exists, password reset instructions have been sent",
+	MsgInvalidInvitation:  "The invitation is invalid or has expired",
+
+	// 특정 리소스 메시지
+	MsgVehicleNotFound:  "Vehicle not found",
+	MsgDriverNotFound:   "Driver not found",
+	MsgRouteNotFound:    "Route not found",
+	MsgScheduleNotFound: "Schedule not found",
+}
+
+// resourceNamesEn - 메시지 인자/안내 문구에 쓰는 리소스·역할 이름의 영어 표기 (없는 이름은 그대로)
+var resourceNamesEn = map[string]string{
+	"API 키":     "API key",
+	"결석 신고":     "Absence report",
+	"관리자":       "Administrator",
+	"경로":        "Route",
+	"계정":        "Account",
+	"기관":        "Organization",
+	"기관 설정":     "Organization settings",
+	"기기":        "Device",
+	"기사":        "Driver",
+	"기사 대체 배정":  "Driver substitution",
+	"기사 상태":     "Driver status",
+	"동승자":       "Attendant",
+	"동승자 대체 배정": "Attendant substitution",
+	"면허 번호":     "License number",
+	"면허 확인":     "License review",
+	"면허 정보":     "License information",
+	"면허증 스캔":    "License scan",
+	"보호자":       "Guardian",
+	"보호자 연락처":   "Guardian phone number",
+	"보호자 정보":    "Guardian information",
+	"비밀번호":      "Password",
+	"사진 동의":     "Photo consent",
+	"삭제된 경로":    "Deleted route",
+	"삭제된 기사":    "Deleted driver",
+	"삭제된 일정":    "Deleted schedule",
+	"삭제된 차량":    "Deleted vehicle",
+	"삭제된 탑승자":   "Deleted passenger",
+	"세션":        "Session",
+	"알림 설정":     "Notification settings",
+	"언어 설정":     "Language settings",
+	"연결된 탑승자":   "Linked passenger",
+	"운행":        "Trip",
+	"운행 배정":     "Trip assignment",
+	"운행 시작 권한":  "Trip start permission",
+	"운행 일정":     "Schedule",
+	"웹훅":        "Webhook",
+	"이메일":       "Email",
+	"일정":        "Schedule",
+	"일정 상태":     "Schedule status",
+	"일정 예외 날짜":  "Schedule exception",
+	"자녀 연결":     "Child link",
+	"정류장":       "Stop",
+	"정류장 기록":    "Stop event",
+	"정류장 배정":    "Stop assignment",
+	"정류장 순서":    "Stop order",
+	"주유 기록":     "Fuel log",
+	"주행거리계":     "Odometer",
+	"주소 검색":     "Address search",
+	"지도 API":    "Map API",
+	"차량":        "Vehicle",
+	"차량 번호":     "Plate number",
+	"차량 서류":     "Vehicle document",
+	"초대":        "Invitation",
+	"캘린더":       "Calendar",
+	"캘린더 구독":    "Calendar subscription",
+	"파일":        "File",
+	"파일 저장소":    "File storage",
+	"탑승 기록":     "Boarding record",
+	"탑승자":       "Passenger",
+	"탑승자 사진":    "Passenger photo",
+	"플랫폼 운영자":   "Platform operator",
+
+	// 서류 종류 (만료 안내)
+	"운전면허":   "Driver's license",
+	"자동차 보험": "Vehicle insurance",
+	"정기검사":   "Vehicle inspection",
+
+	// JSON 타입 이름 (검증 사유 "%s 타입이어야 합니다")
+	"객체":  "object",
+	"문자열": "string",
+	"배열":  "array",
+	"불리언": "boolean",
+	"숫자":  "number",
+}
@@ -0,0 +1,36 @@
+package util
+
+// messagesKo - 한국어 메시지 (기본 언어, 다른 언어에 없는 키는 이 메시지 사용)
+var messagesKo = map[string]string{
+	// 성공 메시지
+	MsgSuccess:  "요청이 성공적으로 처리되었습니다",
+	MsgCreated:  "%s이(가) 생성되었습니다",
+	MsgUpdated:  "%s이(가) 수정되었습니다",
+	MsgDeleted:  "%s이(가) 삭제되었습니다",
+	MsgRestored: "%s이(가) 복구되었습니다",
+
+	// 에러 메시지
+	MsgResourceNotFound: "%s을(를) 찾을 수 없습니다",
+	MsgUnauthorized:     "인증이 필요합니다",
+	MsgForbidden:        "접근 권한이 없습니다",
+	MsgInternalError:    "서버 내부 오류가 발생했습니다",
+	MsgDuplicate:        "이미 존재하는 %s입니다",
+	MsgValidationFailed: "입력값 검증에 실패했습니다",
+	MsgBadRequest:       "잘못된 요청입니다",
+	MsgConflict:         "요청이 현재 상태와 충돌합니다",
+	MsgTooManyRequests:  "요청이 너무 많습니다. 잠시 후 다시 시도해 주세요",
+	MsgExternalService:  "%s 연동 중 오류가 발생했습니다. 잠시 후 다시 시도해 주세요",
+
+	// 인증 메시지
+	MsgInvalidCredentials: "이메일 또는 비밀번호가 올바르지 않습니다",
+	MsgAccountDisabled:    "비활성화된 계정입니다",
+	MsgInvalidResetToken:  "유효하지 않거나 만료된 비밀번호 재설정 토큰입니다",
+	MsgPasswordResetSent:  "가입된 계정이 있으면 비밀번호 재설정 안내를 발송했습니다",
+	MsgInvalidInvitation:  "유효하지 않거나 만료된 초대입니다",
+
+	// 특정 리소스 메시지
+	MsgVehicleNotFound:  "차량을 찾을 수 없습니다",
+	MsgDriverNotFound:   "운전자를 찾을 수 없습니다",
+	MsgRouteNotFound:    "경로를 찾을 수 없습니다",
+	MsgScheduleNotFound: "운행 일정을 찾을 수 없습니다",
+}
@@ -0,0 +1,217 @@
+package util
+
+// phrasesEn - 자유 형식 문구의 영어 번역 (한국어 원문이 키, %s/%d 자리는 원문과 같은 순서)
+// 원문을 바꾸면 이 키도 같이 바꿔야 함 (키가 다르면 한국어 그대로 응답)
+var phrasesEn = map[string]string{
+	// 요청 형식 검증 (validation)
+	"필수 입력 항목입니다":                                     "This field is required",
+	"올바른 이메일 형식이 아닙니다":                                "Must be a valid email address",
+	"다음 값 중 하나여야 합니다: %s":                             "Must be one of: %s",
+	"최소 %s개(자) 이상이어야 합니다":                             "Must have at least %s items (characters)",
+	"%s 이상이어야 합니다":                                    "Must be at least %s",
+	"최대 %s개(자) 이하여야 합니다":                              "Must have at most %s items (characters)",
+	"%s 이하여야 합니다":                                     "Must be at most %s",
+	"길이가 %s이어야 합니다":                                   "Length must be %s",
+	"형식이 올바르지 않습니다 (%s)":                              "Invalid format (%s)",
+	"차량 번호 형식이 아닙니다 (예: 12가3456)":                     "Must be a Korean plate number (e.g. 12가3456)",
+	"시각 형식이 아닙니다 (HH:MM, 예: 08:30)":                   "Must be a time (HH:MM, e.g. 08:30)",
+	"요일은 1(월)부터 7(일)까지입니다":                            "Weekday must be between 1 (Mon) and 7 (Sun)",
+	"전화번호 형식이 아닙니다 (예: 010-1234-5678, +821012345678)": "Must be a phone number (e.g. 010-1234-5678, +821012345678)",
+	"위도는 -90 이상 90 이하여야 합니다":                          "Latitude must be between -90 and 90",
+	"경도는 -180 이상 180 이하여야 합니다":                        "Longitude must be between -180 and 180",
+	"일수 형식이 아닙니다 (예: 30 또는 30d, 최대 %d일)":              "Must be a number of days (e.g. 30 or 30d, up to %d days)",
+	"유효하지 않은 값입니다 (%s)":                               "Invalid value (%s)",
+	"%s 타입이어야 합니다":                                    "Must be a %s",
+	"올바른 JSON 형식이 아닙니다":                               "Malformed JSON",
+	"요청 값을 해석할 수 없습니다":                                "The request could not be parsed",
+	"필수 항목입니다":                                        "This field is required",
+	"정렬할 수 없는 필드입니다: %s (가능: %s)":                     "Cannot sort by %s (allowed: %s)",
+	"필터할 수 없는 필드입니다 (가능: %s)":                         "Cannot filter by this field (allowed: %s)",
+
+	// 요청 공통 (인증/멱등성)
+	"플랫폼 운영자의 변경 요청은 %s 헤더로 대상 기관을 지정해야 합니다":     "Platform operators must select the target organization with the %s header to make changes",
+	"%s 값이 올바른 기관 ID가 아닙니다":                      "%s is not a valid organization ID",
+	"대상 기관을 X-Organization-ID 헤더로 지정해야 합니다":      "Select the target organization with the X-Organization-ID header",
+	"Idempotency-Key는 255자 이하여야 합니다":             "Idempotency-Key must be at most 255 characters",
+	"요청 본문이 너무 큽니다 (Idempotency-Key 요청은 1MB 이하)": "Request body is too large (requests with an Idempotency-Key are limited to 1MB)",
+	"요청 본문을 읽을 수 없습니다":                           "The request body could not be read",
+	"같은 Idempotency-Key가 다른 요청에 사용되었습니다":         "The Idempotency-Key was already used for a different request",
+	"같은 Idempotency-Key의 요청을 처리 중입니다":            "A request with the same Idempotency-Key is still being processed",
+
+	// 계정/기관
+	"현재 비밀번호가 올바르지 않습니다":                            "The current password is incorrect",
+	"이미 비활성화된 계정입니다":                                "The account is already disabled",
+	"이미 활성화된 계정입니다":                                 "The account is already active",
+	"본인 계정의 역할은 변경할 수 없습니다":                         "You cannot change the role of your own account",
+	"본인 계정은 기관에서 제외할 수 없습니다":                        "You cannot remove your own account from the organization",
+	"관리자 외 계정은 프로필 ID가 필요합니다":                       "Accounts other than administrators require a profile ID",
+	"이메일 또는 연락처가 필요합니다":                             "An email or phone number is required",
+	"연락처로 받은 초대는 로그인에 사용할 이메일이 필요합니다":               "Invitations sent by phone require an email to sign in with",
+	"로고 주소는 http(s) URL이어야 합니다":                     "The logo must be an http(s) URL",
+	"시간대는 Asia/Seoul 같은 IANA 이름이어야 합니다":             "The time zone must be an IANA name such as Asia/Seoul",
+	"허용 Origin은 최대 20개까지 등록할 수 있습니다":                "Up to 20 allowed origins can be registered",
+	"Origin은 https://app.example.com 형식이어야 합니다: %s": "Origins must look like https://app.example.com: %s",
+	"만료 시각은 현재 이후여야 합니다":                            "The expiry time must be in the future",
+	"이미 폐기된 API 키입니다":                               "The API key has already been revoked",
+	"http 또는 https 주소여야 합니다":                        "Must be an http or https URL",
+
+	// 기사/동승자
+	"존재하지 않는 기사입니다":                          "The driver does not exist",
+	"활동 중인 기사가 아닙니다":                         "The driver is not active",
+	"면허가 확인되지 않았거나 만료된 기사입니다":                "The driver's license is unverified or expired",
+	"같은 일정에 기간이 겹치는 기사 대체 배정이 있습니다":          "A substitute driver is already assigned to this schedule for an overlapping period",
+	"만료된 면허는 확인할 수 없습니다 (면허 정보를 먼저 갱신해 주세요)": "An expired license cannot be verified (update the license details first)",
+	"반려 사유를 입력해 주세요":                         "Enter a reason for the rejection",
+	"퇴사한 기사의 상태는 변경할 수 없습니다":                 "The status of a terminated driver cannot be changed",
+	"active 또는 on_leave만 가능합니다":              "Must be active or on_leave",
+	"이미 퇴사 처리된 기사입니다":                        "The driver has already been terminated",
+	"개인정보가 익명화된 기사는 복구할 수 없습니다":              "A driver whose personal data has been anonymized cannot be restored",
+	"존재하지 않는 동승자입니다":                         "The attendant does not exist",
+	"활동 중인 동승자가 아닙니다":                        "The attendant is not active",
+	"같은 일정에 기간이 겹치는 동승자 대체 배정이 있습니다":         "A substitute attendant is already assigned to this schedule for an overlapping period",
+	"퇴사한 동승자의 상태는 변경할 수 없습니다":                "The status of a terminated attendant cannot be changed",
+	"활동 중인 동승자에게만 운행 시작 권한을 부여할 수 있습니다":      "Only active attendants can be allowed to start trips",
+	"종료일은 시작일 이후여야 합니다":                      "The end date must be after the start date",
+
+	// 차량
+	"존재하지 않는 차량입니다":                              "The vehicle does not exist",
+	"운행 가능한 상태의 차량이 아닙니다":                        "The vehicle is not available for service",
+	"정원 상한은 하한 이상이어야 합니다":                        "The maximum capacity must be at least the minimum",
+	"만료일은 발급일 이후여야 합니다":                          "The expiry date must be after the issue date",
+	"주유 시각은 현재 이후일 수 없습니다":                       "The fill-up time cannot be in the future",
+	"주행거리계는 직전 주유 기록(%d km)보다 작을 수 없습니다":         "The odometer cannot be lower than the previous fuel log (%d km)",
+	"주행거리계는 다음 주유 기록(%d km)보다 클 수 없습니다":          "The odometer cannot be higher than the next fuel log (%d km)",
+	"주행거리계는 현재 차량 주행거리계(%d km)보다 작을 수 없습니다":      "The odometer cannot be lower than the vehicle's current odometer (%d km)",
+	"탑승 예정 인원(%d명)이 차량 %s 승객 정원(%d명)을 %d명 초과합니다": "Expected riders (%d) exceed the passenger capacity of vehicle %s (%d) by %d",
+
+	// 경로/정류장
+	"존재하지 않는 경로입니다":                                  "The route does not exist",
+	"비활성 상태의 경로입니다":                                  "The route is inactive",
+	"해당 경로에 속한 정류장이 아닙니다":                            "The stop does not belong to the route",
+	"경로의 정류장 %d개를 모두 포함해야 합니다":                       "All %d stops of the route must be included",
+	"잘못되었거나 중복된 정류장 ID입니다: %s":                       "Invalid or duplicate stop ID: %s",
+	"지도 API가 설정되지 않았습니다":                             "The map API is not configured",
+	"정류장이 2개 이상이어야 경로를 계산할 수 있습니다":                   "At least 2 stops are required to calculate a route",
+	"정류장 사이 도로 경로를 찾을 수 없습니다":                        "No road route could be found between the stops",
+	"정류장이 3개 이상이어야 순서를 최적화할 수 있습니다":                  "At least 3 stops are required to optimize the order",
+	"정류장이 %d개 이하인 경로만 순서를 최적화할 수 있습니다":               "Only routes with at most %d stops can be optimized",
+	"주소 검색을 사용할 수 없어 위도/경도를 직접 입력해야 합니다":             "Address search is unavailable; enter the latitude and longitude directly",
+	"주소로 좌표를 찾을 수 없습니다. 주소를 확인하거나 위도/경도를 직접 입력해 주세요": "No coordinates were found for the address. Check the address or enter the latitude and longitude directly",
+	"정류장 순서는 1부터 %d 사이여야 합니다":                        "The stop order must be between 1 and %d",
+
+	// 일정/운행
+	"운행 요일이 중복되었습니다":                              "Service days contain duplicates",
+	"유효 종료일은 시작일 이후여야 합니다":                        "The valid-to date must be after the valid-from date",
+	"차량 또는 기사가 겹치는 시간대의 다른 일정에 이미 배정되어 있습니다":      "The vehicle or driver is already assigned to another schedule at an overlapping time",
+	"날짜는 YYYY-MM-DD 형식이어야 합니다":                    "The date must be in YYYY-MM-DD format",
+	"월은 YYYY-MM 형식이어야 합니다":                        "The month must be in YYYY-MM format",
+	"해당 날짜에 운행하지 않는 일정입니다":                        "The schedule does not run on that date",
+	"운행을 시작할 수 없는 상태입니다: %s":                      "The trip cannot be started in its current status: %s",
+	"운행을 완료할 수 없는 상태입니다: %s":                      "The trip cannot be completed in its current status: %s",
+	"운행을 완료하기 전에 차량 내부 잔류 인원 확인이 필요합니다":           "Check the vehicle for remaining passengers before completing the trip",
+	"운행 중에만 차량 내부를 확인할 수 있습니다: %s":                "The vehicle can only be checked during a trip: %s",
+	"운행을 일시정지할 수 없는 상태입니다: %s":                    "The trip cannot be paused in its current status: %s",
+	"일시정지 중인 운행만 재개할 수 있습니다: %s":                  "Only paused trips can be resumed: %s",
+	"이미 취소된 운행입니다":                                "The trip has already been cancelled",
+	"완료된 운행은 취소할 수 없습니다":                          "A completed trip cannot be cancelled",
+	"하차 또는 예외 처리되지 않은 탑승자가 있습니다":                  "Some passengers have not alighted or been marked as exceptions",
+	"대기 중이거나 운행 중인 운행만 지연을 보고할 수 있습니다: %s":        "Delays can only be reported for pending or in-progress trips: %s",
+	"운행 중인 운행만 도착 예정 시간을 제공합니다":                   "Arrival estimates are only available for trips in progress",
+	"운행 중인 운행에만 위치를 전송할 수 있습니다":                   "Locations can only be reported for trips in progress",
+	"from 이후 시각이어야 합니다":                           "Must be after from",
+	"프레임이 너무 많습니다. interval을 늘리거나 구간을 줄여주세요":      "Too many frames. Increase the interval or shorten the range",
+	"운행 중에만 정류장 도착/출발을 기록할 수 있습니다: %s":            "Stop arrivals and departures can only be recorded during a trip: %s",
+	"배정을 변경할 수 없는 상태입니다: %s":                      "The assignment cannot be changed in the trip's current status: %s",
+	"변경할 차량, 기사 또는 동승자가 없습니다":                     "No vehicle, driver or attendant to change",
+	"운행에 투입할 수 없는 차량입니다 (정비 중·비활성 또는 보험/정기검사 만료)": "The vehicle cannot be put into service (under maintenance, inactive, or insurance/inspection expired)",
+	"차량 정원이 탑승 예정 인원(%d명)보다 적습니다":                 "The vehicle capacity is less than the expected riders (%d)",
+	"운행에 투입할 수 없는 기사입니다 (휴직·퇴사, 면허 미확인 또는 만료)":    "The driver cannot be put into service (on leave, terminated, or license unverified or expired)",
+	"운행에 투입할 수 없는 동승자입니다":                         "The attendant cannot be put into service",
+	"교체 차량 또는 인력이 겹치는 시간대의 다른 운행에 이미 배정되어 있습니다":   "The replacement vehicle or staff is already assigned to another trip at an overlapping time",
+	"기간은 최대 %d일까지 조회할 수 있습니다":                     "The range can be at most %d days",
+
+	// 운행 생성 제외 사유
+	"이 날짜의 운행이 이미 있습니다":                 "A trip already exists for this date",
+	"경로 배정 탑승자가 차량 %s 승객 정원을 %d명 초과합니다": "Passengers assigned to the route exceed the capacity of vehicle %s by %d",
+	"공휴일(%s)입니다":                        "Public holiday (%s)",
+	"비활성 일정입니다":                         "The schedule is inactive",
+	"유효 기간(%s ~ %s) 밖입니다":               "Outside the valid period (%s ~ %s)",
+	"운행 요일이 아닙니다":                       "Not a service day",
+	"예외 날짜로 운행하지 않습니다":                  "No service on this exception date",
+	"예외 날짜로 운행하지 않습니다 (%s)":             "No service on this exception date (%s)",
+	"배정된 차량이 없습니다":                      "No vehicle is assigned",
+	"차량 %s 상태가 %s입니다":                   "Vehicle %s is %s",
+	"차량 %s 보험 또는 정기검사가 만료됩니다":           "Insurance or inspection of vehicle %s expires",
+
+	// 탑승자/승하차
+	"검색어는 2자 이상이어야 합니다":            "The search term must be at least 2 characters",
+	"개인정보가 익명화된 탑승자는 복구할 수 없습니다":   "A passenger whose personal data has been anonymized cannot be restored",
+	"이미 연결된 탑승자입니다":                "The passenger is already linked",
+	"지난 날짜는 결석 신고할 수 없습니다":         "Absences cannot be reported for past dates",
+	"지난 결석 신고는 취소할 수 없습니다":         "Past absence reports cannot be cancelled",
+	"이미 승차한 탑승자입니다":                "The passenger has already boarded",
+	"승차 기록이 없는 탑승자입니다":             "The passenger has no boarding record",
+	"이미 하차한 탑승자입니다":                "The passenger has already alighted",
+	"이미 승차한 탑승자는 불참 처리할 수 없습니다":    "A passenger who has boarded cannot be marked as a no-show",
+	"이미 불참 처리된 탑승자입니다":             "The passenger is already marked as a no-show",
+	"결석 신고된 탑승자입니다":                "The passenger has reported an absence",
+	"이미 예외 처리된 탑승자입니다":             "The passenger is already marked as an exception",
+	"승차 중인 탑승자만 예외 처리할 수 있습니다":     "Only passengers on board can be marked as exceptions",
+	"이 정류장에 배정되지 않은 탑승자입니다: %s":    "The passenger is not assigned to this stop: %s",
+	"다른 정류장에 기록된 탑승자입니다: %s":       "The passenger was recorded at a different stop: %s",
+	"이미 승차한 탑승자가 포함되어 있습니다":        "Some passengers have already boarded",
+	"승차 기록이 없는 탑승자가 포함되어 있습니다":     "Some passengers have no boarding record",
+	"이미 하차한 탑승자가 포함되어 있습니다":        "Some passengers have already alighted",
+	"운행 중에만 승차/하차를 기록할 수 있습니다: %s": "Boarding and alighting can only be recorded during a trip: %s",
+	"이 운행 경로의 정류장에 배정되지 않은 탑승자입니다": "The passenger is not assigned to a stop on this trip's route",
+
+	// 파일/가져오기
+	"지원하지 않는 용도입니다: %s":                                              "Unsupported purpose: %s",
+	"빈 파일은 올릴 수 없습니다":                                                "Empty files cannot be uploaded",
+	"파일은 최대 %dMB까지 올릴 수 있습니다":                                        "Files can be at most %dMB",
+	"올릴 수 있는 파일 형식: %s":                                              "Allowed file types: %s",
+	"파일을 첨부해 주세요 (multipart/form-data의 file 필드)":                     "Attach a file (the file field of multipart/form-data)",
+	"머리글과 데이터 행이 필요합니다":                                              "A header row and data rows are required",
+	"한 번에 최대 %d행까지 가져올 수 있습니다":                                       "Up to %d rows can be imported at once",
+	"CSV(.csv) 또는 Excel(.xlsx) 파일만 가져올 수 있습니다":                       "Only CSV (.csv) or Excel (.xlsx) files can be imported",
+	"CSV 파일이 UTF-8 인코딩이 아닙니다. 엑셀에서 'CSV UTF-8(쉼표로 분리)' 형식으로 저장해 주세요": "The CSV file is not UTF-8 encoded. Save it as 'CSV UTF-8 (Comma delimited)' in Excel",
+	"파일을 읽을 수 없습니다. 형식이 올바른지 확인해 주세요":                                "The file could not be read. Check that the format is correct",
+	"같은 항목의 열이 여러 개입니다: %s":                                          "Multiple columns map to the same field: %s",
+	"필수 열이 없습니다: %s":                                                 "Missing required columns: %s",
+	"정수여야 합니다":                                                       "Must be an integer",
+	"숫자여야 합니다":                                                       "Must be a number",
+	"파일 %d행과 이름, 보호자 연락처가 같습니다":                                      "Same name and guardian phone as row %d of the file",
+	"이름과 보호자 연락처가 같은 탑승자가 이미 등록되어 있습니다":                              "A passenger with the same name and guardian phone is already registered",
+	"경로와 정류장은 함께 입력해야 합니다":                                           "Route and stop must be entered together",
+	"파일 %d행과 경로, 정류장 이름이 같습니다":                                       "Same route and stop name as row %d of the file",
+	"경로에 같은 이름의 정류장이 이미 있습니다":                                        "The route already has a stop with the same name",
+
+	// 알림 (푸시/알림톡/문자)
+	"[어디니]": "[Eodini]",
+	"승차 알림": "Boarding",
+	"하차 알림": "Alighting",
+	"%s 님이 %s에서 %s에 승차했습니다": "%s boarded at %s at %s",
+	"%s 님이 %s에서 %s에 하차했습니다": "%s got off at %s at %s",
+	"불참 알림": "No-show",
+	"%s 님이 %s에서 탑승하지 않았습니다 (%s, %s)": "%s did not board at %s (%s, %s)",
+	"차량 도착 예정": "Vehicle arriving soon",
+	"%s 님의 차량이 %s에 약 %d분 후 도착합니다": "%s's vehicle will arrive at %s in about %d min",
+	"운행 지연 알림":                   "Trip delayed",
+	"%s 운행이 지연되고 있습니다 (%s)":      "The %s trip is delayed (%s)",
+	"운행 배정 변경 알림":                "Trip assignment changed",
+	"%s %s 운행이 변경되었습니다: %s":      "The %s %s trip has changed: %s",
+	"%s %s 운행이 변경되었습니다: %s (%s)": "The %s %s trip has changed: %s (%s)",
+	"차량 %s":        "Vehicle %s",
+	"기사 %s":        "Driver %s",
+	"동승자 %s":       "Attendant %s",
+	"동승자 없음":       "No attendant",
+	"서류 만료 예정 %d건": "%d documents expiring soon",
+	"%s 만료 예정":     "%s expiring soon",
+	"%s이(가) %s에 만료됩니다 (%s). 갱신한 면허증을 앱에서 다시 올려 주세요.":               "%s expires on %s (%s). Upload your renewed license in the app.",
+	"%s이(가) %s에 만료됩니다 (%s). 만료되면 운행에 배정할 수 없으니 관리자에게 갱신을 요청해 주세요.": "%s expires on %s (%s). It cannot be assigned to trips once expired, so ask an administrator to renew it.",
+	"만료됨":      "expired",
+	"비밀번호 재설정": "Password reset",
+	"[어디니] 비밀번호 재설정 코드입니다. 본인이 요청하지 않았다면 무시해 주세요.": "[Eodini] Your password reset code. If you did not request it, ignore this message.",
+	"기관 초대": "Organization invitation",
+	"[어디니] %s에서 %s 계정으로 초대했습니다. 앱에서 초대 코드를 입력해 주세요.": "[Eodini] %s has invited you as %s. Enter the invitation code in the app.",
+}
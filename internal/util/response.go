@@ -1,9 +1,13 @@
 package util
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
 
 // 📝 설명: Spring의 ResponseEntity처럼 표준화된 API 응답 구조
-// 🎯 실무 포인트: 모든 API 응답을 통일된 포맷으로 관리
+// 🎯 실무 포인트: 모든 API 응답을 통일된 포맷으로 관리, 에러 메시지는 요청 언어(LocaleFromContext)로 다시 만듦
+// 성공 메시지는 핸들러가 요청 언어로 만들어 전달 (GetRequestMessage)
 // ⚠️ 주의사항: Success와 Error는 상호 배타적 (둘 중 하나만 존재)
 
 // APIResponse - 표준 API 응답 구조
@@ -27,11 +31,18 @@ func SuccessResponse(c *gin.Context, statusCode int, message string, data interf
 	})
 }
 
-// ErrorResponse - 에러 응답 헬퍼 함수
+// ErrorResponse - 에러 응답 헬퍼 함수 (요청 ID를 details.request_id로 포함 → 문의 접수 시 로그와 대조)
 // 사용 예:
 //   ErrorResponse(c, util.NewNotFoundError("차량"))
 //   ErrorResponse(c, util.NewValidationError("입력값 오류", details))
 func ErrorResponse(c *gin.Context, err *AppError) {
+	if c.Request != nil {
+		if requestID := logger.RequestIDFromContext(c.Request.Context()); requestID != "" {
+			err = err.WithDetail("request_id", requestID)
+		}
+		err = err.Localize(LocaleFromContext(c.Request.Context()))
+	}
+
 	c.JSON(err.StatusCode, APIResponse{
 		Success: false,
 		Message: err.Message,
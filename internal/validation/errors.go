@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/hyeokjun/eodini/internal/util"
+)
+
+// 📝 설명: 바인딩/검증 실패를 필드별 메시지로 변환
+// 🎯 실무 포인트: 클라이언트가 필드 옆에 바로 표시할 수 있도록 {"필드 경로": "사유"} 형태로 통일
+// ⚠️ 주의사항: 내부 파서 메시지(Go 타입명 등)는 응답에 노출하지 않음
+//            사유는 util.LocalizedText로 남겨 응답 시 요청 로케일로 렌더링
+
+// BodyField - 특정 필드가 아닌 본문 전체 오류의 키
+const BodyField = "body"
+
+// FieldErrors - 바인딩 에러를 필드 경로별 메시지로 변환
+// 예: {"plate_number": "차량 번호 형식이 아닙니다 (예: 12가3456)", "stops[0].latitude": "..."}
+func FieldErrors(err error) map[string]interface{} {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		details := make(map[string]interface{}, len(validationErrs))
+		for _, fe := range validationErrs {
+			details[fieldPath(fe)] = Message(fe)
+		}
+		return details
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return map[string]interface{}{typeErr.Field: util.Text("%s 타입이어야 합니다", jsonTypeName(typeErr.Type))}
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return map[string]interface{}{BodyField: util.Text("올바른 JSON 형식이 아닙니다")}
+	}
+
+	return map[string]interface{}{BodyField: util.Text("요청 값을 해석할 수 없습니다")}
+}
+
+// Message - 검증 태그별 사용자 메시지
+func Message(fe validator.FieldError) util.LocalizedText {
+	switch fe.Tag() {
+	case "required", "required_with":
+		return util.Text("필수 입력 항목입니다")
+	case "email":
+		return util.Text("올바른 이메일 형식이 아닙니다")
+	case "oneof":
+		return util.Text("다음 값 중 하나여야 합니다: %s", fe.Param())
+	case "min":
+		if fe.Kind() == reflect.Slice || fe.Kind() == reflect.String {
+			return util.Text("최소 %s개(자) 이상이어야 합니다", fe.Param())
+		}
+		return util.Text("%s 이상이어야 합니다", fe.Param())
+	case "max":
+		if fe.Kind() == reflect.Slice || fe.Kind() == reflect.String {
+			return util.Text("최대 %s개(자) 이하여야 합니다", fe.Param())
+		}
+		return util.Text("%s 이하여야 합니다", fe.Param())
+	case "len":
+		return util.Text("길이가 %s이어야 합니다", fe.Param())
+	case "datetime":
+		return util.Text("형식이 올바르지 않습니다 (%s)", fe.Param())
+	case TagPlate:
+		return util.Text("차량 번호 형식이 아닙니다 (예: 12가3456)")
+	case TagHHMM:
+		return util.Text("시각 형식이 아닙니다 (HH:MM, 예: 08:30)")
+	case TagWeekday:
+		return util.Text("요일은 1(월)부터 7(일)까지입니다")
+	case TagPhone:
+		return util.Text("전화번호 형식이 아닙니다 (예: 010-1234-5678, +821012345678)")
+	case TagLatitude:
+		return util.Text("위도는 -90 이상 90 이하여야 합니다")
+	case TagLongitude:
+		return util.Text("경도는 -180 이상 180 이하여야 합니다")
+	case TagDays:
+		return util.Text("일수 형식이 아닙니다 (예: 30 또는 30d, 최대 %d일)", MaxDays)
+	default:
+		return util.Text("유효하지 않은 값입니다 (%s)", fe.Tag())
+	}
+}
+
+// fieldPath - 최상위 구조체 이름을 제외한 필드 경로 (예: stops[0].name)
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if idx := strings.Index(namespace, "."); idx >= 0 {
+		return namespace[idx+1:]
+	}
+	return fe.Field()
+}
+
+// jsonTypeName - Go 타입을 JSON 타입 이름으로 표시
+func jsonTypeName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "숫자"
+	case reflect.Bool:
+		return "불리언"
+	case reflect.String:
+		return "문자열"
+	case reflect.Slice, reflect.Array:
+		return "배열"
+	default:
+		return "객체"
+	}
+}
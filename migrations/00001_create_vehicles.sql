@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE vehicles (
+    id                  UUID PRIMARY KEY,
+    plate_number        VARCHAR(20)  NOT NULL,
+    model               VARCHAR(100) NOT NULL,
+    manufacturer        VARCHAR(100),
+    vehicle_type        VARCHAR(20)  NOT NULL,
+    capacity            INTEGER      NOT NULL CHECK (capacity > 0),
+    year                INTEGER,
+    color               VARCHAR(30),
+    status              VARCHAR(20)  NOT NULL DEFAULT 'active',
+    insurance_expiry    TIMESTAMPTZ,
+    inspection_expiry   TIMESTAMPTZ,
+    last_maintenance_at TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at          TIMESTAMPTZ
+);
+
+-- 삭제된 차량의 번호는 재사용 가능
+CREATE UNIQUE INDEX idx_vehicles_plate_number ON vehicles (plate_number) WHERE deleted_at IS NULL;
+CREATE INDEX idx_vehicles_status ON vehicles (status);
+CREATE INDEX idx_vehicles_deleted_at ON vehicles (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS vehicles;
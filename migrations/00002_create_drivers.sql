@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE drivers (
+    id                UUID PRIMARY KEY,
+    name              VARCHAR(50)  NOT NULL,
+    phone             VARCHAR(20)  NOT NULL,
+    email             VARCHAR(255),
+    status            VARCHAR(20)  NOT NULL DEFAULT 'active',
+    license_number    VARCHAR(30)  NOT NULL,
+    license_type      VARCHAR(20)  NOT NULL,
+    license_expiry    TIMESTAMPTZ  NOT NULL,
+    hire_date         TIMESTAMPTZ,
+    termination_date  TIMESTAMPTZ,
+    address           TEXT,
+    emergency_contact VARCHAR(50),
+    notes             TEXT,
+    created_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at        TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_drivers_license_number ON drivers (license_number) WHERE deleted_at IS NULL;
+CREATE INDEX idx_drivers_license_expiry ON drivers (license_expiry);
+CREATE INDEX idx_drivers_deleted_at ON drivers (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS drivers;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE attendants (
+    id                UUID PRIMARY KEY,
+    name              VARCHAR(50)  NOT NULL,
+    phone             VARCHAR(20)  NOT NULL,
+    email             VARCHAR(255),
+    role              VARCHAR(20)  NOT NULL,
+    status            VARCHAR(20)  NOT NULL DEFAULT 'active',
+    can_start_trip    BOOLEAN      NOT NULL DEFAULT FALSE,
+    hire_date         TIMESTAMPTZ,
+    termination_date  TIMESTAMPTZ,
+    organization      VARCHAR(100),
+    address           TEXT,
+    emergency_contact VARCHAR(50),
+    notes             TEXT,
+    created_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at        TIMESTAMPTZ
+);
+
+CREATE INDEX idx_attendants_role ON attendants (role);
+CREATE INDEX idx_attendants_deleted_at ON attendants (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS attendants;
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE routes (
+    id             UUID PRIMARY KEY,
+    name           VARCHAR(100) NOT NULL,
+    description    TEXT,
+    status         VARCHAR(20)  NOT NULL DEFAULT 'active',
+    estimated_time INTEGER      NOT NULL DEFAULT 0,
+    total_distance INTEGER      NOT NULL DEFAULT 0,
+    created_at     TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at     TIMESTAMPTZ
+);
+
+CREATE INDEX idx_routes_deleted_at ON routes (deleted_at);
+
+-- 정류장 순서(stop_order)는 경로 내에서 1..N 연속으로 관리 (ORDER는 예약어)
+CREATE TABLE stops (
+    id                     UUID PRIMARY KEY,
+    route_id               UUID             NOT NULL REFERENCES routes (id),
+    name                   VARCHAR(100)     NOT NULL,
+    address                TEXT,
+    stop_order             INTEGER          NOT NULL,
+    latitude               DOUBLE PRECISION NOT NULL,
+    longitude              DOUBLE PRECISION NOT NULL,
+    estimated_arrival_time INTEGER          NOT NULL DEFAULT 0,
+    notes                  TEXT,
+    created_at             TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    updated_at             TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    deleted_at             TIMESTAMPTZ
+);
+
+CREATE INDEX idx_stops_route_id ON stops (route_id, stop_order);
+CREATE INDEX idx_stops_deleted_at ON stops (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS stops;
+DROP TABLE IF EXISTS routes;
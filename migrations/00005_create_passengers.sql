@@ -0,0 +1,31 @@
+-- +goose Up
+-- 미배정 탑승자는 assigned_route_id/assigned_stop_id가 빈 문자열이므로 FK 없이 관리
+CREATE TABLE passengers (
+    id                 UUID PRIMARY KEY,
+    name               VARCHAR(50)  NOT NULL,
+    age                INTEGER,
+    gender             VARCHAR(10),
+    status             VARCHAR(20)  NOT NULL DEFAULT 'active',
+    assigned_route_id  VARCHAR(36),
+    assigned_stop_id   VARCHAR(36),
+    stop_order         INTEGER      NOT NULL DEFAULT 0,
+    guardian_name      VARCHAR(50)  NOT NULL,
+    guardian_phone     VARCHAR(20)  NOT NULL,
+    guardian_email     VARCHAR(255),
+    guardian_relation  VARCHAR(20),
+    emergency_contact  VARCHAR(50),
+    emergency_relation VARCHAR(20),
+    address            TEXT,
+    medical_notes      TEXT,
+    notes              TEXT,
+    created_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at         TIMESTAMPTZ
+);
+
+CREATE INDEX idx_passengers_assigned_route_id ON passengers (assigned_route_id);
+CREATE INDEX idx_passengers_assigned_stop_id ON passengers (assigned_stop_id);
+CREATE INDEX idx_passengers_deleted_at ON passengers (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS passengers;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE schedules (
+    id                   UUID PRIMARY KEY,
+    name                 VARCHAR(100) NOT NULL,
+    description          TEXT,
+    status               VARCHAR(20)  NOT NULL DEFAULT 'active',
+    start_time           VARCHAR(5)   NOT NULL, -- HH:MM
+    time_slot            VARCHAR(20),
+    days_of_week         JSONB        NOT NULL DEFAULT '[]', -- 1=월 ~ 7=일
+    route_id             UUID         NOT NULL REFERENCES routes (id),
+    vehicle_id           UUID         NOT NULL REFERENCES vehicles (id),
+    default_driver_id    UUID         NOT NULL REFERENCES drivers (id),
+    default_attendant_id UUID         REFERENCES attendants (id),
+    valid_from           TIMESTAMPTZ,
+    valid_to             TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at           TIMESTAMPTZ
+);
+
+CREATE INDEX idx_schedules_route_id ON schedules (route_id);
+CREATE INDEX idx_schedules_vehicle_id ON schedules (vehicle_id);
+CREATE INDEX idx_schedules_default_driver_id ON schedules (default_driver_id);
+CREATE INDEX idx_schedules_deleted_at ON schedules (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS schedules;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE driver_assignments (
+    id          UUID PRIMARY KEY,
+    schedule_id UUID        NOT NULL REFERENCES schedules (id),
+    driver_id   UUID        NOT NULL REFERENCES drivers (id),
+    start_date  TIMESTAMPTZ NOT NULL,
+    end_date    TIMESTAMPTZ NOT NULL,
+    reason      TEXT,
+    approved_by VARCHAR(36),
+    approved_at TIMESTAMPTZ,
+    created_by  VARCHAR(36),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at  TIMESTAMPTZ,
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_driver_assignments_schedule_period ON driver_assignments (schedule_id, start_date, end_date);
+CREATE INDEX idx_driver_assignments_driver_id ON driver_assignments (driver_id);
+CREATE INDEX idx_driver_assignments_deleted_at ON driver_assignments (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS driver_assignments;
@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE trips (
+    id                    UUID PRIMARY KEY,
+    schedule_id           UUID        NOT NULL REFERENCES schedules (id),
+    date                  DATE        NOT NULL,
+    status                VARCHAR(20) NOT NULL DEFAULT 'pending',
+    vehicle_id            UUID        NOT NULL REFERENCES vehicles (id),
+    assigned_driver_id    UUID        NOT NULL REFERENCES drivers (id),
+    assigned_attendant_id UUID        REFERENCES attendants (id),
+    started_at            TIMESTAMPTZ,
+    completed_at          TIMESTAMPTZ,
+    started_by            VARCHAR(100), -- driver:{id} 또는 attendant:{id}
+    actual_start_location JSONB,
+    actual_end_location   JSONB,
+    total_distance        INTEGER     NOT NULL DEFAULT 0,
+    cancelled_at          TIMESTAMPTZ,
+    cancellation_reason   TEXT,
+    notes                 TEXT,
+    created_at            TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at            TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at            TIMESTAMPTZ
+);
+
+-- 일정당 하루 1회 운행 (중복 생성 방지)
+CREATE UNIQUE INDEX idx_trips_schedule_date ON trips (schedule_id, date) WHERE deleted_at IS NULL;
+CREATE INDEX idx_trips_date_status ON trips (date, status);
+CREATE INDEX idx_trips_assigned_driver_id ON trips (assigned_driver_id);
+CREATE INDEX idx_trips_deleted_at ON trips (deleted_at);
+
+CREATE TABLE trip_passengers (
+    id             UUID PRIMARY KEY,
+    trip_id        UUID        NOT NULL REFERENCES trips (id) ON DELETE CASCADE,
+    passenger_id   UUID        NOT NULL REFERENCES passengers (id),
+    stop_id        UUID        NOT NULL REFERENCES stops (id),
+    boarded_at     TIMESTAMPTZ,
+    alighted_at    TIMESTAMPTZ,
+    is_boarded     BOOLEAN     NOT NULL DEFAULT FALSE,
+    is_alighted    BOOLEAN     NOT NULL DEFAULT FALSE,
+    no_show_reason TEXT,
+    notes          TEXT,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_trip_passengers_trip_passenger ON trip_passengers (trip_id, passenger_id);
+CREATE INDEX idx_trip_passengers_passenger_id ON trip_passengers (passenger_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS trip_passengers;
+DROP TABLE IF EXISTS trips;
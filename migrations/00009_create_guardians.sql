@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE guardians (
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(50)  NOT NULL,
+    phone      VARCHAR(20)  NOT NULL,
+    email      VARCHAR(255),
+    status     VARCHAR(20)  NOT NULL DEFAULT 'active',
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX uq_guardians_phone ON guardians (phone) WHERE deleted_at IS NULL;
+CREATE INDEX idx_guardians_deleted_at ON guardians (deleted_at);
+
+CREATE TABLE guardian_passengers (
+    guardian_id  UUID        NOT NULL REFERENCES guardians (id),
+    passenger_id UUID        NOT NULL REFERENCES passengers (id),
+    relation     VARCHAR(20),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (guardian_id, passenger_id)
+);
+
+CREATE INDEX idx_guardian_passengers_passenger_id ON guardian_passengers (passenger_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS guardian_passengers;
+DROP TABLE IF EXISTS guardians;
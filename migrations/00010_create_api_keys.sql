@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE api_keys (
+    id           UUID PRIMARY KEY,
+    name         VARCHAR(100) NOT NULL,
+    prefix       VARCHAR(12)  NOT NULL,
+    key_hash     VARCHAR(64)  NOT NULL,
+    scopes       JSONB        NOT NULL DEFAULT '[]',
+    created_by   VARCHAR(36),
+    last_used_at TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX uq_api_keys_key_hash ON api_keys (key_hash);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE users (
+    id                  UUID PRIMARY KEY,
+    email               VARCHAR(255) NOT NULL,
+    phone               VARCHAR(20),
+    password_hash       VARCHAR(100) NOT NULL,
+    role                VARCHAR(20)  NOT NULL,
+    profile_id          VARCHAR(36),
+    status              VARCHAR(20)  NOT NULL DEFAULT 'active',
+    last_login_at       TIMESTAMPTZ,
+    password_changed_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    disabled_at         TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at          TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX uq_users_email ON users (email) WHERE deleted_at IS NULL;
+CREATE INDEX idx_users_role ON users (role);
+CREATE INDEX idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE password_reset_tokens (
+    id         UUID PRIMARY KEY,
+    user_id    UUID        NOT NULL REFERENCES users (id),
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX uq_password_reset_tokens_token_hash ON password_reset_tokens (token_hash);
+CREATE INDEX idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS password_reset_tokens;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE audit_logs (
+    id          UUID PRIMARY KEY,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id   VARCHAR(80) NOT NULL,
+    action      VARCHAR(10) NOT NULL,
+    changes     JSONB       NOT NULL DEFAULT '{}',
+    actor_id    VARCHAR(36),
+    actor_role  VARCHAR(20),
+    request_id  VARCHAR(64),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_logs_entity ON audit_logs (entity_type, entity_id, created_at DESC);
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs (actor_id, created_at DESC);
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_logs;
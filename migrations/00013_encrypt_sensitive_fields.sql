@@ -0,0 +1,25 @@
+-- +goose Up
+-- 암호문(enc:v1:...)은 평문보다 길어 TEXT로 변경, 기존 평문은 `-encrypt-fields`로 암호화
+ALTER TABLE passengers
+    ALTER COLUMN guardian_phone TYPE TEXT,
+    ALTER COLUMN emergency_contact TYPE TEXT;
+
+ALTER TABLE guardians
+    ALTER COLUMN phone TYPE TEXT,
+    ADD COLUMN phone_hash VARCHAR(64);
+
+DROP INDEX IF EXISTS uq_guardians_phone;
+CREATE UNIQUE INDEX uq_guardians_phone_hash ON guardians (phone_hash) WHERE deleted_at IS NULL;
+
+-- +goose Down
+-- 암호화된 값이 남아 있으면 길이 초과로 실패 (복호화 후 되돌릴 것)
+DROP INDEX IF EXISTS uq_guardians_phone_hash;
+CREATE UNIQUE INDEX uq_guardians_phone ON guardians (phone) WHERE deleted_at IS NULL;
+
+ALTER TABLE guardians
+    DROP COLUMN phone_hash,
+    ALTER COLUMN phone TYPE VARCHAR(20);
+
+ALTER TABLE passengers
+    ALTER COLUMN emergency_contact TYPE VARCHAR(50),
+    ALTER COLUMN guardian_phone TYPE VARCHAR(20);
@@ -0,0 +1,24 @@
+-- +goose Up
+ALTER TABLE passengers
+    ADD COLUMN deactivated_at TIMESTAMPTZ,
+    ADD COLUMN anonymized_at  TIMESTAMPTZ;
+
+ALTER TABLE drivers
+    ADD COLUMN anonymized_at TIMESTAMPTZ;
+
+-- 기존 비활성 탑승자는 마지막 수정 시각을 비활성 전환 시각으로 간주
+UPDATE passengers SET deactivated_at = updated_at WHERE status = 'inactive';
+
+-- 익명화 대상 조회용 (아직 익명화되지 않은 행만)
+CREATE INDEX idx_passengers_retention ON passengers (deactivated_at) WHERE anonymized_at IS NULL;
+CREATE INDEX idx_drivers_retention ON drivers (termination_date) WHERE anonymized_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_drivers_retention;
+DROP INDEX IF EXISTS idx_passengers_retention;
+
+ALTER TABLE drivers DROP COLUMN anonymized_at;
+
+ALTER TABLE passengers
+    DROP COLUMN anonymized_at,
+    DROP COLUMN deactivated_at;
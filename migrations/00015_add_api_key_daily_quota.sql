@@ -0,0 +1,6 @@
+-- +goose Up
+-- API 키별 일일 요청 한도 (0이면 QUOTA_API_KEY_DAILY 기본 한도)
+ALTER TABLE api_keys ADD COLUMN daily_quota INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE api_keys DROP COLUMN daily_quota;
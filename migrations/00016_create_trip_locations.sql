@@ -0,0 +1,18 @@
+-- +goose Up
+-- 운행 중 차량 GPS 기록 (경로 조회/거리 계산용, 수 초 간격으로 쌓이므로 BIGSERIAL)
+CREATE TABLE trip_locations (
+    id          BIGSERIAL PRIMARY KEY,
+    trip_id     UUID             NOT NULL REFERENCES trips (id),
+    vehicle_id  UUID             NOT NULL REFERENCES vehicles (id),
+    latitude    DOUBLE PRECISION NOT NULL,
+    longitude   DOUBLE PRECISION NOT NULL,
+    speed       DOUBLE PRECISION,
+    heading     DOUBLE PRECISION,
+    recorded_at TIMESTAMPTZ      NOT NULL,
+    created_at  TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_trip_locations_trip_recorded ON trip_locations (trip_id, recorded_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS trip_locations;
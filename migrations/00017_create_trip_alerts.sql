@@ -0,0 +1,19 @@
+-- +goose Up
+-- 운행 중 위험 운전 경보 (과속, 어린이 보호구역 과속, 급가속/급감속)
+CREATE TABLE trip_alerts (
+    id          UUID PRIMARY KEY,
+    trip_id     UUID             NOT NULL REFERENCES trips (id),
+    vehicle_id  UUID             NOT NULL REFERENCES vehicles (id),
+    type        VARCHAR(30)      NOT NULL,
+    value       DOUBLE PRECISION NOT NULL,
+    threshold   DOUBLE PRECISION NOT NULL,
+    latitude    DOUBLE PRECISION NOT NULL,
+    longitude   DOUBLE PRECISION NOT NULL,
+    occurred_at TIMESTAMPTZ      NOT NULL,
+    created_at  TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_trip_alerts_trip_type ON trip_alerts (trip_id, type, occurred_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS trip_alerts;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- 운행 중 위치 수신 끊김 감지 시각 (위치가 다시 오거나 운행이 끝나면 NULL)
+ALTER TABLE trips ADD COLUMN signal_lost_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE trips DROP COLUMN IF EXISTS signal_lost_at;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- 푸시 알림 수신 기기 (FCM 토큰, 기기마다 하나)
+CREATE TABLE device_tokens (
+    id         UUID PRIMARY KEY,
+    user_id    UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token      VARCHAR(512) NOT NULL UNIQUE,
+    platform   VARCHAR(10)  NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_device_tokens_user ON device_tokens (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS device_tokens;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- 보호자 알림 수신 설정 (행이 없으면 모든 채널/모든 알림 수신)
+CREATE TABLE notification_preferences (
+    guardian_id UUID PRIMARY KEY REFERENCES guardians (id),
+    channels    JSONB       NOT NULL,
+    events      JSONB       NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_preferences;
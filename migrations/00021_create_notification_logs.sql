@@ -0,0 +1,31 @@
+-- +goose Up
+-- 알림 발송 기록 (상태, 중계사 응답, 시도 횟수 / retrying은 재시도 작업이 next_attempt_at에 다시 발송)
+CREATE TABLE notification_logs (
+    id              UUID PRIMARY KEY,
+    guardian_id     VARCHAR(36),
+    user_id         VARCHAR(36),
+    phone           TEXT,
+    channels        JSONB,
+    event           VARCHAR(20),
+    title           VARCHAR(200) NOT NULL,
+    body            TEXT         NOT NULL,
+    data            JSONB,
+    template        VARCHAR(50),
+    variables       JSONB,
+    status          VARCHAR(10)  NOT NULL,
+    channel         VARCHAR(10),
+    attempts        INTEGER      NOT NULL DEFAULT 0,
+    response        TEXT,
+    next_attempt_at TIMESTAMPTZ,
+    sent_at         TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_logs_guardian ON notification_logs (guardian_id, created_at DESC);
+CREATE INDEX idx_notification_logs_status ON notification_logs (status, created_at DESC);
+-- 재시도 대상 조회용
+CREATE INDEX idx_notification_logs_due ON notification_logs (next_attempt_at) WHERE status = 'retrying';
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_logs;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- 불참 처리 시각 (수동 처리 또는 정류장 출발 시 미탑승 자동 처리 / 일일 출결 집계 기준)
+ALTER TABLE trip_passengers ADD COLUMN no_show_at TIMESTAMPTZ;
+
+CREATE INDEX idx_trip_passengers_no_show_at ON trip_passengers(no_show_at) WHERE no_show_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_trip_passengers_no_show_at;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS no_show_at;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- 운행 지연 (출발 예정 시각 경과 자동 감지 또는 기사/관리자 보고, 정시성 집계에 사용)
+ALTER TABLE trips ADD COLUMN delayed_at TIMESTAMPTZ;
+ALTER TABLE trips ADD COLUMN delay_reason VARCHAR(200);
+
+-- +goose Down
+ALTER TABLE trips DROP COLUMN IF EXISTS delay_reason;
+ALTER TABLE trips DROP COLUMN IF EXISTS delayed_at;
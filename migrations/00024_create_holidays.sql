@@ -0,0 +1,16 @@
+-- +goose Up
+-- 공휴일 (특일 정보 API 동기화, 없는 연도는 기본 공휴일 표 사용)
+CREATE TABLE holidays (
+    date       DATE PRIMARY KEY,
+    name       VARCHAR(50) NOT NULL,
+    source     VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 일정별 공휴일 운행 제외 여부 (기존 일정도 제외가 기본)
+ALTER TABLE schedules ADD COLUMN skip_holidays BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- +goose Down
+ALTER TABLE schedules DROP COLUMN IF EXISTS skip_holidays;
+DROP TABLE IF EXISTS holidays;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- 일정 예외 날짜 (skip: 그날 운행 안 함, extra: 그날 추가 운행, 운행 생성 시 요일/유효 기간/공휴일보다 우선)
+CREATE TABLE schedule_exceptions (
+    id          UUID PRIMARY KEY,
+    schedule_id UUID         NOT NULL REFERENCES schedules (id),
+    date        DATE         NOT NULL,
+    type        VARCHAR(10)  NOT NULL,
+    reason      VARCHAR(200),
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_schedule_exceptions_schedule_date ON schedule_exceptions (schedule_id, date);
+CREATE INDEX idx_schedule_exceptions_date ON schedule_exceptions (date);
+
+-- +goose Down
+DROP TABLE IF EXISTS schedule_exceptions;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- 동승자 대체 배정 (기간 동안 일정의 기본 동승자 대신 운행에 배정, 같은 일정에 기간 겹침 불가)
+CREATE TABLE attendant_assignments (
+    id           UUID PRIMARY KEY,
+    schedule_id  UUID        NOT NULL REFERENCES schedules (id),
+    attendant_id UUID        NOT NULL REFERENCES attendants (id),
+    start_date   DATE        NOT NULL,
+    end_date     DATE        NOT NULL,
+    reason       TEXT,
+    approved_by  VARCHAR(36),
+    approved_at  TIMESTAMPTZ,
+    created_by   VARCHAR(36),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at   TIMESTAMPTZ,
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_attendant_assignments_schedule_period ON attendant_assignments (schedule_id, start_date, end_date);
+CREATE INDEX idx_attendant_assignments_attendant_id ON attendant_assignments (attendant_id);
+CREATE INDEX idx_attendant_assignments_deleted_at ON attendant_assignments (deleted_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS attendant_assignments;
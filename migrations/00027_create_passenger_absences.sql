@@ -0,0 +1,24 @@
+-- +goose Up
+-- 탑승자 결석 사전 신고 (time_slot이 빈 값이면 하루 종일, 운행 탑승 기록은 불참이 아닌 결석 신고로 표시)
+CREATE TABLE passenger_absences (
+    id           UUID PRIMARY KEY,
+    passenger_id UUID        NOT NULL REFERENCES passengers (id),
+    date         DATE        NOT NULL,
+    time_slot    VARCHAR(20) NOT NULL DEFAULT '',
+    reason       TEXT,
+    reported_by  VARCHAR(36),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at   TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX idx_passenger_absences_passenger_date_slot ON passenger_absences (passenger_id, date, time_slot) WHERE deleted_at IS NULL;
+CREATE INDEX idx_passenger_absences_date ON passenger_absences (date);
+
+ALTER TABLE trip_passengers ADD COLUMN excused_at TIMESTAMPTZ;
+ALTER TABLE trip_passengers ADD COLUMN excused_reason TEXT;
+
+-- +goose Down
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS excused_reason;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS excused_at;
+DROP TABLE IF EXISTS passenger_absences;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- 승차/하차/불참을 누가(driver:{id} / attendant:{id} / system) 어디서 기록했는지
+ALTER TABLE trip_passengers ADD COLUMN boarded_by VARCHAR(100);
+ALTER TABLE trip_passengers ADD COLUMN boarded_location JSONB;
+ALTER TABLE trip_passengers ADD COLUMN alighted_by VARCHAR(100);
+ALTER TABLE trip_passengers ADD COLUMN alighted_location JSONB;
+ALTER TABLE trip_passengers ADD COLUMN no_show_by VARCHAR(100);
+
+-- +goose Down
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS no_show_by;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS alighted_location;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS alighted_by;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS boarded_location;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS boarded_by;
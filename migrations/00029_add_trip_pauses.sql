@@ -0,0 +1,8 @@
+-- +goose Up
+-- 운행 일시정지 (status = 'paused') 이력과 누적 일시정지 시간 (초)
+ALTER TABLE trips ADD COLUMN pauses JSONB;
+ALTER TABLE trips ADD COLUMN paused_seconds INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE trips DROP COLUMN IF EXISTS paused_seconds;
+ALTER TABLE trips DROP COLUMN IF EXISTS pauses;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- 운행 정류장 도착/출발 기록 (source: geofence 자동 판정 / manual 기사·동승자 기록, 운행 + 정류장 + 종류당 한 건)
+CREATE TABLE trip_stop_events (
+    id          UUID PRIMARY KEY,
+    trip_id     UUID        NOT NULL REFERENCES trips (id),
+    stop_id     UUID        NOT NULL REFERENCES stops (id),
+    type        VARCHAR(20) NOT NULL,
+    source      VARCHAR(20) NOT NULL,
+    recorded_by VARCHAR(100),
+    location    JSONB,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_trip_stop_events_trip_stop_type ON trip_stop_events (trip_id, stop_id, type);
+
+-- +goose Down
+DROP TABLE IF EXISTS trip_stop_events;
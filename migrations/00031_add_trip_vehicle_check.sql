@@ -0,0 +1,14 @@
+-- +goose Up
+-- 운행 완료 전 차내 잔류 확인 (잠든 아이 방지): 승무원의 차량 내부 확인 기록 + 하차 기록 없이 내린 탑승자 예외 처리
+ALTER TABLE trips ADD COLUMN vehicle_checked_at TIMESTAMPTZ;
+ALTER TABLE trips ADD COLUMN vehicle_checked_by VARCHAR(100);
+ALTER TABLE trip_passengers ADD COLUMN exception_at TIMESTAMPTZ;
+ALTER TABLE trip_passengers ADD COLUMN exception_reason TEXT;
+ALTER TABLE trip_passengers ADD COLUMN exception_by VARCHAR(100);
+
+-- +goose Down
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS exception_by;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS exception_reason;
+ALTER TABLE trip_passengers DROP COLUMN IF EXISTS exception_at;
+ALTER TABLE trips DROP COLUMN IF EXISTS vehicle_checked_by;
+ALTER TABLE trips DROP COLUMN IF EXISTS vehicle_checked_at;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- 전화 문의 중 아이를 빠르게 찾기 위한 탑승자 검색: 이름/보호자 이름은 pg_trgm 부분 일치, 연락처는 검색용 해시 일치
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 보호자 연락처는 암호화 저장이라 guardians.phone_hash와 같은 방식의 검색용 해시를 둠 (기존 행은 `-encrypt-fields`로 채움)
+ALTER TABLE passengers ADD COLUMN guardian_phone_hash VARCHAR(64);
+
+CREATE INDEX idx_passengers_name_trgm ON passengers USING GIN (name gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_passengers_guardian_name_trgm ON passengers USING GIN (guardian_name gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX idx_passengers_guardian_phone_hash ON passengers (guardian_phone_hash) WHERE deleted_at IS NULL;
+CREATE INDEX idx_guardians_name_trgm ON guardians USING GIN (name gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_guardians_name_trgm;
+DROP INDEX IF EXISTS idx_passengers_guardian_phone_hash;
+DROP INDEX IF EXISTS idx_passengers_guardian_name_trgm;
+DROP INDEX IF EXISTS idx_passengers_name_trgm;
+ALTER TABLE passengers DROP COLUMN IF EXISTS guardian_phone_hash;
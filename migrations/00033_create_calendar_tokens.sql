@@ -0,0 +1,13 @@
+-- +goose Up
+-- 운행 일정 캘린더 구독 토큰 (계정당 하나, 원문은 저장하지 않고 SHA-256 해시만 보관)
+CREATE TABLE calendar_tokens (
+    id           UUID PRIMARY KEY,
+    user_id      UUID        NOT NULL UNIQUE REFERENCES users (id) ON DELETE CASCADE,
+    token_hash   VARCHAR(64) NOT NULL UNIQUE,
+    last_used_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS calendar_tokens;
@@ -0,0 +1,42 @@
+-- +goose Up
+-- 웹훅 구독 (서명 비밀키는 암호문 저장, events는 받을 이벤트 종류 배열)
+CREATE TABLE webhook_subscriptions (
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    url        VARCHAR(500) NOT NULL,
+    secret     TEXT         NOT NULL,
+    events     JSONB        NOT NULL,
+    active     BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_by VARCHAR(36),
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+-- 이벤트별 구독 조회용 (events @> '["trip.started"]')
+CREATE INDEX idx_webhook_subscriptions_events ON webhook_subscriptions USING GIN (events);
+
+-- 웹훅 전달 기록 (pending/retrying은 재시도 작업이 next_attempt_at에 다시 전달)
+CREATE TABLE webhook_deliveries (
+    id              UUID PRIMARY KEY,
+    subscription_id UUID        NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id        UUID        NOT NULL,
+    event           VARCHAR(30) NOT NULL,
+    payload         TEXT        NOT NULL,
+    status          VARCHAR(10) NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    response_status INTEGER,
+    response        TEXT,
+    next_attempt_at TIMESTAMPTZ,
+    delivered_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries (subscription_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_status ON webhook_deliveries (status, created_at DESC);
+-- 재시도 대상 조회용
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status IN ('pending', 'retrying');
+
+-- +goose Down
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
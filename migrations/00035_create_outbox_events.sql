@@ -0,0 +1,23 @@
+-- +goose Up
+-- 아웃박스 이벤트 (엔티티 변경과 같은 트랜잭션으로 기록, 릴레이 작업이 구독 핸들러에 전달)
+CREATE TABLE outbox_events (
+    id           UUID PRIMARY KEY,
+    type         VARCHAR(50) NOT NULL,
+    aggregate_id UUID        NOT NULL,
+    payload      JSONB       NOT NULL,
+    status       VARCHAR(10) NOT NULL,
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    handled      JSONB,
+    last_error   TEXT,
+    available_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 릴레이 대상 조회용
+CREATE INDEX idx_outbox_events_due ON outbox_events (available_at) WHERE status = 'pending';
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events (aggregate_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_events;
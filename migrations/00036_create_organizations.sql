@@ -0,0 +1,80 @@
+-- +goose Up
+-- 기관(유치원/병원/학원) 단위 데이터 분리, 기존 데이터는 기본 기관으로 이전
+CREATE TABLE organizations (
+    id         UUID PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    type       VARCHAR(20)  NOT NULL,
+    phone      VARCHAR(20),
+    address    VARCHAR(255),
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_organizations_deleted_at ON organizations (deleted_at);
+
+INSERT INTO organizations (id, name, type) VALUES ('00000000-0000-0000-0000-000000000001', '기본 기관', 'kindergarten');
+
+-- 기존 행은 DEFAULT로 기본 기관에 배정한 뒤 DEFAULT 제거 (신규 행은 애플리케이션이 지정)
+ALTER TABLE users ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE api_keys ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE vehicles ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE drivers ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE attendants ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE routes ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE schedules ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE passengers ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE guardians ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+ALTER TABLE trips ADD COLUMN organization_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES organizations (id);
+
+ALTER TABLE users ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE api_keys ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE vehicles ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE drivers ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE attendants ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE routes ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE schedules ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE passengers ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE guardians ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE trips ALTER COLUMN organization_id DROP DEFAULT;
+
+CREATE INDEX idx_users_organization_id ON users (organization_id);
+CREATE INDEX idx_api_keys_organization_id ON api_keys (organization_id);
+CREATE INDEX idx_vehicles_organization_id ON vehicles (organization_id);
+CREATE INDEX idx_drivers_organization_id ON drivers (organization_id);
+CREATE INDEX idx_attendants_organization_id ON attendants (organization_id);
+CREATE INDEX idx_routes_organization_id ON routes (organization_id);
+CREATE INDEX idx_schedules_organization_id ON schedules (organization_id);
+CREATE INDEX idx_passengers_organization_id ON passengers (organization_id);
+CREATE INDEX idx_guardians_organization_id ON guardians (organization_id);
+CREATE INDEX idx_trips_organization_id ON trips (organization_id);
+
+-- 차량 번호/면허 번호/보호자 연락처는 기관 안에서만 중복 불가 (로그인 이메일은 전체에서 유일 유지)
+DROP INDEX IF EXISTS idx_vehicles_plate_number;
+CREATE UNIQUE INDEX idx_vehicles_plate_number ON vehicles (organization_id, plate_number) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS idx_drivers_license_number;
+CREATE UNIQUE INDEX idx_drivers_license_number ON drivers (organization_id, license_number) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS uq_guardians_phone_hash;
+CREATE UNIQUE INDEX uq_guardians_phone_hash ON guardians (organization_id, phone_hash) WHERE deleted_at IS NULL;
+
+-- +goose Down
+-- 여러 기관에 같은 차량 번호/면허 번호/연락처가 있으면 유니크 인덱스 생성이 실패 (정리 후 되돌릴 것)
+DROP INDEX IF EXISTS uq_guardians_phone_hash;
+CREATE UNIQUE INDEX uq_guardians_phone_hash ON guardians (phone_hash) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS idx_drivers_license_number;
+CREATE UNIQUE INDEX idx_drivers_license_number ON drivers (license_number) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS idx_vehicles_plate_number;
+CREATE UNIQUE INDEX idx_vehicles_plate_number ON vehicles (plate_number) WHERE deleted_at IS NULL;
+
+ALTER TABLE trips DROP COLUMN organization_id;
+ALTER TABLE guardians DROP COLUMN organization_id;
+ALTER TABLE passengers DROP COLUMN organization_id;
+ALTER TABLE schedules DROP COLUMN organization_id;
+ALTER TABLE routes DROP COLUMN organization_id;
+ALTER TABLE attendants DROP COLUMN organization_id;
+ALTER TABLE drivers DROP COLUMN organization_id;
+ALTER TABLE vehicles DROP COLUMN organization_id;
+ALTER TABLE api_keys DROP COLUMN organization_id;
+ALTER TABLE users DROP COLUMN organization_id;
+
+DROP TABLE IF EXISTS organizations;
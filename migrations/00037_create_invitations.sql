@@ -0,0 +1,25 @@
+-- +goose Up
+-- 기관 직원 초대 (원문 토큰은 저장하지 않고 SHA-256 해시만 보관, 수락 시 같은 기관 계정 생성)
+CREATE TABLE invitations (
+    id               UUID PRIMARY KEY,
+    organization_id  UUID         NOT NULL REFERENCES organizations (id),
+    email            VARCHAR(255),
+    phone            VARCHAR(20),
+    role             VARCHAR(20)  NOT NULL,
+    profile_id       VARCHAR(36),
+    token_hash       VARCHAR(64)  NOT NULL,
+    invited_by       UUID         NOT NULL REFERENCES users (id),
+    expires_at       TIMESTAMPTZ  NOT NULL,
+    accepted_at      TIMESTAMPTZ,
+    accepted_user_id UUID REFERENCES users (id),
+    revoked_at       TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_invitations_contact CHECK (email IS NOT NULL OR phone IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX uq_invitations_token_hash ON invitations (token_hash);
+CREATE INDEX idx_invitations_organization_id ON invitations (organization_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS invitations;
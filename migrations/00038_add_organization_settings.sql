@@ -0,0 +1,10 @@
+-- +goose Up
+-- 기관별 화면 표시(표시 이름, 로고)와 웹 앱 허용 Origin (CORS 전역 목록에 더해 허용)
+ALTER TABLE organizations ADD COLUMN display_name VARCHAR(100);
+ALTER TABLE organizations ADD COLUMN logo_url VARCHAR(500);
+ALTER TABLE organizations ADD COLUMN allowed_origins JSONB NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE organizations DROP COLUMN IF EXISTS allowed_origins;
+ALTER TABLE organizations DROP COLUMN IF EXISTS logo_url;
+ALTER TABLE organizations DROP COLUMN IF EXISTS display_name;
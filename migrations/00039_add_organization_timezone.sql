@@ -0,0 +1,6 @@
+-- +goose Up
+-- 기관 시간대 (IANA 이름) - 일정 출발 시각(HH:MM)과 날짜 경계를 이 시간대로 해석
+ALTER TABLE organizations ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul';
+
+-- +goose Down
+ALTER TABLE organizations DROP COLUMN IF EXISTS timezone;
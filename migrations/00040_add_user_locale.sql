@@ -0,0 +1,6 @@
+-- +goose Up
+-- 사용자 언어 설정 (ko, en) - 빈 값이면 요청의 Accept-Language로 응답 언어 결정
+ALTER TABLE users ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- 차량 주유 기록 (주유량, 금액, 주행거리계) - 직전 주유 이후 주행 거리로 연비 계산
+CREATE TABLE fuel_logs (
+    id              UUID PRIMARY KEY,
+    organization_id UUID          NOT NULL REFERENCES organizations (id),
+    vehicle_id      UUID          NOT NULL REFERENCES vehicles (id),
+    filled_at       TIMESTAMPTZ   NOT NULL,
+    liters          NUMERIC(7, 2) NOT NULL CHECK (liters > 0),
+    cost            INTEGER       NOT NULL CHECK (cost >= 0),
+    odometer_km     INTEGER       NOT NULL CHECK (odometer_km >= 0),
+    station         VARCHAR(100),
+    recorded_by     UUID          NOT NULL REFERENCES users (id),
+    created_at      TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_fuel_logs_organization_id ON fuel_logs (organization_id);
+CREATE INDEX idx_fuel_logs_vehicle_filled_at ON fuel_logs (vehicle_id, filled_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS fuel_logs;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- 차량 주행거리계 (운행 완료 시 기사 확인 값 또는 주행 거리로 갱신) + 변경 이력
+ALTER TABLE vehicles ADD COLUMN odometer_km INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE vehicles ADD COLUMN odometer_updated_at TIMESTAMPTZ;
+ALTER TABLE trips ADD COLUMN odometer_km INTEGER;
+
+CREATE TABLE odometer_readings (
+    id              UUID PRIMARY KEY,
+    organization_id UUID        NOT NULL REFERENCES organizations (id),
+    vehicle_id      UUID        NOT NULL REFERENCES vehicles (id),
+    odometer_km     INTEGER     NOT NULL CHECK (odometer_km >= 0),
+    source          VARCHAR(20) NOT NULL,
+    trip_id         UUID REFERENCES trips (id),
+    recorded_by     VARCHAR(36),
+    recorded_at     TIMESTAMPTZ NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_odometer_readings_organization_id ON odometer_readings (organization_id);
+CREATE INDEX idx_odometer_readings_vehicle_recorded_at ON odometer_readings (vehicle_id, recorded_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS odometer_readings;
+ALTER TABLE trips DROP COLUMN IF EXISTS odometer_km;
+ALTER TABLE vehicles DROP COLUMN IF EXISTS odometer_updated_at;
+ALTER TABLE vehicles DROP COLUMN IF EXISTS odometer_km;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- 차량 서류 (보험 증권, 정기검사 증명서 등 원본 파일 메타데이터, 파일은 STORAGE_PROVIDER 저장소)
+CREATE TABLE vehicle_documents (
+    id              UUID PRIMARY KEY,
+    organization_id UUID         NOT NULL REFERENCES organizations (id),
+    vehicle_id      UUID         NOT NULL REFERENCES vehicles (id),
+    type            VARCHAR(20)  NOT NULL,
+    title           VARCHAR(100),
+    file_name       VARCHAR(255) NOT NULL,
+    content_type    VARCHAR(100) NOT NULL,
+    size            BIGINT       NOT NULL CHECK (size > 0),
+    storage_key     VARCHAR(255) NOT NULL UNIQUE,
+    issued_on       DATE,
+    expires_on      DATE,
+    uploaded_by     VARCHAR(36),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_vehicle_documents_organization_id ON vehicle_documents (organization_id);
+CREATE INDEX idx_vehicle_documents_vehicle_type ON vehicle_documents (vehicle_id, type);
+
+-- +goose Down
+DROP TABLE IF EXISTS vehicle_documents;
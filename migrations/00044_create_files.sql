@@ -0,0 +1,48 @@
+-- +goose Up
+-- 업로드 파일 메타데이터 (차량 서류, 면허증 스캔, 사고 사진 등 → 원본은 STORAGE_PROVIDER 저장소)
+CREATE TABLE files (
+    id              UUID PRIMARY KEY,
+    organization_id UUID         NOT NULL REFERENCES organizations (id),
+    purpose         VARCHAR(30)  NOT NULL,
+    file_name       VARCHAR(255) NOT NULL,
+    content_type    VARCHAR(100) NOT NULL,
+    size            BIGINT       NOT NULL CHECK (size > 0),
+    storage_key     VARCHAR(255) NOT NULL UNIQUE,
+    uploaded_by     VARCHAR(36),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_files_organization_id ON files (organization_id);
+
+-- 기존 차량 서류의 파일 정보를 files로 이전 (파일 ID = 서류 ID, 저장소 키는 그대로)
+INSERT INTO files (id, organization_id, purpose, file_name, content_type, size, storage_key, uploaded_by, created_at)
+SELECT id, organization_id, 'vehicle_document', file_name, content_type, size, storage_key, uploaded_by, created_at
+FROM vehicle_documents;
+
+ALTER TABLE vehicle_documents ADD COLUMN file_id UUID REFERENCES files (id);
+UPDATE vehicle_documents SET file_id = id;
+ALTER TABLE vehicle_documents ALTER COLUMN file_id SET NOT NULL;
+ALTER TABLE vehicle_documents
+    DROP COLUMN file_name,
+    DROP COLUMN content_type,
+    DROP COLUMN size,
+    DROP COLUMN storage_key;
+
+-- +goose Down
+ALTER TABLE vehicle_documents
+    ADD COLUMN file_name    VARCHAR(255),
+    ADD COLUMN content_type VARCHAR(100),
+    ADD COLUMN size         BIGINT,
+    ADD COLUMN storage_key  VARCHAR(255);
+UPDATE vehicle_documents d
+SET file_name = f.file_name, content_type = f.content_type, size = f.size, storage_key = f.storage_key
+FROM files f
+WHERE f.id = d.file_id;
+ALTER TABLE vehicle_documents
+    ALTER COLUMN file_name SET NOT NULL,
+    ALTER COLUMN content_type SET NOT NULL,
+    ALTER COLUMN size SET NOT NULL,
+    ALTER COLUMN storage_key SET NOT NULL,
+    ADD CONSTRAINT vehicle_documents_storage_key_key UNIQUE (storage_key),
+    DROP COLUMN file_id;
+DROP TABLE IF EXISTS files;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- 면허 확인 상태 (pending/verified/rejected) + 면허증 스캔
+-- 기존 기사는 이미 운행 중이므로 확인 완료로 간주 (배포 직후 배정이 막히지 않도록), 새로 등록하는 기사부터 확인 대기
+ALTER TABLE drivers
+    ADD COLUMN license_status        VARCHAR(20) NOT NULL DEFAULT 'verified',
+    ADD COLUMN license_file_id       UUID REFERENCES files (id) ON DELETE SET NULL,
+    ADD COLUMN license_reviewed_by   VARCHAR(36),
+    ADD COLUMN license_reviewed_at   TIMESTAMPTZ,
+    ADD COLUMN license_reject_reason VARCHAR(200);
+ALTER TABLE drivers ALTER COLUMN license_status SET DEFAULT 'pending';
+
+CREATE INDEX idx_drivers_license_status ON drivers (license_status) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_drivers_license_status;
+ALTER TABLE drivers
+    DROP COLUMN license_reject_reason,
+    DROP COLUMN license_reviewed_at,
+    DROP COLUMN license_reviewed_by,
+    DROP COLUMN license_file_id,
+    DROP COLUMN license_status;
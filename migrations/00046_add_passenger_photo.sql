@@ -0,0 +1,14 @@
+-- +goose Up
+-- 탑승자 사진 + 보호자 사진 동의 (기존 탑승자는 동의 없음 → 사진 등록/조회 불가)
+ALTER TABLE passengers
+    ADD COLUMN photo_consent    BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN photo_consent_at TIMESTAMPTZ,
+    ADD COLUMN photo_consent_by VARCHAR(36),
+    ADD COLUMN photo_file_id    UUID REFERENCES files (id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE passengers
+    DROP COLUMN photo_file_id,
+    DROP COLUMN photo_consent_by,
+    DROP COLUMN photo_consent_at,
+    DROP COLUMN photo_consent;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- 면허/보험/정기검사 만료 안내 발송 기록 (대상·만료일·안내 구간마다 한 번 → 중복 안내 방지)
+CREATE TABLE expiry_reminders (
+    id              UUID PRIMARY KEY,
+    organization_id UUID        NOT NULL REFERENCES organizations (id),
+    kind            VARCHAR(20) NOT NULL,
+    subject_id      UUID        NOT NULL,
+    expires_on      DATE        NOT NULL,
+    window_days     INT         NOT NULL CHECK (window_days > 0),
+    sent_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, subject_id, expires_on, window_days)
+);
+
+CREATE INDEX idx_expiry_reminders_organization_id ON expiry_reminders (organization_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS expiry_reminders;
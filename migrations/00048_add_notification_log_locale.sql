@@ -0,0 +1,6 @@
+-- +goose Up
+-- 알림 발송 기록의 제목/본문 언어 (재시도 시 같은 언어의 알림톡 템플릿 선택, 기존 기록은 기본 언어)
+ALTER TABLE notification_logs ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE notification_logs DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,60 @@
+-- +goose Up
+-- 웹훅 구독/전달 기록, 아웃박스 이벤트, 알림 발송 기록, 감사 로그도 기관 단위로 분리
+-- 기존 행은 연결된 엔티티의 기관으로 채우고, 찾지 못한 행은 기본 기관으로 배정 (감사 로그 제외)
+ALTER TABLE webhook_subscriptions ADD COLUMN organization_id UUID REFERENCES organizations (id);
+ALTER TABLE webhook_deliveries ADD COLUMN organization_id UUID REFERENCES organizations (id);
+ALTER TABLE outbox_events ADD COLUMN organization_id UUID REFERENCES organizations (id);
+ALTER TABLE notification_logs ADD COLUMN organization_id UUID REFERENCES organizations (id);
+ALTER TABLE audit_logs ADD COLUMN organization_id UUID REFERENCES organizations (id);
+
+-- 웹훅 구독: 등록한 관리자의 기관, 전달 기록: 구독의 기관
+UPDATE webhook_subscriptions s SET organization_id = u.organization_id
+FROM users u WHERE u.id::text = s.created_by;
+UPDATE webhook_subscriptions SET organization_id = '00000000-0000-0000-0000-000000000001' WHERE organization_id IS NULL;
+UPDATE webhook_deliveries d SET organization_id = s.organization_id
+FROM webhook_subscriptions s WHERE s.id = d.subscription_id;
+
+-- 아웃박스 이벤트: 이벤트가 발생한 운행의 기관
+UPDATE outbox_events e SET organization_id = t.organization_id
+FROM trips t WHERE t.id = e.aggregate_id;
+UPDATE outbox_events SET organization_id = '00000000-0000-0000-0000-000000000001' WHERE organization_id IS NULL;
+
+-- 알림 발송 기록: 보호자의 기관, 보호자가 아닌 수신자는 계정의 기관
+-- 이후 기관을 알 수 없는 알림만 기관 없이 기록 (플랫폼 운영자만 조회)
+UPDATE notification_logs l SET organization_id = g.organization_id
+FROM guardians g WHERE g.id::text = l.guardian_id;
+UPDATE notification_logs l SET organization_id = u.organization_id
+FROM users u WHERE l.organization_id IS NULL AND u.id::text = l.user_id;
+UPDATE notification_logs SET organization_id = '00000000-0000-0000-0000-000000000001' WHERE organization_id IS NULL;
+
+-- 감사 로그: 행위자의 기관 (플랫폼 운영자와 시스템 작업은 대상 엔티티로 알 수 있는 경우만)
+-- 기관을 알 수 없는 기록은 비워 둠 → 플랫폼 운영자만 조회 가능
+UPDATE audit_logs a SET organization_id = u.organization_id
+FROM users u WHERE u.id::text = a.actor_id AND u.role <> 'super_admin';
+UPDATE audit_logs a SET organization_id = t.organization_id
+FROM trips t WHERE a.organization_id IS NULL AND a.entity_type = 'trips' AND t.id::text = a.entity_id;
+UPDATE audit_logs a SET organization_id = s.organization_id
+FROM schedules s WHERE a.organization_id IS NULL AND a.entity_type = 'schedules' AND s.id::text = a.entity_id;
+UPDATE audit_logs a SET organization_id = p.organization_id
+FROM passengers p WHERE a.organization_id IS NULL AND a.entity_type = 'passengers' AND p.id::text = a.entity_id;
+UPDATE audit_logs a SET organization_id = o.organization_id
+FROM outbox_events o WHERE a.organization_id IS NULL AND a.entity_type = 'outbox_events' AND o.id::text = a.entity_id;
+UPDATE audit_logs a SET organization_id = d.organization_id
+FROM webhook_deliveries d WHERE a.organization_id IS NULL AND a.entity_type = 'webhook_deliveries' AND d.id::text = a.entity_id;
+
+ALTER TABLE webhook_subscriptions ALTER COLUMN organization_id SET NOT NULL;
+ALTER TABLE webhook_deliveries ALTER COLUMN organization_id SET NOT NULL;
+ALTER TABLE outbox_events ALTER COLUMN organization_id SET NOT NULL;
+
+CREATE INDEX idx_webhook_subscriptions_organization_id ON webhook_subscriptions (organization_id);
+CREATE INDEX idx_webhook_deliveries_organization_id ON webhook_deliveries (organization_id);
+CREATE INDEX idx_outbox_events_organization_id ON outbox_events (organization_id);
+CREATE INDEX idx_notification_logs_organization_id ON notification_logs (organization_id, created_at DESC);
+CREATE INDEX idx_audit_logs_organization_id ON audit_logs (organization_id, created_at DESC);
+
+-- +goose Down
+ALTER TABLE audit_logs DROP COLUMN organization_id;
+ALTER TABLE notification_logs DROP COLUMN organization_id;
+ALTER TABLE outbox_events DROP COLUMN organization_id;
+ALTER TABLE webhook_deliveries DROP COLUMN organization_id;
+ALTER TABLE webhook_subscriptions DROP COLUMN organization_id;
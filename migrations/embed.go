@@ -0,0 +1,13 @@
+// Package migrations - 버전별 SQL 마이그레이션 (goose 형식)
+package migrations
+
+import "embed"
+
+// 📝 설명: 마이그레이션 SQL을 바이너리에 포함
+// 🎯 실무 포인트: 배포 환경에 SQL 파일을 따로 복사할 필요 없음
+// ⚠️ 주의사항: 적용된 파일은 수정 금지, 변경은 항상 새 버전 파일로 추가
+
+// FS - 임베드된 마이그레이션 파일
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,71 @@
+package alimtalk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// 📝 설명: 알리고 카카오 알림톡 발송 (POST /akv10/alimtalk/send/, form 전송)
+// 🎯 실무 포인트: 문자와 같은 알리고 계정으로 발송 가능, 치환을 마친 본문 전체를 전송
+// ⚠️ 주의사항: HTTP 200이어도 code가 0이 아니면 실패 (미승인 템플릿, 본문 불일치 등)
+
+// AligoBaseURL - 알리고 알림톡 API 기본 주소
+const AligoBaseURL = "https://kakaoapi.aligo.in"
+
+// AligoClient - 알리고 알림톡 발송 클라이언트
+type AligoClient struct {
+	APIKey     string // 발급받은 API 키
+	UserID     string // 알리고 계정 ID
+	SenderKey  string // 카카오 발신 프로필 키
+	Sender     string // 사전 등록된 발신번호
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewAligoClient - 알리고 알림톡 클라이언트 생성
+// 사용 예: client := alimtalk.NewAligoClient(cfg.Alimtalk.APIKey, cfg.Alimtalk.AccountID, cfg.Alimtalk.SenderKey, cfg.Alimtalk.SenderNumber, cfg.Alimtalk.Timeout)
+func NewAligoClient(apiKey, userID, senderKey, sender string, timeout time.Duration) *AligoClient {
+	return &AligoClient{APIKey: apiKey, UserID: userID, SenderKey: senderKey, Sender: sender, BaseURL: AligoBaseURL, HTTPClient: newHTTPClient(timeout)}
+}
+
+type aligoResponse struct {
+	Code    int    `json:"code"` // 0이면 성공
+	Message string `json:"message"`
+}
+
+// Send - 알림톡 한 건 발송
+func (c *AligoClient) Send(ctx context.Context, msg *Message) error {
+	to, err := validate(msg)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("apikey", c.APIKey)
+	form.Set("userid", c.UserID)
+	form.Set("senderkey", c.SenderKey)
+	form.Set("tpl_code", msg.TemplateCode)
+	form.Set("sender", c.Sender)
+	form.Set("receiver_1", to)
+	form.Set("subject_1", msg.TemplateCode)
+	form.Set("message_1", msg.Text)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/akv10/alimtalk/send/", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp aligoResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return err
+	}
+	if resp.Code != 0 {
+		return fmt.Errorf("alimtalk: aligo result %d: %s", resp.Code, resp.Message)
+	}
+	return nil
+}
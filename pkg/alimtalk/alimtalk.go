@@ -0,0 +1,94 @@
+package alimtalk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hyeokjun/eodini/pkg/sms"
+)
+
+// 📝 설명: 카카오 알림톡 발송 (사전 승인된 템플릿 + 변수 치환)
+// 🎯 실무 포인트: 어린이집/학원 보호자 안내의 기본 수단, 문자보다 저렴하고 읽음률이 높음
+// 템플릿 코드는 발신 프로필마다 발급되므로 코드와 본문을 템플릿 파일로 관리
+// ⚠️ 주의사항: 본문이 승인된 템플릿과 한 글자라도 다르면 발송 실패 (치환 후 본문으로 비교)
+
+// Sender - 알림톡 발송 (AligoClient, NHNClient가 구현)
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Message - 알림톡 한 건 (Template.Message로 생성)
+type Message struct {
+	To           string            // 수신 번호 (하이픈 허용)
+	TemplateCode string            // 승인된 템플릿 코드
+	Text         string            // 변수를 치환한 본문 (알리고는 본문 전체를 전송)
+	Variables    map[string]string // 템플릿 변수 (NHN Cloud는 변수만 전송)
+}
+
+// Template - 승인된 알림톡 템플릿
+type Template struct {
+	Code string `json:"code"`
+	Text string `json:"text"` // #{변수} 포함 본문
+}
+
+var (
+	// ErrMissingVariable - 템플릿 변수 값 누락
+	ErrMissingVariable = errors.New("alimtalk: missing template variable")
+	// ErrTemplateInvalid - 코드나 본문이 비어 있는 템플릿
+	ErrTemplateInvalid = errors.New("alimtalk: template requires code and text")
+)
+
+// variablePattern - #{변수} 자리표시자
+var variablePattern = regexp.MustCompile(`#\{([^}]+)\}`)
+
+// Render - 변수 치환 (값이 없는 변수가 있으면 ErrMissingVariable)
+func (t Template) Render(variables map[string]string) (string, error) {
+	var missing error
+	text := variablePattern.ReplaceAllStringFunc(t.Text, func(placeholder string) string {
+		name := placeholder[2 : len(placeholder)-1]
+		value, ok := variables[name]
+		if !ok && missing == nil {
+			missing = fmt.Errorf("%w: %s", ErrMissingVariable, name)
+		}
+		return value
+	})
+	if missing != nil {
+		return "", missing
+	}
+	return text, nil
+}
+
+// Message - 수신자와 변수로 발송할 메시지 생성
+func (t Template) Message(to string, variables map[string]string) (*Message, error) {
+	text, err := t.Render(variables)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{To: to, TemplateCode: t.Code, Text: text, Variables: variables}, nil
+}
+
+// ParseTemplates - 템플릿 파일(JSON, 이름 → {code, text}) 해석
+// 예: {"boarded": {"code": "EODINI_BOARD_01", "text": "#{passenger} 어린이가 #{stop}에서 승차했습니다."}}
+func ParseTemplates(raw []byte) (map[string]Template, error) {
+	var templates map[string]Template
+	if err := json.Unmarshal(raw, &templates); err != nil {
+		return nil, fmt.Errorf("alimtalk: invalid templates file: %w", err)
+	}
+	for name, template := range templates {
+		if template.Code == "" || template.Text == "" {
+			return nil, fmt.Errorf("%w: %s", ErrTemplateInvalid, name)
+		}
+	}
+	return templates, nil
+}
+
+// validate - 발송 전 공통 검증 (정규화한 수신 번호 반환)
+func validate(msg *Message) (string, error) {
+	if msg.TemplateCode == "" {
+		return "", ErrTemplateInvalid
+	}
+	return sms.NormalizeNumber(msg.To)
+}
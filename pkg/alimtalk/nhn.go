@@ -0,0 +1,97 @@
+package alimtalk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// 📝 설명: NHN Cloud KakaoTalk Bizmessage 알림톡 발송 (POST /alimtalk/v2.3/appkeys/{appKey}/messages)
+// 🎯 실무 포인트: 템플릿 변수만 보내면 NHN Cloud가 등록된 템플릿 본문에 치환
+// ⚠️ 주의사항: header.isSuccessful과 수신자별 resultCode를 모두 확인해야 실제 발송 요청 성공
+
+// NHNBaseURL - NHN Cloud 알림톡 API 기본 주소
+const NHNBaseURL = "https://api-alimtalk.cloud.toast.com"
+
+// NHNClient - NHN Cloud 알림톡 발송 클라이언트
+type NHNClient struct {
+	AppKey     string // 서비스 앱키
+	SecretKey  string // X-Secret-Key
+	SenderKey  string // 카카오 발신 프로필 키
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewNHNClient - NHN Cloud 알림톡 클라이언트 생성
+// 사용 예: client := alimtalk.NewNHNClient(cfg.Alimtalk.AccountID, cfg.Alimtalk.APIKey, cfg.Alimtalk.SenderKey, cfg.Alimtalk.Timeout)
+func NewNHNClient(appKey, secretKey, senderKey string, timeout time.Duration) *NHNClient {
+	return &NHNClient{AppKey: appKey, SecretKey: secretKey, SenderKey: senderKey, BaseURL: NHNBaseURL, HTTPClient: newHTTPClient(timeout)}
+}
+
+type nhnRecipient struct {
+	RecipientNo       string            `json:"recipientNo"`
+	TemplateParameter map[string]string `json:"templateParameter,omitempty"`
+}
+
+type nhnRequest struct {
+	SenderKey     string         `json:"senderKey"`
+	TemplateCode  string         `json:"templateCode"`
+	RecipientList []nhnRecipient `json:"recipientList"`
+}
+
+type nhnResponse struct {
+	Header struct {
+		IsSuccessful  bool   `json:"isSuccessful"`
+		ResultCode    int    `json:"resultCode"`
+		ResultMessage string `json:"resultMessage"`
+	} `json:"header"`
+	Message struct {
+		SendResults []struct {
+			ResultCode    int    `json:"resultCode"`
+			ResultMessage string `json:"resultMessage"`
+		} `json:"sendResults"`
+	} `json:"message"`
+}
+
+// Send - 알림톡 한 건 발송
+func (c *NHNClient) Send(ctx context.Context, msg *Message) error {
+	to, err := validate(msg)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(nhnRequest{
+		SenderKey:     c.SenderKey,
+		TemplateCode:  msg.TemplateCode,
+		RecipientList: []nhnRecipient{{RecipientNo: to, TemplateParameter: msg.Variables}},
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := c.BaseURL + "/alimtalk/v2.3/appkeys/" + url.PathEscape(c.AppKey) + "/messages"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json;charset=UTF-8")
+	req.Header.Set("X-Secret-Key", c.SecretKey)
+
+	var resp nhnResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return err
+	}
+	if !resp.Header.IsSuccessful {
+		return fmt.Errorf("alimtalk: nhn result %d: %s", resp.Header.ResultCode, resp.Header.ResultMessage)
+	}
+	for _, result := range resp.Message.SendResults {
+		if result.ResultCode != 0 {
+			return fmt.Errorf("alimtalk: nhn recipient result %d: %s", result.ResultCode, result.ResultMessage)
+		}
+	}
+	return nil
+}
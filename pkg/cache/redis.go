@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: Redis 연결 (세션/토큰 폐기 목록, 캐시)
+// 🎯 실무 포인트: 시작 시 PING으로 연결을 확인해 설정 오류를 조기에 발견
+// ⚠️ 주의사항: 애플리케이션 종료 시 Close 호출 필수
+
+// pingTimeout - 연결 확인 제한 시간
+const pingTimeout = 5 * time.Second
+
+// New - Redis 클라이언트 생성 및 연결 확인
+// 사용 예: rdb, err := cache.New(cfg)
+func New(cfg *config.Config) (*redis.Client, error) {
+	options := &redis.Options{
+		Addr:     cfg.GetRedisAddr(),
+		Username: cfg.Redis.Username,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	}
+	if cfg.Redis.TLS {
+		options.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12, ServerName: cfg.Redis.Host}
+	}
+	client := redis.NewClient(options)
+	if cfg.Tracing.Enabled {
+		client.AddHook(TracingHook{})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect redis: %w", err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 📝 설명: Redis 명령마다 span 생성 (go-redis Hook)
+// 🎯 실무 포인트: 요청 빈도 제한, 세션 폐기 확인, 실시간 위치 전달 등 Redis 호출이 요청 트레이스에 함께 표시
+// ⚠️ 주의사항: 명령 이름만 기록하고 인자(키/값)는 기록하지 않음 → 토큰/세션 ID가 트레이스로 나가지 않음
+
+// TracingHook - Redis 명령/파이프라인/연결 span 생성
+// 사용 예: client.AddHook(cache.TracingHook{})
+type TracingHook struct{}
+
+var _ redis.Hook = TracingHook{}
+
+// DialHook - 새 연결 생성 span
+func (TracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		ctx, span := startRedisSpan(ctx, "redis.dial")
+		defer span.End()
+
+		conn, err := next(ctx, network, addr)
+		recordRedisError(span, err)
+		return conn, err
+	}
+}
+
+// ProcessHook - 단일 명령 span (이름은 명령 이름, 예: "GET")
+func (TracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		name := strings.ToUpper(cmd.Name())
+		ctx, span := startRedisSpan(ctx, name, semconv.DBOperationName(name))
+		defer span.End()
+
+		err := next(ctx, cmd)
+		recordRedisError(span, err)
+		return err
+	}
+}
+
+// ProcessPipelineHook - 파이프라인/트랜잭션 span (명령 수 기록)
+func (TracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		ctx, span := startRedisSpan(ctx, "PIPELINE",
+			semconv.DBOperationName("PIPELINE"),
+			semconv.DBOperationBatchSize(len(cmds)),
+		)
+		defer span.End()
+
+		err := next(ctx, cmds)
+		recordRedisError(span, err)
+		return err
+	}
+}
+
+// startRedisSpan - Redis 클라이언트 span 시작
+func startRedisSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(append(attrs, semconv.DBSystemNameRedis)...),
+	)
+}
+
+// recordRedisError - 오류 기록 (키 없음(redis.Nil)은 정상 흐름이라 제외)
+func recordRedisError(span trace.Span, err error) {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
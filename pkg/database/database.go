@@ -0,0 +1,52 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/hyeokjun/eodini/config"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// 📝 설명: PostgreSQL 연결 (GORM + pgx 드라이버)
+// 🎯 실무 포인트: 커넥션 풀 설정을 config.DatabaseConfig에서 주입
+// ⚠️ 주의사항: 애플리케이션 종료 시 Close 호출 필수
+
+// New - PostgreSQL 연결 및 커넥션 풀 설정
+// 사용 예: db, err := database.New(cfg)
+func New(cfg *config.Config) (*gorm.DB, error) {
+	logLevel := gormlogger.Silent
+	if cfg.IsDevelopment() {
+		logLevel = gormlogger.Warn
+	}
+
+	db, err := gorm.Open(postgres.Open(cfg.GetDatabaseDSN()), &gorm.Config{
+		Logger: gormlogger.Default.LogMode(logLevel),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
+	}
+
+	// 커넥션 풀 설정
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.Database.ConnMaxIdleTime)
+
+	return db, nil
+}
+
+// Close - 커넥션 풀 종료
+func Close(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 쿼리마다 DB span 생성 (GORM 콜백)
+// 🎯 실무 포인트: Repository 코드 수정 없이 모든 쿼리가 요청 트레이스의 하위 span으로 기록
+// ⚠️ 주의사항: 쿼리 문자열은 바인딩 값 없이(placeholder 그대로) 기록 → 연락처/주소 등 개인정보가 트레이스로 나가지 않음
+// context가 없는 쿼리(db.WithContext 미사용)는 부모 없는 span이 되므로 Repository는 항상 WithContext 사용
+
+// tracingSpanKey - 진행 중인 span을 Statement에 보관하는 키
+const tracingSpanKey = "tracing:span"
+
+// RegisterTracing - 생성/조회/수정/삭제/Row/Raw 콜백에 span 시작/종료 등록
+// 사용 예: if cfg.Tracing.Enabled { err = database.RegisterTracing(db) }
+func RegisterTracing(db *gorm.DB) error {
+	callback := db.Callback()
+	return errors.Join(
+		callback.Create().Before("*").Register("tracing:before_create", startSpan("INSERT")),
+		callback.Create().After("*").Register("tracing:after_create", endSpan),
+		callback.Query().Before("*").Register("tracing:before_query", startSpan("SELECT")),
+		callback.Query().After("*").Register("tracing:after_query", endSpan),
+		callback.Update().Before("*").Register("tracing:before_update", startSpan("UPDATE")),
+		callback.Update().After("*").Register("tracing:after_update", endSpan),
+		callback.Delete().Before("*").Register("tracing:before_delete", startSpan("DELETE")),
+		callback.Delete().After("*").Register("tracing:after_delete", endSpan),
+		callback.Row().Before("*").Register("tracing:before_row", startSpan("SELECT")),
+		callback.Row().After("*").Register("tracing:after_row", endSpan),
+		callback.Raw().Before("*").Register("tracing:before_raw", startSpan("EXEC")),
+		callback.Raw().After("*").Register("tracing:after_raw", endSpan),
+	)
+}
+
+// startSpan - 쿼리 실행 전 span 시작 (이름은 "SELECT trips" 형식, 테이블을 모르면 연산만)
+func startSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		stmt := db.Statement
+		name := operation
+		if stmt.Table != "" {
+			name += " " + stmt.Table
+		}
+
+		_, span := tracing.Tracer().Start(stmt.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemNamePostgreSQL,
+				semconv.DBOperationName(operation),
+			),
+		)
+		if stmt.Table != "" {
+			span.SetAttributes(semconv.DBCollectionName(stmt.Table))
+		}
+		db.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+// endSpan - 쿼리 실행 후 쿼리 문자열/처리 행 수/오류 기록 후 span 종료
+func endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if query := db.Statement.SQL.String(); query != "" {
+		span.SetAttributes(semconv.DBQueryText(query))
+	}
+	span.SetAttributes(attribute.Int64("db.response.returned_rows", db.RowsAffected))
+
+	// 조회 결과 없음은 정상 흐름 (404 응답)이라 오류로 표시하지 않음
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// 📝 설명: 트랜잭션 관리자 (여러 Repository 작업을 하나의 트랜잭션으로 묶기)
+// 🎯 실무 포인트: 트랜잭션을 context로 전달 → Repository 시그니처 변경 없이 참여
+// ⚠️ 주의사항: Repository는 r.db 대신 Conn(ctx, r.db)로 쿼리해야 트랜잭션에 참여
+
+// txKey - context에 트랜잭션을 저장하는 키
+type txKey struct{}
+
+// TxManager - 트랜잭션 경계 인터페이스 (Service에서 사용)
+type TxManager interface {
+	// WithTx - fn을 하나의 트랜잭션에서 실행 (에러 반환 또는 panic 시 롤백)
+	// 이미 트랜잭션 안이면 기존 트랜잭션에 합류
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// gormTxManager - GORM 기반 구현체
+type gormTxManager struct {
+	db *gorm.DB
+}
+
+// NewTxManager - 트랜잭션 관리자 생성
+func NewTxManager(db *gorm.DB) TxManager {
+	return &gormTxManager{db: db}
+}
+
+// WithTx - 트랜잭션 실행
+// 사용 예:
+//
+//	err := txManager.WithTx(ctx, func(ctx context.Context) error {
+//		if err := tripRepo.Create(ctx, trip); err != nil {
+//			return err
+//		}
+//		return scheduleRepo.Update(ctx, schedule)
+//	})
+func (m *gormTxManager) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn - context에 트랜잭션이 있으면 트랜잭션을, 없으면 기본 커넥션을 반환
+// 사용 예: database.Conn(ctx, r.db).Create(vehicle)
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,50 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"mime"
+	"strings"
+	"time"
+)
+
+// 📝 설명: 이메일 발송 (관리자 보고서, 비밀번호 재설정, 서류 만료 안내)
+// 🎯 실무 포인트: SMTP(사내 메일 서버, Gmail 등)와 AWS SES를 Sender 인터페이스로 감싸 설정으로 교체
+// ⚠️ 주의사항: 발신 주소는 SPF/DKIM이 설정된 도메인이어야 스팸함으로 가지 않음 (SES는 인증된 주소만 허용)
+
+// Sender - 이메일 발송 (SMTPClient, SESClient가 구현)
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Message - 이메일 내용 (본문은 HTML)
+type Message struct {
+	To      []string
+	Subject string
+	HTML    string
+}
+
+// ErrNoRecipients - 수신자 없음
+var ErrNoRecipients = errors.New("email: no recipients")
+
+// buildMIME - SMTP DATA로 보낼 메시지 (제목은 RFC 2047, 본문은 base64)
+func buildMIME(from string, msg *Message, now time.Time) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from + "\r\n")
+	buf.WriteString("To: " + strings.Join(msg.To, ", ") + "\r\n")
+	buf.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", msg.Subject) + "\r\n")
+	buf.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(msg.HTML))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return buf.Bytes()
+}
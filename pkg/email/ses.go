@@ -0,0 +1,150 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+)
+
+// 📝 설명: AWS SES v2 발송 (POST /v2/email/outbound-emails, SigV4 서명)
+// 🎯 실무 포인트: 프로덕션(AWS)에서는 SMTP 서버 없이 SES API로 직접 발송
+// ⚠️ 주의사항: 샌드박스 상태의 SES는 인증된 수신 주소로만 발송 가능 (운영 전 프로덕션 액세스 요청)
+
+// maxErrorBody - 에러 메시지에 담는 응답 본문 최대 길이
+const maxErrorBody = 512
+
+// SESClient - AWS SES 발송 클라이언트
+type SESClient struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	From            string
+	BaseURL         string // 비어 있으면 https://email.{region}.amazonaws.com
+	HTTPClient      *http.Client
+	Now             func() time.Time // 서명 시각 (테스트용)
+}
+
+// NewSESClient - SES 클라이언트 생성
+// 사용 예: client := email.NewSESClient(cfg.Email.SESRegion, cfg.Email.SESAccessKeyID, cfg.Email.SESSecretAccessKey, cfg.Email.From, cfg.Email.Timeout)
+func NewSESClient(region, accessKeyID, secretAccessKey, from string, timeout time.Duration) *SESClient {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &SESClient{
+		Region:          region,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		From:            from,
+		BaseURL:         "https://email." + region + ".amazonaws.com",
+		HTTPClient:      &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)},
+		Now:             time.Now,
+	}
+}
+
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+type sesRequest struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	Destination      struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	Content struct {
+		Simple struct {
+			Subject sesContent `json:"Subject"`
+			Body    struct {
+				HTML sesContent `json:"Html"`
+			} `json:"Body"`
+		} `json:"Simple"`
+	} `json:"Content"`
+}
+
+// Send - 이메일 한 통 발송
+func (c *SESClient) Send(ctx context.Context, msg *Message) error {
+	if len(msg.To) == 0 {
+		return ErrNoRecipients
+	}
+
+	var body sesRequest
+	body.FromEmailAddress = c.From
+	body.Destination.ToAddresses = msg.To
+	body.Content.Simple.Subject = sesContent{Data: msg.Subject, Charset: "UTF-8"}
+	body.Content.Simple.Body.HTML = sesContent{Data: msg.HTML, Charset: "UTF-8"}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/v2/email/outbound-emails", bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.sign(req, raw)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("email: ses request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("email: ses unexpected status %d: %s", resp.StatusCode, errBody)
+	}
+	return nil
+}
+
+// sign - AWS Signature Version 4 (서명 헤더: content-type, host, x-amz-date)
+func (c *SESClient) sign(req *http.Request, payload []byte) {
+	now := c.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	const signedHeaders = "content-type;host;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"content-type:" + req.Header.Get("Content-Type") + "\n" +
+			"host:" + req.URL.Host + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		hashHex(payload),
+	}, "\n")
+
+	scope := date + "/" + c.Region + "/ses/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+c.SecretAccessKey), date)
+	key = hmacSHA256(key, c.Region)
+	key = hmacSHA256(key, "ses")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+c.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,90 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// 📝 설명: SMTP 발송 (STARTTLS 지원 시 암호화 후 PLAIN 인증)
+// 🎯 실무 포인트: 587 포트(제출용)를 기본으로 사용, 로컬 개발은 MailHog 같은 테스트 서버로 확인
+// ⚠️ 주의사항: 465 포트(SMTPS, 접속 즉시 TLS)는 지원하지 않음
+
+// SMTPClient - SMTP 발송 클라이언트
+type SMTPClient struct {
+	Host     string
+	Port     string
+	Username string // 비어 있으면 인증 생략
+	Password string
+	From     string
+	Timeout  time.Duration // 접속부터 발송 완료까지 제한 시간
+}
+
+// NewSMTPClient - SMTP 클라이언트 생성
+// 사용 예: client := email.NewSMTPClient(cfg.Email.SMTPHost, cfg.Email.SMTPPort, cfg.Email.SMTPUsername, cfg.Email.SMTPPassword, cfg.Email.From, cfg.Email.Timeout)
+func NewSMTPClient(host, port, username, password, from string, timeout time.Duration) *SMTPClient {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &SMTPClient{Host: host, Port: port, Username: username, Password: password, From: from, Timeout: timeout}
+}
+
+// Send - 이메일 한 통 발송
+func (c *SMTPClient) Send(ctx context.Context, msg *Message) error {
+	if len(msg.To) == 0 {
+		return ErrNoRecipients
+	}
+	from, err := mail.ParseAddress(c.From)
+	if err != nil {
+		return fmt.Errorf("email: invalid sender address: %w", err)
+	}
+
+	dialer := net.Dialer{Timeout: c.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.Host, c.Port))
+	if err != nil {
+		return fmt.Errorf("email: smtp connect failed: %w", err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(c.Timeout))
+
+	client, err := smtp.NewClient(conn, c.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("email: smtp handshake failed: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.Host}); err != nil {
+			return fmt.Errorf("email: smtp starttls failed: %w", err)
+		}
+	}
+	if c.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.Username, c.Password, c.Host)); err != nil {
+			return fmt.Errorf("email: smtp auth failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("email: smtp sender rejected: %w", err)
+	}
+	for _, to := range msg.To {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("email: smtp recipient %s rejected: %w", to, err)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("email: smtp data failed: %w", err)
+	}
+	if _, err := writer.Write(buildMIME(from.String(), msg, time.Now())); err != nil {
+		return fmt.Errorf("email: smtp write failed: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("email: smtp message rejected: %w", err)
+	}
+	return client.Quit()
+}
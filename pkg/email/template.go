@@ -0,0 +1,82 @@
+package email
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// 📝 설명: HTML 이메일 템플릿 (공통 layout.html + 메일별 subject/content 정의)
+// 🎯 실무 포인트: 메일별 파일에 {{define "subject"}}와 {{define "content"}}만 두고 머리글/바닥글은 레이아웃에서 공유
+// ⚠️ 주의사항: html/template이 값을 자동 이스케이프하므로 사용자 입력(이름 등)을 그대로 넘겨도 안전
+
+// layoutFile - 모든 메일이 공유하는 레이아웃 파일 이름
+const layoutFile = "layout.html"
+
+// ErrTemplateNotFound - 등록되지 않은 템플릿 이름
+var ErrTemplateNotFound = errors.New("email: template not found")
+
+// Templates - 이름(파일명에서 .html 제외)별 메일 템플릿
+type Templates struct {
+	set map[string]*template.Template
+}
+
+// ParseTemplates - fsys 최상위의 layout.html과 나머지 *.html 파일 해석
+// 사용 예: templates, err := email.ParseTemplates(templateFS)
+func ParseTemplates(fsys fs.FS) (*Templates, error) {
+	files, err := fs.Glob(fsys, "*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	templates := &Templates{set: make(map[string]*template.Template)}
+	for _, file := range files {
+		if file == layoutFile {
+			continue
+		}
+		tmpl, err := template.New(layoutFile).ParseFS(fsys, layoutFile, file)
+		if err != nil {
+			return nil, fmt.Errorf("email: parse %s: %w", file, err)
+		}
+		if tmpl.Lookup("subject") == nil || tmpl.Lookup("content") == nil {
+			return nil, fmt.Errorf("email: %s must define subject and content", file)
+		}
+		templates.set[strings.TrimSuffix(path.Base(file), ".html")] = tmpl
+	}
+	return templates, nil
+}
+
+// MustParseTemplates - ParseTemplates 실패 시 panic (embed된 템플릿용)
+func MustParseTemplates(fsys fs.FS) *Templates {
+	templates, err := ParseTemplates(fsys)
+	if err != nil {
+		panic(err)
+	}
+	return templates
+}
+
+// Render - 제목과 HTML 본문 생성
+func (t *Templates) Render(name string, data interface{}) (subject string, body string, err error) {
+	tmpl, ok := t.set[name]
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", err
+	}
+	// 제목은 HTML이 아니므로 이스케이프 해제 (예: &amp; → &)
+	subject = html.UnescapeString(strings.TrimSpace(buf.String()))
+
+	buf.Reset()
+	if err := tmpl.ExecuteTemplate(&buf, layoutFile, data); err != nil {
+		return "", "", err
+	}
+	return subject, buf.String(), nil
+}
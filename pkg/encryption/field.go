@@ -0,0 +1,114 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 📝 설명: 컬럼 단위 암호화 (AES-256-GCM)
+// 🎯 실무 포인트: 의료 정보/연락처 등 민감 정보는 DB 덤프가 유출돼도 읽을 수 없도록 저장
+// 암호문은 "enc:v1:" 접두사로 구분 → 접두사 없는 기존 평문 데이터도 그대로 읽힘 (점진 전환)
+// ⚠️ 주의사항: 같은 평문도 매번 다른 암호문 → 일치 검색이 필요하면 BlindIndex 값을 별도 컬럼에 저장
+
+// KeySize - AES-256 키 길이 (바이트)
+const KeySize = 32
+
+// encryptedPrefix - 암호문 식별 접두사 (형식 버전 포함)
+const encryptedPrefix = "enc:v1:"
+
+// blindIndexLabel - 검색용 해시 키 파생에 사용하는 라벨 (암호화 키와 분리)
+const blindIndexLabel = "eodini:blind-index:v1"
+
+// ErrInvalidCiphertext - 암호문 형식 오류 또는 키 불일치
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// FieldCipher - 컬럼 암호화기
+type FieldCipher struct {
+	aead     cipher.AEAD
+	indexKey []byte
+}
+
+// NewFieldCipher - 32바이트 키로 암호화기 생성
+func NewFieldCipher(key []byte) (*FieldCipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("field encryption key must be %d bytes, got %d", KeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(blindIndexLabel))
+
+	return &FieldCipher{aead: aead, indexKey: mac.Sum(nil)}, nil
+}
+
+// NewFieldCipherFromBase64 - base64로 인코딩된 키로 암호화기 생성 (환경변수/KMS 주입용)
+func NewFieldCipherFromBase64(encoded string) (*FieldCipher, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("field encryption key must be base64: %w", err)
+	}
+	return NewFieldCipher(key)
+}
+
+// Encrypt - 평문 암호화 (빈 문자열은 그대로 유지)
+func (c *FieldCipher) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return encryptedPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt - 암호문 복호화 (접두사가 없으면 평문으로 간주하고 그대로 반환)
+func (c *FieldCipher) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+	return string(plaintext), nil
+}
+
+// BlindIndex - 일치 검색용 해시 (HMAC-SHA256, hex)
+// 사용 예: 연락처 중복 확인 시 phone_hash = BlindIndex(phone)으로 조회
+func (c *FieldCipher) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsEncrypted - 암호문 형식 여부
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
@@ -0,0 +1,175 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// 📝 설명: 위경도 계산 유틸리티 (거리, 경로 단순화, 폴리라인 인코딩/디코딩)
+// 🎯 실무 포인트: 통학 차량 운행 범위(도시 단위)에서는 구면 근사로 충분한 정확도
+// ⚠️ 주의사항: 좌표는 WGS84 도(degree) 단위, 거리는 미터
+
+// earthRadius - 지구 평균 반지름 (미터)
+const earthRadius = 6371000.0
+
+// Point - 위경도 좌표
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Distance - 두 좌표 사이 거리 (하버사인 공식, 미터)
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(b.Longitude - a.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Interpolate - 두 좌표 사이 fraction(0~1) 지점 (짧은 구간 선형 보간)
+func Interpolate(a, b Point, fraction float64) Point {
+	return Point{
+		Latitude:  a.Latitude + (b.Latitude-a.Latitude)*fraction,
+		Longitude: a.Longitude + (b.Longitude-a.Longitude)*fraction,
+	}
+}
+
+// Simplify - 더글러스-포이커 알고리즘으로 경로 단순화 (남길 좌표의 인덱스를 순서대로 반환)
+// tolerance(미터) 이내로 직선에 가까운 중간 좌표를 제거, 처음과 마지막 좌표는 항상 유지
+// 사용 예: kept := geo.Simplify(points, 10)
+func Simplify(points []Point, tolerance float64) []int {
+	if len(points) <= 2 || tolerance <= 0 {
+		kept := make([]int, len(points))
+		for i := range points {
+			kept[i] = i
+		}
+		return kept
+	}
+
+	// 평면 근사 좌표 (첫 좌표 위도 기준 등장방형 투영, 미터)
+	cosLat := math.Cos(radians(points[0].Latitude))
+	xy := make([][2]float64, len(points))
+	for i, p := range points {
+		xy[i] = [2]float64{radians(p.Longitude) * cosLat * earthRadius, radians(p.Latitude) * earthRadius}
+	}
+
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+
+	// 긴 경로에서도 재귀 깊이 문제가 없도록 스택으로 처리
+	stack := [][2]int{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		span := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		first, last := span[0], span[1]
+
+		farthest, maxDist := -1, tolerance
+		for i := first + 1; i < last; i++ {
+			if d := segmentDistance(xy[i], xy[first], xy[last]); d > maxDist {
+				farthest, maxDist = i, d
+			}
+		}
+		if farthest < 0 {
+			continue
+		}
+		keep[farthest] = true
+		stack = append(stack, [2]int{first, farthest}, [2]int{farthest, last})
+	}
+
+	var kept []int
+	for i, k := range keep {
+		if k {
+			kept = append(kept, i)
+		}
+	}
+	return kept
+}
+
+// EncodePolyline - Google Encoded Polyline 형식 문자열 (정밀도 1e-5, 지도 SDK에서 바로 사용)
+func EncodePolyline(points []Point) string {
+	var sb strings.Builder
+	var prevLat, prevLng int64
+	for _, p := range points {
+		lat := int64(math.Round(p.Latitude * 1e5))
+		lng := int64(math.Round(p.Longitude * 1e5))
+		encodeValue(&sb, lat-prevLat)
+		encodeValue(&sb, lng-prevLng)
+		prevLat, prevLng = lat, lng
+	}
+	return sb.String()
+}
+
+// DecodePolyline - Google Encoded Polyline 문자열을 좌표로 (지도 API 응답 경로 변환용)
+func DecodePolyline(encoded string) ([]Point, error) {
+	var points []Point
+	var lat, lng int64
+	for i := 0; i < len(encoded); {
+		dLat, next, err := decodeValue(encoded, i)
+		if err != nil {
+			return nil, err
+		}
+		dLng, next, err := decodeValue(encoded, next)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+		lat += dLat
+		lng += dLng
+		points = append(points, Point{Latitude: float64(lat) / 1e5, Longitude: float64(lng) / 1e5})
+	}
+	return points, nil
+}
+
+// encodeValue - 폴리라인 값 하나를 5비트 단위로 인코딩
+func encodeValue(sb *strings.Builder, value int64) {
+	v := value << 1
+	if value < 0 {
+		v = ^v
+	}
+	for v >= 0x20 {
+		sb.WriteByte(byte((0x20 | (v & 0x1f)) + 63))
+		v >>= 5
+	}
+	sb.WriteByte(byte(v + 63))
+}
+
+// decodeValue - i부터 폴리라인 값 하나를 읽고 다음 위치 반환
+func decodeValue(encoded string, i int) (int64, int, error) {
+	var v int64
+	for shift := uint(0); ; shift += 5 {
+		if i >= len(encoded) || shift > 60 {
+			return 0, i, fmt.Errorf("invalid polyline at %d", i)
+		}
+		b := int64(encoded[i]) - 63
+		i++
+		if b < 0 {
+			return 0, i, fmt.Errorf("invalid polyline at %d", i-1)
+		}
+		v |= (b & 0x1f) << shift
+		if b < 0x20 {
+			break
+		}
+	}
+	if v&1 != 0 {
+		return ^(v >> 1), i, nil
+	}
+	return v >> 1, i, nil
+}
+
+// segmentDistance - 점 p에서 선분 ab까지 거리 (평면 좌표)
+func segmentDistance(p, a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1])
+	}
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p[0]-(a[0]+t*dx), p[1]-(a[1]+t*dy))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
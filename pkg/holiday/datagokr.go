@@ -0,0 +1,137 @@
+package holiday
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+)
+
+// 📝 설명: 공공데이터포털 한국천문연구원 특일 정보 - 공휴일 조회 (GET /getRestDeInfo)
+// 🎯 실무 포인트: 연도만 주면 그 해 공휴일 전체(대체/임시공휴일 포함)를 한 번에 조회
+// ⚠️ 주의사항: 서비스 키는 포털에서 발급받은 "인코딩 전" 키를 사용 (여기서 URL 인코딩)
+// 결과가 한 건이면 item이 배열이 아닌 객체, 없으면 items가 빈 문자열로 옴
+
+// DataGoKrBaseURL - 특일 정보 API 기본 주소
+const DataGoKrBaseURL = "https://apis.data.go.kr/B090041/openapi/service/SpcdeInfoService"
+
+// maxErrorBody - 에러 메시지에 담는 응답 본문 최대 길이
+const maxErrorBody = 512
+
+// DataGoKrClient - 특일 정보 API 클라이언트
+type DataGoKrClient struct {
+	ServiceKey string // 공공데이터포털 서비스 키 (디코딩 키)
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewDataGoKrClient - 특일 정보 API 클라이언트 생성
+// 사용 예: client := holiday.NewDataGoKrClient(cfg.Holiday.APIKey, cfg.Holiday.Timeout)
+func NewDataGoKrClient(serviceKey string, timeout time.Duration) *DataGoKrClient {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &DataGoKrClient{ServiceKey: serviceKey, BaseURL: DataGoKrBaseURL, HTTPClient: &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)}}
+}
+
+type dataGoKrResponse struct {
+	Response struct {
+		Header struct {
+			ResultCode string `json:"resultCode"` // "00"이면 정상
+			ResultMsg  string `json:"resultMsg"`
+		} `json:"header"`
+		Body struct {
+			Items json.RawMessage `json:"items"` // {"item": [...]} 또는 {"item": {...}} 또는 ""
+		} `json:"body"`
+	} `json:"response"`
+}
+
+type dataGoKrItem struct {
+	DateName  string `json:"dateName"`
+	IsHoliday string `json:"isHoliday"` // "Y"
+	Locdate   int    `json:"locdate"`   // 20250101
+}
+
+// Fetch - 연도의 공휴일 조회 (isHoliday가 Y인 날만)
+func (c *DataGoKrClient) Fetch(ctx context.Context, year int) ([]Holiday, error) {
+	query := url.Values{}
+	query.Set("ServiceKey", c.ServiceKey)
+	query.Set("solYear", strconv.Itoa(year))
+	query.Set("numOfRows", "100")
+	query.Set("_type", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/getRestDeInfo?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("holiday: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return nil, fmt.Errorf("holiday: unexpected status %d: %s", resp.StatusCode, body)
+	}
+	var out dataGoKrResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("holiday: invalid response: %w", err)
+	}
+	if out.Response.Header.ResultCode != "00" {
+		return nil, fmt.Errorf("holiday: api error %s: %s", out.Response.Header.ResultCode, out.Response.Header.ResultMsg)
+	}
+
+	items, err := decodeItems(out.Response.Body.Items)
+	if err != nil {
+		return nil, err
+	}
+	holidays := make([]Holiday, 0, len(items))
+	for _, item := range items {
+		if item.IsHoliday != "Y" {
+			continue
+		}
+		date, err := time.Parse("20060102", strconv.Itoa(item.Locdate))
+		if err != nil {
+			return nil, fmt.Errorf("holiday: invalid locdate %d", item.Locdate)
+		}
+		holidays = append(holidays, Holiday{Date: date, Name: item.DateName})
+	}
+	return holidays, nil
+}
+
+// decodeItems - items의 세 가지 형태(배열, 단일 객체, 빈 문자열)를 목록으로
+func decodeItems(raw json.RawMessage) ([]dataGoKrItem, error) {
+	var wrapper struct {
+		Item json.RawMessage `json:"item"`
+	}
+	if len(raw) == 0 || raw[0] != '{' {
+		return nil, nil // 결과 없음 ("")
+	}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return nil, fmt.Errorf("holiday: invalid items: %w", err)
+	}
+	if len(wrapper.Item) == 0 {
+		return nil, nil
+	}
+
+	if wrapper.Item[0] == '[' {
+		var items []dataGoKrItem
+		if err := json.Unmarshal(wrapper.Item, &items); err != nil {
+			return nil, fmt.Errorf("holiday: invalid items: %w", err)
+		}
+		return items, nil
+	}
+	var item dataGoKrItem
+	if err := json.Unmarshal(wrapper.Item, &item); err != nil {
+		return nil, fmt.Errorf("holiday: invalid items: %w", err)
+	}
+	return []dataGoKrItem{item}, nil
+}
@@ -0,0 +1,109 @@
+package holiday
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// 📝 설명: 국내 공휴일 달력 (설날/추석 등 음력 공휴일, 대체공휴일 포함)
+// 🎯 실무 포인트: 기본 공휴일 표(Static)로 바로 동작하고, 공공데이터포털 특일 정보 API(DataGoKrClient)로
+// 표에 없는 연도와 임시공휴일(선거일 등)을 동기화
+// ⚠️ 주의사항: 음력 공휴일과 임시공휴일은 해마다 달라 기본 표는 2025~2027년만 포함 (이후 연도는 API 동기화 필요)
+
+// Holiday - 공휴일
+type Holiday struct {
+	Date time.Time // 날짜 (UTC 자정)
+	Name string    // 이름 (예: "설날", "대체공휴일")
+}
+
+// Fetcher - 연도별 공휴일 조회 (DataGoKrClient가 구현)
+type Fetcher interface {
+	Fetch(ctx context.Context, year int) ([]Holiday, error)
+}
+
+// staticTable - 기본 공휴일 표 (YYYY-MM-DD → 이름, 관보/인사혁신처 발표 기준)
+var staticTable = map[string]string{
+	// 2025
+	"2025-01-01": "1월1일",
+	"2025-01-27": "임시공휴일",
+	"2025-01-28": "설날",
+	"2025-01-29": "설날",
+	"2025-01-30": "설날",
+	"2025-03-01": "삼일절",
+	"2025-03-03": "대체공휴일",
+	"2025-05-05": "어린이날",
+	"2025-05-06": "대체공휴일",
+	"2025-06-03": "대통령선거일",
+	"2025-06-06": "현충일",
+	"2025-08-15": "광복절",
+	"2025-10-03": "개천절",
+	"2025-10-05": "추석",
+	"2025-10-06": "추석",
+	"2025-10-07": "추석",
+	"2025-10-08": "대체공휴일",
+	"2025-10-09": "한글날",
+	"2025-12-25": "기독탄신일",
+	// 2026
+	"2026-01-01": "1월1일",
+	"2026-02-16": "설날",
+	"2026-02-17": "설날",
+	"2026-02-18": "설날",
+	"2026-03-01": "삼일절",
+	"2026-03-02": "대체공휴일",
+	"2026-05-05": "어린이날",
+	"2026-05-24": "부처님오신날",
+	"2026-05-25": "대체공휴일",
+	"2026-06-03": "전국동시지방선거",
+	"2026-06-06": "현충일",
+	"2026-08-15": "광복절",
+	"2026-08-17": "대체공휴일",
+	"2026-09-24": "추석",
+	"2026-09-25": "추석",
+	"2026-09-26": "추석",
+	"2026-09-28": "대체공휴일",
+	"2026-10-03": "개천절",
+	"2026-10-05": "대체공휴일",
+	"2026-10-09": "한글날",
+	"2026-12-25": "기독탄신일",
+	// 2027
+	"2027-01-01": "1월1일",
+	"2027-02-06": "설날",
+	"2027-02-07": "설날",
+	"2027-02-08": "설날",
+	"2027-02-09": "대체공휴일",
+	"2027-03-01": "삼일절",
+	"2027-05-05": "어린이날",
+	"2027-05-13": "부처님오신날",
+	"2027-06-06": "현충일",
+	"2027-08-15": "광복절",
+	"2027-08-16": "대체공휴일",
+	"2027-09-14": "추석",
+	"2027-09-15": "추석",
+	"2027-09-16": "추석",
+	"2027-10-03": "개천절",
+	"2027-10-04": "대체공휴일",
+	"2027-10-09": "한글날",
+	"2027-10-11": "대체공휴일",
+	"2027-12-25": "기독탄신일",
+	"2027-12-27": "대체공휴일",
+}
+
+// Lookup - 기본 표에서 날짜의 공휴일 이름 (공휴일이 아니면 false)
+func Lookup(date time.Time) (string, bool) {
+	name, ok := staticTable[date.Format(time.DateOnly)]
+	return name, ok
+}
+
+// Static - 기본 표의 연도별 공휴일 (날짜 순, 표에 없는 연도면 빈 목록)
+func Static(year int) []Holiday {
+	var holidays []Holiday
+	for key, name := range staticTable {
+		date, _ := time.Parse(time.DateOnly, key)
+		if date.Year() == year {
+			holidays = append(holidays, Holiday{Date: date, Name: name})
+		}
+	}
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
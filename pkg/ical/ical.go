@@ -0,0 +1,127 @@
+package ical
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 📝 설명: iCalendar(.ics, RFC 5545) 구독 피드 쓰기 (VCALENDAR + VEVENT 목록)
+// 🎯 실무 포인트: 시각은 모두 UTC(…Z)로 써서 VTIMEZONE 없이 휴대폰 캘린더가 현지 시각으로 표시
+// 같은 UID의 일정은 캘린더 앱이 새로 고칠 때 덮어씀 → 운행 변경/취소가 구독자에게 그대로 반영
+// ⚠️ 주의사항: 줄은 CRLF, 75바이트를 넘으면 접어서(folding) 다음 줄을 공백으로 시작 (UTF-8 글자 중간은 자르지 않음)
+
+// maxLineOctets - 접기 전 한 줄 최대 길이 (CRLF 제외)
+const maxLineOctets = 75
+
+// timestampFormat - UTC 날짜-시각 형식
+const timestampFormat = "20060102T150405Z"
+
+// EventStatus - 일정 상태
+type EventStatus string
+
+const (
+	StatusConfirmed EventStatus = "CONFIRMED" // 확정 (기본)
+	StatusCancelled EventStatus = "CANCELLED" // 취소
+)
+
+// Calendar - 구독 캘린더
+type Calendar struct {
+	Name    string        // 캘린더 이름 (X-WR-CALNAME)
+	Refresh time.Duration // 권장 새로 고침 간격 (0이면 생략)
+	Events  []Event
+}
+
+// Event - 캘린더 일정
+type Event struct {
+	UID         string // 일정 고유 ID (같은 운행은 항상 같은 값)
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string // 줄바꿈 가능
+	Location    string
+	Status      EventStatus // 빈 값이면 CONFIRMED
+	Updated     time.Time   // 마지막 변경 시각 (0이면 생성 시각 사용)
+}
+
+// Write - 캘린더를 .ics 내용으로 생성
+func Write(cal Calendar, now time.Time) []byte {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//eodini//schedule feed//KO")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if cal.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escapeText(cal.Name))
+	}
+	if cal.Refresh > 0 {
+		minutes := max(int(cal.Refresh.Minutes()), 1)
+		writeLine(&b, "REFRESH-INTERVAL;VALUE=DURATION:PT"+strconv.Itoa(minutes)+"M")
+		writeLine(&b, "X-PUBLISHED-TTL:PT"+strconv.Itoa(minutes)+"M")
+	}
+
+	for _, event := range cal.Events {
+		status := event.Status
+		if status == "" {
+			status = StatusConfirmed
+		}
+		updated := event.Updated
+		if updated.IsZero() {
+			updated = now
+		}
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+escapeText(event.UID))
+		writeLine(&b, "DTSTAMP:"+formatTime(now))
+		writeLine(&b, "LAST-MODIFIED:"+formatTime(updated))
+		writeLine(&b, "DTSTART:"+formatTime(event.Start))
+		writeLine(&b, "DTEND:"+formatTime(event.End))
+		writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.Location != "" {
+			writeLine(&b, "LOCATION:"+escapeText(event.Location))
+		}
+		writeLine(&b, "STATUS:"+string(status))
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// formatTime - UTC 날짜-시각
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timestampFormat)
+}
+
+// escapeText - TEXT 값 이스케이프 (역슬래시, 쉼표, 세미콜론, 줄바꿈)
+func escapeText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(text)
+}
+
+// writeLine - 한 줄 쓰기 (75바이트마다 접음, 이어지는 줄은 공백 한 칸으로 시작해 최대 74바이트)
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
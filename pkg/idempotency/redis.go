@@ -0,0 +1,87 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: Idempotency-Key 응답 저장소 (Redis)
+// 🎯 실무 포인트: 첫 요청이 키를 선점(SET NX)하고, 성공 응답을 저장해 두었다가 재시도 요청에 그대로 돌려줌
+// ⚠️ 주의사항: 선점 후 서버가 죽으면 pendingTTL 동안 같은 키의 재시도는 "처리 중"으로 거부됨
+
+// keyPrefix - 저장 키 접두사
+const keyPrefix = "idempotency:"
+
+// pendingTTL - 처리 중 표시 유지 시간 (요청 처리 제한 시간보다 길게)
+const pendingTTL = time.Minute
+
+// Record - 키별 저장 내용
+type Record struct {
+	Fingerprint string              `json:"fingerprint"`      // 요청 식별값 (메서드+경로+본문 해시)
+	Completed   bool                `json:"completed"`        // false면 첫 요청이 아직 처리 중
+	Status      int                 `json:"status,omitempty"` // 저장된 응답 상태 코드
+	Header      map[string][]string `json:"header,omitempty"` // 저장된 응답 헤더 (Content-Type 등)
+	Body        []byte              `json:"body,omitempty"`   // 저장된 응답 본문
+	CreatedAt   time.Time           `json:"created_at"`       // 첫 요청 시각
+}
+
+// RedisStore - Redis 기반 저장소
+type RedisStore struct {
+	rdb *redis.Client
+}
+
+// NewRedisStore - 저장소 생성
+// 사용 예: store := idempotency.NewRedisStore(rdb)
+func NewRedisStore(rdb *redis.Client) *RedisStore {
+	return &RedisStore{rdb: rdb}
+}
+
+// Reserve - 키 선점 (선점하면 nil, 이미 있으면 기존 기록 반환)
+func (s *RedisStore) Reserve(ctx context.Context, key, fingerprint string) (*Record, error) {
+	pending, err := json.Marshal(&Record{Fingerprint: fingerprint, CreatedAt: time.Now()})
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := s.rdb.SetNX(ctx, keyPrefix+key, pending, pendingTTL).Result()
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	raw, err := s.rdb.Get(ctx, keyPrefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			// 조회 직전에 만료됨 → 다시 선점 시도
+			return s.Reserve(ctx, key, fingerprint)
+		}
+		return nil, err
+	}
+
+	var record Record
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Complete - 처리 완료된 응답 저장 (ttl 동안 재시도에 재사용)
+func (s *RedisStore) Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	record.Completed = true
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.rdb.Set(ctx, keyPrefix+key, raw, ttl).Err()
+}
+
+// Release - 선점 해제 (실패한 요청은 저장하지 않고 재시도 허용)
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return s.rdb.Del(ctx, keyPrefix+key).Err()
+}
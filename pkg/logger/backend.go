@@ -0,0 +1,127 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// 📝 설명: 표준 log 대신 slog/zap으로 출력하는 Backend 어댑터
+// 🎯 실무 포인트: 호출 코드(logger.Info 등)는 그대로 두고 LOG_BACKEND 설정만으로 출력 라이브러리 교체
+// zap은 고성능 JSON 인코딩, slog는 표준 라이브러리라 의존성 없이 사용
+// ⚠️ 주의사항: 레벨 필터링은 logger 패키지가 먼저 하므로 어댑터에 넘기는 로거는 가장 낮은 레벨까지 허용해야 함
+
+// Backend 이름 (LOG_BACKEND)
+const (
+	StandardBackendName = "standard"
+	SlogBackendName     = "slog"
+	ZapBackendName      = "zap"
+)
+
+// slogLevelFatal - slog에는 Fatal이 없어 Error보다 높은 레벨로 표시
+const slogLevelFatal = slog.Level(12)
+
+// NewBackend - 이름과 형식으로 Backend 생성 (w는 slog/zap 출력 대상, standard는 SetOutput 사용)
+// 사용 예: backend, err := logger.NewBackend("zap", logger.JSONFormat, os.Stdout)
+func NewBackend(name string, format Format, w io.Writer) (Backend, error) {
+	switch name {
+	case "", StandardBackendName:
+		return standardBackend{}, nil
+	case SlogBackendName:
+		options := &slog.HandlerOptions{Level: slog.LevelDebug, ReplaceAttr: replaceSlogLevel}
+		if format == JSONFormat {
+			return NewSlogBackend(slog.New(slog.NewJSONHandler(w, options))), nil
+		}
+		return NewSlogBackend(slog.New(slog.NewTextHandler(w, options))), nil
+	case ZapBackendName:
+		encoderConfig := zap.NewProductionEncoderConfig()
+		encoderConfig.TimeKey = "timestamp"
+		encoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+		encoder := zapcore.NewConsoleEncoder(encoderConfig)
+		if format == JSONFormat {
+			encoder = zapcore.NewJSONEncoder(encoderConfig)
+		}
+		return NewZapBackend(zap.New(zapcore.NewCore(encoder, zapcore.AddSync(w), zapcore.DebugLevel))), nil
+	default:
+		return nil, fmt.Errorf("logger: unknown backend %q", name)
+	}
+}
+
+// slogBackend - slog.Logger 어댑터
+type slogBackend struct {
+	logger *slog.Logger
+}
+
+// NewSlogBackend - slog.Logger로 출력하는 Backend
+func NewSlogBackend(l *slog.Logger) Backend {
+	return &slogBackend{logger: l}
+}
+
+// Log - 필드를 slog 속성으로 변환해 출력
+func (b *slogBackend) Log(level LogLevel, message string, fields map[string]interface{}) {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for key, value := range fields {
+		attrs = append(attrs, slog.Any(key, value))
+	}
+	b.logger.LogAttrs(context.Background(), slogLevel(level), message, attrs...)
+}
+
+// slogLevel - logger 레벨 → slog 레벨
+func slogLevel(level LogLevel) slog.Level {
+	switch level {
+	case DebugLevel:
+		return slog.LevelDebug
+	case InfoLevel:
+		return slog.LevelInfo
+	case WarnLevel:
+		return slog.LevelWarn
+	case ErrorLevel:
+		return slog.LevelError
+	default:
+		return slogLevelFatal
+	}
+}
+
+// replaceSlogLevel - Fatal 레벨을 "ERROR+4" 대신 "FATAL"로 표시
+func replaceSlogLevel(_ []string, attr slog.Attr) slog.Attr {
+	if attr.Key == slog.LevelKey {
+		if level, ok := attr.Value.Any().(slog.Level); ok && level >= slogLevelFatal {
+			return slog.String(slog.LevelKey, FatalLevel.String())
+		}
+	}
+	return attr
+}
+
+// zapBackend - zap.Logger 어댑터
+type zapBackend struct {
+	logger *zap.Logger
+}
+
+// NewZapBackend - zap.Logger로 출력하는 Backend
+func NewZapBackend(l *zap.Logger) Backend {
+	return &zapBackend{logger: l}
+}
+
+// Log - 필드를 zap 필드로 변환해 출력
+// ⚠️ Fatal은 zap의 Error 레벨로 기록 (프로세스 종료는 logger.Fatal이 처리, zap.Fatal의 즉시 종료와 겹치지 않도록)
+func (b *zapBackend) Log(level LogLevel, message string, fields map[string]interface{}) {
+	zapFields := make([]zap.Field, 0, len(fields))
+	for key, value := range fields {
+		zapFields = append(zapFields, zap.Any(key, value))
+	}
+
+	switch level {
+	case DebugLevel:
+		b.logger.Debug(message, zapFields...)
+	case InfoLevel:
+		b.logger.Info(message, zapFields...)
+	case WarnLevel:
+		b.logger.Warn(message, zapFields...)
+	default:
+		b.logger.Error(message, zapFields...)
+	}
+}
@@ -0,0 +1,78 @@
+package logger
+
+import "context"
+
+// 📝 설명: 요청 단위 추적 정보를 context로 전달
+// 🎯 실무 포인트: 미들웨어가 저장한 요청 ID를 Service/Repository에서도 조회 (감사 로그, logger.FromContext 등)
+// ⚠️ 주의사항: gin.Context가 아닌 c.Request.Context()에 저장해야 하위 계층까지 전달됨
+
+type requestIDKey struct{}
+
+// WithRequestID - context에 요청 ID 저장
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext - context에서 요청 ID 조회 (없으면 빈 문자열)
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// Entry - 공통 필드(요청 ID 등)를 모든 로그에 붙이는 로거
+type Entry struct {
+	fields map[string]interface{}
+}
+
+// FromContext - context의 요청 ID를 request_id 필드로 붙인 로거 (요청 ID가 없으면 필드 없음)
+// 사용 예: logger.FromContext(ctx).Warn("Failed to notify guardian", map[string]interface{}{"guardian_id": id})
+func FromContext(ctx context.Context) *Entry {
+	entry := &Entry{}
+	if ctx == nil {
+		return entry
+	}
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		entry.fields = map[string]interface{}{"request_id": requestID}
+	}
+	return entry
+}
+
+// With - 공통 필드를 추가한 새 로거 (기존 로거는 그대로)
+func (e *Entry) With(fields map[string]interface{}) *Entry {
+	return &Entry{fields: e.merge(fields)}
+}
+
+// Debug - 디버그 로그
+func (e *Entry) Debug(message string, fields map[string]interface{}) {
+	logWithFields(DebugLevel, message, e.merge(fields))
+}
+
+// Info - 정보 로그
+func (e *Entry) Info(message string, fields map[string]interface{}) {
+	logWithFields(InfoLevel, message, e.merge(fields))
+}
+
+// Warn - 경고 로그
+func (e *Entry) Warn(message string, fields map[string]interface{}) {
+	logWithFields(WarnLevel, message, e.merge(fields))
+}
+
+// Error - 에러 로그
+func (e *Entry) Error(message string, fields map[string]interface{}) {
+	logWithFields(ErrorLevel, message, e.merge(fields))
+}
+
+// merge - 공통 필드 + 호출 필드 (같은 키는 호출 필드 우선)
+func (e *Entry) merge(fields map[string]interface{}) map[string]interface{} {
+	if len(e.fields) == 0 {
+		return fields
+	}
+	merged := make(map[string]interface{}, len(e.fields)+len(fields))
+	for key, value := range e.fields {
+		merged[key] = value
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+	return merged
+}
@@ -1,15 +1,20 @@
 package logger
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strings"
+	"sync/atomic"
 	"time"
 )
 
-// 📝 설명: 간단한 구조화된 로거 (추후 zap, logrus 등으로 교체 가능)
+// 📝 설명: 간단한 구조화된 로거 (출력은 교체 가능한 Backend가 담당)
 // 🎯 실무 포인트: 로그 레벨 분리, 구조화된 필드 지원
-// ⚠️ 주의사항: 프로덕션에서는 zap 등 성능 좋은 라이브러리 사용 권장
+// 기본은 표준 log 기반 출력, LOG_BACKEND로 slog/zap 어댑터 선택 (호출 코드는 그대로)
+// ⚠️ 주의사항: 레벨 필터링은 이 패키지에서 먼저 처리 → Backend는 받은 로그를 그대로 출력
 
 // LogLevel - 로그 레벨
 type LogLevel int
@@ -22,52 +27,138 @@ const (
 	FatalLevel
 )
 
+// String - 로그에 표시하는 레벨 이름
+func (l LogLevel) String() string {
+	switch l {
+	case DebugLevel:
+		return "DEBUG"
+	case InfoLevel:
+		return "INFO"
+	case WarnLevel:
+		return "WARN"
+	case ErrorLevel:
+		return "ERROR"
+	default:
+		return "FATAL"
+	}
+}
+
+// ParseLevel - 설정 값(debug, info, warn, error) → 로그 레벨 (그 외는 info)
+func ParseLevel(value string) LogLevel {
+	switch strings.ToLower(value) {
+	case "debug":
+		return DebugLevel
+	case "warn":
+		return WarnLevel
+	case "error":
+		return ErrorLevel
+	default:
+		return InfoLevel
+	}
+}
+
+// Format - 로그 출력 형식
+type Format string
+
+const (
+	TextFormat Format = "text" // 사람이 읽기 쉬운 한 줄 ([시각] 레벨 | 메시지 | key=value)
+	JSONFormat Format = "json" // 한 줄에 JSON 객체 하나 (Loki/ELK 수집용)
+)
+
+// Backend - 로그 출력 구현 (기본 출력, slog, zap)
+type Backend interface {
+	Log(level LogLevel, message string, fields map[string]interface{})
+}
+
+// currentLevel - 현재 로그 레벨 (실행 중 설정 재로드로 바뀔 수 있어 atomic)
+var currentLevel atomic.Int32
+
 var (
-	currentLevel = InfoLevel
-	logger       = log.New(os.Stdout, "", 0)
+	currentFormat          = TextFormat
+	currentBackend Backend = standardBackend{}
+	logger                 = log.New(os.Stdout, "", 0)
 )
 
-// SetLevel - 로그 레벨 설정
+func init() {
+	currentLevel.Store(int32(InfoLevel))
+}
+
+// SetLevel - 로그 레벨 설정 (요청 처리 중에도 안전하게 변경 가능)
 func SetLevel(level LogLevel) {
-	currentLevel = level
+	currentLevel.Store(int32(level))
+}
+
+// Level - 현재 로그 레벨
+func Level() LogLevel {
+	return LogLevel(currentLevel.Load())
+}
+
+// SetFormat - 기본 출력의 로그 형식 설정 (json이 아니면 text)
+func SetFormat(format Format) {
+	if format != JSONFormat {
+		format = TextFormat
+	}
+	currentFormat = format
+}
+
+// SetOutput - 기본 출력의 출력 대상 변경 (테스트에서 출력 검증용)
+func SetOutput(w io.Writer) {
+	logger.SetOutput(w)
+}
+
+// SetBackend - 로그 출력 구현 교체 (nil이면 기본 출력)
+// 사용 예: logger.SetBackend(logger.NewSlogBackend(slog.New(slog.NewJSONHandler(os.Stdout, nil))))
+func SetBackend(backend Backend) {
+	if backend == nil {
+		backend = standardBackend{}
+	}
+	currentBackend = backend
 }
 
 // Debug - 디버그 로그
 func Debug(message string, fields map[string]interface{}) {
-	if currentLevel <= DebugLevel {
-		logWithFields("DEBUG", message, fields)
-	}
+	logWithFields(DebugLevel, message, fields)
 }
 
 // Info - 정보 로그
 func Info(message string, fields map[string]interface{}) {
-	if currentLevel <= InfoLevel {
-		logWithFields("INFO", message, fields)
-	}
+	logWithFields(InfoLevel, message, fields)
 }
 
 // Warn - 경고 로그
 func Warn(message string, fields map[string]interface{}) {
-	if currentLevel <= WarnLevel {
-		logWithFields("WARN", message, fields)
-	}
+	logWithFields(WarnLevel, message, fields)
 }
 
 // Error - 에러 로그
 func Error(message string, fields map[string]interface{}) {
-	if currentLevel <= ErrorLevel {
-		logWithFields("ERROR", message, fields)
-	}
+	logWithFields(ErrorLevel, message, fields)
 }
 
 // Fatal - 치명적 에러 로그 (프로그램 종료)
 func Fatal(message string, fields map[string]interface{}) {
-	logWithFields("FATAL", message, fields)
+	logWithFields(FatalLevel, message, fields)
 	os.Exit(1)
 }
 
-// logWithFields - 필드와 함께 로그 출력
-func logWithFields(level, message string, fields map[string]interface{}) {
+// logWithFields - 레벨 확인 후 Backend로 출력
+func logWithFields(level LogLevel, message string, fields map[string]interface{}) {
+	if level < Level() {
+		return
+	}
+	currentBackend.Log(level, message, fields)
+}
+
+// standardBackend - 표준 log 기반 기본 출력 (SetFormat으로 text/json 선택)
+type standardBackend struct{}
+
+// Log - 현재 형식으로 한 줄 출력
+func (standardBackend) Log(level LogLevel, message string, fields map[string]interface{}) {
+	if currentFormat == JSONFormat {
+		logJSON(level.String(), message, fields)
+		return
+	}
+
 	timestamp := time.Now().Format("2006-01-02 15:04:05")
 
 	logMessage := fmt.Sprintf("[%s] %s | %s", timestamp, level, message)
@@ -82,26 +173,64 @@ func logWithFields(level, message string, fields map[string]interface{}) {
 	logger.Println(logMessage)
 }
 
+// jsonEntry - JSON 로그 한 줄
+// 예: {"timestamp":"2025-01-02T08:30:00.123+09:00","level":"INFO","msg":"HTTP Request","fields":{"status":200}}
+type jsonEntry struct {
+	Timestamp string                 `json:"timestamp"`
+	Level     string                 `json:"level"`
+	Message   string                 `json:"msg"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// logJSON - JSON 한 줄로 출력 (error 값은 메시지 문자열로 기록)
+func logJSON(level, message string, fields map[string]interface{}) {
+	entry := jsonEntry{
+		Timestamp: time.Now().Format(time.RFC3339Nano),
+		Level:     level,
+		Message:   message,
+	}
+	if len(fields) > 0 {
+		entry.Fields = make(map[string]interface{}, len(fields))
+		for key, value := range fields {
+			if err, ok := value.(error); ok {
+				value = err.Error()
+			}
+			entry.Fields[key] = value
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		// JSON으로 표현할 수 없는 값(함수, 채널 등)은 문자열로 바꿔서 기록
+		for key, value := range entry.Fields {
+			entry.Fields[key] = fmt.Sprintf("%v", value)
+		}
+		line, _ = json.Marshal(entry)
+	}
+
+	logger.Println(string(line))
+}
+
 // Infof - 포맷팅된 정보 로그 (필드 없음)
 func Infof(format string, args ...interface{}) {
-	if currentLevel <= InfoLevel {
+	if Level() <= InfoLevel {
 		message := fmt.Sprintf(format, args...)
-		logWithFields("INFO", message, nil)
+		logWithFields(InfoLevel, message, nil)
 	}
 }
 
 // Errorf - 포맷팅된 에러 로그 (필드 없음)
 func Errorf(format string, args ...interface{}) {
-	if currentLevel <= ErrorLevel {
+	if Level() <= ErrorLevel {
 		message := fmt.Sprintf(format, args...)
-		logWithFields("ERROR", message, nil)
+		logWithFields(ErrorLevel, message, nil)
 	}
 }
 
 // Warnf - 포맷팅된 경고 로그 (필드 없음)
 func Warnf(format string, args ...interface{}) {
-	if currentLevel <= WarnLevel {
+	if Level() <= WarnLevel {
 		message := fmt.Sprintf(format, args...)
-		logWithFields("WARN", message, nil)
+		logWithFields(WarnLevel, message, nil)
 	}
 }
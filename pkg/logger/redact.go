@@ -0,0 +1,121 @@
+package logger
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// 📝 설명: 로그에 남기 전 개인정보 마스킹
+// 🎯 실무 포인트: 에러 메시지/요청 본문에 섞인 연락처, 보호자 이름, 의료 정보를 로그 수집기로 보내지 않음
+// ⚠️ 주의사항: 필드명 기반 마스킹은 JSON/key=value 형태만 인식 → 자유 텍스트의 이름은 막지 못함
+
+// RedactedValue - 마스킹된 값 표시
+const RedactedValue = "***"
+
+// DefaultRedactFields - 기본 마스킹 대상 JSON 필드명 (소문자)
+var DefaultRedactFields = []string{
+	"phone",
+	"guardian_phone",
+	"guardian_name",
+	"emergency_contact",
+	"medical_notes",
+	"address",
+	"password",
+	"current_password",
+	"new_password",
+	"token",
+}
+
+// phonePattern - 휴대폰/지역번호 전화번호 (가운데 자리만 가림)
+var phonePattern = regexp.MustCompile(`\b(0\d{1,2})[-.\s]?(\d{3,4})[-.\s]?(\d{4})\b`)
+
+// Redactor - 로그 마스킹 규칙
+type Redactor struct {
+	fields     map[string]bool
+	pairsRegex *regexp.Regexp // "field":"value" 또는 field=value 형태
+}
+
+// NewRedactor - 마스킹할 필드명 목록으로 생성 (대소문자 무시)
+// 사용 예: logger.NewRedactor(append(logger.DefaultRedactFields, "email"))
+func NewRedactor(fields []string) *Redactor {
+	r := &Redactor{fields: make(map[string]bool, len(fields))}
+
+	quoted := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if field == "" || r.fields[field] {
+			continue
+		}
+		r.fields[field] = true
+		quoted = append(quoted, regexp.QuoteMeta(field))
+	}
+
+	if len(quoted) > 0 {
+		keys := strings.Join(quoted, "|")
+		r.pairsRegex = regexp.MustCompile(`(?i)("(?:` + keys + `)"\s*:\s*)("(?:[^"\\]|\\.)*"|[^,}\]\s]+)` +
+			`|\b((?:` + keys + `)\s*[=:]\s*)([^\s,;&}\]]+)`)
+	}
+	return r
+}
+
+// DefaultRedactor - 기본 필드 목록으로 생성
+func DefaultRedactor() *Redactor {
+	return NewRedactor(DefaultRedactFields)
+}
+
+// RedactString - 문자열 안의 민감 필드 값과 전화번호 마스킹
+func (r *Redactor) RedactString(s string) string {
+	if r.pairsRegex != nil {
+		s = r.pairsRegex.ReplaceAllStringFunc(s, func(match string) string {
+			groups := r.pairsRegex.FindStringSubmatch(match)
+			if groups[1] != "" {
+				return groups[1] + `"` + RedactedValue + `"`
+			}
+			return groups[3] + RedactedValue
+		})
+	}
+	return maskPhones(s)
+}
+
+// RedactJSON - JSON 본문의 민감 필드 값 마스킹 (JSON이 아니면 RedactString 적용)
+func (r *Redactor) RedactJSON(body []byte) []byte {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return []byte(r.RedactString(string(body)))
+	}
+
+	redacted, err := json.Marshal(r.redactValue(value))
+	if err != nil {
+		return []byte(r.RedactString(string(body)))
+	}
+	return redacted
+}
+
+// redactValue - JSON 값 재귀 마스킹
+func (r *Redactor) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if r.fields[strings.ToLower(key)] {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = r.redactValue(child)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = r.redactValue(child)
+		}
+		return v
+	case string:
+		return maskPhones(v)
+	}
+	return value
+}
+
+// maskPhones - 전화번호 가운데 자리 마스킹 (예: 010-1234-5678 → 010-****-5678)
+func maskPhones(s string) string {
+	return phonePattern.ReplaceAllString(s, "$1-****-$3")
+}
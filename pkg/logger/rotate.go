@@ -0,0 +1,234 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 📝 설명: 로그 출력 대상 (stdout/stderr/파일) + 파일 크기/주기 기준 교체(rotation)
+// 🎯 실무 포인트: 로그 수집기가 없는 현장 설치(on-prem)에서 파일로 남기면서 stdout에도 동시에 출력
+// 교체된 파일은 "<경로>.<교체 시각>"으로 보관하고 MaxBackups를 넘는 오래된 파일은 삭제
+// ⚠️ 주의사항: 여러 프로세스가 같은 파일에 쓰면 교체가 꼬이므로 인스턴스마다 다른 경로 사용
+// 교체 주기는 UTC 기준으로 정렬 (24h면 매일 UTC 자정, 서버를 재시작해도 같은 기준)
+
+// 출력 대상 이름 (LOG_OUTPUT, 그 외 값은 파일 경로)
+const (
+	StdoutOutput = "stdout"
+	StderrOutput = "stderr"
+)
+
+// backupTimeFormat - 교체된 파일 이름의 시각 형식 (이름 순 정렬 = 시간 순)
+const backupTimeFormat = "20060102-150405.000"
+
+// RotateOptions - 파일 교체 설정 (0이면 해당 기준 사용 안 함)
+type RotateOptions struct {
+	MaxSize    int64            // 파일 최대 크기 (바이트, 넘기 전에 교체)
+	Interval   time.Duration    // 교체 주기 (예: 24h)
+	MaxBackups int              // 보관할 교체 파일 수 (0이면 모두 보관)
+	Now        func() time.Time // 현재 시각 (nil이면 time.Now, 테스트용)
+}
+
+// OpenOutputs - 출력 대상 목록을 하나의 Writer로 (stdout, stderr, 파일 경로 혼용, 비어 있으면 stdout)
+// 사용 예:
+//
+//	out, err := logger.OpenOutputs([]string{"stdout", "/var/log/eodini/api.log"}, logger.RotateOptions{MaxSize: 100 << 20, Interval: 24 * time.Hour})
+//	defer out.Close()
+//	logger.SetOutput(out)
+func OpenOutputs(destinations []string, opts RotateOptions) (io.WriteCloser, error) {
+	if len(destinations) == 0 {
+		destinations = []string{StdoutOutput}
+	}
+
+	outputs := &multiOutput{}
+	for _, destination := range destinations {
+		switch destination {
+		case StdoutOutput:
+			outputs.writers = append(outputs.writers, os.Stdout)
+		case StderrOutput:
+			outputs.writers = append(outputs.writers, os.Stderr)
+		default:
+			file, err := NewRotatingFile(destination, opts)
+			if err != nil {
+				_ = outputs.Close()
+				return nil, err
+			}
+			outputs.writers = append(outputs.writers, file)
+			outputs.closers = append(outputs.closers, file)
+		}
+	}
+	return outputs, nil
+}
+
+// multiOutput - 여러 대상에 같은 로그 출력 (한 대상이 실패해도 나머지는 계속)
+type multiOutput struct {
+	writers []io.Writer
+	closers []io.Closer
+}
+
+// Write - 모든 대상에 출력 (첫 오류 반환)
+func (m *multiOutput) Write(p []byte) (int, error) {
+	var firstErr error
+	for _, w := range m.writers {
+		if _, err := w.Write(p); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return len(p), firstErr
+}
+
+// Close - 파일 대상 닫기 (stdout/stderr는 닫지 않음)
+func (m *multiOutput) Close() error {
+	var firstErr error
+	for _, c := range m.closers {
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// RotatingFile - 크기/주기 기준으로 교체되는 로그 파일
+type RotatingFile struct {
+	mu       sync.Mutex
+	path     string
+	opts     RotateOptions
+	file     *os.File
+	size     int64
+	openedAt time.Time // 현재 파일의 교체 주기 판단 기준 (기존 파일이면 마지막 수정 시각)
+}
+
+// NewRotatingFile - 로그 파일 열기 (디렉터리가 없으면 생성, 기존 파일이면 이어서 기록)
+func NewRotatingFile(path string, opts RotateOptions) (*RotatingFile, error) {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("logger: failed to create log directory: %w", err)
+	}
+
+	f := &RotatingFile{path: path, opts: opts}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Write - 크기/주기를 넘으면 교체 후 기록
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+	if f.shouldRotate(int64(len(p))) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close - 파일 닫기
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// shouldRotate - 이번 기록으로 최대 크기를 넘거나 교체 주기가 지났는지 (빈 파일은 크기로 교체하지 않음)
+func (f *RotatingFile) shouldRotate(n int64) bool {
+	if f.opts.MaxSize > 0 && f.size > 0 && f.size+n > f.opts.MaxSize {
+		return true
+	}
+	if f.opts.Interval > 0 {
+		now := f.opts.Now()
+		return now.Truncate(f.opts.Interval).After(f.openedAt.Truncate(f.opts.Interval))
+	}
+	return false
+}
+
+// open - 파일 열기 (추가 모드), 현재 크기와 기준 시각 기록
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("logger: failed to open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("logger: failed to stat log file: %w", err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	f.openedAt = f.opts.Now()
+	if f.size > 0 {
+		f.openedAt = info.ModTime()
+	}
+	return nil
+}
+
+// rotate - 현재 파일을 "<경로>.<시각>"으로 옮기고 새 파일 열기, 오래된 교체 파일 정리
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("logger: failed to close log file: %w", err)
+	}
+	f.file = nil
+
+	backup := f.path + "." + f.opts.Now().UTC().Format(backupTimeFormat)
+	if err := os.Rename(f.path, backup); err != nil {
+		return fmt.Errorf("logger: failed to rotate log file: %w", err)
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	f.openedAt = f.opts.Now()
+
+	return f.prune()
+}
+
+// prune - MaxBackups를 넘는 오래된 교체 파일 삭제
+func (f *RotatingFile) prune() error {
+	if f.opts.MaxBackups <= 0 {
+		return nil
+	}
+
+	matches, err := filepath.Glob(f.path + ".*")
+	if err != nil {
+		return nil
+	}
+	backups := matches[:0]
+	prefix := f.path + "."
+	for _, match := range matches {
+		if _, err := time.Parse(backupTimeFormat, strings.TrimPrefix(match, prefix)); err == nil {
+			backups = append(backups, match)
+		}
+	}
+	if len(backups) <= f.opts.MaxBackups {
+		return nil
+	}
+
+	sort.Strings(backups)
+	for _, old := range backups[:len(backups)-f.opts.MaxBackups] {
+		if err := os.Remove(old); err != nil {
+			return fmt.Errorf("logger: failed to remove old log file: %w", err)
+		}
+	}
+	return nil
+}
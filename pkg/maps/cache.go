@@ -0,0 +1,225 @@
+package maps
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: 길찾기/주소 검색 결과 캐시 (Redis: 여러 인스턴스 공유, 메모리: 단일 인스턴스/테스트)
+// 🎯 실무 포인트: 같은 좌표 순서(주소)면 같은 결과이므로 해시를 키로 TTL 동안 재사용
+// ⚠️ 주의사항: 캐시 오류는 경고 로그만 남기고 제공자를 직접 호출 (캐시가 길찾기를 막지 않음)
+
+// 캐시 키 종류 (+ 좌표/주소 해시)
+const (
+	directionsKey = "directions:"
+	matrixKey     = "matrix:"
+	geocodeKey    = "geocode:"
+)
+
+// Cache - 길찾기 결과 저장소 (RedisCache, MemoryCache가 구현)
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error) // 없으면 false
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// CachedClient - 결과를 캐시하는 Client
+type CachedClient struct {
+	client Client
+	cache  Cache
+	ttl    time.Duration
+}
+
+// NewCachedClient - 캐시 클라이언트 생성
+// 사용 예: client := maps.NewCachedClient(maps.NewKakaoClient(key, timeout), maps.NewRedisCache(rdb, "maps:kakao:"), 24*time.Hour)
+func NewCachedClient(client Client, cache Cache, ttl time.Duration) *CachedClient {
+	return &CachedClient{client: client, cache: cache, ttl: ttl}
+}
+
+// Directions - 캐시에 있으면 그대로, 없으면 조회 후 저장
+func (c *CachedClient) Directions(ctx context.Context, waypoints []geo.Point) (*Directions, error) {
+	key := directionsKey + pointsHash(waypoints)
+	raw, ok, err := c.cache.Get(ctx, key)
+	if err != nil {
+		logger.Warn("Maps cache unavailable", map[string]interface{}{"error": err.Error()})
+	}
+	if ok {
+		var cached Directions
+		if err := json.Unmarshal(raw, &cached); err == nil {
+			return &cached, nil
+		}
+	}
+
+	result, err := c.client.Directions(ctx, waypoints)
+	if err != nil {
+		return nil, err
+	}
+	if raw, err := json.Marshal(result); err == nil {
+		if err := c.cache.Set(ctx, key, raw, c.ttl); err != nil {
+			logger.Warn("Failed to cache directions", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	return result, nil
+}
+
+// Matrix - 캐시에 있으면 그대로, 없으면 조회 후 저장
+func (c *CachedClient) Matrix(ctx context.Context, points []geo.Point) (*Matrix, error) {
+	key := matrixKey + pointsHash(points)
+	raw, ok, err := c.cache.Get(ctx, key)
+	if err != nil {
+		logger.Warn("Maps cache unavailable", map[string]interface{}{"error": err.Error()})
+	}
+	if ok {
+		var cached Matrix
+		if err := json.Unmarshal(raw, &cached); err == nil {
+			return &cached, nil
+		}
+	}
+
+	result, err := c.client.Matrix(ctx, points)
+	if err != nil {
+		return nil, err
+	}
+	if raw, err := json.Marshal(result); err == nil {
+		if err := c.cache.Set(ctx, key, raw, c.ttl); err != nil {
+			logger.Warn("Failed to cache matrix", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	return result, nil
+}
+
+// CachedGeocoder - 결과를 캐시하는 Geocoder (주소를 찾지 못한 결과는 캐시하지 않음)
+type CachedGeocoder struct {
+	geocoder Geocoder
+	cache    Cache
+	ttl      time.Duration
+}
+
+// NewCachedGeocoder - 캐시 Geocoder 생성
+// 사용 예: geocoder := maps.NewCachedGeocoder(maps.NewKakaoClient(key, timeout), maps.NewRedisCache(rdb, "maps:kakao:"), 24*time.Hour)
+func NewCachedGeocoder(geocoder Geocoder, cache Cache, ttl time.Duration) *CachedGeocoder {
+	return &CachedGeocoder{geocoder: geocoder, cache: cache, ttl: ttl}
+}
+
+// Geocode - 캐시에 있으면 그대로, 없으면 조회 후 저장 (앞뒤 공백 무시)
+func (g *CachedGeocoder) Geocode(ctx context.Context, address string) (geo.Point, error) {
+	address = strings.TrimSpace(address)
+	sum := sha256.Sum256([]byte(address))
+	key := geocodeKey + hex.EncodeToString(sum[:])
+	raw, ok, err := g.cache.Get(ctx, key)
+	if err != nil {
+		logger.Warn("Maps cache unavailable", map[string]interface{}{"error": err.Error()})
+	}
+	if ok {
+		var cached geo.Point
+		if err := json.Unmarshal(raw, &cached); err == nil {
+			return cached, nil
+		}
+	}
+
+	point, err := g.geocoder.Geocode(ctx, address)
+	if err != nil {
+		return geo.Point{}, err
+	}
+	if raw, err := json.Marshal(point); err == nil {
+		if err := g.cache.Set(ctx, key, raw, g.ttl); err != nil {
+			logger.Warn("Failed to cache geocode", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	return point, nil
+}
+
+// pointsHash - 좌표 순서 해시 (소수점 6자리 ≈ 0.1m)
+func pointsHash(points []geo.Point) string {
+	var sb strings.Builder
+	for _, p := range points {
+		sb.WriteString(strconv.FormatFloat(p.Latitude, 'f', 6, 64))
+		sb.WriteByte(',')
+		sb.WriteString(strconv.FormatFloat(p.Longitude, 'f', 6, 64))
+		sb.WriteByte(';')
+	}
+	sum := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// RedisCache - Redis 기반 캐시
+type RedisCache struct {
+	rdb    *redis.Client
+	prefix string
+}
+
+// NewRedisCache - 캐시 생성 (prefix로 제공자별 키 분리)
+// 사용 예: cache := maps.NewRedisCache(rdb, "maps:kakao:")
+func NewRedisCache(rdb *redis.Client, prefix string) *RedisCache {
+	return &RedisCache{rdb: rdb, prefix: prefix}
+}
+
+// Get - 캐시 조회
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	raw, err := c.rdb.Get(ctx, c.prefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return raw, true, nil
+}
+
+// Set - 캐시 저장 (ttl 후 만료)
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.rdb.Set(ctx, c.prefix+key, value, ttl).Err()
+}
+
+// MemoryCache - 메모리 캐시 (만료 시각 지난 값은 조회 시 제외)
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache - 메모리 캐시 생성
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]memoryEntry{}}
+}
+
+// Get - 캐시 조회
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set - 캐시 저장 (ttl이 0이면 만료 없음)
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	c.entries[key] = entry
+	return nil
+}
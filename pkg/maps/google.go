@@ -0,0 +1,230 @@
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+)
+
+// 📝 설명: Google Directions API 길찾기 (GET /maps/api/directions/json), Distance Matrix API (GET /maps/api/distancematrix/json),
+// Geocoding API (GET /maps/api/geocode/json)
+// ⚠️ 주의사항: 경유지는 최대 25개 (출발/도착 포함 27개), 좌표는 "위도,경도" 순서
+// 국내 자동차 길찾기는 지원이 제한적이므로 해외 지점이나 비교용으로 사용
+
+// GoogleBaseURL - Google Maps Platform 기본 주소
+const GoogleBaseURL = "https://maps.googleapis.com"
+
+// googleMaxPoints - 한 번에 요청할 수 있는 좌표 수 (출발 + 경유 25 + 도착)
+const googleMaxPoints = 27
+
+// googleMatrixBlock - 거리 행렬 한 번에 요청할 출발지/도착지 수 (요청당 최대 100개 조합)
+const googleMatrixBlock = 10
+
+// GoogleClient - Google Directions 클라이언트
+type GoogleClient struct {
+	APIKey     string
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewGoogleClient - Google Directions 클라이언트 생성
+// 사용 예: client := maps.NewGoogleClient(cfg.Maps.APIKey, cfg.Maps.Timeout)
+func NewGoogleClient(apiKey string, timeout time.Duration) *GoogleClient {
+	return &GoogleClient{APIKey: apiKey, BaseURL: GoogleBaseURL, HTTPClient: newHTTPClient(timeout)}
+}
+
+type googleValue struct {
+	Value int `json:"value"` // 미터 또는 초
+}
+
+type googleResponse struct {
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message"`
+	Routes       []struct {
+		OverviewPolyline struct {
+			Points string `json:"points"`
+		} `json:"overview_polyline"`
+		Legs []struct {
+			Distance googleValue `json:"distance"`
+			Duration googleValue `json:"duration"`
+		} `json:"legs"`
+	} `json:"routes"`
+}
+
+// Directions - 경유지 순서대로 경로 조회
+func (c *GoogleClient) Directions(ctx context.Context, waypoints []geo.Point) (*Directions, error) {
+	return directions(ctx, waypoints, googleMaxPoints, c.fetch)
+}
+
+// fetch - API 한 번 호출
+func (c *GoogleClient) fetch(ctx context.Context, waypoints []geo.Point) (*section, error) {
+	query := url.Values{}
+	query.Set("origin", googlePoint(waypoints[0]))
+	query.Set("destination", googlePoint(waypoints[len(waypoints)-1]))
+	if len(waypoints) > 2 {
+		query.Set("waypoints", googlePoints(waypoints[1:len(waypoints)-1]))
+	}
+	query.Set("mode", "driving")
+	query.Set("key", c.APIKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/maps/api/directions/json?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp googleResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return nil, err
+	}
+	switch resp.Status {
+	case "OK":
+	case "ZERO_RESULTS", "NOT_FOUND":
+		return nil, ErrNoRoute
+	default:
+		return nil, fmt.Errorf("maps: google status %s: %s", resp.Status, resp.ErrorMessage)
+	}
+	if len(resp.Routes) == 0 {
+		return nil, ErrNoRoute
+	}
+	route := resp.Routes[0]
+
+	path, err := geo.DecodePolyline(route.OverviewPolyline.Points)
+	if err != nil {
+		return nil, fmt.Errorf("maps: invalid google polyline: %w", err)
+	}
+	result := &section{path: path}
+	for _, leg := range route.Legs {
+		result.legs = append(result.legs, Leg{Distance: leg.Distance.Value, Duration: time.Duration(leg.Duration.Value) * time.Second})
+	}
+	return result, nil
+}
+
+type googleMatrixResponse struct {
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message"`
+	Rows         []struct {
+		Elements []struct {
+			Status   string      `json:"status"`
+			Distance googleValue `json:"distance"`
+			Duration googleValue `json:"duration"`
+		} `json:"elements"`
+	} `json:"rows"`
+}
+
+// Matrix - 출발지/도착지 10개씩 나눠 거리 행렬 조회
+func (c *GoogleClient) Matrix(ctx context.Context, points []geo.Point) (*Matrix, error) {
+	if len(points) < 2 {
+		return nil, ErrTooFewWaypoints
+	}
+
+	matrix := newMatrix(len(points))
+	for from := 0; from < len(points); from += googleMatrixBlock {
+		for to := 0; to < len(points); to += googleMatrixBlock {
+			origins := points[from:min(from+googleMatrixBlock, len(points))]
+			destinations := points[to:min(to+googleMatrixBlock, len(points))]
+			if err := c.fetchMatrix(ctx, origins, destinations, matrix, from, to); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return matrix, nil
+}
+
+// fetchMatrix - 거리 행렬 API 한 번 호출, 결과를 matrix의 (from, to) 위치부터 채움
+func (c *GoogleClient) fetchMatrix(ctx context.Context, origins, destinations []geo.Point, matrix *Matrix, from, to int) error {
+	query := url.Values{}
+	query.Set("origins", googlePoints(origins))
+	query.Set("destinations", googlePoints(destinations))
+	query.Set("mode", "driving")
+	query.Set("key", c.APIKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/maps/api/distancematrix/json?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	var resp googleMatrixResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return err
+	}
+	if resp.Status != "OK" {
+		return fmt.Errorf("maps: google status %s: %s", resp.Status, resp.ErrorMessage)
+	}
+	if len(resp.Rows) != len(origins) {
+		return fmt.Errorf("maps: expected %d rows, got %d", len(origins), len(resp.Rows))
+	}
+	for i, row := range resp.Rows {
+		if len(row.Elements) != len(destinations) {
+			return fmt.Errorf("maps: expected %d elements, got %d", len(destinations), len(row.Elements))
+		}
+		for j, element := range row.Elements {
+			if from+i == to+j {
+				continue
+			}
+			if element.Status != "OK" {
+				return ErrNoRoute
+			}
+			matrix.Legs[from+i][to+j] = Leg{Distance: element.Distance.Value, Duration: time.Duration(element.Duration.Value) * time.Second}
+		}
+	}
+	return nil
+}
+
+type googleGeocodeResponse struct {
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message"`
+	Results      []struct {
+		Geometry struct {
+			Location struct {
+				Lat float64 `json:"lat"`
+				Lng float64 `json:"lng"`
+			} `json:"location"`
+		} `json:"geometry"`
+	} `json:"results"`
+}
+
+// Geocode - 주소 검색 결과 첫 번째 좌표
+func (c *GoogleClient) Geocode(ctx context.Context, address string) (geo.Point, error) {
+	query := url.Values{}
+	query.Set("address", address)
+	query.Set("key", c.APIKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/maps/api/geocode/json?"+query.Encode(), nil)
+	if err != nil {
+		return geo.Point{}, err
+	}
+
+	var resp googleGeocodeResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return geo.Point{}, err
+	}
+	switch resp.Status {
+	case "OK":
+	case "ZERO_RESULTS":
+		return geo.Point{}, ErrAddressNotFound
+	default:
+		return geo.Point{}, fmt.Errorf("maps: google status %s: %s", resp.Status, resp.ErrorMessage)
+	}
+	if len(resp.Results) == 0 {
+		return geo.Point{}, ErrAddressNotFound
+	}
+	location := resp.Results[0].Geometry.Location
+	return geo.Point{Latitude: location.Lat, Longitude: location.Lng}, nil
+}
+
+func googlePoints(points []geo.Point) string {
+	values := make([]string, len(points))
+	for i, p := range points {
+		values[i] = googlePoint(p)
+	}
+	return strings.Join(values, "|")
+}
+
+func googlePoint(p geo.Point) string {
+	return strconv.FormatFloat(p.Latitude, 'f', -1, 64) + "," + strconv.FormatFloat(p.Longitude, 'f', -1, 64)
+}
@@ -0,0 +1,148 @@
+package maps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+)
+
+// 📝 설명: 카카오모빌리티 다중 경유지 길찾기 (POST /v1/waypoints/directions), 카카오 로컬 주소 검색 (GET /v2/local/search/address.json)
+// ⚠️ 주의사항: 경유지는 최대 30개 (출발/도착 포함 32개), 좌표는 x=경도, y=위도
+// 길찾기와 주소 검색은 주소(도메인)가 다르지만 같은 REST API 키 사용
+
+// KakaoBaseURL - 카카오모빌리티 API 기본 주소
+const KakaoBaseURL = "https://apis-navi.kakaomobility.com"
+
+// KakaoLocalBaseURL - 카카오 로컬 API 기본 주소
+const KakaoLocalBaseURL = "https://dapi.kakao.com"
+
+// kakaoMaxPoints - 한 번에 요청할 수 있는 좌표 수 (출발 + 경유 30 + 도착)
+const kakaoMaxPoints = 32
+
+// KakaoClient - 카카오모빌리티 길찾기 클라이언트
+type KakaoClient struct {
+	APIKey       string // REST API 키
+	BaseURL      string
+	LocalBaseURL string
+	HTTPClient   *http.Client
+}
+
+// NewKakaoClient - 카카오모빌리티 클라이언트 생성
+// 사용 예: client := maps.NewKakaoClient(cfg.Maps.APIKey, cfg.Maps.Timeout)
+func NewKakaoClient(apiKey string, timeout time.Duration) *KakaoClient {
+	return &KakaoClient{APIKey: apiKey, BaseURL: KakaoBaseURL, LocalBaseURL: KakaoLocalBaseURL, HTTPClient: newHTTPClient(timeout)}
+}
+
+type kakaoPoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type kakaoRequest struct {
+	Origin      kakaoPoint   `json:"origin"`
+	Destination kakaoPoint   `json:"destination"`
+	Waypoints   []kakaoPoint `json:"waypoints,omitempty"`
+	Priority    string       `json:"priority"`
+}
+
+type kakaoResponse struct {
+	Routes []struct {
+		ResultCode int    `json:"result_code"`
+		ResultMsg  string `json:"result_msg"`
+		Sections   []struct {
+			Distance int `json:"distance"` // 미터
+			Duration int `json:"duration"` // 초
+			Roads    []struct {
+				Vertexes []float64 `json:"vertexes"` // x1, y1, x2, y2, ...
+			} `json:"roads"`
+		} `json:"sections"`
+	} `json:"routes"`
+}
+
+// Directions - 경유지 순서대로 경로 조회
+func (c *KakaoClient) Directions(ctx context.Context, waypoints []geo.Point) (*Directions, error) {
+	return directions(ctx, waypoints, kakaoMaxPoints, c.fetch)
+}
+
+// Matrix - 지점 쌍마다 길찾기를 호출해 거리 행렬 구성 (다중 목적지 API는 반경 10km 제한이 있어 사용하지 않음)
+func (c *KakaoClient) Matrix(ctx context.Context, points []geo.Point) (*Matrix, error) {
+	return pairwiseMatrix(ctx, points, c.fetch)
+}
+
+// fetch - API 한 번 호출 (섹션 = 경유지 사이 구간)
+func (c *KakaoClient) fetch(ctx context.Context, waypoints []geo.Point) (*section, error) {
+	body := kakaoRequest{
+		Origin:      toKakaoPoint(waypoints[0]),
+		Destination: toKakaoPoint(waypoints[len(waypoints)-1]),
+		Priority:    "RECOMMEND",
+	}
+	for _, p := range waypoints[1 : len(waypoints)-1] {
+		body.Waypoints = append(body.Waypoints, toKakaoPoint(p))
+	}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/v1/waypoints/directions", bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "KakaoAK "+c.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp kakaoResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Routes) == 0 || resp.Routes[0].ResultCode != 0 {
+		return nil, ErrNoRoute
+	}
+
+	result := &section{}
+	for _, s := range resp.Routes[0].Sections {
+		result.legs = append(result.legs, Leg{Distance: s.Distance, Duration: time.Duration(s.Duration) * time.Second})
+		for _, road := range s.Roads {
+			for i := 0; i+1 < len(road.Vertexes); i += 2 {
+				result.path = append(result.path, geo.Point{Latitude: road.Vertexes[i+1], Longitude: road.Vertexes[i]})
+			}
+		}
+	}
+	return result, nil
+}
+
+type kakaoAddressResponse struct {
+	Documents []struct {
+		X string `json:"x"` // 경도
+		Y string `json:"y"` // 위도
+	} `json:"documents"`
+}
+
+// Geocode - 주소 검색 결과 첫 번째 좌표
+func (c *KakaoClient) Geocode(ctx context.Context, address string) (geo.Point, error) {
+	query := url.Values{}
+	query.Set("query", address)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.LocalBaseURL+"/v2/local/search/address.json?"+query.Encode(), nil)
+	if err != nil {
+		return geo.Point{}, err
+	}
+	req.Header.Set("Authorization", "KakaoAK "+c.APIKey)
+
+	var resp kakaoAddressResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return geo.Point{}, err
+	}
+	if len(resp.Documents) == 0 {
+		return geo.Point{}, ErrAddressNotFound
+	}
+	return parsePoint(resp.Documents[0].Y, resp.Documents[0].X)
+}
+
+func toKakaoPoint(p geo.Point) kakaoPoint {
+	return kakaoPoint{X: p.Longitude, Y: p.Latitude}
+}
@@ -0,0 +1,129 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+)
+
+// 📝 설명: 지도 API 연동 (경유지를 순서대로 지나는 도로 경로의 거리/소요 시간/폴리라인, 지점 간 거리 행렬, 주소 → 좌표)
+// 🎯 실무 포인트: 카카오모빌리티/네이버/구글 길찾기를 같은 Client 인터페이스로 감싸 설정으로 교체
+// 경로 기하 정보는 정류장이 바뀌지 않으면 그대로이므로 CachedClient로 캐시해 API 호출량을 줄임
+// ⚠️ 주의사항: 제공자마다 한 번에 넣을 수 있는 경유지 수가 달라 초과하면 구간을 나눠 여러 번 호출
+
+// Client - 길찾기 클라이언트 (KakaoClient, NaverClient, GoogleClient, CachedClient가 구현)
+type Client interface {
+	Directions(ctx context.Context, waypoints []geo.Point) (*Directions, error)
+	Matrix(ctx context.Context, points []geo.Point) (*Matrix, error)
+}
+
+// Geocoder - 주소 → 좌표 변환 (KakaoClient, NaverClient, GoogleClient, CachedGeocoder가 구현)
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (geo.Point, error)
+}
+
+// Directions - 경유지를 순서대로 지나는 경로
+type Directions struct {
+	Distance int           `json:"distance"` // 총 거리 (미터)
+	Duration time.Duration `json:"duration"` // 총 소요 시간
+	Polyline string        `json:"polyline"` // 도로 경로 (Google Encoded Polyline)
+	Legs     []Leg         `json:"legs"`     // 경유지 사이 구간 (경유지 수 - 1개)
+}
+
+// Leg - 경유지 사이 한 구간
+type Leg struct {
+	Distance int           `json:"distance"` // 거리 (미터)
+	Duration time.Duration `json:"duration"` // 소요 시간
+}
+
+// Matrix - 모든 지점 쌍 사이 구간 (Legs[i][j] = i번 지점 → j번 지점, 같은 지점은 0)
+type Matrix struct {
+	Legs [][]Leg `json:"legs"`
+}
+
+// newMatrix - n×n 빈 행렬
+func newMatrix(n int) *Matrix {
+	legs := make([][]Leg, n)
+	for i := range legs {
+		legs[i] = make([]Leg, n)
+	}
+	return &Matrix{Legs: legs}
+}
+
+var (
+	// ErrTooFewWaypoints - 출발지와 도착지 없이 경로 요청
+	ErrTooFewWaypoints = errors.New("maps: at least two waypoints are required")
+	// ErrNoRoute - 제공자가 경로를 찾지 못함 (도로가 없는 좌표 등)
+	ErrNoRoute = errors.New("maps: no route found")
+	// ErrAddressNotFound - 주소에 해당하는 좌표 없음 (오타, 건물명만 입력 등)
+	ErrAddressNotFound = errors.New("maps: address not found")
+)
+
+// section - 제공자 호출 한 번의 결과
+type section struct {
+	path []geo.Point
+	legs []Leg
+}
+
+// fetchFunc - 경유지 수 제한 안에서 제공자 API 한 번 호출
+type fetchFunc func(ctx context.Context, waypoints []geo.Point) (*section, error)
+
+// directions - maxPoints개씩 끝점을 겹쳐 나눠 호출한 뒤 하나의 경로로 합침
+func directions(ctx context.Context, waypoints []geo.Point, maxPoints int, fetch fetchFunc) (*Directions, error) {
+	if len(waypoints) < 2 {
+		return nil, ErrTooFewWaypoints
+	}
+
+	result := &Directions{Legs: make([]Leg, 0, len(waypoints)-1)}
+	var path []geo.Point
+	for start := 0; start < len(waypoints)-1; start += maxPoints - 1 {
+		end := min(start+maxPoints, len(waypoints))
+		part, err := fetch(ctx, waypoints[start:end])
+		if err != nil {
+			return nil, err
+		}
+		if len(part.legs) != end-start-1 {
+			return nil, fmt.Errorf("maps: expected %d legs, got %d", end-start-1, len(part.legs))
+		}
+
+		if len(path) > 0 && len(part.path) > 0 {
+			part.path = part.path[1:] // 앞 구간의 도착점과 같은 점
+		}
+		path = append(path, part.path...)
+		for _, leg := range part.legs {
+			result.Distance += leg.Distance
+			result.Duration += leg.Duration
+			result.Legs = append(result.Legs, leg)
+		}
+	}
+	result.Polyline = geo.EncodePolyline(path)
+	return result, nil
+}
+
+// pairwiseMatrix - 거리 행렬 API가 없는 제공자용, 지점 쌍마다 길찾기 한 번씩 호출 (n×(n-1)회)
+func pairwiseMatrix(ctx context.Context, points []geo.Point, fetch fetchFunc) (*Matrix, error) {
+	if len(points) < 2 {
+		return nil, ErrTooFewWaypoints
+	}
+
+	matrix := newMatrix(len(points))
+	for i := range points {
+		for j := range points {
+			if i == j {
+				continue
+			}
+			part, err := fetch(ctx, []geo.Point{points[i], points[j]})
+			if err != nil {
+				return nil, err
+			}
+			if len(part.legs) != 1 {
+				return nil, fmt.Errorf("maps: expected 1 leg, got %d", len(part.legs))
+			}
+			matrix.Legs[i][j] = part.legs[0]
+		}
+	}
+	return matrix, nil
+}
@@ -0,0 +1,145 @@
+package maps
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+)
+
+// 📝 설명: 네이버 클라우드 Directions 5 길찾기 (GET /map-direction/v1/driving), Geocoding (GET /map-geocode/v2/geocode)
+// ⚠️ 주의사항: 경유지는 최대 5개 (출발/도착 포함 7개), 좌표는 "경도,위도" 순서
+// 구간별 거리/시간은 summary.waypoints(직전 지점부터 각 경유지까지)와 전체 합계의 차이로 계산
+
+// NaverBaseURL - 네이버 클라우드 지도 API 기본 주소
+const NaverBaseURL = "https://naveropenapi.apigw.ntruss.com"
+
+// naverMaxPoints - 한 번에 요청할 수 있는 좌표 수 (출발 + 경유 5 + 도착)
+const naverMaxPoints = 7
+
+// NaverClient - 네이버 Directions 5 클라이언트
+type NaverClient struct {
+	ClientID     string // X-NCP-APIGW-API-KEY-ID
+	ClientSecret string // X-NCP-APIGW-API-KEY
+	BaseURL      string
+	HTTPClient   *http.Client
+}
+
+// NewNaverClient - 네이버 Directions 클라이언트 생성
+// 사용 예: client := maps.NewNaverClient(cfg.Maps.ClientID, cfg.Maps.APIKey, cfg.Maps.Timeout)
+func NewNaverClient(clientID, clientSecret string, timeout time.Duration) *NaverClient {
+	return &NaverClient{ClientID: clientID, ClientSecret: clientSecret, BaseURL: NaverBaseURL, HTTPClient: newHTTPClient(timeout)}
+}
+
+type naverSummary struct {
+	Distance  int `json:"distance"` // 미터
+	Duration  int `json:"duration"` // 밀리초
+	Waypoints []struct {
+		Distance int `json:"distance"` // 직전 지점부터 미터
+		Duration int `json:"duration"` // 직전 지점부터 밀리초
+	} `json:"waypoints"`
+}
+
+type naverResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Route   struct {
+		Traoptimal []struct {
+			Summary naverSummary `json:"summary"`
+			Path    [][]float64  `json:"path"` // [경도, 위도]
+		} `json:"traoptimal"`
+	} `json:"route"`
+}
+
+// Directions - 경유지 순서대로 경로 조회
+func (c *NaverClient) Directions(ctx context.Context, waypoints []geo.Point) (*Directions, error) {
+	return directions(ctx, waypoints, naverMaxPoints, c.fetch)
+}
+
+// Matrix - 지점 쌍마다 길찾기를 호출해 거리 행렬 구성 (거리 행렬 API 없음)
+func (c *NaverClient) Matrix(ctx context.Context, points []geo.Point) (*Matrix, error) {
+	return pairwiseMatrix(ctx, points, c.fetch)
+}
+
+// fetch - API 한 번 호출
+func (c *NaverClient) fetch(ctx context.Context, waypoints []geo.Point) (*section, error) {
+	query := url.Values{}
+	query.Set("start", naverPoint(waypoints[0]))
+	query.Set("goal", naverPoint(waypoints[len(waypoints)-1]))
+	if len(waypoints) > 2 {
+		via := make([]string, 0, len(waypoints)-2)
+		for _, p := range waypoints[1 : len(waypoints)-1] {
+			via = append(via, naverPoint(p))
+		}
+		query.Set("waypoints", strings.Join(via, "|"))
+	}
+	query.Set("option", "traoptimal")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/map-direction/v1/driving?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-NCP-APIGW-API-KEY-ID", c.ClientID)
+	req.Header.Set("X-NCP-APIGW-API-KEY", c.ClientSecret)
+
+	var resp naverResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 || len(resp.Route.Traoptimal) == 0 {
+		return nil, ErrNoRoute
+	}
+	route := resp.Route.Traoptimal[0]
+
+	result := &section{}
+	remainingDistance, remainingDuration := route.Summary.Distance, route.Summary.Duration
+	for _, w := range route.Summary.Waypoints {
+		result.legs = append(result.legs, Leg{Distance: w.Distance, Duration: time.Duration(w.Duration) * time.Millisecond})
+		remainingDistance -= w.Distance
+		remainingDuration -= w.Duration
+	}
+	result.legs = append(result.legs, Leg{Distance: remainingDistance, Duration: time.Duration(remainingDuration) * time.Millisecond})
+	for _, p := range route.Path {
+		if len(p) >= 2 {
+			result.path = append(result.path, geo.Point{Latitude: p[1], Longitude: p[0]})
+		}
+	}
+	return result, nil
+}
+
+type naverGeocodeResponse struct {
+	Status    string `json:"status"`
+	Addresses []struct {
+		X string `json:"x"` // 경도
+		Y string `json:"y"` // 위도
+	} `json:"addresses"`
+}
+
+// Geocode - 주소 검색 결과 첫 번째 좌표
+func (c *NaverClient) Geocode(ctx context.Context, address string) (geo.Point, error) {
+	query := url.Values{}
+	query.Set("query", address)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/map-geocode/v2/geocode?"+query.Encode(), nil)
+	if err != nil {
+		return geo.Point{}, err
+	}
+	req.Header.Set("X-NCP-APIGW-API-KEY-ID", c.ClientID)
+	req.Header.Set("X-NCP-APIGW-API-KEY", c.ClientSecret)
+
+	var resp naverGeocodeResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return geo.Point{}, err
+	}
+	if len(resp.Addresses) == 0 {
+		return geo.Point{}, ErrAddressNotFound
+	}
+	return parsePoint(resp.Addresses[0].Y, resp.Addresses[0].X)
+}
+
+func naverPoint(p geo.Point) string {
+	return strconv.FormatFloat(p.Longitude, 'f', -1, 64) + "," + strconv.FormatFloat(p.Latitude, 'f', -1, 64)
+}
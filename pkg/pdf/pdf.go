@@ -0,0 +1,316 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf16"
+)
+
+// 📝 설명: 표 형식 PDF 문서 쓰기 (제목 + 정보 줄 + 표 + 요약 줄, A4 가로)
+// 🎯 실무 포인트: 한글은 PDF 뷰어 내장 CJK 글꼴(HYGoThic-Medium, Adobe-Korea1)로 표기 → 글꼴 파일을 포함하지 않아 용량이 작음
+// 페이지마다 제목/정보/표 머리글을 반복하고 아래에 "쪽 / 전체 쪽"을 표시
+// ⚠️ 주의사항: 글자 폭은 반각(ASCII) 0.5em, 그 외 1em으로 어림 → 칸보다 긴 값은 "…"로 자름 (줄바꿈 없음)
+// 기본 다국어 평면(BMP) 밖 문자(이모지 등)는 "?"로 표기
+
+// 페이지 배치 (단위: pt, A4 가로)
+const (
+	pageWidth    = 842.0
+	pageHeight   = 595.0
+	pageMargin   = 36.0
+	titleSize    = 16.0
+	textSize     = 9.0
+	lineHeight   = 14.0
+	headerHeight = 20.0
+	rowHeight    = 18.0
+	cellPadding  = 4.0
+	footerSize   = 8.0
+)
+
+// fontName - 뷰어 내장 한글 고딕 글꼴
+const fontName = "HYGoThic-Medium"
+
+// 객체 번호 (1 카탈로그, 2 페이지 목록, 3~5 글꼴, 6 문서 정보, 7부터 쪽마다 페이지 + 내용 스트림)
+const (
+	infoObject      = 6
+	firstPageObject = 7
+)
+
+// Align - 칸 안 글자 정렬
+type Align int
+
+const (
+	AlignLeft   Align = iota // 왼쪽 (기본)
+	AlignCenter              // 가운데
+	AlignRight               // 오른쪽 (숫자)
+)
+
+// Column - 표의 열
+type Column struct {
+	Header string  // 머리글
+	Width  float64 // 상대 너비 (전체 열 합계 대비 비율로 표 너비를 나눔)
+	Align  Align   // 값 정렬 (머리글은 항상 가운데)
+}
+
+// Document - 내보낼 표 문서
+type Document struct {
+	Title   string     // 제목 (문서 정보의 제목으로도 사용)
+	Info    []string   // 제목 아래 정보 줄 (대상, 기간 등)
+	Columns []Column   // 표 열
+	Rows    [][]string // 표 행 (열보다 많은 칸은 무시)
+	Summary []string   // 표 아래 요약 줄 (마지막 쪽)
+}
+
+// Write - 표 문서를 PDF로 생성
+func Write(doc Document) ([]byte, error) {
+	if len(doc.Columns) == 0 {
+		return nil, errors.New("pdf: document has no columns")
+	}
+
+	pages := paginate(doc)
+	w := &writer{}
+	w.object("<< /Type /Catalog /Pages 2 0 R >>")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObject+2*i)
+	}
+	w.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %g %g] >>", strings.Join(kids, " "), len(pages), pageWidth, pageHeight))
+	w.object("<< /Type /Font /Subtype /Type0 /BaseFont /" + fontName + "-UniKS-UCS2-H /Encoding /UniKS-UCS2-H /DescendantFonts [4 0 R] >>")
+	w.object("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /" + fontName +
+		" /CIDSystemInfo << /Registry (Adobe) /Ordering (Korea1) /Supplement 1 >> /FontDescriptor 5 0 R /DW 1000 /W [1 95 500] >>")
+	w.object("<< /Type /FontDescriptor /FontName /" + fontName +
+		" /Flags 4 /FontBBox [0 -148 1001 880] /ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>")
+	w.object("<< /Title " + hexText(doc.Title, true) + " >>")
+
+	for i, p := range pages {
+		content, err := deflate(renderPage(doc, p, i+1, len(pages)))
+		if err != nil {
+			return nil, err
+		}
+		w.object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", firstPageObject+2*i+1))
+		w.stream(content)
+	}
+	return w.finish(infoObject), nil
+}
+
+// page - 한 쪽에 들어갈 행 범위와 요약 표시 여부
+type page struct {
+	rows    [][]string
+	table   bool // 표 머리글 표시 (요약만 넘어간 쪽은 false)
+	summary bool
+}
+
+// paginate - 행을 쪽별로 나눔 (요약 줄이 마지막 쪽에 들어가지 않으면 쪽 추가)
+func paginate(doc Document) []page {
+	available := tableTop(doc) - headerHeight - (pageMargin + lineHeight)
+	perPage := max(int(available/rowHeight), 1)
+	summaryRows := int(math.Ceil((float64(len(doc.Summary))*lineHeight + cellPadding) / rowHeight))
+
+	var pages []page
+	rows := doc.Rows
+	for len(rows) > perPage {
+		pages = append(pages, page{rows: rows[:perPage], table: true})
+		rows = rows[perPage:]
+	}
+	last := page{rows: rows, table: true, summary: true}
+	if len(doc.Summary) > 0 && len(rows)+summaryRows > perPage {
+		pages = append(pages, page{rows: rows, table: true})
+		last = page{summary: true}
+	}
+	return append(pages, last)
+}
+
+// tableTop - 표 윗변 y 좌표 (제목과 정보 줄 아래)
+func tableTop(doc Document) float64 {
+	return pageHeight - pageMargin - titleSize - cellPadding - float64(len(doc.Info))*lineHeight - lineHeight/2
+}
+
+// renderPage - 한 쪽의 내용 스트림
+func renderPage(doc Document, p page, number, total int) []byte {
+	var b strings.Builder
+	b.WriteString("0.5 w\n")
+
+	y := pageHeight - pageMargin - titleSize
+	drawText(&b, doc.Title, pageMargin, y, titleSize)
+	y -= cellPadding
+	for _, line := range doc.Info {
+		y -= lineHeight
+		drawText(&b, line, pageMargin, y, textSize)
+	}
+
+	widths := columnWidths(doc.Columns)
+	y = tableTop(doc)
+	if p.table {
+		y -= headerHeight
+		x := pageMargin
+		for i, column := range doc.Columns {
+			fmt.Fprintf(&b, "0.92 g %.2f %.2f %.2f %.2f re f 0 g\n", x, y, widths[i], headerHeight)
+			drawCell(&b, column.Header, AlignCenter, x, y, widths[i], headerHeight)
+			x += widths[i]
+		}
+		for _, row := range p.rows {
+			y -= rowHeight
+			x = pageMargin
+			for i, column := range doc.Columns {
+				cell := ""
+				if i < len(row) {
+					cell = row[i]
+				}
+				drawCell(&b, cell, column.Align, x, y, widths[i], rowHeight)
+				x += widths[i]
+			}
+		}
+	}
+
+	if p.summary {
+		y -= cellPadding
+		for _, line := range doc.Summary {
+			y -= lineHeight
+			drawText(&b, line, pageMargin, y, textSize)
+		}
+	}
+
+	footer := fmt.Sprintf("%d / %d", number, total)
+	drawText(&b, footer, (pageWidth-textWidth(footer, footerSize))/2, pageMargin/2, footerSize)
+	return []byte(b.String())
+}
+
+// columnWidths - 상대 너비를 표 너비(여백 제외)에 맞춤 (너비가 0 이하인 열은 1로 취급)
+func columnWidths(columns []Column) []float64 {
+	total := 0.0
+	for _, column := range columns {
+		total += max(column.Width, 1)
+	}
+	widths := make([]float64, len(columns))
+	for i, column := range columns {
+		widths[i] = (pageWidth - 2*pageMargin) * max(column.Width, 1) / total
+	}
+	return widths
+}
+
+// drawCell - 테두리와 정렬된 값 (칸보다 길면 자름)
+func drawCell(b *strings.Builder, text string, align Align, x, y, width, height float64) {
+	fmt.Fprintf(b, "%.2f %.2f %.2f %.2f re S\n", x, y, width, height)
+	text = fit(text, width-2*cellPadding, textSize)
+	if text == "" {
+		return
+	}
+	tx := x + cellPadding
+	switch align {
+	case AlignCenter:
+		tx = x + (width-textWidth(text, textSize))/2
+	case AlignRight:
+		tx = x + width - cellPadding - textWidth(text, textSize)
+	}
+	drawText(b, text, tx, y+(height-textSize)/2+textSize*0.15, textSize)
+}
+
+// drawText - 기준선 (x, y)에 한 줄 표시
+func drawText(b *strings.Builder, text string, x, y, size float64) {
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(b, "BT /F1 %g Tf %.2f %.2f Td %s Tj ET\n", size, x, y, hexText(text, false))
+}
+
+// fit - 폭 안에 들어가도록 뒤를 "…"로 자름
+func fit(text string, width, size float64) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if textWidth(text, size) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if candidate := string(runes) + "…"; textWidth(candidate, size) <= width {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// textWidth - 어림 글자 폭 (반각 0.5em, 전각 1em)
+func textWidth(text string, size float64) float64 {
+	width := 0.0
+	for _, r := range text {
+		if r < 0x80 {
+			width += 0.5
+		} else {
+			width++
+		}
+	}
+	return width * size
+}
+
+// hexText - UCS-2(UTF-16BE) 16진 문자열 (문서 정보 문자열은 BOM을 붙임)
+func hexText(text string, bom bool) string {
+	var b strings.Builder
+	b.WriteString("<")
+	if bom {
+		b.WriteString("FEFF")
+	}
+	for _, r := range text {
+		if r > 0xFFFF || utf16.IsSurrogate(r) {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+// deflate - 내용 스트림 압축 (FlateDecode)
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writer - 객체를 순서대로 쓰고 교차 참조표(xref)용 위치를 기록
+type writer struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+// object - 다음 번호의 객체 추가
+func (w *writer) object(body string) {
+	w.begin()
+	fmt.Fprintf(&w.buf, "%s\nendobj\n", body)
+}
+
+// stream - 다음 번호의 압축 스트림 객체 추가
+func (w *writer) stream(data []byte) {
+	w.begin()
+	fmt.Fprintf(&w.buf, "<< /Length %d /Filter /FlateDecode >>\nstream\n", len(data))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+}
+
+// begin - 객체 시작 위치 기록
+func (w *writer) begin() {
+	if w.buf.Len() == 0 {
+		w.buf.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+	}
+	w.offsets = append(w.offsets, w.buf.Len())
+	fmt.Fprintf(&w.buf, "%d 0 obj\n", len(w.offsets))
+}
+
+// finish - 교차 참조표와 트레일러
+func (w *writer) finish(info int) []byte {
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, offset := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets)+1, info, xref)
+	return w.buf.Bytes()
+}
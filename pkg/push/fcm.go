@@ -0,0 +1,217 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hyeokjun/eodini/pkg/tracing"
+)
+
+// 📝 설명: Firebase Cloud Messaging HTTP v1 발송 (POST /v1/projects/{project}/messages:send)
+// 🎯 실무 포인트: 서비스 계정 키로 서명한 JWT를 OAuth2 액세스 토큰으로 교환해 만료 전까지 재사용
+// ⚠️ 주의사항: UNREGISTERED/SENDER_ID_MISMATCH/INVALID_ARGUMENT 응답은 토큰 문제로 보고 ErrInvalidToken 반환
+// (INVALID_ARGUMENT는 메시지 형식 오류일 수도 있지만 Message는 제목/본문/문자열 데이터만 보내므로 토큰 오류로 간주)
+
+// FCMBaseURL - FCM HTTP v1 API 기본 주소
+const FCMBaseURL = "https://fcm.googleapis.com"
+
+// fcmScope - FCM 발송 권한 범위
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+// fcmTokenMargin - 액세스 토큰 만료 전 미리 갱신하는 여유 시간
+const fcmTokenMargin = time.Minute
+
+// maxErrorBody - 에러 메시지에 담는 응답 본문 최대 길이
+const maxErrorBody = 512
+
+// fcmInvalidTokenCodes - 토큰을 더 이상 쓸 수 없다는 FCM 오류 코드
+var fcmInvalidTokenCodes = map[string]bool{
+	"UNREGISTERED":       true, // 앱 삭제, 토큰 만료
+	"SENDER_ID_MISMATCH": true, // 다른 Firebase 프로젝트의 토큰
+	"INVALID_ARGUMENT":   true, // 형식이 잘못된 토큰
+}
+
+// ServiceAccount - Firebase 콘솔에서 받은 서비스 계정 키(JSON)의 필요한 항목
+type ServiceAccount struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"` // PEM
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMClient - FCM 발송 클라이언트
+type FCMClient struct {
+	ProjectID  string
+	BaseURL    string
+	HTTPClient *http.Client
+
+	clientEmail string
+	privateKey  *rsa.PrivateKey
+	tokenURI    string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMClient - 서비스 계정 키(JSON)로 FCM 클라이언트 생성 (timeout이 0이면 10초)
+// 사용 예: client, err := push.NewFCMClient(credentials, cfg.Push.Timeout)
+func NewFCMClient(credentials []byte, timeout time.Duration) (*FCMClient, error) {
+	var account ServiceAccount
+	if err := json.Unmarshal(credentials, &account); err != nil {
+		return nil, fmt.Errorf("push: invalid service account: %w", err)
+	}
+	if account.ProjectID == "" || account.ClientEmail == "" || account.TokenURI == "" {
+		return nil, errors.New("push: service account requires project_id, client_email and token_uri")
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("push: invalid service account private key: %w", err)
+	}
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &FCMClient{
+		ProjectID:   account.ProjectID,
+		BaseURL:     FCMBaseURL,
+		HTTPClient:  &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)},
+		clientEmail: account.ClientEmail,
+		privateKey:  key,
+		tokenURI:    account.TokenURI,
+	}, nil
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// Send - 기기 하나에 알림 발송
+func (c *FCMClient) Send(ctx context.Context, token string, msg *Message) error {
+	accessToken, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(fcmRequest{Message: fcmMessage{
+		Token:        token,
+		Notification: fcmNotification{Title: msg.Title, Body: msg.Body},
+		Data:         msg.Data,
+	}})
+	if err != nil {
+		return err
+	}
+	endpoint := c.BaseURL + "/v1/projects/" + url.PathEscape(c.ProjectID) + "/messages:send"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("push: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	var fcmErr fcmErrorResponse
+	if err := json.Unmarshal(body, &fcmErr); err == nil {
+		for _, detail := range fcmErr.Error.Details {
+			if fcmInvalidTokenCodes[detail.ErrorCode] {
+				return fmt.Errorf("%w: %s", ErrInvalidToken, detail.ErrorCode)
+			}
+		}
+	}
+	return fmt.Errorf("push: unexpected status %d: %s", resp.StatusCode, body)
+}
+
+type fcmTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"` // 초
+}
+
+// token - 유효한 액세스 토큰 (만료 1분 전이면 새로 발급)
+func (c *FCMClient) token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.accessToken != "" && now.Add(fcmTokenMargin).Before(c.expiresAt) {
+		return c.accessToken, nil
+	}
+
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   c.clientEmail,
+		"scope": fcmScope,
+		"aud":   c.tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(c.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("push: failed to sign token request: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("push: token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return "", fmt.Errorf("push: token request status %d: %s", resp.StatusCode, body)
+	}
+	var token fcmTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("push: invalid token response: %w", err)
+	}
+
+	c.accessToken = token.AccessToken
+	c.expiresAt = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	return c.accessToken, nil
+}
@@ -0,0 +1,25 @@
+package push
+
+import (
+	"context"
+	"errors"
+)
+
+// 📝 설명: 모바일 앱 푸시 알림 발송 (기기 토큰 단위)
+// 🎯 실무 포인트: 제공자(FCM)를 Sender 인터페이스로 감싸 서비스는 발송 결과만 판단
+// ⚠️ 주의사항: 앱 삭제/재설치로 무효가 된 토큰은 ErrInvalidToken → 호출 측에서 저장된 토큰 정리
+
+// Sender - 푸시 발송 (FCMClient가 구현)
+type Sender interface {
+	Send(ctx context.Context, token string, msg *Message) error
+}
+
+// Message - 푸시 알림 내용
+type Message struct {
+	Title string            `json:"title"`
+	Body  string            `json:"body"`
+	Data  map[string]string `json:"data,omitempty"` // 앱이 화면 이동 등에 쓰는 부가 정보 (예: trip_id)
+}
+
+// ErrInvalidToken - 제공자가 더 이상 유효하지 않다고 응답한 기기 토큰 (앱 삭제, 다른 프로젝트 토큰 등)
+var ErrInvalidToken = errors.New("push: invalid device token")
@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// 📝 설명: Redis 슬라이딩 윈도우 요청 제한
+// 🎯 실무 포인트: 키별 ZSET에 요청 시각(ms)을 기록하고 윈도우 밖 기록은 지움 → 경계 시점 몰림 없이 정확히 제한
+// Lua 스크립트로 조회/추가를 원자적으로 처리해 여러 서버 인스턴스가 같은 한도를 공유
+// ⚠️ 주의사항: 한도만큼 ZSET 원소가 쌓이므로 한도가 매우 큰 규칙(수만 건 이상)에는 고정 윈도우 카운터가 적합
+
+// keyPrefix - 요청 제한 키 접두사
+const keyPrefix = "ratelimit:"
+
+// Result - 요청 허용 여부
+type Result struct {
+	Allowed    bool          // 허용 여부
+	Remaining  int           // 윈도우 안에서 남은 요청 수
+	RetryAfter time.Duration // 거부 시 다음 요청이 가능해지기까지 남은 시간
+}
+
+// slidingWindowScript - KEYS[1]=키, ARGV=현재 시각(ms), 윈도우(ms), 한도, 원소 ID
+// 반환: {허용(1/0), 남은 요청 수, 재시도까지 남은 ms}
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	return {1, limit - count - 1, 0}
+end
+
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+local retry = window
+if oldest[2] then
+	retry = tonumber(oldest[2]) + window - now
+end
+return {0, 0, retry}
+`)
+
+// RedisLimiter - Redis 기반 요청 제한기
+type RedisLimiter struct {
+	rdb *redis.Client
+}
+
+// NewRedisLimiter - 요청 제한기 생성
+// 사용 예: limiter := ratelimit.NewRedisLimiter(rdb)
+func NewRedisLimiter(rdb *redis.Client) *RedisLimiter {
+	return &RedisLimiter{rdb: rdb}
+}
+
+// Allow - key의 window 안 요청이 limit 미만이면 허용하고 기록
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	now := time.Now().UnixMilli()
+	values, err := slidingWindowScript.Run(ctx, l.rdb, []string{keyPrefix + key},
+		now, window.Milliseconds(), limit, strconv.FormatInt(now, 10)+"-"+uuid.NewString()).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
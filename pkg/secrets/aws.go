@@ -0,0 +1,145 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+)
+
+// 📝 설명: AWS Secrets Manager 조회 (GetSecretValue, SigV4 서명)
+// 🎯 실무 포인트: 비밀 문자열(SecretString)에 JSON 객체로 여러 값을 담아 한 번에 조회
+// ⚠️ 주의사항: IAM 정책은 해당 비밀의 secretsmanager:GetSecretValue만 허용
+// 바이너리 비밀(SecretBinary)은 지원하지 않음
+
+// AWSClient - Secrets Manager 조회 클라이언트
+type AWSClient struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SecretID        string
+	BaseURL         string // 비어 있으면 https://secretsmanager.{region}.amazonaws.com
+	HTTPClient      *http.Client
+	Now             func() time.Time // 서명 시각 (테스트용)
+}
+
+// NewAWSClient - Secrets Manager 클라이언트 생성
+// 사용 예: client := secrets.NewAWSClient("ap-northeast-2", accessKeyID, secretAccessKey, "eodini/prod", 10*time.Second)
+func NewAWSClient(region, accessKeyID, secretAccessKey, secretID string, timeout time.Duration) *AWSClient {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &AWSClient{
+		Region:          region,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SecretID:        secretID,
+		BaseURL:         "https://secretsmanager." + region + ".amazonaws.com",
+		HTTPClient:      &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)},
+		Now:             time.Now,
+	}
+}
+
+type awsGetSecretValueResponse struct {
+	SecretString *string `json:"SecretString"`
+}
+
+type awsErrorResponse struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+// Fetch - 비밀 문자열(JSON 객체) 조회
+func (c *AWSClient) Fetch(ctx context.Context) (map[string]string, error) {
+	raw, err := json.Marshal(map[string]string{"SecretId": c.SecretID})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/", bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	c.sign(req, raw)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("secrets: secrets manager request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		var awsErr awsErrorResponse
+		if json.Unmarshal(errBody, &awsErr) == nil && strings.HasSuffix(awsErr.Type, "ResourceNotFoundException") {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("secrets: secrets manager unexpected status %d: %s", resp.StatusCode, errBody)
+	}
+
+	var body awsGetSecretValueResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("secrets: invalid secrets manager response: %w", err)
+	}
+	if body.SecretString == nil {
+		return nil, fmt.Errorf("secrets: secret %s has no SecretString", c.SecretID)
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(*body.SecretString), &values); err != nil {
+		return nil, fmt.Errorf("secrets: secret %s must be a JSON object", c.SecretID)
+	}
+	return stringValues(values)
+}
+
+// sign - AWS Signature Version 4 (서명 헤더: content-type, host, x-amz-date, x-amz-target)
+func (c *AWSClient) sign(req *http.Request, payload []byte) {
+	now := c.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	const signedHeaders = "content-type;host;x-amz-date;x-amz-target"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"content-type:" + req.Header.Get("Content-Type") + "\n" +
+			"host:" + req.URL.Host + "\n" +
+			"x-amz-date:" + amzDate + "\n" +
+			"x-amz-target:" + req.Header.Get("X-Amz-Target") + "\n",
+		signedHeaders,
+		hashHex(payload),
+	}, "\n")
+
+	scope := date + "/" + c.Region + "/secretsmanager/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+c.SecretAccessKey), date)
+	key = hmacSHA256(key, c.Region)
+	key = hmacSHA256(key, "secretsmanager")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+c.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
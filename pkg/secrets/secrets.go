@@ -0,0 +1,79 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 📝 설명: 비밀 값 조회 (Vault KV / AWS Secrets Manager)
+// 🎯 실무 포인트: DB 비밀번호, JWT 서명 키, 외부 API 키를 평문 환경변수 대신 비밀 저장소에서 시작 시 조회
+// 비밀 하나에 "환경변수 이름 → 값"을 모아 두면 (예: {"DB_PASSWORD": "...", "JWT_SECRET": "..."}) config가 환경변수처럼 사용
+// ⚠️ 주의사항: 조회한 값은 로그에 남기지 않음 (오류 메시지에도 키 이름만)
+
+// Provider 이름 (SECRETS_PROVIDER)
+const (
+	VaultProviderName = "vault"
+	AWSProviderName   = "aws-sm"
+)
+
+// maxErrorBody - 에러 메시지에 담는 응답 본문 최대 길이
+const maxErrorBody = 512
+
+// ErrNotFound - 비밀이 없음 (경로/ID 오타 또는 권한 없음)
+var ErrNotFound = errors.New("secrets: secret not found")
+
+// Provider - 비밀 저장소 (이름 → 값)
+type Provider interface {
+	Fetch(ctx context.Context) (map[string]string, error)
+}
+
+// Config - 비밀 저장소 접속 정보
+type Config struct {
+	Provider string        // vault, aws-sm
+	Timeout  time.Duration // 조회 제한 시간
+
+	VaultAddr      string // Vault 주소 (예: https://vault.internal:8200)
+	VaultToken     string // Vault 토큰
+	VaultNamespace string // Vault Enterprise 네임스페이스 (없으면 빈 값)
+	VaultPath      string // KV 경로 (v2는 "secret/data/eodini")
+
+	AWSRegion          string // AWS 리전 (예: ap-northeast-2)
+	AWSAccessKeyID     string // IAM 액세스 키
+	AWSSecretAccessKey string // IAM 시크릿 키
+	AWSSecretID        string // 비밀 이름 또는 ARN
+}
+
+// New - 설정한 저장소의 Provider 생성
+// 사용 예:
+//
+//	provider, err := secrets.New(secrets.Config{Provider: "vault", VaultAddr: addr, VaultToken: token, VaultPath: "secret/data/eodini"})
+//	values, err := provider.Fetch(ctx)
+func New(cfg Config) (Provider, error) {
+	switch cfg.Provider {
+	case VaultProviderName:
+		return NewVaultClient(cfg.VaultAddr, cfg.VaultToken, cfg.VaultNamespace, cfg.VaultPath, cfg.Timeout), nil
+	case AWSProviderName:
+		return NewAWSClient(cfg.AWSRegion, cfg.AWSAccessKeyID, cfg.AWSSecretAccessKey, cfg.AWSSecretID, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("secrets: unknown provider %q", cfg.Provider)
+	}
+}
+
+// stringValues - JSON 객체의 값을 문자열로 (숫자/불리언은 그대로 표기, 객체/배열은 거부)
+func stringValues(raw map[string]interface{}) (map[string]string, error) {
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		switch v := value.(type) {
+		case string:
+			values[key] = v
+		case float64, bool:
+			values[key] = fmt.Sprint(v)
+		case nil:
+		default:
+			return nil, fmt.Errorf("secrets: value of %s must be a string", key)
+		}
+	}
+	return values, nil
+}
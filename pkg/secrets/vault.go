@@ -0,0 +1,82 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+)
+
+// 📝 설명: HashiCorp Vault KV 조회 (GET /v1/{path}, X-Vault-Token)
+// 🎯 실무 포인트: KV v2("secret/data/eodini")와 v1("secret/eodini") 응답 모두 지원
+// ⚠️ 주의사항: 토큰은 읽기 전용 정책으로 발급 (해당 경로 read만 허용)
+
+// VaultClient - Vault KV 조회 클라이언트
+type VaultClient struct {
+	Addr       string
+	Token      string
+	Namespace  string // 비어 있으면 X-Vault-Namespace 생략
+	Path       string
+	HTTPClient *http.Client
+}
+
+// NewVaultClient - Vault 클라이언트 생성
+// 사용 예: client := secrets.NewVaultClient("https://vault.internal:8200", token, "", "secret/data/eodini", 10*time.Second)
+func NewVaultClient(addr, token, namespace, path string, timeout time.Duration) *VaultClient {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &VaultClient{
+		Addr:       strings.TrimRight(addr, "/"),
+		Token:      token,
+		Namespace:  namespace,
+		Path:       strings.Trim(path, "/"),
+		HTTPClient: &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)},
+	}
+}
+
+type vaultResponse struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// Fetch - 경로의 비밀 전체 조회 (KV v2면 data.data, v1이면 data)
+func (c *VaultClient) Fetch(ctx context.Context) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.Addr+"/v1/"+c.Path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", c.Token)
+	if c.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.Namespace)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("secrets: vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return nil, fmt.Errorf("secrets: vault unexpected status %d: %s", resp.StatusCode, errBody)
+	}
+
+	var body vaultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("secrets: invalid vault response: %w", err)
+	}
+	data := body.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, v2 := data["metadata"]; v2 {
+			data = nested
+		}
+	}
+	return stringValues(data)
+}
@@ -0,0 +1,74 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// 📝 설명: 알리고 문자 발송 (POST /send/, form 전송)
+// 🎯 실무 포인트: 소규모 기관에서 많이 쓰는 선불형 중계사, 단문/장문을 msg_type으로 구분
+// ⚠️ 주의사항: HTTP 200이어도 result_code가 1이 아니면 실패 (잔액 부족, 미등록 발신번호 등)
+
+// AligoBaseURL - 알리고 API 기본 주소
+const AligoBaseURL = "https://apis.aligo.in"
+
+// AligoClient - 알리고 발송 클라이언트
+type AligoClient struct {
+	APIKey     string // 발급받은 API 키
+	UserID     string // 알리고 계정 ID
+	Sender     string // 사전 등록된 발신번호
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewAligoClient - 알리고 클라이언트 생성
+// 사용 예: client := sms.NewAligoClient(cfg.SMS.APIKey, cfg.SMS.AccountID, cfg.SMS.SenderNumber, cfg.SMS.Timeout)
+func NewAligoClient(apiKey, userID, sender string, timeout time.Duration) *AligoClient {
+	return &AligoClient{APIKey: apiKey, UserID: userID, Sender: sender, BaseURL: AligoBaseURL, HTTPClient: newHTTPClient(timeout)}
+}
+
+type aligoResponse struct {
+	ResultCode json.Number `json:"result_code"` // 1이면 성공 (문자열/숫자 모두 옴)
+	Message    string      `json:"message"`
+}
+
+// Send - 문자 한 건 발송
+func (c *AligoClient) Send(ctx context.Context, msg *Message) error {
+	to, err := validate(msg)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{}
+	form.Set("key", c.APIKey)
+	form.Set("user_id", c.UserID)
+	form.Set("sender", c.Sender)
+	form.Set("receiver", to)
+	form.Set("msg", msg.Text)
+	if IsLong(msg.Text) {
+		form.Set("msg_type", "LMS")
+		form.Set("title", msg.Title)
+	} else {
+		form.Set("msg_type", "SMS")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/send/", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp aligoResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return err
+	}
+	if resp.ResultCode.String() != "1" {
+		return fmt.Errorf("sms: aligo result %s: %s", resp.ResultCode, resp.Message)
+	}
+	return nil
+}
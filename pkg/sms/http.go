@@ -0,0 +1,41 @@
+package sms
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+)
+
+// maxErrorBody - 에러 메시지에 담는 응답 본문 최대 길이
+const maxErrorBody = 512
+
+// newHTTPClient - 제한 시간이 있는 HTTP 클라이언트 (0이면 10초, 호출마다 span 생성 + 트레이스 전파)
+func newHTTPClient(timeout time.Duration) *http.Client {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil)}
+}
+
+// doJSON - 요청을 보내고 2xx 응답 본문을 out으로 디코딩
+func doJSON(client *http.Client, req *http.Request, out interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sms: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("sms: unexpected status %d: %s", resp.StatusCode, body)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("sms: invalid response: %w", err)
+	}
+	return nil
+}
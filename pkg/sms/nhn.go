@@ -0,0 +1,101 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// 📝 설명: NHN Cloud Notification SMS v3.0 발송 (POST /sms/v3.0/appKeys/{appKey}/sender/sms|mms)
+// 🎯 실무 포인트: 단문은 /sender/sms, 장문은 /sender/mms(제목 포함)로 나눠 호출
+// ⚠️ 주의사항: header.isSuccessful과 수신자별 resultCode를 모두 확인해야 실제 발송 요청 성공
+
+// NHNBaseURL - NHN Cloud SMS API 기본 주소
+const NHNBaseURL = "https://api-sms.cloud.toast.com"
+
+// NHNClient - NHN Cloud SMS 발송 클라이언트
+type NHNClient struct {
+	AppKey     string // 서비스 앱키
+	SecretKey  string // X-Secret-Key
+	Sender     string // 사전 등록된 발신번호
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewNHNClient - NHN Cloud SMS 클라이언트 생성
+// 사용 예: client := sms.NewNHNClient(cfg.SMS.AccountID, cfg.SMS.APIKey, cfg.SMS.SenderNumber, cfg.SMS.Timeout)
+func NewNHNClient(appKey, secretKey, sender string, timeout time.Duration) *NHNClient {
+	return &NHNClient{AppKey: appKey, SecretKey: secretKey, Sender: sender, BaseURL: NHNBaseURL, HTTPClient: newHTTPClient(timeout)}
+}
+
+type nhnRecipient struct {
+	RecipientNo string `json:"recipientNo"`
+}
+
+type nhnRequest struct {
+	Title         string         `json:"title,omitempty"`
+	Body          string         `json:"body"`
+	SendNo        string         `json:"sendNo"`
+	RecipientList []nhnRecipient `json:"recipientList"`
+}
+
+type nhnResponse struct {
+	Header struct {
+		IsSuccessful  bool   `json:"isSuccessful"`
+		ResultCode    int    `json:"resultCode"`
+		ResultMessage string `json:"resultMessage"`
+	} `json:"header"`
+	Body struct {
+		Data struct {
+			SendResultList []struct {
+				ResultCode    int    `json:"resultCode"`
+				ResultMessage string `json:"resultMessage"`
+			} `json:"sendResultList"`
+		} `json:"data"`
+	} `json:"body"`
+}
+
+// Send - 문자 한 건 발송
+func (c *NHNClient) Send(ctx context.Context, msg *Message) error {
+	to, err := validate(msg)
+	if err != nil {
+		return err
+	}
+
+	body := nhnRequest{Body: msg.Text, SendNo: c.Sender, RecipientList: []nhnRecipient{{RecipientNo: to}}}
+	path := "/sender/sms"
+	if IsLong(msg.Text) {
+		path = "/sender/mms"
+		body.Title = msg.Title
+	}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	endpoint := c.BaseURL + "/sms/v3.0/appKeys/" + url.PathEscape(c.AppKey) + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json;charset=UTF-8")
+	req.Header.Set("X-Secret-Key", c.SecretKey)
+
+	var resp nhnResponse
+	if err := doJSON(c.HTTPClient, req, &resp); err != nil {
+		return err
+	}
+	if !resp.Header.IsSuccessful {
+		return fmt.Errorf("sms: nhn result %d: %s", resp.Header.ResultCode, resp.Header.ResultMessage)
+	}
+	for _, result := range resp.Body.Data.SendResultList {
+		if result.ResultCode != 0 {
+			return fmt.Errorf("sms: nhn recipient result %d: %s", result.ResultCode, result.ResultMessage)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// 📝 설명: 문자 메시지 발송 (휴대폰 번호 단위)
+// 🎯 실무 포인트: 국내 문자 중계사(알리고, NHN Cloud)를 Sender 인터페이스로 감싸 설정으로 교체
+// 90바이트(EUC-KR 기준, 한글 2바이트)를 넘으면 장문(LMS)으로 발송
+// ⚠️ 주의사항: 발신번호는 중계사에 사전 등록된 번호만 사용 가능 (전기통신사업법)
+
+// Sender - 문자 발송 (AligoClient, NHNClient가 구현)
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Message - 문자 내용
+type Message struct {
+	To    string // 수신 번호 (하이픈 허용)
+	Title string // 장문 제목 (단문이면 무시)
+	Text  string
+}
+
+const (
+	// ShortLimit - 단문(SMS) 최대 바이트
+	ShortLimit = 90
+	// LongLimit - 장문(LMS) 최대 바이트
+	LongLimit = 2000
+)
+
+var (
+	// ErrInvalidNumber - 숫자가 아니거나 길이가 맞지 않는 수신 번호
+	ErrInvalidNumber = errors.New("sms: invalid phone number")
+	// ErrTooLong - 장문 한도를 넘는 내용
+	ErrTooLong = errors.New("sms: message exceeds 2000 bytes")
+)
+
+// ByteLength - 통신사 과금 기준 길이 (ASCII 1바이트, 그 외 2바이트)
+func ByteLength(text string) int {
+	length := 0
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			length++
+		} else {
+			length += 2
+		}
+	}
+	return length
+}
+
+// IsLong - 장문(LMS)으로 보내야 하는지
+func IsLong(text string) bool {
+	return ByteLength(text) > ShortLimit
+}
+
+// NormalizeNumber - 하이픈/공백 제거 후 국내 번호 형식 확인 (예: "010-1234-5678" → "01012345678")
+func NormalizeNumber(number string) (string, error) {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, number)
+	if len(normalized) < 8 || len(normalized) > 12 {
+		return "", ErrInvalidNumber
+	}
+	for _, r := range normalized {
+		if r < '0' || r > '9' {
+			return "", ErrInvalidNumber
+		}
+	}
+	return normalized, nil
+}
+
+// validate - 발송 전 공통 검증 (정규화한 수신 번호 반환)
+func validate(msg *Message) (string, error) {
+	to, err := NormalizeNumber(msg.To)
+	if err != nil {
+		return "", err
+	}
+	if ByteLength(msg.Text) > LongLimit {
+		return "", ErrTooLong
+	}
+	return to, nil
+}
@@ -0,0 +1,61 @@
+package startup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/logger"
+)
+
+// 📝 설명: 시작 시 의존 서비스(DB, Redis, 마이그레이션) 준비를 기다리는 재시도 루프
+// 🎯 실무 포인트: DB/Redis가 파드보다 늦게 뜨는 롤아웃에서도 바로 죽지 않고 지수 백오프로 재시도
+// 모든 준비가 끝난 뒤에 HTTP 리스너를 열기 때문에 준비 안 된 파드로 트래픽이 가지 않음
+// ⚠️ 주의사항: ctx 기한(전체 대기 한도)을 넘기면 오류 반환 → 호출 측이 종료해서 Kubernetes가 재시작
+// 대기 시간이 liveness probe 한도보다 길면 재시작이 반복되므로 startupProbe로 여유를 둘 것
+
+// Options - 재시도 간격 설정
+type Options struct {
+	Backoff    time.Duration // 첫 재시도 간격 (실패할 때마다 두 배)
+	MaxBackoff time.Duration // 재시도 간격 상한
+}
+
+// Wait - check가 성공할 때까지 재시도 (ctx가 끝나면 마지막 오류와 함께 실패)
+// 여러 의존성을 같은 기한의 ctx로 기다리면 전체 시작 시간 한도가 됨
+// 사용 예:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+//	defer cancel()
+//	err := startup.Wait(ctx, "redis", opts, func(ctx context.Context) error { return rdb.Ping(ctx).Err() })
+func Wait(ctx context.Context, name string, opts Options, check func(ctx context.Context) error) error {
+	backoff := opts.Backoff
+	for attempt := 1; ; attempt++ {
+		err := check(ctx)
+		if err == nil {
+			if attempt > 1 {
+				logger.Info("Dependency ready", map[string]interface{}{"dependency": name, "attempts": attempt})
+			}
+			return nil
+		}
+
+		logger.Warn("Dependency not ready, retrying", map[string]interface{}{
+			"dependency": name,
+			"attempt":    attempt,
+			"retry_in":   backoff.String(),
+			"error":      err.Error(),
+		})
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%s not ready after %d attempts: %w", name, attempt, err)
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > opts.MaxBackoff {
+			backoff = opts.MaxBackoff
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package tabular
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// 📝 설명: 업로드한 표 파일(CSV, Excel .xlsx) 읽기
+// 🎯 실무 포인트: 엑셀에서 "CSV UTF-8"로 저장한 파일(BOM 포함)과 .xlsx 첫 번째 시트를 같은 행 목록으로 변환
+// 행 번호는 파일 기준(첫 행 = 1)이라 오류 보고에 그대로 쓸 수 있음, 모든 칸이 빈 행은 건너뜀
+// ⚠️ 주의사항: 구형 .xls(바이너리)와 UTF-8이 아닌 CSV(EUC-KR 등)는 지원하지 않음
+
+var (
+	// ErrUnsupportedFormat - .csv/.xlsx가 아닌 파일
+	ErrUnsupportedFormat = errors.New("tabular: unsupported file format")
+	// ErrNotUTF8 - UTF-8이 아닌 CSV (엑셀 기본 "CSV" 저장은 EUC-KR)
+	ErrNotUTF8 = errors.New("tabular: csv is not utf-8 encoded")
+	// ErrMalformed - 파일 구조를 해석할 수 없음
+	ErrMalformed = errors.New("tabular: malformed file")
+)
+
+// utf8BOM - 엑셀 "CSV UTF-8" 저장 시 파일 앞에 붙는 BOM
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Row - 파일의 한 행
+type Row struct {
+	Number int      // 파일 기준 행 번호 (1부터)
+	Cells  []string // 칸 값 (앞뒤 공백 제거)
+}
+
+// Cell - i번째 칸 값 (칸이 없으면 빈 문자열)
+func (r Row) Cell(i int) string {
+	if i < 0 || i >= len(r.Cells) {
+		return ""
+	}
+	return r.Cells[i]
+}
+
+// Read - 파일 이름의 확장자로 형식을 판단해 행 목록으로 변환
+func Read(filename string, data []byte) ([]Row, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return ReadCSV(data)
+	case ".xlsx":
+		return ReadXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ReadCSV - CSV 읽기 (UTF-8 BOM 제거, 행마다 칸 수가 달라도 허용)
+func ReadCSV(data []byte) ([]Row, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if !utf8.Valid(data) {
+		return nil, ErrNotUTF8
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+		}
+		line, _ := reader.FieldPos(0)
+		rows = appendRow(rows, line, record)
+	}
+	return rows, nil
+}
+
+// appendRow - 칸 값을 다듬어 행 추가 (모든 칸이 비었으면 건너뜀)
+func appendRow(rows []Row, number int, cells []string) []Row {
+	empty := true
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+		if cells[i] != "" {
+			empty = false
+		}
+	}
+	if empty {
+		return rows
+	}
+	return append(rows, Row{Number: number, Cells: cells})
+}
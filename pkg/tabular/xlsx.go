@@ -0,0 +1,210 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// 📝 설명: Excel .xlsx 첫 번째 시트 읽기 (ZIP 안의 workbook, 관계, 공유 문자열, 시트 XML만 해석)
+// 🎯 실무 포인트: 외부 라이브러리 없이 값만 읽는 최소 구현 (수식은 저장된 결과값, 서식/병합/날짜 서식은 무시)
+// 숫자 칸은 저장된 값을 가장 짧은 십진 표기로 바꿈 (예: 37.566499999999998 → 37.5665)
+// ⚠️ 주의사항: 숫자로 입력된 연락처는 앞자리 0이 빠진 채 저장되므로 연락처 칸은 "텍스트" 서식 권장
+
+// maxXLSXPart - 압축 해제 후 XML 파트 최대 크기 (압축 폭탄 방지)
+const maxXLSXPart = 32 << 20
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+// String - 서식 구간(r)으로 나뉜 문자열은 이어 붙임 (발음 표기 rPh는 제외)
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX - .xlsx 첫 번째 시트 읽기
+func ReadXLSX(data []byte) ([]Row, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	parts := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		parts[file.Name] = file
+	}
+
+	sheetPath, err := firstSheetPath(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if _, ok := parts["xl/sharedStrings.xml"]; ok {
+		if err := decodePart(parts, "xl/sharedStrings.xml", &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	var sheet xlsxSheet
+	if err := decodePart(parts, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([]Row, 0, len(sheet.Rows))
+	number := 0
+	for _, row := range sheet.Rows {
+		number++
+		if row.Number > 0 {
+			number = row.Number
+		}
+
+		var cells []string
+		for i, cell := range row.Cells {
+			col := i
+			if cell.Ref != "" {
+				col = columnIndex(cell.Ref)
+			}
+			if col < 0 {
+				return nil, fmt.Errorf("%w: invalid cell reference %q", ErrMalformed, cell.Ref)
+			}
+			for len(cells) <= col {
+				cells = append(cells, "")
+			}
+
+			value, err := cellValue(cell.Type, cell.Value, cell.Inline, shared.Items)
+			if err != nil {
+				return nil, err
+			}
+			cells[col] = value
+		}
+		rows = appendRow(rows, number, cells)
+	}
+	return rows, nil
+}
+
+// firstSheetPath - workbook에 처음 등록된 시트의 ZIP 내부 경로
+func firstSheetPath(parts map[string]*zip.File) (string, error) {
+	var workbook xlsxWorkbook
+	if err := decodePart(parts, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("%w: workbook has no sheets", ErrMalformed)
+	}
+
+	var rels xlsxRelationships
+	if err := decodePart(parts, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("%w: sheet relationship %q not found", ErrMalformed, workbook.Sheets[0].RelID)
+}
+
+// decodePart - ZIP 안의 XML 파트 해석
+func decodePart(parts map[string]*zip.File, name string, v interface{}) error {
+	file, ok := parts[name]
+	if !ok {
+		return fmt.Errorf("%w: missing %s", ErrMalformed, name)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	defer rc.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(rc, maxXLSXPart)).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrMalformed, name, err)
+	}
+	return nil
+}
+
+// cellValue - 칸 형식별 값 (s: 공유 문자열, inlineStr: 직접 입력 문자열, n/빈 값: 숫자, 그 외는 저장값 그대로)
+func cellValue(cellType, value string, inline xlsxText, shared []xlsxText) (string, error) {
+	switch cellType {
+	case "s":
+		idx, err := strconv.Atoi(value)
+		if err != nil || idx < 0 || idx >= len(shared) {
+			return "", fmt.Errorf("%w: invalid shared string index %q", ErrMalformed, value)
+		}
+		return shared[idx].String(), nil
+	case "inlineStr":
+		return inline.String(), nil
+	case "", "n":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+// columnIndex - 칸 참조의 열 번호 (예: "A1" → 0, "AB12" → 27, 형식이 틀리면 -1)
+func columnIndex(ref string) int {
+	col := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		letters++
+	}
+	if letters == 0 {
+		return -1
+	}
+	return col - 1
+}
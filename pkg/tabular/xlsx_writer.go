@@ -0,0 +1,208 @@
+package tabular
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// 📝 설명: Excel .xlsx 한 시트 쓰기 (머리글 + 데이터 행)
+// 🎯 실무 포인트: 모든 칸을 문자열(inlineStr)로 써서 엑셀이 날짜/번호를 임의로 바꾸지 않게 함
+// 머리글은 굵게, 첫 행 고정, 열 너비는 머리글과 값 길이로 대략 맞춤
+// ⚠️ 주의사항: 행 전체를 메모리에서 만든 뒤 ZIP으로 압축 (수만 행 수준까지를 가정)
+
+// MaxSheetRows - 엑셀 한 시트의 최대 행 수 (머리글 포함)
+const MaxSheetRows = 1048576
+
+// maxColumnWidth - 자동 열 너비 상한 (글자 수)
+const maxColumnWidth = 50
+
+// Sheet - 내보낼 시트
+type Sheet struct {
+	Name   string     // 시트 이름 (31자 이하, 엑셀 금지 문자 제외)
+	Header []string   // 머리글 (1행)
+	Rows   [][]string // 데이터 행
+}
+
+// xlsxStaticParts - 내용과 무관한 고정 파트
+var xlsxStaticParts = map[string]string{
+	"[Content_Types].xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`,
+	"_rels/.rels": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`,
+	"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`,
+	// 스타일 0: 기본, 1: 굵게 (머리글)
+	"xl/styles.xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="2"><font><sz val="11"/><name val="맑은 고딕"/></font><font><b/><sz val="11"/><name val="맑은 고딕"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+		`</styleSheet>`,
+}
+
+// WriteXLSX - 시트 하나짜리 .xlsx 생성
+func WriteXLSX(sheet Sheet) ([]byte, error) {
+	if len(sheet.Rows)+1 > MaxSheetRows {
+		return nil, fmt.Errorf("tabular: %d rows exceed sheet limit", len(sheet.Rows))
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		if err := writePart(archive, name, func(w io.Writer) error {
+			_, err := io.WriteString(w, xlsxStaticParts[name])
+			return err
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := writePart(archive, "xl/workbook.xml", func(w io.Writer) error {
+		return writeWorkbook(w, sheet.Name)
+	}); err != nil {
+		return nil, err
+	}
+	if err := writePart(archive, "xl/worksheets/sheet1.xml", func(w io.Writer) error {
+		return writeSheet(w, sheet)
+	}); err != nil {
+		return nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writePart - ZIP에 파트 추가
+func writePart(archive *zip.Writer, name string, write func(w io.Writer) error) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	return write(w)
+}
+
+// writeWorkbook - 시트 목록 (시트 이름의 엑셀 금지 문자는 공백으로)
+func writeWorkbook(w io.Writer, name string) error {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`\/?*[]:`, r) {
+			return ' '
+		}
+		return r
+	}, name)
+	if runes := []rune(name); len(runes) > 31 {
+		name = string(runes[:31])
+	}
+	if strings.TrimSpace(name) == "" {
+		name = "Sheet1"
+	}
+
+	if _, err := io.WriteString(w, `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`); err != nil {
+		return err
+	}
+	if err := xml.EscapeText(w, []byte(name)); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, `" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+	return err
+}
+
+// writeSheet - 머리글(굵게, 고정) + 데이터 행
+func writeSheet(w io.Writer, sheet Sheet) error {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if len(sheet.Header) > 0 {
+		b.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	}
+
+	widths := columnWidths(sheet)
+	if len(widths) > 0 {
+		b.WriteString("<cols>")
+		for i, width := range widths {
+			fmt.Fprintf(&b, `<col min="%d" max="%d" width="%d" customWidth="1"/>`, i+1, i+1, width)
+		}
+		b.WriteString("</cols>")
+	}
+
+	b.WriteString("<sheetData>")
+	number := 0
+	if len(sheet.Header) > 0 {
+		number++
+		writeRow(&b, number, sheet.Header, ` s="1"`)
+	}
+	for _, row := range sheet.Rows {
+		number++
+		writeRow(&b, number, row, "")
+	}
+	b.WriteString("</sheetData></worksheet>")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeRow - 행의 칸을 문자열로 (빈 칸은 생략)
+func writeRow(b *strings.Builder, number int, cells []string, style string) {
+	fmt.Fprintf(b, `<row r="%d">`, number)
+	for i, cell := range cells {
+		if cell == "" {
+			continue
+		}
+		fmt.Fprintf(b, `<c r="%s%d" t="inlineStr"%s><is><t xml:space="preserve">`, columnName(i), number, style)
+		_ = xml.EscapeText(b, []byte(cell)) // strings.Builder 쓰기는 실패하지 않음
+		b.WriteString("</t></is></c>")
+	}
+	b.WriteString("</row>")
+}
+
+// columnWidths - 열별 최대 글자 수 기준 너비 (한글 등 전각 문자는 2칸)
+func columnWidths(sheet Sheet) []int {
+	var widths []int
+	measure := func(cells []string) {
+		for i, cell := range cells {
+			width := 0
+			for _, r := range cell {
+				width++
+				if r >= 0x1100 {
+					width++
+				}
+			}
+			for len(widths) <= i {
+				widths = append(widths, 8)
+			}
+			widths[i] = max(widths[i], min(width+2, maxColumnWidth))
+		}
+	}
+	measure(sheet.Header)
+	for _, row := range sheet.Rows {
+		measure(row)
+	}
+	return widths
+}
+
+// columnName - 열 번호의 칸 참조 문자 (0 → "A", 27 → "AB")
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
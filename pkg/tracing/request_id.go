@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// RequestIDHeader - 요청 ID 헤더 (gRPC 메타데이터는 소문자 x-request-id)
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDPropagator - X-Request-ID를 트레이스 컨텍스트와 함께 전파
+// 수신: 헤더 값을 logger.WithRequestID로 context에 저장 (감사 로그/요청 로그와 같은 ID)
+// 발신: context의 요청 ID를 외부 호출(웹훅, 문자 발송 등) 헤더에 추가
+type RequestIDPropagator struct{}
+
+var _ propagation.TextMapPropagator = RequestIDPropagator{}
+
+// Inject - context의 요청 ID를 carrier에 기록 (없으면 생략)
+func (RequestIDPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	if requestID := logger.RequestIDFromContext(ctx); requestID != "" {
+		carrier.Set(RequestIDHeader, requestID)
+	}
+}
+
+// Extract - carrier의 요청 ID를 context에 저장 (없으면 그대로)
+func (RequestIDPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	if requestID := carrier.Get(RequestIDHeader); requestID != "" {
+		return logger.WithRequestID(ctx, requestID)
+	}
+	return ctx
+}
+
+// Fields - 전파하는 헤더 이름
+func (RequestIDPropagator) Fields() []string {
+	return []string{RequestIDHeader}
+}
@@ -0,0 +1,175 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/tracing"
+)
+
+// 📝 설명: 외부 시스템으로 이벤트 전달 (HTTP POST + HMAC-SHA256 서명)
+// 🎯 실무 포인트: 서명 헤더는 "t=<유닉스 초>,v1=<hex>" 형식, 서명 대상은 "<t>.<본문>"
+// → 수신 측은 같은 비밀키로 다시 계산해 비교하고 t가 오래되었으면 거부(재전송 공격 방지)
+// ⚠️ 주의사항: 2xx만 성공, 408/429/5xx와 네트워크 오류는 일시 장애(재시도), 그 외 4xx는 재시도해도 같은 결과
+
+// 요청 헤더
+const (
+	HeaderEvent     = "X-Eodini-Event"     // 이벤트 종류 (예: trip.started)
+	HeaderDelivery  = "X-Eodini-Delivery"  // 전달 ID (재시도해도 같음 → 수신 측 중복 처리 방지)
+	HeaderSignature = "X-Eodini-Signature" // t=<유닉스 초>,v1=<HMAC-SHA256 hex>
+)
+
+// secretPrefix - 서버가 생성한 서명 비밀키 접두어
+const secretPrefix = "whsec_"
+
+// maxResponseBody - 실패 기록에 남기는 응답 본문 최대 길이
+const maxResponseBody = 512
+
+var (
+	// ErrInvalidSignature - 서명 헤더 형식이 잘못되었거나 값이 일치하지 않음
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	// ErrExpiredSignature - 허용 시간보다 오래된 서명
+	ErrExpiredSignature = errors.New("webhook: signature timestamp outside tolerance")
+)
+
+// Sender - 이벤트 전달 (Client가 구현)
+type Sender interface {
+	Send(ctx context.Context, msg *Message) (int, error) // 응답 HTTP 상태 (네트워크 오류면 0)
+}
+
+// Message - 전달할 이벤트
+type Message struct {
+	URL        string
+	Secret     string // 서명 비밀키
+	Event      string
+	DeliveryID string
+	Body       []byte // JSON 본문 (서명 대상)
+}
+
+// StatusError - 2xx가 아닌 응답
+type StatusError struct {
+	Code int
+	Body string // 응답 본문 앞부분
+}
+
+// Error - 에러 메시지
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("webhook: unexpected status %d: %s", e.Code, e.Body)
+}
+
+// Temporary - 다시 보내면 성공할 수 있는 응답인지 (408, 429, 5xx)
+func (e *StatusError) Temporary() bool {
+	return e.Code == http.StatusRequestTimeout || e.Code == http.StatusTooManyRequests || e.Code >= 500
+}
+
+// IsPermanent - 재시도해도 같은 결과인 실패인지 (408/429를 제외한 4xx 등 2xx가 아닌 응답)
+func IsPermanent(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && !statusErr.Temporary()
+}
+
+// Client - HTTP 이벤트 전달 클라이언트
+type Client struct {
+	http *http.Client
+}
+
+// NewClient - 제한 시간이 있는 전달 클라이언트 (0이면 10초, 리다이렉트는 따라가지 않음)
+func NewClient(timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &Client{http: &http.Client{
+		Timeout:   timeout,
+		Transport: tracing.NewTransport(nil),
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send - 서명한 이벤트를 POST (2xx가 아니면 *StatusError)
+func (c *Client) Send(ctx context.Context, msg *Message) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.URL, bytes.NewReader(msg.Body))
+	if err != nil {
+		return 0, fmt.Errorf("webhook: invalid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "eodini-webhook/1.0")
+	req.Header.Set(HeaderEvent, msg.Event)
+	req.Header.Set(HeaderDelivery, msg.DeliveryID)
+	req.Header.Set(HeaderSignature, Sign(msg.Secret, time.Now(), msg.Body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhook: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+		return resp.StatusCode, &StatusError{Code: resp.StatusCode, Body: string(body)}
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody)) // 연결 재사용
+	return resp.StatusCode, nil
+}
+
+// Sign - 서명 헤더 값 생성
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + signature(secret, t, body)
+}
+
+// Verify - 서명 헤더 검증 (수신 측 구현 참고용, tolerance 0이면 시각 확인 생략)
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || v1 == "" {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(v1), []byte(signature(secret, t, body))) {
+		return ErrInvalidSignature
+	}
+	if age := now.Sub(time.Unix(unix, 0)); tolerance > 0 && (age > tolerance || age < -tolerance) {
+		return ErrExpiredSignature
+	}
+	return nil
+}
+
+// GenerateSecret - 서명 비밀키 생성 (whsec_ + 256비트 난수)
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// signature - HMAC-SHA256("<t>.<본문>") hex
+func signature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
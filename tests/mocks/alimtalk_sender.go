@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hyeokjun/eodini/pkg/alimtalk"
+)
+
+// AlimtalkSender - 알림톡 발송 대역 (발송 내역 기록)
+type AlimtalkSender struct {
+	mu   sync.Mutex
+	Sent []alimtalk.Message
+	Err  error // 설정 시 Send가 이 에러 반환 (템플릿 불일치, 중계사 장애 재현)
+}
+
+// NewAlimtalkSender - 알림톡 발송 대역 생성
+func NewAlimtalkSender() *AlimtalkSender {
+	return &AlimtalkSender{}
+}
+
+// Send - 발송 기록
+func (s *AlimtalkSender) Send(ctx context.Context, msg *alimtalk.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	s.Sent = append(s.Sent, *msg)
+	return nil
+}
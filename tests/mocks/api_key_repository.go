@@ -0,0 +1,98 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// APIKeyRepository - 인메모리 API 키 Repository
+type APIKeyRepository struct {
+	mu   sync.RWMutex
+	keys map[string]*domain.APIKey
+}
+
+// NewAPIKeyRepository - 인메모리 API 키 Repository 생성
+func NewAPIKeyRepository() *APIKeyRepository {
+	return &APIKeyRepository{keys: map[string]*domain.APIKey{}}
+}
+
+// Create - API 키 저장
+func (r *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &key.OrganizationID)
+	copied := *key
+	r.keys[key.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *APIKeyRepository) GetByID(ctx context.Context, id string) (*domain.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *key
+	return &copied, nil
+}
+
+// GetByHash - 키 해시로 조회
+func (r *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, key := range r.keys {
+		if key.KeyHash == keyHash {
+			copied := *key
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *APIKeyRepository) List(ctx context.Context, filter repository.APIKeyFilter) ([]*domain.APIKey, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.APIKey
+	for _, key := range r.keys {
+		if !filter.IncludeRevoked && key.IsRevoked() {
+			continue
+		}
+		copied := *key
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - API 키 수정
+func (r *APIKeyRepository) Update(ctx context.Context, key *domain.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.keys[key.ID]; !ok {
+		return repository.ErrNotFound
+	}
+	copied := *key
+	r.keys[key.ID] = &copied
+	return nil
+}
+
+// TouchLastUsed - 마지막 사용 시각 갱신
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if key, ok := r.keys[id]; ok {
+		key.LastUsedAt = &usedAt
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// AttendantAssignmentRepository - 인메모리 동승자 대체 배정 Repository
+type AttendantAssignmentRepository struct {
+	mu          sync.RWMutex
+	assignments map[string]domain.AttendantAssignment
+}
+
+// NewAttendantAssignmentRepository - 인메모리 동승자 대체 배정 Repository 생성
+func NewAttendantAssignmentRepository() *AttendantAssignmentRepository {
+	return &AttendantAssignmentRepository{assignments: make(map[string]domain.AttendantAssignment)}
+}
+
+// Create - 대체 배정 저장
+func (r *AttendantAssignmentRepository) Create(ctx context.Context, assignment *domain.AttendantAssignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.assignments[assignment.ID] = *assignment
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *AttendantAssignmentRepository) GetByID(ctx context.Context, id string) (*domain.AttendantAssignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	assignment, ok := r.assignments[id]
+	if !ok || assignment.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	return &assignment, nil
+}
+
+// ListBySchedule - 일정의 대체 배정 목록 (시작일 순)
+func (r *AttendantAssignmentRepository) ListBySchedule(ctx context.Context, scheduleID string) ([]*domain.AttendantAssignment, error) {
+	return r.list(func(a domain.AttendantAssignment) bool { return a.ScheduleID == scheduleID }), nil
+}
+
+// ListActiveOn - 날짜가 기간에 포함된 대체 배정 목록
+func (r *AttendantAssignmentRepository) ListActiveOn(ctx context.Context, date time.Time) ([]*domain.AttendantAssignment, error) {
+	return r.list(func(a domain.AttendantAssignment) bool { return a.IsActiveOnDate(date) }), nil
+}
+
+// Update - 대체 배정 수정
+func (r *AttendantAssignmentRepository) Update(ctx context.Context, assignment *domain.AttendantAssignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.assignments[assignment.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	r.assignments[assignment.ID] = *assignment
+	return nil
+}
+
+// SoftDelete - 대체 배정 삭제
+func (r *AttendantAssignmentRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignment, ok := r.assignments[id]
+	if !ok || assignment.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	assignment.DeletedAt = &now
+	r.assignments[id] = assignment
+	return nil
+}
+
+// list - 삭제되지 않고 조건에 맞는 배정 복사본 (시작일 순)
+func (r *AttendantAssignmentRepository) list(match func(domain.AttendantAssignment) bool) []*domain.AttendantAssignment {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var assignments []*domain.AttendantAssignment
+	for _, assignment := range r.assignments {
+		if assignment.DeletedAt == nil && match(assignment) {
+			assignment := assignment
+			assignments = append(assignments, &assignment)
+		}
+	}
+	sort.Slice(assignments, func(i, j int) bool { return assignments[i].StartDate.Before(assignments[j].StartDate) })
+	return assignments
+}
@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// AttendantRepository - 인메모리 동승자 Repository
+type AttendantRepository struct {
+	mu         sync.RWMutex
+	attendants map[string]*domain.Attendant
+}
+
+// NewAttendantRepository - 인메모리 동승자 Repository 생성
+func NewAttendantRepository() *AttendantRepository {
+	return &AttendantRepository{attendants: map[string]*domain.Attendant{}}
+}
+
+// Create - 동승자 저장
+func (r *AttendantRepository) Create(ctx context.Context, attendant *domain.Attendant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *attendant
+	r.attendants[attendant.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *AttendantRepository) GetByID(ctx context.Context, id string) (*domain.Attendant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	attendant, ok := r.attendants[id]
+	if !ok || attendant.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *attendant
+	return &copied, nil
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *AttendantRepository) List(ctx context.Context, filter repository.AttendantFilter) ([]*domain.Attendant, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Attendant
+	for _, attendant := range r.attendants {
+		if attendant.DeletedAt != nil {
+			continue
+		}
+		if filter.Status != "" && attendant.Status != filter.Status {
+			continue
+		}
+		if filter.Role != "" && attendant.Role != filter.Role {
+			continue
+		}
+		if filter.CanStartTrip != nil && attendant.CanStartTrip != *filter.CanStartTrip {
+			continue
+		}
+		copied := *attendant
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 동승자 수정
+func (r *AttendantRepository) Update(ctx context.Context, attendant *domain.Attendant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.attendants[attendant.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *attendant
+	r.attendants[attendant.ID] = &copied
+	return nil
+}
+
+// SoftDelete - 동승자 삭제
+func (r *AttendantRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	attendant, ok := r.attendants[id]
+	if !ok || attendant.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	attendant.DeletedAt = &now
+	return nil
+}
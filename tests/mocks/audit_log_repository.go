@@ -0,0 +1,74 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// AuditLogRepository - 인메모리 감사 로그 Repository
+type AuditLogRepository struct {
+	mu   sync.RWMutex
+	logs []*domain.AuditLog
+}
+
+// NewAuditLogRepository - 인메모리 감사 로그 Repository 생성
+func NewAuditLogRepository() *AuditLogRepository {
+	return &AuditLogRepository{}
+}
+
+// Create - 감사 로그 저장
+func (r *AuditLogRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *log
+	r.logs = append(r.logs, &copied)
+	return nil
+}
+
+// List - 조건에 맞는 목록 조회 (최신순)
+func (r *AuditLogRepository) List(ctx context.Context, filter repository.AuditLogFilter) ([]*domain.AuditLog, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.AuditLog
+	for _, log := range r.logs {
+		if filter.EntityType != "" && log.EntityType != filter.EntityType {
+			continue
+		}
+		if filter.EntityID != "" && log.EntityID != filter.EntityID {
+			continue
+		}
+		if filter.ActorID != "" && log.ActorID != filter.ActorID {
+			continue
+		}
+		copied := *log
+		result = append(result, &copied)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// RedactEntity - 엔티티의 과거 감사 로그 값 가림
+func (r *AuditLogRepository) RedactEntity(ctx context.Context, entityType, entityID string, columns []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, log := range r.logs {
+		if log.EntityType != entityType || log.EntityID != entityID {
+			continue
+		}
+		copied := *log
+		copied.Changes = make(map[string]domain.AuditChange, len(log.Changes))
+		for column, change := range log.Changes {
+			copied.Changes[column] = change
+		}
+		copied.RedactColumns(columns)
+		r.logs[i] = &copied
+	}
+	return nil
+}
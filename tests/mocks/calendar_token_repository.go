@@ -0,0 +1,72 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// CalendarTokenRepository - 인메모리 캘린더 구독 토큰 Repository (사용자 ID가 키)
+type CalendarTokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[string]domain.CalendarToken
+}
+
+// NewCalendarTokenRepository - 인메모리 캘린더 구독 토큰 Repository 생성
+func NewCalendarTokenRepository() *CalendarTokenRepository {
+	return &CalendarTokenRepository{tokens: make(map[string]domain.CalendarToken)}
+}
+
+// Upsert - 캘린더 구독 토큰 발급 (같은 사용자는 교체, 최초 ID 유지)
+func (r *CalendarTokenRepository) Upsert(ctx context.Context, token *domain.CalendarToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token.UpdatedAt = time.Now()
+	token.LastUsedAt = nil
+	if existing, ok := r.tokens[token.UserID]; ok {
+		token.ID = existing.ID
+		token.CreatedAt = existing.CreatedAt
+	}
+	r.tokens[token.UserID] = *token
+	return nil
+}
+
+// GetByHash - 토큰 해시로 조회
+func (r *CalendarTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.CalendarToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			copied := token
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// DeleteByUser - 사용자의 토큰 삭제
+func (r *CalendarTokenRepository) DeleteByUser(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.tokens[userID]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.tokens, userID)
+	return nil
+}
+
+// TouchLastUsed - 마지막 사용 시각 기록
+func (r *CalendarTokenRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for userID, token := range r.tokens {
+		if token.ID == id {
+			token.LastUsedAt = &usedAt
+			r.tokens[userID] = token
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// DeviceTokenRepository - 인메모리 기기 토큰 Repository (토큰 값이 키)
+type DeviceTokenRepository struct {
+	mu      sync.RWMutex
+	devices map[string]domain.DeviceToken
+}
+
+// NewDeviceTokenRepository - 인메모리 기기 토큰 Repository 생성
+func NewDeviceTokenRepository() *DeviceTokenRepository {
+	return &DeviceTokenRepository{devices: make(map[string]domain.DeviceToken)}
+}
+
+// Upsert - 같은 토큰이면 사용자/기기 종류/등록 시각 교체
+func (r *DeviceTokenRepository) Upsert(ctx context.Context, device *domain.DeviceToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	device.UpdatedAt = time.Now()
+	if existing, ok := r.devices[device.Token]; ok {
+		existing.UserID = device.UserID
+		existing.Platform = device.Platform
+		existing.UpdatedAt = device.UpdatedAt
+		r.devices[device.Token] = existing
+		return nil
+	}
+	r.devices[device.Token] = *device
+	return nil
+}
+
+// ListByUser - 사용자의 기기 토큰 (최근 등록 순)
+func (r *DeviceTokenRepository) ListByUser(ctx context.Context, userID string) ([]*domain.DeviceToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var result []*domain.DeviceToken
+	for _, device := range r.devices {
+		if device.UserID == userID {
+			copied := device
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].UpdatedAt.After(result[j].UpdatedAt) })
+	return result, nil
+}
+
+// Delete - 사용자의 기기 토큰 삭제
+func (r *DeviceTokenRepository) Delete(ctx context.Context, userID, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	device, ok := r.devices[token]
+	if !ok || device.UserID != userID {
+		return repository.ErrNotFound
+	}
+	delete(r.devices, token)
+	return nil
+}
+
+// DeleteTokens - 토큰 값으로 일괄 삭제
+func (r *DeviceTokenRepository) DeleteTokens(ctx context.Context, tokens []string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var deleted int64
+	for _, token := range tokens {
+		if _, ok := r.devices[token]; ok {
+			delete(r.devices, token)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
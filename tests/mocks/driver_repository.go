@@ -0,0 +1,173 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// DriverRepository - 인메모리 기사 Repository
+type DriverRepository struct {
+	mu      sync.RWMutex
+	drivers map[string]*domain.Driver
+}
+
+// NewDriverRepository - 인메모리 기사 Repository 생성
+func NewDriverRepository() *DriverRepository {
+	return &DriverRepository{drivers: map[string]*domain.Driver{}}
+}
+
+// Create - 기사 저장
+func (r *DriverRepository) Create(ctx context.Context, driver *domain.Driver) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *driver
+	r.drivers[driver.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *DriverRepository) GetByID(ctx context.Context, id string) (*domain.Driver, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	driver, ok := r.drivers[id]
+	if !ok || driver.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *driver
+	return &copied, nil
+}
+
+// GetByLicenseNumber - 면허 번호로 조회
+func (r *DriverRepository) GetByLicenseNumber(ctx context.Context, licenseNumber string) (*domain.Driver, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, driver := range r.drivers {
+		if driver.LicenseNumber == licenseNumber && driver.DeletedAt == nil {
+			copied := *driver
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *DriverRepository) List(ctx context.Context, filter repository.DriverFilter) ([]*domain.Driver, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Driver
+	for _, driver := range r.drivers {
+		if driver.DeletedAt != nil && !filter.IncludeDeleted {
+			continue
+		}
+		if filter.Status != "" && driver.Status != filter.Status {
+			continue
+		}
+		if filter.LicenseStatus != "" && driver.LicenseStatus != filter.LicenseStatus {
+			continue
+		}
+		if filter.LicenseExpiringWithin != nil {
+			deadline := time.Now().AddDate(0, 0, *filter.LicenseExpiringWithin)
+			if !driver.LicenseExpiry.Before(deadline) {
+				continue
+			}
+		}
+		copied := *driver
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 기사 수정
+func (r *DriverRepository) Update(ctx context.Context, driver *domain.Driver) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.drivers[driver.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *driver
+	r.drivers[driver.ID] = &copied
+	return nil
+}
+
+// SoftDelete - 기사 삭제
+func (r *DriverRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	driver, ok := r.drivers[id]
+	if !ok || driver.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	driver.DeletedAt = &now
+	return nil
+}
+
+// GetDeleted - 삭제된 기사 조회
+func (r *DriverRepository) GetDeleted(ctx context.Context, id string) (*domain.Driver, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	driver, ok := r.drivers[id]
+	if !ok || driver.DeletedAt == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *driver
+	return &copied, nil
+}
+
+// Restore - 삭제된 기사 복구
+func (r *DriverRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	driver, ok := r.drivers[id]
+	if !ok || driver.DeletedAt == nil {
+		return repository.ErrNotFound
+	}
+	driver.DeletedAt = nil
+	return nil
+}
+
+// ListForAnonymization - 보존 기간이 지난 기사 조회 (삭제된 기사 포함)
+func (r *DriverRepository) ListForAnonymization(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Driver, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Driver
+	for _, driver := range r.drivers {
+		if driver.AnonymizedAt != nil {
+			continue
+		}
+		terminated := driver.TerminationDate != nil && driver.TerminationDate.Before(cutoff)
+		deleted := driver.DeletedAt != nil && driver.DeletedAt.Before(cutoff)
+		if !terminated && !deleted {
+			continue
+		}
+		copied := *driver
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return paginate(result, 0, limit), nil
+}
+
+// Anonymize - 익명화 저장 (삭제된 기사 포함)
+func (r *DriverRepository) Anonymize(ctx context.Context, driver *domain.Driver) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.drivers[driver.ID]
+	if !ok || existing.AnonymizedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *driver
+	r.drivers[driver.ID] = &copied
+	return nil
+}
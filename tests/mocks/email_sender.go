@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hyeokjun/eodini/pkg/email"
+)
+
+// EmailSender - 이메일 발송 대역 (발송 내역 기록)
+type EmailSender struct {
+	mu   sync.Mutex
+	Sent []email.Message
+	Err  error // 설정 시 Send가 이 에러 반환
+}
+
+// NewEmailSender - 이메일 발송 대역 생성
+func NewEmailSender() *EmailSender {
+	return &EmailSender{}
+}
+
+// Send - 발송 기록
+func (s *EmailSender) Send(ctx context.Context, msg *email.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	s.Sent = append(s.Sent, *msg)
+	return nil
+}
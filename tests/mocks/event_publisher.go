@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// PublishedEvent - 발행된 이벤트
+type PublishedEvent struct {
+	Event          domain.WebhookEvent
+	OrganizationID string
+	AggregateID    string
+	Data           interface{}
+}
+
+// EventPublisher - 이벤트 발행 대역 (발행 내역 기록, 동기)
+type EventPublisher struct {
+	mu         sync.Mutex
+	Events     []PublishedEvent
+	Err        error             // 설정하면 Publish가 이 에러 반환 (기록 실패 재현)
+	Dispatcher *event.Dispatcher // 설정하면 발행 즉시 핸들러에 전달 (아웃박스 릴레이 대신)
+}
+
+// NewEventPublisher - 이벤트 발행 대역 생성
+func NewEventPublisher() *EventPublisher {
+	return &EventPublisher{}
+}
+
+// Publish - 발행 기록 (Dispatcher가 있으면 릴레이처럼 이벤트 기관 context로 바로 전달, 핸들러 에러는 무시)
+func (p *EventPublisher) Publish(ctx context.Context, published domain.WebhookEvent, organizationID, aggregateID string, data interface{}) error {
+	p.mu.Lock()
+	if p.Err != nil {
+		p.mu.Unlock()
+		return p.Err
+	}
+	p.Events = append(p.Events, PublishedEvent{Event: published, OrganizationID: organizationID, AggregateID: aggregateID, Data: data})
+	dispatcher := p.Dispatcher
+	p.mu.Unlock()
+
+	if dispatcher == nil {
+		return nil
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, _ = dispatcher.Dispatch(repository.WithOrganization(ctx, organizationID), event.Event{ID: uuid.New().String(), Type: string(published), OrganizationID: organizationID, OccurredAt: time.Now(), Payload: payload}, nil)
+	return nil
+}
+
+// Types - 발행된 이벤트 종류 (발행 순)
+func (p *EventPublisher) Types() []domain.WebhookEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	types := make([]domain.WebhookEvent, len(p.Events))
+	for i, published := range p.Events {
+		types[i] = published.Event
+	}
+	return types
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+)
+
+// ExpiryReminderRepository - 인메모리 만료 안내 발송 기록 Repository
+type ExpiryReminderRepository struct {
+	mu        sync.RWMutex
+	reminders map[string]domain.ExpiryReminder // 키: 종류/대상/만료일/구간
+}
+
+// NewExpiryReminderRepository - 인메모리 만료 안내 발송 기록 Repository 생성
+func NewExpiryReminderRepository() *ExpiryReminderRepository {
+	return &ExpiryReminderRepository{reminders: make(map[string]domain.ExpiryReminder)}
+}
+
+// Claim - 발송 기록 저장 (같은 대상·만료일·구간 기록이 있으면 false)
+func (r *ExpiryReminderRepository) Claim(ctx context.Context, reminder *domain.ExpiryReminder) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := fmt.Sprintf("%s/%s/%s/%d", reminder.Kind, reminder.SubjectID, reminder.ExpiresOn.Format("2006-01-02"), reminder.WindowDays)
+	if _, ok := r.reminders[key]; ok {
+		return false, nil
+	}
+	assignOrganization(ctx, &reminder.OrganizationID)
+	r.reminders[key] = *reminder
+	return true, nil
+}
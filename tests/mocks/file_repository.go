@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// FileRepository - 인메모리 업로드 파일 Repository
+type FileRepository struct {
+	mu    sync.RWMutex
+	files map[string]domain.File
+}
+
+// NewFileRepository - 인메모리 업로드 파일 Repository 생성
+func NewFileRepository() *FileRepository {
+	return &FileRepository{files: make(map[string]domain.File)}
+}
+
+// Create - 업로드 파일 저장
+func (r *FileRepository) Create(ctx context.Context, file *domain.File) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &file.OrganizationID)
+	r.files[file.ID] = *file
+	return nil
+}
+
+// GetByID - ID로 업로드 파일 조회
+func (r *FileRepository) GetByID(ctx context.Context, id string) (*domain.File, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	file, ok := r.files[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &file, nil
+}
+
+// Delete - 업로드 파일 삭제
+func (r *FileRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.files[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.files, id)
+	return nil
+}
@@ -0,0 +1,90 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// FuelLogRepository - 인메모리 주유 기록 Repository
+type FuelLogRepository struct {
+	mu   sync.RWMutex
+	logs map[string]domain.FuelLog
+}
+
+// NewFuelLogRepository - 인메모리 주유 기록 Repository 생성
+func NewFuelLogRepository() *FuelLogRepository {
+	return &FuelLogRepository{logs: make(map[string]domain.FuelLog)}
+}
+
+// Create - 주유 기록 저장
+func (r *FuelLogRepository) Create(ctx context.Context, log *domain.FuelLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &log.OrganizationID)
+	r.logs[log.ID] = *log
+	return nil
+}
+
+// List - 조건에 맞는 주유 기록 (주유 시각, 주행거리계 순)
+func (r *FuelLogRepository) List(ctx context.Context, filter repository.FuelLogFilter) ([]*domain.FuelLog, error) {
+	return r.list(func(l domain.FuelLog) bool {
+		return (filter.VehicleID == "" || l.VehicleID == filter.VehicleID) &&
+			(filter.From == nil || !l.FilledAt.Before(*filter.From)) &&
+			(filter.To == nil || l.FilledAt.Before(*filter.To))
+	}), nil
+}
+
+// LatestBefore - 차량의 at 이전 마지막 주유 기록
+func (r *FuelLogRepository) LatestBefore(ctx context.Context, vehicleID string, at time.Time) (*domain.FuelLog, error) {
+	logs := r.list(func(l domain.FuelLog) bool { return l.VehicleID == vehicleID && l.FilledAt.Before(at) })
+	if len(logs) == 0 {
+		return nil, repository.ErrNotFound
+	}
+	return logs[len(logs)-1], nil
+}
+
+// EarliestAfter - 차량의 at 이후 첫 주유 기록
+func (r *FuelLogRepository) EarliestAfter(ctx context.Context, vehicleID string, at time.Time) (*domain.FuelLog, error) {
+	logs := r.list(func(l domain.FuelLog) bool { return l.VehicleID == vehicleID && l.FilledAt.After(at) })
+	if len(logs) == 0 {
+		return nil, repository.ErrNotFound
+	}
+	return logs[0], nil
+}
+
+// Delete - 주유 기록 삭제
+func (r *FuelLogRepository) Delete(ctx context.Context, vehicleID, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	log, ok := r.logs[id]
+	if !ok || log.VehicleID != vehicleID {
+		return repository.ErrNotFound
+	}
+	delete(r.logs, id)
+	return nil
+}
+
+// list - 조건에 맞는 기록 복사본 (주유 시각, 주행거리계 순)
+func (r *FuelLogRepository) list(match func(domain.FuelLog) bool) []*domain.FuelLog {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var logs []*domain.FuelLog
+	for _, log := range r.logs {
+		if match(log) {
+			log := log
+			logs = append(logs, &log)
+		}
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if !logs[i].FilledAt.Equal(logs[j].FilledAt) {
+			return logs[i].FilledAt.Before(logs[j].FilledAt)
+		}
+		return logs[i].OdometerKm < logs[j].OdometerKm
+	})
+	return logs
+}
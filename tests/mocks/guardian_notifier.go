@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+)
+
+// GuardianNotification - 보호자 알림 발송 내역
+type GuardianNotification struct {
+	GuardianID   string // 보호자 ID (연락처로 직접 보낸 경우 빈 값)
+	Phone        string // 연락처로 직접 보낸 경우 수신 번호
+	Event        domain.NotificationEvent
+	Notification service.Notification
+}
+
+// GuardianNotifier - 보호자 알림 대역 (발송 내역 기록, 백그라운드 발송도 안전하게 조회)
+type GuardianNotifier struct {
+	mu   sync.Mutex
+	sent []GuardianNotification
+}
+
+// NewGuardianNotifier - 보호자 알림 대역 생성
+func NewGuardianNotifier() *GuardianNotifier {
+	return &GuardianNotifier{}
+}
+
+// NotifyGuardian - 보호자 알림 기록 (항상 푸시로 보낸 것으로 처리)
+func (n *GuardianNotifier) NotifyGuardian(ctx context.Context, guardianID string, event domain.NotificationEvent, notification *service.Notification) (domain.NotificationChannel, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, GuardianNotification{GuardianID: guardianID, Event: event, Notification: *notification})
+	return domain.NotificationChannelPush, nil
+}
+
+// Notify - 연락처 직접 발송 기록 (항상 문자로 보낸 것으로 처리)
+func (n *GuardianNotifier) Notify(ctx context.Context, recipient service.Recipient, notification *service.Notification) (domain.NotificationChannel, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, GuardianNotification{Phone: recipient.Phone, Notification: *notification})
+	return domain.NotificationChannelSMS, nil
+}
+
+// Sent - 지금까지의 발송 내역
+func (n *GuardianNotifier) Sent() []GuardianNotification {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]GuardianNotification(nil), n.sent...)
+}
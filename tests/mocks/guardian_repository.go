@@ -0,0 +1,180 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// GuardianRepository - 인메모리 보호자 Repository
+type GuardianRepository struct {
+	mu        sync.RWMutex
+	guardians map[string]*domain.Guardian
+	links     []domain.GuardianPassenger // 연결 순서 유지
+}
+
+// NewGuardianRepository - 인메모리 보호자 Repository 생성
+func NewGuardianRepository() *GuardianRepository {
+	return &GuardianRepository{guardians: map[string]*domain.Guardian{}}
+}
+
+// Create - 보호자 저장
+func (r *GuardianRepository) Create(ctx context.Context, guardian *domain.Guardian) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *guardian
+	r.guardians[guardian.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *GuardianRepository) GetByID(ctx context.Context, id string) (*domain.Guardian, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	guardian, ok := r.guardians[id]
+	if !ok || guardian.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *guardian
+	return &copied, nil
+}
+
+// GetByPhone - 연락처로 조회
+func (r *GuardianRepository) GetByPhone(ctx context.Context, phone string) (*domain.Guardian, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, guardian := range r.guardians {
+		if guardian.Phone == phone && guardian.DeletedAt == nil {
+			copied := *guardian
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *GuardianRepository) List(ctx context.Context, filter repository.GuardianFilter) ([]*domain.Guardian, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Guardian
+	for _, guardian := range r.guardians {
+		if guardian.DeletedAt != nil {
+			continue
+		}
+		if filter.Status != "" && guardian.Status != filter.Status {
+			continue
+		}
+		if filter.Name != "" && !strings.Contains(guardian.Name, filter.Name) {
+			continue
+		}
+		if filter.Phone != "" && guardian.Phone != filter.Phone {
+			continue
+		}
+		copied := *guardian
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 보호자 수정
+func (r *GuardianRepository) Update(ctx context.Context, guardian *domain.Guardian) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.guardians[guardian.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *guardian
+	r.guardians[guardian.ID] = &copied
+	return nil
+}
+
+// SoftDelete - 보호자 삭제 및 자녀 연결 해제
+func (r *GuardianRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	guardian, ok := r.guardians[id]
+	if !ok || guardian.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	guardian.DeletedAt = &now
+
+	remaining := r.links[:0]
+	for _, link := range r.links {
+		if link.GuardianID != id {
+			remaining = append(remaining, link)
+		}
+	}
+	r.links = remaining
+	return nil
+}
+
+// LinkPassenger - 보호자-탑승자 연결 저장
+func (r *GuardianRepository) LinkPassenger(ctx context.Context, link *domain.GuardianPassenger) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.links = append(r.links, *link)
+	return nil
+}
+
+// UnlinkPassenger - 보호자-탑승자 연결 삭제
+func (r *GuardianRepository) UnlinkPassenger(ctx context.Context, guardianID, passengerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, link := range r.links {
+		if link.GuardianID == guardianID && link.PassengerID == passengerID {
+			r.links = append(r.links[:i], r.links[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+// GetLink - 보호자-탑승자 연결 조회
+func (r *GuardianRepository) GetLink(ctx context.Context, guardianID, passengerID string) (*domain.GuardianPassenger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, link := range r.links {
+		if link.GuardianID == guardianID && link.PassengerID == passengerID {
+			copied := link
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// ListLinks - 보호자에 연결된 탑승자 목록 (연결 순)
+func (r *GuardianRepository) ListLinks(ctx context.Context, guardianID string) ([]domain.GuardianPassenger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var links []domain.GuardianPassenger
+	for _, link := range r.links {
+		if link.GuardianID == guardianID {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+// ListPassengerLinks - 탑승자에 연결된 보호자 목록 (연결 순)
+func (r *GuardianRepository) ListPassengerLinks(ctx context.Context, passengerID string) ([]domain.GuardianPassenger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var links []domain.GuardianPassenger
+	for _, link := range r.links {
+		if link.PassengerID == passengerID {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
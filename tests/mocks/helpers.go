@@ -0,0 +1,134 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// paginate - Offset/Limit 적용 (Limit 0이면 전체)
+func paginate[T any](items []T, offset, limit int) []T {
+	if limit <= 0 {
+		return items
+	}
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
+// applyListOptions - 필드 일치 필터와 정렬 적용 (컬럼은 gorm column 태그, 없으면 json 태그로 찾음)
+// 정렬이 없으면 각 Mock의 기본 정렬을 그대로 유지
+func applyListOptions[T any](items []T, opts repository.ListOptions) []T {
+	if len(opts.Filters) > 0 {
+		filtered := make([]T, 0, len(items))
+		for _, item := range items {
+			matched := true
+			for column, value := range opts.Filters {
+				if columnString(columnValue(item, column)) != value {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				filtered = append(filtered, item)
+			}
+		}
+		items = filtered
+	}
+
+	if len(opts.Sort) > 0 {
+		sort.SliceStable(items, func(i, j int) bool {
+			for _, field := range opts.Sort {
+				cmp := compareColumns(columnValue(items[i], field.Column), columnValue(items[j], field.Column))
+				if cmp == 0 {
+					continue
+				}
+				if field.Desc {
+					return cmp > 0
+				}
+				return cmp < 0
+			}
+			return false
+		})
+	}
+	return items
+}
+
+// columnValue - 컬럼명에 해당하는 필드 값 (nil 포인터는 유효하지 않은 값)
+func columnValue(item interface{}, column string) reflect.Value {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		for _, part := range strings.Split(field.Tag.Get("gorm"), ";") {
+			if strings.HasPrefix(part, "column:") {
+				name = strings.TrimPrefix(part, "column:")
+			}
+		}
+		if name == column {
+			return reflect.Indirect(v.Field(i))
+		}
+	}
+	panic(fmt.Sprintf("mocks: unknown column %q", column))
+}
+
+// columnString - 필터 비교용 문자열 표현
+func columnString(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// compareColumns - 정렬 비교 (nil은 가장 작은 값)
+func compareColumns(a, b reflect.Value) int {
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		return -1
+	case !b.IsValid():
+		return 1
+	}
+
+	if at, ok := a.Interface().(time.Time); ok {
+		return at.Compare(b.Interface().(time.Time))
+	}
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(a.Int(), b.Int())
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(a.Float(), b.Float())
+	default:
+		return strings.Compare(columnString(a), columnString(b))
+	}
+}
+
+// compareOrdered - 크기 비교 (-1, 0, 1)
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// assignOrganization - 생성 시 context의 기관 지정 (Repository 테넌트 훅과 같은 동작, 이미 지정되어 있으면 유지)
+func assignOrganization(ctx context.Context, organizationID *string) {
+	if *organizationID == "" {
+		*organizationID = repository.OrganizationFromContext(ctx)
+	}
+}
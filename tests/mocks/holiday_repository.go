@@ -0,0 +1,60 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// HolidayRepository - 인메모리 공휴일 Repository (YYYY-MM-DD가 키)
+type HolidayRepository struct {
+	mu       sync.RWMutex
+	holidays map[string]domain.Holiday
+}
+
+// NewHolidayRepository - 인메모리 공휴일 Repository 생성
+func NewHolidayRepository() *HolidayRepository {
+	return &HolidayRepository{holidays: make(map[string]domain.Holiday)}
+}
+
+// Get - 날짜의 공휴일 조회
+func (r *HolidayRepository) Get(ctx context.Context, date time.Time) (*domain.Holiday, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	holiday, ok := r.holidays[date.Format(time.DateOnly)]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &holiday, nil
+}
+
+// ListRange - 기간(양 끝 포함)의 공휴일 목록 (날짜 순)
+func (r *HolidayRepository) ListRange(ctx context.Context, from, to time.Time) ([]*domain.Holiday, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	start, end := from.Format(time.DateOnly), to.Format(time.DateOnly)
+	var holidays []*domain.Holiday
+	for key, holiday := range r.holidays {
+		if key >= start && key <= end {
+			holiday := holiday
+			holidays = append(holidays, &holiday)
+		}
+	}
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays, nil
+}
+
+// Upsert - 공휴일 저장 (같은 날짜면 교체)
+func (r *HolidayRepository) Upsert(ctx context.Context, holidays []*domain.Holiday) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, holiday := range holidays {
+		holiday.UpdatedAt = time.Now()
+		r.holidays[holiday.Date.Format(time.DateOnly)] = *holiday
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/idempotency"
+)
+
+// IdempotencyStore - 인메모리 Idempotency-Key 저장소 (만료 없음)
+type IdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]idempotency.Record
+	Err     error // 설정 시 Reserve가 이 에러 반환 (Redis 장애 재현)
+}
+
+// NewIdempotencyStore - 인메모리 저장소 생성
+func NewIdempotencyStore() *IdempotencyStore {
+	return &IdempotencyStore{records: map[string]idempotency.Record{}}
+}
+
+// Reserve - 키가 없으면 처리 중으로 선점하고 nil, 있으면 기존 기록 복사본 반환
+func (s *IdempotencyStore) Reserve(ctx context.Context, key, fingerprint string) (*idempotency.Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	if record, ok := s.records[key]; ok {
+		return &record, nil
+	}
+	s.records[key] = idempotency.Record{Fingerprint: fingerprint, CreatedAt: time.Now()}
+	return nil, nil
+}
+
+// Complete - 완료된 응답 저장
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, record *idempotency.Record, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := *record
+	saved.Completed = true
+	s.records[key] = saved
+	return nil
+}
+
+// Release - 선점 해제
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+// Has - 키 저장 여부 (테스트 검증용)
+func (s *IdempotencyStore) Has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.records[key]
+	return ok
+}
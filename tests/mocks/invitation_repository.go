@@ -0,0 +1,85 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// InvitationRepository - 인메모리 초대 Repository
+type InvitationRepository struct {
+	mu          sync.RWMutex
+	invitations map[string]*domain.Invitation
+}
+
+// NewInvitationRepository - 인메모리 초대 Repository 생성
+func NewInvitationRepository() *InvitationRepository {
+	return &InvitationRepository{invitations: map[string]*domain.Invitation{}}
+}
+
+// Create - 초대 저장
+func (r *InvitationRepository) Create(ctx context.Context, invitation *domain.Invitation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &invitation.OrganizationID)
+	copied := *invitation
+	r.invitations[invitation.ID] = &copied
+	return nil
+}
+
+// GetByHash - 토큰 해시로 조회
+func (r *InvitationRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.Invitation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, invitation := range r.invitations {
+		if invitation.TokenHash == tokenHash {
+			copied := *invitation
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// ListPending - 수락 대기 중인 초대 목록 (최근 초대 순)
+func (r *InvitationRepository) ListPending(ctx context.Context, now time.Time) ([]*domain.Invitation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var result []*domain.Invitation
+	for _, invitation := range r.invitations {
+		if invitation.IsPending(now) {
+			copied := *invitation
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	return result, nil
+}
+
+// MarkAccepted - 수락 처리
+func (r *InvitationRepository) MarkAccepted(ctx context.Context, id, userID string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	invitation, ok := r.invitations[id]
+	if !ok || !invitation.IsPending(at) {
+		return repository.ErrNotFound
+	}
+	invitation.AcceptedAt = &at
+	invitation.AcceptedUserID = &userID
+	return nil
+}
+
+// Revoke - 초대 취소
+func (r *InvitationRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	invitation, ok := r.invitations[id]
+	if !ok || !invitation.IsPending(at) {
+		return repository.ErrNotFound
+	}
+	invitation.RevokedAt = &at
+	return nil
+}
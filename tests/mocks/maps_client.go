@@ -0,0 +1,103 @@
+package mocks
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/maps"
+)
+
+// MapsClient - 지도 API 대역 (직선 거리 × 1.5를 도로 거리로, 시속 30km로 소요 시간 계산)
+type MapsClient struct {
+	mu    sync.Mutex
+	Calls int   // Directions/Matrix 호출 횟수
+	Err   error // 설정 시 Directions/Matrix가 이 에러 반환 (API 장애 재현)
+}
+
+// NewMapsClient - 지도 API 대역 생성
+func NewMapsClient() *MapsClient {
+	return &MapsClient{}
+}
+
+// Directions - 구간별 직선 거리 기반 경로
+func (c *MapsClient) Directions(ctx context.Context, waypoints []geo.Point) (*maps.Directions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Calls++
+	if c.Err != nil {
+		return nil, c.Err
+	}
+	if len(waypoints) < 2 {
+		return nil, maps.ErrTooFewWaypoints
+	}
+
+	result := &maps.Directions{Polyline: geo.EncodePolyline(waypoints)}
+	for i := 1; i < len(waypoints); i++ {
+		leg := straightLeg(waypoints[i-1], waypoints[i])
+		result.Legs = append(result.Legs, leg)
+		result.Distance += leg.Distance
+		result.Duration += leg.Duration
+	}
+	return result, nil
+}
+
+// Matrix - 지점 쌍마다 직선 거리 기반 구간
+func (c *MapsClient) Matrix(ctx context.Context, points []geo.Point) (*maps.Matrix, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Calls++
+	if c.Err != nil {
+		return nil, c.Err
+	}
+	if len(points) < 2 {
+		return nil, maps.ErrTooFewWaypoints
+	}
+
+	matrix := &maps.Matrix{Legs: make([][]maps.Leg, len(points))}
+	for i := range points {
+		matrix.Legs[i] = make([]maps.Leg, len(points))
+		for j := range points {
+			if i != j {
+				matrix.Legs[i][j] = straightLeg(points[i], points[j])
+			}
+		}
+	}
+	return matrix, nil
+}
+
+// straightLeg - 직선 거리 × 1.5, 시속 30km 구간
+func straightLeg(from, to geo.Point) maps.Leg {
+	distance := int(math.Round(geo.Distance(from, to) * 1.5))
+	return maps.Leg{Distance: distance, Duration: time.Duration(float64(distance) / (30.0 / 3.6) * float64(time.Second))}
+}
+
+// Geocoder - 주소 검색 대역 (Addresses에 없는 주소는 ErrAddressNotFound)
+type Geocoder struct {
+	mu        sync.Mutex
+	Addresses map[string]geo.Point
+	Calls     int   // Geocode 호출 횟수
+	Err       error // 설정 시 Geocode가 이 에러 반환 (API 장애 재현)
+}
+
+// NewGeocoder - 주소 검색 대역 생성
+func NewGeocoder() *Geocoder {
+	return &Geocoder{Addresses: map[string]geo.Point{}}
+}
+
+// Geocode - 등록된 주소의 좌표
+func (g *Geocoder) Geocode(ctx context.Context, address string) (geo.Point, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.Calls++
+	if g.Err != nil {
+		return geo.Point{}, g.Err
+	}
+	point, ok := g.Addresses[address]
+	if !ok {
+		return geo.Point{}, maps.ErrAddressNotFound
+	}
+	return point, nil
+}
@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// NotificationDedupRepository - 인메모리 알림 중복 방지 Repository (TTL 무시)
+type NotificationDedupRepository struct {
+	mu     sync.Mutex
+	claims map[string]bool
+}
+
+// NewNotificationDedupRepository - 인메모리 알림 중복 방지 Repository 생성
+func NewNotificationDedupRepository() *NotificationDedupRepository {
+	return &NotificationDedupRepository{claims: map[string]bool{}}
+}
+
+// Claim - 키 선점 (이미 선점된 키면 false)
+func (r *NotificationDedupRepository) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.claims[key] {
+		return false, nil
+	}
+	r.claims[key] = true
+	return true, nil
+}
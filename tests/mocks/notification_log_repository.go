@@ -0,0 +1,100 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// NotificationLogRepository - 인메모리 알림 발송 기록 Repository (생성 순서 유지)
+type NotificationLogRepository struct {
+	mu   sync.RWMutex
+	logs []*domain.NotificationLog
+}
+
+// NewNotificationLogRepository - 인메모리 알림 발송 기록 Repository 생성
+func NewNotificationLogRepository() *NotificationLogRepository {
+	return &NotificationLogRepository{}
+}
+
+// Create - 발송 기록 저장
+func (r *NotificationLogRepository) Create(ctx context.Context, log *domain.NotificationLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &log.OrganizationID)
+	copied := *log
+	r.logs = append(r.logs, &copied)
+	return nil
+}
+
+// Update - 발송 결과 반영
+func (r *NotificationLogRepository) Update(ctx context.Context, log *domain.NotificationLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.logs {
+		if existing.ID == log.ID {
+			copied := *log
+			r.logs[i] = &copied
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+// ClaimDue - 재시도할 때가 된 기록을 가져가고 lease만큼 미룸
+func (r *NotificationLogRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.NotificationLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*domain.NotificationLog
+	for _, log := range r.logs {
+		if log.Status == domain.NotificationStatusRetrying && log.NextAttemptAt != nil && !log.NextAttemptAt.After(now) {
+			due = append(due, log)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(*due[j].NextAttemptAt) })
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	result := make([]*domain.NotificationLog, len(due))
+	leased := now.Add(lease)
+	for i, log := range due {
+		copied := *log
+		result[i] = &copied
+		log.NextAttemptAt = &leased
+	}
+	return result, nil
+}
+
+// List - 조건에 맞는 목록 조회 (최신순)
+func (r *NotificationLogRepository) List(ctx context.Context, filter repository.NotificationLogFilter) ([]*domain.NotificationLog, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.NotificationLog
+	for _, log := range r.logs {
+		if filter.GuardianID != "" && log.GuardianID != filter.GuardianID {
+			continue
+		}
+		if filter.Status != "" && log.Status != filter.Status {
+			continue
+		}
+		if filter.Channel != "" && log.Channel != filter.Channel {
+			continue
+		}
+		if filter.Event != "" && log.Event != filter.Event {
+			continue
+		}
+		copied := *log
+		result = append(result, &copied)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// NotificationPreferenceRepository - 인메모리 알림 수신 설정 Repository (보호자 ID가 키)
+type NotificationPreferenceRepository struct {
+	mu          sync.RWMutex
+	preferences map[string]domain.NotificationPreference
+}
+
+// NewNotificationPreferenceRepository - 인메모리 알림 수신 설정 Repository 생성
+func NewNotificationPreferenceRepository() *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{preferences: make(map[string]domain.NotificationPreference)}
+}
+
+// Get - 보호자의 알림 수신 설정 조회
+func (r *NotificationPreferenceRepository) Get(ctx context.Context, guardianID string) (*domain.NotificationPreference, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	preference, ok := r.preferences[guardianID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &preference, nil
+}
+
+// Upsert - 알림 수신 설정 저장
+func (r *NotificationPreferenceRepository) Upsert(ctx context.Context, preference *domain.NotificationPreference) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	preference.UpdatedAt = time.Now()
+	r.preferences[preference.GuardianID] = *preference
+	return nil
+}
@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// OdometerReadingRepository - 인메모리 주행거리계 기록 Repository
+type OdometerReadingRepository struct {
+	mu       sync.RWMutex
+	readings map[string]domain.OdometerReading
+}
+
+// NewOdometerReadingRepository - 인메모리 주행거리계 기록 Repository 생성
+func NewOdometerReadingRepository() *OdometerReadingRepository {
+	return &OdometerReadingRepository{readings: make(map[string]domain.OdometerReading)}
+}
+
+// Create - 주행거리계 기록 저장
+func (r *OdometerReadingRepository) Create(ctx context.Context, reading *domain.OdometerReading) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &reading.OrganizationID)
+	r.readings[reading.ID] = *reading
+	return nil
+}
+
+// List - 조건에 맞는 주행거리계 기록 (기록 시각 역순)
+func (r *OdometerReadingRepository) List(ctx context.Context, filter repository.OdometerReadingFilter) ([]*domain.OdometerReading, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var readings []*domain.OdometerReading
+	for _, reading := range r.readings {
+		if reading.VehicleID != filter.VehicleID ||
+			(filter.From != nil && reading.RecordedAt.Before(*filter.From)) ||
+			(filter.To != nil && !reading.RecordedAt.Before(*filter.To)) {
+			continue
+		}
+		reading := reading
+		readings = append(readings, &reading)
+	}
+	sort.Slice(readings, func(i, j int) bool {
+		if !readings[i].RecordedAt.Equal(readings[j].RecordedAt) {
+			return readings[i].RecordedAt.After(readings[j].RecordedAt)
+		}
+		return readings[i].CreatedAt.After(readings[j].CreatedAt)
+	})
+	return readings, nil
+}
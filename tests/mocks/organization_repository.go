@@ -0,0 +1,67 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// OrganizationRepository - 인메모리 기관 Repository
+type OrganizationRepository struct {
+	mu            sync.RWMutex
+	organizations map[string]*domain.Organization
+}
+
+// NewOrganizationRepository - 인메모리 기관 Repository 생성
+func NewOrganizationRepository() *OrganizationRepository {
+	return &OrganizationRepository{organizations: map[string]*domain.Organization{}}
+}
+
+// Create - 기관 저장
+func (r *OrganizationRepository) Create(ctx context.Context, organization *domain.Organization) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *organization
+	r.organizations[organization.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *OrganizationRepository) GetByID(ctx context.Context, id string) (*domain.Organization, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	organization, ok := r.organizations[id]
+	if !ok || organization.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *organization
+	return &copied, nil
+}
+
+// Update - 기관 수정
+func (r *OrganizationRepository) Update(ctx context.Context, organization *domain.Organization) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.organizations[organization.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *organization
+	r.organizations[organization.ID] = &copied
+	return nil
+}
+
+// ListAllowedOrigins - 삭제되지 않은 기관의 허용 Origin 전체
+func (r *OrganizationRepository) ListAllowedOrigins(ctx context.Context) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var origins []string
+	for _, organization := range r.organizations {
+		if organization.DeletedAt == nil {
+			origins = append(origins, organization.AllowedOrigins...)
+		}
+	}
+	return origins, nil
+}
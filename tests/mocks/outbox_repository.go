@@ -0,0 +1,82 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// OutboxRepository - 인메모리 아웃박스 Repository (기록 순서 유지)
+type OutboxRepository struct {
+	mu     sync.RWMutex
+	events []*domain.OutboxEvent
+	Err    error // 설정하면 Create가 이 에러 반환 (트랜잭션 롤백 확인용)
+}
+
+// NewOutboxRepository - 인메모리 아웃박스 Repository 생성
+func NewOutboxRepository() *OutboxRepository {
+	return &OutboxRepository{}
+}
+
+// Create - 이벤트 기록
+func (r *OutboxRepository) Create(ctx context.Context, event *domain.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.Err != nil {
+		return r.Err
+	}
+	copied := *event
+	r.events = append(r.events, &copied)
+	return nil
+}
+
+// Update - 전달 결과 반영
+func (r *OutboxRepository) Update(ctx context.Context, event *domain.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, stored := range r.events {
+		if stored.ID == event.ID {
+			copied := *event
+			copied.Handled = append([]string(nil), event.Handled...)
+			r.events[i] = &copied
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+// ClaimDue - 전달할 때가 된 이벤트를 가져가고 lease만큼 미룸 (기록 순)
+func (r *OutboxRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.OutboxEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []*domain.OutboxEvent
+	for _, event := range r.events {
+		if limit > 0 && len(result) == limit {
+			break
+		}
+		if event.Status != domain.OutboxPending || event.AvailableAt.After(now) {
+			continue
+		}
+		copied := *event
+		copied.Handled = append([]string(nil), event.Handled...)
+		result = append(result, &copied)
+		event.AvailableAt = now.Add(lease)
+	}
+	return result, nil
+}
+
+// All - 기록된 이벤트 전체 (기록 순)
+func (r *OutboxRepository) All() []*domain.OutboxEvent {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]*domain.OutboxEvent, len(r.events))
+	for i, event := range r.events {
+		copied := *event
+		result[i] = &copied
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// PassengerAbsenceRepository - 인메모리 결석 신고 Repository
+type PassengerAbsenceRepository struct {
+	mu       sync.RWMutex
+	absences map[string]domain.PassengerAbsence
+}
+
+// NewPassengerAbsenceRepository - 인메모리 결석 신고 Repository 생성
+func NewPassengerAbsenceRepository() *PassengerAbsenceRepository {
+	return &PassengerAbsenceRepository{absences: make(map[string]domain.PassengerAbsence)}
+}
+
+// Create - 결석 신고 저장
+func (r *PassengerAbsenceRepository) Create(ctx context.Context, absence *domain.PassengerAbsence) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.absences[absence.ID] = *absence
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *PassengerAbsenceRepository) GetByID(ctx context.Context, id string) (*domain.PassengerAbsence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	absence, ok := r.absences[id]
+	if !ok || absence.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	return &absence, nil
+}
+
+// ListByPassenger - 탑승자의 결석 신고 목록 (from 날짜 이후, 날짜 순)
+func (r *PassengerAbsenceRepository) ListByPassenger(ctx context.Context, passengerID string, from time.Time) ([]*domain.PassengerAbsence, error) {
+	return r.list(func(a domain.PassengerAbsence) bool {
+		return a.PassengerID == passengerID && a.Date.Format(time.DateOnly) >= from.Format(time.DateOnly)
+	}), nil
+}
+
+// ListByDate - 날짜의 결석 신고 목록
+func (r *PassengerAbsenceRepository) ListByDate(ctx context.Context, date time.Time) ([]*domain.PassengerAbsence, error) {
+	return r.list(func(a domain.PassengerAbsence) bool { return sameDate(a.Date, date) }), nil
+}
+
+// SoftDelete - 결석 신고 취소
+func (r *PassengerAbsenceRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	absence, ok := r.absences[id]
+	if !ok || absence.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	absence.DeletedAt = &now
+	r.absences[id] = absence
+	return nil
+}
+
+// list - 삭제되지 않고 조건에 맞는 신고 복사본 (날짜, 시간대 순)
+func (r *PassengerAbsenceRepository) list(match func(domain.PassengerAbsence) bool) []*domain.PassengerAbsence {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var absences []*domain.PassengerAbsence
+	for _, absence := range r.absences {
+		if absence.DeletedAt == nil && match(absence) {
+			absence := absence
+			absences = append(absences, &absence)
+		}
+	}
+	sort.Slice(absences, func(i, j int) bool {
+		if !absences[i].Date.Equal(absences[j].Date) {
+			return absences[i].Date.Before(absences[j].Date)
+		}
+		return absences[i].TimeSlot < absences[j].TimeSlot
+	})
+	return absences
+}
@@ -0,0 +1,220 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// PassengerRepository - 인메모리 탑승자 Repository
+type PassengerRepository struct {
+	mu         sync.RWMutex
+	passengers map[string]*domain.Passenger
+}
+
+// NewPassengerRepository - 인메모리 탑승자 Repository 생성
+func NewPassengerRepository() *PassengerRepository {
+	return &PassengerRepository{passengers: map[string]*domain.Passenger{}}
+}
+
+// Create - 탑승자 저장
+func (r *PassengerRepository) Create(ctx context.Context, passenger *domain.Passenger) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *passenger
+	r.passengers[passenger.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *PassengerRepository) GetByID(ctx context.Context, id string) (*domain.Passenger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	passenger, ok := r.passengers[id]
+	if !ok || passenger.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *passenger
+	return &copied, nil
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *PassengerRepository) List(ctx context.Context, filter repository.PassengerFilter) ([]*domain.Passenger, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Passenger
+	for _, passenger := range r.passengers {
+		if passenger.DeletedAt != nil && !filter.IncludeDeleted {
+			continue
+		}
+		if filter.Status != "" && passenger.Status != filter.Status {
+			continue
+		}
+		if filter.RouteID != "" && passenger.AssignedRouteID != filter.RouteID {
+			continue
+		}
+		if filter.StopID != "" && passenger.AssignedStopID != filter.StopID {
+			continue
+		}
+		if filter.Name != "" && !strings.Contains(passenger.Name, filter.Name) {
+			continue
+		}
+		copied := *passenger
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].StopOrder != result[j].StopOrder {
+			return result[i].StopOrder < result[j].StopOrder
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 탑승자 수정
+func (r *PassengerRepository) Update(ctx context.Context, passenger *domain.Passenger) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.passengers[passenger.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *passenger
+	r.passengers[passenger.ID] = &copied
+	return nil
+}
+
+// SoftDelete - 탑승자 삭제
+func (r *PassengerRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	passenger, ok := r.passengers[id]
+	if !ok || passenger.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	passenger.DeletedAt = &now
+	return nil
+}
+
+// GetDeleted - 삭제된 탑승자 조회
+func (r *PassengerRepository) GetDeleted(ctx context.Context, id string) (*domain.Passenger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	passenger, ok := r.passengers[id]
+	if !ok || passenger.DeletedAt == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *passenger
+	return &copied, nil
+}
+
+// Restore - 삭제된 탑승자 복구
+func (r *PassengerRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	passenger, ok := r.passengers[id]
+	if !ok || passenger.DeletedAt == nil {
+		return repository.ErrNotFound
+	}
+	passenger.DeletedAt = nil
+	return nil
+}
+
+// ListForAnonymization - 보존 기간이 지난 탑승자 조회 (삭제된 탑승자 포함)
+func (r *PassengerRepository) ListForAnonymization(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Passenger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Passenger
+	for _, passenger := range r.passengers {
+		if passenger.AnonymizedAt != nil {
+			continue
+		}
+		deactivatedAt := passenger.DeactivatedAt
+		if deactivatedAt == nil {
+			deactivatedAt = &passenger.UpdatedAt
+		}
+		inactive := passenger.Status == domain.PassengerStatusInactive && deactivatedAt.Before(cutoff)
+		deleted := passenger.DeletedAt != nil && passenger.DeletedAt.Before(cutoff)
+		if !inactive && !deleted {
+			continue
+		}
+		copied := *passenger
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return paginate(result, 0, limit), nil
+}
+
+// Anonymize - 익명화 저장 (삭제된 탑승자 포함)
+func (r *PassengerRepository) Anonymize(ctx context.Context, passenger *domain.Passenger) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.passengers[passenger.ID]
+	if !ok || existing.AnonymizedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *passenger
+	r.passengers[passenger.ID] = &copied
+	return nil
+}
+
+// Search - 이름/보호자 이름 부분 일치, 보호자 연락처는 숫자만 비교해 전체 일치 (연결된 보호자 계정은 검색하지 않음)
+func (r *PassengerRepository) Search(ctx context.Context, query string, limit int) ([]*domain.Passenger, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	lowered := strings.ToLower(query)
+	digits := phoneDigits(query)
+	var result []*domain.Passenger
+	for _, passenger := range r.passengers {
+		if passenger.DeletedAt != nil {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(passenger.Name), lowered) &&
+			!strings.Contains(strings.ToLower(passenger.GuardianName), lowered) &&
+			(digits == "" || phoneDigits(passenger.GuardianPhone) != digits) {
+			continue
+		}
+		copied := *passenger
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return paginate(result, 0, limit), nil
+}
+
+// phoneDigits - 연락처 비교용 숫자 (+82는 0으로, 연락처가 아니면 빈 값)
+func phoneDigits(phone string) string {
+	digits := strings.NewReplacer("-", "", " ", "").Replace(phone)
+	if strings.HasPrefix(digits, "+82") {
+		digits = "0" + digits[len("+82"):]
+	}
+	if len(digits) < 9 || strings.Trim(digits, "0123456789") != "" {
+		return ""
+	}
+	return digits
+}
+
+// syncStopOrders - 경로 탑승자의 정류장 순서를 orders(정류장 ID → 순서)로 갱신 (삭제된 정류장 탑승자는 유지)
+func (r *PassengerRepository) syncStopOrders(routeID string, orders map[string]int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, passenger := range r.passengers {
+		if passenger.AssignedRouteID != routeID || passenger.DeletedAt != nil {
+			continue
+		}
+		if order, ok := orders[passenger.AssignedStopID]; ok {
+			passenger.StopOrder = order
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hyeokjun/eodini/pkg/push"
+)
+
+// PushSender - 푸시 발송 대역 (발송 내역 기록, 토큰별 에러 지정)
+type PushSender struct {
+	mu     sync.Mutex
+	Sent   map[string][]push.Message // 토큰별 발송 내역
+	Errors map[string]error          // 설정 시 해당 토큰 발송이 이 에러 반환 (push.ErrInvalidToken 등)
+}
+
+// NewPushSender - 푸시 발송 대역 생성
+func NewPushSender() *PushSender {
+	return &PushSender{Sent: map[string][]push.Message{}, Errors: map[string]error{}}
+}
+
+// Send - 발송 기록
+func (s *PushSender) Send(ctx context.Context, token string, msg *push.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors[token]; err != nil {
+		return err
+	}
+	s.Sent[token] = append(s.Sent[token], *msg)
+	return nil
+}
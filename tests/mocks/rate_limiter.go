@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/ratelimit"
+)
+
+// RateLimiter - 인메모리 요청 제한기 (윈도우 만료 없이 키별 요청 수만 셈)
+type RateLimiter struct {
+	mu     sync.Mutex
+	counts map[string]int
+	Err    error // 설정 시 Allow가 이 에러 반환 (Redis 장애 재현)
+}
+
+// NewRateLimiter - 인메모리 요청 제한기 생성
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{counts: map[string]int{}}
+}
+
+// Allow - 키별 요청 수가 limit 미만이면 허용
+func (l *RateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (ratelimit.Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.Err != nil {
+		return ratelimit.Result{}, l.Err
+	}
+	if l.counts[key] >= limit {
+		return ratelimit.Result{Allowed: false, RetryAfter: window}, nil
+	}
+	l.counts[key]++
+	return ratelimit.Result{Allowed: true, Remaining: limit - l.counts[key]}, nil
+}
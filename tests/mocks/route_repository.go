@@ -0,0 +1,271 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// RouteRepository - 인메모리 경로 Repository
+type RouteRepository struct {
+	mu         sync.RWMutex
+	routes     map[string]*domain.Route
+	stops      map[string]*domain.Stop
+	Passengers *PassengerRepository // 설정하면 순서 변경 시 탑승자 정류장 순서도 갱신 (DB 트랜잭션 동기화 재현)
+}
+
+// NewRouteRepository - 인메모리 경로 Repository 생성
+func NewRouteRepository() *RouteRepository {
+	return &RouteRepository{
+		routes: map[string]*domain.Route{},
+		stops:  map[string]*domain.Stop{},
+	}
+}
+
+// Create - 경로 저장 (정류장 포함)
+func (r *RouteRepository) Create(ctx context.Context, route *domain.Route) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *route
+	copied.Stops = nil
+	r.routes[route.ID] = &copied
+	for _, stop := range route.Stops {
+		stopCopy := stop
+		r.stops[stop.ID] = &stopCopy
+	}
+	return nil
+}
+
+// GetByID - ID로 조회 (정류장 순서대로 포함)
+func (r *RouteRepository) GetByID(ctx context.Context, id string) (*domain.Route, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	route, ok := r.routes[id]
+	if !ok || route.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *route
+	copied.Stops = r.activeStops(id)
+	return &copied, nil
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *RouteRepository) List(ctx context.Context, filter repository.RouteFilter) ([]*domain.Route, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Route
+	for _, route := range r.routes {
+		if route.DeletedAt != nil && !filter.IncludeDeleted {
+			continue
+		}
+		if filter.Status != "" && route.Status != filter.Status {
+			continue
+		}
+		copied := *route
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 경로 수정
+func (r *RouteRepository) Update(ctx context.Context, route *domain.Route) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.routes[route.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *route
+	copied.Stops = nil
+	r.routes[route.ID] = &copied
+	return nil
+}
+
+// SoftDelete - 경로 및 소속 정류장 삭제
+func (r *RouteRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	route, ok := r.routes[id]
+	if !ok || route.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	route.DeletedAt = &now
+	for _, stop := range r.stops {
+		if stop.RouteID == id && stop.DeletedAt == nil {
+			stop.DeletedAt = &now
+		}
+	}
+	return nil
+}
+
+// GetDeleted - 삭제된 경로 조회
+func (r *RouteRepository) GetDeleted(ctx context.Context, id string) (*domain.Route, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	route, ok := r.routes[id]
+	if !ok || route.DeletedAt == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *route
+	return &copied, nil
+}
+
+// Restore - 삭제된 경로 복구 (함께 삭제된 정류장 포함)
+func (r *RouteRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	route, ok := r.routes[id]
+	if !ok || route.DeletedAt == nil {
+		return repository.ErrNotFound
+	}
+	deletedAt := *route.DeletedAt
+	route.DeletedAt = nil
+	for _, stop := range r.stops {
+		if stop.RouteID == id && stop.DeletedAt != nil && stop.DeletedAt.Equal(deletedAt) {
+			stop.DeletedAt = nil
+		}
+	}
+	return nil
+}
+
+// ListStops - 경로의 정류장 목록
+func (r *RouteRepository) ListStops(ctx context.Context, routeID string) ([]domain.Stop, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.activeStops(routeID), nil
+}
+
+// GetStop - 경로 내 정류장 조회
+func (r *RouteRepository) GetStop(ctx context.Context, routeID, stopID string) (*domain.Stop, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stop, ok := r.stops[stopID]
+	if !ok || stop.RouteID != routeID || stop.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *stop
+	return &copied, nil
+}
+
+// InsertStop - stop.Order 위치에 삽입
+func (r *RouteRepository) InsertStop(ctx context.Context, stop *domain.Stop) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.shift(stop.RouteID, stop.Order, 0, 1)
+	copied := *stop
+	r.stops[stop.ID] = &copied
+	r.syncPassengers(stop.RouteID)
+	return nil
+}
+
+// UpdateStop - 정류장 정보 수정 (순서 제외)
+func (r *RouteRepository) UpdateStop(ctx context.Context, stop *domain.Stop) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.stops[stop.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *stop
+	copied.Order = existing.Order
+	copied.RouteID = existing.RouteID
+	r.stops[stop.ID] = &copied
+	return nil
+}
+
+// MoveStop - 정류장 순서 이동
+func (r *RouteRepository) MoveStop(ctx context.Context, routeID, stopID string, newOrder int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stop, ok := r.stops[stopID]
+	if !ok || stop.RouteID != routeID || stop.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	switch {
+	case newOrder < stop.Order:
+		r.shift(routeID, newOrder, stop.Order-1, 1)
+	case newOrder > stop.Order:
+		r.shift(routeID, stop.Order+1, newOrder, -1)
+	default:
+		return nil
+	}
+	stop.Order = newOrder
+	r.syncPassengers(routeID)
+	return nil
+}
+
+// RemoveStop - 정류장 삭제 후 이후 정류장 순서 당김
+func (r *RouteRepository) RemoveStop(ctx context.Context, routeID, stopID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stop, ok := r.stops[stopID]
+	if !ok || stop.RouteID != routeID || stop.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	stop.DeletedAt = &now
+	r.shift(routeID, stop.Order+1, 0, -1)
+	r.syncPassengers(routeID)
+	return nil
+}
+
+// ReorderStops - 전달된 ID 순서대로 1..N 재배치
+func (r *RouteRepository) ReorderStops(ctx context.Context, routeID string, stopIDs []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, stopID := range stopIDs {
+		stop, ok := r.stops[stopID]
+		if !ok || stop.RouteID != routeID || stop.DeletedAt != nil {
+			return repository.ErrNotFound
+		}
+		stop.Order = i + 1
+	}
+	r.syncPassengers(routeID)
+	return nil
+}
+
+// activeStops - 삭제되지 않은 정류장을 순서대로 반환 (lock 보유 상태에서 호출)
+func (r *RouteRepository) activeStops(routeID string) []domain.Stop {
+	var stops []domain.Stop
+	for _, stop := range r.stops {
+		if stop.RouteID == routeID && stop.DeletedAt == nil {
+			stops = append(stops, *stop)
+		}
+	}
+	sort.Slice(stops, func(i, j int) bool { return stops[i].Order < stops[j].Order })
+	return stops
+}
+
+// syncPassengers - 경로 탑승자의 정류장 순서 갱신 (lock 보유 상태에서 호출)
+func (r *RouteRepository) syncPassengers(routeID string) {
+	if r.Passengers == nil {
+		return
+	}
+	orders := make(map[string]int)
+	for _, stop := range r.activeStops(routeID) {
+		orders[stop.ID] = stop.Order
+	}
+	r.Passengers.syncStopOrders(routeID, orders)
+}
+
+// shift - [from, to] 구간 순서를 delta만큼 이동 (to가 0이면 끝까지)
+func (r *RouteRepository) shift(routeID string, from, to, delta int) {
+	for _, stop := range r.stops {
+		if stop.RouteID != routeID || stop.DeletedAt != nil || stop.Order < from {
+			continue
+		}
+		if to > 0 && stop.Order > to {
+			continue
+		}
+		stop.Order += delta
+	}
+}
@@ -0,0 +1,79 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// ScheduleExceptionRepository - 인메모리 일정 예외 Repository
+type ScheduleExceptionRepository struct {
+	mu         sync.RWMutex
+	exceptions map[string]domain.ScheduleException
+}
+
+// NewScheduleExceptionRepository - 인메모리 일정 예외 Repository 생성
+func NewScheduleExceptionRepository() *ScheduleExceptionRepository {
+	return &ScheduleExceptionRepository{exceptions: make(map[string]domain.ScheduleException)}
+}
+
+// Create - 일정 예외 생성
+func (r *ScheduleExceptionRepository) Create(ctx context.Context, exception *domain.ScheduleException) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exceptions[exception.ID] = *exception
+	return nil
+}
+
+// GetBySchedule - 일정의 날짜 예외 조회
+func (r *ScheduleExceptionRepository) GetBySchedule(ctx context.Context, scheduleID string, date time.Time) (*domain.ScheduleException, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, exception := range r.exceptions {
+		if exception.ScheduleID == scheduleID && sameDate(exception.Date, date) {
+			return &exception, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// ListBySchedule - 일정의 예외 목록 (날짜 순)
+func (r *ScheduleExceptionRepository) ListBySchedule(ctx context.Context, scheduleID string) ([]*domain.ScheduleException, error) {
+	return r.list(func(e domain.ScheduleException) bool { return e.ScheduleID == scheduleID }), nil
+}
+
+// ListByDate - 날짜의 모든 일정 예외
+func (r *ScheduleExceptionRepository) ListByDate(ctx context.Context, date time.Time) ([]*domain.ScheduleException, error) {
+	return r.list(func(e domain.ScheduleException) bool { return sameDate(e.Date, date) }), nil
+}
+
+// Delete - 일정 예외 삭제
+func (r *ScheduleExceptionRepository) Delete(ctx context.Context, scheduleID, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	exception, ok := r.exceptions[id]
+	if !ok || exception.ScheduleID != scheduleID {
+		return repository.ErrNotFound
+	}
+	delete(r.exceptions, id)
+	return nil
+}
+
+// list - 조건에 맞는 예외 복사본 (날짜 순)
+func (r *ScheduleExceptionRepository) list(match func(domain.ScheduleException) bool) []*domain.ScheduleException {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var exceptions []*domain.ScheduleException
+	for _, exception := range r.exceptions {
+		if match(exception) {
+			exception := exception
+			exceptions = append(exceptions, &exception)
+		}
+	}
+	sort.Slice(exceptions, func(i, j int) bool { return exceptions[i].Date.Before(exceptions[j].Date) })
+	return exceptions
+}
@@ -0,0 +1,127 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// ScheduleRepository - 인메모리 일정 Repository
+type ScheduleRepository struct {
+	mu        sync.RWMutex
+	schedules map[string]*domain.Schedule
+}
+
+// NewScheduleRepository - 인메모리 일정 Repository 생성
+func NewScheduleRepository() *ScheduleRepository {
+	return &ScheduleRepository{schedules: map[string]*domain.Schedule{}}
+}
+
+// Create - 일정 저장
+func (r *ScheduleRepository) Create(ctx context.Context, schedule *domain.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schedules[schedule.ID] = copySchedule(schedule)
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *ScheduleRepository) GetByID(ctx context.Context, id string) (*domain.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	schedule, ok := r.schedules[id]
+	if !ok || schedule.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	return copySchedule(schedule), nil
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *ScheduleRepository) List(ctx context.Context, filter repository.ScheduleFilter) ([]*domain.Schedule, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Schedule
+	for _, schedule := range r.schedules {
+		if schedule.DeletedAt != nil && !filter.IncludeDeleted {
+			continue
+		}
+		if filter.Status != "" && schedule.Status != filter.Status {
+			continue
+		}
+		if filter.RouteID != "" && schedule.RouteID != filter.RouteID {
+			continue
+		}
+		if filter.VehicleID != "" && schedule.VehicleID != filter.VehicleID {
+			continue
+		}
+		if filter.DriverID != "" && schedule.DefaultDriverID != filter.DriverID {
+			continue
+		}
+		result = append(result, copySchedule(schedule))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].StartTime < result[j].StartTime })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 일정 수정
+func (r *ScheduleRepository) Update(ctx context.Context, schedule *domain.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.schedules[schedule.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	r.schedules[schedule.ID] = copySchedule(schedule)
+	return nil
+}
+
+// SoftDelete - 일정 삭제
+func (r *ScheduleRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	schedule, ok := r.schedules[id]
+	if !ok || schedule.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	schedule.DeletedAt = &now
+	return nil
+}
+
+// GetDeleted - 삭제된 일정 조회
+func (r *ScheduleRepository) GetDeleted(ctx context.Context, id string) (*domain.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	schedule, ok := r.schedules[id]
+	if !ok || schedule.DeletedAt == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *schedule
+	return &copied, nil
+}
+
+// Restore - 삭제된 일정 복구
+func (r *ScheduleRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	schedule, ok := r.schedules[id]
+	if !ok || schedule.DeletedAt == nil {
+		return repository.ErrNotFound
+	}
+	schedule.DeletedAt = nil
+	return nil
+}
+
+// copySchedule - 요일 슬라이스까지 복사
+func copySchedule(schedule *domain.Schedule) *domain.Schedule {
+	copied := *schedule
+	copied.DaysOfWeek = append([]int(nil), schedule.DaysOfWeek...)
+	return &copied
+}
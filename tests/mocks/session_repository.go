@@ -0,0 +1,89 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// SessionRepository - 인메모리 세션 Repository
+type SessionRepository struct {
+	mu       sync.RWMutex
+	sessions map[string]*domain.Session // 세션 ID → 세션
+	revoked  map[string]bool
+}
+
+// NewSessionRepository - 인메모리 세션 Repository 생성
+func NewSessionRepository() *SessionRepository {
+	return &SessionRepository{
+		sessions: map[string]*domain.Session{},
+		revoked:  map[string]bool{},
+	}
+}
+
+// Track - 세션 등록
+func (r *SessionRepository) Track(ctx context.Context, session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *session
+	r.sessions[session.ID] = &copied
+	return nil
+}
+
+// List - 만료되지 않은 세션 목록
+func (r *SessionRepository) List(ctx context.Context, userID string) ([]*domain.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := r.active(userID)
+	sort.Slice(result, func(i, j int) bool { return result[i].ExpiresAt.Before(result[j].ExpiresAt) })
+	return result, nil
+}
+
+// Revoke - 세션 하나 폐기
+func (r *SessionRepository) Revoke(ctx context.Context, userID, sessionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	session, ok := r.sessions[sessionID]
+	if !ok || session.UserID != userID || !session.ExpiresAt.After(time.Now()) {
+		return repository.ErrNotFound
+	}
+	r.revoked[sessionID] = true
+	delete(r.sessions, sessionID)
+	return nil
+}
+
+// RevokeAll - 사용자의 모든 세션 폐기
+func (r *SessionRepository) RevokeAll(ctx context.Context, userID string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	active := r.active(userID)
+	for _, session := range active {
+		r.revoked[session.ID] = true
+		delete(r.sessions, session.ID)
+	}
+	return len(active), nil
+}
+
+// IsRevoked - 폐기된 세션인지 확인
+func (r *SessionRepository) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.revoked[sessionID], nil
+}
+
+// active - 사용자의 만료되지 않은 세션 (lock 보유 상태에서 호출)
+func (r *SessionRepository) active(userID string) []*domain.Session {
+	now := time.Now()
+	var result []*domain.Session
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.ExpiresAt.After(now) {
+			copied := *session
+			result = append(result, &copied)
+		}
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hyeokjun/eodini/pkg/sms"
+)
+
+// SMSSender - 문자 발송 대역 (발송 내역 기록)
+type SMSSender struct {
+	mu   sync.Mutex
+	Sent []sms.Message
+	Err  error // 설정 시 Send가 이 에러 반환 (중계사 장애 재현)
+}
+
+// NewSMSSender - 문자 발송 대역 생성
+func NewSMSSender() *SMSSender {
+	return &SMSSender{}
+}
+
+// Send - 발송 기록
+func (s *SMSSender) Send(ctx context.Context, msg *sms.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	s.Sent = append(s.Sent, *msg)
+	return nil
+}
@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// TripAlertRepository - 인메모리 운행 경보 Repository
+type TripAlertRepository struct {
+	mu     sync.RWMutex
+	alerts []domain.TripAlert
+}
+
+// NewTripAlertRepository - 인메모리 운행 경보 Repository 생성
+func NewTripAlertRepository() *TripAlertRepository {
+	return &TripAlertRepository{}
+}
+
+// Create - 경보 저장
+func (r *TripAlertRepository) Create(ctx context.Context, alert *domain.TripAlert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alerts = append(r.alerts, *alert)
+	return nil
+}
+
+// ListByTrip - 운행의 경보 (발생 순)
+func (r *TripAlertRepository) ListByTrip(ctx context.Context, tripID string) ([]*domain.TripAlert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var result []*domain.TripAlert
+	for _, alert := range r.alerts {
+		if alert.TripID == tripID {
+			copied := alert
+			result = append(result, &copied)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].OccurredAt.Before(result[j].OccurredAt) })
+	return result, nil
+}
+
+// LatestByType - 운행의 해당 종류 마지막 경보
+func (r *TripAlertRepository) LatestByType(ctx context.Context, tripID string, alertType domain.AlertType) (*domain.TripAlert, error) {
+	alerts, _ := r.ListByTrip(ctx, tripID)
+	for i := len(alerts) - 1; i >= 0; i-- {
+		if alerts[i].Type == alertType {
+			return alerts[i], nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
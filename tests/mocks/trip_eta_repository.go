@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// TripETARepository - 인메모리 도착 예정 시간 캐시
+type TripETARepository struct {
+	mu      sync.RWMutex
+	etas    map[string]domain.TripETA
+	expires map[string]time.Time
+	Saves   int // 저장 횟수 (캐시 재사용 확인용)
+}
+
+// NewTripETARepository - 인메모리 도착 예정 시간 캐시 생성
+func NewTripETARepository() *TripETARepository {
+	return &TripETARepository{
+		etas:    map[string]domain.TripETA{},
+		expires: map[string]time.Time{},
+	}
+}
+
+// Get - 만료되지 않은 캐시
+func (r *TripETARepository) Get(ctx context.Context, tripID string) (*domain.TripETA, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	eta, ok := r.etas[tripID]
+	if !ok || time.Now().After(r.expires[tripID]) {
+		return nil, repository.ErrNotFound
+	}
+	eta.Stops = append([]domain.StopETA(nil), eta.Stops...)
+	return &eta, nil
+}
+
+// Save - 캐시 저장
+func (r *TripETARepository) Save(ctx context.Context, eta *domain.TripETA, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *eta
+	copied.Stops = append([]domain.StopETA(nil), eta.Stops...)
+	r.etas[eta.TripID] = copied
+	r.expires[eta.TripID] = time.Now().Add(ttl)
+	r.Saves++
+	return nil
+}
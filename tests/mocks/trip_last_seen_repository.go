@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TripLastSeenRepository - 인메모리 마지막 위치 수신 시각 Repository
+type TripLastSeenRepository struct {
+	mu   sync.RWMutex
+	seen map[string]time.Time
+}
+
+// NewTripLastSeenRepository - 인메모리 마지막 위치 수신 시각 Repository 생성
+func NewTripLastSeenRepository() *TripLastSeenRepository {
+	return &TripLastSeenRepository{seen: map[string]time.Time{}}
+}
+
+// Touch - 마지막 수신 시각 갱신
+func (r *TripLastSeenRepository) Touch(ctx context.Context, tripID string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seen[tripID] = at
+	return nil
+}
+
+// GetMany - 여러 운행의 마지막 수신 시각 (기록이 없는 운행은 제외)
+func (r *TripLastSeenRepository) GetMany(ctx context.Context, tripIDs []string) (map[string]time.Time, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	seen := map[string]time.Time{}
+	for _, id := range tripIDs {
+		if at, ok := r.seen[id]; ok {
+			seen[id] = at
+		}
+	}
+	return seen, nil
+}
@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// TripLocationRepository - 인메모리 운행 GPS 기록 Repository
+type TripLocationRepository struct {
+	mu        sync.RWMutex
+	positions []domain.VehiclePosition
+}
+
+// NewTripLocationRepository - 인메모리 운행 GPS 기록 Repository 생성
+func NewTripLocationRepository() *TripLocationRepository {
+	return &TripLocationRepository{}
+}
+
+// Create - GPS 기록 저장 (ID 순번 부여)
+func (r *TripLocationRepository) Create(ctx context.Context, position *domain.VehiclePosition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	position.ID = int64(len(r.positions) + 1)
+	r.positions = append(r.positions, *position)
+	return nil
+}
+
+// ListByTrip - 운행의 GPS 기록 (측정 시각 순)
+func (r *TripLocationRepository) ListByTrip(ctx context.Context, tripID string) ([]*domain.VehiclePosition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var result []*domain.VehiclePosition
+	for _, position := range r.positions {
+		if position.TripID == tripID {
+			copied := position
+			result = append(result, &copied)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].RecordedAt.Before(result[j].RecordedAt) })
+	return result, nil
+}
+
+// Latest - 운행의 마지막 GPS 기록
+func (r *TripLocationRepository) Latest(ctx context.Context, tripID string) (*domain.VehiclePosition, error) {
+	positions, _ := r.ListByTrip(ctx, tripID)
+	if len(positions) == 0 {
+		return nil, repository.ErrNotFound
+	}
+	return positions[len(positions)-1], nil
+}
@@ -0,0 +1,198 @@
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// TripRepository - 인메모리 운행 Repository
+type TripRepository struct {
+	mu    sync.RWMutex
+	trips map[string]*domain.Trip
+}
+
+// NewTripRepository - 인메모리 운행 Repository 생성
+func NewTripRepository() *TripRepository {
+	return &TripRepository{trips: map[string]*domain.Trip{}}
+}
+
+// Create - 운행 저장
+func (r *TripRepository) Create(ctx context.Context, trip *domain.Trip) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *trip
+	r.trips[trip.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *TripRepository) GetByID(ctx context.Context, id string) (*domain.Trip, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	trip, ok := r.trips[id]
+	if !ok || trip.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *trip
+	return &copied, nil
+}
+
+// GetByScheduleAndDate - 일정/날짜로 조회
+func (r *TripRepository) GetByScheduleAndDate(ctx context.Context, scheduleID string, date time.Time) (*domain.Trip, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, trip := range r.trips {
+		if trip.DeletedAt == nil && trip.ScheduleID == scheduleID && sameDate(trip.Date, date) {
+			copied := *trip
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *TripRepository) List(ctx context.Context, filter repository.TripFilter) ([]*domain.Trip, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.Trip
+	for _, trip := range r.trips {
+		if trip.DeletedAt != nil {
+			continue
+		}
+		if filter.Date != nil && !sameDate(trip.Date, *filter.Date) {
+			continue
+		}
+		if filter.From != nil && trip.Date.Format(time.DateOnly) < filter.From.Format(time.DateOnly) {
+			continue
+		}
+		if filter.To != nil && trip.Date.Format(time.DateOnly) > filter.To.Format(time.DateOnly) {
+			continue
+		}
+		if filter.ScheduleIDs != nil && !slices.Contains(filter.ScheduleIDs, trip.ScheduleID) {
+			continue
+		}
+		if filter.Status != "" && trip.Status != filter.Status {
+			continue
+		}
+		if filter.ScheduleID != "" && trip.ScheduleID != filter.ScheduleID {
+			continue
+		}
+		if filter.VehicleID != "" && trip.VehicleID != filter.VehicleID {
+			continue
+		}
+		if filter.DriverID != "" && trip.AssignedDriverID != filter.DriverID {
+			continue
+		}
+		if filter.AttendantID != "" && (trip.AssignedAttendantID == nil || *trip.AssignedAttendantID != filter.AttendantID) {
+			continue
+		}
+		if filter.PassengerID != "" && !slices.ContainsFunc(trip.TripPassengers, func(p domain.TripPassenger) bool { return p.PassengerID == filter.PassengerID }) {
+			continue
+		}
+		copied := *trip
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Date.After(result[j].Date) })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 운행 수정
+func (r *TripRepository) Update(ctx context.Context, trip *domain.Trip) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.trips[trip.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *trip
+	copied.TripPassengers = existing.TripPassengers
+	r.trips[trip.ID] = &copied
+	return nil
+}
+
+// sameDate - 날짜(연/월/일) 비교
+func sameDate(a, b time.Time) bool {
+	return a.Format(time.DateOnly) == b.Format(time.DateOnly)
+}
+
+// MarkSignalLost - 운행 중이고 표시 전일 때만 위치 수신 끊김 표시
+func (r *TripRepository) MarkSignalLost(ctx context.Context, id string, at time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	trip, ok := r.trips[id]
+	if !ok || trip.DeletedAt != nil || !trip.IsInProgress() || trip.SignalLostAt != nil {
+		return false, nil
+	}
+	trip.SignalLostAt = &at
+	return true, nil
+}
+
+// MarkDelayed - 대기 중이고 표시 전일 때만 출발 지연 표시
+func (r *TripRepository) MarkDelayed(ctx context.Context, id string, at time.Time, reason string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	trip, ok := r.trips[id]
+	if !ok || trip.DeletedAt != nil || !trip.IsPending() || trip.DelayedAt != nil {
+		return false, nil
+	}
+	trip.DelayedAt = &at
+	trip.DelayReason = reason
+	return true, nil
+}
+
+// ClearSignalLost - 위치 수신 끊김 해제
+func (r *TripRepository) ClearSignalLost(ctx context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	trip, ok := r.trips[id]
+	if !ok || trip.DeletedAt != nil || trip.SignalLostAt == nil {
+		return false, nil
+	}
+	trip.SignalLostAt = nil
+	return true, nil
+}
+
+// AddDistance - 운행 중일 때만 주행 거리 누적
+func (r *TripRepository) AddDistance(ctx context.Context, id string, meters int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	trip, ok := r.trips[id]
+	if !ok || trip.DeletedAt != nil || !trip.IsInProgress() {
+		return nil
+	}
+	trip.TotalDistance += meters
+	return nil
+}
+
+// SavePassenger - 탑승자 기록 저장 (없으면 추가)
+func (r *TripRepository) SavePassenger(ctx context.Context, passenger *domain.TripPassenger) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	trip, ok := r.trips[passenger.TripID]
+	if !ok || trip.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	passengers := make([]domain.TripPassenger, 0, len(trip.TripPassengers)+1)
+	found := false
+	for _, existing := range trip.TripPassengers {
+		if existing.ID == passenger.ID {
+			existing = *passenger
+			found = true
+		}
+		passengers = append(passengers, existing)
+	}
+	if !found {
+		passengers = append(passengers, *passenger)
+	}
+	trip.TripPassengers = passengers
+	return nil
+}
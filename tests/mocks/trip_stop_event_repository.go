@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// TripStopEventRepository - 인메모리 정류장 도착/출발 기록 Repository
+type TripStopEventRepository struct {
+	mu     sync.RWMutex
+	events []domain.TripStopEvent
+}
+
+// NewTripStopEventRepository - 인메모리 정류장 도착/출발 기록 Repository 생성
+func NewTripStopEventRepository() *TripStopEventRepository {
+	return &TripStopEventRepository{}
+}
+
+// Create - 기록 저장
+func (r *TripStopEventRepository) Create(ctx context.Context, event *domain.TripStopEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, *event)
+	return nil
+}
+
+// ListByTrip - 운행의 기록 (발생 순)
+func (r *TripStopEventRepository) ListByTrip(ctx context.Context, tripID string) ([]*domain.TripStopEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var result []*domain.TripStopEvent
+	for _, event := range r.events {
+		if event.TripID == tripID {
+			copied := event
+			result = append(result, &copied)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].OccurredAt.Before(result[j].OccurredAt) })
+	return result, nil
+}
+
+// ListByTrips - 여러 운행의 기록 (운행별 발생 순)
+func (r *TripStopEventRepository) ListByTrips(ctx context.Context, tripIDs []string) ([]*domain.TripStopEvent, error) {
+	var result []*domain.TripStopEvent
+	for _, tripID := range tripIDs {
+		events, _ := r.ListByTrip(ctx, tripID)
+		result = append(result, events...)
+	}
+	return result, nil
+}
+
+// Get - 운행 + 정류장 + 종류의 기록
+func (r *TripStopEventRepository) Get(ctx context.Context, tripID, stopID string, eventType domain.StopEventType) (*domain.TripStopEvent, error) {
+	events, _ := r.ListByTrip(ctx, tripID)
+	for _, event := range events {
+		if event.StopID == stopID && event.Type == eventType {
+			return event, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
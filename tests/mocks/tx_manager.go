@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"context"
+)
+
+// TxManager - 트랜잭션 없이 fn을 그대로 실행하는 TxManager
+// 인메모리 Repository는 롤백을 지원하지 않으므로 호출 횟수만 기록
+type TxManager struct {
+	Calls int
+}
+
+// NewTxManager - 테스트용 TxManager 생성
+func NewTxManager() *TxManager {
+	return &TxManager{}
+}
+
+// WithTx - fn 실행
+func (m *TxManager) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Calls++
+	return fn(ctx)
+}
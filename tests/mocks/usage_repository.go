@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// UsageRepository - 인메모리 일일 사용량 Repository
+type UsageRepository struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+// NewUsageRepository - 인메모리 사용량 Repository 생성
+func NewUsageRepository() *UsageRepository {
+	return &UsageRepository{counts: map[string]int64{}}
+}
+
+// Increment - 요청 수 1 증가
+func (r *UsageRepository) Increment(ctx context.Context, identity string, day time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := identity + ":" + day.Format("20060102")
+	r.counts[key]++
+	return r.counts[key], nil
+}
+
+// Get - 현재 요청 수
+func (r *UsageRepository) Get(ctx context.Context, identity string, day time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.counts[identity+":"+day.Format("20060102")], nil
+}
@@ -0,0 +1,174 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// UserRepository - 인메모리 계정 Repository
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[string]*domain.User
+}
+
+// NewUserRepository - 인메모리 계정 Repository 생성
+func NewUserRepository() *UserRepository {
+	return &UserRepository{users: map[string]*domain.User{}}
+}
+
+// Create - 계정 저장
+func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user.Email = strings.ToLower(user.Email)
+	assignOrganization(ctx, &user.OrganizationID)
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+// GetByEmail - 이메일로 조회 (대소문자 무시)
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, user := range r.users {
+		if user.DeletedAt == nil && user.Email == strings.ToLower(email) {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *UserRepository) List(ctx context.Context, filter repository.UserFilter) ([]*domain.User, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.User
+	for _, user := range r.users {
+		if user.DeletedAt != nil {
+			continue
+		}
+		if filter.Role != "" && user.Role != filter.Role {
+			continue
+		}
+		if filter.Status != "" && user.Status != filter.Status {
+			continue
+		}
+		if filter.Email != "" && !strings.Contains(user.Email, strings.ToLower(filter.Email)) {
+			continue
+		}
+		if filter.ProfileID != "" && user.ProfileID != filter.ProfileID {
+			continue
+		}
+		copied := *user
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Email < result[j].Email })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 계정 수정
+func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.users[user.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+// Delete - 계정 삭제 (soft delete)
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok || user.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	user.DeletedAt = &now
+	return nil
+}
+
+// PasswordResetTokenRepository - 인메모리 재설정 토큰 Repository
+type PasswordResetTokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[string]*domain.PasswordResetToken
+}
+
+// NewPasswordResetTokenRepository - 인메모리 재설정 토큰 Repository 생성
+func NewPasswordResetTokenRepository() *PasswordResetTokenRepository {
+	return &PasswordResetTokenRepository{tokens: map[string]*domain.PasswordResetToken{}}
+}
+
+// Create - 토큰 저장
+func (r *PasswordResetTokenRepository) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *token
+	r.tokens[token.ID] = &copied
+	return nil
+}
+
+// GetByHash - 토큰 해시로 조회
+func (r *PasswordResetTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			copied := *token
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// MarkUsed - 토큰 사용 처리 (이미 사용된 토큰이면 ErrNotFound)
+func (r *PasswordResetTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token, ok := r.tokens[id]
+	if !ok || token.UsedAt != nil {
+		return repository.ErrNotFound
+	}
+	token.UsedAt = &usedAt
+	return nil
+}
+
+// InvalidateAll - 사용자의 미사용 토큰 일괄 무효화
+func (r *PasswordResetTokenRepository) InvalidateAll(ctx context.Context, userID string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.UsedAt == nil {
+			usedAt := at
+			token.UsedAt = &usedAt
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// VehicleDocumentRepository - 인메모리 차량 서류 Repository
+type VehicleDocumentRepository struct {
+	mu        sync.RWMutex
+	documents map[string]domain.VehicleDocument
+}
+
+// NewVehicleDocumentRepository - 인메모리 차량 서류 Repository 생성
+func NewVehicleDocumentRepository() *VehicleDocumentRepository {
+	return &VehicleDocumentRepository{documents: make(map[string]domain.VehicleDocument)}
+}
+
+// Create - 차량 서류 저장
+func (r *VehicleDocumentRepository) Create(ctx context.Context, document *domain.VehicleDocument) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &document.OrganizationID)
+	r.documents[document.ID] = *document
+	return nil
+}
+
+// List - 차량의 서류 목록 (업로드 시각 역순)
+func (r *VehicleDocumentRepository) List(ctx context.Context, vehicleID string, docType domain.VehicleDocumentType) ([]*domain.VehicleDocument, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var documents []*domain.VehicleDocument
+	for _, document := range r.documents {
+		if document.VehicleID != vehicleID || (docType != "" && document.Type != docType) {
+			continue
+		}
+		document := document
+		documents = append(documents, &document)
+	}
+	sort.Slice(documents, func(i, j int) bool { return documents[i].CreatedAt.After(documents[j].CreatedAt) })
+	return documents, nil
+}
+
+// GetByID - 차량의 서류 조회
+func (r *VehicleDocumentRepository) GetByID(ctx context.Context, vehicleID, id string) (*domain.VehicleDocument, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	document, ok := r.documents[id]
+	if !ok || document.VehicleID != vehicleID {
+		return nil, repository.ErrNotFound
+	}
+	return &document, nil
+}
+
+// Delete - 차량 서류 삭제
+func (r *VehicleDocumentRepository) Delete(ctx context.Context, vehicleID, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	document, ok := r.documents[id]
+	if !ok || document.VehicleID != vehicleID {
+		return repository.ErrNotFound
+	}
+	delete(r.documents, id)
+	return nil
+}
@@ -0,0 +1,157 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// 📝 설명: 테스트용 인메모리 차량 Repository
+// 🎯 실무 포인트: DB 없이 Service/Handler 단위 테스트 가능
+// ⚠️ 주의사항: 실제 SQL 동작(인덱스, 제약조건)은 검증하지 않음
+
+// VehicleRepository - 인메모리 차량 Repository
+type VehicleRepository struct {
+	mu       sync.RWMutex
+	vehicles map[string]*domain.Vehicle
+}
+
+// NewVehicleRepository - 인메모리 차량 Repository 생성
+func NewVehicleRepository() *VehicleRepository {
+	return &VehicleRepository{vehicles: map[string]*domain.Vehicle{}}
+}
+
+// Create - 차량 저장
+func (r *VehicleRepository) Create(ctx context.Context, vehicle *domain.Vehicle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *vehicle
+	r.vehicles[vehicle.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *VehicleRepository) GetByID(ctx context.Context, id string) (*domain.Vehicle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	vehicle, ok := r.vehicles[id]
+	if !ok || vehicle.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *vehicle
+	return &copied, nil
+}
+
+// GetByPlateNumber - 차량 번호로 조회
+func (r *VehicleRepository) GetByPlateNumber(ctx context.Context, plateNumber string) (*domain.Vehicle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, vehicle := range r.vehicles {
+		if vehicle.PlateNumber == plateNumber && vehicle.DeletedAt == nil {
+			copied := *vehicle
+			return &copied, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// List - 조건에 맞는 목록 조회
+func (r *VehicleRepository) List(ctx context.Context, filter repository.VehicleFilter) ([]*domain.Vehicle, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	var result []*domain.Vehicle
+	for _, vehicle := range r.vehicles {
+		if vehicle.DeletedAt != nil && !filter.IncludeDeleted {
+			continue
+		}
+		if filter.Status != "" && vehicle.Status != filter.Status {
+			continue
+		}
+		if filter.VehicleType != "" && vehicle.VehicleType != filter.VehicleType {
+			continue
+		}
+		if filter.MinCapacity != nil && vehicle.Capacity < *filter.MinCapacity {
+			continue
+		}
+		if filter.MaxCapacity != nil && vehicle.Capacity > *filter.MaxCapacity {
+			continue
+		}
+		if !matchesExpiry(vehicle.InsuranceExpiry, filter.InsuranceExpiringWithin, filter.InsuranceExpired, now) ||
+			!matchesExpiry(vehicle.InspectionExpiry, filter.InspectionExpiringWithin, filter.InspectionExpired, now) {
+			continue
+		}
+		copied := *vehicle
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].PlateNumber < result[j].PlateNumber })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// Update - 차량 수정
+func (r *VehicleRepository) Update(ctx context.Context, vehicle *domain.Vehicle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.vehicles[vehicle.ID]
+	if !ok || existing.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	copied := *vehicle
+	r.vehicles[vehicle.ID] = &copied
+	return nil
+}
+
+// SoftDelete - 차량 삭제
+func (r *VehicleRepository) SoftDelete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vehicle, ok := r.vehicles[id]
+	if !ok || vehicle.DeletedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := time.Now()
+	vehicle.DeletedAt = &now
+	return nil
+}
+
+// GetDeleted - 삭제된 차량 조회
+func (r *VehicleRepository) GetDeleted(ctx context.Context, id string) (*domain.Vehicle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	vehicle, ok := r.vehicles[id]
+	if !ok || vehicle.DeletedAt == nil {
+		return nil, repository.ErrNotFound
+	}
+	copied := *vehicle
+	return &copied, nil
+}
+
+// Restore - 삭제된 차량 복구
+func (r *VehicleRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vehicle, ok := r.vehicles[id]
+	if !ok || vehicle.DeletedAt == nil {
+		return repository.ErrNotFound
+	}
+	vehicle.DeletedAt = nil
+	return nil
+}
+
+// matchesExpiry - 만료일 조건 (Repository의 expiryScope와 동일)
+func matchesExpiry(expiry *time.Time, within *int, expired *bool, now time.Time) bool {
+	if within != nil && (expiry == nil || !expiry.Before(now.AddDate(0, 0, *within))) {
+		return false
+	}
+	if expired != nil && *expired != (expiry != nil && expiry.Before(now)) {
+		return false
+	}
+	return true
+}
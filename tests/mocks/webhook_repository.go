@@ -0,0 +1,187 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+)
+
+// WebhookSubscriptionRepository - 인메모리 웹훅 구독 Repository
+type WebhookSubscriptionRepository struct {
+	mu            sync.RWMutex
+	subscriptions map[string]*domain.WebhookSubscription
+}
+
+// NewWebhookSubscriptionRepository - 인메모리 웹훅 구독 Repository 생성
+func NewWebhookSubscriptionRepository() *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{subscriptions: map[string]*domain.WebhookSubscription{}}
+}
+
+// Create - 웹훅 구독 저장
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &subscription.OrganizationID)
+	copied := *subscription
+	r.subscriptions[subscription.ID] = &copied
+	return nil
+}
+
+// GetByID - ID로 조회
+func (r *WebhookSubscriptionRepository) GetByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	copied := *subscription
+	return &copied, nil
+}
+
+// List - 조건에 맞는 목록 조회 (최신순)
+func (r *WebhookSubscriptionRepository) List(ctx context.Context, filter repository.WebhookSubscriptionFilter) ([]*domain.WebhookSubscription, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.WebhookSubscription, 0, len(r.subscriptions))
+	for _, subscription := range r.subscriptions {
+		copied := *subscription
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
+
+// ListActiveByEvent - 기관에서 이벤트를 받는 활성 구독 목록 (등록 순)
+func (r *WebhookSubscriptionRepository) ListActiveByEvent(ctx context.Context, organizationID string, event domain.WebhookEvent) ([]*domain.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.WebhookSubscription
+	for _, subscription := range r.subscriptions {
+		if subscription.OrganizationID == organizationID && subscription.Subscribes(event) {
+			copied := *subscription
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result, nil
+}
+
+// Update - 웹훅 구독 수정
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.subscriptions[subscription.ID]; !ok {
+		return repository.ErrNotFound
+	}
+	subscription.UpdatedAt = time.Now()
+	copied := *subscription
+	r.subscriptions[subscription.ID] = &copied
+	return nil
+}
+
+// Delete - 웹훅 구독 삭제
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.subscriptions[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.subscriptions, id)
+	return nil
+}
+
+// WebhookDeliveryRepository - 인메모리 웹훅 전달 기록 Repository (생성 순서 유지)
+type WebhookDeliveryRepository struct {
+	mu         sync.RWMutex
+	deliveries []*domain.WebhookDelivery
+}
+
+// NewWebhookDeliveryRepository - 인메모리 웹훅 전달 기록 Repository 생성
+func NewWebhookDeliveryRepository() *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{}
+}
+
+// Create - 전달 기록 저장
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignOrganization(ctx, &delivery.OrganizationID)
+	copied := *delivery
+	r.deliveries = append(r.deliveries, &copied)
+	return nil
+}
+
+// Update - 전달 결과 반영
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.deliveries {
+		if existing.ID == delivery.ID {
+			copied := *delivery
+			r.deliveries[i] = &copied
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+// ClaimDue - 전달할 때가 된 기록(pending/retrying)을 가져가고 lease만큼 미룸
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*domain.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		pending := delivery.Status == domain.WebhookDeliveryPending || delivery.Status == domain.WebhookDeliveryRetrying
+		if pending && delivery.NextAttemptAt != nil && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(*due[j].NextAttemptAt) })
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	result := make([]*domain.WebhookDelivery, len(due))
+	leased := now.Add(lease)
+	for i, delivery := range due {
+		copied := *delivery
+		result[i] = &copied
+		delivery.NextAttemptAt = &leased
+	}
+	return result, nil
+}
+
+// List - 조건에 맞는 목록 조회 (최신순)
+func (r *WebhookDeliveryRepository) List(ctx context.Context, filter repository.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*domain.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if filter.SubscriptionID != "" && delivery.SubscriptionID != filter.SubscriptionID {
+			continue
+		}
+		if filter.Status != "" && delivery.Status != filter.Status {
+			continue
+		}
+		if filter.Event != "" && delivery.Event != filter.Event {
+			continue
+		}
+		copied := *delivery
+		result = append(result, &copied)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+
+	result = applyListOptions(result, filter.ListOptions)
+	return paginate(result, filter.Offset, filter.Limit), int64(len(result)), nil
+}
@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/hyeokjun/eodini/pkg/webhook"
+)
+
+// WebhookSender - 웹훅 전달 대역 (전달 내역 기록)
+type WebhookSender struct {
+	mu     sync.Mutex
+	Sent   []webhook.Message
+	Status int   // 설정 시 이 상태로 응답 (기본 200)
+	Err    error // 설정 시 Send가 이 에러 반환 (수신 서버 장애 재현)
+}
+
+// NewWebhookSender - 웹훅 전달 대역 생성
+func NewWebhookSender() *WebhookSender {
+	return &WebhookSender{}
+}
+
+// Send - 전달 기록
+func (s *WebhookSender) Send(ctx context.Context, msg *webhook.Message) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Sent = append(s.Sent, *msg)
+	status := s.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return status, s.Err
+}
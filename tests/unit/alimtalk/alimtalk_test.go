@@ -0,0 +1,138 @@
+package alimtalk_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/alimtalk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var boardedTemplate = alimtalk.Template{
+	Code: "EODINI_BOARD_01",
+	Text: "#{passenger} 어린이가 #{stop}에서 #{time}에 승차했습니다.",
+}
+
+// TestTemplate_Message - 변수 치환, 값이 없는 변수는 에러
+func TestTemplate_Message(t *testing.T) {
+	// When
+	msg, err := boardedTemplate.Message("010-1234-5678", map[string]string{"passenger": "김민준", "stop": "정문", "time": "08:10"})
+	_, missingErr := boardedTemplate.Message("01012345678", map[string]string{"passenger": "김민준"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "EODINI_BOARD_01", msg.TemplateCode)
+	assert.Equal(t, "김민준 어린이가 정문에서 08:10에 승차했습니다.", msg.Text)
+	assert.ErrorIs(t, missingErr, alimtalk.ErrMissingVariable)
+	assert.Contains(t, missingErr.Error(), "stop")
+}
+
+// TestParseTemplates - 템플릿 파일 해석, 코드/본문 누락은 에러
+func TestParseTemplates(t *testing.T) {
+	templates, err := alimtalk.ParseTemplates([]byte(`{"boarded": {"code": "EODINI_BOARD_01", "text": "#{passenger} 승차"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "EODINI_BOARD_01", templates["boarded"].Code)
+
+	_, err = alimtalk.ParseTemplates([]byte(`{"alighted": {"text": "#{passenger} 하차"}}`))
+	assert.ErrorIs(t, err, alimtalk.ErrTemplateInvalid)
+
+	_, err = alimtalk.ParseTemplates([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+// TestAligoClient_Send - 치환된 본문 전체를 전송, code가 0이 아니면 실패
+func TestAligoClient_Send(t *testing.T) {
+	// Given
+	var form map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/akv10/alimtalk/send/", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		form = map[string]string{
+			"senderkey":  r.PostForm.Get("senderkey"),
+			"tpl_code":   r.PostForm.Get("tpl_code"),
+			"sender":     r.PostForm.Get("sender"),
+			"receiver_1": r.PostForm.Get("receiver_1"),
+			"message_1":  r.PostForm.Get("message_1"),
+		}
+		if r.PostForm.Get("receiver_1") == "01099999999" {
+			_, _ = w.Write([]byte(`{"code":-99,"message":"템플릿 내용이 일치하지 않습니다."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":0,"message":"성공적으로 전송요청 하였습니다.","info":{"type":"AT","scnt":1,"fcnt":0}}`))
+	}))
+	defer server.Close()
+	client := alimtalk.NewAligoClient("api-key", "eodini", "sender-key", "0212345678", time.Second)
+	client.BaseURL = server.URL
+	msg, err := boardedTemplate.Message("010-1234-5678", map[string]string{"passenger": "김민준", "stop": "정문", "time": "08:10"})
+	require.NoError(t, err)
+
+	// When
+	sendErr := client.Send(context.Background(), msg)
+	msg.To = "01099999999"
+	failedErr := client.Send(context.Background(), msg)
+
+	// Then
+	require.NoError(t, sendErr)
+	require.Error(t, failedErr)
+	assert.Contains(t, failedErr.Error(), "-99")
+	assert.Equal(t, map[string]string{
+		"senderkey":  "sender-key",
+		"tpl_code":   "EODINI_BOARD_01",
+		"sender":     "0212345678",
+		"receiver_1": "01099999999",
+		"message_1":  "김민준 어린이가 정문에서 08:10에 승차했습니다.",
+	}, form)
+}
+
+// TestNHNClient_Send - 템플릿 변수만 전송, 수신자별 결과 코드 확인
+func TestNHNClient_Send(t *testing.T) {
+	// Given
+	var body struct {
+		SenderKey     string `json:"senderKey"`
+		TemplateCode  string `json:"templateCode"`
+		RecipientList []struct {
+			RecipientNo       string            `json:"recipientNo"`
+			TemplateParameter map[string]string `json:"templateParameter"`
+		} `json:"recipientList"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/alimtalk/v2.3/appkeys/app-key/messages", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("X-Secret-Key"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		resultCode := "0"
+		if body.RecipientList[0].RecipientNo == "01099999999" {
+			resultCode = "-3001"
+		}
+		_, _ = w.Write([]byte(`{"header":{"isSuccessful":true,"resultCode":0,"resultMessage":"success"},` +
+			`"message":{"requestId":"r-1","sendResults":[{"recipientSeq":1,"resultCode":` + resultCode + `,"resultMessage":"result"}]}}`))
+	}))
+	defer server.Close()
+	client := alimtalk.NewNHNClient("app-key", "secret", "sender-key", time.Second)
+	client.BaseURL = server.URL
+	variables := map[string]string{"passenger": "김민준", "stop": "정문", "time": "08:10"}
+	msg, err := boardedTemplate.Message("010-1234-5678", variables)
+	require.NoError(t, err)
+
+	// When
+	sendErr := client.Send(context.Background(), msg)
+
+	// Then
+	require.NoError(t, sendErr)
+	assert.Equal(t, "sender-key", body.SenderKey)
+	assert.Equal(t, "EODINI_BOARD_01", body.TemplateCode)
+	assert.Equal(t, "01012345678", body.RecipientList[0].RecipientNo)
+	assert.Equal(t, variables, body.RecipientList[0].TemplateParameter)
+
+	// When - 수신자 결과 실패
+	msg.To = "01099999999"
+	failedErr := client.Send(context.Background(), msg)
+
+	// Then
+	require.Error(t, failedErr)
+	assert.Contains(t, failedErr.Error(), "-3001")
+}
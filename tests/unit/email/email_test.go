@@ -0,0 +1,188 @@
+package email_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/email"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripFunc - 고정 호스트로 보낸 요청을 가로채는 Transport (서명에 호스트가 포함되므로 httptest 대신 사용)
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestSESClient_Send - SigV4 서명과 SES v2 요청 본문 검증
+func TestSESClient_Send(t *testing.T) {
+	// Given
+	var captured *http.Request
+	var body []byte
+	client := email.NewSESClient("ap-northeast-2", "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "no-reply@eodini.kr", time.Second)
+	client.Now = func() time.Time { return time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC) }
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		captured = req
+		body, _ = io.ReadAll(req.Body)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"MessageId":"m-1"}`)), Header: http.Header{}}, nil
+	})
+
+	// When
+	err := client.Send(context.Background(), &email.Message{To: []string{"admin@eodini.kr"}, Subject: "테스트", HTML: "<p>안녕하세요</p>"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "https://email.ap-northeast-2.amazonaws.com/v2/email/outbound-emails", captured.URL.String())
+	assert.Equal(t, "20250303T000000Z", captured.Header.Get("X-Amz-Date"))
+	// 기대 서명은 AWS SigV4 절차로 별도 계산한 값
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20250303/ap-northeast-2/ses/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date, "+
+		"Signature=999cead6411cd11c0b4083ae2c55f49e006779935d053fe5cc79263b8687d6e1", captured.Header.Get("Authorization"))
+
+	var request map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &request))
+	assert.Equal(t, "no-reply@eodini.kr", request["FromEmailAddress"])
+	simple := request["Content"].(map[string]interface{})["Simple"].(map[string]interface{})
+	assert.Equal(t, "테스트", simple["Subject"].(map[string]interface{})["Data"])
+}
+
+// TestSESClient_Send_Error - 2xx가 아니면 응답 본문을 포함한 에러
+func TestSESClient_Send_Error(t *testing.T) {
+	// Given
+	client := email.NewSESClient("ap-northeast-2", "AKIDEXAMPLE", "secret", "no-reply@eodini.kr", time.Second)
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(`{"message":"Email address is not verified."}`)), Header: http.Header{}}, nil
+	})
+
+	// When
+	err := client.Send(context.Background(), &email.Message{To: []string{"admin@eodini.kr"}, Subject: "테스트", HTML: "<p>본문</p>"})
+	noRecipientErr := client.Send(context.Background(), &email.Message{Subject: "테스트"})
+
+	// Then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not verified")
+	assert.ErrorIs(t, noRecipientErr, email.ErrNoRecipients)
+}
+
+// fakeSMTPServer - 명령과 DATA 본문을 기록하는 최소 SMTP 서버 (STARTTLS 미지원, PLAIN 인증 허용)
+func fakeSMTPServer(t *testing.T) (addr string, commands *[]string, data *string, done <-chan struct{}) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	commands = &[]string{}
+	data = new(string)
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			*commands = append(*commands, line)
+			switch {
+			case strings.HasPrefix(line, "EHLO"):
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case strings.HasPrefix(line, "AUTH"):
+				reply("235 2.7.0 Authentication successful")
+			case line == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var buf strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil || dataLine == ".\r\n" {
+						break
+					}
+					buf.WriteString(dataLine)
+				}
+				*data = buf.String()
+				reply("250 OK")
+			case line == "QUIT":
+				reply("221 Bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return listener.Addr().String(), commands, data, finished
+}
+
+// TestSMTPClient_Send - 인증 후 봉투 주소와 MIME 헤더 전송
+func TestSMTPClient_Send(t *testing.T) {
+	// Given
+	addr, commands, data, done := fakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(addr)
+	client := email.NewSMTPClient(host, port, "mailer", "secret", "어디니 <no-reply@eodini.kr>", time.Second)
+
+	// When
+	err := client.Send(context.Background(), &email.Message{
+		To:      []string{"admin@eodini.kr", "ops@eodini.kr"},
+		Subject: "[어디니] 비밀번호 재설정 안내",
+		HTML:    "<p>재설정 코드</p>",
+	})
+
+	// Then
+	require.NoError(t, err)
+	<-done
+	assert.Contains(t, *commands, "AUTH PLAIN AG1haWxlcgBzZWNyZXQ=")
+	assert.Contains(t, *commands, "MAIL FROM:<no-reply@eodini.kr>")
+	assert.Contains(t, *commands, "RCPT TO:<admin@eodini.kr>")
+	assert.Contains(t, *commands, "RCPT TO:<ops@eodini.kr>")
+	assert.Contains(t, *data, "To: admin@eodini.kr, ops@eodini.kr\r\n")
+	assert.Contains(t, *data, "Subject: =?UTF-8?b?")
+	assert.Contains(t, *data, "Content-Type: text/html; charset=UTF-8\r\n")
+}
+
+// TestTemplates_Render - 레이아웃 공유, 제목은 이스케이프 해제, 본문 값은 이스케이프
+func TestTemplates_Render(t *testing.T) {
+	// Given
+	fsys := fstest.MapFS{
+		"layout.html":  {Data: []byte(`<title>{{template "subject" .}}</title><main>{{template "content" .}}</main>`)},
+		"welcome.html": {Data: []byte(`{{define "subject"}}{{.Name}} & 환영{{end}}{{define "content"}}<p>{{.Name}}</p>{{end}}`)},
+	}
+	templates, err := email.ParseTemplates(fsys)
+	require.NoError(t, err)
+
+	// When
+	subject, body, err := templates.Render("welcome", map[string]string{"Name": "<김민준>"})
+	_, _, missingErr := templates.Render("missing", nil)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "<김민준> & 환영", subject)
+	assert.Contains(t, body, "<main><p>&lt;김민준&gt;</p></main>")
+	assert.ErrorIs(t, missingErr, email.ErrTemplateNotFound)
+
+	// When - subject 정의가 없는 템플릿
+	_, err = email.ParseTemplates(fstest.MapFS{
+		"layout.html": {Data: []byte(`{{template "content" .}}`)},
+		"broken.html": {Data: []byte(`{{define "content"}}본문{{end}}`)},
+	})
+
+	// Then
+	assert.Error(t, err)
+}
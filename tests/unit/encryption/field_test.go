@@ -0,0 +1,95 @@
+package encryption_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyeokjun/eodini/pkg/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCipher - 고정 키 암호화기
+func newCipher(t *testing.T, fill byte) *encryption.FieldCipher {
+	cipher, err := encryption.NewFieldCipher(bytes.Repeat([]byte{fill}, encryption.KeySize))
+	require.NoError(t, err)
+	return cipher
+}
+
+// TestFieldCipher_RoundTrip - 암호화 후 복호화하면 원문, 같은 평문도 매번 다른 암호문
+func TestFieldCipher_RoundTrip(t *testing.T) {
+	// Given
+	cipher := newCipher(t, 1)
+
+	// When
+	first, err := cipher.Encrypt("땅콩 알레르기, 에피펜 소지")
+	require.NoError(t, err)
+	second, err := cipher.Encrypt("땅콩 알레르기, 에피펜 소지")
+	require.NoError(t, err)
+
+	// Then
+	assert.True(t, encryption.IsEncrypted(first))
+	assert.NotContains(t, first, "알레르기")
+	assert.NotEqual(t, first, second)
+
+	plaintext, err := cipher.Decrypt(first)
+	require.NoError(t, err)
+	assert.Equal(t, "땅콩 알레르기, 에피펜 소지", plaintext)
+}
+
+// TestFieldCipher_PlaintextPassthrough - 빈 값과 암호화 도입 전 평문은 그대로
+func TestFieldCipher_PlaintextPassthrough(t *testing.T) {
+	// Given
+	cipher := newCipher(t, 1)
+
+	// When & Then
+	empty, err := cipher.Encrypt("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	legacy, err := cipher.Decrypt("010-1234-5678")
+	require.NoError(t, err)
+	assert.Equal(t, "010-1234-5678", legacy)
+}
+
+// TestFieldCipher_WrongKey - 다른 키나 변조된 암호문은 복호화 실패
+func TestFieldCipher_WrongKey(t *testing.T) {
+	// Given
+	encrypted, err := newCipher(t, 1).Encrypt("010-1234-5678")
+	require.NoError(t, err)
+
+	tampered := []byte(encrypted)
+	tampered[len(tampered)-5] ^= 0x01 // base64 문자 한 글자 변경
+
+	// When
+	_, wrongKeyErr := newCipher(t, 2).Decrypt(encrypted)
+	_, tamperedErr := newCipher(t, 1).Decrypt(string(tampered))
+
+	// Then
+	assert.ErrorIs(t, wrongKeyErr, encryption.ErrInvalidCiphertext)
+	assert.ErrorIs(t, tamperedErr, encryption.ErrInvalidCiphertext)
+}
+
+// TestFieldCipher_BlindIndex - 같은 키/값이면 같은 해시, 키가 다르면 다른 해시
+func TestFieldCipher_BlindIndex(t *testing.T) {
+	// Given
+	cipher := newCipher(t, 1)
+
+	// When & Then
+	assert.Equal(t, cipher.BlindIndex("010-1234-5678"), cipher.BlindIndex("010-1234-5678"))
+	assert.NotEqual(t, cipher.BlindIndex("010-1234-5678"), cipher.BlindIndex("010-1234-5679"))
+	assert.NotEqual(t, cipher.BlindIndex("010-1234-5678"), newCipher(t, 2).BlindIndex("010-1234-5678"))
+	assert.Len(t, cipher.BlindIndex("010-1234-5678"), 64)
+}
+
+// TestNewFieldCipherFromBase64 - 키 형식 검증
+func TestNewFieldCipherFromBase64(t *testing.T) {
+	_, err := encryption.NewFieldCipherFromBase64("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+	assert.NoError(t, err)
+
+	_, err = encryption.NewFieldCipherFromBase64("c2hvcnQta2V5")
+	assert.Error(t, err)
+
+	_, err = encryption.NewFieldCipherFromBase64("not base64")
+	assert.Error(t, err)
+}
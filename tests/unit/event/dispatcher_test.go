@@ -0,0 +1,70 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDispatcher_Dispatch - 종류가 맞는 핸들러만 등록 순으로 실행, 실패해도 나머지 실행, 성공한 핸들러 이름 반환
+func TestDispatcher_Dispatch(t *testing.T) {
+	// Given
+	var calls []string
+	record := func(name string, err error) event.HandlerFunc {
+		return func(ctx context.Context, e event.Event) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+	dispatcher := event.NewDispatcher()
+	dispatcher.Subscribe("webhook", record("webhook", nil))
+	dispatcher.Subscribe("notification", record("notification", errors.New("sms down")), "passenger.boarded")
+	dispatcher.Subscribe("stats", record("stats", nil), "trip.completed")
+	e := event.Event{ID: "event-1", Type: "passenger.boarded", Payload: []byte(`{"trip_id":"trip-1"}`)}
+
+	// When
+	done, err := dispatcher.Dispatch(context.Background(), e, nil)
+
+	// Then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notification: sms down")
+	assert.Equal(t, []string{"webhook", "notification"}, calls)
+	assert.Equal(t, []string{"webhook"}, done)
+
+	// When - 재시도 시 성공한 핸들러는 건너뜀
+	calls = nil
+	done, err = dispatcher.Dispatch(context.Background(), e, done)
+
+	// Then
+	require.Error(t, err)
+	assert.Equal(t, []string{"notification"}, calls)
+	assert.Equal(t, []string{"webhook"}, done)
+}
+
+// TestDispatcher_Panic - 핸들러 패닉은 에러로 처리
+func TestDispatcher_Panic(t *testing.T) {
+	dispatcher := event.NewDispatcher()
+	dispatcher.Subscribe("broken", func(ctx context.Context, e event.Event) error { panic("boom") })
+
+	done, err := dispatcher.Dispatch(context.Background(), event.Event{Type: "trip.started"}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "panic: boom")
+	assert.Empty(t, done)
+}
+
+// TestEvent_Decode - Payload 해석
+func TestEvent_Decode(t *testing.T) {
+	var data struct {
+		TripID string `json:"trip_id"`
+	}
+
+	err := event.Event{Payload: []byte(`{"trip_id":"trip-1"}`)}.Decode(&data)
+
+	require.NoError(t, err)
+	assert.Equal(t, "trip-1", data.TripID)
+}
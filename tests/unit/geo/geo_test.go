@@ -0,0 +1,70 @@
+package geo_test
+
+import (
+	"testing"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDistance - 서울시청~강남역 약 8.9km
+func TestDistance(t *testing.T) {
+	cityHall := geo.Point{Latitude: 37.5665, Longitude: 126.9780}
+	gangnam := geo.Point{Latitude: 37.4979, Longitude: 127.0276}
+
+	assert.InDelta(t, 8850, geo.Distance(cityHall, gangnam), 100)
+	assert.Zero(t, geo.Distance(cityHall, cityHall))
+}
+
+// TestInterpolate - 두 좌표 사이 비율 지점
+func TestInterpolate(t *testing.T) {
+	a := geo.Point{Latitude: 37.5, Longitude: 127.0}
+	b := geo.Point{Latitude: 37.6, Longitude: 127.2}
+
+	mid := geo.Interpolate(a, b, 0.5)
+	assert.InDelta(t, 37.55, mid.Latitude, 1e-9)
+	assert.InDelta(t, 127.1, mid.Longitude, 1e-9)
+	assert.Equal(t, a, geo.Interpolate(a, b, 0))
+}
+
+// TestSimplify - 허용 오차 이내 중간 좌표 제거, 처음/마지막 유지
+func TestSimplify(t *testing.T) {
+	points := []geo.Point{
+		{Latitude: 37.5000, Longitude: 127.0000},
+		{Latitude: 37.5005, Longitude: 127.00001}, // 직선에서 약 1m 벗어남
+		{Latitude: 37.5010, Longitude: 127.0000},
+		{Latitude: 37.5010, Longitude: 127.0010}, // 꺾인 지점 다음
+	}
+
+	assert.Equal(t, []int{0, 2, 3}, geo.Simplify(points, 10))
+	assert.Equal(t, []int{0, 1, 2, 3}, geo.Simplify(points, 0))
+	assert.Equal(t, []int{0}, geo.Simplify(points[:1], 10))
+	assert.Empty(t, geo.Simplify(nil, 10))
+}
+
+// TestEncodePolyline - Google 폴리라인 알고리즘 문서 예시
+func TestEncodePolyline(t *testing.T) {
+	points := []geo.Point{
+		{Latitude: 38.5, Longitude: -120.2},
+		{Latitude: 40.7, Longitude: -120.95},
+		{Latitude: 43.252, Longitude: -126.453},
+	}
+
+	assert.Equal(t, "_p~iF~ps|U_ulLnnqC_mqNvxq`@", geo.EncodePolyline(points))
+	assert.Empty(t, geo.EncodePolyline(nil))
+}
+
+// TestDecodePolyline - 인코딩 예시를 원래 좌표로 복원, 잘린 문자열은 에러
+func TestDecodePolyline(t *testing.T) {
+	points, err := geo.DecodePolyline("_p~iF~ps|U_ulLnnqC_mqNvxq`@")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []geo.Point{
+		{Latitude: 38.5, Longitude: -120.2},
+		{Latitude: 40.7, Longitude: -120.95},
+		{Latitude: 43.252, Longitude: -126.453},
+	}, points)
+
+	_, err = geo.DecodePolyline("_p~iF~ps|U_ulL")
+	assert.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package geofence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 위도 0.001도 ≈ 111m
+var stops = []domain.Stop{
+	{ID: "stop-1", Name: "OO아파트 정문", Order: 1, Latitude: 37.500, Longitude: 127.0},
+	{ID: "stop-2", Name: "OO초등학교", Order: 2, Latitude: 37.510, Longitude: 127.0},
+}
+
+// at - trip-1 차량 위치
+func at(lat float64) *domain.VehiclePosition {
+	return &domain.VehiclePosition{TripID: "trip-1", VehicleID: "vehicle-1", Latitude: lat, Longitude: 127.0, RecordedAt: time.Now()}
+}
+
+// types - 이벤트 종류만 추출
+func types(events []geofence.Event) []geofence.EventType {
+	result := make([]geofence.EventType, len(events))
+	for i, event := range events {
+		result[i] = event.Type
+	}
+	return result
+}
+
+// TestEngine_Evaluate - 정류장 1을 향해 접근 → 도착 → 출발, 단계가 바뀔 때만 이벤트
+func TestEngine_Evaluate(t *testing.T) {
+	// Given
+	var received []geofence.Event
+	engine := geofence.NewEngine(geofence.DefaultConfig(), geofence.NewMemoryStore(), geofence.ListenerFunc(func(ctx context.Context, event geofence.Event) {
+		received = append(received, event)
+	}))
+	ctx := context.Background()
+
+	// When & Then
+	assert.Empty(t, engine.Evaluate(ctx, at(37.496), stops)) // 약 444m 밖
+
+	events := engine.Evaluate(ctx, at(37.498), stops) // 약 222m
+	require.Equal(t, []geofence.EventType{geofence.EventApproaching}, types(events))
+	assert.Equal(t, "stop-1", events[0].StopID)
+	assert.Equal(t, 1, events[0].StopOrder)
+	assert.InDelta(t, 222, events[0].Distance, 5)
+
+	assert.Empty(t, engine.Evaluate(ctx, at(37.4985), stops)) // 여전히 접근 중
+
+	events = engine.Evaluate(ctx, at(37.5001), stops) // 약 11m
+	assert.Equal(t, []geofence.EventType{geofence.EventArrived}, types(events))
+
+	assert.Empty(t, engine.Evaluate(ctx, at(37.5006), stops)) // 약 67m, 출발 반경 안 (GPS 튐)
+
+	events = engine.Evaluate(ctx, at(37.501), stops) // 약 111m
+	assert.Equal(t, []geofence.EventType{geofence.EventDeparted}, types(events))
+
+	assert.Empty(t, engine.Evaluate(ctx, at(37.5), stops)) // 출발 후 재진입은 무시
+	assert.Len(t, received, 3)
+}
+
+// TestEngine_Evaluate_DirectArrival - 접근 판정 없이 바로 도착 반경에 들어와도 도착 이벤트
+func TestEngine_Evaluate_DirectArrival(t *testing.T) {
+	// Given
+	engine := geofence.NewEngine(geofence.DefaultConfig(), geofence.NewMemoryStore())
+
+	// When
+	events := engine.Evaluate(context.Background(), at(37.510), stops)
+
+	// Then
+	require.Len(t, events, 1)
+	assert.Equal(t, geofence.EventArrived, events[0].Type)
+	assert.Equal(t, "stop-2", events[0].StopID)
+	assert.Equal(t, "vehicle-1", events[0].VehicleID)
+}
+
+// TestEngine_Evaluate_PassBy - 도착 없이 접근 반경을 벗어나면 다시 접근 가능
+func TestEngine_Evaluate_PassBy(t *testing.T) {
+	// Given
+	engine := geofence.NewEngine(geofence.DefaultConfig(), geofence.NewMemoryStore())
+	ctx := context.Background()
+	require.Len(t, engine.Evaluate(ctx, at(37.498), stops), 1)
+
+	// When
+	left := engine.Evaluate(ctx, at(37.496), stops)
+	again := engine.Evaluate(ctx, at(37.498), stops)
+
+	// Then
+	assert.Empty(t, left)
+	assert.Equal(t, []geofence.EventType{geofence.EventApproaching}, types(again))
+}
+
+// TestEngine_Reset - 초기화하면 같은 운행을 처음부터 판정
+func TestEngine_Reset(t *testing.T) {
+	// Given
+	engine := geofence.NewEngine(geofence.DefaultConfig(), geofence.NewMemoryStore())
+	ctx := context.Background()
+	require.Len(t, engine.Evaluate(ctx, at(37.5), stops), 1)
+
+	// When
+	require.NoError(t, engine.Reset(ctx, "trip-1"))
+
+	// Then
+	assert.Len(t, engine.Evaluate(ctx, at(37.5), stops), 1)
+}
+
+// failingStore - 항상 실패하는 저장소
+type failingStore struct{}
+
+func (failingStore) Load(ctx context.Context, tripID string) (map[string]geofence.Phase, error) {
+	return nil, errors.New("redis down")
+}
+
+func (failingStore) Save(ctx context.Context, tripID string, changes map[string]geofence.Phase) error {
+	return errors.New("redis down")
+}
+
+func (failingStore) Clear(ctx context.Context, tripID string) error {
+	return errors.New("redis down")
+}
+
+// TestEngine_Evaluate_StoreUnavailable - 저장소 오류 시 판정을 건너뛰고 이벤트 없음
+func TestEngine_Evaluate_StoreUnavailable(t *testing.T) {
+	// Given
+	called := false
+	engine := geofence.NewEngine(geofence.DefaultConfig(), failingStore{}, geofence.ListenerFunc(func(ctx context.Context, event geofence.Event) {
+		called = true
+	}))
+
+	// When
+	events := engine.Evaluate(context.Background(), at(37.5), stops)
+
+	// Then
+	assert.Empty(t, events)
+	assert.False(t, called)
+}
@@ -0,0 +1,287 @@
+package grpcserver_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	eodiniv1 "github.com/hyeokjun/eodini/api/eodini/v1"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/grpcserver"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testTokens = auth.NewTokenManager("test-secret", time.Hour)
+	driver     = &auth.Principal{UserID: "u-1", OrganizationID: "org-1", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	guardian   = &auth.Principal{UserID: "u-2", OrganizationID: "org-1", Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+)
+
+// fixture - 운행 중인 운행 하나와 bufconn으로 연결한 gRPC 클라이언트
+type fixture struct {
+	conn       *grpc.ClientConn
+	apiKeys    *service.APIKeyService
+	scheduleID string
+	tripID     string
+}
+
+// newFixture - 일정/운행을 만들고 gRPC 서버를 메모리 연결로 실행
+func newFixture(t *testing.T) *fixture {
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	tripService := service.NewTripService(mocks.NewTripRepository(), scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	trip, err := tripService.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2025-03-03"})
+	require.NoError(t, err)
+	_, err = tripService.Start(auth.WithPrincipal(ctx, driver), trip.ID, &dto.TripLocationRequest{})
+	require.NoError(t, err)
+
+	apiKeys := service.NewAPIKeyService(mocks.NewAPIKeyRepository())
+	srv := grpcserver.NewServer(&grpcserver.Services{
+		Tokens:     testTokens,
+		APIKeyAuth: apiKeys,
+		Trip:       tripService,
+		Schedule:   service.NewScheduleService(scheduleRepo, mocks.NewRouteRepository(), mocks.NewVehicleRepository(), mocks.NewDriverRepository(), mocks.NewPassengerRepository()),
+		Tracking:   service.NewTrackingService(tripService, scheduleRepo, mocks.NewRouteRepository(), mocks.NewGuardianRepository(), mocks.NewPassengerRepository(), mocks.NewTripLocationRepository(), realtime.NewHub(), nil),
+	})
+
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return &fixture{conn: conn, apiKeys: apiKeys, scheduleID: schedule.ID, tripID: trip.ID}
+}
+
+// as - 사용자 토큰을 메타데이터로 담은 context
+func as(t *testing.T, principal *auth.Principal) context.Context {
+	token, err := testTokens.Issue(principal)
+	require.NoError(t, err)
+	return metadata.AppendToOutgoingContext(t.Context(), "authorization", "Bearer "+token)
+}
+
+// withAPIKey - 지정한 scope의 API 키를 발급해 메타데이터로 담은 context
+func (f *fixture) withAPIKey(t *testing.T, scopes ...string) context.Context {
+	issued, err := f.apiKeys.Issue(repository.WithOrganization(context.Background(), "org-1"), "admin-1", &dto.IssueAPIKeyRequest{Name: "텔레매틱스 게이트웨이", Scopes: scopes})
+	require.NoError(t, err)
+	return metadata.AppendToOutgoingContext(t.Context(), "x-api-key", issued.Key)
+}
+
+// assertStatus - gRPC 상태 코드와 에러 코드(ErrorInfo.reason) 확인
+func assertStatus(t *testing.T, err error, code codes.Code, reason string) *status.Status {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "gRPC 상태 에러여야 함: %v", err)
+	assert.Equal(t, code, st.Code())
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			assert.Equal(t, reason, info.Reason)
+			return st
+		}
+	}
+	t.Errorf("ErrorInfo 상세가 없음: %v", st.Details())
+	return st
+}
+
+// TestServer_Authentication - 인증/인가는 REST와 같은 규칙 (운영 인력 또는 scope를 가진 API 키, 헬스 체크는 인증 없이)
+func TestServer_Authentication(t *testing.T) {
+	// Given
+	f := newFixture(t)
+	trips := eodiniv1.NewTripServiceClient(f.conn)
+	req := &eodiniv1.GetTripRequest{Id: f.tripID}
+
+	// When & Then
+	_, err := trips.GetTrip(t.Context(), req)
+	assertStatus(t, err, codes.Unauthenticated, "UNAUTHORIZED")
+
+	_, err = trips.GetTrip(metadata.AppendToOutgoingContext(t.Context(), "authorization", "Bearer invalid"), req)
+	assertStatus(t, err, codes.Unauthenticated, "UNAUTHORIZED")
+
+	_, err = trips.GetTrip(as(t, guardian), req)
+	assertStatus(t, err, codes.PermissionDenied, "FORBIDDEN")
+
+	_, err = trips.GetTrip(f.withAPIKey(t, "locations:write"), req)
+	assertStatus(t, err, codes.PermissionDenied, "FORBIDDEN")
+
+	_, err = trips.GetTrip(metadata.AppendToOutgoingContext(t.Context(), "x-api-key", "eod_unknown"), req)
+	assertStatus(t, err, codes.Unauthenticated, "UNAUTHORIZED")
+
+	trip, err := trips.GetTrip(f.withAPIKey(t, "trips:read"), req)
+	require.NoError(t, err)
+	assert.Equal(t, f.tripID, trip.Id)
+
+	_, err = trips.GetTrip(metadata.AppendToOutgoingContext(as(t, driver), "x-organization-id", "5f0c8a4e-2b7d-4c11-9a53-7e2f1d6b8c90"), req)
+	assertStatus(t, err, codes.PermissionDenied, "FORBIDDEN") // 다른 기관 지정
+
+	operator := &auth.Principal{UserID: "operator-1", OrganizationID: "org-1", Role: domain.RoleSuperAdmin}
+	_, err = trips.GetTrip(as(t, operator), req)
+	require.NoError(t, err) // 기관을 지정하지 않은 플랫폼 운영자는 전체 기관 대상
+
+	health, err := healthpb.NewHealthClient(f.conn).Check(t.Context(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, health.Status)
+}
+
+// TestTripService_GetAndList - 운행 조회와 목록 필터 검증
+func TestTripService_GetAndList(t *testing.T) {
+	// Given
+	f := newFixture(t)
+	trips := eodiniv1.NewTripServiceClient(f.conn)
+	ctx := as(t, driver)
+
+	// When
+	trip, err := trips.GetTrip(ctx, &eodiniv1.GetTripRequest{Id: f.tripID})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, f.scheduleID, trip.ScheduleId)
+	assert.Equal(t, "2025-03-03", trip.Date)
+	assert.Equal(t, "in_progress", trip.Status)
+	assert.Equal(t, "vehicle-1", trip.VehicleId)
+	assert.NotNil(t, trip.StartedAt)
+	assert.Nil(t, trip.CompletedAt)
+	assert.Empty(t, trip.AssignedAttendantId)
+
+	_, err = trips.GetTrip(ctx, &eodiniv1.GetTripRequest{Id: "missing"})
+	assertStatus(t, err, codes.NotFound, "NOT_FOUND")
+
+	// When - 목록 조회
+	list, err := trips.ListTrips(ctx, &eodiniv1.ListTripsRequest{Date: "2025-03-03", Status: "in_progress", Page: &eodiniv1.PageRequest{PageSize: 500}})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, list.Trips, 1)
+	assert.Equal(t, f.tripID, list.Trips[0].Id)
+	assert.True(t, proto.Equal(&eodiniv1.PageInfo{Page: 1, PageSize: 100, TotalItems: 1, TotalPages: 1}, list.Page))
+
+	// When - 잘못된 필터
+	_, err = trips.ListTrips(ctx, &eodiniv1.ListTripsRequest{Status: "running", Date: "03/03/2025"})
+
+	// Then
+	st := assertStatus(t, err, codes.InvalidArgument, "VALIDATION_ERROR")
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	assert.Equal(t, []string{"date", "status"}, fields)
+}
+
+// TestScheduleService_GetAndList - 일정 조회는 schedules:read API 키로 가능
+func TestScheduleService_GetAndList(t *testing.T) {
+	// Given
+	f := newFixture(t)
+	schedules := eodiniv1.NewScheduleServiceClient(f.conn)
+	ctx := f.withAPIKey(t, "schedules:read")
+
+	// When
+	schedule, err := schedules.GetSchedule(ctx, &eodiniv1.GetScheduleRequest{Id: f.scheduleID})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "오전 8시 A코스", schedule.Name)
+	assert.Equal(t, "08:00", schedule.StartTime)
+	assert.Equal(t, []int32{1, 2, 3, 4, 5}, schedule.DaysOfWeek)
+	assert.Equal(t, "driver-1", schedule.DefaultDriverId)
+
+	list, err := schedules.ListSchedules(ctx, &eodiniv1.ListSchedulesRequest{DriverId: "driver-1"})
+	require.NoError(t, err)
+	assert.Len(t, list.Schedules, 1)
+	assert.Equal(t, int32(20), list.Page.PageSize)
+
+	_, err = schedules.ListSchedules(ctx, &eodiniv1.ListSchedulesRequest{Status: "deleted"})
+	assertStatus(t, err, codes.InvalidArgument, "VALIDATION_ERROR")
+
+	_, err = eodiniv1.NewTripServiceClient(f.conn).GetTrip(ctx, &eodiniv1.GetTripRequest{Id: f.tripID})
+	assertStatus(t, err, codes.PermissionDenied, "FORBIDDEN")
+}
+
+// TestLocationService_ReportLocation - 위치 전송 검증/권한은 REST와 같음
+func TestLocationService_ReportLocation(t *testing.T) {
+	// Given
+	f := newFixture(t)
+	locations := eodiniv1.NewLocationServiceClient(f.conn)
+	recordedAt := time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+	req := &eodiniv1.ReportLocationRequest{TripId: f.tripID, Latitude: 37.5665, Longitude: 126.978, Heading: proto.Float64(90), RecordedAt: timestamppb.New(recordedAt)}
+
+	// When
+	position, err := locations.ReportLocation(f.withAPIKey(t, "locations:write"), req)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "vehicle-1", position.VehicleId)
+	assert.Equal(t, 90.0, position.GetHeading())
+	assert.Nil(t, position.Speed)
+	assert.True(t, recordedAt.Equal(position.RecordedAt.AsTime()))
+
+	// When & Then - 잘못된 좌표, 권한 없는 운영 인력, 없는 운행
+	_, err = locations.ReportLocation(as(t, driver), &eodiniv1.ReportLocationRequest{TripId: f.tripID, Latitude: 137, Longitude: 126.978})
+	assertStatus(t, err, codes.InvalidArgument, "VALIDATION_ERROR")
+
+	_, err = locations.ReportLocation(as(t, &auth.Principal{UserID: "u-3", OrganizationID: "org-1", Role: domain.RoleDriver, ProfileID: "driver-2"}), req)
+	assertStatus(t, err, codes.PermissionDenied, "FORBIDDEN")
+
+	_, err = locations.ReportLocation(as(t, driver), &eodiniv1.ReportLocationRequest{TripId: "missing", Latitude: 37.5, Longitude: 127})
+	assertStatus(t, err, codes.NotFound, "NOT_FOUND")
+}
+
+// TestLocationService_StreamLocations - 건별 거부는 스트림을 끊지 않고 건수로 집계
+func TestLocationService_StreamLocations(t *testing.T) {
+	// Given
+	f := newFixture(t)
+	stream, err := eodiniv1.NewLocationServiceClient(f.conn).StreamLocations(f.withAPIKey(t, "locations:write"))
+	require.NoError(t, err)
+
+	// When
+	for _, req := range []*eodiniv1.ReportLocationRequest{
+		{TripId: f.tripID, Latitude: 37.5, Longitude: 127.0},
+		{TripId: f.tripID, Latitude: 37.5, Longitude: 200},    // 잘못된 좌표
+		{TripId: "missing", Latitude: 37.5, Longitude: 127.0}, // 없는 운행
+		{TripId: f.tripID, Latitude: 37.501, Longitude: 127.0, Speed: proto.Float64(30)},
+	} {
+		require.NoError(t, stream.Send(req))
+	}
+	resp, err := stream.CloseAndRecv()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Accepted)
+	assert.Equal(t, int32(2), resp.Rejected)
+
+	// When - scope 없는 API 키
+	stream, err = eodiniv1.NewLocationServiceClient(f.conn).StreamLocations(f.withAPIKey(t, "trips:read"))
+	require.NoError(t, err)
+	_, err = stream.CloseAndRecv()
+
+	// Then
+	assertStatus(t, err, codes.PermissionDenied, "FORBIDDEN")
+}
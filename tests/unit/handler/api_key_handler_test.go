@@ -0,0 +1,56 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAPIKeyHandler_IssueAndUse - 관리자가 발급한 키로 scope 범위 내 API만 호출 가능
+func TestAPIKeyHandler_IssueAndUse(t *testing.T) {
+	// Given: vehicles:read 키 발급
+	apiKeyService := service.NewAPIKeyService(mocks.NewAPIKeyRepository())
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:     testTokens,
+		APIKeyAuth: apiKeyService,
+		APIKey:     handler.NewAPIKeyHandler(apiKeyService),
+		Vehicle:    handler.NewVehicleHandler(service.NewVehicleService(mocks.NewVehicleRepository())),
+		Driver:     handler.NewDriverHandler(service.NewDriverService(mocks.NewDriverRepository())),
+	})
+
+	w := performJSON(router, http.MethodPost, "/api/v1/api-keys", map[string]interface{}{
+		"name":   "텔레매틱스 게이트웨이",
+		"scopes": []string{"vehicles:read"},
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	data := decodeBody(t, w)["data"].(map[string]interface{})
+	key := data["key"].(string)
+	keyID := data["api_key"].(map[string]interface{})["id"].(string)
+	assert.NotContains(t, data["api_key"], "key_hash")
+
+	withKey := func(method, path string) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(middleware.APIKeyHeader, key)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// When & Then
+	assert.Equal(t, http.StatusOK, withKey(http.MethodGet, "/api/v1/vehicles"))
+	assert.Equal(t, http.StatusForbidden, withKey(http.MethodGet, "/api/v1/drivers"))       // scope 없는 리소스
+	assert.Equal(t, http.StatusForbidden, withKey(http.MethodDelete, "/api/v1/vehicles/x")) // 변경 불가
+	assert.Equal(t, http.StatusForbidden, withKey(http.MethodGet, "/api/v1/api-keys"))      // 관리 API 불가
+
+	// 폐기 후에는 인증 실패
+	w = performJSON(router, http.MethodDelete, "/api/v1/api-keys/"+keyID, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, http.StatusUnauthorized, withKey(http.MethodGet, "/api/v1/vehicles"))
+}
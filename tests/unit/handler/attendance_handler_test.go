@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAttendanceHandler_Monthly - 연결된 보호자의 월별 출결 조회, 기사 403, 월 형식 오류 400
+func TestAttendanceHandler_Monthly(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	guardianRepo := mocks.NewGuardianRepository()
+	guardian := domain.NewGuardian("김보호", "010-1234-5678")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	require.NoError(t, guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("A코스 등원", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	tripRepo := mocks.NewTripRepository()
+	trip := domain.NewTrip(schedule.ID, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), "vehicle-1", "driver-1", nil)
+	record := domain.NewTripPassenger(trip.ID, passenger.ID, "stop-1")
+	record.BoardPassenger("driver-1", nil)
+	trip.TripPassengers = append(trip.TripPassengers, *record)
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:     testTokens,
+		Attendance: handler.NewAttendanceHandler(service.NewAttendanceService(passengerRepo, guardianRepo, tripRepo, scheduleRepo, nil)),
+	})
+	parent := &auth.Principal{UserID: "user-guardian", Role: domain.RoleGuardian, ProfileID: guardian.ID}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/passengers/" + passenger.ID + "/attendance"
+
+	// When
+	monthly := performJSONAs(router, parent, http.MethodGet, path+"?month=2025-03", nil)
+	forbidden := performJSONAs(router, driver, http.MethodGet, path+"?month=2025-03", nil)
+	invalid := performJSONAs(router, parent, http.MethodGet, path+"?month=2025-3-10", nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, monthly.Code)
+	data := decodeBody(t, monthly)["data"].(map[string]interface{})
+	assert.Equal(t, float64(1), data["summary"].(map[string]interface{})["rides"])
+	day := data["days"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "2025-03-10", day["date"])
+	assert.Equal(t, "boarded", day["trips"].([]interface{})[0].(map[string]interface{})["status"])
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Contains(t, decodeBody(t, invalid)["error"].(map[string]interface{})["details"], "month")
+}
@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAttendantAssignmentHandler - 대체 배정 생성/승인(관리자)/조회(운영 인력), 기간 겹침 409
+func TestAttendantAssignmentHandler(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	attendantRepo := mocks.NewAttendantRepository()
+	substitute := domain.NewAttendant("박대체", "010-1111-2222", domain.AttendantRoleTeacher)
+	require.NoError(t, attendantRepo.Create(ctx, substitute))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		AttendantAssignment: handler.NewAttendantAssignmentHandler(
+			service.NewAttendantAssignmentService(mocks.NewAttendantAssignmentRepository(), scheduleRepo, attendantRepo)),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/schedules/" + schedule.ID + "/attendant-assignments"
+	body := map[string]interface{}{"attendant_id": substitute.ID, "start_date": "2025-03-10", "end_date": "2025-03-14"}
+
+	// When
+	created := performJSON(router, http.MethodPost, path, body)
+	overlap := performJSON(router, http.MethodPost, path, body)
+	invalid := performJSON(router, http.MethodPost, path, map[string]interface{}{"attendant_id": substitute.ID, "start_date": "3/10", "end_date": "2025-03-14"})
+	forbidden := performJSONAs(router, driver, http.MethodPost, path, body)
+	listed := performJSONAs(router, driver, http.MethodGet, path, nil)
+
+	// Then
+	require.Equal(t, http.StatusCreated, created.Code)
+	assert.Equal(t, http.StatusConflict, overlap.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusOK, listed.Code)
+	assert.Len(t, decodeBody(t, listed)["data"], 1)
+
+	// When
+	id := decodeBody(t, created)["data"].(map[string]interface{})["id"].(string)
+	approved := performJSON(router, http.MethodPost, path+"/"+id+"/approve", nil)
+	deleted := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+	missing := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, approved.Code)
+	assert.NotEmpty(t, decodeBody(t, approved)["data"].(map[string]interface{})["approved_at"])
+	assert.Equal(t, http.StatusOK, deleted.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
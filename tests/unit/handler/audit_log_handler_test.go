@@ -0,0 +1,61 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuditLogHandler_List - 엔티티/행위자 필터, 관리자 전용
+func TestAuditLogHandler_List(t *testing.T) {
+	// Given: 차량 변경 2건(관리자 2명), 탑승자 변경 1건
+	repo := mocks.NewAuditLogRepository()
+	seed := func(entityType, entityID, actorID string, action domain.AuditAction, at time.Time) {
+		log := domain.NewAuditLog(entityType, entityID, action, map[string]domain.AuditChange{
+			"status": {Before: "active", After: "maintenance"},
+		})
+		log.ActorID = actorID
+		log.ActorRole = domain.RoleAdmin
+		log.CreatedAt = at
+		require.NoError(t, repo.Create(context.Background(), log))
+	}
+	now := time.Now()
+	seed("vehicles", "vehicle-1", "admin-1", domain.AuditActionCreate, now.Add(-2*time.Hour))
+	seed("vehicles", "vehicle-1", "admin-2", domain.AuditActionUpdate, now.Add(-time.Hour))
+	seed("passengers", "passenger-1", "admin-1", domain.AuditActionUpdate, now)
+
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		AuditLog: handler.NewAuditLogHandler(service.NewAuditLogService(repo)),
+	})
+
+	// When: 엔티티 기준 조회
+	w := performJSON(router, http.MethodGet, "/api/v1/audit-logs?entity_type=vehicles&entity_id=vehicle-1", nil)
+
+	// Then: 최신순
+	require.Equal(t, http.StatusOK, w.Code)
+	logs := decodeBody(t, w)["data"].([]interface{})
+	require.Len(t, logs, 2)
+	assert.Equal(t, "update", logs[0].(map[string]interface{})["action"])
+	assert.Equal(t, "create", logs[1].(map[string]interface{})["action"])
+	assert.NotEmpty(t, w.Header().Get("X-Request-ID"))
+
+	// When: 행위자 기준 조회
+	w = performJSON(router, http.MethodGet, "/api/v1/audit-logs?actor_id=admin-1", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, decodeBody(t, w)["data"].([]interface{}), 2)
+
+	// 관리자 외 역할은 조회 불가
+	driver := &auth.Principal{UserID: "user-9", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	w = performJSONAs(router, driver, http.MethodGet, "/api/v1/audit-logs", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,154 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuthRouter - 계정/인증 핸들러 테스트 라우터
+func newAuthRouter() *gin.Engine {
+	users := mocks.NewUserRepository()
+	sessions := mocks.NewSessionRepository()
+	authService := service.NewAuthService(users, mocks.NewPasswordResetTokenRepository(), sessions, testTokens, nil, 30*time.Minute)
+	return handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Sessions: authService,
+		Auth:     handler.NewAuthHandler(authService),
+		User:     handler.NewUserHandler(service.NewUserService(users, sessions)),
+	})
+}
+
+// TestAuthHandler_LoginFlow - 관리자가 만든 계정으로 로그인 후 내 정보 조회
+func TestAuthHandler_LoginFlow(t *testing.T) {
+	// Given
+	router := newAuthRouter()
+	w := performJSON(router, http.MethodPost, "/api/v1/users", map[string]interface{}{
+		"email":    "admin@eodini.kr",
+		"password": "password123",
+		"role":     "admin",
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	assert.NotContains(t, decodeBody(t, w)["data"], "password_hash")
+
+	// When: 로그인 (인증 헤더 없이)
+	w = performJSONAs(router, nil, http.MethodPost, "/api/v1/auth/login", map[string]interface{}{
+		"email":    "admin@eodini.kr",
+		"password": "password123",
+	})
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	token := decodeBody(t, w)["data"].(map[string]interface{})["access_token"].(string)
+
+	w = performWithBearer(router, token, http.MethodGet, "/api/v1/auth/me")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "admin@eodini.kr", decodeBody(t, w)["data"].(map[string]interface{})["email"])
+}
+
+// TestAuthHandler_LogoutEverywhere - 모든 세션 종료 후 기존 토큰은 즉시 401
+func TestAuthHandler_LogoutEverywhere(t *testing.T) {
+	// Given: 같은 계정으로 두 기기 로그인
+	router := newAuthRouter()
+	w := performJSON(router, http.MethodPost, "/api/v1/users", map[string]interface{}{
+		"email":    "admin@eodini.kr",
+		"password": "password123",
+		"role":     "admin",
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	login := func() string {
+		w := performJSONAs(router, nil, http.MethodPost, "/api/v1/auth/login", map[string]interface{}{
+			"email":    "admin@eodini.kr",
+			"password": "password123",
+		})
+		require.Equal(t, http.StatusOK, w.Code)
+		return decodeBody(t, w)["data"].(map[string]interface{})["access_token"].(string)
+	}
+	phone, laptop := login(), login()
+
+	w = performWithBearer(router, laptop, http.MethodGet, "/api/v1/auth/sessions")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, decodeBody(t, w)["data"], 2)
+
+	// When
+	w = performWithBearer(router, laptop, http.MethodDelete, "/api/v1/auth/sessions")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, float64(2), decodeBody(t, w)["data"].(map[string]interface{})["revoked"])
+	assert.Equal(t, http.StatusUnauthorized, performWithBearer(router, phone, http.MethodGet, "/api/v1/auth/me").Code)
+	assert.Equal(t, http.StatusUnauthorized, performWithBearer(router, laptop, http.MethodGet, "/api/v1/auth/me").Code)
+}
+
+// performWithBearer - 지정한 원문 토큰으로 요청 실행
+func performWithBearer(router *gin.Engine, token, method, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestAuthHandler_PublicEndpoints - 로그인 실패는 401, 재설정 요청은 항상 202
+func TestAuthHandler_PublicEndpoints(t *testing.T) {
+	router := newAuthRouter()
+
+	w := performJSONAs(router, nil, http.MethodPost, "/api/v1/auth/login", map[string]interface{}{
+		"email":    "nobody@eodini.kr",
+		"password": "password123",
+	})
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = performJSONAs(router, nil, http.MethodPost, "/api/v1/auth/password/forgot", map[string]interface{}{
+		"email": "nobody@eodini.kr",
+	})
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	w = performJSONAs(router, nil, http.MethodPost, "/api/v1/auth/password/reset", map[string]interface{}{
+		"token":        "unknown",
+		"new_password": "password123",
+	})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestUserHandler_AdminOnly - 계정 관리는 관리자만 가능
+func TestUserHandler_AdminOnly(t *testing.T) {
+	router := newAuthRouter()
+	driver := &auth.Principal{UserID: "driver-1", Role: domain.RoleDriver}
+
+	w := performJSONAs(router, driver, http.MethodGet, "/api/v1/users", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+// TestUserHandler_Members - 역할 변경/구성원 제외는 관리자만, 본인 계정은 400
+func TestUserHandler_Members(t *testing.T) {
+	router := newAuthRouter()
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "driver-1", Role: domain.RoleDriver}
+
+	w := performJSONAs(router, driver, http.MethodDelete, "/api/v1/users/user-2", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = performJSONAs(router, admin, http.MethodDelete, "/api/v1/users/admin-1", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = performJSONAs(router, admin, http.MethodPut, "/api/v1/users/admin-1/role", map[string]interface{}{"role": "driver", "profile_id": "5f0c8a4e-2b7d-4c11-9a53-7e2f1d6b8c90"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = performJSONAs(router, admin, http.MethodPut, "/api/v1/users/user-2/role", map[string]interface{}{"role": "owner"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = performJSONAs(router, admin, http.MethodDelete, "/api/v1/users/user-2", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
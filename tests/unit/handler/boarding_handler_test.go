@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBoardingRouter - 기사 driver-1이 시작한 오전 운행과 정류장에 배정된 탑승자 한 명
+func newBoardingRouter(t *testing.T) (*gin.Engine, *domain.Trip, *domain.Stop, *domain.Passenger) {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+
+	route := domain.NewRoute("A코스", "", 40)
+	stop := domain.NewStop(route.ID, "해오름아파트 정문", "", 1, 37.5, 127.0, 10)
+	route.AddStop(*stop)
+	require.NoError(t, routeRepo.Create(ctx, route))
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	passenger.AssignToStop(route.ID, stop.ID, 1)
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	trip := domain.NewTrip(schedule.ID, time.Now(), "vehicle-1", "driver-1", nil)
+	require.NoError(t, trip.Start("driver:driver-1", nil))
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	boardingService := service.NewBoardingService(tripService, tripRepo, scheduleRepo, routeRepo, passengerRepo, mocks.NewGuardianRepository(),
+		mocks.NewAttendantRepository(), mocks.NewTxManager(), nil, nil, nil, nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Boarding: handler.NewBoardingHandler(boardingService),
+	})
+	return router, trip, stop, passenger
+}
+
+// TestBoardingHandler_BoardAndAlight - 배정 기사의 승차/하차/불참 기록 (보호자는 403, 승차 전 하차는 409, 사유 없는 불참은 400)
+func TestBoardingHandler_BoardAndAlight(t *testing.T) {
+	// Given
+	router, trip, _, passenger := newBoardingRouter(t)
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	guardian := &auth.Principal{UserID: "user-guardian", Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+	base := "/api/v1/trips/" + trip.ID + "/passengers/" + passenger.ID
+
+	// When
+	early := performJSONAs(router, driver, http.MethodPost, base+"/alight", nil)
+	forbidden := performJSONAs(router, guardian, http.MethodPost, base+"/board", nil)
+	noReason := performJSONAs(router, driver, http.MethodPost, base+"/no-show", map[string]interface{}{})
+	noShow := performJSONAs(router, driver, http.MethodPost, base+"/no-show", map[string]interface{}{"reason": "결석 연락"})
+	badLocation := performJSONAs(router, driver, http.MethodPost, base+"/board", map[string]interface{}{"latitude": 37.5})
+	boarded := performJSONAs(router, driver, http.MethodPost, base+"/board", map[string]interface{}{"latitude": 37.5, "longitude": 127.0})
+	alighted := performJSONAs(router, driver, http.MethodPost, base+"/alight", nil)
+
+	// Then
+	assert.Equal(t, http.StatusConflict, early.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, noReason.Code)
+	assert.Equal(t, http.StatusOK, noShow.Code)
+	assert.Equal(t, "결석 연락", decodeBody(t, noShow)["data"].(map[string]interface{})["no_show_reason"])
+	assert.Equal(t, http.StatusBadRequest, badLocation.Code)
+	assert.Equal(t, http.StatusOK, boarded.Code)
+	boardedData := decodeBody(t, boarded)["data"].(map[string]interface{})
+	assert.Equal(t, true, boardedData["is_boarded"])
+	assert.Equal(t, "driver:driver-1", boardedData["boarded_by"])
+	assert.Equal(t, 37.5, boardedData["boarded_location"].(map[string]interface{})["latitude"])
+	assert.Equal(t, http.StatusOK, alighted.Code)
+	assert.Equal(t, true, decodeBody(t, alighted)["data"].(map[string]interface{})["is_alighted"])
+}
+
+// TestBoardingHandler_BoardAll - 정류장 일괄 승차/하차 (대상 미지정·중복 지정은 400)
+func TestBoardingHandler_BoardAll(t *testing.T) {
+	// Given
+	router, trip, stop, passenger := newBoardingRouter(t)
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	base := "/api/v1/trips/" + trip.ID + "/stops/" + stop.ID
+
+	// When
+	empty := performJSONAs(router, driver, http.MethodPost, base+"/board-all", map[string]interface{}{})
+	both := performJSONAs(router, driver, http.MethodPost, base+"/board-all", map[string]interface{}{"all_expected": true, "passenger_ids": []string{passenger.ID}})
+	boarded := performJSONAs(router, driver, http.MethodPost, base+"/board-all", map[string]interface{}{"passenger_ids": []string{passenger.ID}})
+	alighted := performJSONAs(router, driver, http.MethodPost, base+"/alight-all", map[string]interface{}{"all_expected": true})
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, empty.Code)
+	assert.Equal(t, http.StatusBadRequest, both.Code)
+	require.Equal(t, http.StatusOK, boarded.Code)
+	assert.Len(t, decodeBody(t, boarded)["data"], 1)
+	require.Equal(t, http.StatusOK, alighted.Code)
+	assert.Equal(t, true, decodeBody(t, alighted)["data"].([]interface{})[0].(map[string]interface{})["is_alighted"])
+}
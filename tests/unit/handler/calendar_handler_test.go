@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCalendarHandler_IssueAndFeed - 기사/동승자만 발급, 피드는 인증 헤더 없이 토큰 주소로 조회, 해제 후 404
+func TestCalendarHandler_IssueAndFeed(t *testing.T) {
+	// Given
+	userRepo := mocks.NewUserRepository()
+	user := domain.NewUser("driver@example.com", "hash", domain.RoleDriver, "driver-1")
+	require.NoError(t, userRepo.Create(t.Context(), user))
+	scheduleRepo, vehicleRepo, tripRepo := mocks.NewScheduleRepository(), mocks.NewVehicleRepository(), mocks.NewTripRepository()
+	generation := service.NewTripGenerationService(scheduleRepo, mocks.NewScheduleExceptionRepository(), mocks.NewDriverAssignmentRepository(),
+		mocks.NewAttendantAssignmentRepository(), vehicleRepo, mocks.NewDriverRepository(), tripRepo, mocks.NewPassengerRepository(), service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+	calendarService := service.NewCalendarService(mocks.NewCalendarTokenRepository(), userRepo, tripRepo, scheduleRepo, mocks.NewRouteRepository(), vehicleRepo, generation, nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Calendar: handler.NewCalendarHandler(calendarService),
+	})
+	driver := &auth.Principal{UserID: user.ID, Role: domain.RoleDriver, ProfileID: "driver-1"}
+	guardian := &auth.Principal{UserID: "user-guardian", Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+
+	// When
+	forbidden := performJSONAs(router, guardian, http.MethodPost, "/api/v1/me/calendar-token", nil)
+	issued := performJSONAs(router, driver, http.MethodPost, "/api/v1/me/calendar-token", nil)
+	require.Equal(t, http.StatusCreated, issued.Code)
+	path := decodeBody(t, issued)["data"].(map[string]interface{})["path"].(string)
+	feed := performJSONAs(router, nil, http.MethodGet, path, nil)
+	withoutSuffix := performJSONAs(router, nil, http.MethodGet, strings.TrimSuffix(path, ".ics"), nil)
+	revoked := performJSONAs(router, driver, http.MethodDelete, "/api/v1/me/calendar-token", nil)
+	afterRevoke := performJSONAs(router, nil, http.MethodGet, path, nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusOK, feed.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", feed.Header().Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(feed.Body.String(), "BEGIN:VCALENDAR\r\n"))
+	assert.Equal(t, http.StatusNotFound, withoutSuffix.Code)
+	assert.Equal(t, http.StatusOK, revoked.Code)
+	assert.Equal(t, http.StatusNotFound, afterRevoke.Code)
+}
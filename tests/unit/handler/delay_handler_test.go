@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDelayHandler_Report - 배정 기사의 지연 보고 (사유 없으면 400, 보호자는 403)
+func TestDelayHandler_Report(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	trip := domain.NewTrip(schedule.ID, time.Now(), "vehicle-1", "driver-1", nil)
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	delayService := service.NewDelayService(tripService, tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewGuardianRepository(),
+		nil, nil, nil, nil, 10*time.Minute, nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Delay:  handler.NewDelayHandler(delayService),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	guardian := &auth.Principal{UserID: "user-guardian", Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+	path := "/api/v1/trips/" + trip.ID + "/delay"
+
+	// When
+	noReason := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{})
+	forbidden := performJSONAs(router, guardian, http.MethodPost, path, map[string]interface{}{"reason": "차량 고장"})
+	reported := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"reason": "차량 고장"})
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, noReason.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusOK, reported.Code)
+	data := decodeBody(t, reported)["data"].(map[string]interface{})
+	assert.Equal(t, "차량 고장", data["delay_reason"])
+	assert.NotEmpty(t, data["delayed_at"])
+}
@@ -0,0 +1,38 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeviceHandler_RegisterAndUnregister - 로그인 사용자 본인 기기 등록/해제 (다른 사용자의 토큰은 404)
+func TestDeviceHandler_RegisterAndUnregister(t *testing.T) {
+	// Given
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Device: handler.NewDeviceHandler(service.NewDeviceService(mocks.NewDeviceTokenRepository(), nil)),
+	})
+	guardian := &auth.Principal{UserID: "guardian-1", Role: domain.RoleGuardian}
+	other := &auth.Principal{UserID: "guardian-2", Role: domain.RoleGuardian}
+	body := map[string]interface{}{"token": "fcm-token-1", "platform": "android"}
+
+	// When
+	registered := performJSONAs(router, guardian, http.MethodPost, "/api/v1/me/devices", body)
+	invalid := performJSONAs(router, guardian, http.MethodPost, "/api/v1/me/devices", map[string]interface{}{"token": "fcm-token-2", "platform": "symbian"})
+	othersDevice := performJSONAs(router, other, http.MethodDelete, "/api/v1/me/devices", map[string]interface{}{"token": "fcm-token-1"})
+	removed := performJSONAs(router, guardian, http.MethodDelete, "/api/v1/me/devices", map[string]interface{}{"token": "fcm-token-1"})
+
+	// Then
+	assert.Equal(t, http.StatusCreated, registered.Code)
+	assert.Equal(t, "guardian-1", decodeBody(t, registered)["data"].(map[string]interface{})["user_id"])
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusNotFound, othersDevice.Code)
+	assert.Equal(t, http.StatusOK, removed.Code)
+}
@@ -0,0 +1,61 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDriverLicenseHandler - 기사 본인 스캔 업로드 → 관리자 확인, 다른 기사/동승자 403
+func TestDriverLicenseHandler(t *testing.T) {
+	// Given
+	driverRepo := mocks.NewDriverRepository()
+	driver := domain.NewDriver("김기사", "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	require.NoError(t, driverRepo.Create(context.Background(), driver))
+	fileService := service.NewFileService(mocks.NewFileRepository(), storage.NewLocalStorage(t.TempDir()))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:        testTokens,
+		Driver:        handler.NewDriverHandler(service.NewDriverService(driverRepo)),
+		DriverLicense: handler.NewDriverLicenseHandler(service.NewDriverLicenseService(driverRepo, fileService)),
+	})
+	self := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: driver.ID}
+	other := &auth.Principal{UserID: "user-driver-2", Role: domain.RoleDriver, ProfileID: "driver-2"}
+	attendant := &auth.Principal{UserID: "user-attendant", Role: domain.RoleAttendant, ProfileID: "attendant-1"}
+	path := "/api/v1/drivers/" + driver.ID + "/license"
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	// When
+	uploaded := performMultipartAs(router, self, http.MethodPut, path+"/scan", nil, "면허증.png", png)
+	otherUpload := performMultipartAs(router, other, http.MethodPut, path+"/scan", nil, "면허증.png", png)
+	attendantUpload := performMultipartAs(router, attendant, http.MethodPut, path+"/scan", nil, "면허증.png", png)
+	downloaded := performJSONAs(router, self, http.MethodGet, path+"/scan", nil)
+	driverReview := performJSONAs(router, self, http.MethodPut, path+"/review", map[string]interface{}{"status": "verified"})
+	noReason := performJSON(router, http.MethodPut, path+"/review", map[string]interface{}{"status": "rejected"})
+	badStatus := performJSON(router, http.MethodPut, path+"/review", map[string]interface{}{"status": "approved"})
+	verified := performJSON(router, http.MethodPut, path+"/review", map[string]interface{}{"status": "verified"})
+
+	// Then
+	require.Equal(t, http.StatusOK, uploaded.Code)
+	data := decodeBody(t, uploaded)["data"].(map[string]interface{})
+	assert.Equal(t, "pending", data["license_status"])
+	assert.NotEmpty(t, data["license_file_id"])
+	assert.Equal(t, http.StatusForbidden, otherUpload.Code)
+	assert.Equal(t, http.StatusForbidden, attendantUpload.Code)
+	require.Equal(t, http.StatusOK, downloaded.Code)
+	assert.Equal(t, png, downloaded.Body.Bytes())
+	assert.Equal(t, http.StatusForbidden, driverReview.Code)
+	assert.Equal(t, http.StatusBadRequest, noReason.Code)
+	assert.Equal(t, http.StatusBadRequest, badStatus.Code)
+	require.Equal(t, http.StatusOK, verified.Code)
+	assert.Equal(t, "verified", decodeBody(t, verified)["data"].(map[string]interface{})["license_status"])
+}
@@ -0,0 +1,60 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFileHandler - 용도별 업로드 역할, 업로드한 사용자/관리자만 조회/다운로드
+func TestFileHandler(t *testing.T) {
+	// Given
+	fileService := service.NewFileService(mocks.NewFileRepository(), storage.NewLocalStorage(t.TempDir()))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		File:   handler.NewFileHandler(fileService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	otherDriver := &auth.Principal{UserID: "user-driver-2", Role: domain.RoleDriver, ProfileID: "driver-2"}
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	// When
+	uploaded := performDocumentUploadAs(router, driver, "/api/v1/files", map[string]string{"purpose": "driver_license"}, "면허증.png", png)
+	forbidden := performDocumentUploadAs(router, driver, "/api/v1/files", map[string]string{"purpose": "vehicle_document"}, "보험.png", png)
+	badPurpose := performDocumentUploadAs(router, driver, "/api/v1/files", map[string]string{"purpose": "avatar"}, "a.png", png)
+	noFile := performDocumentUploadAs(router, driver, "/api/v1/files", map[string]string{"purpose": "driver_license"}, "", nil)
+
+	// Then
+	require.Equal(t, http.StatusCreated, uploaded.Code)
+	data := decodeBody(t, uploaded)["data"].(map[string]interface{})
+	assert.Equal(t, "driver_license", data["purpose"])
+	assert.Equal(t, "image/png", data["content_type"])
+	assert.NotContains(t, data, "storage_key")
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, badPurpose.Code)
+	assert.Equal(t, http.StatusBadRequest, noFile.Code)
+
+	// When
+	path := "/api/v1/files/" + data["id"].(string)
+	own := performJSONAs(router, driver, http.MethodGet, path, nil)
+	other := performJSONAs(router, otherDriver, http.MethodGet, path, nil)
+	downloaded := performJSONAs(router, admin, http.MethodGet, path+"/content", nil)
+	missing := performJSONAs(router, admin, http.MethodGet, "/api/v1/files/missing/content", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, own.Code)
+	assert.Equal(t, http.StatusForbidden, other.Code)
+	require.Equal(t, http.StatusOK, downloaded.Code)
+	assert.Equal(t, "image/png", downloaded.Header().Get("Content-Type"))
+	assert.Equal(t, png, downloaded.Body.Bytes())
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFuelLogHandler - 기사 등록/조회, 동승자 등록 403, 형식 검증, 관리자 삭제, 연비 보고서
+func TestFuelLogHandler(t *testing.T) {
+	// Given
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(context.Background(), vehicle))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:  testTokens,
+		FuelLog: handler.NewFuelLogHandler(service.NewFuelLogService(vehicleRepo, mocks.NewFuelLogRepository(), nil)),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	attendant := &auth.Principal{UserID: "user-attendant", Role: domain.RoleAttendant, ProfileID: "attendant-1"}
+	path := "/api/v1/vehicles/" + vehicle.ID + "/fuel-logs"
+
+	// When
+	first := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"filled_at": "2025-03-01T09:00:00+09:00", "liters": 50, "cost": 80000, "odometer_km": 10000})
+	second := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"filled_at": "2025-03-10T09:00:00+09:00", "liters": 40, "cost": 64000, "odometer_km": 10400, "station": "SK 역삼점"})
+	backwards := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"liters": 40, "odometer_km": 9000})
+	invalid := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"liters": 0, "odometer_km": 11000})
+	forbidden := performJSONAs(router, attendant, http.MethodPost, path, map[string]interface{}{"liters": 40, "odometer_km": 11000})
+	listed := performJSONAs(router, attendant, http.MethodGet, path+"?from=2025-03-01&to=2025-03-31", nil)
+	report := performJSON(router, http.MethodGet, "/api/v1/reports/fuel-economy?from=2025-03-01&to=2025-03-31", nil)
+	reportForbidden := performJSONAs(router, driver, http.MethodGet, "/api/v1/reports/fuel-economy?from=2025-03-01&to=2025-03-31", nil)
+
+	// Then
+	require.Equal(t, http.StatusCreated, first.Code)
+	require.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, http.StatusBadRequest, backwards.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusOK, listed.Code)
+	assert.Len(t, decodeBody(t, listed)["data"], 2)
+	require.Equal(t, http.StatusOK, report.Code)
+	vehicles := decodeBody(t, report)["data"].(map[string]interface{})["vehicles"].([]interface{})
+	require.Len(t, vehicles, 1)
+	assert.Equal(t, 10.0, vehicles[0].(map[string]interface{})["km_per_liter"])
+	assert.Equal(t, http.StatusForbidden, reportForbidden.Code)
+
+	// When
+	id := decodeBody(t, second)["data"].(map[string]interface{})["id"].(string)
+	driverDelete := performJSONAs(router, driver, http.MethodDelete, path+"/"+id, nil)
+	deleted := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+	missing := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, driverDelete.Code)
+	assert.Equal(t, http.StatusOK, deleted.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
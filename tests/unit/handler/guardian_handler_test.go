@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGuardianHandler_MyChildren - 보호자는 토큰의 보호자 ID 기준으로 본인 자녀만 조회
+func TestGuardianHandler_MyChildren(t *testing.T) {
+	// Given: 관리자가 보호자 등록 후 자녀 연결
+	routeRepo := mocks.NewRouteRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianService := service.NewGuardianService(mocks.NewGuardianRepository(), passengerRepo, routeRepo)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:    testTokens,
+		Guardian:  handler.NewGuardianHandler(guardianService),
+		Passenger: handler.NewPassengerHandler(service.NewPassengerService(passengerRepo, routeRepo, mocks.NewScheduleRepository(), mocks.NewVehicleRepository())),
+	})
+
+	w := performJSON(router, http.MethodPost, "/api/v1/guardians", map[string]interface{}{
+		"name":  "김보호",
+		"phone": "010-1234-5678",
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	guardianID := decodeBody(t, w)["data"].(map[string]interface{})["id"].(string)
+
+	w = performJSON(router, http.MethodPost, "/api/v1/passengers", map[string]interface{}{
+		"name":           "김철수",
+		"guardian_name":  "김보호",
+		"guardian_phone": "010-1234-5678",
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	passengerID := decodeBody(t, w)["data"].(map[string]interface{})["id"].(string)
+
+	w = performJSON(router, http.MethodPost, "/api/v1/guardians/"+guardianID+"/passengers", map[string]interface{}{
+		"passenger_id": passengerID,
+		"relation":     "모",
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	// When
+	me := &auth.Principal{UserID: "user-1", Role: domain.RoleGuardian, ProfileID: guardianID}
+	w = performJSONAs(router, me, http.MethodGet, "/api/v1/me/children", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	children := decodeBody(t, w)["data"].([]interface{})
+	require.Len(t, children, 1)
+	child := children[0].(map[string]interface{})
+	assert.Equal(t, "김철수", child["passenger"].(map[string]interface{})["name"])
+
+	// 보호자는 관리자용 보호자/탑승자 API 접근 불가
+	w = performJSONAs(router, me, http.MethodGet, "/api/v1/guardians/"+guardianID+"/children", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = performJSONAs(router, me, http.MethodGet, "/api/v1/passengers/"+passengerID, nil)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
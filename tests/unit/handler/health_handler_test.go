@@ -18,7 +18,7 @@ func init() {
 // TestHealth - 기본 Health Check 테스트
 func TestHealth(t *testing.T) {
 	// Given
-	router := handler.SetupRouter()
+	router := handler.SetupRouter(nil)
 
 	// When
 	w := httptest.NewRecorder()
@@ -45,7 +45,7 @@ func TestHealth(t *testing.T) {
 // TestReadiness - Readiness Probe 테스트
 func TestReadiness(t *testing.T) {
 	// Given
-	router := handler.SetupRouter()
+	router := handler.SetupRouter(nil)
 
 	// When
 	w := httptest.NewRecorder()
@@ -70,7 +70,7 @@ func TestReadiness(t *testing.T) {
 // TestLiveness - Liveness Probe 테스트
 func TestLiveness(t *testing.T) {
 	// Given
-	router := handler.SetupRouter()
+	router := handler.SetupRouter(nil)
 
 	// When
 	w := httptest.NewRecorder()
@@ -93,7 +93,7 @@ func TestLiveness(t *testing.T) {
 // TestPingEndpoint - 임시 Ping 엔드포인트 테스트
 func TestPingEndpoint(t *testing.T) {
 	// Given
-	router := handler.SetupRouter()
+	router := handler.SetupRouter(nil)
 
 	// When
 	w := httptest.NewRecorder()
@@ -113,7 +113,7 @@ func TestPingEndpoint(t *testing.T) {
 // TestHealthCheckResponseFormat - 응답 포맷 확인
 func TestHealthCheckResponseFormat(t *testing.T) {
 	// Given
-	router := handler.SetupRouter()
+	router := handler.SetupRouter(nil)
 
 	// When
 	w := httptest.NewRecorder()
@@ -0,0 +1,34 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHolidayHandler_List - 연도별 공휴일 목록 (연도 범위 검증)
+func TestHolidayHandler_List(t *testing.T) {
+	// Given
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:  testTokens,
+		Holiday: handler.NewHolidayHandler(service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil)),
+	})
+
+	// When
+	listed := performJSON(router, http.MethodGet, "/api/v1/holidays?year=2026", nil)
+	invalid := performJSON(router, http.MethodGet, "/api/v1/holidays?year=1999", nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, listed.Code)
+	holidays := decodeBody(t, listed)["data"].([]interface{})
+	require.NotEmpty(t, holidays)
+	first := holidays[0].(map[string]interface{})
+	assert.Equal(t, "1월1일", first["name"])
+	assert.Equal(t, "static", first["source"])
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+}
@@ -0,0 +1,77 @@
+package handler_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// performUploadAs - 지정한 인증 주체로 file 필드 multipart 업로드 (filename이 비면 파일 없이 요청)
+func performUploadAs(router *gin.Engine, principal *auth.Principal, path, filename string, content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if filename != "" {
+		part, _ := writer.CreateFormFile("file", filename)
+		_, _ = part.Write(content)
+	}
+	_ = writer.Close()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+issueToken(principal))
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestImportHandler_ImportPassengers - 업로드한 CSV의 행별 결과 (dry_run은 저장 안 함, 관리자 전용, 파일 없으면 400)
+func TestImportHandler_ImportPassengers(t *testing.T) {
+	// Given
+	passengerRepo := mocks.NewPassengerRepository()
+	routeRepo := mocks.NewRouteRepository()
+	importService := service.NewImportService(passengerRepo, routeRepo, mocks.NewScheduleRepository(), mocks.NewVehicleRepository(),
+		service.NewRouteService(routeRepo, mocks.NewTxManager(), nil, nil), mocks.NewTxManager())
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Import: handler.NewImportHandler(importService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	content := []byte("이름,보호자 이름,보호자 연락처\n김철수,김보호,010-1111-2222\n이영희,이보호,010-123\n")
+
+	// When
+	forbidden := performUploadAs(router, driver, "/api/v1/imports/passengers", "children.csv", content)
+	missing := performUploadAs(router, admin, "/api/v1/imports/passengers", "", nil)
+	dryRun := performUploadAs(router, admin, "/api/v1/imports/passengers?dry_run=true", "children.csv", content)
+	_, afterDryRun, _ := passengerRepo.List(t.Context(), repository.PassengerFilter{})
+	imported := performUploadAs(router, admin, "/api/v1/imports/passengers", "children.csv", content)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusBadRequest, missing.Code)
+	assert.Contains(t, decodeBody(t, missing)["error"].(map[string]interface{})["details"], "file")
+	require.Equal(t, http.StatusOK, dryRun.Code)
+	assert.Equal(t, true, decodeBody(t, dryRun)["data"].(map[string]interface{})["dry_run"])
+	assert.Zero(t, afterDryRun)
+
+	require.Equal(t, http.StatusOK, imported.Code)
+	data := decodeBody(t, imported)["data"].(map[string]interface{})
+	assert.Equal(t, float64(2), data["total"])
+	assert.Equal(t, float64(1), data["imported"])
+	assert.Equal(t, float64(1), data["failed"])
+	rowErr := data["errors"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, float64(3), rowErr["row"])
+	assert.Contains(t, rowErr["errors"], "guardian_phone")
+}
@@ -0,0 +1,68 @@
+package handler_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInvitationHandler - 초대/목록/취소는 관리자만, 수락은 인증 없이 토큰으로 계정 생성
+func TestInvitationHandler(t *testing.T) {
+	// Given
+	organizations := mocks.NewOrganizationRepository()
+	organization := domain.NewOrganization("해바라기 유치원", domain.OrganizationTypeKindergarten)
+	organization.ID = testOrganizationID
+	require.NoError(t, organizations.Create(t.Context(), organization))
+	users := mocks.NewUserRepository()
+	texts := mocks.NewSMSSender()
+	svc := service.NewInvitationService(mocks.NewInvitationRepository(), users, organizations, mocks.NewTxManager(),
+		service.InvitationChannels{SMS: service.SMSInvitationNotifier{Sender: texts}}, 72*time.Hour)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:     testTokens,
+		Invitation: handler.NewInvitationHandler(svc),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	body := map[string]interface{}{"phone": "010-1234-5678", "role": "driver", "profile_id": "5f0c8a4e-2b7d-4c11-9a53-7e2f1d6b8c90"}
+
+	// When
+	forbidden := performJSONAs(router, driver, http.MethodPost, "/api/v1/organization/invitations", body)
+	invalid := performJSONAs(router, admin, http.MethodPost, "/api/v1/organization/invitations", map[string]interface{}{"role": "guardian", "phone": "010-1234-5678"})
+	created := performJSONAs(router, admin, http.MethodPost, "/api/v1/organization/invitations", body)
+	listed := performJSONAs(router, admin, http.MethodGet, "/api/v1/organization/invitations", nil)
+	lines := strings.Split(texts.Sent[len(texts.Sent)-1].Text, "\n")
+	accepted := performJSON(router, http.MethodPost, "/api/v1/invitations/accept", map[string]interface{}{
+		"token": lines[len(lines)-1], "email": "driver@eodini.kr", "password": "password123",
+	})
+	reused := performJSON(router, http.MethodPost, "/api/v1/invitations/accept", map[string]interface{}{
+		"token": lines[len(lines)-1], "email": "other@eodini.kr", "password": "password123",
+	})
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	require.Equal(t, http.StatusCreated, created.Code)
+	data := decodeBody(t, created)["data"].(map[string]interface{})
+	assert.Equal(t, "pending", data["status"])
+	assert.Equal(t, true, data["delivered"])
+	assert.NotContains(t, created.Body.String(), "token_hash")
+	require.Equal(t, http.StatusOK, listed.Code)
+	assert.Len(t, decodeBody(t, listed)["data"], 1)
+	require.Equal(t, http.StatusCreated, accepted.Code)
+	user := decodeBody(t, accepted)["data"].(map[string]interface{})
+	assert.Equal(t, testOrganizationID, user["organization_id"])
+	assert.Equal(t, "driver", user["role"])
+	assert.Equal(t, http.StatusBadRequest, reused.Code)
+
+	revoked := performJSONAs(router, admin, http.MethodDelete, "/api/v1/organization/invitations/"+data["id"].(string), nil)
+	assert.Equal(t, http.StatusNotFound, revoked.Code) // 이미 수락된 초대
+}
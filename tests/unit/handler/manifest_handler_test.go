@@ -0,0 +1,61 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestManifestHandler - 배정 기사는 정류장별 명단 조회, 다른 기사는 403
+func TestManifestHandler(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+
+	route := domain.NewRoute("A코스", "", 40)
+	stop := domain.NewStop(route.ID, "해오름아파트 정문", "", 1, 37.5, 127.0, 10)
+	route.AddStop(*stop)
+	require.NoError(t, routeRepo.Create(ctx, route))
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	passenger.AssignToStop(route.ID, stop.ID, 1)
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	trip := domain.NewTrip(schedule.ID, time.Now(), "vehicle-1", "driver-1", nil)
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Manifest: handler.NewManifestHandler(service.NewManifestService(tripService, scheduleRepo, routeRepo, passengerRepo, mocks.NewGuardianRepository())),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	otherDriver := &auth.Principal{UserID: "user-driver-2", Role: domain.RoleDriver, ProfileID: "driver-2"}
+	path := "/api/v1/trips/" + trip.ID + "/manifest"
+
+	// When
+	ok := performJSONAs(router, driver, http.MethodGet, path, nil)
+	forbidden := performJSONAs(router, otherDriver, http.MethodGet, path, nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, ok.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	data := decodeBody(t, ok)["data"].(map[string]interface{})
+	stops := data["stops"].([]interface{})
+	require.Len(t, stops, 1)
+	boarding := stops[0].(map[string]interface{})["boarding"].([]interface{})
+	require.Len(t, boarding, 1)
+	assert.Equal(t, "김민준", boarding[0].(map[string]interface{})["name"])
+}
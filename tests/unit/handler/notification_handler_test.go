@@ -0,0 +1,80 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNotificationHandler_MyPreference - 보호자 본인 수신 설정 조회/변경 (보호자가 아니면 403)
+func TestNotificationHandler_MyPreference(t *testing.T) {
+	// Given
+	svc := service.NewNotificationService(mocks.NewNotificationPreferenceRepository(), nil, mocks.NewGuardianRepository(), mocks.NewUserRepository(),
+		nil, nil, nil, nil, service.NotificationRetryPolicy{})
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:       testTokens,
+		Notification: handler.NewNotificationHandler(svc),
+	})
+	guardian := &auth.Principal{UserID: "user-1", Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+
+	// When
+	defaults := performJSONAs(router, guardian, http.MethodGet, "/api/v1/me/notification-preferences", nil)
+	invalid := performJSONAs(router, guardian, http.MethodPut, "/api/v1/me/notification-preferences", map[string]interface{}{
+		"channels": []string{"fax"},
+		"events":   []string{"boarded"},
+	})
+	updated := performJSONAs(router, guardian, http.MethodPut, "/api/v1/me/notification-preferences", map[string]interface{}{
+		"channels": []string{"sms"},
+		"events":   []string{"boarded", "delay"},
+	})
+	admin := performJSON(router, http.MethodGet, "/api/v1/me/notification-preferences", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, defaults.Code)
+	assert.Len(t, decodeBody(t, defaults)["data"].(map[string]interface{})["channels"], 3)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusOK, updated.Code)
+	assert.Equal(t, []interface{}{"sms"}, decodeBody(t, updated)["data"].(map[string]interface{})["channels"])
+	assert.Equal(t, http.StatusForbidden, admin.Code)
+}
+
+// TestNotificationHandler_List - 관리자 발송 기록 조회 (상태 필터, 보호자는 403)
+func TestNotificationHandler_List(t *testing.T) {
+	// Given
+	logRepo := mocks.NewNotificationLogRepository()
+	svc := service.NewNotificationService(mocks.NewNotificationPreferenceRepository(), logRepo, mocks.NewGuardianRepository(), mocks.NewUserRepository(),
+		nil, nil, nil, mocks.NewSMSSender(), service.NotificationRetryPolicy{MaxAttempts: 1})
+	_, err := svc.Notify(context.Background(), service.Recipient{Phone: "01012345678"}, &service.Notification{Title: "승차 알림", Body: "민준이가 승차했습니다"})
+	require.NoError(t, err)
+	_, err = svc.Notify(context.Background(), service.Recipient{}, &service.Notification{Title: "하차 알림", Body: "민준이가 하차했습니다"})
+	require.Error(t, err)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:       testTokens,
+		Notification: handler.NewNotificationHandler(svc),
+	})
+	guardian := &auth.Principal{UserID: "user-1", Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+
+	// When
+	all := performJSON(router, http.MethodGet, "/api/v1/notifications", nil)
+	sent := performJSON(router, http.MethodGet, "/api/v1/notifications?status=sent", nil)
+	invalid := performJSON(router, http.MethodGet, "/api/v1/notifications?status=unknown", nil)
+	forbidden := performJSONAs(router, guardian, http.MethodGet, "/api/v1/notifications", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, all.Code)
+	assert.Len(t, decodeBody(t, all)["data"], 2)
+	assert.Equal(t, http.StatusOK, sent.Code)
+	sentLogs := decodeBody(t, sent)["data"].([]interface{})
+	require.Len(t, sentLogs, 1)
+	assert.Equal(t, "sms", sentLogs[0].(map[string]interface{})["channel"])
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+}
@@ -0,0 +1,51 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOdometerHandler - 관리자 입력, 기사 입력 403, 형식 검증, 변경 이력 조회
+func TestOdometerHandler(t *testing.T) {
+	// Given
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(context.Background(), vehicle))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Odometer: handler.NewOdometerHandler(service.NewOdometerService(vehicleRepo, mocks.NewOdometerReadingRepository(), mocks.NewTxManager(), nil)),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/vehicles/" + vehicle.ID
+
+	// When
+	updated := performJSON(router, http.MethodPut, path+"/odometer", map[string]interface{}{"odometer_km": 42000})
+	missingValue := performJSON(router, http.MethodPut, path+"/odometer", map[string]interface{}{})
+	negative := performJSON(router, http.MethodPut, path+"/odometer", map[string]interface{}{"odometer_km": -1})
+	forbidden := performJSONAs(router, driver, http.MethodPut, path+"/odometer", map[string]interface{}{"odometer_km": 43000})
+	notFound := performJSON(router, http.MethodPut, "/api/v1/vehicles/missing/odometer", map[string]interface{}{"odometer_km": 1})
+	history := performJSONAs(router, driver, http.MethodGet, path+"/odometer-readings", nil)
+	invalidQuery := performJSON(router, http.MethodGet, path+"/odometer-readings?from=2025/03/01", nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, updated.Code)
+	assert.Equal(t, 42000.0, decodeBody(t, updated)["data"].(map[string]interface{})["odometer_km"])
+	assert.Equal(t, http.StatusBadRequest, missingValue.Code)
+	assert.Equal(t, http.StatusBadRequest, negative.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusNotFound, notFound.Code)
+	require.Equal(t, http.StatusOK, history.Code)
+	readings := decodeBody(t, history)["data"].([]interface{})
+	require.Len(t, readings, 1)
+	assert.Equal(t, "manual", readings[0].(map[string]interface{})["source"])
+	assert.Equal(t, http.StatusBadRequest, invalidQuery.Code)
+}
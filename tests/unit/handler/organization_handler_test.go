@@ -0,0 +1,81 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrganizationHandler - 소속 기관 조회는 운영 인력, 수정은 관리자만 (토큰의 기관만 대상)
+func TestOrganizationHandler(t *testing.T) {
+	// Given
+	repo := mocks.NewOrganizationRepository()
+	organization := domain.NewOrganization("해바라기 유치원", domain.OrganizationTypeKindergarten)
+	require.NoError(t, repo.Create(t.Context(), organization))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:       testTokens,
+		Organization: handler.NewOrganizationHandler(service.NewOrganizationService(repo)),
+	})
+	admin := &auth.Principal{UserID: "admin-1", OrganizationID: organization.ID, Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", OrganizationID: organization.ID, Role: domain.RoleDriver, ProfileID: "driver-1"}
+	guardian := &auth.Principal{UserID: "user-guardian", OrganizationID: organization.ID, Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+
+	// When
+	got := performJSONAs(router, driver, http.MethodGet, "/api/v1/organization", nil)
+	guardianGet := performJSONAs(router, guardian, http.MethodGet, "/api/v1/organization", nil)
+	otherOrganization := performJSONAs(router, &auth.Principal{UserID: "admin-2", Role: domain.RoleAdmin}, http.MethodGet, "/api/v1/organization", nil)
+	forbidden := performJSONAs(router, driver, http.MethodPut, "/api/v1/organization", map[string]interface{}{"name": "새 이름"})
+	invalid := performJSONAs(router, admin, http.MethodPut, "/api/v1/organization", map[string]interface{}{"type": "hospital"})
+	updated := performJSONAs(router, admin, http.MethodPut, "/api/v1/organization", map[string]interface{}{"type": "academy", "phone": "02-123-4567"})
+
+	// Then
+	require.Equal(t, http.StatusOK, got.Code)
+	assert.Equal(t, "해바라기 유치원", decodeBody(t, got)["data"].(map[string]interface{})["name"])
+	assert.Equal(t, http.StatusForbidden, guardianGet.Code)
+	assert.Equal(t, http.StatusNotFound, otherOrganization.Code) // 테스트 기본 기관(org-1)은 등록되지 않음
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	require.Equal(t, http.StatusOK, updated.Code)
+	data := decodeBody(t, updated)["data"].(map[string]interface{})
+	assert.Equal(t, "academy", data["type"])
+	assert.Equal(t, "02-123-4567", data["phone"])
+}
+
+// TestOrganizationHandler_Settings - 기관 설정 조회는 인증된 사용자 모두, 수정은 관리자만
+func TestOrganizationHandler_Settings(t *testing.T) {
+	// Given
+	repo := mocks.NewOrganizationRepository()
+	organization := domain.NewOrganization("해바라기 유치원", domain.OrganizationTypeKindergarten)
+	require.NoError(t, repo.Create(t.Context(), organization))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:       testTokens,
+		Organization: handler.NewOrganizationHandler(service.NewOrganizationService(repo)),
+	})
+	admin := &auth.Principal{UserID: "admin-1", OrganizationID: organization.ID, Role: domain.RoleAdmin}
+	guardian := &auth.Principal{UserID: "user-guardian", OrganizationID: organization.ID, Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+
+	// When
+	forbidden := performJSONAs(router, guardian, http.MethodPut, "/api/v1/org/settings", map[string]interface{}{"display_name": "해바라기"})
+	invalid := performJSONAs(router, admin, http.MethodPut, "/api/v1/org/settings", map[string]interface{}{"allowed_origins": []string{"sunflower.example.com"}})
+	updated := performJSONAs(router, admin, http.MethodPut, "/api/v1/org/settings", map[string]interface{}{
+		"display_name":    "해바라기",
+		"allowed_origins": []string{"https://sunflower.example.com"},
+	})
+	got := performJSONAs(router, guardian, http.MethodGet, "/api/v1/org/settings", nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	require.Equal(t, http.StatusOK, updated.Code)
+	require.Equal(t, http.StatusOK, got.Code)
+	data := decodeBody(t, got)["data"].(map[string]interface{})
+	assert.Equal(t, "해바라기", data["display_name"])
+	assert.Equal(t, []interface{}{"https://sunflower.example.com"}, data["allowed_origins"])
+}
@@ -0,0 +1,66 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPassengerAbsenceHandler - 연결된 보호자의 결석 신고/조회/취소, 기사 403, 형식 오류 400, 중복 409
+func TestPassengerAbsenceHandler(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	guardianRepo := mocks.NewGuardianRepository()
+	guardian := domain.NewGuardian("김보호", "010-1234-5678")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	require.NoError(t, guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		PassengerAbsence: handler.NewPassengerAbsenceHandler(service.NewPassengerAbsenceService(
+			mocks.NewPassengerAbsenceRepository(), passengerRepo, guardianRepo, mocks.NewTripRepository(), mocks.NewScheduleRepository(), nil)),
+	})
+	parent := &auth.Principal{UserID: "user-guardian", Role: domain.RoleGuardian, ProfileID: guardian.ID}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/passengers/" + passenger.ID + "/absences"
+	body := map[string]interface{}{
+		"date":      time.Now().AddDate(0, 0, 2).Format("2006-01-02"),
+		"time_slot": "morning",
+		"reason":    "병원 진료",
+	}
+
+	// When
+	created := performJSONAs(router, parent, http.MethodPost, path, body)
+	duplicate := performJSONAs(router, parent, http.MethodPost, path, body)
+	invalid := performJSONAs(router, parent, http.MethodPost, path, map[string]interface{}{"date": "3/10", "time_slot": "night"})
+	forbidden := performJSONAs(router, driver, http.MethodPost, path, body)
+	listed := performJSONAs(router, parent, http.MethodGet, path, nil)
+
+	// Then
+	require.Equal(t, http.StatusCreated, created.Code)
+	assert.Equal(t, http.StatusConflict, duplicate.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusOK, listed.Code)
+	assert.Len(t, decodeBody(t, listed)["data"], 1)
+
+	// When
+	id := decodeBody(t, created)["data"].(map[string]interface{})["id"].(string)
+	deleted := performJSONAs(router, parent, http.MethodDelete, path+"/"+id, nil)
+	missing := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, deleted.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
@@ -0,0 +1,69 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPassengerHandler_Create_FieldErrors - 필드별 검증 에러 응답
+func TestPassengerHandler_Create_FieldErrors(t *testing.T) {
+	// Given
+	passengerService := service.NewPassengerService(mocks.NewPassengerRepository(), mocks.NewRouteRepository(), mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:    testTokens,
+		Passenger: handler.NewPassengerHandler(passengerService),
+	})
+
+	// When
+	w := performJSON(router, http.MethodPost, "/api/v1/passengers", map[string]interface{}{
+		"guardian_name":  "김보호",
+		"guardian_email": "not-an-email",
+		"route_id":       "route",
+	})
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	details := decodeBody(t, w)["error"].(map[string]interface{})["details"].(map[string]interface{})
+	assert.Contains(t, details, "name")
+	assert.Contains(t, details, "guardian_phone")
+	assert.Contains(t, details, "guardian_email")
+	assert.Contains(t, details, "stop_id")
+}
+
+// TestPassengerHandler_Search - 운영 인력 검색 허용, 보호자는 403, 검색어 누락은 400
+func TestPassengerHandler_Search(t *testing.T) {
+	// Given
+	passengerRepo := mocks.NewPassengerRepository()
+	require.NoError(t, passengerRepo.Create(context.Background(), domain.NewPassenger("김민준", "김보호", "010-1234-5678")))
+	passengerService := service.NewPassengerService(passengerRepo, mocks.NewRouteRepository(), mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:    testTokens,
+		Passenger: handler.NewPassengerHandler(passengerService),
+	})
+	attendant := &auth.Principal{UserID: "user-2", Role: domain.RoleAttendant, ProfileID: "attendant-1"}
+	guardian := &auth.Principal{UserID: "user-3", Role: domain.RoleGuardian}
+	path := "/api/v1/passengers/search?q=" + url.QueryEscape("010-1234-5678")
+
+	// When
+	found := performJSONAs(router, attendant, http.MethodGet, path, nil)
+	forbidden := performJSONAs(router, guardian, http.MethodGet, path, nil)
+	missing := performJSON(router, http.MethodGet, "/api/v1/passengers/search", nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, found.Code)
+	data := decodeBody(t, found)["data"].([]interface{})
+	require.Len(t, data, 1)
+	assert.Equal(t, "김민준", data[0].(map[string]interface{})["name"])
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, missing.Code)
+}
@@ -0,0 +1,64 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPassengerPhotoHandler - 동의 전 사진 403 → 보호자 동의 후 등록, 기사 조회 가능, 기사는 등록 불가
+func TestPassengerPhotoHandler(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	guardian := domain.NewGuardian("김보호", "010-1234-5678")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	require.NoError(t, guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+	fileService := service.NewFileService(mocks.NewFileRepository(), storage.NewLocalStorage(t.TempDir()))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:         testTokens,
+		PassengerPhoto: handler.NewPassengerPhotoHandler(service.NewPassengerPhotoService(passengerRepo, guardianRepo, fileService)),
+	})
+	parent := &auth.Principal{UserID: "user-guardian", Role: domain.RoleGuardian, ProfileID: guardian.ID}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/passengers/" + passenger.ID
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	// When
+	noConsentUpload := performMultipartAs(router, parent, http.MethodPut, path+"/photo", nil, "민준.png", png)
+	noConsentView := performJSONAs(router, driver, http.MethodGet, path+"/photo", nil)
+	missingConsent := performJSONAs(router, parent, http.MethodPut, path+"/photo-consent", map[string]interface{}{})
+	consented := performJSONAs(router, parent, http.MethodPut, path+"/photo-consent", map[string]interface{}{"consent": true})
+	driverUpload := performMultipartAs(router, driver, http.MethodPut, path+"/photo", nil, "민준.png", png)
+	uploaded := performMultipartAs(router, parent, http.MethodPut, path+"/photo", nil, "민준.png", png)
+	viewed := performJSONAs(router, driver, http.MethodGet, path+"/photo", nil)
+	driverDelete := performJSONAs(router, driver, http.MethodDelete, path+"/photo", nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, noConsentUpload.Code)
+	assert.Equal(t, http.StatusForbidden, noConsentView.Code)
+	assert.Equal(t, http.StatusBadRequest, missingConsent.Code)
+	require.Equal(t, http.StatusOK, consented.Code)
+	data := decodeBody(t, consented)["data"].(map[string]interface{})
+	assert.Equal(t, true, data["photo_consent"])
+	assert.Equal(t, "user-guardian", data["photo_consent_by"])
+	assert.Equal(t, http.StatusForbidden, driverUpload.Code)
+	require.Equal(t, http.StatusOK, uploaded.Code)
+	assert.NotEmpty(t, decodeBody(t, uploaded)["data"].(map[string]interface{})["photo_file_id"])
+	require.Equal(t, http.StatusOK, viewed.Code)
+	assert.Equal(t, png, viewed.Body.Bytes())
+	assert.Equal(t, "image/png", viewed.Header().Get("Content-Type"))
+	assert.Equal(t, http.StatusForbidden, driverDelete.Code)
+}
@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/pkg/tabular"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReportHandler_ExportAttendance - .xlsx 첨부 파일 응답 (관리자 전용, 기간 누락은 400)
+func TestReportHandler_ExportAttendance(t *testing.T) {
+	// Given
+	reportService := service.NewReportService(mocks.NewTripRepository(), mocks.NewScheduleRepository(), mocks.NewRouteRepository(),
+		mocks.NewVehicleRepository(), mocks.NewDriverRepository(), mocks.NewPassengerRepository(), mocks.NewTripStopEventRepository(), nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Report: handler.NewReportHandler(reportService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+
+	// When
+	forbidden := performJSONAs(router, driver, http.MethodGet, "/api/v1/reports/attendance/export?from=2025-03-01&to=2025-03-31", nil)
+	missing := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/attendance/export?to=2025-03-31", nil)
+	exported := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/attendance/export?from=2025-03-01&to=2025-03-31", nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusBadRequest, missing.Code)
+	assert.Contains(t, decodeBody(t, missing)["error"].(map[string]interface{})["details"], "from")
+
+	require.Equal(t, http.StatusOK, exported.Code)
+	assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", exported.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="attendance_2025-03-01_2025-03-31.xlsx"`, exported.Header().Get("Content-Disposition"))
+	rows, err := tabular.Read("attendance.xlsx", exported.Body.Bytes())
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "운행 날짜", rows[0].Cell(0))
+}
+
+// TestReportHandler_ExportDrivingLog - .pdf 첨부 파일 응답 (관리자 전용, 차량 누락 400, 없는 차량 404)
+func TestReportHandler_ExportDrivingLog(t *testing.T) {
+	// Given
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(t.Context(), vehicle))
+	reportService := service.NewReportService(mocks.NewTripRepository(), mocks.NewScheduleRepository(), mocks.NewRouteRepository(),
+		vehicleRepo, mocks.NewDriverRepository(), mocks.NewPassengerRepository(), mocks.NewTripStopEventRepository(), nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Report: handler.NewReportHandler(reportService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/reports/driving-log/export?from=2025-03-01&to=2025-03-31&vehicle_id="
+
+	// When
+	forbidden := performJSONAs(router, driver, http.MethodGet, path+vehicle.ID, nil)
+	missing := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/driving-log/export?from=2025-03-01&to=2025-03-31", nil)
+	notFound := performJSONAs(router, admin, http.MethodGet, path+"00000000-0000-0000-0000-000000000000", nil)
+	exported := performJSONAs(router, admin, http.MethodGet, path+vehicle.ID, nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusBadRequest, missing.Code)
+	assert.Contains(t, decodeBody(t, missing)["error"].(map[string]interface{})["details"], "vehicle_id")
+	assert.Equal(t, http.StatusNotFound, notFound.Code)
+
+	require.Equal(t, http.StatusOK, exported.Code)
+	assert.Equal(t, "application/pdf", exported.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="driving_log_2025-03-01_2025-03-31.pdf"`, exported.Header().Get("Content-Disposition"))
+	assert.True(t, bytes.HasPrefix(exported.Body.Bytes(), []byte("%PDF-")))
+}
+
+// TestReportHandler_DriverSummary - 기사별 근무 합계 (관리자 전용, 기간 검증 400)
+func TestReportHandler_DriverSummary(t *testing.T) {
+	// Given
+	driverRepo := mocks.NewDriverRepository()
+	driver := domain.NewDriver("박기사", "010-5555-6666", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(3, 0, 0))
+	require.NoError(t, driverRepo.Create(t.Context(), driver))
+	reportService := service.NewReportService(mocks.NewTripRepository(), mocks.NewScheduleRepository(), mocks.NewRouteRepository(),
+		mocks.NewVehicleRepository(), driverRepo, mocks.NewPassengerRepository(), mocks.NewTripStopEventRepository(), nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Report: handler.NewReportHandler(reportService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	attendant := &auth.Principal{UserID: "user-attendant", Role: domain.RoleAttendant, ProfileID: "attendant-1"}
+
+	// When
+	forbidden := performJSONAs(router, attendant, http.MethodGet, "/api/v1/reports/drivers?from=2025-03-01&to=2025-03-31", nil)
+	reversed := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/drivers?from=2025-03-31&to=2025-03-01", nil)
+	report := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/drivers?from=2025-03-01&to=2025-03-31", nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusBadRequest, reversed.Code)
+	assert.Contains(t, decodeBody(t, reversed)["error"].(map[string]interface{})["details"], "to")
+	require.Equal(t, http.StatusOK, report.Code)
+	drivers := decodeBody(t, report)["data"].(map[string]interface{})["drivers"].([]interface{})
+	require.Len(t, drivers, 1)
+	assert.Equal(t, "박기사", drivers[0].(map[string]interface{})["driver_name"])
+	assert.Equal(t, float64(0), drivers[0].(map[string]interface{})["trips"])
+}
+
+// TestReportHandler_VehicleSummary - 차량 가동률 (관리자 전용, 기간 누락 400)
+func TestReportHandler_VehicleSummary(t *testing.T) {
+	// Given
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(t.Context(), vehicle))
+	reportService := service.NewReportService(mocks.NewTripRepository(), mocks.NewScheduleRepository(), mocks.NewRouteRepository(),
+		vehicleRepo, mocks.NewDriverRepository(), mocks.NewPassengerRepository(), mocks.NewTripStopEventRepository(), nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Report: handler.NewReportHandler(reportService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+
+	// When
+	forbidden := performJSONAs(router, driver, http.MethodGet, "/api/v1/reports/vehicles?from=2025-03-01&to=2025-03-31", nil)
+	missing := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/vehicles?from=2025-03-01", nil)
+	report := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/vehicles?from=2025-03-01&to=2025-03-31", nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, missing.Code)
+	require.Equal(t, http.StatusOK, report.Code)
+	data := decodeBody(t, report)["data"].(map[string]interface{})
+	assert.Equal(t, float64(0), data["service_days"])
+	vehicles := data["vehicles"].([]interface{})
+	require.Len(t, vehicles, 1)
+	assert.Equal(t, "12가3456", vehicles[0].(map[string]interface{})["plate_number"])
+	assert.Equal(t, float64(0), vehicles[0].(map[string]interface{})["utilization"])
+}
+
+// TestReportHandler_Punctuality - 정시성 통계 (관리자 전용, 잘못된 날짜 400, 운행이 없으면 빈 목록)
+func TestReportHandler_Punctuality(t *testing.T) {
+	// Given
+	reportService := service.NewReportService(mocks.NewTripRepository(), mocks.NewScheduleRepository(), mocks.NewRouteRepository(),
+		mocks.NewVehicleRepository(), mocks.NewDriverRepository(), mocks.NewPassengerRepository(), mocks.NewTripStopEventRepository(), nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Report: handler.NewReportHandler(reportService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	guardian := &auth.Principal{UserID: "user-guardian", Role: domain.RoleGuardian, ProfileID: "guardian-1"}
+
+	// When
+	forbidden := performJSONAs(router, guardian, http.MethodGet, "/api/v1/reports/punctuality?from=2025-03-01&to=2025-03-31", nil)
+	invalid := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/punctuality?from=2025-03-01&to=03/31", nil)
+	report := performJSONAs(router, admin, http.MethodGet, "/api/v1/reports/punctuality?from=2025-03-01&to=2025-03-31", nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	require.Equal(t, http.StatusOK, report.Code)
+	data := decodeBody(t, report)["data"].(map[string]interface{})
+	assert.Equal(t, float64(0), data["overall"].(map[string]interface{})["trips"])
+	assert.Empty(t, data["routes"])
+	assert.Empty(t, data["drivers"])
+}
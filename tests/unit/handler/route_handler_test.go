@@ -0,0 +1,165 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/maps"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRouteRouter - 인메모리 Repository로 구성한 경로 테스트 라우터
+func newRouteRouter() *gin.Engine {
+	return newRouteRouterWithMaps(nil)
+}
+
+// newRouteRouterWithMaps - 지도 API를 연결한 경로 테스트 라우터
+func newRouteRouterWithMaps(mapsClient maps.Client) *gin.Engine {
+	routeService := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), mapsClient, nil)
+	return handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Route:  handler.NewRouteHandler(routeService),
+	})
+}
+
+// newStop - 테스트용 정류장 요청 바디
+func newStop(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":      name,
+		"address":   "서울시 강남구",
+		"latitude":  37.5,
+		"longitude": 127.0,
+	}
+}
+
+// TestRouteHandler_ReorderStops - 경로 생성 후 정류장 전체 재배치
+func TestRouteHandler_ReorderStops(t *testing.T) {
+	// Given
+	router := newRouteRouter()
+	w := performJSON(router, http.MethodPost, "/api/v1/routes", map[string]interface{}{
+		"name":  "1호차 등원",
+		"stops": []interface{}{newStop("A"), newStop("B")},
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	route := decodeBody(t, w)["data"].(map[string]interface{})
+	stops := route["stops"].([]interface{})
+	a := stops[0].(map[string]interface{})["id"]
+	b := stops[1].(map[string]interface{})["id"]
+
+	// When
+	w = performJSON(router, http.MethodPut, "/api/v1/routes/"+route["id"].(string)+"/stops/reorder", map[string]interface{}{
+		"stop_ids": []interface{}{b, a},
+	})
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	reordered := decodeBody(t, w)["data"].(map[string]interface{})["stops"].([]interface{})
+	assert.Equal(t, "B", reordered[0].(map[string]interface{})["name"])
+	assert.Equal(t, float64(1), reordered[0].(map[string]interface{})["order"])
+}
+
+// TestRouteHandler_AddStop_RouteNotFound - 없는 경로에 정류장 추가
+func TestRouteHandler_AddStop_RouteNotFound(t *testing.T) {
+	// Given
+	router := newRouteRouter()
+
+	// When
+	w := performJSON(router, http.MethodPost, "/api/v1/routes/unknown/stops", newStop("A"))
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestRouteHandler_Geometry - 정류장 사이 구간 반환, 지도 API 장애는 502
+func TestRouteHandler_Geometry(t *testing.T) {
+	// Given
+	mapsClient := mocks.NewMapsClient()
+	router := newRouteRouterWithMaps(mapsClient)
+	second := newStop("B")
+	second["latitude"] = 37.51
+	w := performJSON(router, http.MethodPost, "/api/v1/routes", map[string]interface{}{
+		"name":  "1호차 등원",
+		"stops": []interface{}{newStop("A"), second},
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	route := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Greater(t, route["total_distance"], float64(0))
+
+	// When
+	w = performJSON(router, http.MethodGet, "/api/v1/routes/"+route["id"].(string)+"/geometry", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	geometry := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Len(t, geometry["legs"], 1)
+	assert.Equal(t, route["total_distance"], geometry["distance"])
+
+	// Given
+	mapsClient.Err = errors.New("maps: unexpected status 401")
+
+	// When
+	w = performJSON(router, http.MethodGet, "/api/v1/routes/"+route["id"].(string)+"/geometry", nil)
+
+	// Then
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.Equal(t, util.ErrCodeExternal, decodeBody(t, w)["error"].(map[string]interface{})["code"])
+}
+
+// TestRouteHandler_AddStop_Coordinates - 위도/경도는 함께 입력, 주소 검색 미설정 시 좌표 필수
+func TestRouteHandler_AddStop_Coordinates(t *testing.T) {
+	// Given
+	router := newRouteRouter()
+	w := performJSON(router, http.MethodPost, "/api/v1/routes", map[string]interface{}{"name": "1호차 등원"})
+	require.Equal(t, http.StatusCreated, w.Code)
+	path := "/api/v1/routes/" + decodeBody(t, w)["data"].(map[string]interface{})["id"].(string) + "/stops"
+	latitudeOnly := newStop("A")
+	delete(latitudeOnly, "longitude")
+	addressOnly := newStop("B")
+	delete(addressOnly, "latitude")
+	delete(addressOnly, "longitude")
+
+	// When
+	partial := performJSON(router, http.MethodPost, path, latitudeOnly)
+	missing := performJSON(router, http.MethodPost, path, addressOnly)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, partial.Code)
+	assert.Contains(t, decodeBody(t, partial)["error"].(map[string]interface{})["details"], "longitude")
+	assert.Equal(t, http.StatusBadRequest, missing.Code)
+	assert.Contains(t, decodeBody(t, missing)["error"].(map[string]interface{})["details"], "latitude")
+}
+
+// TestRouteHandler_Optimize - 정류장 순서 제안만 반환하고 경로는 그대로
+func TestRouteHandler_Optimize(t *testing.T) {
+	// Given
+	router := newRouteRouterWithMaps(mocks.NewMapsClient())
+	stops := make([]interface{}, 4)
+	for i, latitude := range []float64{37.50, 37.52, 37.51, 37.53} {
+		stop := newStop(string(rune('A' + i)))
+		stop["latitude"] = latitude
+		stops[i] = stop
+	}
+	w := performJSON(router, http.MethodPost, "/api/v1/routes", map[string]interface{}{"name": "1호차 등원", "stops": stops})
+	require.Equal(t, http.StatusCreated, w.Code)
+	routeID := decodeBody(t, w)["data"].(map[string]interface{})["id"].(string)
+
+	// When
+	w = performJSON(router, http.MethodPost, "/api/v1/routes/"+routeID+"/optimize", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	proposal := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Equal(t, true, proposal["changed"])
+	assert.Equal(t, "C", proposal["stops"].([]interface{})[1].(map[string]interface{})["name"])
+	assert.Greater(t, proposal["saved_duration"], float64(0))
+
+	w = performJSON(router, http.MethodGet, "/api/v1/routes/"+routeID+"/stops", nil)
+	assert.Equal(t, "B", decodeBody(t, w)["data"].([]interface{})[1].(map[string]interface{})["name"])
+}
@@ -0,0 +1,53 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScheduleExceptionHandler - 예외 날짜 추가(관리자)/조회(운영 인력)/삭제, 형식 검증과 중복 409
+func TestScheduleExceptionHandler(t *testing.T) {
+	// Given
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오후 2시 A코스", "14:00", domain.TimeSlotAfternoon, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(context.Background(), schedule))
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:            testTokens,
+		ScheduleException: handler.NewScheduleExceptionHandler(service.NewScheduleExceptionService(scheduleRepo, mocks.NewScheduleExceptionRepository())),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/schedules/" + schedule.ID + "/exceptions"
+
+	// When
+	created := performJSON(router, http.MethodPost, path, map[string]interface{}{"date": "2025-05-05", "type": "skip", "reason": "어린이날 휴원"})
+	duplicate := performJSON(router, http.MethodPost, path, map[string]interface{}{"date": "2025-05-05", "type": "extra"})
+	invalid := performJSON(router, http.MethodPost, path, map[string]interface{}{"date": "2025-05-05", "type": "cancel"})
+	forbidden := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"date": "2025-07-20", "type": "extra"})
+	listed := performJSONAs(router, driver, http.MethodGet, path, nil)
+
+	// Then
+	require.Equal(t, http.StatusCreated, created.Code)
+	assert.Equal(t, http.StatusConflict, duplicate.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusOK, listed.Code)
+	assert.Len(t, decodeBody(t, listed)["data"], 1)
+
+	// When
+	id := decodeBody(t, created)["data"].(map[string]interface{})["id"].(string)
+	deleted := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+	missing := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, deleted.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
@@ -0,0 +1,53 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestScheduleHandler_Create_InvalidFormat - 출발 시각/요일 형식 검증
+func TestScheduleHandler_Create_InvalidFormat(t *testing.T) {
+	scheduleService := service.NewScheduleService(
+		mocks.NewScheduleRepository(), mocks.NewRouteRepository(), mocks.NewVehicleRepository(), mocks.NewDriverRepository(), mocks.NewPassengerRepository(),
+	)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Schedule: handler.NewScheduleHandler(scheduleService),
+	})
+
+	tests := []struct {
+		name       string
+		startTime  string
+		daysOfWeek []int
+	}{
+		{"한 자리 시각", "8:00", []int{1}},
+		{"범위 초과 시각", "24:30", []int{1}},
+		{"요일 0", "08:00", []int{0, 1}},
+		{"요일 8", "08:00", []int{8}},
+		{"요일 없음", "08:00", []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			w := performJSON(router, http.MethodPost, "/api/v1/schedules", map[string]interface{}{
+				"name":              "오전 8시 A코스",
+				"start_time":        tt.startTime,
+				"time_slot":         "morning",
+				"days_of_week":      tt.daysOfWeek,
+				"route_id":          "route",
+				"vehicle_id":        "vehicle",
+				"default_driver_id": "driver",
+			})
+
+			// Then
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Equal(t, "VALIDATION_ERROR", decodeBody(t, w)["error"].(map[string]interface{})["code"])
+		})
+	}
+}
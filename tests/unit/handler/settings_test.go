@@ -0,0 +1,82 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLiveSettings_CORSOrigins - 허용 Origin 교체가 다음 요청부터 적용
+func TestLiveSettings_CORSOrigins(t *testing.T) {
+	// Given: 관리자 화면만 허용
+	settings := handler.NewLiveSettings(handler.Settings{CORSOrigins: []string{"https://admin.eodini.kr"}})
+	router := handler.SetupRouter(&handler.Handlers{Settings: settings})
+	preflight := func(origin string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/vehicles", nil)
+		req.Header.Set("Origin", origin)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// When
+	before := preflight("https://app.eodini.kr")
+	settings.Store(handler.Settings{CORSOrigins: []string{"https://admin.eodini.kr", "https://app.eodini.kr"}})
+	after := preflight("https://app.eodini.kr")
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, before.Code)
+	assert.Equal(t, http.StatusNoContent, after.Code)
+	assert.Equal(t, "https://app.eodini.kr", after.Header().Get("Access-Control-Allow-Origin"))
+}
+
+// TestLiveSettings_RateLimitAndQuota - 요청 제한/일일 한도 플래그와 한도 교체
+func TestLiveSettings_RateLimitAndQuota(t *testing.T) {
+	// Given: 요청 제한/일일 한도 모두 꺼짐
+	quotaService := service.NewQuotaService(mocks.NewUsageRepository(), service.QuotaLimits{User: 100})
+	settings := handler.NewLiveSettings(handler.Settings{
+		RateLimits: handler.RateLimits{API: middleware.RateLimitRule{Name: "api", Limit: 1, Window: time.Minute}},
+	})
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Limiter:  mocks.NewRateLimiter(),
+		Quotas:   quotaService,
+		Settings: settings,
+		Vehicle:  handler.NewVehicleHandler(service.NewVehicleService(mocks.NewVehicleRepository())),
+	})
+	driver := &auth.Principal{UserID: "driver-1", Role: domain.RoleDriver}
+
+	// When
+	performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles", nil)
+	disabled := performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles", nil)
+
+	// Then: 꺼져 있으면 한도 헤더 없이 통과
+	assert.Equal(t, http.StatusOK, disabled.Code)
+	assert.Empty(t, disabled.Header().Get("X-RateLimit-Limit"))
+	assert.Empty(t, disabled.Header().Get("X-Quota-Limit"))
+
+	// Given: 재로드로 두 기능 켜기
+	current := settings.Load()
+	current.RateLimitEnabled = true
+	current.QuotaEnabled = true
+	settings.Store(current)
+
+	// When
+	first := performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles", nil)
+	over := performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "1", first.Header().Get("X-RateLimit-Limit"))
+	assert.NotEmpty(t, first.Header().Get("X-Quota-Limit"))
+	assert.Equal(t, http.StatusTooManyRequests, over.Code)
+}
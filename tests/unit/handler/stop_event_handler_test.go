@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStopEventHandler_RecordAndPunctuality - 배정 기사의 도착 기록 (종류 오류 400, 중복 409) 후 정시성 보고서 조회
+func TestStopEventHandler_RecordAndPunctuality(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	route := domain.NewRoute("A코스", "", 40)
+	stop := domain.NewStop(route.ID, "해오름아파트 정문", "", 1, 37.5, 127.0, 10)
+	route.AddStop(*stop)
+	require.NoError(t, routeRepo.Create(ctx, route))
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	trip := domain.NewTrip(schedule.ID, time.Now(), "vehicle-1", "driver-1", nil)
+	require.NoError(t, trip.Start("driver:driver-1", nil))
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	stopEventService := service.NewStopEventService(tripService, scheduleRepo, routeRepo, mocks.NewTripStopEventRepository(), nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:    testTokens,
+		StopEvent: handler.NewStopEventHandler(stopEventService),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/trips/" + trip.ID + "/stops/" + stop.ID + "/events"
+
+	// When
+	invalid := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"type": "approaching"})
+	recorded := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"type": "arrived", "latitude": 37.5, "longitude": 127.0})
+	duplicate := performJSONAs(router, driver, http.MethodPost, path, map[string]interface{}{"type": "arrived"})
+	report := performJSON(router, http.MethodGet, "/api/v1/trips/"+trip.ID+"/punctuality", nil)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, http.StatusCreated, recorded.Code)
+	data := decodeBody(t, recorded)["data"].(map[string]interface{})
+	assert.Equal(t, "manual", data["source"])
+	assert.Equal(t, "driver:driver-1", data["recorded_by"])
+	assert.Equal(t, http.StatusConflict, duplicate.Code)
+
+	require.Equal(t, http.StatusOK, report.Code)
+	stops := decodeBody(t, report)["data"].(map[string]interface{})["stops"].([]interface{})
+	require.Len(t, stops, 1)
+	assert.Equal(t, "manual", stops[0].(map[string]interface{})["arrival_source"])
+}
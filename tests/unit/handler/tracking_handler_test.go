@@ -0,0 +1,206 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var trackingDriver = &auth.Principal{UserID: "u-1", Role: domain.RoleDriver, ProfileID: "driver-1"}
+
+// newTrackingRouter - 운행 중인 운행 하나와 실시간 위치 핸들러를 가진 테스트 라우터
+func newTrackingRouter(t *testing.T) (*gin.Engine, *realtime.Hub, string) {
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	tripService := service.NewTripService(mocks.NewTripRepository(), scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	trip, err := tripService.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2025-03-03"})
+	require.NoError(t, err)
+	_, err = tripService.Start(auth.WithPrincipal(ctx, trackingDriver), trip.ID, &dto.TripLocationRequest{})
+	require.NoError(t, err)
+
+	hub := realtime.NewHub()
+	alertService := service.NewAlertService(mocks.NewTripAlertRepository(), tripService, service.AlertRules{SpeedLimit: 80}, nil)
+	trackingService := service.NewTrackingService(tripService, scheduleRepo, mocks.NewRouteRepository(), mocks.NewGuardianRepository(), mocks.NewPassengerRepository(), mocks.NewTripLocationRepository(), hub, nil, alertService)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:   testTokens,
+		Tracking: handler.NewTrackingHandler(trackingService, hub),
+		ETA:      handler.NewETAHandler(service.NewETAService(trackingService, mocks.NewTripETARepository(), nil, time.Minute)),
+		Alert:    handler.NewAlertHandler(alertService),
+	})
+	return router, hub, trip.ID
+}
+
+// dialWatch - access_token 쿼리로 운행 구독 WebSocket 연결
+func dialWatch(server *httptest.Server, principal *auth.Principal, tripID string) (*websocket.Conn, *http.Response, error) {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?trip_id=" + tripID + "&access_token=" + issueToken(principal)
+	return websocket.DefaultDialer.Dial(url, nil)
+}
+
+// TestTrackingHandler_ReportLocation - 위치 전송 검증 및 권한
+func TestTrackingHandler_ReportLocation(t *testing.T) {
+	// Given
+	router, _, tripID := newTrackingRouter(t)
+	path := "/api/v1/trips/" + tripID + "/locations"
+
+	// When & Then
+	w := performJSONAs(router, trackingDriver, http.MethodPost, path, map[string]interface{}{"latitude": 137.0, "longitude": 126.978})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = performJSONAs(router, &auth.Principal{UserID: "u-3", Role: domain.RoleGuardian, ProfileID: "guardian-1"}, http.MethodPost, path, map[string]interface{}{"latitude": 37.5665, "longitude": 126.978})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = performJSONAs(router, trackingDriver, http.MethodPost, path, map[string]interface{}{"latitude": 37.5665, "longitude": 126.978, "heading": 90})
+	require.Equal(t, http.StatusOK, w.Code)
+	data := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Equal(t, "vehicle-1", data["vehicle_id"])
+	assert.Equal(t, 90.0, data["heading"])
+}
+
+// TestTrackingHandler_Track - 기록된 위치로 주행 경로 조회, 보호자는 조회 불가
+func TestTrackingHandler_Track(t *testing.T) {
+	// Given
+	router, _, tripID := newTrackingRouter(t)
+	for _, lat := range []float64{37.5, 37.501, 37.502} {
+		w := performJSONAs(router, trackingDriver, http.MethodPost, "/api/v1/trips/"+tripID+"/locations", map[string]interface{}{"latitude": lat, "longitude": 127.0})
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	path := "/api/v1/trips/" + tripID + "/track"
+
+	// When & Then
+	w := performJSON(router, http.MethodGet, path+"?tolerance=0", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	data := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Equal(t, 3.0, data["total_points"])
+	assert.Len(t, data["points"], 3)
+	assert.NotEmpty(t, data["polyline"])
+
+	assert.Equal(t, http.StatusBadRequest, performJSON(router, http.MethodGet, path+"?tolerance=-1", nil).Code)
+	assert.Equal(t, http.StatusForbidden, performJSONAs(router, &auth.Principal{UserID: "u-3", Role: domain.RoleGuardian, ProfileID: "guardian-1"}, http.MethodGet, path, nil).Code)
+	assert.Equal(t, http.StatusNotFound, performJSON(router, http.MethodGet, "/api/v1/trips/missing/track", nil).Code)
+}
+
+// TestTrackingHandler_Replay - 구간/간격 쿼리로 운행 재생 조회
+func TestTrackingHandler_Replay(t *testing.T) {
+	// Given: 10초 간격 기록 2개
+	router, _, tripID := newTrackingRouter(t)
+	start := time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+	for i, lat := range []float64{37.5, 37.501} {
+		body := map[string]interface{}{"latitude": lat, "longitude": 127.0, "recorded_at": start.Add(time.Duration(i) * 10 * time.Second)}
+		require.Equal(t, http.StatusOK, performJSONAs(router, trackingDriver, http.MethodPost, "/api/v1/trips/"+tripID+"/locations", body).Code)
+	}
+	path := "/api/v1/trips/" + tripID + "/replay"
+
+	// When & Then
+	w := performJSON(router, http.MethodGet, path+"?interval=2&from=2025-03-03T08:00:04Z", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	data := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Equal(t, 2.0, data["interval"])
+	assert.Len(t, data["frames"], 4) // 4, 6, 8, 10초
+
+	assert.Equal(t, http.StatusOK, performJSON(router, http.MethodGet, path, nil).Code)
+	assert.Equal(t, http.StatusBadRequest, performJSON(router, http.MethodGet, path+"?interval=301", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, performJSON(router, http.MethodGet, path+"?from=yesterday", nil).Code)
+	assert.Equal(t, http.StatusForbidden, performJSONAs(router, &auth.Principal{UserID: "u-3", Role: domain.RoleGuardian, ProfileID: "guardian-1"}, http.MethodGet, path, nil).Code)
+}
+
+// TestETAHandler_Get - 관리자는 도착 예정 시간 조회, 배정 기사는 조회 불가
+func TestETAHandler_Get(t *testing.T) {
+	// Given
+	router, _, tripID := newTrackingRouter(t)
+	require.Equal(t, http.StatusOK, performJSONAs(router, trackingDriver, http.MethodPost, "/api/v1/trips/"+tripID+"/locations", map[string]interface{}{"latitude": 37.5, "longitude": 127.0}).Code)
+	path := "/api/v1/trips/" + tripID + "/eta"
+
+	// When & Then
+	w := performJSON(router, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	data := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Equal(t, tripID, data["trip_id"])
+	assert.NotNil(t, data["position"])
+	assert.Empty(t, data["stops"]) // 경로 미등록
+
+	assert.Equal(t, http.StatusForbidden, performJSONAs(router, trackingDriver, http.MethodGet, path, nil).Code)
+	assert.Equal(t, http.StatusNotFound, performJSON(router, http.MethodGet, "/api/v1/trips/missing/eta", nil).Code)
+}
+
+// TestAlertHandler_List - 과속 위치 전송 후 경보 조회, 보호자는 조회 불가
+func TestAlertHandler_List(t *testing.T) {
+	// Given
+	router, _, tripID := newTrackingRouter(t)
+	require.Equal(t, http.StatusOK, performJSONAs(router, trackingDriver, http.MethodPost, "/api/v1/trips/"+tripID+"/locations", map[string]interface{}{"latitude": 37.5, "longitude": 127.0, "speed": 95}).Code)
+	path := "/api/v1/trips/" + tripID + "/alerts"
+
+	// When & Then
+	w := performJSON(router, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	alerts := decodeBody(t, w)["data"].([]interface{})
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "speeding", alerts[0].(map[string]interface{})["type"])
+
+	assert.Equal(t, http.StatusForbidden, performJSONAs(router, &auth.Principal{UserID: "u-3", Role: domain.RoleGuardian, ProfileID: "guardian-1"}, http.MethodGet, path, nil).Code)
+	assert.Equal(t, http.StatusNotFound, performJSON(router, http.MethodGet, "/api/v1/trips/missing/alerts", nil).Code)
+}
+
+// TestTrackingHandler_Watch - 관리자가 구독하면 전송된 위치를 실시간으로 받고, 연결 종료 시 구독 해제
+func TestTrackingHandler_Watch(t *testing.T) {
+	// Given
+	router, hub, tripID := newTrackingRouter(t)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	conn, _, err := dialWatch(server, &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}, tripID)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return hub.SubscriberCount(tripID) == 1 }, time.Second, 10*time.Millisecond)
+
+	// When
+	w := performJSONAs(router, trackingDriver, http.MethodPost, "/api/v1/trips/"+tripID+"/locations", map[string]interface{}{"latitude": 37.5665, "longitude": 126.978})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// Then
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, message, err := conn.ReadMessage()
+	require.NoError(t, err)
+	var event map[string]interface{}
+	require.NoError(t, json.Unmarshal(message, &event))
+	assert.Equal(t, "location", event["type"])
+	assert.Equal(t, tripID, event["trip_id"])
+
+	require.NoError(t, conn.Close())
+	assert.Eventually(t, func() bool { return hub.SubscriberCount(tripID) == 0 }, time.Second, 10*time.Millisecond)
+}
+
+// TestTrackingHandler_Watch_Rejected - 토큰 없음(401), 권한 없는 보호자(403)는 연결 전에 거부
+func TestTrackingHandler_Watch_Rejected(t *testing.T) {
+	// Given
+	router, _, tripID := newTrackingRouter(t)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	// When
+	_, unauthenticated, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws?trip_id="+tripID, nil)
+	require.Error(t, err)
+	_, forbidden, err := dialWatch(server, &auth.Principal{UserID: "u-3", Role: domain.RoleGuardian, ProfileID: "guardian-1"}, tripID)
+	require.Error(t, err)
+
+	// Then
+	assert.Equal(t, http.StatusUnauthorized, unauthenticated.StatusCode)
+	assert.Equal(t, http.StatusForbidden, forbidden.StatusCode)
+}
@@ -0,0 +1,55 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTripAssignmentHandler_Update - 관리자의 기사 교체 (기사는 403, 없는 기사는 400)
+func TestTripAssignmentHandler_Update(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	driverRepo := mocks.NewDriverRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	substitute := domain.NewDriver("김대체", "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	substitute.VerifyLicense("admin-1", time.Now())
+	require.NoError(t, driverRepo.Create(ctx, substitute))
+	trip := domain.NewTrip(schedule.ID, time.Now(), "vehicle-1", "driver-1", nil)
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	tripAssignmentService := service.NewTripAssignmentService(tripService, tripRepo, scheduleRepo, mocks.NewRouteRepository(),
+		mocks.NewVehicleRepository(), driverRepo, mocks.NewAttendantRepository(), mocks.NewPassengerRepository(), mocks.NewGuardianRepository(), nil, nil, nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:         testTokens,
+		TripAssignment: handler.NewTripAssignmentHandler(tripAssignmentService),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/trips/" + trip.ID + "/assignment"
+
+	// When
+	forbidden := performJSONAs(router, driver, http.MethodPatch, path, map[string]interface{}{"driver_id": substitute.ID})
+	missing := performJSON(router, http.MethodPatch, path, map[string]interface{}{"driver_id": "driver-404"})
+	updated := performJSON(router, http.MethodPatch, path, map[string]interface{}{"driver_id": substitute.ID, "reason": "기사 병가"})
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, missing.Code)
+	require.Equal(t, http.StatusOK, updated.Code)
+	data := decodeBody(t, updated)["data"].(map[string]interface{})
+	assert.Equal(t, substitute.ID, data["assigned_driver_id"])
+	assert.Equal(t, "vehicle-1", data["vehicle_id"])
+}
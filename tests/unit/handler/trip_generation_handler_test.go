@@ -0,0 +1,54 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTripGenerationHandler_Preview - 관리자 미리보기 (날짜 필수, 기사는 403)
+func TestTripGenerationHandler_Preview(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	require.NoError(t, scheduleRepo.Create(ctx, domain.NewSchedule("평일 08:00", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", vehicle.ID, "driver-1")))
+
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		TripGeneration: handler.NewTripGenerationHandler(service.NewTripGenerationService(scheduleRepo, mocks.NewScheduleExceptionRepository(), mocks.NewDriverAssignmentRepository(), mocks.NewAttendantAssignmentRepository(), vehicleRepo, mocks.NewDriverRepository(), mocks.NewTripRepository(),
+			mocks.NewPassengerRepository(), service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)),
+	})
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+
+	// When
+	invalidDate := performJSON(router, http.MethodGet, "/api/v1/trip-generation/preview?date=2025-3-10", nil)
+	today := performJSON(router, http.MethodGet, "/api/v1/trip-generation/preview", nil)
+	forbidden := performJSONAs(router, driver, http.MethodGet, "/api/v1/trip-generation/preview?date=2025-03-10", nil)
+	monday := performJSON(router, http.MethodGet, "/api/v1/trip-generation/preview?date=2025-03-10", nil)
+	sunday := performJSON(router, http.MethodGet, "/api/v1/trip-generation/preview?date=2025-03-09", nil)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, invalidDate.Code)
+	require.Equal(t, http.StatusOK, today.Code) // 날짜를 비우면 기관 시간대(기본 한국 시간)의 오늘
+	assert.Equal(t, time.Now().In(time.FixedZone("KST", 9*60*60)).Format(time.DateOnly), decodeBody(t, today)["data"].(map[string]interface{})["date"])
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusOK, monday.Code)
+	planned := decodeBody(t, monday)["data"].(map[string]interface{})["planned"].([]interface{})
+	require.Len(t, planned, 1)
+	assert.Equal(t, "2025-03-10T08:00:00+09:00", planned[0].(map[string]interface{})["departure_at"])
+	skipped := decodeBody(t, sunday)["data"].(map[string]interface{})["skipped"].([]interface{})
+	require.Len(t, skipped, 1)
+	assert.Equal(t, "not_service_day", skipped[0].(map[string]interface{})["reason"])
+}
@@ -0,0 +1,71 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTripHandler_StartAuthorization - 관리자가 생성한 운행은 배정 기사만 시작 가능, 시작 후 일시정지/재개
+func TestTripHandler_StartAuthorization(t *testing.T) {
+	// Given: 월~금 일정과 2025-03-03(월) 운행
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(context.Background(), schedule))
+
+	tripService := service.NewTripService(mocks.NewTripRepository(), scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Trip:   handler.NewTripHandler(tripService),
+	})
+
+	w := performJSON(router, http.MethodPost, "/api/v1/trips", map[string]interface{}{
+		"schedule_id": schedule.ID,
+		"date":        "2025-03-03",
+	})
+	require.Equal(t, http.StatusCreated, w.Code)
+	tripID := decodeBody(t, w)["data"].(map[string]interface{})["id"].(string)
+	startPath := "/api/v1/trips/" + tripID + "/start"
+
+	// When & Then
+	assert.Equal(t, http.StatusForbidden, performJSON(router, http.MethodPost, startPath, nil).Code) // 관리자
+	assert.Equal(t, http.StatusForbidden, performJSONAs(router, &auth.Principal{UserID: "u-2", Role: domain.RoleDriver, ProfileID: "driver-2"}, http.MethodPost, startPath, nil).Code)
+	assert.Equal(t, http.StatusForbidden, performJSONAs(router, &auth.Principal{UserID: "u-3", Role: domain.RoleGuardian, ProfileID: "guardian-1"}, http.MethodPost, startPath, nil).Code)
+
+	w = performJSONAs(router, &auth.Principal{UserID: "u-1", Role: domain.RoleDriver, ProfileID: "driver-1"}, http.MethodPost, startPath, map[string]interface{}{
+		"latitude":  37.5665,
+		"longitude": 126.9780,
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+	data := decodeBody(t, w)["data"].(map[string]interface{})
+	assert.Equal(t, "in_progress", data["status"])
+	assert.Equal(t, "driver:driver-1", data["started_by"])
+
+	// When & Then - 일시정지/재개
+	driver := &auth.Principal{UserID: "u-1", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	assert.Equal(t, http.StatusBadRequest, performJSONAs(router, driver, http.MethodPost, "/api/v1/trips/"+tripID+"/pause", map[string]interface{}{}).Code)
+	w = performJSONAs(router, driver, http.MethodPost, "/api/v1/trips/"+tripID+"/pause", map[string]interface{}{"reason": "병원 진료 대기"})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "paused", decodeBody(t, w)["data"].(map[string]interface{})["status"])
+	w = performJSONAs(router, driver, http.MethodPost, "/api/v1/trips/"+tripID+"/resume", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "in_progress", decodeBody(t, w)["data"].(map[string]interface{})["status"])
+	assert.Equal(t, http.StatusConflict, performJSONAs(router, driver, http.MethodPost, "/api/v1/trips/"+tripID+"/resume", nil).Code)
+
+	// When & Then - 차량 내부 확인 전에는 완료 불가
+	assert.Equal(t, http.StatusConflict, performJSONAs(router, driver, http.MethodPost, "/api/v1/trips/"+tripID+"/complete", nil).Code)
+	w = performJSONAs(router, driver, http.MethodPost, "/api/v1/trips/"+tripID+"/vehicle-check", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "driver:driver-1", decodeBody(t, w)["data"].(map[string]interface{})["vehicle_checked_by"])
+	w = performJSONAs(router, driver, http.MethodPost, "/api/v1/trips/"+tripID+"/complete", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "completed", decodeBody(t, w)["data"].(map[string]interface{})["status"])
+}
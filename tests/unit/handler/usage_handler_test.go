@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUsageHandler_QuotaAndUsage - 한도 초과 시 429, 사용량 조회는 한도와 무관하게 가능
+func TestUsageHandler_QuotaAndUsage(t *testing.T) {
+	// Given: 사용자 일일 한도 2회
+	quotaService := service.NewQuotaService(mocks.NewUsageRepository(), service.QuotaLimits{User: 2})
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:  testTokens,
+		Quotas:  quotaService,
+		Usage:   handler.NewUsageHandler(quotaService),
+		Vehicle: handler.NewVehicleHandler(service.NewVehicleService(mocks.NewVehicleRepository())),
+	})
+	driver := &auth.Principal{UserID: "driver-1", Role: domain.RoleDriver}
+
+	// When
+	first := performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles", nil)
+	performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles", nil)
+	over := performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles", nil)
+	usage := performJSONAs(router, driver, http.MethodGet, "/api/v1/me/usage", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "2", first.Header().Get("X-Quota-Limit"))
+	assert.Equal(t, "1", first.Header().Get("X-Quota-Remaining"))
+
+	assert.Equal(t, http.StatusTooManyRequests, over.Code)
+	assert.NotEmpty(t, over.Header().Get("Retry-After"))
+
+	require.Equal(t, http.StatusOK, usage.Code)
+	data := decodeBody(t, usage)["data"].(map[string]interface{})
+	assert.Equal(t, "user", data["identity_type"])
+	assert.Equal(t, "driver-1", data["identity_id"])
+	assert.Equal(t, float64(3), data["used"])
+	assert.Equal(t, float64(2), data["limit"])
+	assert.Equal(t, float64(0), data["remaining"])
+}
+
+// TestUsageHandler_RequiresAuth - 인증 없이 조회 불가
+func TestUsageHandler_RequiresAuth(t *testing.T) {
+	// Given
+	quotaService := service.NewQuotaService(mocks.NewUsageRepository(), service.QuotaLimits{})
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens: testTokens,
+		Usage:  handler.NewUsageHandler(quotaService),
+	})
+
+	// When
+	w := performJSONAs(router, nil, http.MethodGet, "/api/v1/me/usage", nil)
+
+	// Then
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
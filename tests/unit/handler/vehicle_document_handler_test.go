@@ -0,0 +1,102 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// performDocumentUploadAs - 폼 필드와 file 필드를 함께 multipart 업로드 (filename이 비면 파일 없이 요청)
+func performDocumentUploadAs(router *gin.Engine, principal *auth.Principal, path string, fields map[string]string, filename string, content []byte) *httptest.ResponseRecorder {
+	return performMultipartAs(router, principal, http.MethodPost, path, fields, filename, content)
+}
+
+// performMultipartAs - 지정한 메서드로 multipart/form-data 요청
+func performMultipartAs(router *gin.Engine, principal *auth.Principal, method, path string, fields map[string]string, filename string, content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range fields {
+		_ = writer.WriteField(name, value)
+	}
+	if filename != "" {
+		part, _ := writer.CreateFormFile("file", filename)
+		_, _ = part.Write(content)
+	}
+	_ = writer.Close()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+issueToken(principal))
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestVehicleDocumentHandler - 관리자 업로드, 기사 업로드 403, 형식 검증, 목록/다운로드, 삭제
+func TestVehicleDocumentHandler(t *testing.T) {
+	// Given
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(context.Background(), vehicle))
+	documentService := service.NewVehicleDocumentService(vehicleRepo, mocks.NewVehicleDocumentRepository(), service.NewFileService(mocks.NewFileRepository(), storage.NewLocalStorage(t.TempDir())), nil)
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:          testTokens,
+		VehicleDocument: handler.NewVehicleDocumentHandler(documentService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	path := "/api/v1/vehicles/" + vehicle.ID + "/documents"
+	pdf := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\n")
+
+	// When
+	uploaded := performDocumentUploadAs(router, admin, path, map[string]string{"type": "insurance", "expires_on": "2026-02-28"}, "보험증권.pdf", pdf)
+	forbidden := performDocumentUploadAs(router, driver, path, map[string]string{"type": "insurance"}, "보험증권.pdf", pdf)
+	noFile := performDocumentUploadAs(router, admin, path, map[string]string{"type": "insurance"}, "", nil)
+	badType := performDocumentUploadAs(router, admin, path, map[string]string{"type": "receipt"}, "영수증.pdf", pdf)
+	badDate := performDocumentUploadAs(router, admin, path, map[string]string{"type": "insurance", "expires_on": "2026/02/28"}, "보험증권.pdf", pdf)
+	notPDF := performDocumentUploadAs(router, admin, path, map[string]string{"type": "other"}, "메모.pdf", []byte("hello"))
+	listed := performJSONAs(router, driver, http.MethodGet, path+"?type=insurance", nil)
+
+	// Then
+	require.Equal(t, http.StatusCreated, uploaded.Code)
+	data := decodeBody(t, uploaded)["data"].(map[string]interface{})
+	file := data["file"].(map[string]interface{})
+	assert.Equal(t, "보험증권.pdf", file["file_name"])
+	assert.NotContains(t, file, "storage_key")
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, noFile.Code)
+	assert.Equal(t, http.StatusBadRequest, badType.Code)
+	assert.Equal(t, http.StatusBadRequest, badDate.Code)
+	assert.Equal(t, http.StatusBadRequest, notPDF.Code)
+	require.Equal(t, http.StatusOK, listed.Code)
+	assert.Len(t, decodeBody(t, listed)["data"], 1)
+
+	// When
+	id := data["id"].(string)
+	downloaded := performJSONAs(router, driver, http.MethodGet, path+"/"+id+"/file", nil)
+	driverDelete := performJSONAs(router, driver, http.MethodDelete, path+"/"+id, nil)
+	deleted := performJSON(router, http.MethodDelete, path+"/"+id, nil)
+	missing := performJSONAs(router, driver, http.MethodGet, path+"/"+id+"/file", nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, downloaded.Code)
+	assert.Equal(t, "application/pdf", downloaded.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename*=utf-8''%EB%B3%B4%ED%97%98%EC%A6%9D%EA%B6%8C.pdf", downloaded.Header().Get("Content-Disposition"))
+	assert.Equal(t, pdf, downloaded.Body.Bytes())
+	assert.Equal(t, http.StatusForbidden, driverDelete.Code)
+	assert.Equal(t, http.StatusOK, deleted.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
@@ -0,0 +1,336 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newVehicleRouter - 인메모리 Repository로 구성한 테스트 라우터
+func newVehicleRouter() *gin.Engine {
+	vehicleService := service.NewVehicleService(mocks.NewVehicleRepository())
+	return handler.SetupRouter(&handler.Handlers{
+		Tokens:  testTokens,
+		Vehicle: handler.NewVehicleHandler(vehicleService),
+	})
+}
+
+// testTokens - 핸들러 테스트 공용 토큰 관리자
+var testTokens = auth.NewTokenManager("test-secret", time.Hour)
+
+// testOrganizationID - 테스트 토큰의 기본 소속 기관
+const testOrganizationID = "org-1"
+
+// issueToken - 테스트 토큰 발급 (소속 기관을 지정하지 않으면 testOrganizationID)
+func issueToken(principal *auth.Principal) string {
+	issued := *principal
+	if issued.OrganizationID == "" {
+		issued.OrganizationID = testOrganizationID
+	}
+	token, _ := testTokens.Issue(&issued)
+	return token
+}
+
+// performJSON - 관리자 권한으로 JSON 요청 실행 헬퍼
+func performJSON(router *gin.Engine, method, path string, body interface{}) *httptest.ResponseRecorder {
+	return performJSONAs(router, &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}, method, path, body)
+}
+
+// performJSONAs - 지정한 인증 주체로 JSON 요청 실행 (nil이면 토큰 없이 요청)
+func performJSONAs(router *gin.Engine, principal *auth.Principal, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var reader *bytes.Reader
+	if body != nil {
+		payload, _ := json.Marshal(body)
+		reader = bytes.NewReader(payload)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	if principal != nil {
+		req.Header.Set("Authorization", "Bearer "+issueToken(principal))
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// decodeBody - 응답 JSON 디코딩 헬퍼
+func decodeBody(t *testing.T, w *httptest.ResponseRecorder) map[string]interface{} {
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response
+}
+
+var validVehicle = map[string]interface{}{
+	"plate_number": "12가3456",
+	"model":        "그랜드스타렉스",
+	"manufacturer": "현대",
+	"vehicle_type": "van",
+	"capacity":     12,
+}
+
+// TestVehicleHandler_Create - 차량 등록 API
+func TestVehicleHandler_Create(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+
+	// When
+	w := performJSON(router, http.MethodPost, "/api/v1/vehicles", validVehicle)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, w.Code)
+	response := decodeBody(t, w)
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, "12가3456", data["plate_number"])
+	assert.NotEmpty(t, data["id"])
+}
+
+// TestVehicleHandler_Create_ValidationError - 필수값 누락
+func TestVehicleHandler_Create_ValidationError(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+
+	// When
+	w := performJSON(router, http.MethodPost, "/api/v1/vehicles", map[string]interface{}{
+		"plate_number": "12가3456",
+		"vehicle_type": "truck",
+	})
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "VALIDATION_ERROR")
+}
+
+// TestVehicleHandler_Create_InvalidPlate - 차량 번호 형식 오류는 필드별 메시지로 반환
+func TestVehicleHandler_Create_InvalidPlate(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+	body := map[string]interface{}{}
+	for k, v := range validVehicle {
+		body[k] = v
+	}
+	body["plate_number"] = "ABC-1234"
+
+	// When
+	w := performJSON(router, http.MethodPost, "/api/v1/vehicles", body)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	details := decodeBody(t, w)["error"].(map[string]interface{})["details"].(map[string]interface{})
+	assert.Contains(t, details["plate_number"], "차량 번호 형식")
+}
+
+// TestVehicleHandler_Create_Duplicate - 차량 번호 중복 시 409
+func TestVehicleHandler_Create_Duplicate(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+	performJSON(router, http.MethodPost, "/api/v1/vehicles", validVehicle)
+
+	// When
+	w := performJSON(router, http.MethodPost, "/api/v1/vehicles", validVehicle)
+
+	// Then
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "DUPLICATE_ERROR")
+}
+
+// TestVehicleHandler_List_Pagination - 페이지네이션 응답
+func TestVehicleHandler_List_Pagination(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+	for _, plate := range []string{"11가1111", "22가2222", "33가3333"} {
+		body := map[string]interface{}{"plate_number": plate, "model": "쏠라티", "vehicle_type": "bus", "capacity": 15}
+		performJSON(router, http.MethodPost, "/api/v1/vehicles", body)
+	}
+
+	// When
+	w := performJSON(router, http.MethodGet, "/api/v1/vehicles?page=2&page_size=2", nil)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	response := decodeBody(t, w)
+	pagination := response["pagination"].(map[string]interface{})
+	assert.Equal(t, float64(2), pagination["page"])
+	assert.Equal(t, float64(3), pagination["total_items"])
+	assert.Equal(t, float64(2), pagination["total_pages"])
+	assert.Len(t, response["data"].([]interface{}), 1)
+}
+
+// TestVehicleHandler_List_SortAndFilter - sort/filter[...] 적용 및 허용되지 않은 필드는 400
+func TestVehicleHandler_List_SortAndFilter(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+	for _, v := range []struct {
+		plate        string
+		manufacturer string
+		capacity     int
+	}{{"11가1111", "현대", 15}, {"22가2222", "기아", 9}, {"33가3333", "현대", 25}} {
+		body := map[string]interface{}{"plate_number": v.plate, "model": "쏠라티", "manufacturer": v.manufacturer, "vehicle_type": "bus", "capacity": v.capacity}
+		require.Equal(t, http.StatusCreated, performJSON(router, http.MethodPost, "/api/v1/vehicles", body).Code)
+	}
+
+	// When
+	w := performJSON(router, http.MethodGet, "/api/v1/vehicles?sort=-capacity&filter[manufacturer]=현대&page_size=1", nil)
+	unknownSort := performJSON(router, http.MethodGet, "/api/v1/vehicles?sort=color", nil)
+	unknownFilter := performJSON(router, http.MethodGet, "/api/v1/vehicles?filter[plate_number]=11가1111", nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	response := decodeBody(t, w)
+	data := response["data"].([]interface{})
+	require.Len(t, data, 1)
+	assert.Equal(t, "33가3333", data[0].(map[string]interface{})["plate_number"])
+	assert.Equal(t, float64(2), response["pagination"].(map[string]interface{})["total_items"])
+
+	assert.Equal(t, http.StatusBadRequest, unknownSort.Code)
+	assert.Contains(t, decodeBody(t, unknownSort)["error"].(map[string]interface{})["details"], "sort")
+	assert.Equal(t, http.StatusBadRequest, unknownFilter.Code)
+	assert.Contains(t, decodeBody(t, unknownFilter)["error"].(map[string]interface{})["details"], "filter[plate_number]")
+}
+
+// TestVehicleHandler_List_AdvancedFilters - 정원 범위, 보험 만료 임박/만료 여부 필터
+func TestVehicleHandler_List_AdvancedFilters(t *testing.T) {
+	// Given - 보험 만료됨(9인승), 10일 후 만료(15인승), 100일 후 만료(25인승)
+	router := newVehicleRouter()
+	now := time.Now()
+	for _, v := range []struct {
+		plate     string
+		capacity  int
+		insurance time.Time
+	}{
+		{"11가1111", 9, now.AddDate(0, 0, -1)},
+		{"22가2222", 15, now.AddDate(0, 0, 10)},
+		{"33가3333", 25, now.AddDate(0, 0, 100)},
+	} {
+		body := map[string]interface{}{"plate_number": v.plate, "model": "쏠라티", "vehicle_type": "bus", "capacity": v.capacity, "insurance_expiry": v.insurance}
+		require.Equal(t, http.StatusCreated, performJSON(router, http.MethodPost, "/api/v1/vehicles", body).Code)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{"30일 이내 만료 (이미 만료 포함)", "insurance_expiring_within=30d", []string{"11가1111", "22가2222"}},
+		{"보험 만료", "insurance_expired=true", []string{"11가1111"}},
+		{"보험 미만료", "insurance_expired=false", []string{"22가2222", "33가3333"}},
+		{"정원 범위", "min_capacity=10&max_capacity=20", []string{"22가2222"}},
+		{"조건 조합", "insurance_expiring_within=30&min_capacity=10", []string{"22가2222"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			w := performJSON(router, http.MethodGet, "/api/v1/vehicles?"+tt.query, nil)
+
+			// Then
+			require.Equal(t, http.StatusOK, w.Code)
+			var plates []string
+			for _, item := range decodeBody(t, w)["data"].([]interface{}) {
+				plates = append(plates, item.(map[string]interface{})["plate_number"].(string))
+			}
+			assert.Equal(t, tt.expected, plates)
+		})
+	}
+
+	// When - 잘못된 일수 형식, 뒤집힌 정원 범위
+	invalidDays := performJSON(router, http.MethodGet, "/api/v1/vehicles?inspection_expiring_within=month", nil)
+	invertedRange := performJSON(router, http.MethodGet, "/api/v1/vehicles?min_capacity=20&max_capacity=10", nil)
+
+	// Then
+	assert.Equal(t, http.StatusBadRequest, invalidDays.Code)
+	assert.Contains(t, decodeBody(t, invalidDays)["error"].(map[string]interface{})["details"], "inspection_expiring_within")
+	assert.Equal(t, http.StatusBadRequest, invertedRange.Code)
+	assert.Contains(t, decodeBody(t, invertedRange)["error"].(map[string]interface{})["details"], "max_capacity")
+}
+
+// TestVehicleHandler_GetUpdateDelete - 조회/수정/삭제 흐름
+func TestVehicleHandler_GetUpdateDelete(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+	created := decodeBody(t, performJSON(router, http.MethodPost, "/api/v1/vehicles", validVehicle))
+	id := created["data"].(map[string]interface{})["id"].(string)
+
+	// When: 수정
+	w := performJSON(router, http.MethodPut, "/api/v1/vehicles/"+id, map[string]interface{}{"color": "노란색"})
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "노란색", decodeBody(t, w)["data"].(map[string]interface{})["color"])
+
+	// When: 삭제 후 조회
+	w = performJSON(router, http.MethodDelete, "/api/v1/vehicles/"+id, nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = performJSON(router, http.MethodGet, "/api/v1/vehicles/"+id, nil)
+
+	// Then
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestVehicleHandler_RoleAccess - 역할별 접근 제어 (조회는 운영 인력, 변경은 관리자)
+func TestVehicleHandler_RoleAccess(t *testing.T) {
+	router := newVehicleRouter()
+	driver := &auth.Principal{UserID: "user-2", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	guardian := &auth.Principal{UserID: "user-3", Role: domain.RoleGuardian}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		method    string
+		body      interface{}
+		expected  int
+	}{
+		{"기사 조회 허용", driver, http.MethodGet, nil, http.StatusOK},
+		{"기사 등록 거부", driver, http.MethodPost, validVehicle, http.StatusForbidden},
+		{"보호자 조회 거부", guardian, http.MethodGet, nil, http.StatusForbidden},
+		{"토큰 없음", nil, http.MethodGet, nil, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			w := performJSONAs(router, tt.principal, tt.method, "/api/v1/vehicles", tt.body)
+
+			// Then
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+// TestVehicleHandler_Restore - 삭제 포함 목록은 관리자만, 복구 후 다시 조회 가능
+func TestVehicleHandler_Restore(t *testing.T) {
+	// Given
+	router := newVehicleRouter()
+	created := decodeBody(t, performJSON(router, http.MethodPost, "/api/v1/vehicles", validVehicle))
+	id := created["data"].(map[string]interface{})["id"].(string)
+	require.Equal(t, http.StatusOK, performJSON(router, http.MethodDelete, "/api/v1/vehicles/"+id, nil).Code)
+	driver := &auth.Principal{UserID: "user-2", Role: domain.RoleDriver, ProfileID: "driver-1"}
+
+	// When
+	forbidden := performJSONAs(router, driver, http.MethodGet, "/api/v1/vehicles?include_deleted=true", nil)
+	listed := performJSON(router, http.MethodGet, "/api/v1/vehicles?include_deleted=true", nil)
+	driverRestore := performJSONAs(router, driver, http.MethodPost, "/api/v1/vehicles/"+id+"/restore", nil)
+	restored := performJSON(router, http.MethodPost, "/api/v1/vehicles/"+id+"/restore", nil)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	require.Equal(t, http.StatusOK, listed.Code)
+	assert.Len(t, decodeBody(t, listed)["data"], 1)
+	assert.Equal(t, http.StatusForbidden, driverRestore.Code)
+	assert.Equal(t, http.StatusOK, restored.Code)
+	assert.Equal(t, http.StatusOK, performJSON(router, http.MethodGet, "/api/v1/vehicles/"+id, nil).Code)
+}
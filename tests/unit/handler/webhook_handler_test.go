@@ -0,0 +1,72 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/handler"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhookHandler - 관리자만 등록/조회/수정/삭제, 등록 응답에만 secret 포함, 전달 기록 조회
+func TestWebhookHandler(t *testing.T) {
+	// Given
+	webhookService := service.NewWebhookService(mocks.NewWebhookSubscriptionRepository(), mocks.NewWebhookDeliveryRepository(), mocks.NewWebhookSender(),
+		service.NotificationRetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: time.Hour})
+	router := handler.SetupRouter(&handler.Handlers{
+		Tokens:  testTokens,
+		Webhook: handler.NewWebhookHandler(webhookService),
+	})
+	admin := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	driver := &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"}
+	body := map[string]interface{}{
+		"name":   "출결 시스템",
+		"url":    "https://example.com/hooks",
+		"events": []string{"trip.started", "passenger.boarded"},
+	}
+
+	// When
+	forbidden := performJSONAs(router, driver, http.MethodPost, "/api/v1/webhooks", body)
+	invalid := performJSONAs(router, admin, http.MethodPost, "/api/v1/webhooks", map[string]interface{}{
+		"name": "출결 시스템", "url": "https://example.com/hooks", "events": []string{"trip.teleported"},
+	})
+	created := performJSONAs(router, admin, http.MethodPost, "/api/v1/webhooks", body)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	require.Equal(t, http.StatusCreated, created.Code)
+	data := decodeBody(t, created)["data"].(map[string]interface{})
+	assert.Contains(t, data["secret"], "whsec_")
+	subscription := data["webhook"].(map[string]interface{})
+	assert.NotContains(t, subscription, "secret")
+	id := subscription["id"].(string)
+
+	// When
+	got := performJSONAs(router, admin, http.MethodGet, "/api/v1/webhooks/"+id, nil)
+	updated := performJSONAs(router, admin, http.MethodPatch, "/api/v1/webhooks/"+id, map[string]interface{}{"active": false})
+	err := webhookService.HandleEvent(t.Context(), event.Event{ID: "event-1", Type: string(domain.WebhookEventTripStarted), OccurredAt: time.Now(), Payload: []byte(`{"trip_id":"trip-1"}`)})
+	require.NoError(t, err)
+	deliveries := performJSONAs(router, admin, http.MethodGet, "/api/v1/webhooks/"+id+"/deliveries?status=delivered", nil)
+	badStatus := performJSONAs(router, admin, http.MethodGet, "/api/v1/webhooks/"+id+"/deliveries?status=lost", nil)
+	deleted := performJSONAs(router, admin, http.MethodDelete, "/api/v1/webhooks/"+id, nil)
+	missing := performJSONAs(router, admin, http.MethodGet, "/api/v1/webhooks/"+id, nil)
+
+	// Then
+	require.Equal(t, http.StatusOK, got.Code)
+	assert.NotContains(t, decodeBody(t, got)["data"], "secret")
+	require.Equal(t, http.StatusOK, updated.Code)
+	assert.Equal(t, false, decodeBody(t, updated)["data"].(map[string]interface{})["active"])
+	require.Equal(t, http.StatusOK, deliveries.Code)
+	assert.Empty(t, decodeBody(t, deliveries)["data"]) // 비활성 구독에는 전달하지 않음
+	assert.Equal(t, http.StatusBadRequest, badStatus.Code)
+	assert.Equal(t, http.StatusOK, deleted.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+}
@@ -0,0 +1,117 @@
+package holiday_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/holiday"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLookup - 기본 공휴일 표 (음력 공휴일/대체공휴일 포함)
+func TestLookup(t *testing.T) {
+	// When
+	seollal, isSeollal := holiday.Lookup(time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC))
+	substitute, isSubstitute := holiday.Lookup(time.Date(2025, 10, 8, 0, 0, 0, 0, time.UTC))
+	_, isWorkday := holiday.Lookup(time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC))
+
+	// Then
+	assert.True(t, isSeollal)
+	assert.Equal(t, "설날", seollal)
+	assert.True(t, isSubstitute)
+	assert.Equal(t, "대체공휴일", substitute)
+	assert.False(t, isWorkday)
+}
+
+// TestStatic - 연도별 목록은 날짜 순, 표에 없는 연도는 빈 목록
+func TestStatic(t *testing.T) {
+	// When
+	holidays := holiday.Static(2026)
+
+	// Then
+	require.NotEmpty(t, holidays)
+	assert.Equal(t, "1월1일", holidays[0].Name)
+	for i := 1; i < len(holidays); i++ {
+		assert.True(t, holidays[i-1].Date.Before(holidays[i].Date))
+	}
+	assert.Empty(t, holiday.Static(2040))
+}
+
+// TestDataGoKrClient_Fetch - 연도 조회, isHoliday가 Y인 날만 반환
+func TestDataGoKrClient_Fetch(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/getRestDeInfo", r.URL.Path)
+		assert.Equal(t, "key+/=", r.URL.Query().Get("ServiceKey"))
+		assert.Equal(t, "2028", r.URL.Query().Get("solYear"))
+		assert.Equal(t, "json", r.URL.Query().Get("_type"))
+
+		_, _ = w.Write([]byte(`{"response":{"header":{"resultCode":"00","resultMsg":"NORMAL SERVICE."},
+			"body":{"items":{"item":[
+				{"dateKind":"01","dateName":"1월1일","isHoliday":"Y","locdate":20280101},
+				{"dateKind":"01","dateName":"설날","isHoliday":"Y","locdate":20280126},
+				{"dateKind":"01","dateName":"제헌절","isHoliday":"N","locdate":20280717}
+			]},"numOfRows":100,"pageNo":1,"totalCount":3}}}`))
+	}))
+	defer server.Close()
+	client := holiday.NewDataGoKrClient("key+/=", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	holidays, err := client.Fetch(context.Background(), 2028)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []holiday.Holiday{
+		{Date: time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC), Name: "1월1일"},
+		{Date: time.Date(2028, 1, 26, 0, 0, 0, 0, time.UTC), Name: "설날"},
+	}, holidays)
+}
+
+// TestDataGoKrClient_Fetch_SingleAndEmpty - 결과가 한 건이면 객체, 없으면 빈 문자열
+func TestDataGoKrClient_Fetch_SingleAndEmpty(t *testing.T) {
+	// Given
+	bodies := map[string]string{
+		"2028": `{"response":{"header":{"resultCode":"00"},"body":{"items":{"item":{"dateName":"설날","isHoliday":"Y","locdate":20280126}},"totalCount":1}}}`,
+		"2029": `{"response":{"header":{"resultCode":"00"},"body":{"items":"","totalCount":0}}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(bodies[r.URL.Query().Get("solYear")]))
+	}))
+	defer server.Close()
+	client := holiday.NewDataGoKrClient("key", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	single, singleErr := client.Fetch(context.Background(), 2028)
+	empty, emptyErr := client.Fetch(context.Background(), 2029)
+
+	// Then
+	require.NoError(t, singleErr)
+	require.Len(t, single, 1)
+	assert.Equal(t, "설날", single[0].Name)
+	require.NoError(t, emptyErr)
+	assert.Empty(t, empty)
+}
+
+// TestDataGoKrClient_Fetch_APIError - resultCode가 00이 아니면 에러 (서비스 키 오류 등)
+func TestDataGoKrClient_Fetch_APIError(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"response":{"header":{"resultCode":"30","resultMsg":"SERVICE_KEY_IS_NOT_REGISTERED_ERROR"}}}`))
+	}))
+	defer server.Close()
+	client := holiday.NewDataGoKrClient("wrong", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	_, err := client.Fetch(context.Background(), 2028)
+
+	// Then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "SERVICE_KEY_IS_NOT_REGISTERED_ERROR")
+}
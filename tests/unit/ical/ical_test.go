@@ -0,0 +1,72 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hyeokjun/eodini/pkg/ical"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWrite - CRLF 줄바꿈, UTC 시각, TEXT 이스케이프, 기본 상태, 새로 고침 간격
+func TestWrite(t *testing.T) {
+	// Given
+	kst := time.FixedZone("KST", 9*60*60)
+	now := time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC)
+	cal := ical.Calendar{
+		Name:    "운행 일정",
+		Refresh: time.Hour,
+		Events: []ical.Event{
+			{
+				UID:         "schedule-1-20250310@eodini",
+				Start:       time.Date(2025, 3, 10, 8, 0, 0, 0, kst),
+				End:         time.Date(2025, 3, 10, 8, 40, 0, 0, kst),
+				Summary:     "오전 등원, A코스; 대체",
+				Description: "경로: 1호차\n차량: 12가3456",
+			},
+			{UID: "schedule-1-20250311@eodini", Start: now, End: now, Summary: "취소", Status: ical.StatusCancelled},
+		},
+	}
+
+	// When
+	body := string(ical.Write(cal, now))
+
+	// Then
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(body, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.NotContains(t, strings.ReplaceAll(body, "\r\n", ""), "\n")
+	assert.Contains(t, body, "X-WR-CALNAME:운행 일정\r\n")
+	assert.Contains(t, body, "REFRESH-INTERVAL;VALUE=DURATION:PT60M\r\n")
+	assert.Contains(t, body, "DTSTAMP:20250310T060000Z\r\n")
+	assert.Contains(t, body, "DTSTART:20250309T230000Z\r\nDTEND:20250309T234000Z\r\n")
+	assert.Contains(t, body, `SUMMARY:오전 등원\, A코스\; 대체`)
+	assert.Contains(t, body, `DESCRIPTION:경로: 1호차\n차량: 12가3456`)
+	assert.Equal(t, 1, strings.Count(body, "STATUS:CONFIRMED"))
+	assert.Equal(t, 1, strings.Count(body, "STATUS:CANCELLED"))
+}
+
+// TestWrite_Folding - 75바이트를 넘는 줄은 접고, 한글 글자 중간에서 자르지 않음
+func TestWrite_Folding(t *testing.T) {
+	// Given
+	summary := strings.Repeat("가나다라마바사", 10)
+	now := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	// When
+	body := string(ical.Write(ical.Calendar{Events: []ical.Event{{UID: "1", Start: now, End: now, Summary: summary}}}, now))
+
+	// Then
+	lines := strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n")
+	folded := 0
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, utf8.ValidString(line))
+		if strings.HasPrefix(line, " ") {
+			folded++
+		}
+	}
+	require.Positive(t, folded)
+	assert.Contains(t, strings.ReplaceAll(body, "\r\n ", ""), "SUMMARY:"+summary+"\r\n")
+}
@@ -0,0 +1,118 @@
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewBackend_Slog - slog 어댑터로 JSON 출력 (레벨 필터링은 logger 패키지가 처리)
+func TestNewBackend_Slog(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	backend, err := logger.NewBackend(logger.SlogBackendName, logger.JSONFormat, &buf)
+	require.NoError(t, err)
+	logger.SetBackend(backend)
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetBackend(nil)
+
+	// When
+	logger.Debug("filtered", nil)
+	logger.Warn("Rate limiter unavailable", map[string]interface{}{"rule": "api"})
+
+	// Then
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "Rate limiter unavailable", entry["msg"])
+	assert.Equal(t, "api", entry["rule"])
+}
+
+// TestNewBackend_Zap - zap 어댑터로 JSON 출력
+func TestNewBackend_Zap(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	backend, err := logger.NewBackend(logger.ZapBackendName, logger.JSONFormat, &buf)
+	require.NoError(t, err)
+	logger.SetBackend(backend)
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetBackend(nil)
+
+	// When
+	logger.Error("Job failed", map[string]interface{}{"job": "outbox-relay", "count": 3})
+
+	// Then
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "error", entry["level"])
+	assert.Equal(t, "Job failed", entry["msg"])
+	assert.Equal(t, "outbox-relay", entry["job"])
+	assert.Equal(t, float64(3), entry["count"])
+	assert.NotEmpty(t, entry["timestamp"])
+}
+
+// TestNewBackend_Unknown - 지원하지 않는 출력 구현
+func TestNewBackend_Unknown(t *testing.T) {
+	// When
+	_, err := logger.NewBackend("logrus", logger.TextFormat, os.Stdout)
+
+	// Then
+	assert.Error(t, err)
+}
+
+// TestFromContext_AddsRequestID - context의 요청 ID가 request_id 필드로 추가
+func TestFromContext_AddsRequestID(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetFormat(logger.JSONFormat)
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetOutput(os.Stdout)
+	defer logger.SetFormat(logger.TextFormat)
+
+	ctx := logger.WithRequestID(context.Background(), "req-123")
+
+	// When
+	logger.FromContext(ctx).With(map[string]interface{}{"trip_id": "trip-1"}).
+		Warn("Failed to notify guardian", map[string]interface{}{"guardian_id": "guardian-1"})
+
+	// Then
+	var entry struct {
+		Level  string                 `json:"level"`
+		Fields map[string]interface{} `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry.Level)
+	assert.Equal(t, map[string]interface{}{
+		"request_id":  "req-123",
+		"trip_id":     "trip-1",
+		"guardian_id": "guardian-1",
+	}, entry.Fields)
+}
+
+// TestFromContext_WithoutRequestID - 요청 ID가 없으면 호출 필드만 기록
+func TestFromContext_WithoutRequestID(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetFormat(logger.JSONFormat)
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetOutput(os.Stdout)
+	defer logger.SetFormat(logger.TextFormat)
+
+	// When
+	logger.FromContext(context.Background()).Info("Holidays synced", map[string]interface{}{"year": 2025})
+
+	// Then
+	var entry struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, map[string]interface{}{"year": float64(2025)}, entry.Fields)
+}
@@ -1,9 +1,16 @@
 package logger_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestSetLevel - 로그 레벨 설정 테스트
@@ -18,6 +25,21 @@ func TestSetLevel(t *testing.T) {
 	logger.Error("error message", nil)
 }
 
+// TestParseLevel - 설정 값 → 로그 레벨 (알 수 없는 값은 info)
+func TestParseLevel(t *testing.T) {
+	assert.Equal(t, logger.DebugLevel, logger.ParseLevel("debug"))
+	assert.Equal(t, logger.WarnLevel, logger.ParseLevel("WARN"))
+	assert.Equal(t, logger.ErrorLevel, logger.ParseLevel("error"))
+	assert.Equal(t, logger.InfoLevel, logger.ParseLevel("verbose"))
+
+	// When: 실행 중 레벨 변경
+	logger.SetLevel(logger.ParseLevel("warn"))
+	defer logger.SetLevel(logger.InfoLevel)
+
+	// Then
+	assert.Equal(t, logger.WarnLevel, logger.Level())
+}
+
 // TestDebug - Debug 로그 테스트
 func TestDebug(t *testing.T) {
 	// Given
@@ -109,3 +131,77 @@ func TestLogLevelFiltering(t *testing.T) {
 	logger.Warn("should not print", nil)
 	logger.Error("should print", nil)
 }
+
+// TestJSONFormat - LOG_FORMAT=json이면 한 줄에 JSON 객체 하나 (timestamp, level, msg, fields)
+func TestJSONFormat(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetFormat(logger.JSONFormat)
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetOutput(os.Stdout)
+	defer logger.SetFormat(logger.TextFormat)
+
+	// When
+	logger.Error("Job failed", map[string]interface{}{
+		"job":   "outbox-relay",
+		"error": errors.New("connection refused"),
+		"count": 3,
+	})
+	logger.Infof("Server listening on %s", ":8080")
+
+	// Then
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.NotEmpty(t, entry["timestamp"])
+	assert.Equal(t, "ERROR", entry["level"])
+	assert.Equal(t, "Job failed", entry["msg"])
+	assert.Equal(t, map[string]interface{}{
+		"job":   "outbox-relay",
+		"error": "connection refused",
+		"count": float64(3),
+	}, entry["fields"])
+
+	entry = nil
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	assert.Equal(t, "Server listening on :8080", entry["msg"])
+	assert.NotContains(t, entry, "fields")
+}
+
+// TestJSONFormat_UnsupportedValue - JSON으로 표현할 수 없는 필드 값은 문자열로 기록
+func TestJSONFormat_UnsupportedValue(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetFormat(logger.JSONFormat)
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetOutput(os.Stdout)
+	defer logger.SetFormat(logger.TextFormat)
+
+	// When
+	logger.Info("with channel", map[string]interface{}{"ch": make(chan int)})
+
+	// Then
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.IsType(t, "", entry["fields"].(map[string]interface{})["ch"])
+}
+
+// TestTextFormat - 기본(text) 형식은 [시각] 레벨 | 메시지 | key=value
+func TestTextFormat(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetFormat("unknown")
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetOutput(os.Stdout)
+
+	// When
+	logger.Info("hello", map[string]interface{}{"key": "value"})
+
+	// Then
+	assert.Contains(t, buf.String(), "INFO | hello | key=value")
+}
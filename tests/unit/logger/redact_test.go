@@ -0,0 +1,51 @@
+package logger_test
+
+import (
+	"testing"
+
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRedactor_RedactString - 에러 메시지 속 JSON/key=value 필드와 전화번호 마스킹
+func TestRedactor_RedactString(t *testing.T) {
+	redactor := logger.DefaultRedactor()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"JSON 필드", `{"guardian_name":"김보호","age":5}`, `{"guardian_name":"***","age":5}`},
+		{"key=value", "medical_notes=땅콩 status=active", "medical_notes=*** status=active"},
+		{"map 출력", "map[guardian_phone:01012345678]", "map[guardian_phone:***]"},
+		{"대소문자 무시", `{"Medical_Notes": "천식"}`, `{"Medical_Notes": "***"}`},
+		{"자유 텍스트 전화번호", "duplicate phone 010-1234-5678", "duplicate phone 010-****-5678"},
+		{"대상 아님", "vehicle 12가3456 not found", "vehicle 12가3456 not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, redactor.RedactString(tt.input))
+		})
+	}
+}
+
+// TestRedactor_RedactJSON - 중첩 객체/배열까지 deny-list 필드 마스킹
+func TestRedactor_RedactJSON(t *testing.T) {
+	// Given
+	redactor := logger.NewRedactor(append([]string{"email"}, logger.DefaultRedactFields...))
+	body := []byte(`{"name":"김철수","guardian":{"email":"mom@example.com","phone":"010-1111-2222"},"notes":["연락 010-3333-4444"]}`)
+
+	// When
+	redacted := string(redactor.RedactJSON(body))
+
+	// Then
+	assert.JSONEq(t, `{"name":"김철수","guardian":{"email":"***","phone":"***"},"notes":["연락 010-****-4444"]}`, redacted)
+}
+
+// TestRedactor_RedactJSON_Invalid - JSON이 아니면 문자열 규칙으로 마스킹
+func TestRedactor_RedactJSON_Invalid(t *testing.T) {
+	redacted := logger.DefaultRedactor().RedactJSON([]byte("phone=01099998888"))
+	assert.Equal(t, "phone=***", string(redacted))
+}
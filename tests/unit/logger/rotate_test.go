@@ -0,0 +1,106 @@
+package logger_test
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock - 테스트용 시각
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+// backups - 교체된 로그 파일 목록 (이름 순)
+func backups(t *testing.T, path string) []string {
+	matches, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	sort.Strings(matches)
+	return matches
+}
+
+// TestRotatingFile_RotatesBySize - 최대 크기를 넘기 전에 교체, 보관 개수 초과분 삭제
+func TestRotatingFile_RotatesBySize(t *testing.T) {
+	// Given
+	clock := &fakeClock{now: time.Date(2025, 3, 4, 8, 0, 0, 0, time.UTC)}
+	path := filepath.Join(t.TempDir(), "logs", "api.log")
+	file, err := logger.NewRotatingFile(path, logger.RotateOptions{MaxSize: 10, MaxBackups: 2, Now: clock.Now})
+	require.NoError(t, err)
+	defer file.Close()
+
+	// When: 6바이트씩 4번 (매번 10바이트를 넘으므로 3번 교체)
+	for i := 0; i < 4; i++ {
+		_, err := file.Write([]byte("line-\n"))
+		require.NoError(t, err)
+		clock.now = clock.now.Add(time.Second)
+	}
+
+	// Then
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "line-\n", string(current))
+
+	rotated := backups(t, path)
+	require.Len(t, rotated, 2)
+	assert.Equal(t, path+".20250304-080002.000", rotated[0])
+	assert.Equal(t, path+".20250304-080003.000", rotated[1])
+}
+
+// TestRotatingFile_RotatesByInterval - 교체 주기(UTC 기준 정렬)가 지나면 교체
+func TestRotatingFile_RotatesByInterval(t *testing.T) {
+	// Given
+	clock := &fakeClock{now: time.Date(2025, 3, 4, 23, 59, 0, 0, time.UTC)}
+	path := filepath.Join(t.TempDir(), "api.log")
+	file, err := logger.NewRotatingFile(path, logger.RotateOptions{Interval: 24 * time.Hour, Now: clock.Now})
+	require.NoError(t, err)
+	defer file.Close()
+
+	// When: 같은 날 두 번, 다음 날 한 번
+	_, _ = file.Write([]byte("day1-a\n"))
+	clock.now = clock.now.Add(30 * time.Second)
+	_, _ = file.Write([]byte("day1-b\n"))
+	clock.now = clock.now.Add(time.Minute)
+	_, _ = file.Write([]byte("day2\n"))
+
+	// Then
+	rotated := backups(t, path)
+	require.Len(t, rotated, 1)
+	previous, err := os.ReadFile(rotated[0])
+	require.NoError(t, err)
+	assert.Equal(t, "day1-a\nday1-b\n", string(previous))
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "day2\n", string(current))
+}
+
+// TestOpenOutputs_WritesToAllDestinations - 파일과 stdout 동시 출력 (standard 출력에 연결)
+func TestOpenOutputs_WritesToAllDestinations(t *testing.T) {
+	// Given
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")
+	output, err := logger.OpenOutputs([]string{"stdout", first, second}, logger.RotateOptions{})
+	require.NoError(t, err)
+	logger.SetOutput(output)
+	logger.SetLevel(logger.InfoLevel)
+	defer logger.SetOutput(os.Stdout)
+
+	// When
+	logger.Info("written everywhere", nil)
+	require.NoError(t, output.Close())
+
+	// Then
+	for _, path := range []string{first, second} {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "INFO | written everywhere")
+	}
+}
@@ -0,0 +1,396 @@
+package maps_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/pkg/maps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var waypoints = []geo.Point{
+	{Latitude: 37.50, Longitude: 127.00},
+	{Latitude: 37.51, Longitude: 127.01},
+	{Latitude: 37.52, Longitude: 127.02},
+}
+
+// TestKakaoClient_Directions - 섹션별 거리/시간을 구간으로, vertexes(x, y)를 경로로 변환
+func TestKakaoClient_Directions(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/waypoints/directions", r.URL.Path)
+		assert.Equal(t, "KakaoAK test-key", r.Header.Get("Authorization"))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, 127.0, body["origin"].(map[string]interface{})["x"])
+		assert.Len(t, body["waypoints"], 1)
+
+		_, _ = w.Write([]byte(`{"routes":[{"result_code":0,"sections":[
+			{"distance":1500,"duration":240,"roads":[{"vertexes":[127.00,37.50,127.01,37.51]}]},
+			{"distance":1600,"duration":300,"roads":[{"vertexes":[127.01,37.51,127.02,37.52]}]}
+		]}]}`))
+	}))
+	defer server.Close()
+	client := maps.NewKakaoClient("test-key", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	directions, err := client.Directions(context.Background(), waypoints)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 3100, directions.Distance)
+	assert.Equal(t, 9*time.Minute, directions.Duration)
+	assert.Equal(t, []maps.Leg{{Distance: 1500, Duration: 4 * time.Minute}, {Distance: 1600, Duration: 5 * time.Minute}}, directions.Legs)
+
+	path, err := geo.DecodePolyline(directions.Polyline)
+	require.NoError(t, err)
+	assert.Len(t, path, 4)
+	assert.Equal(t, geo.Point{Latitude: 37.5, Longitude: 127.0}, path[0])
+}
+
+// TestKakaoClient_Directions_NoRoute - result_code가 0이 아니면 ErrNoRoute
+func TestKakaoClient_Directions_NoRoute(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"routes":[{"result_code":104,"result_msg":"출발지와 도착지가 5m 이내"}]}`))
+	}))
+	defer server.Close()
+	client := maps.NewKakaoClient("test-key", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	_, err := client.Directions(context.Background(), waypoints)
+
+	// Then
+	assert.ErrorIs(t, err, maps.ErrNoRoute)
+}
+
+// TestNaverClient_Directions - 경유지가 제한(5개)을 넘으면 나눠 호출하고 구간을 합침
+func TestNaverClient_Directions(t *testing.T) {
+	// Given
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/map-direction/v1/driving", r.URL.Path)
+		assert.Equal(t, "client-id", r.Header.Get("X-NCP-APIGW-API-KEY-ID"))
+		assert.Equal(t, "secret", r.Header.Get("X-NCP-APIGW-API-KEY"))
+
+		// 좌표 n개 → 구간 n-1개 (각 1000m, 2분)
+		n := 2
+		if via := r.URL.Query().Get("waypoints"); via != "" {
+			n += len(strings.Split(via, "|"))
+		}
+		start := r.URL.Query().Get("start")
+		var summaryWaypoints []string
+		for i := 0; i < n-2; i++ {
+			summaryWaypoints = append(summaryWaypoints, `{"distance":1000,"duration":120000}`)
+		}
+		_, _ = w.Write([]byte(`{"code":0,"route":{"traoptimal":[{"summary":{"distance":` +
+			strconv.Itoa((n-1)*1000) + `,"duration":` + strconv.Itoa((n-1)*120000) + `,"waypoints":[` + strings.Join(summaryWaypoints, ",") + `]},` +
+			`"path":[[` + start + `],[` + r.URL.Query().Get("goal") + `]]}]}}`))
+	}))
+	defer server.Close()
+	client := maps.NewNaverClient("client-id", "secret", time.Second)
+	client.BaseURL = server.URL
+
+	points := make([]geo.Point, 9)
+	for i := range points {
+		points[i] = geo.Point{Latitude: 37.5 + float64(i)*0.01, Longitude: 127.0}
+	}
+
+	// When
+	directions, err := client.Directions(context.Background(), points)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls) // 7개 + 3개 (끝점 겹침)
+	assert.Len(t, directions.Legs, 8)
+	assert.Equal(t, 8000, directions.Distance)
+	assert.Equal(t, 16*time.Minute, directions.Duration)
+
+	path, err := geo.DecodePolyline(directions.Polyline)
+	require.NoError(t, err)
+	assert.Len(t, path, 3) // 첫 구간 2점 + 두 번째 구간 도착점
+}
+
+// TestGoogleClient_Directions - legs와 overview_polyline 사용, ZERO_RESULTS는 ErrNoRoute
+func TestGoogleClient_Directions(t *testing.T) {
+	// Given
+	status := "OK"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "37.5,127", r.URL.Query().Get("origin"))
+		assert.Equal(t, "37.51,127.01", r.URL.Query().Get("waypoints"))
+		assert.Equal(t, "test-key", r.URL.Query().Get("key"))
+		_, _ = w.Write([]byte(`{"status":"` + status + `","routes":[{"overview_polyline":{"points":"` +
+			geo.EncodePolyline(waypoints) + `"},"legs":[{"distance":{"value":1400},"duration":{"value":200}},{"distance":{"value":1450},"duration":{"value":220}}]}]}`))
+	}))
+	defer server.Close()
+	client := maps.NewGoogleClient("test-key", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	directions, err := client.Directions(context.Background(), waypoints)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2850, directions.Distance)
+	assert.Equal(t, 420*time.Second, directions.Duration)
+	assert.Equal(t, geo.EncodePolyline(waypoints), directions.Polyline)
+
+	// Given
+	status = "ZERO_RESULTS"
+
+	// When
+	_, err = client.Directions(context.Background(), waypoints)
+
+	// Then
+	assert.ErrorIs(t, err, maps.ErrNoRoute)
+}
+
+// TestGoogleClient_Matrix - 출발지/도착지 10개씩 나눠 호출한 결과를 하나의 행렬로
+func TestGoogleClient_Matrix(t *testing.T) {
+	// Given - 지점 i → j 거리는 |i - j| × 1000m
+	index := func(value string) int {
+		latitude, err := strconv.ParseFloat(strings.Split(value, ",")[0], 64)
+		require.NoError(t, err)
+		return int(math.Round((latitude - 37.5) / 0.01))
+	}
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/maps/api/distancematrix/json", r.URL.Path)
+		origins := strings.Split(r.URL.Query().Get("origins"), "|")
+		destinations := strings.Split(r.URL.Query().Get("destinations"), "|")
+		assert.LessOrEqual(t, len(origins)*len(destinations), 100)
+
+		rows := make([]string, len(origins))
+		for i, origin := range origins {
+			elements := make([]string, len(destinations))
+			for j, destination := range destinations {
+				gap := index(origin) - index(destination)
+				if gap < 0 {
+					gap = -gap
+				}
+				elements[j] = `{"status":"OK","distance":{"value":` + strconv.Itoa(gap*1000) + `},"duration":{"value":` + strconv.Itoa(gap*120) + `}}`
+			}
+			rows[i] = `{"elements":[` + strings.Join(elements, ",") + `]}`
+		}
+		_, _ = w.Write([]byte(`{"status":"OK","rows":[` + strings.Join(rows, ",") + `]}`))
+	}))
+	defer server.Close()
+	client := maps.NewGoogleClient("test-key", time.Second)
+	client.BaseURL = server.URL
+
+	points := make([]geo.Point, 12)
+	for i := range points {
+		points[i] = geo.Point{Latitude: 37.5 + float64(i)*0.01, Longitude: 127.0}
+	}
+
+	// When
+	matrix, err := client.Matrix(context.Background(), points)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls) // (10 + 2) × (10 + 2)
+	require.Len(t, matrix.Legs, 12)
+	assert.Equal(t, maps.Leg{Distance: 11000, Duration: 22 * time.Minute}, matrix.Legs[0][11])
+	assert.Equal(t, maps.Leg{Distance: 3000, Duration: 6 * time.Minute}, matrix.Legs[11][8])
+	assert.Equal(t, maps.Leg{}, matrix.Legs[5][5])
+}
+
+// TestKakaoClient_Matrix - 거리 행렬 API 없이 지점 쌍마다 길찾기 호출
+func TestKakaoClient_Matrix(t *testing.T) {
+	// Given
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Empty(t, body["waypoints"])
+		_, _ = w.Write([]byte(`{"routes":[{"result_code":0,"sections":[{"distance":1500,"duration":240,"roads":[]}]}]}`))
+	}))
+	defer server.Close()
+	client := maps.NewKakaoClient("test-key", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	matrix, err := client.Matrix(context.Background(), waypoints)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 6, calls) // 3 × 2
+	assert.Equal(t, maps.Leg{Distance: 1500, Duration: 4 * time.Minute}, matrix.Legs[2][0])
+	assert.Equal(t, maps.Leg{}, matrix.Legs[1][1])
+}
+
+// TestDirections_HTTPError - 2xx가 아닌 응답은 상태 코드를 담은 에러
+func TestDirections_HTTPError(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"msg":"invalid key"}`))
+	}))
+	defer server.Close()
+	client := maps.NewKakaoClient("wrong", time.Second)
+	client.BaseURL = server.URL
+
+	// When
+	_, err := client.Directions(context.Background(), waypoints)
+
+	// Then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+
+	// When - 좌표 1개
+	_, err = client.Directions(context.Background(), waypoints[:1])
+
+	// Then
+	assert.ErrorIs(t, err, maps.ErrTooFewWaypoints)
+}
+
+// countingClient - 호출 횟수를 세는 Client
+type countingClient struct {
+	calls int
+}
+
+func (c *countingClient) Directions(ctx context.Context, points []geo.Point) (*maps.Directions, error) {
+	c.calls++
+	return &maps.Directions{Distance: 1000 * len(points), Duration: time.Minute, Legs: []maps.Leg{{Distance: 1000, Duration: time.Minute}}}, nil
+}
+
+func (c *countingClient) Matrix(ctx context.Context, points []geo.Point) (*maps.Matrix, error) {
+	c.calls++
+	return &maps.Matrix{Legs: [][]maps.Leg{{{}, {Distance: 1000, Duration: time.Minute}}, {{Distance: 1000, Duration: time.Minute}, {}}}}, nil
+}
+
+// TestCachedClient_Directions - 같은 좌표 순서는 캐시, 순서가 다르면 다시 조회
+func TestCachedClient_Directions(t *testing.T) {
+	// Given
+	inner := &countingClient{}
+	client := maps.NewCachedClient(inner, maps.NewMemoryCache(), time.Hour)
+	ctx := context.Background()
+
+	// When
+	first, err := client.Directions(ctx, waypoints)
+	require.NoError(t, err)
+	second, err := client.Directions(ctx, waypoints)
+	require.NoError(t, err)
+	_, err = client.Directions(ctx, []geo.Point{waypoints[2], waypoints[1], waypoints[0]})
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, 2, inner.calls)
+	assert.Equal(t, first, second)
+
+	// When - 거리 행렬은 길찾기와 별도 키
+	firstMatrix, err := client.Matrix(ctx, waypoints)
+	require.NoError(t, err)
+	secondMatrix, err := client.Matrix(ctx, waypoints)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, 3, inner.calls)
+	assert.Equal(t, firstMatrix, secondMatrix)
+}
+
+// TestGeocode - 제공자별 주소 검색 응답을 좌표로 변환, 결과가 없으면 ErrAddressNotFound
+func TestGeocode(t *testing.T) {
+	// Given
+	const address = "서울 강남구 테헤란로 152"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/v2/local/search/address.json":
+			assert.Equal(t, "KakaoAK test-key", r.Header.Get("Authorization"))
+			if query.Get("query") == address {
+				_, _ = w.Write([]byte(`{"documents":[{"x":"127.0364","y":"37.5006"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"documents":[]}`))
+		case "/map-geocode/v2/geocode":
+			assert.Equal(t, "client-id", r.Header.Get("X-NCP-APIGW-API-KEY-ID"))
+			if query.Get("query") == address {
+				_, _ = w.Write([]byte(`{"status":"OK","addresses":[{"x":"127.0364","y":"37.5006"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":"OK","addresses":[]}`))
+		case "/maps/api/geocode/json":
+			assert.Equal(t, "test-key", query.Get("key"))
+			if query.Get("address") == address {
+				_, _ = w.Write([]byte(`{"status":"OK","results":[{"geometry":{"location":{"lat":37.5006,"lng":127.0364}}}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":"ZERO_RESULTS","results":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	kakao := maps.NewKakaoClient("test-key", time.Second)
+	kakao.LocalBaseURL = server.URL
+	naver := maps.NewNaverClient("client-id", "client-secret", time.Second)
+	naver.BaseURL = server.URL
+	google := maps.NewGoogleClient("test-key", time.Second)
+	google.BaseURL = server.URL
+
+	for name, geocoder := range map[string]maps.Geocoder{"kakao": kakao, "naver": naver, "google": google} {
+		t.Run(name, func(t *testing.T) {
+			// When
+			point, err := geocoder.Geocode(context.Background(), address)
+			_, notFoundErr := geocoder.Geocode(context.Background(), "없는 주소")
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, geo.Point{Latitude: 37.5006, Longitude: 127.0364}, point)
+			assert.ErrorIs(t, notFoundErr, maps.ErrAddressNotFound)
+		})
+	}
+}
+
+// countingGeocoder - 호출 횟수를 세는 Geocoder
+type countingGeocoder struct {
+	calls int
+}
+
+func (g *countingGeocoder) Geocode(ctx context.Context, address string) (geo.Point, error) {
+	g.calls++
+	if address == "없는 주소" {
+		return geo.Point{}, maps.ErrAddressNotFound
+	}
+	return geo.Point{Latitude: 37.5, Longitude: 127.0}, nil
+}
+
+// TestCachedGeocoder_Geocode - 같은 주소(앞뒤 공백 무시)는 캐시, 찾지 못한 주소는 캐시하지 않음
+func TestCachedGeocoder_Geocode(t *testing.T) {
+	// Given
+	inner := &countingGeocoder{}
+	geocoder := maps.NewCachedGeocoder(inner, maps.NewMemoryCache(), time.Hour)
+	ctx := context.Background()
+
+	// When
+	first, err := geocoder.Geocode(ctx, "서울 강남구")
+	require.NoError(t, err)
+	second, err := geocoder.Geocode(ctx, " 서울 강남구 ")
+	require.NoError(t, err)
+	_, err1 := geocoder.Geocode(ctx, "없는 주소")
+	_, err2 := geocoder.Geocode(ctx, "없는 주소")
+
+	// Then
+	assert.Equal(t, first, second)
+	assert.ErrorIs(t, err1, maps.ErrAddressNotFound)
+	assert.ErrorIs(t, err2, maps.ErrAddressNotFound)
+	assert.Equal(t, 3, inner.calls)
+}
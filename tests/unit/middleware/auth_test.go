@@ -0,0 +1,196 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuthRouter - 관리자 전용 엔드포인트 하나를 가진 테스트 라우터
+func newAuthRouter(tokens *auth.TokenManager) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	router.GET("/admin", middleware.Authenticate(tokens, nil, nil), middleware.RequireRole(domain.RoleAdmin), func(c *gin.Context) {
+		principal, _ := auth.FromContext(c.Request.Context())
+		c.String(http.StatusOK, principal.UserID)
+	})
+	return router
+}
+
+// performWithToken - Authorization 헤더를 붙여 요청
+func performWithToken(router *gin.Engine, token string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestAuthenticate_ValidToken - 유효한 토큰이면 인증 주체가 context에 저장
+func TestAuthenticate_ValidToken(t *testing.T) {
+	// Given
+	tokens := auth.NewTokenManager("secret", time.Hour)
+	token, err := tokens.Issue(&auth.Principal{UserID: "user-1", OrganizationID: "org-1", Role: domain.RoleAdmin})
+	require.NoError(t, err)
+
+	// When
+	w := performWithToken(newAuthRouter(tokens), token)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user-1", w.Body.String())
+}
+
+// TestAuthenticate_Rejected - 토큰 누락/위조/만료, 소속 기관 없음이면 401
+func TestAuthenticate_Rejected(t *testing.T) {
+	tokens := auth.NewTokenManager("secret", time.Hour)
+	forged, _ := auth.NewTokenManager("other-secret", time.Hour).Issue(&auth.Principal{UserID: "user-1", OrganizationID: "org-1", Role: domain.RoleAdmin})
+	expired, _ := auth.NewTokenManager("secret", -time.Minute).Issue(&auth.Principal{UserID: "user-1", OrganizationID: "org-1", Role: domain.RoleAdmin})
+	noOrganization, _ := tokens.Issue(&auth.Principal{UserID: "user-1", Role: domain.RoleAdmin})
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"토큰 없음", ""},
+		{"형식 오류", "not-a-jwt"},
+		{"다른 키로 서명", forged},
+		{"만료", expired},
+		{"소속 기관 없음", noOrganization},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			w := performWithToken(newAuthRouter(tokens), tt.token)
+
+			// Then
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Contains(t, w.Body.String(), "UNAUTHORIZED")
+		})
+	}
+}
+
+// TestRequireRole_Forbidden - 역할이 맞지 않으면 403
+func TestRequireRole_Forbidden(t *testing.T) {
+	// Given
+	tokens := auth.NewTokenManager("secret", time.Hour)
+	token, err := tokens.Issue(&auth.Principal{UserID: "guardian-1", OrganizationID: "org-1", Role: domain.RoleGuardian})
+	require.NoError(t, err)
+
+	// When
+	w := performWithToken(newAuthRouter(tokens), token)
+
+	// Then
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "FORBIDDEN")
+}
+
+// TestIssue_InvalidRole - 정의되지 않은 역할은 토큰 발급 불가
+func TestIssue_InvalidRole(t *testing.T) {
+	// Given
+	tokens := auth.NewTokenManager("secret", time.Hour)
+
+	// When
+	_, err := tokens.Issue(&auth.Principal{UserID: "user-1", Role: "superuser"})
+
+	// Then
+	assert.Error(t, err)
+}
+
+// revokedSessions - 지정한 세션만 폐기된 것으로 응답하는 테스트용 SessionChecker
+type revokedSessions map[string]bool
+
+func (r revokedSessions) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	return r[sessionID], nil
+}
+
+// TestAuthenticate_RevokedSession - 폐기된 세션의 토큰은 만료 전이라도 401
+func TestAuthenticate_RevokedSession(t *testing.T) {
+	// Given
+	tokens := auth.NewTokenManager("secret", time.Hour)
+	revoked, err := tokens.Issue(&auth.Principal{UserID: "user-1", OrganizationID: "org-1", Role: domain.RoleAdmin, SessionID: "lost-phone"})
+	require.NoError(t, err)
+	active, err := tokens.Issue(&auth.Principal{UserID: "user-1", OrganizationID: "org-1", Role: domain.RoleAdmin})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	router.GET("/admin", middleware.Authenticate(tokens, nil, revokedSessions{"lost-phone": true}), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// When & Then
+	assert.Equal(t, http.StatusUnauthorized, performWithToken(router, revoked).Code)
+	assert.Equal(t, http.StatusOK, performWithToken(router, active).Code)
+}
+
+// newTenantRouter - 요청 대상 기관을 응답하는 관리자 전용 테스트 라우터 (GET/POST)
+func newTenantRouter(tokens *auth.TokenManager) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	echo := func(c *gin.Context) {
+		principal, _ := auth.FromContext(c.Request.Context())
+		c.String(http.StatusOK, "org="+principal.OrganizationID)
+	}
+	group := router.Group("/tenant", middleware.Authenticate(tokens, nil, nil), middleware.RequireRole(domain.RoleAdmin))
+	group.GET("", echo)
+	group.POST("", echo)
+	return router
+}
+
+// TestAuthenticate_ResolveOrganization - 일반 사용자는 소속 기관 고정, 플랫폼 운영자는 X-Organization-ID로 지정 (미지정 조회는 전체 기관)
+func TestAuthenticate_ResolveOrganization(t *testing.T) {
+	tokens := auth.NewTokenManager("secret", time.Hour)
+	router := newTenantRouter(tokens)
+	admin, _ := tokens.Issue(&auth.Principal{UserID: "admin-1", OrganizationID: "org-1", Role: domain.RoleAdmin})
+	operator, _ := tokens.Issue(&auth.Principal{UserID: "operator-1", OrganizationID: "org-1", Role: domain.RoleSuperAdmin})
+	target := "5f0c8a4e-2b7d-4c11-9a53-7e2f1d6b8c90"
+
+	tests := []struct {
+		name         string
+		method       string
+		token        string
+		organization string
+		wantCode     int
+		wantBody     string
+	}{
+		{"관리자 - 헤더 없음", http.MethodGet, admin, "", http.StatusOK, "org=org-1"},
+		{"관리자 - 소속 기관 지정", http.MethodPost, admin, "org-1", http.StatusOK, "org=org-1"},
+		{"관리자 - 다른 기관 지정", http.MethodGet, admin, target, http.StatusForbidden, "FORBIDDEN"},
+		{"운영자 - 기관 미지정 조회", http.MethodGet, operator, "", http.StatusOK, "org="},
+		{"운영자 - 기관 지정", http.MethodPost, operator, target, http.StatusOK, "org=" + target},
+		{"운영자 - 기관 미지정 변경", http.MethodPost, operator, "", http.StatusBadRequest, "BAD_REQUEST"},
+		{"운영자 - 잘못된 기관 ID", http.MethodGet, operator, "org-2", http.StatusBadRequest, "BAD_REQUEST"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			req := httptest.NewRequest(tt.method, "/tenant", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			if tt.organization != "" {
+				req.Header.Set(middleware.OrganizationHeader, tt.organization)
+			}
+
+			// When
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Then
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantBody)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package middleware_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -141,3 +142,39 @@ func TestCORS_NoOriginHeader(t *testing.T) {
 	// Then
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+// originSet - 고정 목록으로 허용 여부를 답하는 OriginChecker
+type originSet map[string]bool
+
+func (s originSet) IsAllowedOrigin(_ context.Context, origin string) bool {
+	return s[origin]
+}
+
+// TestCORS_OrganizationOrigins - 설정 목록에 없어도 기관이 등록한 Origin은 허용 (Vary: Origin)
+func TestCORS_OrganizationOrigins(t *testing.T) {
+	// Given
+	config := middleware.ProductionCORSConfig([]string{"https://app.eodini.kr"})
+	config.Origins = originSet{"https://sunflower.example.com": true}
+	router := gin.New()
+	router.Use(middleware.CORS(config))
+	router.GET("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+	request := func(origin string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Origin", origin)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// When
+	organization := request("https://sunflower.example.com")
+	unknown := request("https://evil.example.com")
+
+	// Then
+	assert.Equal(t, http.StatusOK, organization.Code)
+	assert.Equal(t, "https://sunflower.example.com", organization.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Origin", organization.Header().Get("Vary"))
+	assert.Equal(t, http.StatusForbidden, unknown.Code)
+}
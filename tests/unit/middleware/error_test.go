@@ -1,6 +1,7 @@
 package middleware_test
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -37,6 +38,36 @@ func TestErrorHandler_WithAppError(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "차량")
 }
 
+// TestErrorHandler_IncludesRequestID - 에러 응답의 details.request_id에 요청 ID 포함
+func TestErrorHandler_IncludesRequestID(t *testing.T) {
+	// Given
+	shared := util.NewNotFoundError("차량")
+	router := gin.New()
+	router.Use(middleware.RequestIDMiddleware())
+	router.Use(middleware.ErrorHandler())
+	router.GET("/test", func(c *gin.Context) {
+		_ = c.Error(shared)
+	})
+
+	// When
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	router.ServeHTTP(w, req)
+
+	// Then
+	var body struct {
+		Error struct {
+			Code    string                 `json:"code"`
+			Details map[string]interface{} `json:"details"`
+		} `json:"error"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "NOT_FOUND", body.Error.Code)
+	assert.Equal(t, "req-123", body.Error.Details["request_id"])
+	assert.Nil(t, shared.Details) // 원본 에러 값은 그대로
+}
+
 // TestErrorHandler_WithGenericError - 일반 에러 처리 테스트
 func TestErrorHandler_WithGenericError(t *testing.T) {
 	// Given
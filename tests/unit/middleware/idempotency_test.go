@@ -0,0 +1,221 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// newIdempotencyRouter - 호출 횟수를 세는 POST 엔드포인트를 가진 테스트 라우터
+// fail이 true면 핸들러가 에러를 반환
+func newIdempotencyRouter(store middleware.IdempotencyStore, calls *int, fail *bool) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	router.Use(func(c *gin.Context) {
+		principal := &auth.Principal{UserID: "driver-1", Role: domain.RoleDriver}
+		c.Request = c.Request.WithContext(auth.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	})
+	router.Use(middleware.Idempotency(store, time.Hour))
+	handler := func(c *gin.Context) {
+		*calls++
+		if fail != nil && *fail {
+			_ = c.Error(util.NewConflictError("운행을 시작할 수 없는 상태입니다"))
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"call": *calls})
+	}
+	router.POST("/trips/:id/start", handler)
+	router.PUT("/trips/:id", handler)
+	return router
+}
+
+// performIdempotent - Idempotency-Key 헤더를 붙여 요청
+func performIdempotent(router *gin.Engine, method, path, key, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestIdempotency_ReplaysResponse - 같은 키로 재시도하면 핸들러를 다시 실행하지 않고 첫 응답 반환
+func TestIdempotency_ReplaysResponse(t *testing.T) {
+	// Given
+	calls := 0
+	router := newIdempotencyRouter(mocks.NewIdempotencyStore(), &calls, nil)
+
+	// When
+	first := performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+	second := performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+
+	// Then
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "application/json; charset=utf-8", second.Header().Get("Content-Type"))
+	assert.Equal(t, "true", second.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Empty(t, first.Header().Get(middleware.IdempotentReplayedHeader))
+}
+
+// TestIdempotency_WithoutKey - 헤더가 없으면 매번 실행
+func TestIdempotency_WithoutKey(t *testing.T) {
+	// Given
+	calls := 0
+	router := newIdempotencyRouter(mocks.NewIdempotencyStore(), &calls, nil)
+
+	// When
+	performIdempotent(router, http.MethodPost, "/trips/t1/start", "", `{}`)
+	performIdempotent(router, http.MethodPost, "/trips/t1/start", "", `{}`)
+
+	// Then
+	assert.Equal(t, 2, calls)
+}
+
+// TestIdempotency_OnlyPost - POST 외 메서드는 헤더가 있어도 무시
+func TestIdempotency_OnlyPost(t *testing.T) {
+	// Given
+	calls := 0
+	router := newIdempotencyRouter(mocks.NewIdempotencyStore(), &calls, nil)
+
+	// When
+	performIdempotent(router, http.MethodPut, "/trips/t1", "key-1", `{}`)
+	performIdempotent(router, http.MethodPut, "/trips/t1", "key-1", `{}`)
+
+	// Then
+	assert.Equal(t, 2, calls)
+}
+
+// TestIdempotency_KeyReusedForDifferentRequest - 같은 키로 다른 요청을 보내면 422
+func TestIdempotency_KeyReusedForDifferentRequest(t *testing.T) {
+	// Given
+	calls := 0
+	router := newIdempotencyRouter(mocks.NewIdempotencyStore(), &calls, nil)
+	performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+
+	// When
+	w := performIdempotent(router, http.MethodPost, "/trips/t2/start", "key-1", `{}`)
+
+	// Then
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+// TestIdempotency_InProgress - 첫 요청이 처리 중일 때 같은 키로 재시도하면 409
+func TestIdempotency_InProgress(t *testing.T) {
+	// Given
+	var retry *httptest.ResponseRecorder
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	router.Use(func(c *gin.Context) {
+		principal := &auth.Principal{UserID: "driver-1", Role: domain.RoleDriver}
+		c.Request = c.Request.WithContext(auth.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	})
+	router.Use(middleware.Idempotency(mocks.NewIdempotencyStore(), time.Hour))
+	router.POST("/trips/:id/start", func(c *gin.Context) {
+		if retry == nil {
+			// 첫 요청 처리 도중 재시도 도착
+			retry = performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+		}
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	// When
+	first := performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusConflict, retry.Code)
+}
+
+// TestIdempotency_FailureNotCached - 실패한 요청은 저장하지 않아 같은 키로 다시 시도 가능
+func TestIdempotency_FailureNotCached(t *testing.T) {
+	// Given
+	calls := 0
+	fail := true
+	store := mocks.NewIdempotencyStore()
+	router := newIdempotencyRouter(store, &calls, &fail)
+	first := performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+	released := !store.Has("driver-1:key-1")
+
+	// When
+	fail = false
+	second := performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+
+	// Then
+	assert.Equal(t, http.StatusConflict, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.True(t, released)
+	assert.Equal(t, 2, calls)
+}
+
+// TestIdempotency_FailOpen - 저장소 오류 시 중복 방지 없이 요청 통과
+func TestIdempotency_FailOpen(t *testing.T) {
+	// Given
+	calls := 0
+	store := mocks.NewIdempotencyStore()
+	store.Err = errors.New("redis down")
+	router := newIdempotencyRouter(store, &calls, nil)
+
+	// When
+	w := performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", `{}`)
+
+	// Then
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+// TestIdempotency_BodyTooLarge - 본문이 1MB를 넘으면 읽지 않고 413, 핸들러 실행 안 함
+func TestIdempotency_BodyTooLarge(t *testing.T) {
+	// Given
+	calls := 0
+	router := newIdempotencyRouter(mocks.NewIdempotencyStore(), &calls, nil)
+	body := `{"note":"` + strings.Repeat("a", 1<<20) + `"}`
+
+	// When
+	w := performIdempotent(router, http.MethodPost, "/trips/t1/start", "key-1", body)
+
+	// Then
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, 0, calls)
+}
+
+// TestIdempotency_SkipsMultipart - 파일 업로드(multipart)는 본문을 읽지 않고 매번 실행
+func TestIdempotency_SkipsMultipart(t *testing.T) {
+	// Given
+	calls := 0
+	router := newIdempotencyRouter(mocks.NewIdempotencyStore(), &calls, nil)
+	upload := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/trips/t1/start", strings.NewReader("--x\r\n\r\n--x--\r\n"))
+		req.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+		req.Header.Set(middleware.IdempotencyKeyHeader, "key-1")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// When
+	first := upload()
+	second := upload()
+
+	// Then
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, 2, calls)
+	assert.Empty(t, second.Header().Get(middleware.IdempotentReplayedHeader))
+}
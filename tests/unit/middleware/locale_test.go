@@ -0,0 +1,119 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLocaleRouter - 메시지 응답/에러 응답 엔드포인트를 가진 테스트 라우터
+func newLocaleRouter(tokens *auth.TokenManager) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.Locale())
+	router.Use(middleware.ErrorHandler())
+	router.GET("/vehicles", func(c *gin.Context) {
+		util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgCreated, "차량"))
+	})
+	router.GET("/missing", func(c *gin.Context) {
+		_ = c.Error(util.NewNotFoundError("차량"))
+	})
+	router.GET("/trips/start", func(c *gin.Context) {
+		_ = c.Error(util.NewConflictError("운행을 시작할 수 없는 상태입니다: %s", domain.TripStatusCompleted))
+	})
+	router.GET("/invalid", func(c *gin.Context) {
+		_ = c.Error(util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+			"phone":    util.Text("전화번호 형식이 아닙니다 (예: 010-1234-5678, +821012345678)"),
+			"capacity": util.Text("%s 타입이어야 합니다", "숫자"),
+			"reason":   "반려 사유를 입력해 주세요",
+		}))
+	})
+	router.GET("/me", middleware.Authenticate(tokens, nil, nil), func(c *gin.Context) {
+		util.SuccessWithMessageOnly(c, http.StatusOK, util.GetRequestMessage(c.Request.Context(), util.MsgSuccess))
+	})
+	return router
+}
+
+// TestLocale_AcceptLanguage - Accept-Language에 맞춰 성공/에러 메시지 변환 (없으면 한국어)
+func TestLocale_AcceptLanguage(t *testing.T) {
+	// Given
+	router := newLocaleRouter(auth.NewTokenManager("secret", time.Hour))
+	perform := func(path, acceptLanguage string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// When
+	english := perform("/vehicles", "en-US,en;q=0.9")
+	englishError := perform("/missing", "en")
+	korean := perform("/vehicles", "")
+
+	// Then
+	assert.Contains(t, english.Body.String(), "Vehicle has been created")
+	assert.Equal(t, "en", english.Header().Get("Content-Language"))
+	assert.Equal(t, http.StatusNotFound, englishError.Code)
+	assert.Contains(t, englishError.Body.String(), "Vehicle not found")
+	assert.Contains(t, korean.Body.String(), "차량이(가) 생성되었습니다")
+	assert.Equal(t, "ko", korean.Header().Get("Content-Language"))
+}
+
+// TestLocale_FreeFormErrors - 서비스의 자유 형식 에러와 필드별 사유도 요청 언어로 (한국어 원문은 그대로)
+func TestLocale_FreeFormErrors(t *testing.T) {
+	// Given
+	router := newLocaleRouter(auth.NewTokenManager("secret", time.Hour))
+	perform := func(path, acceptLanguage string) string {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept-Language", acceptLanguage)
+		router.ServeHTTP(w, req)
+		return w.Body.String()
+	}
+
+	// When
+	conflict := perform("/trips/start", "en")
+	invalid := perform("/invalid", "en")
+	koreanInvalid := perform("/invalid", "ko")
+
+	// Then
+	assert.Contains(t, conflict, "The trip cannot be started in its current status: completed")
+	assert.Contains(t, invalid, "Validation failed")
+	assert.Contains(t, invalid, "Must be a phone number")
+	assert.Contains(t, invalid, "Must be a number")
+	assert.Contains(t, invalid, "Enter a reason for the rejection")
+	assert.Contains(t, koreanInvalid, "숫자 타입이어야 합니다")
+	assert.Contains(t, koreanInvalid, "반려 사유를 입력해 주세요")
+}
+
+// TestLocale_PrincipalPreference - 토큰의 언어 설정이 Accept-Language보다 우선
+func TestLocale_PrincipalPreference(t *testing.T) {
+	// Given
+	tokens := auth.NewTokenManager("secret", time.Hour)
+	token, err := tokens.Issue(&auth.Principal{UserID: "user-1", OrganizationID: "org-1", Role: domain.RoleAdmin, Locale: "en"})
+	require.NoError(t, err)
+	router := newLocaleRouter(tokens)
+
+	// When
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept-Language", "ko")
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Request processed successfully")
+	assert.Equal(t, "en", w.Header().Get("Content-Language"))
+}
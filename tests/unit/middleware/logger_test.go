@@ -0,0 +1,43 @@
+package middleware_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRequestLogger_RedactsErrors - 로그에 남는 에러 메시지의 개인정보 마스킹
+func TestRequestLogger_RedactsErrors(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	defer logger.SetOutput(os.Stdout)
+
+	router := gin.New()
+	router.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		Redactor: logger.NewRedactor([]string{"medical_notes", "diagnosis"}),
+	}))
+	router.GET("/", func(c *gin.Context) {
+		_ = c.Error(errors.New(`insert failed: {"medical_notes":"천식","diagnosis":"ADHD","guardian_phone":"010-1234-5678"}`))
+		c.Status(http.StatusInternalServerError)
+	})
+
+	// When
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	// Then
+	logged := buf.String()
+	assert.Contains(t, logged, `"medical_notes":"***"`)
+	assert.Contains(t, logged, `"diagnosis":"***"`)
+	assert.Contains(t, logged, "010-****-5678")
+	assert.NotContains(t, logged, "천식")
+	assert.NotContains(t, logged, "ADHD")
+}
@@ -0,0 +1,103 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRateLimitRouter - 요청 제한이 걸린 엔드포인트 하나를 가진 테스트 라우터
+func newRateLimitRouter(limiter middleware.RateLimiter, rule middleware.RateLimitRule) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.ErrorHandler())
+	router.GET("/limited", middleware.RateLimit(limiter, rule), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+// performFrom - 지정한 클라이언트 IP에서 요청
+func performFrom(router *gin.Engine, remoteAddr string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/limited", nil)
+	req.RemoteAddr = remoteAddr
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// TestRateLimit_RejectsOverLimit - 한도 초과 시 429와 Retry-After
+func TestRateLimit_RejectsOverLimit(t *testing.T) {
+	// Given
+	rule := middleware.RateLimitRule{Name: "auth", Limit: 2, Window: time.Minute}
+	router := newRateLimitRouter(mocks.NewRateLimiter(), rule)
+
+	// When
+	first := performFrom(router, "10.0.0.1:1234")
+	second := performFrom(router, "10.0.0.1:1234")
+	third := performFrom(router, "10.0.0.1:1234")
+
+	// Then
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "2", first.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "1", first.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, http.StatusOK, second.Code)
+
+	assert.Equal(t, http.StatusTooManyRequests, third.Code)
+	assert.Equal(t, "60", third.Header().Get("Retry-After"))
+
+	var body util.APIResponse
+	require.NoError(t, json.Unmarshal(third.Body.Bytes(), &body))
+	require.NotNil(t, body.Error)
+	assert.Equal(t, util.ErrCodeTooMany, body.Error.Code)
+}
+
+// TestRateLimit_PerClientIP - 클라이언트 IP별로 한도를 따로 셈
+func TestRateLimit_PerClientIP(t *testing.T) {
+	// Given
+	rule := middleware.RateLimitRule{Name: "api", Limit: 1, Window: time.Minute}
+	router := newRateLimitRouter(mocks.NewRateLimiter(), rule)
+	performFrom(router, "10.0.0.1:1234")
+
+	// When
+	w := performFrom(router, "10.0.0.2:1234")
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestRateLimit_FailOpen - 제한기 오류 시 요청 통과
+func TestRateLimit_FailOpen(t *testing.T) {
+	// Given
+	limiter := mocks.NewRateLimiter()
+	limiter.Err = errors.New("redis: connection refused")
+	router := newRateLimitRouter(limiter, middleware.RateLimitRule{Name: "api", Limit: 1, Window: time.Minute})
+
+	// When
+	w := performFrom(router, "10.0.0.1:1234")
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestRateLimit_Disabled - 제한기가 없거나 한도가 0이면 제한 없음
+func TestRateLimit_Disabled(t *testing.T) {
+	// Given
+	noLimiter := newRateLimitRouter(nil, middleware.RateLimitRule{Name: "api", Limit: 1, Window: time.Minute})
+	noLimit := newRateLimitRouter(mocks.NewRateLimiter(), middleware.RateLimitRule{Name: "api", Window: time.Minute})
+
+	// When / Then
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, performFrom(noLimiter, "10.0.0.1:1234").Code)
+		assert.Equal(t, http.StatusOK, performFrom(noLimit, "10.0.0.1:1234").Code)
+	}
+}
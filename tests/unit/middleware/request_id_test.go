@@ -0,0 +1,66 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRequestIDMiddleware_PropagatesToContext - 요청 ID가 응답 헤더와 요청 context에 모두 전달
+func TestRequestIDMiddleware_PropagatesToContext(t *testing.T) {
+	// Given
+	router := gin.New()
+	router.Use(middleware.RequestIDMiddleware())
+	router.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, logger.RequestIDFromContext(c.Request.Context()))
+	})
+
+	// When: 클라이언트가 보낸 ID 사용
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(t, "req-123", w.Body.String())
+	assert.Equal(t, "req-123", w.Header().Get("X-Request-ID"))
+
+	// When: 헤더가 없으면 새로 생성
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	// Then: UUIDv4
+	generated, err := uuid.Parse(w.Body.String())
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.Version(4), generated.Version())
+	assert.Equal(t, w.Body.String(), w.Header().Get("X-Request-ID"))
+}
+
+// TestRequestIDMiddleware_RejectsInvalidHeader - 형식이 맞지 않는 요청 ID는 버리고 새로 생성
+func TestRequestIDMiddleware_RejectsInvalidHeader(t *testing.T) {
+	// Given
+	router := gin.New()
+	router.Use(middleware.RequestIDMiddleware())
+	router.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, logger.RequestIDFromContext(c.Request.Context()))
+	})
+
+	for _, header := range []string{"req 123\nlevel=ERROR", strings.Repeat("a", 129)} {
+		// When
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Request-ID", header)
+		router.ServeHTTP(w, req)
+
+		// Then
+		_, err := uuid.Parse(w.Body.String())
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,94 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hyeokjun/eodini/internal/middleware"
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// 수신 traceparent의 트레이스 ID
+const (
+	incomingTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	incomingTraceparent = "00-" + incomingTraceID + "-00f067aa0ba902b7-01"
+)
+
+// newTracedRouter - 메모리 기록용 TracerProvider로 Tracing + RequestIDMiddleware 적용
+func newTracedRouter(t *testing.T) (*gin.Engine, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	router := gin.New()
+	router.Use(middleware.Tracing("eodini-api"))
+	router.Use(middleware.RequestIDMiddleware())
+	router.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, logger.RequestIDFromContext(c.Request.Context()))
+	})
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router, recorder
+}
+
+// TestTracing_ContinuesIncomingTrace - traceparent를 이어받고, 요청 ID가 없으면 트레이스 ID를 요청 ID로 사용
+func TestTracing_ContinuesIncomingTrace(t *testing.T) {
+	// Given
+	router, recorder := newTracedRouter(t)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("traceparent", incomingTraceparent)
+
+	// When
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(t, incomingTraceID, w.Body.String())
+	assert.Equal(t, incomingTraceID, w.Header().Get("X-Request-ID"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, incomingTraceID, spans[0].SpanContext().TraceID().String())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("http.request_id", incomingTraceID))
+}
+
+// TestTracing_KeepsClientRequestID - 클라이언트가 보낸 X-Request-ID는 그대로 사용하고 span에 기록
+func TestTracing_KeepsClientRequestID(t *testing.T) {
+	// Given
+	router, recorder := newTracedRouter(t)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("traceparent", incomingTraceparent)
+	req.Header.Set("X-Request-ID", "req-123")
+
+	// When
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Then
+	assert.Equal(t, "req-123", w.Body.String())
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Contains(t, spans[0].Attributes(), attribute.String("http.request_id", "req-123"))
+}
+
+// TestTracing_SkipsHealthCheck - 헬스 체크는 span을 만들지 않음
+func TestTracing_SkipsHealthCheck(t *testing.T) {
+	// Given
+	router, recorder := newTracedRouter(t)
+
+	// When
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	// Then
+	assert.Empty(t, recorder.Ended())
+}
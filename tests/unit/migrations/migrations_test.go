@@ -0,0 +1,57 @@
+package migrations_test
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/hyeokjun/eodini/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrations_Files - 버전 연속성 및 goose 형식 확인
+func TestMigrations_Files(t *testing.T) {
+	// Given
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for i, name := range files {
+		// Then: 00001_, 00002_ ... 순서로 빠짐없이 존재
+		assert.True(t, strings.HasPrefix(name, fmt.Sprintf("%05d_", i+1)), "unexpected version: %s", name)
+
+		content, err := fs.ReadFile(migrations.FS, name)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "-- +goose Up", name)
+		assert.Contains(t, string(content), "-- +goose Down", name)
+	}
+}
+
+// TestMigrations_Tables - 모든 도메인 테이블 생성 여부
+func TestMigrations_Tables(t *testing.T) {
+	// Given
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	require.NoError(t, err)
+
+	var all strings.Builder
+	for _, name := range files {
+		content, err := fs.ReadFile(migrations.FS, name)
+		require.NoError(t, err)
+		all.Write(content)
+	}
+
+	// Then
+	tables := []string{
+		"vehicles", "drivers", "routes", "stops", "schedules",
+		"trips", "trip_passengers", "passengers", "attendants", "driver_assignments",
+		"guardians", "guardian_passengers", "api_keys",
+		"users", "password_reset_tokens", "audit_logs", "trip_locations", "trip_alerts",
+		"device_tokens", "notification_preferences", "notification_logs", "holidays", "schedule_exceptions", "attendant_assignments",
+		"passenger_absences", "trip_stop_events", "webhook_subscriptions", "webhook_deliveries", "outbox_events", "organizations", "invitations",
+	}
+	for _, table := range tables {
+		assert.Contains(t, all.String(), "CREATE TABLE "+table+" (", table)
+	}
+}
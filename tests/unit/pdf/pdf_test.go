@@ -0,0 +1,70 @@
+package pdf_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hyeokjun/eodini/pkg/pdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tableDocument - rows행짜리 2열 문서
+func tableDocument(rows int) pdf.Document {
+	doc := pdf.Document{
+		Title:   "차량 운행일지",
+		Info:    []string{"차량: 12가3456", "기간: 2025-03-01 ~ 2025-03-31"},
+		Columns: []pdf.Column{{Header: "운행 날짜", Width: 1}, {Header: "주행 거리(km)", Width: 1, Align: pdf.AlignRight}},
+		Summary: []string{"운행 합계"},
+	}
+	for i := 0; i < rows; i++ {
+		doc.Rows = append(doc.Rows, []string{fmt.Sprintf("2025-03-%02d", i%31+1), "12.5"})
+	}
+	return doc
+}
+
+// TestWrite - 교차 참조표의 위치가 각 객체 시작을 가리키고, 행이 많으면 여러 쪽
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name  string
+		rows  int
+		pages int
+	}{
+		{"행 없음", 0, 1},
+		{"한 쪽", 10, 1},
+		{"여러 쪽", 60, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			data, err := pdf.Write(tableDocument(tt.rows))
+
+			// Then
+			require.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+			assert.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+			assert.Contains(t, string(data), fmt.Sprintf("/Count %d", tt.pages))
+			assert.Equal(t, tt.pages, bytes.Count(data, []byte("/Type /Page ")))
+
+			startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+			require.NotNil(t, startxref)
+			offset, _ := strconv.Atoi(string(startxref[1]))
+			require.True(t, bytes.HasPrefix(data[offset:], []byte("xref\n")))
+			entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[offset:], -1)
+			require.Len(t, entries, 6+2*tt.pages)
+			for i, entry := range entries {
+				at, _ := strconv.Atoi(string(entry[1]))
+				assert.True(t, bytes.HasPrefix(data[at:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "객체 %d 위치", i+1)
+			}
+		})
+	}
+}
+
+// TestWrite_NoColumns - 열이 없으면 에러
+func TestWrite_NoColumns(t *testing.T) {
+	_, err := pdf.Write(pdf.Document{Title: "빈 문서"})
+	assert.Error(t, err)
+}
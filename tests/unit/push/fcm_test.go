@@ -0,0 +1,122 @@
+package push_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hyeokjun/eodini/pkg/push"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFCMServer - 토큰 발급과 발송 API를 흉내 내는 서버 (토큰 "stale"은 UNREGISTERED, "flaky"는 503)
+func newFCMServer(t *testing.T, key *rsa.PrivateKey, tokenCalls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			*tokenCalls++
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+			claims := jwt.MapClaims{}
+			_, err := jwt.ParseWithClaims(r.PostForm.Get("assertion"), claims, func(*jwt.Token) (interface{}, error) {
+				return &key.PublicKey, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "push@eodini.iam.gserviceaccount.com", claims["iss"])
+			assert.Equal(t, "https://www.googleapis.com/auth/firebase.messaging", claims["scope"])
+			_, _ = w.Write([]byte(`{"access_token":"access-1","expires_in":3600,"token_type":"Bearer"}`))
+
+		case "/v1/projects/eodini/messages:send":
+			assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+			var body struct {
+				Message struct {
+					Token        string            `json:"token"`
+					Notification map[string]string `json:"notification"`
+					Data         map[string]string `json:"data"`
+				} `json:"message"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			switch body.Message.Token {
+			case "stale":
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND",` +
+					`"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`))
+			case "flaky":
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"error":{"code":503,"status":"UNAVAILABLE","details":[{"errorCode":"UNAVAILABLE"}]}}`))
+			default:
+				assert.Equal(t, "탑승 알림", body.Message.Notification["title"])
+				assert.Equal(t, "trip-1", body.Message.Data["trip_id"])
+				_, _ = w.Write([]byte(`{"name":"projects/eodini/messages/1"}`))
+			}
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+// newCredentials - 테스트용 서비스 계정 키(JSON)
+func newCredentials(t *testing.T, key *rsa.PrivateKey, tokenURI string) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	credentials, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "eodini",
+		"client_email": "push@eodini.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":    tokenURI,
+	})
+	require.NoError(t, err)
+	return credentials
+}
+
+// TestFCMClient_Send - 액세스 토큰은 재사용, UNREGISTERED는 ErrInvalidToken, 그 외 오류는 일반 에러
+func TestFCMClient_Send(t *testing.T) {
+	// Given
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tokenCalls := 0
+	server := newFCMServer(t, key, &tokenCalls)
+	defer server.Close()
+	client, err := push.NewFCMClient(newCredentials(t, key, server.URL+"/token"), time.Second)
+	require.NoError(t, err)
+	client.BaseURL = server.URL
+	ctx := context.Background()
+	msg := &push.Message{Title: "탑승 알림", Body: "민준이가 승차했습니다", Data: map[string]string{"trip_id": "trip-1"}}
+
+	// When
+	okErr := client.Send(ctx, "device-1", msg)
+	staleErr := client.Send(ctx, "stale", msg)
+	flakyErr := client.Send(ctx, "flaky", msg)
+
+	// Then
+	require.NoError(t, okErr)
+	assert.ErrorIs(t, staleErr, push.ErrInvalidToken)
+	require.Error(t, flakyErr)
+	assert.NotErrorIs(t, flakyErr, push.ErrInvalidToken)
+	assert.Contains(t, flakyErr.Error(), "503")
+	assert.Equal(t, 1, tokenCalls)
+}
+
+// TestNewFCMClient_InvalidCredentials - 필수 항목 누락, 잘못된 키는 생성 실패
+func TestNewFCMClient_InvalidCredentials(t *testing.T) {
+	// When
+	_, notJSON := push.NewFCMClient([]byte("not json"), time.Second)
+	_, missing := push.NewFCMClient([]byte(`{"project_id":"eodini"}`), time.Second)
+	_, badKey := push.NewFCMClient([]byte(`{"project_id":"eodini","client_email":"a@b","token_uri":"https://oauth2.googleapis.com/token","private_key":"x"}`), time.Second)
+
+	// Then
+	assert.Error(t, notJSON)
+	assert.Error(t, missing)
+	assert.Error(t, badKey)
+}
@@ -0,0 +1,102 @@
+package realtime_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHub_PublishLocation - 같은 운행 구독자에게만 위치 이벤트 전달
+func TestHub_PublishLocation(t *testing.T) {
+	// Given
+	hub := realtime.NewHub()
+	watching := hub.Subscribe("trip-1")
+	other := hub.Subscribe("trip-2")
+	position := &domain.VehiclePosition{TripID: "trip-1", VehicleID: "vehicle-1", Latitude: 37.5665, Longitude: 126.978, RecordedAt: time.Now()}
+
+	// When
+	require.NoError(t, hub.PublishLocation(context.Background(), position))
+
+	// Then
+	select {
+	case message := <-watching.Messages():
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal(message, &event))
+		assert.Equal(t, realtime.EventLocation, event["type"])
+		assert.Equal(t, "trip-1", event["trip_id"])
+		assert.Equal(t, 37.5665, event["data"].(map[string]interface{})["latitude"])
+	default:
+		t.Fatal("구독자에게 이벤트가 전달되지 않음")
+	}
+	assert.Empty(t, other.Messages())
+}
+
+// TestHub_HandleStopEvent - 정류장 이벤트를 이벤트 종류 그대로 구독자에게 전달
+func TestHub_HandleStopEvent(t *testing.T) {
+	// Given
+	hub := realtime.NewHub()
+	sub := hub.Subscribe("trip-1")
+
+	// When
+	hub.HandleStopEvent(context.Background(), geofence.Event{Type: geofence.EventArrived, TripID: "trip-1", StopID: "stop-1"})
+
+	// Then
+	select {
+	case message := <-sub.Messages():
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal(message, &event))
+		assert.Equal(t, "stop_arrived", event["type"])
+		assert.Equal(t, "stop-1", event["data"].(map[string]interface{})["stop_id"])
+	default:
+		t.Fatal("구독자에게 이벤트가 전달되지 않음")
+	}
+}
+
+// TestHub_Unsubscribe - 구독 해제 시 채널이 닫히고 구독자 수에서 빠짐 (중복 해제 안전)
+func TestHub_Unsubscribe(t *testing.T) {
+	// Given
+	hub := realtime.NewHub()
+	sub := hub.Subscribe("trip-1")
+	require.Equal(t, 1, hub.SubscriberCount("trip-1"))
+
+	// When
+	hub.Unsubscribe(sub)
+	hub.Unsubscribe(sub)
+
+	// Then
+	_, open := <-sub.Messages()
+	assert.False(t, open)
+	assert.Equal(t, 0, hub.SubscriberCount("trip-1"))
+	hub.Broadcast("trip-1", []byte("{}")) // 구독자가 없어도 패닉 없음
+}
+
+// TestHub_SlowSubscriber - 버퍼가 찬 구독자 때문에 발행이 막히지 않음
+func TestHub_SlowSubscriber(t *testing.T) {
+	// Given
+	hub := realtime.NewHub()
+	slow := hub.Subscribe("trip-1")
+
+	// When
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			hub.Broadcast("trip-1", []byte("{}"))
+		}
+		close(done)
+	}()
+
+	// Then
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("느린 구독자 때문에 발행이 막힘")
+	}
+	assert.NotEmpty(t, slow.Messages())
+}
@@ -0,0 +1,30 @@
+package realtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRedisBroker_FallbackToLocal - Redis 발행 실패 시 이 인스턴스 구독자에게 직접 전달
+func TestRedisBroker_FallbackToLocal(t *testing.T) {
+	// Given: 연결할 수 없는 Redis
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", DialTimeout: 100 * time.Millisecond, MaxRetries: -1})
+	defer rdb.Close()
+	hub := realtime.NewHub()
+	sub := hub.Subscribe("trip-1")
+	broker := realtime.NewRedisBroker(rdb, hub)
+
+	// When
+	err := broker.PublishLocation(context.Background(), &domain.VehiclePosition{TripID: "trip-1", Latitude: 37.5665, Longitude: 126.978, RecordedAt: time.Now()})
+
+	// Then
+	require.NoError(t, err)
+	assert.Len(t, sub.Messages(), 1)
+}
@@ -0,0 +1,146 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// newDryRunDB - DB 연결 없이 SQL만 생성하는 GORM (테넌트 훅 등록)
+func newDryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, repository.RegisterTenantHooks(db))
+	return db
+}
+
+// asOrganization - 지정한 기관 소속 인증 주체의 context
+func asOrganization(organizationID string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin-1", OrganizationID: organizationID, Role: domain.RoleAdmin})
+}
+
+// TestTenantHooks_ScopeQueries - 조회/수정/삭제에 소속 기관 조건 추가 (기관 컬럼이 없는 모델은 제외)
+func TestTenantHooks_ScopeQueries(t *testing.T) {
+	// Given
+	db := newDryRunDB(t)
+	ctx := asOrganization("org-1")
+
+	// When
+	query := db.WithContext(ctx).Where("deleted_at IS NULL").Find(&[]domain.Vehicle{}).Statement
+	update := db.WithContext(ctx).Model(&domain.Route{}).Where("id = ?", "route-1").Updates(map[string]interface{}{"name": "A"}).Statement
+	hardDelete := db.WithContext(ctx).Where("id = ?", "trip-1").Delete(&domain.Trip{}).Statement
+	unscoped := db.WithContext(ctx).Where("trip_id = ?", "trip-1").Find(&[]domain.TripPassenger{}).Statement
+
+	// Then
+	assert.Contains(t, query.SQL.String(), `"vehicles"."organization_id" = $1`)
+	assert.Contains(t, query.Vars, "org-1")
+	assert.Contains(t, update.SQL.String(), `"routes"."organization_id" =`)
+	assert.Contains(t, hardDelete.SQL.String(), `"trips"."organization_id" =`)
+	assert.NotContains(t, unscoped.SQL.String(), "organization_id")
+}
+
+// TestTenantHooks_NoOrganization - 인증 주체가 없으면(백그라운드 작업) 조건 없음, WithOrganization으로 지정 가능
+func TestTenantHooks_NoOrganization(t *testing.T) {
+	// Given
+	db := newDryRunDB(t)
+
+	// When
+	background := db.WithContext(context.Background()).Find(&[]domain.Schedule{}).Statement
+	explicit := db.WithContext(repository.WithOrganization(context.Background(), "org-2")).Find(&[]domain.Schedule{}).Statement
+
+	// Then
+	assert.NotContains(t, background.SQL.String(), "organization_id")
+	assert.Contains(t, explicit.SQL.String(), `"schedules"."organization_id" = $1`)
+	assert.Contains(t, explicit.Vars, "org-2")
+}
+
+// TestTenantHooks_SuperAdmin - 기관을 지정하지 않은 플랫폼 운영자는 전체 기관, 지정하면 해당 기관만
+func TestTenantHooks_SuperAdmin(t *testing.T) {
+	// Given
+	db := newDryRunDB(t)
+	operator := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "operator-1", Role: domain.RoleSuperAdmin})
+	targeted := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "operator-1", OrganizationID: "org-3", Role: domain.RoleSuperAdmin})
+
+	// When
+	all := db.WithContext(operator).Find(&[]domain.Vehicle{}).Statement
+	scoped := db.WithContext(targeted).Find(&[]domain.Vehicle{}).Statement
+
+	// Then
+	assert.NotContains(t, all.SQL.String(), "organization_id")
+	assert.Contains(t, scoped.SQL.String(), `"vehicles"."organization_id" = $1`)
+	assert.Contains(t, scoped.Vars, "org-3")
+}
+
+// TestTenantHooks_AssignOnCreate - 생성 시 소속 기관 지정, 다른 기관으로는 생성 불가
+func TestTenantHooks_AssignOnCreate(t *testing.T) {
+	// Given
+	db := newDryRunDB(t)
+	ctx := asOrganization("org-1")
+	vehicle := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "흰색")
+	other := domain.NewVehicle("34나5678", "카운티", "현대", domain.VehicleTypeMiniBus, 25, 2021, "노란색")
+	other.OrganizationID = "org-2"
+
+	// When
+	err := db.WithContext(ctx).Create(vehicle).Error
+	mismatch := db.WithContext(ctx).Create(other).Error
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "org-1", vehicle.OrganizationID)
+	assert.ErrorIs(t, mismatch, repository.ErrOrganizationMismatch)
+}
+
+// TestTenantHooks_EventLogs - 감사 로그/알림 발송 기록/웹훅 구독·전달 기록/아웃박스 이벤트도 소속 기관만 조회
+func TestTenantHooks_EventLogs(t *testing.T) {
+	// Given
+	db := newDryRunDB(t)
+	ctx := asOrganization("org-1")
+
+	// When
+	statements := map[string]*gorm.Statement{
+		"audit_logs":            db.WithContext(ctx).Find(&[]domain.AuditLog{}).Statement,
+		"notification_logs":     db.WithContext(ctx).Find(&[]domain.NotificationLog{}).Statement,
+		"webhook_subscriptions": db.WithContext(ctx).Find(&[]domain.WebhookSubscription{}).Statement,
+		"webhook_deliveries":    db.WithContext(ctx).Find(&[]domain.WebhookDelivery{}).Statement,
+		"outbox_events":         db.WithContext(ctx).Find(&[]domain.OutboxEvent{}).Statement,
+	}
+
+	// Then
+	for table, stmt := range statements {
+		assert.Contains(t, stmt.SQL.String(), `"`+table+`"."organization_id" = $1`, table)
+		assert.Contains(t, stmt.Vars, "org-1", table)
+	}
+}
+
+// TestTenantHooks_EventLogs_AssignOnCreate - 웹훅 구독은 등록한 기관 소속, 다른 기관의 구독/이벤트는 기록 불가
+func TestTenantHooks_EventLogs_AssignOnCreate(t *testing.T) {
+	// Given
+	db := newDryRunDB(t)
+	ctx := asOrganization("org-1")
+	subscription := domain.NewWebhookSubscription("출결 시스템", "https://example.com/hooks", "secret", []domain.WebhookEvent{domain.WebhookEventTripStarted}, "admin-1")
+	foreign := domain.NewWebhookSubscription("출결 시스템", "https://example.org/hooks", "secret", []domain.WebhookEvent{domain.WebhookEventTripStarted}, "admin-1")
+	foreign.OrganizationID = "org-2"
+	outboxEvent := domain.NewOutboxEvent("org-2", string(domain.WebhookEventTripStarted), "trip-1", "{}")
+
+	// When
+	err := db.WithContext(ctx).Create(subscription).Error
+	mismatch := db.WithContext(ctx).Create(foreign).Error
+	eventMismatch := db.WithContext(ctx).Create(outboxEvent).Error
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "org-1", subscription.OrganizationID)
+	assert.ErrorIs(t, mismatch, repository.ErrOrganizationMismatch)
+	assert.ErrorIs(t, eventMismatch, repository.ErrOrganizationMismatch)
+}
@@ -0,0 +1,126 @@
+package secrets_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestVaultClient_FetchKVv2 - KV v2 응답(data.data)의 값 조회, 토큰/네임스페이스 헤더
+func TestVaultClient_FetchKVv2(t *testing.T) {
+	// Given
+	var captured *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured = r
+		_, _ = io.WriteString(w, `{"data":{"data":{"DB_PASSWORD":"s3cret","REDIS_DB":2},"metadata":{"version":3}}}`)
+	}))
+	defer server.Close()
+	client := secrets.NewVaultClient(server.URL+"/", "hvs.token", "eodini", "/secret/data/eodini", time.Second)
+
+	// When
+	values, err := client.Fetch(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"DB_PASSWORD": "s3cret", "REDIS_DB": "2"}, values)
+	assert.Equal(t, "/v1/secret/data/eodini", captured.URL.Path)
+	assert.Equal(t, "hvs.token", captured.Header.Get("X-Vault-Token"))
+	assert.Equal(t, "eodini", captured.Header.Get("X-Vault-Namespace"))
+}
+
+// TestVaultClient_FetchKVv1 - KV v1 응답(data)과 없는 경로
+func TestVaultClient_FetchKVv1(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/eodini" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data":{"JWT_SECRET":"signing-key"}}`)
+	}))
+	defer server.Close()
+
+	// When
+	values, err := secrets.NewVaultClient(server.URL, "hvs.token", "", "secret/eodini", time.Second).Fetch(context.Background())
+	_, missingErr := secrets.NewVaultClient(server.URL, "hvs.token", "", "secret/missing", time.Second).Fetch(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "signing-key", values["JWT_SECRET"])
+	assert.ErrorIs(t, missingErr, secrets.ErrNotFound)
+}
+
+// TestAWSClient_Fetch - SigV4 서명과 SecretString(JSON 객체) 해석
+func TestAWSClient_Fetch(t *testing.T) {
+	// Given
+	var captured *http.Request
+	var body []byte
+	client := secrets.NewAWSClient("ap-northeast-2", "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "eodini/prod", time.Second)
+	client.Now = func() time.Time { return time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC) }
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		captured = req
+		body, _ = io.ReadAll(req.Body)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{},
+			Body: io.NopCloser(strings.NewReader(`{"Name":"eodini/prod","SecretString":"{\"DB_PASSWORD\":\"s3cret\",\"SMS_API_KEY\":\"sms-key\"}"}`))}, nil
+	})
+
+	// When
+	values, err := client.Fetch(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"DB_PASSWORD": "s3cret", "SMS_API_KEY": "sms-key"}, values)
+	assert.Equal(t, "https://secretsmanager.ap-northeast-2.amazonaws.com/", captured.URL.String())
+	assert.Equal(t, "secretsmanager.GetSecretValue", captured.Header.Get("X-Amz-Target"))
+	assert.JSONEq(t, `{"SecretId":"eodini/prod"}`, string(body))
+	// 기대 서명은 AWS SigV4 절차로 별도 계산한 값
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20250303/ap-northeast-2/secretsmanager/aws4_request, "+
+		"SignedHeaders=content-type;host;x-amz-date;x-amz-target, "+
+		"Signature=eb7785bfeb6edcbcd020de1579e1283168f9a6172860ad555874dc85940fee93", captured.Header.Get("Authorization"))
+}
+
+// TestAWSClient_FetchErrors - 없는 비밀, JSON 객체가 아닌 비밀
+func TestAWSClient_FetchErrors(t *testing.T) {
+	// Given
+	notFound := secrets.NewAWSClient("ap-northeast-2", "AKIDEXAMPLE", "secret", "eodini/missing", time.Second)
+	notFound.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusBadRequest, Header: http.Header{},
+			Body: io.NopCloser(strings.NewReader(`{"__type":"ResourceNotFoundException","message":"Secrets Manager can't find the specified secret."}`))}, nil
+	})
+	plain := secrets.NewAWSClient("ap-northeast-2", "AKIDEXAMPLE", "secret", "eodini/plain", time.Second)
+	plain.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{},
+			Body: io.NopCloser(strings.NewReader(`{"SecretString":"just-a-password"}`))}, nil
+	})
+
+	// When
+	_, notFoundErr := notFound.Fetch(context.Background())
+	_, plainErr := plain.Fetch(context.Background())
+
+	// Then
+	assert.ErrorIs(t, notFoundErr, secrets.ErrNotFound)
+	require.Error(t, plainErr)
+	assert.Contains(t, plainErr.Error(), "JSON object")
+	assert.NotContains(t, plainErr.Error(), "just-a-password")
+}
+
+// TestNew_UnknownProvider - 알 수 없는 저장소 이름
+func TestNew_UnknownProvider(t *testing.T) {
+	_, err := secrets.New(secrets.Config{Provider: "consul"})
+
+	assert.Error(t, err)
+}
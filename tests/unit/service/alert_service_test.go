@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingNotifier - 알림 받은 경보 기록
+type recordingNotifier struct {
+	alerts []*domain.TripAlert
+}
+
+func (n *recordingNotifier) NotifyAlert(ctx context.Context, alert *domain.TripAlert) error {
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+var alertRules = service.AlertRules{
+	SpeedLimit:           80,
+	SchoolZoneSpeedLimit: 30,
+	SchoolZones:          []service.SchoolZone{{Center: geo.Point{Latitude: 37.5, Longitude: 127.0}, Radius: 300}},
+	HarshAcceleration:    11,
+	HarshBraking:         8,
+	Cooldown:             time.Minute,
+}
+
+var alertStart = time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+
+// positionAt - trip-1 차량의 seconds초 시점 위치
+func positionAt(seconds int, lat, speed float64) *domain.VehiclePosition {
+	return &domain.VehiclePosition{
+		TripID:     "trip-1",
+		VehicleID:  "vehicle-1",
+		Latitude:   lat,
+		Longitude:  127.0,
+		Speed:      &speed,
+		RecordedAt: alertStart.Add(time.Duration(seconds) * time.Second),
+	}
+}
+
+// TestAlertService_ObserveLocation - 보호구역 안은 보호구역 제한 속도, 밖은 전체 제한 속도로 판정
+func TestAlertService_ObserveLocation(t *testing.T) {
+	// Given
+	alertRepo := mocks.NewTripAlertRepository()
+	notifier := &recordingNotifier{}
+	svc := service.NewAlertService(alertRepo, nil, alertRules, notifier)
+	trip := &domain.Trip{ID: "trip-1"}
+	ctx := context.Background()
+
+	// When
+	svc.ObserveLocation(ctx, trip, nil, positionAt(0, 37.5, 40))   // 보호구역 40km/h
+	svc.ObserveLocation(ctx, trip, nil, positionAt(30, 37.51, 70)) // 보호구역 밖 70km/h (정상)
+	svc.ObserveLocation(ctx, trip, nil, positionAt(60, 37.52, 90)) // 보호구역 밖 90km/h
+
+	// Then
+	alerts, err := alertRepo.ListByTrip(ctx, "trip-1")
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, domain.AlertSchoolZoneSpeeding, alerts[0].Type)
+	assert.Equal(t, 40.0, alerts[0].Value)
+	assert.Equal(t, 30.0, alerts[0].Threshold)
+	assert.Equal(t, domain.AlertSpeeding, alerts[1].Type)
+	assert.Len(t, notifier.alerts, 2)
+}
+
+// TestAlertService_ObserveLocation_Cooldown - 과속이 이어져도 Cooldown 안에는 한 번만 기록
+func TestAlertService_ObserveLocation_Cooldown(t *testing.T) {
+	// Given
+	alertRepo := mocks.NewTripAlertRepository()
+	svc := service.NewAlertService(alertRepo, nil, alertRules, nil)
+	trip := &domain.Trip{ID: "trip-1"}
+	ctx := context.Background()
+
+	// When: 0, 20, 40초 과속 후 70초 과속
+	for _, seconds := range []int{0, 20, 40, 70} {
+		svc.ObserveLocation(ctx, trip, nil, positionAt(seconds, 37.52, 90))
+	}
+
+	// Then
+	alerts, err := alertRepo.ListByTrip(ctx, "trip-1")
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, alertStart.Add(70*time.Second), alerts[1].OccurredAt)
+}
+
+// TestAlertService_ObserveLocation_Harsh - 직전 위치와의 초당 속도 변화로 급가속/급감속 판정
+func TestAlertService_ObserveLocation_Harsh(t *testing.T) {
+	// Given
+	alertRepo := mocks.NewTripAlertRepository()
+	svc := service.NewAlertService(alertRepo, nil, alertRules, nil)
+	trip := &domain.Trip{ID: "trip-1"}
+	ctx := context.Background()
+
+	// When
+	svc.ObserveLocation(ctx, trip, positionAt(0, 37.52, 20), positionAt(2, 37.52, 50))     // +15km/h/s
+	svc.ObserveLocation(ctx, trip, positionAt(10, 37.52, 50), positionAt(12, 37.52, 30))   // -10km/h/s
+	svc.ObserveLocation(ctx, trip, positionAt(100, 37.52, 0), positionAt(130, 37.52, 60))  // 간격이 길어 판정 안 함
+	svc.ObserveLocation(ctx, trip, positionAt(200, 37.52, 40), positionAt(205, 37.52, 50)) // +2km/h/s 정상
+
+	// Then
+	alerts, err := alertRepo.ListByTrip(ctx, "trip-1")
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, domain.AlertHarshAcceleration, alerts[0].Type)
+	assert.Equal(t, 15.0, alerts[0].Value)
+	assert.Equal(t, domain.AlertHarshBraking, alerts[1].Type)
+	assert.Equal(t, 10.0, alerts[1].Value)
+}
+
+// TestAlertService_List - 위치 전송으로 생성된 경보 조회
+func TestAlertService_List(t *testing.T) {
+	// Given: 경보 서비스를 위치 후속 처리로 등록
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	tripService := service.NewTripService(mocks.NewTripRepository(), scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	trip, err := tripService.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2025-03-03"})
+	require.NoError(t, err)
+	driverCtx := asPrincipal(domain.RoleDriver, "driver-1")
+	_, err = tripService.Start(driverCtx, trip.ID, &dto.TripLocationRequest{})
+	require.NoError(t, err)
+
+	alertService := service.NewAlertService(mocks.NewTripAlertRepository(), tripService, alertRules, nil)
+	tracking := service.NewTrackingService(tripService, scheduleRepo, mocks.NewRouteRepository(), mocks.NewGuardianRepository(), mocks.NewPassengerRepository(), mocks.NewTripLocationRepository(), realtime.NewHub(), nil, alertService)
+
+	// When: 90km/h 전송 2초 후 60km/h 전송 (급감속)
+	for i, speed := range []float64{90, 60} {
+		recordedAt := alertStart.Add(time.Duration(i) * 2 * time.Second)
+		_, err := tracking.ReportLocation(driverCtx, trip.ID, &dto.ReportLocationRequest{Latitude: floatPtr(37.6), Longitude: floatPtr(127.0), Speed: &speed, RecordedAt: &recordedAt})
+		require.NoError(t, err)
+	}
+	alerts, err := alertService.List(ctx, trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, domain.AlertSpeeding, alerts[0].Type)
+	assert.Equal(t, domain.AlertHarshBraking, alerts[1].Type)
+	assert.Equal(t, "vehicle-1", alerts[1].VehicleID)
+
+	// When & Then: 없는 운행
+	_, err = alertService.List(ctx, "missing")
+	var appErr *util.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, util.ErrCodeNotFound, appErr.Code)
+}
@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAPIKeyRequest - 테스트용 API 키 발급 요청
+func newAPIKeyRequest(scopes ...string) *dto.IssueAPIKeyRequest {
+	return &dto.IssueAPIKeyRequest{Name: "텔레매틱스 게이트웨이", Scopes: scopes}
+}
+
+// TestAPIKeyService_IssueAndAuthenticate - 발급한 원문 키로 인증
+func TestAPIKeyService_IssueAndAuthenticate(t *testing.T) {
+	// Given
+	repo := mocks.NewAPIKeyRepository()
+	svc := service.NewAPIKeyService(repo)
+	issued, err := svc.Issue(context.Background(), "admin-1", newAPIKeyRequest("vehicles:read", "locations:write"))
+	require.NoError(t, err)
+
+	// When
+	principal, err := svc.AuthenticateAPIKey(context.Background(), issued.Key)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, issued.APIKey.ID, principal.UserID)
+	assert.Equal(t, domain.RoleIntegration, principal.Role)
+	assert.True(t, principal.HasScope(domain.ScopeLocationsWrite))
+	assert.False(t, principal.HasScope(domain.ScopeTripsRead))
+	assert.NotContains(t, issued.APIKey.KeyHash, issued.Key) // 원문 미저장
+	assert.Equal(t, issued.Key[:8], issued.APIKey.Prefix)
+
+	stored, err := repo.GetByID(context.Background(), issued.APIKey.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.LastUsedAt)
+}
+
+// TestAPIKeyService_Authenticate_Rejected - 없는/폐기된/만료된 키는 인증 실패
+func TestAPIKeyService_Authenticate_Rejected(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewAPIKeyService(mocks.NewAPIKeyRepository())
+
+	revoked, err := svc.Issue(ctx, "admin-1", newAPIKeyRequest("vehicles:read"))
+	require.NoError(t, err)
+	_, err = svc.Revoke(ctx, revoked.APIKey.ID)
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(50 * time.Millisecond)
+	expiringReq := newAPIKeyRequest("vehicles:read")
+	expiringReq.ExpiresAt = &expiresAt
+	expired, err := svc.Issue(ctx, "admin-1", expiringReq)
+	require.NoError(t, err)
+	time.Sleep(60 * time.Millisecond)
+
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{"없는 키", "eod_unknown"},
+		{"폐기된 키", revoked.Key},
+		{"만료된 키", expired.Key},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			_, err := svc.AuthenticateAPIKey(ctx, tt.key)
+
+			// Then
+			appErr, ok := err.(*util.AppError)
+			require.True(t, ok)
+			assert.Equal(t, util.ErrCodeUnauthorized, appErr.Code)
+		})
+	}
+}
+
+// TestAPIKeyService_Revoke_AlreadyRevoked - 이미 폐기된 키 재폐기 거부
+func TestAPIKeyService_Revoke_AlreadyRevoked(t *testing.T) {
+	// Given
+	svc := service.NewAPIKeyService(mocks.NewAPIKeyRepository())
+	issued, err := svc.Issue(context.Background(), "admin-1", newAPIKeyRequest("vehicles:read"))
+	require.NoError(t, err)
+	_, err = svc.Revoke(context.Background(), issued.APIKey.ID)
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.Revoke(context.Background(), issued.APIKey.ID)
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+}
+
+// TestAPIKeyService_Issue_PastExpiry - 과거 만료 시각 거부
+func TestAPIKeyService_Issue_PastExpiry(t *testing.T) {
+	// Given
+	svc := service.NewAPIKeyService(mocks.NewAPIKeyRepository())
+	req := newAPIKeyRequest("vehicles:read")
+	past := time.Now().Add(-time.Hour)
+	req.ExpiresAt = &past
+
+	// When
+	_, err := svc.Issue(context.Background(), "admin-1", req)
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeValidation, appErr.Code)
+	assert.Contains(t, appErr.Details, "expires_at")
+}
@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApproachService_HandleStopEvent - 접근 이벤트 시 정류장 배정 탑승자의 보호자에게 한 번만 "약 N분 후 도착" 알림
+func TestApproachService_HandleStopEvent(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	guardian := domain.NewGuardian("엄마", "010-0000-0000")
+	require.NoError(t, f.guardianRepo.Create(ctx, guardian))
+	require.NoError(t, f.guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, f.passenger.ID, "엄마")))
+	elsewhere := domain.NewPassenger("박지호", "박보호", "010-5555-6666")
+	elsewhere.AssignToStop(f.route.ID, "stop-other", 2)
+	require.NoError(t, f.passengerRepo.Create(ctx, elsewhere))
+
+	svc := service.NewApproachService(f.tripRepo, f.scheduleRepo, f.passengerRepo, f.guardianRepo,
+		mocks.NewNotificationDedupRepository(), f.notifier, 30)
+	event := geofence.Event{
+		Type:     geofence.EventApproaching,
+		TripID:   f.trip.ID,
+		StopID:   f.stop.ID,
+		StopName: f.stop.Name,
+		Distance: 300,
+	}
+
+	// When - 지나쳤다가 다시 접근해도 한 번만
+	svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventArrived, TripID: f.trip.ID, StopID: f.stop.ID})
+	svc.HandleStopEvent(ctx, event)
+	svc.HandleStopEvent(ctx, event)
+
+	// Then: 300m × 1.3 ÷ 500m/분 → 1분
+	require.Eventually(t, func() bool { return len(f.notifier.Sent()) == 1 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	require.Len(t, f.notifier.Sent(), 1)
+	sent := f.notifier.Sent()[0]
+	assert.Equal(t, guardian.ID, sent.GuardianID)
+	assert.Equal(t, domain.NotificationEventApproaching, sent.Event)
+	assert.Equal(t, "approaching", sent.Notification.Template)
+	assert.Equal(t, "1", sent.Notification.Variables["minutes"])
+	assert.Contains(t, sent.Notification.Body, "해오름아파트 정문에 약 1분 후 도착")
+}
+
+// TestApproachService_SkipsNoShow - 불참 처리된 탑승자의 보호자에게는 접근 알림을 보내지 않음
+func TestApproachService_SkipsNoShow(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	_, err := f.svc.MarkNoShow(ctx, f.trip.ID, f.passenger.ID, "결석")
+	require.NoError(t, err)
+	// 불참 알림 (관리자, 동승자)
+	require.Eventually(t, func() bool { return len(f.notifier.Sent()) == 2 }, time.Second, 10*time.Millisecond)
+
+	svc := service.NewApproachService(f.tripRepo, f.scheduleRepo, f.passengerRepo, f.guardianRepo,
+		mocks.NewNotificationDedupRepository(), f.notifier, 30)
+
+	// When
+	svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventApproaching, TripID: f.trip.ID, StopID: f.stop.ID, Distance: 3000})
+
+	// Then
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, f.notifier.Sent(), 2)
+}
@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// attendanceFixture - 보호자에 연결된 탑승자와 오전/오후 일정
+type attendanceFixture struct {
+	svc       *service.AttendanceService
+	tripRepo  *mocks.TripRepository
+	passenger *domain.Passenger
+	guardian  *domain.Guardian
+	morning   *domain.Schedule
+	afternoon *domain.Schedule
+}
+
+func newAttendanceFixture(t *testing.T) *attendanceFixture {
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	guardian := domain.NewGuardian("김보호", "010-1234-5678")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	require.NoError(t, guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+
+	morning := domain.NewSchedule("A코스 등원", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, morning))
+	afternoon := domain.NewSchedule("A코스 하원", "15:00", domain.TimeSlotAfternoon, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, afternoon))
+
+	return &attendanceFixture{
+		svc:       service.NewAttendanceService(passengerRepo, guardianRepo, tripRepo, scheduleRepo, nil),
+		tripRepo:  tripRepo,
+		passenger: passenger,
+		guardian:  guardian,
+		morning:   morning,
+		afternoon: afternoon,
+	}
+}
+
+// createTrip - 날짜의 운행과 탑승 기록 (mark로 기록 상태 변경, passengerID가 비면 기록 없는 운행)
+func (f *attendanceFixture) createTrip(t *testing.T, schedule *domain.Schedule, date string, passengerID string, mark func(*domain.TripPassenger)) *domain.Trip {
+	day, _ := time.Parse(time.DateOnly, date)
+	trip := domain.NewTrip(schedule.ID, day, schedule.VehicleID, schedule.DefaultDriverID, nil)
+	if passengerID != "" {
+		record := domain.NewTripPassenger(trip.ID, passengerID, "stop-1")
+		if mark != nil {
+			mark(record)
+		}
+		trip.TripPassengers = append(trip.TripPassengers, *record)
+	}
+	require.NoError(t, f.tripRepo.Create(context.Background(), trip))
+	return trip
+}
+
+// TestAttendanceService_MonthlyAttendance - 그 달 운행을 날짜별로 묶고 승차/결석/불참 합계 (취소, 다른 달, 다른 탑승자 운행 제외)
+func TestAttendanceService_MonthlyAttendance(t *testing.T) {
+	// Given
+	f := newAttendanceFixture(t)
+	id := f.passenger.ID
+	ride := func(r *domain.TripPassenger) {
+		r.BoardPassenger("driver-1", nil)
+		r.AlightPassenger("driver-1", nil)
+	}
+	f.createTrip(t, f.afternoon, "2025-03-03", id, ride)
+	f.createTrip(t, f.morning, "2025-03-03", id, ride)
+	f.createTrip(t, f.morning, "2025-03-04", id, func(r *domain.TripPassenger) { r.MarkNoShow("정류장에 나오지 않음", "driver-1") })
+	f.createTrip(t, f.afternoon, "2025-03-04", id, func(r *domain.TripPassenger) { r.BoardPassenger("driver-1", nil) })
+	f.createTrip(t, f.morning, "2025-03-31", id, func(r *domain.TripPassenger) { r.MarkExcused("병원 진료") })
+	cancelled := f.createTrip(t, f.morning, "2025-03-05", id, nil)
+	require.NoError(t, cancelled.Cancel("차량 점검"))
+	require.NoError(t, f.tripRepo.Update(context.Background(), cancelled))
+	f.createTrip(t, f.morning, "2025-04-01", id, ride)
+	f.createTrip(t, f.morning, "2025-03-06", "other-passenger", ride)
+
+	// When
+	result, err := f.svc.MonthlyAttendance(asPrincipal(domain.RoleGuardian, f.guardian.ID), id, "2025-03")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "김민준", result.PassengerName)
+	assert.Equal(t, "2025-03", result.Month)
+	assert.Equal(t, 5, result.Summary.Trips)
+	assert.Equal(t, 3, result.Summary.Rides)
+	assert.Equal(t, 1, result.Summary.Absences)
+	assert.Equal(t, 1, result.Summary.NoShows)
+	assert.Equal(t, 2, result.Summary.RideDays)
+
+	require.Len(t, result.Days, 3)
+	assert.Equal(t, "2025-03-03", result.Days[0].Date)
+	require.Len(t, result.Days[0].Trips, 2)
+	assert.Equal(t, "A코스 등원", result.Days[0].Trips[0].ScheduleName)
+	assert.Equal(t, "alighted", result.Days[0].Trips[0].Status)
+	assert.NotNil(t, result.Days[0].Trips[0].BoardedAt)
+	assert.Equal(t, "no_show", result.Days[1].Trips[0].Status)
+	assert.Equal(t, "정류장에 나오지 않음", result.Days[1].Trips[0].Reason)
+	assert.Equal(t, "boarded", result.Days[1].Trips[1].Status)
+	assert.Equal(t, "2025-03-31", result.Days[2].Date)
+	assert.Equal(t, "excused", result.Days[2].Trips[0].Status)
+	assert.Equal(t, "병원 진료", result.Days[2].Trips[0].Reason)
+}
+
+// TestAttendanceService_MonthlyAttendance_Access - 관리자와 연결된 보호자만 조회, 없는 탑승자는 NOT_FOUND
+func TestAttendanceService_MonthlyAttendance_Access(t *testing.T) {
+	f := newAttendanceFixture(t)
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		passengerID string
+		code        string
+	}{
+		{"관리자", asPrincipal(domain.RoleAdmin, ""), f.passenger.ID, ""},
+		{"연결되지 않은 보호자", asPrincipal(domain.RoleGuardian, "guardian-other"), f.passenger.ID, util.ErrCodeForbidden},
+		{"기사", asPrincipal(domain.RoleDriver, "driver-1"), f.passenger.ID, util.ErrCodeForbidden},
+		{"없는 탑승자", asPrincipal(domain.RoleAdmin, ""), "passenger-unknown", util.ErrCodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			result, err := f.svc.MonthlyAttendance(tt.ctx, tt.passengerID, "")
+
+			// Then
+			if tt.code == "" {
+				require.NoError(t, err)
+				assert.Equal(t, time.Now().In(time.FixedZone("KST", 9*60*60)).Format("2006-01"), result.Month)
+				assert.Empty(t, result.Days)
+				return
+			}
+			assertAppError(t, err, tt.code)
+		})
+	}
+}
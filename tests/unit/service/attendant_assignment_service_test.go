@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAttendantAssignmentService - 대체 배정 생성/승인/삭제, 같은 일정의 기간 겹침 거부
+func TestAttendantAssignmentService(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleAdmin, "admin-1")
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	attendantRepo := mocks.NewAttendantRepository()
+	substitute := domain.NewAttendant("박대체", "010-1111-2222", domain.AttendantRoleTeacher)
+	require.NoError(t, attendantRepo.Create(ctx, substitute))
+	onLeave := domain.NewAttendant("김휴가", "010-3333-4444", domain.AttendantRoleNurse)
+	onLeave.SetOnLeave()
+	require.NoError(t, attendantRepo.Create(ctx, onLeave))
+	svc := service.NewAttendantAssignmentService(mocks.NewAttendantAssignmentRepository(), scheduleRepo, attendantRepo)
+
+	// When
+	assignment, err := svc.Create(ctx, schedule.ID, &dto.CreateAttendantAssignmentRequest{
+		AttendantID: substitute.ID, StartDate: "2025-03-10", EndDate: "2025-03-14", Reason: "담임 연수",
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "user-admin-1", assignment.CreatedBy)
+	assert.False(t, assignment.IsApproved())
+
+	// When: 기간이 겹치거나 잘못된 요청
+	_, overlapErr := svc.Create(ctx, schedule.ID, &dto.CreateAttendantAssignmentRequest{
+		AttendantID: substitute.ID, StartDate: "2025-03-14", EndDate: "2025-03-20",
+	})
+	_, reversedErr := svc.Create(ctx, schedule.ID, &dto.CreateAttendantAssignmentRequest{
+		AttendantID: substitute.ID, StartDate: "2025-04-10", EndDate: "2025-04-01",
+	})
+	_, onLeaveErr := svc.Create(ctx, schedule.ID, &dto.CreateAttendantAssignmentRequest{
+		AttendantID: onLeave.ID, StartDate: "2025-04-01", EndDate: "2025-04-02",
+	})
+	_, missingErr := svc.Create(ctx, "missing", &dto.CreateAttendantAssignmentRequest{
+		AttendantID: substitute.ID, StartDate: "2025-04-01", EndDate: "2025-04-02",
+	})
+
+	// Then
+	assertAppError(t, overlapErr, util.ErrCodeConflict)
+	assertAppError(t, reversedErr, util.ErrCodeValidation)
+	assertAppError(t, onLeaveErr, util.ErrCodeValidation)
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+
+	// When: 바로 다음 기간은 허용
+	_, err = svc.Create(ctx, schedule.ID, &dto.CreateAttendantAssignmentRequest{
+		AttendantID: substitute.ID, StartDate: "2025-03-15", EndDate: "2025-03-20",
+	})
+	require.NoError(t, err)
+
+	// When: 승인 (다른 일정 ID로는 NOT_FOUND)
+	_, wrongScheduleErr := svc.Approve(ctx, "other", assignment.ID)
+	approved, err := svc.Approve(ctx, schedule.ID, assignment.ID)
+
+	// Then
+	assertAppError(t, wrongScheduleErr, util.ErrCodeNotFound)
+	require.NoError(t, err)
+	assert.True(t, approved.IsApproved())
+	assert.Equal(t, "user-admin-1", approved.ApprovedBy)
+
+	// When
+	require.NoError(t, svc.Delete(ctx, schedule.ID, assignment.ID))
+
+	// Then
+	assignments, err := svc.List(context.Background(), schedule.ID)
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Equal(t, "2025-03-15", assignments[0].StartDate.Format("2006-01-02"))
+}
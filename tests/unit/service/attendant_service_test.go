@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAttendantRequest - 테스트용 동승자 등록 요청
+func newAttendantRequest(name, role string) *dto.CreateAttendantRequest {
+	return &dto.CreateAttendantRequest{
+		Name:  name,
+		Phone: "010-1234-5678",
+		Role:  role,
+	}
+}
+
+// TestAttendantService_StartTripPermission - 권한 부여/회수 및 권한 필터
+func TestAttendantService_StartTripPermission(t *testing.T) {
+	// Given
+	svc := service.NewAttendantService(mocks.NewAttendantRepository())
+	teacher, err := svc.Create(context.Background(), newAttendantRequest("김선생", "teacher"))
+	require.NoError(t, err)
+	_, err = svc.Create(context.Background(), newAttendantRequest("이간호", "nurse"))
+	require.NoError(t, err)
+	canStart := true
+
+	// When
+	granted, err := svc.GrantStartTripPermission(context.Background(), teacher.ID)
+	require.NoError(t, err)
+	permitted, total, err := svc.List(context.Background(), repository.AttendantFilter{CanStartTrip: &canStart})
+	require.NoError(t, err)
+	revoked, err := svc.RevokeStartTripPermission(context.Background(), teacher.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, granted.CanStartTrip)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, teacher.ID, permitted[0].ID)
+	assert.False(t, revoked.CanStartTrip)
+}
+
+// TestAttendantService_GrantPermission_OnLeave - 휴가 중인 동승자에게 권한 부여 불가
+func TestAttendantService_GrantPermission_OnLeave(t *testing.T) {
+	// Given
+	svc := service.NewAttendantService(mocks.NewAttendantRepository())
+	attendant, err := svc.Create(context.Background(), newAttendantRequest("김선생", "teacher"))
+	require.NoError(t, err)
+	onLeave := string(domain.AttendantStatusOnLeave)
+	_, err = svc.Update(context.Background(), attendant.ID, &dto.UpdateAttendantRequest{Status: &onLeave})
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.GrantStartTripPermission(context.Background(), attendant.ID)
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+}
+
+// TestAttendantService_List_RoleFilter - 역할 필터
+func TestAttendantService_List_RoleFilter(t *testing.T) {
+	// Given
+	svc := service.NewAttendantService(mocks.NewAttendantRepository())
+	_, _ = svc.Create(context.Background(), newAttendantRequest("김선생", "teacher"))
+	_, _ = svc.Create(context.Background(), newAttendantRequest("이간호", "nurse"))
+
+	// When
+	nurses, total, err := svc.List(context.Background(), repository.AttendantFilter{Role: domain.AttendantRoleNurse})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "이간호", nurses[0].Name)
+}
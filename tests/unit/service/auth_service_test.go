@@ -0,0 +1,294 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureNotifier - 발송된 재설정 토큰을 기록하는 테스트용 Notifier
+type captureNotifier struct {
+	tokens []string
+}
+
+func (n *captureNotifier) SendPasswordReset(ctx context.Context, user *domain.User, token string) error {
+	n.tokens = append(n.tokens, token)
+	return nil
+}
+
+// authFixture - 인증 서비스 테스트 구성
+type authFixture struct {
+	users    *mocks.UserRepository
+	sessions *mocks.SessionRepository
+	notifier *captureNotifier
+	auth     *service.AuthService
+	user     *service.UserService
+}
+
+func newAuthFixture(t *testing.T) *authFixture {
+	t.Helper()
+	users := mocks.NewUserRepository()
+	sessions := mocks.NewSessionRepository()
+	notifier := &captureNotifier{}
+	tokens := auth.NewTokenManager("test-secret", time.Hour)
+	return &authFixture{
+		users:    users,
+		sessions: sessions,
+		notifier: notifier,
+		auth:     service.NewAuthService(users, mocks.NewPasswordResetTokenRepository(), sessions, tokens, notifier, 30*time.Minute),
+		user:     service.NewUserService(users, sessions),
+	}
+}
+
+// createUser - 테스트용 기사 계정 생성
+func (f *authFixture) createUser(t *testing.T, email, password string) *domain.User {
+	t.Helper()
+	user, err := f.user.Create(context.Background(), &dto.CreateUserRequest{
+		Email:     email,
+		Password:  password,
+		Role:      "driver",
+		ProfileID: "8a1f6a4e-0000-4000-8000-000000000001",
+	})
+	require.NoError(t, err)
+	return user
+}
+
+// assertAppError - AppError 코드 검증
+func assertAppError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok, "expected AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+// TestAuthService_Login - 로그인 성공 시 계정 역할/프로필이 담긴 토큰 발급
+func TestAuthService_Login(t *testing.T) {
+	// Given
+	f := newAuthFixture(t)
+	user := f.createUser(t, "Driver@Eodini.kr", "password123")
+
+	// When
+	result, err := f.auth.Login(context.Background(), &dto.LoginRequest{Email: "driver@eodini.kr", Password: "password123"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer", result.TokenType)
+	assert.Equal(t, 3600, result.ExpiresIn)
+
+	principal, err := auth.NewTokenManager("test-secret", time.Hour).Parse(result.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, principal.UserID)
+	assert.Equal(t, domain.RoleDriver, principal.Role)
+	assert.Equal(t, user.ProfileID, principal.ProfileID)
+	assert.Equal(t, result.SessionID, principal.SessionID)
+
+	stored, err := f.users.GetByID(context.Background(), user.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.LastLoginAt)
+}
+
+// TestAuthService_Login_Rejected - 잘못된 비밀번호/없는 계정/비활성 계정
+func TestAuthService_Login_Rejected(t *testing.T) {
+	ctx := context.Background()
+	f := newAuthFixture(t)
+	f.createUser(t, "driver@eodini.kr", "password123")
+	disabled := f.createUser(t, "old@eodini.kr", "password123")
+	_, err := f.user.Disable(ctx, disabled.ID)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		message  string
+	}{
+		{"잘못된 비밀번호", "driver@eodini.kr", "wrong-password", util.GetMessage(util.MsgInvalidCredentials)},
+		{"없는 계정", "nobody@eodini.kr", "password123", util.GetMessage(util.MsgInvalidCredentials)},
+		{"비활성 계정", "old@eodini.kr", "password123", util.GetMessage(util.MsgAccountDisabled)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			_, err := f.auth.Login(ctx, &dto.LoginRequest{Email: tt.email, Password: tt.password})
+
+			// Then
+			assertAppError(t, err, util.ErrCodeUnauthorized)
+			assert.Equal(t, tt.message, err.(*util.AppError).Message)
+		})
+	}
+}
+
+// TestAuthService_ChangePassword - 현재 비밀번호 확인 후 변경
+func TestAuthService_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	f := newAuthFixture(t)
+	user := f.createUser(t, "driver@eodini.kr", "password123")
+
+	// When: 현재 비밀번호 불일치
+	err := f.auth.ChangePassword(ctx, user.ID, &dto.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: "newpassword1"})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeValidation)
+
+	// When: 정상 변경
+	err = f.auth.ChangePassword(ctx, user.ID, &dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "newpassword1"})
+
+	// Then
+	require.NoError(t, err)
+	_, err = f.auth.Login(ctx, &dto.LoginRequest{Email: "driver@eodini.kr", Password: "password123"})
+	assertAppError(t, err, util.ErrCodeUnauthorized)
+	_, err = f.auth.Login(ctx, &dto.LoginRequest{Email: "driver@eodini.kr", Password: "newpassword1"})
+	assert.NoError(t, err)
+}
+
+// TestAuthService_UpdateLocale - 언어 설정은 다음 로그인 토큰부터 포함 (빈 값이면 해제)
+func TestAuthService_UpdateLocale(t *testing.T) {
+	ctx := context.Background()
+	f := newAuthFixture(t)
+	user := f.createUser(t, "driver@eodini.kr", "password123")
+
+	// When
+	updated, err := f.auth.UpdateLocale(ctx, user.ID, &dto.UpdateLocaleRequest{Locale: "en"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "en", updated.Locale)
+	result, err := f.auth.Login(ctx, &dto.LoginRequest{Email: "driver@eodini.kr", Password: "password123"})
+	require.NoError(t, err)
+	principal, err := auth.NewTokenManager("test-secret", time.Hour).Parse(result.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "en", principal.Locale)
+
+	// When: 설정 해제
+	updated, err = f.auth.UpdateLocale(ctx, user.ID, &dto.UpdateLocaleRequest{})
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, updated.Locale)
+}
+
+// TestAuthService_ForgotAndResetPassword - 재설정 토큰은 1회만 사용 가능
+func TestAuthService_ForgotAndResetPassword(t *testing.T) {
+	ctx := context.Background()
+	f := newAuthFixture(t)
+	f.createUser(t, "driver@eodini.kr", "password123")
+
+	// Given: 재설정 요청 2회 (없는 이메일은 발송 없이 성공)
+	require.NoError(t, f.auth.ForgotPassword(ctx, &dto.ForgotPasswordRequest{Email: "nobody@eodini.kr"}))
+	require.NoError(t, f.auth.ForgotPassword(ctx, &dto.ForgotPasswordRequest{Email: "driver@eodini.kr"}))
+	require.NoError(t, f.auth.ForgotPassword(ctx, &dto.ForgotPasswordRequest{Email: "driver@eodini.kr"}))
+	require.Len(t, f.notifier.tokens, 2)
+
+	// When
+	err := f.auth.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: f.notifier.tokens[1], NewPassword: "newpassword1"})
+
+	// Then
+	require.NoError(t, err)
+	_, err = f.auth.Login(ctx, &dto.LoginRequest{Email: "driver@eodini.kr", Password: "newpassword1"})
+	assert.NoError(t, err)
+
+	// 같은 토큰 재사용, 이전 토큰, 임의 토큰 모두 거부
+	for _, token := range []string{f.notifier.tokens[1], f.notifier.tokens[0], "unknown-token"} {
+		err = f.auth.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: token, NewPassword: "another-password"})
+		assertAppError(t, err, util.ErrCodeBadRequest)
+	}
+}
+
+// TestAuthService_RevokeSessions - 세션 종료 후 해당 jti는 폐기 상태
+func TestAuthService_RevokeSessions(t *testing.T) {
+	ctx := context.Background()
+	f := newAuthFixture(t)
+	user := f.createUser(t, "driver@eodini.kr", "password123")
+	login := func() string {
+		result, err := f.auth.Login(ctx, &dto.LoginRequest{Email: "driver@eodini.kr", Password: "password123"})
+		require.NoError(t, err)
+		return result.SessionID
+	}
+	phone, tablet, laptop := login(), login(), login()
+
+	// When: 한 세션만 종료
+	require.NoError(t, f.auth.RevokeSession(ctx, user.ID, phone))
+
+	// Then
+	revoked, err := f.auth.IsSessionRevoked(ctx, phone)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = f.auth.IsSessionRevoked(ctx, tablet)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+	assertAppError(t, f.auth.RevokeSession(ctx, "other-user", tablet), util.ErrCodeNotFound) // 타인 세션
+
+	// When: 관리자가 계정 세션 전체 종료
+	count, err := f.user.RevokeSessions(ctx, user.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	for _, id := range []string{tablet, laptop} {
+		revoked, err := f.auth.IsSessionRevoked(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	}
+}
+
+// TestUserService_Disable_RevokesSessions - 계정 비활성화 시 기존 세션 종료
+func TestUserService_Disable_RevokesSessions(t *testing.T) {
+	ctx := context.Background()
+	f := newAuthFixture(t)
+	user := f.createUser(t, "driver@eodini.kr", "password123")
+	result, err := f.auth.Login(ctx, &dto.LoginRequest{Email: "driver@eodini.kr", Password: "password123"})
+	require.NoError(t, err)
+
+	// When
+	_, err = f.user.Disable(ctx, user.ID)
+
+	// Then
+	require.NoError(t, err)
+	revoked, err := f.auth.IsSessionRevoked(ctx, result.SessionID)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+// TestUserService_Create_DuplicateEmail - 이메일 중복 거부 (대소문자 무시)
+func TestUserService_Create_DuplicateEmail(t *testing.T) {
+	// Given
+	f := newAuthFixture(t)
+	f.createUser(t, "driver@eodini.kr", "password123")
+
+	// When
+	_, err := f.user.Create(context.Background(), &dto.CreateUserRequest{
+		Email:    "DRIVER@eodini.kr",
+		Password: "password123",
+		Role:     "admin",
+	})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeDuplicate)
+}
+
+// TestUserService_Create_ProfileRequired - 관리자 외 역할은 프로필 ID 필수
+func TestUserService_Create_ProfileRequired(t *testing.T) {
+	// Given
+	f := newAuthFixture(t)
+
+	// When
+	_, err := f.user.Create(context.Background(), &dto.CreateUserRequest{
+		Email:    "guardian@eodini.kr",
+		Password: "password123",
+		Role:     "guardian",
+	})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeValidation)
+	assert.Contains(t, err.(*util.AppError).Details, "profile_id")
+}
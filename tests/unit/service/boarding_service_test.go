@@ -0,0 +1,481 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// boardingFixture - 승차/하차 테스트용 의존성 (운행 중인 운행, 경로 정류장에 배정된 탑승자)
+type boardingFixture struct {
+	svc           *service.BoardingService
+	tripRepo      *mocks.TripRepository
+	scheduleRepo  *mocks.ScheduleRepository
+	guardianRepo  *mocks.GuardianRepository
+	passengerRepo *mocks.PassengerRepository
+	txManager     *mocks.TxManager
+	notifier      *mocks.GuardianNotifier
+	events        *mocks.EventPublisher
+	trip          *domain.Trip
+	passenger     *domain.Passenger
+	stop          *domain.Stop
+	route         *domain.Route
+}
+
+// newBoardingFixture - 기사 driver-1, 동승자(010-2222-3333) 배정 운행을 시작한 상태로 준비 (운행 기관 관리자 010-9999-0000, 다른 기관 관리자 010-8888-0000)
+func newBoardingFixture(t *testing.T) *boardingFixture {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	attendantRepo := mocks.NewAttendantRepository()
+	txManager := mocks.NewTxManager()
+	notifier := mocks.NewGuardianNotifier()
+	events := mocks.NewEventPublisher()
+
+	route := domain.NewRoute("A코스", "", 40)
+	stop := domain.NewStop(route.ID, "해오름아파트 정문", "", 1, 37.5, 127.0, 10)
+	route.AddStop(*stop)
+	require.NoError(t, routeRepo.Create(ctx, route))
+
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	passenger.AssignToStop(route.ID, stop.ID, 1)
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+
+	attendant := domain.NewAttendant("이동승", "010-2222-3333", domain.AttendantRoleTeacher)
+	require.NoError(t, attendantRepo.Create(ctx, attendant))
+
+	organization := domain.NewOrganization("해오름 어린이집", domain.OrganizationTypeKindergarten)
+	userRepo := mocks.NewUserRepository()
+	for organizationID, phone := range map[string]string{organization.ID: "010-9999-0000", "other-org": "010-8888-0000"} {
+		admin := domain.NewUser(phone+"@example.com", "hash", domain.RoleAdmin, "")
+		admin.OrganizationID, admin.Phone = organizationID, phone
+		require.NoError(t, userRepo.Create(ctx, admin))
+	}
+
+	trip := domain.NewTrip(schedule.ID, time.Now(), "vehicle-1", "driver-1", &attendant.ID)
+	trip.OrganizationID = organization.ID
+	require.NoError(t, trip.Start("driver:driver-1", nil))
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), attendantRepo, nil, nil, nil)
+	svc := service.NewBoardingService(tripService, tripRepo, scheduleRepo, routeRepo, passengerRepo, guardianRepo, attendantRepo,
+		txManager, notifier, events, service.NewOperatorContacts(userRepo, mocks.NewOrganizationRepository(), []string{"010-7777-0000"}), nil)
+	// 알림은 이벤트 핸들러로 발송 (아웃박스 릴레이 대신 발행 즉시 전달)
+	events.Dispatcher = event.NewDispatcher()
+	events.Dispatcher.Subscribe("boarding-notification", svc.HandleEvent)
+	return &boardingFixture{
+		svc:           svc,
+		tripRepo:      tripRepo,
+		scheduleRepo:  scheduleRepo,
+		guardianRepo:  guardianRepo,
+		passengerRepo: passengerRepo,
+		txManager:     txManager,
+		notifier:      notifier,
+		events:        events,
+		trip:          trip,
+		passenger:     passenger,
+		stop:          stop,
+		route:         route,
+	}
+}
+
+// TestBoardingService_BoardAndAlight - 승차/하차 기록 후 연결된 보호자 전원에게 정류장/시각 알림
+func TestBoardingService_BoardAndAlight(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	for _, name := range []string{"엄마", "아빠"} {
+		guardian := domain.NewGuardian(name, "010-0000-0000")
+		require.NoError(t, f.guardianRepo.Create(ctx, guardian))
+		require.NoError(t, f.guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, f.passenger.ID, name)))
+	}
+
+	lat, lng := 37.5001, 127.0002
+
+	// When
+	boarded, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, &dto.TripLocationRequest{Latitude: &lat, Longitude: &lng})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, boarded.IsBoarded)
+	assert.Equal(t, f.stop.ID, boarded.StopID)
+	assert.Equal(t, "driver:driver-1", boarded.BoardedBy)
+	require.NotNil(t, boarded.BoardedLocation)
+	assert.Equal(t, lat, boarded.BoardedLocation.Latitude)
+	assert.Eventually(t, func() bool { return len(f.notifier.Sent()) == 2 }, time.Second, 10*time.Millisecond)
+	sent := f.notifier.Sent()[0]
+	assert.Equal(t, domain.NotificationEventBoarded, sent.Event)
+	assert.Contains(t, sent.Notification.Body, "해오름아파트 정문")
+	assert.Equal(t, "boarded", sent.Notification.Template)
+	assert.Equal(t, boarded.BoardedAt.In(time.FixedZone("KST", 9*60*60)).Format("15:04"), sent.Notification.Variables["time"])
+
+	// When
+	_, duplicateErr := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+	alighted, err := f.svc.Alight(ctx, f.trip.ID, f.passenger.ID, nil)
+
+	// Then
+	assertAppError(t, duplicateErr, util.ErrCodeConflict)
+	require.NoError(t, err)
+	assert.True(t, alighted.IsAlighted)
+	assert.Equal(t, "driver:driver-1", alighted.AlightedBy)
+	assert.Nil(t, alighted.AlightedLocation)
+	assert.Eventually(t, func() bool { return len(f.notifier.Sent()) == 4 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, domain.NotificationEventAlighted, f.notifier.Sent()[3].Event)
+	stored, _ := f.tripRepo.GetByID(ctx, f.trip.ID)
+	require.Len(t, stored.TripPassengers, 1)
+	assert.True(t, stored.TripPassengers[0].IsAlighted)
+}
+
+// TestBoardingService_FallbackToPassengerGuardianPhone - 연결된 보호자가 없으면 탑승자 정보의 보호자 연락처로 발송
+func TestBoardingService_FallbackToPassengerGuardianPhone(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+
+	// When
+	_, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+
+	// Then
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return len(f.notifier.Sent()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "010-1234-5678", f.notifier.Sent()[0].Phone)
+}
+
+// TestBoardingService_Rules - 배정 승무원만, 운행 중에만, 승차 전 하차 불가
+func TestBoardingService_Rules(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	driver := asPrincipal(domain.RoleDriver, "driver-1")
+
+	// When
+	_, otherDriverErr := f.svc.Board(asPrincipal(domain.RoleDriver, "driver-2"), f.trip.ID, f.passenger.ID, nil)
+	_, adminErr := f.svc.Board(asPrincipal(domain.RoleAdmin, "admin-1"), f.trip.ID, f.passenger.ID, nil)
+	_, alightErr := f.svc.Alight(driver, f.trip.ID, f.passenger.ID, nil)
+	_, unknownErr := f.svc.Board(driver, f.trip.ID, "passenger-unknown", nil)
+
+	// Then
+	assertAppError(t, otherDriverErr, util.ErrCodeForbidden)
+	assertAppError(t, adminErr, util.ErrCodeForbidden)
+	assertAppError(t, alightErr, util.ErrCodeConflict)
+	assertAppError(t, unknownErr, util.ErrCodeNotFound)
+
+	// Given - 운행 완료
+	require.NoError(t, f.trip.ConfirmVehicleEmpty("driver:driver-1"))
+	require.NoError(t, f.trip.Complete(nil))
+	require.NoError(t, f.tripRepo.Update(context.Background(), f.trip))
+
+	// When
+	_, completedErr := f.svc.Board(driver, f.trip.ID, f.passenger.ID, nil)
+
+	// Then
+	assertAppError(t, completedErr, util.ErrCodeConflict)
+	assert.Empty(t, f.notifier.Sent())
+}
+
+// TestBoardingService_MarkNoShow - 불참 처리 후 관리자/배정 동승자에게 알림, 늦게 승차하면 불참 기록 해제
+func TestBoardingService_MarkNoShow(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+
+	// When
+	record, err := f.svc.MarkNoShow(ctx, f.trip.ID, f.passenger.ID, "보호자 연락 - 병원 진료")
+	_, duplicateErr := f.svc.MarkNoShow(ctx, f.trip.ID, f.passenger.ID, "중복")
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, record.IsNoShow())
+	assert.Equal(t, "보호자 연락 - 병원 진료", record.NoShowReason)
+	assert.Equal(t, "driver:driver-1", record.NoShowBy)
+	assertAppError(t, duplicateErr, util.ErrCodeConflict)
+	assert.Eventually(t, func() bool { return len(f.notifier.Sent()) == 2 }, time.Second, 10*time.Millisecond)
+	phones := []string{f.notifier.Sent()[0].Phone, f.notifier.Sent()[1].Phone}
+	assert.ElementsMatch(t, []string{"010-9999-0000", "010-2222-3333"}, phones)
+	assert.Contains(t, f.notifier.Sent()[0].Notification.Body, "김민준")
+	assert.Contains(t, f.notifier.Sent()[0].Notification.Body, "해오름아파트 정문")
+
+	// When - 늦게 승차
+	boarded, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+	_, boardedErr := f.svc.MarkNoShow(ctx, f.trip.ID, f.passenger.ID, "착오")
+
+	// Then
+	require.NoError(t, err)
+	assert.False(t, boarded.IsNoShow())
+	assert.Empty(t, boarded.NoShowReason)
+	assert.Empty(t, boarded.NoShowBy)
+	assertAppError(t, boardedErr, util.ErrCodeConflict)
+}
+
+// TestBoardingService_HandleStopEvent - 정류장 출발 시 그 정류장의 미탑승자만 불참 처리 (승차자/다른 정류장 제외, 한 번만)
+func TestBoardingService_HandleStopEvent(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	missed := domain.NewPassenger("이서연", "이보호", "010-3333-4444")
+	missed.AssignToStop(f.route.ID, f.stop.ID, 2)
+	require.NoError(t, f.passengerRepo.Create(ctx, missed))
+	elsewhere := domain.NewPassenger("박지호", "박보호", "010-5555-6666")
+	elsewhere.AssignToStop(f.route.ID, "stop-other", 1)
+	require.NoError(t, f.passengerRepo.Create(ctx, elsewhere))
+	_, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(f.notifier.Sent()) == 1 }, time.Second, 10*time.Millisecond)
+
+	event := geofence.Event{Type: geofence.EventDeparted, TripID: f.trip.ID, StopID: f.stop.ID, OccurredAt: time.Now()}
+
+	// When
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventArrived, TripID: f.trip.ID, StopID: f.stop.ID})
+	f.svc.HandleStopEvent(ctx, event)
+	f.svc.HandleStopEvent(ctx, event)
+
+	// Then
+	stored, err := f.tripRepo.GetByID(ctx, f.trip.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.TripPassengers, 2)
+	for _, record := range stored.TripPassengers {
+		switch record.PassengerID {
+		case f.passenger.ID:
+			assert.True(t, record.IsBoarded)
+			assert.False(t, record.IsNoShow())
+		case missed.ID:
+			assert.True(t, record.IsNoShow())
+			assert.Equal(t, f.stop.ID, record.StopID)
+			assert.NotEmpty(t, record.NoShowReason)
+			assert.Equal(t, "system", record.NoShowBy)
+		default:
+			t.Fatalf("unexpected passenger record: %s", record.PassengerID)
+		}
+	}
+	// 승차 알림 1건 + 불참 알림 (관리자, 동승자)
+	assert.Eventually(t, func() bool { return len(f.notifier.Sent()) == 3 }, time.Second, 10*time.Millisecond)
+	assert.Contains(t, f.notifier.Sent()[2].Notification.Body, "이서연")
+}
+
+// TestBoardingService_Events - 승차/하차/불참(수동, 정류장 출발 시 자동)과 일괄 처리는 탑승자별 웹훅 이벤트로 발행
+func TestBoardingService_Events(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	missed := domain.NewPassenger("이서연", "이보호", "010-3333-4444")
+	missed.AssignToStop(f.route.ID, f.stop.ID, 2)
+	require.NoError(t, f.passengerRepo.Create(ctx, missed))
+	absent := domain.NewPassenger("박지호", "박보호", "010-5555-6666")
+	absent.AssignToStop(f.route.ID, f.stop.ID, 3)
+	require.NoError(t, f.passengerRepo.Create(ctx, absent))
+
+	// When
+	_, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+	require.NoError(t, err)
+	_, err = f.svc.MarkNoShow(ctx, f.trip.ID, absent.ID, "연락 없음")
+	require.NoError(t, err)
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventDeparted, TripID: f.trip.ID, StopID: f.stop.ID, OccurredAt: time.Now()})
+	_, err = f.svc.AlightAll(ctx, f.trip.ID, f.stop.ID, &dto.BulkBoardingRequest{AllExpected: true})
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []domain.WebhookEvent{
+		domain.WebhookEventPassengerBoarded,
+		domain.WebhookEventPassengerNoShow,
+		domain.WebhookEventPassengerNoShow,
+		domain.WebhookEventPassengerAlighted,
+	}, f.events.Types())
+
+	boarded := f.events.Events[0].Data.(*dto.PassengerWebhookData)
+	assert.Equal(t, f.trip.ID, boarded.TripID)
+	assert.Equal(t, f.passenger.ID, boarded.PassengerID)
+	assert.Equal(t, f.stop.ID, boarded.StopID)
+	assert.NotNil(t, boarded.BoardedAt)
+	assert.Equal(t, missed.ID, f.events.Events[2].Data.(*dto.PassengerWebhookData).PassengerID)
+	assert.NotNil(t, f.events.Events[2].Data.(*dto.PassengerWebhookData).NoShowAt)
+	for _, published := range f.events.Events {
+		assert.Equal(t, f.trip.ID, published.AggregateID)
+	}
+}
+
+// TestBoardingService_EventFailure - 이벤트를 기록하지 못하면 승차 처리 실패 (트랜잭션 롤백), 알림도 없음
+func TestBoardingService_EventFailure(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	f.events.Err = errors.New("outbox unavailable")
+
+	// When
+	_, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+
+	// Then
+	assertAppError(t, err, util.ErrCodeInternal)
+	assert.Equal(t, 1, f.txManager.Calls)
+	assert.Empty(t, f.notifier.Sent())
+}
+
+// TestBoardingService_Excused - 결석 신고된 탑승자는 정류장 출발 시 불참 처리하지 않고 수동 불참 처리도 거부
+func TestBoardingService_Excused(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	record := domain.NewTripPassenger(f.trip.ID, f.passenger.ID, f.stop.ID)
+	record.MarkExcused("병원 진료")
+	require.NoError(t, f.tripRepo.SavePassenger(ctx, record))
+
+	// When
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventDeparted, TripID: f.trip.ID, StopID: f.stop.ID, OccurredAt: time.Now()})
+	_, err := f.svc.MarkNoShow(ctx, f.trip.ID, f.passenger.ID, "연락 없음")
+
+	// Then
+	assertAppError(t, err, util.ErrCodeConflict)
+	stored, err := f.tripRepo.GetByID(ctx, f.trip.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.TripPassengers, 1)
+	assert.True(t, stored.TripPassengers[0].IsExcused())
+	assert.False(t, stored.TripPassengers[0].IsNoShow())
+	assert.Empty(t, f.notifier.Sent())
+}
+
+// TestBoardingService_BoardAll - 정류장 탑승 예정자 전원 일괄 승차 (결석 신고/다른 정류장 제외, 한 트랜잭션)
+func TestBoardingService_BoardAll(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleAttendant, *f.trip.AssignedAttendantID)
+	sibling := domain.NewPassenger("김서윤", "김보호", "010-1234-5678")
+	sibling.AssignToStop(f.route.ID, f.stop.ID, 2)
+	excused := domain.NewPassenger("이하준", "이보호", "010-3333-4444")
+	excused.AssignToStop(f.route.ID, f.stop.ID, 3)
+	elsewhere := domain.NewPassenger("박지호", "박보호", "010-5555-6666")
+	elsewhere.AssignToStop(f.route.ID, "stop-other", 1)
+	for _, passenger := range []*domain.Passenger{sibling, excused, elsewhere} {
+		require.NoError(t, f.passengerRepo.Create(ctx, passenger))
+	}
+	excusedRecord := domain.NewTripPassenger(f.trip.ID, excused.ID, f.stop.ID)
+	excusedRecord.MarkExcused("병원 진료")
+	require.NoError(t, f.tripRepo.SavePassenger(ctx, excusedRecord))
+	lat, lng := 37.5, 127.0
+
+	// When
+	records, err := f.svc.BoardAll(ctx, f.trip.ID, f.stop.ID, &dto.BulkBoardingRequest{
+		AllExpected:         true,
+		TripLocationRequest: dto.TripLocationRequest{Latitude: &lat, Longitude: &lng},
+	})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	boarded := []string{records[0].PassengerID, records[1].PassengerID}
+	assert.ElementsMatch(t, []string{f.passenger.ID, sibling.ID}, boarded)
+	for _, record := range records {
+		assert.True(t, record.IsBoarded)
+		assert.Equal(t, "attendant:"+*f.trip.AssignedAttendantID, record.BoardedBy)
+		require.NotNil(t, record.BoardedLocation)
+	}
+	assert.Equal(t, 1, f.txManager.Calls)
+	stored, err := f.tripRepo.GetByID(ctx, f.trip.ID)
+	require.NoError(t, err)
+	assert.Len(t, stored.TripPassengers, 3)
+	// 보호자 연결이 없으므로 탑승자 정보의 보호자 연락처로 1건씩
+	assert.Eventually(t, func() bool { return len(f.notifier.Sent()) == 2 }, time.Second, 10*time.Millisecond)
+}
+
+// TestBoardingService_BoardAll_Rejected - 하나라도 기록할 수 없으면 아무것도 기록하지 않음
+func TestBoardingService_BoardAll_Rejected(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	sibling := domain.NewPassenger("김서윤", "김보호", "010-1234-5678")
+	sibling.AssignToStop(f.route.ID, f.stop.ID, 2)
+	elsewhere := domain.NewPassenger("박지호", "박보호", "010-5555-6666")
+	elsewhere.AssignToStop(f.route.ID, "stop-other", 1)
+	for _, passenger := range []*domain.Passenger{sibling, elsewhere} {
+		require.NoError(t, f.passengerRepo.Create(ctx, passenger))
+	}
+	_, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+	require.NoError(t, err)
+
+	// When
+	_, boardedErr := f.svc.BoardAll(ctx, f.trip.ID, f.stop.ID, &dto.BulkBoardingRequest{PassengerIDs: []string{sibling.ID, f.passenger.ID}})
+	_, elsewhereErr := f.svc.BoardAll(ctx, f.trip.ID, f.stop.ID, &dto.BulkBoardingRequest{PassengerIDs: []string{sibling.ID, elsewhere.ID}})
+	_, unknownStopErr := f.svc.BoardAll(ctx, f.trip.ID, "stop-unknown", &dto.BulkBoardingRequest{AllExpected: true})
+	_, forbiddenErr := f.svc.BoardAll(asPrincipal(domain.RoleDriver, "driver-2"), f.trip.ID, f.stop.ID, &dto.BulkBoardingRequest{AllExpected: true})
+
+	// Then
+	assertAppError(t, boardedErr, util.ErrCodeConflict)
+	assertAppError(t, elsewhereErr, util.ErrCodeValidation)
+	assertAppError(t, unknownStopErr, util.ErrCodeNotFound)
+	assertAppError(t, forbiddenErr, util.ErrCodeForbidden)
+	assert.Equal(t, 1, f.txManager.Calls, "단건 승차(기록 + 이벤트)만 트랜잭션 실행")
+	stored, err := f.tripRepo.GetByID(ctx, f.trip.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.TripPassengers, 1, "형제 탑승자는 기록되지 않음")
+}
+
+// TestBoardingService_AlightAll - 오전 일정은 승차 후 미하차자 전원 일괄 하차, 미승차자 지정은 409
+func TestBoardingService_AlightAll(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	sibling := domain.NewPassenger("김서윤", "김보호", "010-1234-5678")
+	sibling.AssignToStop(f.route.ID, f.stop.ID, 2)
+	require.NoError(t, f.passengerRepo.Create(ctx, sibling))
+	_, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+	require.NoError(t, err)
+
+	// When
+	_, notBoardedErr := f.svc.AlightAll(ctx, f.trip.ID, f.stop.ID, &dto.BulkBoardingRequest{PassengerIDs: []string{f.passenger.ID, sibling.ID}})
+	records, err := f.svc.AlightAll(ctx, f.trip.ID, f.stop.ID, &dto.BulkBoardingRequest{AllExpected: true})
+
+	// Then
+	assertAppError(t, notBoardedErr, util.ErrCodeConflict)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, f.passenger.ID, records[0].PassengerID)
+	assert.True(t, records[0].IsAlighted)
+	assert.Equal(t, "driver:driver-1", records[0].AlightedBy)
+}
+
+// TestBoardingService_FlagException - 승차 중인 탑승자만 예외 처리 (기록자, 사유 저장)
+func TestBoardingService_FlagException(t *testing.T) {
+	// Given
+	f := newBoardingFixture(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	_, notBoardedErr := f.svc.FlagException(ctx, f.trip.ID, f.passenger.ID, "병원 이송")
+	_, err := f.svc.Board(ctx, f.trip.ID, f.passenger.ID, nil)
+	require.NoError(t, err)
+
+	// When
+	record, err := f.svc.FlagException(ctx, f.trip.ID, f.passenger.ID, "병원 이송")
+	_, againErr := f.svc.FlagException(ctx, f.trip.ID, f.passenger.ID, "병원 이송")
+
+	// Then
+	assertAppError(t, notBoardedErr, util.ErrCodeConflict)
+	require.NoError(t, err)
+	assert.True(t, record.HasException())
+	assert.False(t, record.IsOnBoard())
+	assert.Equal(t, "병원 이송", record.ExceptionReason)
+	assert.Equal(t, "driver:driver-1", record.ExceptionBy)
+	assertAppError(t, againErr, util.ErrCodeConflict)
+
+	// Then: 승차와 예외 처리 모두 기록과 같은 트랜잭션으로 이벤트 기록
+	assert.Equal(t, []domain.WebhookEvent{
+		domain.WebhookEventPassengerBoarded,
+		domain.WebhookEventPassengerException,
+	}, f.events.Types())
+	flagged := f.events.Events[1].Data.(*dto.PassengerWebhookData)
+	assert.Equal(t, f.passenger.ID, flagged.PassengerID)
+	assert.NotNil(t, flagged.ExceptionAt)
+	assert.Equal(t, "병원 이송", flagged.ExceptionReason)
+}
@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// calendarFixture - 캘린더 서비스와 매일 운행하는 일정 두 개 (08:00 driver-1, 15:00 driver-2)
+type calendarFixture struct {
+	svc            *service.CalendarService
+	userRepo       *mocks.UserRepository
+	tripRepo       *mocks.TripRepository
+	assignmentRepo *mocks.DriverAssignmentRepository
+	vehicle        *domain.Vehicle
+	morning        *domain.Schedule
+	afternoon      *domain.Schedule
+	today          time.Time // 한국 시간 기준 오늘 (UTC 자정)
+}
+
+// newCalendarFixture - 공휴일과 무관하게 매일 운행하는 일정으로 구성
+func newCalendarFixture(t *testing.T) *calendarFixture {
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	tripRepo := mocks.NewTripRepository()
+	assignmentRepo := mocks.NewDriverAssignmentRepository()
+
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	route := domain.NewRoute("1호차 등원", "", 40)
+	require.NoError(t, routeRepo.Create(ctx, route))
+	newSchedule := func(name, startTime, driverID string) *domain.Schedule {
+		schedule := domain.NewSchedule(name, startTime, domain.TimeSlotMorning, []int{1, 2, 3, 4, 5, 6, 7}, route.ID, vehicle.ID, driverID)
+		schedule.SkipHolidays = false
+		require.NoError(t, scheduleRepo.Create(ctx, schedule))
+		return schedule
+	}
+
+	kst := time.Now().In(time.FixedZone("KST", 9*60*60))
+	f := &calendarFixture{
+		userRepo:       mocks.NewUserRepository(),
+		tripRepo:       tripRepo,
+		assignmentRepo: assignmentRepo,
+		vehicle:        vehicle,
+		morning:        newSchedule("오전 등원", "08:00", "driver-1"),
+		afternoon:      newSchedule("오후 하원", "15:00", "driver-2"),
+		today:          time.Date(kst.Year(), kst.Month(), kst.Day(), 0, 0, 0, 0, time.UTC),
+	}
+	generation := service.NewTripGenerationService(scheduleRepo, mocks.NewScheduleExceptionRepository(), assignmentRepo, mocks.NewAttendantAssignmentRepository(),
+		vehicleRepo, mocks.NewDriverRepository(), tripRepo, mocks.NewPassengerRepository(), service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+	f.svc = service.NewCalendarService(mocks.NewCalendarTokenRepository(), f.userRepo, tripRepo, scheduleRepo, routeRepo, vehicleRepo, generation, nil)
+	return f
+}
+
+// createUser - 계정 저장
+func (f *calendarFixture) createUser(t *testing.T, role domain.Role, profileID string) *domain.User {
+	user := domain.NewUser(string(role)+"-"+profileID+"@example.com", "hash", role, profileID)
+	require.NoError(t, f.userRepo.Create(context.Background(), user))
+	return user
+}
+
+// unfold - 접힌 줄을 이어 붙임 (검사 편의)
+func unfold(feed []byte) string {
+	return strings.ReplaceAll(string(feed), "\r\n ", "")
+}
+
+// TestCalendarService_Feed - 생성된 운행 + 운행 계획 (운행별 배정 변경, 대체 배정, 취소, 지난 운행 범위 반영)
+func TestCalendarService_Feed(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newCalendarFixture(t)
+	user := f.createUser(t, domain.RoleDriver, "driver-1")
+
+	require.NoError(t, f.tripRepo.Create(ctx, domain.NewTrip(f.morning.ID, f.today, f.vehicle.ID, "driver-1", nil)))
+	cancelled := domain.NewTrip(f.morning.ID, f.today.AddDate(0, 0, -1), f.vehicle.ID, "driver-1", nil)
+	require.NoError(t, cancelled.Cancel("차량 점검"))
+	require.NoError(t, f.tripRepo.Create(ctx, cancelled))
+	require.NoError(t, f.tripRepo.Create(ctx, domain.NewTrip(f.morning.ID, f.today.AddDate(0, 0, -10), f.vehicle.ID, "driver-1", nil)))
+	// 내일 오전 운행은 다른 기사로 변경됨
+	require.NoError(t, f.tripRepo.Create(ctx, domain.NewTrip(f.morning.ID, f.today.AddDate(0, 0, 1), f.vehicle.ID, "driver-3", nil)))
+	// 모레부터 이틀 동안 오후 운행 대체
+	assignment := domain.NewDriverAssignment(f.afternoon.ID, "driver-1", f.today.AddDate(0, 0, 2), f.today.AddDate(0, 0, 3), "원 담당자 휴가", "admin-1")
+	assignment.Approve("admin-1")
+	require.NoError(t, f.assignmentRepo.Create(ctx, assignment))
+
+	issued, err := f.svc.IssueToken(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/calendar/"+issued.Token+".ics", issued.Path)
+
+	// When
+	feed, err := f.svc.Feed(ctx, issued.Token)
+
+	// Then: 어제(취소) + 오늘 + 모레~13일 후 계획 12건 + 대체 2건
+	require.NoError(t, err)
+	body := unfold(feed)
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+	assert.Equal(t, 16, strings.Count(body, "BEGIN:VEVENT"))
+	assert.Equal(t, 1, strings.Count(body, "STATUS:CANCELLED"))
+	assert.Contains(t, body, "SUMMARY:[취소] 오전 등원")
+	assert.Contains(t, body, `취소 사유: 차량 점검`)
+	assert.Equal(t, 2, strings.Count(body, "SUMMARY:오후 하원 (대체)"))
+	assert.Contains(t, body, `경로: 1호차 등원\n차량: 12가3456 (스타렉스)`)
+	assert.NotContains(t, body, "UID:"+f.morning.ID+"-"+f.today.AddDate(0, 0, 1).Format("20060102"))
+
+	// 오늘 08:00 KST = 전날 23:00 UTC, 경로 예상 소요 40분
+	uid := "UID:" + f.morning.ID + "-" + f.today.Format("20060102") + "@eodini"
+	require.Contains(t, body, uid)
+	event := body[strings.Index(body, uid):]
+	event = event[:strings.Index(event, "END:VEVENT")]
+	assert.Contains(t, event, "DTSTART:"+f.today.AddDate(0, 0, -1).Format("20060102")+"T230000Z")
+	assert.Contains(t, event, "DTEND:"+f.today.AddDate(0, 0, -1).Format("20060102")+"T234000Z")
+	assert.Contains(t, event, "STATUS:CONFIRMED")
+}
+
+// TestCalendarService_Token - 재발급하면 이전 주소 무효, 해제 후 NOT_FOUND, 비활성/운영 인력이 아닌 계정 NOT_FOUND
+func TestCalendarService_Token(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newCalendarFixture(t)
+	driver := f.createUser(t, domain.RoleDriver, "driver-1")
+	first, err := f.svc.IssueToken(ctx, driver.ID)
+	require.NoError(t, err)
+
+	// When
+	second, err := f.svc.IssueToken(ctx, driver.ID)
+	require.NoError(t, err)
+
+	// Then
+	assert.NotEqual(t, first.Token, second.Token)
+	_, err = f.svc.Feed(ctx, first.Token)
+	assertAppError(t, err, util.ErrCodeNotFound)
+	_, err = f.svc.Feed(ctx, second.Token)
+	require.NoError(t, err)
+
+	// When: 구독 해제 (두 번째는 해제할 주소 없음)
+	require.NoError(t, f.svc.RevokeToken(ctx, driver.ID))
+	assertAppError(t, f.svc.RevokeToken(ctx, driver.ID), util.ErrCodeNotFound)
+
+	// Then
+	_, err = f.svc.Feed(ctx, second.Token)
+	assertAppError(t, err, util.ErrCodeNotFound)
+
+	tests := []struct {
+		name  string
+		setup func(user *domain.User)
+		role  domain.Role
+	}{
+		{name: "비활성 계정", role: domain.RoleDriver, setup: func(user *domain.User) { user.Disable() }},
+		{name: "보호자", role: domain.RoleGuardian, setup: func(user *domain.User) {}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := f.createUser(t, tt.role, "profile-"+string(tt.role))
+			tt.setup(user)
+			require.NoError(t, f.userRepo.Update(ctx, user))
+			issued, err := f.svc.IssueToken(ctx, user.ID)
+			require.NoError(t, err)
+
+			_, err = f.svc.Feed(ctx, issued.Token)
+
+			assertAppError(t, err, util.ErrCodeNotFound)
+		})
+	}
+}
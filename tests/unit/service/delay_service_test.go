@@ -0,0 +1,208 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// delayFixture - 지연 테스트용 의존성 (08:00 출발 일정, 경로에 배정된 형제 탑승자 2명 + 연결 없는 탑승자 1명)
+type delayFixture struct {
+	svc       *service.DelayService
+	tripRepo  *mocks.TripRepository
+	txManager *mocks.TxManager
+	notifier  *mocks.GuardianNotifier
+	events    *mocks.EventPublisher
+	schedule  *domain.Schedule
+	guardian  *domain.Guardian
+}
+
+// newDelayFixture - 기관 없는 운행은 플랫폼 운영 연락처 010-9999-0000, 지연 기준 10분
+func newDelayFixture(t *testing.T) *delayFixture {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	notifier := mocks.NewGuardianNotifier()
+
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	guardian := domain.NewGuardian("엄마", "010-0000-0000")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	for _, name := range []string{"김민준", "김서윤"} {
+		passenger := domain.NewPassenger(name, "엄마", "010-0000-0000")
+		passenger.AssignToStop("route-1", "stop-1", 1)
+		require.NoError(t, passengerRepo.Create(ctx, passenger))
+		require.NoError(t, guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+	}
+	unlinked := domain.NewPassenger("이서연", "이보호", "010-3333-4444")
+	unlinked.AssignToStop("route-1", "stop-2", 2)
+	require.NoError(t, passengerRepo.Create(ctx, unlinked))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	txManager := mocks.NewTxManager()
+	events := mocks.NewEventPublisher()
+	svc := service.NewDelayService(tripService, tripRepo, scheduleRepo, passengerRepo, guardianRepo, txManager, notifier, events,
+		service.NewOperatorContacts(mocks.NewUserRepository(), mocks.NewOrganizationRepository(), []string{"010-9999-0000"}), 10*time.Minute, nil)
+	// 알림은 이벤트 핸들러로 발송 (아웃박스 릴레이 대신 발행 즉시 전달)
+	events.Dispatcher = event.NewDispatcher()
+	events.Dispatcher.Subscribe("delay-notification", svc.HandleEvent, string(domain.WebhookEventTripDelayed))
+	return &delayFixture{
+		svc:       svc,
+		tripRepo:  tripRepo,
+		txManager: txManager,
+		notifier:  notifier,
+		events:    events,
+		schedule:  schedule,
+		guardian:  guardian,
+	}
+}
+
+// TestDelayService_Detect - 출발 예정 시각 후 기준 시간이 지나도 대기 중인 오늘 운행만 한 번 지연 표시 후 알림
+func TestDelayService_Detect(t *testing.T) {
+	// Given
+	f := newDelayFixture(t)
+	ctx := context.Background()
+	kst := time.FixedZone("KST", 9*60*60)
+	date := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	late := domain.NewTrip(f.schedule.ID, date, "vehicle-1", "driver-1", nil)
+	require.NoError(t, f.tripRepo.Create(ctx, late))
+	started := domain.NewTrip(f.schedule.ID, date, "vehicle-1", "driver-1", nil)
+	require.NoError(t, started.Start("driver:driver-1", nil))
+	require.NoError(t, f.tripRepo.Create(ctx, started))
+
+	// When - 08:05 (기준 전)
+	early, err := f.svc.Detect(ctx, time.Date(2025, 3, 3, 8, 5, 0, 0, kst))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, early)
+
+	// When - 08:11, 다시 감지해도 한 번만
+	marked, err := f.svc.Detect(ctx, time.Date(2025, 3, 3, 8, 11, 0, 0, kst))
+	again, _ := f.svc.Detect(ctx, time.Date(2025, 3, 3, 8, 12, 0, 0, kst))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, marked)
+	assert.Equal(t, 0, again)
+	stored, _ := f.tripRepo.GetByID(ctx, late.ID)
+	assert.True(t, stored.IsDelayed())
+	assert.Contains(t, stored.DelayReason, "08:00")
+	assert.Equal(t, []domain.WebhookEvent{domain.WebhookEventTripDelayed}, f.events.Types(), "지연 표시와 이벤트 기록은 한 번")
+	assert.Equal(t, late.ID, f.events.Events[0].AggregateID)
+
+	// 보호자는 자녀 수와 관계없이 한 번, 연결 없는 탑승자는 보호자 연락처, 관리자 연락처
+	sent := f.notifier.Sent()
+	require.Len(t, sent, 3)
+	assert.Equal(t, f.guardian.ID, sent[0].GuardianID)
+	assert.Equal(t, domain.NotificationEventDelay, sent[0].Event)
+	assert.Equal(t, "010-3333-4444", sent[1].Phone)
+	assert.Equal(t, "010-9999-0000", sent[2].Phone)
+	assert.Contains(t, sent[0].Notification.Body, "오전 8시 A코스")
+}
+
+// TestDelayService_DetectOrganizationTimezone - 출발 시각과 "오늘"은 운행 기관의 시간대 기준
+func TestDelayService_DetectOrganizationTimezone(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	organizationRepo := mocks.NewOrganizationRepository()
+	organization := domain.NewOrganization("Sunflower Academy", domain.OrganizationTypeAcademy)
+	organization.Timezone = "America/Los_Angeles"
+	require.NoError(t, organizationRepo.Create(ctx, organization))
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("Morning A", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	trip := domain.NewTrip(schedule.ID, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), "vehicle-1", "driver-1", nil)
+	trip.OrganizationID = organization.ID
+	require.NoError(t, tripRepo.Create(ctx, trip))
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	svc := service.NewDelayService(tripService, tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewGuardianRepository(), nil,
+		nil, nil, nil, 10*time.Minute, service.NewOrganizationService(organizationRepo))
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+
+	// When - 한국 시간 3/3 08:11은 기관 시간대로 3/2 (아직 운행 날짜 전)
+	kstMorning, err := svc.Detect(ctx, time.Date(2025, 3, 3, 8, 11, 0, 0, time.FixedZone("KST", 9*60*60)))
+	require.NoError(t, err)
+	localMorning, err := svc.Detect(ctx, time.Date(2025, 3, 3, 8, 11, 0, 0, losAngeles))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, kstMorning)
+	assert.Equal(t, 1, localMorning)
+}
+
+// TestDelayService_Report - 관리자/배정 기사의 지연 보고 (다른 기사는 403, 완료된 운행은 409), 저장과 이벤트 기록은 한 트랜잭션
+func TestDelayService_Report(t *testing.T) {
+	// Given
+	f := newDelayFixture(t)
+	trip := domain.NewTrip(f.schedule.ID, time.Now(), "vehicle-1", "driver-1", nil)
+	require.NoError(t, f.tripRepo.Create(context.Background(), trip))
+
+	// When
+	_, forbiddenErr := f.svc.Report(asPrincipal(domain.RoleDriver, "driver-2"), trip.ID, "차량 고장")
+	reported, err := f.svc.Report(asPrincipal(domain.RoleDriver, "driver-1"), trip.ID, "차량 고장")
+
+	// Then
+	assertAppError(t, forbiddenErr, util.ErrCodeForbidden)
+	require.NoError(t, err)
+	assert.True(t, reported.IsDelayed())
+	assert.Equal(t, "차량 고장", reported.DelayReason)
+	assert.Equal(t, 1, f.txManager.Calls)
+	assert.Equal(t, []domain.WebhookEvent{domain.WebhookEventTripDelayed}, f.events.Types())
+	assert.Len(t, f.notifier.Sent(), 3)
+
+	// When - 사유를 바꿔 다시 보고하면 처음 지연 시각 유지
+	firstDelayedAt := *reported.DelayedAt
+	updated, err := f.svc.Report(asPrincipal(domain.RoleAdmin, "admin-1"), trip.ID, "도로 공사")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "도로 공사", updated.DelayReason)
+	assert.Equal(t, firstDelayedAt, *updated.DelayedAt)
+	sent := f.notifier.Sent()
+	require.Len(t, sent, 6)
+	assert.Contains(t, sent[5].Notification.Body, "도로 공사")
+
+	// Given - 운행 완료
+	require.NoError(t, trip.Start("driver:driver-1", nil))
+	require.NoError(t, trip.ConfirmVehicleEmpty("driver:driver-1"))
+	require.NoError(t, trip.Complete(nil))
+	require.NoError(t, f.tripRepo.Update(context.Background(), trip))
+
+	// When
+	_, completedErr := f.svc.Report(asPrincipal(domain.RoleAdmin, "admin-1"), trip.ID, "지연")
+
+	// Then
+	assertAppError(t, completedErr, util.ErrCodeConflict)
+	assert.Len(t, f.events.Events, 2)
+}
+
+// TestDelayService_ReportEventFailure - 이벤트 기록에 실패하면 보고 실패 (알림 없이 저장만 되지 않도록)
+func TestDelayService_ReportEventFailure(t *testing.T) {
+	// Given
+	f := newDelayFixture(t)
+	trip := domain.NewTrip(f.schedule.ID, time.Now(), "vehicle-1", "driver-1", nil)
+	require.NoError(t, f.tripRepo.Create(context.Background(), trip))
+	f.events.Err = errors.New("outbox unavailable")
+
+	// When
+	_, err := f.svc.Report(asPrincipal(domain.RoleAdmin, "admin-1"), trip.ID, "차량 고장")
+
+	// Then
+	assertAppError(t, err, util.ErrCodeInternal)
+	assert.Empty(t, f.notifier.Sent())
+}
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/push"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeviceService_Register - 같은 토큰을 다른 계정으로 등록하면 소유자 이전
+func TestDeviceService_Register(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	repo := mocks.NewDeviceTokenRepository()
+	svc := service.NewDeviceService(repo, nil)
+	_, err := svc.Register(ctx, "user-1", &dto.RegisterDeviceRequest{Token: "token-a", Platform: "android"})
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.Register(ctx, "user-2", &dto.RegisterDeviceRequest{Token: "token-a", Platform: "android"})
+
+	// Then
+	require.NoError(t, err)
+	first, _ := repo.ListByUser(ctx, "user-1")
+	second, _ := repo.ListByUser(ctx, "user-2")
+	assert.Empty(t, first)
+	assert.Len(t, second, 1)
+
+	// When - 다른 사용자의 토큰 해제
+	err = svc.Unregister(ctx, "user-1", "token-a")
+
+	// Then
+	assertAppError(t, err, util.ErrCodeNotFound)
+	require.NoError(t, svc.Unregister(ctx, "user-2", "token-a"))
+}
+
+// TestDeviceService_Push - 모든 기기에 발송, 무효 토큰은 삭제하고 일시 오류 토큰은 유지
+func TestDeviceService_Push(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	repo := mocks.NewDeviceTokenRepository()
+	sender := mocks.NewPushSender()
+	sender.Errors["token-stale"] = push.ErrInvalidToken
+	sender.Errors["token-flaky"] = errors.New("push: unexpected status 503")
+	svc := service.NewDeviceService(repo, sender)
+	for _, token := range []string{"token-phone", "token-tablet", "token-stale", "token-flaky"} {
+		_, err := svc.Register(ctx, "guardian-1", &dto.RegisterDeviceRequest{Token: token, Platform: "ios"})
+		require.NoError(t, err)
+	}
+	msg := &push.Message{Title: "탑승 알림", Body: "민준이가 승차했습니다"}
+
+	// When
+	sent, err := svc.Push(ctx, "guardian-1", msg)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, []push.Message{*msg}, sender.Sent["token-phone"])
+	remaining, _ := repo.ListByUser(ctx, "guardian-1")
+	tokens := make([]string, len(remaining))
+	for i, device := range remaining {
+		tokens[i] = device.Token
+	}
+	assert.ElementsMatch(t, []string{"token-phone", "token-tablet", "token-flaky"}, tokens)
+}
+
+// TestDeviceService_Push_AllTransient - 모든 기기가 일시 오류로 실패하면 에러 반환 (알림 재시도 대상)
+func TestDeviceService_Push_AllTransient(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	sender := mocks.NewPushSender()
+	sender.Errors["token-flaky"] = errors.New("push: unexpected status 503")
+	svc := service.NewDeviceService(mocks.NewDeviceTokenRepository(), sender)
+	_, err := svc.Register(ctx, "guardian-1", &dto.RegisterDeviceRequest{Token: "token-flaky", Platform: "android"})
+	require.NoError(t, err)
+
+	// When
+	sent, err := svc.Push(ctx, "guardian-1", &push.Message{Title: "탑승 알림"})
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, 0, sent)
+}
+
+// TestDeviceService_Push_Disabled - 발송 클라이언트가 없으면 발송하지 않음
+func TestDeviceService_Push_Disabled(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	svc := service.NewDeviceService(mocks.NewDeviceTokenRepository(), nil)
+	_, err := svc.Register(ctx, "guardian-1", &dto.RegisterDeviceRequest{Token: "token-a", Platform: "web"})
+	require.NoError(t, err)
+
+	// When
+	sent, err := svc.Push(ctx, "guardian-1", &push.Message{Title: "탑승 알림"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+}
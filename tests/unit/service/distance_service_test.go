@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var distanceStart = time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+
+// trackPoint - trip-1의 seconds초 시점 위치 (위도 0.001도 ≈ 111m)
+func trackPoint(seconds int, lat, speed float64) *domain.VehiclePosition {
+	return &domain.VehiclePosition{
+		TripID:     "trip-1",
+		Latitude:   lat,
+		Longitude:  127.0,
+		Speed:      &speed,
+		RecordedAt: distanceStart.Add(time.Duration(seconds) * time.Second),
+	}
+}
+
+// TestDistanceService_ObserveLocation - 구간 거리 누적, GPS 튐과 정차 중 흔들림은 제외
+func TestDistanceService_ObserveLocation(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	require.NoError(t, tripRepo.Create(ctx, &domain.Trip{ID: "trip-1", Status: domain.TripStatusInProgress}))
+	svc := service.NewDistanceService(tripRepo, mocks.NewTripLocationRepository())
+	trip, err := tripRepo.GetByID(ctx, "trip-1")
+	require.NoError(t, err)
+
+	p0 := trackPoint(0, 37.500, 30)
+	p1 := trackPoint(20, 37.501, 30)  // 111m
+	p2 := trackPoint(40, 37.521, 30)  // 20초에 2.2km (약 400km/h) → 튐
+	p3 := trackPoint(60, 37.502, 0)   // 튄 위치에서 돌아오는 구간도 제외
+	p4 := trackPoint(80, 37.50201, 0) // 정차 중 흔들림
+	p5 := trackPoint(100, 37.503, 25) // 110m
+
+	// When
+	svc.ObserveLocation(ctx, trip, nil, p0)
+	svc.ObserveLocation(ctx, trip, p0, p1)
+	svc.ObserveLocation(ctx, trip, p1, p2)
+	svc.ObserveLocation(ctx, trip, p2, p3)
+	svc.ObserveLocation(ctx, trip, p3, p4)
+	svc.ObserveLocation(ctx, trip, p4, p5)
+
+	// Then
+	stored, err := tripRepo.GetByID(ctx, "trip-1")
+	require.NoError(t, err)
+	assert.InDelta(t, 221, stored.TotalDistance, 2)
+	assert.Equal(t, stored.TotalDistance, trip.TotalDistance)
+}
+
+// TestDistanceService_CompleteTrip - 운행 완료 시 기록된 전체 경로로 주행 거리 확정
+func TestDistanceService_CompleteTrip(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	tripRepo := mocks.NewTripRepository()
+	locationRepo := mocks.NewTripLocationRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	distanceService := service.NewDistanceService(tripRepo, locationRepo)
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil, distanceService)
+	trip, err := tripService.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2025-03-03"})
+	require.NoError(t, err)
+	_, err = tripService.Start(ctx, trip.ID, &dto.TripLocationRequest{})
+	require.NoError(t, err)
+
+	// 누적 중 빠진 구간(튄 위치 전후)이 있어도 완료 시 튄 위치만 건너뛰고 전체 경로로 보정
+	for i, lat := range []float64{37.500, 37.501, 37.521, 37.502, 37.503} {
+		position := trackPoint(i*20, lat, 30)
+		position.TripID = trip.ID
+		require.NoError(t, locationRepo.Create(ctx, position))
+	}
+	require.NoError(t, tripRepo.AddDistance(ctx, trip.ID, 111))
+	_, err = tripService.ConfirmVehicleEmpty(ctx, trip.ID)
+	require.NoError(t, err)
+
+	// When
+	completed, err := tripService.Complete(ctx, trip.ID, &dto.CompleteTripRequest{})
+
+	// Then
+	require.NoError(t, err)
+	assert.InDelta(t, 334, completed.TotalDistance, 2)
+	stored, err := tripRepo.GetByID(ctx, trip.ID)
+	require.NoError(t, err)
+	assert.Equal(t, completed.TotalDistance, stored.TotalDistance)
+
+	// When - 완료 후에는 더 이상 누적되지 않음
+	require.NoError(t, tripRepo.AddDistance(ctx, trip.ID, 500))
+
+	// Then
+	stored, err = tripRepo.GetByID(ctx, trip.ID)
+	require.NoError(t, err)
+	assert.Equal(t, completed.TotalDistance, stored.TotalDistance)
+}
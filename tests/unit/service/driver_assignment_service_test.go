@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDriverAssignmentService - 같은 일정에 기간이 겹치는 기사 대체 배정 거부 (양 끝 날짜 포함)
+func TestDriverAssignmentService(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleAdmin, "admin-1")
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	other := domain.NewSchedule("오전 8시 B코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-2", "vehicle-2", "driver-2")
+	require.NoError(t, scheduleRepo.Create(ctx, other))
+	driverRepo := mocks.NewDriverRepository()
+	substitute := domain.NewDriver("김대체", "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	substitute.VerifyLicense("admin-1", time.Now())
+	require.NoError(t, driverRepo.Create(ctx, substitute))
+	terminated := domain.NewDriver("이퇴사", "010-8765-4321", "11-22-333333-55", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	terminated.Terminate(time.Now())
+	require.NoError(t, driverRepo.Create(ctx, terminated))
+	unverified := domain.NewDriver("박신입", "010-5555-6666", "11-22-333333-66", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	require.NoError(t, driverRepo.Create(ctx, unverified))
+	svc := service.NewDriverAssignmentService(mocks.NewDriverAssignmentRepository(), scheduleRepo, driverRepo)
+	request := func(driverID, start, end string) *dto.CreateDriverAssignmentRequest {
+		return &dto.CreateDriverAssignmentRequest{DriverID: driverID, StartDate: start, EndDate: end, Reason: "원 담당자 휴가"}
+	}
+
+	// When
+	assignment, err := svc.Create(ctx, schedule.ID, request(substitute.ID, "2025-03-10", "2025-03-14"))
+	require.NoError(t, err)
+	_, containsErr := svc.Create(ctx, schedule.ID, request(substitute.ID, "2025-03-11", "2025-03-12"))
+	_, coversErr := svc.Create(ctx, schedule.ID, request(substitute.ID, "2025-03-01", "2025-03-31"))
+	_, edgeErr := svc.Create(ctx, schedule.ID, request(substitute.ID, "2025-03-03", "2025-03-10"))
+	_, terminatedErr := svc.Create(ctx, schedule.ID, request(terminated.ID, "2025-04-01", "2025-04-02"))
+	_, unverifiedErr := svc.Create(ctx, schedule.ID, request(unverified.ID, "2025-04-01", "2025-04-02"))
+	_, reversedErr := svc.Create(ctx, schedule.ID, request(substitute.ID, "2025-04-10", "2025-04-01"))
+	_, afterErr := svc.Create(ctx, schedule.ID, request(substitute.ID, "2025-03-15", "2025-03-16"))
+	_, otherScheduleErr := svc.Create(ctx, other.ID, request(substitute.ID, "2025-03-10", "2025-03-14"))
+
+	// Then
+	assert.Equal(t, "2025-03-10", assignment.StartDate.Format("2006-01-02"))
+	assert.Equal(t, "user-admin-1", assignment.CreatedBy)
+	assertAppError(t, containsErr, util.ErrCodeConflict)
+	assertAppError(t, coversErr, util.ErrCodeConflict)
+	assertAppError(t, edgeErr, util.ErrCodeConflict)
+	assertAppError(t, terminatedErr, util.ErrCodeValidation)
+	assertAppError(t, unverifiedErr, util.ErrCodeValidation)
+	assertAppError(t, reversedErr, util.ErrCodeValidation)
+	assert.NoError(t, afterErr)
+	assert.NoError(t, otherScheduleErr, "다른 일정의 배정과는 겹쳐도 됨")
+
+	var appErr *util.AppError
+	require.ErrorAs(t, containsErr, &appErr)
+	conflicts := appErr.Details["conflicts"].([]*domain.DriverAssignment)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, assignment.ID, conflicts[0].ID)
+
+	// When: 삭제하면 같은 기간에 다시 배정 가능
+	_, err = svc.Approve(ctx, schedule.ID, assignment.ID)
+	require.NoError(t, err)
+	require.NoError(t, svc.Delete(ctx, schedule.ID, assignment.ID))
+	_, err = svc.Create(ctx, schedule.ID, request(substitute.ID, "2025-03-11", "2025-03-12"))
+
+	// Then
+	assert.NoError(t, err)
+	assignments, err := svc.List(context.Background(), schedule.ID)
+	require.NoError(t, err)
+	assert.Len(t, assignments, 2)
+}
+
+// TestDriverAssignment_TakesPrecedenceOver - 겹치는 배정은 승인된 배정 → 나중에 승인된 배정 → 나중에 생성된 배정 우선
+func TestDriverAssignment_TakesPrecedenceOver(t *testing.T) {
+	// Given
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	earlier := domain.NewDriverAssignment("schedule-1", "driver-a", day, day, "", "")
+	later := domain.NewDriverAssignment("schedule-1", "driver-b", day, day, "", "")
+	later.CreatedAt = earlier.CreatedAt.Add(time.Minute)
+
+	// Then: 둘 다 미승인이면 나중에 생성된 배정
+	assert.True(t, later.TakesPrecedenceOver(earlier))
+	assert.False(t, earlier.TakesPrecedenceOver(later))
+
+	// When: 먼저 생성된 배정만 승인
+	earlier.Approve("admin-1")
+
+	// Then
+	assert.True(t, earlier.TakesPrecedenceOver(later))
+
+	// When: 나중에 생성된 배정을 더 먼저 승인
+	later.Approve("admin-1")
+	approvedEarlier := earlier.ApprovedAt.Add(-time.Hour)
+	later.ApprovedAt = &approvedEarlier
+
+	// Then: 승인 시각이 늦은 배정 우선
+	assert.True(t, earlier.TakesPrecedenceOver(later))
+	assert.False(t, later.TakesPrecedenceOver(earlier))
+}
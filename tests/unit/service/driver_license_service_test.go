@@ -0,0 +1,66 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDriverLicenseService - 기사 본인/관리자만 업로드·다운로드, 새 스캔은 확인 대기 + 이전 스캔 삭제
+func TestDriverLicenseService(t *testing.T) {
+	// Given
+	admin := asPrincipal(domain.RoleAdmin, "admin-1")
+	driverRepo := mocks.NewDriverRepository()
+	driver := domain.NewDriver("김기사", "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	driver.VerifyLicense("user-admin-1", time.Now())
+	require.NoError(t, driverRepo.Create(admin, driver))
+	self := asPrincipal(domain.RoleDriver, driver.ID)
+	other := asPrincipal(domain.RoleDriver, "driver-2")
+	attendant := asPrincipal(domain.RoleAttendant, driver.ID)
+	fileRepo := mocks.NewFileRepository()
+	svc := service.NewDriverLicenseService(driverRepo, service.NewFileService(fileRepo, storage.NewLocalStorage(t.TempDir())))
+
+	// When
+	_, _, noScanErr := svc.DownloadScan(self, driver.ID)
+	first, err := svc.UploadScan(self, driver.ID, "면허증.png", samplePNG)
+	require.NoError(t, err)
+	firstFileID := *first.LicenseFileID
+	_, otherErr := svc.UploadScan(other, driver.ID, "면허증.png", samplePNG)
+	_, attendantErr := svc.UploadScan(attendant, driver.ID, "면허증.png", samplePNG)
+	_, textErr := svc.UploadScan(self, driver.ID, "면허증.pdf", []byte("plain text"))
+	second, err := svc.UploadScan(admin, driver.ID, "면허증-재촬영.pdf", samplePDF)
+
+	// Then
+	assertAppError(t, noScanErr, util.ErrCodeNotFound)
+	require.NoError(t, err)
+	assert.Equal(t, domain.LicenseStatusPending, first.LicenseStatus, "새 스캔은 다시 확인 대기")
+	assert.Nil(t, first.LicenseReviewedAt)
+	assertAppError(t, otherErr, util.ErrCodeForbidden)
+	assertAppError(t, attendantErr, util.ErrCodeForbidden)
+	assertAppError(t, textErr, util.ErrCodeValidation)
+	require.NotNil(t, second.LicenseFileID)
+	assert.NotEqual(t, firstFileID, *second.LicenseFileID)
+	_, err = fileRepo.GetByID(admin, firstFileID)
+	assert.ErrorIs(t, err, repository.ErrNotFound, "이전 스캔은 삭제")
+
+	// When
+	file, data, err := svc.DownloadScan(self, driver.ID)
+	_, _, otherDownloadErr := svc.DownloadScan(other, driver.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "면허증-재촬영.pdf", file.FileName)
+	assert.Equal(t, samplePDF, data)
+	assertAppError(t, otherDownloadErr, util.ErrCodeForbidden)
+	stored, err := driverRepo.GetByID(admin, driver.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.LicenseStatusPending, stored.LicenseStatus)
+}
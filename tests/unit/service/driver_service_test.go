@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDriverRequest - 테스트용 기사 등록 요청
+func newDriverRequest(name, licenseNumber string, expiry time.Time) *dto.CreateDriverRequest {
+	return &dto.CreateDriverRequest{
+		Name:          name,
+		Phone:         "010-1234-5678",
+		LicenseNumber: licenseNumber,
+		LicenseType:   "type_1_large",
+		LicenseExpiry: expiry,
+	}
+}
+
+// TestDriverService_Create_DuplicateLicense - 면허 번호 중복
+func TestDriverService_Create_DuplicateLicense(t *testing.T) {
+	// Given
+	svc := service.NewDriverService(mocks.NewDriverRepository())
+	expiry := time.Now().AddDate(2, 0, 0)
+	_, err := svc.Create(context.Background(), newDriverRequest("김기사", "11-22-333333-44", expiry))
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.Create(context.Background(), newDriverRequest("이기사", "11-22-333333-44", expiry))
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeDuplicate, appErr.Code)
+}
+
+// TestDriverService_ChangeStatus - 휴가 → 복귀
+func TestDriverService_ChangeStatus(t *testing.T) {
+	// Given
+	svc := service.NewDriverService(mocks.NewDriverRepository())
+	driver, err := svc.Create(context.Background(), newDriverRequest("김기사", "11-22-333333-44", time.Now().AddDate(1, 0, 0)))
+	require.NoError(t, err)
+
+	// When
+	onLeave, err := svc.ChangeStatus(context.Background(), driver.ID, domain.DriverStatusOnLeave)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.DriverStatusOnLeave, onLeave.Status)
+	assert.False(t, onLeave.IsAvailableForTrip())
+}
+
+// TestDriverService_Terminate - 퇴사 후 상태 변경 불가
+func TestDriverService_Terminate(t *testing.T) {
+	// Given
+	svc := service.NewDriverService(mocks.NewDriverRepository())
+	driver, err := svc.Create(context.Background(), newDriverRequest("김기사", "11-22-333333-44", time.Now().AddDate(1, 0, 0)))
+	require.NoError(t, err)
+
+	// When
+	terminated, err := svc.Terminate(context.Background(), driver.ID, nil)
+	require.NoError(t, err)
+	_, statusErr := svc.ChangeStatus(context.Background(), driver.ID, domain.DriverStatusActive)
+	_, terminateErr := svc.Terminate(context.Background(), driver.ID, nil)
+
+	// Then
+	assert.Equal(t, domain.DriverStatusInactive, terminated.Status)
+	assert.True(t, terminated.IsTerminated())
+	assert.Equal(t, util.ErrCodeConflict, statusErr.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeConflict, terminateErr.(*util.AppError).Code)
+}
+
+// TestDriverService_List_LicenseExpiring - 면허 만료 임박 필터
+func TestDriverService_List_LicenseExpiring(t *testing.T) {
+	// Given
+	svc := service.NewDriverService(mocks.NewDriverRepository())
+	_, _ = svc.Create(context.Background(), newDriverRequest("곧만료", "A-1", time.Now().AddDate(0, 0, 10)))
+	_, _ = svc.Create(context.Background(), newDriverRequest("여유", "A-2", time.Now().AddDate(1, 0, 0)))
+	within := 30
+
+	// When
+	drivers, total, err := svc.List(context.Background(), repository.DriverFilter{LicenseExpiringWithin: &within})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "곧만료", drivers[0].Name)
+	assert.True(t, drivers[0].NeedsLicenseRenewal())
+}
+
+// TestDriverService_ReviewLicense - 확인/반려(사유 필수), 만료 면허 확인 불가, 면허 정보 변경 시 다시 확인 대기
+func TestDriverService_ReviewLicense(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleAdmin, "admin-1")
+	svc := service.NewDriverService(mocks.NewDriverRepository())
+	driver, err := svc.Create(ctx, newDriverRequest("김기사", "11-22-333333-44", time.Now().AddDate(1, 0, 0)))
+	require.NoError(t, err)
+	expired, err := svc.Create(ctx, newDriverRequest("이기사", "11-22-333333-55", time.Now().AddDate(0, 0, -1)))
+	require.NoError(t, err)
+
+	// When
+	_, noReasonErr := svc.ReviewLicense(ctx, driver.ID, &dto.ReviewLicenseRequest{Status: "rejected", Reason: " "})
+	rejected, rejectErr := svc.ReviewLicense(ctx, driver.ID, &dto.ReviewLicenseRequest{Status: "rejected", Reason: "스캔 식별 불가"})
+	require.NoError(t, rejectErr)
+	rejectReason := rejected.LicenseRejectReason
+	verified, err := svc.ReviewLicense(ctx, driver.ID, &dto.ReviewLicenseRequest{Status: "verified"})
+	_, expiredErr := svc.ReviewLicense(ctx, expired.ID, &dto.ReviewLicenseRequest{Status: "verified"})
+
+	// Then
+	assert.Equal(t, domain.LicenseStatusPending, expired.LicenseStatus, "신규 기사는 확인 대기")
+	assertAppError(t, noReasonErr, util.ErrCodeValidation)
+	assert.Equal(t, "스캔 식별 불가", rejectReason)
+	require.NoError(t, err)
+	assert.Equal(t, domain.LicenseStatusVerified, verified.LicenseStatus)
+	assert.Equal(t, "user-admin-1", verified.LicenseReviewedBy)
+	assert.Empty(t, verified.LicenseRejectReason)
+	assert.True(t, verified.IsAvailableForTrip())
+	assertAppError(t, expiredErr, util.ErrCodeConflict)
+
+	// When - 같은 값으로 수정하면 그대로, 만료일이 바뀌면 다시 확인 대기
+	unchanged, err := svc.UpdateLicense(ctx, driver.ID, &dto.UpdateLicenseRequest{LicenseNumber: &verified.LicenseNumber})
+	require.NoError(t, err)
+	unchangedStatus := unchanged.LicenseStatus
+	renewal := time.Now().AddDate(5, 0, 0)
+	renewed, err := svc.UpdateLicense(ctx, driver.ID, &dto.UpdateLicenseRequest{LicenseExpiry: &renewal})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.LicenseStatusVerified, unchangedStatus)
+	assert.Equal(t, domain.LicenseStatusPending, renewed.LicenseStatus)
+	assert.Nil(t, renewed.LicenseReviewedAt)
+	assert.False(t, renewed.IsAvailableForTrip())
+}
@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEmailService_SendPasswordReset - 계정 이메일로 재설정 코드와 유효 시간 발송
+func TestEmailService_SendPasswordReset(t *testing.T) {
+	// Given
+	sender := mocks.NewEmailSender()
+	svc := service.NewEmailService(sender, []string{"admin@eodini.kr"}, 30*time.Minute)
+
+	// When
+	err := svc.SendPasswordReset(context.Background(), &domain.User{Email: "driver@eodini.kr"}, "reset-token-123")
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, sender.Sent, 1)
+	assert.Equal(t, []string{"driver@eodini.kr"}, sender.Sent[0].To)
+	assert.Equal(t, "[어디니] 비밀번호 재설정 안내", sender.Sent[0].Subject)
+	assert.Contains(t, sender.Sent[0].HTML, "reset-token-123")
+	assert.Contains(t, sender.Sent[0].HTML, "30분 동안")
+}
+
+// TestEmailService_SendInvitation - 초대 이메일로 기관명, 역할, 초대 코드 발송 (이메일 없는 초대는 에러)
+func TestEmailService_SendInvitation(t *testing.T) {
+	// Given
+	sender := mocks.NewEmailSender()
+	svc := service.NewEmailService(sender, nil, 30*time.Minute)
+	organization := domain.NewOrganization("해바라기 유치원", domain.OrganizationTypeKindergarten)
+	invitation := domain.NewInvitation(domain.RoleDriver, "driver-1", "hash", "admin-1", 72*time.Hour)
+	address := "new-driver@eodini.kr"
+	invitation.Email = &address
+	byPhone := domain.NewInvitation(domain.RoleDriver, "driver-1", "hash", "admin-1", 72*time.Hour)
+
+	// When
+	err := svc.SendInvitation(context.Background(), invitation, organization, "invite-token-123")
+	noEmail := svc.SendInvitation(context.Background(), byPhone, organization, "invite-token-456")
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, sender.Sent, 1)
+	assert.Equal(t, []string{address}, sender.Sent[0].To)
+	assert.Equal(t, "[어디니] 해바라기 유치원 초대 안내", sender.Sent[0].Subject)
+	assert.Contains(t, sender.Sent[0].HTML, "기사 계정으로 초대")
+	assert.Contains(t, sender.Sent[0].HTML, "invite-token-123")
+	assert.Contains(t, sender.Sent[0].HTML, "72시간 동안")
+	assert.Error(t, noEmail)
+}
+
+// TestEmailService_LocalizedTemplates - 계정 언어 설정 또는 요청 언어의 템플릿으로 발송
+func TestEmailService_LocalizedTemplates(t *testing.T) {
+	// Given
+	sender := mocks.NewEmailSender()
+	svc := service.NewEmailService(sender, nil, time.Hour)
+	organization := domain.NewOrganization("Sunflower Kindergarten", domain.OrganizationTypeKindergarten)
+	invitation := domain.NewInvitation(domain.RoleAdmin, "", "hash", "admin-1", 30*time.Minute)
+	address := "new-admin@eodini.kr"
+	invitation.Email = &address
+	english := util.WithLocale(context.Background(), util.LocaleEnglish)
+
+	// When: 계정 설정(en)이 요청 언어(ko)보다 우선, 초대는 요청 언어
+	resetErr := svc.SendPasswordReset(context.Background(), &domain.User{Email: "driver@eodini.kr", Locale: "en"}, "reset-token-123")
+	inviteErr := svc.SendInvitation(english, invitation, organization, "invite-token-123")
+
+	// Then
+	require.NoError(t, resetErr)
+	require.NoError(t, inviteErr)
+	require.Len(t, sender.Sent, 2)
+	assert.Equal(t, "[Eodini] Reset your password", sender.Sent[0].Subject)
+	assert.Contains(t, sender.Sent[0].HTML, "within 1 hour")
+	assert.Contains(t, sender.Sent[0].HTML, `<html lang="en">`)
+	assert.Equal(t, "[Eodini] You're invited to Sunflower Kindergarten", sender.Sent[1].Subject)
+	assert.Contains(t, sender.Sent[1].HTML, "join as Administrator")
+	assert.Contains(t, sender.Sent[1].HTML, "within 30 minutes")
+}
+
+// TestEmailService_SendDocumentExpiry - 수신자를 지정하지 않으면 관리자에게, 만료된 서류는 만료됨 표기
+func TestEmailService_SendDocumentExpiry(t *testing.T) {
+	// Given
+	sender := mocks.NewEmailSender()
+	svc := service.NewEmailService(sender, []string{"admin@eodini.kr"}, time.Hour)
+	items := []service.DocumentExpiry{
+		{Document: "운전면허", Holder: "김기사", ExpiresAt: time.Now().AddDate(0, 0, 14)},
+		{Document: "자동차 보험", Holder: "12가3456", ExpiresAt: time.Now().AddDate(0, 0, -2)},
+	}
+
+	// When
+	err := svc.SendDocumentExpiry(context.Background(), nil, items)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, sender.Sent, 1)
+	msg := sender.Sent[0]
+	assert.Equal(t, []string{"admin@eodini.kr"}, msg.To)
+	assert.Equal(t, "[어디니] 서류 만료 예정 안내 (2건)", msg.Subject)
+	assert.Contains(t, msg.HTML, "김기사")
+	assert.Contains(t, msg.HTML, "14일")
+	assert.Contains(t, msg.HTML, "만료됨")
+}
+
+// TestEmailService_SendAdminReport - 보고서 항목을 관리자 전원에게 발송
+func TestEmailService_SendAdminReport(t *testing.T) {
+	// Given
+	sender := mocks.NewEmailSender()
+	svc := service.NewEmailService(sender, []string{"admin@eodini.kr", "ops@eodini.kr"}, time.Hour)
+
+	// When
+	err := svc.SendAdminReport(context.Background(), &service.AdminReport{
+		Title:  "일일 운행 보고서",
+		Period: "2025-03-03",
+		Rows:   []service.AdminReportRow{{Label: "완료 운행", Value: "12건"}, {Label: "위험 운전 경보", Value: "3건"}},
+		Notes:  []string{"A코스 08:00 운행 15분 지연"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, sender.Sent, 1)
+	assert.Equal(t, []string{"admin@eodini.kr", "ops@eodini.kr"}, sender.Sent[0].To)
+	assert.Equal(t, "[어디니] 일일 운행 보고서 (2025-03-03)", sender.Sent[0].Subject)
+	assert.Contains(t, sender.Sent[0].HTML, "완료 운행")
+	assert.Contains(t, sender.Sent[0].HTML, "15분 지연")
+}
+
+// TestEmailService_Disabled - 발송 클라이언트가 없으면 ErrEmailDisabled
+func TestEmailService_Disabled(t *testing.T) {
+	// Given
+	svc := service.NewEmailService(nil, []string{"admin@eodini.kr"}, time.Hour)
+
+	// When
+	err := svc.SendAdminReport(context.Background(), &service.AdminReport{Title: "일일 운행 보고서"})
+
+	// Then
+	assert.False(t, svc.Enabled())
+	assert.ErrorIs(t, err, service.ErrEmailDisabled)
+}
@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withStops - route-1에 북쪽으로 약 1.1km 간격 정류장 3개 등록
+func (f *trackingFixture) withStops(t *testing.T) {
+	route := domain.NewRoute("A코스", "", 30)
+	route.ID = "route-1"
+	for i := 1; i <= 3; i++ {
+		route.Stops = append(route.Stops, *domain.NewStop(route.ID, "정류장", "", i, 37.5+float64(i-1)*0.01, 127.0, 0))
+	}
+	require.NoError(t, f.routeRepo.Create(context.Background(), route))
+}
+
+// reportAt - 배정 기사로 위치 전송
+func (f *trackingFixture) reportAt(t *testing.T, lat float64) {
+	_, err := f.svc.ReportLocation(asPrincipal(domain.RoleDriver, "driver-1"), f.trip.ID, &dto.ReportLocationRequest{Latitude: &lat, Longitude: floatPtr(127.0)})
+	require.NoError(t, err)
+}
+
+// TestStraightLineEstimator - 직선 거리 × 1.3 ÷ 속도 + 앞 정류장 정차 시간 누적
+func TestStraightLineEstimator(t *testing.T) {
+	// Given: 36km/h = 10m/s
+	estimator := service.StraightLineEstimator{Speed: 36, StopDwell: time.Minute}
+	origin := geo.Point{Latitude: 37.5, Longitude: 127.0}
+	stops := []geo.Point{{Latitude: 37.51, Longitude: 127.0}, {Latitude: 37.52, Longitude: 127.0}}
+
+	// When
+	times, err := estimator.TravelTimes(context.Background(), origin, stops)
+
+	// Then: 구간 약 1112m → 약 145초
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	assert.InDelta(t, 145, times[0].Seconds(), 1)
+	assert.InDelta(t, 145*2+60, times[1].Seconds(), 2)
+}
+
+// TestETAService_GetETA - 도착한 정류장은 0분, 남은 정류장은 순서대로 누적, 결과는 캐시
+func TestETAService_GetETA(t *testing.T) {
+	// Given: 첫 정류장 도착
+	f := newTrackingFixture(t)
+	f.withStops(t)
+	f.startTrip(t)
+	f.reportAt(t, 37.5001)
+	f.linkChild(t, "guardian-1", "route-1")
+	etaRepo := mocks.NewTripETARepository()
+	svc := service.NewETAService(f.svc, etaRepo, nil, time.Minute)
+	guardianCtx := asPrincipal(domain.RoleGuardian, "guardian-1")
+
+	// When
+	eta, err := svc.GetETA(guardianCtx, f.trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, eta.Stops, 3)
+	assert.True(t, eta.Stops[0].Arrived)
+	assert.Equal(t, 0, eta.Stops[0].Minutes)
+	assert.InDelta(t, 1123, eta.Stops[1].Distance, 5) // 정류장 1까지 약 11m + 구간 약 1112m
+	assert.Equal(t, 5, eta.Stops[1].Minutes)          // 약 1.1km × 1.3 ÷ 20km/h + 정차 30초 ≈ 4.8분
+	assert.Greater(t, eta.Stops[2].Minutes, eta.Stops[1].Minutes)
+	assert.Equal(t, 37.5001, eta.Position.Latitude)
+
+	// When: 캐시 기간 안에 다시 조회
+	cached, err := svc.GetETA(guardianCtx, f.trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, eta.CalculatedAt, cached.CalculatedAt)
+	assert.Equal(t, 1, etaRepo.Saves)
+}
+
+// TestETAService_GetETA_SkipsDepartedStops - 출발한 정류장은 남은 목록에서 제외
+func TestETAService_GetETA_SkipsDepartedStops(t *testing.T) {
+	// Given: 첫 정류장 도착 후 출발
+	f := newTrackingFixture(t)
+	f.withStops(t)
+	f.startTrip(t)
+	f.reportAt(t, 37.5)
+	f.reportAt(t, 37.503)
+	svc := service.NewETAService(f.svc, mocks.NewTripETARepository(), nil, time.Minute)
+
+	// When
+	eta, err := svc.GetETA(asPrincipal(domain.RoleAdmin, ""), f.trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, eta.Stops, 2)
+	assert.Equal(t, 2, eta.Stops[0].StopOrder)
+	assert.False(t, eta.Stops[0].Arrived)
+}
+
+// TestETAService_GetETA_Errors - 권한 없음, 운행 중 아님, 위치 없음
+func TestETAService_GetETA_Errors(t *testing.T) {
+	// Given
+	f := newTrackingFixture(t)
+	svc := service.NewETAService(f.svc, mocks.NewTripETARepository(), nil, time.Minute)
+	adminCtx := asPrincipal(domain.RoleAdmin, "")
+
+	// When & Then: 탑승 자녀가 없는 보호자
+	_, err := svc.GetETA(asPrincipal(domain.RoleGuardian, "guardian-9"), f.trip.ID)
+	var appErr *util.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, util.ErrCodeForbidden, appErr.Code)
+
+	// When & Then: 운행 시작 전
+	_, err = svc.GetETA(adminCtx, f.trip.ID)
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+
+	// When & Then: 시작했지만 위치 수신 전
+	f.startTrip(t)
+	eta, err := svc.GetETA(adminCtx, f.trip.ID)
+	require.NoError(t, err)
+	assert.Nil(t, eta.Position)
+	assert.Empty(t, eta.Stops)
+}
@@ -0,0 +1,188 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expiryEmail - 관리자 만료 안내 메일 한 통
+type expiryEmail struct {
+	to    []string
+	items []service.DocumentExpiry
+}
+
+// recordingExpiryEmails - 관리자 만료 안내 메일 기록 (err를 지정하면 발송 실패)
+type recordingExpiryEmails struct {
+	sent []expiryEmail
+	err  error
+}
+
+func (e *recordingExpiryEmails) SendDocumentExpiry(ctx context.Context, to []string, items []service.DocumentExpiry) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.sent = append(e.sent, expiryEmail{to: to, items: items})
+	return nil
+}
+
+// expiryFixture - 해오름 기관: 면허 만료 10일 전(14일 구간) 기사, 보험 2일/정기검사 20일 전 차량을 쓰는 일정의 기사
+// 새싹 기관(관리자 없음): 면허 만료 5일 전(14일 구간) 기사
+type expiryFixture struct {
+	svc      *service.ExpiryReminderService
+	emails   *recordingExpiryEmails
+	notifier *mocks.GuardianNotifier
+	expiring *domain.Driver
+	vehicle  *domain.Vehicle
+}
+
+// newExpiryFixture - 해오름 관리자(admin@haeoreum.kr, 010-9999-9999), 플랫폼 운영 연락처 010-0000-9999
+func newExpiryFixture(t *testing.T) *expiryFixture {
+	ctx := context.Background()
+	now := time.Now()
+	driverRepo := mocks.NewDriverRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	userRepo := mocks.NewUserRepository()
+	haeoreum := domain.NewOrganization("해오름 어린이집", domain.OrganizationTypeKindergarten)
+	saessak := domain.NewOrganization("새싹 학원", domain.OrganizationTypeAcademy)
+
+	expiring := domain.NewDriver("김만료", "010-1111-1111", "11-22-333333-44", domain.LicenseType1Large, now.AddDate(0, 0, 10))
+	regular := domain.NewDriver("이기사", "010-2222-2222", "11-22-333333-55", domain.LicenseType1Large, now.AddDate(0, 0, 60))
+	retired := domain.NewDriver("박퇴사", "010-3333-3333", "11-22-333333-66", domain.LicenseType1Large, now.AddDate(0, 0, 2))
+	retired.Status = domain.DriverStatusInactive
+	other := domain.NewDriver("최새싹", "010-4444-4444", "11-22-333333-77", domain.LicenseType1Large, now.AddDate(0, 0, 5))
+	for _, driver := range []*domain.Driver{expiring, regular, retired} {
+		driver.OrganizationID = haeoreum.ID
+	}
+	other.OrganizationID = saessak.ID
+	for _, driver := range []*domain.Driver{expiring, regular, retired, other} {
+		require.NoError(t, driverRepo.Create(ctx, driver))
+	}
+
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "white")
+	vehicle.OrganizationID = haeoreum.ID
+	vehicle.UpdateInsuranceExpiry(now.AddDate(0, 0, 2))
+	vehicle.UpdateInspectionExpiry(now.AddDate(0, 0, 20))
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	require.NoError(t, scheduleRepo.Create(ctx, domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", vehicle.ID, regular.ID)))
+
+	admin := domain.NewUser("admin@haeoreum.kr", "hash", domain.RoleAdmin, "")
+	admin.OrganizationID, admin.Phone = haeoreum.ID, "010-9999-9999"
+	require.NoError(t, userRepo.Create(ctx, admin))
+
+	emails := &recordingExpiryEmails{}
+	notifier := mocks.NewGuardianNotifier()
+	return &expiryFixture{
+		svc: service.NewExpiryReminderService(
+			driverRepo, vehicleRepo, scheduleRepo, userRepo, mocks.NewExpiryReminderRepository(),
+			emails, notifier, service.NewOperatorContacts(userRepo, mocks.NewOrganizationRepository(), []string{"010-0000-9999"}), []int{3, 30, 14}, nil,
+		),
+		emails:   emails,
+		notifier: notifier,
+		expiring: expiring,
+		vehicle:  vehicle,
+	}
+}
+
+// expirySummaries - 관리자 요약 알림 (제목이 "서류 만료 예정"인 알림, 연락처 → 제목)
+func expirySummaries(notifier *mocks.GuardianNotifier) map[string]string {
+	summaries := map[string]string{}
+	for _, sent := range notifier.Sent() {
+		if strings.HasPrefix(sent.Notification.Title, "서류 만료 예정") {
+			summaries[sent.Phone] = sent.Notification.Title
+		}
+	}
+	return summaries
+}
+
+// TestExpiryReminderService_Run - 구간에 들어온 면허/보험/정기검사를 기관마다 관리자 메일 한 통과 해당 기사 알림으로, 다시 실행하면 안내 안 함
+func TestExpiryReminderService_Run(t *testing.T) {
+	// Given
+	f := newExpiryFixture(t)
+
+	// When
+	result, err := f.svc.Run(context.Background(), time.Now())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Licenses)
+	assert.Equal(t, 1, result.Insurances)
+	assert.Equal(t, 1, result.Inspections)
+	require.Len(t, f.emails.sent, 1, "메일 주소가 있는 관리자는 해오름 기관뿐")
+	assert.Equal(t, []string{"admin@haeoreum.kr"}, f.emails.sent[0].to)
+	var documents []string
+	for _, item := range f.emails.sent[0].items {
+		documents = append(documents, item.Document+" "+item.Holder)
+	}
+	assert.ElementsMatch(t, []string{"운전면허 김만료", "자동차 보험 12가3456", "정기검사 12가3456"}, documents, "다른 기관의 항목은 섞지 않음")
+	assert.Equal(t, map[string]string{"010-0000-9999": "서류 만료 예정 1건"}, expirySummaries(f.notifier),
+		"관리자가 없는 기관은 플랫폼 운영 연락처로 그 기관 항목만 요약")
+
+	var phones []string
+	for _, sent := range f.notifier.Sent() {
+		phones = append(phones, sent.Phone)
+	}
+	assert.ElementsMatch(t, []string{"010-1111-1111", "010-2222-2222", "010-2222-2222", "010-4444-4444", "010-0000-9999"}, phones,
+		"면허는 본인, 차량은 일정의 기사 (관리자 연락처는 메일이 있으면 생략)")
+	for _, sent := range f.notifier.Sent() {
+		if sent.Phone == "010-1111-1111" {
+			assert.Equal(t, "운전면허 만료 예정", sent.Notification.Title)
+			assert.Contains(t, sent.Notification.Body, "D-10")
+			assert.Equal(t, "license", sent.Notification.Data["kind"])
+		}
+	}
+
+	// When - 같은 구간에서는 다시 안내하지 않음
+	again, err := f.svc.Run(context.Background(), time.Now().Add(time.Hour))
+
+	// Then
+	require.NoError(t, err)
+	assert.Zero(t, again.Total())
+	assert.Len(t, f.emails.sent, 1)
+	assert.Len(t, f.notifier.Sent(), 5)
+
+	// When - 다음 구간(3일 전)에 들어오면 다시 안내
+	later, err := f.svc.Run(context.Background(), time.Now().AddDate(0, 0, 8))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, later.Licenses, "면허는 두 기관 기사 모두 3일 구간 진입")
+	assert.Zero(t, later.Insurances, "보험은 이미 3일 구간 안내")
+	assert.Equal(t, 1, later.Inspections, "정기검사는 14일 구간 진입")
+}
+
+// TestExpiryReminderService_Run_OperatorFallback - 메일이 설정되지 않았으면 기관마다 그 기관의 운영 알림 수신자에게 요약 알림
+func TestExpiryReminderService_Run_OperatorFallback(t *testing.T) {
+	// Given
+	f := newExpiryFixture(t)
+	f.emails.err = service.ErrEmailDisabled
+
+	// When
+	result, err := f.svc.Run(context.Background(), time.Now())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Total())
+	assert.Equal(t, map[string]string{
+		"010-9999-9999": "서류 만료 예정 3건",
+		"010-0000-9999": "서류 만료 예정 1건",
+	}, expirySummaries(f.notifier))
+	for _, sent := range f.notifier.Sent() {
+		switch sent.Phone {
+		case "010-9999-9999":
+			assert.Contains(t, sent.Notification.Body, "운전면허 김만료")
+			assert.NotContains(t, sent.Notification.Body, "최새싹")
+		case "010-0000-9999":
+			assert.Contains(t, sent.Notification.Body, "운전면허 최새싹")
+			assert.NotContains(t, sent.Notification.Body, "김만료")
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFileService_Upload - 용도별 업로드 역할/형식/크기 정책
+func TestFileService_Upload(t *testing.T) {
+	// Given
+	admin := asPrincipal(domain.RoleAdmin, "admin-1")
+	driver := asPrincipal(domain.RoleDriver, "driver-1")
+	attendant := asPrincipal(domain.RoleAttendant, "attendant-1")
+	store := storage.NewLocalStorage(t.TempDir())
+	svc := service.NewFileService(mocks.NewFileRepository(), store)
+	largePNG := append(append([]byte{}, samplePNG...), bytes.Repeat([]byte{0}, 5<<20)...)
+
+	// When
+	license, err := svc.Upload(driver, domain.FilePurposeDriverLicense, "면허증.png", samplePNG)
+	_, attendantLicenseErr := svc.Upload(attendant, domain.FilePurposeDriverLicense, "면허증.png", samplePNG)
+	_, driverDocumentErr := svc.Upload(driver, domain.FilePurposeVehicleDocument, "보험.pdf", samplePDF)
+	_, pdfPhotoErr := svc.Upload(attendant, domain.FilePurposeIncidentPhoto, "사고.pdf", samplePDF)
+	photo, photoErr := svc.Upload(attendant, domain.FilePurposeIncidentPhoto, "사고.png", largePNG)
+	_, largeLicenseErr := svc.Upload(admin, domain.FilePurposeDriverLicense, "면허증.png", largePNG)
+	_, emptyErr := svc.Upload(admin, domain.FilePurposeVehicleDocument, "empty.pdf", nil)
+	_, unknownErr := svc.Upload(admin, domain.FilePurpose("avatar"), "a.png", samplePNG)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", license.ContentType)
+	assert.Equal(t, int64(len(samplePNG)), license.Size)
+	assert.Equal(t, "user-driver-1", license.UploadedBy)
+	assert.Equal(t, "driver_license/"+license.ID, license.StorageKey)
+	assertAppError(t, attendantLicenseErr, util.ErrCodeForbidden)
+	assertAppError(t, driverDocumentErr, util.ErrCodeForbidden)
+	assertAppError(t, pdfPhotoErr, util.ErrCodeValidation)
+	require.NoError(t, photoErr, "사고 사진은 10MB까지")
+	assert.Equal(t, domain.FilePurposeIncidentPhoto, photo.Purpose)
+	assertAppError(t, largeLicenseErr, util.ErrCodeValidation)
+	assertAppError(t, emptyErr, util.ErrCodeValidation)
+	assertAppError(t, unknownErr, util.ErrCodeValidation)
+
+	data, err := store.Get(driver, license.StorageKey)
+	require.NoError(t, err)
+	assert.Equal(t, samplePNG, data)
+}
+
+// TestFileService_DownloadDelete - 업로드한 사용자와 관리자만 조회, 삭제 시 원본도 삭제
+func TestFileService_DownloadDelete(t *testing.T) {
+	// Given
+	admin := asPrincipal(domain.RoleAdmin, "admin-1")
+	driver := asPrincipal(domain.RoleDriver, "driver-1")
+	otherDriver := asPrincipal(domain.RoleDriver, "driver-2")
+	store := storage.NewLocalStorage(t.TempDir())
+	fileRepo := mocks.NewFileRepository()
+	svc := service.NewFileService(fileRepo, store)
+	license, err := svc.Upload(driver, domain.FilePurposeDriverLicense, "면허증.pdf", samplePDF)
+	require.NoError(t, err)
+
+	// When
+	_, own, ownErr := svc.Download(driver, license.ID)
+	_, _, adminErr := svc.Download(admin, license.ID)
+	_, _, otherErr := svc.Download(otherDriver, license.ID)
+	_, otherGetErr := svc.Get(otherDriver, license.ID)
+	_, read, readErr := svc.Read(otherDriver, license.ID)
+	_, _, missingErr := svc.Download(admin, "missing")
+
+	// Then
+	require.NoError(t, ownErr)
+	assert.Equal(t, samplePDF, own)
+	assert.NoError(t, adminErr)
+	assertAppError(t, otherErr, util.ErrCodeForbidden)
+	assertAppError(t, otherGetErr, util.ErrCodeForbidden)
+	require.NoError(t, readErr, "Read는 권한 확인을 호출하는 서비스에 맡김")
+	assert.Equal(t, samplePDF, read)
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+
+	// When
+	require.NoError(t, svc.Delete(admin, license.ID))
+	deletedErr := svc.Delete(admin, license.ID)
+
+	// Then
+	assertAppError(t, deletedErr, util.ErrCodeNotFound)
+	_, err = fileRepo.GetByID(admin, license.ID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	_, err = store.Get(admin, license.StorageKey)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fuelFixture - 차량 두 대와 주유 기록 서비스
+type fuelFixture struct {
+	ctx context.Context
+	svc *service.FuelLogService
+	van *domain.Vehicle
+	bus *domain.Vehicle
+}
+
+func newFuelFixture(t *testing.T) *fuelFixture {
+	t.Helper()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user-driver", Role: domain.RoleDriver, ProfileID: "driver-1"})
+	vehicleRepo := mocks.NewVehicleRepository()
+	van := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	bus := domain.NewVehicle("34나5678", "카운티", "현대", domain.VehicleTypeMiniBus, 25, 2021, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, van))
+	require.NoError(t, vehicleRepo.Create(ctx, bus))
+	return &fuelFixture{ctx: ctx, svc: service.NewFuelLogService(vehicleRepo, mocks.NewFuelLogRepository(), nil), van: van, bus: bus}
+}
+
+// fill - 한국 시간 기준 주유 기록 등록
+func (f *fuelFixture) fill(t *testing.T, vehicle *domain.Vehicle, at string, liters float64, cost, odometer int) *domain.FuelLog {
+	t.Helper()
+	filledAt, err := time.Parse(time.RFC3339, at)
+	require.NoError(t, err)
+	log, err := f.svc.Add(f.ctx, vehicle.ID, &dto.CreateFuelLogRequest{FilledAt: &filledAt, Liters: liters, Cost: cost, OdometerKm: odometer})
+	require.NoError(t, err)
+	return log
+}
+
+// TestFuelLogService_Add - 기록자는 인증 주체, 주행거리계는 앞뒤 기록 사이, 미래 시각 거부
+func TestFuelLogService_Add(t *testing.T) {
+	// Given
+	f := newFuelFixture(t)
+	f.fill(t, f.van, "2025-03-01T09:00:00+09:00", 50, 80000, 10000)
+	f.fill(t, f.van, "2025-03-10T09:00:00+09:00", 50, 80000, 10500)
+	between, _ := time.Parse(time.RFC3339, "2025-03-05T09:00:00+09:00")
+	future := time.Now().Add(time.Hour)
+
+	// When
+	middle, err := f.svc.Add(f.ctx, f.van.ID, &dto.CreateFuelLogRequest{FilledAt: &between, Liters: 20, Cost: 32000, OdometerKm: 10200})
+	_, backwardsErr := f.svc.Add(f.ctx, f.van.ID, &dto.CreateFuelLogRequest{FilledAt: &between, Liters: 20, OdometerKm: 9900})
+	_, aheadErr := f.svc.Add(f.ctx, f.van.ID, &dto.CreateFuelLogRequest{FilledAt: &between, Liters: 20, OdometerKm: 10600})
+	_, futureErr := f.svc.Add(f.ctx, f.van.ID, &dto.CreateFuelLogRequest{FilledAt: &future, Liters: 20, OdometerKm: 11000})
+	_, missingErr := f.svc.Add(f.ctx, "missing", &dto.CreateFuelLogRequest{Liters: 20, OdometerKm: 11000})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "user-driver", middle.RecordedBy)
+	assertAppError(t, backwardsErr, util.ErrCodeValidation)
+	assertAppError(t, aheadErr, util.ErrCodeValidation)
+	assertAppError(t, futureErr, util.ErrCodeValidation)
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+
+	logs, err := f.svc.List(f.ctx, f.van.ID, &dto.FuelLogQuery{From: "2025-03-05", To: "2025-03-10"})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, 10200, logs[0].OdometerKm)
+
+	// When: 다른 차량 ID로는 삭제 불가
+	assertAppError(t, f.svc.Delete(f.ctx, f.bus.ID, middle.ID), util.ErrCodeNotFound)
+	require.NoError(t, f.svc.Delete(f.ctx, f.van.ID, middle.ID))
+}
+
+// TestFuelLogService_Report - 기간 직전 기록 기준 연비, 월별 추이, 전체 평균 대비, 확인이 필요한 주유
+func TestFuelLogService_Report(t *testing.T) {
+	// Given: 승합차는 기간 이전 기록이 기준 (10 km/L 안팎), 소형버스는 기간 첫 주유가 기준
+	f := newFuelFixture(t)
+	f.fill(t, f.van, "2025-02-25T09:00:00+09:00", 40, 64000, 10000)
+	f.fill(t, f.van, "2025-03-01T00:30:00+09:00", 50, 80000, 10500) // 한국 시간 3월 (UTC 2월)
+	f.fill(t, f.van, "2025-03-20T09:00:00+09:00", 50, 80000, 11000)
+	f.fill(t, f.van, "2025-04-02T09:00:00+09:00", 50, 80000, 11250) // 5 km/L → low_economy
+	noDistance := f.fill(t, f.van, "2025-04-03T09:00:00+09:00", 20, 32000, 11250)
+	f.fill(t, f.bus, "2025-03-02T09:00:00+09:00", 60, 96000, 50000)
+	f.fill(t, f.bus, "2025-03-30T09:00:00+09:00", 60, 96000, 50300)
+	from, _ := time.Parse(time.DateOnly, "2025-03-01")
+	to, _ := time.Parse(time.DateOnly, "2025-04-30")
+
+	// When
+	report, err := f.svc.Report(f.ctx, from, to)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, report.Vehicles, 2)
+
+	bus := report.Vehicles[0] // 연비 낮은 순
+	assert.Equal(t, "34나5678", bus.PlateNumber)
+	assert.Equal(t, 2, bus.FillUps)
+	assert.Equal(t, 300, bus.DistanceKm)
+	assert.Equal(t, 5.0, bus.KmPerLiter)
+	assert.Empty(t, bus.Flags)
+
+	van := report.Vehicles[1]
+	assert.Equal(t, 4, van.FillUps)
+	assert.Equal(t, 170.0, van.Liters)
+	assert.Equal(t, 272000, van.Cost)
+	assert.Equal(t, 1250, van.DistanceKm)
+	assert.Equal(t, 7.4, van.KmPerLiter)
+	assert.Equal(t, 217.6, van.CostPerKm)
+	require.Len(t, van.Monthly, 2)
+	assert.Equal(t, "2025-03", van.Monthly[0].Month)
+	assert.Equal(t, 10.0, van.Monthly[0].KmPerLiter)
+	assert.Equal(t, 3.6, van.Monthly[1].KmPerLiter)
+	require.Len(t, van.Flags, 2)
+	assert.Equal(t, "low_economy", van.Flags[0].Reason)
+	assert.Equal(t, noDistance.ID, van.Flags[1].FuelLogID)
+	assert.Equal(t, "no_distance", van.Flags[1].Reason)
+
+	assert.Equal(t, 6, report.Fleet.FillUps)
+	assert.Equal(t, 1550, report.Fleet.DistanceKm)
+	assert.Equal(t, 6.7, report.Fleet.KmPerLiter) // 1550 km / 230 L
+	assert.Equal(t, 110.4, van.FleetRatio)
+}
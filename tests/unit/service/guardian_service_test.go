@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// guardianFixture - 보호자 서비스 테스트용 의존성 모음
+type guardianFixture struct {
+	svc          *service.GuardianService
+	passengerSvc *service.PassengerService
+	routeSvc     *service.RouteService
+}
+
+// newGuardianFixture - 인메모리 Repository로 보호자 서비스 구성
+func newGuardianFixture() *guardianFixture {
+	routeRepo := mocks.NewRouteRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	return &guardianFixture{
+		svc:          service.NewGuardianService(mocks.NewGuardianRepository(), passengerRepo, routeRepo),
+		passengerSvc: service.NewPassengerService(passengerRepo, routeRepo, mocks.NewScheduleRepository(), mocks.NewVehicleRepository()),
+		routeSvc:     service.NewRouteService(routeRepo, mocks.NewTxManager(), nil, nil),
+	}
+}
+
+// TestGuardianService_Create_DuplicatePhone - 연락처 중복 등록 거부
+func TestGuardianService_Create_DuplicatePhone(t *testing.T) {
+	// Given
+	f := newGuardianFixture()
+	_, err := f.svc.Create(context.Background(), &dto.CreateGuardianRequest{Name: "김보호", Phone: "010-1234-5678"})
+	require.NoError(t, err)
+
+	// When
+	_, err = f.svc.Create(context.Background(), &dto.CreateGuardianRequest{Name: "이보호", Phone: "010-1234-5678"})
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeDuplicate, appErr.Code)
+}
+
+// TestGuardianService_ListChildren - 자녀 목록에 배정 경로/정류장 포함
+func TestGuardianService_ListChildren(t *testing.T) {
+	// Given: 정류장 B에 배정된 자녀 1명, 미배정 자녀 1명
+	ctx := context.Background()
+	f := newGuardianFixture()
+	route := createRouteWithStops(t, f.routeSvc)
+	guardian, err := f.svc.Create(ctx, &dto.CreateGuardianRequest{Name: "김보호", Phone: "010-1234-5678"})
+	require.NoError(t, err)
+
+	assigned, err := f.passengerSvc.Create(ctx, newPassengerRequest("김철수"))
+	require.NoError(t, err)
+	_, err = f.passengerSvc.AssignToStop(ctx, assigned.ID, &dto.AssignStopRequest{RouteID: route.ID, StopID: route.Stops[1].ID})
+	require.NoError(t, err)
+	unassigned, err := f.passengerSvc.Create(ctx, newPassengerRequest("김영희"))
+	require.NoError(t, err)
+
+	_, err = f.svc.LinkPassenger(ctx, guardian.ID, &dto.LinkPassengerRequest{PassengerID: assigned.ID, Relation: "모"})
+	require.NoError(t, err)
+	_, err = f.svc.LinkPassenger(ctx, guardian.ID, &dto.LinkPassengerRequest{PassengerID: unassigned.ID, Relation: "모"})
+	require.NoError(t, err)
+
+	// When
+	children, err := f.svc.ListChildren(ctx, guardian.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+	assert.Equal(t, "김철수", children[0].Passenger.Name)
+	assert.Equal(t, "모", children[0].Relation)
+	require.NotNil(t, children[0].Route)
+	assert.Equal(t, route.ID, children[0].Route.ID)
+	require.NotNil(t, children[0].Stop)
+	assert.Equal(t, "B", children[0].Stop.Name)
+	assert.Nil(t, children[1].Route)
+	assert.Nil(t, children[1].Stop)
+}
+
+// TestGuardianService_LinkPassenger_Duplicate - 같은 자녀 중복 연결 거부
+func TestGuardianService_LinkPassenger_Duplicate(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newGuardianFixture()
+	guardian, err := f.svc.Create(ctx, &dto.CreateGuardianRequest{Name: "김보호", Phone: "010-1234-5678"})
+	require.NoError(t, err)
+	passenger, err := f.passengerSvc.Create(ctx, newPassengerRequest("김철수"))
+	require.NoError(t, err)
+	_, err = f.svc.LinkPassenger(ctx, guardian.ID, &dto.LinkPassengerRequest{PassengerID: passenger.ID})
+	require.NoError(t, err)
+
+	// When
+	_, err = f.svc.LinkPassenger(ctx, guardian.ID, &dto.LinkPassengerRequest{PassengerID: passenger.ID})
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+}
+
+// TestGuardianService_UnlinkPassenger - 연결 해제 후 자녀 목록에서 제외
+func TestGuardianService_UnlinkPassenger(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newGuardianFixture()
+	guardian, err := f.svc.Create(ctx, &dto.CreateGuardianRequest{Name: "김보호", Phone: "010-1234-5678"})
+	require.NoError(t, err)
+	passenger, err := f.passengerSvc.Create(ctx, newPassengerRequest("김철수"))
+	require.NoError(t, err)
+	_, err = f.svc.LinkPassenger(ctx, guardian.ID, &dto.LinkPassengerRequest{PassengerID: passenger.ID})
+	require.NoError(t, err)
+
+	// When
+	err = f.svc.UnlinkPassenger(ctx, guardian.ID, passenger.ID)
+
+	// Then
+	require.NoError(t, err)
+	children, err := f.svc.ListChildren(ctx, guardian.ID)
+	require.NoError(t, err)
+	assert.Empty(t, children)
+
+	// 이미 해제된 연결은 404
+	err = f.svc.UnlinkPassenger(ctx, guardian.ID, passenger.ID)
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeNotFound, appErr.Code)
+}
+
+// TestGuardianService_LinkPassenger_PassengerNotFound - 없는 탑승자 연결
+func TestGuardianService_LinkPassenger_PassengerNotFound(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newGuardianFixture()
+	guardian, err := f.svc.Create(ctx, &dto.CreateGuardianRequest{Name: "김보호", Phone: "010-1234-5678"})
+	require.NoError(t, err)
+
+	// When
+	_, err = f.svc.LinkPassenger(ctx, guardian.ID, &dto.LinkPassengerRequest{PassengerID: "00000000-0000-0000-0000-000000000000"})
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeNotFound, appErr.Code)
+}
@@ -0,0 +1,86 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/pkg/holiday"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubHolidayFetcher - 연도별 고정 공휴일을 돌려주는 Fetcher
+type stubHolidayFetcher map[int][]holiday.Holiday
+
+func (f stubHolidayFetcher) Fetch(ctx context.Context, year int) ([]holiday.Holiday, error) {
+	return f[year], nil
+}
+
+// TestHolidayService_IsHoliday - 동기화한 공휴일 우선, 없으면 기본 공휴일 표
+func TestHolidayService_IsHoliday(t *testing.T) {
+	// Given: 2026-06-03(지방선거)은 표에도 있지만 동기화한 이름이 우선, 2030-01-01은 동기화로만 존재
+	ctx := context.Background()
+	repo := mocks.NewHolidayRepository()
+	require.NoError(t, repo.Upsert(ctx, []*domain.Holiday{
+		domain.NewHoliday(time.Date(2026, 6, 3, 0, 0, 0, 0, time.UTC), "임시공휴일", domain.HolidaySourceAPI),
+		domain.NewHoliday(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), "1월1일", domain.HolidaySourceAPI),
+	}))
+	svc := service.NewHolidayService(repo, nil, nil)
+
+	tests := []struct {
+		date     time.Time
+		wantName string
+		wantOK   bool
+	}{
+		{time.Date(2026, 6, 3, 0, 0, 0, 0, time.UTC), "임시공휴일", true},
+		{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), "1월1일", true},
+		{time.Date(2026, 9, 25, 0, 0, 0, 0, time.UTC), "추석", true},
+		{time.Date(2026, 9, 29, 0, 0, 0, 0, time.UTC), "", false},
+	}
+	for _, tt := range tests {
+		// When
+		name, ok, err := svc.IsHoliday(ctx, tt.date)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantOK, ok, tt.date)
+		assert.Equal(t, tt.wantName, name, tt.date)
+	}
+}
+
+// TestHolidayService_Sync - API 공휴일 저장 후 목록에 반영 (같은 날짜는 동기화분 우선)
+func TestHolidayService_Sync(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	repo := mocks.NewHolidayRepository()
+	fetcher := stubHolidayFetcher{2026: {
+		{Date: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Name: "1월1일"},
+		{Date: time.Date(2026, 7, 17, 0, 0, 0, 0, time.UTC), Name: "임시공휴일"},
+	}}
+	svc := service.NewHolidayService(repo, fetcher, nil)
+
+	// When
+	count, err := svc.Sync(ctx, 2026)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	holidays, err := svc.List(ctx, 2026)
+	require.NoError(t, err)
+	assert.Len(t, holidays, len(holiday.Static(2026))+1)
+	sources := map[string]domain.HolidaySource{}
+	for _, h := range holidays {
+		sources[h.Date.Format(time.DateOnly)] = h.Source
+	}
+	assert.Equal(t, domain.HolidaySourceAPI, sources["2026-01-01"])
+	assert.Equal(t, domain.HolidaySourceAPI, sources["2026-07-17"])
+	assert.Equal(t, domain.HolidaySourceStatic, sources["2026-02-17"])
+
+	// Then: Fetcher가 없으면 동기화 안 함
+	_, err = service.NewHolidayService(repo, nil, nil).Sync(ctx, 2026)
+	assert.ErrorIs(t, err, service.ErrHolidaySyncDisabled)
+}
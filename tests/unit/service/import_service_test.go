@@ -0,0 +1,221 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// importFixture - 가져오기 테스트용 Repository와 서비스
+type importFixture struct {
+	svc           *service.ImportService
+	passengerRepo *mocks.PassengerRepository
+	routeRepo     *mocks.RouteRepository
+	scheduleRepo  *mocks.ScheduleRepository
+	vehicleRepo   *mocks.VehicleRepository
+	txManager     *mocks.TxManager
+	route         *domain.Route // "1호차 등원" (정류장 A, B, C)
+}
+
+func newImportFixture(t *testing.T) *importFixture {
+	f := &importFixture{
+		passengerRepo: mocks.NewPassengerRepository(),
+		routeRepo:     mocks.NewRouteRepository(),
+		scheduleRepo:  mocks.NewScheduleRepository(),
+		vehicleRepo:   mocks.NewVehicleRepository(),
+		txManager:     mocks.NewTxManager(),
+	}
+	routeService := service.NewRouteService(f.routeRepo, mocks.NewTxManager(), nil, nil)
+	f.route = createRouteWithStops(t, routeService)
+	f.svc = service.NewImportService(f.passengerRepo, f.routeRepo, f.scheduleRepo, f.vehicleRepo, routeService, f.txManager)
+	return f
+}
+
+// csvFile - 줄 목록을 CSV 파일 내용으로
+func csvFile(lines ...string) []byte {
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// errorsByRow - 결과의 행 번호 → 필드별 사유
+func errorsByRow(result *dto.ImportResponse) map[int]map[string]interface{} {
+	errors := make(map[int]map[string]interface{}, len(result.Errors))
+	for _, rowErr := range result.Errors {
+		errors[rowErr.Row] = rowErr.Errors
+	}
+	return errors
+}
+
+// TestImportService_ImportPassengers - 통과한 행만 한 트랜잭션으로 등록, 실패한 행은 행 번호와 필드별 사유
+func TestImportService_ImportPassengers(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newImportFixture(t)
+	data := csvFile(
+		"이름,나이,성별,보호자 이름,보호자 연락처,경로,정류장,반",
+		"김철수,5,남,김보호,010-1111-2222,1호차 등원,A,해님반",
+		"이영희,6,여,이보호,1033334444,1호차 등원,b,달님반", // 엑셀이 뺀 앞자리 0 복구, 정류장 이름 대소문자 무시
+		"박민수,,,박보호,010-123,,",
+		"김철수,5,남,김보호,01011112222,,",
+		"최지우,다섯,,최보호,010-5555-6666,,",
+		"정하늘,,,정보호,010-7777-8888,없는 경로,A",
+		",,,,,,",
+		"한가람,,,한보호,010-9999-0000,1호차 등원,Z",
+		"윤바다,,,윤보호,010-1212-3434,1호차 등원,",
+	)
+
+	// When
+	result, err := f.svc.ImportPassengers(ctx, "children.csv", data, false)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 8, result.Total)
+	assert.Equal(t, 2, result.Imported)
+	assert.Equal(t, 6, result.Failed)
+	errors := errorsByRow(result)
+	for row, field := range map[int]string{4: "guardian_phone", 5: "name", 6: "age", 7: "route", 9: "stop", 10: "route"} {
+		assert.Contains(t, errors[row], field, "row %d", row)
+	}
+	assert.Contains(t, errors[5]["name"], "파일 2행")
+	assert.Equal(t, 1, f.txManager.Calls)
+
+	passengers, total, err := f.passengerRepo.List(ctx, repository.PassengerFilter{RouteID: f.route.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), total)
+	assert.Equal(t, "김철수", passengers[0].Name)
+	assert.Equal(t, "male", passengers[0].Gender)
+	assert.Equal(t, 1, passengers[0].StopOrder)
+	assert.Equal(t, "이영희", passengers[1].Name)
+	assert.Equal(t, "01033334444", passengers[1].GuardianPhone)
+	assert.Equal(t, 2, passengers[1].StopOrder)
+}
+
+// TestImportService_ImportPassengers_DryRunAndExisting - dry_run은 저장하지 않고, 이미 등록된 탑승자는 실패
+func TestImportService_ImportPassengers_DryRunAndExisting(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newImportFixture(t)
+	require.NoError(t, f.passengerRepo.Create(ctx, domain.NewPassenger("김철수", "김보호", "010-1111-2222")))
+	data := csvFile(
+		"name,guardian_name,guardian_phone",
+		"김철수,김보호,+821011112222",
+		"이영희,이보호,010-3333-4444",
+	)
+
+	// When
+	result, err := f.svc.ImportPassengers(ctx, "children.csv", data, true)
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 1, result.Imported)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 2, result.Errors[0].Row)
+	assert.Contains(t, result.Errors[0].Errors["name"], "이미 등록")
+	assert.Zero(t, f.txManager.Calls)
+	_, total, _ := f.passengerRepo.List(ctx, repository.PassengerFilter{})
+	assert.Equal(t, int64(1), total)
+}
+
+// TestImportService_ImportPassengers_OverCapacity - 파일 안의 배정 인원을 누적해 차량 승객 정원 초과 행은 실패
+func TestImportService_ImportPassengers_OverCapacity(t *testing.T) {
+	// Given - 3인승(승객 정원 2명) 차량 일정
+	ctx := context.Background()
+	f := newImportFixture(t)
+	vehicle := domain.NewVehicle("12가3456", "레이", "기아", domain.VehicleTypeVan, 3, 2022, "흰색")
+	require.NoError(t, f.vehicleRepo.Create(ctx, vehicle))
+	require.NoError(t, f.scheduleRepo.Create(ctx, domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, f.route.ID, vehicle.ID, "driver-1")))
+	data := csvFile(
+		"이름,보호자 이름,보호자 연락처,경로,정류장",
+		"김철수,김보호,010-1111-2222,"+f.route.ID+",A",
+		"이영희,이보호,010-3333-4444,1호차 등원,B",
+		"박민수,박보호,010-5555-6666,1호차 등원,C",
+		"최지우,최보호,010-7777-8888,,",
+	)
+
+	// When
+	result, err := f.svc.ImportPassengers(ctx, "children.csv", data, false)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Imported)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 4, result.Errors[0].Row)
+	assert.Contains(t, result.Errors[0].Errors["route"], "승객 정원(2명)")
+}
+
+// TestImportService_FileErrors - 파일 자체 문제는 file 필드 VALIDATION_ERROR (아무것도 저장하지 않음)
+func TestImportService_FileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		data     []byte
+		message  string
+	}{
+		{"지원하지 않는 형식", "children.xls", []byte("binary"), ".xlsx"},
+		{"UTF-8이 아닌 CSV", "children.csv", []byte{0xC0, 0xCC, 0xB8, 0xA7, '\n', 'a', '\n'}, "CSV UTF-8"},
+		{"머리글만 있음", "children.csv", csvFile("이름,보호자 이름,보호자 연락처"), "데이터 행"},
+		{"필수 열 없음", "children.csv", csvFile("이름,나이", "김철수,5"), "보호자 이름, 보호자 연락처"},
+		{"같은 항목 열 중복", "children.csv", csvFile("이름,보호자 이름,보호자 연락처,보호자 전화번호", "김철수,김보호,010-1111-2222,010-1111-2222"), "보호자 전화번호"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			f := newImportFixture(t)
+
+			// When
+			_, err := f.svc.ImportPassengers(context.Background(), tt.filename, tt.data, false)
+
+			// Then
+			assertAppError(t, err, util.ErrCodeValidation)
+			assert.Contains(t, fmt.Sprint(err.(*util.AppError).Details["file"]), tt.message)
+			assert.Zero(t, f.txManager.Calls)
+		})
+	}
+}
+
+// TestImportService_ImportStops - 순서 생략은 마지막에 추가, 파일 순서대로 삽입, 중복/좌표 없음/순서 범위 밖은 실패
+func TestImportService_ImportStops(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newImportFixture(t)
+	data := csvFile(
+		"경로,정류장 이름,주소,순서,위도,경도",
+		"1호차 등원,D,서울시 강남구,,37.5,127.0",
+		"1호차 등원,E,서울시 강남구,1,37.5,127.0",
+		"1호차 등원,d,서울시 강남구,,37.5,127.0",
+		"1호차 등원,F,서울시 강남구,,,",
+		"1호차 등원,A,서울시 강남구,,37.5,127.0",
+		"1호차 등원,G,서울시 강남구,9,37.5,127.0",
+		"없는 경로,H,서울시 강남구,,37.5,127.0",
+		"1호차 등원,I,서울시 강남구,,91,127.0",
+	)
+
+	// When
+	result, err := f.svc.ImportStops(ctx, "stops.csv", data, false)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Imported)
+	errors := errorsByRow(result)
+	assert.Contains(t, errors[4], "name")
+	assert.Contains(t, errors[5], "latitude")
+	assert.Contains(t, errors[6], "name")
+	assert.Equal(t, "정류장 순서는 1부터 6 사이여야 합니다", errors[7]["order"])
+	assert.Contains(t, errors[8], "route")
+	assert.Contains(t, errors[9], "latitude")
+
+	stops, err := f.routeRepo.ListStops(ctx, f.route.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"E", "A", "B", "C", "D"}, stopNames(t, stops))
+}
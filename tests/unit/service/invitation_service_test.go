@@ -0,0 +1,239 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// invitationFixture - 초대 서비스와 발송 내역
+type invitationFixture struct {
+	svc          *service.InvitationService
+	users        *mocks.UserRepository
+	invitations  *mocks.InvitationRepository
+	emails       *mocks.EmailSender
+	texts        *mocks.SMSSender
+	organization *domain.Organization
+	adminCtx     context.Context
+}
+
+// newInvitationFixture - 기관 하나와 관리자 context (이메일/문자 채널 모두 설정)
+func newInvitationFixture(t *testing.T) *invitationFixture {
+	organizations := mocks.NewOrganizationRepository()
+	organization := domain.NewOrganization("해바라기 유치원", domain.OrganizationTypeKindergarten)
+	require.NoError(t, organizations.Create(context.Background(), organization))
+
+	f := &invitationFixture{
+		users:        mocks.NewUserRepository(),
+		invitations:  mocks.NewInvitationRepository(),
+		emails:       mocks.NewEmailSender(),
+		texts:        mocks.NewSMSSender(),
+		organization: organization,
+		adminCtx: auth.WithPrincipal(context.Background(), &auth.Principal{
+			UserID: "admin-1", OrganizationID: organization.ID, Role: domain.RoleAdmin,
+		}),
+	}
+	channels := service.InvitationChannels{
+		Email: service.NewEmailService(f.emails, nil, 30*time.Minute),
+		SMS:   service.SMSInvitationNotifier{Sender: f.texts},
+	}
+	f.svc = service.NewInvitationService(f.invitations, f.users, organizations, mocks.NewTxManager(), channels, 72*time.Hour)
+	return f
+}
+
+// lastTextToken - 마지막 초대 문자의 초대 코드 (본문 마지막 줄)
+func (f *invitationFixture) lastTextToken(t *testing.T) string {
+	require.NotEmpty(t, f.texts.Sent)
+	lines := strings.Split(f.texts.Sent[len(f.texts.Sent)-1].Text, "\n")
+	return lines[len(lines)-1]
+}
+
+// TestInvitationService_Invite - 이메일 초대는 메일, 연락처 초대는 문자로 발송 (토큰 원문은 저장하지 않음)
+func TestInvitationService_Invite(t *testing.T) {
+	// Given
+	f := newInvitationFixture(t)
+
+	// When
+	byEmail, emailErr := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Email: "Teacher@Eodini.kr", Role: "admin"})
+	byPhone, phoneErr := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Phone: "010-1234-5678", Role: "driver", ProfileID: "driver-1"})
+
+	// Then
+	require.NoError(t, emailErr)
+	assert.Equal(t, f.organization.ID, byEmail.OrganizationID)
+	assert.Equal(t, "teacher@eodini.kr", *byEmail.Email)
+	assert.Equal(t, domain.InvitationStatusPending, byEmail.Status)
+	assert.True(t, *byEmail.Delivered)
+	require.Len(t, f.emails.Sent, 1)
+	assert.Equal(t, []string{"teacher@eodini.kr"}, f.emails.Sent[0].To)
+
+	require.NoError(t, phoneErr)
+	assert.Equal(t, "admin-1", byPhone.InvitedBy)
+	require.Len(t, f.texts.Sent, 1)
+	assert.Equal(t, "010-1234-5678", f.texts.Sent[0].To)
+	assert.NotEqual(t, f.lastTextToken(t), byPhone.TokenHash)
+	assert.Equal(t, auth.HashToken(f.lastTextToken(t)), byPhone.TokenHash)
+}
+
+// TestInvitationService_InviteValidation - 연락 수단 누락, 프로필 누락, 이미 등록된 이메일 거부
+func TestInvitationService_InviteValidation(t *testing.T) {
+	// Given
+	f := newInvitationFixture(t)
+	require.NoError(t, f.users.Create(f.adminCtx, domain.NewUser("driver@eodini.kr", "hash", domain.RoleDriver, "driver-1")))
+
+	// When
+	_, noContact := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Role: "admin"})
+	_, noProfile := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Email: "new@eodini.kr", Role: "attendant"})
+	_, member := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Email: "driver@eodini.kr", Role: "driver", ProfileID: "driver-1"})
+
+	// Then
+	assert.Equal(t, util.ErrCodeValidation, noContact.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeValidation, noProfile.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeDuplicate, member.(*util.AppError).Code)
+	assert.Empty(t, f.emails.Sent)
+}
+
+// TestInvitationService_Accept - 인증 주체 없이 토큰으로 수락 → 초대한 기관 소속 계정 생성, 토큰은 1회용
+func TestInvitationService_Accept(t *testing.T) {
+	// Given
+	f := newInvitationFixture(t)
+	_, err := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Phone: "010-1234-5678", Role: "driver", ProfileID: "driver-1"})
+	require.NoError(t, err)
+	token := f.lastTextToken(t)
+
+	// When
+	_, noEmail := f.svc.Accept(context.Background(), &dto.AcceptInvitationRequest{Token: token, Password: "password123"})
+	user, acceptErr := f.svc.Accept(context.Background(), &dto.AcceptInvitationRequest{Token: token, Email: "driver@eodini.kr", Password: "password123"})
+	_, reused := f.svc.Accept(context.Background(), &dto.AcceptInvitationRequest{Token: token, Email: "other@eodini.kr", Password: "password123"})
+	_, unknown := f.svc.Accept(context.Background(), &dto.AcceptInvitationRequest{Token: "unknown", Email: "x@eodini.kr", Password: "password123"})
+
+	// Then
+	assert.Equal(t, util.ErrCodeValidation, noEmail.(*util.AppError).Code)
+	require.NoError(t, acceptErr)
+	assert.Equal(t, f.organization.ID, user.OrganizationID)
+	assert.Equal(t, domain.RoleDriver, user.Role)
+	assert.Equal(t, "driver-1", user.ProfileID)
+	assert.Equal(t, "010-1234-5678", user.Phone)
+	assert.True(t, auth.CheckPassword(user.PasswordHash, "password123"))
+	assert.Equal(t, util.ErrCodeBadRequest, reused.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeBadRequest, unknown.(*util.AppError).Code)
+
+	pending, err := f.svc.ListPending(f.adminCtx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+// TestInvitationService_AcceptDuplicateEmail - 다른 기관에 같은 이메일 계정이 있으면 수락 불가 (이메일은 전체 기관에서 유일)
+func TestInvitationService_AcceptDuplicateEmail(t *testing.T) {
+	// Given
+	f := newInvitationFixture(t)
+	existing := domain.NewUser("teacher@eodini.kr", "hash", domain.RoleAdmin, "")
+	existing.OrganizationID = "other-org"
+	require.NoError(t, f.users.Create(context.Background(), existing))
+	_, err := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Phone: "010-1234-5678", Role: "admin"})
+	require.NoError(t, err)
+
+	// When
+	_, dup := f.svc.Accept(context.Background(), &dto.AcceptInvitationRequest{Token: f.lastTextToken(t), Email: "teacher@eodini.kr", Password: "password123"})
+
+	// Then
+	assert.Equal(t, util.ErrCodeDuplicate, dup.(*util.AppError).Code)
+}
+
+// TestInvitationService_Revoke - 취소한 초대는 목록에서 빠지고 수락 불가, 다시 취소하면 404
+func TestInvitationService_Revoke(t *testing.T) {
+	// Given
+	f := newInvitationFixture(t)
+	invitation, err := f.svc.Invite(f.adminCtx, "admin-1", &dto.InviteMemberRequest{Phone: "010-1234-5678", Role: "admin"})
+	require.NoError(t, err)
+
+	// When
+	revokeErr := f.svc.Revoke(f.adminCtx, invitation.ID)
+	again := f.svc.Revoke(f.adminCtx, invitation.ID)
+	_, acceptErr := f.svc.Accept(context.Background(), &dto.AcceptInvitationRequest{Token: f.lastTextToken(t), Email: "a@eodini.kr", Password: "password123"})
+
+	// Then
+	require.NoError(t, revokeErr)
+	assert.Equal(t, util.ErrCodeNotFound, again.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeBadRequest, acceptErr.(*util.AppError).Code)
+	pending, err := f.svc.ListPending(f.adminCtx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+// TestUserService_ChangeRoleAndRemove - 역할 변경/제외 시 세션 종료, 본인 계정은 변경/제외 불가
+func TestUserService_ChangeRoleAndRemove(t *testing.T) {
+	// Given
+	users := mocks.NewUserRepository()
+	sessions := mocks.NewSessionRepository()
+	svc := service.NewUserService(users, sessions)
+	ctx := context.Background()
+	member := domain.NewUser("driver@eodini.kr", "hash", domain.RoleDriver, "driver-1")
+	require.NoError(t, users.Create(ctx, member))
+	require.NoError(t, sessions.Track(ctx, &domain.Session{ID: "s-1", UserID: member.ID, ExpiresAt: time.Now().Add(time.Hour)}))
+
+	// When
+	_, self := svc.ChangeRole(ctx, "admin-1", "admin-1", &dto.ChangeUserRoleRequest{Role: "driver", ProfileID: "driver-1"})
+	_, noProfile := svc.ChangeRole(ctx, "admin-1", member.ID, &dto.ChangeUserRoleRequest{Role: "attendant"})
+	changed, changeErr := svc.ChangeRole(ctx, "admin-1", member.ID, &dto.ChangeUserRoleRequest{Role: "admin"})
+	revoked, _ := sessions.IsRevoked(ctx, "s-1")
+	selfRemove := svc.Remove(ctx, "admin-1", "admin-1")
+	removeErr := svc.Remove(ctx, "admin-1", member.ID)
+	_, getErr := svc.Get(ctx, member.ID)
+
+	// Then
+	assert.Equal(t, util.ErrCodeBadRequest, self.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeValidation, noProfile.(*util.AppError).Code)
+	require.NoError(t, changeErr)
+	assert.Equal(t, domain.RoleAdmin, changed.Role)
+	assert.Empty(t, changed.ProfileID)
+	assert.True(t, revoked)
+	assert.Equal(t, util.ErrCodeBadRequest, selfRemove.(*util.AppError).Code)
+	require.NoError(t, removeErr)
+	assert.Equal(t, util.ErrCodeNotFound, getErr.(*util.AppError).Code)
+}
+
+// TestUserService_SuperAdminProtected - 기관 관리자는 플랫폼 운영자 계정을 비활성화/역할 변경/제외/세션 종료할 수 없음
+func TestUserService_SuperAdminProtected(t *testing.T) {
+	// Given
+	users := mocks.NewUserRepository()
+	sessions := mocks.NewSessionRepository()
+	svc := service.NewUserService(users, sessions)
+	operator := domain.NewUser("ops@eodini.kr", "hash", domain.RoleSuperAdmin, "")
+	require.NoError(t, users.Create(context.Background(), operator))
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin})
+	superAdmin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "ops-2", Role: domain.RoleSuperAdmin})
+
+	// When
+	_, disableErr := svc.Disable(admin, operator.ID)
+	_, changeErr := svc.ChangeRole(admin, "admin-1", operator.ID, &dto.ChangeUserRoleRequest{Role: "driver", ProfileID: "driver-1"})
+	_, revokeErr := svc.RevokeSessions(admin, operator.ID)
+	removeErr := svc.Remove(admin, "admin-1", operator.ID)
+
+	// Then
+	assertAppError(t, disableErr, util.ErrCodeForbidden)
+	assertAppError(t, changeErr, util.ErrCodeForbidden)
+	assertAppError(t, revokeErr, util.ErrCodeForbidden)
+	assertAppError(t, removeErr, util.ErrCodeForbidden)
+	stored, err := svc.Get(context.Background(), operator.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsActive())
+	assert.Equal(t, domain.RoleSuperAdmin, stored.Role)
+
+	// When: 플랫폼 운영자는 다른 플랫폼 운영자 계정을 관리 가능
+	disabled, err := svc.Disable(superAdmin, operator.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.False(t, disabled.IsActive())
+	require.NoError(t, svc.Remove(superAdmin, "ops-2", operator.ID))
+}
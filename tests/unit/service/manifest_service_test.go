@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// manifestFixture - 정류장 3개(마지막은 기관) 경로와 탑승자 2명, 기사 driver-1 배정 운행
+type manifestFixture struct {
+	svc          *service.ManifestService
+	tripRepo     *mocks.TripRepository
+	guardianRepo *mocks.GuardianRepository
+	trip         *domain.Trip
+	stops        []*domain.Stop
+	minjun       *domain.Passenger
+	seoyeon      *domain.Passenger
+}
+
+func newManifestFixture(t *testing.T, timeSlot domain.TimeSlot) *manifestFixture {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+
+	route := domain.NewRoute("A코스", "", 40)
+	stops := []*domain.Stop{
+		domain.NewStop(route.ID, "해오름아파트 정문", "", 1, 37.50, 127.00, 5),
+		domain.NewStop(route.ID, "푸른마을 놀이터", "", 2, 37.51, 127.01, 12),
+		domain.NewStop(route.ID, "햇살유치원", "", 3, 37.52, 127.02, 25),
+	}
+	for _, stop := range stops {
+		route.AddStop(*stop)
+	}
+	require.NoError(t, routeRepo.Create(ctx, route))
+	schedule := domain.NewSchedule("A코스", "08:00", timeSlot, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	minjun := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	minjun.MedicalNotes = "땅콩 알레르기"
+	minjun.AssignToStop(route.ID, stops[0].ID, 1)
+	require.NoError(t, passengerRepo.Create(ctx, minjun))
+	seoyeon := domain.NewPassenger("박서연", "박보호", "010-8765-4321")
+	seoyeon.AssignToStop(route.ID, stops[1].ID, 2)
+	require.NoError(t, passengerRepo.Create(ctx, seoyeon))
+	inactive := domain.NewPassenger("이졸업", "이보호", "010-0000-1111")
+	inactive.AssignToStop(route.ID, stops[1].ID, 2)
+	inactive.SetInactive()
+	require.NoError(t, passengerRepo.Create(ctx, inactive))
+
+	trip := domain.NewTrip(schedule.ID, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), "vehicle-1", "driver-1", nil)
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	return &manifestFixture{
+		svc:          service.NewManifestService(tripService, scheduleRepo, routeRepo, passengerRepo, guardianRepo),
+		tripRepo:     tripRepo,
+		guardianRepo: guardianRepo,
+		trip:         trip,
+		stops:        stops,
+		minjun:       minjun,
+		seoyeon:      seoyeon,
+	}
+}
+
+// manifestNames - 정류장 승차/하차 탑승자 이름
+func manifestNames(passengers []dto.ManifestPassengerResponse) []string {
+	names := []string{}
+	for _, passenger := range passengers {
+		names = append(names, passenger.Name)
+	}
+	return names
+}
+
+// TestManifestService_Morning - 오전 일정은 배정 정류장에서 승차, 마지막 정류장에서 하차 (비활성 탑승자 제외)
+func TestManifestService_Morning(t *testing.T) {
+	// Given
+	f := newManifestFixture(t, domain.TimeSlotMorning)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	guardian := domain.NewGuardian("김엄마", "010-2222-3333")
+	require.NoError(t, f.guardianRepo.Create(ctx, guardian))
+	require.NoError(t, f.guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, f.minjun.ID, "모")))
+
+	// When
+	manifest, err := f.svc.Get(ctx, f.trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "2025-03-10", manifest.Date)
+	assert.Equal(t, 2, manifest.TotalPassengers)
+	assert.Equal(t, 1, manifest.MedicalAlerts)
+	require.Len(t, manifest.Stops, 3)
+	assert.Equal(t, []string{"김민준"}, manifestNames(manifest.Stops[0].Boarding))
+	assert.Equal(t, []string{"박서연"}, manifestNames(manifest.Stops[1].Boarding))
+	assert.Empty(t, manifest.Stops[2].Boarding)
+	assert.Empty(t, manifest.Stops[0].Alighting)
+	assert.Equal(t, []string{"김민준", "박서연"}, manifestNames(manifest.Stops[2].Alighting))
+
+	minjun := manifest.Stops[0].Boarding[0]
+	assert.True(t, minjun.MedicalAlert)
+	assert.Equal(t, "땅콩 알레르기", minjun.MedicalNotes)
+	assert.Equal(t, string(service.ManifestStatusExpected), minjun.Status)
+	assert.Equal(t, []dto.ManifestGuardianContact{{Name: "김엄마", Phone: "010-2222-3333", Relation: "모"}}, minjun.Guardians)
+	seoyeon := manifest.Stops[1].Boarding[0]
+	assert.False(t, seoyeon.MedicalAlert)
+	assert.Equal(t, []dto.ManifestGuardianContact{{Name: "박보호", Phone: "010-8765-4321"}}, seoyeon.Guardians, "연결된 보호자가 없으면 탑승자 정보의 연락처")
+}
+
+// TestManifestService_AfternoonWithRecords - 오후 일정은 첫 정류장에서 승차, 배정 정류장에서 하차 (탑승 기록 상태 반영)
+func TestManifestService_AfternoonWithRecords(t *testing.T) {
+	// Given
+	f := newManifestFixture(t, domain.TimeSlotAfternoon)
+	ctx := asPrincipal(domain.RoleAdmin, "")
+	boarded := domain.NewTripPassenger(f.trip.ID, f.minjun.ID, f.stops[0].ID)
+	boarded.BoardPassenger("driver:driver-1", nil)
+	require.NoError(t, f.tripRepo.SavePassenger(ctx, boarded))
+	noShow := domain.NewTripPassenger(f.trip.ID, f.seoyeon.ID, f.stops[1].ID)
+	noShow.MarkNoShow("결석 연락", "driver:driver-1")
+	require.NoError(t, f.tripRepo.SavePassenger(ctx, noShow))
+
+	// When
+	manifest, err := f.svc.Get(ctx, f.trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"김민준", "박서연"}, manifestNames(manifest.Stops[0].Boarding))
+	assert.Equal(t, []string{"김민준"}, manifestNames(manifest.Stops[0].Alighting))
+	assert.Equal(t, []string{"박서연"}, manifestNames(manifest.Stops[1].Alighting))
+	assert.Empty(t, manifest.Stops[2].Alighting)
+	assert.Equal(t, string(service.ManifestStatusBoarded), manifest.Stops[0].Boarding[0].Status)
+	assert.Equal(t, string(service.ManifestStatusNoShow), manifest.Stops[0].Boarding[1].Status)
+}
+
+// TestManifestService_Forbidden - 배정되지 않은 기사/보호자는 조회 불가
+func TestManifestService_Forbidden(t *testing.T) {
+	// Given
+	f := newManifestFixture(t, domain.TimeSlotMorning)
+
+	// When
+	_, otherDriverErr := f.svc.Get(asPrincipal(domain.RoleDriver, "driver-2"), f.trip.ID)
+	_, guardianErr := f.svc.Get(asPrincipal(domain.RoleGuardian, "guardian-1"), f.trip.ID)
+	_, missingErr := f.svc.Get(asPrincipal(domain.RoleAdmin, ""), "missing")
+
+	// Then
+	assertAppError(t, otherDriverErr, util.ErrCodeForbidden)
+	assertAppError(t, guardianErr, util.ErrCodeForbidden)
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+}
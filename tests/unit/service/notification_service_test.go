@@ -0,0 +1,359 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/pkg/alimtalk"
+	"github.com/hyeokjun/eodini/pkg/sms"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newChannelNotificationService - 발송 채널만 검증하는 알림 서비스 (저장소는 빈 인메모리)
+func newChannelNotificationService(devices *service.DeviceService, alimtalkSender alimtalk.Sender, templates map[string]alimtalk.Template, smsSender sms.Sender) *service.NotificationService {
+	return service.NewNotificationService(
+		mocks.NewNotificationPreferenceRepository(), nil, mocks.NewGuardianRepository(), mocks.NewUserRepository(),
+		devices, alimtalkSender, templates, smsSender, service.NotificationRetryPolicy{},
+	)
+}
+
+// TestNotificationService_Notify - 푸시가 한 기기라도 성공하면 문자 생략, 받을 기기가 없으면 문자 대체
+func TestNotificationService_Notify(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	devices := service.NewDeviceService(mocks.NewDeviceTokenRepository(), mocks.NewPushSender())
+	_, err := devices.Register(ctx, "guardian-app", &dto.RegisterDeviceRequest{Token: "token-a", Platform: "android"})
+	require.NoError(t, err)
+	smsSender := mocks.NewSMSSender()
+	svc := newChannelNotificationService(devices, nil, nil, smsSender)
+	notification := &service.Notification{Title: "승차 알림", Body: "민준이가 승차했습니다"}
+
+	// When
+	pushed, pushErr := svc.Notify(ctx, service.Recipient{UserID: "guardian-app", Phone: "010-1111-2222"}, notification)
+	texted, smsErr := svc.Notify(ctx, service.Recipient{UserID: "guardian-noapp", Phone: "010-3333-4444"}, notification)
+
+	// Then
+	require.NoError(t, pushErr)
+	require.NoError(t, smsErr)
+	assert.Equal(t, domain.NotificationChannelPush, pushed)
+	assert.Equal(t, domain.NotificationChannelSMS, texted)
+	require.Len(t, smsSender.Sent, 1)
+	assert.Equal(t, sms.Message{To: "010-3333-4444", Title: "승차 알림", Text: "[어디니] 승차 알림\n민준이가 승차했습니다"}, smsSender.Sent[0])
+}
+
+// TestNotificationService_Notify_Undelivered - 기기도 연락처도 없으면 에러, 문자 중계사 장애는 그대로 반환
+func TestNotificationService_Notify_Undelivered(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	smsSender := mocks.NewSMSSender()
+	svc := newChannelNotificationService(service.NewDeviceService(mocks.NewDeviceTokenRepository(), nil), nil, nil, smsSender)
+	notification := &service.Notification{Title: "하차 알림", Body: "민준이가 하차했습니다"}
+
+	// When
+	_, noContactErr := svc.Notify(ctx, service.Recipient{UserID: "guardian-1"}, notification)
+	smsSender.Err = errors.New("sms: aligo result -101: 인증오류입니다.")
+	_, providerErr := svc.Notify(ctx, service.Recipient{Phone: "01012345678"}, notification)
+	_, disabledErr := newChannelNotificationService(nil, nil, nil, nil).Notify(ctx, service.Recipient{Phone: "01012345678"}, notification)
+
+	// Then
+	assert.ErrorIs(t, noContactErr, service.ErrNotificationUndelivered)
+	assert.EqualError(t, providerErr, "sms: aligo result -101: 인증오류입니다.")
+	assert.ErrorIs(t, disabledErr, service.ErrNotificationUndelivered)
+}
+
+// TestNotificationService_Notify_Alimtalk - 등록된 템플릿은 알림톡 발송, 실패하거나 템플릿이 없으면 문자로 대체
+func TestNotificationService_Notify_Alimtalk(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	alimtalkSender := mocks.NewAlimtalkSender()
+	smsSender := mocks.NewSMSSender()
+	templates := map[string]alimtalk.Template{
+		"boarded": {Code: "EODINI_BOARD_01", Text: "#{passenger} 어린이가 #{stop}에서 승차했습니다."},
+	}
+	svc := newChannelNotificationService(service.NewDeviceService(mocks.NewDeviceTokenRepository(), nil), alimtalkSender, templates, smsSender)
+	recipient := service.Recipient{UserID: "guardian-1", Phone: "01012345678"}
+	boarded := &service.Notification{
+		Title:     "승차 알림",
+		Body:      "민준이가 정문에서 승차했습니다",
+		Template:  "boarded",
+		Variables: map[string]string{"passenger": "김민준", "stop": "정문"},
+	}
+
+	// When
+	channel, err := svc.Notify(ctx, recipient, boarded)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.NotificationChannelAlimtalk, channel)
+	require.Len(t, alimtalkSender.Sent, 1)
+	assert.Equal(t, "김민준 어린이가 정문에서 승차했습니다.", alimtalkSender.Sent[0].Text)
+	assert.Empty(t, smsSender.Sent)
+
+	// When - 템플릿 미등록, 변수 누락, 알림톡 발송 실패
+	unregistered, unregisteredErr := svc.Notify(ctx, recipient, &service.Notification{Title: "지연 안내", Body: "10분 지연", Template: "delay"})
+	missing, missingErr := svc.Notify(ctx, recipient, &service.Notification{Title: "승차 알림", Body: "승차", Template: "boarded"})
+	alimtalkSender.Err = errors.New("alimtalk: aligo result -99: 템플릿 불일치")
+	failed, failedErr := svc.Notify(ctx, recipient, boarded)
+
+	// Then
+	require.NoError(t, unregisteredErr)
+	require.NoError(t, missingErr)
+	require.NoError(t, failedErr)
+	assert.Equal(t, []domain.NotificationChannel{domain.NotificationChannelSMS, domain.NotificationChannelSMS, domain.NotificationChannelSMS}, []domain.NotificationChannel{unregistered, missing, failed})
+	assert.Len(t, smsSender.Sent, 3)
+
+	// When - 문자 미설정이면 알림톡 실패를 그대로 반환
+	_, err = newChannelNotificationService(nil, alimtalkSender, templates, nil).Notify(ctx, recipient, boarded)
+
+	// Then
+	assert.EqualError(t, err, "alimtalk: aligo result -99: 템플릿 불일치")
+}
+
+// TestNotificationService_Preference - 설정 전에는 기본값(모든 채널/알림), 변경하면 목록 교체
+func TestNotificationService_Preference(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	svc := newChannelNotificationService(nil, nil, nil, nil)
+
+	// When
+	before, err := svc.GetPreference(ctx, "guardian-1")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.AllNotificationChannels, before.Channels)
+	assert.Equal(t, domain.AllNotificationEvents, before.Events)
+
+	// When
+	_, err = svc.UpdatePreference(ctx, "guardian-1", &dto.UpdateNotificationPreferenceRequest{
+		Channels: []string{"alimtalk"},
+		Events:   []string{"boarded", "alighted"},
+	})
+	require.NoError(t, err)
+	after, err := svc.GetPreference(ctx, "guardian-1")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []domain.NotificationChannel{domain.NotificationChannelAlimtalk}, after.Channels)
+	assert.True(t, after.WantsEvent(domain.NotificationEventAlighted))
+	assert.False(t, after.WantsEvent(domain.NotificationEventApproaching))
+}
+
+// TestNotificationService_NotifyGuardian - 보호자 계정 기기로 푸시, 설정에서 끈 알림 종류/채널은 보내지 않음
+func TestNotificationService_NotifyGuardian(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	guardianRepo := mocks.NewGuardianRepository()
+	guardian := domain.NewGuardian("김보호", "010-1234-5678")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	userRepo := mocks.NewUserRepository()
+	account := domain.NewUser("parent@example.com", "hash", domain.RoleGuardian, guardian.ID)
+	require.NoError(t, userRepo.Create(ctx, account))
+	devices := service.NewDeviceService(mocks.NewDeviceTokenRepository(), mocks.NewPushSender())
+	_, err := devices.Register(ctx, account.ID, &dto.RegisterDeviceRequest{Token: "token-a", Platform: "ios"})
+	require.NoError(t, err)
+	smsSender := mocks.NewSMSSender()
+	svc := service.NewNotificationService(mocks.NewNotificationPreferenceRepository(), nil, guardianRepo, userRepo, devices, nil, nil, smsSender, service.NotificationRetryPolicy{})
+	notification := &service.Notification{Title: "승차 알림", Body: "민준이가 승차했습니다"}
+
+	// When
+	channel, err := svc.NotifyGuardian(ctx, guardian.ID, domain.NotificationEventBoarded, notification)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.NotificationChannelPush, channel)
+
+	// Given - 푸시를 끄고 문자만, 접근 알림은 받지 않음
+	_, err = svc.UpdatePreference(ctx, guardian.ID, &dto.UpdateNotificationPreferenceRequest{
+		Channels: []string{"sms"},
+		Events:   []string{"boarded", "alighted", "delay"},
+	})
+	require.NoError(t, err)
+
+	// When
+	channel, err = svc.NotifyGuardian(ctx, guardian.ID, domain.NotificationEventBoarded, notification)
+	_, optedOutErr := svc.NotifyGuardian(ctx, guardian.ID, domain.NotificationEventApproaching, notification)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.NotificationChannelSMS, channel)
+	require.Len(t, smsSender.Sent, 1)
+	assert.Equal(t, "010-1234-5678", smsSender.Sent[0].To)
+	assert.ErrorIs(t, optedOutErr, service.ErrNotificationOptedOut)
+
+	// Given - 모든 채널 끔
+	_, err = svc.UpdatePreference(ctx, guardian.ID, &dto.UpdateNotificationPreferenceRequest{Channels: []string{}, Events: []string{"boarded"}})
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.NotifyGuardian(ctx, guardian.ID, domain.NotificationEventBoarded, notification)
+
+	// Then
+	assert.ErrorIs(t, err, service.ErrNotificationUndelivered)
+	assert.Len(t, smsSender.Sent, 1)
+}
+
+// newLoggedNotificationService - 발송 기록/재시도를 검증하는 알림 서비스 (문자 채널만)
+func newLoggedNotificationService(logRepo *mocks.NotificationLogRepository, smsSender sms.Sender, maxAttempts int) *service.NotificationService {
+	return service.NewNotificationService(
+		mocks.NewNotificationPreferenceRepository(), logRepo, mocks.NewGuardianRepository(), mocks.NewUserRepository(),
+		nil, nil, nil, smsSender, service.NotificationRetryPolicy{MaxAttempts: maxAttempts, Backoff: time.Minute, MaxBackoff: 3 * time.Minute},
+	)
+}
+
+// TestNotificationService_RetryDue - 일시 장애는 지수 백오프로 재시도, 성공하면 sent
+func TestNotificationService_RetryDue(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	logRepo := mocks.NewNotificationLogRepository()
+	smsSender := mocks.NewSMSSender()
+	smsSender.Err = errors.New("sms: unexpected status 503")
+	svc := newLoggedNotificationService(logRepo, smsSender, 5)
+	start := time.Now()
+
+	// When
+	_, err := svc.Notify(ctx, service.Recipient{Phone: "010-1234-5678"}, &service.Notification{Title: "승차 알림", Body: "민준이가 승차했습니다"})
+
+	// Then - 첫 실패는 1분 뒤 재시도 예약
+	require.Error(t, err)
+	logs, _, _ := logRepo.List(ctx, repository.NotificationLogFilter{})
+	require.Len(t, logs, 1)
+	assert.Equal(t, domain.NotificationStatusRetrying, logs[0].Status)
+	assert.Equal(t, 1, logs[0].Attempts)
+	assert.Contains(t, logs[0].Response, "503")
+	assert.WithinDuration(t, start.Add(time.Minute), *logs[0].NextAttemptAt, 5*time.Second)
+
+	// When - 아직 때가 아님
+	retried, err := svc.RetryDue(ctx, start)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, retried)
+
+	// When - 재시도도 실패하면 대기 시간 2배
+	retried, err = svc.RetryDue(ctx, start.Add(2*time.Minute))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, retried)
+	logs, _, _ = logRepo.List(ctx, repository.NotificationLogFilter{})
+	assert.Equal(t, 2, logs[0].Attempts)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), *logs[0].NextAttemptAt, 5*time.Second)
+
+	// When - 중계사 복구 후 재시도
+	smsSender.Err = nil
+	retried, err = svc.RetryDue(ctx, start.Add(10*time.Minute))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, retried)
+	logs, _, _ = logRepo.List(ctx, repository.NotificationLogFilter{Status: domain.NotificationStatusSent})
+	require.Len(t, logs, 1)
+	assert.Equal(t, domain.NotificationChannelSMS, logs[0].Channel)
+	assert.Equal(t, 3, logs[0].Attempts)
+	assert.Nil(t, logs[0].NextAttemptAt)
+	assert.Len(t, smsSender.Sent, 1)
+}
+
+// TestNotificationService_LogOrganization - 발송 기록은 발송한 기관 소속, 기관이 없는 작업(운영 연락처 요약)은 플랫폼 알림
+func TestNotificationService_LogOrganization(t *testing.T) {
+	// Given
+	logRepo := mocks.NewNotificationLogRepository()
+	svc := newLoggedNotificationService(logRepo, mocks.NewSMSSender(), 3)
+	notification := &service.Notification{Title: "지연 알림", Body: "출발이 늦어지고 있습니다"}
+
+	// When
+	_, err := svc.Notify(repository.WithOrganization(context.Background(), "org-1"), service.Recipient{Phone: "010-1234-5678"}, notification)
+	require.NoError(t, err)
+	_, err = svc.Notify(context.Background(), service.Recipient{Phone: "010-9999-0000"}, notification)
+	require.NoError(t, err)
+
+	// Then
+	logs, _, _ := logRepo.List(context.Background(), repository.NotificationLogFilter{})
+	require.Len(t, logs, 2)
+	organizations := []string{logs[0].OrganizationID, logs[1].OrganizationID}
+	assert.ElementsMatch(t, []string{"org-1", ""}, organizations)
+}
+
+// TestNotificationService_DeadLetter - 최대 시도 횟수를 넘으면 dead, 연락처 없는 알림은 재시도 없이 failed
+func TestNotificationService_DeadLetter(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	logRepo := mocks.NewNotificationLogRepository()
+	smsSender := mocks.NewSMSSender()
+	smsSender.Err = errors.New("sms: request failed: timeout")
+	svc := newLoggedNotificationService(logRepo, smsSender, 2)
+	notification := &service.Notification{Title: "하차 알림", Body: "민준이가 하차했습니다"}
+
+	// When
+	_, _ = svc.Notify(ctx, service.Recipient{Phone: "01012345678"}, notification)
+	_, _ = svc.RetryDue(ctx, time.Now().Add(time.Hour))
+	_, undeliveredErr := svc.Notify(ctx, service.Recipient{}, notification)
+
+	// Then
+	assert.ErrorIs(t, undeliveredErr, service.ErrNotificationUndelivered)
+	dead, _, _ := logRepo.List(ctx, repository.NotificationLogFilter{Status: domain.NotificationStatusDead})
+	require.Len(t, dead, 1)
+	assert.Equal(t, 2, dead[0].Attempts)
+	assert.Nil(t, dead[0].NextAttemptAt)
+	failed, _, _ := logRepo.List(ctx, repository.NotificationLogFilter{Status: domain.NotificationStatusFailed})
+	require.Len(t, failed, 1)
+	assert.Equal(t, 1, failed[0].Attempts)
+
+	// When - dead/failed는 더 이상 재시도하지 않음
+	retried, err := svc.RetryDue(ctx, time.Now().Add(24*time.Hour))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, retried)
+}
+
+// TestSMSAlertNotifier_NotifyAlert - 운영 알림 수신자 전원에게 한국 시간 기준으로 발송
+func TestSMSAlertNotifier_NotifyAlert(t *testing.T) {
+	// Given
+	sender := mocks.NewSMSSender()
+	operators := service.NewOperatorContacts(mocks.NewUserRepository(), mocks.NewOrganizationRepository(), []string{"01011112222", "01033334444"})
+	notifier := service.SMSAlertNotifier{Sender: sender, Operators: operators}
+	alert := &domain.TripAlert{
+		TripID:     "trip-1",
+		Type:       domain.AlertSpeeding,
+		Value:      92,
+		Threshold:  80,
+		OccurredAt: time.Date(2025, 3, 3, 23, 10, 5, 0, time.UTC),
+	}
+
+	// When
+	err := notifier.NotifyAlert(context.Background(), alert)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, sender.Sent, 2)
+	assert.Equal(t, "01033334444", sender.Sent[1].To)
+	assert.Contains(t, sender.Sent[0].Text, "측정 92km/h (기준 80km/h)")
+	assert.Contains(t, sender.Sent[0].Text, "03/04 08:10:05")
+}
+
+// TestSMSPasswordResetNotifier - 연락처가 없는 계정은 발송하지 않음
+func TestSMSPasswordResetNotifier(t *testing.T) {
+	// Given
+	sender := mocks.NewSMSSender()
+	notifier := service.SMSPasswordResetNotifier{Sender: sender}
+	ctx := context.Background()
+
+	// When
+	noPhoneErr := notifier.SendPasswordReset(ctx, &domain.User{Email: "a@example.com"}, "reset-token")
+	err := notifier.SendPasswordReset(ctx, &domain.User{Email: "b@example.com", Phone: "01012345678"}, "reset-token")
+
+	// Then
+	require.Error(t, noPhoneErr)
+	require.NoError(t, err)
+	require.Len(t, sender.Sent, 1)
+	assert.Contains(t, sender.Sent[0].Text, "reset-token")
+}
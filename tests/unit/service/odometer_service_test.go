@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int { return &v }
+
+// TestOdometerService_FinalizeTrip - 기사 확인 값 우선, 없으면 기준값 + 주행 거리, 줄어드는 값은 거부
+func TestOdometerService_FinalizeTrip(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	vehicleRepo := mocks.NewVehicleRepository()
+	readingRepo := mocks.NewOdometerReadingRepository()
+	van := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, van))
+	svc := service.NewOdometerService(vehicleRepo, readingRepo, mocks.NewTxManager(), nil)
+
+	// When - 기준값이 없으면 주행 거리만으로 갱신하지 않음
+	unset := &domain.Trip{ID: "trip-1", VehicleID: van.ID, TotalDistance: 5000}
+	require.NoError(t, svc.FinalizeTrip(ctx, unset))
+
+	// Then
+	assert.Nil(t, unset.OdometerKm)
+	stored, err := vehicleRepo.GetByID(ctx, van.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.HasOdometer())
+
+	// When - 기준값 입력 후 주행 거리(12.4 km)로 계산
+	_, err = svc.SetOdometer(ctx, van.ID, &dto.UpdateOdometerRequest{OdometerKm: intPtr(10000)})
+	require.NoError(t, err)
+	computed := &domain.Trip{ID: "trip-2", VehicleID: van.ID, TotalDistance: 12400}
+	require.NoError(t, svc.FinalizeTrip(ctx, computed))
+
+	// Then
+	require.NotNil(t, computed.OdometerKm)
+	assert.Equal(t, 10012, *computed.OdometerKm)
+
+	// When - 기사 확인 값이 있으면 그대로 사용
+	confirmed := &domain.Trip{ID: "trip-3", VehicleID: van.ID, TotalDistance: 3000, OdometerKm: intPtr(10050)}
+	require.NoError(t, svc.FinalizeTrip(ctx, confirmed))
+	backwards := &domain.Trip{ID: "trip-4", VehicleID: van.ID, OdometerKm: intPtr(10040)}
+	backwardsErr := svc.FinalizeTrip(ctx, backwards)
+	missingErr := svc.FinalizeTrip(ctx, &domain.Trip{ID: "trip-5", VehicleID: "missing", OdometerKm: intPtr(1)})
+
+	// Then
+	assertAppError(t, backwardsErr, util.ErrCodeValidation)
+	assert.NoError(t, missingErr, "삭제된 차량은 운행 완료를 막지 않음")
+	stored, err = vehicleRepo.GetByID(ctx, van.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 10050, stored.OdometerKm)
+
+	readings, err := readingRepo.List(ctx, repository.OdometerReadingFilter{VehicleID: van.ID})
+	require.NoError(t, err)
+	require.Len(t, readings, 3)
+	sources := map[domain.OdometerSource]int{}
+	for _, reading := range readings {
+		sources[reading.Source] = reading.OdometerKm
+		assert.Equal(t, "user-driver-1", reading.RecordedBy)
+	}
+	assert.Equal(t, map[domain.OdometerSource]int{
+		domain.OdometerSourceManual:       10000,
+		domain.OdometerSourceTripDistance: 10012,
+		domain.OdometerSourceTrip:         10050,
+	}, sources)
+}
+
+// TestOdometerService_SetOdometer - 관리자 입력은 줄어드는 값도 허용, 이력은 최신 순
+func TestOdometerService_SetOdometer(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleAdmin, "admin-1")
+	vehicleRepo := mocks.NewVehicleRepository()
+	van := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, van))
+	svc := service.NewOdometerService(vehicleRepo, mocks.NewOdometerReadingRepository(), mocks.NewTxManager(), nil)
+
+	// When
+	_, err := svc.SetOdometer(ctx, van.ID, &dto.UpdateOdometerRequest{OdometerKm: intPtr(150000)})
+	require.NoError(t, err)
+	replaced, err := svc.SetOdometer(ctx, van.ID, &dto.UpdateOdometerRequest{OdometerKm: intPtr(0)}) // 계기판 교체
+	_, missingErr := svc.SetOdometer(ctx, "missing", &dto.UpdateOdometerRequest{OdometerKm: intPtr(10)})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, replaced.OdometerKm)
+	assert.True(t, replaced.HasOdometer())
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+
+	history, err := svc.History(ctx, van.ID, &dto.OdometerReadingQuery{})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 0, history[0].OdometerKm)
+	assert.Equal(t, 150000, history[1].OdometerKm)
+
+	_, err = svc.History(ctx, "missing", &dto.OdometerReadingQuery{})
+	assertAppError(t, err, util.ErrCodeNotFound)
+}
+
+// TestTripService_CompleteWithOdometer - 기사 확인 값이 차량 주행거리계보다 작으면 운행 완료 거부
+func TestTripService_CompleteWithOdometer(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	vehicleRepo := mocks.NewVehicleRepository()
+	van := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, van))
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", van.ID, "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	odometerService := service.NewOdometerService(vehicleRepo, mocks.NewOdometerReadingRepository(), mocks.NewTxManager(), nil)
+	_, err := odometerService.SetOdometer(ctx, van.ID, &dto.UpdateOdometerRequest{OdometerKm: intPtr(20000)})
+	require.NoError(t, err)
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil, odometerService)
+	trip, err := tripService.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2025-03-03"})
+	require.NoError(t, err)
+	_, err = tripService.Start(ctx, trip.ID, &dto.TripLocationRequest{})
+	require.NoError(t, err)
+	_, err = tripService.ConfirmVehicleEmpty(ctx, trip.ID)
+	require.NoError(t, err)
+
+	// When
+	_, backwardsErr := tripService.Complete(ctx, trip.ID, &dto.CompleteTripRequest{OdometerKm: intPtr(19990)})
+
+	// Then
+	assertAppError(t, backwardsErr, util.ErrCodeValidation)
+	stored, err := tripRepo.GetByID(ctx, trip.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripStatusInProgress, stored.Status)
+
+	// When
+	completed, err := tripService.Complete(ctx, trip.ID, &dto.CompleteTripRequest{OdometerKm: intPtr(20035)})
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, completed.OdometerKm)
+	assert.Equal(t, 20035, *completed.OdometerKm)
+	vehicle, err := vehicleRepo.GetByID(ctx, van.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 20035, vehicle.OdometerKm)
+}
@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOperatorContacts_Operators - 기관 활성 관리자 → 기관 대표 연락처 → 플랫폼 운영 연락처 순, 다른 기관 관리자는 제외
+func TestOperatorContacts_Operators(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	userRepo := mocks.NewUserRepository()
+	organizationRepo := mocks.NewOrganizationRepository()
+	withAdmins := domain.NewOrganization("해오름 어린이집", domain.OrganizationTypeKindergarten)
+	withPhone := domain.NewOrganization("새싹 학원", domain.OrganizationTypeAcademy)
+	phone := "02-123-4567"
+	withPhone.Phone = &phone
+	empty := domain.NewOrganization("햇살 요양원", domain.OrganizationTypeClinic)
+	for _, organization := range []*domain.Organization{withAdmins, withPhone, empty} {
+		require.NoError(t, organizationRepo.Create(ctx, organization))
+	}
+
+	addAdmin := func(organizationID, email, phone string, status domain.UserStatus) *domain.User {
+		admin := domain.NewUser(email, "hash", domain.RoleAdmin, "")
+		admin.OrganizationID, admin.Phone, admin.Status = organizationID, phone, status
+		require.NoError(t, userRepo.Create(ctx, admin))
+		return admin
+	}
+	active := addAdmin(withAdmins.ID, "admin@haeoreum.kr", "010-1111-0000", domain.UserStatusActive)
+	addAdmin(withAdmins.ID, "left@haeoreum.kr", "010-1111-9999", domain.UserStatusDisabled)
+	noPhone := addAdmin(withPhone.ID, "admin@saessak.kr", "", domain.UserStatusActive)
+	driver := domain.NewUser("driver@haeoreum.kr", "hash", domain.RoleDriver, "driver-1")
+	driver.OrganizationID, driver.Phone = withAdmins.ID, "010-2222-0000"
+	require.NoError(t, userRepo.Create(ctx, driver))
+
+	contacts := service.NewOperatorContacts(userRepo, organizationRepo, []string{"010-9999-0000"})
+
+	// When
+	admins := contacts.Operators(ctx, withAdmins.ID)
+	fallbackPhone := contacts.Operators(ctx, withPhone.ID)
+	platform := contacts.Operators(ctx, empty.ID)
+	unknown := contacts.Operators(ctx, "")
+
+	// Then
+	assert.Equal(t, []service.Operator{{UserID: active.ID, Phone: "010-1111-0000", Email: "admin@haeoreum.kr"}}, admins)
+	assert.Equal(t, []service.Operator{{UserID: noPhone.ID, Email: "admin@saessak.kr"}, {Phone: "02-123-4567"}}, fallbackPhone,
+		"연락처가 있는 관리자가 없으면 기관 대표 연락처도 함께")
+	assert.Equal(t, []service.Operator{{Phone: "010-9999-0000"}}, platform)
+	assert.Equal(t, []service.Operator{{Phone: "010-9999-0000"}}, unknown)
+}
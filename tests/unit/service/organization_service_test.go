@@ -0,0 +1,156 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOrganizationFixture - 기관 두 곳이 등록된 기관 서비스
+func newOrganizationFixture(t *testing.T) (*service.OrganizationService, *domain.Organization, *domain.Organization) {
+	repo := mocks.NewOrganizationRepository()
+	kindergarten := domain.NewOrganization("해바라기 유치원", domain.OrganizationTypeKindergarten)
+	academy := domain.NewOrganization("별빛 학원", domain.OrganizationTypeAcademy)
+	require.NoError(t, repo.Create(context.Background(), kindergarten))
+	require.NoError(t, repo.Create(context.Background(), academy))
+	return service.NewOrganizationService(repo), kindergarten, academy
+}
+
+// TestOrganizationService_Current - 인증 주체의 소속 기관만 조회
+func TestOrganizationService_Current(t *testing.T) {
+	// Given
+	svc, kindergarten, academy := newOrganizationFixture(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "u-1", OrganizationID: academy.ID, Role: domain.RoleDriver})
+
+	// When
+	organization, err := svc.Current(ctx)
+	_, anonymousErr := svc.Current(context.Background())
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, academy.ID, organization.ID)
+	assert.NotEqual(t, kindergarten.ID, organization.ID)
+	appErr, ok := anonymousErr.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeUnauthorized, appErr.Code)
+}
+
+// TestOrganizationService_CurrentSuperAdmin - 플랫폼 운영자는 지정한 기관, 지정하지 않으면 400
+func TestOrganizationService_CurrentSuperAdmin(t *testing.T) {
+	// Given
+	svc, kindergarten, _ := newOrganizationFixture(t)
+	targeted := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "operator-1", OrganizationID: kindergarten.ID, Role: domain.RoleSuperAdmin})
+	unscoped := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "operator-1", Role: domain.RoleSuperAdmin})
+
+	// When
+	organization, err := svc.Current(targeted)
+	_, unscopedErr := svc.Current(unscoped)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, kindergarten.ID, organization.ID)
+	assert.Equal(t, util.ErrCodeBadRequest, unscopedErr.(*util.AppError).Code)
+}
+
+// TestOrganizationService_Update - 전달한 필드만 수정, 빈 연락처는 삭제
+func TestOrganizationService_Update(t *testing.T) {
+	// Given
+	svc, kindergarten, _ := newOrganizationFixture(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin-1", OrganizationID: kindergarten.ID, Role: domain.RoleAdmin})
+	name, phone, address := "해바라기 어린이집", "02-123-4567", "서울시 마포구"
+	_, err := svc.Update(ctx, &dto.UpdateOrganizationRequest{Phone: &phone, Address: &address})
+	require.NoError(t, err)
+
+	// When
+	empty := ""
+	updated, err := svc.Update(ctx, &dto.UpdateOrganizationRequest{Name: &name, Phone: &empty})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, name, updated.Name)
+	assert.Equal(t, domain.OrganizationTypeKindergarten, updated.Type)
+	assert.Nil(t, updated.Phone)
+	require.NotNil(t, updated.Address)
+	assert.Equal(t, address, *updated.Address)
+}
+
+// TestOrganizationService_UpdateSettings - 표시 이름/로고 수정, Origin은 정규화 후 전체 교체 (형식이 틀리면 400)
+func TestOrganizationService_UpdateSettings(t *testing.T) {
+	// Given
+	svc, kindergarten, _ := newOrganizationFixture(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin-1", OrganizationID: kindergarten.ID, Role: domain.RoleAdmin})
+	displayName, logo := "해바라기", "https://cdn.example.com/sunflower.png"
+	origins := []string{"https://Sunflower.example.com/", "https://sunflower.example.com", "http://localhost:5173"}
+	invalidOrigins := []string{"https://sunflower.example.com/app"}
+	invalidLogo := "javascript:alert(1)"
+
+	// When
+	before, err := svc.Settings(ctx)
+	require.NoError(t, err)
+	updated, err := svc.UpdateSettings(ctx, &dto.UpdateOrganizationSettingsRequest{DisplayName: &displayName, LogoURL: &logo, AllowedOrigins: &origins})
+	_, originErr := svc.UpdateSettings(ctx, &dto.UpdateOrganizationSettingsRequest{AllowedOrigins: &invalidOrigins})
+	_, logoErr := svc.UpdateSettings(ctx, &dto.UpdateOrganizationSettingsRequest{LogoURL: &invalidLogo})
+
+	// Then
+	assert.Equal(t, "해바라기 유치원", before.DisplayName) // 설정 전에는 기관명
+	assert.Empty(t, before.AllowedOrigins)
+	require.NoError(t, err)
+	assert.Equal(t, "해바라기", updated.DisplayName)
+	assert.Equal(t, logo, *updated.LogoURL)
+	assert.Equal(t, []string{"https://sunflower.example.com", "http://localhost:5173"}, updated.AllowedOrigins)
+	assert.Equal(t, util.ErrCodeValidation, originErr.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeValidation, logoErr.(*util.AppError).Code)
+}
+
+// TestOrganizationService_IsAllowedOrigin - 어느 기관이든 등록한 Origin 허용, 설정을 바꾸면 바로 반영
+func TestOrganizationService_IsAllowedOrigin(t *testing.T) {
+	// Given
+	svc, kindergarten, academy := newOrganizationFixture(t)
+	asAdmin := func(organizationID string) context.Context {
+		return auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin-1", OrganizationID: organizationID, Role: domain.RoleAdmin})
+	}
+	kindergartenOrigins := []string{"https://sunflower.example.com"}
+	academyOrigins := []string{"https://starlight.example.com"}
+	_, err := svc.UpdateSettings(asAdmin(kindergarten.ID), &dto.UpdateOrganizationSettingsRequest{AllowedOrigins: &kindergartenOrigins})
+	require.NoError(t, err)
+	require.False(t, svc.IsAllowedOrigin(context.Background(), "https://starlight.example.com"))
+
+	// When
+	_, err = svc.UpdateSettings(asAdmin(academy.ID), &dto.UpdateOrganizationSettingsRequest{AllowedOrigins: &academyOrigins})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, svc.IsAllowedOrigin(context.Background(), "https://sunflower.example.com"))
+	assert.True(t, svc.IsAllowedOrigin(context.Background(), "https://starlight.example.com"))
+	assert.False(t, svc.IsAllowedOrigin(context.Background(), "https://evil.example.com"))
+}
+
+// TestOrganizationService_Timezone - 시간대 설정 검증 후 바로 반영 (기본은 한국 시간)
+func TestOrganizationService_Timezone(t *testing.T) {
+	// Given
+	svc, kindergarten, _ := newOrganizationFixture(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin-1", OrganizationID: kindergarten.ID, Role: domain.RoleAdmin})
+	require.Equal(t, "Asia/Seoul", svc.Location(ctx, kindergarten.ID).String())
+	timezone, invalid := "America/Los_Angeles", "Mars/Olympus"
+
+	// When
+	updated, err := svc.UpdateSettings(ctx, &dto.UpdateOrganizationSettingsRequest{Timezone: &timezone})
+	_, invalidErr := svc.UpdateSettings(ctx, &dto.UpdateOrganizationSettingsRequest{Timezone: &invalid})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, timezone, updated.Timezone)
+	assert.Equal(t, timezone, svc.Location(ctx, kindergarten.ID).String())
+	assert.Equal(t, util.ErrCodeValidation, invalidErr.(*util.AppError).Code)
+	_, offset := time.Now().In(svc.Location(ctx, "unknown-organization")).Zone()
+	assert.Equal(t, 9*60*60, offset) // 기관을 읽지 못하면 한국 시간
+}
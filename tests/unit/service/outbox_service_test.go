@@ -0,0 +1,139 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOutboxService_Relay - 기록한 이벤트를 핸들러에 전달, 실패한 핸들러만 백오프 후 재시도, 최대 횟수 초과는 dead
+func TestOutboxService_Relay(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	outboxRepo := mocks.NewOutboxRepository()
+	var webhookCalls, notificationCalls []event.Event
+	notificationErr := errors.New("notification down")
+	dispatcher := event.NewDispatcher()
+	dispatcher.Subscribe("webhook", func(ctx context.Context, e event.Event) error {
+		webhookCalls = append(webhookCalls, e)
+		return nil
+	})
+	dispatcher.Subscribe("notification", func(ctx context.Context, e event.Event) error {
+		notificationCalls = append(notificationCalls, e)
+		return notificationErr
+	}, string(domain.WebhookEventPassengerBoarded))
+	svc := service.NewOutboxService(outboxRepo, dispatcher, service.NotificationRetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: time.Hour})
+
+	require.NoError(t, svc.Publish(ctx, domain.WebhookEventTripStarted, "org-1", "trip-1", &dto.TripWebhookData{TripID: "trip-1"}))
+	require.NoError(t, svc.Publish(ctx, domain.WebhookEventPassengerBoarded, "org-1", "trip-1", &dto.PassengerWebhookData{TripID: "trip-1", PassengerID: "passenger-1"}))
+	now := time.Now()
+
+	// When
+	relayed, err := svc.Relay(ctx, now)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, relayed)
+	require.Len(t, webhookCalls, 2)
+	assert.Equal(t, "trip.started", webhookCalls[0].Type)
+	assert.Equal(t, "org-1", webhookCalls[0].OrganizationID)
+	var data dto.PassengerWebhookData
+	require.NoError(t, webhookCalls[1].Decode(&data))
+	assert.Equal(t, "passenger-1", data.PassengerID)
+
+	events := outboxRepo.All()
+	assert.Equal(t, "org-1", events[0].OrganizationID)
+	assert.Equal(t, domain.OutboxPublished, events[0].Status)
+	assert.NotNil(t, events[0].PublishedAt)
+	assert.Equal(t, webhookCalls[0].ID, events[0].ID)
+	assert.Equal(t, domain.OutboxPending, events[1].Status)
+	assert.Equal(t, []string{"webhook"}, events[1].Handled)
+	assert.Equal(t, 1, events[1].Attempts)
+	assert.Contains(t, events[1].LastError, "notification down")
+	assert.WithinDuration(t, now.Add(time.Minute), events[1].AvailableAt, 5*time.Second)
+
+	// When - 재시도 시각 전
+	relayed, err = svc.Relay(ctx, now.Add(30*time.Second))
+
+	// Then
+	require.NoError(t, err)
+	assert.Zero(t, relayed)
+
+	// When - 재시도 (웹훅은 다시 전달하지 않음), 핸들러 복구
+	notificationErr = nil
+	relayed, err = svc.Relay(ctx, now.Add(2*time.Minute))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Len(t, webhookCalls, 2)
+	assert.Len(t, notificationCalls, 2)
+	assert.Equal(t, notificationCalls[0].ID, notificationCalls[1].ID)
+	published := outboxRepo.All()[1]
+	assert.Equal(t, domain.OutboxPublished, published.Status)
+	assert.Equal(t, []string{"webhook", "notification"}, published.Handled)
+	assert.Empty(t, published.LastError)
+
+	// When - 계속 실패하면 최대 시도 횟수에서 dead
+	notificationErr = errors.New("notification down")
+	require.NoError(t, svc.Publish(ctx, domain.WebhookEventPassengerBoarded, "org-1", "trip-1", &dto.PassengerWebhookData{TripID: "trip-1"}))
+	later := time.Now()
+	for _, at := range []time.Duration{0, 2 * time.Minute, 10 * time.Minute} {
+		_, err = svc.Relay(ctx, later.Add(at))
+		require.NoError(t, err)
+	}
+
+	// Then
+	dead := outboxRepo.All()[2]
+	assert.Equal(t, domain.OutboxDead, dead.Status)
+	assert.Equal(t, 3, dead.Attempts)
+	_, err = svc.Relay(ctx, later.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 3, outboxRepo.All()[2].Attempts, "dead 이벤트는 다시 전달하지 않음")
+}
+
+// TestOutboxService_Relay_Organization - 핸들러는 이벤트가 발생한 기관으로 제한한 context로 실행
+func TestOutboxService_Relay_Organization(t *testing.T) {
+	// Given
+	outboxRepo := mocks.NewOutboxRepository()
+	scoped := map[string]string{}
+	dispatcher := event.NewDispatcher()
+	dispatcher.Subscribe("webhook", func(ctx context.Context, e event.Event) error {
+		scoped[e.ID] = repository.OrganizationFromContext(ctx)
+		return nil
+	})
+	svc := service.NewOutboxService(outboxRepo, dispatcher, service.NotificationRetryPolicy{MaxAttempts: 3})
+	require.NoError(t, svc.Publish(context.Background(), domain.WebhookEventTripStarted, "org-1", "trip-1", &dto.TripWebhookData{TripID: "trip-1"}))
+	require.NoError(t, svc.Publish(context.Background(), domain.WebhookEventTripStarted, "org-2", "trip-2", &dto.TripWebhookData{TripID: "trip-2"}))
+
+	// When
+	_, err := svc.Relay(context.Background(), time.Now())
+
+	// Then
+	require.NoError(t, err)
+	events := outboxRepo.All()
+	assert.Equal(t, "org-1", scoped[events[0].ID])
+	assert.Equal(t, "org-2", scoped[events[1].ID])
+}
+
+// TestOutboxService_Publish_Error - 기록 실패는 에러로 반환 (호출한 트랜잭션 롤백)
+func TestOutboxService_Publish_Error(t *testing.T) {
+	outboxRepo := mocks.NewOutboxRepository()
+	outboxRepo.Err = errors.New("db down")
+	svc := service.NewOutboxService(outboxRepo, nil, service.NotificationRetryPolicy{})
+
+	err := svc.Publish(context.Background(), domain.WebhookEventTripStarted, "org-1", "trip-1", &dto.TripWebhookData{TripID: "trip-1"})
+
+	assert.Error(t, err)
+	assert.Empty(t, outboxRepo.All())
+}
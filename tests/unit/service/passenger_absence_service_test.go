@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// absenceFixture - 보호자에 연결된 탑승자와 오전 일정의 내일 운행(탑승 기록 포함)
+type absenceFixture struct {
+	svc       *service.PassengerAbsenceService
+	tripRepo  *mocks.TripRepository
+	passenger *domain.Passenger
+	guardian  *domain.Guardian
+	trip      *domain.Trip
+	tomorrow  string
+}
+
+func newAbsenceFixture(t *testing.T) *absenceFixture {
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	passenger.AssignToStop("route-1", "stop-1", 1)
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	guardian := domain.NewGuardian("김보호", "010-1234-5678")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	require.NoError(t, guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{0, 1, 2, 3, 4, 5, 6}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	tomorrow := time.Now().In(time.FixedZone("KST", 9*60*60)).AddDate(0, 0, 1).Format(time.DateOnly)
+	date, _ := time.Parse(time.DateOnly, tomorrow)
+	trip := domain.NewTrip(schedule.ID, date, schedule.VehicleID, schedule.DefaultDriverID, nil)
+	trip.TripPassengers = append(trip.TripPassengers, *domain.NewTripPassenger(trip.ID, passenger.ID, "stop-1"))
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	return &absenceFixture{
+		svc:       service.NewPassengerAbsenceService(mocks.NewPassengerAbsenceRepository(), passengerRepo, guardianRepo, tripRepo, scheduleRepo, nil),
+		tripRepo:  tripRepo,
+		passenger: passenger,
+		guardian:  guardian,
+		trip:      trip,
+		tomorrow:  tomorrow,
+	}
+}
+
+// record - 저장된 운행의 탑승 기록
+func (f *absenceFixture) record(t *testing.T) *domain.TripPassenger {
+	stored, err := f.tripRepo.GetByID(context.Background(), f.trip.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.TripPassengers, 1)
+	return &stored.TripPassengers[0]
+}
+
+// TestPassengerAbsenceService_Create - 연결된 보호자 신고 시 그날 운행 탑승 기록을 결석 신고로 표시
+func TestPassengerAbsenceService_Create(t *testing.T) {
+	// Given
+	f := newAbsenceFixture(t)
+	ctx := asPrincipal(domain.RoleGuardian, f.guardian.ID)
+
+	// When
+	absence, err := f.svc.Create(ctx, f.passenger.ID, &dto.CreatePassengerAbsenceRequest{Date: f.tomorrow, TimeSlot: "morning", Reason: "병원 진료"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "user-"+f.guardian.ID, absence.ReportedBy)
+	record := f.record(t)
+	assert.True(t, record.IsExcused())
+	assert.Equal(t, "병원 진료", record.ExcusedReason)
+	assert.False(t, record.IsNoShow())
+
+	absences, err := f.svc.List(ctx, f.passenger.ID)
+	require.NoError(t, err)
+	assert.Len(t, absences, 1)
+}
+
+// TestPassengerAbsenceService_Create_Rejected - 연결 없는 보호자/기사, 지난 날짜, 시간대 겹침 거부
+func TestPassengerAbsenceService_Create_Rejected(t *testing.T) {
+	// Given
+	f := newAbsenceFixture(t)
+	guardianCtx := asPrincipal(domain.RoleGuardian, f.guardian.ID)
+	_, err := f.svc.Create(guardianCtx, f.passenger.ID, &dto.CreatePassengerAbsenceRequest{Date: f.tomorrow})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		req     dto.CreatePassengerAbsenceRequest
+		wantErr string
+	}{
+		{name: "연결 없는 보호자", ctx: asPrincipal(domain.RoleGuardian, "guardian-other"), req: dto.CreatePassengerAbsenceRequest{Date: f.tomorrow}, wantErr: util.ErrCodeForbidden},
+		{name: "기사", ctx: asPrincipal(domain.RoleDriver, "driver-1"), req: dto.CreatePassengerAbsenceRequest{Date: f.tomorrow}, wantErr: util.ErrCodeForbidden},
+		{name: "지난 날짜", ctx: guardianCtx, req: dto.CreatePassengerAbsenceRequest{Date: "2020-01-06"}, wantErr: util.ErrCodeValidation},
+		{name: "하루 종일 신고와 겹침", ctx: guardianCtx, req: dto.CreatePassengerAbsenceRequest{Date: f.tomorrow, TimeSlot: "afternoon"}, wantErr: util.ErrCodeDuplicate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			_, err := f.svc.Create(tt.ctx, f.passenger.ID, &tt.req)
+
+			// Then
+			assertAppError(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestPassengerAbsenceService_Create_OtherTimeSlot - 다른 시간대 신고는 오전 운행 탑승 기록에 영향 없음
+func TestPassengerAbsenceService_Create_OtherTimeSlot(t *testing.T) {
+	// Given
+	f := newAbsenceFixture(t)
+
+	// When
+	_, err := f.svc.Create(asPrincipal(domain.RoleAdmin, ""), f.passenger.ID, &dto.CreatePassengerAbsenceRequest{Date: f.tomorrow, TimeSlot: "afternoon"})
+
+	// Then
+	require.NoError(t, err)
+	assert.False(t, f.record(t).IsExcused())
+}
+
+// TestPassengerAbsenceService_Delete - 신고 취소 시 탑승 기록의 결석 표시 해제
+func TestPassengerAbsenceService_Delete(t *testing.T) {
+	// Given
+	f := newAbsenceFixture(t)
+	ctx := asPrincipal(domain.RoleGuardian, f.guardian.ID)
+	absence, err := f.svc.Create(ctx, f.passenger.ID, &dto.CreatePassengerAbsenceRequest{Date: f.tomorrow})
+	require.NoError(t, err)
+	require.True(t, f.record(t).IsExcused())
+
+	// When
+	err = f.svc.Delete(ctx, f.passenger.ID, absence.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.False(t, f.record(t).IsExcused())
+	assertAppError(t, f.svc.Delete(ctx, f.passenger.ID, absence.ID), util.ErrCodeNotFound)
+}
+
+// TestPassengerAbsenceService_OrganizationTimezone - "오늘"은 탑승자 소속 기관의 시간대 기준 (한국은 이미 다음 날이어도 신고 가능)
+func TestPassengerAbsenceService_OrganizationTimezone(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	organizationRepo := mocks.NewOrganizationRepository()
+	organization := domain.NewOrganization("Pago Pago Academy", domain.OrganizationTypeAcademy)
+	organization.Timezone = "Pacific/Pago_Pago"
+	require.NoError(t, organizationRepo.Create(ctx, organization))
+	passengerRepo := mocks.NewPassengerRepository()
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	passenger.OrganizationID = organization.ID
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	svc := service.NewPassengerAbsenceService(mocks.NewPassengerAbsenceRepository(), passengerRepo, mocks.NewGuardianRepository(),
+		mocks.NewTripRepository(), mocks.NewScheduleRepository(), service.NewOrganizationService(organizationRepo))
+	location, err := time.LoadLocation("Pacific/Pago_Pago")
+	require.NoError(t, err)
+	local := time.Now().In(location)
+	adminCtx := asPrincipal(domain.RoleAdmin, "")
+
+	// When
+	absence, err := svc.Create(adminCtx, passenger.ID, &dto.CreatePassengerAbsenceRequest{Date: local.Format(time.DateOnly), Reason: "감기"})
+	_, pastErr := svc.Create(adminCtx, passenger.ID, &dto.CreatePassengerAbsenceRequest{Date: local.AddDate(0, 0, -1).Format(time.DateOnly)})
+
+	// Then
+	require.NoError(t, err)
+	assertAppError(t, pastErr, util.ErrCodeValidation)
+	absences, err := svc.List(adminCtx, passenger.ID)
+	require.NoError(t, err)
+	require.Len(t, absences, 1)
+	assert.Equal(t, absence.ID, absences[0].ID)
+	require.NoError(t, svc.Delete(adminCtx, passenger.ID, absence.ID))
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPassengerPhotoService - 동의 전에는 등록/조회 403, 연결된 보호자만 등록, 철회하면 사진 삭제
+func TestPassengerPhotoService(t *testing.T) {
+	// Given
+	admin := asPrincipal(domain.RoleAdmin, "admin-1")
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	require.NoError(t, passengerRepo.Create(admin, passenger))
+	guardian := domain.NewGuardian("김보호", "010-1234-5678")
+	require.NoError(t, guardianRepo.Create(admin, guardian))
+	require.NoError(t, guardianRepo.LinkPassenger(admin, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+	parent := asPrincipal(domain.RoleGuardian, guardian.ID)
+	stranger := asPrincipal(domain.RoleGuardian, "guardian-2")
+	driver := asPrincipal(domain.RoleDriver, "driver-1")
+	fileRepo := mocks.NewFileRepository()
+	svc := service.NewPassengerPhotoService(passengerRepo, guardianRepo, service.NewFileService(fileRepo, storage.NewLocalStorage(t.TempDir())))
+
+	// When
+	_, noConsentUpload := svc.UploadPhoto(parent, passenger.ID, "민준.png", samplePNG)
+	_, _, noConsentView := svc.Photo(driver, passenger.ID)
+	_, strangerConsent := svc.SetConsent(stranger, passenger.ID, true)
+	_, driverConsent := svc.SetConsent(driver, passenger.ID, true)
+	consented, err := svc.SetConsent(parent, passenger.ID, true)
+	require.NoError(t, err)
+	_, _, noPhoto := svc.Photo(driver, passenger.ID)
+	_, pdfErr := svc.UploadPhoto(parent, passenger.ID, "민준.pdf", samplePDF)
+	_, strangerUpload := svc.UploadPhoto(stranger, passenger.ID, "민준.png", samplePNG)
+	uploaded, err := svc.UploadPhoto(parent, passenger.ID, "민준.png", samplePNG)
+
+	// Then
+	assertAppError(t, noConsentUpload, util.ErrCodeForbidden)
+	assertAppError(t, noConsentView, util.ErrCodeForbidden)
+	assertAppError(t, strangerConsent, util.ErrCodeForbidden)
+	assertAppError(t, driverConsent, util.ErrCodeForbidden)
+	assert.True(t, consented.PhotoConsent)
+	require.NotNil(t, consented.PhotoConsentAt)
+	assert.Equal(t, "user-"+guardian.ID, consented.PhotoConsentBy)
+	assertAppError(t, noPhoto, util.ErrCodeNotFound)
+	assertAppError(t, pdfErr, util.ErrCodeValidation)
+	assertAppError(t, strangerUpload, util.ErrCodeForbidden)
+	require.NoError(t, err)
+	require.NotNil(t, uploaded.PhotoFileID)
+	photoFileID := *uploaded.PhotoFileID
+
+	// When
+	file, data, err := svc.Photo(driver, passenger.ID)
+	_, _, strangerView := svc.Photo(stranger, passenger.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "민준.png", file.FileName)
+	assert.Equal(t, samplePNG, data)
+	assertAppError(t, strangerView, util.ErrCodeForbidden)
+
+	// When
+	withdrawn, err := svc.SetConsent(parent, passenger.ID, false)
+	_, _, withdrawnView := svc.Photo(admin, passenger.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.False(t, withdrawn.PhotoConsent)
+	assert.Nil(t, withdrawn.PhotoFileID)
+	_, err = fileRepo.GetByID(admin, photoFileID)
+	assert.ErrorIs(t, err, repository.ErrNotFound, "철회하면 사진 파일 삭제")
+	assertAppError(t, withdrawnView, util.ErrCodeForbidden)
+	stored, err := passengerRepo.GetByID(admin, passenger.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.HasPhotoConsent())
+}
+
+// TestPassengerPhotoService_DeletePhoto - 사진만 삭제하고 동의는 유지, 사진이 없으면 NOT_FOUND
+func TestPassengerPhotoService_DeletePhoto(t *testing.T) {
+	// Given
+	admin := asPrincipal(domain.RoleAdmin, "admin-1")
+	passengerRepo := mocks.NewPassengerRepository()
+	passenger := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	require.NoError(t, passengerRepo.Create(admin, passenger))
+	fileRepo := mocks.NewFileRepository()
+	svc := service.NewPassengerPhotoService(passengerRepo, mocks.NewGuardianRepository(), service.NewFileService(fileRepo, storage.NewLocalStorage(t.TempDir())))
+	_, err := svc.SetConsent(admin, passenger.ID, true)
+	require.NoError(t, err)
+	uploaded, err := svc.UploadPhoto(admin, passenger.ID, "민준.png", samplePNG)
+	require.NoError(t, err)
+	photoFileID := *uploaded.PhotoFileID
+
+	// When
+	err = svc.DeletePhoto(admin, passenger.ID)
+	againErr := svc.DeletePhoto(admin, passenger.ID)
+
+	// Then
+	require.NoError(t, err)
+	assertAppError(t, againErr, util.ErrCodeNotFound)
+	_, err = fileRepo.GetByID(admin, photoFileID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	stored, err := passengerRepo.GetByID(admin, passenger.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.PhotoFileID)
+	assert.True(t, stored.HasPhotoConsent(), "사진을 지워도 동의는 유지")
+}
@@ -0,0 +1,254 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPassengerRequest - 테스트용 탑승자 등록 요청
+func newPassengerRequest(name string) *dto.CreatePassengerRequest {
+	return &dto.CreatePassengerRequest{
+		Name:          name,
+		GuardianName:  "김보호",
+		GuardianPhone: "010-9876-5432",
+	}
+}
+
+// TestPassengerService_AssignToStop - 정류장 배정 시 순서 캐싱
+func TestPassengerService_AssignToStop(t *testing.T) {
+	// Given
+	routeRepo := mocks.NewRouteRepository()
+	routeService := service.NewRouteService(routeRepo, mocks.NewTxManager(), nil, nil)
+	svc := service.NewPassengerService(mocks.NewPassengerRepository(), routeRepo, mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	route := createRouteWithStops(t, routeService)
+	passenger, err := svc.Create(context.Background(), newPassengerRequest("김철수"))
+	require.NoError(t, err)
+
+	// When
+	assigned, err := svc.AssignToStop(context.Background(), passenger.ID, &dto.AssignStopRequest{
+		RouteID: route.ID,
+		StopID:  route.Stops[1].ID,
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, assigned.IsAssigned())
+	assert.Equal(t, 2, assigned.StopOrder)
+}
+
+// TestPassengerService_StopOrderFollowsStops - 정류장 이동/재배치/추가/삭제 후에도 탑승자 목록이 현재 정류장 순서대로
+func TestPassengerService_StopOrderFollowsStops(t *testing.T) {
+	// Given - A, B, C 정류장에 한 명씩 배정
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	routeRepo := mocks.NewRouteRepository()
+	routeRepo.Passengers = passengerRepo
+	routeService := service.NewRouteService(routeRepo, mocks.NewTxManager(), nil, nil)
+	svc := service.NewPassengerService(passengerRepo, routeRepo, mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	route := createRouteWithStops(t, routeService)
+	a, b, c := route.Stops[0].ID, route.Stops[1].ID, route.Stops[2].ID
+	for i, name := range []string{"가", "나", "다"} {
+		passenger, err := svc.Create(ctx, newPassengerRequest(name))
+		require.NoError(t, err)
+		_, err = svc.AssignToStop(ctx, passenger.ID, &dto.AssignStopRequest{RouteID: route.ID, StopID: route.Stops[i].ID})
+		require.NoError(t, err)
+	}
+	listed := func() ([]string, []int) {
+		passengers, _, err := svc.List(ctx, repository.PassengerFilter{RouteID: route.ID})
+		require.NoError(t, err)
+		names, orders := make([]string, len(passengers)), make([]int, len(passengers))
+		for i, passenger := range passengers {
+			names[i], orders[i] = passenger.Name, passenger.StopOrder
+		}
+		return names, orders
+	}
+
+	// When - C를 맨 앞으로
+	order := 1
+	_, err := routeService.UpdateStop(ctx, route.ID, c, &dto.UpdateStopRequest{Order: &order})
+	require.NoError(t, err)
+	movedNames, movedOrders := listed()
+
+	// When - B, A, C 순으로 재배치 후 맨 앞에 정류장 추가
+	_, err = routeService.ReorderStops(ctx, route.ID, []string{b, a, c})
+	require.NoError(t, err)
+	stop := newStopRequest("D", 1)
+	_, err = routeService.AddStop(ctx, route.ID, &stop)
+	require.NoError(t, err)
+	insertedNames, insertedOrders := listed()
+
+	// When - B 삭제
+	require.NoError(t, routeService.RemoveStop(ctx, route.ID, b))
+	_, removedOrders := listed()
+
+	// Then
+	assert.Equal(t, []string{"다", "가", "나"}, movedNames)
+	assert.Equal(t, []int{1, 2, 3}, movedOrders)
+	assert.Equal(t, []string{"나", "가", "다"}, insertedNames)
+	assert.Equal(t, []int{2, 3, 4}, insertedOrders)
+	assert.Equal(t, []int{2, 2, 3}, removedOrders, "삭제된 정류장 탑승자는 기존 순서 유지, 나머지는 당겨짐")
+}
+
+// TestPassengerService_AssignToStop_StopOfOtherRoute - 다른 경로의 정류장 배정 거부
+func TestPassengerService_AssignToStop_StopOfOtherRoute(t *testing.T) {
+	// Given
+	routeRepo := mocks.NewRouteRepository()
+	routeService := service.NewRouteService(routeRepo, mocks.NewTxManager(), nil, nil)
+	svc := service.NewPassengerService(mocks.NewPassengerRepository(), routeRepo, mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	routeA := createRouteWithStops(t, routeService)
+	routeB := createRouteWithStops(t, routeService)
+	passenger, err := svc.Create(context.Background(), newPassengerRequest("김철수"))
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.AssignToStop(context.Background(), passenger.ID, &dto.AssignStopRequest{
+		RouteID: routeA.ID,
+		StopID:  routeB.Stops[0].ID,
+	})
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeValidation, appErr.Code)
+	assert.Contains(t, appErr.Details, "stop_id")
+}
+
+// TestPassengerService_AssignToStop_OverCapacity - 경로 활성 일정의 차량 승객 정원을 넘으면 초과 인원과 함께 409
+func TestPassengerService_AssignToStop_OverCapacity(t *testing.T) {
+	// Given - 3인승(승객 정원 2명) 차량 일정
+	ctx := context.Background()
+	routeRepo := mocks.NewRouteRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	svc := service.NewPassengerService(mocks.NewPassengerRepository(), routeRepo, scheduleRepo, vehicleRepo)
+	route := createRouteWithStops(t, service.NewRouteService(routeRepo, mocks.NewTxManager(), nil, nil))
+	vehicle := domain.NewVehicle("12가3456", "레이", "기아", domain.VehicleTypeVan, 3, 2022, "흰색")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	require.NoError(t, scheduleRepo.Create(ctx, domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, vehicle.ID, "driver-1")))
+	assign := &dto.AssignStopRequest{RouteID: route.ID, StopID: route.Stops[0].ID}
+	for _, name := range []string{"김철수", "이영희"} {
+		passenger, err := svc.Create(ctx, newPassengerRequest(name))
+		require.NoError(t, err)
+		_, err = svc.AssignToStop(ctx, passenger.ID, assign)
+		require.NoError(t, err)
+	}
+	third, err := svc.Create(ctx, newPassengerRequest("박민수"))
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.AssignToStop(ctx, third.ID, assign)
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+	assert.Equal(t, 1, appErr.Details["overflow"])
+	assert.Equal(t, 2, appErr.Details["capacity"])
+
+	// When - 이미 배정된 탑승자가 같은 경로의 다른 정류장으로 옮기는 것은 인원 변화 없음
+	passengers, _, _ := svc.List(ctx, repository.PassengerFilter{RouteID: route.ID})
+	_, err = svc.AssignToStop(ctx, passengers[0].ID, &dto.AssignStopRequest{RouteID: route.ID, StopID: route.Stops[1].ID})
+
+	// Then
+	require.NoError(t, err)
+}
+
+// TestPassengerService_UpdateGuardian - 전달된 필드만 수정
+func TestPassengerService_UpdateGuardian(t *testing.T) {
+	// Given
+	svc := service.NewPassengerService(mocks.NewPassengerRepository(), mocks.NewRouteRepository(), mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	passenger, err := svc.Create(context.Background(), newPassengerRequest("김철수"))
+	require.NoError(t, err)
+	phone := "010-1111-2222"
+
+	// When
+	updated, err := svc.UpdateGuardian(context.Background(), passenger.ID, &dto.UpdateGuardianRequest{GuardianPhone: &phone})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "김보호", updated.GuardianName)
+	assert.Equal(t, phone, updated.GuardianPhone)
+}
+
+// TestPassengerService_Restore - 삭제된 탑승자 복구 (익명화된 탑승자는 409)
+func TestPassengerService_Restore(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	svc := service.NewPassengerService(passengerRepo, mocks.NewRouteRepository(), mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	deleted, err := svc.Create(ctx, newPassengerRequest("김민준"))
+	require.NoError(t, err)
+	anonymized := domain.NewPassenger("이서연", "이보호", "010-1111-2222")
+	anonymized.Anonymize(time.Now())
+	require.NoError(t, passengerRepo.Create(ctx, anonymized))
+	for _, id := range []string{deleted.ID, anonymized.ID} {
+		require.NoError(t, svc.Delete(ctx, id))
+	}
+
+	// When
+	restored, err := svc.Restore(ctx, deleted.ID)
+	_, anonymizedErr := svc.Restore(ctx, anonymized.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt)
+	assertAppError(t, anonymizedErr, util.ErrCodeConflict)
+	_, err = svc.Get(ctx, anonymized.ID)
+	assertAppError(t, err, util.ErrCodeNotFound)
+}
+
+// TestPassengerService_Search - 아이 이름/보호자 이름 부분 일치, 연락처는 형식과 무관하게 전체 일치
+func TestPassengerService_Search(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	passengerRepo := mocks.NewPassengerRepository()
+	svc := service.NewPassengerService(passengerRepo, mocks.NewRouteRepository(), mocks.NewScheduleRepository(), mocks.NewVehicleRepository())
+	minjun := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	seoyeon := domain.NewPassenger("이서연", "박민정", "010-9999-0000")
+	for _, passenger := range []*domain.Passenger{minjun, seoyeon} {
+		require.NoError(t, passengerRepo.Create(ctx, passenger))
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{"아이 이름", "민준", []string{minjun.ID}},
+		{"보호자 이름", " 박민정 ", []string{seoyeon.ID}},
+		{"연락처 숫자만", "01012345678", []string{minjun.ID}},
+		{"연락처 국제 형식", "+82 10-1234-5678", []string{minjun.ID}},
+		{"연락처 일부", "5678", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			passengers, err := svc.Search(ctx, tt.query, 0)
+
+			// Then
+			require.NoError(t, err)
+			var ids []string
+			for _, passenger := range passengers {
+				ids = append(ids, passenger.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+
+	// When - 공백 제외 2자 미만
+	_, err := svc.Search(ctx, " 민 ", 0)
+
+	// Then
+	assertAppError(t, err, util.ErrCodeValidation)
+}
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQuotaService_ConsumeQuota_ExceedsLimit - 한도를 넘는 요청은 TOO_MANY_REQUESTS
+func TestQuotaService_ConsumeQuota_ExceedsLimit(t *testing.T) {
+	// Given
+	svc := service.NewQuotaService(mocks.NewUsageRepository(), service.QuotaLimits{User: 2, APIKey: 10})
+	principal := &auth.Principal{UserID: "user-1", Role: domain.RoleDriver}
+
+	// When
+	first, err1 := svc.ConsumeQuota(context.Background(), principal)
+	second, err2 := svc.ConsumeQuota(context.Background(), principal)
+	third, err3 := svc.ConsumeQuota(context.Background(), principal)
+
+	// Then
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	assert.Equal(t, int64(1), first.Remaining)
+	assert.Equal(t, int64(0), second.Remaining)
+
+	appErr, ok := err3.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeTooMany, appErr.Code)
+	assert.Equal(t, int64(3), third.Used)
+	assert.Equal(t, int64(0), third.Remaining)
+	assert.Equal(t, domain.UsageIdentityUser, third.IdentityType)
+}
+
+// TestQuotaService_ConsumeQuota_APIKeyQuota - API 키는 키별 한도가 기본 한도보다 우선
+func TestQuotaService_ConsumeQuota_APIKeyQuota(t *testing.T) {
+	// Given
+	svc := service.NewQuotaService(mocks.NewUsageRepository(), service.QuotaLimits{User: 100, APIKey: 100})
+	custom := &auth.Principal{UserID: "key-1", Role: domain.RoleIntegration, DailyQuota: 1}
+	standard := &auth.Principal{UserID: "key-2", Role: domain.RoleIntegration}
+
+	// When
+	_, err := svc.ConsumeQuota(context.Background(), custom)
+	require.NoError(t, err)
+	_, err = svc.ConsumeQuota(context.Background(), custom)
+	usage, stdErr := svc.ConsumeQuota(context.Background(), standard)
+
+	// Then
+	assert.Error(t, err)
+	require.NoError(t, stdErr)
+	assert.Equal(t, domain.UsageIdentityAPIKey, usage.IdentityType)
+	assert.Equal(t, int64(100), usage.Limit)
+	assert.Equal(t, int64(99), usage.Remaining)
+}
+
+// TestQuotaService_GetUsage - 조회는 사용량을 늘리지 않음, 한도 0은 무제한
+func TestQuotaService_GetUsage(t *testing.T) {
+	// Given
+	svc := service.NewQuotaService(mocks.NewUsageRepository(), service.QuotaLimits{})
+	principal := &auth.Principal{UserID: "admin-1", Role: domain.RoleAdmin}
+	_, err := svc.ConsumeQuota(context.Background(), principal)
+	require.NoError(t, err)
+
+	// When
+	usage, err := svc.GetUsage(context.Background(), principal)
+	again, _ := svc.GetUsage(context.Background(), principal)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.Used)
+	assert.Equal(t, int64(1), again.Used)
+	assert.Equal(t, int64(0), usage.Limit)
+	assert.Equal(t, int64(-1), usage.Remaining)
+	assert.False(t, usage.Exceeded())
+}
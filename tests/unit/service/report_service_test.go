@@ -0,0 +1,442 @@
+package service_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/hex"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/tabular"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reportFixture - 경로 2개(각 일정 1개), 차량/기사, 탑승자 3명
+type reportFixture struct {
+	svc         *service.ReportService
+	tripRepo    *mocks.TripRepository
+	vehicleRepo *mocks.VehicleRepository
+	driverRepo  *mocks.DriverRepository
+	eventRepo   *mocks.TripStopEventRepository
+	vehicle     *domain.Vehicle
+	driver      *domain.Driver
+	route       *domain.Route
+	schedule    *domain.Schedule
+	other       *domain.Schedule
+	stops       []*domain.Stop
+	passengers  []*domain.Passenger
+}
+
+func newReportFixture(t *testing.T) *reportFixture {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	driverRepo := mocks.NewDriverRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	eventRepo := mocks.NewTripStopEventRepository()
+
+	route := domain.NewRoute("1호차 등원", "", 40)
+	stops := []*domain.Stop{
+		domain.NewStop(route.ID, "해오름아파트 정문", "", 1, 37.50, 127.00, 5),
+		domain.NewStop(route.ID, "푸른마을 놀이터", "", 2, 37.51, 127.01, 12),
+	}
+	for _, stop := range stops {
+		route.AddStop(*stop)
+	}
+	require.NoError(t, routeRepo.Create(ctx, route))
+	otherRoute := domain.NewRoute("2호차 등원", "", 40)
+	require.NoError(t, routeRepo.Create(ctx, otherRoute))
+
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	driver := domain.NewDriver("박기사", "010-5555-6666", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(3, 0, 0))
+	require.NoError(t, driverRepo.Create(ctx, driver))
+
+	schedule := domain.NewSchedule("1호차 등원", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, vehicle.ID, driver.ID)
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	other := domain.NewSchedule("2호차 등원", "07:50", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, otherRoute.ID, vehicle.ID, driver.ID)
+	require.NoError(t, scheduleRepo.Create(ctx, other))
+
+	var passengers []*domain.Passenger
+	for _, name := range []string{"김민준", "박서연", "이하준"} {
+		passenger := domain.NewPassenger(name, name+" 보호자", "010-1234-5678")
+		require.NoError(t, passengerRepo.Create(ctx, passenger))
+		passengers = append(passengers, passenger)
+	}
+
+	return &reportFixture{
+		svc:         service.NewReportService(tripRepo, scheduleRepo, routeRepo, vehicleRepo, driverRepo, passengerRepo, eventRepo, nil),
+		tripRepo:    tripRepo,
+		vehicleRepo: vehicleRepo,
+		driverRepo:  driverRepo,
+		eventRepo:   eventRepo,
+		vehicle:     vehicle,
+		driver:      driver,
+		route:       route,
+		schedule:    schedule,
+		other:       other,
+		stops:       stops,
+		passengers:  passengers,
+	}
+}
+
+// createTrip - 날짜의 운행 생성 (records는 저장할 탑승 기록)
+func (f *reportFixture) createTrip(t *testing.T, schedule *domain.Schedule, date time.Time, records ...*domain.TripPassenger) *domain.Trip {
+	trip := domain.NewTrip(schedule.ID, date, schedule.VehicleID, schedule.DefaultDriverID, nil)
+	for _, record := range records {
+		record.TripID = trip.ID
+		trip.TripPassengers = append(trip.TripPassengers, *record)
+	}
+	require.NoError(t, f.tripRepo.Create(context.Background(), trip))
+	return trip
+}
+
+// TestReportService_ExportAttendance - 기간 안의 운행만 날짜순, 운행 안에서는 정류장 순서 (취소/다른 경로 제외)
+func TestReportService_ExportAttendance(t *testing.T) {
+	// Given
+	f := newReportFixture(t)
+	kst := time.FixedZone("KST", 9*60*60)
+	day1 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	boarded := domain.NewTripPassenger("", f.passengers[0].ID, f.stops[1].ID)
+	boarded.BoardPassenger("attendant-1", nil)
+	boardedAt := time.Date(2025, 3, 11, 8, 5, 0, 0, kst)
+	boarded.BoardedAt = &boardedAt
+	boarded.AlightPassenger("attendant-1", nil)
+	alightedAt := time.Date(2025, 3, 11, 8, 30, 0, 0, kst)
+	boarded.AlightedAt = &alightedAt
+	noShow := domain.NewTripPassenger("", f.passengers[1].ID, f.stops[0].ID)
+	noShow.MarkNoShow("정류장에 나오지 않음", "attendant-1")
+	excused := domain.NewTripPassenger("", f.passengers[2].ID, f.stops[0].ID)
+	excused.MarkExcused("병원 진료")
+
+	f.createTrip(t, f.schedule, day2, boarded, noShow)
+	f.createTrip(t, f.schedule, day1, excused)
+	cancelled := f.createTrip(t, f.schedule, day2.AddDate(0, 0, 1), domain.NewTripPassenger("", f.passengers[0].ID, f.stops[0].ID))
+	require.NoError(t, cancelled.Cancel("차량 점검"))
+	require.NoError(t, f.tripRepo.Update(context.Background(), cancelled))
+	f.createTrip(t, f.schedule, day1.AddDate(0, 0, -1), domain.NewTripPassenger("", f.passengers[0].ID, f.stops[0].ID))
+	f.createTrip(t, f.other, day1, domain.NewTripPassenger("", f.passengers[0].ID, f.stops[0].ID))
+
+	// When
+	data, err := f.svc.ExportAttendance(context.Background(), day1, day2.AddDate(0, 0, 1), f.route.ID)
+	require.NoError(t, err)
+	rows, err := tabular.Read("attendance.xlsx", data)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	assert.Equal(t, "운행 날짜", rows[0].Cell(0))
+	assert.Equal(t, []string{"2025-03-10", "1호차 등원", "08:00", "1호차 등원", "12가3456", "박기사", "이하준", "해오름아파트 정문", "결석(사전 신고)", "", "", "병원 진료"}, rows[1].Cells)
+	assert.Equal(t, []string{"2025-03-11", "박서연", "해오름아파트 정문", "불참", "정류장에 나오지 않음"},
+		[]string{rows[2].Cell(0), rows[2].Cell(6), rows[2].Cell(7), rows[2].Cell(8), rows[2].Cell(11)})
+	assert.Equal(t, []string{"2025-03-11", "김민준", "푸른마을 놀이터", "하차", "08:05", "08:30"},
+		[]string{rows[3].Cell(0), rows[3].Cell(6), rows[3].Cell(7), rows[3].Cell(8), rows[3].Cell(9), rows[3].Cell(10)})
+}
+
+// TestReportService_ExportAttendance_AllRoutes - 경로를 지정하지 않으면 모든 경로 (같은 날은 출발 시각순)
+func TestReportService_ExportAttendance_AllRoutes(t *testing.T) {
+	// Given
+	f := newReportFixture(t)
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	f.createTrip(t, f.schedule, day, domain.NewTripPassenger("", f.passengers[0].ID, f.stops[0].ID))
+	f.createTrip(t, f.other, day, domain.NewTripPassenger("", f.passengers[1].ID, f.stops[0].ID))
+
+	// When
+	data, err := f.svc.ExportAttendance(context.Background(), day, day, "")
+	require.NoError(t, err)
+	rows, err := tabular.Read("attendance.xlsx", data)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "2호차 등원", rows[1].Cell(1))
+	assert.Equal(t, "기록 없음", rows[1].Cell(8))
+	assert.Equal(t, "1호차 등원", rows[2].Cell(1))
+}
+
+// TestReportService_ExportAttendance_Validation - 잘못된 기간/경로는 검증 에러
+func TestReportService_ExportAttendance_Validation(t *testing.T) {
+	f := newReportFixture(t)
+	from := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		to      time.Time
+		routeID string
+		field   string
+	}{
+		{"종료일이 시작일보다 이전", from.AddDate(0, 0, -1), "", "to"},
+		{"366일 초과", from.AddDate(0, 0, 366), "", "to"},
+		{"없는 경로", from, "00000000-0000-0000-0000-000000000000", "route_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			_, err := f.svc.ExportAttendance(context.Background(), from, tt.to, tt.routeID)
+
+			// Then
+			assertAppError(t, err, util.ErrCodeValidation)
+			assert.Contains(t, err.(*util.AppError).Details, tt.field)
+		})
+	}
+}
+
+// pdfLines - PDF 내용 스트림의 글자 줄 (쪽 순서대로)
+func pdfLines(t *testing.T, data []byte) []string {
+	var lines []string
+	for _, match := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(data, -1) {
+		reader, err := zlib.NewReader(bytes.NewReader(match[1]))
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		for _, text := range regexp.MustCompile(`<([0-9A-F]*)> Tj`).FindAllSubmatch(content, -1) {
+			raw, err := hex.DecodeString(string(text[1]))
+			require.NoError(t, err)
+			units := make([]uint16, len(raw)/2)
+			for i := range units {
+				units[i] = uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+			}
+			lines = append(lines, string(utf16.Decode(units)))
+		}
+	}
+	return lines
+}
+
+// TestReportService_ExportDrivingLog - 차량의 기간 운행만 날짜순 한 행 (취소/다른 차량 제외, 인원 집계, 합계 줄)
+func TestReportService_ExportDrivingLog(t *testing.T) {
+	// Given
+	f := newReportFixture(t)
+	day1 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC)
+	kst := time.FixedZone("KST", 9*60*60)
+
+	boarded := domain.NewTripPassenger("", f.passengers[0].ID, f.stops[0].ID)
+	boarded.BoardPassenger("driver-1", nil)
+	noShow := domain.NewTripPassenger("", f.passengers[1].ID, f.stops[0].ID)
+	noShow.MarkNoShow("정류장에 나오지 않음", "driver-1")
+	excused := domain.NewTripPassenger("", f.passengers[2].ID, f.stops[1].ID)
+	excused.MarkExcused("병원 진료")
+	completed := f.createTrip(t, f.schedule, day2, boarded, noShow, excused)
+	startedAt := time.Date(2025, 3, 11, 8, 0, 0, 0, kst)
+	completedAt := startedAt.Add(42 * time.Minute)
+	completed.Status = domain.TripStatusCompleted
+	completed.StartedAt, completed.CompletedAt = &startedAt, &completedAt
+	completed.TotalDistance = 12345
+	require.NoError(t, f.tripRepo.Update(context.Background(), completed))
+
+	f.createTrip(t, f.schedule, day1)
+	cancelled := f.createTrip(t, f.other, day1)
+	require.NoError(t, cancelled.Cancel("차량 점검"))
+	require.NoError(t, f.tripRepo.Update(context.Background(), cancelled))
+	otherVehicle := domain.NewTrip(f.schedule.ID, day1, "vehicle-2", f.driver.ID, nil)
+	require.NoError(t, f.tripRepo.Create(context.Background(), otherVehicle))
+
+	// When
+	data, err := f.svc.ExportDrivingLog(context.Background(), f.vehicle.ID, day1, day2)
+
+	// Then
+	require.NoError(t, err)
+	lines := pdfLines(t, data)
+	text := strings.Join(lines, "|")
+	assert.Equal(t, "차량 운행일지", lines[0])
+	assert.Contains(t, text, "차량: 12가3456 (현대 스타렉스, 정원 12명)|기간: 2025-03-10 ~ 2025-03-11")
+	assert.Contains(t, text, "2025-03-10|1호차 등원|박기사|대기|0|0|0|0|2025-03-11|1호차 등원|박기사|완료|08:00|08:42|42|12.3|3|1|1|1|")
+	assert.Contains(t, text, "운행 2회, 운행 시간 42분, 주행 거리 12.3km, 승차 인원 연 1명")
+	assert.NotContains(t, text, "2호차 등원")
+	assert.Equal(t, "1 / 1", lines[len(lines)-1])
+}
+
+// TestReportService_ExportDrivingLog_Vehicle - 삭제된 차량도 출력, 없는 차량은 NOT_FOUND
+func TestReportService_ExportDrivingLog_Vehicle(t *testing.T) {
+	// Given
+	f := newReportFixture(t)
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, f.vehicleRepo.SoftDelete(context.Background(), f.vehicle.ID))
+
+	// When
+	deleted, deletedErr := f.svc.ExportDrivingLog(context.Background(), f.vehicle.ID, day, day)
+	_, missingErr := f.svc.ExportDrivingLog(context.Background(), "00000000-0000-0000-0000-000000000000", day, day)
+
+	// Then
+	require.NoError(t, deletedErr)
+	assert.Contains(t, strings.Join(pdfLines(t, deleted), "|"), "차량: 12가3456")
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+}
+
+// TestReportService_DriverSummary - 기사별 운행 수, 완료 운행 시간/거리, 운행한 날과 대체 운행한 날 (취소 제외, 운행 없는 기사는 0)
+func TestReportService_DriverSummary(t *testing.T) {
+	// Given
+	f := newReportFixture(t)
+	ctx := context.Background()
+	substitute := domain.NewDriver("김대체", "010-7777-8888", "11-22-333333-55", domain.LicenseType1Large, time.Now().AddDate(3, 0, 0))
+	require.NoError(t, f.driverRepo.Create(ctx, substitute))
+	idle := domain.NewDriver("이대기", "010-9999-0000", "11-22-333333-66", domain.LicenseType1Large, time.Now().AddDate(3, 0, 0))
+	require.NoError(t, f.driverRepo.Create(ctx, idle))
+	day1 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	complete := func(trip *domain.Trip, minutes, meters int) {
+		startedAt := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+		completedAt := startedAt.Add(time.Duration(minutes) * time.Minute)
+		trip.Status = domain.TripStatusCompleted
+		trip.StartedAt, trip.CompletedAt = &startedAt, &completedAt
+		trip.TotalDistance = meters
+		require.NoError(t, f.tripRepo.Update(ctx, trip))
+	}
+	complete(f.createTrip(t, f.schedule, day1), 40, 12000)
+	complete(f.createTrip(t, f.other, day1), 30, 8000)
+	f.createTrip(t, f.schedule, day2) // 대기 중 (시간/거리 없음)
+
+	substituted := domain.NewTrip(f.other.ID, day2, f.vehicle.ID, substitute.ID, nil)
+	require.NoError(t, f.tripRepo.Create(ctx, substituted))
+	complete(substituted, 35, 9000)
+	cancelled := domain.NewTrip(f.schedule.ID, day2.AddDate(0, 0, -2), f.vehicle.ID, substitute.ID, nil)
+	require.NoError(t, cancelled.Cancel("차량 점검"))
+	require.NoError(t, f.tripRepo.Create(ctx, cancelled))
+	outside := domain.NewTrip(f.schedule.ID, day2.AddDate(0, 0, 1), f.vehicle.ID, substitute.ID, nil)
+	require.NoError(t, f.tripRepo.Create(ctx, outside))
+
+	// When
+	report, err := f.svc.DriverSummary(ctx, day1.AddDate(0, 0, -3), day2)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "2025-03-07", report.From)
+	require.Len(t, report.Drivers, 3)
+	assert.Equal(t, dto.DriverReportEntry{
+		DriverID: substitute.ID, DriverName: "김대체",
+		Trips: 1, CompletedTrips: 1, DrivingMinutes: 35, TotalDistance: 9000, WorkDays: 1, SubstitutionDays: 1,
+	}, report.Drivers[0])
+	assert.Equal(t, dto.DriverReportEntry{
+		DriverID: f.driver.ID, DriverName: "박기사",
+		Trips: 3, CompletedTrips: 2, DrivingMinutes: 70, TotalDistance: 20000, WorkDays: 2, SubstitutionDays: 0,
+	}, report.Drivers[1])
+	assert.Equal(t, dto.DriverReportEntry{DriverID: idle.ID, DriverName: "이대기"}, report.Drivers[2])
+}
+
+// TestReportService_VehicleSummary - 운행일(어느 차량이든 운행한 날) 대비 차량별 운행한 날과 가동률 (취소 제외, 운행 없는 차량 포함)
+func TestReportService_VehicleSummary(t *testing.T) {
+	// Given
+	f := newReportFixture(t)
+	ctx := context.Background()
+	spare := domain.NewVehicle("34나5678", "카니발", "기아", domain.VehicleTypeVan, 9, 2021, "흰색")
+	require.NoError(t, f.vehicleRepo.Create(ctx, spare))
+	idle := domain.NewVehicle("56다7890", "쏠라티", "현대", domain.VehicleTypeMiniBus, 15, 2020, "노랑")
+	require.NoError(t, f.vehicleRepo.Create(ctx, idle))
+	day1 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	withDistance := func(trip *domain.Trip, meters int) {
+		trip.TotalDistance = meters
+		require.NoError(t, f.tripRepo.Update(ctx, trip))
+	}
+	withDistance(f.createTrip(t, f.schedule, day1), 12340)
+	withDistance(f.createTrip(t, f.other, day1), 8000)
+	withDistance(f.createTrip(t, f.schedule, day1.AddDate(0, 0, 1)), 12000)
+	spareTrip := domain.NewTrip(f.schedule.ID, day1.AddDate(0, 0, 2), spare.ID, f.driver.ID, nil)
+	require.NoError(t, f.tripRepo.Create(ctx, spareTrip))
+	cancelled := domain.NewTrip(f.schedule.ID, day1.AddDate(0, 0, 3), spare.ID, f.driver.ID, nil)
+	require.NoError(t, cancelled.Cancel("차량 점검"))
+	require.NoError(t, f.tripRepo.Create(ctx, cancelled))
+
+	// When
+	report, err := f.svc.VehicleSummary(ctx, day1, day1.AddDate(0, 0, 6))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.ServiceDays)
+	require.Len(t, report.Vehicles, 3)
+	primary := report.Vehicles[0]
+	assert.Equal(t, "12가3456", primary.PlateNumber)
+	assert.Equal(t, 3, primary.Trips)
+	assert.Equal(t, 32.3, primary.DistanceKm)
+	assert.Equal(t, 2, primary.ActiveDays)
+	assert.Equal(t, 1, primary.IdleDays)
+	assert.Equal(t, 66.7, primary.Utilization)
+	assert.Equal(t, "34나5678", report.Vehicles[1].PlateNumber)
+	assert.Equal(t, 1, report.Vehicles[1].Trips)
+	assert.Equal(t, 33.3, report.Vehicles[1].Utilization)
+	assert.Equal(t, dto.VehicleReportEntry{
+		VehicleID: idle.ID, PlateNumber: "56다7890", Status: domain.VehicleStatusActive, Capacity: 15, IdleDays: 3,
+	}, report.Vehicles[2])
+}
+
+// TestReportService_Punctuality - 출발/정류장 도착 지연의 정시율과 백분위 (전체, 경로별, 기사별, 출발 전/취소 운행 제외)
+func TestReportService_Punctuality(t *testing.T) {
+	// Given
+	f := newReportFixture(t)
+	ctx := context.Background()
+	kst := time.FixedZone("KST", 9*60*60)
+	substitute := domain.NewDriver("김대체", "010-7777-8888", "11-22-333333-55", domain.LicenseType1Large, time.Now().AddDate(3, 0, 0))
+	require.NoError(t, f.driverRepo.Create(ctx, substitute))
+	day1 := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	at := func(day time.Time, hour, minute int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, kst)
+	}
+	start := func(trip *domain.Trip, startedAt time.Time, arrivals ...time.Time) {
+		trip.Status = domain.TripStatusInProgress
+		trip.StartedAt = &startedAt
+		require.NoError(t, f.tripRepo.Update(ctx, trip))
+		for i, arrivedAt := range arrivals {
+			require.NoError(t, f.eventRepo.Create(ctx, domain.NewTripStopEvent(trip.ID, f.stops[i].ID, domain.StopEventArrived,
+				domain.StopEventSourceGeofence, "system", nil, arrivedAt)))
+		}
+	}
+
+	// 1호차 08:00 출발 (정류장 예상 도착 5분, 12분 후), 2호차 07:50 출발 (정류장 없음)
+	start(f.createTrip(t, f.schedule, day1), at(day1, 8, 2), at(day1, 8, 6), at(day1, 8, 25))
+	start(f.createTrip(t, f.other, day1), at(day1, 7, 45))
+	substituted := domain.NewTrip(f.schedule.ID, day2, f.vehicle.ID, substitute.ID, nil)
+	require.NoError(t, f.tripRepo.Create(ctx, substituted))
+	start(substituted, at(day2, 8, 10), at(day2, 8, 14))
+	f.createTrip(t, f.schedule, day2.AddDate(0, 0, 1))
+	cancelled := f.createTrip(t, f.other, day2)
+	require.NoError(t, cancelled.Cancel("차량 점검"))
+	startedAt := at(day2, 9, 0)
+	cancelled.StartedAt = &startedAt
+	require.NoError(t, f.tripRepo.Update(ctx, cancelled))
+
+	// When
+	report, err := f.svc.Punctuality(ctx, day1, day2.AddDate(0, 0, 1))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, dto.PunctualityStats{
+		Trips: 3, OnTimeStarts: 2, OnTimeStartPct: 66.7,
+		StartDelay:    dto.DelayPercentile{P50: 2, P90: 10, P95: 10, Max: 10},
+		RecordedStops: 3, OnTimeStops: 1, OnTimeStopPct: 33.3,
+		ArrivalDelay: dto.DelayPercentile{P50: 9, P90: 13, P95: 13, Max: 13},
+	}, report.Overall)
+
+	require.Len(t, report.Routes, 2)
+	assert.Equal(t, "1호차 등원", report.Routes[0].RouteName)
+	assert.Equal(t, 2, report.Routes[0].Trips)
+	assert.Equal(t, dto.DelayPercentile{P50: 2, P90: 10, P95: 10, Max: 10}, report.Routes[0].StartDelay)
+	assert.Equal(t, "2호차 등원", report.Routes[1].RouteName)
+	assert.Equal(t, 100.0, report.Routes[1].OnTimeStartPct)
+	assert.Equal(t, dto.DelayPercentile{P50: -5, P90: -5, P95: -5, Max: -5}, report.Routes[1].StartDelay)
+	assert.Zero(t, report.Routes[1].RecordedStops)
+
+	require.Len(t, report.Drivers, 2)
+	assert.Equal(t, "김대체", report.Drivers[0].DriverName)
+	assert.Equal(t, 0.0, report.Drivers[0].OnTimeStartPct)
+	assert.Equal(t, 1, report.Drivers[0].RecordedStops)
+	assert.Equal(t, "박기사", report.Drivers[1].DriverName)
+	assert.Equal(t, 2, report.Drivers[1].Trips)
+	assert.Equal(t, 50.0, report.Drivers[1].OnTimeStopPct)
+}
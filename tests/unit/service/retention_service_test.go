@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const retentionPeriod = 3 * 365 * 24 * time.Hour
+
+// retentionFixture - 보존 기간 테스트용 의존성
+type retentionFixture struct {
+	passengerRepo *mocks.PassengerRepository
+	driverRepo    *mocks.DriverRepository
+	auditLogRepo  *mocks.AuditLogRepository
+	svc           *service.RetentionService
+}
+
+func newRetentionFixture() *retentionFixture {
+	f := &retentionFixture{
+		passengerRepo: mocks.NewPassengerRepository(),
+		driverRepo:    mocks.NewDriverRepository(),
+		auditLogRepo:  mocks.NewAuditLogRepository(),
+	}
+	f.svc = service.NewRetentionService(f.passengerRepo, f.driverRepo, f.auditLogRepo, mocks.NewTxManager(), retentionPeriod)
+	return f
+}
+
+// newInactivePassenger - deactivatedAt에 비활성 전환된 탑승자
+func newInactivePassenger(t *testing.T, repo *mocks.PassengerRepository, name string, deactivatedAt time.Time) *domain.Passenger {
+	passenger := domain.NewPassenger(name, "김보호", "010-1234-5678")
+	passenger.MedicalNotes = "땅콩 알레르기"
+	passenger.Address = "서울시 강남구"
+	passenger.SetInactive()
+	passenger.DeactivatedAt = &deactivatedAt
+	require.NoError(t, repo.Create(context.Background(), passenger))
+	return passenger
+}
+
+// TestRetentionService_Run_AnonymizesExpiredPassengers - 보존 기간 경과한 비활성 탑승자만 익명화
+func TestRetentionService_Run_AnonymizesExpiredPassengers(t *testing.T) {
+	// Given
+	f := newRetentionFixture()
+	now := time.Now()
+	expired := newInactivePassenger(t, f.passengerRepo, "홍길동", now.Add(-retentionPeriod-24*time.Hour))
+	recent := newInactivePassenger(t, f.passengerRepo, "김철수", now.AddDate(-1, 0, 0))
+	active := domain.NewPassenger("이영희", "이보호", "010-2222-3333")
+	require.NoError(t, f.passengerRepo.Create(context.Background(), active))
+
+	// When
+	result, err := f.svc.Run(context.Background(), now)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Passengers)
+
+	anonymized, err := f.passengerRepo.GetByID(context.Background(), expired.ID)
+	require.NoError(t, err)
+	assert.True(t, anonymized.IsAnonymized())
+	assert.Equal(t, domain.AnonymizedName, anonymized.Name)
+	assert.Equal(t, domain.AnonymizedName, anonymized.GuardianName)
+	assert.Empty(t, anonymized.GuardianPhone)
+	assert.Empty(t, anonymized.MedicalNotes)
+	assert.Empty(t, anonymized.Address)
+	assert.Equal(t, domain.PassengerStatusInactive, anonymized.Status)
+
+	kept, err := f.passengerRepo.GetByID(context.Background(), recent.ID)
+	require.NoError(t, err)
+	assert.False(t, kept.IsAnonymized())
+	assert.Equal(t, "김철수", kept.Name)
+
+	kept, err = f.passengerRepo.GetByID(context.Background(), active.ID)
+	require.NoError(t, err)
+	assert.False(t, kept.IsAnonymized())
+}
+
+// TestRetentionService_Run_AnonymizesTerminatedDrivers - 퇴사 후 보존 기간 경과한 기사 익명화
+func TestRetentionService_Run_AnonymizesTerminatedDrivers(t *testing.T) {
+	// Given
+	f := newRetentionFixture()
+	now := time.Now()
+	expiry := now.AddDate(1, 0, 0)
+
+	terminated := domain.NewDriver("김기사", "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, expiry)
+	terminated.Terminate(now.AddDate(-4, 0, 0))
+	require.NoError(t, f.driverRepo.Create(context.Background(), terminated))
+
+	working := domain.NewDriver("이기사", "010-9876-5432", "11-22-333333-55", domain.LicenseType1Large, expiry)
+	require.NoError(t, f.driverRepo.Create(context.Background(), working))
+
+	// When
+	result, err := f.svc.Run(context.Background(), now)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Drivers)
+
+	anonymized, err := f.driverRepo.GetByID(context.Background(), terminated.ID)
+	require.NoError(t, err)
+	assert.True(t, anonymized.IsAnonymized())
+	assert.Equal(t, domain.AnonymizedName, anonymized.Name)
+	assert.Empty(t, anonymized.Phone)
+	assert.Equal(t, "ANON-"+terminated.ID, anonymized.LicenseNumber)
+
+	kept, err := f.driverRepo.GetByID(context.Background(), working.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "이기사", kept.Name)
+}
+
+// TestRetentionService_Run_RedactsAuditLogs - 익명화 대상의 과거 감사 로그 값 가림
+func TestRetentionService_Run_RedactsAuditLogs(t *testing.T) {
+	// Given
+	f := newRetentionFixture()
+	now := time.Now()
+	passenger := newInactivePassenger(t, f.passengerRepo, "홍길동", now.Add(-retentionPeriod-time.Hour))
+	log := domain.NewAuditLog("passengers", passenger.ID, domain.AuditActionUpdate, map[string]domain.AuditChange{
+		"name": {Before: "홍길순", After: "홍길동"},
+		"age":  {Before: 6, After: 7},
+	})
+	require.NoError(t, f.auditLogRepo.Create(context.Background(), log))
+
+	// When
+	_, err := f.svc.Run(context.Background(), now)
+
+	// Then
+	require.NoError(t, err)
+	logs, _, err := f.auditLogRepo.List(context.Background(), repository.AuditLogFilter{EntityID: passenger.ID})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, domain.AuditChange{Before: domain.AuditRedacted, After: domain.AuditRedacted}, logs[0].Changes["name"])
+	assert.Equal(t, domain.AuditChange{Before: 6, After: 7}, logs[0].Changes["age"])
+}
+
+// TestRetentionService_Run_Idempotent - 재실행 시 이미 익명화된 행은 건너뜀
+func TestRetentionService_Run_Idempotent(t *testing.T) {
+	// Given
+	f := newRetentionFixture()
+	now := time.Now()
+	newInactivePassenger(t, f.passengerRepo, "홍길동", now.Add(-retentionPeriod-time.Hour))
+	_, err := f.svc.Run(context.Background(), now)
+	require.NoError(t, err)
+
+	// When
+	result, err := f.svc.Run(context.Background(), now)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Passengers)
+	assert.Equal(t, 0, result.Drivers)
+}
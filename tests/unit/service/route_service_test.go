@@ -0,0 +1,358 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/geo"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStopRequest - 테스트용 정류장 요청
+func newStopRequest(name string, order int) dto.CreateStopRequest {
+	return dto.CreateStopRequest{
+		Name:      name,
+		Address:   "서울시 강남구",
+		Order:     order,
+		Latitude:  floatPtr(37.5),
+		Longitude: floatPtr(127.0),
+	}
+}
+
+// createRouteWithStops - A, B, C 정류장을 가진 경로 생성
+func createRouteWithStops(t *testing.T, svc *service.RouteService) *domain.Route {
+	route, err := svc.Create(context.Background(), &dto.CreateRouteRequest{
+		Name: "1호차 등원",
+		Stops: []dto.CreateStopRequest{
+			newStopRequest("A", 0),
+			newStopRequest("B", 0),
+			newStopRequest("C", 0),
+		},
+	})
+	require.NoError(t, err)
+	return route
+}
+
+// stopNames - 정류장 이름을 순서대로 반환하고 순서가 1..N 연속인지 검증
+func stopNames(t *testing.T, stops []domain.Stop) []string {
+	names := make([]string, len(stops))
+	for i, stop := range stops {
+		assert.Equal(t, i+1, stop.Order)
+		names[i] = stop.Name
+	}
+	return names
+}
+
+// TestRouteService_AddStop_Insert - 중간 삽입 시 이후 정류장 순서 밀림
+func TestRouteService_AddStop_Insert(t *testing.T) {
+	// Given
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, nil)
+	route := createRouteWithStops(t, svc)
+	req := newStopRequest("X", 2)
+
+	// When
+	_, err := svc.AddStop(context.Background(), route.ID, &req)
+	require.NoError(t, err)
+	stops, err := svc.ListStops(context.Background(), route.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A", "X", "B", "C"}, stopNames(t, stops))
+}
+
+// TestRouteService_AddStop_OutOfRange - 순서 범위 초과
+func TestRouteService_AddStop_OutOfRange(t *testing.T) {
+	// Given
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, nil)
+	route := createRouteWithStops(t, svc)
+	req := newStopRequest("X", 5)
+
+	// When
+	_, err := svc.AddStop(context.Background(), route.ID, &req)
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeValidation, appErr.Code)
+}
+
+// TestRouteService_MoveAndRemoveStop - 순서 이동 및 삭제 후 1..N 유지
+func TestRouteService_MoveAndRemoveStop(t *testing.T) {
+	// Given
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, nil)
+	route := createRouteWithStops(t, svc)
+	first, last := route.Stops[0], route.Stops[2]
+	order := 3
+
+	// When
+	_, err := svc.UpdateStop(context.Background(), route.ID, first.ID, &dto.UpdateStopRequest{Order: &order})
+	require.NoError(t, err)
+	moved, _ := svc.ListStops(context.Background(), route.ID)
+	require.NoError(t, svc.RemoveStop(context.Background(), route.ID, last.ID))
+	removed, _ := svc.ListStops(context.Background(), route.ID)
+
+	// Then
+	assert.Equal(t, []string{"B", "C", "A"}, stopNames(t, moved))
+	assert.Equal(t, []string{"B", "A"}, stopNames(t, removed))
+}
+
+// TestRouteService_UpdateStop_InvalidOrder - 순서가 범위를 벗어나면 다른 필드도 저장하지 않음
+func TestRouteService_UpdateStop_InvalidOrder(t *testing.T) {
+	// Given
+	txManager := mocks.NewTxManager()
+	svc := service.NewRouteService(mocks.NewRouteRepository(), txManager, nil, nil)
+	route := createRouteWithStops(t, svc)
+	first := route.Stops[0]
+	name, order := "정문", 4
+
+	// When
+	_, err := svc.UpdateStop(context.Background(), route.ID, first.ID, &dto.UpdateStopRequest{Name: &name, Order: &order})
+	stops, _ := svc.ListStops(context.Background(), route.ID)
+
+	// Then
+	assertAppError(t, err, util.ErrCodeValidation)
+	assert.Equal(t, []string{"A", "B", "C"}, stopNames(t, stops))
+	assert.Zero(t, txManager.Calls)
+}
+
+// TestRouteService_ReorderStops - 전체 순서 재배치
+func TestRouteService_ReorderStops(t *testing.T) {
+	// Given
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, nil)
+	route := createRouteWithStops(t, svc)
+	a, b, c := route.Stops[0].ID, route.Stops[1].ID, route.Stops[2].ID
+
+	// When
+	reordered, err := svc.ReorderStops(context.Background(), route.ID, []string{c, a, b})
+	_, missingErr := svc.ReorderStops(context.Background(), route.ID, []string{c, a})
+	_, dupErr := svc.ReorderStops(context.Background(), route.ID, []string{c, a, a})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C", "A", "B"}, stopNames(t, reordered.Stops))
+	assert.Equal(t, util.ErrCodeValidation, missingErr.(*util.AppError).Code)
+	assert.Equal(t, util.ErrCodeValidation, dupErr.(*util.AppError).Code)
+}
+
+// createRouteAlongLatitude - 위도 0.01도(≈1.1km) 간격 정류장 3개 경로 생성
+func createRouteAlongLatitude(t *testing.T, svc *service.RouteService) *domain.Route {
+	stops := make([]dto.CreateStopRequest, 3)
+	for i := range stops {
+		stops[i] = newStopRequest(string(rune('A'+i)), 0)
+		stops[i].Latitude = floatPtr(37.50 + float64(i)*0.01)
+	}
+	route, err := svc.Create(context.Background(), &dto.CreateRouteRequest{Name: "1호차 등원", EstimatedTime: 60, Stops: stops})
+	require.NoError(t, err)
+	return route
+}
+
+// TestRouteService_Directions - 지도 API로 예상 소요 시간/총 거리 자동 계산, 정류장 변경 시 다시 계산
+func TestRouteService_Directions(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), mocks.NewMapsClient(), nil)
+
+	// When
+	route := createRouteAlongLatitude(t, svc)
+
+	// Then - 도로 거리 1668m × 2, 시속 30km → 400초 (분 단위 올림)
+	assert.InDelta(t, 3336, route.TotalDistance, 2)
+	assert.Equal(t, 7, route.EstimatedTime)
+
+	// When
+	require.NoError(t, svc.RemoveStop(ctx, route.ID, route.Stops[2].ID))
+	updated, err := svc.Get(ctx, route.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.InDelta(t, 1668, updated.TotalDistance, 1)
+	assert.Equal(t, 4, updated.EstimatedTime)
+}
+
+// TestRouteService_Directions_Failure - 지도 API 실패 시 정류장 변경은 반영하고 기존 값 유지
+func TestRouteService_Directions_Failure(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	mapsClient := mocks.NewMapsClient()
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), mapsClient, nil)
+	route := createRouteAlongLatitude(t, svc)
+	mapsClient.Err = errors.New("maps: request failed: timeout")
+	req := newStopRequest("D", 0)
+
+	// When
+	_, err := svc.AddStop(ctx, route.ID, &req)
+	updated, _ := svc.Get(ctx, route.ID)
+	_, geometryErr := svc.Geometry(ctx, route.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 4, updated.GetStopCount())
+	assert.Equal(t, route.TotalDistance, updated.TotalDistance)
+	assertAppError(t, geometryErr, util.ErrCodeExternal)
+}
+
+// TestRouteService_Geometry - 정류장 사이 구간별 거리/소요 시간, 지도 API 미설정 시 충돌
+func TestRouteService_Geometry(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), mocks.NewMapsClient(), nil)
+	route := createRouteAlongLatitude(t, svc)
+
+	// When
+	geometry, err := svc.Geometry(ctx, route.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, geometry.Legs, 2)
+	assert.Equal(t, route.Stops[0].ID, geometry.Legs[0].FromStopID)
+	assert.Equal(t, route.Stops[1].ID, geometry.Legs[0].ToStopID)
+	assert.Equal(t, route.Stops[2].ID, geometry.Legs[1].ToStopID)
+	assert.Equal(t, geometry.Legs[0].Duration+geometry.Legs[1].Duration, geometry.Duration)
+	assert.NotEmpty(t, geometry.Polyline)
+
+	// When
+	_, err = service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, nil).Geometry(ctx, route.ID)
+
+	// Then
+	assertAppError(t, err, util.ErrCodeConflict)
+}
+
+// TestRouteService_Geocode - 좌표 없이 주소만 보내면 주소 검색, 좌표를 보내면 직접 입력값 사용
+func TestRouteService_Geocode(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	geocoder := mocks.NewGeocoder()
+	geocoder.Addresses["서울시 강남구 테헤란로 152"] = geo.Point{Latitude: 37.5006, Longitude: 127.0364}
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, geocoder)
+	route := createRouteWithStops(t, svc)
+	req := dto.CreateStopRequest{Name: "X", Address: "서울시 강남구 테헤란로 152"}
+
+	// When
+	stop, err := svc.AddStop(ctx, route.ID, &req)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 37.5006, stop.Latitude)
+	assert.Equal(t, 127.0364, stop.Longitude)
+	assert.Equal(t, 1, geocoder.Calls) // 좌표를 보낸 A, B, C는 검색하지 않음
+	assert.Equal(t, 37.5, route.Stops[0].Latitude)
+}
+
+// TestRouteService_Geocode_Failure - 주소 검색 실패 시 정류장 생성 거부
+func TestRouteService_Geocode_Failure(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	geocoder := mocks.NewGeocoder()
+	stops := []dto.CreateStopRequest{newStopRequest("A", 0), {Name: "B", Address: "없는 주소"}}
+
+	// When - 검색 결과 없음
+	_, notFoundErr := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, geocoder).
+		Create(ctx, &dto.CreateRouteRequest{Name: "1호차 등원", Stops: stops})
+
+	// Then
+	assertAppError(t, notFoundErr, util.ErrCodeValidation)
+	assert.Contains(t, notFoundErr.(*util.AppError).Details, "stops[1].address")
+
+	// When - 주소 검색 미설정
+	_, disabledErr := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, nil).
+		Create(ctx, &dto.CreateRouteRequest{Name: "1호차 등원", Stops: stops})
+
+	// Then
+	assertAppError(t, disabledErr, util.ErrCodeValidation)
+	assert.Contains(t, disabledErr.(*util.AppError).Details, "stops[1].latitude")
+
+	// When - 주소 검색 API 장애
+	geocoder.Err = errors.New("maps: request failed: timeout")
+	_, externalErr := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, geocoder).
+		Create(ctx, &dto.CreateRouteRequest{Name: "1호차 등원", Stops: stops})
+
+	// Then
+	assertAppError(t, externalErr, util.ErrCodeExternal)
+}
+
+// TestRouteService_Optimize - 첫/마지막 정류장 고정, 되돌아가는 순서를 바로잡고 절약 시간 계산 (저장하지 않음)
+func TestRouteService_Optimize(t *testing.T) {
+	// Given - A(37.50) → B(37.52) → C(37.51) → D(37.53): B, C를 바꾸면 되돌아가지 않음
+	ctx := context.Background()
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), mocks.NewMapsClient(), nil)
+	stops := make([]dto.CreateStopRequest, 4)
+	for i, latitude := range []float64{37.50, 37.52, 37.51, 37.53} {
+		stops[i] = newStopRequest(string(rune('A'+i)), 0)
+		stops[i].Latitude = floatPtr(latitude)
+	}
+	route, err := svc.Create(ctx, &dto.CreateRouteRequest{Name: "1호차 등원", Stops: stops})
+	require.NoError(t, err)
+
+	// When
+	proposal, err := svc.Optimize(ctx, route.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, proposal.Changed)
+	assert.Equal(t, []string{route.Stops[0].ID, route.Stops[2].ID, route.Stops[1].ID, route.Stops[3].ID}, proposal.StopIDs)
+	assert.Equal(t, 3, proposal.Stops[1].CurrentOrder)
+	assert.Equal(t, 2, proposal.Stops[1].ProposedOrder)
+	assert.Greater(t, proposal.SavedDuration, 0)
+	assert.Equal(t, proposal.CurrentDuration-proposal.OptimizedDuration, proposal.SavedDuration)
+	assert.Less(t, proposal.OptimizedDistance, proposal.CurrentDistance)
+
+	unchanged, _ := svc.Get(ctx, route.ID)
+	assert.Equal(t, []string{"A", "B", "C", "D"}, stopNames(t, unchanged.Stops))
+
+	// When - 이미 최적 순서
+	_, err = svc.ReorderStops(ctx, route.ID, proposal.StopIDs)
+	require.NoError(t, err)
+	again, err := svc.Optimize(ctx, route.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.False(t, again.Changed)
+	assert.Equal(t, proposal.StopIDs, again.StopIDs)
+	assert.Equal(t, 0, again.SavedDuration)
+}
+
+// TestRouteService_Optimize_Conflict - 지도 API 미설정, 정류장 3개 미만이면 최적화 불가
+func TestRouteService_Optimize_Conflict(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	repo := mocks.NewRouteRepository()
+	svc := service.NewRouteService(repo, mocks.NewTxManager(), mocks.NewMapsClient(), nil)
+	route, err := svc.Create(ctx, &dto.CreateRouteRequest{
+		Name:  "1호차 등원",
+		Stops: []dto.CreateStopRequest{newStopRequest("A", 0), newStopRequest("B", 0)},
+	})
+	require.NoError(t, err)
+
+	// When
+	_, tooFewErr := svc.Optimize(ctx, route.ID)
+	_, disabledErr := service.NewRouteService(repo, mocks.NewTxManager(), nil, nil).Optimize(ctx, route.ID)
+
+	// Then
+	assertAppError(t, tooFewErr, util.ErrCodeConflict)
+	assertAppError(t, disabledErr, util.ErrCodeConflict)
+}
+
+// TestRouteService_Restore - 경로와 함께 삭제된 정류장만 복구 (먼저 삭제한 정류장은 그대로)
+func TestRouteService_Restore(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	svc := service.NewRouteService(mocks.NewRouteRepository(), mocks.NewTxManager(), nil, nil)
+	route := createRouteWithStops(t, svc)
+	require.NoError(t, svc.RemoveStop(ctx, route.ID, route.Stops[1].ID))
+	require.NoError(t, svc.Delete(ctx, route.ID))
+
+	// When
+	restored, err := svc.Restore(ctx, route.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt)
+	assert.Equal(t, []string{"A", "C"}, stopNames(t, restored.Stops))
+}
@@ -0,0 +1,50 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScheduleExceptionService - 예외 날짜 추가/목록/삭제 (일정 + 날짜당 하나)
+func TestScheduleExceptionService(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오후 2시 A코스", "14:00", domain.TimeSlotAfternoon, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	svc := service.NewScheduleExceptionService(scheduleRepo, mocks.NewScheduleExceptionRepository())
+
+	// When
+	extra, err := svc.Add(ctx, schedule.ID, &dto.CreateScheduleExceptionRequest{Date: "2025-07-20", Type: "extra", Reason: "여름 캠프"})
+	require.NoError(t, err)
+	_, err = svc.Add(ctx, schedule.ID, &dto.CreateScheduleExceptionRequest{Date: "2025-05-05", Type: "skip"})
+	require.NoError(t, err)
+	_, duplicateErr := svc.Add(ctx, schedule.ID, &dto.CreateScheduleExceptionRequest{Date: "2025-07-20", Type: "skip"})
+	_, missingErr := svc.Add(ctx, "missing", &dto.CreateScheduleExceptionRequest{Date: "2025-07-20", Type: "skip"})
+
+	// Then
+	assert.True(t, extra.IsExtra())
+	assertAppError(t, duplicateErr, util.ErrCodeDuplicate)
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+
+	exceptions, err := svc.List(ctx, schedule.ID)
+	require.NoError(t, err)
+	require.Len(t, exceptions, 2)
+	assert.Equal(t, "2025-05-05", exceptions[0].Date.Format("2006-01-02"))
+
+	// When: 다른 일정 ID로는 삭제 불가
+	assertAppError(t, svc.Delete(ctx, "other", extra.ID), util.ErrCodeNotFound)
+	require.NoError(t, svc.Delete(ctx, schedule.ID, extra.ID))
+
+	// Then
+	exceptions, _ = svc.List(ctx, schedule.ID)
+	assert.Len(t, exceptions, 1)
+}
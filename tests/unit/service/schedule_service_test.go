@@ -0,0 +1,283 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scheduleFixture - 일정 테스트용 의존성
+type scheduleFixture struct {
+	svc           *service.ScheduleService
+	vehicleRepo   *mocks.VehicleRepository
+	driverRepo    *mocks.DriverRepository
+	passengerRepo *mocks.PassengerRepository
+	route         *domain.Route
+	vehicle       *domain.Vehicle
+	driver        *domain.Driver
+}
+
+// newScheduleFixture - 활성 경로/차량/기사를 미리 등록한 일정 서비스
+func newScheduleFixture(t *testing.T) *scheduleFixture {
+	ctx := context.Background()
+	routeRepo := mocks.NewRouteRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	driverRepo := mocks.NewDriverRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+
+	route := domain.NewRoute("A코스", "", 40)
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2024, "흰색")
+	driver := domain.NewDriver("김기사", "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	driver.VerifyLicense("admin-1", time.Now())
+	require.NoError(t, routeRepo.Create(ctx, route))
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	require.NoError(t, driverRepo.Create(ctx, driver))
+
+	return &scheduleFixture{
+		svc:           service.NewScheduleService(mocks.NewScheduleRepository(), routeRepo, vehicleRepo, driverRepo, passengerRepo),
+		vehicleRepo:   vehicleRepo,
+		driverRepo:    driverRepo,
+		passengerRepo: passengerRepo,
+		route:         route,
+		vehicle:       vehicle,
+		driver:        driver,
+	}
+}
+
+// request - 유효한 일정 생성 요청
+func (f *scheduleFixture) request() *dto.CreateScheduleRequest {
+	return &dto.CreateScheduleRequest{
+		Name:            "오전 8시 A코스",
+		StartTime:       "08:00",
+		TimeSlot:        "morning",
+		DaysOfWeek:      []int{1, 2, 3, 4, 5},
+		RouteID:         f.route.ID,
+		VehicleID:       f.vehicle.ID,
+		DefaultDriverID: f.driver.ID,
+	}
+}
+
+// TestScheduleService_Create - 일정 생성
+func TestScheduleService_Create(t *testing.T) {
+	// Given
+	f := newScheduleFixture(t)
+
+	// When
+	schedule, err := f.svc.Create(context.Background(), f.request())
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, schedule.IsActive())
+	assert.True(t, schedule.IsWeekday())
+	assert.True(t, schedule.SkipHolidays) // 기본값: 공휴일 운행 안 함
+}
+
+// TestScheduleService_SkipHolidays - 생성/수정 시 공휴일 운행 여부 지정 (생략하면 유지)
+func TestScheduleService_SkipHolidays(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newScheduleFixture(t)
+	req := f.request()
+	runsOnHolidays := false
+	req.SkipHolidays = &runsOnHolidays
+
+	// When
+	created, err := f.svc.Create(ctx, req)
+	require.NoError(t, err)
+	name := "오전 8시 A코스 (연중무휴)"
+	renamed, err := f.svc.Update(ctx, created.ID, &dto.UpdateScheduleRequest{Name: &name})
+	require.NoError(t, err)
+	skip := true
+	updated, err := f.svc.Update(ctx, created.ID, &dto.UpdateScheduleRequest{SkipHolidays: &skip})
+	require.NoError(t, err)
+
+	// Then
+	assert.False(t, created.SkipHolidays)
+	assert.False(t, renamed.SkipHolidays)
+	assert.True(t, updated.SkipHolidays)
+}
+
+// TestScheduleService_Create_InvalidReferences - 존재하지 않는 경로, 정비 중인 차량
+func TestScheduleService_Create_InvalidReferences(t *testing.T) {
+	// Given
+	f := newScheduleFixture(t)
+	f.vehicle.SetMaintenance()
+	require.NoError(t, f.vehicleRepo.Update(context.Background(), f.vehicle))
+	req := f.request()
+	req.RouteID = "unknown"
+	req.DaysOfWeek = []int{1, 1}
+
+	// When
+	_, err := f.svc.Create(context.Background(), req)
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeValidation, appErr.Code)
+	assert.Contains(t, appErr.Details, "route_id")
+	assert.Contains(t, appErr.Details, "vehicle_id")
+	assert.Contains(t, appErr.Details, "days_of_week")
+	assert.NotContains(t, appErr.Details, "default_driver_id")
+}
+
+// TestScheduleService_Create_DriverLicense - 면허가 확인되지 않았거나 만료된 기사는 기본 기사로 배정 불가
+func TestScheduleService_Create_DriverLicense(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	f := newScheduleFixture(t)
+	unverified := domain.NewDriver("박기사", "010-2222-3333", "11-22-444444-55", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	require.NoError(t, f.driverRepo.Create(ctx, unverified))
+	expired := domain.NewDriver("최기사", "010-3333-4444", "11-22-555555-66", domain.LicenseType1Large, time.Now().AddDate(0, 0, -1))
+	expired.VerifyLicense("admin-1", time.Now().AddDate(-1, 0, 0))
+	require.NoError(t, f.driverRepo.Create(ctx, expired))
+
+	for _, driver := range []*domain.Driver{unverified, expired} {
+		req := f.request()
+		req.DefaultDriverID = driver.ID
+
+		// When
+		_, err := f.svc.Create(ctx, req)
+
+		// Then
+		appErr, ok := err.(*util.AppError)
+		require.True(t, ok)
+		assert.Equal(t, util.ErrCodeValidation, appErr.Code)
+		assert.Equal(t, "면허가 확인되지 않았거나 만료된 기사입니다", appErr.Details["default_driver_id"])
+	}
+}
+
+// TestScheduleService_ActivateDeactivate - 비활성화 후 재활성화
+func TestScheduleService_ActivateDeactivate(t *testing.T) {
+	// Given
+	f := newScheduleFixture(t)
+	schedule, err := f.svc.Create(context.Background(), f.request())
+	require.NoError(t, err)
+
+	// When
+	deactivated, err := f.svc.Deactivate(context.Background(), schedule.ID)
+	require.NoError(t, err)
+	activated, err := f.svc.Activate(context.Background(), schedule.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.ScheduleStatusInactive, deactivated.Status)
+	assert.Equal(t, domain.ScheduleStatusActive, activated.Status)
+}
+
+// TestScheduleService_Conflicts - 같은 차량/기사의 겹치는 요일·기간·시간대 일정은 CONFLICT (경로 소요 40분)
+func TestScheduleService_Conflicts(t *testing.T) {
+	// Given: 평일 08:00~08:40 일정
+	ctx := context.Background()
+	f := newScheduleFixture(t)
+	existing, err := f.svc.Create(ctx, f.request())
+	require.NoError(t, err)
+
+	at := func(startTime string, days []int) *dto.CreateScheduleRequest {
+		req := f.request()
+		req.Name = "추가 " + startTime
+		req.StartTime = startTime
+		req.DaysOfWeek = days
+		return req
+	}
+
+	// When
+	_, overlapErr := f.svc.Create(ctx, at("08:30", []int{3, 6}))
+	_, backToBackErr := f.svc.Create(ctx, at("08:40", []int{1, 2, 3, 4, 5}))
+	_, weekendErr := f.svc.Create(ctx, at("08:00", []int{6, 7}))
+	earlier := "07:50"
+	_, selfErr := f.svc.Update(ctx, existing.ID, &dto.UpdateScheduleRequest{StartTime: &earlier})
+
+	// Then
+	require.Error(t, overlapErr)
+	appErr := overlapErr.(*util.AppError)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+	conflicts := appErr.Details["conflicts"].([]dto.ScheduleConflictResponse)
+	require.Len(t, conflicts, 2)
+	assert.Equal(t, "vehicle", conflicts[0].Resource)
+	assert.Equal(t, "driver", conflicts[1].Resource)
+	assert.Equal(t, existing.ID, conflicts[0].ScheduleID)
+	assert.Equal(t, "08:00", conflicts[0].StartTime)
+	assert.Equal(t, "08:40", conflicts[0].EndTime)
+
+	assert.NoError(t, backToBackErr) // 앞 일정이 끝나는 시각에 출발
+	assert.NoError(t, weekendErr)
+	assert.NoError(t, selfErr) // 자기 자신과는 비교하지 않음
+
+	// When: 유효 기간이 겹치지 않으면 허용, 무기한 일정은 모든 기간과 겹침
+	summer := at("10:00", []int{1})
+	summer.ValidFrom, summer.ValidTo = dateRef(2025, 7, 1), dateRef(2025, 7, 31)
+	winter := at("10:00", []int{1})
+	winter.ValidFrom, winter.ValidTo = dateRef(2025, 12, 1), dateRef(2025, 12, 31)
+	_, summerErr := f.svc.Create(ctx, summer)
+	_, winterErr := f.svc.Create(ctx, winter)
+	_, allYearErr := f.svc.Create(ctx, at("10:20", []int{1}))
+
+	// Then
+	assert.NoError(t, summerErr)
+	assert.NoError(t, winterErr)
+	require.Error(t, allYearErr)
+	assert.Len(t, allYearErr.(*util.AppError).Details["conflicts"], 4)
+
+	// When: 비활성 일정은 비교하지 않지만, 겹치는 일정이 생기면 다시 활성화할 수 없음
+	_, err = f.svc.Deactivate(ctx, existing.ID)
+	require.NoError(t, err)
+	_, replacementErr := f.svc.Create(ctx, at("08:00", []int{1, 2, 3, 4, 5}))
+	_, activateErr := f.svc.Activate(ctx, existing.ID)
+
+	// Then
+	assert.NoError(t, replacementErr)
+	assertAppError(t, activateErr, util.ErrCodeConflict)
+}
+
+// dateRef - 날짜 포인터 (UTC 자정)
+func dateRef(year int, month time.Month, day int) *time.Time {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+// TestScheduleService_Capacity - 경로 배정 탑승자가 차량 승객 정원을 넘으면 초과 인원과 함께 409 (비활성 일정은 허용)
+func TestScheduleService_Capacity(t *testing.T) {
+	// Given: 12인승(승객 정원 11명) 차량, 경로에 12명 배정
+	ctx := context.Background()
+	f := newScheduleFixture(t)
+	for i := 0; i < 12; i++ {
+		passenger := domain.NewPassenger(fmt.Sprintf("탑승자%d", i), "보호자", "010-0000-0000")
+		passenger.AssignToStop(f.route.ID, "stop-1", 1)
+		require.NoError(t, f.passengerRepo.Create(ctx, passenger))
+	}
+
+	// When
+	_, err := f.svc.Create(ctx, f.request())
+
+	// Then
+	require.Error(t, err)
+	appErr := err.(*util.AppError)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+	assert.Equal(t, 1, appErr.Details["overflow"])
+	assert.Equal(t, 12, appErr.Details["riders"])
+
+	// When: 큰 차량으로 바꾸면 생성 가능
+	bus := domain.NewVehicle("34나5678", "카운티", "현대", domain.VehicleTypeMiniBus, 25, 2024, "노랑")
+	require.NoError(t, f.vehicleRepo.Create(ctx, bus))
+	req := f.request()
+	req.VehicleID = bus.ID
+	created, err := f.svc.Create(ctx, req)
+
+	// Then
+	require.NoError(t, err)
+
+	// When: 정원이 작은 차량으로 수정하면 거부
+	_, err = f.svc.Update(ctx, created.ID, &dto.UpdateScheduleRequest{VehicleID: &f.vehicle.ID})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeConflict)
+}
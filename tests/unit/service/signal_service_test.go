@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSignalNotifier - 끊김/복구 알림 기록
+type recordingSignalNotifier struct {
+	lost     []string
+	restored []string
+}
+
+func (n *recordingSignalNotifier) NotifySignalLost(ctx context.Context, trip *domain.Trip, lastSeen time.Time) error {
+	n.lost = append(n.lost, trip.ID)
+	return nil
+}
+
+func (n *recordingSignalNotifier) NotifySignalRestored(ctx context.Context, trip *domain.Trip) error {
+	n.restored = append(n.restored, trip.ID)
+	return nil
+}
+
+// newInProgressTrip - startedAt에 시작한 운행 중 운행 저장
+func newInProgressTrip(t *testing.T, repo *mocks.TripRepository, id string, startedAt time.Time) {
+	t.Helper()
+	require.NoError(t, repo.Create(context.Background(), &domain.Trip{
+		ID:        id,
+		Status:    domain.TripStatusInProgress,
+		StartedAt: &startedAt,
+	}))
+}
+
+// TestSignalService_Detect - timeout 이상 위치가 없는 운행만 끊김 표시, 위치 기록이 없으면 시작 시각 기준
+func TestSignalService_Detect(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	now := time.Date(2025, 3, 3, 8, 30, 0, 0, time.UTC)
+	tripRepo := mocks.NewTripRepository()
+	lastSeenRepo := mocks.NewTripLastSeenRepository()
+	notifier := &recordingSignalNotifier{}
+	svc := service.NewSignalService(tripRepo, lastSeenRepo, notifier, 3*time.Minute)
+
+	newInProgressTrip(t, tripRepo, "fresh", now.Add(-time.Hour))
+	newInProgressTrip(t, tripRepo, "stale", now.Add(-time.Hour))
+	newInProgressTrip(t, tripRepo, "silent", now.Add(-5*time.Minute))
+	newInProgressTrip(t, tripRepo, "just-started", now.Add(-time.Minute))
+	require.NoError(t, lastSeenRepo.Touch(ctx, "fresh", now.Add(-time.Minute)))
+	require.NoError(t, lastSeenRepo.Touch(ctx, "stale", now.Add(-4*time.Minute)))
+
+	// When
+	marked, err := svc.Detect(ctx, now)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, marked)
+	assert.ElementsMatch(t, []string{"stale", "silent"}, notifier.lost)
+
+	stale, err := tripRepo.GetByID(ctx, "stale")
+	require.NoError(t, err)
+	assert.True(t, stale.IsSignalLost())
+	fresh, err := tripRepo.GetByID(ctx, "fresh")
+	require.NoError(t, err)
+	assert.False(t, fresh.IsSignalLost())
+
+	// When - 다음 주기에도 끊겨 있으면 다시 알리지 않음
+	marked, err = svc.Detect(ctx, now.Add(30*time.Second))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, marked)
+	assert.Len(t, notifier.lost, 2)
+}
+
+// TestSignalService_ObserveLocation - 끊김 표시된 운행에 위치가 다시 오면 해제 후 복구 알림
+func TestSignalService_ObserveLocation(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	now := time.Now()
+	tripRepo := mocks.NewTripRepository()
+	lastSeenRepo := mocks.NewTripLastSeenRepository()
+	notifier := &recordingSignalNotifier{}
+	svc := service.NewSignalService(tripRepo, lastSeenRepo, notifier, 3*time.Minute)
+
+	newInProgressTrip(t, tripRepo, "trip-1", now.Add(-10*time.Minute))
+	marked, err := svc.Detect(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, marked)
+	trip, err := tripRepo.GetByID(ctx, "trip-1")
+	require.NoError(t, err)
+
+	// When
+	svc.ObserveLocation(ctx, trip, nil, &domain.VehiclePosition{TripID: "trip-1", RecordedAt: now})
+
+	// Then
+	assert.False(t, trip.IsSignalLost())
+	assert.Equal(t, []string{"trip-1"}, notifier.restored)
+	stored, err := tripRepo.GetByID(ctx, "trip-1")
+	require.NoError(t, err)
+	assert.False(t, stored.IsSignalLost())
+
+	seen, err := lastSeenRepo.GetMany(ctx, []string{"trip-1"})
+	require.NoError(t, err)
+	assert.Contains(t, seen, "trip-1")
+
+	// When - 수신이 이어지면 timeout 전까지 다시 표시하지 않음
+	marked, err = svc.Detect(ctx, time.Now().Add(time.Minute))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 0, marked)
+}
@@ -0,0 +1,190 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stopEventFixture - 정류장 두 개(출발 후 10분, 25분) 경로의 운행 중 운행
+type stopEventFixture struct {
+	svc       *service.StopEventService
+	eventRepo *mocks.TripStopEventRepository
+	tripRepo  *mocks.TripRepository
+	trip      *domain.Trip
+	stops     []*domain.Stop
+}
+
+func newStopEventFixture(t *testing.T) *stopEventFixture {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	eventRepo := mocks.NewTripStopEventRepository()
+
+	route := domain.NewRoute("A코스", "", 40)
+	first := domain.NewStop(route.ID, "해오름아파트 정문", "", 1, 37.5, 127.0, 10)
+	second := domain.NewStop(route.ID, "어디니 어린이집", "", 2, 37.51, 127.01, 25)
+	route.AddStop(*first)
+	route.AddStop(*second)
+	require.NoError(t, routeRepo.Create(ctx, route))
+
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	trip := domain.NewTrip(schedule.ID, time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), "vehicle-1", "driver-1", nil)
+	require.NoError(t, trip.Start("driver:driver-1", nil))
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	return &stopEventFixture{
+		svc:       service.NewStopEventService(tripService, scheduleRepo, routeRepo, eventRepo, nil),
+		eventRepo: eventRepo,
+		tripRepo:  tripRepo,
+		trip:      trip,
+		stops:     []*domain.Stop{first, second},
+	}
+}
+
+// TestStopEventService_HandleStopEvent - 지오펜스 도착/출발은 자동 기록, 접근과 중복 판정은 무시
+func TestStopEventService_HandleStopEvent(t *testing.T) {
+	// Given
+	f := newStopEventFixture(t)
+	ctx := context.Background()
+	arrivedAt := time.Now()
+	stopID := f.stops[0].ID
+
+	// When
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventApproaching, TripID: f.trip.ID, StopID: stopID, OccurredAt: arrivedAt})
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventArrived, TripID: f.trip.ID, StopID: stopID, OccurredAt: arrivedAt})
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventArrived, TripID: f.trip.ID, StopID: stopID, OccurredAt: arrivedAt.Add(time.Minute)})
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventDeparted, TripID: f.trip.ID, StopID: stopID, OccurredAt: arrivedAt.Add(2 * time.Minute)})
+
+	// Then
+	events, err := f.svc.List(ctx, f.trip.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, domain.StopEventArrived, events[0].Type)
+	assert.Equal(t, domain.StopEventSourceGeofence, events[0].Source)
+	assert.Equal(t, "system", events[0].RecordedBy)
+	assert.True(t, events[0].OccurredAt.Equal(arrivedAt))
+	assert.Equal(t, domain.StopEventDeparted, events[1].Type)
+}
+
+// TestStopEventService_Record - 배정 기사의 수동 기록 (기록자, 앱 위치 저장)
+func TestStopEventService_Record(t *testing.T) {
+	// Given
+	f := newStopEventFixture(t)
+	lat, lng := 37.5001, 127.0002
+
+	// When
+	event, err := f.svc.Record(asPrincipal(domain.RoleDriver, "driver-1"), f.trip.ID, f.stops[0].ID, &dto.RecordStopEventRequest{
+		Type:                "arrived",
+		TripLocationRequest: dto.TripLocationRequest{Latitude: &lat, Longitude: &lng},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.StopEventSourceManual, event.Source)
+	assert.Equal(t, "driver:driver-1", event.RecordedBy)
+	require.NotNil(t, event.Location)
+	assert.Equal(t, lat, event.Location.Latitude)
+
+	// 수동 기록 뒤 지오펜스 판정은 덮어쓰지 않음
+	f.svc.HandleStopEvent(context.Background(), geofence.Event{Type: geofence.EventArrived, TripID: f.trip.ID, StopID: f.stops[0].ID, OccurredAt: time.Now()})
+	events, err := f.eventRepo.ListByTrip(context.Background(), f.trip.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.StopEventSourceManual, events[0].Source)
+}
+
+// TestStopEventService_Record_Rejected - 미배정 기사, 경로 밖 정류장, 중복 기록, 운행 중이 아닐 때 거부
+func TestStopEventService_Record_Rejected(t *testing.T) {
+	// Given
+	f := newStopEventFixture(t)
+	driverCtx := asPrincipal(domain.RoleDriver, "driver-1")
+	arrived := &dto.RecordStopEventRequest{Type: "arrived"}
+	_, err := f.svc.Record(driverCtx, f.trip.ID, f.stops[0].ID, arrived)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		stopID  string
+		wantErr string
+	}{
+		{name: "미배정 기사", ctx: asPrincipal(domain.RoleDriver, "driver-2"), stopID: f.stops[1].ID, wantErr: util.ErrCodeForbidden},
+		{name: "경로 밖 정류장", ctx: driverCtx, stopID: "stop-other", wantErr: util.ErrCodeNotFound},
+		{name: "이미 도착 기록", ctx: driverCtx, stopID: f.stops[0].ID, wantErr: util.ErrCodeDuplicate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			_, err := f.svc.Record(tt.ctx, f.trip.ID, tt.stopID, arrived)
+
+			// Then
+			assertAppError(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("완료된 운행", func(t *testing.T) {
+		// Given
+		require.NoError(t, f.trip.ConfirmVehicleEmpty("driver:driver-1"))
+		require.NoError(t, f.trip.Complete(nil))
+		require.NoError(t, f.tripRepo.Update(context.Background(), f.trip))
+
+		// When
+		_, err := f.svc.Record(driverCtx, f.trip.ID, f.stops[1].ID, arrived)
+
+		// Then
+		assertAppError(t, err, util.ErrCodeConflict)
+	})
+}
+
+// TestStopEventService_Punctuality - 일정 출발 08:00 기준 예상 도착과 실제 도착 비교 (5분 이내 정시)
+func TestStopEventService_Punctuality(t *testing.T) {
+	// Given
+	f := newStopEventFixture(t)
+	ctx := context.Background()
+	kst := time.FixedZone("KST", 9*60*60)
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventArrived, TripID: f.trip.ID, StopID: f.stops[0].ID,
+		OccurredAt: time.Date(2025, 3, 4, 8, 13, 0, 0, kst)})
+	f.svc.HandleStopEvent(ctx, geofence.Event{Type: geofence.EventDeparted, TripID: f.trip.ID, StopID: f.stops[0].ID,
+		OccurredAt: time.Date(2025, 3, 4, 8, 15, 0, 0, kst)})
+
+	// When
+	report, err := f.svc.Punctuality(asPrincipal(domain.RoleAdmin, ""), f.trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, report.Stops, 2)
+	assert.True(t, report.ScheduledDeparture.Equal(time.Date(2025, 3, 4, 8, 0, 0, 0, kst)))
+
+	first := report.Stops[0]
+	assert.True(t, first.EstimatedArrival.Equal(time.Date(2025, 3, 4, 8, 10, 0, 0, kst)))
+	require.NotNil(t, first.Delay)
+	assert.Equal(t, 3, *first.Delay)
+	assert.True(t, first.OnTime)
+	assert.Equal(t, domain.StopEventSourceGeofence, first.ArrivalSource)
+	assert.NotNil(t, first.ActualDeparture)
+
+	second := report.Stops[1]
+	assert.Nil(t, second.ActualArrival)
+	assert.Nil(t, second.Delay)
+	assert.False(t, second.OnTime)
+
+	assert.Equal(t, 1, report.RecordedStops)
+	assert.Equal(t, 1, report.OnTimeStops)
+	assert.Equal(t, 0, report.LateStops)
+	assert.Equal(t, 3.0, report.AverageDelay)
+}
@@ -0,0 +1,274 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/geofence"
+	"github.com/hyeokjun/eodini/internal/realtime"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trackingFixture - 실시간 위치 테스트용 의존성
+type trackingFixture struct {
+	svc           *service.TrackingService
+	tripService   *service.TripService
+	hub           *realtime.Hub
+	guardianRepo  *mocks.GuardianRepository
+	passengerRepo *mocks.PassengerRepository
+	locationRepo  *mocks.TripLocationRepository
+	routeRepo     *mocks.RouteRepository
+	trip          *domain.Trip
+}
+
+// newTrackingFixture - route-1 평일 일정의 2025-03-03(월) 운행을 생성한 실시간 위치 서비스
+func newTrackingFixture(t *testing.T) *trackingFixture {
+	ctx := context.Background()
+	scheduleRepo := mocks.NewScheduleRepository()
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	tripService := service.NewTripService(mocks.NewTripRepository(), scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, nil)
+	trip, err := tripService.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2025-03-03"})
+	require.NoError(t, err)
+
+	hub := realtime.NewHub()
+	routeRepo := mocks.NewRouteRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	locationRepo := mocks.NewTripLocationRepository()
+	return &trackingFixture{
+		svc:           service.NewTrackingService(tripService, scheduleRepo, routeRepo, guardianRepo, passengerRepo, locationRepo, hub, geofence.NewEngine(geofence.DefaultConfig(), geofence.NewMemoryStore(), hub)),
+		tripService:   tripService,
+		hub:           hub,
+		guardianRepo:  guardianRepo,
+		passengerRepo: passengerRepo,
+		locationRepo:  locationRepo,
+		routeRepo:     routeRepo,
+		trip:          trip,
+	}
+}
+
+// startTrip - 배정 기사로 운행 시작
+func (f *trackingFixture) startTrip(t *testing.T) {
+	_, err := f.tripService.Start(asPrincipal(domain.RoleDriver, "driver-1"), f.trip.ID, &dto.TripLocationRequest{})
+	require.NoError(t, err)
+}
+
+// linkChild - 보호자에게 routeID 경로에 배정된 자녀 연결
+func (f *trackingFixture) linkChild(t *testing.T, guardianID, routeID string) {
+	ctx := context.Background()
+	child := domain.NewPassenger("김어린", "김보호", "010-1234-5678")
+	child.AssignToStop(routeID, "stop-1", 1)
+	require.NoError(t, f.passengerRepo.Create(ctx, child))
+	require.NoError(t, f.guardianRepo.LinkPassenger(ctx, &domain.GuardianPassenger{GuardianID: guardianID, PassengerID: child.ID}))
+}
+
+func floatPtr(v float64) *float64 { return &v }
+
+// TestTrackingService_ReportLocation - 운행 중일 때 배정 기사 위치를 구독자에게 전달
+func TestTrackingService_ReportLocation(t *testing.T) {
+	// Given
+	f := newTrackingFixture(t)
+	sub := f.hub.Subscribe(f.trip.ID)
+	driverCtx := asPrincipal(domain.RoleDriver, "driver-1")
+	req := &dto.ReportLocationRequest{Latitude: floatPtr(37.5665), Longitude: floatPtr(126.978), Speed: floatPtr(32)}
+
+	// When: 시작 전 전송
+	_, err := f.svc.ReportLocation(driverCtx, f.trip.ID, req)
+
+	// Then
+	var appErr *util.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, util.ErrCodeConflict, appErr.Code)
+
+	// When: 운행 시작 후 전송
+	f.startTrip(t)
+	position, err := f.svc.ReportLocation(driverCtx, f.trip.ID, req)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "vehicle-1", position.VehicleID)
+	assert.WithinDuration(t, time.Now(), position.RecordedAt, time.Second)
+	assert.Len(t, sub.Messages(), 1)
+
+	saved, err := f.locationRepo.ListByTrip(context.Background(), f.trip.ID)
+	require.NoError(t, err)
+	assert.Len(t, saved, 1)
+}
+
+// TestTrackingService_ReportLocation_Geofence - 일정 경로 정류장에 도착하면 구독자에게 정류장 이벤트 전달
+func TestTrackingService_ReportLocation_Geofence(t *testing.T) {
+	// Given
+	f := newTrackingFixture(t)
+	route := domain.NewRoute("A코스", "", 30)
+	route.ID = "route-1"
+	route.Stops = []domain.Stop{*domain.NewStop(route.ID, "OO아파트 정문", "", 1, 37.5, 127.0, 0)}
+	require.NoError(t, f.routeRepo.Create(context.Background(), route))
+	f.startTrip(t)
+	sub := f.hub.Subscribe(f.trip.ID)
+
+	// When: 정류장 약 11m 앞
+	_, err := f.svc.ReportLocation(asPrincipal(domain.RoleDriver, "driver-1"), f.trip.ID, &dto.ReportLocationRequest{Latitude: floatPtr(37.5001), Longitude: floatPtr(127.0)})
+
+	// Then: 위치 이벤트 다음 도착 이벤트
+	require.NoError(t, err)
+	require.Len(t, sub.Messages(), 2)
+	<-sub.Messages()
+	assert.Contains(t, string(<-sub.Messages()), `"type":"stop_arrived"`)
+}
+
+// TestTrackingService_ReportLocation_Authorization - 배정 승무원과 locations:write API 키만 전송 가능
+func TestTrackingService_ReportLocation_Authorization(t *testing.T) {
+	// Given
+	f := newTrackingFixture(t)
+	f.startTrip(t)
+	req := &dto.ReportLocationRequest{Latitude: floatPtr(37.5665), Longitude: floatPtr(126.978)}
+	device := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "key-1", Role: domain.RoleIntegration, Scopes: []domain.APIKeyScope{domain.ScopeLocationsWrite}})
+	readOnlyKey := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "key-2", Role: domain.RoleIntegration, Scopes: []domain.APIKeyScope{domain.ScopeTripsRead}})
+
+	// When & Then
+	_, err := f.svc.ReportLocation(device, f.trip.ID, req)
+	assert.NoError(t, err)
+
+	for _, ctx := range []context.Context{readOnlyKey, asPrincipal(domain.RoleDriver, "driver-2"), asPrincipal(domain.RoleAdmin, "")} {
+		_, err := f.svc.ReportLocation(ctx, f.trip.ID, req)
+		var appErr *util.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, util.ErrCodeForbidden, appErr.Code)
+	}
+}
+
+// TestTrackingService_AuthorizeWatch - 관리자와 탑승 자녀가 있는 보호자만 실시간 조회 가능
+func TestTrackingService_AuthorizeWatch(t *testing.T) {
+	// Given
+	f := newTrackingFixture(t)
+	f.linkChild(t, "guardian-1", "route-1")
+	f.linkChild(t, "guardian-2", "route-2")
+
+	// When & Then
+	_, err := f.svc.AuthorizeWatch(asPrincipal(domain.RoleAdmin, ""), f.trip.ID)
+	assert.NoError(t, err)
+
+	_, err = f.svc.AuthorizeWatch(asPrincipal(domain.RoleGuardian, "guardian-1"), f.trip.ID)
+	assert.NoError(t, err)
+
+	for _, ctx := range []context.Context{
+		asPrincipal(domain.RoleGuardian, "guardian-2"), // 다른 경로 자녀
+		asPrincipal(domain.RoleGuardian, "guardian-3"), // 연결된 자녀 없음
+		asPrincipal(domain.RoleDriver, "driver-1"),
+	} {
+		_, err := f.svc.AuthorizeWatch(ctx, f.trip.ID)
+		var appErr *util.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, util.ErrCodeForbidden, appErr.Code)
+	}
+
+	_, err = f.svc.AuthorizeWatch(asPrincipal(domain.RoleAdmin, ""), "missing")
+	var appErr *util.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, util.ErrCodeNotFound, appErr.Code)
+}
+
+// TestTrackingService_GetTrack - 직선 구간 중간 좌표는 제거하고 꺾이는 지점은 유지
+func TestTrackingService_GetTrack(t *testing.T) {
+	// Given: 북쪽으로 직진(약 11m 간격 10개) 후 동쪽으로 꺾어 직진
+	f := newTrackingFixture(t)
+	f.startTrip(t)
+	driverCtx := asPrincipal(domain.RoleDriver, "driver-1")
+	start := time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+	report := func(i int, lat, lng float64) {
+		recordedAt := start.Add(time.Duration(i) * 5 * time.Second)
+		_, err := f.svc.ReportLocation(driverCtx, f.trip.ID, &dto.ReportLocationRequest{Latitude: &lat, Longitude: &lng, RecordedAt: &recordedAt})
+		require.NoError(t, err)
+	}
+	for i := 0; i < 10; i++ {
+		report(i, 37.5+float64(i)*0.0001, 127.0)
+	}
+	for i := 1; i <= 5; i++ {
+		report(9+i, 37.5009, 127.0+float64(i)*0.0001)
+	}
+
+	// When
+	track, err := f.svc.GetTrack(context.Background(), f.trip.ID, service.DefaultTrackTolerance)
+	raw, rawErr := f.svc.GetTrack(context.Background(), f.trip.ID, 0)
+
+	// Then
+	require.NoError(t, err)
+	require.NoError(t, rawErr)
+	assert.Equal(t, 15, track.TotalPoints)
+	require.Len(t, track.Points, 3) // 출발, 꺾인 지점, 도착
+	assert.Equal(t, 37.5009, track.Points[1].Latitude)
+	assert.Equal(t, 127.0, track.Points[1].Longitude)
+	assert.Equal(t, start, track.Points[0].RecordedAt)
+	assert.NotEmpty(t, track.Polyline)
+	assert.Len(t, raw.Points, 15)
+	assert.Empty(t, track.PlannedStops) // 경로 미등록
+}
+
+// TestTrackingService_GetReplay - 기록 사이 시각은 보간하고 구간 밖은 잘라냄
+func TestTrackingService_GetReplay(t *testing.T) {
+	// Given: 10초 간격 기록 3개 (북쪽으로 직진, 속도 10 → 20 → 20)
+	f := newTrackingFixture(t)
+	f.startTrip(t)
+	driverCtx := asPrincipal(domain.RoleDriver, "driver-1")
+	start := time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC)
+	for i, speed := range []float64{10, 20, 20} {
+		lat, lng := 37.5+float64(i)*0.001, 127.0
+		recordedAt := start.Add(time.Duration(i) * 10 * time.Second)
+		_, err := f.svc.ReportLocation(driverCtx, f.trip.ID, &dto.ReportLocationRequest{Latitude: &lat, Longitude: &lng, Speed: floatPtr(speed), RecordedAt: &recordedAt})
+		require.NoError(t, err)
+	}
+	ctx := context.Background()
+
+	// When
+	replay, err := f.svc.GetReplay(ctx, f.trip.ID, nil, nil, 5*time.Second)
+
+	// Then: 0, 5, 10, 15, 20초
+	require.NoError(t, err)
+	require.Len(t, replay.Frames, 5)
+	assert.Equal(t, 5, replay.Interval)
+	assert.Equal(t, start, *replay.From)
+	assert.False(t, replay.Frames[0].Interpolated)
+	assert.True(t, replay.Frames[1].Interpolated)
+	assert.InDelta(t, 37.5005, replay.Frames[1].Latitude, 1e-9)
+	assert.InDelta(t, 15.0, *replay.Frames[1].Speed, 1e-9)
+	assert.Equal(t, start.Add(20*time.Second), replay.Frames[4].Timestamp)
+
+	// When: 기록 범위를 벗어난 구간은 잘라냄
+	from, to := start.Add(12*time.Second), start.Add(time.Hour)
+	clipped, err := f.svc.GetReplay(ctx, f.trip.ID, &from, &to, 4*time.Second)
+
+	// Then: 12, 16, 20초
+	require.NoError(t, err)
+	require.Len(t, clipped.Frames, 3)
+	assert.Equal(t, start.Add(20*time.Second), *clipped.To)
+
+	// When & Then: to가 from보다 앞서면 거부
+	var appErr *util.AppError
+	_, err = f.svc.GetReplay(ctx, f.trip.ID, &to, &from, time.Second)
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, util.ErrCodeValidation, appErr.Code)
+}
+
+// TestTrackingService_GetReplay_Empty - 기록이 없으면 빈 프레임
+func TestTrackingService_GetReplay_Empty(t *testing.T) {
+	// Given
+	f := newTrackingFixture(t)
+
+	// When
+	replay, err := f.svc.GetReplay(context.Background(), f.trip.ID, nil, nil, service.DefaultReplayInterval)
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, replay.Frames)
+	assert.Nil(t, replay.From)
+}
@@ -0,0 +1,222 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tripAssignmentFixture - 배정 변경 테스트용 의존성 (A코스 40분, 08:00 일정의 운행 + 08:30 일정)
+type tripAssignmentFixture struct {
+	svc           *service.TripAssignmentService
+	tripRepo      *mocks.TripRepository
+	driverRepo    *mocks.DriverRepository
+	attendantRepo *mocks.AttendantRepository
+	notifier      *mocks.GuardianNotifier
+	events        *mocks.EventPublisher
+	trip          *domain.Trip
+	morning       *domain.Schedule
+	late          *domain.Schedule
+	vehicle       *domain.Vehicle
+	driver        *domain.Driver
+	attendant     *domain.Attendant
+	guardian      *domain.Guardian
+	date          time.Time
+}
+
+// newTripAssignmentFixture - 교체 가능한 차량/기사/동승자 각 1, 보호자가 연결된 탑승자 1명
+func newTripAssignmentFixture(t *testing.T) *tripAssignmentFixture {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	routeRepo := mocks.NewRouteRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	driverRepo := mocks.NewDriverRepository()
+	attendantRepo := mocks.NewAttendantRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	guardianRepo := mocks.NewGuardianRepository()
+	notifier := mocks.NewGuardianNotifier()
+
+	route := domain.NewRoute("A코스", "", 40)
+	require.NoError(t, routeRepo.Create(ctx, route))
+	morning := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-1", "driver-1")
+	late := domain.NewSchedule("오전 8시 반 A코스", "08:30", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, route.ID, "vehicle-2", "driver-2")
+	for _, schedule := range []*domain.Schedule{morning, late} {
+		require.NoError(t, scheduleRepo.Create(ctx, schedule))
+	}
+
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	driver := domain.NewDriver("김대체", "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	driver.VerifyLicense("admin-1", time.Now())
+	require.NoError(t, driverRepo.Create(ctx, driver))
+	attendant := domain.NewAttendant("박대체", "010-1111-2222", domain.AttendantRoleTeacher)
+	require.NoError(t, attendantRepo.Create(ctx, attendant))
+
+	guardian := domain.NewGuardian("엄마", "010-0000-0000")
+	require.NoError(t, guardianRepo.Create(ctx, guardian))
+	passenger := domain.NewPassenger("김민준", "엄마", "010-0000-0000")
+	passenger.AssignToStop(route.ID, "stop-1", 1)
+	require.NoError(t, passengerRepo.Create(ctx, passenger))
+	require.NoError(t, guardianRepo.LinkPassenger(ctx, domain.NewGuardianPassenger(guardian.ID, passenger.ID, "엄마")))
+
+	date := time.Now().Truncate(24 * time.Hour)
+	trip := domain.NewTrip(morning.ID, date, "vehicle-1", "driver-1", nil)
+	require.NoError(t, tripRepo.Create(ctx, trip))
+
+	tripService := service.NewTripService(tripRepo, scheduleRepo, mocks.NewPassengerRepository(), mocks.NewPassengerAbsenceRepository(), attendantRepo, nil, nil, nil)
+	events := mocks.NewEventPublisher()
+	svc := service.NewTripAssignmentService(tripService, tripRepo, scheduleRepo, routeRepo, vehicleRepo, driverRepo,
+		attendantRepo, passengerRepo, guardianRepo, mocks.NewTxManager(), notifier, events)
+	// 알림은 이벤트 핸들러로 발송 (아웃박스 릴레이 대신 발행 즉시 전달)
+	events.Dispatcher = event.NewDispatcher()
+	events.Dispatcher.Subscribe("assignment-notification", svc.HandleEvent, string(domain.WebhookEventTripReassigned))
+	return &tripAssignmentFixture{
+		svc:           svc,
+		tripRepo:      tripRepo,
+		driverRepo:    driverRepo,
+		attendantRepo: attendantRepo,
+		notifier:      notifier,
+		events:        events,
+		trip:          trip,
+		morning:       morning,
+		late:          late,
+		vehicle:       vehicle,
+		driver:        driver,
+		attendant:     attendant,
+		guardian:      guardian,
+		date:          date,
+	}
+}
+
+// TestTripAssignmentService_Update - 전달된 항목만 교체하고 경로 탑승자의 보호자에게 한 번 알림
+func TestTripAssignmentService_Update(t *testing.T) {
+	// Given
+	f := newTripAssignmentFixture(t)
+	ctx := context.Background()
+
+	// When
+	updated, err := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{
+		VehicleID:   &f.vehicle.ID,
+		AttendantID: &f.attendant.ID,
+		Reason:      "차량 고장",
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, f.vehicle.ID, updated.VehicleID)
+	assert.Equal(t, "driver-1", updated.AssignedDriverID)
+	require.NotNil(t, updated.AssignedAttendantID)
+	assert.Equal(t, f.attendant.ID, *updated.AssignedAttendantID)
+	stored, _ := f.tripRepo.GetByID(ctx, f.trip.ID)
+	assert.Equal(t, f.vehicle.ID, stored.VehicleID)
+
+	require.Len(t, f.events.Events, 1)
+	published := f.events.Events[0]
+	assert.Equal(t, domain.WebhookEventTripReassigned, published.Event)
+	data, ok := published.Data.(*dto.TripReassignmentWebhookData)
+	require.True(t, ok)
+	assert.Equal(t, "vehicle-1", data.PreviousVehicleID)
+	assert.Nil(t, data.PreviousAttendantID)
+	assert.Equal(t, "차량 고장", data.Reason)
+
+	require.Len(t, f.notifier.Sent(), 1)
+	sent := f.notifier.Sent()[0]
+	assert.Equal(t, f.guardian.ID, sent.GuardianID)
+	assert.Equal(t, domain.NotificationEventAssignment, sent.Event)
+	assert.Contains(t, sent.Notification.Body, "차량 12가3456")
+	assert.Contains(t, sent.Notification.Body, "동승자 박대체")
+	assert.Contains(t, sent.Notification.Body, "차량 고장")
+
+	// When - 빈 문자열이면 동승자 해제
+	empty := ""
+	cleared, err := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{AttendantID: &empty})
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, cleared.AssignedAttendantID)
+}
+
+// TestTripAssignmentService_Update_Validation - 변경 없음, 투입 불가 대상, 취소된 운행
+func TestTripAssignmentService_Update_Validation(t *testing.T) {
+	// Given
+	f := newTripAssignmentFixture(t)
+	ctx := context.Background()
+	same := "vehicle-1"
+	missing := "driver-404"
+	f.attendant.SetOnLeave()
+	require.NoError(t, f.attendantRepo.Update(ctx, f.attendant))
+
+	// When
+	_, unchangedErr := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{VehicleID: &same})
+	_, unavailableErr := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{
+		DriverID:    &missing,
+		AttendantID: &f.attendant.ID,
+	})
+
+	// Then
+	assertAppError(t, unchangedErr, util.ErrCodeValidation)
+	assertAppError(t, unavailableErr, util.ErrCodeValidation)
+	details := unavailableErr.(*util.AppError).Details
+	assert.Contains(t, details, "driver_id")
+	assert.Contains(t, details, "attendant_id")
+	stored, _ := f.tripRepo.GetByID(ctx, f.trip.ID)
+	assert.Equal(t, "driver-1", stored.AssignedDriverID)
+
+	// When - 면허가 반려된 기사
+	f.driver.RejectLicense("admin-1", "스캔 식별 불가", time.Now())
+	require.NoError(t, f.driverRepo.Update(ctx, f.driver))
+	_, rejectedErr := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{DriverID: &f.driver.ID})
+
+	// Then
+	assertAppError(t, rejectedErr, util.ErrCodeValidation)
+	assert.Contains(t, rejectedErr.(*util.AppError).Details, "driver_id")
+
+	// When - 취소된 운행
+	require.NoError(t, stored.Cancel("휴원"))
+	require.NoError(t, f.tripRepo.Update(ctx, stored))
+	_, cancelledErr := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{DriverID: &f.driver.ID})
+
+	// Then
+	assertAppError(t, cancelledErr, util.ErrCodeConflict)
+	assert.Empty(t, f.notifier.Sent())
+}
+
+// TestTripAssignmentService_Update_DoubleBooking - 같은 날 겹치는 운행에 배정된 대상은 409, 겹치는 운행이 취소되면 허용
+func TestTripAssignmentService_Update_DoubleBooking(t *testing.T) {
+	// Given - 08:30 운행(08:00~08:40과 겹침)이 교체 차량 사용 중
+	f := newTripAssignmentFixture(t)
+	ctx := context.Background()
+	overlapping := domain.NewTrip(f.late.ID, f.date, f.vehicle.ID, "driver-2", nil)
+	require.NoError(t, f.tripRepo.Create(ctx, overlapping))
+
+	// When
+	_, err := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{VehicleID: &f.vehicle.ID})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeConflict)
+	conflicts := err.(*util.AppError).Details["conflicts"].([]dto.TripConflictResponse)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "vehicle", conflicts[0].Resource)
+	assert.Equal(t, overlapping.ID, conflicts[0].TripID)
+	assert.Equal(t, "08:30", conflicts[0].StartTime)
+	assert.Equal(t, "09:10", conflicts[0].EndTime)
+
+	// When - 겹치는 운행이 취소되면 교체 가능
+	require.NoError(t, overlapping.Cancel("휴원"))
+	require.NoError(t, f.tripRepo.Update(ctx, overlapping))
+	updated, err := f.svc.Update(ctx, f.trip.ID, &dto.UpdateTripAssignmentRequest{VehicleID: &f.vehicle.ID})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, f.vehicle.ID, updated.VehicleID)
+}
@@ -0,0 +1,279 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTripGenerationService_Preview - 생성될 운행과 생성하지 않는 일정의 이유 (저장하지 않음)
+func TestTripGenerationService_Preview(t *testing.T) {
+	// Given: 2025-03-10 월요일
+	ctx := context.Background()
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	scheduleRepo := mocks.NewScheduleRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	tripRepo := mocks.NewTripRepository()
+
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	expired := domain.NewVehicle("34나5678", "쏠라티", "현대", domain.VehicleTypeMiniBus, 15, 2019, "노랑")
+	insuranceExpiry := date.AddDate(0, 0, -1)
+	expired.InsuranceExpiry = &insuranceExpiry
+	require.NoError(t, vehicleRepo.Create(ctx, expired))
+
+	newSchedule := func(name, startTime string, days []int, vehicleID string) *domain.Schedule {
+		schedule := domain.NewSchedule(name, startTime, domain.TimeSlotMorning, days, "route-1", vehicleID, "driver-1")
+		require.NoError(t, scheduleRepo.Create(ctx, schedule))
+		return schedule
+	}
+	weekdays := []int{1, 2, 3, 4, 5}
+	planned := newSchedule("평일 08:00", "08:00", weekdays, vehicle.ID)
+	inactive := newSchedule("비활성", "08:10", weekdays, vehicle.ID)
+	inactive.SetInactive()
+	require.NoError(t, scheduleRepo.Update(ctx, inactive))
+	ended := newSchedule("지난 학기", "08:20", weekdays, vehicle.ID)
+	ended.SetValidPeriod(date.AddDate(0, -3, 0), date.AddDate(0, 0, -1))
+	require.NoError(t, scheduleRepo.Update(ctx, ended))
+	weekend := newSchedule("주말", "08:30", []int{6, 7}, vehicle.ID)
+	created := newSchedule("이미 생성", "08:40", weekdays, vehicle.ID)
+	require.NoError(t, tripRepo.Create(ctx, domain.NewTrip(created.ID, date, vehicle.ID, "driver-1", nil)))
+	noVehicle := newSchedule("보험 만료 차량", "08:50", weekdays, expired.ID)
+	// 승객 정원 2명 차량인데 경로에 3명 배정
+	passengerRepo := mocks.NewPassengerRepository()
+	small := domain.NewVehicle("56다7890", "레이", "기아", domain.VehicleTypeVan, 3, 2022, "흰색")
+	require.NoError(t, vehicleRepo.Create(ctx, small))
+	overCapacity := domain.NewSchedule("정원 초과", "09:00", domain.TimeSlotMorning, weekdays, "route-2", small.ID, "driver-2")
+	require.NoError(t, scheduleRepo.Create(ctx, overCapacity))
+	for _, name := range []string{"김민준", "이서연", "박지호"} {
+		passenger := domain.NewPassenger(name, "보호자", "010-0000-0000")
+		passenger.AssignToStop("route-2", "stop-1", 1)
+		require.NoError(t, passengerRepo.Create(ctx, passenger))
+	}
+
+	svc := service.NewTripGenerationService(scheduleRepo, mocks.NewScheduleExceptionRepository(), mocks.NewDriverAssignmentRepository(), mocks.NewAttendantAssignmentRepository(), vehicleRepo, mocks.NewDriverRepository(), tripRepo, passengerRepo, service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+
+	// When
+	preview, err := svc.Preview(ctx, date)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "2025-03-10", preview.Date)
+	require.Len(t, preview.Planned, 1)
+	assert.Equal(t, planned.ID, preview.Planned[0].ScheduleID)
+	assert.Equal(t, vehicle.ID, preview.Planned[0].VehicleID)
+	assert.Equal(t, "driver-1", preview.Planned[0].DriverID)
+
+	reasons := map[string]string{}
+	for _, skipped := range preview.Skipped {
+		reasons[skipped.ScheduleID] = skipped.Reason
+		assert.NotEmpty(t, skipped.Detail)
+		if skipped.ScheduleID == overCapacity.ID {
+			assert.Equal(t, 1, skipped.Overflow)
+		}
+	}
+	assert.Equal(t, map[string]string{
+		inactive.ID:     string(service.TripSkipInactive),
+		ended.ID:        string(service.TripSkipOutOfPeriod),
+		weekend.ID:      string(service.TripSkipNotServiceDay),
+		created.ID:      string(service.TripSkipAlreadyExists),
+		noVehicle.ID:    string(service.TripSkipVehicleUnavailable),
+		overCapacity.ID: string(service.TripSkipOverCapacity),
+	}, reasons)
+
+	// Then: 아무것도 저장하지 않음
+	trips, _, _ := tripRepo.List(ctx, repository.TripFilter{Date: &date})
+	assert.Len(t, trips, 1)
+}
+
+// TestTripGenerationService_Preview_Holiday - 공휴일 제외 일정만 빠지고 공휴일 운행 일정은 생성
+func TestTripGenerationService_Preview_Holiday(t *testing.T) {
+	// Given: 2026-02-17 화요일(설날)
+	ctx := context.Background()
+	date := time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC)
+	scheduleRepo := mocks.NewScheduleRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+
+	skipping := domain.NewSchedule("평일 08:00", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", vehicle.ID, "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, skipping))
+	running := domain.NewSchedule("연중무휴 09:00", "09:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5, 6, 7}, "route-1", vehicle.ID, "driver-1")
+	running.SkipHolidays = false
+	require.NoError(t, scheduleRepo.Create(ctx, running))
+
+	svc := service.NewTripGenerationService(scheduleRepo, mocks.NewScheduleExceptionRepository(), mocks.NewDriverAssignmentRepository(), mocks.NewAttendantAssignmentRepository(), vehicleRepo, mocks.NewDriverRepository(), mocks.NewTripRepository(), mocks.NewPassengerRepository(), service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+
+	// When
+	preview, err := svc.Preview(ctx, date)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, preview.Planned, 1)
+	assert.Equal(t, running.ID, preview.Planned[0].ScheduleID)
+	require.Len(t, preview.Skipped, 1)
+	assert.Equal(t, skipping.ID, preview.Skipped[0].ScheduleID)
+	assert.Equal(t, string(service.TripSkipHoliday), preview.Skipped[0].Reason)
+	assert.Contains(t, preview.Skipped[0].Detail, "설날")
+}
+
+// TestTripGenerationService_Preview_Exception - 예외 날짜: skip은 운행 안 함, extra는 요일/공휴일과 무관하게 운행 (비활성 일정 제외)
+func TestTripGenerationService_Preview_Exception(t *testing.T) {
+	// Given: 2025-05-05 월요일(어린이날)
+	ctx := context.Background()
+	date := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	scheduleRepo := mocks.NewScheduleRepository()
+	exceptionRepo := mocks.NewScheduleExceptionRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+
+	newSchedule := func(name, startTime string, days []int) *domain.Schedule {
+		schedule := domain.NewSchedule(name, startTime, domain.TimeSlotAfternoon, days, "route-1", vehicle.ID, "driver-1")
+		schedule.SkipHolidays = false
+		require.NoError(t, scheduleRepo.Create(ctx, schedule))
+		return schedule
+	}
+	skipped := newSchedule("평일 14:00", "14:00", []int{1, 2, 3, 4, 5})
+	require.NoError(t, exceptionRepo.Create(ctx, domain.NewScheduleException(skipped.ID, date, domain.ScheduleExceptionSkip, "휴원")))
+	extra := newSchedule("주말 15:00", "15:00", []int{6, 7})
+	require.NoError(t, exceptionRepo.Create(ctx, domain.NewScheduleException(extra.ID, date, domain.ScheduleExceptionExtra, "어린이날 행사")))
+	inactive := newSchedule("비활성 16:00", "16:00", []int{6, 7})
+	inactive.SetInactive()
+	require.NoError(t, scheduleRepo.Update(ctx, inactive))
+	require.NoError(t, exceptionRepo.Create(ctx, domain.NewScheduleException(inactive.ID, date, domain.ScheduleExceptionExtra, "")))
+	other := newSchedule("평일 17:00", "17:00", []int{1, 2, 3, 4, 5})
+	require.NoError(t, exceptionRepo.Create(ctx, domain.NewScheduleException(other.ID, date.AddDate(0, 0, 1), domain.ScheduleExceptionSkip, "")))
+
+	svc := service.NewTripGenerationService(scheduleRepo, exceptionRepo, mocks.NewDriverAssignmentRepository(), mocks.NewAttendantAssignmentRepository(), vehicleRepo, mocks.NewDriverRepository(), mocks.NewTripRepository(),
+		mocks.NewPassengerRepository(), service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+
+	// When
+	preview, err := svc.Preview(ctx, date)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, preview.Planned, 2)
+	assert.Equal(t, extra.ID, preview.Planned[0].ScheduleID)
+	assert.True(t, preview.Planned[0].Extra)
+	assert.Equal(t, other.ID, preview.Planned[1].ScheduleID)
+	assert.False(t, preview.Planned[1].Extra)
+
+	reasons := map[string]string{}
+	for _, s := range preview.Skipped {
+		reasons[s.ScheduleID] = s.Reason
+	}
+	assert.Equal(t, map[string]string{
+		skipped.ID:  string(service.TripSkipException),
+		inactive.ID: string(service.TripSkipInactive),
+	}, reasons)
+	assert.Contains(t, preview.Skipped[0].Detail, "휴원")
+}
+
+// TestTripGenerationService_AttendantSubstitute - 승인된 대체 배정 기간에는 대체 동승자로 생성
+func TestTripGenerationService_AttendantSubstitute(t *testing.T) {
+	// Given: 2025-03-10 월요일
+	ctx := context.Background()
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	scheduleRepo := mocks.NewScheduleRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	assignmentRepo := mocks.NewAttendantAssignmentRepository()
+
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	defaultAttendant := "attendant-default"
+	newSchedule := func(name, startTime string) *domain.Schedule {
+		schedule := domain.NewSchedule(name, startTime, domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", vehicle.ID, "driver-1")
+		schedule.DefaultAttendantID = &defaultAttendant
+		require.NoError(t, scheduleRepo.Create(ctx, schedule))
+		return schedule
+	}
+	covered := newSchedule("대체 배정", "08:00")
+	approved := domain.NewAttendantAssignment(covered.ID, "attendant-sub", date.AddDate(0, 0, -2), date.AddDate(0, 0, 4), "담임 연수", "")
+	approved.Approve("admin-1")
+	require.NoError(t, assignmentRepo.Create(ctx, approved))
+	pending := newSchedule("승인 대기", "08:10")
+	require.NoError(t, assignmentRepo.Create(ctx, domain.NewAttendantAssignment(pending.ID, "attendant-sub", date, date, "", "")))
+	expired := newSchedule("기간 지남", "08:20")
+	old := domain.NewAttendantAssignment(expired.ID, "attendant-sub", date.AddDate(0, 0, -7), date.AddDate(0, 0, -1), "", "")
+	old.Approve("admin-1")
+	require.NoError(t, assignmentRepo.Create(ctx, old))
+
+	svc := service.NewTripGenerationService(scheduleRepo, mocks.NewScheduleExceptionRepository(), mocks.NewDriverAssignmentRepository(), assignmentRepo, vehicleRepo, mocks.NewDriverRepository(), mocks.NewTripRepository(),
+		mocks.NewPassengerRepository(), service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+
+	// When
+	preview, err := svc.Preview(ctx, date)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, preview.Planned, 3)
+	attendants := map[string]string{}
+	for _, planned := range preview.Planned {
+		require.NotNil(t, planned.AttendantID)
+		attendants[planned.ScheduleID] = *planned.AttendantID
+		assert.Equal(t, planned.ScheduleID == covered.ID, planned.AttendantSubstitute)
+	}
+	assert.Equal(t, map[string]string{
+		covered.ID: "attendant-sub",
+		pending.ID: defaultAttendant,
+		expired.ID: defaultAttendant,
+	}, attendants)
+}
+
+// TestTripGenerationService_DriverSubstitute - 승인된 기사 대체 배정 반영, 겹치면 가장 나중에 승인된 배정 우선
+func TestTripGenerationService_DriverSubstitute(t *testing.T) {
+	// Given: 2025-03-10 월요일, 검증 이전에 저장된 겹치는 배정
+	ctx := context.Background()
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	scheduleRepo := mocks.NewScheduleRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	assignmentRepo := mocks.NewDriverAssignmentRepository()
+
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, vehicle))
+	schedule := domain.NewSchedule("평일 08:00", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", vehicle.ID, "driver-1")
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	approvedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	assign := func(driverID string, createdAt time.Time, approvedAt *time.Time) {
+		assignment := domain.NewDriverAssignment(schedule.ID, driverID, date.AddDate(0, 0, -3), date.AddDate(0, 0, 3), "", "")
+		assignment.CreatedAt = createdAt
+		assignment.ApprovedAt = approvedAt
+		require.NoError(t, assignmentRepo.Create(ctx, assignment))
+	}
+	latestApproval := approvedAt.Add(time.Hour)
+	assign("driver-old", approvedAt.Add(-48*time.Hour), &approvedAt)
+	assign("driver-latest", approvedAt.Add(-72*time.Hour), &latestApproval)
+	assign("driver-pending", approvedAt.Add(24*time.Hour), nil)
+
+	svc := service.NewTripGenerationService(scheduleRepo, mocks.NewScheduleExceptionRepository(), assignmentRepo, mocks.NewAttendantAssignmentRepository(),
+		vehicleRepo, mocks.NewDriverRepository(), mocks.NewTripRepository(), mocks.NewPassengerRepository(), service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+
+	// When
+	for i := 0; i < 5; i++ {
+		preview, err := svc.Preview(ctx, date)
+
+		// Then: 저장 순서와 무관하게 항상 같은 기사
+		require.NoError(t, err)
+		require.Len(t, preview.Planned, 1)
+		assert.Equal(t, "driver-latest", preview.Planned[0].DriverID)
+		assert.True(t, preview.Planned[0].DriverSubstitute)
+	}
+
+	// When: 기간 밖 날짜는 기본 기사
+	preview, err := svc.Preview(ctx, date.AddDate(0, 0, 7))
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, preview.Planned, 1)
+	assert.Equal(t, "driver-1", preview.Planned[0].DriverID)
+	assert.False(t, preview.Planned[0].DriverSubstitute)
+}
@@ -0,0 +1,642 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/auth"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tripFixture - 운행 테스트용 의존성
+type tripFixture struct {
+	svc           *service.TripService
+	tripRepo      *mocks.TripRepository
+	passengerRepo *mocks.PassengerRepository
+	absenceRepo   *mocks.PassengerAbsenceRepository
+	attendantRepo *mocks.AttendantRepository
+	txManager     *mocks.TxManager
+	events        *mocks.EventPublisher
+	schedule      *domain.Schedule
+	attendant     *domain.Attendant
+}
+
+// newTripFixture - 평일 일정(기사 + 동승자 배정)을 미리 등록한 운행 서비스
+func newTripFixture(t *testing.T) *tripFixture {
+	ctx := context.Background()
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	absenceRepo := mocks.NewPassengerAbsenceRepository()
+	attendantRepo := mocks.NewAttendantRepository()
+	txManager := mocks.NewTxManager()
+	events := mocks.NewEventPublisher()
+
+	attendant := domain.NewAttendant("이선생", "010-2222-3333", domain.AttendantRoleTeacher)
+	require.NoError(t, attendantRepo.Create(ctx, attendant))
+
+	schedule := domain.NewSchedule("오전 8시 A코스", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", "vehicle-1", "driver-1")
+	schedule.AssignAttendant(attendant.ID)
+	require.NoError(t, scheduleRepo.Create(ctx, schedule))
+
+	return &tripFixture{
+		svc:           service.NewTripService(tripRepo, scheduleRepo, passengerRepo, absenceRepo, attendantRepo, txManager, events, nil),
+		tripRepo:      tripRepo,
+		passengerRepo: passengerRepo,
+		absenceRepo:   absenceRepo,
+		attendantRepo: attendantRepo,
+		txManager:     txManager,
+		events:        events,
+		schedule:      schedule,
+		attendant:     attendant,
+	}
+}
+
+// createTrip - 2025-03-03(월) 운행 생성
+func (f *tripFixture) createTrip(t *testing.T) *domain.Trip {
+	trip, err := f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: f.schedule.ID, Date: "2025-03-03"})
+	require.NoError(t, err)
+	return trip
+}
+
+// asPrincipal - 인증 주체가 담긴 context
+func asPrincipal(role domain.Role, profileID string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user-" + profileID, Role: role, ProfileID: profileID})
+}
+
+// TestTripService_Create - 일정 기본값으로 운행 생성, 같은 날 중복 생성 거부
+func TestTripService_Create(t *testing.T) {
+	// Given
+	f := newTripFixture(t)
+
+	// When
+	trip := f.createTrip(t)
+
+	// Then
+	assert.Equal(t, domain.TripStatusPending, trip.Status)
+	assert.Equal(t, "driver-1", trip.AssignedDriverID)
+	require.NotNil(t, trip.AssignedAttendantID)
+	assert.Equal(t, f.attendant.ID, *trip.AssignedAttendantID)
+
+	_, err := f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: f.schedule.ID, Date: "2025-03-03"})
+	assertAppError(t, err, util.ErrCodeDuplicate)
+
+	_, err = f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: f.schedule.ID, Date: "2025-03-08"}) // 토요일
+	assertAppError(t, err, util.ErrCodeValidation)
+}
+
+// TestTripService_Create_Passengers - 경로 정류장에 배정된 활동 중 탑승자의 탑승 기록을 함께 생성
+func TestTripService_Create_Passengers(t *testing.T) {
+	// Given
+	f := newTripFixture(t)
+	ctx := context.Background()
+	assigned := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	assigned.AssignToStop(f.schedule.RouteID, "stop-1", 1)
+	inactive := domain.NewPassenger("이졸업", "이보호", "010-0000-1111")
+	inactive.AssignToStop(f.schedule.RouteID, "stop-1", 1)
+	inactive.SetInactive()
+	otherRoute := domain.NewPassenger("박서연", "박보호", "010-8765-4321")
+	otherRoute.AssignToStop("route-2", "stop-9", 1)
+	unassigned := domain.NewPassenger("최미배정", "최보호", "010-2222-0000")
+	for _, passenger := range []*domain.Passenger{assigned, inactive, otherRoute, unassigned} {
+		require.NoError(t, f.passengerRepo.Create(ctx, passenger))
+	}
+
+	// When
+	trip := f.createTrip(t)
+
+	// Then
+	stored, err := f.tripRepo.GetByID(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.TripPassengers, 1)
+	record := stored.TripPassengers[0]
+	assert.Equal(t, trip.ID, record.TripID)
+	assert.Equal(t, assigned.ID, record.PassengerID)
+	assert.Equal(t, "stop-1", record.StopID)
+	assert.False(t, record.IsBoarded)
+	assert.False(t, record.IsNoShow())
+}
+
+// TestTripService_Create_ExcusedPassengers - 일정 시간대에 결석 신고된 탑승자는 결석 신고 기록으로 생성
+func TestTripService_Create_ExcusedPassengers(t *testing.T) {
+	// Given
+	f := newTripFixture(t)
+	ctx := context.Background()
+	date := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	morningOff := domain.NewPassenger("김민준", "김보호", "010-1234-5678")
+	afternoonOff := domain.NewPassenger("박서연", "박보호", "010-8765-4321")
+	for _, passenger := range []*domain.Passenger{morningOff, afternoonOff} {
+		passenger.AssignToStop(f.schedule.RouteID, "stop-1", 1)
+		require.NoError(t, f.passengerRepo.Create(ctx, passenger))
+	}
+	require.NoError(t, f.absenceRepo.Create(ctx, domain.NewPassengerAbsence(morningOff.ID, date, domain.TimeSlotMorning, "병원 진료", "user-guardian-1")))
+	require.NoError(t, f.absenceRepo.Create(ctx, domain.NewPassengerAbsence(afternoonOff.ID, date, domain.TimeSlotAfternoon, "가족 행사", "user-guardian-2")))
+
+	// When
+	trip := f.createTrip(t)
+
+	// Then
+	stored, err := f.tripRepo.GetByID(ctx, trip.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.TripPassengers, 2)
+	for i := range stored.TripPassengers {
+		record := &stored.TripPassengers[i]
+		if record.PassengerID == morningOff.ID {
+			assert.True(t, record.IsExcused())
+			assert.Equal(t, "병원 진료", record.ExcusedReason)
+		} else {
+			assert.False(t, record.IsExcused(), "오후 결석 신고는 오전 운행에 영향 없음")
+		}
+	}
+}
+
+// plannedTripFixture - 운행 생성 계획과 같은 기준으로 운행 생성을 판정하는 운행 서비스 의존성
+type plannedTripFixture struct {
+	svc                     *service.TripService
+	tripRepo                *mocks.TripRepository
+	scheduleRepo            *mocks.ScheduleRepository
+	exceptionRepo           *mocks.ScheduleExceptionRepository
+	driverAssignmentRepo    *mocks.DriverAssignmentRepository
+	attendantAssignmentRepo *mocks.AttendantAssignmentRepository
+	passengerRepo           *mocks.PassengerRepository
+	vehicleRepo             *mocks.VehicleRepository
+	driverRepo              *mocks.DriverRepository
+	vehicle                 *domain.Vehicle
+}
+
+// newPlannedTripFixture - 운행 생성 계획(planner)을 연결한 운행 서비스 (승객 정원 11명 차량, 면허 확인된 driver-1 등록)
+func newPlannedTripFixture(t *testing.T) *plannedTripFixture {
+	tripRepo := mocks.NewTripRepository()
+	scheduleRepo := mocks.NewScheduleRepository()
+	passengerRepo := mocks.NewPassengerRepository()
+	exceptionRepo := mocks.NewScheduleExceptionRepository()
+	driverAssignmentRepo := mocks.NewDriverAssignmentRepository()
+	attendantAssignmentRepo := mocks.NewAttendantAssignmentRepository()
+	vehicleRepo := mocks.NewVehicleRepository()
+	vehicle := domain.NewVehicle("12가3456", "스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(context.Background(), vehicle))
+	driverRepo := mocks.NewDriverRepository()
+	planner := service.NewTripGenerationService(scheduleRepo, exceptionRepo, driverAssignmentRepo, attendantAssignmentRepo,
+		vehicleRepo, driverRepo, tripRepo, passengerRepo, service.NewHolidayService(mocks.NewHolidayRepository(), nil, nil), nil)
+	f := &plannedTripFixture{
+		svc:                     service.NewTripService(tripRepo, scheduleRepo, passengerRepo, mocks.NewPassengerAbsenceRepository(), mocks.NewAttendantRepository(), nil, nil, planner),
+		tripRepo:                tripRepo,
+		scheduleRepo:            scheduleRepo,
+		exceptionRepo:           exceptionRepo,
+		driverAssignmentRepo:    driverAssignmentRepo,
+		attendantAssignmentRepo: attendantAssignmentRepo,
+		passengerRepo:           passengerRepo,
+		vehicleRepo:             vehicleRepo,
+		driverRepo:              driverRepo,
+		vehicle:                 vehicle,
+	}
+	f.createDriver(t, "driver-1")
+	return f
+}
+
+// createDriver - 면허가 확인된 기사 등록 (ID 지정)
+func (f *plannedTripFixture) createDriver(t *testing.T, id string) *domain.Driver {
+	driver := domain.NewDriver("기사 "+id, "010-1234-5678", "11-22-333333-44", domain.LicenseType1Large, time.Now().AddDate(1, 0, 0))
+	driver.ID = id
+	driver.VerifyLicense("admin-1", time.Now())
+	require.NoError(t, f.driverRepo.Create(context.Background(), driver))
+	return driver
+}
+
+// createSchedule - 평일 일정 등록
+func (f *plannedTripFixture) createSchedule(t *testing.T, skipHolidays bool) *domain.Schedule {
+	schedule := domain.NewSchedule("평일 08:00", "08:00", domain.TimeSlotMorning, []int{1, 2, 3, 4, 5}, "route-1", f.vehicle.ID, "driver-1")
+	schedule.SkipHolidays = skipHolidays
+	require.NoError(t, f.scheduleRepo.Create(context.Background(), schedule))
+	return schedule
+}
+
+// TestTripService_Create_Holiday - 공휴일 제외 일정은 공휴일에 운행 생성 거부, 공휴일 운행 일정은 생성
+func TestTripService_Create_Holiday(t *testing.T) {
+	// Given: 2026-02-17 화요일(설날)
+	f := newPlannedTripFixture(t)
+	skipping := f.createSchedule(t, true)
+	running := f.createSchedule(t, false)
+
+	// When
+	_, skipErr := f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: skipping.ID, Date: "2026-02-17"})
+	trip, runErr := f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: running.ID, Date: "2026-02-17"})
+
+	// Then
+	assertAppError(t, skipErr, util.ErrCodeValidation)
+	require.NoError(t, runErr)
+	assert.Equal(t, running.ID, trip.ScheduleID)
+
+	// Then: 공휴일이 아닌 날은 그대로 생성
+	_, err := f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: skipping.ID, Date: "2026-02-19"})
+	require.NoError(t, err)
+}
+
+// TestTripService_Create_Exception - 예외 날짜로 운행하지 않는 날은 거부, 추가 운행 날짜는 운행 요일이 아니어도 생성
+func TestTripService_Create_Exception(t *testing.T) {
+	// Given: 2026-03-10 화요일은 운행 안 함, 2026-03-14 토요일은 추가 운행
+	ctx := context.Background()
+	f := newPlannedTripFixture(t)
+	schedule := f.createSchedule(t, true)
+	require.NoError(t, f.exceptionRepo.Create(ctx, domain.NewScheduleException(schedule.ID, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), domain.ScheduleExceptionSkip, "개교기념일")))
+	require.NoError(t, f.exceptionRepo.Create(ctx, domain.NewScheduleException(schedule.ID, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC), domain.ScheduleExceptionExtra, "보충 수업")))
+
+	// When
+	_, skipErr := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-10"})
+	extra, extraErr := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-14"})
+
+	// Then
+	assertAppError(t, skipErr, util.ErrCodeValidation)
+	require.NoError(t, extraErr)
+	assert.Equal(t, "2026-03-14", extra.Date.Format(time.DateOnly))
+
+	// Then: 예외가 없는 토요일은 그대로 거부
+	_, err := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-21"})
+	assertAppError(t, err, util.ErrCodeValidation)
+}
+
+// TestTripService_Create_Substitutes - 그날 승인된 기사/동승자 대체 배정이 있으면 일정 기본 배정 대신 대체 인력으로 생성
+func TestTripService_Create_Substitutes(t *testing.T) {
+	// Given: 2026-03-10 화요일, 기사는 승인된 대체 배정, 동승자는 승인 대기
+	ctx := context.Background()
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	f := newPlannedTripFixture(t)
+	schedule := f.createSchedule(t, true)
+	defaultAttendant := "attendant-default"
+	schedule.DefaultAttendantID = &defaultAttendant
+	require.NoError(t, f.scheduleRepo.Update(ctx, schedule))
+
+	f.createDriver(t, "driver-sub")
+	driverSub := domain.NewDriverAssignment(schedule.ID, "driver-sub", date.AddDate(0, 0, -1), date.AddDate(0, 0, 1), "병가", "")
+	driverSub.Approve("admin-1")
+	require.NoError(t, f.driverAssignmentRepo.Create(ctx, driverSub))
+	require.NoError(t, f.attendantAssignmentRepo.Create(ctx, domain.NewAttendantAssignment(schedule.ID, "attendant-sub", date, date, "", "")))
+
+	// When
+	trip, err := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-10"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "driver-sub", trip.AssignedDriverID)
+	require.NotNil(t, trip.AssignedAttendantID)
+	assert.Equal(t, defaultAttendant, *trip.AssignedAttendantID)
+
+	// When: 대체 배정 기간 밖 날짜는 일정 기본 기사
+	other, err := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-12"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "driver-1", other.AssignedDriverID)
+}
+
+// TestTripService_Create_LatestSubstitute - 승인된 대체 배정이 겹치면 미리보기와 같이 가장 나중에 승인된 배정으로 생성
+func TestTripService_Create_LatestSubstitute(t *testing.T) {
+	// Given: 2026-03-10 화요일, 검증 이전에 저장된 겹치는 승인 배정
+	ctx := context.Background()
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	f := newPlannedTripFixture(t)
+	schedule := f.createSchedule(t, true)
+
+	approvedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	latestApproval := approvedAt.Add(time.Hour)
+	assign := func(driverID string, createdAt time.Time, approvedAt *time.Time) {
+		assignment := domain.NewDriverAssignment(schedule.ID, driverID, date.AddDate(0, 0, -3), date.AddDate(0, 0, 3), "", "")
+		assignment.CreatedAt = createdAt
+		assignment.ApprovedAt = approvedAt
+		require.NoError(t, f.driverAssignmentRepo.Create(ctx, assignment))
+	}
+	f.createDriver(t, "driver-latest")
+	assign("driver-old", approvedAt.Add(-48*time.Hour), &approvedAt)
+	assign("driver-latest", approvedAt.Add(-72*time.Hour), &latestApproval)
+	assign("driver-pending", approvedAt.Add(24*time.Hour), nil)
+
+	attendantOld := domain.NewAttendantAssignment(schedule.ID, "attendant-old", date, date, "", "")
+	attendantOld.ApprovedAt = &approvedAt
+	attendantLatest := domain.NewAttendantAssignment(schedule.ID, "attendant-latest", date, date, "", "")
+	attendantLatest.ApprovedAt = &latestApproval
+	require.NoError(t, f.attendantAssignmentRepo.Create(ctx, attendantLatest))
+	require.NoError(t, f.attendantAssignmentRepo.Create(ctx, attendantOld))
+
+	// When
+	trip, err := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-10"})
+
+	// Then: 저장 순서와 무관하게 가장 나중에 승인된 배정
+	require.NoError(t, err)
+	assert.Equal(t, "driver-latest", trip.AssignedDriverID)
+	require.NotNil(t, trip.AssignedAttendantID)
+	assert.Equal(t, "attendant-latest", *trip.AssignedAttendantID)
+}
+
+// TestTripService_Create_Capacity - 경로 배정 탑승자가 차량 승객 정원을 넘으면 CONFLICT, 차량을 쓸 수 없으면 VALIDATION_ERROR
+func TestTripService_Create_Capacity(t *testing.T) {
+	// Given: 승객 정원 2명 차량 일정의 경로에 3명 배정
+	ctx := context.Background()
+	f := newPlannedTripFixture(t)
+	small := domain.NewVehicle("56다7890", "레이", "기아", domain.VehicleTypeVan, 3, 2022, "흰색")
+	require.NoError(t, f.vehicleRepo.Create(ctx, small))
+	schedule := f.createSchedule(t, true)
+	schedule.VehicleID = small.ID
+	require.NoError(t, f.scheduleRepo.Update(ctx, schedule))
+	for _, name := range []string{"김민준", "이서연", "박지호"} {
+		passenger := domain.NewPassenger(name, "보호자", "010-0000-0000")
+		passenger.AssignToStop(schedule.RouteID, "stop-1", 1)
+		require.NoError(t, f.passengerRepo.Create(ctx, passenger))
+	}
+
+	// When
+	_, err := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-10"})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeConflict)
+	var appErr *util.AppError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, 1, appErr.Details["overflow"])
+	trips, _, _ := f.tripRepo.List(ctx, repository.TripFilter{})
+	assert.Empty(t, trips)
+
+	// When: 정비 중 차량
+	small.SetMaintenance()
+	require.NoError(t, f.vehicleRepo.Update(ctx, small))
+	_, err = f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-10"})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeValidation)
+}
+
+// TestTripService_Create_DriverLicense - 운행 기사의 면허가 확인되지 않았거나 만료되었으면 생성 거부
+func TestTripService_Create_DriverLicense(t *testing.T) {
+	// Given: 일정 기본 기사의 면허가 배정 후 만료
+	ctx := context.Background()
+	f := newPlannedTripFixture(t)
+	schedule := f.createSchedule(t, true)
+	driver, err := f.driverRepo.GetByID(ctx, "driver-1")
+	require.NoError(t, err)
+	driver.LicenseExpiry = time.Now().AddDate(0, 0, -1)
+	require.NoError(t, f.driverRepo.Update(ctx, driver))
+
+	// When
+	_, err = f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-10"})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeValidation)
+
+	// When: 면허가 확인된 대체 기사가 승인되어 있으면 대체 기사로 생성
+	f.createDriver(t, "driver-sub")
+	assignment := domain.NewDriverAssignment(schedule.ID, "driver-sub", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), "", "")
+	assignment.Approve("admin-1")
+	require.NoError(t, f.driverAssignmentRepo.Create(ctx, assignment))
+	trip, err := f.svc.Create(ctx, &dto.CreateTripRequest{ScheduleID: schedule.ID, Date: "2026-03-10"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "driver-sub", trip.AssignedDriverID)
+}
+
+// TestTripService_Start_Authorization - 배정 기사 또는 권한 있는 배정 동승자만 시작 가능
+func TestTripService_Start_Authorization(t *testing.T) {
+	tests := []struct {
+		name      string
+		ctx       func(f *tripFixture) context.Context
+		canStart  bool // 동승자 운행 시작 권한
+		wantErr   string
+		startedBy func(f *tripFixture) string
+	}{
+		{
+			name:      "배정 기사",
+			ctx:       func(f *tripFixture) context.Context { return asPrincipal(domain.RoleDriver, "driver-1") },
+			startedBy: func(f *tripFixture) string { return "driver:driver-1" },
+		},
+		{
+			name:      "권한 있는 배정 동승자",
+			ctx:       func(f *tripFixture) context.Context { return asPrincipal(domain.RoleAttendant, f.attendant.ID) },
+			canStart:  true,
+			startedBy: func(f *tripFixture) string { return "attendant:" + f.attendant.ID },
+		},
+		{
+			name:    "권한 없는 배정 동승자",
+			ctx:     func(f *tripFixture) context.Context { return asPrincipal(domain.RoleAttendant, f.attendant.ID) },
+			wantErr: util.ErrCodeForbidden,
+		},
+		{
+			name:    "배정되지 않은 기사",
+			ctx:     func(f *tripFixture) context.Context { return asPrincipal(domain.RoleDriver, "driver-2") },
+			wantErr: util.ErrCodeForbidden,
+		},
+		{
+			name:    "관리자",
+			ctx:     func(f *tripFixture) context.Context { return asPrincipal(domain.RoleAdmin, "") },
+			wantErr: util.ErrCodeForbidden,
+		},
+		{
+			name:    "인증 없음",
+			ctx:     func(f *tripFixture) context.Context { return context.Background() },
+			wantErr: util.ErrCodeUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			f := newTripFixture(t)
+			if tt.canStart {
+				f.attendant.GrantStartTripPermission()
+				require.NoError(t, f.attendantRepo.Update(context.Background(), f.attendant))
+			}
+			trip := f.createTrip(t)
+
+			// When
+			started, err := f.svc.Start(tt.ctx(f), trip.ID, &dto.TripLocationRequest{})
+
+			// Then
+			if tt.wantErr != "" {
+				assertAppError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, domain.TripStatusInProgress, started.Status)
+			assert.Equal(t, tt.startedBy(f), started.StartedBy)
+		})
+	}
+}
+
+// TestTripService_StartTwice - 이미 시작된 운행은 CONFLICT, 완료 시 위치 기록
+func TestTripService_StartTwice(t *testing.T) {
+	// Given
+	f := newTripFixture(t)
+	trip := f.createTrip(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+	_, err := f.svc.Start(ctx, trip.ID, nil)
+	require.NoError(t, err)
+
+	// When
+	_, err = f.svc.Start(ctx, trip.ID, nil)
+
+	// Then
+	assertAppError(t, err, util.ErrCodeConflict)
+
+	_, err = f.svc.ConfirmVehicleEmpty(ctx, trip.ID)
+	require.NoError(t, err)
+	lat, lng := 37.5665, 126.9780
+	completed, err := f.svc.Complete(ctx, trip.ID, &dto.CompleteTripRequest{TripLocationRequest: dto.TripLocationRequest{Latitude: &lat, Longitude: &lng}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripStatusCompleted, completed.Status)
+	require.NotNil(t, completed.ActualEndLocation)
+	assert.WithinDuration(t, time.Now(), completed.ActualEndLocation.Timestamp, time.Second)
+}
+
+// TestTripService_Events - 운행 시작/완료/취소는 웹훅 이벤트로 발행 (실패한 요청은 발행 안 함)
+func TestTripService_Events(t *testing.T) {
+	// Given
+	f := newTripFixture(t)
+	trip := f.createTrip(t)
+	ctx := asPrincipal(domain.RoleDriver, "driver-1")
+
+	// When
+	_, err := f.svc.Start(ctx, trip.ID, nil)
+	require.NoError(t, err)
+	_, err = f.svc.Start(ctx, trip.ID, nil)
+	assertAppError(t, err, util.ErrCodeConflict)
+	_, err = f.svc.ConfirmVehicleEmpty(ctx, trip.ID)
+	require.NoError(t, err)
+	_, err = f.svc.Complete(ctx, trip.ID, nil)
+	require.NoError(t, err)
+
+	other, err := f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: f.schedule.ID, Date: "2025-03-04"})
+	require.NoError(t, err)
+	_, err = f.svc.Cancel(context.Background(), other.ID, &dto.CancelTripRequest{Reason: "차량 점검"})
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []domain.WebhookEvent{
+		domain.WebhookEventTripStarted,
+		domain.WebhookEventTripCompleted,
+		domain.WebhookEventTripCancelled,
+	}, f.events.Types())
+
+	completed := f.events.Events[1].Data.(*dto.TripWebhookData)
+	assert.Equal(t, trip.ID, completed.TripID)
+	assert.Equal(t, "2025-03-03", completed.Date)
+	assert.Equal(t, "completed", completed.Status)
+	assert.NotNil(t, completed.StartedAt)
+	assert.NotNil(t, completed.CompletedAt)
+	assert.Equal(t, "차량 점검", f.events.Events[2].Data.(*dto.TripWebhookData).CancellationReason)
+	assert.Equal(t, trip.ID, f.events.Events[0].AggregateID)
+	assert.Equal(t, 3, f.txManager.Calls, "운행 저장과 이벤트 기록은 한 트랜잭션")
+
+	// When - 이벤트 기록 실패 (트랜잭션 롤백)
+	f.events.Err = errors.New("outbox unavailable")
+	failed, err := f.svc.Create(context.Background(), &dto.CreateTripRequest{ScheduleID: f.schedule.ID, Date: "2025-03-05"})
+	require.NoError(t, err)
+	_, err = f.svc.Start(ctx, failed.ID, nil)
+
+	// Then
+	assertAppError(t, err, util.ErrCodeInternal)
+}
+
+// TestTripService_PauseResume - 배정 동승자도 일시정지/재개 가능, 이력과 누적 시간 기록, 일시정지 중 완료 가능
+func TestTripService_PauseResume(t *testing.T) {
+	// Given
+	f := newTripFixture(t)
+	trip := f.createTrip(t)
+	driverCtx := asPrincipal(domain.RoleDriver, "driver-1")
+	attendantCtx := asPrincipal(domain.RoleAttendant, f.attendant.ID)
+	pauseReq := &dto.PauseTripRequest{Reason: "병원 진료 대기"}
+
+	// When
+	_, pendingErr := f.svc.Pause(driverCtx, trip.ID, pauseReq)
+	_, err := f.svc.Start(driverCtx, trip.ID, nil)
+	require.NoError(t, err)
+	_, resumeErr := f.svc.Resume(driverCtx, trip.ID)
+	_, forbiddenErr := f.svc.Pause(asPrincipal(domain.RoleDriver, "driver-2"), trip.ID, pauseReq)
+	paused, err := f.svc.Pause(attendantCtx, trip.ID, pauseReq)
+
+	// Then
+	assertAppError(t, pendingErr, util.ErrCodeConflict)
+	assertAppError(t, resumeErr, util.ErrCodeConflict)
+	assertAppError(t, forbiddenErr, util.ErrCodeForbidden)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripStatusPaused, paused.Status)
+	require.NotNil(t, paused.CurrentPause())
+	assert.Equal(t, "병원 진료 대기", paused.CurrentPause().Reason)
+	assert.Equal(t, "attendant:"+f.attendant.ID, paused.CurrentPause().PausedBy)
+
+	// When
+	resumed, err := f.svc.Resume(driverCtx, trip.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripStatusInProgress, resumed.Status)
+	assert.Nil(t, resumed.CurrentPause())
+	require.Len(t, resumed.Pauses, 1)
+	assert.Equal(t, "driver:driver-1", resumed.Pauses[0].ResumedBy)
+	assert.NotNil(t, resumed.Pauses[0].ResumedAt)
+
+	// When - 다시 일시정지한 채로 완료
+	_, err = f.svc.Pause(driverCtx, trip.ID, &dto.PauseTripRequest{Reason: "휴식"})
+	require.NoError(t, err)
+	_, err = f.svc.ConfirmVehicleEmpty(driverCtx, trip.ID)
+	require.NoError(t, err)
+	completed, err := f.svc.Complete(driverCtx, trip.ID, nil)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.TripStatusCompleted, completed.Status)
+	require.Len(t, completed.Pauses, 2)
+	assert.NotNil(t, completed.Pauses[1].ResumedAt)
+}
+
+// TestTripService_Complete_VehicleCheck - 차내 잔류 탑승자가 있거나 차량 내부 확인 전이면 완료 거부
+func TestTripService_Complete_VehicleCheck(t *testing.T) {
+	// Given - 두 명이 승차한 운행
+	f := newTripFixture(t)
+	ctx := context.Background()
+	trip := f.createTrip(t)
+	driverCtx := asPrincipal(domain.RoleDriver, "driver-1")
+	_, err := f.svc.Start(driverCtx, trip.ID, nil)
+	require.NoError(t, err)
+	alighted := domain.NewTripPassenger(trip.ID, "passenger-1", "stop-1")
+	handedOver := domain.NewTripPassenger(trip.ID, "passenger-2", "stop-1")
+	for _, record := range []*domain.TripPassenger{alighted, handedOver} {
+		record.BoardPassenger("driver:driver-1", nil)
+		require.NoError(t, f.tripRepo.SavePassenger(ctx, record))
+	}
+
+	// When - 차내 잔류 탑승자가 있을 때
+	_, completeErr := f.svc.Complete(driverCtx, trip.ID, nil)
+	_, checkErr := f.svc.ConfirmVehicleEmpty(driverCtx, trip.ID)
+
+	// Then
+	assertAppError(t, completeErr, util.ErrCodeConflict)
+	assert.ElementsMatch(t, []string{"passenger-1", "passenger-2"}, completeErr.(*util.AppError).Details["passenger_ids"])
+	assertAppError(t, checkErr, util.ErrCodeConflict)
+
+	// When - 하차/예외 처리 후 확인 없이 완료
+	alighted.AlightPassenger("driver:driver-1", nil)
+	require.NoError(t, handedOver.FlagException("중간에 보호자 인계", "driver:driver-1"))
+	require.NoError(t, f.tripRepo.SavePassenger(ctx, alighted))
+	require.NoError(t, f.tripRepo.SavePassenger(ctx, handedOver))
+	_, uncheckedErr := f.svc.Complete(driverCtx, trip.ID, nil)
+
+	// Then
+	assertAppError(t, uncheckedErr, util.ErrCodeConflict)
+
+	// When - 동승자 확인 후 완료
+	checked, err := f.svc.ConfirmVehicleEmpty(asPrincipal(domain.RoleAttendant, f.attendant.ID), trip.ID)
+	require.NoError(t, err)
+	completed, err := f.svc.Complete(driverCtx, trip.ID, nil)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "attendant:"+f.attendant.ID, checked.VehicleCheckedBy)
+	require.NotNil(t, checked.VehicleCheckedAt)
+	assert.Equal(t, domain.TripStatusCompleted, completed.Status)
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	samplePDF = []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\n")
+	samplePNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+)
+
+// TestVehicleDocumentService_Upload - 내용으로 형식 판별, 보험/정기검사 만료일은 더 늦을 때만 차량에 반영
+func TestVehicleDocumentService_Upload(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleAdmin, "admin-1")
+	vehicleRepo := mocks.NewVehicleRepository()
+	van := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, van))
+	store := storage.NewLocalStorage(t.TempDir())
+	fileRepo := mocks.NewFileRepository()
+	svc := service.NewVehicleDocumentService(vehicleRepo, mocks.NewVehicleDocumentRepository(), service.NewFileService(fileRepo, store), nil)
+
+	// When
+	insurance, err := svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "insurance", IssuedOn: "2025-03-01", ExpiresOn: "2026-02-28"}, "보험증권.pdf", samplePDF)
+	_, oldErr := svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "insurance", ExpiresOn: "2025-02-28"}, "지난보험.pdf", samplePDF)
+	inspection, inspectionErr := svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "inspection", ExpiresOn: "2025-09-30"}, "검사.png", samplePNG)
+	_, textErr := svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "other"}, "memo.pdf", []byte("plain text"))
+	_, emptyErr := svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "other"}, "empty.pdf", nil)
+	_, datesErr := svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "insurance", IssuedOn: "2025-03-01", ExpiresOn: "2025-02-01"}, "보험.pdf", samplePDF)
+	_, missingErr := svc.Upload(ctx, "missing", &dto.UploadVehicleDocumentRequest{Type: "other"}, "a.pdf", samplePDF)
+
+	// Then
+	require.NoError(t, err)
+	require.NoError(t, oldErr)
+	require.NoError(t, inspectionErr)
+	assert.Equal(t, "application/pdf", insurance.File.ContentType)
+	assert.Equal(t, domain.FilePurposeVehicleDocument, insurance.File.Purpose)
+	assert.Equal(t, "image/png", inspection.File.ContentType)
+	assert.Equal(t, "user-admin-1", insurance.UploadedBy)
+	assertAppError(t, textErr, util.ErrCodeValidation)
+	assertAppError(t, emptyErr, util.ErrCodeValidation)
+	assertAppError(t, datesErr, util.ErrCodeValidation)
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	stored, err := vehicleRepo.GetByID(ctx, van.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.InsuranceExpiry)
+	assert.True(t, time.Date(2026, 2, 28, 0, 0, 0, 0, seoul).Equal(*stored.InsuranceExpiry), "지난 서류는 만료일을 되돌리지 않음")
+	require.NotNil(t, stored.InspectionExpiry)
+	assert.True(t, time.Date(2025, 9, 30, 0, 0, 0, 0, seoul).Equal(*stored.InspectionExpiry))
+
+	data, err := store.Get(ctx, insurance.File.StorageKey)
+	require.NoError(t, err)
+	assert.Equal(t, samplePDF, data)
+	_, err = fileRepo.GetByID(ctx, insurance.FileID)
+	assert.NoError(t, err)
+}
+
+// TestVehicleDocumentService_ListDownloadDelete - 종류별 목록, 원본 다운로드, 삭제 시 파일도 삭제
+func TestVehicleDocumentService_ListDownloadDelete(t *testing.T) {
+	// Given
+	ctx := asPrincipal(domain.RoleAdmin, "admin-1")
+	vehicleRepo := mocks.NewVehicleRepository()
+	van := domain.NewVehicle("12가3456", "그랜드스타렉스", "현대", domain.VehicleTypeVan, 12, 2022, "노랑")
+	bus := domain.NewVehicle("34나5678", "카운티", "현대", domain.VehicleTypeMiniBus, 25, 2021, "노랑")
+	require.NoError(t, vehicleRepo.Create(ctx, van))
+	require.NoError(t, vehicleRepo.Create(ctx, bus))
+	store := storage.NewLocalStorage(t.TempDir())
+	fileRepo := mocks.NewFileRepository()
+	svc := service.NewVehicleDocumentService(vehicleRepo, mocks.NewVehicleDocumentRepository(), service.NewFileService(fileRepo, store), nil)
+	insurance, err := svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "insurance"}, "보험.pdf", samplePDF)
+	require.NoError(t, err)
+	_, err = svc.Upload(ctx, van.ID, &dto.UploadVehicleDocumentRequest{Type: "registration"}, "등록증.png", samplePNG)
+	require.NoError(t, err)
+
+	// When
+	all, err := svc.List(ctx, van.ID, &dto.VehicleDocumentQuery{})
+	require.NoError(t, err)
+	insuranceOnly, err := svc.List(ctx, van.ID, &dto.VehicleDocumentQuery{Type: "insurance"})
+	require.NoError(t, err)
+	file, data, err := svc.Download(ctx, van.ID, insurance.ID)
+	_, _, otherVehicleErr := svc.Download(ctx, bus.ID, insurance.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+	require.Len(t, insuranceOnly, 1)
+	assert.Equal(t, "보험.pdf", file.FileName)
+	assert.Equal(t, samplePDF, data)
+	assertAppError(t, otherVehicleErr, util.ErrCodeNotFound)
+
+	// When
+	require.NoError(t, svc.Delete(ctx, van.ID, insurance.ID))
+	missingErr := svc.Delete(ctx, van.ID, insurance.ID)
+
+	// Then
+	assertAppError(t, missingErr, util.ErrCodeNotFound)
+	_, err = store.Get(ctx, insurance.File.StorageKey)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = fileRepo.GetByID(ctx, insurance.FileID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newVehicleRequest - 테스트용 차량 등록 요청
+func newVehicleRequest(plateNumber string) *dto.CreateVehicleRequest {
+	return &dto.CreateVehicleRequest{
+		PlateNumber:  plateNumber,
+		Model:        "그랜드스타렉스",
+		Manufacturer: "현대",
+		VehicleType:  "van",
+		Capacity:     12,
+		Year:         2022,
+	}
+}
+
+// TestVehicleService_Create - 차량 등록 성공
+func TestVehicleService_Create(t *testing.T) {
+	// Given
+	svc := service.NewVehicleService(mocks.NewVehicleRepository())
+
+	// When
+	vehicle, err := svc.Create(context.Background(), newVehicleRequest("12가3456"))
+
+	// Then
+	require.NoError(t, err)
+	assert.NotEmpty(t, vehicle.ID)
+	assert.Equal(t, domain.VehicleStatusActive, vehicle.Status)
+	assert.Equal(t, 11, vehicle.GetPassengerCapacity())
+}
+
+// TestVehicleService_Create_DuplicatePlateNumber - 차량 번호 중복
+func TestVehicleService_Create_DuplicatePlateNumber(t *testing.T) {
+	// Given
+	svc := service.NewVehicleService(mocks.NewVehicleRepository())
+	_, err := svc.Create(context.Background(), newVehicleRequest("12가3456"))
+	require.NoError(t, err)
+
+	// When
+	_, err = svc.Create(context.Background(), newVehicleRequest("12가3456"))
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeDuplicate, appErr.Code)
+}
+
+// TestVehicleService_Update_DuplicatePlateNumber - 다른 차량의 번호로 변경 시도
+func TestVehicleService_Update_DuplicatePlateNumber(t *testing.T) {
+	// Given
+	svc := service.NewVehicleService(mocks.NewVehicleRepository())
+	_, err := svc.Create(context.Background(), newVehicleRequest("12가3456"))
+	require.NoError(t, err)
+	second, err := svc.Create(context.Background(), newVehicleRequest("34나5678"))
+	require.NoError(t, err)
+
+	// When
+	plate := "12가3456"
+	_, err = svc.Update(context.Background(), second.ID, &dto.UpdateVehicleRequest{PlateNumber: &plate})
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeDuplicate, appErr.Code)
+}
+
+// TestVehicleService_Update_Status - 상태 변경 시 도메인 메소드 적용
+func TestVehicleService_Update_Status(t *testing.T) {
+	// Given
+	svc := service.NewVehicleService(mocks.NewVehicleRepository())
+	vehicle, err := svc.Create(context.Background(), newVehicleRequest("12가3456"))
+	require.NoError(t, err)
+
+	// When
+	status := "maintenance"
+	updated, err := svc.Update(context.Background(), vehicle.ID, &dto.UpdateVehicleRequest{Status: &status})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, domain.VehicleStatusMaintenance, updated.Status)
+	assert.NotNil(t, updated.LastMaintenanceAt)
+}
+
+// TestVehicleService_Delete_NotFound - 없는 차량 삭제
+func TestVehicleService_Delete_NotFound(t *testing.T) {
+	// Given
+	svc := service.NewVehicleService(mocks.NewVehicleRepository())
+
+	// When
+	err := svc.Delete(context.Background(), "unknown-id")
+
+	// Then
+	appErr, ok := err.(*util.AppError)
+	require.True(t, ok)
+	assert.Equal(t, util.ErrCodeNotFound, appErr.Code)
+}
+
+// TestVehicleService_List_Filter - 상태 필터 적용
+func TestVehicleService_List_Filter(t *testing.T) {
+	// Given
+	svc := service.NewVehicleService(mocks.NewVehicleRepository())
+	first, _ := svc.Create(context.Background(), newVehicleRequest("12가3456"))
+	_, _ = svc.Create(context.Background(), newVehicleRequest("34나5678"))
+	status := "inactive"
+	_, err := svc.Update(context.Background(), first.ID, &dto.UpdateVehicleRequest{Status: &status})
+	require.NoError(t, err)
+
+	// When
+	vehicles, total, err := svc.List(context.Background(), repository.VehicleFilter{Status: domain.VehicleStatusActive})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "34나5678", vehicles[0].PlateNumber)
+}
+
+// TestVehicleService_Restore - 삭제된 차량 복구 (같은 번호 차량이 새로 등록되었으면 409)
+func TestVehicleService_Restore(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	svc := service.NewVehicleService(mocks.NewVehicleRepository())
+	deleted, err := svc.Create(ctx, newVehicleRequest("12가3456"))
+	require.NoError(t, err)
+	require.NoError(t, svc.Delete(ctx, deleted.ID))
+
+	// When
+	active, _, _ := svc.List(ctx, repository.VehicleFilter{})
+	all, total, err := svc.List(ctx, repository.VehicleFilter{IncludeDeleted: true})
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, active)
+	assert.Equal(t, int64(1), total)
+	assert.NotNil(t, all[0].DeletedAt)
+
+	// When - 같은 번호로 새 차량이 등록된 상태에서 복구
+	replacement, err := svc.Create(ctx, newVehicleRequest("12가3456"))
+	require.NoError(t, err)
+	_, duplicateErr := svc.Restore(ctx, deleted.ID)
+
+	// Then
+	assertAppError(t, duplicateErr, util.ErrCodeDuplicate)
+
+	// When - 새 차량을 지운 뒤 복구
+	require.NoError(t, svc.Delete(ctx, replacement.ID))
+	restored, err := svc.Restore(ctx, deleted.ID)
+	_, notDeletedErr := svc.Restore(ctx, deleted.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt)
+	assertAppError(t, notDeletedErr, util.ErrCodeNotFound)
+}
@@ -0,0 +1,322 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyeokjun/eodini/internal/domain"
+	"github.com/hyeokjun/eodini/internal/dto"
+	"github.com/hyeokjun/eodini/internal/event"
+	"github.com/hyeokjun/eodini/internal/repository"
+	"github.com/hyeokjun/eodini/internal/service"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/pkg/webhook"
+	"github.com/hyeokjun/eodini/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookFixture - 웹훅 서비스 (최대 3회, 1분부터 2배 백오프)
+type webhookFixture struct {
+	svc              *service.WebhookService
+	subscriptionRepo *mocks.WebhookSubscriptionRepository
+	deliveryRepo     *mocks.WebhookDeliveryRepository
+	sender           *mocks.WebhookSender
+}
+
+// newWebhookFixture - 웹훅 서비스 생성
+func newWebhookFixture() *webhookFixture {
+	f := &webhookFixture{
+		subscriptionRepo: mocks.NewWebhookSubscriptionRepository(),
+		deliveryRepo:     mocks.NewWebhookDeliveryRepository(),
+		sender:           mocks.NewWebhookSender(),
+	}
+	f.svc = service.NewWebhookService(f.subscriptionRepo, f.deliveryRepo, f.sender,
+		service.NotificationRetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: time.Hour})
+	return f
+}
+
+// create - org-1 관리자로 구독 등록
+func (f *webhookFixture) create(t *testing.T, events ...string) *domain.WebhookSubscription {
+	return f.createIn(t, "org-1", events...)
+}
+
+// createIn - 지정한 기관 관리자로 구독 등록
+func (f *webhookFixture) createIn(t *testing.T, organizationID string, events ...string) *domain.WebhookSubscription {
+	issued, err := f.svc.Create(repository.WithOrganization(context.Background(), organizationID), "admin-1", &dto.CreateWebhookRequest{
+		Name: "출결 시스템", URL: "https://example.com/hooks", Events: events,
+	})
+	require.NoError(t, err)
+	return issued.Webhook
+}
+
+// deliveries - 구독의 전달 기록 (최신순)
+func (f *webhookFixture) deliveries(t *testing.T, subscriptionID string) []*domain.WebhookDelivery {
+	deliveries, _, err := f.svc.ListDeliveries(context.Background(), subscriptionID, repository.WebhookDeliveryFilter{})
+	require.NoError(t, err)
+	return deliveries
+}
+
+// TestWebhookService_Create - 비밀키 생략 시 생성, 이벤트 중복 제거, http/https 주소만 허용
+func TestWebhookService_Create(t *testing.T) {
+	// Given
+	f := newWebhookFixture()
+	ctx := context.Background()
+
+	// When
+	issued, err := f.svc.Create(ctx, "admin-1", &dto.CreateWebhookRequest{
+		Name: "출결 시스템", URL: "https://example.com/hooks", Events: []string{"trip.started", "trip.started", "passenger.boarded"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Contains(t, issued.Secret, "whsec_")
+	assert.Equal(t, issued.Secret, issued.Webhook.Secret)
+	assert.True(t, issued.Webhook.Active)
+	assert.Equal(t, []domain.WebhookEvent{domain.WebhookEventTripStarted, domain.WebhookEventPassengerBoarded}, issued.Webhook.Events)
+	assert.Equal(t, "admin-1", issued.Webhook.CreatedBy)
+
+	// When - 비밀키 지정
+	custom, err := f.svc.Create(ctx, "admin-1", &dto.CreateWebhookRequest{
+		Name: "출결 시스템", URL: "http://10.0.0.5/hooks", Secret: "my-own-secret-value", Events: []string{"trip.started"},
+	})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "my-own-secret-value", custom.Secret)
+
+	// When - 지원하지 않는 주소
+	_, err = f.svc.Create(ctx, "admin-1", &dto.CreateWebhookRequest{Name: "FTP", URL: "ftp://example.com", Events: []string{"trip.started"}})
+
+	// Then
+	assertAppError(t, err, util.ErrCodeValidation)
+}
+
+// TestWebhookService_Dispatch - 이벤트를 받는 활성 구독에만 서명해서 전달, 전달 기록 저장
+func TestWebhookService_Dispatch(t *testing.T) {
+	// Given
+	f := newWebhookFixture()
+	ctx := context.Background()
+	trips := f.create(t, "trip.started", "trip.completed")
+	boarding := f.create(t, "passenger.boarded")
+	paused := f.create(t, "trip.started")
+	_, err := f.svc.Update(ctx, paused.ID, &dto.UpdateWebhookRequest{Active: new(bool)})
+	require.NoError(t, err)
+
+	// When
+	count, err := f.svc.Dispatch(ctx, domainEvent(t, domain.WebhookEventTripStarted, &dto.TripWebhookData{TripID: "trip-1", Date: "2025-03-03"}))
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, f.sender.Sent, 1)
+	sent := f.sender.Sent[0]
+	assert.Equal(t, "https://example.com/hooks", sent.URL)
+	assert.Equal(t, trips.Secret, sent.Secret)
+	assert.Equal(t, "trip.started", sent.Event)
+
+	var envelope map[string]interface{}
+	require.NoError(t, json.Unmarshal(sent.Body, &envelope))
+	assert.Equal(t, "trip.started", envelope["type"])
+	assert.NotEmpty(t, envelope["id"])
+	assert.Equal(t, "trip-1", envelope["data"].(map[string]interface{})["trip_id"])
+
+	deliveries := f.deliveries(t, trips.ID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, sent.DeliveryID, deliveries[0].ID)
+	assert.Equal(t, envelope["id"], deliveries[0].EventID)
+	assert.Equal(t, domain.WebhookDeliveryDelivered, deliveries[0].Status)
+	assert.Equal(t, http.StatusOK, deliveries[0].ResponseStatus)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.NotNil(t, deliveries[0].DeliveredAt)
+	assert.Nil(t, deliveries[0].NextAttemptAt)
+	assert.Empty(t, f.deliveries(t, boarding.ID))
+	assert.Empty(t, f.deliveries(t, paused.ID))
+}
+
+// TestWebhookService_Dispatch_Organization - 이벤트가 발생한 기관의 구독에만 전달 (기관이 없는 이벤트는 전달하지 않음)
+func TestWebhookService_Dispatch_Organization(t *testing.T) {
+	// Given
+	f := newWebhookFixture()
+	own := f.create(t, "trip.started")
+	other := f.createIn(t, "org-2", "trip.started")
+	e := domainEvent(t, domain.WebhookEventTripStarted, &dto.TripWebhookData{TripID: "trip-1"})
+	orphan := domainEvent(t, domain.WebhookEventTripStarted, &dto.TripWebhookData{TripID: "trip-2"})
+	orphan.OrganizationID = ""
+
+	// When
+	count, err := f.svc.Dispatch(context.Background(), e)
+	skipped, orphanErr := f.svc.Dispatch(context.Background(), orphan)
+
+	// Then
+	require.NoError(t, err)
+	require.NoError(t, orphanErr)
+	assert.Equal(t, 1, count)
+	assert.Zero(t, skipped)
+	require.Len(t, f.sender.Sent, 1)
+	deliveries := f.deliveries(t, own.ID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, "org-1", deliveries[0].OrganizationID)
+	assert.Empty(t, f.deliveries(t, other.ID))
+}
+
+// TestWebhookService_Retry - 일시 장애는 지수 백오프로 재시도 후 dead, 4xx는 재시도 없이 failed
+func TestWebhookService_Retry(t *testing.T) {
+	t.Run("일시 장애", func(t *testing.T) {
+		// Given
+		f := newWebhookFixture()
+		ctx := context.Background()
+		subscription := f.create(t, "trip.completed")
+		f.sender.Status, f.sender.Err = http.StatusServiceUnavailable, &webhook.StatusError{Code: http.StatusServiceUnavailable}
+
+		// When
+		_, err := f.svc.Dispatch(ctx, domainEvent(t, domain.WebhookEventTripCompleted, &dto.TripWebhookData{TripID: "trip-1"}))
+
+		// Then: 1분 후 재시도
+		require.NoError(t, err)
+		delivery := f.deliveries(t, subscription.ID)[0]
+		assert.Equal(t, domain.WebhookDeliveryRetrying, delivery.Status)
+		assert.Equal(t, http.StatusServiceUnavailable, delivery.ResponseStatus)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), *delivery.NextAttemptAt, 5*time.Second)
+
+		// When: 아직 때가 되지 않음
+		retried, err := f.svc.RetryDue(ctx, time.Now())
+
+		// Then
+		require.NoError(t, err)
+		assert.Zero(t, retried)
+
+		// When: 두 번째 시도도 실패 → 2분 후
+		retried, err = f.svc.RetryDue(ctx, time.Now().Add(time.Minute+time.Second))
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, 1, retried)
+		delivery = f.deliveries(t, subscription.ID)[0]
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.WithinDuration(t, time.Now().Add(2*time.Minute), *delivery.NextAttemptAt, 5*time.Second)
+
+		// When: 세 번째(마지막) 시도 실패
+		_, err = f.svc.RetryDue(ctx, time.Now().Add(3*time.Minute))
+
+		// Then
+		require.NoError(t, err)
+		delivery = f.deliveries(t, subscription.ID)[0]
+		assert.Equal(t, domain.WebhookDeliveryDead, delivery.Status)
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.Nil(t, delivery.NextAttemptAt)
+		assert.Len(t, f.sender.Sent, 3)
+		// 재시도에도 같은 전달 ID
+		assert.Equal(t, f.sender.Sent[0].DeliveryID, f.sender.Sent[2].DeliveryID)
+	})
+
+	t.Run("영구 실패", func(t *testing.T) {
+		// Given
+		f := newWebhookFixture()
+		subscription := f.create(t, "trip.completed")
+		f.sender.Status, f.sender.Err = http.StatusGone, &webhook.StatusError{Code: http.StatusGone, Body: "gone"}
+
+		// When
+		_, err := f.svc.Dispatch(context.Background(), domainEvent(t, domain.WebhookEventTripCompleted, &dto.TripWebhookData{TripID: "trip-1"}))
+
+		// Then
+		require.NoError(t, err)
+		delivery := f.deliveries(t, subscription.ID)[0]
+		assert.Equal(t, domain.WebhookDeliveryFailed, delivery.Status)
+		assert.Equal(t, http.StatusGone, delivery.ResponseStatus)
+		assert.Contains(t, delivery.Response, "gone")
+		assert.Nil(t, delivery.NextAttemptAt)
+	})
+
+	t.Run("중단된 첫 전달과 비활성 구독", func(t *testing.T) {
+		// Given: 전달 전에 서버가 종료되어 pending으로 남은 기록
+		f := newWebhookFixture()
+		ctx := context.Background()
+		active := f.create(t, "trip.started")
+		inactive := f.create(t, "trip.started")
+		_, err := f.svc.Update(ctx, inactive.ID, &dto.UpdateWebhookRequest{Active: new(bool)})
+		require.NoError(t, err)
+		for _, subscription := range []*domain.WebhookSubscription{active, inactive} {
+			require.NoError(t, f.deliveryRepo.Create(ctx, domain.NewWebhookDelivery(subscription, "event-1", domain.WebhookEventTripStarted, `{}`, time.Now())))
+		}
+
+		// When
+		retried, err := f.svc.RetryDue(ctx, time.Now().Add(time.Second))
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, 2, retried)
+		require.Len(t, f.sender.Sent, 1)
+		assert.Equal(t, active.URL, f.sender.Sent[0].URL)
+		assert.Equal(t, domain.WebhookDeliveryDelivered, f.deliveries(t, active.ID)[0].Status)
+		assert.Equal(t, domain.WebhookDeliveryFailed, f.deliveries(t, inactive.ID)[0].Status)
+	})
+}
+
+// TestWebhookService_Manage - 수정(보낸 필드만), 삭제, 없는 구독 NOT_FOUND
+func TestWebhookService_Manage(t *testing.T) {
+	// Given
+	f := newWebhookFixture()
+	ctx := context.Background()
+	subscription := f.create(t, "trip.started")
+	name := "통합 출결"
+
+	// When
+	updated, err := f.svc.Update(ctx, subscription.ID, &dto.UpdateWebhookRequest{Name: &name, Events: []string{"passenger.no_show"}})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "통합 출결", updated.Name)
+	assert.Equal(t, subscription.URL, updated.URL)
+	assert.Equal(t, []domain.WebhookEvent{domain.WebhookEventPassengerNoShow}, updated.Events)
+	assert.Equal(t, subscription.Secret, updated.Secret)
+
+	badURL := "mailto:ops@example.com"
+	_, err = f.svc.Update(ctx, subscription.ID, &dto.UpdateWebhookRequest{URL: &badURL})
+	assertAppError(t, err, util.ErrCodeValidation)
+
+	// When
+	require.NoError(t, f.svc.Delete(ctx, subscription.ID))
+
+	// Then
+	assertAppError(t, f.svc.Delete(ctx, subscription.ID), util.ErrCodeNotFound)
+	_, _, err = f.svc.ListDeliveries(ctx, subscription.ID, repository.WebhookDeliveryFilter{})
+	assertAppError(t, err, util.ErrCodeNotFound)
+}
+
+// TestWebhookService_HandleEvent - 아웃박스 이벤트를 받아 전달 (본문 id와 시각은 이벤트 값 그대로)
+func TestWebhookService_HandleEvent(t *testing.T) {
+	// Given
+	f := newWebhookFixture()
+	subscription := f.create(t, "passenger.boarded")
+	e := domainEvent(t, domain.WebhookEventPassengerBoarded, &dto.PassengerWebhookData{TripID: "trip-1", PassengerID: "passenger-1"})
+
+	// When
+	err := f.svc.HandleEvent(context.Background(), e)
+	ignored := f.svc.HandleEvent(context.Background(), domainEvent(t, domain.WebhookEventTripStarted, &dto.TripWebhookData{TripID: "trip-1"}))
+
+	// Then
+	require.NoError(t, err)
+	require.NoError(t, ignored)
+	deliveries := f.deliveries(t, subscription.ID)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, e.ID, deliveries[0].EventID)
+
+	var envelope map[string]interface{}
+	require.NoError(t, json.Unmarshal(f.sender.Sent[0].Body, &envelope))
+	assert.Equal(t, e.ID, envelope["id"])
+	assert.Equal(t, e.OccurredAt.Format(time.RFC3339Nano), envelope["created_at"])
+	assert.Equal(t, "passenger-1", envelope["data"].(map[string]interface{})["passenger_id"])
+}
+
+// domainEvent - 테스트용 org-1 도메인 이벤트 (아웃박스 릴레이가 전달하는 형태)
+func domainEvent(t *testing.T, eventType domain.WebhookEvent, data interface{}) event.Event {
+	payload, err := json.Marshal(data)
+	require.NoError(t, err)
+	return event.Event{ID: uuid.New().String(), Type: string(eventType), OrganizationID: "org-1", OccurredAt: time.Now().UTC(), Payload: payload}
+}
@@ -0,0 +1,114 @@
+package sms_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/sms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestByteLength - 한글 2바이트, ASCII 1바이트 기준으로 단문/장문 구분
+func TestByteLength(t *testing.T) {
+	assert.Equal(t, 11, sms.ByteLength("[어디니] OK"))
+	assert.False(t, sms.IsLong(strings.Repeat("가", 45)))
+	assert.True(t, sms.IsLong(strings.Repeat("가", 45)+"!"))
+}
+
+// TestNormalizeNumber - 하이픈/공백 제거, 숫자가 아니거나 길이가 맞지 않으면 에러
+func TestNormalizeNumber(t *testing.T) {
+	number, err := sms.NormalizeNumber("010-1234 5678")
+	require.NoError(t, err)
+	assert.Equal(t, "01012345678", number)
+
+	_, err = sms.NormalizeNumber("010-abcd-5678")
+	assert.ErrorIs(t, err, sms.ErrInvalidNumber)
+	_, err = sms.NormalizeNumber("112")
+	assert.ErrorIs(t, err, sms.ErrInvalidNumber)
+}
+
+// TestAligoClient_Send - 90바이트 초과는 LMS, result_code가 1이 아니면 실패
+func TestAligoClient_Send(t *testing.T) {
+	// Given
+	var forms []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/send/", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		forms = append(forms, map[string]string{
+			"key":      r.PostForm.Get("key"),
+			"sender":   r.PostForm.Get("sender"),
+			"receiver": r.PostForm.Get("receiver"),
+			"msg_type": r.PostForm.Get("msg_type"),
+			"title":    r.PostForm.Get("title"),
+		})
+		if r.PostForm.Get("receiver") == "01099999999" {
+			_, _ = w.Write([]byte(`{"result_code":"-101","message":"인증오류입니다."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result_code":1,"message":"success","msg_id":"123"}`))
+	}))
+	defer server.Close()
+	client := sms.NewAligoClient("api-key", "eodini", "0212345678", time.Second)
+	client.BaseURL = server.URL
+	ctx := context.Background()
+
+	// When
+	shortErr := client.Send(ctx, &sms.Message{To: "010-1234-5678", Text: "[어디니] 승차했습니다"})
+	longErr := client.Send(ctx, &sms.Message{To: "01012345678", Title: "운행 안내", Text: strings.Repeat("가", 60)})
+	failedErr := client.Send(ctx, &sms.Message{To: "01099999999", Text: "테스트"})
+
+	// Then
+	require.NoError(t, shortErr)
+	require.NoError(t, longErr)
+	require.Error(t, failedErr)
+	assert.Contains(t, failedErr.Error(), "-101")
+	assert.Equal(t, map[string]string{"key": "api-key", "sender": "0212345678", "receiver": "01012345678", "msg_type": "SMS", "title": ""}, forms[0])
+	assert.Equal(t, "LMS", forms[1]["msg_type"])
+	assert.Equal(t, "운행 안내", forms[1]["title"])
+}
+
+// TestNHNClient_Send - 단문은 /sender/sms, 장문은 /sender/mms, 수신자별 결과 코드 확인
+func TestNHNClient_Send(t *testing.T) {
+	// Given
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("X-Secret-Key"))
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "0212345678", body["sendNo"])
+
+		recipient := body["recipientList"].([]interface{})[0].(map[string]interface{})["recipientNo"]
+		resultCode := "0"
+		if recipient == "01099999999" {
+			resultCode = "-1000"
+		}
+		_, _ = w.Write([]byte(`{"header":{"isSuccessful":true,"resultCode":0,"resultMessage":"SUCCESS"},` +
+			`"body":{"data":{"requestId":"r-1","sendResultList":[{"resultCode":` + resultCode + `,"resultMessage":"result"}]}}}`))
+	}))
+	defer server.Close()
+	client := sms.NewNHNClient("app-key", "secret", "0212345678", time.Second)
+	client.BaseURL = server.URL
+	ctx := context.Background()
+
+	// When
+	shortErr := client.Send(ctx, &sms.Message{To: "01012345678", Text: "[어디니] 승차했습니다"})
+	longErr := client.Send(ctx, &sms.Message{To: "01012345678", Title: "운행 안내", Text: strings.Repeat("가", 60)})
+	failedErr := client.Send(ctx, &sms.Message{To: "01099999999", Text: "테스트"})
+
+	// Then
+	require.NoError(t, shortErr)
+	require.NoError(t, longErr)
+	require.Error(t, failedErr)
+	assert.Equal(t, []string{
+		"/sms/v3.0/appKeys/app-key/sender/sms",
+		"/sms/v3.0/appKeys/app-key/sender/mms",
+		"/sms/v3.0/appKeys/app-key/sender/sms",
+	}, paths)
+}
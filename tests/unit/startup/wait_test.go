@@ -0,0 +1,67 @@
+package startup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/startup"
+	"github.com/stretchr/testify/assert"
+)
+
+// testOptions - 빠른 재시도 간격
+var testOptions = startup.Options{Backoff: time.Millisecond, MaxBackoff: 4 * time.Millisecond}
+
+// TestWait_RetriesUntilReady - 실패하는 동안 재시도하고 성공하면 반환
+func TestWait_RetriesUntilReady(t *testing.T) {
+	// Given
+	attempts := 0
+	check := func(context.Context) error {
+		attempts++
+		if attempts < 4 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	// When
+	err := startup.Wait(context.Background(), "database", testOptions, check)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 4, attempts)
+}
+
+// TestWait_GivesUpAfterDeadline - ctx 기한을 넘기면 마지막 오류와 함께 실패
+func TestWait_GivesUpAfterDeadline(t *testing.T) {
+	// Given
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	refused := errors.New("connection refused")
+
+	// When
+	err := startup.Wait(ctx, "redis", testOptions, func(context.Context) error { return refused })
+
+	// Then
+	assert.ErrorIs(t, err, refused)
+	assert.Contains(t, err.Error(), "redis not ready")
+}
+
+// TestWait_PassesContext - check는 대기 기한이 있는 ctx를 받음
+func TestWait_PassesContext(t *testing.T) {
+	// Given
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// When
+	err := startup.Wait(ctx, "migrations", testOptions, func(ctx context.Context) error {
+		if _, ok := ctx.Deadline(); !ok {
+			return errors.New("no deadline")
+		}
+		return nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+}
@@ -0,0 +1,115 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripFunc - 고정 호스트로 보낸 요청을 가로채는 Transport (서명에 호스트가 포함되므로 httptest 대신 사용)
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestLocalStorage - 저장/조회/덮어쓰기/삭제, 없는 키는 ErrNotFound, 저장소 밖 경로 거부
+func TestLocalStorage(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	store := storage.NewLocalStorage(t.TempDir())
+
+	// When
+	require.NoError(t, store.Put(ctx, "vehicle-documents/v-1/d-1", []byte("first"), "application/pdf"))
+	require.NoError(t, store.Put(ctx, "vehicle-documents/v-1/d-1", []byte("second"), "application/pdf"))
+	data, err := store.Get(ctx, "vehicle-documents/v-1/d-1")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+
+	// When
+	require.NoError(t, store.Delete(ctx, "vehicle-documents/v-1/d-1"))
+	_, missingErr := store.Get(ctx, "vehicle-documents/v-1/d-1")
+
+	// Then
+	assert.ErrorIs(t, missingErr, storage.ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, "vehicle-documents/v-1/d-1"), "없는 키 삭제는 에러 아님")
+	for _, key := range []string{"", "/etc/passwd", "../secret", "a/../../b", "a//b"} {
+		assert.ErrorIs(t, store.Put(ctx, key, []byte("x"), ""), storage.ErrInvalidKey, key)
+	}
+}
+
+// TestS3Storage_Put - 가상 호스트 주소, 키 경로 인코딩, SigV4 서명
+func TestS3Storage_Put(t *testing.T) {
+	// Given
+	var captured *http.Request
+	var body []byte
+	client := storage.NewS3Storage("ap-northeast-2", "eodini-docs", "", "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", time.Second)
+	client.Now = func() time.Time { return time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC) }
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		captured = req
+		body, _ = io.ReadAll(req.Body)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+	})
+
+	// When
+	err := client.Put(context.Background(), "vehicle-documents/v-1/보험.pdf", []byte("%PDF-1.4 test"), "application/pdf")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, captured.Method)
+	assert.Equal(t, "https://eodini-docs.s3.ap-northeast-2.amazonaws.com/vehicle-documents/v-1/%EB%B3%B4%ED%97%98.pdf", captured.URL.String())
+	assert.Equal(t, "application/pdf", captured.Header.Get("Content-Type"))
+	assert.Equal(t, "%PDF-1.4 test", string(body))
+	// 기대 서명은 AWS SigV4 절차로 별도 계산한 값
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20250303/ap-northeast-2/s3/aws4_request, "+
+		"SignedHeaders=host;x-amz-content-sha256;x-amz-date, "+
+		"Signature=7f1b19b523e9a8979cac8fb6234bf4824be6c0ff867a759b5a46e547b319de29", captured.Header.Get("Authorization"))
+}
+
+// TestS3Storage_GetDelete - 404는 조회 시 ErrNotFound/삭제 시 성공, 그 밖의 실패는 응답 본문 포함, 엔드포인트 지정 시 경로 방식
+func TestS3Storage_GetDelete(t *testing.T) {
+	// Given
+	objects := map[string]string{"/eodini-docs/vehicle-documents/v-1/d-1": "pdf"}
+	var urls []string
+	client := storage.NewS3Storage("kr-standard", "eodini-docs", "https://kr.object.ncloudstorage.com/", "key", "secret", time.Second)
+	client.HTTPClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		urls = append(urls, req.URL.String())
+		status, body := http.StatusOK, objects[req.URL.Path]
+		switch {
+		case req.URL.Path == "/eodini-docs/vehicle-documents/v-1/denied":
+			status, body = http.StatusForbidden, "<Error><Code>AccessDenied</Code></Error>"
+		case body == "":
+			status = http.StatusNotFound
+		case req.Method == http.MethodDelete:
+			status = http.StatusNoContent
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+	})
+	ctx := context.Background()
+
+	// When
+	data, err := client.Get(ctx, "vehicle-documents/v-1/d-1")
+	_, missingErr := client.Get(ctx, "vehicle-documents/v-1/d-2")
+	_, deniedErr := client.Get(ctx, "vehicle-documents/v-1/denied")
+	deleteErr := client.Delete(ctx, "vehicle-documents/v-1/d-1")
+	deleteMissingErr := client.Delete(ctx, "vehicle-documents/v-1/d-2")
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "pdf", string(data))
+	assert.Equal(t, "https://kr.object.ncloudstorage.com/eodini-docs/vehicle-documents/v-1/d-1", urls[0])
+	assert.ErrorIs(t, missingErr, storage.ErrNotFound)
+	require.Error(t, deniedErr)
+	assert.Contains(t, deniedErr.Error(), "AccessDenied")
+	assert.NoError(t, deleteErr)
+	assert.NoError(t, deleteMissingErr)
+}
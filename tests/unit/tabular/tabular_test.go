@@ -0,0 +1,109 @@
+package tabular_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/hyeokjun/eodini/pkg/tabular"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadCSV - BOM 제거, 앞뒤 공백 제거, 빈 행은 건너뛰고 파일 행 번호 유지
+func TestReadCSV(t *testing.T) {
+	// Given
+	data := []byte("\xEF\xBB\xBF이름,연락처\n 김철수 ,010-1111-2222\n,\n\"이, 영희\",\"010-3333-4444\"\n")
+
+	// When
+	rows, err := tabular.Read("children.CSV", data)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, tabular.Row{Number: 1, Cells: []string{"이름", "연락처"}}, rows[0])
+	assert.Equal(t, tabular.Row{Number: 2, Cells: []string{"김철수", "010-1111-2222"}}, rows[1])
+	assert.Equal(t, 4, rows[2].Number)
+	assert.Equal(t, "이, 영희", rows[2].Cell(0))
+	assert.Equal(t, "", rows[2].Cell(5))
+}
+
+// TestRead_Errors - UTF-8이 아닌 CSV, 지원하지 않는 확장자, 깨진 xlsx
+func TestRead_Errors(t *testing.T) {
+	_, err := tabular.Read("children.csv", []byte{0xC0, 0xCC, 0xB8, 0xA7}) // EUC-KR "이름"
+	assert.ErrorIs(t, err, tabular.ErrNotUTF8)
+
+	_, err = tabular.Read("children.xls", []byte("binary"))
+	assert.ErrorIs(t, err, tabular.ErrUnsupportedFormat)
+
+	_, err = tabular.Read("children.xlsx", []byte("not a zip"))
+	assert.ErrorIs(t, err, tabular.ErrMalformed)
+}
+
+// TestReadXLSX - 공유 문자열(서식 구간 포함), 직접 입력 문자열, 숫자, 빈 칸 건너뛴 열 위치
+func TestReadXLSX(t *testing.T) {
+	// Given
+	data := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+			<sheets><sheet name="원아" sheetId="1" r:id="rId3"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+			<Relationship Id="rId1" Type="styles" Target="styles.xml"/>
+			<Relationship Id="rId3" Type="worksheet" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+			<si><t>이름</t></si><si><t>위도</t></si><si><r><t>김</t></r><r><t>철수</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+			<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>
+			<row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3"><v>37.566499999999998</v></c></row>
+			<row r="4"><c r="A4" t="inlineStr"><is><t>이영희</t></is></c><c r="B4" t="str"><v>수식 결과</v></c></row>
+			</sheetData></worksheet>`,
+	})
+
+	// When
+	rows, err := tabular.Read("children.xlsx", data)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, tabular.Row{Number: 1, Cells: []string{"이름", "", "위도"}}, rows[0])
+	assert.Equal(t, tabular.Row{Number: 3, Cells: []string{"김철수", "", "37.5665"}}, rows[1])
+	assert.Equal(t, tabular.Row{Number: 4, Cells: []string{"이영희", "수식 결과"}}, rows[2])
+}
+
+// buildXLSX - 파트 경로 → XML 내용으로 xlsx(ZIP) 생성
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range parts {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// TestWriteXLSX - 쓴 파일을 다시 읽으면 같은 값 (특수 문자 이스케이프, 빈 칸 위치 유지)
+func TestWriteXLSX(t *testing.T) {
+	// Given
+	sheet := tabular.Sheet{
+		Name:   "출결/기록",
+		Header: []string{"탑승자", "사유", "비고"},
+		Rows: [][]string{
+			{"김철수", "", "<병원> & 귀가"},
+			{"이영희", "  앞뒤 공백은 읽을 때 제거  "},
+		},
+	}
+
+	// When
+	data, err := tabular.WriteXLSX(sheet)
+	require.NoError(t, err)
+	rows, err := tabular.Read("attendance.xlsx", data)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"탑승자", "사유", "비고"}, rows[0].Cells)
+	assert.Equal(t, []string{"김철수", "", "<병원> & 귀가"}, rows[1].Cells)
+	assert.Equal(t, []string{"이영희", "앞뒤 공백은 읽을 때 제거"}, rows[2].Cells)
+}
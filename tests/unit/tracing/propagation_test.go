@@ -0,0 +1,65 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hyeokjun/eodini/pkg/logger"
+	"github.com/hyeokjun/eodini/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestPropagator_ExtractsTraceAndRequestID - 수신 헤더의 traceparent와 X-Request-ID를 context로
+func TestPropagator_ExtractsTraceAndRequestID(t *testing.T) {
+	// Given
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	header.Set("X-Request-ID", "req-123")
+
+	// When
+	ctx := tracing.Propagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	// Then
+	spanContext := trace.SpanContextFromContext(ctx)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID().String())
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, "req-123", logger.RequestIDFromContext(ctx))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", tracing.TraceID(ctx))
+}
+
+// TestPropagator_InjectsTraceAndRequestID - 외부 호출 헤더에 traceparent와 X-Request-ID 추가
+func TestPropagator_InjectsTraceAndRequestID(t *testing.T) {
+	// Given
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	ctx = logger.WithRequestID(ctx, "req-123")
+
+	// When
+	header := http.Header{}
+	tracing.Propagator().Inject(ctx, propagation.HeaderCarrier(header))
+
+	// Then
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", header.Get("traceparent"))
+	assert.Equal(t, "req-123", header.Get("X-Request-ID"))
+}
+
+// TestPropagator_WithoutContext - 추적/요청 ID가 없으면 헤더를 추가하지 않음
+func TestPropagator_WithoutContext(t *testing.T) {
+	// Given
+	header := http.Header{}
+
+	// When
+	tracing.Propagator().Inject(context.Background(), propagation.HeaderCarrier(header))
+
+	// Then
+	assert.Empty(t, header)
+	assert.Empty(t, tracing.TraceID(context.Background()))
+}
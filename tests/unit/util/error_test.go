@@ -167,3 +167,34 @@ func TestAppError_AsError(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, util.ErrCodeNotFound, appErr.Code)
 }
+
+// TestAppError_WithDetail - 상세 정보를 추가한 복사본 반환 (원본은 그대로)
+func TestAppError_WithDetail(t *testing.T) {
+	// Given
+	original := util.NewTooManyRequestsError(0)
+
+	// When
+	withRequestID := original.WithDetail("request_id", "req-123")
+
+	// Then
+	assert.Equal(t, original.Code, withRequestID.Code)
+	assert.Equal(t, original.StatusCode, withRequestID.StatusCode)
+	assert.Equal(t, "req-123", withRequestID.Details["request_id"])
+	assert.Equal(t, 0, withRequestID.Details["retry_after_seconds"])
+	assert.NotContains(t, original.Details, "request_id")
+}
+
+// TestAppError_Localize - 메시지를 요청 언어로 변환한 복사본 반환 (원본은 그대로)
+func TestAppError_Localize(t *testing.T) {
+	// Given
+	original := util.NewNotFoundError("차량")
+
+	// When
+	english := original.Localize(util.LocaleEnglish)
+
+	// Then
+	assert.Equal(t, "Vehicle not found", english.Message)
+	assert.Equal(t, original.Code, english.Code)
+	assert.Equal(t, original.StatusCode, english.StatusCode)
+	assert.Equal(t, "차량을(를) 찾을 수 없습니다", original.Message)
+}
@@ -0,0 +1,103 @@
+package util_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNegotiateLocale - Accept-Language에서 지원하는 언어 중 우선순위가 가장 높은 언어 선택
+func TestNegotiateLocale(t *testing.T) {
+	tests := []struct {
+		header string
+		want   util.Locale
+	}{
+		{"", util.LocaleKorean},
+		{"en-US,en;q=0.9", util.LocaleEnglish},
+		{"ja,en;q=0.5,ko;q=0.8", util.LocaleKorean},
+		{"ko;q=0.3, EN_gb;q=0.7", util.LocaleEnglish},
+		{"en;q=0,ko", util.LocaleKorean},
+		{"fr-FR,de", util.LocaleKorean},
+	}
+
+	for _, tt := range tests {
+		// When / Then
+		assert.Equal(t, tt.want, util.NegotiateLocale(tt.header), tt.header)
+	}
+}
+
+// TestLocaleFromContext - context에 언어가 없으면 기본 언어
+func TestLocaleFromContext(t *testing.T) {
+	// Given
+	ctx := util.WithLocale(context.Background(), util.LocaleEnglish)
+
+	// When / Then
+	assert.Equal(t, util.LocaleEnglish, util.LocaleFromContext(ctx))
+	assert.Equal(t, util.DefaultLocale, util.LocaleFromContext(context.Background()))
+}
+
+// TestGetLocalizedMessage - 언어별 메시지, 리소스 이름 인자 번역, 없는 키는 기본 언어
+func TestGetLocalizedMessage(t *testing.T) {
+	// Given
+	util.AddMessage("TEST_KOREAN_ONLY", "한국어 전용 메시지")
+
+	// When / Then
+	assert.Equal(t, "Vehicle has been created", util.GetLocalizedMessage(util.LocaleEnglish, util.MsgCreated, "차량"))
+	assert.Equal(t, "Foo not found", util.GetLocalizedMessage(util.LocaleEnglish, util.MsgResourceNotFound, "Foo"))
+	assert.Equal(t, "차량이(가) 생성되었습니다", util.GetLocalizedMessage(util.LocaleKorean, util.MsgCreated, "차량"))
+	assert.Equal(t, "한국어 전용 메시지", util.GetLocalizedMessage(util.LocaleEnglish, "TEST_KOREAN_ONLY"))
+}
+
+// TestAppError_LocalizeFreeForm - 에러는 키와 인자로 요청 언어 메시지를 다시 만듦 (자유 형식 문구, 필드별 사유 포함)
+func TestAppError_LocalizeFreeForm(t *testing.T) {
+	// Given
+	conflict := util.NewConflictError("운행을 시작할 수 없는 상태입니다: %s", "completed")
+	notFound := util.NewNotFoundError("정류장")
+	invalid := util.NewValidationError(util.MsgValidationFailed, map[string]interface{}{
+		"capacity": util.Text("%s 타입이어야 합니다", "숫자"),
+		"reason":   "반려 사유를 입력해 주세요",
+		"custom":   "번역이 없는 문구",
+	})
+
+	// When
+	englishConflict := conflict.Localize(util.LocaleEnglish)
+	englishInvalid := invalid.Localize(util.LocaleEnglish)
+
+	// Then
+	assert.Equal(t, "The trip cannot be started in its current status: completed", englishConflict.Message)
+	assert.Equal(t, "운행을 시작할 수 없는 상태입니다: completed", conflict.Message) // 원본은 그대로
+	assert.Equal(t, "Stop not found", notFound.Localize(util.LocaleEnglish).Message)
+	assert.Equal(t, "Validation failed", englishInvalid.Message)
+	assert.Equal(t, "Must be a number", englishInvalid.Details["capacity"])
+	assert.Equal(t, "Enter a reason for the rejection", englishInvalid.Details["reason"])
+	assert.Equal(t, "번역이 없는 문구", englishInvalid.Details["custom"])
+	assert.Same(t, conflict, conflict.Localize(util.LocaleKorean))
+}
+
+// TestAddLocalizedMessage_Concurrent - 런타임 메시지 추가와 조회가 동시에 일어나도 안전 (go test -race)
+func TestAddLocalizedMessage_Concurrent(t *testing.T) {
+	// Given
+	var wg sync.WaitGroup
+
+	// When
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			util.AddLocalizedMessage(util.LocaleEnglish, fmt.Sprintf("TEST_CONCURRENT_%d", i), "Concurrent message")
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = util.GetLocalizedMessage(util.LocaleEnglish, util.MsgCreated, "차량")
+			_ = util.GetAllMessages()
+		}()
+	}
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, "Concurrent message", util.GetLocalizedMessage(util.LocaleEnglish, "TEST_CONCURRENT_0"))
+}
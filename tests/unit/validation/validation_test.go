@@ -0,0 +1,97 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/hyeokjun/eodini/internal/util"
+	"github.com/hyeokjun/eodini/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sample - 도메인 규칙을 모두 사용하는 테스트용 요청
+type sample struct {
+	PlateNumber string   `json:"plate_number" validate:"omitempty,kr_plate"`
+	StartTime   string   `json:"start_time" validate:"omitempty,hhmm"`
+	DaysOfWeek  []int    `json:"days_of_week" validate:"omitempty,dive,weekday"`
+	Phone       string   `json:"phone" validate:"omitempty,phone"`
+	Latitude    *float64 `json:"latitude" validate:"omitempty,latitude"`
+	Longitude   *float64 `json:"longitude" validate:"omitempty,longitude"`
+	Days        string   `json:"days" validate:"omitempty,days"`
+}
+
+func newValidator(t *testing.T) *validator.Validate {
+	v := validator.New()
+	require.NoError(t, validation.Register(v))
+	return v
+}
+
+func float(v float64) *float64 { return &v }
+
+// TestRegister_Rules - 규칙별 허용/거부 값
+func TestRegister_Rules(t *testing.T) {
+	tests := []struct {
+		name  string
+		input sample
+		field string // 실패가 기대되는 필드 (빈 값이면 통과)
+	}{
+		{"차량 번호", sample{PlateNumber: "12가3456"}, ""},
+		{"차량 번호 3자리", sample{PlateNumber: "123가4567"}, ""},
+		{"차량 번호 지역명", sample{PlateNumber: "서울12가3456"}, ""},
+		{"차량 번호 형식 오류", sample{PlateNumber: "AB-1234"}, "plate_number"},
+		{"시각", sample{StartTime: "08:30"}, ""},
+		{"시각 범위 초과", sample{StartTime: "24:00"}, "start_time"},
+		{"시각 자릿수", sample{StartTime: "8:30"}, "start_time"},
+		{"요일", sample{DaysOfWeek: []int{1, 5, 7}}, ""},
+		{"요일 범위 초과", sample{DaysOfWeek: []int{0}}, "days_of_week[0]"},
+		{"휴대폰", sample{Phone: "010-1234-5678"}, ""},
+		{"지역번호 하이픈 없음", sample{Phone: "0212345678"}, ""},
+		{"E.164", sample{Phone: "+821012345678"}, ""},
+		{"전화번호 형식 오류", sample{Phone: "1234"}, "phone"},
+		{"좌표", sample{Latitude: float(37.5), Longitude: float(127.0)}, ""},
+		{"위도 범위 초과", sample{Latitude: float(91)}, "latitude"},
+		{"경도 범위 초과", sample{Longitude: float(-180.5)}, "longitude"},
+		{"일수", sample{Days: "30"}, ""},
+		{"일수 d 접미사", sample{Days: "30d"}, ""},
+		{"일수 최대 초과", sample{Days: "3651d"}, "days"},
+		{"일수 형식 오류", sample{Days: "30일"}, "days"},
+	}
+
+	v := newValidator(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			err := v.Struct(tt.input)
+
+			// Then
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			details := validation.FieldErrors(err)
+			assert.Contains(t, details, tt.field)
+			assert.NotContains(t, fmt.Sprint(details[tt.field]), tt.field) // 태그 이름 대신 사용자 메시지
+		})
+	}
+}
+
+// TestFieldErrors_JSONErrors - JSON 파싱 오류도 필드/본문 단위 메시지로 변환
+func TestFieldErrors_JSONErrors(t *testing.T) {
+	// Given
+	var target struct {
+		Capacity int `json:"capacity"`
+	}
+
+	// When: 타입 불일치
+	typeErr := json.Unmarshal([]byte(`{"capacity":"many"}`), &target)
+	// When: 문법 오류
+	syntaxErr := json.Unmarshal([]byte(`{"capacity":`), &target)
+
+	// Then
+	assert.Equal(t, map[string]interface{}{"capacity": util.Text("%s 타입이어야 합니다", "숫자")}, validation.FieldErrors(typeErr))
+	assert.Contains(t, validation.FieldErrors(syntaxErr), validation.BodyField)
+}
@@ -0,0 +1,108 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyeokjun/eodini/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSignVerify - 같은 비밀키/본문이면 검증 통과, 본문/비밀키가 다르거나 오래된 서명은 거부
+func TestSignVerify(t *testing.T) {
+	now := time.Unix(1760000000, 0)
+	body := []byte(`{"type":"trip.started"}`)
+	header := webhook.Sign("whsec_test", now, body)
+
+	assert.True(t, strings.HasPrefix(header, "t=1760000000,v1="))
+	assert.NoError(t, webhook.Verify("whsec_test", header, body, 5*time.Minute, now.Add(time.Minute)))
+	assert.ErrorIs(t, webhook.Verify("whsec_test", header, []byte(`{}`), 0, now), webhook.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.Verify("whsec_other", header, body, 0, now), webhook.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.Verify("whsec_test", "v1=abc", body, 0, now), webhook.ErrInvalidSignature)
+	assert.ErrorIs(t, webhook.Verify("whsec_test", header, body, 5*time.Minute, now.Add(10*time.Minute)), webhook.ErrExpiredSignature)
+}
+
+// TestGenerateSecret - whsec_ 접두어 + 매번 다른 값
+func TestGenerateSecret(t *testing.T) {
+	first, err := webhook.GenerateSecret()
+	require.NoError(t, err)
+	second, err := webhook.GenerateSecret()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(first, "whsec_"))
+	assert.NotEqual(t, first, second)
+}
+
+// TestClient_Send - 서명 헤더와 본문 전달, 2xx가 아니면 StatusError (408/429/5xx만 일시 장애)
+func TestClient_Send(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		wantErr   bool
+		permanent bool
+	}{
+		{name: "성공", status: http.StatusNoContent},
+		{name: "수신 서버 오류", status: http.StatusBadGateway, wantErr: true},
+		{name: "요청 과다", status: http.StatusTooManyRequests, wantErr: true},
+		{name: "잘못된 주소", status: http.StatusNotFound, wantErr: true, permanent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var received *http.Request
+			var receivedBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				receivedBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("응답 본문"))
+			}))
+			defer server.Close()
+			body := []byte(`{"id":"evt-1"}`)
+
+			// When
+			status, err := webhook.NewClient(time.Second).Send(context.Background(), &webhook.Message{
+				URL: server.URL, Secret: "whsec_test", Event: "trip.started", DeliveryID: "delivery-1", Body: body,
+			})
+
+			// Then
+			assert.Equal(t, tt.status, status)
+			require.NotNil(t, received)
+			assert.Equal(t, http.MethodPost, received.Method)
+			assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+			assert.Equal(t, "trip.started", received.Header.Get(webhook.HeaderEvent))
+			assert.Equal(t, "delivery-1", received.Header.Get(webhook.HeaderDelivery))
+			assert.Equal(t, body, receivedBody)
+			assert.NoError(t, webhook.Verify("whsec_test", received.Header.Get(webhook.HeaderSignature), receivedBody, time.Minute, time.Now()))
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var statusErr *webhook.StatusError
+			require.True(t, errors.As(err, &statusErr))
+			assert.Equal(t, "응답 본문", statusErr.Body)
+			assert.Equal(t, tt.permanent, webhook.IsPermanent(err))
+		})
+	}
+}
+
+// TestClient_Send_Unreachable - 연결 실패는 상태 0, 일시 장애
+func TestClient_Send_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	status, err := webhook.NewClient(time.Second).Send(context.Background(), &webhook.Message{URL: url, Body: []byte(`{}`)})
+
+	assert.Equal(t, 0, status)
+	assert.Error(t, err)
+	assert.False(t, webhook.IsPermanent(err))
+}